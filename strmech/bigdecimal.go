@@ -1,13 +1,16 @@
 package strmech
 
 import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"math/big"
 	"sync"
 )
 
 // BigDecimal
 //
-// Contains a representation of integer or floating
-// point numeric values.
+// Contains an exact representation of integer or
+// floating point numeric values.
 //
 // This type incorporates integer math routines to
 // ensure a high degree of accuracy when managing
@@ -16,31 +19,2065 @@ import (
 // numeric values.
 //
 // The encapsulated numeric value is maintained
-// internally as form of scientific notation.
+// internally as an integer significand multiplied by
+// 10 raised to the power of an integer exponent:
+//
+//	numeric value = significand x 10^exponent
+//
+//	Examples
+//		Numeric Value:	265,200,000
+//		significand:	2652
+//		exponent:		5
+//
+//		Numeric Value:	-1.250
+//		significand:	-1250
+//		exponent:		-3
+//
+// Addition, subtraction and multiplication are always
+// exact. Division and rounding operations apply one of
+// the rounding algorithms defined by enumeration type
+// NumberRoundingType.
+//
+// Trailing zeros in the significand are preserved until
+// method BigDecimal.Normalize() is called. Therefore,
+// the number of fractional digits (scale) of a numeric
+// value such as '1.250' is retained when converting to
+// an instance of NumberStrKernel.
 type BigDecimal struct {
 	significand BigIntNum
 	//	The significand is also known as the mantissa or
-	//	coefficient in scientific notation. It represents
-	//	the significant digits in the base number shown
-	//	in the following example:
+	//	coefficient. It represents the significant digits
+	//	in the numeric value as shown in the following
+	//	example:
 	//
-	//		Scientific Notation Calculation:
-	//			265,200,000 = 2.652 x 10^8
+	//		Calculation:
+	//			265,200,000 = 2652 x 10^5
 	//		Base Numeric Value: 265,200,000
-	//		Scientific Notation Format: "2.652 x 10^8"
-	//		significand: '2.652'
-	//		exponent: 8
+	//		significand: 2652
+	//		exponent: 5
+	//
+	//	The significand also carries the number sign of
+	//	the numeric value.
 
 	exponent BigIntNum
-	//	The component of scientific notation known as the
-	//	'exponent' is illustrated in the following example:
+	//	The exponent specifies the power of 10 by which
+	//	the significand is multiplied. A negative exponent
+	//	specifies the number of fractional digits in the
+	//	numeric value.
 	//
-	//		Scientific Notation Calculation:
-	//			265,200,000 = 2.652 x 10^8
-	//		Base Numeric Value: 265,200,000
-	//		Scientific Notation Format: "2.652 x 10^8"
-	//		significand: '2.652'
-	//		exponent: 8
+	//		Calculation:
+	//			1.250 = 1250 x 10^-3
+	//		Base Numeric Value: 1.250
+	//		significand: 1250
+	//		exponent: -3
 
 	lock *sync.Mutex
 }
+
+// Add
+//
+// Adds the numeric value of input parameter 'addend' to
+// the numeric value of the current BigDecimal instance
+// and returns the exact sum as a new instance of
+// BigDecimal.
+//
+// The number of fractional digits in the returned sum is
+// equal to the greater of the fractional digit counts of
+// the two operands.
+//
+// Neither the current BigDecimal instance nor 'addend'
+// will be modified.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	addend						*BigDecimal
+//
+//		A pointer to an instance of BigDecimal. The
+//		numeric value of this instance will be added to
+//		that of the current BigDecimal instance.
+//
+//		If 'addend' is a nil pointer, an error will be
+//		returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	sum							BigDecimal
+//
+//		If this method completes successfully, a new
+//		instance of BigDecimal will be returned
+//		containing the sum of the current BigDecimal
+//		numeric value and 'addend'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (bigDec *BigDecimal) Add(
+	addend *BigDecimal,
+	errorPrefix interface{}) (
+	sum BigDecimal,
+	err error) {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"BigDecimal."+
+			"Add()",
+		"")
+
+	if err != nil {
+		return sum, err
+	}
+
+	err = new(bigDecimalNanobot).add(
+		&sum,
+		bigDec,
+		addend,
+		false,
+		ePrefix.XCpy(
+			"sum<-bigDec+addend"))
+
+	return sum, err
+}
+
+// Compare
+//
+// Compares the numeric value of the current BigDecimal
+// instance to that of input parameter
+// 'incomingBigDecimal' and returns an integer value:
+//
+//	-1 if current BigDecimal <  incomingBigDecimal
+//	 0 if current BigDecimal == incomingBigDecimal
+//	+1 if current BigDecimal >  incomingBigDecimal
+//
+// The comparison is based on numeric value only.
+// Therefore, '1.25' and '1.2500' are considered equal.
+//
+// If 'incomingBigDecimal' is a nil pointer, it is
+// treated as a numeric value of zero.
+func (bigDec *BigDecimal) Compare(
+	incomingBigDecimal *BigDecimal) int {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	return new(bigDecimalNanobot).compare(
+		bigDec,
+		incomingBigDecimal)
+}
+
+// CopyIn
+//
+// Copies the data fields from an incoming instance of
+// BigDecimal ('incomingBigDecimal') to the data fields
+// of the current BigDecimal instance ('bigDec').
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All data field values in the current BigDecimal
+//	instance ('bigDec') will be deleted and
+//	overwritten.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	incomingBigDecimal			*BigDecimal
+//
+//		A pointer to an instance of BigDecimal. This
+//		method will NOT change the values of internal
+//		member variables contained in this instance.
+//
+//		All data values in this BigDecimal instance will
+//		be copied to the current BigDecimal instance
+//		('bigDec').
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (bigDec *BigDecimal) CopyIn(
+	incomingBigDecimal *BigDecimal,
+	errorPrefix interface{}) error {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"BigDecimal."+
+			"CopyIn()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if incomingBigDecimal == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'incomingBigDecimal' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	new(bigDecimalElectron).copy(
+		bigDec,
+		incomingBigDecimal)
+
+	return err
+}
+
+// CopyOut
+//
+// Returns a deep copy of the current BigDecimal
+// instance.
+func (bigDec *BigDecimal) CopyOut() BigDecimal {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	newBigDec := BigDecimal{}
+
+	new(bigDecimalElectron).copy(
+		&newBigDec,
+		bigDec)
+
+	return newBigDec
+}
+
+// Divide
+//
+// Divides the numeric value of the current BigDecimal
+// instance by the numeric value of input parameter
+// 'divisor'. The quotient is rounded to 'precision'
+// fractional digits and returned as a new instance of
+// BigDecimal.
+//
+// Neither the current BigDecimal instance nor 'divisor'
+// will be modified.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	divisor						*BigDecimal
+//
+//		A pointer to an instance of BigDecimal. The
+//		numeric value of the current BigDecimal instance
+//		will be divided by the numeric value of this
+//		instance.
+//
+//		If 'divisor' is a nil pointer or has a numeric
+//		value of zero, an error will be returned.
+//
+//	precision					int
+//
+//		The number of fractional digits to the right of
+//		the radix point which will be returned in the
+//		quotient.
+//
+//		If this value is less than zero, an error will be
+//		returned.
+//
+//	roundingType				NumberRoundingType
+//
+//		The rounding algorithm applied to the quotient.
+//		The entire remainder of the division operation is
+//		evaluated when rounding. Rounding types 'Floor'
+//		and 'Ceiling' round towards negative and positive
+//		infinity respectively.
+//
+//		If this parameter is set to NumRoundType.NoRounding(),
+//		the quotient will be truncated to 'precision'
+//		fractional digits.
+//
+//		If this parameter is invalid, an error will be
+//		returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	quotient					BigDecimal
+//
+//		If this method completes successfully, a new
+//		instance of BigDecimal will be returned
+//		containing the result of dividing the current
+//		BigDecimal numeric value by 'divisor'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (bigDec *BigDecimal) Divide(
+	divisor *BigDecimal,
+	precision int,
+	roundingType NumberRoundingType,
+	errorPrefix interface{}) (
+	quotient BigDecimal,
+	err error) {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"BigDecimal."+
+			"Divide()",
+		"")
+
+	if err != nil {
+		return quotient, err
+	}
+
+	err = new(bigDecimalNanobot).divide(
+		&quotient,
+		bigDec,
+		divisor,
+		precision,
+		roundingType,
+		ePrefix.XCpy(
+			"quotient<-bigDec/divisor"))
+
+	return quotient, err
+}
+
+// Empty
+//
+// Resets all internal member variables for the current
+// instance of BigDecimal to their initial or zero
+// values. The resulting numeric value is zero.
+func (bigDec *BigDecimal) Empty() {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	new(bigDecimalElectron).setComponents(
+		bigDec,
+		big.NewInt(0),
+		big.NewInt(0))
+
+	bigDec.lock.Unlock()
+
+	bigDec.lock = nil
+}
+
+// Equal
+//
+// Returns 'true' if the numeric value of the current
+// BigDecimal instance is equal to that of the incoming
+// BigDecimal instance ('incomingBigDecimal').
+//
+// The comparison is based on numeric value only.
+// Therefore, '1.25' and '1.2500' are considered equal.
+//
+// If 'incomingBigDecimal' is a nil pointer, this method
+// returns 'false'.
+func (bigDec *BigDecimal) Equal(
+	incomingBigDecimal *BigDecimal) bool {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	if incomingBigDecimal == nil {
+		return false
+	}
+
+	return new(bigDecimalNanobot).compare(
+		bigDec,
+		incomingBigDecimal) == 0
+}
+
+// GetBigRat
+//
+// Returns the exact numeric value of the current
+// BigDecimal instance as a new instance of *big.Rat.
+func (bigDec *BigDecimal) GetBigRat() *big.Rat {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	significand,
+		exponent := new(bigDecimalElectron).
+		getComponents(bigDec)
+
+	bigDecAtom := bigDecimalAtom{}
+
+	if exponent.Sign() >= 0 {
+
+		return new(big.Rat).SetInt(
+			significand.Mul(
+				significand,
+				bigDecAtom.powerOfTen(exponent)))
+	}
+
+	return new(big.Rat).SetFrac(
+		significand,
+		bigDecAtom.powerOfTen(
+			exponent.Neg(exponent)))
+}
+
+// GetExponent
+//
+// Returns a deep copy of the exponent for the current
+// BigDecimal instance.
+//
+//	numeric value = significand x 10^exponent
+func (bigDec *BigDecimal) GetExponent() BigIntNum {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	return bigDec.exponent.CopyOut()
+}
+
+// GetNumberStrKernel
+//
+// Converts the numeric value of the current BigDecimal
+// instance to a new instance of NumberStrKernel. The
+// Number String Formatting methods provided by
+// NumberStrKernel may then be used to format the
+// numeric value.
+//
+// The number of fractional digits configured in the
+// returned NumberStrKernel is equal to the absolute
+// value of a negative exponent. Trailing fractional
+// zeros are therefore preserved.
+//
+// The current BigDecimal instance will NOT be modified.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberStrKernel
+//
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		containing the numeric value of the current
+//		BigDecimal instance.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (bigDec *BigDecimal) GetNumberStrKernel(
+	errorPrefix interface{}) (
+	NumberStrKernel,
+	error) {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"BigDecimal."+
+			"GetNumberStrKernel()",
+		"")
+
+	if err != nil {
+		return NumberStrKernel{}, err
+	}
+
+	return new(bigDecimalNanobot).getNumStrKernel(
+		bigDec,
+		ePrefix.XCpy(
+			"bigDec"))
+}
+
+// GetSign
+//
+// Returns the number sign of the current BigDecimal
+// instance as an integer value:
+//
+//	-1 if the numeric value is less than zero
+//	 0 if the numeric value is equal to zero
+//	+1 if the numeric value is greater than zero
+func (bigDec *BigDecimal) GetSign() int {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	return bigDec.significand.GetSign()
+}
+
+// GetSignificand
+//
+// Returns a deep copy of the significand for the
+// current BigDecimal instance.
+//
+//	numeric value = significand x 10^exponent
+func (bigDec *BigDecimal) GetSignificand() BigIntNum {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	return bigDec.significand.CopyOut()
+}
+
+// IsZero
+//
+// Returns 'true' if the numeric value of the current
+// BigDecimal instance is equal to zero.
+func (bigDec *BigDecimal) IsZero() bool {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	return bigDec.significand.GetSign() == 0
+}
+
+// Multiply
+//
+// Multiplies the numeric value of the current
+// BigDecimal instance by the numeric value of input
+// parameter 'multiplier' and returns the exact product
+// as a new instance of BigDecimal.
+//
+// The number of fractional digits in the returned
+// product is equal to the sum of the fractional digit
+// counts of the two operands.
+//
+// Neither the current BigDecimal instance nor
+// 'multiplier' will be modified.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	multiplier					*BigDecimal
+//
+//		A pointer to an instance of BigDecimal. The
+//		numeric value of the current BigDecimal instance
+//		will be multiplied by the numeric value of this
+//		instance.
+//
+//		If 'multiplier' is a nil pointer, an error will
+//		be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	product						BigDecimal
+//
+//		If this method completes successfully, a new
+//		instance of BigDecimal will be returned
+//		containing the product of the current BigDecimal
+//		numeric value and 'multiplier'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (bigDec *BigDecimal) Multiply(
+	multiplier *BigDecimal,
+	errorPrefix interface{}) (
+	product BigDecimal,
+	err error) {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"BigDecimal."+
+			"Multiply()",
+		"")
+
+	if err != nil {
+		return product, err
+	}
+
+	err = new(bigDecimalNanobot).multiply(
+		&product,
+		bigDec,
+		multiplier,
+		ePrefix.XCpy(
+			"product<-bigDec*multiplier"))
+
+	return product, err
+}
+
+// NewFromBigInt
+//
+// Creates and returns a new instance of BigDecimal
+// configured with a significand and exponent.
+//
+//	numeric value = significand x 10^exponent
+//
+//	Example
+//		significand = 12345
+//		exponent = -2
+//		numeric value = 123.45
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	significand					*big.Int
+//
+//		A pointer to an instance of big.Int containing
+//		the significand of the new BigDecimal instance.
+//		This instance will NOT be modified.
+//
+//		If 'significand' is a nil pointer, an error will
+//		be returned.
+//
+//	exponent					int
+//
+//		The power of 10 by which 'significand' is
+//		multiplied. A negative exponent specifies the
+//		number of fractional digits in the numeric value.
+//		Set this value to zero to configure an integer
+//		value equal to 'significand'.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	BigDecimal
+//
+//		If this method completes successfully, a new
+//		instance of BigDecimal will be returned.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (bigDec *BigDecimal) NewFromBigInt(
+	significand *big.Int,
+	exponent int,
+	errorPrefix interface{}) (
+	BigDecimal,
+	error) {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	newBigDec := BigDecimal{}
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"BigDecimal."+
+			"NewFromBigInt()",
+		"")
+
+	if err != nil {
+		return newBigDec, err
+	}
+
+	if significand == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'significand' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return newBigDec, err
+	}
+
+	new(bigDecimalElectron).setComponents(
+		&newBigDec,
+		significand,
+		big.NewInt(int64(exponent)))
+
+	return newBigDec, err
+}
+
+// NewFromBigRat
+//
+// Creates and returns a new instance of BigDecimal
+// configured with the numeric value of a rational
+// number ('bigRatValue').
+//
+// The returned BigDecimal will contain exactly
+// 'roundToFractionalDigits' fractional digits. If the
+// decimal expansion of 'bigRatValue' contains more
+// fractional digits, the value will be rounded using
+// the rounding algorithm specified by 'roundingType'.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	bigRatValue					*big.Rat
+//
+//		A pointer to an instance of big.Rat. The
+//		numeric value of this rational number will be
+//		converted to a BigDecimal. This instance will NOT
+//		be modified.
+//
+//		If 'bigRatValue' is a nil pointer, an error will
+//		be returned.
+//
+//	roundToFractionalDigits		int
+//
+//		The number of fractional digits to the right of
+//		the radix point contained in the returned
+//		BigDecimal.
+//
+//		If this value is less than zero, an error will be
+//		returned.
+//
+//	roundingType				NumberRoundingType
+//
+//		The rounding algorithm applied when the decimal
+//		expansion of 'bigRatValue' exceeds
+//		'roundToFractionalDigits'.
+//
+//		If this parameter is set to NumRoundType.NoRounding(),
+//		the value will be truncated to
+//		'roundToFractionalDigits' fractional digits.
+//
+//		If this parameter is invalid, an error will be
+//		returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	BigDecimal
+//
+//		If this method completes successfully, a new
+//		instance of BigDecimal will be returned
+//		containing the converted numeric value of
+//		'bigRatValue'.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (bigDec *BigDecimal) NewFromBigRat(
+	bigRatValue *big.Rat,
+	roundToFractionalDigits int,
+	roundingType NumberRoundingType,
+	errorPrefix interface{}) (
+	BigDecimal,
+	error) {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	newBigDec := BigDecimal{}
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"BigDecimal."+
+			"NewFromBigRat()",
+		"")
+
+	if err != nil {
+		return newBigDec, err
+	}
+
+	err = new(bigDecimalNanobot).setFromBigRat(
+		&newBigDec,
+		bigRatValue,
+		roundToFractionalDigits,
+		roundingType,
+		ePrefix.XCpy(
+			"newBigDec<-bigRatValue"))
+
+	return newBigDec, err
+}
+
+// NewFromInt64
+//
+// Creates and returns a new instance of BigDecimal
+// configured with a significand and exponent.
+//
+//	numeric value = significand x 10^exponent
+//
+//	Examples
+//		NewFromInt64(125, -2)	= 1.25
+//		NewFromInt64(-7, 0)		= -7
+//		NewFromInt64(3, 4)		= 30000
+func (bigDec *BigDecimal) NewFromInt64(
+	significand int64,
+	exponent int) BigDecimal {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	newBigDec := BigDecimal{}
+
+	new(bigDecimalElectron).setComponents(
+		&newBigDec,
+		big.NewInt(significand),
+		big.NewInt(int64(exponent)))
+
+	return newBigDec
+}
+
+// NewFromNumStr
+//
+// Creates and returns a new instance of BigDecimal
+// configured with the numeric value of a Native Number
+// String.
+//
+// A Native Number String consists of numeric digits, an
+// optional leading minus sign ('-') and an optional
+// period ('.') radix point.
+//
+//	Examples
+//		"123.4560"
+//		"-0.0005"
+//		"1000000"
+//
+// Trailing fractional zeros are preserved. "123.4560"
+// is therefore configured with an exponent of -4.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	nativeNumStr				string
+//
+//		A Native Number String containing the numeric
+//		value which will be used to configure the
+//		returned BigDecimal. If this string is empty or
+//		invalid, an error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	BigDecimal
+//
+//		If this method completes successfully, a new
+//		instance of BigDecimal will be returned
+//		containing the numeric value of 'nativeNumStr'.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (bigDec *BigDecimal) NewFromNumStr(
+	nativeNumStr string,
+	errorPrefix interface{}) (
+	BigDecimal,
+	error) {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	newBigDec := BigDecimal{}
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"BigDecimal."+
+			"NewFromNumStr()",
+		"")
+
+	if err != nil {
+		return newBigDec, err
+	}
+
+	err = new(bigDecimalNanobot).setFromNativeNumStr(
+		&newBigDec,
+		nativeNumStr,
+		ePrefix.XCpy(
+			"newBigDec<-nativeNumStr"))
+
+	return newBigDec, err
+}
+
+// NewFromNumStrKernel
+//
+// Creates and returns a new instance of BigDecimal
+// configured with the numeric value of an instance of
+// NumberStrKernel.
+//
+// The exponent of the returned BigDecimal is set to the
+// negative value of the number of fractional digits
+// contained in 'numStrKernel'.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		numeric value of this instance will be used to
+//		configure the returned BigDecimal. This instance
+//		will NOT be modified.
+//
+//		If 'numStrKernel' is a nil pointer or invalid, an
+//		error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	BigDecimal
+//
+//		If this method completes successfully, a new
+//		instance of BigDecimal will be returned
+//		containing the numeric value of 'numStrKernel'.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (bigDec *BigDecimal) NewFromNumStrKernel(
+	numStrKernel *NumberStrKernel,
+	errorPrefix interface{}) (
+	BigDecimal,
+	error) {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	newBigDec := BigDecimal{}
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"BigDecimal."+
+			"NewFromNumStrKernel()",
+		"")
+
+	if err != nil {
+		return newBigDec, err
+	}
+
+	err = new(bigDecimalNanobot).setFromNumStrKernel(
+		&newBigDec,
+		numStrKernel,
+		ePrefix.XCpy(
+			"newBigDec<-numStrKernel"))
+
+	return newBigDec, err
+}
+
+// Normalize
+//
+// Removes all trailing zeros from the significand of
+// the current BigDecimal instance and adjusts the
+// exponent accordingly. The numeric value is NOT
+// changed.
+//
+//	Example:
+//		Before: 1.25000 = 125000 x 10^-5
+//		After:  1.25    = 125 x 10^-2
+func (bigDec *BigDecimal) Normalize() {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	new(bigDecimalNanobot).normalize(bigDec)
+}
+
+// Round
+//
+// Rounds the numeric value of the current BigDecimal
+// instance to a specified number of fractional digits.
+//
+// If the current BigDecimal instance contains fewer
+// fractional digits than 'roundToFractionalDigits', no
+// action is taken.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	This method will modify the numeric value of the
+//	current BigDecimal instance.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	roundingType				NumberRoundingType
+//
+//		The rounding algorithm which will be applied. The
+//		entire sequence of dropped digits is evaluated
+//		when rounding. Rounding types 'Floor' and
+//		'Ceiling' round towards negative and positive
+//		infinity respectively at the specified fractional
+//		digit position.
+//
+//		If this parameter is set to NumRoundType.NoRounding(),
+//		the current BigDecimal instance will NOT be
+//		modified.
+//
+//		If this parameter is invalid, an error will be
+//		returned.
+//
+//	roundToFractionalDigits		int
+//
+//		The number of fractional digits to the right of
+//		the radix point remaining after the rounding
+//		operation.
+//
+//		If this value is less than zero, an error will be
+//		returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (bigDec *BigDecimal) Round(
+	roundingType NumberRoundingType,
+	roundToFractionalDigits int,
+	errorPrefix interface{}) error {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"BigDecimal."+
+			"Round()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(bigDecimalNanobot).round(
+		bigDec,
+		roundingType,
+		roundToFractionalDigits,
+		ePrefix.XCpy(
+			"bigDec"))
+}
+
+// String
+//
+// Returns the numeric value of the current BigDecimal
+// instance formatted as a Native Number String.
+//
+// A Native Number String consists of numeric digits, a
+// leading minus sign ('-') for negative values and a
+// period ('.') radix point.
+//
+//	Example: "-1234.560"
+//
+// If the exponent magnitude is too large to expand as a
+// Native Number String, the numeric value is returned
+// in the format "significandEexponent".
+//
+// This method satisfies the fmt.Stringer interface.
+func (bigDec *BigDecimal) String() string {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	integerDigits,
+		fractionalDigits,
+		numberSign,
+		err := new(bigDecimalElectron).getDigitRunes(
+		bigDec,
+		nil)
+
+	if err != nil {
+
+		significand,
+			exponent := new(bigDecimalElectron).
+			getComponents(bigDec)
+
+		return significand.Text(10) +
+			"E" +
+			exponent.Text(10)
+	}
+
+	numStr := string(integerDigits)
+
+	if len(fractionalDigits) > 0 {
+		numStr += "." + string(fractionalDigits)
+	}
+
+	if numberSign == NumSignVal.Negative() {
+		numStr = "-" + numStr
+	}
+
+	return numStr
+}
+
+// Subtract
+//
+// Subtracts the numeric value of input parameter
+// 'subtrahend' from the numeric value of the current
+// BigDecimal instance and returns the exact difference
+// as a new instance of BigDecimal.
+//
+// The number of fractional digits in the returned
+// difference is equal to the greater of the fractional
+// digit counts of the two operands.
+//
+// Neither the current BigDecimal instance nor
+// 'subtrahend' will be modified.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	subtrahend					*BigDecimal
+//
+//		A pointer to an instance of BigDecimal. The
+//		numeric value of this instance will be subtracted
+//		from that of the current BigDecimal instance.
+//
+//		If 'subtrahend' is a nil pointer, an error will
+//		be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	difference					BigDecimal
+//
+//		If this method completes successfully, a new
+//		instance of BigDecimal will be returned
+//		containing the result of subtracting
+//		'subtrahend' from the current BigDecimal numeric
+//		value.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (bigDec *BigDecimal) Subtract(
+	subtrahend *BigDecimal,
+	errorPrefix interface{}) (
+	difference BigDecimal,
+	err error) {
+
+	if bigDec.lock == nil {
+		bigDec.lock = new(sync.Mutex)
+	}
+
+	bigDec.lock.Lock()
+
+	defer bigDec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"BigDecimal."+
+			"Subtract()",
+		"")
+
+	if err != nil {
+		return difference, err
+	}
+
+	err = new(bigDecimalNanobot).add(
+		&difference,
+		bigDec,
+		subtrahend,
+		true,
+		ePrefix.XCpy(
+			"difference<-bigDec-subtrahend"))
+
+	return difference, err
+}
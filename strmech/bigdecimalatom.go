@@ -0,0 +1,302 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"math/big"
+	"sync"
+)
+
+// bigDecimalAtom - Provides helper methods used to
+// perform rounding operations on the integer components
+// of type BigDecimal.
+type bigDecimalAtom struct {
+	lock *sync.Mutex
+}
+
+// powerOfTen
+//
+// Returns 10 raised to the power of 'exponent' as a new
+// instance of *big.Int.
+//
+// If 'exponent' is less than or equal to zero, this
+// method returns a value of one (1).
+//
+// This method does NOT lock the current instance of
+// bigDecimalAtom.
+func (bigDecAtom *bigDecimalAtom) powerOfTen(
+	exponent *big.Int) *big.Int {
+
+	if exponent == nil ||
+		exponent.Sign() <= 0 {
+
+		return big.NewInt(1)
+	}
+
+	return new(big.Int).Exp(
+		big.NewInt(10),
+		exponent,
+		nil)
+}
+
+// roundBigIntQuotient
+//
+// Divides 'numerator' by 'denominator' and rounds the
+// quotient to an integer value using the rounding
+// algorithm specified by input parameter
+// 'roundingType'.
+//
+// All rounding algorithms evaluate the entire remainder
+// of the division operation. Therefore, the rounded
+// result is always exact.
+//
+// Rounding types 'Floor' and 'Ceiling' are applied as
+// directional rounding. 'Floor' rounds towards negative
+// infinity and 'Ceiling' rounds towards positive
+// infinity.
+//
+// A rounding type of NumRoundType.NoRounding() is
+// treated as NumRoundType.Truncate().
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numerator					*big.Int
+//
+//		The signed dividend. This instance will NOT be
+//		modified.
+//
+//	denominator					*big.Int
+//
+//		The divisor. If this value is zero, an error will
+//		be returned. This instance will NOT be modified.
+//
+//	roundingType				NumberRoundingType
+//
+//		The rounding algorithm applied to the quotient.
+//		If this parameter is invalid, an error will be
+//		returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	*big.Int
+//
+//		The rounded integer quotient.
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (bigDecAtom *bigDecimalAtom) roundBigIntQuotient(
+	numerator *big.Int,
+	denominator *big.Int,
+	roundingType NumberRoundingType,
+	errPrefDto *ePref.ErrPrefixDto) (
+	*big.Int,
+	error) {
+
+	if bigDecAtom.lock == nil {
+		bigDecAtom.lock = new(sync.Mutex)
+	}
+
+	bigDecAtom.lock.Lock()
+
+	defer bigDecAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	quotient := big.NewInt(0)
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"bigDecimalAtom."+
+			"roundBigIntQuotient()",
+		"")
+
+	if err != nil {
+		return quotient, err
+	}
+
+	if numerator == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numerator' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return quotient, err
+	}
+
+	if denominator == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'denominator' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return quotient, err
+	}
+
+	if denominator.Sign() == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'denominator' is invalid!\n"+
+			"Division by zero is not permitted.\n",
+			ePrefix.String())
+
+		return quotient, err
+	}
+
+	if !roundingType.XIsValid() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'roundingType' is invalid!\n"+
+			"roundingType string value  = '%v'\n"+
+			"roundingType integer value = '%v'\n",
+			ePrefix.String(),
+			roundingType.String(),
+			roundingType.XValueInt())
+
+		return quotient, err
+	}
+
+	num := new(big.Int).Set(numerator)
+
+	den := new(big.Int).Set(denominator)
+
+	if den.Sign() < 0 {
+		num.Neg(num)
+		den.Neg(den)
+	}
+
+	remainder := new(big.Int)
+
+	quotient.QuoRem(num, den, remainder)
+
+	if remainder.Sign() == 0 {
+		return quotient, err
+	}
+
+	// The sign of the exact quotient
+	quotientSign := num.Sign()
+
+	// Compare the remainder to one half of the
+	// denominator.
+	//	-1 = less than one half
+	//	 0 = exactly one half
+	//	+1 = greater than one half
+	halfCmp := new(big.Int).Lsh(
+		new(big.Int).Abs(remainder), 1).Cmp(den)
+
+	roundAwayFromZero := false
+
+	switch roundingType {
+
+	case NumRoundType.NoRounding(),
+		NumRoundType.Truncate():
+
+		roundAwayFromZero = false
+
+	case NumRoundType.Floor():
+
+		roundAwayFromZero = quotientSign < 0
+
+	case NumRoundType.Ceiling():
+
+		roundAwayFromZero = quotientSign > 0
+
+	case NumRoundType.HalfAwayFromZero():
+
+		roundAwayFromZero = halfCmp >= 0
+
+	case NumRoundType.HalfTowardsZero():
+
+		roundAwayFromZero = halfCmp > 0
+
+	case NumRoundType.HalfUpWithNegNums():
+
+		roundAwayFromZero = halfCmp > 0 ||
+			(halfCmp == 0 && quotientSign > 0)
+
+	case NumRoundType.HalfDownWithNegNums():
+
+		roundAwayFromZero = halfCmp > 0 ||
+			(halfCmp == 0 && quotientSign < 0)
+
+	case NumRoundType.HalfToEven():
+
+		roundAwayFromZero = halfCmp > 0 ||
+			(halfCmp == 0 && quotient.Bit(0) == 1)
+
+	case NumRoundType.HalfToOdd():
+
+		roundAwayFromZero = halfCmp > 0 ||
+			(halfCmp == 0 && quotient.Bit(0) == 0)
+
+	case NumRoundType.Randomly():
+
+		if halfCmp != 0 {
+
+			roundAwayFromZero = halfCmp > 0
+
+			break
+		}
+
+		var randomNum int64
+
+		randomNum,
+			err = new(numStrMathQuark).randomInt64(
+			2,
+			ePrefix.XCpy(
+				"randomNum"))
+
+		if err != nil {
+			return quotient, err
+		}
+
+		roundAwayFromZero = randomNum == 1
+
+	default:
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'roundingType' is invalid!\n"+
+			"roundingType string value  = '%v'\n",
+			ePrefix.String(),
+			roundingType.String())
+
+		return quotient, err
+	}
+
+	if roundAwayFromZero {
+		quotient.Add(
+			quotient,
+			big.NewInt(int64(quotientSign)))
+	}
+
+	return quotient, err
+}
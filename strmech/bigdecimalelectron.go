@@ -0,0 +1,306 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"math/big"
+	"strings"
+	"sync"
+)
+
+// maxBigDecimalDigitExpansion - The maximum magnitude of
+// a BigDecimal exponent which may be expanded to integer
+// and fractional digits in a number string.
+const maxBigDecimalDigitExpansion = 1000000
+
+// bigDecimalElectron - Provides low level helper methods
+// used to access and configure the significand and
+// exponent components of type BigDecimal.
+type bigDecimalElectron struct {
+	lock *sync.Mutex
+}
+
+// copy
+//
+// Copies the significand and exponent from a source
+// instance of BigDecimal to a destination instance of
+// BigDecimal.
+//
+// If either input parameter is a nil pointer, this
+// method takes no action and exits.
+func (bigDecElectron *bigDecimalElectron) copy(
+	destinationBigDec *BigDecimal,
+	sourceBigDec *BigDecimal) {
+
+	if bigDecElectron.lock == nil {
+		bigDecElectron.lock = new(sync.Mutex)
+	}
+
+	bigDecElectron.lock.Lock()
+
+	defer bigDecElectron.lock.Unlock()
+
+	if destinationBigDec == nil ||
+		sourceBigDec == nil {
+
+		return
+	}
+
+	bIntNumElectron := bigIntNumElectron{}
+
+	bIntNumElectron.copy(
+		&destinationBigDec.significand,
+		&sourceBigDec.significand)
+
+	bIntNumElectron.copy(
+		&destinationBigDec.exponent,
+		&sourceBigDec.exponent)
+}
+
+// getComponents
+//
+// Returns the significand and exponent of a BigDecimal
+// instance as new instances of *big.Int.
+//
+//	numeric value = significand x 10^exponent
+func (bigDecElectron *bigDecimalElectron) getComponents(
+	bigDec *BigDecimal) (
+	significand *big.Int,
+	exponent *big.Int) {
+
+	if bigDecElectron.lock == nil {
+		bigDecElectron.lock = new(sync.Mutex)
+	}
+
+	bigDecElectron.lock.Lock()
+
+	defer bigDecElectron.lock.Unlock()
+
+	if bigDec == nil {
+		return big.NewInt(0), big.NewInt(0)
+	}
+
+	bIntNumElectron := bigIntNumElectron{}
+
+	significand = bIntNumElectron.getBigInt(
+		&bigDec.significand)
+
+	exponent = bIntNumElectron.getBigInt(
+		&bigDec.exponent)
+
+	return significand, exponent
+}
+
+// getDigitRunes
+//
+// Converts the numeric value of a BigDecimal instance
+// to arrays of integer and fractional numeric digits.
+//
+// The number of fractional digits returned is equal to
+// the absolute value of a negative exponent. If the
+// exponent is greater than or equal to zero, the
+// returned fractional digits array will be empty.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	bigDec						*BigDecimal
+//
+//		A pointer to an instance of BigDecimal. The
+//		numeric value of this instance will be converted
+//		to integer and fractional digit arrays. This
+//		instance will NOT be modified.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	integerDigits				[]rune
+//
+//		The integer digits of the absolute numeric value.
+//		If the integer value is zero, this array will
+//		contain a single zero character ('0').
+//
+//	fractionalDigits			[]rune
+//
+//		The fractional digits of the absolute numeric
+//		value.
+//
+//	numberSign					NumericSignValueType
+//
+//		The number sign of the numeric value. If the
+//		numeric value is zero, this value is set to
+//		NumSignVal.Zero().
+//
+//	err							error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (bigDecElectron *bigDecimalElectron) getDigitRunes(
+	bigDec *BigDecimal,
+	errPrefDto *ePref.ErrPrefixDto) (
+	integerDigits []rune,
+	fractionalDigits []rune,
+	numberSign NumericSignValueType,
+	err error) {
+
+	if bigDecElectron.lock == nil {
+		bigDecElectron.lock = new(sync.Mutex)
+	}
+
+	bigDecElectron.lock.Lock()
+
+	defer bigDecElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"bigDecimalElectron."+
+			"getDigitRunes()",
+		"")
+
+	if err != nil {
+		return integerDigits, fractionalDigits, numberSign, err
+	}
+
+	if bigDec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'bigDec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return integerDigits, fractionalDigits, numberSign, err
+	}
+
+	bIntNumElectron := bigIntNumElectron{}
+
+	significand := bIntNumElectron.getBigInt(
+		&bigDec.significand)
+
+	exponent := bIntNumElectron.getBigInt(
+		&bigDec.exponent)
+
+	if !exponent.IsInt64() ||
+		exponent.Int64() > int64(maxBigDecimalDigitExpansion) ||
+		exponent.Int64() < int64(-maxBigDecimalDigitExpansion) {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The BigDecimal exponent is out of range!\n"+
+			"The exponent magnitude exceeds the maximum number\n"+
+			"of digits which can be expanded in a number string.\n"+
+			"Maximum Exponent Magnitude = '%v'\n"+
+			"BigDecimal Exponent        = '%v'\n",
+			ePrefix.String(),
+			maxBigDecimalDigitExpansion,
+			exponent.Text(10))
+
+		return integerDigits, fractionalDigits, numberSign, err
+	}
+
+	numberSign = NumSignVal.Zero()
+
+	if significand.Sign() > 0 {
+
+		numberSign = NumSignVal.Positive()
+
+	} else if significand.Sign() < 0 {
+
+		numberSign = NumSignVal.Negative()
+	}
+
+	digits := new(big.Int).Abs(significand).Text(10)
+
+	exp := int(exponent.Int64())
+
+	if exp >= 0 {
+
+		integerDigits = []rune(
+			digits + strings.Repeat("0", exp))
+
+		if numberSign == NumSignVal.Zero() {
+			integerDigits = []rune{'0'}
+		}
+
+		return integerDigits, fractionalDigits, numberSign, err
+	}
+
+	exp = -exp
+
+	if len(digits) <= exp {
+		digits = strings.Repeat("0", exp-len(digits)+1) +
+			digits
+	}
+
+	lenIntDigits := len(digits) - exp
+
+	integerDigits = []rune(digits[:lenIntDigits])
+
+	fractionalDigits = []rune(digits[lenIntDigits:])
+
+	return integerDigits, fractionalDigits, numberSign, err
+}
+
+// setComponents
+//
+// Deletes and resets the significand and exponent of a
+// BigDecimal instance.
+//
+//	numeric value = significand x 10^exponent
+//
+// Input parameters 'significand' and 'exponent' will NOT
+// be modified. If any of the input parameters is a nil
+// pointer, this method takes no action and exits.
+func (bigDecElectron *bigDecimalElectron) setComponents(
+	bigDec *BigDecimal,
+	significand *big.Int,
+	exponent *big.Int) {
+
+	if bigDecElectron.lock == nil {
+		bigDecElectron.lock = new(sync.Mutex)
+	}
+
+	bigDecElectron.lock.Lock()
+
+	defer bigDecElectron.lock.Unlock()
+
+	if bigDec == nil ||
+		significand == nil ||
+		exponent == nil {
+
+		return
+	}
+
+	bIntNumElectron := bigIntNumElectron{}
+
+	bIntNumElectron.setBigInt(
+		&bigDec.significand,
+		significand)
+
+	bIntNumElectron.setBigInt(
+		&bigDec.exponent,
+		exponent)
+}
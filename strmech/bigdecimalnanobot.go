@@ -0,0 +1,1440 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"math/big"
+	"strings"
+	"sync"
+)
+
+// bigDecimalNanobot - Provides helper methods used to
+// perform arithmetic, comparison, rounding and conversion
+// operations for type BigDecimal.
+type bigDecimalNanobot struct {
+	lock *sync.Mutex
+}
+
+// add
+//
+// Adds or subtracts the numeric values of two instances
+// of BigDecimal. The exact result is stored in input
+// parameter 'result'.
+//
+// The exponent of the result is equal to the smaller of
+// the two operand exponents. Consequently, the number of
+// fractional digits in the result is equal to the
+// greater of the fractional digit counts of the two
+// operands.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the data values contained in input parameter
+//	'result' will be deleted and reset to new values.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	result						*BigDecimal
+//
+//		A pointer to an instance of BigDecimal. The
+//		result of the addition or subtraction operation
+//		will be stored in this instance.
+//
+//	bigDec01					*BigDecimal
+//
+//		A pointer to an instance of BigDecimal containing
+//		the first operand.
+//
+//	bigDec02					*BigDecimal
+//
+//		A pointer to an instance of BigDecimal containing
+//		the second operand.
+//
+//	negateBigDec02				bool
+//
+//		If this parameter is set to 'true', the numeric
+//		value of 'bigDec02' will be subtracted from that
+//		of 'bigDec01'. Otherwise, the two numeric values
+//		will be added together.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (bigDecNanobot *bigDecimalNanobot) add(
+	result *BigDecimal,
+	bigDec01 *BigDecimal,
+	bigDec02 *BigDecimal,
+	negateBigDec02 bool,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if bigDecNanobot.lock == nil {
+		bigDecNanobot.lock = new(sync.Mutex)
+	}
+
+	bigDecNanobot.lock.Lock()
+
+	defer bigDecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"bigDecimalNanobot."+
+			"add()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	err = new(bigDecimalNanobot).testOperands(
+		result,
+		bigDec01,
+		bigDec02,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	bigDecElectron := bigDecimalElectron{}
+
+	significand01,
+		exponent01 := bigDecElectron.getComponents(bigDec01)
+
+	significand02,
+		exponent02 := bigDecElectron.getComponents(bigDec02)
+
+	if negateBigDec02 {
+		significand02.Neg(significand02)
+	}
+
+	significand01,
+		significand02,
+		exponent := new(bigDecimalNanobot).alignExponents(
+		significand01,
+		exponent01,
+		significand02,
+		exponent02)
+
+	bigDecElectron.setComponents(
+		result,
+		new(big.Int).Add(significand01, significand02),
+		exponent)
+
+	return err
+}
+
+// alignExponents
+//
+// Receives the significands and exponents of two
+// BigDecimal values and returns new significands scaled
+// to a common exponent. The common exponent is the
+// smaller of the two exponents.
+//
+// The input parameters will NOT be modified.
+//
+// This method does NOT lock the current instance of
+// bigDecimalNanobot.
+func (bigDecNanobot *bigDecimalNanobot) alignExponents(
+	significand01 *big.Int,
+	exponent01 *big.Int,
+	significand02 *big.Int,
+	exponent02 *big.Int) (
+	alignedSignificand01 *big.Int,
+	alignedSignificand02 *big.Int,
+	commonExponent *big.Int) {
+
+	bigDecAtom := bigDecimalAtom{}
+
+	commonExponent = new(big.Int).Set(exponent01)
+
+	if exponent02.Cmp(commonExponent) < 0 {
+		commonExponent.Set(exponent02)
+	}
+
+	alignedSignificand01 = new(big.Int).Mul(
+		significand01,
+		bigDecAtom.powerOfTen(
+			new(big.Int).Sub(exponent01, commonExponent)))
+
+	alignedSignificand02 = new(big.Int).Mul(
+		significand02,
+		bigDecAtom.powerOfTen(
+			new(big.Int).Sub(exponent02, commonExponent)))
+
+	return alignedSignificand01, alignedSignificand02, commonExponent
+}
+
+// compare
+//
+// Compares the numeric values of two BigDecimal
+// instances and returns an integer value:
+//
+//	-1 if bigDec01 <  bigDec02
+//	 0 if bigDec01 == bigDec02
+//	+1 if bigDec01 >  bigDec02
+//
+// A nil pointer is treated as a value of zero.
+func (bigDecNanobot *bigDecimalNanobot) compare(
+	bigDec01 *BigDecimal,
+	bigDec02 *BigDecimal) int {
+
+	if bigDecNanobot.lock == nil {
+		bigDecNanobot.lock = new(sync.Mutex)
+	}
+
+	bigDecNanobot.lock.Lock()
+
+	defer bigDecNanobot.lock.Unlock()
+
+	bigDecElectron := bigDecimalElectron{}
+
+	significand01,
+		exponent01 := bigDecElectron.getComponents(bigDec01)
+
+	significand02,
+		exponent02 := bigDecElectron.getComponents(bigDec02)
+
+	if significand01.Sign() != significand02.Sign() {
+
+		if significand01.Sign() < significand02.Sign() {
+			return -1
+		}
+
+		return 1
+	}
+
+	significand01,
+		significand02,
+		_ = new(bigDecimalNanobot).alignExponents(
+		significand01,
+		exponent01,
+		significand02,
+		exponent02)
+
+	return significand01.Cmp(significand02)
+}
+
+// divide
+//
+// Divides the numeric value of 'dividend' by that of
+// 'divisor'. The quotient is rounded to 'precision'
+// fractional digits using the rounding algorithm
+// specified by 'roundingType' and stored in input
+// parameter 'quotient'.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the data values contained in input parameter
+//	'quotient' will be deleted and reset to new values.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	quotient					*BigDecimal
+//
+//		A pointer to an instance of BigDecimal. The
+//		result of the division operation will be stored
+//		in this instance.
+//
+//	dividend					*BigDecimal
+//
+//		A pointer to an instance of BigDecimal containing
+//		the dividend.
+//
+//	divisor						*BigDecimal
+//
+//		A pointer to an instance of BigDecimal containing
+//		the divisor. If the numeric value of 'divisor' is
+//		zero, an error will be returned.
+//
+//	precision					int
+//
+//		The number of fractional digits to the right of
+//		the radix point which will be returned in the
+//		quotient. If this value is less than zero, an
+//		error will be returned.
+//
+//	roundingType				NumberRoundingType
+//
+//		The rounding algorithm applied to the quotient.
+//
+//		If this parameter is set to NumRoundType.NoRounding(),
+//		the quotient will be truncated to 'precision'
+//		fractional digits.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (bigDecNanobot *bigDecimalNanobot) divide(
+	quotient *BigDecimal,
+	dividend *BigDecimal,
+	divisor *BigDecimal,
+	precision int,
+	roundingType NumberRoundingType,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if bigDecNanobot.lock == nil {
+		bigDecNanobot.lock = new(sync.Mutex)
+	}
+
+	bigDecNanobot.lock.Lock()
+
+	defer bigDecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"bigDecimalNanobot."+
+			"divide()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	err = new(bigDecimalNanobot).testOperands(
+		quotient,
+		dividend,
+		divisor,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	if precision < 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'precision' is invalid!\n"+
+			"'precision' has a value less than zero.\n"+
+			"precision = '%v'\n",
+			ePrefix.String(),
+			precision)
+
+		return err
+	}
+
+	bigDecElectron := bigDecimalElectron{}
+
+	dividendSignificand,
+		dividendExponent := bigDecElectron.getComponents(dividend)
+
+	divisorSignificand,
+		divisorExponent := bigDecElectron.getComponents(divisor)
+
+	if divisorSignificand.Sign() == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'divisor' is invalid!\n"+
+			"'divisor' has a numeric value of zero.\n"+
+			"Division by zero is not permitted.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	// quotient x 10^precision =
+	//   dividendSignificand x 10^scaleExponent /
+	//     divisorSignificand
+	scaleExponent := new(big.Int).Sub(
+		dividendExponent,
+		divisorExponent)
+
+	scaleExponent.Add(
+		scaleExponent,
+		big.NewInt(int64(precision)))
+
+	bigDecAtom := bigDecimalAtom{}
+
+	numerator := new(big.Int).Set(dividendSignificand)
+
+	denominator := new(big.Int).Set(divisorSignificand)
+
+	if scaleExponent.Sign() >= 0 {
+
+		numerator.Mul(
+			numerator,
+			bigDecAtom.powerOfTen(scaleExponent))
+
+	} else {
+
+		denominator.Mul(
+			denominator,
+			bigDecAtom.powerOfTen(
+				new(big.Int).Neg(scaleExponent)))
+	}
+
+	var roundedSignificand *big.Int
+
+	roundedSignificand,
+		err = bigDecAtom.roundBigIntQuotient(
+		numerator,
+		denominator,
+		roundingType,
+		ePrefix.XCpy(
+			"numerator/denominator"))
+
+	if err != nil {
+		return err
+	}
+
+	bigDecElectron.setComponents(
+		quotient,
+		roundedSignificand,
+		big.NewInt(int64(-precision)))
+
+	return err
+}
+
+// getNumStrKernel
+//
+// Converts the numeric value of a BigDecimal instance to
+// a new instance of NumberStrKernel.
+//
+// The number of fractional digits configured in the
+// returned NumberStrKernel is equal to the absolute
+// value of a negative BigDecimal exponent.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	bigDec						*BigDecimal
+//
+//		A pointer to an instance of BigDecimal. This
+//		instance will NOT be modified.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberStrKernel
+//
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		containing the numeric value of 'bigDec'.
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (bigDecNanobot *bigDecimalNanobot) getNumStrKernel(
+	bigDec *BigDecimal,
+	errPrefDto *ePref.ErrPrefixDto) (
+	NumberStrKernel,
+	error) {
+
+	if bigDecNanobot.lock == nil {
+		bigDecNanobot.lock = new(sync.Mutex)
+	}
+
+	bigDecNanobot.lock.Lock()
+
+	defer bigDecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	newNumStrKernel := NumberStrKernel{}
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"bigDecimalNanobot."+
+			"getNumStrKernel()",
+		"")
+
+	if err != nil {
+		return newNumStrKernel, err
+	}
+
+	var integerDigits, fractionalDigits []rune
+	var numberSign NumericSignValueType
+
+	integerDigits,
+		fractionalDigits,
+		numberSign,
+		err = new(bigDecimalElectron).getDigitRunes(
+		bigDec,
+		ePrefix.XCpy(
+			"bigDec"))
+
+	if err != nil {
+		return newNumStrKernel, err
+	}
+
+	err = new(numberStrKernelNanobot).setWithRunes(
+		&newNumStrKernel,
+		integerDigits,
+		fractionalDigits,
+		numberSign,
+		ePrefix.XCpy(
+			"newNumStrKernel<-bigDec"))
+
+	return newNumStrKernel, err
+}
+
+// multiply
+//
+// Multiplies the numeric values of two instances of
+// BigDecimal. The exact product is stored in input
+// parameter 'product'.
+//
+// The exponent of the product is equal to the sum of the
+// operand exponents. Consequently, the number of
+// fractional digits in the product is equal to the sum
+// of the fractional digit counts of the two operands.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the data values contained in input parameter
+//	'product' will be deleted and reset to new values.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	product						*BigDecimal
+//
+//		A pointer to an instance of BigDecimal. The
+//		result of the multiplication operation will be
+//		stored in this instance.
+//
+//	bigDec01					*BigDecimal
+//
+//		A pointer to an instance of BigDecimal containing
+//		the multiplicand.
+//
+//	bigDec02					*BigDecimal
+//
+//		A pointer to an instance of BigDecimal containing
+//		the multiplier.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (bigDecNanobot *bigDecimalNanobot) multiply(
+	product *BigDecimal,
+	bigDec01 *BigDecimal,
+	bigDec02 *BigDecimal,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if bigDecNanobot.lock == nil {
+		bigDecNanobot.lock = new(sync.Mutex)
+	}
+
+	bigDecNanobot.lock.Lock()
+
+	defer bigDecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"bigDecimalNanobot."+
+			"multiply()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	err = new(bigDecimalNanobot).testOperands(
+		product,
+		bigDec01,
+		bigDec02,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	bigDecElectron := bigDecimalElectron{}
+
+	significand01,
+		exponent01 := bigDecElectron.getComponents(bigDec01)
+
+	significand02,
+		exponent02 := bigDecElectron.getComponents(bigDec02)
+
+	bigDecElectron.setComponents(
+		product,
+		new(big.Int).Mul(significand01, significand02),
+		new(big.Int).Add(exponent01, exponent02))
+
+	return err
+}
+
+// normalize
+//
+// Removes all trailing zeros from the significand of a
+// BigDecimal instance and adjusts the exponent
+// accordingly. The numeric value of 'bigDec' is NOT
+// changed.
+//
+//	Example:
+//		Before: significand = 125000  exponent = -5
+//				numeric value = 1.25000
+//		After:  significand = 125     exponent = -2
+//				numeric value = 1.25
+//
+// A numeric value of zero is normalized to a
+// significand of zero and an exponent of zero.
+//
+// If 'bigDec' is a nil pointer, this method takes no
+// action and exits.
+func (bigDecNanobot *bigDecimalNanobot) normalize(
+	bigDec *BigDecimal) {
+
+	if bigDecNanobot.lock == nil {
+		bigDecNanobot.lock = new(sync.Mutex)
+	}
+
+	bigDecNanobot.lock.Lock()
+
+	defer bigDecNanobot.lock.Unlock()
+
+	if bigDec == nil {
+		return
+	}
+
+	bigDecElectron := bigDecimalElectron{}
+
+	significand,
+		exponent := bigDecElectron.getComponents(bigDec)
+
+	if significand.Sign() == 0 {
+
+		exponent.SetInt64(0)
+
+	} else {
+
+		bigTen := big.NewInt(10)
+
+		quotient := new(big.Int)
+
+		remainder := new(big.Int)
+
+		for {
+
+			quotient.QuoRem(significand, bigTen, remainder)
+
+			if remainder.Sign() != 0 {
+				break
+			}
+
+			significand.Set(quotient)
+
+			exponent.Add(exponent, big.NewInt(1))
+		}
+	}
+
+	bigDecElectron.setComponents(
+		bigDec,
+		significand,
+		exponent)
+}
+
+// round
+//
+// Rounds the numeric value of a BigDecimal instance to
+// a specified number of fractional digits using the
+// rounding algorithm specified by 'roundingType'.
+//
+// If the number of fractional digits contained in
+// 'bigDec' is less than or equal to
+// 'roundToFractionalDigits', no action is taken.
+//
+// If 'roundingType' is set to NumRoundType.NoRounding(),
+// no action is taken.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	bigDec						*BigDecimal
+//
+//		A pointer to an instance of BigDecimal. The
+//		numeric value of this instance will be rounded.
+//
+//	roundingType				NumberRoundingType
+//
+//		The rounding algorithm which will be applied. If
+//		this parameter is invalid, an error will be
+//		returned.
+//
+//	roundToFractionalDigits		int
+//
+//		The number of fractional digits to the right of
+//		the radix point remaining after the rounding
+//		operation. If this value is less than zero, an
+//		error will be returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (bigDecNanobot *bigDecimalNanobot) round(
+	bigDec *BigDecimal,
+	roundingType NumberRoundingType,
+	roundToFractionalDigits int,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if bigDecNanobot.lock == nil {
+		bigDecNanobot.lock = new(sync.Mutex)
+	}
+
+	bigDecNanobot.lock.Lock()
+
+	defer bigDecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"bigDecimalNanobot."+
+			"round()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if bigDec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'bigDec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if !roundingType.XIsValid() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'roundingType' is invalid!\n"+
+			"roundingType string value  = '%v'\n"+
+			"roundingType integer value = '%v'\n",
+			ePrefix.String(),
+			roundingType.String(),
+			roundingType.XValueInt())
+
+		return err
+	}
+
+	if roundToFractionalDigits < 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'roundToFractionalDigits' is invalid!\n"+
+			"'roundToFractionalDigits' has a value less than zero.\n"+
+			"roundToFractionalDigits = '%v'\n",
+			ePrefix.String(),
+			roundToFractionalDigits)
+
+		return err
+	}
+
+	if roundingType == NumRoundType.NoRounding() {
+		return err
+	}
+
+	bigDecElectron := bigDecimalElectron{}
+
+	significand,
+		exponent := bigDecElectron.getComponents(bigDec)
+
+	targetExponent := big.NewInt(int64(-roundToFractionalDigits))
+
+	if exponent.Cmp(targetExponent) >= 0 {
+		return err
+	}
+
+	var roundedSignificand *big.Int
+
+	roundedSignificand,
+		err = new(bigDecimalAtom).roundBigIntQuotient(
+		significand,
+		new(bigDecimalAtom).powerOfTen(
+			new(big.Int).Sub(targetExponent, exponent)),
+		roundingType,
+		ePrefix.XCpy(
+			"significand"))
+
+	if err != nil {
+		return err
+	}
+
+	bigDecElectron.setComponents(
+		bigDec,
+		roundedSignificand,
+		targetExponent)
+
+	return err
+}
+
+// setFromBigRat
+//
+// Deletes and resets the numeric value of a BigDecimal
+// instance using a rational number ('bigRatValue').
+//
+// If the decimal expansion of 'bigRatValue' terminates
+// within 'roundToFractionalDigits' fractional digits,
+// the conversion is exact and no rounding is applied.
+// Otherwise, the value is rounded to
+// 'roundToFractionalDigits' fractional digits using the
+// rounding algorithm specified by 'roundingType'.
+//
+// In all cases, the exponent of 'bigDec' is set to the
+// negative value of 'roundToFractionalDigits'.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the data values contained in input parameter
+//	'bigDec' will be deleted and reset to new values.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	bigDec						*BigDecimal
+//
+//		A pointer to an instance of BigDecimal. The
+//		numeric value of this instance will be reset.
+//
+//	bigRatValue					*big.Rat
+//
+//		The rational number which will be converted to a
+//		decimal value. This instance will NOT be
+//		modified.
+//
+//	roundToFractionalDigits		int
+//
+//		The maximum number of fractional digits in the
+//		converted decimal value. If this value is less
+//		than zero, an error will be returned.
+//
+//	roundingType				NumberRoundingType
+//
+//		The rounding algorithm applied when the decimal
+//		expansion of 'bigRatValue' exceeds
+//		'roundToFractionalDigits'.
+//
+//		If this parameter is set to NumRoundType.NoRounding(),
+//		the value will be truncated to
+//		'roundToFractionalDigits' fractional digits.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (bigDecNanobot *bigDecimalNanobot) setFromBigRat(
+	bigDec *BigDecimal,
+	bigRatValue *big.Rat,
+	roundToFractionalDigits int,
+	roundingType NumberRoundingType,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if bigDecNanobot.lock == nil {
+		bigDecNanobot.lock = new(sync.Mutex)
+	}
+
+	bigDecNanobot.lock.Lock()
+
+	defer bigDecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"bigDecimalNanobot."+
+			"setFromBigRat()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if bigDec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'bigDec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if bigRatValue == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'bigRatValue' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if roundToFractionalDigits < 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'roundToFractionalDigits' is invalid!\n"+
+			"'roundToFractionalDigits' has a value less than zero.\n"+
+			"roundToFractionalDigits = '%v'\n",
+			ePrefix.String(),
+			roundToFractionalDigits)
+
+		return err
+	}
+
+	bigDecAtom := bigDecimalAtom{}
+
+	numerator := new(big.Int).Mul(
+		bigRatValue.Num(),
+		bigDecAtom.powerOfTen(
+			big.NewInt(int64(roundToFractionalDigits))))
+
+	var significand *big.Int
+
+	significand,
+		err = bigDecAtom.roundBigIntQuotient(
+		numerator,
+		bigRatValue.Denom(),
+		roundingType,
+		ePrefix.XCpy(
+			"bigRatValue"))
+
+	if err != nil {
+		return err
+	}
+
+	bigDecElectron := bigDecimalElectron{}
+
+	bigDecElectron.setComponents(
+		bigDec,
+		significand,
+		big.NewInt(int64(-roundToFractionalDigits)))
+
+	return err
+}
+
+// setFromNativeNumStr
+//
+// Deletes and resets the numeric value of a BigDecimal
+// instance using a Native Number String.
+//
+// A Native Number String consists of numeric digits, an
+// optional leading minus sign ('-') and an optional
+// period ('.') radix point.
+//
+// The exponent of 'bigDec' will be set to the negative
+// value of the number of fractional digits contained in
+// 'nativeNumStr'. Trailing fractional zeros are
+// therefore preserved.
+//
+//	Example: "1234.50" = 123450 x 10^-2
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the data values contained in input parameter
+//	'bigDec' will be deleted and reset to new values.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	bigDec						*BigDecimal
+//
+//		A pointer to an instance of BigDecimal. The
+//		numeric value of this instance will be reset.
+//
+//	nativeNumStr				string
+//
+//		A Native Number String. If this string is empty
+//		or invalid, an error will be returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (bigDecNanobot *bigDecimalNanobot) setFromNativeNumStr(
+	bigDec *BigDecimal,
+	nativeNumStr string,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if bigDecNanobot.lock == nil {
+		bigDecNanobot.lock = new(sync.Mutex)
+	}
+
+	bigDecNanobot.lock.Lock()
+
+	defer bigDecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"bigDecimalNanobot."+
+			"setFromNativeNumStr()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if bigDec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'bigDec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	var numStrKernel NumberStrKernel
+
+	numStrKernel,
+		_,
+		err = new(NumberStrKernel).NewParseNativeNumberStr(
+		nativeNumStr,
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"nativeNumStr"))
+
+	if err != nil {
+		return err
+	}
+
+	err = new(bigDecimalNanobot).setFromNumStrKernel(
+		bigDec,
+		&numStrKernel,
+		ePrefix.XCpy(
+			"bigDec<-numStrKernel"))
+
+	if err != nil {
+		return err
+	}
+
+	// The Native Number String parser deletes trailing
+	// fractional zeros. Restore the original scale.
+	scale := 0
+
+	radixIdx := strings.LastIndex(nativeNumStr, ".")
+
+	if radixIdx < 0 {
+		return err
+	}
+
+	for i := radixIdx + 1; i < len(nativeNumStr); i++ {
+
+		if nativeNumStr[i] < '0' ||
+			nativeNumStr[i] > '9' {
+
+			break
+		}
+
+		scale++
+	}
+
+	bigDecElectron := bigDecimalElectron{}
+
+	significand,
+		exponent := bigDecElectron.getComponents(bigDec)
+
+	targetExponent := big.NewInt(int64(-scale))
+
+	if exponent.Cmp(targetExponent) <= 0 {
+		return err
+	}
+
+	significand.Mul(
+		significand,
+		new(bigDecimalAtom).powerOfTen(
+			new(big.Int).Sub(exponent, targetExponent)))
+
+	bigDecElectron.setComponents(
+		bigDec,
+		significand,
+		targetExponent)
+
+	return err
+}
+
+// setFromNumStrKernel
+//
+// Deletes and resets the numeric value of a BigDecimal
+// instance using the integer and fractional digits
+// contained in an instance of NumberStrKernel.
+//
+// The exponent of 'bigDec' will be set to the negative
+// value of the number of fractional digits contained in
+// 'numStrKernel'. Trailing fractional zeros are
+// therefore preserved.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the data values contained in input parameter
+//	'bigDec' will be deleted and reset to new values.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	bigDec						*BigDecimal
+//
+//		A pointer to an instance of BigDecimal. The
+//		numeric value of this instance will be reset.
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. If
+//		this instance is invalid, an error will be
+//		returned. This instance will NOT be modified.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (bigDecNanobot *bigDecimalNanobot) setFromNumStrKernel(
+	bigDec *BigDecimal,
+	numStrKernel *NumberStrKernel,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if bigDecNanobot.lock == nil {
+		bigDecNanobot.lock = new(sync.Mutex)
+	}
+
+	bigDecNanobot.lock.Lock()
+
+	defer bigDecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"bigDecimalNanobot."+
+			"setFromNumStrKernel()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if bigDec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'bigDec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	var scaledDigits []rune
+	var scale int
+	var numberSign NumericSignValueType
+
+	scaledDigits,
+		scale,
+		numberSign,
+		err = new(numStrMathArithmeticMolecule).
+		getValidatedDigits(
+			numStrKernel,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	if err != nil {
+		return err
+	}
+
+	significand, ok := new(big.Int).SetString(
+		string(scaledDigits),
+		10)
+
+	if !ok {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Conversion of numeric digits to big.Int failed!\n"+
+			"Numeric Digits = '%v'\n",
+			ePrefix.String(),
+			string(scaledDigits))
+
+		return err
+	}
+
+	if numberSign == NumSignVal.Negative() {
+		significand.Neg(significand)
+	}
+
+	new(bigDecimalElectron).setComponents(
+		bigDec,
+		significand,
+		big.NewInt(int64(-scale)))
+
+	return err
+}
+
+// testOperands
+//
+// Verifies that the result and operand pointers passed
+// to an arithmetic operation are not nil.
+//
+// This method does NOT lock the current instance of
+// bigDecimalNanobot.
+func (bigDecNanobot *bigDecimalNanobot) testOperands(
+	result *BigDecimal,
+	bigDec01 *BigDecimal,
+	bigDec02 *BigDecimal,
+	ePrefix *ePref.ErrPrefixDto) error {
+
+	if result == nil {
+
+		return fmt.Errorf("%v\n"+
+			"Error: Input parameter 'result' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+	}
+
+	if bigDec01 == nil {
+
+		return fmt.Errorf("%v\n"+
+			"Error: Input parameter 'bigDec01' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+	}
+
+	if bigDec02 == nil {
+
+		return fmt.Errorf("%v\n"+
+			"Error: Input parameter 'bigDec02' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+	}
+
+	return nil
+}
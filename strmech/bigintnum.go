@@ -1,14 +1,724 @@
 package strmech
 
 import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
 	"math/big"
 	"sync"
 )
 
+// BigIntNum
+//
+// Contains a signed integer numeric value of unlimited
+// size.
+//
+// The integer value is stored internally as a
+// significand multiplied by 10 raised to the power of a
+// non-negative exponent:
+//
+//	integer value = significand x 10^exponent
+//
+// Trailing zeros are always removed from the
+// significand and accumulated in the exponent.
+// Therefore, large integer values containing many
+// trailing zeros are stored in a compact format.
+//
+//	Example
+//		Integer Value:	-265,200,000
+//		significand:	-2652
+//		exponent:		5
+//
+// BigIntNum is used by type BigDecimal to store the
+// significand and exponent of exact decimal values.
 type BigIntNum struct {
 	significand big.Int
+	//	The significand contains the integer digits
+	//	remaining after all trailing zeros have been
+	//	removed. The significand also carries the number
+	//	sign of the integer value.
 
 	exponent big.Int
+	//	The exponent is always greater than or equal to
+	//	zero. It specifies the number of trailing zeros
+	//	removed from the significand.
 
 	lock *sync.Mutex
 }
+
+// CopyIn
+//
+// Copies the data fields from an incoming instance of
+// BigIntNum ('incomingBigIntNum') to the data fields of
+// the current BigIntNum instance ('bigIntNum').
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All data field values in the current BigIntNum
+//	instance ('bigIntNum') will be deleted and
+//	overwritten.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	incomingBigIntNum			*BigIntNum
+//
+//		A pointer to an instance of BigIntNum. This method
+//		will NOT change the values of internal member
+//		variables contained in this instance.
+//
+//		All data values in this BigIntNum instance will be
+//		copied to the current BigIntNum instance
+//		('bigIntNum').
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (bigIntNum *BigIntNum) CopyIn(
+	incomingBigIntNum *BigIntNum,
+	errorPrefix interface{}) error {
+
+	if bigIntNum.lock == nil {
+		bigIntNum.lock = new(sync.Mutex)
+	}
+
+	bigIntNum.lock.Lock()
+
+	defer bigIntNum.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"BigIntNum."+
+			"CopyIn()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if incomingBigIntNum == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'incomingBigIntNum' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	new(bigIntNumElectron).copy(
+		bigIntNum,
+		incomingBigIntNum)
+
+	return err
+}
+
+// CopyOut
+//
+// Returns a deep copy of the current BigIntNum
+// instance.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	NONE
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	BigIntNum
+//
+//		A deep copy of the current BigIntNum instance.
+func (bigIntNum *BigIntNum) CopyOut() BigIntNum {
+
+	if bigIntNum.lock == nil {
+		bigIntNum.lock = new(sync.Mutex)
+	}
+
+	bigIntNum.lock.Lock()
+
+	defer bigIntNum.lock.Unlock()
+
+	newBigIntNum := BigIntNum{}
+
+	new(bigIntNumElectron).copy(
+		&newBigIntNum,
+		bigIntNum)
+
+	return newBigIntNum
+}
+
+// Empty
+//
+// Resets all internal member variables for the current
+// instance of BigIntNum to their initial or zero values.
+// The resulting integer value is zero.
+func (bigIntNum *BigIntNum) Empty() {
+
+	if bigIntNum.lock == nil {
+		bigIntNum.lock = new(sync.Mutex)
+	}
+
+	bigIntNum.lock.Lock()
+
+	bigIntNum.significand.SetInt64(0)
+
+	bigIntNum.exponent.SetInt64(0)
+
+	bigIntNum.lock.Unlock()
+
+	bigIntNum.lock = nil
+}
+
+// Equal
+//
+// Returns 'true' if the integer value of the current
+// BigIntNum instance is equal to that of the incoming
+// BigIntNum instance ('incomingBigIntNum').
+//
+// If 'incomingBigIntNum' is a nil pointer, this method
+// returns 'false'.
+func (bigIntNum *BigIntNum) Equal(
+	incomingBigIntNum *BigIntNum) bool {
+
+	if bigIntNum.lock == nil {
+		bigIntNum.lock = new(sync.Mutex)
+	}
+
+	bigIntNum.lock.Lock()
+
+	defer bigIntNum.lock.Unlock()
+
+	if incomingBigIntNum == nil {
+		return false
+	}
+
+	bigIntNumElectron1 := bigIntNumElectron{}
+
+	return bigIntNumElectron1.getBigInt(bigIntNum).Cmp(
+		bigIntNumElectron1.getBigInt(incomingBigIntNum)) == 0
+}
+
+// GetBigInt
+//
+// Returns the integer value of the current BigIntNum
+// instance as a new instance of *big.Int.
+func (bigIntNum *BigIntNum) GetBigInt() *big.Int {
+
+	if bigIntNum.lock == nil {
+		bigIntNum.lock = new(sync.Mutex)
+	}
+
+	bigIntNum.lock.Lock()
+
+	defer bigIntNum.lock.Unlock()
+
+	return new(bigIntNumElectron).getBigInt(bigIntNum)
+}
+
+// GetSign
+//
+// Returns the number sign of the current BigIntNum
+// instance as an integer value:
+//
+//	-1 if the integer value is less than zero
+//	 0 if the integer value is equal to zero
+//	+1 if the integer value is greater than zero
+func (bigIntNum *BigIntNum) GetSign() int {
+
+	if bigIntNum.lock == nil {
+		bigIntNum.lock = new(sync.Mutex)
+	}
+
+	bigIntNum.lock.Lock()
+
+	defer bigIntNum.lock.Unlock()
+
+	return bigIntNum.significand.Sign()
+}
+
+// NewFromBigInt
+//
+// Creates and returns a new instance of BigIntNum
+// configured with the integer value passed by input
+// parameter 'bigIntValue'.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	bigIntValue					*big.Int
+//
+//		A pointer to an instance of big.Int. The integer
+//		value of this instance will be used to configure
+//		the returned instance of BigIntNum.
+//
+//		'bigIntValue' will NOT be modified.
+//
+//		If 'bigIntValue' is a nil pointer, an error will be
+//		returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	BigIntNum
+//
+//		If this method completes successfully, a new
+//		instance of BigIntNum will be returned
+//		encapsulating the integer value of
+//		'bigIntValue'.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (bigIntNum *BigIntNum) NewFromBigInt(
+	bigIntValue *big.Int,
+	errorPrefix interface{}) (
+	BigIntNum,
+	error) {
+
+	if bigIntNum.lock == nil {
+		bigIntNum.lock = new(sync.Mutex)
+	}
+
+	bigIntNum.lock.Lock()
+
+	defer bigIntNum.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	newBigIntNum := BigIntNum{}
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"BigIntNum."+
+			"NewFromBigInt()",
+		"")
+
+	if err != nil {
+		return newBigIntNum, err
+	}
+
+	if bigIntValue == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'bigIntValue' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return newBigIntNum, err
+	}
+
+	new(bigIntNumElectron).setBigInt(
+		&newBigIntNum,
+		bigIntValue)
+
+	return newBigIntNum, err
+}
+
+// NewFromInt64
+//
+// Creates and returns a new instance of BigIntNum
+// configured with the integer value passed by input
+// parameter 'int64Value'.
+func (bigIntNum *BigIntNum) NewFromInt64(
+	int64Value int64) BigIntNum {
+
+	if bigIntNum.lock == nil {
+		bigIntNum.lock = new(sync.Mutex)
+	}
+
+	bigIntNum.lock.Lock()
+
+	defer bigIntNum.lock.Unlock()
+
+	newBigIntNum := BigIntNum{}
+
+	new(bigIntNumElectron).setBigInt(
+		&newBigIntNum,
+		big.NewInt(int64Value))
+
+	return newBigIntNum
+}
+
+// SetBigInt
+//
+// Deletes and resets the integer value of the current
+// BigIntNum instance to the value passed by input
+// parameter 'bigIntValue'.
+//
+// If 'bigIntValue' is a nil pointer, an error will be
+// returned and the current BigIntNum instance will NOT
+// be modified.
+//
+// 'bigIntValue' will NOT be modified.
+func (bigIntNum *BigIntNum) SetBigInt(
+	bigIntValue *big.Int,
+	errorPrefix interface{}) error {
+
+	if bigIntNum.lock == nil {
+		bigIntNum.lock = new(sync.Mutex)
+	}
+
+	bigIntNum.lock.Lock()
+
+	defer bigIntNum.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"BigIntNum."+
+			"SetBigInt()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if bigIntValue == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'bigIntValue' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	new(bigIntNumElectron).setBigInt(
+		bigIntNum,
+		bigIntValue)
+
+	return err
+}
+
+// SetInt64
+//
+// Deletes and resets the integer value of the current
+// BigIntNum instance to the value passed by input
+// parameter 'int64Value'.
+func (bigIntNum *BigIntNum) SetInt64(
+	int64Value int64) {
+
+	if bigIntNum.lock == nil {
+		bigIntNum.lock = new(sync.Mutex)
+	}
+
+	bigIntNum.lock.Lock()
+
+	defer bigIntNum.lock.Unlock()
+
+	new(bigIntNumElectron).setBigInt(
+		bigIntNum,
+		big.NewInt(int64Value))
+}
+
+// String
+//
+// Returns the integer value of the current BigIntNum
+// instance formatted as a string of base 10 numeric
+// digits. Negative values are prefixed with a leading
+// minus sign ('-').
+//
+// This method satisfies the fmt.Stringer interface.
+func (bigIntNum *BigIntNum) String() string {
+
+	if bigIntNum.lock == nil {
+		bigIntNum.lock = new(sync.Mutex)
+	}
+
+	bigIntNum.lock.Lock()
+
+	defer bigIntNum.lock.Unlock()
+
+	return new(bigIntNumElectron).
+		getBigInt(bigIntNum).Text(10)
+}
+
+// bigIntNumElectron - Provides helper methods for type
+// BigIntNum.
+type bigIntNumElectron struct {
+	lock *sync.Mutex
+}
+
+// copy
+//
+// Copies the significand and exponent from a source
+// instance of BigIntNum to a destination instance of
+// BigIntNum.
+func (bIntNumElectron *bigIntNumElectron) copy(
+	destinationBigIntNum *BigIntNum,
+	sourceBigIntNum *BigIntNum) {
+
+	if bIntNumElectron.lock == nil {
+		bIntNumElectron.lock = new(sync.Mutex)
+	}
+
+	bIntNumElectron.lock.Lock()
+
+	defer bIntNumElectron.lock.Unlock()
+
+	if destinationBigIntNum == nil ||
+		sourceBigIntNum == nil {
+
+		return
+	}
+
+	destinationBigIntNum.significand.Set(
+		&sourceBigIntNum.significand)
+
+	destinationBigIntNum.exponent.Set(
+		&sourceBigIntNum.exponent)
+}
+
+// getBigInt
+//
+// Computes and returns the integer value of a BigIntNum
+// instance as a new instance of *big.Int.
+//
+//	integer value = significand x 10^exponent
+func (bIntNumElectron *bigIntNumElectron) getBigInt(
+	bigIntNum *BigIntNum) *big.Int {
+
+	if bIntNumElectron.lock == nil {
+		bIntNumElectron.lock = new(sync.Mutex)
+	}
+
+	bIntNumElectron.lock.Lock()
+
+	defer bIntNumElectron.lock.Unlock()
+
+	bigIntValue := big.NewInt(0)
+
+	if bigIntNum == nil {
+		return bigIntValue
+	}
+
+	bigIntValue.Set(&bigIntNum.significand)
+
+	if bigIntNum.exponent.Sign() > 0 {
+
+		bigIntValue.Mul(
+			bigIntValue,
+			new(big.Int).Exp(
+				big.NewInt(10),
+				&bigIntNum.exponent,
+				nil))
+	}
+
+	return bigIntValue
+}
+
+// setBigInt
+//
+// Configures a BigIntNum instance with the integer value
+// of 'bigIntValue'. Trailing zeros are removed from the
+// significand and accumulated in the exponent.
+//
+// 'bigIntValue' will NOT be modified.
+func (bIntNumElectron *bigIntNumElectron) setBigInt(
+	bigIntNum *BigIntNum,
+	bigIntValue *big.Int) {
+
+	if bIntNumElectron.lock == nil {
+		bIntNumElectron.lock = new(sync.Mutex)
+	}
+
+	bIntNumElectron.lock.Lock()
+
+	defer bIntNumElectron.lock.Unlock()
+
+	if bigIntNum == nil ||
+		bigIntValue == nil {
+
+		return
+	}
+
+	bigIntNum.significand.Set(bigIntValue)
+
+	bigIntNum.exponent.SetInt64(0)
+
+	if bigIntNum.significand.Sign() == 0 {
+		return
+	}
+
+	bigTen := big.NewInt(10)
+
+	quotient := new(big.Int)
+
+	remainder := new(big.Int)
+
+	var trailingZeros int64
+
+	for {
+
+		quotient.QuoRem(
+			&bigIntNum.significand,
+			bigTen,
+			remainder)
+
+		if remainder.Sign() != 0 {
+			break
+		}
+
+		bigIntNum.significand.Set(quotient)
+
+		trailingZeros++
+	}
+
+	bigIntNum.exponent.SetInt64(trailingZeros)
+}
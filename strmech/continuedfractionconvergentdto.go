@@ -0,0 +1,103 @@
+package strmech
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+)
+
+// ContinuedFractionConvergentDto
+//
+// A data transport type designed to store and transmit
+// a single convergent of a simple continued fraction
+// expansion.
+//
+// The simple continued fraction expansion of a value 'x'
+// is written as:
+//
+//	x = a0 + 1/(a1 + 1/(a2 + 1/(a3 + ...)))
+//
+// The n-th convergent is the rational number obtained by
+// truncating this expansion after partial quotient 'an'.
+// Each convergent is a better approximation of 'x' than
+// any rational number with a smaller denominator.
+//
+// Example: The convergents of Pi (3.14159265...) are
+//
+//	3/1, 22/7, 333/106, 355/113, 103993/33102 ...
+//
+// ----------------------------------------------------------------
+//
+// # Reference:
+//
+//	https://en.wikipedia.org/wiki/Continued_fraction
+type ContinuedFractionConvergentDto struct {
+	Index int
+	//	The zero based index of this convergent within
+	//	the continued fraction expansion.
+
+	PartialQuotient *big.Int
+	//	The partial quotient 'an' of the continued
+	//	fraction expansion at this index. The first
+	//	partial quotient (a0) is the floor of the value
+	//	and may be zero or negative. All subsequent
+	//	partial quotients are positive.
+
+	Convergent *big.Rat
+	//	The rational number obtained by truncating the
+	//	continued fraction expansion after
+	//	'PartialQuotient'.
+
+	AbsoluteError *big.Rat
+	//	The exact absolute difference between the
+	//	expanded value and 'Convergent'.
+
+	lock *sync.Mutex
+}
+
+// String
+//
+// Returns a formatted text string describing the
+// convergent encapsulated by the current instance of
+// ContinuedFractionConvergentDto.
+//
+// Example:
+//
+//	"[1] a=7 convergent=22/7 error=0.00126448927"
+func (cFracConvergentDto *ContinuedFractionConvergentDto) String() string {
+
+	if cFracConvergentDto.lock == nil {
+		cFracConvergentDto.lock = new(sync.Mutex)
+	}
+
+	cFracConvergentDto.lock.Lock()
+
+	defer cFracConvergentDto.lock.Unlock()
+
+	partialQuotientStr := "<nil>"
+
+	if cFracConvergentDto.PartialQuotient != nil {
+		partialQuotientStr =
+			cFracConvergentDto.PartialQuotient.String()
+	}
+
+	convergentStr := "<nil>"
+
+	if cFracConvergentDto.Convergent != nil {
+		convergentStr =
+			cFracConvergentDto.Convergent.String()
+	}
+
+	absoluteErrorStr := "<nil>"
+
+	if cFracConvergentDto.AbsoluteError != nil {
+		absoluteErrorStr =
+			cFracConvergentDto.AbsoluteError.FloatString(11)
+	}
+
+	return fmt.Sprintf("[%v] a=%v convergent=%v error=%v",
+		cFracConvergentDto.Index,
+		partialQuotientStr,
+		convergentStr,
+		absoluteErrorStr)
+}
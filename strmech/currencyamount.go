@@ -0,0 +1,1284 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
+
+// CurrencyAmount
+//
+// Type CurrencyAmount encapsulates a numeric amount
+// denominated in a specific currency. The numeric amount
+// is stored as a NumberStrKernel and is tagged with a
+// three character ISO 4217 alphabetic currency code and
+// the number of minor unit, or fractional, digits used
+// by that currency.
+//
+//	Examples:
+//		Amount: 1234.56  Currency: "USD"  Minor Units: 2
+//		Amount: 1500     Currency: "JPY"  Minor Units: 0
+//
+// Currency amounts may be converted to other currencies
+// using an offline exchange rate table supplied by the
+// caller. Reference type CurrencyRateTable and method
+// CurrencyAmount.ConvertTo().
+//
+// When formatted as a currency number string, the
+// matching Country Culture Specification is selected
+// automatically from the currency code. This allows
+// reports containing amounts denominated in several
+// different currencies to be formatted correctly.
+// Reference method CurrencyAmount.FmtCurrencyNumStr().
+//
+// Country Culture Specifications are currently available
+// for the following currencies:
+//
+//	"USD" - United States
+//	"GBP" - United Kingdom
+//	"EUR" - France
+//
+// All other currencies are formatted using the ISO 4217
+// currency registry. Reference type CurrencyISO4217Spec.
+//
+// ----------------------------------------------------------------
+//
+// # Usage
+//
+//	var rateTable CurrencyRateTable
+//
+//	rate, _, err := new(NumberStrKernel).
+//		NewParseNativeNumberStr(
+//			"0.92",
+//			NumRoundType.NoRounding(),
+//			0,
+//			nil)
+//
+//	err = rateTable.AddRate("USD", "EUR", &rate, nil)
+//
+//	usdAmt, err := new(CurrencyAmount).NewCurrencyAmount(
+//		&amount, "USD", 2, nil)
+//
+//	eurAmt, err := usdAmt.ConvertTo(
+//		"EUR",
+//		2,
+//		&rateTable,
+//		NumRoundType.HalfToEven(),
+//		nil)
+//
+//	eurNumStr, err := eurAmt.FmtCurrencyNumStr(
+//		new(NumStrNumberFieldSpec).NewNOP(),
+//		NumRoundType.HalfAwayFromZero(),
+//		nil)
+type CurrencyAmount struct {
+	amount NumberStrKernel
+	// The numeric value of the currency amount.
+
+	currencyCode string
+	// The three character ISO 4217 alphabetic
+	// currency code. Example: "USD"
+
+	minorUnitDigits uint
+	// The number of minor unit, or fractional,
+	// digits used by the currency. For "USD",
+	// this value is 2.
+
+	lock *sync.Mutex
+}
+
+// ConvertTo
+//
+// Converts the current CurrencyAmount instance to an
+// equivalent amount denominated in the currency
+// specified by input parameter 'targetCurrencyCode'.
+//
+// The exchange rate is extracted from the offline rate
+// table supplied by input parameter 'rateTable'. The
+// rate table must contain an exchange rate for either
+// the current/target currency pair or the
+// target/current currency pair.
+//
+// The converted amount is rounded to the number of
+// minor unit digits specified by input parameter
+// 'targetMinorUnitDigits' using the rounding algorithm
+// specified by input parameter 'roundingType'.
+//
+// All calculations are performed with exact decimal
+// arithmetic. No floating point arithmetic is used.
+//
+// The current instance of CurrencyAmount is NOT
+// modified.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	targetCurrencyCode			string
+//
+//		The three character ISO 4217 alphabetic currency
+//		code identifying the currency to which the
+//		current amount will be converted. This code is
+//		NOT case-sensitive.
+//
+//		If 'targetCurrencyCode' is equal to the currency
+//		code of the current instance, no exchange rate
+//		is required and the amount is simply rounded to
+//		'targetMinorUnitDigits'.
+//
+//		If 'targetCurrencyCode' is not listed in the ISO
+//		4217 currency registry, an error will be
+//		returned.
+//
+//	targetMinorUnitDigits		uint
+//
+//		The number of minor unit, or fractional, digits
+//		used by the target currency. For the Euro
+//		("EUR"), this value is 2. For the Japanese Yen
+//		("JPY"), this value is zero. If this value does
+//		not match the ISO 4217 minor units for the
+//		target currency, an error will be returned.
+//
+//	rateTable					*CurrencyRateTable
+//
+//		A pointer to an offline exchange rate table
+//		supplying the conversion rate.
+//
+//	roundingType				NumberRoundingType
+//
+//		The rounding algorithm applied to the converted
+//		amount. Currency conversions require explicit
+//		rounding. Therefore, NumRoundType.None() and
+//		NumRoundType.NoRounding() are invalid.
+//
+//		Valid rounding types include:
+//
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	CurrencyAmount
+//
+//		If this method completes successfully, a new
+//		instance of CurrencyAmount will be returned
+//		containing the converted amount denominated in
+//		the target currency.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (curAmt *CurrencyAmount) ConvertTo(
+	targetCurrencyCode string,
+	targetMinorUnitDigits uint,
+	rateTable *CurrencyRateTable,
+	roundingType NumberRoundingType,
+	errorPrefix interface{}) (
+	CurrencyAmount,
+	error) {
+
+	if curAmt.lock == nil {
+		curAmt.lock = new(sync.Mutex)
+	}
+
+	curAmt.lock.Lock()
+
+	defer curAmt.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+	var convertedCurAmt CurrencyAmount
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"CurrencyAmount."+
+			"ConvertTo()",
+		"")
+
+	if err != nil {
+		return convertedCurAmt, err
+	}
+
+	err = new(currencyAmountNanobot).convert(
+		&convertedCurAmt,
+		curAmt,
+		targetCurrencyCode,
+		targetMinorUnitDigits,
+		rateTable,
+		roundingType,
+		ePrefix.XCpy(
+			"convertedCurAmt<-curAmt"))
+
+	return convertedCurAmt, err
+}
+
+// CopyIn
+//
+// Copies the data fields from an incoming instance of
+// CurrencyAmount ('incomingCurAmt') to the data fields
+// of the current CurrencyAmount instance ('curAmt').
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// All the data fields in current CurrencyAmount instance
+// ('curAmt') will be deleted and overwritten.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	incomingCurAmt				*CurrencyAmount
+//
+//		A pointer to an instance of CurrencyAmount. This
+//		method will NOT change the values of internal
+//		member variables contained in this instance.
+//
+//		All data values in this CurrencyAmount instance
+//		will be copied to current CurrencyAmount
+//		instance ('curAmt').
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (curAmt *CurrencyAmount) CopyIn(
+	incomingCurAmt *CurrencyAmount,
+	errorPrefix interface{}) (
+	err error) {
+
+	if curAmt.lock == nil {
+		curAmt.lock = new(sync.Mutex)
+	}
+
+	curAmt.lock.Lock()
+
+	defer curAmt.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"CurrencyAmount."+
+			"CopyIn()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(currencyAmountElectron).copy(
+		curAmt,
+		incomingCurAmt,
+		ePrefix.XCpy(
+			"curAmt<-incomingCurAmt"))
+}
+
+// CopyOut
+//
+// Returns a deep copy of the current CurrencyAmount
+// instance.
+func (curAmt *CurrencyAmount) CopyOut() CurrencyAmount {
+
+	if curAmt.lock == nil {
+		curAmt.lock = new(sync.Mutex)
+	}
+
+	curAmt.lock.Lock()
+
+	defer curAmt.lock.Unlock()
+
+	newCurAmt := CurrencyAmount{}
+
+	_ = new(currencyAmountElectron).copy(
+		&newCurAmt,
+		curAmt,
+		nil)
+
+	return newCurAmt
+}
+
+// Empty
+//
+// Resets all internal member variables for the current
+// instance of CurrencyAmount to their initial or zero
+// values.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// This method will delete all pre-existing internal
+// member variable data values in the current instance
+// of CurrencyAmount.
+func (curAmt *CurrencyAmount) Empty() {
+
+	if curAmt.lock == nil {
+		curAmt.lock = new(sync.Mutex)
+	}
+
+	curAmt.lock.Lock()
+
+	new(currencyAmountElectron).empty(
+		curAmt)
+
+	curAmt.lock.Unlock()
+
+	curAmt.lock = nil
+}
+
+// Equal
+//
+// Receives a pointer to another instance of
+// CurrencyAmount and proceeds to compare its internal
+// member variables to those of the current
+// CurrencyAmount instance in order to determine if they
+// are equivalent.
+//
+// A boolean flag showing the result of this comparison
+// is returned. If the member variables for both
+// instances are equal in all respects, this flag is set
+// to 'true'. Otherwise, this method returns 'false'.
+func (curAmt *CurrencyAmount) Equal(
+	incomingCurAmt *CurrencyAmount) bool {
+
+	if curAmt.lock == nil {
+		curAmt.lock = new(sync.Mutex)
+	}
+
+	curAmt.lock.Lock()
+
+	defer curAmt.lock.Unlock()
+
+	return new(currencyAmountElectron).equal(
+		curAmt,
+		incomingCurAmt)
+}
+
+// FmtCurrencyNumStr
+//
+// Returns a formatted currency number string for the
+// current instance of CurrencyAmount.
+//
+// The Country Culture Specification used to format the
+// number string is selected automatically using the
+// currency code of the current CurrencyAmount instance.
+// As a result, amounts denominated in different
+// currencies will each be formatted with the currency
+// symbol, decimal separator and integer separators
+// appropriate to that currency.
+//
+// If no Country Culture Specification uses the currency,
+// the format is taken from the ISO 4217 currency
+// registry. Reference type CurrencyISO4217Spec.
+//
+//	Examples:
+//		1234567.891 "USD" -> "$ 1,234,567.89"
+//		1234567.891 "EUR" -> "1 234 567,89 €"
+//		1234567.891 "CHF" -> "CHF 1'234'567.89"
+//
+// The numeric value is rounded to the number of minor
+// unit digits configured for the current instance using
+// the rounding algorithm specified by input parameter
+// 'roundingType'.
+//
+// If the currency code is not found in either the
+// Country Culture Specifications or the ISO 4217
+// currency registry, an error is returned.
+//
+// The current instance of CurrencyAmount is NOT
+// modified.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numberFieldSpec				NumStrNumberFieldSpec
+//
+//		This Number Field Specification contains the
+//		field length and text justification parameters
+//		applied to the formatted number string.
+//
+//		To format the number string without a number
+//		field, use:
+//
+//			new(NumStrNumberFieldSpec).NewNOP()
+//
+//	roundingType				NumberRoundingType
+//
+//		The rounding algorithm applied when rounding the
+//		currency amount to the configured number of minor
+//		unit digits. NumRoundType.None() and
+//		NumRoundType.NoRounding() are invalid and will
+//		trigger an error.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	string
+//
+//		If this method completes successfully, this
+//		parameter will return a currency number string
+//		formatted for the currency of the current
+//		CurrencyAmount instance.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (curAmt *CurrencyAmount) FmtCurrencyNumStr(
+	numberFieldSpec NumStrNumberFieldSpec,
+	roundingType NumberRoundingType,
+	errorPrefix interface{}) (
+	string,
+	error) {
+
+	if curAmt.lock == nil {
+		curAmt.lock = new(sync.Mutex)
+	}
+
+	curAmt.lock.Lock()
+
+	defer curAmt.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"CurrencyAmount."+
+			"FmtCurrencyNumStr()",
+		"")
+
+	if err != nil {
+		return "", err
+	}
+
+	return new(currencyAmountNanobot).formatCurrencyNumStr(
+		curAmt,
+		numberFieldSpec,
+		roundingType,
+		ePrefix.XCpy(
+			"curAmt"))
+}
+
+// GetAmount
+//
+// Returns a deep copy of the NumberStrKernel containing
+// the numeric value of the current CurrencyAmount
+// instance.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberStrKernel
+//
+//		If this method completes successfully, a deep
+//		copy of the numeric amount will be returned.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (curAmt *CurrencyAmount) GetAmount(
+	errorPrefix interface{}) (
+	NumberStrKernel,
+	error) {
+
+	if curAmt.lock == nil {
+		curAmt.lock = new(sync.Mutex)
+	}
+
+	curAmt.lock.Lock()
+
+	defer curAmt.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+	var amount NumberStrKernel
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"CurrencyAmount."+
+			"GetAmount()",
+		"")
+
+	if err != nil {
+		return amount, err
+	}
+
+	err = new(numberStrKernelNanobot).copy(
+		&amount,
+		&curAmt.amount,
+		ePrefix.XCpy(
+			"amount<-curAmt.amount"))
+
+	return amount, err
+}
+
+// GetCountryCultureSpec
+//
+// Returns the Country Culture Specification matching the
+// currency code of the current CurrencyAmount instance.
+// This is the specification used by method
+// CurrencyAmount.FmtCurrencyNumStr().
+//
+// Where a currency is used by more than one country, the
+// first matching country is returned. For the Euro
+// ("EUR"), this is France.
+//
+// If no Country Culture Specification is available for
+// the currency code, an error is returned.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumStrFmtCountryCultureSpec
+//
+//		If this method completes successfully, the
+//		Country Culture Specification for the currency
+//		of the current CurrencyAmount instance will be
+//		returned.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (curAmt *CurrencyAmount) GetCountryCultureSpec(
+	errorPrefix interface{}) (
+	NumStrFmtCountryCultureSpec,
+	error) {
+
+	if curAmt.lock == nil {
+		curAmt.lock = new(sync.Mutex)
+	}
+
+	curAmt.lock.Lock()
+
+	defer curAmt.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"CurrencyAmount."+
+			"GetCountryCultureSpec()",
+		"")
+
+	if err != nil {
+		return NumStrFmtCountryCultureSpec{}, err
+	}
+
+	return new(currencyAmountElectron).getCountryCultureSpec(
+		curAmt.currencyCode,
+		ePrefix.XCpy(
+			"curAmt.currencyCode"))
+}
+
+// GetCurrencyCode
+//
+// Returns the three character ISO 4217 alphabetic
+// currency code for the current CurrencyAmount
+// instance.
+func (curAmt *CurrencyAmount) GetCurrencyCode() string {
+
+	if curAmt.lock == nil {
+		curAmt.lock = new(sync.Mutex)
+	}
+
+	curAmt.lock.Lock()
+
+	defer curAmt.lock.Unlock()
+
+	return curAmt.currencyCode
+}
+
+// GetMinorUnitDigits
+//
+// Returns the number of minor unit, or fractional,
+// digits used by the currency of the current
+// CurrencyAmount instance.
+func (curAmt *CurrencyAmount) GetMinorUnitDigits() uint {
+
+	if curAmt.lock == nil {
+		curAmt.lock = new(sync.Mutex)
+	}
+
+	curAmt.lock.Lock()
+
+	defer curAmt.lock.Unlock()
+
+	return curAmt.minorUnitDigits
+}
+
+// NewCurrencyAmount
+//
+// Creates and returns a new instance of CurrencyAmount.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	amount						*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel
+//		containing the numeric value of the currency
+//		amount. A deep copy of this instance will be
+//		stored in the returned CurrencyAmount. The
+//		numeric value is NOT rounded.
+//
+//	currencyCode				string
+//
+//		The three character ISO 4217 alphabetic currency
+//		code. Lower case characters are converted to
+//		upper case. If 'currencyCode' is not listed in
+//		the ISO 4217 currency registry, an error will be
+//		returned.
+//
+//	minorUnitDigits				uint
+//
+//		The number of minor unit, or fractional, digits
+//		used by the currency. For the US Dollar ("USD"),
+//		this value is 2. If this value does not match
+//		the ISO 4217 minor units for the currency, an
+//		error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	CurrencyAmount
+//
+//		If this method completes successfully, a new
+//		instance of CurrencyAmount will be returned.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (curAmt *CurrencyAmount) NewCurrencyAmount(
+	amount *NumberStrKernel,
+	currencyCode string,
+	minorUnitDigits uint,
+	errorPrefix interface{}) (
+	CurrencyAmount,
+	error) {
+
+	if curAmt.lock == nil {
+		curAmt.lock = new(sync.Mutex)
+	}
+
+	curAmt.lock.Lock()
+
+	defer curAmt.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+	var newCurAmt CurrencyAmount
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"CurrencyAmount."+
+			"NewCurrencyAmount()",
+		"")
+
+	if err != nil {
+		return newCurAmt, err
+	}
+
+	err = new(currencyAmountNanobot).setCurrencyAmount(
+		&newCurAmt,
+		amount,
+		currencyCode,
+		minorUnitDigits,
+		ePrefix.XCpy(
+			"newCurAmt"))
+
+	return newCurAmt, err
+}
+
+// SetCurrencyAmount
+//
+// Deletes and overwrites all data values contained in
+// the current instance of CurrencyAmount.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// All the data fields in current CurrencyAmount instance
+// will be deleted and overwritten. If this method
+// returns an error, the current instance will not be
+// modified.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	amount						*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel
+//		containing the numeric value of the currency
+//		amount. A deep copy of this instance will be
+//		stored in the current CurrencyAmount instance.
+//
+//	currencyCode				string
+//
+//		The three character ISO 4217 alphabetic currency
+//		code. Lower case characters are converted to
+//		upper case. If 'currencyCode' is not listed in
+//		the ISO 4217 currency registry, an error will be
+//		returned.
+//
+//	minorUnitDigits				uint
+//
+//		The number of minor unit, or fractional, digits
+//		used by the currency. If this value does not
+//		match the ISO 4217 minor units for the currency,
+//		an error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (curAmt *CurrencyAmount) SetCurrencyAmount(
+	amount *NumberStrKernel,
+	currencyCode string,
+	minorUnitDigits uint,
+	errorPrefix interface{}) (
+	err error) {
+
+	if curAmt.lock == nil {
+		curAmt.lock = new(sync.Mutex)
+	}
+
+	curAmt.lock.Lock()
+
+	defer curAmt.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"CurrencyAmount."+
+			"SetCurrencyAmount()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(currencyAmountNanobot).setCurrencyAmount(
+		curAmt,
+		amount,
+		currencyCode,
+		minorUnitDigits,
+		ePrefix.XCpy(
+			"curAmt"))
+}
@@ -0,0 +1,554 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"strings"
+	"sync"
+)
+
+// currencyAmountElectron - Provides helper methods for
+// type CurrencyAmount.
+type currencyAmountElectron struct {
+	lock *sync.Mutex
+}
+
+// copy
+//
+// Copies all data from input parameter 'sourceCurAmt'
+// to input parameter 'destinationCurAmt'. Both instances
+// are of type CurrencyAmount.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// All the data fields in 'destinationCurAmt' will be
+// deleted and overwritten.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	destinationCurAmt			*CurrencyAmount
+//
+//		A pointer to an instance of CurrencyAmount. Data
+//		extracted from input parameter 'sourceCurAmt'
+//		will be copied to this parameter.
+//
+//	sourceCurAmt				*CurrencyAmount
+//
+//		A pointer to an instance of CurrencyAmount. All
+//		data values in this instance will be copied to
+//		input parameter 'destinationCurAmt'.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+func (curAmtElectron *currencyAmountElectron) copy(
+	destinationCurAmt *CurrencyAmount,
+	sourceCurAmt *CurrencyAmount,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if curAmtElectron.lock == nil {
+		curAmtElectron.lock = new(sync.Mutex)
+	}
+
+	curAmtElectron.lock.Lock()
+
+	defer curAmtElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"currencyAmountElectron."+
+			"copy()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if destinationCurAmt == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'destinationCurAmt' is a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if sourceCurAmt == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sourceCurAmt' is a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	err = new(numberStrKernelNanobot).copy(
+		&destinationCurAmt.amount,
+		&sourceCurAmt.amount,
+		ePrefix.XCpy(
+			"destinationCurAmt.amount<-"+
+				"sourceCurAmt.amount"))
+
+	if err != nil {
+		return err
+	}
+
+	destinationCurAmt.currencyCode =
+		sourceCurAmt.currencyCode
+
+	destinationCurAmt.minorUnitDigits =
+		sourceCurAmt.minorUnitDigits
+
+	return err
+}
+
+// empty
+//
+// Resets all internal member variables for the instance
+// of CurrencyAmount passed as input parameter 'curAmt'
+// to their initial or zero values.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// All the member variable data values contained in input
+// parameter 'curAmt' will be deleted.
+func (curAmtElectron *currencyAmountElectron) empty(
+	curAmt *CurrencyAmount) {
+
+	if curAmtElectron.lock == nil {
+		curAmtElectron.lock = new(sync.Mutex)
+	}
+
+	curAmtElectron.lock.Lock()
+
+	defer curAmtElectron.lock.Unlock()
+
+	if curAmt == nil {
+		return
+	}
+
+	new(numberStrKernelElectron).empty(
+		&curAmt.amount)
+
+	curAmt.currencyCode = ""
+
+	curAmt.minorUnitDigits = 0
+
+	return
+}
+
+// equal
+//
+// Receives pointers to two instances of CurrencyAmount
+// and proceeds to compare their member variables in
+// order to determine if they are equivalent.
+//
+// If the member variables for both instances are equal
+// in all respects, this method returns 'true'.
+// Otherwise, this method returns 'false'.
+func (curAmtElectron *currencyAmountElectron) equal(
+	curAmt1 *CurrencyAmount,
+	curAmt2 *CurrencyAmount) bool {
+
+	if curAmtElectron.lock == nil {
+		curAmtElectron.lock = new(sync.Mutex)
+	}
+
+	curAmtElectron.lock.Lock()
+
+	defer curAmtElectron.lock.Unlock()
+
+	if curAmt1 == nil ||
+		curAmt2 == nil {
+
+		return false
+	}
+
+	if curAmt1.currencyCode !=
+		curAmt2.currencyCode {
+
+		return false
+	}
+
+	if curAmt1.minorUnitDigits !=
+		curAmt2.minorUnitDigits {
+
+		return false
+	}
+
+	return new(numberStrKernelElectron).equal(
+		&curAmt1.amount,
+		&curAmt2.amount)
+}
+
+// getCountryCultureSpec
+//
+// Returns the Country Culture Specification which uses
+// the currency identified by input parameter
+// 'currencyCode'.
+//
+// The Country Culture Specifications searched by this
+// method are those configured by type
+// numStrFmtCountryCultureSpecMech. Where a currency is
+// used by more than one country, the first matching
+// country is returned. For the Euro ("EUR"), this is
+// France.
+//
+// If no Country Culture Specification is found for
+// 'currencyCode', an error is returned.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	currencyCode				string
+//
+//		The three character ISO 4217 alphabetic currency
+//		code. This code is NOT case-sensitive.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	countryCultureSpec			NumStrFmtCountryCultureSpec
+//
+//		If this method completes successfully, this
+//		parameter will return the Country Culture
+//		Specification associated with 'currencyCode'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+func (curAmtElectron *currencyAmountElectron) getCountryCultureSpec(
+	currencyCode string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	countryCultureSpec NumStrFmtCountryCultureSpec,
+	err error) {
+
+	if curAmtElectron.lock == nil {
+		curAmtElectron.lock = new(sync.Mutex)
+	}
+
+	curAmtElectron.lock.Lock()
+
+	defer curAmtElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"currencyAmountElectron."+
+			"getCountryCultureSpec()",
+		"")
+
+	if err != nil {
+		return countryCultureSpec, err
+	}
+
+	var found bool
+
+	countryCultureSpec,
+		found,
+		err = new(currencyAmountElectron).lookupCountryCultureSpec(
+		currencyCode,
+		ePrefix.XCpy(
+			"countryCultureSpec<-currencyCode"))
+
+	if err != nil {
+		return countryCultureSpec, err
+	}
+
+	if !found {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: No Country Culture Specification was found\n"+
+			"for the currency code specified by 'currencyCode'.\n"+
+			"currencyCode = '%v'\n",
+			ePrefix.String(),
+			currencyCode)
+	}
+
+	return countryCultureSpec, err
+}
+
+// lookupCountryCultureSpec
+//
+// Searches the Country Culture Specifications configured
+// by type numStrFmtCountryCultureSpecMech for a country
+// which uses the currency identified by input parameter
+// 'currencyCode'. The currency code is NOT
+// case-sensitive.
+//
+// If a matching Country Culture Specification is found,
+// it is returned and 'found' is set to 'true'. Where a
+// currency is used by more than one country, the first
+// matching country is returned. For the Euro ("EUR"),
+// this is France.
+//
+// If no match is found, 'found' is set to 'false' and
+// no error is returned.
+func (curAmtElectron *currencyAmountElectron) lookupCountryCultureSpec(
+	currencyCode string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	countryCultureSpec NumStrFmtCountryCultureSpec,
+	found bool,
+	err error) {
+
+	if curAmtElectron.lock == nil {
+		curAmtElectron.lock = new(sync.Mutex)
+	}
+
+	curAmtElectron.lock.Lock()
+
+	defer curAmtElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"currencyAmountElectron."+
+			"lookupCountryCultureSpec()",
+		"")
+
+	if err != nil {
+		return countryCultureSpec, found, err
+	}
+
+	countryCultureMech := numStrFmtCountryCultureSpecMech{}
+
+	countrySetters := []func(
+		*NumStrFmtCountryCultureSpec,
+		*ePref.ErrPrefixDto) error{
+		countryCultureMech.setCountryUS,
+		countryCultureMech.setCountryUK,
+		countryCultureMech.setCountryFrance,
+		countryCultureMech.setCountryGermany,
+	}
+
+	var candidateSpec NumStrFmtCountryCultureSpec
+
+	for i := 0; i < len(countrySetters); i++ {
+
+		err = countrySetters[i](
+			&candidateSpec,
+			ePrefix.XCpy(
+				fmt.Sprintf("candidateSpec[%v]",
+					i)))
+
+		if err != nil {
+			return countryCultureSpec, found, err
+		}
+
+		if strings.EqualFold(
+			candidateSpec.CurrencyCode,
+			currencyCode) {
+
+			countryCultureSpec = candidateSpec
+
+			found = true
+
+			return countryCultureSpec, found, err
+		}
+
+		candidateSpec = NumStrFmtCountryCultureSpec{}
+	}
+
+	return countryCultureSpec, found, err
+}
+
+// testCurrencyCode
+//
+// Validates an ISO 4217 alphabetic currency code. A
+// valid currency code consists of exactly three
+// alphabetic characters 'A' through 'Z'.
+//
+// Leading and trailing white space is removed and lower
+// case characters are converted to upper case. The
+// resulting normalized currency code is returned.
+//
+//	Examples:
+//		"usd"   -> "USD"
+//		" EUR " -> "EUR"
+//		"US$"   -> Error
+//
+// If 'currencyCode' is invalid, an error is returned.
+func (curAmtElectron *currencyAmountElectron) testCurrencyCode(
+	currencyCode string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	normalizedCurrencyCode string,
+	err error) {
+
+	if curAmtElectron.lock == nil {
+		curAmtElectron.lock = new(sync.Mutex)
+	}
+
+	curAmtElectron.lock.Lock()
+
+	defer curAmtElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"currencyAmountElectron."+
+			"testCurrencyCode()",
+		"")
+
+	if err != nil {
+		return normalizedCurrencyCode, err
+	}
+
+	codeRunes := []rune(
+		strings.ToUpper(
+			strings.TrimSpace(currencyCode)))
+
+	isValid := len(codeRunes) == 3
+
+	for i := 0; isValid && i < len(codeRunes); i++ {
+
+		if codeRunes[i] < 'A' ||
+			codeRunes[i] > 'Z' {
+
+			isValid = false
+		}
+	}
+
+	if !isValid {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'currencyCode' is invalid!\n"+
+			"'currencyCode' must consist of three alphabetic\n"+
+			"characters as specified by ISO 4217.\n"+
+			"currencyCode = '%v'\n",
+			ePrefix.String(),
+			currencyCode)
+
+		return normalizedCurrencyCode, err
+	}
+
+	normalizedCurrencyCode = string(codeRunes)
+
+	return normalizedCurrencyCode, err
+}
+
+// testMinorUnitDigits
+//
+// Validates a normalized ISO 4217 alphabetic currency
+// code and the associated number of minor unit digits
+// against the ISO 4217 currency registry.
+//
+// The currency code must be listed in the registry and
+// the number of minor unit digits must match the minor
+// units specified for the currency by the registry.
+//
+//	Examples:
+//		"USD", 2 -> Valid
+//		"JPY", 0 -> Valid
+//		"JPY", 2 -> Error
+//		"ZZZ", 2 -> Error
+//
+// If 'currencyCode' or 'minorUnitDigits' is invalid, an
+// error is returned.
+func (curAmtElectron *currencyAmountElectron) testMinorUnitDigits(
+	currencyCode string,
+	minorUnitDigits uint,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if curAmtElectron.lock == nil {
+		curAmtElectron.lock = new(sync.Mutex)
+	}
+
+	curAmtElectron.lock.Lock()
+
+	defer curAmtElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"currencyAmountElectron."+
+			"testMinorUnitDigits()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	var curISO4217Spec CurrencyISO4217Spec
+
+	err = new(currencyISO4217SpecAtom).setFromCode(
+		&curISO4217Spec,
+		currencyCode,
+		ePrefix.XCpy(
+			"curISO4217Spec<-currencyCode"))
+
+	if err != nil {
+		return err
+	}
+
+	if minorUnitDigits != curISO4217Spec.MinorUnits {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'minorUnitDigits' is invalid!\n"+
+			"'minorUnitDigits' does not match the ISO 4217 minor\n"+
+			"units for the currency.\n"+
+			"currencyCode         = '%v'\n"+
+			"minorUnitDigits      = '%v'\n"+
+			"ISO 4217 Minor Units = '%v'\n",
+			ePrefix.String(),
+			currencyCode,
+			minorUnitDigits,
+			curISO4217Spec.MinorUnits)
+
+		return err
+	}
+
+	return err
+}
@@ -0,0 +1,712 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
+
+// currencyAmountNanobot - Provides helper methods for
+// type CurrencyAmount.
+type currencyAmountNanobot struct {
+	lock *sync.Mutex
+}
+
+// convert
+//
+// Converts the currency amount contained in input
+// parameter 'sourceCurAmt' to an equivalent amount
+// denominated in the currency specified by input
+// parameter 'targetCurrencyCode'. The converted amount
+// is stored in input parameter 'targetCurAmt'.
+//
+// The exchange rate is extracted from the offline rate
+// table passed as input parameter 'rateTable'. If the
+// rate table contains a rate for the source/target
+// currency pair, the source amount is multiplied by
+// that rate. If the rate table only contains a rate for
+// the target/source currency pair, the source amount is
+// divided by that rate.
+//
+// The converted amount is rounded to the number of
+// minor unit digits specified by input parameter
+// 'targetMinorUnitDigits' using the rounding algorithm
+// specified by input parameter 'roundingType'. If the
+// converted amount contains fewer fractional digits
+// than 'targetMinorUnitDigits', trailing fractional
+// zeros are added.
+//
+// If the source and target currency codes are
+// identical, no exchange rate is required and the
+// source amount is simply rounded to
+// 'targetMinorUnitDigits'.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// All the data fields in 'targetCurAmt' will be deleted
+// and overwritten.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	targetCurAmt				*CurrencyAmount
+//
+//		A pointer to an instance of CurrencyAmount. The
+//		converted currency amount will be stored in this
+//		instance. If this method returns an error,
+//		'targetCurAmt' will not be modified.
+//
+//	sourceCurAmt				*CurrencyAmount
+//
+//		A pointer to an instance of CurrencyAmount
+//		containing the currency amount to be converted.
+//		This instance will NOT be modified.
+//
+//	targetCurrencyCode			string
+//
+//		The three character ISO 4217 alphabetic currency
+//		code identifying the currency to which
+//		'sourceCurAmt' will be converted.
+//
+//	targetMinorUnitDigits		uint
+//
+//		The number of minor unit, or fractional, digits
+//		used by the target currency. This value must
+//		match the ISO 4217 minor units for the target
+//		currency.
+//
+//	rateTable					*CurrencyRateTable
+//
+//		A pointer to the offline exchange rate table
+//		supplying the conversion rate.
+//
+//	roundingType				NumberRoundingType
+//
+//		The rounding algorithm applied to the converted
+//		amount. NumRoundType.None() and
+//		NumRoundType.NoRounding() are invalid for
+//		currency conversions.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+func (curAmtNanobot *currencyAmountNanobot) convert(
+	targetCurAmt *CurrencyAmount,
+	sourceCurAmt *CurrencyAmount,
+	targetCurrencyCode string,
+	targetMinorUnitDigits uint,
+	rateTable *CurrencyRateTable,
+	roundingType NumberRoundingType,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if curAmtNanobot.lock == nil {
+		curAmtNanobot.lock = new(sync.Mutex)
+	}
+
+	curAmtNanobot.lock.Lock()
+
+	defer curAmtNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"currencyAmountNanobot."+
+			"convert()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if targetCurAmt == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'targetCurAmt' is a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if sourceCurAmt == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sourceCurAmt' is a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if len(sourceCurAmt.currencyCode) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sourceCurAmt' is invalid!\n"+
+			"'sourceCurAmt' has NOT been assigned a currency code.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if !roundingType.XIsValid() ||
+		roundingType == NumRoundType.NoRounding() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'roundingType' is invalid!\n"+
+			"Currency conversions require an explicit rounding type.\n"+
+			"roundingType string value  = '%v'\n"+
+			"roundingType integer value = '%v'\n",
+			ePrefix.String(),
+			roundingType.String(),
+			roundingType.XValueInt())
+
+		return err
+	}
+
+	curAmtElectron := currencyAmountElectron{}
+
+	targetCurrencyCode,
+		err = curAmtElectron.testCurrencyCode(
+		targetCurrencyCode,
+		ePrefix.XCpy(
+			"targetCurrencyCode"))
+
+	if err != nil {
+		return err
+	}
+
+	err = curAmtElectron.testMinorUnitDigits(
+		targetCurrencyCode,
+		targetMinorUnitDigits,
+		ePrefix.XCpy(
+			"targetMinorUnitDigits"))
+
+	if err != nil {
+		return err
+	}
+
+	bigDecNanobot := bigDecimalNanobot{}
+
+	var sourceBigDec, convertedBigDec BigDecimal
+
+	err = bigDecNanobot.setFromNumStrKernel(
+		&sourceBigDec,
+		&sourceCurAmt.amount,
+		ePrefix.XCpy(
+			"sourceBigDec<-sourceCurAmt.amount"))
+
+	if err != nil {
+		return err
+	}
+
+	if targetCurrencyCode == sourceCurAmt.currencyCode {
+
+		convertedBigDec = sourceBigDec
+
+	} else {
+
+		if rateTable == nil {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'rateTable' is a nil pointer!\n",
+				ePrefix.String())
+
+			return err
+		}
+
+		rate,
+			isInverse,
+			found := new(currencyRateTableElectron).getRate(
+			rateTable,
+			sourceCurAmt.currencyCode,
+			targetCurrencyCode)
+
+		if !found {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: The rate table does NOT contain an exchange\n"+
+				"rate for the requested currency conversion.\n"+
+				"Source Currency Code = '%v'\n"+
+				"Target Currency Code = '%v'\n",
+				ePrefix.String(),
+				sourceCurAmt.currencyCode,
+				targetCurrencyCode)
+
+			return err
+		}
+
+		if isInverse {
+
+			err = bigDecNanobot.divide(
+				&convertedBigDec,
+				&sourceBigDec,
+				&rate,
+				int(targetMinorUnitDigits),
+				roundingType,
+				ePrefix.XCpy(
+					"convertedBigDec<-sourceBigDec/rate"))
+
+		} else {
+
+			err = bigDecNanobot.multiply(
+				&convertedBigDec,
+				&sourceBigDec,
+				&rate,
+				ePrefix.XCpy(
+					"convertedBigDec<-sourceBigDec*rate"))
+
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	err = bigDecNanobot.round(
+		&convertedBigDec,
+		roundingType,
+		int(targetMinorUnitDigits),
+		ePrefix.XCpy(
+			"convertedBigDec"))
+
+	if err != nil {
+		return err
+	}
+
+	var convertedAmount NumberStrKernel
+
+	convertedAmount,
+		err = bigDecNanobot.getNumStrKernel(
+		&convertedBigDec,
+		ePrefix.XCpy(
+			"convertedAmount<-convertedBigDec"))
+
+	if err != nil {
+		return err
+	}
+
+	// Pad the fractional digits out to the
+	// number of target minor unit digits.
+	err = new(numberStrKernelQuark).roundNumStrKernel(
+		&convertedAmount,
+		roundingType,
+		int(targetMinorUnitDigits),
+		ePrefix.XCpy(
+			"convertedAmount"))
+
+	if err != nil {
+		return err
+	}
+
+	targetCurAmt.amount = convertedAmount
+
+	targetCurAmt.currencyCode = targetCurrencyCode
+
+	targetCurAmt.minorUnitDigits = targetMinorUnitDigits
+
+	return err
+}
+
+// formatCurrencyNumStr
+//
+// Returns a currency number string for the instance of
+// CurrencyAmount passed as input parameter 'curAmt'.
+//
+// The Country Culture Specification used to format the
+// number string is selected automatically using the
+// currency code of 'curAmt'. If no Country Culture
+// Specification uses the currency, the format is taken
+// from the ISO 4217 currency registry. Reference type
+// CurrencyISO4217Spec.
+//
+// The numeric value is rounded exactly to the number of
+// minor unit digits configured for 'curAmt' before it
+// is formatted. The amount stored in 'curAmt' is NOT
+// modified.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	curAmt						*CurrencyAmount
+//
+//		A pointer to an instance of CurrencyAmount. The
+//		currency amount will be formatted as a currency
+//		number string. This instance will NOT be
+//		modified.
+//
+//	numberFieldSpec				NumStrNumberFieldSpec
+//
+//		This Number Field Specification contains the
+//		field length and text justification parameters
+//		applied to the formatted number string.
+//
+//	roundingType				NumberRoundingType
+//
+//		The rounding algorithm applied when rounding the
+//		currency amount to the configured number of minor
+//		unit digits. NumRoundType.None() and
+//		NumRoundType.NoRounding() are invalid for
+//		currency amounts.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	numStr						string
+//
+//		If this method completes successfully, this
+//		parameter will return the formatted currency
+//		number string.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+func (curAmtNanobot *currencyAmountNanobot) formatCurrencyNumStr(
+	curAmt *CurrencyAmount,
+	numberFieldSpec NumStrNumberFieldSpec,
+	roundingType NumberRoundingType,
+	errPrefDto *ePref.ErrPrefixDto) (
+	numStr string,
+	err error) {
+
+	if curAmtNanobot.lock == nil {
+		curAmtNanobot.lock = new(sync.Mutex)
+	}
+
+	curAmtNanobot.lock.Lock()
+
+	defer curAmtNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"currencyAmountNanobot."+
+			"formatCurrencyNumStr()",
+		"")
+
+	if err != nil {
+		return numStr, err
+	}
+
+	if curAmt == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'curAmt' is a nil pointer!\n",
+			ePrefix.String())
+
+		return numStr, err
+	}
+
+	if !roundingType.XIsValid() ||
+		roundingType == NumRoundType.NoRounding() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'roundingType' is invalid!\n"+
+			"Currency amounts require an explicit rounding type.\n"+
+			"roundingType string value  = '%v'\n"+
+			"roundingType integer value = '%v'\n",
+			ePrefix.String(),
+			roundingType.String(),
+			roundingType.XValueInt())
+
+		return numStr, err
+	}
+
+	bigDecNanobot := bigDecimalNanobot{}
+
+	var roundedBigDec BigDecimal
+
+	err = bigDecNanobot.setFromNumStrKernel(
+		&roundedBigDec,
+		&curAmt.amount,
+		ePrefix.XCpy(
+			"roundedBigDec<-curAmt.amount"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	// Round exactly to the number of minor unit digits.
+	err = bigDecNanobot.round(
+		&roundedBigDec,
+		roundingType,
+		int(curAmt.minorUnitDigits),
+		ePrefix.XCpy(
+			"roundedBigDec"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	var roundedAmount NumberStrKernel
+
+	roundedAmount,
+		err = bigDecNanobot.getNumStrKernel(
+		&roundedBigDec,
+		ePrefix.XCpy(
+			"roundedAmount<-roundedBigDec"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	// Pad the fractional digits out to the
+	// number of minor unit digits.
+	err = new(numberStrKernelQuark).roundNumStrKernel(
+		&roundedAmount,
+		NumRoundType.Truncate(),
+		int(curAmt.minorUnitDigits),
+		ePrefix.XCpy(
+			"roundedAmount"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	var roundingSpec NumStrRoundingSpec
+
+	err = new(numStrRoundingSpecNanobot).
+		setNStrNStrRoundingSpec(
+			&roundingSpec,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"roundingSpec"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	var countryCultureSpec NumStrFmtCountryCultureSpec
+	var foundCountryCulture bool
+
+	countryCultureSpec,
+		foundCountryCulture,
+		err = new(currencyAmountElectron).lookupCountryCultureSpec(
+		curAmt.currencyCode,
+		ePrefix.XCpy(
+			"countryCultureSpec<-curAmt.currencyCode"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	var numStrFmtSpec NumStrFormatSpec
+
+	if foundCountryCulture {
+
+		numStrFmtSpec,
+			err = new(NumStrFormatSpec).NewCountryCurrencyNumFormat(
+			countryCultureSpec,
+			numberFieldSpec,
+			ePrefix.XCpy(
+				"numStrFmtSpec<-countryCultureSpec"))
+
+	} else {
+
+		numStrFmtSpec,
+			err = new(NumStrFormatSpec).NewCurrencyISO4217(
+			curAmt.currencyCode,
+			numberFieldSpec,
+			ePrefix.XCpy(
+				"numStrFmtSpec<-curAmt.currencyCode"))
+
+	}
+
+	if err != nil {
+		return numStr, err
+	}
+
+	return new(numberStrKernelMolecule).
+		formatNumStr(
+			&roundedAmount,
+			roundingSpec,
+			numStrFmtSpec,
+			ePrefix.XCpy("curAmt.amount"))
+}
+
+// setCurrencyAmount
+//
+// Deletes and overwrites all the data values contained
+// in input parameter 'curAmt' using the numeric amount,
+// currency code and minor unit digits passed as input
+// parameters.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	curAmt						*CurrencyAmount
+//
+//		A pointer to an instance of CurrencyAmount. All
+//		data values in this instance will be deleted and
+//		overwritten. If this method returns an error,
+//		'curAmt' will not be modified.
+//
+//	amount						*NumberStrKernel
+//
+//		The numeric value of the currency amount. A deep
+//		copy of this instance is stored in 'curAmt'.
+//
+//	currencyCode				string
+//
+//		The three character ISO 4217 alphabetic currency
+//		code. Lower case characters are converted to
+//		upper case. The code must be listed in the ISO
+//		4217 currency registry.
+//
+//	minorUnitDigits				uint
+//
+//		The number of minor unit, or fractional, digits
+//		used by the currency. This value must match the
+//		ISO 4217 minor units for the currency.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+func (curAmtNanobot *currencyAmountNanobot) setCurrencyAmount(
+	curAmt *CurrencyAmount,
+	amount *NumberStrKernel,
+	currencyCode string,
+	minorUnitDigits uint,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if curAmtNanobot.lock == nil {
+		curAmtNanobot.lock = new(sync.Mutex)
+	}
+
+	curAmtNanobot.lock.Lock()
+
+	defer curAmtNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"currencyAmountNanobot."+
+			"setCurrencyAmount()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if curAmt == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'curAmt' is a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if amount == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'amount' is a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	curAmtElectron := currencyAmountElectron{}
+
+	currencyCode,
+		err = curAmtElectron.testCurrencyCode(
+		currencyCode,
+		ePrefix.XCpy(
+			"currencyCode"))
+
+	if err != nil {
+		return err
+	}
+
+	err = curAmtElectron.testMinorUnitDigits(
+		currencyCode,
+		minorUnitDigits,
+		ePrefix.XCpy(
+			"minorUnitDigits"))
+
+	if err != nil {
+		return err
+	}
+
+	var newAmount NumberStrKernel
+
+	err = new(numberStrKernelNanobot).copy(
+		&newAmount,
+		amount,
+		ePrefix.XCpy(
+			"newAmount<-amount"))
+
+	if err != nil {
+		return err
+	}
+
+	curAmt.amount = newAmount
+
+	curAmt.currencyCode = currencyCode
+
+	curAmt.minorUnitDigits = minorUnitDigits
+
+	return err
+}
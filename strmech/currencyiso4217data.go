@@ -0,0 +1,425 @@
+package strmech
+
+import "sync"
+
+//	ISO 4217 Currency Codes
+//
+//	ISO 4217 is the international standard published by
+//	the International Organization for Standardization
+//	(ISO) which defines alphabetic and numeric codes for
+//	the representation of currencies.
+//
+//	Each currency is identified by a three character
+//	alphabetic code and a three digit numeric code.
+//	The first two characters of the alphabetic code are
+//	usually the ISO 3166-1 alpha-2 country code. The
+//	third character is usually the first letter of the
+//	currency name.
+//
+//		Example: "CHF" - Switzerland (CH) Franc (F)
+//
+//	ISO 4217 also specifies the number of minor unit, or
+//	fractional, digits used by each currency.
+//
+//		Currency	Numeric		Minor
+//		  Code		 Code		Units		Example
+//		--------	-------		-----		-------------
+//		  JPY		  392		  0			¥1,235
+//		  USD		  840		  2			$1,234.57
+//		  KWD		  414		  3			KD 1,234.568
+//		  CLF		  990		  4			UF 1.234,5678
+//
+//	The table 'iso4217CurrencyRecords' contains the
+//	active currency codes listed in ISO 4217 List One,
+//	including fund codes. Precious metal codes (XAU,
+//	XAG, XPD, XPT), bond market units (XBA - XBD),
+//	supranational units (XDR, XSU, XUA) and the testing
+//	and 'no currency' codes (XTS, XXX) do not have minor
+//	units and are not included.
+//
+//	In addition to the ISO 4217 data, each record
+//	specifies the currency symbol, symbol placement,
+//	decimal separator, integer separator and integer
+//	grouping typically used when formatting amounts in
+//	that currency. These formatting parameters reflect
+//	the most common usage in the issuing country and
+//	may differ from usage in other countries.
+//
+//	Reference:
+//		https://www.iso.org/iso-4217-currency-codes.html
+//		https://en.wikipedia.org/wiki/ISO_4217
+
+var lockISO4217CurrencyRecords = sync.Mutex{}
+
+// iso4217CurrencyRecord - Holds the ISO 4217 data and
+// typical formatting parameters for a single currency.
+type iso4217CurrencyRecord struct {
+	alphabeticCode string
+	// The three character ISO 4217 alphabetic code
+
+	numericCode string
+	// The three digit ISO 4217 numeric code
+
+	minorUnits uint
+	// The number of minor unit, or fractional, digits
+
+	currencyName string
+	// The ISO 4217 currency name
+
+	currencySymbol string
+	// The currency symbol typically used in formatting
+
+	symbolAfterNumber bool
+	// If 'true', the currency symbol follows the
+	// number. Otherwise, the symbol precedes the
+	// number.
+
+	symbolSpaceDelimited bool
+	// If 'true', a space separates the currency
+	// symbol from the number.
+
+	decimalSeparator string
+	// The decimal separator character
+
+	integerSeparator string
+	// The integer or 'thousands' separator character
+
+	useIndiaNumbering bool
+	// If 'true', integer digits are grouped using
+	// the India Numbering System. Otherwise, integer
+	// digits are grouped in thousands.
+}
+
+// iso4217CurrencyRecords - Contains the ISO 4217
+// currency records in alphabetical order by alphabetic
+// currency code.
+var iso4217CurrencyRecords = []iso4217CurrencyRecord{
+	{"AED", "784", 2, "UAE Dirham", "AED",
+		false, true, ".", ",", false},
+	{"AFN", "971", 2, "Afghani", "؋",
+		false, false, ".", ",", false},
+	{"ALL", "008", 2, "Lek", "L",
+		true, true, ",", " ", false},
+	{"AMD", "051", 2, "Armenian Dram", "֏",
+		true, true, ",", " ", false},
+	{"AOA", "973", 2, "Kwanza", "Kz",
+		true, true, ",", " ", false},
+	{"ARS", "032", 2, "Argentine Peso", "$",
+		false, true, ",", ".", false},
+	{"AUD", "036", 2, "Australian Dollar", "$",
+		false, false, ".", ",", false},
+	{"AWG", "533", 2, "Aruban Florin", "ƒ",
+		false, false, ".", ",", false},
+	{"AZN", "944", 2, "Azerbaijan Manat", "₼",
+		true, true, ",", " ", false},
+	{"BAM", "977", 2, "Convertible Mark", "KM",
+		true, true, ",", ".", false},
+	{"BBD", "052", 2, "Barbados Dollar", "$",
+		false, false, ".", ",", false},
+	{"BDT", "050", 2, "Taka", "৳",
+		false, false, ".", ",", true},
+	{"BHD", "048", 3, "Bahraini Dinar", "BD",
+		false, true, ".", ",", false},
+	{"BIF", "108", 0, "Burundi Franc", "FBu",
+		true, true, ",", " ", false},
+	{"BMD", "060", 2, "Bermudian Dollar", "$",
+		false, false, ".", ",", false},
+	{"BND", "096", 2, "Brunei Dollar", "$",
+		false, false, ".", ",", false},
+	{"BOB", "068", 2, "Boliviano", "Bs",
+		false, true, ",", ".", false},
+	{"BOV", "984", 2, "Mvdol", "BOV",
+		false, true, ",", ".", false},
+	{"BRL", "986", 2, "Brazilian Real", "R$",
+		false, true, ",", ".", false},
+	{"BSD", "044", 2, "Bahamian Dollar", "$",
+		false, false, ".", ",", false},
+	{"BTN", "064", 2, "Ngultrum", "Nu.",
+		false, true, ".", ",", true},
+	{"BWP", "072", 2, "Pula", "P",
+		false, false, ".", ",", false},
+	{"BYN", "933", 2, "Belarusian Ruble", "Br",
+		true, true, ",", " ", false},
+	{"BZD", "084", 2, "Belize Dollar", "BZ$",
+		false, false, ".", ",", false},
+	{"CAD", "124", 2, "Canadian Dollar", "$",
+		false, false, ".", ",", false},
+	{"CDF", "976", 2, "Congolese Franc", "FC",
+		true, true, ",", " ", false},
+	{"CHE", "947", 2, "WIR Euro", "CHE",
+		false, true, ".", "'", false},
+	{"CHF", "756", 2, "Swiss Franc", "CHF",
+		false, true, ".", "'", false},
+	{"CHW", "948", 2, "WIR Franc", "CHW",
+		false, true, ".", "'", false},
+	{"CLF", "990", 4, "Unidad de Fomento", "UF",
+		false, true, ",", ".", false},
+	{"CLP", "152", 0, "Chilean Peso", "$",
+		false, false, ",", ".", false},
+	{"CNY", "156", 2, "Yuan Renminbi", "¥",
+		false, false, ".", ",", false},
+	{"COP", "170", 2, "Colombian Peso", "$",
+		false, true, ",", ".", false},
+	{"COU", "970", 2, "Unidad de Valor Real", "COU",
+		false, true, ",", ".", false},
+	{"CRC", "188", 2, "Costa Rican Colon", "₡",
+		false, false, ",", " ", false},
+	{"CUP", "192", 2, "Cuban Peso", "$",
+		false, false, ".", ",", false},
+	{"CVE", "132", 2, "Cabo Verde Escudo", "Esc",
+		true, true, ",", " ", false},
+	{"CZK", "203", 2, "Czech Koruna", "Kč",
+		true, true, ",", " ", false},
+	{"DJF", "262", 0, "Djibouti Franc", "Fdj",
+		true, true, ",", " ", false},
+	{"DKK", "208", 2, "Danish Krone", "kr.",
+		true, true, ",", ".", false},
+	{"DOP", "214", 2, "Dominican Peso", "RD$",
+		false, false, ".", ",", false},
+	{"DZD", "012", 2, "Algerian Dinar", "DA",
+		true, true, ",", " ", false},
+	{"EGP", "818", 2, "Egyptian Pound", "E£",
+		false, true, ".", ",", false},
+	{"ERN", "232", 2, "Nakfa", "Nfk",
+		false, true, ".", ",", false},
+	{"ETB", "230", 2, "Ethiopian Birr", "Br",
+		false, true, ".", ",", false},
+	{"EUR", "978", 2, "Euro", "€",
+		true, true, ",", ".", false},
+	{"FJD", "242", 2, "Fiji Dollar", "$",
+		false, false, ".", ",", false},
+	{"FKP", "238", 2, "Falkland Islands Pound", "£",
+		false, false, ".", ",", false},
+	{"GBP", "826", 2, "Pound Sterling", "£",
+		false, false, ".", ",", false},
+	{"GEL", "981", 2, "Lari", "₾",
+		true, true, ",", " ", false},
+	{"GHS", "936", 2, "Ghana Cedi", "GH₵",
+		false, false, ".", ",", false},
+	{"GIP", "292", 2, "Gibraltar Pound", "£",
+		false, false, ".", ",", false},
+	{"GMD", "270", 2, "Dalasi", "D",
+		false, false, ".", ",", false},
+	{"GNF", "324", 0, "Guinean Franc", "FG",
+		true, true, ",", " ", false},
+	{"GTQ", "320", 2, "Quetzal", "Q",
+		false, false, ".", ",", false},
+	{"GYD", "328", 2, "Guyana Dollar", "$",
+		false, false, ".", ",", false},
+	{"HKD", "344", 2, "Hong Kong Dollar", "HK$",
+		false, false, ".", ",", false},
+	{"HNL", "340", 2, "Lempira", "L",
+		false, false, ".", ",", false},
+	{"HTG", "332", 2, "Gourde", "G",
+		false, true, ".", ",", false},
+	{"HUF", "348", 2, "Forint", "Ft",
+		true, true, ",", " ", false},
+	{"IDR", "360", 2, "Rupiah", "Rp",
+		false, false, ",", ".", false},
+	{"ILS", "376", 2, "New Israeli Sheqel", "₪",
+		false, false, ".", ",", false},
+	{"INR", "356", 2, "Indian Rupee", "₹",
+		false, false, ".", ",", true},
+	{"IQD", "368", 3, "Iraqi Dinar", "IQD",
+		false, true, ".", ",", false},
+	{"IRR", "364", 2, "Iranian Rial", "IRR",
+		false, true, ".", ",", false},
+	{"ISK", "352", 0, "Iceland Krona", "kr",
+		true, true, ",", ".", false},
+	{"JMD", "388", 2, "Jamaican Dollar", "$",
+		false, false, ".", ",", false},
+	{"JOD", "400", 3, "Jordanian Dinar", "JD",
+		false, true, ".", ",", false},
+	{"JPY", "392", 0, "Yen", "¥",
+		false, false, ".", ",", false},
+	{"KES", "404", 2, "Kenyan Shilling", "KSh",
+		false, true, ".", ",", false},
+	{"KGS", "417", 2, "Som", "сом",
+		true, true, ",", " ", false},
+	{"KHR", "116", 2, "Riel", "៛",
+		true, false, ".", ",", false},
+	{"KMF", "174", 0, "Comorian Franc", "CF",
+		true, true, ",", " ", false},
+	{"KPW", "408", 2, "North Korean Won", "₩",
+		false, false, ".", ",", false},
+	{"KRW", "410", 0, "Won", "₩",
+		false, false, ".", ",", false},
+	{"KWD", "414", 3, "Kuwaiti Dinar", "KD",
+		false, true, ".", ",", false},
+	{"KYD", "136", 2, "Cayman Islands Dollar", "$",
+		false, false, ".", ",", false},
+	{"KZT", "398", 2, "Tenge", "₸",
+		true, true, ",", " ", false},
+	{"LAK", "418", 2, "Lao Kip", "₭",
+		false, false, ",", ".", false},
+	{"LBP", "422", 2, "Lebanese Pound", "LBP",
+		false, true, ".", ",", false},
+	{"LKR", "144", 2, "Sri Lanka Rupee", "Rs",
+		false, true, ".", ",", false},
+	{"LRD", "430", 2, "Liberian Dollar", "$",
+		false, false, ".", ",", false},
+	{"LSL", "426", 2, "Loti", "L",
+		false, true, ".", ",", false},
+	{"LYD", "434", 3, "Libyan Dinar", "LD",
+		false, true, ".", ",", false},
+	{"MAD", "504", 2, "Moroccan Dirham", "DH",
+		true, true, ",", " ", false},
+	{"MDL", "498", 2, "Moldovan Leu", "L",
+		true, true, ",", " ", false},
+	{"MGA", "969", 2, "Malagasy Ariary", "Ar",
+		true, true, ",", " ", false},
+	{"MKD", "807", 2, "Denar", "ден",
+		true, true, ",", ".", false},
+	{"MMK", "104", 2, "Kyat", "K",
+		false, true, ".", ",", false},
+	{"MNT", "496", 2, "Tugrik", "₮",
+		false, false, ".", ",", false},
+	{"MOP", "446", 2, "Pataca", "MOP$",
+		false, false, ".", ",", false},
+	{"MRU", "929", 2, "Ouguiya", "UM",
+		true, true, ",", " ", false},
+	{"MUR", "480", 2, "Mauritius Rupee", "Rs",
+		false, true, ".", ",", false},
+	{"MVR", "462", 2, "Rufiyaa", "Rf",
+		false, true, ".", ",", false},
+	{"MWK", "454", 2, "Malawi Kwacha", "MK",
+		false, false, ".", ",", false},
+	{"MXN", "484", 2, "Mexican Peso", "$",
+		false, false, ".", ",", false},
+	{"MXV", "979", 2, "Mexican Unidad de Inversion (UDI)", "MXV",
+		false, true, ".", ",", false},
+	{"MYR", "458", 2, "Malaysian Ringgit", "RM",
+		false, false, ".", ",", false},
+	{"MZN", "943", 2, "Mozambique Metical", "MT",
+		true, true, ",", " ", false},
+	{"NAD", "516", 2, "Namibia Dollar", "$",
+		false, false, ".", ",", false},
+	{"NGN", "566", 2, "Naira", "₦",
+		false, false, ".", ",", false},
+	{"NIO", "558", 2, "Cordoba Oro", "C$",
+		false, false, ".", ",", false},
+	{"NOK", "578", 2, "Norwegian Krone", "kr",
+		true, true, ",", " ", false},
+	{"NPR", "524", 2, "Nepalese Rupee", "Rs",
+		false, true, ".", ",", true},
+	{"NZD", "554", 2, "New Zealand Dollar", "$",
+		false, false, ".", ",", false},
+	{"OMR", "512", 3, "Rial Omani", "OMR",
+		false, true, ".", ",", false},
+	{"PAB", "590", 2, "Balboa", "B/.",
+		false, false, ".", ",", false},
+	{"PEN", "604", 2, "Sol", "S/",
+		false, true, ".", ",", false},
+	{"PGK", "598", 2, "Kina", "K",
+		false, false, ".", ",", false},
+	{"PHP", "608", 2, "Philippine Peso", "₱",
+		false, false, ".", ",", false},
+	{"PKR", "586", 2, "Pakistan Rupee", "Rs",
+		false, true, ".", ",", false},
+	{"PLN", "985", 2, "Zloty", "zł",
+		true, true, ",", " ", false},
+	{"PYG", "600", 0, "Guarani", "₲",
+		false, true, ",", ".", false},
+	{"QAR", "634", 2, "Qatari Rial", "QR",
+		false, true, ".", ",", false},
+	{"RON", "946", 2, "Romanian Leu", "lei",
+		true, true, ",", ".", false},
+	{"RSD", "941", 2, "Serbian Dinar", "RSD",
+		true, true, ",", ".", false},
+	{"RUB", "643", 2, "Russian Ruble", "₽",
+		true, true, ",", " ", false},
+	{"RWF", "646", 0, "Rwanda Franc", "FRw",
+		false, true, ".", ",", false},
+	{"SAR", "682", 2, "Saudi Riyal", "SAR",
+		false, true, ".", ",", false},
+	{"SBD", "090", 2, "Solomon Islands Dollar", "$",
+		false, false, ".", ",", false},
+	{"SCR", "690", 2, "Seychelles Rupee", "SR",
+		false, true, ".", ",", false},
+	{"SDG", "938", 2, "Sudanese Pound", "SDG",
+		false, true, ".", ",", false},
+	{"SEK", "752", 2, "Swedish Krona", "kr",
+		true, true, ",", " ", false},
+	{"SGD", "702", 2, "Singapore Dollar", "$",
+		false, false, ".", ",", false},
+	{"SHP", "654", 2, "Saint Helena Pound", "£",
+		false, false, ".", ",", false},
+	{"SLE", "925", 2, "Leone", "Le",
+		false, true, ".", ",", false},
+	{"SOS", "706", 2, "Somali Shilling", "Sh",
+		false, true, ".", ",", false},
+	{"SRD", "968", 2, "Surinam Dollar", "$",
+		false, false, ",", ".", false},
+	{"SSP", "728", 2, "South Sudanese Pound", "£",
+		false, false, ".", ",", false},
+	{"STN", "930", 2, "Dobra", "Db",
+		true, true, ",", " ", false},
+	{"SVC", "222", 2, "El Salvador Colon", "₡",
+		false, false, ".", ",", false},
+	{"SYP", "760", 2, "Syrian Pound", "£S",
+		false, true, ".", ",", false},
+	{"SZL", "748", 2, "Lilangeni", "E",
+		false, false, ".", ",", false},
+	{"THB", "764", 2, "Baht", "฿",
+		false, false, ".", ",", false},
+	{"TJS", "972", 2, "Somoni", "SM",
+		true, true, ",", " ", false},
+	{"TMT", "934", 2, "Turkmenistan New Manat", "m",
+		true, true, ",", " ", false},
+	{"TND", "788", 3, "Tunisian Dinar", "DT",
+		true, true, ",", " ", false},
+	{"TOP", "776", 2, "Pa'anga", "T$",
+		false, false, ".", ",", false},
+	{"TRY", "949", 2, "Turkish Lira", "₺",
+		false, false, ",", ".", false},
+	{"TTD", "780", 2, "Trinidad and Tobago Dollar", "TT$",
+		false, false, ".", ",", false},
+	{"TWD", "901", 2, "New Taiwan Dollar", "NT$",
+		false, false, ".", ",", false},
+	{"TZS", "834", 2, "Tanzanian Shilling", "TSh",
+		false, true, ".", ",", false},
+	{"UAH", "980", 2, "Hryvnia", "₴",
+		true, true, ",", " ", false},
+	{"UGX", "800", 0, "Uganda Shilling", "USh",
+		false, true, ".", ",", false},
+	{"USD", "840", 2, "US Dollar", "$",
+		false, false, ".", ",", false},
+	{"USN", "997", 2, "US Dollar (Next day)", "$",
+		false, false, ".", ",", false},
+	{"UYI", "940", 0, "Uruguay Peso en Unidades Indexadas (UI)", "UYI",
+		false, true, ",", ".", false},
+	{"UYU", "858", 2, "Peso Uruguayo", "$",
+		false, true, ",", ".", false},
+	{"UYW", "927", 4, "Unidad Previsional", "UYW",
+		false, true, ",", ".", false},
+	{"UZS", "860", 2, "Uzbekistan Sum", "soʻm",
+		true, true, ",", " ", false},
+	{"VED", "926", 2, "Bolívar Soberano (Digital)", "Bs.D",
+		false, true, ",", ".", false},
+	{"VES", "928", 2, "Bolívar Soberano", "Bs.S",
+		false, true, ",", ".", false},
+	{"VND", "704", 0, "Dong", "₫",
+		true, true, ",", ".", false},
+	{"VUV", "548", 0, "Vatu", "VT",
+		true, true, ".", ",", false},
+	{"WST", "882", 2, "Tala", "WS$",
+		false, false, ".", ",", false},
+	{"XAF", "950", 0, "CFA Franc BEAC", "FCFA",
+		true, true, ",", " ", false},
+	{"XCD", "951", 2, "East Caribbean Dollar", "$",
+		false, false, ".", ",", false},
+	{"XCG", "532", 2, "Caribbean Guilder", "Cg",
+		false, false, ".", ",", false},
+	{"XOF", "952", 0, "CFA Franc BCEAO", "CFA",
+		true, true, ",", " ", false},
+	{"XPF", "953", 0, "CFP Franc", "F",
+		true, true, ",", " ", false},
+	{"YER", "886", 2, "Yemeni Rial", "YER",
+		false, true, ".", ",", false},
+	{"ZAR", "710", 2, "Rand", "R",
+		false, false, ".", ",", false},
+	{"ZMW", "967", 2, "Zambian Kwacha", "K",
+		false, false, ".", ",", false},
+	{"ZWG", "924", 2, "Zimbabwe Gold", "ZiG",
+		false, true, ".", ",", false},
+}
@@ -0,0 +1,771 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
+
+// CurrencyISO4217Spec
+//
+// Type CurrencyISO4217Spec contains the ISO 4217 data
+// for a single currency together with the formatting
+// parameters typically used to format amounts in that
+// currency.
+//
+// The package includes a built-in registry of the
+// active currency codes listed in ISO 4217. Use method
+// CurrencyISO4217Spec.NewFromCode() to look up a
+// currency by alphabetic code ("CHF") or numeric code
+// ("756").
+//
+// Method CurrencyISO4217Spec.GetNumStrFormatSpec()
+// returns a ready-to-use currency Number String Format
+// Specification (NumStrFormatSpec) configured with the
+// currency symbol, symbol placement, decimal separator,
+// integer separator and integer grouping for the
+// currency.
+//
+//	Examples:
+//		"JPY":  ¥1,235
+//		"CHF":  CHF 1'234.57
+//		"EUR":  1.234,57 €
+//		"INR":  ₹12,34,567.89
+//
+// Also reference method:
+//
+//	NumStrFormatSpec.NewCurrencyISO4217()
+type CurrencyISO4217Spec struct {
+	AlphabeticCode string
+	//	The three character ISO 4217 alphabetic currency
+	//	code. Example: "CHF"
+
+	NumericCode string
+	//	The three digit ISO 4217 numeric currency code.
+	//	Example: "756"
+
+	CurrencyName string
+	//	The ISO 4217 currency name.
+	//	Example: "Swiss Franc"
+
+	MinorUnits uint
+	//	The number of minor unit, or fractional, digits
+	//	used by the currency as specified by ISO 4217.
+	//	Examples: "JPY" = 0, "USD" = 2, "KWD" = 3
+
+	CurrencySymbol string
+	//	The currency symbol typically used when
+	//	formatting amounts in this currency. Where no
+	//	widely recognized symbol exists, the alphabetic
+	//	code is used. Examples: "$", "€", "CHF"
+
+	SymbolLocation NumericSymbolLocation
+	//	Specifies the typical placement of the currency
+	//	symbol relative to the number. This value must be
+	//	set to one of the following:
+	//		NumSymLocation.Before() - "$123.45"
+	//		NumSymLocation.After()  - "123,45 €"
+
+	SymbolSpaceDelimited bool
+	//	If this value is set to 'true', a space separates
+	//	the currency symbol and the number.
+	//		true:  "CHF 123.45"
+	//		false: "$123.45"
+
+	DecimalSeparator string
+	//	The decimal separator typically used when
+	//	formatting amounts in this currency.
+	//	Examples: ".", ","
+
+	IntegerSeparator string
+	//	The integer, or 'thousands', separator typically
+	//	used when formatting amounts in this currency.
+	//	Examples: ",", ".", " ", "'"
+
+	IntegerGrouping IntegerGroupingType
+	//	The integer grouping typically used when
+	//	formatting amounts in this currency.
+	//	Examples:
+	//		IntGroupingType.Thousands()
+	//		IntGroupingType.IndiaNumbering()
+
+	lock *sync.Mutex
+}
+
+// CopyIn
+//
+// Copies the data fields from an incoming instance of
+// CurrencyISO4217Spec ('incomingSpec') to the data
+// fields of the current CurrencyISO4217Spec instance.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// All the data fields in current CurrencyISO4217Spec
+// instance will be deleted and overwritten.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	incomingSpec				*CurrencyISO4217Spec
+//
+//		A pointer to an instance of CurrencyISO4217Spec.
+//		This method will NOT change the values of
+//		internal member variables contained in this
+//		instance.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (curISO4217Spec *CurrencyISO4217Spec) CopyIn(
+	incomingSpec *CurrencyISO4217Spec,
+	errorPrefix interface{}) (
+	err error) {
+
+	if curISO4217Spec.lock == nil {
+		curISO4217Spec.lock = new(sync.Mutex)
+	}
+
+	curISO4217Spec.lock.Lock()
+
+	defer curISO4217Spec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"CurrencyISO4217Spec."+
+			"CopyIn()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(currencyISO4217SpecAtom).copy(
+		curISO4217Spec,
+		incomingSpec,
+		ePrefix.XCpy(
+			"curISO4217Spec<-incomingSpec"))
+}
+
+// CopyOut
+//
+// Returns a deep copy of the current
+// CurrencyISO4217Spec instance.
+func (curISO4217Spec *CurrencyISO4217Spec) CopyOut() CurrencyISO4217Spec {
+
+	if curISO4217Spec.lock == nil {
+		curISO4217Spec.lock = new(sync.Mutex)
+	}
+
+	curISO4217Spec.lock.Lock()
+
+	defer curISO4217Spec.lock.Unlock()
+
+	newSpec := CurrencyISO4217Spec{}
+
+	_ = new(currencyISO4217SpecAtom).copy(
+		&newSpec,
+		curISO4217Spec,
+		nil)
+
+	return newSpec
+}
+
+// Empty
+//
+// Resets all internal member variables for the current
+// instance of CurrencyISO4217Spec to their initial or
+// zero values.
+func (curISO4217Spec *CurrencyISO4217Spec) Empty() {
+
+	if curISO4217Spec.lock == nil {
+		curISO4217Spec.lock = new(sync.Mutex)
+	}
+
+	curISO4217Spec.lock.Lock()
+
+	new(currencyISO4217SpecAtom).empty(
+		curISO4217Spec)
+
+	curISO4217Spec.lock.Unlock()
+
+	curISO4217Spec.lock = nil
+}
+
+// Equal
+//
+// Receives a pointer to another instance of
+// CurrencyISO4217Spec and proceeds to compare its
+// member variables to those of the current
+// CurrencyISO4217Spec instance in order to determine if
+// they are equivalent.
+//
+// If the member variables for both instances are equal
+// in all respects, this method returns 'true'.
+// Otherwise, this method returns 'false'.
+func (curISO4217Spec *CurrencyISO4217Spec) Equal(
+	incomingSpec *CurrencyISO4217Spec) bool {
+
+	if curISO4217Spec.lock == nil {
+		curISO4217Spec.lock = new(sync.Mutex)
+	}
+
+	curISO4217Spec.lock.Lock()
+
+	defer curISO4217Spec.lock.Unlock()
+
+	return new(currencyISO4217SpecAtom).equal(
+		curISO4217Spec,
+		incomingSpec)
+}
+
+// GetAllAlphabeticCodes
+//
+// Returns the alphabetic currency codes for all
+// currencies contained in the built-in ISO 4217
+// currency registry. The codes are returned in
+// alphabetical order.
+//
+//	Example: []string{"AED", "AFN", "ALL", ... "ZWG"}
+func (curISO4217Spec *CurrencyISO4217Spec) GetAllAlphabeticCodes() []string {
+
+	if curISO4217Spec.lock == nil {
+		curISO4217Spec.lock = new(sync.Mutex)
+	}
+
+	curISO4217Spec.lock.Lock()
+
+	defer curISO4217Spec.lock.Unlock()
+
+	lockISO4217CurrencyRecords.Lock()
+
+	defer lockISO4217CurrencyRecords.Unlock()
+
+	codes := make([]string, len(iso4217CurrencyRecords))
+
+	for i := 0; i < len(iso4217CurrencyRecords); i++ {
+		codes[i] = iso4217CurrencyRecords[i].alphabeticCode
+	}
+
+	return codes
+}
+
+// GetNumStrFormatSpec
+//
+// Returns a currency Number String Format Specification
+// (NumStrFormatSpec) configured using the currency
+// symbol, symbol placement, decimal separator, integer
+// separator and integer grouping contained in the
+// current instance of CurrencyISO4217Spec.
+//
+// Negative values are formatted with a leading minus
+// sign ('-').
+//
+//	Examples:
+//		"JPY":  ¥1,235       -¥1,235
+//		"CHF":  CHF 1'234.57 -CHF 1'234.57
+//		"EUR":  1.234,57 €   -1.234,57 €
+//
+// The returned NumStrFormatSpec does not perform
+// rounding. To round amounts to the number of minor
+// unit digits specified by ISO 4217, use the rounding
+// specification returned by method:
+//
+//	CurrencyISO4217Spec.GetNumStrRoundingSpec()
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numberFieldSpec				NumStrNumberFieldSpec
+//
+//		This Number Field Specification contains the
+//		field length and text justification parameters
+//		applied to the returned NumStrFormatSpec.
+//
+//		To format number strings without a number
+//		field, use:
+//
+//			new(NumStrNumberFieldSpec).NewNOP()
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumStrFormatSpec
+//
+//		If this method completes successfully, a new
+//		instance of NumStrFormatSpec configured for the
+//		currency will be returned.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (curISO4217Spec *CurrencyISO4217Spec) GetNumStrFormatSpec(
+	numberFieldSpec NumStrNumberFieldSpec,
+	errorPrefix interface{}) (
+	NumStrFormatSpec,
+	error) {
+
+	if curISO4217Spec.lock == nil {
+		curISO4217Spec.lock = new(sync.Mutex)
+	}
+
+	curISO4217Spec.lock.Lock()
+
+	defer curISO4217Spec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"CurrencyISO4217Spec."+
+			"GetNumStrFormatSpec()",
+		"")
+
+	if err != nil {
+		return NumStrFormatSpec{}, err
+	}
+
+	return new(currencyISO4217SpecAtom).getNumStrFormatSpec(
+		curISO4217Spec,
+		numberFieldSpec,
+		ePrefix.XCpy(
+			"curISO4217Spec"))
+}
+
+// GetNumStrRoundingSpec
+//
+// Returns a Number String Rounding Specification
+// (NumStrRoundingSpec) which rounds numeric values to
+// the number of minor unit digits specified by ISO 4217
+// for the current instance of CurrencyISO4217Spec
+// (member variable 'MinorUnits').
+//
+// Currency Number String Format Specifications returned
+// by GetNumStrFormatSpec() and
+// NumStrFormatSpec.NewCurrencyISO4217() do not perform
+// rounding. Pass the rounding specification returned by
+// this method to NumberStrKernel.FmtNumStr() in order to
+// display the correct number of fractional digits.
+//
+//	Examples:
+//		-1234567.891 "JPY" -> -¥1,234,568
+//		-1234567.891 "USD" -> -$1,234,567.89
+//		-1234567.891 "KWD" -> -KD 1,234,567.891
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	roundingType				NumberRoundingType
+//
+//		The rounding algorithm applied when rounding
+//		numeric values to the number of minor unit
+//		digits. If 'roundingType' is invalid, an error
+//		will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumStrRoundingSpec
+//
+//		If this method completes successfully, a new
+//		instance of NumStrRoundingSpec configured to
+//		round numeric values to the ISO 4217 minor unit
+//		digits of the currency will be returned.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (curISO4217Spec *CurrencyISO4217Spec) GetNumStrRoundingSpec(
+	roundingType NumberRoundingType,
+	errorPrefix interface{}) (
+	NumStrRoundingSpec,
+	error) {
+
+	if curISO4217Spec.lock == nil {
+		curISO4217Spec.lock = new(sync.Mutex)
+	}
+
+	curISO4217Spec.lock.Lock()
+
+	defer curISO4217Spec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"CurrencyISO4217Spec."+
+			"GetNumStrRoundingSpec()",
+		"")
+
+	if err != nil {
+		return NumStrRoundingSpec{}, err
+	}
+
+	return new(NumStrRoundingSpec).NewRoundingSpec(
+		roundingType,
+		int(curISO4217Spec.MinorUnits),
+		ePrefix.XCpy(
+			"curISO4217Spec.MinorUnits"))
+}
+
+// NewFromCode
+//
+// Looks up a currency in the built-in ISO 4217 currency
+// registry and returns a new, fully populated instance
+// of CurrencyISO4217Spec.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	currencyCode				string
+//
+//		The currency to look up. This may be either the
+//		three character ISO 4217 alphabetic code or the
+//		three digit ISO 4217 numeric code. Alphabetic
+//		codes are NOT case-sensitive.
+//
+//			Examples: "CHF", "chf", "756"
+//
+//		If 'currencyCode' is not found in the registry,
+//		an error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	CurrencyISO4217Spec
+//
+//		If this method completes successfully, a new
+//		instance of CurrencyISO4217Spec populated with
+//		the registry data for 'currencyCode' will be
+//		returned.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (curISO4217Spec *CurrencyISO4217Spec) NewFromCode(
+	currencyCode string,
+	errorPrefix interface{}) (
+	CurrencyISO4217Spec,
+	error) {
+
+	if curISO4217Spec.lock == nil {
+		curISO4217Spec.lock = new(sync.Mutex)
+	}
+
+	curISO4217Spec.lock.Lock()
+
+	defer curISO4217Spec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+	var newSpec CurrencyISO4217Spec
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"CurrencyISO4217Spec."+
+			"NewFromCode()",
+		"")
+
+	if err != nil {
+		return newSpec, err
+	}
+
+	err = new(currencyISO4217SpecAtom).setFromCode(
+		&newSpec,
+		currencyCode,
+		ePrefix.XCpy(
+			"newSpec<-currencyCode"))
+
+	return newSpec, err
+}
@@ -0,0 +1,441 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"strings"
+	"sync"
+)
+
+// currencyISO4217SpecAtom - Provides helper methods for
+// type CurrencyISO4217Spec.
+type currencyISO4217SpecAtom struct {
+	lock *sync.Mutex
+}
+
+// copy
+//
+// Copies all data from input parameter 'sourceSpec' to
+// input parameter 'destinationSpec'. Both instances are
+// of type CurrencyISO4217Spec.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// All the data fields in 'destinationSpec' will be
+// deleted and overwritten.
+func (curISO4217Atom *currencyISO4217SpecAtom) copy(
+	destinationSpec *CurrencyISO4217Spec,
+	sourceSpec *CurrencyISO4217Spec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if curISO4217Atom.lock == nil {
+		curISO4217Atom.lock = new(sync.Mutex)
+	}
+
+	curISO4217Atom.lock.Lock()
+
+	defer curISO4217Atom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"currencyISO4217SpecAtom."+
+			"copy()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if destinationSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'destinationSpec' is a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if sourceSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sourceSpec' is a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	destinationSpec.AlphabeticCode = sourceSpec.AlphabeticCode
+	destinationSpec.NumericCode = sourceSpec.NumericCode
+	destinationSpec.CurrencyName = sourceSpec.CurrencyName
+	destinationSpec.MinorUnits = sourceSpec.MinorUnits
+	destinationSpec.CurrencySymbol = sourceSpec.CurrencySymbol
+	destinationSpec.SymbolLocation = sourceSpec.SymbolLocation
+	destinationSpec.SymbolSpaceDelimited = sourceSpec.SymbolSpaceDelimited
+	destinationSpec.DecimalSeparator = sourceSpec.DecimalSeparator
+	destinationSpec.IntegerSeparator = sourceSpec.IntegerSeparator
+	destinationSpec.IntegerGrouping = sourceSpec.IntegerGrouping
+
+	return err
+}
+
+// empty
+//
+// Resets all internal member variables for the instance
+// of CurrencyISO4217Spec passed as input parameter
+// 'curISO4217Spec' to their initial or zero values.
+func (curISO4217Atom *currencyISO4217SpecAtom) empty(
+	curISO4217Spec *CurrencyISO4217Spec) {
+
+	if curISO4217Atom.lock == nil {
+		curISO4217Atom.lock = new(sync.Mutex)
+	}
+
+	curISO4217Atom.lock.Lock()
+
+	defer curISO4217Atom.lock.Unlock()
+
+	if curISO4217Spec == nil {
+		return
+	}
+
+	curISO4217Spec.AlphabeticCode = ""
+	curISO4217Spec.NumericCode = ""
+	curISO4217Spec.CurrencyName = ""
+	curISO4217Spec.MinorUnits = 0
+	curISO4217Spec.CurrencySymbol = ""
+	curISO4217Spec.SymbolLocation = NumSymLocation.None()
+	curISO4217Spec.SymbolSpaceDelimited = false
+	curISO4217Spec.DecimalSeparator = ""
+	curISO4217Spec.IntegerSeparator = ""
+	curISO4217Spec.IntegerGrouping = IntGroupingType.None()
+
+	return
+}
+
+// equal
+//
+// Receives pointers to two instances of
+// CurrencyISO4217Spec and proceeds to compare their
+// member variables in order to determine if they are
+// equivalent.
+//
+// If the member variables for both instances are equal
+// in all respects, this method returns 'true'.
+// Otherwise, this method returns 'false'.
+func (curISO4217Atom *currencyISO4217SpecAtom) equal(
+	curISO4217Spec1 *CurrencyISO4217Spec,
+	curISO4217Spec2 *CurrencyISO4217Spec) bool {
+
+	if curISO4217Atom.lock == nil {
+		curISO4217Atom.lock = new(sync.Mutex)
+	}
+
+	curISO4217Atom.lock.Lock()
+
+	defer curISO4217Atom.lock.Unlock()
+
+	if curISO4217Spec1 == nil ||
+		curISO4217Spec2 == nil {
+
+		return false
+	}
+
+	return curISO4217Spec1.AlphabeticCode == curISO4217Spec2.AlphabeticCode &&
+		curISO4217Spec1.NumericCode == curISO4217Spec2.NumericCode &&
+		curISO4217Spec1.CurrencyName == curISO4217Spec2.CurrencyName &&
+		curISO4217Spec1.MinorUnits == curISO4217Spec2.MinorUnits &&
+		curISO4217Spec1.CurrencySymbol == curISO4217Spec2.CurrencySymbol &&
+		curISO4217Spec1.SymbolLocation == curISO4217Spec2.SymbolLocation &&
+		curISO4217Spec1.SymbolSpaceDelimited == curISO4217Spec2.SymbolSpaceDelimited &&
+		curISO4217Spec1.DecimalSeparator == curISO4217Spec2.DecimalSeparator &&
+		curISO4217Spec1.IntegerSeparator == curISO4217Spec2.IntegerSeparator &&
+		curISO4217Spec1.IntegerGrouping == curISO4217Spec2.IntegerGrouping
+}
+
+// getNumStrFormatSpec
+//
+// Creates and returns a currency Number String Format
+// Specification (NumStrFormatSpec) configured from the
+// symbol, separator and grouping parameters contained
+// in input parameter 'curISO4217Spec'.
+//
+// Negative values are formatted with a leading minus
+// sign ('-') positioned outside the currency symbol.
+//
+//	Examples:
+//		"CHF": "CHF 1'234.57"  "-CHF 1'234.57"
+//		"JPY": "¥1,235"        "-¥1,235"
+//		"EUR": "1.234,57 €"    "-1.234,57 €"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	curISO4217Spec				*CurrencyISO4217Spec
+//
+//		A pointer to an instance of CurrencyISO4217Spec.
+//		The formatting parameters contained in this
+//		instance will be used to configure the returned
+//		NumStrFormatSpec. This instance will NOT be
+//		modified.
+//
+//	numberFieldSpec				NumStrNumberFieldSpec
+//
+//		This Number Field Specification contains the
+//		field length and text justification parameters
+//		applied to the returned NumStrFormatSpec.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	newNumStrFmtSpec			NumStrFormatSpec
+//
+//		If this method completes successfully, a new
+//		instance of NumStrFormatSpec configured for the
+//		currency will be returned.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+func (curISO4217Atom *currencyISO4217SpecAtom) getNumStrFormatSpec(
+	curISO4217Spec *CurrencyISO4217Spec,
+	numberFieldSpec NumStrNumberFieldSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	newNumStrFmtSpec NumStrFormatSpec,
+	err error) {
+
+	if curISO4217Atom.lock == nil {
+		curISO4217Atom.lock = new(sync.Mutex)
+	}
+
+	curISO4217Atom.lock.Lock()
+
+	defer curISO4217Atom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"currencyISO4217SpecAtom."+
+			"getNumStrFormatSpec()",
+		"")
+
+	if err != nil {
+		return newNumStrFmtSpec, err
+	}
+
+	if curISO4217Spec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'curISO4217Spec' is a nil pointer!\n",
+			ePrefix.String())
+
+		return newNumStrFmtSpec, err
+	}
+
+	if len(curISO4217Spec.DecimalSeparator) == 0 &&
+		curISO4217Spec.MinorUnits > 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: 'curISO4217Spec.DecimalSeparator' is invalid!\n"+
+			"'DecimalSeparator' is empty.\n"+
+			"Currency Code = '%v'\n",
+			ePrefix.String(),
+			curISO4217Spec.AlphabeticCode)
+
+		return newNumStrFmtSpec, err
+	}
+
+	var leadingCurrencySymbol, trailingCurrencySymbol string
+
+	switch curISO4217Spec.SymbolLocation {
+
+	case NumSymLocation.Before():
+
+		leadingCurrencySymbol = curISO4217Spec.CurrencySymbol
+
+		if curISO4217Spec.SymbolSpaceDelimited {
+			leadingCurrencySymbol += " "
+		}
+
+	case NumSymLocation.After():
+
+		trailingCurrencySymbol = curISO4217Spec.CurrencySymbol
+
+		if curISO4217Spec.SymbolSpaceDelimited {
+			trailingCurrencySymbol = " " + trailingCurrencySymbol
+		}
+
+	default:
+
+		err = fmt.Errorf("%v\n"+
+			"Error: 'curISO4217Spec.SymbolLocation' is invalid!\n"+
+			"'SymbolLocation' must be equal to NumSymLocation.Before()\n"+
+			"or NumSymLocation.After().\n"+
+			"Currency Code = '%v'\n"+
+			"SymbolLocation string value  = '%v'\n"+
+			"SymbolLocation integer value = '%v'\n",
+			ePrefix.String(),
+			curISO4217Spec.AlphabeticCode,
+			curISO4217Spec.SymbolLocation.String(),
+			curISO4217Spec.SymbolLocation.XValueInt())
+
+		return newNumStrFmtSpec, err
+	}
+
+	err = new(numStrFmtSpecMechanics).
+		setCurrencyBasic(
+			&newNumStrFmtSpec,
+			[]rune(curISO4217Spec.DecimalSeparator),
+			[]rune(curISO4217Spec.IntegerSeparator),
+			curISO4217Spec.IntegerGrouping,
+			[]rune(leadingCurrencySymbol),
+			[]rune(trailingCurrencySymbol),
+			true,
+			[]rune("-"),
+			nil,
+			NumFieldSymPos.InsideNumField(),
+			numberFieldSpec.GetNumFieldLength(),
+			numberFieldSpec.GetNumFieldJustification(),
+			ePrefix.XCpy(
+				"newNumStrFmtSpec<-"+
+					curISO4217Spec.AlphabeticCode))
+
+	return newNumStrFmtSpec, err
+}
+
+// setFromCode
+//
+// Looks up the ISO 4217 currency identified by input
+// parameter 'currencyCode' and uses that data to
+// populate input parameter 'curISO4217Spec'.
+//
+// 'currencyCode' may be either the three character
+// ISO 4217 alphabetic code ("CHF") or the three digit
+// ISO 4217 numeric code ("756"). Alphabetic codes are
+// NOT case-sensitive.
+//
+// If the currency code is not found, an error is
+// returned and 'curISO4217Spec' is not modified.
+func (curISO4217Atom *currencyISO4217SpecAtom) setFromCode(
+	curISO4217Spec *CurrencyISO4217Spec,
+	currencyCode string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if curISO4217Atom.lock == nil {
+		curISO4217Atom.lock = new(sync.Mutex)
+	}
+
+	curISO4217Atom.lock.Lock()
+
+	defer curISO4217Atom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"currencyISO4217SpecAtom."+
+			"setFromCode()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if curISO4217Spec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'curISO4217Spec' is a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	searchCode := strings.ToUpper(
+		strings.TrimSpace(currencyCode))
+
+	if len(searchCode) != 3 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'currencyCode' is invalid!\n"+
+			"'currencyCode' must be a three character ISO 4217\n"+
+			"alphabetic code or a three digit numeric code.\n"+
+			"currencyCode = '%v'\n",
+			ePrefix.String(),
+			currencyCode)
+
+		return err
+	}
+
+	lockISO4217CurrencyRecords.Lock()
+
+	defer lockISO4217CurrencyRecords.Unlock()
+
+	for i := 0; i < len(iso4217CurrencyRecords); i++ {
+
+		record := &iso4217CurrencyRecords[i]
+
+		if record.alphabeticCode != searchCode &&
+			record.numericCode != searchCode {
+
+			continue
+		}
+
+		curISO4217Spec.AlphabeticCode = record.alphabeticCode
+		curISO4217Spec.NumericCode = record.numericCode
+		curISO4217Spec.CurrencyName = record.currencyName
+		curISO4217Spec.MinorUnits = record.minorUnits
+		curISO4217Spec.CurrencySymbol = record.currencySymbol
+
+		if record.symbolAfterNumber {
+			curISO4217Spec.SymbolLocation = NumSymLocation.After()
+		} else {
+			curISO4217Spec.SymbolLocation = NumSymLocation.Before()
+		}
+
+		curISO4217Spec.SymbolSpaceDelimited = record.symbolSpaceDelimited
+		curISO4217Spec.DecimalSeparator = record.decimalSeparator
+		curISO4217Spec.IntegerSeparator = record.integerSeparator
+
+		if record.useIndiaNumbering {
+			curISO4217Spec.IntegerGrouping = IntGroupingType.IndiaNumbering()
+		} else {
+			curISO4217Spec.IntegerGrouping = IntGroupingType.Thousands()
+		}
+
+		return err
+	}
+
+	err = fmt.Errorf("%v\n"+
+		"Error: Input parameter 'currencyCode' is invalid!\n"+
+		"'currencyCode' was not found in the ISO 4217 currency registry.\n"+
+		"currencyCode = '%v'\n",
+		ePrefix.String(),
+		currencyCode)
+
+	return err
+}
@@ -0,0 +1,272 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
+
+// CurrencyRateTable
+//
+// Type CurrencyRateTable is an offline table of currency
+// exchange rates supplied by the caller. It is used by
+// type CurrencyAmount to convert amounts between
+// currencies.
+//
+// No exchange rates are retrieved from external
+// sources. All rates must be added by the caller using
+// method CurrencyRateTable.AddRate().
+//
+// Each exchange rate specifies the number of units of a
+// quote currency equal to one unit of a base currency.
+// Currencies are identified by their three character
+// ISO 4217 alphabetic codes.
+//
+//	Example:
+//		Base Currency:  "USD"
+//		Quote Currency: "EUR"
+//		Rate:           0.92
+//		1 USD = 0.92 EUR
+//
+// When converting from the base currency to the quote
+// currency, amounts are multiplied by the rate. When
+// converting from the quote currency to the base
+// currency, amounts are divided by the rate. Therefore,
+// a single rate entry supports conversions in both
+// directions.
+//
+// Exchange rates are stored with exact decimal
+// precision. No floating point arithmetic is used.
+//
+// The zero value of CurrencyRateTable is an empty rate
+// table ready for use.
+type CurrencyRateTable struct {
+	rates map[string]BigDecimal
+	// Exchange rates keyed by "BASE/QUOTE"
+	// currency code pairs. Example: "USD/EUR"
+
+	lock *sync.Mutex
+}
+
+// AddRate
+//
+// Adds an exchange rate to the current instance of
+// CurrencyRateTable. If a rate for the same base/quote
+// currency pair already exists, it is replaced.
+//
+// The exchange rate specifies the number of units of the
+// quote currency equal to one unit of the base currency.
+//
+//	Example:
+//		baseCurrencyCode:  "USD"
+//		quoteCurrencyCode: "EUR"
+//		rate:              0.92
+//		1 USD = 0.92 EUR
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	baseCurrencyCode			string
+//
+//		The three character ISO 4217 alphabetic currency
+//		code for the base currency. This code is NOT
+//		case-sensitive. If the code is not listed in the
+//		ISO 4217 currency registry, an error will be
+//		returned.
+//
+//	quoteCurrencyCode			string
+//
+//		The three character ISO 4217 alphabetic currency
+//		code for the quote currency. This code is NOT
+//		case-sensitive and must be different from
+//		'baseCurrencyCode'. If the code is not listed in
+//		the ISO 4217 currency registry, an error will be
+//		returned.
+//
+//	rate						*NumberStrKernel
+//
+//		The number of units of the quote currency equal
+//		to one unit of the base currency. This value
+//		must be greater than zero.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (curRateTable *CurrencyRateTable) AddRate(
+	baseCurrencyCode string,
+	quoteCurrencyCode string,
+	rate *NumberStrKernel,
+	errorPrefix interface{}) error {
+
+	if curRateTable.lock == nil {
+		curRateTable.lock = new(sync.Mutex)
+	}
+
+	curRateTable.lock.Lock()
+
+	defer curRateTable.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"CurrencyRateTable."+
+			"AddRate()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(currencyRateTableElectron).addRate(
+		curRateTable,
+		baseCurrencyCode,
+		quoteCurrencyCode,
+		rate,
+		ePrefix.XCpy(
+			"curRateTable"))
+}
+
+// Empty
+//
+// Deletes all exchange rates contained in the current
+// instance of CurrencyRateTable.
+func (curRateTable *CurrencyRateTable) Empty() {
+
+	if curRateTable.lock == nil {
+		curRateTable.lock = new(sync.Mutex)
+	}
+
+	curRateTable.lock.Lock()
+
+	curRateTable.rates = nil
+
+	curRateTable.lock.Unlock()
+
+	curRateTable.lock = nil
+}
+
+// GetNumberOfRates
+//
+// Returns the number of exchange rates contained in the
+// current instance of CurrencyRateTable.
+func (curRateTable *CurrencyRateTable) GetNumberOfRates() int {
+
+	if curRateTable.lock == nil {
+		curRateTable.lock = new(sync.Mutex)
+	}
+
+	curRateTable.lock.Lock()
+
+	defer curRateTable.lock.Unlock()
+
+	return len(curRateTable.rates)
+}
+
+// HasRate
+//
+// Returns 'true' if the current instance of
+// CurrencyRateTable is able to convert amounts from the
+// currency identified by 'fromCurrencyCode' to the
+// currency identified by 'toCurrencyCode'.
+//
+// A conversion is possible if the rate table contains an
+// exchange rate for either the from/to currency pair or
+// the reverse to/from currency pair.
+//
+// Currency codes are NOT case-sensitive.
+func (curRateTable *CurrencyRateTable) HasRate(
+	fromCurrencyCode string,
+	toCurrencyCode string) bool {
+
+	if curRateTable.lock == nil {
+		curRateTable.lock = new(sync.Mutex)
+	}
+
+	curRateTable.lock.Lock()
+
+	defer curRateTable.lock.Unlock()
+
+	_,
+		_,
+		found := new(currencyRateTableElectron).getRate(
+		curRateTable,
+		fromCurrencyCode,
+		toCurrencyCode)
+
+	return found
+}
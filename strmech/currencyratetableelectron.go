@@ -0,0 +1,270 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"strings"
+	"sync"
+)
+
+// currencyRateTableElectron - Provides helper methods
+// used to store and retrieve the exchange rates
+// encapsulated by type CurrencyRateTable.
+type currencyRateTableElectron struct {
+	lock *sync.Mutex
+}
+
+// addRate
+//
+// Adds or replaces an exchange rate in an instance of
+// CurrencyRateTable.
+//
+// The exchange rate specifies the number of units of the
+// quote currency equal to one unit of the base currency.
+//
+//	Example:
+//		baseCurrencyCode:  "USD"
+//		quoteCurrencyCode: "EUR"
+//		rate:              0.92
+//		1 USD = 0.92 EUR
+//
+// Both currency codes must be valid three character ISO
+// 4217 alphabetic codes listed in the ISO 4217 currency
+// registry. 'rate' must be greater than zero.
+func (curRateTableElectron *currencyRateTableElectron) addRate(
+	rateTable *CurrencyRateTable,
+	baseCurrencyCode string,
+	quoteCurrencyCode string,
+	rate *NumberStrKernel,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if curRateTableElectron.lock == nil {
+		curRateTableElectron.lock = new(sync.Mutex)
+	}
+
+	curRateTableElectron.lock.Lock()
+
+	defer curRateTableElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"currencyRateTableElectron."+
+			"addRate()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if rateTable == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'rateTable' is a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if rate == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'rate' is a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	curAmtElectron := currencyAmountElectron{}
+
+	baseCurrencyCode,
+		err = curAmtElectron.testCurrencyCode(
+		baseCurrencyCode,
+		ePrefix.XCpy(
+			"baseCurrencyCode"))
+
+	if err != nil {
+		return err
+	}
+
+	quoteCurrencyCode,
+		err = curAmtElectron.testCurrencyCode(
+		quoteCurrencyCode,
+		ePrefix.XCpy(
+			"quoteCurrencyCode"))
+
+	if err != nil {
+		return err
+	}
+
+	curISO4217Atom := currencyISO4217SpecAtom{}
+
+	var curISO4217Spec CurrencyISO4217Spec
+
+	err = curISO4217Atom.setFromCode(
+		&curISO4217Spec,
+		baseCurrencyCode,
+		ePrefix.XCpy(
+			"baseCurrencyCode"))
+
+	if err != nil {
+		return err
+	}
+
+	err = curISO4217Atom.setFromCode(
+		&curISO4217Spec,
+		quoteCurrencyCode,
+		ePrefix.XCpy(
+			"quoteCurrencyCode"))
+
+	if err != nil {
+		return err
+	}
+
+	if baseCurrencyCode == quoteCurrencyCode {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The base and quote currency codes are identical!\n"+
+			"An exchange rate must specify two different currencies.\n"+
+			"baseCurrencyCode  = '%v'\n"+
+			"quoteCurrencyCode = '%v'\n",
+			ePrefix.String(),
+			baseCurrencyCode,
+			quoteCurrencyCode)
+
+		return err
+	}
+
+	var rateBigDec BigDecimal
+
+	err = new(bigDecimalNanobot).setFromNumStrKernel(
+		&rateBigDec,
+		rate,
+		ePrefix.XCpy(
+			"rateBigDec<-rate"))
+
+	if err != nil {
+		return err
+	}
+
+	if rateBigDec.GetSign() <= 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'rate' is invalid!\n"+
+			"'rate' must be greater than zero.\n"+
+			"%v/%v rate = '%v'\n",
+			ePrefix.String(),
+			baseCurrencyCode,
+			quoteCurrencyCode,
+			rateBigDec.String())
+
+		return err
+	}
+
+	if rateTable.rates == nil {
+		rateTable.rates = make(map[string]BigDecimal)
+	}
+
+	rateTable.rates[curRateTableElectron.getRateKey(
+		baseCurrencyCode,
+		quoteCurrencyCode)] = rateBigDec
+
+	return err
+}
+
+// getRate
+//
+// Returns the exchange rate used to convert amounts in
+// the base currency to amounts in the quote currency.
+//
+// If the rate table contains a rate for the
+// base/quote currency pair, that rate is returned and
+// 'isInverse' is set to 'false'. The converted amount
+// is computed by multiplying the base currency amount
+// by 'rate'.
+//
+// If the rate table only contains a rate for the
+// reverse quote/base currency pair, that rate is
+// returned and 'isInverse' is set to 'true'. The
+// converted amount is computed by dividing the base
+// currency amount by 'rate'.
+//
+// If neither currency pair is found, 'found' is set to
+// 'false'.
+//
+// Currency codes are NOT case-sensitive.
+func (curRateTableElectron *currencyRateTableElectron) getRate(
+	rateTable *CurrencyRateTable,
+	baseCurrencyCode string,
+	quoteCurrencyCode string) (
+	rate BigDecimal,
+	isInverse bool,
+	found bool) {
+
+	if curRateTableElectron.lock == nil {
+		curRateTableElectron.lock = new(sync.Mutex)
+	}
+
+	curRateTableElectron.lock.Lock()
+
+	defer curRateTableElectron.lock.Unlock()
+
+	if rateTable == nil ||
+		rateTable.rates == nil {
+
+		return rate, isInverse, found
+	}
+
+	baseCurrencyCode = strings.ToUpper(
+		strings.TrimSpace(baseCurrencyCode))
+
+	quoteCurrencyCode = strings.ToUpper(
+		strings.TrimSpace(quoteCurrencyCode))
+
+	var tableRate BigDecimal
+
+	tableRate,
+		found = rateTable.rates[curRateTableElectron.getRateKey(
+		baseCurrencyCode,
+		quoteCurrencyCode)]
+
+	if found {
+
+		rate = tableRate.CopyOut()
+
+		return rate, isInverse, found
+	}
+
+	tableRate,
+		found = rateTable.rates[curRateTableElectron.getRateKey(
+		quoteCurrencyCode,
+		baseCurrencyCode)]
+
+	if found {
+
+		rate = tableRate.CopyOut()
+
+		isInverse = true
+	}
+
+	return rate, isInverse, found
+}
+
+// getRateKey
+//
+// Returns the map key used to store the exchange rate
+// for a base/quote currency pair.
+//
+//	Example: "USD/EUR"
+//
+// This method does NOT lock the current instance of
+// currencyRateTableElectron.
+func (curRateTableElectron *currencyRateTableElectron) getRateKey(
+	baseCurrencyCode string,
+	quoteCurrencyCode string) string {
+
+	return baseCurrencyCode + "/" + quoteCurrencyCode
+}
@@ -0,0 +1,317 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"strings"
+	"sync"
+)
+
+// engNotationSIMetricsElectron - Provides helper methods
+// used to access the International System of Units (SI)
+// prefix symbols and names stored in maps
+// 'mEngNotationSISymbols' and 'mEngNotationSINames'.
+type engNotationSIMetricsElectron struct {
+	lock *sync.Mutex
+}
+
+// getSIMetricsKey
+//
+// Returns the map key used to access the SI prefix
+// symbol and name maps for a given power of ten.
+//
+// Negative exponents are formatted with the Unicode
+// minus sign ('−' U+2212) used by the map keys.
+//
+//	Examples:
+//		exponent  3 => "10^3"
+//		exponent -6 => "10^−6"
+//
+// This method does NOT lock the current instance of
+// engNotationSIMetricsElectron.
+func (engNotSIElectron *engNotationSIMetricsElectron) getSIMetricsKey(
+	exponent int) string {
+
+	if exponent < 0 {
+		return fmt.Sprintf("10^−%v",
+			-exponent)
+	}
+
+	return fmt.Sprintf("10^%v",
+		exponent)
+}
+
+// getSIPrefix
+//
+// Returns the SI prefix symbol or name associated with
+// a power of ten.
+//
+// If 'exponent' is zero, no prefix is required and an
+// empty string is returned.
+//
+//	Examples:
+//		exponent  3, useName=false  => "k"
+//		exponent  3, useName=true   => "kilo"
+//		exponent -6, useName=false  => "μ"
+//		exponent -6, useName=true   => "micro"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	exponent					int
+//
+//		The power of ten for which the SI prefix will be
+//		returned. This value must be a multiple of three
+//		in the range -24 through +24. Otherwise, an error
+//		will be returned.
+//
+//	useName						bool
+//
+//		If this parameter is set to 'true', the SI prefix
+//		name (example: "kilo") will be returned.
+//
+//		If this parameter is set to 'false', the SI
+//		prefix symbol (example: "k") will be returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	siPrefix					string
+//
+//		The SI prefix symbol or name associated with
+//		'exponent'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (engNotSIElectron *engNotationSIMetricsElectron) getSIPrefix(
+	exponent int,
+	useName bool,
+	errPrefDto *ePref.ErrPrefixDto) (
+	siPrefix string,
+	err error) {
+
+	if engNotSIElectron.lock == nil {
+		engNotSIElectron.lock = new(sync.Mutex)
+	}
+
+	engNotSIElectron.lock.Lock()
+
+	defer engNotSIElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"engNotationSIMetricsElectron."+
+			"getSIPrefix()",
+		"")
+
+	if err != nil {
+
+		return siPrefix, err
+	}
+
+	if exponent == 0 {
+
+		return siPrefix, err
+	}
+
+	key := engNotSIElectron.getSIMetricsKey(exponent)
+
+	lockEngNotationSI.Lock()
+
+	defer lockEngNotationSI.Unlock()
+
+	var ok bool
+
+	if useName {
+
+		siPrefix,
+			ok = mEngNotationSINames[key]
+
+	} else {
+
+		siPrefix,
+			ok = mEngNotationSISymbols[key]
+	}
+
+	if !ok {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'exponent' is invalid!\n"+
+			"No SI prefix exists for the power of ten '%v'.\n"+
+			"SI prefixes are only available for multiples of\n"+
+			"three in the range 10^-24 through 10^24.\n",
+			ePrefix.String(),
+			key)
+	}
+
+	return siPrefix, err
+}
+
+// getSIPrefixExponent
+//
+// Receives an SI prefix symbol or name and returns the
+// associated power of ten.
+//
+// SI prefix symbols are case-sensitive ('m' = milli,
+// 'M' = mega). SI prefix names are NOT case-sensitive.
+//
+// For the 'micro' prefix, the Greek small letter mu
+// ('μ' U+03BC), the micro sign ('µ' U+00B5) and the
+// ASCII substitute 'u' are all accepted.
+//
+// An empty 'siPrefix' string returns an exponent of
+// zero.
+//
+//	Examples:
+//		"k"      =>  3
+//		"mega"   =>  6
+//		"µ"      => -6
+//		"Milli"  => -3
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	siPrefix					string
+//
+//		The SI prefix symbol or name to be converted to a
+//		power of ten. Leading and trailing white space is
+//		ignored. If 'siPrefix' does not match a valid SI
+//		prefix, an error will be returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	exponent					int
+//
+//		The power of ten associated with 'siPrefix'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (engNotSIElectron *engNotationSIMetricsElectron) getSIPrefixExponent(
+	siPrefix string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	exponent int,
+	err error) {
+
+	if engNotSIElectron.lock == nil {
+		engNotSIElectron.lock = new(sync.Mutex)
+	}
+
+	engNotSIElectron.lock.Lock()
+
+	defer engNotSIElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"engNotationSIMetricsElectron."+
+			"getSIPrefixExponent()",
+		"")
+
+	if err != nil {
+
+		return exponent, err
+	}
+
+	siPrefix = strings.TrimSpace(siPrefix)
+
+	if len(siPrefix) == 0 {
+
+		return exponent, err
+	}
+
+	symbol := siPrefix
+
+	if symbol == "µ" ||
+		symbol == "u" {
+
+		symbol = "μ"
+	}
+
+	lockEngNotationSI.Lock()
+
+	defer lockEngNotationSI.Unlock()
+
+	for exp := -24; exp <= 24; exp += 3 {
+
+		if exp == 0 {
+			continue
+		}
+
+		key := engNotSIElectron.getSIMetricsKey(exp)
+
+		if mEngNotationSISymbols[key] == symbol ||
+			strings.EqualFold(mEngNotationSINames[key], siPrefix) {
+
+			exponent = exp
+
+			return exponent, err
+		}
+	}
+
+	err = fmt.Errorf("%v\n"+
+		"Error: Input parameter 'siPrefix' is invalid!\n"+
+		"'siPrefix' is not a valid SI prefix symbol or name.\n"+
+		"siPrefix = '%v'\n",
+		ePrefix.String(),
+		siPrefix)
+
+	return exponent, err
+}
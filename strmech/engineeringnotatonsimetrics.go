@@ -118,12 +118,12 @@ var mEngNotationSINames = map[string]string{
 	"10^18":  "exa",
 	"10^15":  "peta",
 	"10^12":  "tera",
-	"10^9 ":  "giga",
-	"10^6 ":  "mega",
-	"10^3 ":  "kilo",
-	"10^−3 ": "milli",
-	"10^−6 ": "micro",
-	"10^−9 ": "nano",
+	"10^9":   "giga",
+	"10^6":   "mega",
+	"10^3":   "kilo",
+	"10^−3":  "milli",
+	"10^−6":  "micro",
+	"10^−9":  "nano",
 	"10^−12": "pico",
 	"10^−15": "femto",
 	"10^−18": "atto",
@@ -1,6 +1,8 @@
 package strmech
 
 import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
 	"math/big"
 	"sync"
 )
@@ -18,9 +20,32 @@ import (
 //
 //	# Definition of Terms
 //
-// ----------------------------------------------------------------
+//	Engineering notation or engineering form is a version
+//	of scientific notation in which the exponent of ten
+//	must be divisible by three (i.e., they are powers of
+//	a thousand). As an alternative to writing powers of
+//	10, SI or Metric prefixes can be used, which also
+//	provide steps of a factor of a thousand.
+//
+//	Example
+//
+//		Numeric Value				=	265,200,000
+//		Engineering Notation Value	=	'265.2 x 10^6'
+//		coefficient 				=	'265.2'
+//		exponent    				= 	'6'  (10^6)
+//		SI Prefix Symbol Format		=	'265.2 M'
+//		SI Prefix Name Format		=	'265.2 mega'
+//
+//	The coefficient of an Engineering Notation value has
+//	one to three integer digits. The coefficient is
+//	stored as an integer value together with the number
+//	of fractional digits in the coefficient:
+//
+//		coefficient = 2652 with 1 fractional digit = 265.2
 //
-//	in scientific notation.
+//	Type EngNotationKernel supports all the display
+//	formats defined by enumeration
+//	EngineeringNotationFormat.
 //
 // ----------------------------------------------------------------
 //
@@ -41,6 +66,19 @@ type EngNotationKernel struct {
 	//	significand.
 	//
 	// In the example '2.652E+8', the coefficient is '2.652'.
+	//
+	//	The coefficient is stored as an integer containing
+	//	both the integer and fractional digits. The number
+	//	of fractional digits is specified by member
+	//	variable 'coefficientFracDigits'. The coefficient
+	//	also carries the number sign of the numeric value.
+
+	coefficientFracDigits uint
+	//	The number of fractional digits contained in
+	//	'coefficient'.
+	//
+	//	In the example '265.20E+6', 'coefficient' is '26520'
+	//	and 'coefficientFracDigits' is '2'.
 
 	exponent *big.Int
 	// The exponent portion of the Engineering Notation
@@ -50,3 +88,939 @@ type EngNotationKernel struct {
 
 	lock *sync.Mutex
 }
+
+// CopyIn
+//
+// Copies the data fields from an incoming instance of
+// EngNotationKernel ('incomingEngNotKernel') to the
+// data fields of the current EngNotationKernel
+// instance.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the data fields in current EngNotationKernel
+//	instance will be deleted and overwritten.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	incomingEngNotKernel		*EngNotationKernel
+//
+//		A pointer to an instance of EngNotationKernel.
+//		All the internal data field values in this
+//		instance will be copied to corresponding data
+//		fields of the current EngNotationKernel instance.
+//
+//		If 'incomingEngNotKernel' is a nil pointer, an
+//		error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (engNotKernel *EngNotationKernel) CopyIn(
+	incomingEngNotKernel *EngNotationKernel,
+	errorPrefix interface{}) error {
+
+	if engNotKernel.lock == nil {
+		engNotKernel.lock = new(sync.Mutex)
+	}
+
+	engNotKernel.lock.Lock()
+
+	defer engNotKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"EngNotationKernel."+
+			"CopyIn()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if incomingEngNotKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'incomingEngNotKernel' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	new(engNotationKernelAtom).copy(
+		engNotKernel,
+		incomingEngNotKernel)
+
+	return err
+}
+
+// CopyOut
+//
+// Returns a deep copy of the current EngNotationKernel
+// instance.
+func (engNotKernel *EngNotationKernel) CopyOut() EngNotationKernel {
+
+	if engNotKernel.lock == nil {
+		engNotKernel.lock = new(sync.Mutex)
+	}
+
+	engNotKernel.lock.Lock()
+
+	defer engNotKernel.lock.Unlock()
+
+	newEngNotKernel := EngNotationKernel{}
+
+	new(engNotationKernelAtom).copy(
+		&newEngNotKernel,
+		engNotKernel)
+
+	return newEngNotKernel
+}
+
+// Empty
+//
+// Resets all internal member variables for the current
+// instance of EngNotationKernel to their zero or
+// uninitialized states. This method will leave the
+// current instance of EngNotationKernel in an invalid
+// state and unavailable for immediate reuse.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// This method will delete all member variable data
+// values in the current instance of EngNotationKernel.
+// All member variable data values will be reset to
+// their zero or uninitialized states.
+func (engNotKernel *EngNotationKernel) Empty() {
+
+	if engNotKernel.lock == nil {
+		engNotKernel.lock = new(sync.Mutex)
+	}
+
+	engNotKernel.lock.Lock()
+
+	new(engNotationKernelAtom).empty(
+		engNotKernel)
+
+	engNotKernel.lock.Unlock()
+
+	engNotKernel.lock = nil
+}
+
+// Equal
+//
+// Receives a pointer to another instance of
+// EngNotationKernel and proceeds to compare its
+// internal member variables to those of the current
+// EngNotationKernel instance in order to determine if
+// they are equivalent.
+//
+// A boolean flag showing the result of this comparison
+// is returned. If the member variables for both
+// instances are equal in all respects, this flag is set
+// to 'true'. Otherwise, this method returns 'false'.
+//
+// Note that '4.7 x 10^3' and '4.70 x 10^3' are NOT
+// equal because their coefficients contain a different
+// number of fractional digits.
+func (engNotKernel *EngNotationKernel) Equal(
+	incomingEngNotKernel *EngNotationKernel) bool {
+
+	if engNotKernel.lock == nil {
+		engNotKernel.lock = new(sync.Mutex)
+	}
+
+	engNotKernel.lock.Lock()
+
+	defer engNotKernel.lock.Unlock()
+
+	return new(engNotationKernelAtom).equal(
+		engNotKernel,
+		incomingEngNotKernel)
+}
+
+// FmtEngNotation
+//
+// Formats the numeric value encapsulated by the current
+// instance of EngNotationKernel as a number string using
+// one of the Engineering Notation display formats
+// defined by enumeration EngineeringNotationFormat.
+//
+// The decimal separator is always a period ('.') and
+// negative values are prefixed with a leading minus
+// sign ('-').
+//
+//	Examples: Numeric Value 4,700 Unit Symbol "Ω"
+//
+//		EngNotFmt.Exponential()				"4.7 x 10^3 Ω"
+//		EngNotFmt.ENotUprCaseELeadPlus()	"4.7E+3 Ω"
+//		EngNotFmt.ENotUprCaseENoLeadPlus()	"4.7E3 Ω"
+//		EngNotFmt.ENotLwrCaseELeadPlus()	"4.7e+3 Ω"
+//		EngNotFmt.ENotLwrCaseENoLeadPlus()	"4.7e3 Ω"
+//		EngNotFmt.SIPrefixSymbol()			"4.7 kΩ"
+//		EngNotFmt.SIPrefixName()			"4.7 kiloΩ"
+//
+//	Examples: Numeric Value 4,700 No Unit Symbol
+//
+//		EngNotFmt.SIPrefixSymbol()			"4.7 k"
+//		EngNotFmt.SIPrefixName()			"4.7 kilo"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	engNotFmt					EngineeringNotationFormat
+//
+//		Specifies the Engineering Notation display
+//		format. If this value is invalid, an error will
+//		be returned.
+//
+//		For SI Prefix formats, the exponent must be in the
+//		range -24 through +24. Otherwise, an error will
+//		be returned. An exponent of zero is formatted
+//		without an SI prefix.
+//
+//	unitSymbol					string
+//
+//		An optional unit of measure symbol such as "Ω"
+//		or "m". If this string is empty, it is ignored.
+//
+//		For the SI Prefix formats, the unit symbol is
+//		appended directly to the SI prefix ("4.7 kΩ").
+//		For all other formats, the unit symbol is
+//		appended after a single space ("4.7E+3 Ω").
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	string
+//
+//		If this method completes successfully, this
+//		string will contain the numeric value of the
+//		current EngNotationKernel instance formatted in
+//		Engineering Notation.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (engNotKernel *EngNotationKernel) FmtEngNotation(
+	engNotFmt EngineeringNotationFormat,
+	unitSymbol string,
+	errorPrefix interface{}) (
+	string,
+	error) {
+
+	if engNotKernel.lock == nil {
+		engNotKernel.lock = new(sync.Mutex)
+	}
+
+	engNotKernel.lock.Lock()
+
+	defer engNotKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"EngNotationKernel."+
+			"FmtEngNotation()",
+		"")
+
+	if err != nil {
+		return "", err
+	}
+
+	return new(engNotationKernelNanobot).formatEngNotation(
+		engNotKernel,
+		engNotFmt,
+		unitSymbol,
+		ePrefix.XCpy(
+			"engNotKernel"))
+}
+
+// GetCoefficient
+//
+// Returns a copy of the coefficient for the current
+// instance of EngNotationKernel.
+//
+// The coefficient is returned as an integer value
+// containing both the integer and fractional digits of
+// the Engineering Notation coefficient. The number of
+// fractional digits is returned by method
+// GetCoefficientFracDigits().
+//
+//	Example:
+//		Engineering Notation: 265.20 x 10^6
+//		Coefficient:          26520
+//		Fractional Digits:    2
+func (engNotKernel *EngNotationKernel) GetCoefficient() *big.Int {
+
+	if engNotKernel.lock == nil {
+		engNotKernel.lock = new(sync.Mutex)
+	}
+
+	engNotKernel.lock.Lock()
+
+	defer engNotKernel.lock.Unlock()
+
+	coefficient,
+		_ := new(engNotationKernelAtom).getComponents(
+		engNotKernel)
+
+	return coefficient
+}
+
+// GetCoefficientFracDigits
+//
+// Returns the number of fractional digits contained in
+// the coefficient of the current instance of
+// EngNotationKernel.
+//
+//	Example:
+//		Engineering Notation: 265.20 x 10^6
+//		Coefficient:          26520
+//		Fractional Digits:    2
+func (engNotKernel *EngNotationKernel) GetCoefficientFracDigits() uint {
+
+	if engNotKernel.lock == nil {
+		engNotKernel.lock = new(sync.Mutex)
+	}
+
+	engNotKernel.lock.Lock()
+
+	defer engNotKernel.lock.Unlock()
+
+	return engNotKernel.coefficientFracDigits
+}
+
+// GetExponent
+//
+// Returns a copy of the exponent for the current
+// instance of EngNotationKernel. The exponent is always
+// a multiple of three.
+//
+//	Example:
+//		Engineering Notation: 265.20 x 10^6
+//		Exponent:             6
+func (engNotKernel *EngNotationKernel) GetExponent() *big.Int {
+
+	if engNotKernel.lock == nil {
+		engNotKernel.lock = new(sync.Mutex)
+	}
+
+	engNotKernel.lock.Lock()
+
+	defer engNotKernel.lock.Unlock()
+
+	_,
+		exponent := new(engNotationKernelAtom).getComponents(
+		engNotKernel)
+
+	return exponent
+}
+
+// GetNumberStrKernel
+//
+// Converts the numeric value of the current
+// EngNotationKernel instance to a new instance of
+// NumberStrKernel.
+//
+//	Example:
+//		Engineering Notation: 4.70 x 10^3
+//		NumberStrKernel:      4700
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberStrKernel
+//
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		containing the numeric value of the current
+//		EngNotationKernel instance.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (engNotKernel *EngNotationKernel) GetNumberStrKernel(
+	errorPrefix interface{}) (
+	NumberStrKernel,
+	error) {
+
+	if engNotKernel.lock == nil {
+		engNotKernel.lock = new(sync.Mutex)
+	}
+
+	engNotKernel.lock.Lock()
+
+	defer engNotKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"EngNotationKernel."+
+			"GetNumberStrKernel()",
+		"")
+
+	if err != nil {
+		return NumberStrKernel{}, err
+	}
+
+	return new(engNotationKernelNanobot).getNumStrKernel(
+		engNotKernel,
+		ePrefix.XCpy(
+			"engNotKernel"))
+}
+
+// NewFromNumStrKernel
+//
+// Creates and returns a new instance of
+// EngNotationKernel configured with the numeric value
+// contained in an instance of NumberStrKernel.
+//
+// The exponent of the returned Engineering Notation
+// value is always a multiple of three and the
+// coefficient has one to three integer digits.
+//
+//	Examples:
+//		Numeric Value:	265,200,000
+//		Engineering Notation: 265.2 x 10^6
+//
+//		Numeric Value:	0.0000033
+//		Engineering Notation: 3.3 x 10^-6
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		numeric value of this instance will be converted
+//		to Engineering Notation. This instance will NOT
+//		be modified.
+//
+//	coefficientRoundingType		NumberRoundingType
+//
+//		The rounding algorithm applied to the fractional
+//		digits of the coefficient. If this value is set
+//		to NumRoundType.NoRounding(), all significant
+//		digits are retained and 'coefficientFracDigits'
+//		is ignored.
+//
+//		If rounding carries the coefficient to the next
+//		power of one thousand (999.96 => 1000.0), the
+//		exponent is adjusted accordingly (1.0 x 10^n+3).
+//
+//	coefficientFracDigits		int
+//
+//		The number of fractional digits retained in the
+//		coefficient after rounding.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	EngNotationKernel
+//
+//		If this method completes successfully, a new
+//		instance of EngNotationKernel will be returned
+//		containing the numeric value of 'numStrKernel'.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (engNotKernel *EngNotationKernel) NewFromNumStrKernel(
+	numStrKernel *NumberStrKernel,
+	coefficientRoundingType NumberRoundingType,
+	coefficientFracDigits int,
+	errorPrefix interface{}) (
+	EngNotationKernel,
+	error) {
+
+	if engNotKernel.lock == nil {
+		engNotKernel.lock = new(sync.Mutex)
+	}
+
+	engNotKernel.lock.Lock()
+
+	defer engNotKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	newEngNotKernel := EngNotationKernel{}
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"EngNotationKernel."+
+			"NewFromNumStrKernel()",
+		"")
+
+	if err != nil {
+		return newEngNotKernel, err
+	}
+
+	err = new(engNotationKernelNanobot).setFromNumStrKernel(
+		&newEngNotKernel,
+		numStrKernel,
+		coefficientRoundingType,
+		coefficientFracDigits,
+		ePrefix.XCpy(
+			"newEngNotKernel<-numStrKernel"))
+
+	return newEngNotKernel, err
+}
+
+// SetFromNumStrKernel
+//
+// Deletes and resets the data values of the current
+// EngNotationKernel instance using the numeric value
+// contained in an instance of NumberStrKernel.
+//
+// The exponent of the Engineering Notation value is
+// always a multiple of three and the coefficient has
+// one to three integer digits.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the data values in the current instance of
+//	EngNotationKernel will be deleted and reset to new
+//	values.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		numeric value of this instance will be converted
+//		to Engineering Notation. This instance will NOT
+//		be modified.
+//
+//	coefficientRoundingType		NumberRoundingType
+//
+//		The rounding algorithm applied to the fractional
+//		digits of the coefficient. If this value is set
+//		to NumRoundType.NoRounding(), all significant
+//		digits are retained and 'coefficientFracDigits'
+//		is ignored.
+//
+//	coefficientFracDigits		int
+//
+//		The number of fractional digits retained in the
+//		coefficient after rounding.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (engNotKernel *EngNotationKernel) SetFromNumStrKernel(
+	numStrKernel *NumberStrKernel,
+	coefficientRoundingType NumberRoundingType,
+	coefficientFracDigits int,
+	errorPrefix interface{}) error {
+
+	if engNotKernel.lock == nil {
+		engNotKernel.lock = new(sync.Mutex)
+	}
+
+	engNotKernel.lock.Lock()
+
+	defer engNotKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"EngNotationKernel."+
+			"SetFromNumStrKernel()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(engNotationKernelNanobot).setFromNumStrKernel(
+		engNotKernel,
+		numStrKernel,
+		coefficientRoundingType,
+		coefficientFracDigits,
+		ePrefix.XCpy(
+			"engNotKernel<-numStrKernel"))
+}
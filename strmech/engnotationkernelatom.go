@@ -0,0 +1,232 @@
+package strmech
+
+import (
+	"math/big"
+	"strings"
+	"sync"
+)
+
+// engNotationKernelAtom - Provides low level helper
+// methods used to copy, compare and access the
+// components of type EngNotationKernel.
+type engNotationKernelAtom struct {
+	lock *sync.Mutex
+}
+
+// copy
+//
+// Copies the coefficient, coefficient fractional digits
+// and exponent from a source instance of
+// EngNotationKernel to a destination instance of
+// EngNotationKernel.
+//
+// If either input parameter is a nil pointer, this
+// method takes no action and exits.
+func (engNotKernelAtom *engNotationKernelAtom) copy(
+	destinationEngNotKernel *EngNotationKernel,
+	sourceEngNotKernel *EngNotationKernel) {
+
+	if engNotKernelAtom.lock == nil {
+		engNotKernelAtom.lock = new(sync.Mutex)
+	}
+
+	engNotKernelAtom.lock.Lock()
+
+	defer engNotKernelAtom.lock.Unlock()
+
+	if destinationEngNotKernel == nil ||
+		sourceEngNotKernel == nil {
+
+		return
+	}
+
+	destinationEngNotKernel.coefficient = nil
+
+	if sourceEngNotKernel.coefficient != nil {
+		destinationEngNotKernel.coefficient =
+			new(big.Int).Set(sourceEngNotKernel.coefficient)
+	}
+
+	destinationEngNotKernel.coefficientFracDigits =
+		sourceEngNotKernel.coefficientFracDigits
+
+	destinationEngNotKernel.exponent = nil
+
+	if sourceEngNotKernel.exponent != nil {
+		destinationEngNotKernel.exponent =
+			new(big.Int).Set(sourceEngNotKernel.exponent)
+	}
+}
+
+// empty
+//
+// Deletes and resets all the member variable data
+// values in an instance of EngNotationKernel to their
+// initial or zero values.
+//
+// If input parameter 'engNotKernel' is a nil pointer,
+// this method takes no action and exits.
+func (engNotKernelAtom *engNotationKernelAtom) empty(
+	engNotKernel *EngNotationKernel) {
+
+	if engNotKernelAtom.lock == nil {
+		engNotKernelAtom.lock = new(sync.Mutex)
+	}
+
+	engNotKernelAtom.lock.Lock()
+
+	defer engNotKernelAtom.lock.Unlock()
+
+	if engNotKernel == nil {
+		return
+	}
+
+	engNotKernel.coefficient = nil
+
+	engNotKernel.coefficientFracDigits = 0
+
+	engNotKernel.exponent = nil
+}
+
+// equal
+//
+// Compares two instances of EngNotationKernel and
+// returns 'true' if their coefficients, coefficient
+// fractional digits and exponents are equivalent.
+//
+// A nil coefficient or exponent is treated as having a
+// value of zero.
+//
+// If either input parameter is a nil pointer, this
+// method returns 'false'.
+func (engNotKernelAtom *engNotationKernelAtom) equal(
+	engNotKernel1 *EngNotationKernel,
+	engNotKernel2 *EngNotationKernel) bool {
+
+	if engNotKernelAtom.lock == nil {
+		engNotKernelAtom.lock = new(sync.Mutex)
+	}
+
+	engNotKernelAtom.lock.Lock()
+
+	defer engNotKernelAtom.lock.Unlock()
+
+	if engNotKernel1 == nil ||
+		engNotKernel2 == nil {
+
+		return false
+	}
+
+	if engNotKernel1.coefficientFracDigits !=
+		engNotKernel2.coefficientFracDigits {
+
+		return false
+	}
+
+	coefficient1,
+		exponent1 := engNotKernelAtom.getComponents(
+		engNotKernel1)
+
+	coefficient2,
+		exponent2 := engNotKernelAtom.getComponents(
+		engNotKernel2)
+
+	if coefficient1.Cmp(coefficient2) != 0 {
+		return false
+	}
+
+	if exponent1.Cmp(exponent2) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// getCoefficientStr
+//
+// Returns the coefficient of an EngNotationKernel
+// instance formatted as a native number string. The
+// decimal separator is a period ('.') and negative
+// values are prefixed with a leading minus sign ('-').
+//
+//	Examples:
+//		coefficient: 47     fractional digits: 1
+//		Result: "4.7"
+//
+//		coefficient: -26520 fractional digits: 2
+//		Result: "-265.20"
+//
+// If input parameter 'engNotKernel' is a nil pointer,
+// this method returns a value of "0".
+//
+// This method does NOT lock the current instance of
+// engNotationKernelAtom.
+func (engNotKernelAtom *engNotationKernelAtom) getCoefficientStr(
+	engNotKernel *EngNotationKernel) string {
+
+	if engNotKernel == nil {
+		return "0"
+	}
+
+	coefficient,
+		_ := engNotKernelAtom.getComponents(engNotKernel)
+
+	var numSign string
+
+	if coefficient.Sign() < 0 {
+		numSign = "-"
+	}
+
+	digits := new(big.Int).Abs(coefficient).Text(10)
+
+	fracDigits := int(engNotKernel.coefficientFracDigits)
+
+	if len(digits) <= fracDigits {
+
+		digits = strings.Repeat(
+			"0",
+			fracDigits-len(digits)+1) +
+			digits
+	}
+
+	if fracDigits == 0 {
+		return numSign + digits
+	}
+
+	return numSign +
+		digits[:len(digits)-fracDigits] +
+		"." +
+		digits[len(digits)-fracDigits:]
+}
+
+// getComponents
+//
+// Returns copies of the coefficient and exponent of an
+// EngNotationKernel instance. Nil values are returned as
+// a new instance of *big.Int with a value of zero.
+//
+// This method does NOT lock the current instance of
+// engNotationKernelAtom.
+func (engNotKernelAtom *engNotationKernelAtom) getComponents(
+	engNotKernel *EngNotationKernel) (
+	coefficient *big.Int,
+	exponent *big.Int) {
+
+	coefficient = big.NewInt(0)
+
+	exponent = big.NewInt(0)
+
+	if engNotKernel == nil {
+		return coefficient, exponent
+	}
+
+	if engNotKernel.coefficient != nil {
+		coefficient.Set(engNotKernel.coefficient)
+	}
+
+	if engNotKernel.exponent != nil {
+		exponent.Set(engNotKernel.exponent)
+	}
+
+	return coefficient, exponent
+}
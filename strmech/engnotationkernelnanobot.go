@@ -0,0 +1,759 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"math/big"
+	"strings"
+	"sync"
+)
+
+// engNotationKernelNanobot - Provides helper methods
+// used to configure, convert and format instances of
+// EngNotationKernel.
+type engNotationKernelNanobot struct {
+	lock *sync.Mutex
+}
+
+// formatEngNotation
+//
+// Formats the numeric value encapsulated by an instance
+// of EngNotationKernel as a number string using one of
+// the Engineering Notation display formats defined by
+// enumeration EngineeringNotationFormat.
+//
+//	Examples: Numeric Value 4,700
+//
+//		EngNotFmt.Exponential()				"4.7 x 10^3"
+//		EngNotFmt.ENotUprCaseELeadPlus()	"4.7E+3"
+//		EngNotFmt.ENotUprCaseENoLeadPlus()	"4.7E3"
+//		EngNotFmt.ENotLwrCaseELeadPlus()	"4.7e+3"
+//		EngNotFmt.ENotLwrCaseENoLeadPlus()	"4.7e3"
+//		EngNotFmt.SIPrefixSymbol()			"4.7 k"
+//		EngNotFmt.SIPrefixName()			"4.7 kilo"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	engNotKernel				*EngNotationKernel
+//
+//		A pointer to an instance of EngNotationKernel.
+//		The numeric value of this instance will be
+//		formatted as a number string. This instance will
+//		NOT be modified.
+//
+//	engNotFmt					EngineeringNotationFormat
+//
+//		Specifies the Engineering Notation display
+//		format. If this value is invalid, an error will
+//		be returned.
+//
+//		For SI Prefix formats, the exponent must be a
+//		multiple of three in the range -24 through +24.
+//		Otherwise, an error will be returned. An exponent
+//		of zero is formatted without an SI prefix.
+//
+//	unitSymbol					string
+//
+//		An optional unit of measure symbol such as "Ω"
+//		or "m". If this string is empty, it is ignored.
+//
+//		For the SI Prefix formats, the unit symbol is
+//		appended directly to the SI prefix ("4.7 kΩ").
+//		For all other formats, the unit symbol is
+//		appended after a single space ("4.7E+3 Ω").
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	numStr						string
+//
+//		If this method completes successfully, this
+//		string will contain the numeric value of
+//		'engNotKernel' formatted in Engineering Notation.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (engNotKernelNanobot *engNotationKernelNanobot) formatEngNotation(
+	engNotKernel *EngNotationKernel,
+	engNotFmt EngineeringNotationFormat,
+	unitSymbol string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	numStr string,
+	err error) {
+
+	if engNotKernelNanobot.lock == nil {
+		engNotKernelNanobot.lock = new(sync.Mutex)
+	}
+
+	engNotKernelNanobot.lock.Lock()
+
+	defer engNotKernelNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"engNotationKernelNanobot."+
+			"formatEngNotation()",
+		"")
+
+	if err != nil {
+
+		return numStr, err
+	}
+
+	if engNotKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'engNotKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return numStr, err
+	}
+
+	if !engNotFmt.XIsValid() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'engNotFmt' is invalid!\n"+
+			"engNotFmt string value  = '%v'\n"+
+			"engNotFmt integer value = '%v'\n",
+			ePrefix.String(),
+			engNotFmt.String(),
+			int(engNotFmt))
+
+		return numStr, err
+	}
+
+	engNotKernelAtom := engNotationKernelAtom{}
+
+	numStr = engNotKernelAtom.getCoefficientStr(
+		engNotKernel)
+
+	_,
+		exponent := engNotKernelAtom.getComponents(
+		engNotKernel)
+
+	absExponentStr := new(big.Int).Abs(exponent).Text(10)
+
+	var exponentSign string
+
+	switch engNotFmt {
+
+	case EngNotFmt.SIPrefixSymbol(),
+		EngNotFmt.SIPrefixName():
+
+		if !exponent.IsInt64() {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: The exponent is out of range for SI Prefix formatting!\n"+
+				"exponent = '%v'\n",
+				ePrefix.String(),
+				exponent.Text(10))
+
+			return numStr, err
+		}
+
+		var siPrefix string
+
+		siPrefix,
+			err = new(engNotationSIMetricsElectron).getSIPrefix(
+			int(exponent.Int64()),
+			engNotFmt == EngNotFmt.SIPrefixName(),
+			ePrefix.XCpy(
+				"exponent"))
+
+		if err != nil {
+			return numStr, err
+		}
+
+		if len(siPrefix)+len(unitSymbol) > 0 {
+			numStr += " " + siPrefix + unitSymbol
+		}
+
+		return numStr, err
+
+	case EngNotFmt.Exponential():
+
+		if exponent.Sign() < 0 {
+			exponentSign = "-"
+		}
+
+		numStr += " x 10^" + exponentSign + absExponentStr
+
+	default:
+
+		if exponent.Sign() < 0 {
+
+			exponentSign = "-"
+
+		} else if engNotFmt == EngNotFmt.ENotUprCaseELeadPlus() ||
+			engNotFmt == EngNotFmt.ENotLwrCaseELeadPlus() {
+
+			exponentSign = "+"
+		}
+
+		eChar := "E"
+
+		if engNotFmt == EngNotFmt.ENotLwrCaseELeadPlus() ||
+			engNotFmt == EngNotFmt.ENotLwrCaseENoLeadPlus() {
+
+			eChar = "e"
+		}
+
+		numStr += eChar + exponentSign + absExponentStr
+	}
+
+	if len(unitSymbol) > 0 {
+		numStr += " " + unitSymbol
+	}
+
+	return numStr, err
+}
+
+// getNumStrKernel
+//
+// Converts the numeric value encapsulated by an instance
+// of EngNotationKernel to a new instance of
+// NumberStrKernel.
+//
+//	Example:
+//		Engineering Notation: 4.70 x 10^3
+//		NumberStrKernel:      4700
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	engNotKernel				*EngNotationKernel
+//
+//		A pointer to an instance of EngNotationKernel.
+//		The numeric value of this instance will be
+//		converted to a NumberStrKernel. This instance
+//		will NOT be modified.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberStrKernel
+//
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		containing the numeric value of 'engNotKernel'.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (engNotKernelNanobot *engNotationKernelNanobot) getNumStrKernel(
+	engNotKernel *EngNotationKernel,
+	errPrefDto *ePref.ErrPrefixDto) (
+	NumberStrKernel,
+	error) {
+
+	if engNotKernelNanobot.lock == nil {
+		engNotKernelNanobot.lock = new(sync.Mutex)
+	}
+
+	engNotKernelNanobot.lock.Lock()
+
+	defer engNotKernelNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"engNotationKernelNanobot."+
+			"getNumStrKernel()",
+		"")
+
+	if err != nil {
+		return NumberStrKernel{}, err
+	}
+
+	if engNotKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'engNotKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return NumberStrKernel{}, err
+	}
+
+	coefficient,
+		exponent := new(engNotationKernelAtom).getComponents(
+		engNotKernel)
+
+	exponent.Sub(
+		exponent,
+		big.NewInt(int64(engNotKernel.coefficientFracDigits)))
+
+	var bigDec BigDecimal
+
+	new(bigDecimalElectron).setComponents(
+		&bigDec,
+		coefficient,
+		exponent)
+
+	return new(bigDecimalNanobot).getNumStrKernel(
+		&bigDec,
+		ePrefix.XCpy(
+			"bigDec"))
+}
+
+// parseSIPrefixNumStr
+//
+// Parses a number string containing a numeric value
+// followed by an optional SI prefix symbol or name and
+// returns the numeric value as a new instance of
+// NumberStrKernel.
+//
+// The numeric value must be formatted as a native
+// number string: an optional leading plus ('+') or
+// minus ('-') sign, followed by numeric digits and an
+// optional period ('.') as the decimal separator.
+//
+// Spaces between the numeric value and the SI prefix
+// are optional. For the 'micro' prefix, the Greek small
+// letter mu ('μ' U+03BC), the micro sign ('µ' U+00B5)
+// and the ASCII substitute 'u' are all accepted. SI
+// prefix names are NOT case-sensitive.
+//
+//	Examples:
+//		"3.3µ"        =>  0.0000033
+//		"2.2 mega"    =>  2200000
+//		"-4.7k"       => -4700
+//		"4.7 kΩ"      =>  4700  (unitSymbol = "Ω")
+//		"15"          =>  15
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	siPrefixNumStr				string
+//
+//		The number string to be parsed. If this string
+//		does not contain a valid numeric value or the SI
+//		prefix is invalid, an error will be returned.
+//
+//	unitSymbol					string
+//
+//		An optional unit of measure symbol such as "Ω".
+//		If 'siPrefixNumStr' ends with this symbol, it
+//		will be removed before the SI prefix is parsed.
+//		If this string is empty, it is ignored.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberStrKernel
+//
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		containing the numeric value parsed from
+//		'siPrefixNumStr'.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (engNotKernelNanobot *engNotationKernelNanobot) parseSIPrefixNumStr(
+	siPrefixNumStr string,
+	unitSymbol string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	NumberStrKernel,
+	error) {
+
+	if engNotKernelNanobot.lock == nil {
+		engNotKernelNanobot.lock = new(sync.Mutex)
+	}
+
+	engNotKernelNanobot.lock.Lock()
+
+	defer engNotKernelNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"engNotationKernelNanobot."+
+			"parseSIPrefixNumStr()",
+		"")
+
+	if err != nil {
+		return NumberStrKernel{}, err
+	}
+
+	siPrefixRunes := []rune(strings.TrimSpace(siPrefixNumStr))
+
+	if len(unitSymbol) > 0 &&
+		strings.HasSuffix(string(siPrefixRunes), unitSymbol) {
+
+		siPrefixRunes = []rune(
+			strings.TrimSpace(
+				strings.TrimSuffix(
+					string(siPrefixRunes),
+					unitSymbol)))
+	}
+
+	lenSIPrefixRunes := len(siPrefixRunes)
+
+	idx := 0
+
+	var numSign string
+
+	if lenSIPrefixRunes > 0 &&
+		(siPrefixRunes[0] == '-' ||
+			siPrefixRunes[0] == '+') {
+
+		if siPrefixRunes[0] == '-' {
+			numSign = "-"
+		}
+
+		idx++
+	}
+
+	numDigits := 0
+
+	numDecimalSeparators := 0
+
+	for ; idx < lenSIPrefixRunes; idx++ {
+
+		if siPrefixRunes[idx] >= '0' &&
+			siPrefixRunes[idx] <= '9' {
+
+			numDigits++
+
+			continue
+		}
+
+		if siPrefixRunes[idx] == '.' {
+
+			numDecimalSeparators++
+
+			continue
+		}
+
+		break
+	}
+
+	if numDigits == 0 ||
+		numDecimalSeparators > 1 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'siPrefixNumStr' is invalid!\n"+
+			"'siPrefixNumStr' does not begin with a valid numeric value.\n"+
+			"siPrefixNumStr = '%v'\n",
+			ePrefix.String(),
+			siPrefixNumStr)
+
+		return NumberStrKernel{}, err
+	}
+
+	nativeNumStr := numSign +
+		strings.TrimPrefix(
+			strings.TrimPrefix(
+				string(siPrefixRunes[:idx]),
+				"-"),
+			"+")
+
+	var siExponent int
+
+	siExponent,
+		err = new(engNotationSIMetricsElectron).getSIPrefixExponent(
+		string(siPrefixRunes[idx:]),
+		ePrefix.XCpy(
+			"siPrefixNumStr"))
+
+	if err != nil {
+		return NumberStrKernel{}, err
+	}
+
+	var bigDec BigDecimal
+
+	err = new(bigDecimalNanobot).setFromNativeNumStr(
+		&bigDec,
+		nativeNumStr,
+		ePrefix.XCpy(
+			"bigDec<-nativeNumStr"))
+
+	if err != nil {
+		return NumberStrKernel{}, err
+	}
+
+	bigDecElectron := bigDecimalElectron{}
+
+	significand,
+		exponent := bigDecElectron.getComponents(&bigDec)
+
+	exponent.Add(
+		exponent,
+		big.NewInt(int64(siExponent)))
+
+	bigDecElectron.setComponents(
+		&bigDec,
+		significand,
+		exponent)
+
+	return new(bigDecimalNanobot).getNumStrKernel(
+		&bigDec,
+		ePrefix.XCpy(
+			"bigDec"))
+}
+
+// setFromNumStrKernel
+//
+// Deletes and resets the data values of an instance of
+// EngNotationKernel using the numeric value contained
+// in an instance of NumberStrKernel.
+//
+// The Engineering Notation exponent is always a
+// multiple of three and the coefficient has one to
+// three integer digits.
+//
+//	Example:
+//		Numeric Value:	265,200,000
+//		coefficient:	265.2
+//		exponent:		6
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the data values contained in input parameter
+//	'engNotKernel' will be deleted and reset to new
+//	values.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	engNotKernel				*EngNotationKernel
+//
+//		A pointer to an instance of EngNotationKernel.
+//		All the data values in this instance will be
+//		deleted and reset.
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		numeric value of this instance will be converted
+//		to Engineering Notation. This instance will NOT
+//		be modified.
+//
+//	coefficientRoundingType		NumberRoundingType
+//
+//		The rounding algorithm applied to the fractional
+//		digits of the coefficient. If this value is set
+//		to NumRoundType.NoRounding(), all significant
+//		digits are retained.
+//
+//	coefficientFracDigits		int
+//
+//		The number of fractional digits retained in the
+//		coefficient after rounding.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (engNotKernelNanobot *engNotationKernelNanobot) setFromNumStrKernel(
+	engNotKernel *EngNotationKernel,
+	numStrKernel *NumberStrKernel,
+	coefficientRoundingType NumberRoundingType,
+	coefficientFracDigits int,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if engNotKernelNanobot.lock == nil {
+		engNotKernelNanobot.lock = new(sync.Mutex)
+	}
+
+	engNotKernelNanobot.lock.Lock()
+
+	defer engNotKernelNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"engNotationKernelNanobot."+
+			"setFromNumStrKernel()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if engNotKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'engNotKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if numStrKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	var significand NumberStrKernel
+	var exponent int
+
+	significand,
+		exponent,
+		err = new(numberStrKernelAtom).getSciNotationComponents(
+		numStrKernel,
+		ScientificNotationCalcType(0).Engineering(),
+		coefficientRoundingType,
+		coefficientFracDigits,
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	if err != nil {
+		return err
+	}
+
+	fracDigits := significand.fractionalDigits.CharsArray
+
+	coefficient,
+		ok := new(big.Int).SetString(
+		string(significand.integerDigits.CharsArray)+
+			string(fracDigits),
+		10)
+
+	if !ok {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Conversion of significand digits to big.Int failed!\n"+
+			"significand integer digits    = '%v'\n"+
+			"significand fractional digits = '%v'\n",
+			ePrefix.String(),
+			string(significand.integerDigits.CharsArray),
+			string(fracDigits))
+
+		return err
+	}
+
+	if significand.numberSign == NumSignVal.Negative() {
+		coefficient.Neg(coefficient)
+	}
+
+	engNotKernel.coefficient = coefficient
+
+	engNotKernel.coefficientFracDigits = uint(len(fracDigits))
+
+	engNotKernel.exponent = big.NewInt(int64(exponent))
+
+	return err
+}
@@ -0,0 +1,570 @@
+package strmech
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Do NOT access these maps without first getting
+// the lock on 'lockNumberDigitSystemCode'.
+
+var mNumberDigitSystemCodeToString = map[NumberDigitSystem]string{
+	NumberDigitSystem(0): "None",
+	NumberDigitSystem(1): "Latin",
+	NumberDigitSystem(2): "ArabicIndic",
+	NumberDigitSystem(3): "ExtendedArabicIndic",
+	NumberDigitSystem(4): "Devanagari",
+	NumberDigitSystem(5): "Thai",
+	NumberDigitSystem(6): "FullWidth",
+}
+
+var mNumberDigitSystemStringToCode = map[string]NumberDigitSystem{
+	"None":                NumberDigitSystem(0),
+	"Latin":               NumberDigitSystem(1),
+	"ArabicIndic":         NumberDigitSystem(2),
+	"ExtendedArabicIndic": NumberDigitSystem(3),
+	"Devanagari":          NumberDigitSystem(4),
+	"Thai":                NumberDigitSystem(5),
+	"FullWidth":           NumberDigitSystem(6),
+}
+
+var mNumberDigitSystemLwrCaseStringToCode = map[string]NumberDigitSystem{
+	"none":                NumberDigitSystem(0),
+	"latin":               NumberDigitSystem(1),
+	"arabicindic":         NumberDigitSystem(2),
+	"extendedarabicindic": NumberDigitSystem(3),
+	"devanagari":          NumberDigitSystem(4),
+	"thai":                NumberDigitSystem(5),
+	"fullwidth":           NumberDigitSystem(6),
+}
+
+// mNumberDigitSystemZeroDigit - Maps each digit system to
+// the Unicode character representing the digit zero ('0')
+// in that digit system. In all supported digit systems,
+// the digits zero through nine occupy ten consecutive
+// Unicode code points.
+var mNumberDigitSystemZeroDigit = map[NumberDigitSystem]rune{
+	NumberDigitSystem(1): '0',
+	NumberDigitSystem(2): '٠',
+	NumberDigitSystem(3): '۰',
+	NumberDigitSystem(4): '०',
+	NumberDigitSystem(5): '๐',
+	NumberDigitSystem(6): '０',
+}
+
+// NumberDigitSystem - The Number Digit System enumeration is
+// used to specify the characters used to display the numeric
+// digits zero through nine in a formatted number string.
+//
+// By default, number strings are formatted with the Latin, or
+// ASCII, digits '0' through '9'. Many countries and cultures
+// use native digit characters. For example, the value
+// '1234' is displayed as '١٢٣٤' using Eastern Arabic
+// (Arabic-Indic) digits and as '१२३४' using Devanagari
+// digits.
+//
+// When parsing number strings, all the digit systems listed
+// below are recognized automatically and normalized to
+// internal digit values.
+//
+// Since the Go Programming Language does not directly support
+// enumerations, the 'NumberDigitSystem' type has been adapted
+// to function in a manner similar to classic enumerations.
+//
+// 'NumberDigitSystem' is declared as a type 'int'. The method
+// names effectively represent an enumeration of digit systems.
+// These methods are listed as follows:
+//
+// Method                 Integer
+// Name                    Value
+// ------                 -------
+//
+// None                     (0)
+//   - Signals that the Number Digit System is not specified.
+//     Number strings will be formatted with the default
+//     Latin (ASCII) digits '0' through '9'.
+//
+// Latin                    (1)
+//   - Latin, or ASCII, digits '0' through '9'.
+//     Example: 0123456789
+//
+// ArabicIndic              (2)
+//   - Eastern Arabic, or Arabic-Indic, digits U+0660
+//     through U+0669.
+//     Example: ٠١٢٣٤٥٦٧٨٩
+//
+// ExtendedArabicIndic      (3)
+//   - Extended Arabic-Indic digits used in Persian and
+//     Urdu, U+06F0 through U+06F9.
+//     Example: ۰۱۲۳۴۵۶۷۸۹
+//
+// Devanagari               (4)
+//   - Devanagari digits, U+0966 through U+096F.
+//     Example: ०१२३४५६७८९
+//
+// Thai                     (5)
+//   - Thai digits, U+0E50 through U+0E59.
+//     Example: ๐๑๒๓๔๕๖๗๘๙
+//
+// FullWidth                (6)
+//   - Full-width digits commonly used in East Asian text,
+//     U+FF10 through U+FF19.
+//     Example: ０１２３４５６７８９
+//
+// ----------------------------------------------------------------
+//
+// For easy access to these enumeration values, use the global
+// constant 'NumDigitSys'.
+//
+//	Example: NumDigitSys.Devanagari()
+//
+// Otherwise you will need to use the formal syntax.
+//
+//	Example: NumberDigitSystem(0).Devanagari()
+//
+// Depending on your editor, intellisense (a.k.a. intelligent
+// code completion) may not list the NumberDigitSystem
+// methods in alphabetical order.
+//
+// Be advised that all 'NumberDigitSystem' methods beginning
+// with 'X', as well as the method 'String()', are utility
+// methods and not part of the enumeration.
+type NumberDigitSystem int
+
+var lockNumberDigitSystemCode sync.Mutex
+
+// None - Signals that the Number Digit System is not
+// specified. Number strings will be formatted with the
+// default Latin (ASCII) digits '0' through '9'.
+//
+// None is considered a valid choice for the Number Digit
+// System.
+//
+// This method is part of the standard enumeration.
+func (numDigitSystem NumberDigitSystem) None() NumberDigitSystem {
+
+	lockNumberDigitSystemCode.Lock()
+
+	defer lockNumberDigitSystemCode.Unlock()
+
+	return NumberDigitSystem(0)
+}
+
+// Latin - Signals that number strings will be formatted with
+// the Latin, or ASCII, digits '0' through '9'.
+//
+//	Example: 0123456789
+//
+// This method is part of the standard enumeration.
+func (numDigitSystem NumberDigitSystem) Latin() NumberDigitSystem {
+
+	lockNumberDigitSystemCode.Lock()
+
+	defer lockNumberDigitSystemCode.Unlock()
+
+	return NumberDigitSystem(1)
+}
+
+// ArabicIndic - Signals that number strings will be formatted
+// with Eastern Arabic, or Arabic-Indic, digits U+0660 through
+// U+0669.
+//
+//	Example: ٠١٢٣٤٥٦٧٨٩
+//
+// This method is part of the standard enumeration.
+func (numDigitSystem NumberDigitSystem) ArabicIndic() NumberDigitSystem {
+
+	lockNumberDigitSystemCode.Lock()
+
+	defer lockNumberDigitSystemCode.Unlock()
+
+	return NumberDigitSystem(2)
+}
+
+// ExtendedArabicIndic - Signals that number strings will be
+// formatted with Extended Arabic-Indic digits U+06F0 through
+// U+06F9. These digits are used in Persian and Urdu.
+//
+//	Example: ۰۱۲۳۴۵۶۷۸۹
+//
+// This method is part of the standard enumeration.
+func (numDigitSystem NumberDigitSystem) ExtendedArabicIndic() NumberDigitSystem {
+
+	lockNumberDigitSystemCode.Lock()
+
+	defer lockNumberDigitSystemCode.Unlock()
+
+	return NumberDigitSystem(3)
+}
+
+// Devanagari - Signals that number strings will be formatted
+// with Devanagari digits U+0966 through U+096F.
+//
+//	Example: ०१२३४५६७८९
+//
+// This method is part of the standard enumeration.
+func (numDigitSystem NumberDigitSystem) Devanagari() NumberDigitSystem {
+
+	lockNumberDigitSystemCode.Lock()
+
+	defer lockNumberDigitSystemCode.Unlock()
+
+	return NumberDigitSystem(4)
+}
+
+// Thai - Signals that number strings will be formatted with
+// Thai digits U+0E50 through U+0E59.
+//
+//	Example: ๐๑๒๓๔๕๖๗๘๙
+//
+// This method is part of the standard enumeration.
+func (numDigitSystem NumberDigitSystem) Thai() NumberDigitSystem {
+
+	lockNumberDigitSystemCode.Lock()
+
+	defer lockNumberDigitSystemCode.Unlock()
+
+	return NumberDigitSystem(5)
+}
+
+// FullWidth - Signals that number strings will be formatted
+// with full-width digits U+FF10 through U+FF19. Full-width
+// digits are commonly used in East Asian text.
+//
+//	Example: ０１２３４５６７８９
+//
+// This method is part of the standard enumeration.
+func (numDigitSystem NumberDigitSystem) FullWidth() NumberDigitSystem {
+
+	lockNumberDigitSystemCode.Lock()
+
+	defer lockNumberDigitSystemCode.Unlock()
+
+	return NumberDigitSystem(6)
+}
+
+// String - Returns a string with the name of the enumeration
+// associated with this current instance of
+// 'NumberDigitSystem'.
+//
+// This is a standard utility method and is NOT part of the valid
+// enumerations for this type.
+//
+// ----------------------------------------------------------------
+//
+// # Usage
+//
+// t:= NumberDigitSystem(0).Devanagari()
+// str := t.String()
+//
+//	str is now equal to 'Devanagari'
+func (numDigitSystem NumberDigitSystem) String() string {
+
+	lockNumberDigitSystemCode.Lock()
+
+	defer lockNumberDigitSystemCode.Unlock()
+
+	result, ok :=
+		mNumberDigitSystemCodeToString[numDigitSystem]
+
+	if !ok {
+		return "Error: Number Digit System INVALID!"
+
+	}
+
+	return result
+}
+
+// XIsValid - Returns a boolean value signaling whether the current
+// NumberDigitSystem value is valid.
+//
+// The enumeration value "None" is considered VALID. "None"
+// signals that the default Latin (ASCII) digits will be used.
+//
+// This is a standard utility method and is not part of the valid
+// enumerations for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//	 digitSystem :=
+//				NumberDigitSystem(0).Thai()
+//
+//	 isValid := digitSystem.XIsValid() // isValid == true
+//
+//	 digitSystem = NumberDigitSystem(-99)
+//
+//	 isValid = digitSystem.XIsValid() // isValid == false
+func (numDigitSystem NumberDigitSystem) XIsValid() bool {
+
+	lockNumberDigitSystemCode.Lock()
+
+	defer lockNumberDigitSystemCode.Unlock()
+
+	return new(numberDigitSystemNanobot).
+		isValidNumberDigitSystem(numDigitSystem)
+}
+
+// XParseString - Receives a string and attempts to match it with
+// the string value of a supported enumeration. If successful, a
+// new instance of NumberDigitSystem is returned set to the value
+// of the associated enumeration.
+//
+// This is a standard utility method and is NOT part of the valid
+// enumerations for this type.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+// valueString   string
+//   - A string which will be matched against the enumeration string
+//     values. If 'valueString' is equal to one of the enumeration
+//     names, this method will proceed to successful completion and
+//     return the correct enumeration value.
+//
+// caseSensitive   bool
+//
+//   - If 'true' the search for enumeration names will be
+//     case-sensitive and will require an exact match. Therefore,
+//     'thai' will NOT match the enumeration name, 'Thai'.
+//
+//     A case-sensitive search will match any of the following
+//     strings:
+//     "None"
+//     "Latin"
+//     "ArabicIndic"
+//     "ExtendedArabicIndic"
+//     "Devanagari"
+//     "Thai"
+//     "FullWidth"
+//
+//     If 'false', a case-insensitive search is conducted for the
+//     enumeration name. In this example, 'Thai' WILL MATCH the
+//     enumeration name, 'thai'.
+//
+//     A case-insensitive search will match any of the following
+//     lower case names:
+//     "none"
+//     "latin"
+//     "arabicindic"
+//     "extendedarabicindic"
+//     "devanagari"
+//     "thai"
+//     "fullwidth"
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//	NumberDigitSystem
+//	   - Upon successful completion, this method will return a new
+//	     instance of NumberDigitSystem set to the value of the
+//	     enumeration matched by the string search performed on
+//	     input parameter, 'valueString'.
+//
+//	error
+//	   - If this method completes successfully, the returned error
+//	     Type is set equal to 'nil'. If an error condition is
+//	     encountered, this method will return an error type which
+//	     encapsulates an appropriate error message.
+//
+// ----------------------------------------------------------------
+//
+// Usage
+//
+//	t, err := NumberDigitSystem(0).
+//	             XParseString("Devanagari", true)
+//
+//	t is now equal to NumberDigitSystem(0).Devanagari()
+func (numDigitSystem NumberDigitSystem) XParseString(
+	valueString string,
+	caseSensitive bool) (
+	NumberDigitSystem,
+	error) {
+
+	lockNumberDigitSystemCode.Lock()
+
+	defer lockNumberDigitSystemCode.Unlock()
+
+	ePrefix := "NumberDigitSystem.XParseString() "
+
+	var ok bool
+	var numberDigitSystem NumberDigitSystem
+
+	if caseSensitive {
+
+		numberDigitSystem, ok = mNumberDigitSystemStringToCode[valueString]
+
+		if !ok {
+			return NumberDigitSystem(0),
+				fmt.Errorf(ePrefix+
+					"\n'valueString' did NOT MATCH a valid NumberDigitSystem Specification.\n"+
+					"valueString='%v'\n", valueString)
+		}
+
+	} else {
+
+		numberDigitSystem, ok = mNumberDigitSystemLwrCaseStringToCode[strings.ToLower(valueString)]
+
+		if !ok {
+			return NumberDigitSystem(0),
+				fmt.Errorf(ePrefix+
+					"\n'valueString' did NOT MATCH a valid NumberDigitSystem Specification.\n"+
+					"valueString='%v'\n", valueString)
+		}
+	}
+
+	return numberDigitSystem, nil
+}
+
+// XReturnNoneIfInvalid - Provides a standardized value for invalid
+// instances of enumeration NumberDigitSystem.
+//
+// If the current instance of NumberDigitSystem is invalid, this
+// method will always return a value of
+// NumberDigitSystem(0).None().
+//
+// # Background
+//
+// Enumeration NumberDigitSystem has an underlying type of
+// integer (int). This means the type could conceivably be set
+// to any integer value. This method ensures that all invalid
+// NumberDigitSystem instances are consistently classified
+// as 'None' (NumberDigitSystem(0).None()). Remember that
+// 'None' is considered a valid value.
+//
+// This is a standard utility method and is not part of the valid
+// enumerations for this type.
+func (numDigitSystem NumberDigitSystem) XReturnNoneIfInvalid() NumberDigitSystem {
+
+	lockNumberDigitSystemCode.Lock()
+
+	defer lockNumberDigitSystemCode.Unlock()
+
+	isValid := new(numberDigitSystemNanobot).
+		isValidNumberDigitSystem(numDigitSystem)
+
+	if !isValid {
+		return NumberDigitSystem(0)
+	}
+
+	return numDigitSystem
+}
+
+// XValue - This method returns the enumeration value of the
+// current NumberDigitSystem instance.
+//
+// This is a standard utility method and is NOT part of the
+// valid enumerations for this type.
+func (numDigitSystem NumberDigitSystem) XValue() NumberDigitSystem {
+
+	lockNumberDigitSystemCode.Lock()
+
+	defer lockNumberDigitSystemCode.Unlock()
+
+	return numDigitSystem
+}
+
+// XValueInt - This method returns the integer value of the
+// current NumberDigitSystem instance.
+//
+// This is a standard utility method and is NOT part of the valid
+// enumerations for this type.
+func (numDigitSystem NumberDigitSystem) XValueInt() int {
+
+	lockNumberDigitSystemCode.Lock()
+
+	defer lockNumberDigitSystemCode.Unlock()
+
+	return int(numDigitSystem)
+}
+
+// XZeroDigit - Returns the Unicode character representing the
+// digit zero ('0') in the digit system specified by the
+// current NumberDigitSystem instance.
+//
+// If the current NumberDigitSystem instance is set to 'None'
+// or is invalid, this method returns the Latin (ASCII) digit
+// zero ('0').
+//
+// This is a standard utility method and is NOT part of the valid
+// enumerations for this type.
+func (numDigitSystem NumberDigitSystem) XZeroDigit() rune {
+
+	lockNumberDigitSystemCode.Lock()
+
+	defer lockNumberDigitSystemCode.Unlock()
+
+	zeroDigit, ok := mNumberDigitSystemZeroDigit[numDigitSystem]
+
+	if !ok {
+		return '0'
+	}
+
+	return zeroDigit
+}
+
+// NumDigitSys - public global constant of type
+// NumberDigitSystem.
+//
+// This variable serves as an easier, shorthand technique for
+// accessing NumberDigitSystem values.
+//
+// For easy access to these enumeration values, use the global
+// variable NumDigitSys.
+//
+//	Example: NumDigitSys.ArabicIndic()
+//
+// Otherwise you will need to use the formal syntax.
+//
+//	Example: NumberDigitSystem(0).ArabicIndic()
+//
+// Usage:
+//
+//	NumDigitSys.None()
+//	NumDigitSys.Latin()
+//	NumDigitSys.ArabicIndic()
+//	NumDigitSys.ExtendedArabicIndic()
+//	NumDigitSys.Devanagari()
+//	NumDigitSys.Thai()
+//	NumDigitSys.FullWidth()
+const NumDigitSys = NumberDigitSystem(0)
+
+// numberDigitSystemNanobot - Provides helper methods for
+// enumeration NumberDigitSystem.
+type numberDigitSystemNanobot struct {
+	lock *sync.Mutex
+}
+
+// isValidNumberDigitSystem - Receives an instance of
+// NumberDigitSystem and returns a boolean value signaling
+// whether that NumberDigitSystem instance is valid.
+//
+// If the passed instance of NumberDigitSystem is valid, this
+// method returns 'true'.
+//
+// The enumeration value "None" is considered VALID.
+//
+// This is a standard utility method and is not part of the valid
+// NumberDigitSystem enumeration.
+func (numDigitSystemNanobot *numberDigitSystemNanobot) isValidNumberDigitSystem(
+	numDigitSystem NumberDigitSystem) bool {
+
+	if numDigitSystemNanobot.lock == nil {
+		numDigitSystemNanobot.lock = new(sync.Mutex)
+	}
+
+	numDigitSystemNanobot.lock.Lock()
+
+	defer numDigitSystemNanobot.lock.Unlock()
+
+	if numDigitSystem < 0 ||
+		numDigitSystem > 6 {
+		return false
+	}
+
+	return true
+}
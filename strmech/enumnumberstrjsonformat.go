@@ -0,0 +1,511 @@
+package strmech
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+//	Lock lockNumberStrJsonFormat before accessing these
+//	maps!
+
+var mNumberStrJsonFormatCodeToString = map[NumberStrJsonFormat]string{
+	NumberStrJsonFormat(0): "None",
+	NumberStrJsonFormat(1): "QuotedString",
+	NumberStrJsonFormat(2): "BareNumber",
+}
+
+var mNumberStrJsonFormatStringToCode = map[string]NumberStrJsonFormat{
+	"None":         NumberStrJsonFormat(0),
+	"QuotedString": NumberStrJsonFormat(1),
+	"BareNumber":   NumberStrJsonFormat(2),
+}
+
+var mNumberStrJsonFormatLwrCaseStringToCode = map[string]NumberStrJsonFormat{
+	"none":         NumberStrJsonFormat(0),
+	"quotedstring": NumberStrJsonFormat(1),
+	"barenumber":   NumberStrJsonFormat(2),
+}
+
+//	NumberStrJsonFormat
+//
+//	The 'Number String JSON Format' is an enumeration of
+//	type codes used to specify how the numeric value of a
+//	NumberStrKernel instance will be encoded when that
+//	instance is marshalled to JSON.
+//
+// ----------------------------------------------------------------
+//
+// # Background
+//
+//	The JSON standard does not limit the precision of
+//	numeric values. However, many JSON consumers,
+//	including JavaScript, decode JSON numbers as 64-bit
+//	floating point values. Numeric values with more than
+//	15 or 16 significant digits will lose precision when
+//	decoded by these consumers.
+//
+//	Encoding the numeric value as a quoted string
+//	guarantees that all digits will be preserved
+//	regardless of the consumer. Encoding the numeric
+//	value as a bare JSON number produces output which is
+//	more natural for consumers that support arbitrary
+//	precision numbers.
+//
+//	In both cases, the numeric value is formatted as a
+//	Native Number String:
+//
+//		Quoted String:	"-1234.5678"
+//		Bare Number:	-1234.5678
+//
+// ----------------------------------------------------------------
+//
+// # Enumeration Values
+//
+//	Since the Go Programming Language does not directly
+//	support enumerations, the NumberStrJsonFormat type
+//	has been adapted to function in a manner similar to
+//	classic enumerations.
+//
+//	NumberStrJsonFormat is declared as a type 'int'. The
+//	method names associated with this type effectively
+//	represent an enumeration of JSON encoding formats.
+//	These methods are listed as follows:
+//
+//	Method				 Integer
+//	 Name				  Value
+//	------			 	 -------
+//
+//	None	   	   			0
+//
+//		Signals that 'NumberStrJsonFormat' has not been
+//		initialized and therefore has no value. This is
+//		an error condition.
+//
+//		Be advised that NumberStrKernel.MarshalJSON()
+//		will interpret 'None' as a request to apply the
+//		default format, 'QuotedString'.
+//
+//	QuotedString   			1
+//
+//		Signals that the numeric value will be encoded
+//		as a JSON string.
+//
+//			"-1234.5678"
+//
+//		This is the default format.
+//
+//	BareNumber				2
+//
+//		Signals that the numeric value will be encoded
+//		as a bare JSON number.
+//
+//			-1234.5678
+//
+// ----------------------------------------------------------------
+//
+// # Usage
+//
+//	For easy access to these enumeration values, use the
+//	global constant NumStrJsonFmt.
+//
+//		Example: NumStrJsonFmt.BareNumber()
+//
+//	Otherwise you will need to use the formal syntax.
+//
+//		Example: NumberStrJsonFormat(0).BareNumber()
+//
+//	Depending on your editor, intellisense (a.k.a.
+//	intelligent code completion) may not list the
+//	NumberStrJsonFormat methods in alphabetical order.
+//
+//	Be advised that all NumberStrJsonFormat methods
+//	beginning with 'X', as well as the method 'String()',
+//	are utility methods and not part of the enumeration
+//	values.
+type NumberStrJsonFormat int
+
+var lockNumberStrJsonFormat sync.Mutex
+
+// None
+//
+// Signals that 'NumberStrJsonFormat' has not been
+// initialized and therefore has no value.
+//
+// NumberStrKernel.MarshalJSON() will interpret 'None'
+// as a request to apply the default format,
+// 'QuotedString'.
+//
+// This method is part of the standard enumeration.
+func (numStrJsonFmt NumberStrJsonFormat) None() NumberStrJsonFormat {
+
+	lockNumberStrJsonFormat.Lock()
+
+	defer lockNumberStrJsonFormat.Unlock()
+
+	return NumberStrJsonFormat(0)
+}
+
+// QuotedString
+//
+// Signals that the numeric value will be encoded as a
+// JSON string.
+//
+//	"-1234.5678"
+//
+// This method is part of the standard enumeration.
+func (numStrJsonFmt NumberStrJsonFormat) QuotedString() NumberStrJsonFormat {
+
+	lockNumberStrJsonFormat.Lock()
+
+	defer lockNumberStrJsonFormat.Unlock()
+
+	return NumberStrJsonFormat(1)
+}
+
+// BareNumber
+//
+// Signals that the numeric value will be encoded as a
+// bare JSON number.
+//
+//	-1234.5678
+//
+// This method is part of the standard enumeration.
+func (numStrJsonFmt NumberStrJsonFormat) BareNumber() NumberStrJsonFormat {
+
+	lockNumberStrJsonFormat.Lock()
+
+	defer lockNumberStrJsonFormat.Unlock()
+
+	return NumberStrJsonFormat(2)
+}
+
+//	String
+//
+//	Returns a string with the name of the enumeration
+//	associated with this current instance of
+//	'NumberStrJsonFormat'.
+//
+//	This is a standard utility method and is not part of
+//	the valid enumerations for this type.
+//
+// ----------------------------------------------------------------
+//
+// # Usage
+//
+// t:= NumberStrJsonFormat(0).BareNumber()
+// str := t.String()
+//
+//	str is now equal to 'BareNumber'
+func (numStrJsonFmt NumberStrJsonFormat) String() string {
+
+	lockNumberStrJsonFormat.Lock()
+
+	defer lockNumberStrJsonFormat.Unlock()
+
+	result, ok := mNumberStrJsonFormatCodeToString[numStrJsonFmt]
+
+	if !ok {
+
+		return "Error: Number String JSON Format code is UNKNOWN!"
+
+	}
+
+	return result
+}
+
+//	XIsValid
+//
+//	Returns a boolean value signaling whether the current
+//	NumberStrJsonFormat value is valid.
+//
+//	Be advised, the enumeration value "None" is
+//	considered an INVALID selection for
+//	'NumberStrJsonFormat'.
+//
+//	This is a standard utility method and is not part of
+//	the valid enumerations for this type.
+//
+// ------------------------------------------------------------------------
+//
+// # Usage
+//
+//	 numStrJsonFmt :=
+//				NumberStrJsonFormat(0).BareNumber()
+//
+//	 isValid := numStrJsonFmt.XIsValid() // isValid == true
+//
+//	 numStrJsonFmt = NumberStrJsonFormat(-999)
+//
+//	 isValid = numStrJsonFmt.XIsValid() // isValid == false
+func (numStrJsonFmt NumberStrJsonFormat) XIsValid() bool {
+
+	lockNumberStrJsonFormat.Lock()
+
+	defer lockNumberStrJsonFormat.Unlock()
+
+	return new(numberStrJsonFormatNanobot).
+		isValidNumStrJsonFormat(
+			numStrJsonFmt)
+}
+
+//	XParseString
+//
+//	Receives a string and attempts to match it with the
+//	string value of a supported enumeration. If
+//	successful, a new instance of NumberStrJsonFormat is
+//	returned set to the value of the associated
+//	enumeration.
+//
+//	This is a standard utility method and is NOT part of
+//	the valid enumerations for this type.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	valueString			string
+//
+//		A string which will be matched against the
+//		enumeration string values. If 'valueString' is
+//		equal to one of the enumeration names, this
+//		method will proceed to successful completion and
+//		return the correct enumeration value.
+//
+//	caseSensitive		bool
+//
+//		If 'true' the search for enumeration names will
+//		be case-sensitive and will require an exact
+//		match. Therefore, 'barenumber' will NOT match the
+//		enumeration name, 'BareNumber'.
+//
+//		A case-sensitive search will match any of the
+//		following strings:
+//
+//			"None"
+//			"QuotedString"
+//			"BareNumber"
+//
+//		If 'false', a case-insensitive search is conducted
+//		for the enumeration name. In this example,
+//		'barenumber' WILL MATCH the enumeration name,
+//		'BareNumber'.
+//
+//		A case-insensitive search will match any of the
+//		following lower case names:
+//
+//			"none"
+//			"quotedstring"
+//			"barenumber"
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberStrJsonFormat
+//
+//		Upon successful completion, this method will
+//		return a new instance of NumberStrJsonFormat set
+//		to the value of the enumeration matched by the
+//		string search performed on input parameter,
+//		'valueString'.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If an
+//		error condition is encountered, this method will
+//		return an error type which encapsulates an
+//		appropriate error message.
+//
+// ----------------------------------------------------------------
+//
+// # Usage
+//
+//	t, err := NumberStrJsonFormat(0).
+//	             XParseString("BareNumber", true)
+//
+//	t is now equal to NumberStrJsonFormat(0).BareNumber()
+func (numStrJsonFmt NumberStrJsonFormat) XParseString(
+	valueString string,
+	caseSensitive bool) (NumberStrJsonFormat, error) {
+
+	lockNumberStrJsonFormat.Lock()
+
+	defer lockNumberStrJsonFormat.Unlock()
+
+	ePrefix := "NumberStrJsonFormat.XParseString() "
+
+	var ok bool
+	var numberStrJsonFormat NumberStrJsonFormat
+
+	if caseSensitive {
+
+		numberStrJsonFormat, ok =
+			mNumberStrJsonFormatStringToCode[valueString]
+
+		if !ok {
+			return NumberStrJsonFormat(0),
+				fmt.Errorf(ePrefix+
+					"\n'valueString' did NOT MATCH a valid Number String JSON Format value.\n"+
+					"valueString='%v'\n", valueString)
+		}
+
+	} else {
+
+		numberStrJsonFormat, ok =
+			mNumberStrJsonFormatLwrCaseStringToCode[strings.ToLower(valueString)]
+
+		if !ok {
+			return NumberStrJsonFormat(0),
+				fmt.Errorf(ePrefix+
+					"\n'valueString' did NOT MATCH a valid Number String JSON Format value.\n"+
+					"valueString='%v'\n", valueString)
+		}
+	}
+
+	return numberStrJsonFormat, nil
+}
+
+//	XReturnNoneIfInvalid
+//
+//	Provides a standardized value for invalid instances
+//	of enumeration NumberStrJsonFormat.
+//
+//	If the current instance of NumberStrJsonFormat is
+//	invalid, this method will always return a value of
+//	NumberStrJsonFormat(0).None().
+//
+// ----------------------------------------------------------------
+//
+// # Background
+//
+//	Enumeration NumberStrJsonFormat has an underlying
+//	type of integer (int). This means the type could
+//	conceivably be set to any integer value. This method
+//	ensures that all invalid NumberStrJsonFormat
+//	instances are consistently classified as 'None'
+//	(NumberStrJsonFormat(0).None()). Remember that 'None'
+//	is considered an INVALID selection for
+//	'NumberStrJsonFormat'.
+//
+//	This is a standard utility method and is not part of
+//	the valid enumerations for this type.
+func (numStrJsonFmt NumberStrJsonFormat) XReturnNoneIfInvalid() NumberStrJsonFormat {
+
+	lockNumberStrJsonFormat.Lock()
+
+	defer lockNumberStrJsonFormat.Unlock()
+
+	isValid := new(numberStrJsonFormatNanobot).
+		isValidNumStrJsonFormat(numStrJsonFmt)
+
+	if !isValid {
+		return NumberStrJsonFormat(0)
+	}
+
+	return numStrJsonFmt
+}
+
+// XValue
+//
+// This method returns the enumeration value of the
+// current NumberStrJsonFormat instance.
+//
+// This is a standard utility method and is NOT part of
+// the valid enumerations for this type.
+func (numStrJsonFmt NumberStrJsonFormat) XValue() NumberStrJsonFormat {
+
+	lockNumberStrJsonFormat.Lock()
+
+	defer lockNumberStrJsonFormat.Unlock()
+
+	return numStrJsonFmt
+}
+
+// XValueInt
+//
+// This method returns the integer value of the current
+// NumberStrJsonFormat instance.
+//
+// This is a standard utility method and is NOT part of
+// the valid enumerations for this type.
+func (numStrJsonFmt NumberStrJsonFormat) XValueInt() int {
+
+	lockNumberStrJsonFormat.Lock()
+
+	defer lockNumberStrJsonFormat.Unlock()
+
+	return int(numStrJsonFmt)
+}
+
+//	NumStrJsonFmt
+//
+//	Public global constant of type NumberStrJsonFormat.
+//
+//	This variable serves as an easier, shorthand
+//	technique for accessing NumberStrJsonFormat values.
+//
+//	For easy access to these enumeration values, use the
+//	global constant NumStrJsonFmt.
+//
+//		Example:
+//
+//			NumStrJsonFmt.BareNumber()
+//
+//	Otherwise you will need to use the formal syntax.
+//
+//	Example:
+//
+//		NumberStrJsonFormat(0).BareNumber()
+//
+// ----------------------------------------------------------------
+//
+// # Usage
+//
+//	NumStrJsonFmt.None()
+//	NumStrJsonFmt.QuotedString()
+//	NumStrJsonFmt.BareNumber()
+const NumStrJsonFmt = NumberStrJsonFormat(0)
+
+// numberStrJsonFormatNanobot
+//
+// Provides helper methods for enumeration
+// NumberStrJsonFormat.
+type numberStrJsonFormatNanobot struct {
+	lock *sync.Mutex
+}
+
+// isValidNumStrJsonFormat
+//
+// Receives an instance of NumberStrJsonFormat and
+// returns a boolean value signaling whether that
+// NumberStrJsonFormat instance is valid.
+//
+// If the passed instance of NumberStrJsonFormat is
+// valid, this method returns 'true'.
+//
+// Be advised, the enumeration value "None" is considered
+// an INVALID selection for 'NumberStrJsonFormat'.
+//
+// This is a standard utility method and is not part of
+// the valid NumberStrJsonFormat enumeration.
+func (numStrJsonFmtNanobot *numberStrJsonFormatNanobot) isValidNumStrJsonFormat(
+	numStrJsonFmt NumberStrJsonFormat) bool {
+
+	if numStrJsonFmtNanobot.lock == nil {
+		numStrJsonFmtNanobot.lock = new(sync.Mutex)
+	}
+
+	numStrJsonFmtNanobot.lock.Lock()
+
+	defer numStrJsonFmtNanobot.lock.Unlock()
+
+	if numStrJsonFmt < 1 ||
+		numStrJsonFmt > 2 {
+
+		return false
+	}
+
+	return true
+}
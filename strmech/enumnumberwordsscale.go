@@ -0,0 +1,529 @@
+package strmech
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+//	Lock lockNumberWordsScale before accessing these
+//	maps!
+
+var mNumberWordsScaleCodeToString = map[NumberWordsScale]string{
+	NumberWordsScale(0): "None",
+	NumberWordsScale(1): "ShortScale",
+	NumberWordsScale(2): "LongScale",
+}
+
+var mNumberWordsScaleStringToCode = map[string]NumberWordsScale{
+	"None":       NumberWordsScale(0),
+	"ShortScale": NumberWordsScale(1),
+	"LongScale":  NumberWordsScale(2),
+}
+
+var mNumberWordsScaleLwrCaseStringToCode = map[string]NumberWordsScale{
+	"none":       NumberWordsScale(0),
+	"shortscale": NumberWordsScale(1),
+	"longscale":  NumberWordsScale(2),
+}
+
+//	NumberWordsScale
+//
+//	The 'Number Words Scale' is an enumeration of type
+//	codes used to select the naming system applied to
+//	large numbers when numeric values are spelled out as
+//	words.
+//
+// ----------------------------------------------------------------
+//
+// # Terminology
+//
+//	The short scale and the long scale are two different
+//	systems for naming integer powers of ten.
+//
+//	In the short scale, every new term greater than
+//	million is one thousand times larger than the
+//	previous term. A 'billion' is one thousand millions
+//	(10^9) and a 'trillion' is one thousand billions
+//	(10^12). The short scale is used in the United States
+//	and in modern British English.
+//
+//	In the long scale, every new term greater than
+//	million is one million times larger than the previous
+//	term. A 'billion' is one million millions (10^12) and
+//	a 'trillion' is one million billions (10^18). In
+//	French and German the intermediate values are named
+//	with the suffix '-illiard' ('milliard' = 10^9). In
+//	English the intermediate values are named 'thousand
+//	million' (10^9), 'thousand billion' (10^15) etc. The
+//	long scale is used in France and Germany.
+//
+//		Wikipedia
+//		https://en.wikipedia.org/wiki/Long_and_short_scales
+//
+// ----------------------------------------------------------------
+//
+// # Enumeration Values
+//
+//	Since the Go Programming Language does not directly
+//	support enumerations, the NumberWordsScale type has
+//	been adapted to function in a manner similar to
+//	classic enumerations.
+//
+//	NumberWordsScale is declared as a type 'int'. The
+//	method names associated with this type effectively
+//	represent an enumeration of number scale naming
+//	systems. These methods are listed as follows:
+//
+//	Method				 Integer
+//	 Name				  Value
+//	------			 	 -------
+//
+//	None	   	   			0
+//
+//		Signals that 'NumberWordsScale' has not been
+//		initialized and therefore has no value. This is
+//		an error condition.
+//
+//		Be advised that methods which spell out numbers
+//		as words will interpret 'None' as a request to
+//		apply the default scale for the language being
+//		used. Reference method:
+//			NumberStrKernel.FmtNumberWords()
+//
+//	ShortScale	   			1
+//
+//		Signals that large numbers will be named using
+//		the short scale.
+//
+//			1,000,000			one million
+//			1,000,000,000		one billion
+//			1,000,000,000,000	one trillion
+//
+//		The short scale is the default for English.
+//
+//	LongScale				2
+//
+//		Signals that large numbers will be named using
+//		the long scale.
+//
+//			1,000,000			one million
+//			1,000,000,000		one thousand million
+//								un milliard
+//								eine Milliarde
+//			1,000,000,000,000	one billion
+//
+//		The long scale is the default for French and
+//		German.
+//
+// ----------------------------------------------------------------
+//
+// # Usage
+//
+//	For easy access to these enumeration values, use the
+//	global constant NumWordsScale.
+//
+//		Example: NumWordsScale.ShortScale()
+//
+//	Otherwise you will need to use the formal syntax.
+//
+//		Example: NumberWordsScale(0).ShortScale()
+//
+//	Depending on your editor, intellisense (a.k.a.
+//	intelligent code completion) may not list the
+//	NumberWordsScale methods in alphabetical order.
+//
+//	Be advised that all NumberWordsScale methods
+//	beginning with 'X', as well as the method 'String()',
+//	are utility methods and not part of the enumeration
+//	values.
+type NumberWordsScale int
+
+var lockNumberWordsScale sync.Mutex
+
+// None
+//
+// Signals that 'NumberWordsScale' has not been
+// initialized and therefore has no value.
+//
+// Methods which spell out numbers as words will
+// interpret 'None' as a request to apply the default
+// scale for the language being used.
+//
+// This method is part of the standard enumeration.
+func (numWordsScale NumberWordsScale) None() NumberWordsScale {
+
+	lockNumberWordsScale.Lock()
+
+	defer lockNumberWordsScale.Unlock()
+
+	return NumberWordsScale(0)
+}
+
+// ShortScale
+//
+// Signals that large numbers will be named using the
+// short scale. Each new term greater than million is
+// one thousand times larger than the previous term.
+//
+//	1,000,000,000 = one billion
+//
+// This method is part of the standard enumeration.
+func (numWordsScale NumberWordsScale) ShortScale() NumberWordsScale {
+
+	lockNumberWordsScale.Lock()
+
+	defer lockNumberWordsScale.Unlock()
+
+	return NumberWordsScale(1)
+}
+
+// LongScale
+//
+// Signals that large numbers will be named using the
+// long scale. Each new term greater than million is
+// one million times larger than the previous term.
+//
+//	1,000,000,000 = one thousand million
+//	1,000,000,000 = un milliard
+//	1,000,000,000 = eine Milliarde
+//
+// This method is part of the standard enumeration.
+func (numWordsScale NumberWordsScale) LongScale() NumberWordsScale {
+
+	lockNumberWordsScale.Lock()
+
+	defer lockNumberWordsScale.Unlock()
+
+	return NumberWordsScale(2)
+}
+
+//	String
+//
+//	Returns a string with the name of the enumeration
+//	associated with this current instance of
+//	'NumberWordsScale'.
+//
+//	This is a standard utility method and is not part of
+//	the valid enumerations for this type.
+//
+// ----------------------------------------------------------------
+//
+// # Usage
+//
+// t:= NumberWordsScale(0).LongScale()
+// str := t.String()
+//
+//	str is now equal to 'LongScale'
+func (numWordsScale NumberWordsScale) String() string {
+
+	lockNumberWordsScale.Lock()
+
+	defer lockNumberWordsScale.Unlock()
+
+	result, ok := mNumberWordsScaleCodeToString[numWordsScale]
+
+	if !ok {
+
+		return "Error: Number Words Scale code is UNKNOWN!"
+
+	}
+
+	return result
+}
+
+//	XIsValid
+//
+//	Returns a boolean value signaling whether the current
+//	NumberWordsScale value is valid.
+//
+//	Be advised, the enumeration value "None" is
+//	considered an INVALID selection for
+//	'NumberWordsScale'.
+//
+//	This is a standard utility method and is not part of
+//	the valid enumerations for this type.
+//
+// ------------------------------------------------------------------------
+//
+// # Usage
+//
+//	 numWordsScale :=
+//				NumberWordsScale(0).ShortScale()
+//
+//	 isValid := numWordsScale.XIsValid() // isValid == true
+//
+//	 numWordsScale = NumberWordsScale(-999)
+//
+//	 isValid = numWordsScale.XIsValid() // isValid == false
+func (numWordsScale NumberWordsScale) XIsValid() bool {
+
+	lockNumberWordsScale.Lock()
+
+	defer lockNumberWordsScale.Unlock()
+
+	return new(numberWordsScaleNanobot).
+		isValidNumWordsScale(
+			numWordsScale)
+}
+
+//	XParseString
+//
+//	Receives a string and attempts to match it with the
+//	string value of a supported enumeration. If
+//	successful, a new instance of NumberWordsScale is
+//	returned set to the value of the associated
+//	enumeration.
+//
+//	This is a standard utility method and is NOT part of
+//	the valid enumerations for this type.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	valueString			string
+//
+//		A string which will be matched against the
+//		enumeration string values. If 'valueString' is
+//		equal to one of the enumeration names, this
+//		method will proceed to successful completion and
+//		return the correct enumeration value.
+//
+//	caseSensitive		bool
+//
+//		If 'true' the search for enumeration names will
+//		be case-sensitive and will require an exact
+//		match. Therefore, 'longscale' will NOT match the
+//		enumeration name, 'LongScale'.
+//
+//		A case-sensitive search will match any of the
+//		following strings:
+//
+//			"None"
+//			"ShortScale"
+//			"LongScale"
+//
+//		If 'false', a case-insensitive search is conducted
+//		for the enumeration name. In this example,
+//		'longscale' WILL MATCH the enumeration name,
+//		'LongScale'.
+//
+//		A case-insensitive search will match any of the
+//		following lower case names:
+//
+//			"none"
+//			"shortscale"
+//			"longscale"
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberWordsScale
+//
+//		Upon successful completion, this method will
+//		return a new instance of NumberWordsScale set to
+//		the value of the enumeration matched by the
+//		string search performed on input parameter,
+//		'valueString'.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If an
+//		error condition is encountered, this method will
+//		return an error type which encapsulates an
+//		appropriate error message.
+//
+// ----------------------------------------------------------------
+//
+// # Usage
+//
+//	t, err := NumberWordsScale(0).
+//	             XParseString("LongScale", true)
+//
+//	t is now equal to NumberWordsScale(0).LongScale()
+func (numWordsScale NumberWordsScale) XParseString(
+	valueString string,
+	caseSensitive bool) (NumberWordsScale, error) {
+
+	lockNumberWordsScale.Lock()
+
+	defer lockNumberWordsScale.Unlock()
+
+	ePrefix := "NumberWordsScale.XParseString() "
+
+	var ok bool
+	var numberWordsScale NumberWordsScale
+
+	if caseSensitive {
+
+		numberWordsScale, ok =
+			mNumberWordsScaleStringToCode[valueString]
+
+		if !ok {
+			return NumberWordsScale(0),
+				fmt.Errorf(ePrefix+
+					"\n'valueString' did NOT MATCH a valid Number Words Scale value.\n"+
+					"valueString='%v'\n", valueString)
+		}
+
+	} else {
+
+		numberWordsScale, ok =
+			mNumberWordsScaleLwrCaseStringToCode[strings.ToLower(valueString)]
+
+		if !ok {
+			return NumberWordsScale(0),
+				fmt.Errorf(ePrefix+
+					"\n'valueString' did NOT MATCH a valid Number Words Scale value.\n"+
+					"valueString='%v'\n", valueString)
+		}
+	}
+
+	return numberWordsScale, nil
+}
+
+//	XReturnNoneIfInvalid
+//
+//	Provides a standardized value for invalid instances
+//	of enumeration NumberWordsScale.
+//
+//	If the current instance of NumberWordsScale is
+//	invalid, this method will always return a value of
+//	NumberWordsScale(0).None().
+//
+// ----------------------------------------------------------------
+//
+// # Background
+//
+//	Enumeration NumberWordsScale has an underlying type
+//	of integer (int). This means the type could
+//	conceivably be set to any integer value. This method
+//	ensures that all invalid NumberWordsScale instances
+//	are consistently classified as 'None'
+//	(NumberWordsScale(0).None()). Remember that 'None'
+//	is considered an INVALID selection for
+//	'NumberWordsScale'.
+//
+//	This is a standard utility method and is not part of
+//	the valid enumerations for this type.
+func (numWordsScale NumberWordsScale) XReturnNoneIfInvalid() NumberWordsScale {
+
+	lockNumberWordsScale.Lock()
+
+	defer lockNumberWordsScale.Unlock()
+
+	isValid := new(numberWordsScaleNanobot).
+		isValidNumWordsScale(numWordsScale)
+
+	if !isValid {
+		return NumberWordsScale(0)
+	}
+
+	return numWordsScale
+}
+
+// XValue
+//
+// This method returns the enumeration value of the
+// current NumberWordsScale instance.
+//
+// This is a standard utility method and is NOT part of
+// the valid enumerations for this type.
+func (numWordsScale NumberWordsScale) XValue() NumberWordsScale {
+
+	lockNumberWordsScale.Lock()
+
+	defer lockNumberWordsScale.Unlock()
+
+	return numWordsScale
+}
+
+// XValueInt
+//
+// This method returns the integer value of the current
+// NumberWordsScale instance.
+//
+// This is a standard utility method and is NOT part of
+// the valid enumerations for this type.
+func (numWordsScale NumberWordsScale) XValueInt() int {
+
+	lockNumberWordsScale.Lock()
+
+	defer lockNumberWordsScale.Unlock()
+
+	return int(numWordsScale)
+}
+
+//	NumWordsScale
+//
+//	Public global constant of type NumberWordsScale.
+//
+//	This variable serves as an easier, shorthand
+//	technique for accessing NumberWordsScale values.
+//
+//	For easy access to these enumeration values, use the
+//	global constant NumWordsScale.
+//
+//		Example:
+//
+//			NumWordsScale.LongScale()
+//
+//	Otherwise you will need to use the formal syntax.
+//
+//	Example:
+//
+//		NumberWordsScale(0).LongScale()
+//
+// ----------------------------------------------------------------
+//
+// # Usage
+//
+//	NumWordsScale.None()
+//	NumWordsScale.ShortScale()
+//	NumWordsScale.LongScale()
+const NumWordsScale = NumberWordsScale(0)
+
+// numberWordsScaleNanobot
+//
+// Provides helper methods for enumeration
+// NumberWordsScale.
+type numberWordsScaleNanobot struct {
+	lock *sync.Mutex
+}
+
+// isValidNumWordsScale
+//
+// Receives an instance of NumberWordsScale and returns a
+// boolean value signaling whether that NumberWordsScale
+// instance is valid.
+//
+// If the passed instance of NumberWordsScale is valid,
+// this method returns 'true'.
+//
+// Be advised, the enumeration value "None" is considered
+// an INVALID selection for 'NumberWordsScale'.
+//
+// This is a standard utility method and is not part of
+// the valid NumberWordsScale enumeration.
+func (numWordsScaleNanobot *numberWordsScaleNanobot) isValidNumWordsScale(
+	numWordsScale NumberWordsScale) bool {
+
+	if numWordsScaleNanobot.lock == nil {
+		numWordsScaleNanobot.lock = new(sync.Mutex)
+	}
+
+	numWordsScaleNanobot.lock.Lock()
+
+	defer numWordsScaleNanobot.lock.Unlock()
+
+	if numWordsScale < 1 ||
+		numWordsScale > 2 {
+
+		return false
+	}
+
+	return true
+}
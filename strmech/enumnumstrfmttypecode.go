@@ -10,14 +10,19 @@ import (
 // the lock on 'lockNumStrFormatTypeCode'.
 
 var mNumStrFmtTypeCodeToString = map[NumStrFormatTypeCode]string{
-	NumStrFormatTypeCode(0): "None",
-	NumStrFormatTypeCode(1): "AbsoluteValue",
-	NumStrFormatTypeCode(2): "Binary",
-	NumStrFormatTypeCode(3): "Currency",
-	NumStrFormatTypeCode(4): "Hexadecimal",
-	NumStrFormatTypeCode(5): "Octal",
-	NumStrFormatTypeCode(6): "SignedNumber",
-	NumStrFormatTypeCode(7): "ScientificNotation",
+	NumStrFormatTypeCode(0):  "None",
+	NumStrFormatTypeCode(1):  "AbsoluteValue",
+	NumStrFormatTypeCode(2):  "Binary",
+	NumStrFormatTypeCode(3):  "Currency",
+	NumStrFormatTypeCode(4):  "Hexadecimal",
+	NumStrFormatTypeCode(5):  "Octal",
+	NumStrFormatTypeCode(6):  "SignedNumber",
+	NumStrFormatTypeCode(7):  "ScientificNotation",
+	NumStrFormatTypeCode(8):  "RomanNumeral",
+	NumStrFormatTypeCode(9):  "Percent",
+	NumStrFormatTypeCode(10): "PerMille",
+	NumStrFormatTypeCode(11): "BasisPoint",
+	NumStrFormatTypeCode(12): "Ordinal",
 }
 
 var mNumStrFmtTypeCodeStringToCode = map[string]NumStrFormatTypeCode{
@@ -43,6 +48,19 @@ var mNumStrFmtTypeCodeStringToCode = map[string]NumStrFormatTypeCode{
 	"Scientific Form":      NumStrFormatTypeCode(7),
 	"Standard Index Form":  NumStrFormatTypeCode(7),
 	"Standard Form":        NumStrFormatTypeCode(7),
+	"RomanNumeral":         NumStrFormatTypeCode(8),
+	"Roman Numeral":        NumStrFormatTypeCode(8),
+	"Roman":                NumStrFormatTypeCode(8),
+	"Percent":              NumStrFormatTypeCode(9),
+	"Percentage":           NumStrFormatTypeCode(9),
+	"PerMille":             NumStrFormatTypeCode(10),
+	"Per Mille":            NumStrFormatTypeCode(10),
+	"Permille":             NumStrFormatTypeCode(10),
+	"BasisPoint":           NumStrFormatTypeCode(11),
+	"Basis Point":          NumStrFormatTypeCode(11),
+	"Basis Points":         NumStrFormatTypeCode(11),
+	"Ordinal":              NumStrFormatTypeCode(12),
+	"Ordinal Number":       NumStrFormatTypeCode(12),
 }
 
 var mNumStrFmtTypeCodeLwrCaseStringToCode = map[string]NumStrFormatTypeCode{
@@ -68,6 +86,18 @@ var mNumStrFmtTypeCodeLwrCaseStringToCode = map[string]NumStrFormatTypeCode{
 	"scientific form":      NumStrFormatTypeCode(7),
 	"standard index form":  NumStrFormatTypeCode(7),
 	"standard form":        NumStrFormatTypeCode(7),
+	"romannumeral":         NumStrFormatTypeCode(8),
+	"roman numeral":        NumStrFormatTypeCode(8),
+	"roman":                NumStrFormatTypeCode(8),
+	"percent":              NumStrFormatTypeCode(9),
+	"percentage":           NumStrFormatTypeCode(9),
+	"permille":             NumStrFormatTypeCode(10),
+	"per mille":            NumStrFormatTypeCode(10),
+	"basispoint":           NumStrFormatTypeCode(11),
+	"basis point":          NumStrFormatTypeCode(11),
+	"basis points":         NumStrFormatTypeCode(11),
+	"ordinal":              NumStrFormatTypeCode(12),
+	"ordinal number":       NumStrFormatTypeCode(12),
 }
 
 // NumStrFormatTypeCode - The 'Number String Format Type Code' is
@@ -167,6 +197,43 @@ var mNumStrFmtTypeCodeLwrCaseStringToCode = map[string]NumStrFormatTypeCode{
 //
 //	Examples: '2.652e+8'     '2.652e-8'
 //
+// RomanNumeral         (8)
+//
+//	Signals that positive integer values will be displayed in
+//	text as Roman Numerals.
+//
+//	Examples: 'MCMXCIV'      'mcmxciv'
+//
+// Percent              (9)
+//
+//	Signals that the numeric value will be multiplied by 100
+//	and displayed in text as a percentage with a percent
+//	symbol ('%').
+//
+//	Examples: 0.1234 = '12.34 %'   0.1234 = '12.34%'
+//
+// PerMille             (10)
+//
+//	Signals that the numeric value will be multiplied by
+//	1,000 and displayed in text with a per mille symbol
+//	('‰').
+//
+//	Examples: 0.1234 = '123.4 ‰'
+//
+// BasisPoint           (11)
+//
+//	Signals that the numeric value will be multiplied by
+//	10,000 and displayed in text as basis points ('bp').
+//
+//	Examples: 0.1234 = '1,234 bp'
+//
+// Ordinal              (12)
+//
+//	Signals that non-negative integer values will be displayed
+//	in text as ordinal numbers.
+//
+//	Examples: '1st'   '23rd'   '1er'   '2e'   '1.'
+//
 // ----------------------------------------------------------------
 //
 // # USAGE
@@ -349,6 +416,118 @@ func (nStrValSpec NumStrFormatTypeCode) ScientificNotation() NumStrFormatTypeCod
 	return NumStrFormatTypeCode(7)
 }
 
+// RomanNumeral - The 'Roman Numeral' specification signals that
+// positive integer values will be displayed in text number
+// strings as Roman Numerals.
+//
+//	Example Text Display:
+//	    "MCMXCIV"
+//	    "mcmxciv"
+//
+// Values greater than 3,999 may be displayed using the overline
+// or vinculum notation, which multiplies the overlined numeral
+// by 1,000.
+//
+// Reference:
+//
+//	https://en.wikipedia.org/wiki/Roman_numerals
+//
+// This method is part of the standard enumeration.
+func (nStrValSpec NumStrFormatTypeCode) RomanNumeral() NumStrFormatTypeCode {
+
+	lockNumStrFormatTypeCode.Lock()
+
+	defer lockNumStrFormatTypeCode.Unlock()
+
+	return NumStrFormatTypeCode(8)
+}
+
+// Percent - The 'Percent' specification signals that numeric
+// values will be multiplied by 100 and displayed in text
+// number strings as percentages.
+//
+//	Example Text Display:
+//	    0.1234 = "12.34%"
+//	    0.1234 = "12,34 %"
+//
+// The percent symbol and its placement are controlled by the
+// Number Symbol Group configured for the Number String Format
+// Specification.
+//
+// This method is part of the standard enumeration.
+func (nStrValSpec NumStrFormatTypeCode) Percent() NumStrFormatTypeCode {
+
+	lockNumStrFormatTypeCode.Lock()
+
+	defer lockNumStrFormatTypeCode.Unlock()
+
+	return NumStrFormatTypeCode(9)
+}
+
+// PerMille - The 'Per Mille' specification signals that
+// numeric values will be multiplied by 1,000 and displayed in
+// text number strings as parts per thousand.
+//
+//	Example Text Display:
+//	    0.1234 = "123.4‰"
+//	    0.1234 = "123,4 ‰"
+//
+// The per mille symbol and its placement are controlled by the
+// Number Symbol Group configured for the Number String Format
+// Specification.
+//
+// This method is part of the standard enumeration.
+func (nStrValSpec NumStrFormatTypeCode) PerMille() NumStrFormatTypeCode {
+
+	lockNumStrFormatTypeCode.Lock()
+
+	defer lockNumStrFormatTypeCode.Unlock()
+
+	return NumStrFormatTypeCode(10)
+}
+
+// BasisPoint - The 'Basis Point' specification signals that
+// numeric values will be multiplied by 10,000 and displayed in
+// text number strings as basis points. One basis point is
+// equal to one hundredth of one percent.
+//
+//	Example Text Display:
+//	    0.1234 = "1,234 bp"
+//
+// The basis point symbol and its placement are controlled by
+// the Number Symbol Group configured for the Number String
+// Format Specification.
+//
+// This method is part of the standard enumeration.
+func (nStrValSpec NumStrFormatTypeCode) BasisPoint() NumStrFormatTypeCode {
+
+	lockNumStrFormatTypeCode.Lock()
+
+	defer lockNumStrFormatTypeCode.Unlock()
+
+	return NumStrFormatTypeCode(11)
+}
+
+// Ordinal - The 'Ordinal' specification signals that
+// non-negative integer values will be displayed in text number
+// strings as ordinal numbers using the suffix rules of a
+// designated language.
+//
+//	Example Text Display:
+//	    English: 1 = "1st"  23 = "23rd"  111 = "111th"
+//	    French:  1 = "1er"   2 = "2e"
+//	    German:  1 = "1."
+//
+// This method is part of the standard enumeration.
+func (nStrValSpec NumStrFormatTypeCode) Ordinal() NumStrFormatTypeCode {
+
+	lockNumStrFormatTypeCode.Lock()
+
+	defer lockNumStrFormatTypeCode.Unlock()
+
+	return NumStrFormatTypeCode(12)
+}
+
 // String - Returns a string with the name of the enumeration associated
 // with this current instance of 'NumStrFormatTypeCode'.
 //
@@ -452,6 +631,19 @@ func (nStrValSpec NumStrFormatTypeCode) XIsValid() bool {
 //     "ScientificNotation"
 //     "Scientific Notation"
 //     "SCI"
+//     "RomanNumeral"
+//     "Roman Numeral"
+//     "Roman"
+//     "Percent"
+//     "Percentage"
+//     "PerMille"
+//     "Per Mille"
+//     "Permille"
+//     "BasisPoint"
+//     "Basis Point"
+//     "Basis Points"
+//     "Ordinal"
+//     "Ordinal Number"
 //
 //     If 'false', a case-insensitive search is conducted for the
 //     enumeration name. In this example, 'scientificnotation'
@@ -479,6 +671,18 @@ func (nStrValSpec NumStrFormatTypeCode) XIsValid() bool {
 //     "scientific notation"
 //     "sci"
 //     "scientific form"
+//     "romannumeral"
+//     "roman numeral"
+//     "roman"
+//     "percent"
+//     "percentage"
+//     "permille"
+//     "per mille"
+//     "basispoint"
+//     "basis point"
+//     "basis points"
+//     "ordinal"
+//     "ordinal number"
 //
 // ------------------------------------------------------------------------
 //
@@ -645,6 +849,11 @@ func (nStrValSpec NumStrFormatTypeCode) XValueInt() int {
 //	NumStrFmtType.Octal()
 //	NumStrFmtType.SignedNumber()
 //	NumStrFmtType.ScientificNotation()
+//	NumStrFmtType.RomanNumeral()
+//	NumStrFmtType.Percent()
+//	NumStrFmtType.PerMille()
+//	NumStrFmtType.BasisPoint()
+//	NumStrFmtType.Ordinal()
 const NumStrFmtType = NumStrFormatTypeCode(0)
 
 // numStrFmtTypeCodeNanobot - Provides helper methods for
@@ -677,7 +886,7 @@ func (numStrFmtTypeNanobot *numStrFmtTypeCodeNanobot) isValidNumStrFmtTypeCode(
 	defer numStrFmtTypeNanobot.lock.Unlock()
 
 	if numStrFmtTypeCode < 1 ||
-		numStrFmtTypeCode > 9 {
+		numStrFmtTypeCode > 12 {
 
 		return false
 	}
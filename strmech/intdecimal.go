@@ -1,6 +1,10 @@
 package strmech
 
-import "sync"
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
 
 // IntDecimal
 //
@@ -12,10 +16,40 @@ import "sync"
 // 'significand' by 10 to the power of 'exponent'.
 //
 //	numeric value = significand x 10^exponent
+//
+// IntDecimal is a fixed-point type. The exponent is
+// always less than or equal to zero and its magnitude
+// is equal to the 'scale', or number of fractional
+// digits, contained in the numeric value.
+//
+//	Example
+//		Numeric Value:	-1234.50
+//		significand:	[]int8{1,2,3,4,5,0}
+//		exponent:		[]int8{2} (10^-2)
+//		numberSign:		NumSignVal.Negative()
+//
+// Addition, subtraction and multiplication are always
+// exact. The scale may be changed with method
+// IntDecimal.SetScale(), which applies one of the
+// rounding algorithms defined by enumeration type
+// NumberRoundingType.
+//
+// IntDecimal is therefore suitable for storing monetary
+// values without the loss of precision associated with
+// floating point types.
 type IntDecimal struct {
 	significand Int8ArrayDto
+	//	The numeric digits of the absolute numeric value
+	//	with the radix point removed. Each element
+	//	contains a single digit (0-9). Leading zeros are
+	//	removed.
 
 	exponent Int8ArrayDto
+	//	The numeric digits of the absolute value of the
+	//	exponent. Since the exponent is always less than
+	//	or equal to zero, these digits represent the
+	//	scale, or number of fractional digits, contained
+	//	in the numeric value.
 
 	numberSign NumericSignValueType
 	//	An enumeration specifying the number sign associated
@@ -32,11 +66,1829 @@ type IntDecimal struct {
 
 	Description1 string
 	//	Optional. A name, label or narrative text used to
-	//	describe the current instance of Int8ArrayDto.
+	//	describe the current instance of IntDecimal.
 
 	Description2 string
 	//	Optional. A name, label or narrative text used to
-	//	describe the current instance of Int8ArrayDto.
+	//	describe the current instance of IntDecimal.
 
 	lock *sync.Mutex
 }
+
+// Add
+//
+// Adds the numeric value of input parameter 'addend' to
+// the numeric value of the current IntDecimal instance
+// and returns the exact sum as a new instance of
+// IntDecimal.
+//
+// The scale of the returned sum is equal to the greater
+// of the scales of the two operands.
+//
+// Neither the current IntDecimal instance nor 'addend'
+// will be modified.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	addend						*IntDecimal
+//
+//		A pointer to an instance of IntDecimal. The
+//		numeric value of this instance will be added to
+//		that of the current IntDecimal instance.
+//
+//		If 'addend' is a nil pointer, an error will be
+//		returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	sum							IntDecimal
+//
+//		If this method completes successfully, a new
+//		instance of IntDecimal will be returned
+//		containing the sum of the current IntDecimal
+//		numeric value and 'addend'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (intDecimal *IntDecimal) Add(
+	addend *IntDecimal,
+	errorPrefix interface{}) (
+	sum IntDecimal,
+	err error) {
+
+	if intDecimal.lock == nil {
+		intDecimal.lock = new(sync.Mutex)
+	}
+
+	intDecimal.lock.Lock()
+
+	defer intDecimal.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"IntDecimal."+
+			"Add()",
+		"")
+
+	if err != nil {
+		return sum, err
+	}
+
+	err = new(intDecimalNanobot).add(
+		&sum,
+		intDecimal,
+		addend,
+		false,
+		ePrefix.XCpy(
+			"sum<-intDecimal+addend"))
+
+	return sum, err
+}
+
+// Compare
+//
+// Compares the numeric value of the current IntDecimal
+// instance to that of input parameter
+// 'incomingIntDecimal' and returns an integer value:
+//
+//	-1 if current IntDecimal <  incomingIntDecimal
+//	 0 if current IntDecimal == incomingIntDecimal
+//	+1 if current IntDecimal >  incomingIntDecimal
+//
+// The comparison is based on numeric value only.
+// Therefore, '1.25' and '1.2500' are considered equal.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	incomingIntDecimal			*IntDecimal
+//
+//		A pointer to an instance of IntDecimal. The
+//		numeric value of this instance will be compared
+//		to that of the current IntDecimal instance.
+//
+//		If 'incomingIntDecimal' is a nil pointer, an
+//		error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	comparison					int
+//
+//		The comparison result will be set to one of three
+//		values:
+//
+//		-1	= current IntDecimal is less than
+//				'incomingIntDecimal'
+//		 0	= current IntDecimal is equal to
+//				'incomingIntDecimal'
+//		+1	= current IntDecimal is greater than
+//				'incomingIntDecimal'
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (intDecimal *IntDecimal) Compare(
+	incomingIntDecimal *IntDecimal,
+	errorPrefix interface{}) (
+	comparison int,
+	err error) {
+
+	if intDecimal.lock == nil {
+		intDecimal.lock = new(sync.Mutex)
+	}
+
+	intDecimal.lock.Lock()
+
+	defer intDecimal.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"IntDecimal."+
+			"Compare()",
+		"")
+
+	if err != nil {
+		return comparison, err
+	}
+
+	return new(intDecimalNanobot).compare(
+		intDecimal,
+		incomingIntDecimal,
+		ePrefix.XCpy(
+			"intDecimal vs incomingIntDecimal"))
+}
+
+// CopyIn
+//
+// Copies the data fields from an incoming instance of
+// IntDecimal ('incomingIntDecimal') to the data fields
+// of the current IntDecimal instance ('intDecimal').
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All data field values in the current IntDecimal
+//	instance ('intDecimal') will be deleted and
+//	overwritten.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	incomingIntDecimal			*IntDecimal
+//
+//		A pointer to an instance of IntDecimal. This
+//		method will NOT change the values of internal
+//		member variables contained in this instance.
+//
+//		All data values in this IntDecimal instance will
+//		be copied to the current IntDecimal instance
+//		('intDecimal').
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (intDecimal *IntDecimal) CopyIn(
+	incomingIntDecimal *IntDecimal,
+	errorPrefix interface{}) error {
+
+	if intDecimal.lock == nil {
+		intDecimal.lock = new(sync.Mutex)
+	}
+
+	intDecimal.lock.Lock()
+
+	defer intDecimal.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"IntDecimal."+
+			"CopyIn()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if incomingIntDecimal == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'incomingIntDecimal' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	new(intDecimalNanobot).copy(
+		intDecimal,
+		incomingIntDecimal)
+
+	return err
+}
+
+// CopyOut
+//
+// Returns a deep copy of the current IntDecimal
+// instance.
+func (intDecimal *IntDecimal) CopyOut() IntDecimal {
+
+	if intDecimal.lock == nil {
+		intDecimal.lock = new(sync.Mutex)
+	}
+
+	intDecimal.lock.Lock()
+
+	defer intDecimal.lock.Unlock()
+
+	newIntDecimal := IntDecimal{}
+
+	new(intDecimalNanobot).copy(
+		&newIntDecimal,
+		intDecimal)
+
+	return newIntDecimal
+}
+
+// Empty
+//
+// Resets all internal member variables for the current
+// instance of IntDecimal to their initial or zero
+// values. The resulting numeric value is zero.
+func (intDecimal *IntDecimal) Empty() {
+
+	if intDecimal.lock == nil {
+		intDecimal.lock = new(sync.Mutex)
+	}
+
+	intDecimal.lock.Lock()
+
+	intDecimal.significand.Int8Array = nil
+
+	intDecimal.exponent.Int8Array = nil
+
+	intDecimal.numberSign = NumSignVal.Zero()
+
+	intDecimal.Description1 = ""
+
+	intDecimal.Description2 = ""
+
+	intDecimal.lock.Unlock()
+
+	intDecimal.lock = nil
+}
+
+// Equal
+//
+// Returns 'true' if the numeric value of the current
+// IntDecimal instance is equal to that of the incoming
+// IntDecimal instance ('incomingIntDecimal').
+//
+// The comparison is based on numeric value only.
+// Therefore, '1.25' and '1.2500' are considered equal.
+// Descriptions are ignored.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	incomingIntDecimal			*IntDecimal
+//
+//		A pointer to an instance of IntDecimal. The
+//		numeric value of this instance will be compared
+//		to that of the current IntDecimal instance.
+//
+//		If 'incomingIntDecimal' is a nil pointer, an
+//		error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	areEqual					bool
+//
+//		If the numeric values of the current IntDecimal
+//		instance and 'incomingIntDecimal' are equal, this
+//		parameter is set to 'true'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (intDecimal *IntDecimal) Equal(
+	incomingIntDecimal *IntDecimal,
+	errorPrefix interface{}) (
+	areEqual bool,
+	err error) {
+
+	if intDecimal.lock == nil {
+		intDecimal.lock = new(sync.Mutex)
+	}
+
+	intDecimal.lock.Lock()
+
+	defer intDecimal.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"IntDecimal."+
+			"Equal()",
+		"")
+
+	if err != nil {
+		return areEqual, err
+	}
+
+	var comparison int
+
+	comparison,
+		err = new(intDecimalNanobot).compare(
+		intDecimal,
+		incomingIntDecimal,
+		ePrefix.XCpy(
+			"intDecimal vs incomingIntDecimal"))
+
+	if err != nil {
+		return areEqual, err
+	}
+
+	areEqual = comparison == 0
+
+	return areEqual, err
+}
+
+// Format
+//
+// Formats the numeric value of the current IntDecimal
+// instance as a Number String using the Number String
+// Format Specification passed as input parameter
+// 'numStrFmtSpec'.
+//
+// All fractional digits defined by the current scale
+// are included in the formatted Number String. No
+// rounding is applied. Use method IntDecimal.SetScale()
+// to change the number of fractional digits prior to
+// formatting.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrFmtSpec				NumStrFormatSpec
+//
+//		This Number String Format Specification contains
+//		all the parameters necessary to format the
+//		numeric value of the current IntDecimal instance
+//		as a Number String, including the radix point,
+//		integer separators, number sign symbols, currency
+//		symbols and number field specifications.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	string
+//
+//		If this method completes successfully, a
+//		formatted Number String will be returned.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (intDecimal *IntDecimal) Format(
+	numStrFmtSpec NumStrFormatSpec,
+	errorPrefix interface{}) (
+	string,
+	error) {
+
+	if intDecimal.lock == nil {
+		intDecimal.lock = new(sync.Mutex)
+	}
+
+	intDecimal.lock.Lock()
+
+	defer intDecimal.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"IntDecimal."+
+			"Format()",
+		"")
+
+	if err != nil {
+		return "", err
+	}
+
+	intDecElectron := intDecimalElectron{}
+
+	var numStrKernel NumberStrKernel
+
+	numStrKernel,
+		err = intDecElectron.getNumStrKernel(
+		intDecimal,
+		ePrefix.XCpy(
+			"numStrKernel<-intDecimal"))
+
+	if err != nil {
+		return "", err
+	}
+
+	var scale int
+
+	scale,
+		err = intDecElectron.getScale(
+		intDecimal,
+		ePrefix.XCpy(
+			"intDecimal"))
+
+	if err != nil {
+		return "", err
+	}
+
+	var roundingSpec NumStrRoundingSpec
+
+	roundingSpec,
+		err = new(NumStrRoundingSpec).NewRoundingSpec(
+		NumRoundType.NoRounding(),
+		scale,
+		ePrefix.XCpy(
+			"roundingSpec"))
+
+	if err != nil {
+		return "", err
+	}
+
+	return new(numberStrKernelMolecule).
+		formatNumStr(
+			&numStrKernel,
+			roundingSpec,
+			numStrFmtSpec,
+			ePrefix.XCpy(
+				"numStrKernel"))
+}
+
+// GetNumberSign
+//
+// Returns the number sign of the current IntDecimal
+// instance.
+//
+//	NumSignVal.Negative() = -1
+//	NumSignVal.Zero()     =  0
+//	NumSignVal.Positive() =  1
+func (intDecimal *IntDecimal) GetNumberSign() NumericSignValueType {
+
+	if intDecimal.lock == nil {
+		intDecimal.lock = new(sync.Mutex)
+	}
+
+	intDecimal.lock.Lock()
+
+	defer intDecimal.lock.Unlock()
+
+	if intDecimal.numberSign != NumSignVal.Negative() &&
+		intDecimal.numberSign != NumSignVal.Positive() {
+
+		return NumSignVal.Zero()
+	}
+
+	return intDecimal.numberSign
+}
+
+// GetNumberStrKernel
+//
+// Converts the numeric value of the current IntDecimal
+// instance to a new instance of NumberStrKernel.
+//
+// The number of fractional digits configured in the
+// returned NumberStrKernel is equal to the scale of the
+// current IntDecimal instance.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberStrKernel
+//
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		containing the numeric value of the current
+//		IntDecimal instance.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (intDecimal *IntDecimal) GetNumberStrKernel(
+	errorPrefix interface{}) (
+	NumberStrKernel,
+	error) {
+
+	if intDecimal.lock == nil {
+		intDecimal.lock = new(sync.Mutex)
+	}
+
+	intDecimal.lock.Lock()
+
+	defer intDecimal.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"IntDecimal."+
+			"GetNumberStrKernel()",
+		"")
+
+	if err != nil {
+		return NumberStrKernel{}, err
+	}
+
+	return new(intDecimalElectron).getNumStrKernel(
+		intDecimal,
+		ePrefix.XCpy(
+			"intDecimal"))
+}
+
+// GetScale
+//
+// Returns the scale, or number of fractional digits,
+// contained in the numeric value of the current
+// IntDecimal instance.
+//
+//	Example: 1234.50 has a scale of 2
+func (intDecimal *IntDecimal) GetScale() int {
+
+	if intDecimal.lock == nil {
+		intDecimal.lock = new(sync.Mutex)
+	}
+
+	intDecimal.lock.Lock()
+
+	defer intDecimal.lock.Unlock()
+
+	scale,
+		_ := new(intDecimalElectron).getScale(
+		intDecimal,
+		nil)
+
+	return scale
+}
+
+// Multiply
+//
+// Multiplies the numeric value of the current
+// IntDecimal instance by the numeric value of input
+// parameter 'multiplier' and returns the exact product
+// as a new instance of IntDecimal.
+//
+// The scale of the returned product is equal to the sum
+// of the scales of the two operands. Use method
+// IntDecimal.SetScale() to round the product to the
+// required number of fractional digits.
+//
+// Neither the current IntDecimal instance nor
+// 'multiplier' will be modified.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	multiplier					*IntDecimal
+//
+//		A pointer to an instance of IntDecimal. The
+//		numeric value of the current IntDecimal instance
+//		will be multiplied by the numeric value of this
+//		instance.
+//
+//		If 'multiplier' is a nil pointer, an error will
+//		be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	product						IntDecimal
+//
+//		If this method completes successfully, a new
+//		instance of IntDecimal will be returned
+//		containing the product of the current IntDecimal
+//		numeric value and 'multiplier'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (intDecimal *IntDecimal) Multiply(
+	multiplier *IntDecimal,
+	errorPrefix interface{}) (
+	product IntDecimal,
+	err error) {
+
+	if intDecimal.lock == nil {
+		intDecimal.lock = new(sync.Mutex)
+	}
+
+	intDecimal.lock.Lock()
+
+	defer intDecimal.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"IntDecimal."+
+			"Multiply()",
+		"")
+
+	if err != nil {
+		return product, err
+	}
+
+	err = new(intDecimalNanobot).multiply(
+		&product,
+		intDecimal,
+		multiplier,
+		ePrefix.XCpy(
+			"product<-intDecimal*multiplier"))
+
+	return product, err
+}
+
+// NewFromInt64
+//
+// Creates and returns a new instance of IntDecimal
+// configured with an integer value and a scale.
+//
+//	numeric value = int64Value x 10^-scale
+//
+//	Examples
+//		int64Value = 12345	scale = 2	numeric value = 123.45
+//		int64Value = -500	scale = 0	numeric value = -500
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	int64Value					int64
+//
+//		The integer value containing all the numeric
+//		digits of the new IntDecimal, including the
+//		fractional digits.
+//
+//	scale						int
+//
+//		The number of fractional digits contained in
+//		'int64Value'. If this value is less than zero, an
+//		error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	IntDecimal
+//
+//		If this method completes successfully, a new
+//		instance of IntDecimal will be returned.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (intDecimal *IntDecimal) NewFromInt64(
+	int64Value int64,
+	scale int,
+	errorPrefix interface{}) (
+	IntDecimal,
+	error) {
+
+	if intDecimal.lock == nil {
+		intDecimal.lock = new(sync.Mutex)
+	}
+
+	intDecimal.lock.Lock()
+
+	defer intDecimal.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	newIntDecimal := IntDecimal{}
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"IntDecimal."+
+			"NewFromInt64()",
+		"")
+
+	if err != nil {
+		return newIntDecimal, err
+	}
+
+	if scale < 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'scale' is invalid!\n"+
+			"'scale' has a value less than zero.\n"+
+			"scale = '%v'\n",
+			ePrefix.String(),
+			scale)
+
+		return newIntDecimal, err
+	}
+
+	bigDec := new(BigDecimal).NewFromInt64(
+		int64Value,
+		-scale)
+
+	err = new(intDecimalAtom).setFromBigDecimal(
+		&newIntDecimal,
+		&bigDec,
+		ePrefix.XCpy(
+			"newIntDecimal<-int64Value"))
+
+	return newIntDecimal, err
+}
+
+// NewFromNumStr
+//
+// Creates and returns a new instance of IntDecimal
+// configured with the numeric value of a Native Number
+// String.
+//
+// A Native Number String consists of numeric digits, an
+// optional leading minus sign ('-') and an optional
+// period ('.') radix point.
+//
+//	Examples
+//		"1234.50"
+//		"-0.0005"
+//		"1000000"
+//
+// The scale of the returned IntDecimal is equal to the
+// number of fractional digits in 'nativeNumStr'.
+// Trailing fractional zeros are preserved. "1234.50" is
+// therefore configured with a scale of 2.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	nativeNumStr				string
+//
+//		A Native Number String containing the numeric
+//		value which will be used to configure the
+//		returned IntDecimal. If this string is empty or
+//		invalid, an error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	IntDecimal
+//
+//		If this method completes successfully, a new
+//		instance of IntDecimal will be returned
+//		containing the numeric value of 'nativeNumStr'.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (intDecimal *IntDecimal) NewFromNumStr(
+	nativeNumStr string,
+	errorPrefix interface{}) (
+	IntDecimal,
+	error) {
+
+	if intDecimal.lock == nil {
+		intDecimal.lock = new(sync.Mutex)
+	}
+
+	intDecimal.lock.Lock()
+
+	defer intDecimal.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	newIntDecimal := IntDecimal{}
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"IntDecimal."+
+			"NewFromNumStr()",
+		"")
+
+	if err != nil {
+		return newIntDecimal, err
+	}
+
+	var bigDec BigDecimal
+
+	err = new(bigDecimalNanobot).setFromNativeNumStr(
+		&bigDec,
+		nativeNumStr,
+		ePrefix.XCpy(
+			"bigDec<-nativeNumStr"))
+
+	if err != nil {
+		return newIntDecimal, err
+	}
+
+	err = new(intDecimalAtom).setFromBigDecimal(
+		&newIntDecimal,
+		&bigDec,
+		ePrefix.XCpy(
+			"newIntDecimal<-bigDec"))
+
+	return newIntDecimal, err
+}
+
+// SetScale
+//
+// Changes the scale, or number of fractional digits, of
+// the current IntDecimal instance.
+//
+// If 'newScale' is greater than the current scale,
+// trailing zeros are added to the fractional digits and
+// the numeric value is unchanged.
+//
+// If 'newScale' is less than the current scale, the
+// numeric value is rounded to 'newScale' fractional
+// digits using the rounding algorithm specified by
+// 'roundingType'.
+//
+//	Example
+//		Current Value:	123.4567
+//		newScale:		2
+//		roundingType:	NumRoundType.HalfAwayFromZero()
+//		New Value:		123.46
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	This method will modify the numeric value of the
+//	current IntDecimal instance.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	newScale					int
+//
+//		The new number of fractional digits. If this
+//		value is less than zero, an error will be
+//		returned.
+//
+//	roundingType				NumberRoundingType
+//
+//		The rounding algorithm applied when 'newScale' is
+//		less than the current scale. The entire sequence
+//		of dropped digits is evaluated when rounding.
+//
+//		If this parameter is set to NumRoundType.NoRounding(),
+//		the excess fractional digits will be truncated.
+//
+//		If this parameter is invalid, an error will be
+//		returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (intDecimal *IntDecimal) SetScale(
+	newScale int,
+	roundingType NumberRoundingType,
+	errorPrefix interface{}) error {
+
+	if intDecimal.lock == nil {
+		intDecimal.lock = new(sync.Mutex)
+	}
+
+	intDecimal.lock.Lock()
+
+	defer intDecimal.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"IntDecimal."+
+			"SetScale()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(intDecimalNanobot).setScale(
+		intDecimal,
+		newScale,
+		roundingType,
+		ePrefix.XCpy(
+			"intDecimal"))
+}
+
+// String
+//
+// Returns the numeric value of the current IntDecimal
+// instance formatted as a Native Number String.
+//
+// A Native Number String consists of numeric digits, a
+// leading minus sign ('-') for negative values and a
+// period ('.') radix point.
+//
+//	Example: "-1234.50"
+//
+// If the current IntDecimal instance is invalid, an
+// empty string is returned.
+//
+// This method satisfies the fmt.Stringer interface.
+func (intDecimal *IntDecimal) String() string {
+
+	if intDecimal.lock == nil {
+		intDecimal.lock = new(sync.Mutex)
+	}
+
+	intDecimal.lock.Lock()
+
+	defer intDecimal.lock.Unlock()
+
+	bigDec,
+		err := new(intDecimalAtom).getBigDecimal(
+		intDecimal,
+		nil)
+
+	if err != nil {
+		return ""
+	}
+
+	return bigDec.String()
+}
+
+// Subtract
+//
+// Subtracts the numeric value of input parameter
+// 'subtrahend' from the numeric value of the current
+// IntDecimal instance and returns the exact difference
+// as a new instance of IntDecimal.
+//
+// The scale of the returned difference is equal to the
+// greater of the scales of the two operands.
+//
+// Neither the current IntDecimal instance nor
+// 'subtrahend' will be modified.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	subtrahend					*IntDecimal
+//
+//		A pointer to an instance of IntDecimal. The
+//		numeric value of this instance will be subtracted
+//		from that of the current IntDecimal instance.
+//
+//		If 'subtrahend' is a nil pointer, an error will
+//		be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	difference					IntDecimal
+//
+//		If this method completes successfully, a new
+//		instance of IntDecimal will be returned
+//		containing the result of subtracting
+//		'subtrahend' from the current IntDecimal numeric
+//		value.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (intDecimal *IntDecimal) Subtract(
+	subtrahend *IntDecimal,
+	errorPrefix interface{}) (
+	difference IntDecimal,
+	err error) {
+
+	if intDecimal.lock == nil {
+		intDecimal.lock = new(sync.Mutex)
+	}
+
+	intDecimal.lock.Lock()
+
+	defer intDecimal.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"IntDecimal."+
+			"Subtract()",
+		"")
+
+	if err != nil {
+		return difference, err
+	}
+
+	err = new(intDecimalNanobot).add(
+		&difference,
+		intDecimal,
+		subtrahend,
+		true,
+		ePrefix.XCpy(
+			"difference<-intDecimal-subtrahend"))
+
+	return difference, err
+}
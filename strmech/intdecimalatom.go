@@ -0,0 +1,223 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
+
+// intDecimalAtom - Provides helper methods used to
+// convert instances of IntDecimal to and from instances
+// of BigDecimal.
+type intDecimalAtom struct {
+	lock *sync.Mutex
+}
+
+// getBigDecimal
+//
+// Converts the numeric value of an IntDecimal instance
+// to a new instance of BigDecimal. The exponent of the
+// returned BigDecimal is equal to the negative value of
+// the IntDecimal scale.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	intDecimal					*IntDecimal
+//
+//		A pointer to an instance of IntDecimal. This
+//		instance will NOT be modified.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	BigDecimal
+//
+//		If this method completes successfully, a new
+//		instance of BigDecimal will be returned
+//		containing the numeric value of 'intDecimal'.
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (intDecAtom *intDecimalAtom) getBigDecimal(
+	intDecimal *IntDecimal,
+	errPrefDto *ePref.ErrPrefixDto) (
+	BigDecimal,
+	error) {
+
+	if intDecAtom.lock == nil {
+		intDecAtom.lock = new(sync.Mutex)
+	}
+
+	intDecAtom.lock.Lock()
+
+	defer intDecAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	bigDec := BigDecimal{}
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"intDecimalAtom."+
+			"getBigDecimal()",
+		"")
+
+	if err != nil {
+		return bigDec, err
+	}
+
+	var numStrKernel NumberStrKernel
+
+	numStrKernel,
+		err = new(intDecimalElectron).getNumStrKernel(
+		intDecimal,
+		ePrefix.XCpy(
+			"numStrKernel<-intDecimal"))
+
+	if err != nil {
+		return bigDec, err
+	}
+
+	err = new(bigDecimalNanobot).setFromNumStrKernel(
+		&bigDec,
+		&numStrKernel,
+		ePrefix.XCpy(
+			"bigDec<-numStrKernel"))
+
+	return bigDec, err
+}
+
+// setFromBigDecimal
+//
+// Deletes and resets the numeric value of an IntDecimal
+// instance using the numeric value of a BigDecimal.
+//
+// The scale of 'intDecimal' will be set equal to the
+// absolute value of a negative BigDecimal exponent. If
+// the BigDecimal exponent is greater than or equal to
+// zero, the scale will be set to zero.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	intDecimal					*IntDecimal
+//
+//		A pointer to an instance of IntDecimal. The
+//		numeric value of this instance will be reset.
+//
+//	bigDec						*BigDecimal
+//
+//		A pointer to an instance of BigDecimal. This
+//		instance will NOT be modified.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (intDecAtom *intDecimalAtom) setFromBigDecimal(
+	intDecimal *IntDecimal,
+	bigDec *BigDecimal,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if intDecAtom.lock == nil {
+		intDecAtom.lock = new(sync.Mutex)
+	}
+
+	intDecAtom.lock.Lock()
+
+	defer intDecAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"intDecimalAtom."+
+			"setFromBigDecimal()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if intDecimal == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'intDecimal' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	var numStrKernel NumberStrKernel
+
+	numStrKernel,
+		err = new(bigDecimalNanobot).getNumStrKernel(
+		bigDec,
+		ePrefix.XCpy(
+			"numStrKernel<-bigDec"))
+
+	if err != nil {
+		return err
+	}
+
+	return new(intDecimalElectron).setFromNumStrKernel(
+		intDecimal,
+		&numStrKernel,
+		ePrefix.XCpy(
+			"intDecimal<-numStrKernel"))
+}
@@ -0,0 +1,405 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
+
+// intDecimalElectron - Provides low level helper methods
+// used to convert the int8 digit arrays of an IntDecimal
+// to and from instances of NumberStrKernel.
+type intDecimalElectron struct {
+	lock *sync.Mutex
+}
+
+// getNumStrKernel
+//
+// Converts the numeric value of an IntDecimal instance
+// to a new instance of NumberStrKernel.
+//
+// The number of fractional digits configured in the
+// returned NumberStrKernel is equal to the scale of
+// 'intDecimal'.
+//
+// An IntDecimal with an empty significand array is
+// treated as a numeric value of zero.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	intDecimal					*IntDecimal
+//
+//		A pointer to an instance of IntDecimal. This
+//		instance will NOT be modified.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberStrKernel
+//
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		containing the numeric value of 'intDecimal'.
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (intDecElectron *intDecimalElectron) getNumStrKernel(
+	intDecimal *IntDecimal,
+	errPrefDto *ePref.ErrPrefixDto) (
+	NumberStrKernel,
+	error) {
+
+	if intDecElectron.lock == nil {
+		intDecElectron.lock = new(sync.Mutex)
+	}
+
+	intDecElectron.lock.Lock()
+
+	defer intDecElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	newNumStrKernel := NumberStrKernel{}
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"intDecimalElectron."+
+			"getNumStrKernel()",
+		"")
+
+	if err != nil {
+		return newNumStrKernel, err
+	}
+
+	if intDecimal == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'intDecimal' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return newNumStrKernel, err
+	}
+
+	intDecElectron2 := intDecimalElectron{}
+
+	var scale int
+
+	scale,
+		err = intDecElectron2.getScale(
+		intDecimal,
+		ePrefix.XCpy(
+			"intDecimal.exponent"))
+
+	if err != nil {
+		return newNumStrKernel, err
+	}
+
+	lenSignificand := len(intDecimal.significand.Int8Array)
+
+	scaledDigits := make([]rune, lenSignificand)
+
+	for i := 0; i < lenSignificand; i++ {
+
+		digit := intDecimal.significand.Int8Array[i]
+
+		if digit < 0 || digit > 9 {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: The IntDecimal significand is invalid!\n"+
+				"intDecimal.significand.Int8Array[%v] = %v\n",
+				ePrefix.String(),
+				i,
+				digit)
+
+			return newNumStrKernel, err
+		}
+
+		scaledDigits[i] = rune(digit) + '0'
+	}
+
+	numberSign := intDecimal.numberSign
+
+	if numberSign != NumSignVal.Negative() {
+		numberSign = NumSignVal.Positive()
+	}
+
+	err = new(numStrMathArithmeticElectron).
+		setFromScaledDigits(
+			&newNumStrKernel,
+			scaledDigits,
+			scale,
+			numberSign,
+			nil,
+			ePrefix.XCpy(
+				"newNumStrKernel<-intDecimal"))
+
+	return newNumStrKernel, err
+}
+
+// getScale
+//
+// Returns the scale, or number of fractional digits, for
+// an instance of IntDecimal. The scale is computed from
+// the numeric digits contained in the exponent array.
+//
+// An empty exponent array is treated as a scale of
+// zero.
+func (intDecElectron *intDecimalElectron) getScale(
+	intDecimal *IntDecimal,
+	errPrefDto *ePref.ErrPrefixDto) (
+	scale int,
+	err error) {
+
+	if intDecElectron.lock == nil {
+		intDecElectron.lock = new(sync.Mutex)
+	}
+
+	intDecElectron.lock.Lock()
+
+	defer intDecElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"intDecimalElectron."+
+			"getScale()",
+		"")
+
+	if err != nil {
+		return scale, err
+	}
+
+	if intDecimal == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'intDecimal' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return scale, err
+	}
+
+	for i := 0; i < len(intDecimal.exponent.Int8Array); i++ {
+
+		digit := intDecimal.exponent.Int8Array[i]
+
+		if digit < 0 || digit > 9 {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: The IntDecimal exponent is invalid!\n"+
+				"intDecimal.exponent.Int8Array[%v] = %v\n",
+				ePrefix.String(),
+				i,
+				digit)
+
+			return scale, err
+		}
+
+		scale = scale*10 + int(digit)
+
+		if scale > maxBigDecimalDigitExpansion {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: The IntDecimal exponent is out of range!\n"+
+				"Maximum Scale = '%v'\n",
+				ePrefix.String(),
+				maxBigDecimalDigitExpansion)
+
+			return scale, err
+		}
+	}
+
+	return scale, err
+}
+
+// getScaleDigits
+//
+// Converts a non-negative scale value to an array of
+// int8 numeric digits suitable for storage in the
+// IntDecimal exponent array.
+//
+//	Example: scale 12 = []int8{1, 2}
+//
+// This method does NOT lock the current instance of
+// intDecimalElectron.
+func (intDecElectron *intDecimalElectron) getScaleDigits(
+	scale int) []int8 {
+
+	if scale <= 0 {
+		return []int8{0}
+	}
+
+	var scaleDigits []int8
+
+	for scale > 0 {
+
+		scaleDigits = append(
+			[]int8{int8(scale % 10)},
+			scaleDigits...)
+
+		scale /= 10
+	}
+
+	return scaleDigits
+}
+
+// setFromNumStrKernel
+//
+// Deletes and resets the numeric value of an IntDecimal
+// instance using the integer and fractional digits
+// contained in an instance of NumberStrKernel.
+//
+// The scale of 'intDecimal' will be set equal to the
+// number of fractional digits contained in
+// 'numStrKernel'. Trailing fractional zeros are
+// therefore preserved. Leading zeros are removed from
+// the significand.
+//
+// The descriptions contained in 'intDecimal' will NOT be
+// modified.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	intDecimal					*IntDecimal
+//
+//		A pointer to an instance of IntDecimal. The
+//		numeric value of this instance will be reset.
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. If
+//		this instance is invalid, an error will be
+//		returned. This instance will NOT be modified.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (intDecElectron *intDecimalElectron) setFromNumStrKernel(
+	intDecimal *IntDecimal,
+	numStrKernel *NumberStrKernel,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if intDecElectron.lock == nil {
+		intDecElectron.lock = new(sync.Mutex)
+	}
+
+	intDecElectron.lock.Lock()
+
+	defer intDecElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"intDecimalElectron."+
+			"setFromNumStrKernel()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if intDecimal == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'intDecimal' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	var scaledDigits []rune
+	var scale int
+	var numberSign NumericSignValueType
+
+	scaledDigits,
+		scale,
+		numberSign,
+		err = new(numStrMathArithmeticMolecule).
+		getValidatedDigits(
+			numStrKernel,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	if err != nil {
+		return err
+	}
+
+	scaledDigits = new(numStrMathArithmeticAtom).
+		trimLeadingZeros(scaledDigits)
+
+	significand := make([]int8, len(scaledDigits))
+
+	for i := 0; i < len(scaledDigits); i++ {
+		significand[i] = int8(scaledDigits[i] - '0')
+	}
+
+	intDecimal.significand.Int8Array = significand
+
+	intDecimal.exponent.Int8Array =
+		new(intDecimalElectron).getScaleDigits(scale)
+
+	intDecimal.numberSign = numberSign
+
+	return err
+}
@@ -0,0 +1,624 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"math/big"
+	"sync"
+)
+
+// intDecimalNanobot - Provides helper methods used to
+// perform arithmetic, comparison, copy and scaling
+// operations for type IntDecimal.
+type intDecimalNanobot struct {
+	lock *sync.Mutex
+}
+
+// add
+//
+// Adds or subtracts the numeric values of two instances
+// of IntDecimal. The exact result is stored in input
+// parameter 'result'.
+//
+// The scale of the result is equal to the greater of the
+// scales of the two operands.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	The numeric value contained in input parameter
+//	'result' will be deleted and reset to a new value.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	result						*IntDecimal
+//
+//		A pointer to an instance of IntDecimal. The
+//		result of the addition or subtraction operation
+//		will be stored in this instance.
+//
+//	intDecimal01				*IntDecimal
+//
+//		A pointer to an instance of IntDecimal containing
+//		the first operand.
+//
+//	intDecimal02				*IntDecimal
+//
+//		A pointer to an instance of IntDecimal containing
+//		the second operand.
+//
+//	negateIntDecimal02			bool
+//
+//		If this parameter is set to 'true', the numeric
+//		value of 'intDecimal02' will be subtracted from
+//		that of 'intDecimal01'. Otherwise, the two numeric
+//		values will be added together.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (intDecNanobot *intDecimalNanobot) add(
+	result *IntDecimal,
+	intDecimal01 *IntDecimal,
+	intDecimal02 *IntDecimal,
+	negateIntDecimal02 bool,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if intDecNanobot.lock == nil {
+		intDecNanobot.lock = new(sync.Mutex)
+	}
+
+	intDecNanobot.lock.Lock()
+
+	defer intDecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"intDecimalNanobot."+
+			"add()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	var bigDec01, bigDec02, bigDecResult BigDecimal
+
+	bigDec01,
+		bigDec02,
+		err = new(intDecimalNanobot).getBigDecimalOperands(
+		result,
+		intDecimal01,
+		intDecimal02,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	err = new(bigDecimalNanobot).add(
+		&bigDecResult,
+		&bigDec01,
+		&bigDec02,
+		negateIntDecimal02,
+		ePrefix.XCpy(
+			"bigDecResult"))
+
+	if err != nil {
+		return err
+	}
+
+	return new(intDecimalAtom).setFromBigDecimal(
+		result,
+		&bigDecResult,
+		ePrefix.XCpy(
+			"result<-bigDecResult"))
+}
+
+// compare
+//
+// Compares the numeric values of two IntDecimal
+// instances and returns an integer value:
+//
+//	-1 if intDecimal01 <  intDecimal02
+//	 0 if intDecimal01 == intDecimal02
+//	+1 if intDecimal01 >  intDecimal02
+//
+// The comparison is based on numeric value only. The
+// scales of the two operands are ignored.
+//
+// If either operand is a nil pointer or invalid, an
+// error will be returned.
+func (intDecNanobot *intDecimalNanobot) compare(
+	intDecimal01 *IntDecimal,
+	intDecimal02 *IntDecimal,
+	errPrefDto *ePref.ErrPrefixDto) (
+	comparison int,
+	err error) {
+
+	if intDecNanobot.lock == nil {
+		intDecNanobot.lock = new(sync.Mutex)
+	}
+
+	intDecNanobot.lock.Lock()
+
+	defer intDecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"intDecimalNanobot."+
+			"compare()",
+		"")
+
+	if err != nil {
+		return comparison, err
+	}
+
+	var bigDec01, bigDec02 BigDecimal
+
+	bigDec01,
+		bigDec02,
+		err = new(intDecimalNanobot).getBigDecimalOperands(
+		&IntDecimal{},
+		intDecimal01,
+		intDecimal02,
+		ePrefix)
+
+	if err != nil {
+		return comparison, err
+	}
+
+	comparison = new(bigDecimalNanobot).compare(
+		&bigDec01,
+		&bigDec02)
+
+	return comparison, err
+}
+
+// copy
+//
+// Copies the numeric value and descriptions from a
+// source instance of IntDecimal to a destination
+// instance of IntDecimal.
+//
+// If either input parameter is a nil pointer, this
+// method takes no action and exits.
+func (intDecNanobot *intDecimalNanobot) copy(
+	destinationIntDecimal *IntDecimal,
+	sourceIntDecimal *IntDecimal) {
+
+	if intDecNanobot.lock == nil {
+		intDecNanobot.lock = new(sync.Mutex)
+	}
+
+	intDecNanobot.lock.Lock()
+
+	defer intDecNanobot.lock.Unlock()
+
+	if destinationIntDecimal == nil ||
+		sourceIntDecimal == nil {
+
+		return
+	}
+
+	destinationIntDecimal.significand.Int8Array =
+		make([]int8, len(sourceIntDecimal.significand.Int8Array))
+
+	copy(destinationIntDecimal.significand.Int8Array,
+		sourceIntDecimal.significand.Int8Array)
+
+	destinationIntDecimal.exponent.Int8Array =
+		make([]int8, len(sourceIntDecimal.exponent.Int8Array))
+
+	copy(destinationIntDecimal.exponent.Int8Array,
+		sourceIntDecimal.exponent.Int8Array)
+
+	destinationIntDecimal.numberSign =
+		sourceIntDecimal.numberSign
+
+	destinationIntDecimal.Description1 =
+		sourceIntDecimal.Description1
+
+	destinationIntDecimal.Description2 =
+		sourceIntDecimal.Description2
+}
+
+// getBigDecimalOperands
+//
+// Verifies that the result and operand pointers passed
+// to an arithmetic operation are not nil and converts
+// the two IntDecimal operands to instances of
+// BigDecimal.
+//
+// This method does NOT lock the current instance of
+// intDecimalNanobot.
+func (intDecNanobot *intDecimalNanobot) getBigDecimalOperands(
+	result *IntDecimal,
+	intDecimal01 *IntDecimal,
+	intDecimal02 *IntDecimal,
+	ePrefix *ePref.ErrPrefixDto) (
+	bigDec01 BigDecimal,
+	bigDec02 BigDecimal,
+	err error) {
+
+	if result == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'result' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return bigDec01, bigDec02, err
+	}
+
+	if intDecimal01 == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'intDecimal01' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return bigDec01, bigDec02, err
+	}
+
+	if intDecimal02 == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'intDecimal02' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return bigDec01, bigDec02, err
+	}
+
+	intDecAtom := intDecimalAtom{}
+
+	bigDec01,
+		err = intDecAtom.getBigDecimal(
+		intDecimal01,
+		ePrefix.XCpy(
+			"intDecimal01"))
+
+	if err != nil {
+		return bigDec01, bigDec02, err
+	}
+
+	bigDec02,
+		err = intDecAtom.getBigDecimal(
+		intDecimal02,
+		ePrefix.XCpy(
+			"intDecimal02"))
+
+	return bigDec01, bigDec02, err
+}
+
+// multiply
+//
+// Multiplies the numeric values of two instances of
+// IntDecimal. The exact product is stored in input
+// parameter 'product'.
+//
+// The scale of the product is equal to the sum of the
+// scales of the two operands.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	The numeric value contained in input parameter
+//	'product' will be deleted and reset to a new value.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	product						*IntDecimal
+//
+//		A pointer to an instance of IntDecimal. The
+//		result of the multiplication operation will be
+//		stored in this instance.
+//
+//	intDecimal01				*IntDecimal
+//
+//		A pointer to an instance of IntDecimal containing
+//		the multiplicand.
+//
+//	intDecimal02				*IntDecimal
+//
+//		A pointer to an instance of IntDecimal containing
+//		the multiplier.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (intDecNanobot *intDecimalNanobot) multiply(
+	product *IntDecimal,
+	intDecimal01 *IntDecimal,
+	intDecimal02 *IntDecimal,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if intDecNanobot.lock == nil {
+		intDecNanobot.lock = new(sync.Mutex)
+	}
+
+	intDecNanobot.lock.Lock()
+
+	defer intDecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"intDecimalNanobot."+
+			"multiply()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	var bigDec01, bigDec02, bigDecProduct BigDecimal
+
+	bigDec01,
+		bigDec02,
+		err = new(intDecimalNanobot).getBigDecimalOperands(
+		product,
+		intDecimal01,
+		intDecimal02,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	err = new(bigDecimalNanobot).multiply(
+		&bigDecProduct,
+		&bigDec01,
+		&bigDec02,
+		ePrefix.XCpy(
+			"bigDecProduct"))
+
+	if err != nil {
+		return err
+	}
+
+	return new(intDecimalAtom).setFromBigDecimal(
+		product,
+		&bigDecProduct,
+		ePrefix.XCpy(
+			"product<-bigDecProduct"))
+}
+
+// setScale
+//
+// Changes the scale, or number of fractional digits, of
+// an IntDecimal instance.
+//
+// If 'newScale' is greater than the current scale,
+// trailing zeros are added to the fractional digits and
+// the numeric value is unchanged.
+//
+// If 'newScale' is less than the current scale, the
+// numeric value is rounded to 'newScale' fractional
+// digits using the rounding algorithm specified by
+// 'roundingType'.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	intDecimal					*IntDecimal
+//
+//		A pointer to an instance of IntDecimal. The scale
+//		and possibly the numeric value of this instance
+//		will be modified.
+//
+//	newScale					int
+//
+//		The new number of fractional digits. If this
+//		value is less than zero, an error will be
+//		returned.
+//
+//	roundingType				NumberRoundingType
+//
+//		The rounding algorithm applied when 'newScale' is
+//		less than the current scale.
+//
+//		If this parameter is set to NumRoundType.NoRounding(),
+//		the excess fractional digits will be truncated.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (intDecNanobot *intDecimalNanobot) setScale(
+	intDecimal *IntDecimal,
+	newScale int,
+	roundingType NumberRoundingType,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if intDecNanobot.lock == nil {
+		intDecNanobot.lock = new(sync.Mutex)
+	}
+
+	intDecNanobot.lock.Lock()
+
+	defer intDecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"intDecimalNanobot."+
+			"setScale()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if newScale < 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'newScale' is invalid!\n"+
+			"'newScale' has a value less than zero.\n"+
+			"newScale = '%v'\n",
+			ePrefix.String(),
+			newScale)
+
+		return err
+	}
+
+	var currentScale int
+
+	currentScale,
+		err = new(intDecimalElectron).getScale(
+		intDecimal,
+		ePrefix.XCpy(
+			"intDecimal"))
+
+	if err != nil {
+		return err
+	}
+
+	var bigDec BigDecimal
+
+	bigDec,
+		err = new(intDecimalAtom).getBigDecimal(
+		intDecimal,
+		ePrefix.XCpy(
+			"bigDec<-intDecimal"))
+
+	if err != nil {
+		return err
+	}
+
+	if newScale < currentScale {
+
+		if roundingType == NumRoundType.NoRounding() {
+			roundingType = NumRoundType.Truncate()
+		}
+
+		err = new(bigDecimalNanobot).round(
+			&bigDec,
+			roundingType,
+			newScale,
+			ePrefix.XCpy(
+				"bigDec"))
+
+		if err != nil {
+			return err
+		}
+
+	} else if newScale > currentScale {
+
+		bigDecElectron := bigDecimalElectron{}
+
+		significand,
+			_ := bigDecElectron.getComponents(&bigDec)
+
+		significand.Mul(
+			significand,
+			new(bigDecimalAtom).powerOfTen(
+				big.NewInt(int64(newScale-currentScale))))
+
+		bigDecElectron.setComponents(
+			&bigDec,
+			significand,
+			big.NewInt(int64(-newScale)))
+	}
+
+	return new(intDecimalAtom).setFromBigDecimal(
+		intDecimal,
+		&bigDec,
+		ePrefix.XCpy(
+			"intDecimal<-bigDec"))
+}
@@ -365,6 +365,232 @@ func (nStrIntSepMolecule *integerSeparatorSpecMolecule) applyIntSeparators(
 	return numStrWithIntSeps, err
 }
 
+// applyRadixIntSeparators
+//
+// Inserts integer separators into an array of binary,
+// octal or hexadecimal digits.
+//
+// This method applies the same grouping rules used by
+// method applyIntSeparators(). However, the digits
+// passed by input parameter 'radixDigitRunes' may
+// include the hexadecimal digits 'a' through 'f' and
+// 'A' through 'F'.
+//
+//	Example:
+//		radixDigitRunes = "10101101"
+//		integer separator character = '_'
+//		integer grouping = 4
+//		numStrWithIntSeps = "1010_1101"
+//
+// If integer separation is turned off, or no integer
+// separator characters are configured, a copy of
+// 'radixDigitRunes' is returned without separators.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	nStrIntSeparator			*IntegerSeparatorSpec
+//
+//		A pointer to an instance of IntegerSeparatorSpec
+//		specifying the integer separator characters and
+//		digit grouping sequence. This instance will NOT
+//		be modified.
+//
+//	radixDigitRunes				[]rune
+//
+//		An array of binary, octal or hexadecimal digits.
+//		If any character in this array is not a valid
+//		digit, an error will be returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	numStrWithIntSeps			[]rune
+//
+//		If this method completes successfully, the digits
+//		from 'radixDigitRunes' will be returned with
+//		integer separators inserted.
+//
+//	err							error
+//
+//		If this method completes successfully, this
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrIntSepMolecule *integerSeparatorSpecMolecule) applyRadixIntSeparators(
+	nStrIntSeparator *IntegerSeparatorSpec,
+	radixDigitRunes []rune,
+	errPrefDto *ePref.ErrPrefixDto) (
+	numStrWithIntSeps []rune,
+	err error) {
+
+	if nStrIntSepMolecule.lock == nil {
+		nStrIntSepMolecule.lock = new(sync.Mutex)
+	}
+
+	nStrIntSepMolecule.lock.Lock()
+
+	defer nStrIntSepMolecule.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"integerSeparatorSpecMolecule."+
+			"applyRadixIntSeparators()",
+		"")
+
+	if err != nil {
+		return numStrWithIntSeps, err
+	}
+
+	if nStrIntSeparator == nil {
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'nStrIntSeparator' (*IntegerSeparatorSpec) is invalid!\n"+
+			"'nStrIntSeparator' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return numStrWithIntSeps, err
+	}
+
+	lenRadixDigits := len(radixDigitRunes)
+
+	if lenRadixDigits == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'radixDigitRunes' is invalid!\n"+
+			"'radixDigitRunes' is an empty array.\n",
+			ePrefix.String())
+
+		return numStrWithIntSeps, err
+	}
+
+	for i := 0; i < lenRadixDigits; i++ {
+
+		if (radixDigitRunes[i] >= '0' && radixDigitRunes[i] <= '9') ||
+			(radixDigitRunes[i] >= 'a' && radixDigitRunes[i] <= 'f') ||
+			(radixDigitRunes[i] >= 'A' && radixDigitRunes[i] <= 'F') {
+
+			continue
+		}
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'radixDigitRunes' is invalid!\n"+
+			"'radixDigitRunes' contains a non-numeric digit.\n"+
+			"radixDigitRunes[%v] = '%v'\n",
+			ePrefix.String(),
+			i,
+			string(radixDigitRunes[i]))
+
+		return numStrWithIntSeps, err
+	}
+
+	if len(nStrIntSeparator.intSeparatorChars) == 0 ||
+		len(nStrIntSeparator.intSeparatorGrouping) == 0 ||
+		nStrIntSeparator.turnOffIntegerSeparation == true {
+		// This is a NOP condition. Integer separation
+		// IS NOT APPLIED
+		numStrWithIntSeps = make(
+			[]rune, lenRadixDigits)
+
+		copy(numStrWithIntSeps, radixDigitRunes)
+
+		return numStrWithIntSeps, err
+	}
+
+	_,
+		err = new(integerSeparatorSpecQuark).
+		testValidityOfNumStrIntSeparator(
+			nStrIntSeparator,
+			ePrefix.XCpy("nStrIntSeparator->"))
+
+	if err != nil {
+		return numStrWithIntSeps, err
+	}
+
+	lastGroupCntIdx :=
+		len(nStrIntSeparator.intSeparatorGrouping) - 1
+
+	currGroupCntIdx := 0
+
+	maxGroupCnt := nStrIntSeparator.intSeparatorGrouping[0]
+
+	var groupCnt uint = 0
+
+	// Digits and separators are accumulated in
+	// reverse order, from right to left.
+	var reversedRunes []rune
+
+	for i := lenRadixDigits - 1; i >= 0; i-- {
+
+		reversedRunes = append(
+			reversedRunes,
+			radixDigitRunes[i])
+
+		groupCnt++
+
+		if groupCnt == maxGroupCnt && i != 0 {
+
+			groupCnt = 0
+
+			for j := len(nStrIntSeparator.intSeparatorChars) - 1; j >= 0; j-- {
+
+				reversedRunes = append(
+					reversedRunes,
+					nStrIntSeparator.intSeparatorChars[j])
+			}
+
+			if currGroupCntIdx+1 > lastGroupCntIdx {
+
+				if nStrIntSeparator.restartIntGroupingSequence == true {
+					currGroupCntIdx = 0
+				}
+
+			} else {
+
+				currGroupCntIdx++
+			}
+
+			maxGroupCnt =
+				nStrIntSeparator.intSeparatorGrouping[currGroupCntIdx]
+		}
+	}
+
+	lenReversedRunes := len(reversedRunes)
+
+	numStrWithIntSeps = make([]rune, lenReversedRunes)
+
+	for i := 0; i < lenReversedRunes; i++ {
+
+		numStrWithIntSeps[i] =
+			reversedRunes[lenReversedRunes-1-i]
+	}
+
+	return numStrWithIntSeps, err
+}
+
 //	copyIntSepSpec
 //
 //	Copies the data fields from input parameter
@@ -28,83 +28,1292 @@ type MathBigRatHelper struct {
 	lock *sync.Mutex
 }
 
+// BestRationalApproximation
+//
+// Returns the best rational approximation of a big.Rat
+// value subject to a maximum denominator, a tolerance,
+// or both. The approximation is computed from the
+// continued fraction convergents and semiconvergents of
+// 'bigRatNum'.
+//
+//	maxDenominator only:
+//		Returns the rational number closest to the
+//		subject value whose denominator does not exceed
+//		'maxDenominator'.
+//
+//	tolerance only:
+//		Returns the rational number with the smallest
+//		denominator whose absolute difference from the
+//		subject value does not exceed 'tolerance'.
+//
+//	maxDenominator and tolerance:
+//		Returns the rational number with the smallest
+//		denominator whose absolute difference from the
+//		subject value does not exceed 'tolerance'. If no
+//		such rational number has a denominator less than
+//		or equal to 'maxDenominator', an error is
+//		returned.
+//
+// Example:
+//
+//	bigRatNum = 314159265358979/100000000000000
+//	maxDenominator = 1000	Approximation = 355/113
+//	tolerance = 1/100		Approximation = 22/7
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	bigRatNum					*big.Rat
+//
+//		The rational value to be approximated. This
+//		value is not modified.
+//
+//	maxDenominator				*big.Int
+//
+//		The maximum denominator of the returned
+//		approximation. If this parameter is 'nil', no
+//		maximum denominator is applied. If this
+//		parameter is not 'nil', it must be greater than
+//		or equal to one (1).
+//
+//	tolerance					*big.Rat
+//
+//		The maximum absolute difference between the
+//		subject value and the returned approximation. If
+//		this parameter is 'nil', no tolerance is applied.
+//		If this parameter is not 'nil', it must be
+//		greater than or equal to zero (0).
+//
+//		'maxDenominator' and 'tolerance' may not both be
+//		'nil'.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	approximation				*big.Rat
+//
+//		If this method completes successfully, this
+//		parameter will return the best rational
+//		approximation of 'bigRatNum'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (mathRatHelper *MathBigRatHelper) BestRationalApproximation(
+	bigRatNum *big.Rat,
+	maxDenominator *big.Int,
+	tolerance *big.Rat,
+	errorPrefix interface{}) (
+	approximation *big.Rat,
+	err error) {
+
+	if mathRatHelper.lock == nil {
+		mathRatHelper.lock = new(sync.Mutex)
+	}
+
+	mathRatHelper.lock.Lock()
+
+	defer mathRatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	approximation = big.NewRat(0, 1)
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathBigRatHelper."+
+			"BestRationalApproximation()",
+		"")
+
+	if err != nil {
+
+		return approximation, err
+	}
+
+	return new(mathBigRatHelperQuark).
+		bestRationalApproximation(
+			bigRatNum,
+			maxDenominator,
+			tolerance,
+			ePrefix.XCpy("bigRatNum"))
+}
+
+// BigFloatToBestRational
+//
+// Returns the best rational approximation of a big.Float
+// value subject to a maximum denominator, a tolerance,
+// or both.
+//
+// 'bigFloatNum' is first converted to the exactly
+// equivalent big.Rat value. The approximation is then
+// computed from the continued fraction convergents and
+// semiconvergents of that value.
+//
+//	maxDenominator only:
+//		Returns the rational number closest to the
+//		subject value whose denominator does not exceed
+//		'maxDenominator'.
+//
+//	tolerance only:
+//		Returns the rational number with the smallest
+//		denominator whose absolute difference from the
+//		subject value does not exceed 'tolerance'.
+//
+//	maxDenominator and tolerance:
+//		Returns the rational number with the smallest
+//		denominator whose absolute difference from the
+//		subject value does not exceed 'tolerance'. If no
+//		such rational number has a denominator less than
+//		or equal to 'maxDenominator', an error is
+//		returned.
+//
+// Example:
+//
+//	bigFloatNum = 1.4142135623730951	(Square Root of 2)
+//	maxDenominator = 100	Approximation = 140/99
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	bigFloatNum					*big.Float
+//
+//		The floating point value to be approximated. This
+//		value is not modified. If 'bigFloatNum' is an
+//		infinite value, an error will be returned.
+//
+//	maxDenominator				*big.Int
+//
+//		The maximum denominator of the returned
+//		approximation. If this parameter is 'nil', no
+//		maximum denominator is applied. If this
+//		parameter is not 'nil', it must be greater than
+//		or equal to one (1).
+//
+//	tolerance					*big.Rat
+//
+//		The maximum absolute difference between the
+//		subject value and the returned approximation. If
+//		this parameter is 'nil', no tolerance is applied.
+//		If this parameter is not 'nil', it must be
+//		greater than or equal to zero (0).
+//
+//		'maxDenominator' and 'tolerance' may not both be
+//		'nil'.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	approximation				*big.Rat
+//
+//		If this method completes successfully, this
+//		parameter will return the best rational
+//		approximation of 'bigFloatNum'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (mathRatHelper *MathBigRatHelper) BigFloatToBestRational(
+	bigFloatNum *big.Float,
+	maxDenominator *big.Int,
+	tolerance *big.Rat,
+	errorPrefix interface{}) (
+	approximation *big.Rat,
+	err error) {
+
+	if mathRatHelper.lock == nil {
+		mathRatHelper.lock = new(sync.Mutex)
+	}
+
+	mathRatHelper.lock.Lock()
+
+	defer mathRatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	approximation = big.NewRat(0, 1)
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathBigRatHelper."+
+			"BigFloatToBestRational()",
+		"")
+
+	if err != nil {
+
+		return approximation, err
+	}
+
+	mathBigRatHelpQuark := mathBigRatHelperQuark{}
+
+	var bigRatNum *big.Rat
+
+	bigRatNum,
+		err = mathBigRatHelpQuark.bigFloatToRat(
+		bigFloatNum,
+		ePrefix.XCpy("bigFloatNum"))
+
+	if err != nil {
+
+		return approximation, err
+	}
+
+	return mathBigRatHelpQuark.bestRationalApproximation(
+		bigRatNum,
+		maxDenominator,
+		tolerance,
+		ePrefix.XCpy("bigRatNum<-bigFloatNum"))
+}
+
+// BigFloatToConvergents
+//
+// Computes the simple continued fraction expansion of a
+// big.Float value and returns the convergents together
+// with their partial quotients and approximation errors.
+//
+// 'bigFloatNum' is first converted to the exactly
+// equivalent big.Rat value. Since that value is rational,
+// the continued fraction expansion is finite.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	bigFloatNum					*big.Float
+//
+//		The floating point value to be expanded. This
+//		value is not modified. If 'bigFloatNum' is an
+//		infinite value, an error will be returned.
+//
+//	maxConvergents				int
+//
+//		The maximum number of convergents to be returned.
+//		If this value is less than one (1), all
+//		convergents will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	convergents					[]ContinuedFractionConvergentDto
+//
+//		If this method completes successfully, this
+//		array will contain the convergents of
+//		'bigFloatNum' in order of increasing accuracy.
+//		Each element includes the partial quotient, the
+//		convergent and the exact absolute error of the
+//		convergent.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (mathRatHelper *MathBigRatHelper) BigFloatToConvergents(
+	bigFloatNum *big.Float,
+	maxConvergents int,
+	errorPrefix interface{}) (
+	convergents []ContinuedFractionConvergentDto,
+	err error) {
+
+	if mathRatHelper.lock == nil {
+		mathRatHelper.lock = new(sync.Mutex)
+	}
+
+	mathRatHelper.lock.Lock()
+
+	defer mathRatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathBigRatHelper."+
+			"BigFloatToConvergents()",
+		"")
+
+	if err != nil {
+
+		return convergents, err
+	}
+
+	mathBigRatHelpQuark := mathBigRatHelperQuark{}
+
+	var bigRatNum *big.Rat
+
+	bigRatNum,
+		err = mathBigRatHelpQuark.bigFloatToRat(
+		bigFloatNum,
+		ePrefix.XCpy("bigFloatNum"))
+
+	if err != nil {
+
+		return convergents, err
+	}
+
+	return mathBigRatHelpQuark.continuedFractionConvergents(
+		bigRatNum,
+		maxConvergents,
+		ePrefix.XCpy("bigRatNum<-bigFloatNum"))
+}
+
 // BigRatToNativeNumStr
 //
-// Receives a pointer to a big.Rat numeric value and
-// converts that value to a Native Number String.
+// Receives a pointer to a big.Rat numeric value and
+// converts that value to a Native Number String.
+//
+// The term 'Native Number String' means that the number
+// string format is designed to interoperate with the
+// Golang programming language library functions and
+// packages. Types like 'strconv', 'strings', 'math' and
+// 'big' (big.Int, big.Float, big.Rat) routinely parse
+// and convert this type of number string to numeric
+// values. In addition, Native Number Strings are
+// frequently consumed by external library functions such
+// as this one (String Mechanics 'strmech') to convert
+// strings to numeric values and numeric values to
+// strings.
+//
+// While this format is inconsistent with many national
+// and cultural formatting conventions, number strings
+// which fail to implement this standardized formatting
+// protocol will generate errors in some Golang library
+// functions.
+//
+// The 'Native' Number String returned by this method
+// therefore implements a standardized format defined as
+// follows:
+//
+//  1. A Native Number String Consists of numeric
+//     character digits zero through nine inclusive
+//     (0-9).
+//
+//  2. A Native Number String will include a period
+//     or decimal point ('.') to separate integer and
+//     fractional digits within a number string.
+//
+//     Native Number String Floating Point Value:
+//     123.1234
+//
+//  3. A Native Number String will always format
+//     negative numeric values with a leading minus sign
+//     ('-').
+//
+//     Native Number String Negative Value:
+//     -123.2
+//
+//  4. A Native Number String WILL NEVER include integer
+//     separators such as commas (',') to separate
+//     integer digits by thousands.
+//
+//     NOT THIS: 1,000,000
+//     Native Number String: 1000000
+//
+//  5. Native Number Strings will only consist of:
+//
+//     (a)	Numeric digits zero through nine inclusive (0-9).
+//
+//     (b)	A decimal point ('.') for floating point
+//     numbers.
+//
+//     (c)	A leading minus sign ('-') in the case of
+//     negative numeric values.
+//
+// ----------------------------------------------------------------
+//
+// # BE ADVISED
+//
+//	Before being converted to Native Number String, the
+//	big.Rat numeric value will be rounded by the Golang
+//	'big' package function:
+//
+//			func (*Rat) FloatString
+//
+//	The rounding algorithm used is described as:
+//
+//		The last digit is rounded to nearest, with halves
+//		rounded away from zero.
+//			https://pkg.go.dev/math/big#Rat
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	bigRatNum					*big.Rat
+//
+//		A pointer to an instance of the numeric value
+//		type big.Rat. This numeric value will be
+//		converted to, and returned as, a Native Number
+//		String.
+//
+//		Before being converted to a Native Number String,
+//		this numeric value will be rounded by the Golang
+//		'big' package functions as specified by input
+//		parameter, 'roundToFractionalDigits'.
+//
+//	roundToFractionalDigits		int
+//
+//		When set to a positive integer value, this
+//		parameter controls the number of digits to the
+//		right of the radix point or decimal separator
+//		(a.k.a. decimal point). Effectively this defines
+//		the number of fractional digits remaining after
+//		completion of the number rounding operation
+//		performed by the Golang package functions.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	nativeNumStr			string
+//
+//		If this method completes successfully, a Native
+//		Number String representing the numeric value
+//		passed as input	parameter 'numericValue' will be
+//		returned.
+//
+//		The 'Native' Number String returned by this
+//		method implements a standardized format defined
+//		as follows:
+//
+//		1.	A Native Number String Consists of numeric
+//		  	character digits zero through nine inclusive
+//		  	(0-9).
+//
+//		2.	A Native Number String will include a period
+//		  	or decimal point ('.') to separate integer and
+//		  	fractional digits within a number string.
+//
+//					Native Number String Floating Point Value:
+//									123.1234
+//
+//		3.	A Native Number String will always format
+//				negative numeric values with a leading minus sign
+//				('-').
+//
+//				Native Number String Negative Value:
+//							-123.2
+//
+//		4.	A Native Number String WILL NEVER include integer
+//		  	separators such as commas (',') to separate
+//		  	integer digits by thousands.
+//
+//		    				NOT THIS: 1,000,000
+//				Native Number String: 1000000
+//
+//		5.	Native Number Strings will only consist of:
+//
+//			(a)	Numeric digits (0-9).
+//
+//			(b)	A decimal point ('.') for floating point
+//				numbers.
+//
+//			(c)	A leading minus sign ('-') in the case of
+//				negative numeric values.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (mathRatHelper *MathBigRatHelper) BigRatToNativeNumStr(
+	bigRatNum *big.Rat,
+	roundToFractionalDigits int,
+	errorPrefix interface{}) (
+	nativeNumStr string,
+	err error) {
+
+	if mathRatHelper.lock == nil {
+		mathRatHelper.lock = new(sync.Mutex)
+	}
+
+	mathRatHelper.lock.Lock()
+
+	defer mathRatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathBigRatHelper."+
+			"BigRatToNativeNumStr()",
+		"")
+
+	if err != nil {
+
+		return nativeNumStr, err
+	}
+
+	return new(mathBigRatHelperMechanics).
+		bigRatToNativeNumStr(
+			bigRatNum,
+			roundToFractionalDigits,
+			ePrefix.XCpy("bigRatNum"))
+}
+
+//	BigRatToBigFloat
+//
+//	Converts a rational number (type big.Rat) to a
+//	floating point number of type big.Float.
+//
+// ----------------------------------------------------------------
+//
+//	# Input Parameters
+//
+//	bigRatNum					*big.Rat
+//
+//		The rational number which will be converted to a
+//		big.Float numeric value.
+//
+//	roundToFractionalDigits 	int
+//
+//		Controls the number of fractional digits returned
+//		as a big float number ('bigFloatNum').
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it	contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	bigFloatNum					*big.Float
+//
+//		If this method completes successfully, this
+//		parameter will return a big.Float number
+//		representing the numeric value of the rational
+//		number passed through input parameter,
+//		'bigRatNum'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (mathRatHelper *MathBigRatHelper) BigRatToBigFloat(
+	bigRatNum *big.Rat,
+	roundToFractionalDigits int,
+	errorPrefix interface{}) (
+	bigFloatNum *big.Float,
+	err error) {
+
+	if mathRatHelper.lock == nil {
+		mathRatHelper.lock = new(sync.Mutex)
+	}
+
+	mathRatHelper.lock.Lock()
+
+	defer mathRatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	bigFloatNum = big.NewFloat(0)
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathBigRatHelper."+
+			"BigRatToBigFloat()",
+		"")
+
+	if err != nil {
+		return bigFloatNum, err
+	}
+
+	bigFloatNum,
+		err = new(mathBigRatHelperAtom).
+		ratToBigFloat(
+			bigRatNum,
+			roundToFractionalDigits,
+			ePrefix)
+
+	return bigFloatNum, err
+}
+
+// BigRatToMixedFraction
+//
+// Converts a big.Rat numeric value to a fraction or mixed
+// fraction string which exactly represents the rational
+// value. Unlike method BigRatToNativeNumStr(), no
+// information is lost to rounding or truncation.
+//
+// Examples:
+//
+//	Rational	Standard	Vulgar Fractions
+//	13/4		"3 1/4"		"3¼"
+//	-1/3		"-1/3"		"-⅓"
+//	22/7		"3 1/7"		"3⅐"
+//	5/1			"5"			"5"
+//	7/11		"7/11"		"7/11"
+//
+// Strings generated by this method may be converted back
+// to big.Rat values by method MixedFractionToBigRat().
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
 //
-// The term 'Native Number String' means that the number
-// string format is designed to interoperate with the
-// Golang programming language library functions and
-// packages. Types like 'strconv', 'strings', 'math' and
-// 'big' (big.Int, big.Float, big.Rat) routinely parse
-// and convert this type of number string to numeric
-// values. In addition, Native Number Strings are
-// frequently consumed by external library functions such
-// as this one (String Mechanics 'strmech') to convert
-// strings to numeric values and numeric values to
-// strings.
+//	bigRatNum					*big.Rat
 //
-// While this format is inconsistent with many national
-// and cultural formatting conventions, number strings
-// which fail to implement this standardized formatting
-// protocol will generate errors in some Golang library
-// functions.
+//		A pointer to an instance of the numeric value
+//		type big.Rat. This numeric value will be
+//		converted to, and returned as, a mixed fraction
+//		string. 'bigRatNum' is not modified.
 //
-// The 'Native' Number String returned by this method
-// therefore implements a standardized format defined as
-// follows:
+//	useVulgarFractions			bool
 //
-//  1. A Native Number String Consists of numeric
-//     character digits zero through nine inclusive
-//     (0-9).
+//		When set to 'true', the fractional component is
+//		rendered as a Unicode vulgar fraction character
+//		('½', '⅓', '¼', '⅞' etc.) where one exists. The
+//		vulgar fraction immediately follows the whole
+//		number ("3¼"). Fractions for which no vulgar
+//		fraction character exists are rendered in
+//		standard format ("3 7/11").
 //
-//  2. A Native Number String will include a period
-//     or decimal point ('.') to separate integer and
-//     fractional digits within a number string.
+//	errorPrefix					interface{}
 //
-//     Native Number String Floating Point Value:
-//     123.1234
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//  3. A Native Number String will always format
-//     negative numeric values with a leading minus sign
-//     ('-').
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//     Native Number String Negative Value:
-//     -123.2
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//  4. A Native Number String WILL NEVER include integer
-//     separators such as commas (',') to separate
-//     integer digits by thousands.
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//     NOT THIS: 1,000,000
-//     Native Number String: 1000000
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//  5. Native Number Strings will only consist of:
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//     (a)	Numeric digits zero through nine inclusive (0-9).
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//     (b)	A decimal point ('.') for floating point
-//     numbers.
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//     (c)	A leading minus sign ('-') in the case of
-//     negative numeric values.
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
 // ----------------------------------------------------------------
 //
-// # BE ADVISED
+// # Return Values
+//
+//	mixedFractionStr			string
+//
+//		If this method completes successfully, this
+//		parameter will return a fraction or mixed
+//		fraction string representing the exact value
+//		of 'bigRatNum'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (mathRatHelper *MathBigRatHelper) BigRatToMixedFraction(
+	bigRatNum *big.Rat,
+	useVulgarFractions bool,
+	errorPrefix interface{}) (
+	mixedFractionStr string,
+	err error) {
+
+	if mathRatHelper.lock == nil {
+		mathRatHelper.lock = new(sync.Mutex)
+	}
+
+	mathRatHelper.lock.Lock()
+
+	defer mathRatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathBigRatHelper."+
+			"BigRatToMixedFraction()",
+		"")
+
+	if err != nil {
+
+		return mixedFractionStr, err
+	}
+
+	return new(mathBigRatHelperElectron).
+		ratToMixedFraction(
+			bigRatNum,
+			useVulgarFractions,
+			ePrefix.XCpy("bigRatNum"))
+}
+
+// BigRatToRepeatingDecimal
+//
+// Converts a big.Rat numeric value to a decimal string in
+// which the repeating digits (the repetend) are identified
+// explicitly. Unlike method BigRatToNativeNumStr(), the
+// conversion is exact and no rounding or truncation is
+// performed.
+//
+// Examples:
+//
+//	Rational	Parentheses		Overline
+//	1/3			"0.(3)"			"0.3̅"
+//	1/7			"0.(142857)"	"0.1̅4̅2̅8̅5̅7̅"
+//	1/6			"0.1(6)"		"0.16̅"
+//	1/4			"0.25"			"0.25"
+//	-22/7		"-3.(142857)"	"-3.1̅4̅2̅8̅5̅7̅"
+//
+// Strings generated by this method may be converted back
+// to big.Rat values by method RepeatingDecimalToBigRat().
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	bigRatNum					*big.Rat
+//
+//		A pointer to an instance of the numeric value
+//		type big.Rat. This numeric value will be
+//		converted to, and returned as, a repeating
+//		decimal string. 'bigRatNum' is not modified.
+//
+//	useOverline					bool
+//
+//		When set to 'false', the repetend is enclosed in
+//		parentheses ("0.(142857)").
+//
+//		When set to 'true', each digit of the repetend is
+//		followed by a Unicode Combining Overline
+//		character (U+0305). This displays a bar over the
+//		repeating digits.
+//
+//	maxFractionalDigits			int
+//
+//		The maximum number of fractional digits
+//		(non-repeating digits plus repetend digits)
+//		which may be generated. The repetend for a
+//		denominator 'd' can be as long as d-1 digits.
+//		If the repeating decimal requires more
+//		fractional digits than this limit, an error is
+//		returned.
+//
+//		If this value is less than one (1), an error
+//		will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	repeatingDecimalStr			string
+//
+//		If this method completes successfully, this
+//		parameter will return a repeating decimal string
+//		representing the exact value of 'bigRatNum'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (mathRatHelper *MathBigRatHelper) BigRatToRepeatingDecimal(
+	bigRatNum *big.Rat,
+	useOverline bool,
+	maxFractionalDigits int,
+	errorPrefix interface{}) (
+	repeatingDecimalStr string,
+	err error) {
+
+	if mathRatHelper.lock == nil {
+		mathRatHelper.lock = new(sync.Mutex)
+	}
+
+	mathRatHelper.lock.Lock()
+
+	defer mathRatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathBigRatHelper."+
+			"BigRatToRepeatingDecimal()",
+		"")
+
+	if err != nil {
+
+		return repeatingDecimalStr, err
+	}
+
+	return new(mathBigRatHelperElectron).
+		ratToRepeatingDecimal(
+			bigRatNum,
+			useOverline,
+			maxFractionalDigits,
+			ePrefix.XCpy("bigRatNum"))
+}
+
+// ContinuedFractionConvergents
 //
-//	Before being converted to Native Number String, the
-//	big.Rat numeric value will be rounded by the Golang
-//	'big' package function:
+// Computes the simple continued fraction expansion of a
+// big.Rat value and returns the convergents together
+// with their partial quotients and approximation errors.
 //
-//			func (*Rat) FloatString
+// Example:
 //
-//	The rounding algorithm used is described as:
+//	bigRatNum = 314159265358979/100000000000000
 //
-//		The last digit is rounded to nearest, with halves
-//		rounded away from zero.
-//			https://pkg.go.dev/math/big#Rat
+//	Index	Partial Quotient	Convergent		Error
+//	0		3					3/1				0.14159...
+//	1		7					22/7			0.00126...
+//	2		15					333/106			0.00008...
+//	3		1					355/113			0.00000026...
 //
 // ----------------------------------------------------------------
 //
@@ -112,25 +1321,15 @@ type MathBigRatHelper struct {
 //
 //	bigRatNum					*big.Rat
 //
-//		A pointer to an instance of the numeric value
-//		type big.Rat. This numeric value will be
-//		converted to, and returned as, a Native Number
-//		String.
-//
-//		Before being converted to a Native Number String,
-//		this numeric value will be rounded by the Golang
-//		'big' package functions as specified by input
-//		parameter, 'roundToFractionalDigits'.
+//		The rational value to be expanded. This value is
+//		not modified.
 //
-//	roundToFractionalDigits		int
+//	maxConvergents				int
 //
-//		When set to a positive integer value, this
-//		parameter controls the number of digits to the
-//		right of the radix point or decimal separator
-//		(a.k.a. decimal point). Effectively this defines
-//		the number of fractional digits remaining after
-//		completion of the number rounding operation
-//		performed by the Golang package functions.
+//		The maximum number of convergents to be returned.
+//		If this value is less than one (1), all
+//		convergents will be returned. Since 'bigRatNum'
+//		is rational, the number of convergents is finite.
 //
 //	errorPrefix					interface{}
 //
@@ -195,51 +1394,15 @@ type MathBigRatHelper struct {
 //
 // # Return Values
 //
-//	nativeNumStr			string
-//
-//		If this method completes successfully, a Native
-//		Number String representing the numeric value
-//		passed as input	parameter 'numericValue' will be
-//		returned.
-//
-//		The 'Native' Number String returned by this
-//		method implements a standardized format defined
-//		as follows:
-//
-//		1.	A Native Number String Consists of numeric
-//		  	character digits zero through nine inclusive
-//		  	(0-9).
-//
-//		2.	A Native Number String will include a period
-//		  	or decimal point ('.') to separate integer and
-//		  	fractional digits within a number string.
-//
-//					Native Number String Floating Point Value:
-//									123.1234
-//
-//		3.	A Native Number String will always format
-//				negative numeric values with a leading minus sign
-//				('-').
-//
-//				Native Number String Negative Value:
-//							-123.2
-//
-//		4.	A Native Number String WILL NEVER include integer
-//		  	separators such as commas (',') to separate
-//		  	integer digits by thousands.
-//
-//		    				NOT THIS: 1,000,000
-//				Native Number String: 1000000
-//
-//		5.	Native Number Strings will only consist of:
-//
-//			(a)	Numeric digits (0-9).
-//
-//			(b)	A decimal point ('.') for floating point
-//				numbers.
+//	convergents					[]ContinuedFractionConvergentDto
 //
-//			(c)	A leading minus sign ('-') in the case of
-//				negative numeric values.
+//		If this method completes successfully, this
+//		array will contain the convergents of
+//		'bigRatNum' in order of increasing accuracy.
+//		Each element includes the partial quotient, the
+//		convergent and the exact absolute error of the
+//		convergent. The final convergent is equal to
+//		'bigRatNum'.
 //
 //	err							error
 //
@@ -253,11 +1416,11 @@ type MathBigRatHelper struct {
 //		input parameter, 'errorPrefix'. The 'errorPrefix'
 //		text will be attached to the beginning of the
 //		error message.
-func (mathRatHelper *MathBigRatHelper) BigRatToNativeNumStr(
+func (mathRatHelper *MathBigRatHelper) ContinuedFractionConvergents(
 	bigRatNum *big.Rat,
-	roundToFractionalDigits int,
+	maxConvergents int,
 	errorPrefix interface{}) (
-	nativeNumStr string,
+	convergents []ContinuedFractionConvergentDto,
 	err error) {
 
 	if mathRatHelper.lock == nil {
@@ -274,45 +1437,60 @@ func (mathRatHelper *MathBigRatHelper) BigRatToNativeNumStr(
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"MathBigRatHelper."+
-			"BigRatToNativeNumStr()",
+			"ContinuedFractionConvergents()",
 		"")
 
 	if err != nil {
 
-		return nativeNumStr, err
+		return convergents, err
 	}
 
-	return new(mathBigRatHelperMechanics).
-		bigRatToNativeNumStr(
+	return new(mathBigRatHelperQuark).
+		continuedFractionConvergents(
 			bigRatNum,
-			roundToFractionalDigits,
+			maxConvergents,
 			ePrefix.XCpy("bigRatNum"))
 }
 
-//	BigRatToBigFloat
+// MixedFractionToBigRat
 //
-//	Converts a rational number (type big.Rat) to a
-//	floating point number of type big.Float.
+// Parses a fraction or mixed fraction string and returns
+// the exact rational value as a big.Rat.
 //
-// ----------------------------------------------------------------
+// The following formats are supported:
 //
-//	# Input Parameters
+//	"7"			Integer
+//	"22/7"		Proper or improper fraction
+//	"3 1/4"		Mixed fraction
+//	"3¼"		Mixed fraction with a vulgar fraction
+//	"3 ¼"		Mixed fraction with a vulgar fraction
+//	"-¾"		Vulgar fraction
 //
-//	bigRatNum					*big.Rat
+// The Unicode fraction slash (U+2044) may be used in place
+// of the solidus ('/'). A leading minus ('-') or plus ('+')
+// sign applies to the entire value. Leading and trailing
+// white space is ignored.
 //
-//		The rational number which will be converted to a
-//		big.Float numeric value.
+// The fractional component of a mixed fraction must be a
+// proper fraction (numerator less than denominator).
 //
-//	roundToFractionalDigits 	int
+// This method will parse all strings generated by method
+// BigRatToMixedFraction().
 //
-//		Controls the number of fractional digits returned
-//		as a big float number ('bigFloatNum').
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	mixedFractionStr			string
+//
+//		The fraction or mixed fraction string which will
+//		be parsed and converted to a big.Rat value.
 //
 //	errorPrefix					interface{}
 //
 //		This object encapsulates error prefix text which
 //		is included in all returned error messages.
-//		Usually, it	contains the name of the calling
+//		Usually, it contains the name of the calling
 //		method or methods listed as a method or function
 //		chain of execution.
 //
@@ -371,13 +1549,11 @@ func (mathRatHelper *MathBigRatHelper) BigRatToNativeNumStr(
 //
 // # Return Values
 //
-//	bigFloatNum					*big.Float
+//	bigRatNum					*big.Rat
 //
 //		If this method completes successfully, this
-//		parameter will return a big.Float number
-//		representing the numeric value of the rational
-//		number passed through input parameter,
-//		'bigRatNum'.
+//		parameter will return the exact rational value
+//		of 'mixedFractionStr'.
 //
 //	err							error
 //
@@ -391,11 +1567,10 @@ func (mathRatHelper *MathBigRatHelper) BigRatToNativeNumStr(
 //		input parameter, 'errorPrefix'. The 'errorPrefix'
 //		text will be attached to the beginning of the
 //		error message.
-func (mathRatHelper *MathBigRatHelper) BigRatToBigFloat(
-	bigRatNum *big.Rat,
-	roundToFractionalDigits int,
+func (mathRatHelper *MathBigRatHelper) MixedFractionToBigRat(
+	mixedFractionStr string,
 	errorPrefix interface{}) (
-	bigFloatNum *big.Float,
+	bigRatNum *big.Rat,
 	err error) {
 
 	if mathRatHelper.lock == nil {
@@ -408,27 +1583,24 @@ func (mathRatHelper *MathBigRatHelper) BigRatToBigFloat(
 
 	var ePrefix *ePref.ErrPrefixDto
 
-	bigFloatNum = big.NewFloat(0)
+	bigRatNum = big.NewRat(0, 1)
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"MathBigRatHelper."+
-			"BigRatToBigFloat()",
+			"MixedFractionToBigRat()",
 		"")
 
 	if err != nil {
-		return bigFloatNum, err
-	}
 
-	bigFloatNum,
-		err = new(mathBigRatHelperAtom).
-		ratToBigFloat(
-			bigRatNum,
-			roundToFractionalDigits,
-			ePrefix)
+		return bigRatNum, err
+	}
 
-	return bigFloatNum, err
+	return new(mathBigRatHelperElectron).
+		mixedFractionToRat(
+			mixedFractionStr,
+			ePrefix.XCpy("mixedFractionStr"))
 }
 
 // NativeNumStrToBigRatValue
@@ -657,3 +1829,154 @@ func (mathRatHelper *MathBigRatHelper) NativeNumStrToBigRatValue(
 			ePrefix.XCpy(
 				"ptrBigRatNum<-nativeNumStr"))
 }
+
+// RepeatingDecimalToBigRat
+//
+// Parses a repeating decimal string and returns the exact
+// rational value as a big.Rat.
+//
+// The repetend may be enclosed in parentheses
+// ("0.1(6)"), or marked by placing a Unicode Combining
+// Overline character (U+0305) after each repeating digit
+// ("0.16̅"). Decimal strings without a repetend ("1.25")
+// are also accepted.
+//
+// A leading minus ('-') or plus ('+') sign is supported.
+// Leading and trailing white space is ignored.
+//
+// This method will parse all strings generated by method
+// BigRatToRepeatingDecimal().
+//
+// Examples:
+//
+//	"0.(3)"			1/3
+//	"0.1(6)"		1/6
+//	"-3.(142857)"	-22/7
+//	"0.16̅"			1/6
+//	"1.25"			5/4
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	repeatingDecimalStr			string
+//
+//		The repeating decimal string which will be parsed
+//		and converted to a big.Rat value.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	bigRatNum					*big.Rat
+//
+//		If this method completes successfully, this
+//		parameter will return the exact rational value
+//		of 'repeatingDecimalStr'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (mathRatHelper *MathBigRatHelper) RepeatingDecimalToBigRat(
+	repeatingDecimalStr string,
+	errorPrefix interface{}) (
+	bigRatNum *big.Rat,
+	err error) {
+
+	if mathRatHelper.lock == nil {
+		mathRatHelper.lock = new(sync.Mutex)
+	}
+
+	mathRatHelper.lock.Lock()
+
+	defer mathRatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	bigRatNum = big.NewRat(0, 1)
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathBigRatHelper."+
+			"RepeatingDecimalToBigRat()",
+		"")
+
+	if err != nil {
+
+		return bigRatNum, err
+	}
+
+	return new(mathBigRatHelperElectron).
+		repeatingDecimalToRat(
+			repeatingDecimalStr,
+			ePrefix.XCpy("repeatingDecimalStr"))
+}
@@ -0,0 +1,1041 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"math/big"
+	"strings"
+	"sync"
+)
+
+// mBigRatVulgarFractions - Maps proper fractions to the
+// equivalent Unicode vulgar fraction characters.
+var mBigRatVulgarFractions = map[[2]int64]rune{
+	{1, 2}:  '½',
+	{1, 3}:  '⅓',
+	{2, 3}:  '⅔',
+	{1, 4}:  '¼',
+	{3, 4}:  '¾',
+	{1, 5}:  '⅕',
+	{2, 5}:  '⅖',
+	{3, 5}:  '⅗',
+	{4, 5}:  '⅘',
+	{1, 6}:  '⅙',
+	{5, 6}:  '⅚',
+	{1, 7}:  '⅐',
+	{1, 8}:  '⅛',
+	{3, 8}:  '⅜',
+	{5, 8}:  '⅝',
+	{7, 8}:  '⅞',
+	{1, 9}:  '⅑',
+	{1, 10}: '⅒',
+}
+
+// Unicode Combining Overline (U+0305). When placed after
+// a digit, the digit is displayed with a bar above it.
+const bigRatCombiningOverline = '\u0305'
+
+// Unicode Fraction Slash (U+2044)
+const bigRatFractionSlash = '\u2044'
+
+// mathBigRatHelperElectron
+//
+// Provides helper methods for type MathBigRatHelper. These
+// methods convert big.Rat values to and from exact text
+// representations such as mixed fractions and repeating
+// decimals.
+type mathBigRatHelperElectron struct {
+	lock *sync.Mutex
+}
+
+// isDigitStr - Returns 'true' if 'str' is not empty and
+// consists entirely of the ASCII digits '0' through '9'.
+func (mathBigRatHelpElectron *mathBigRatHelperElectron) isDigitStr(
+	str string) bool {
+
+	if len(str) == 0 {
+		return false
+	}
+
+	for _, r := range str {
+
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// mixedFractionToRat
+//
+// Parses a fraction or mixed fraction string and returns
+// the exact rational value as a big.Rat.
+//
+// The following formats are supported:
+//
+//	"7"			Integer
+//	"22/7"		Proper or improper fraction
+//	"3 1/4"		Mixed fraction
+//	"3¼"		Mixed fraction with a vulgar fraction
+//	"3 ¼"		Mixed fraction with a vulgar fraction
+//	"¾"			Vulgar fraction
+//
+// The fraction slash (U+2044) may be used in place of the
+// solidus ('/'). A leading minus ('-') or plus ('+') sign
+// applies to the entire value. Leading and trailing white
+// space is ignored.
+//
+// The fractional component of a mixed fraction must be a
+// proper fraction (numerator less than denominator).
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	mixedFractionStr			string
+//
+//		The fraction or mixed fraction string to be
+//		parsed.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	bigRatNum					*big.Rat
+//
+//		If this method completes successfully, this
+//		parameter will return the exact rational value
+//		of 'mixedFractionStr'.
+//
+//	err							error
+//
+//		If this method completes successfully, this
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathBigRatHelpElectron *mathBigRatHelperElectron) mixedFractionToRat(
+	mixedFractionStr string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	bigRatNum *big.Rat,
+	err error) {
+
+	if mathBigRatHelpElectron.lock == nil {
+		mathBigRatHelpElectron.lock = new(sync.Mutex)
+	}
+
+	mathBigRatHelpElectron.lock.Lock()
+
+	defer mathBigRatHelpElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	bigRatNum = big.NewRat(0, 1)
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"mathBigRatHelperElectron."+
+			"mixedFractionToRat()",
+		"")
+
+	if err != nil {
+
+		return bigRatNum, err
+	}
+
+	str := strings.TrimSpace(mixedFractionStr)
+
+	isNegative := false
+
+	if strings.HasPrefix(str, "-") {
+
+		isNegative = true
+
+		str = str[1:]
+
+	} else if strings.HasPrefix(str, "+") {
+
+		str = str[1:]
+	}
+
+	if len(str) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"ERROR: Input parameter 'mixedFractionStr' is invalid!\n"+
+			"'mixedFractionStr' does not contain a numeric value.\n"+
+			"mixedFractionStr = '%v'\n",
+			ePrefix.String(),
+			mixedFractionStr)
+
+		return bigRatNum, err
+	}
+
+	var wholeStr, fractionStr string
+
+	var fraction *big.Rat
+
+	strRunes := []rune(str)
+
+	lastRune := strRunes[len(strRunes)-1]
+
+	for numDenom, vulgarChar := range mBigRatVulgarFractions {
+
+		if vulgarChar == lastRune {
+
+			fraction = big.NewRat(numDenom[0], numDenom[1])
+
+			wholeStr = strings.TrimSpace(
+				string(strRunes[:len(strRunes)-1]))
+
+			break
+		}
+	}
+
+	if fraction == nil {
+
+		fields := strings.Fields(
+			strings.ReplaceAll(
+				str,
+				string(bigRatFractionSlash),
+				"/"))
+
+		switch len(fields) {
+
+		case 1:
+
+			if strings.Contains(fields[0], "/") {
+				fractionStr = fields[0]
+			} else {
+				wholeStr = fields[0]
+			}
+
+		case 2:
+
+			wholeStr = fields[0]
+			fractionStr = fields[1]
+
+			if !strings.Contains(fractionStr, "/") {
+
+				err = fmt.Errorf("%v\n"+
+					"ERROR: Input parameter 'mixedFractionStr' is invalid!\n"+
+					"The second component of the mixed fraction is not\n"+
+					"a fraction.\n"+
+					"mixedFractionStr = '%v'\n",
+					ePrefix.String(),
+					mixedFractionStr)
+
+				return bigRatNum, err
+			}
+
+		default:
+
+			err = fmt.Errorf("%v\n"+
+				"ERROR: Input parameter 'mixedFractionStr' is invalid!\n"+
+				"'mixedFractionStr' contains too many components.\n"+
+				"mixedFractionStr = '%v'\n",
+				ePrefix.String(),
+				mixedFractionStr)
+
+			return bigRatNum, err
+		}
+	}
+
+	if len(fractionStr) > 0 {
+
+		parts := strings.Split(fractionStr, "/")
+
+		if len(parts) != 2 ||
+			!mathBigRatHelpElectron.isDigitStr(parts[0]) ||
+			!mathBigRatHelpElectron.isDigitStr(parts[1]) {
+
+			err = fmt.Errorf("%v\n"+
+				"ERROR: Input parameter 'mixedFractionStr' is invalid!\n"+
+				"The fraction component must consist of a numerator\n"+
+				"and denominator separated by a slash ('/').\n"+
+				"mixedFractionStr = '%v'\n",
+				ePrefix.String(),
+				mixedFractionStr)
+
+			return bigRatNum, err
+		}
+
+		numerator, _ := new(big.Int).SetString(parts[0], 10)
+
+		denominator, _ := new(big.Int).SetString(parts[1], 10)
+
+		if denominator.Sign() == 0 {
+
+			err = fmt.Errorf("%v\n"+
+				"ERROR: Input parameter 'mixedFractionStr' is invalid!\n"+
+				"The denominator of the fraction is zero.\n"+
+				"mixedFractionStr = '%v'\n",
+				ePrefix.String(),
+				mixedFractionStr)
+
+			return bigRatNum, err
+		}
+
+		if len(wholeStr) > 0 &&
+			numerator.Cmp(denominator) >= 0 {
+
+			err = fmt.Errorf("%v\n"+
+				"ERROR: Input parameter 'mixedFractionStr' is invalid!\n"+
+				"The fraction component of a mixed fraction must be\n"+
+				"a proper fraction (numerator < denominator).\n"+
+				"mixedFractionStr = '%v'\n",
+				ePrefix.String(),
+				mixedFractionStr)
+
+			return bigRatNum, err
+		}
+
+		fraction = new(big.Rat).SetFrac(numerator, denominator)
+	}
+
+	if len(wholeStr) > 0 {
+
+		if !mathBigRatHelpElectron.isDigitStr(wholeStr) {
+
+			err = fmt.Errorf("%v\n"+
+				"ERROR: Input parameter 'mixedFractionStr' is invalid!\n"+
+				"The whole number component contains invalid characters.\n"+
+				"mixedFractionStr = '%v'\n",
+				ePrefix.String(),
+				mixedFractionStr)
+
+			return bigRatNum, err
+		}
+
+		wholeNum, _ := new(big.Int).SetString(wholeStr, 10)
+
+		bigRatNum.SetInt(wholeNum)
+	}
+
+	if fraction != nil {
+		bigRatNum.Add(bigRatNum, fraction)
+	}
+
+	if isNegative {
+		bigRatNum.Neg(bigRatNum)
+	}
+
+	return bigRatNum, err
+}
+
+// ratToMixedFraction
+//
+// Converts a big.Rat value to a fraction or mixed fraction
+// string which exactly represents the rational value.
+//
+// Examples:
+//
+//	13/4	"3 1/4"		"3¼"	(useVulgarFractions = true)
+//	-1/3	"-1/3"		"-⅓"	(useVulgarFractions = true)
+//	22/7	"3 1/7"		"3⅐"	(useVulgarFractions = true)
+//	5/1		"5"
+//	7/11	"7/11"		"7/11"	(no vulgar fraction exists)
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	bigRatNum					*big.Rat
+//
+//		The rational number which will be converted to a
+//		mixed fraction string. This value is not
+//		modified.
+//
+//	useVulgarFractions			bool
+//
+//		When set to 'true', the fractional component is
+//		rendered as a Unicode vulgar fraction character
+//		('½', '¼', '⅞' etc.) if one exists. The vulgar
+//		fraction immediately follows the whole number
+//		("3¼").
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	mixedFractionStr			string
+//
+//		If this method completes successfully, this
+//		parameter will return the mixed fraction string.
+//
+//	err							error
+//
+//		If this method completes successfully, this
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathBigRatHelpElectron *mathBigRatHelperElectron) ratToMixedFraction(
+	bigRatNum *big.Rat,
+	useVulgarFractions bool,
+	errPrefDto *ePref.ErrPrefixDto) (
+	mixedFractionStr string,
+	err error) {
+
+	if mathBigRatHelpElectron.lock == nil {
+		mathBigRatHelpElectron.lock = new(sync.Mutex)
+	}
+
+	mathBigRatHelpElectron.lock.Lock()
+
+	defer mathBigRatHelpElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"mathBigRatHelperElectron."+
+			"ratToMixedFraction()",
+		"")
+
+	if err != nil {
+
+		return mixedFractionStr, err
+	}
+
+	if bigRatNum == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"ERROR: Input parameter 'bigRatNum' is a nil pointer!\n",
+			ePrefix.String())
+
+		return mixedFractionStr, err
+	}
+
+	var sb strings.Builder
+
+	if bigRatNum.Sign() < 0 {
+		sb.WriteString("-")
+	}
+
+	numerator := new(big.Int).Abs(bigRatNum.Num())
+
+	denominator := new(big.Int).Set(bigRatNum.Denom())
+
+	wholeNum,
+		remainder := new(big.Int).QuoRem(
+		numerator,
+		denominator,
+		new(big.Int))
+
+	if remainder.Sign() == 0 {
+
+		sb.WriteString(wholeNum.String())
+
+		return sb.String(), err
+	}
+
+	hasWholeNum := wholeNum.Sign() != 0
+
+	if hasWholeNum {
+		sb.WriteString(wholeNum.String())
+	}
+
+	if useVulgarFractions &&
+		remainder.IsInt64() &&
+		denominator.IsInt64() {
+
+		vulgarChar, ok := mBigRatVulgarFractions[[2]int64{
+			remainder.Int64(),
+			denominator.Int64()}]
+
+		if ok {
+
+			sb.WriteRune(vulgarChar)
+
+			return sb.String(), err
+		}
+	}
+
+	if hasWholeNum {
+		sb.WriteString(" ")
+	}
+
+	sb.WriteString(remainder.String())
+	sb.WriteString("/")
+	sb.WriteString(denominator.String())
+
+	return sb.String(), err
+}
+
+// ratToRepeatingDecimal
+//
+// Converts a big.Rat value to a decimal string in which
+// the repeating digits (the repetend) are identified
+// explicitly. The conversion is exact; no rounding is
+// performed.
+//
+// Examples:
+//
+//	1/3		"0.(3)"			"0.3̅"
+//	1/7		"0.(142857)"	"0.1̅4̅2̅8̅5̅7̅"
+//	1/6		"0.1(6)"		"0.16̅"
+//	1/4		"0.25"			"0.25"
+//	-22/7	"-3.(142857)"	"-3.1̅4̅2̅8̅5̅7̅"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	bigRatNum					*big.Rat
+//
+//		The rational number which will be converted to a
+//		repeating decimal string. This value is not
+//		modified.
+//
+//	useOverline					bool
+//
+//		When set to 'false', the repetend is enclosed in
+//		parentheses ("0.(142857)").
+//
+//		When set to 'true', each digit of the repetend is
+//		followed by a Unicode Combining Overline
+//		character (U+0305), which displays a bar over the
+//		repeating digits.
+//
+//	maxFractionalDigits			int
+//
+//		The maximum number of fractional digits
+//		(non-repeating digits plus repetend digits)
+//		which will be generated. The repetend of a
+//		fraction with denominator 'd' may contain up to
+//		d-1 digits. If this limit is exceeded, an error
+//		is returned. This value must be greater than
+//		zero.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	repeatingDecimalStr			string
+//
+//		If this method completes successfully, this
+//		parameter will return the repeating decimal
+//		string.
+//
+//	err							error
+//
+//		If this method completes successfully, this
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathBigRatHelpElectron *mathBigRatHelperElectron) ratToRepeatingDecimal(
+	bigRatNum *big.Rat,
+	useOverline bool,
+	maxFractionalDigits int,
+	errPrefDto *ePref.ErrPrefixDto) (
+	repeatingDecimalStr string,
+	err error) {
+
+	if mathBigRatHelpElectron.lock == nil {
+		mathBigRatHelpElectron.lock = new(sync.Mutex)
+	}
+
+	mathBigRatHelpElectron.lock.Lock()
+
+	defer mathBigRatHelpElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"mathBigRatHelperElectron."+
+			"ratToRepeatingDecimal()",
+		"")
+
+	if err != nil {
+
+		return repeatingDecimalStr, err
+	}
+
+	if bigRatNum == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"ERROR: Input parameter 'bigRatNum' is a nil pointer!\n",
+			ePrefix.String())
+
+		return repeatingDecimalStr, err
+	}
+
+	if maxFractionalDigits < 1 {
+
+		err = fmt.Errorf("%v\n"+
+			"ERROR: Input parameter 'maxFractionalDigits' is invalid!\n"+
+			"'maxFractionalDigits' has a value less than one (1).\n"+
+			"maxFractionalDigits = %v\n",
+			ePrefix.String(),
+			maxFractionalDigits)
+
+		return repeatingDecimalStr, err
+	}
+
+	var sb strings.Builder
+
+	if bigRatNum.Sign() < 0 {
+		sb.WriteString("-")
+	}
+
+	denominator := new(big.Int).Set(bigRatNum.Denom())
+
+	integerPart,
+		remainder := new(big.Int).QuoRem(
+		new(big.Int).Abs(bigRatNum.Num()),
+		denominator,
+		new(big.Int))
+
+	sb.WriteString(integerPart.String())
+
+	if remainder.Sign() == 0 {
+
+		return sb.String(), err
+	}
+
+	// Long division. Each remainder is recorded with the
+	// index of the fractional digit it produces. When a
+	// remainder repeats, the digits between the two
+	// occurrences form the repetend.
+	remainderIndexes := make(map[string]int)
+
+	var fracDigits []byte
+
+	ten := big.NewInt(10)
+
+	digit := new(big.Int)
+
+	repetendStart := -1
+
+	for remainder.Sign() != 0 {
+
+		remainderKey := remainder.String()
+
+		if idx, ok := remainderIndexes[remainderKey]; ok {
+
+			repetendStart = idx
+
+			break
+		}
+
+		if len(fracDigits) >= maxFractionalDigits {
+
+			err = fmt.Errorf("%v\n"+
+				"ERROR: The repeating decimal for 'bigRatNum' exceeds\n"+
+				"the maximum number of fractional digits.\n"+
+				"bigRatNum           = %v\n"+
+				"maxFractionalDigits = %v\n",
+				ePrefix.String(),
+				bigRatNum.String(),
+				maxFractionalDigits)
+
+			return repeatingDecimalStr, err
+		}
+
+		remainderIndexes[remainderKey] = len(fracDigits)
+
+		remainder.Mul(remainder, ten)
+
+		digit.QuoRem(remainder, denominator, remainder)
+
+		fracDigits = append(fracDigits, byte('0'+digit.Int64()))
+	}
+
+	sb.WriteString(".")
+
+	if repetendStart < 0 {
+
+		sb.Write(fracDigits)
+
+		return sb.String(), err
+	}
+
+	sb.Write(fracDigits[:repetendStart])
+
+	if useOverline {
+
+		for _, fracDigit := range fracDigits[repetendStart:] {
+			sb.WriteByte(fracDigit)
+			sb.WriteRune(bigRatCombiningOverline)
+		}
+
+	} else {
+
+		sb.WriteString("(")
+		sb.Write(fracDigits[repetendStart:])
+		sb.WriteString(")")
+	}
+
+	return sb.String(), err
+}
+
+// repeatingDecimalToRat
+//
+// Parses a repeating decimal string and returns the exact
+// rational value as a big.Rat.
+//
+// The repetend may be enclosed in parentheses
+// ("0.1(6)") or marked by placing a Unicode Combining
+// Overline character (U+0305) after each repeating digit
+// ("0.16̅"). Strings without a repetend ("1.25") are also
+// accepted.
+//
+// A leading minus ('-') or plus ('+') sign is supported.
+// Leading and trailing white space is ignored.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	repeatingDecimalStr			string
+//
+//		The repeating decimal string to be parsed.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	bigRatNum					*big.Rat
+//
+//		If this method completes successfully, this
+//		parameter will return the exact rational value
+//		of 'repeatingDecimalStr'.
+//
+//	err							error
+//
+//		If this method completes successfully, this
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathBigRatHelpElectron *mathBigRatHelperElectron) repeatingDecimalToRat(
+	repeatingDecimalStr string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	bigRatNum *big.Rat,
+	err error) {
+
+	if mathBigRatHelpElectron.lock == nil {
+		mathBigRatHelpElectron.lock = new(sync.Mutex)
+	}
+
+	mathBigRatHelpElectron.lock.Lock()
+
+	defer mathBigRatHelpElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	bigRatNum = big.NewRat(0, 1)
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"mathBigRatHelperElectron."+
+			"repeatingDecimalToRat()",
+		"")
+
+	if err != nil {
+
+		return bigRatNum, err
+	}
+
+	strRunes := []rune(strings.TrimSpace(repeatingDecimalStr))
+
+	isNegative := false
+
+	if len(strRunes) > 0 {
+
+		if strRunes[0] == '-' {
+
+			isNegative = true
+
+			strRunes = strRunes[1:]
+
+		} else if strRunes[0] == '+' {
+
+			strRunes = strRunes[1:]
+		}
+	}
+
+	var integerDigits, nonRepeatingDigits, repetendDigits []rune
+
+	const (
+		stateInteger = iota
+		stateFraction
+		stateParenRepetend
+		stateOverlineRepetend
+		stateComplete
+	)
+
+	state := stateInteger
+
+	lenStrRunes := len(strRunes)
+
+	for i := 0; i < lenStrRunes; i++ {
+
+		r := strRunes[i]
+
+		isOverlined := i+1 < lenStrRunes &&
+			strRunes[i+1] == bigRatCombiningOverline
+
+		switch {
+
+		case state == stateComplete:
+
+			err = fmt.Errorf("%v\n"+
+				"ERROR: Input parameter 'repeatingDecimalStr' is invalid!\n"+
+				"Characters were found after the repetend.\n"+
+				"repeatingDecimalStr = '%v'\n",
+				ePrefix.String(),
+				repeatingDecimalStr)
+
+			return bigRatNum, err
+
+		case r >= '0' && r <= '9':
+
+			if isOverlined {
+
+				if state == stateInteger ||
+					state == stateParenRepetend {
+
+					err = fmt.Errorf("%v\n"+
+						"ERROR: Input parameter 'repeatingDecimalStr' is invalid!\n"+
+						"Overlined digits must follow the decimal point.\n"+
+						"repeatingDecimalStr = '%v'\n",
+						ePrefix.String(),
+						repeatingDecimalStr)
+
+					return bigRatNum, err
+				}
+
+				state = stateOverlineRepetend
+
+				repetendDigits = append(repetendDigits, r)
+
+				// Skip the overline character
+				i++
+
+				continue
+			}
+
+			switch state {
+			case stateInteger:
+				integerDigits = append(integerDigits, r)
+			case stateFraction:
+				nonRepeatingDigits = append(nonRepeatingDigits, r)
+			case stateParenRepetend:
+				repetendDigits = append(repetendDigits, r)
+			default:
+
+				err = fmt.Errorf("%v\n"+
+					"ERROR: Input parameter 'repeatingDecimalStr' is invalid!\n"+
+					"All digits following the first overlined digit must\n"+
+					"also be overlined.\n"+
+					"repeatingDecimalStr = '%v'\n",
+					ePrefix.String(),
+					repeatingDecimalStr)
+
+				return bigRatNum, err
+			}
+
+		case r == '.' && state == stateInteger:
+
+			state = stateFraction
+
+		case r == '(' && state == stateFraction:
+
+			state = stateParenRepetend
+
+		case r == ')' && state == stateParenRepetend:
+
+			if len(repetendDigits) == 0 {
+
+				err = fmt.Errorf("%v\n"+
+					"ERROR: Input parameter 'repeatingDecimalStr' is invalid!\n"+
+					"The parentheses do not contain a repetend.\n"+
+					"repeatingDecimalStr = '%v'\n",
+					ePrefix.String(),
+					repeatingDecimalStr)
+
+				return bigRatNum, err
+			}
+
+			state = stateComplete
+
+		default:
+
+			err = fmt.Errorf("%v\n"+
+				"ERROR: Input parameter 'repeatingDecimalStr' is invalid!\n"+
+				"Invalid character '%v' found at index %v.\n"+
+				"repeatingDecimalStr = '%v'\n",
+				ePrefix.String(),
+				string(r),
+				i,
+				repeatingDecimalStr)
+
+			return bigRatNum, err
+		}
+	}
+
+	if state == stateParenRepetend {
+
+		err = fmt.Errorf("%v\n"+
+			"ERROR: Input parameter 'repeatingDecimalStr' is invalid!\n"+
+			"The repetend parentheses were never closed.\n"+
+			"repeatingDecimalStr = '%v'\n",
+			ePrefix.String(),
+			repeatingDecimalStr)
+
+		return bigRatNum, err
+	}
+
+	if len(integerDigits)+
+		len(nonRepeatingDigits)+
+		len(repetendDigits) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"ERROR: Input parameter 'repeatingDecimalStr' is invalid!\n"+
+			"'repeatingDecimalStr' does not contain any numeric digits.\n"+
+			"repeatingDecimalStr = '%v'\n",
+			ePrefix.String(),
+			repeatingDecimalStr)
+
+		return bigRatNum, err
+	}
+
+	// For integer digits I, non-repeating digits N and
+	// repetend R, where n = len(N) and r = len(R):
+	//
+	//	value = (INR - IN) / (10^n * (10^r - 1))
+	//
+	// When there is no repetend:
+	//
+	//	value = IN / 10^n
+	ten := big.NewInt(10)
+
+	nonRepeatingLen := int64(len(nonRepeatingDigits))
+
+	prefixDigits := "0" +
+		string(integerDigits) +
+		string(nonRepeatingDigits)
+
+	prefixNum, _ := new(big.Int).SetString(prefixDigits, 10)
+
+	denominator := new(big.Int).Exp(
+		ten,
+		big.NewInt(nonRepeatingLen),
+		nil)
+
+	numerator := new(big.Int).Set(prefixNum)
+
+	if len(repetendDigits) > 0 {
+
+		allDigitsNum, _ := new(big.Int).SetString(
+			prefixDigits+string(repetendDigits),
+			10)
+
+		numerator.Sub(allDigitsNum, prefixNum)
+
+		nines := new(big.Int).Exp(
+			ten,
+			big.NewInt(int64(len(repetendDigits))),
+			nil)
+
+		nines.Sub(nines, big.NewInt(1))
+
+		denominator.Mul(denominator, nines)
+	}
+
+	bigRatNum.SetFrac(numerator, denominator)
+
+	if isNegative {
+		bigRatNum.Neg(bigRatNum)
+	}
+
+	return bigRatNum, err
+}
@@ -0,0 +1,663 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"math/big"
+	"sync"
+)
+
+// mathBigRatHelperQuark
+//
+// Provides low level helper methods for type
+// MathBigRatHelper. These methods compute continued
+// fraction expansions and rational approximations of
+// big.Rat values.
+type mathBigRatHelperQuark struct {
+	lock *sync.Mutex
+}
+
+// bestRationalApproximation
+//
+// Returns the best rational approximation of the exact
+// rational value 'value' subject to a maximum denominator,
+// a tolerance, or both. The approximation is computed from
+// the continued fraction convergents and semiconvergents
+// of 'value'.
+//
+//	maxDenominator only:
+//		Returns the rational number closest to 'value'
+//		whose denominator does not exceed
+//		'maxDenominator'.
+//
+//	tolerance only:
+//		Returns the rational number with the smallest
+//		denominator whose absolute difference from
+//		'value' does not exceed 'tolerance'.
+//
+//	maxDenominator and tolerance:
+//		Returns the rational number with the smallest
+//		denominator whose absolute difference from
+//		'value' does not exceed 'tolerance'. If that
+//		denominator exceeds 'maxDenominator', an error
+//		is returned.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	value						*big.Rat
+//
+//		The exact rational value to be approximated. This
+//		value is not modified.
+//
+//	maxDenominator				*big.Int
+//
+//		The maximum denominator of the returned
+//		approximation. If this parameter is 'nil', no
+//		maximum denominator is applied. If this
+//		parameter is not 'nil', it must be greater than
+//		or equal to one (1).
+//
+//	tolerance					*big.Rat
+//
+//		The maximum absolute difference between 'value'
+//		and the returned approximation. If this parameter
+//		is 'nil', no tolerance is applied. If this
+//		parameter is not 'nil', it must be greater than
+//		or equal to zero (0).
+//
+//		'maxDenominator' and 'tolerance' may not both be
+//		'nil'.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	approximation				*big.Rat
+//
+//		If this method completes successfully, this
+//		parameter will return the best rational
+//		approximation of 'value'.
+//
+//	err							error
+//
+//		If this method completes successfully, this
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathBigRatHelpQuark *mathBigRatHelperQuark) bestRationalApproximation(
+	value *big.Rat,
+	maxDenominator *big.Int,
+	tolerance *big.Rat,
+	errPrefDto *ePref.ErrPrefixDto) (
+	approximation *big.Rat,
+	err error) {
+
+	if mathBigRatHelpQuark.lock == nil {
+		mathBigRatHelpQuark.lock = new(sync.Mutex)
+	}
+
+	mathBigRatHelpQuark.lock.Lock()
+
+	defer mathBigRatHelpQuark.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	approximation = big.NewRat(0, 1)
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"mathBigRatHelperQuark."+
+			"bestRationalApproximation()",
+		"")
+
+	if err != nil {
+
+		return approximation, err
+	}
+
+	if value == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"ERROR: Input parameter 'value' is a nil pointer!\n",
+			ePrefix.String())
+
+		return approximation, err
+	}
+
+	if maxDenominator == nil && tolerance == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"ERROR: Input parameters 'maxDenominator' and 'tolerance'\n"+
+			"are both nil pointers! At least one of these parameters\n"+
+			"must be specified.\n",
+			ePrefix.String())
+
+		return approximation, err
+	}
+
+	if maxDenominator != nil &&
+		maxDenominator.Sign() < 1 {
+
+		err = fmt.Errorf("%v\n"+
+			"ERROR: Input parameter 'maxDenominator' is invalid!\n"+
+			"'maxDenominator' has a value less than one (1).\n"+
+			"maxDenominator = %v\n",
+			ePrefix.String(),
+			maxDenominator.String())
+
+		return approximation, err
+	}
+
+	if tolerance != nil &&
+		tolerance.Sign() < 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"ERROR: Input parameter 'tolerance' is invalid!\n"+
+			"'tolerance' has a value less than zero (0).\n"+
+			"tolerance = %v\n",
+			ePrefix.String(),
+			tolerance.RatString())
+
+		return approximation, err
+	}
+
+	partialQuotients := mathBigRatHelpQuark.continuedFraction(value)
+
+	// Convergents h(n)/k(n) are computed with the recurrence
+	//	h(n) = a(n) * h(n-1) + h(n-2)
+	//	k(n) = a(n) * k(n-1) + k(n-2)
+	// seeded with h(-2)=0, h(-1)=1, k(-2)=1, k(-1)=0.
+	hPrev2 := big.NewInt(0)
+	hPrev1 := big.NewInt(1)
+	kPrev2 := big.NewInt(1)
+	kPrev1 := big.NewInt(0)
+
+	for _, partialQuotient := range partialQuotients {
+
+		h := new(big.Int).Mul(partialQuotient, hPrev1)
+		h.Add(h, hPrev2)
+
+		k := new(big.Int).Mul(partialQuotient, kPrev1)
+		k.Add(k, kPrev2)
+
+		if tolerance != nil &&
+			mathBigRatHelpQuark.absDifference(
+				value,
+				new(big.Rat).SetFrac(h, k)).Cmp(tolerance) <= 0 {
+
+			// The semiconvergents
+			//	(h(n-2) + m*h(n-1)) / (k(n-2) + m*k(n-1))
+			// for m = 1 to a(n) approach 'value' from
+			// one side. Their errors decrease as 'm'
+			// increases. Locate the smallest 'm' which
+			// satisfies the tolerance.
+			low := big.NewInt(1)
+			high := new(big.Int).Set(partialQuotient)
+
+			if high.Cmp(low) < 0 {
+				// a(0) may be zero or negative
+				low.Set(high)
+			}
+
+			mid := new(big.Int)
+
+			for low.Cmp(high) < 0 {
+
+				mid.Add(low, high)
+				mid.Rsh(mid, 1)
+
+				if mathBigRatHelpQuark.absDifference(
+					value,
+					mathBigRatHelpQuark.semiconvergent(
+						hPrev2, hPrev1, kPrev2, kPrev1, mid)).
+					Cmp(tolerance) <= 0 {
+
+					high.Set(mid)
+
+				} else {
+
+					low.Add(mid, big.NewInt(1))
+				}
+			}
+
+			approximation =
+				mathBigRatHelpQuark.semiconvergent(
+					hPrev2, hPrev1, kPrev2, kPrev1, low)
+
+			if maxDenominator != nil &&
+				approximation.Denom().Cmp(maxDenominator) > 0 {
+
+				err = fmt.Errorf("%v\n"+
+					"ERROR: No rational approximation satisfying the\n"+
+					"tolerance has a denominator less than or equal to\n"+
+					"'maxDenominator'.\n"+
+					"tolerance      = %v\n"+
+					"maxDenominator = %v\n"+
+					"Smallest qualifying denominator = %v\n",
+					ePrefix.String(),
+					tolerance.RatString(),
+					maxDenominator.String(),
+					approximation.Denom().String())
+
+				return big.NewRat(0, 1), err
+			}
+
+			return approximation, err
+		}
+
+		if maxDenominator != nil &&
+			k.Cmp(maxDenominator) > 0 {
+
+			if tolerance != nil {
+
+				// No semiconvergent preceding the
+				// current convergent is closer to
+				// 'value' than the current convergent.
+				err = fmt.Errorf("%v\n"+
+					"ERROR: No rational approximation satisfying the\n"+
+					"tolerance has a denominator less than or equal to\n"+
+					"'maxDenominator'.\n"+
+					"tolerance      = %v\n"+
+					"maxDenominator = %v\n",
+					ePrefix.String(),
+					tolerance.RatString(),
+					maxDenominator.String())
+
+				return approximation, err
+			}
+
+			// The best approximation is either the
+			// previous convergent or the largest
+			// semiconvergent whose denominator does
+			// not exceed 'maxDenominator'.
+			m := new(big.Int).Sub(maxDenominator, kPrev2)
+			m.Quo(m, kPrev1)
+
+			bound1 := mathBigRatHelpQuark.semiconvergent(
+				hPrev2, hPrev1, kPrev2, kPrev1, m)
+
+			bound2 := new(big.Rat).SetFrac(hPrev1, kPrev1)
+
+			if mathBigRatHelpQuark.absDifference(value, bound2).Cmp(
+				mathBigRatHelpQuark.absDifference(value, bound1)) <= 0 {
+
+				return bound2, err
+			}
+
+			return bound1, err
+		}
+
+		hPrev2, hPrev1 = hPrev1, h
+		kPrev2, kPrev1 = kPrev1, k
+	}
+
+	// The continued fraction expansion is exhausted. The
+	// last convergent is equal to 'value'.
+	approximation.SetFrac(hPrev1, kPrev1)
+
+	return approximation, err
+}
+
+// absDifference - Returns the absolute value of the
+// difference between 'x' and 'y'.
+func (mathBigRatHelpQuark *mathBigRatHelperQuark) absDifference(
+	x *big.Rat,
+	y *big.Rat) *big.Rat {
+
+	diff := new(big.Rat).Sub(x, y)
+
+	return diff.Abs(diff)
+}
+
+// bigFloatToRat - Converts a big.Float value to the exact
+// equivalent big.Rat value. No rounding is performed.
+//
+// An error is returned if 'bigFloatNum' is a nil pointer
+// or an infinite value.
+func (mathBigRatHelpQuark *mathBigRatHelperQuark) bigFloatToRat(
+	bigFloatNum *big.Float,
+	errPrefDto *ePref.ErrPrefixDto) (
+	bigRatNum *big.Rat,
+	err error) {
+
+	if mathBigRatHelpQuark.lock == nil {
+		mathBigRatHelpQuark.lock = new(sync.Mutex)
+	}
+
+	mathBigRatHelpQuark.lock.Lock()
+
+	defer mathBigRatHelpQuark.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	bigRatNum = big.NewRat(0, 1)
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"mathBigRatHelperQuark."+
+			"bigFloatToRat()",
+		"")
+
+	if err != nil {
+
+		return bigRatNum, err
+	}
+
+	if bigFloatNum == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"ERROR: Input parameter 'bigFloatNum' is a nil pointer!\n",
+			ePrefix.String())
+
+		return bigRatNum, err
+	}
+
+	if bigFloatNum.IsInf() {
+
+		err = fmt.Errorf("%v\n"+
+			"ERROR: Input parameter 'bigFloatNum' is invalid!\n"+
+			"'bigFloatNum' is an infinite value.\n"+
+			"bigFloatNum = %v\n",
+			ePrefix.String(),
+			bigFloatNum.String())
+
+		return bigRatNum, err
+	}
+
+	bigRatNum, _ = bigFloatNum.Rat(bigRatNum)
+
+	return bigRatNum, err
+}
+
+// continuedFraction - Returns the partial quotients of
+// the simple continued fraction expansion of 'value'.
+//
+// Since 'value' is rational, the expansion is finite. The
+// first partial quotient is the floor of 'value' and may
+// be zero or negative. All subsequent partial quotients
+// are positive.
+func (mathBigRatHelpQuark *mathBigRatHelperQuark) continuedFraction(
+	value *big.Rat) []*big.Int {
+
+	var partialQuotients []*big.Int
+
+	numerator := new(big.Int).Set(value.Num())
+
+	denominator := new(big.Int).Set(value.Denom())
+
+	remainder := new(big.Int)
+
+	for denominator.Sign() != 0 {
+
+		// Floor division. big.Int.DivMod implements
+		// Euclidean division, which is equal to floor
+		// division for positive denominators.
+		partialQuotient := new(big.Int)
+
+		partialQuotient.DivMod(numerator, denominator, remainder)
+
+		partialQuotients = append(partialQuotients, partialQuotient)
+
+		numerator.Set(denominator)
+
+		denominator.Set(remainder)
+	}
+
+	return partialQuotients
+}
+
+// continuedFractionConvergents
+//
+// Computes the continued fraction expansion of the exact
+// rational value 'value' and returns the partial
+// quotients, convergents and approximation errors.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	value						*big.Rat
+//
+//		The exact rational value to be expanded. This
+//		value is not modified.
+//
+//	maxConvergents				int
+//
+//		The maximum number of convergents to be returned.
+//		If this value is less than one (1), all
+//		convergents will be returned. Since 'value' is
+//		rational, the number of convergents is finite.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	convergents					[]ContinuedFractionConvergentDto
+//
+//		If this method completes successfully, this
+//		array will contain the convergents of 'value'
+//		in order of increasing accuracy.
+//
+//	err							error
+//
+//		If this method completes successfully, this
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathBigRatHelpQuark *mathBigRatHelperQuark) continuedFractionConvergents(
+	value *big.Rat,
+	maxConvergents int,
+	errPrefDto *ePref.ErrPrefixDto) (
+	convergents []ContinuedFractionConvergentDto,
+	err error) {
+
+	if mathBigRatHelpQuark.lock == nil {
+		mathBigRatHelpQuark.lock = new(sync.Mutex)
+	}
+
+	mathBigRatHelpQuark.lock.Lock()
+
+	defer mathBigRatHelpQuark.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"mathBigRatHelperQuark."+
+			"continuedFractionConvergents()",
+		"")
+
+	if err != nil {
+
+		return convergents, err
+	}
+
+	if value == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"ERROR: Input parameter 'value' is a nil pointer!\n",
+			ePrefix.String())
+
+		return convergents, err
+	}
+
+	partialQuotients := mathBigRatHelpQuark.continuedFraction(value)
+
+	hPrev2 := big.NewInt(0)
+	hPrev1 := big.NewInt(1)
+	kPrev2 := big.NewInt(1)
+	kPrev1 := big.NewInt(0)
+
+	for idx, partialQuotient := range partialQuotients {
+
+		if maxConvergents > 0 &&
+			idx >= maxConvergents {
+			break
+		}
+
+		h := new(big.Int).Mul(partialQuotient, hPrev1)
+		h.Add(h, hPrev2)
+
+		k := new(big.Int).Mul(partialQuotient, kPrev1)
+		k.Add(k, kPrev2)
+
+		convergent := new(big.Rat).SetFrac(h, k)
+
+		convergents = append(
+			convergents,
+			ContinuedFractionConvergentDto{
+				Index:           idx,
+				PartialQuotient: new(big.Int).Set(partialQuotient),
+				Convergent:      convergent,
+				AbsoluteError: mathBigRatHelpQuark.absDifference(
+					value,
+					convergent),
+			})
+
+		hPrev2, hPrev1 = hPrev1, h
+		kPrev2, kPrev1 = kPrev1, k
+	}
+
+	return convergents, err
+}
+
+// numStrKernelToRat - Converts the numeric value encapsulated
+// by a NumberStrKernel instance to the exactly equivalent
+// big.Rat value. No rounding is performed.
+func (mathBigRatHelpQuark *mathBigRatHelperQuark) numStrKernelToRat(
+	numStrKernel *NumberStrKernel,
+	errPrefDto *ePref.ErrPrefixDto) (
+	bigRatNum *big.Rat,
+	err error) {
+
+	if mathBigRatHelpQuark.lock == nil {
+		mathBigRatHelpQuark.lock = new(sync.Mutex)
+	}
+
+	mathBigRatHelpQuark.lock.Lock()
+
+	defer mathBigRatHelpQuark.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	bigRatNum = big.NewRat(0, 1)
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"mathBigRatHelperQuark."+
+			"numStrKernelToRat()",
+		"")
+
+	if err != nil {
+
+		return bigRatNum, err
+	}
+
+	if numStrKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"ERROR: Input parameter 'numStrKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return bigRatNum, err
+	}
+
+	var nativeNumStr string
+
+	nativeNumStr,
+		_,
+		err = new(numberStrKernelQuark).getNativeNumStr(
+		numStrKernel,
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	if err != nil {
+
+		return bigRatNum, err
+	}
+
+	var ok bool
+
+	_,
+		ok = bigRatNum.SetString(nativeNumStr)
+
+	if !ok {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: bigRatNum.SetString(nativeNumStr) Failed!\n"+
+			"nativeNumStr = '%v'\n",
+			ePrefix.String(),
+			nativeNumStr)
+	}
+
+	return bigRatNum, err
+}
+
+// semiconvergent - Returns the semiconvergent
+//
+//	(hPrev2 + m*hPrev1) / (kPrev2 + m*kPrev1)
+func (mathBigRatHelpQuark *mathBigRatHelperQuark) semiconvergent(
+	hPrev2 *big.Int,
+	hPrev1 *big.Int,
+	kPrev2 *big.Int,
+	kPrev1 *big.Int,
+	m *big.Int) *big.Rat {
+
+	h := new(big.Int).Mul(m, hPrev1)
+	h.Add(h, hPrev2)
+
+	k := new(big.Int).Mul(m, kPrev1)
+	k.Add(k, kPrev2)
+
+	return new(big.Rat).SetFrac(h, k)
+}
@@ -11,73 +11,92 @@ type MathFloatHelper struct {
 	lock *sync.Mutex
 }
 
-//	FloatToIntFracRunes
-//
-//	Receives one of several types of floating point
-//	values and converts that value to an integer digit
-//	rune array and a fractional digit rune array.
+//	Atan
+//
+//	Computes the inverse tangent (arctangent) of a
+//	big.Float floating point number ('num') to the number
+//	of fractional digits specified by input parameter
+//	'requiredFractionalDigits'. The result is expressed
+//	in radians and falls within the range -π/2 through
+//	+π/2.
+//
+//		Examples:
+//			atan(1)		= 0.78539816339744830962... (π/4)
+//			atan(2.5)	= 1.19028994968253173292...
+//			atan(-0.3)	= -0.29145679447786709199...
+//
+//	The result is returned as both a big.Float value and
+//	an instance of NumberStrKernel.
+//
+//	The returned result is guaranteed to be correctly
+//	rounded. The calculation is performed at a working
+//	precision which exceeds the required number of
+//	fractional digits. The calculation is then repeated
+//	at a higher precision in order to establish an error
+//	bound. If the error bound prevents the result from
+//	being rounded with certainty, the working precision
+//	is doubled and the calculation is repeated until the
+//	correctly rounded result is established.
 //
-//	The integer and fractional digit rune arrays
-//	represent and absolute values extracted from the
-//	original floating point number.
+// ----------------------------------------------------------------
 //
-//	The returned integer and fractional digits are stored
-//	in input parameters 'intDigits' and 'fracDigits'.
+// # Input Parameters
 //
-//	The positive or negative number sign for the returned
-//	numeric digits can be determined by examining the
-//	statistics returned by parameter 'numberStats'
-//	(numberStats.NumberSign).
+//	num							*big.Float
 //
-// ----------------------------------------------------------------
+//		The number for which the arctangent will be
+//		computed.
 //
-//	# Input Parameters
+//		If 'num' is a nil pointer or infinite, an error
+//		will be returned.
 //
-//	floatingPointNumber 		interface{}
+//	requiredFractionalDigits	int
 //
-//		Numeric values passed by means of this empty
-//		interface MUST BE convertible to one of the
-//		following types:
+//		The number of accurate fractional digits required
+//		in the calculation result. The result will be
+//		rounded to this number of fractional digits
+//		using the rounding algorithm specified by input
+//		parameter 'roundingType'.
 //
-//			float32
-//			float64
-//			*big.Float
+//		The number of big.Float precision bits required
+//		to store the result is computed internally by
+//		adding the estimated number of integer digits in
+//		the result, 'requiredFractionalDigits' and a
+//		buffer of extra digits. The working precision is
+//		increased automatically until the correctly
+//		rounded result has been established.
 //
-//		If 'floatingPointNumber' is NOT convertible to
-//		one of the types listed above, an error will be
+//		If this value is less than zero, an error will be
 //		returned.
 //
-//	intDigits					*RuneArrayDto
-//
-//		A pointer to an instance of RuneArrayDto. The
-//		integer numeric digits extracted from
-//		'floatingPointNumber' will be stored as text
-//		characters in the rune array encapsulated by
-//		this RuneArrayDto object.
+//	roundingType				NumberRoundingType
 //
-//		The positive or negative number sign for the
-//		extracted integer digits, can be determined by
-//		examining the statistics returned by parameter
-//		'numberStats' (numberStats.NumberSign).
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied
+//		to the calculation result.
 //
-//	fracDigits					*RuneArrayDto
+//		Possible values are listed as follows:
 //
-//		A pointer to an instance of RuneArrayDto. The
-//		fractional numeric digits extracted from
-//		'floatingPointNumber' will be stored as text
-//		characters in the rune array encapsulated by
-//		this RuneArrayDto object.
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
 //
-//		The positive or negative number sign for the
-//		extracted integer digits, can be determined by
-//		examining the statistics returned by parameter
-//		'numberStats' (numberStats.NumberSign).
+//		NumRoundType.None() and NumRoundType.NoRounding()
+//		are invalid. If either of these values is
+//		submitted, an error will be returned.
 //
 //	errorPrefix					interface{}
 //
 //		This object encapsulates error prefix text which
 //		is included in all returned error messages.
-//		Usually, it	contains the name of the calling
+//		Usually, it contains the name of the calling
 //		method or methods listed as a method or function
 //		chain of execution.
 //
@@ -136,90 +155,38 @@ type MathFloatHelper struct {
 //
 // # Return Values
 //
-//	numberStats					NumberStrStatsDto
-//
-//		This data transfer object will return critical
-//		statistics on the numeric value represented
-//		by the integer and fractional digits extracted
-//		from 'floatingPointNumber' and stored in the
-//		'intDigits' and 'fracDigits' RuneArrayDto
-//		objects.
-//
-//		type NumberStrStatsDto struct {
-//
-//		NumOfIntegerDigits					uint64
-//
-//			The total number of integer digits to the
-//			left of the radix point or, decimal point, in
-//			the subject numeric value.
-//
-//		NumOfSignificantIntegerDigits		uint64
-//
-//			The number of nonzero integer digits to the
-//			left of the radix point or, decimal point, in
-//			the subject numeric value.
-//
-//		NumOfFractionalDigits				uint64
-//
-//			The total number of fractional digits to the
-//			right of the radix point or, decimal point,
-//			in the subject numeric value.
-//
-//		NumOfSignificantFractionalDigits	uint64
-//
-//			The number of nonzero fractional digits to
-//			the right of the radix point or, decimal
-//			point, in the subject numeric value.
-//
-//		NumberValueType 					NumericValueType
-//
-//			This enumeration value specifies whether the
-//			subject numeric value is classified either as
-//			an integer or a floating point number.
-//
-//			Possible enumeration values are listed as
-//			follows:
-//				NumValType.None()
-//				NumValType.FloatingPoint()
-//				NumValType.Integer()
-//
-//		NumberSign							NumericSignValueType
-//
-//			An enumeration specifying the number sign
-//			associated with the numeric value. Possible
-//			values are listed as follows:
-//				NumSignVal.None()		= Invalid Value
-//				NumSignVal.Negative()	= -1
-//				NumSignVal.Zero()		=  0
-//				NumSignVal.Positive()	=  1
+//	arcTangent					*big.Float
 //
-//		IsZeroValue							bool
+//		If this method completes successfully, this
+//		parameter will return the arctangent of 'num' in radians
+//		rounded to 'requiredFractionalDigits'.
 //
-//			If 'true', the subject numeric value is equal
-//			to zero ('0').
+//	arcTangentNumStr			NumberStrKernel
 //
-//			If 'false', the subject numeric value is
-//			greater than or less than zero ('0').
-//		}
+//		If this method completes successfully, this
+//		parameter will return the arctangent of 'num' in radians
+//		rounded to 'requiredFractionalDigits' as an
+//		instance of NumberStrKernel.
 //
 //	err							error
 //
 //		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
-//
-//		If errors are encountered during processing, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
 //		returned error Type will encapsulate an error
-//		message. This returned error message will
-//		incorporate the method chain and text passed by
-//		input parameter, 'errorPrefix'. The 'errorPrefix'
-//		text will be attached to the beginning of the
-//		error message.
-func (mathFloatHelper *MathFloatHelper) FloatNumToIntFracRunes(
-	floatingPointNumber interface{},
-	intDigits *RuneArrayDto,
-	fracDigits *RuneArrayDto,
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathFloatHelper *MathFloatHelper) Atan(
+	num *big.Float,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
 	errorPrefix interface{}) (
-	numberStats NumberStrStatsDto,
+	arcTangent *big.Float,
+	arcTangentNumStr NumberStrKernel,
 	err error) {
 
 	if mathFloatHelper.lock == nil {
@@ -236,59 +203,107 @@ func (mathFloatHelper *MathFloatHelper) FloatNumToIntFracRunes(
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"MathFloatHelper."+
-			"FloatNumToIntFracRunes()",
+			"Atan()",
 		"")
 
 	if err != nil {
-		return numberStats, err
+		return arcTangent, arcTangentNumStr, err
 	}
 
-	numberStats,
-		err = new(mathFloatHelperMechanics).
-		floatNumToIntFracRunes(
-			floatingPointNumber,
-			intDigits,
-			fracDigits,
-			ePrefix)
+	floatHelperMolecule := mathFloatHelperMolecule{}
 
-	return numberStats, err
+	var exactNum *big.Rat
+
+	exactNum,
+		err = floatHelperMolecule.bigFloatToRat(
+		num,
+		"num",
+		ePrefix)
+
+	if err != nil {
+		return arcTangent, arcTangentNumStr, err
+	}
+
+	return floatHelperMolecule.atan(
+		exactNum,
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
 }
 
-//	PiTo20k
+//	AtanNumStrKernel
+//
+//	Computes the inverse tangent (arctangent) of a
+//	NumberStrKernel numeric value ('num') to the number
+//	of fractional digits specified by input parameter
+//	'requiredFractionalDigits'. The result is expressed
+//	in radians and falls within the range -π/2 through
+//	+π/2.
+//
+//		Examples:
+//			atan(1)		= 0.78539816339744830962... (π/4)
+//			atan(2.5)	= 1.19028994968253173292...
+//			atan(-0.3)	= -0.29145679447786709199...
+//
+//	The numeric value of 'num' is converted, without
+//	rounding, to the exactly equivalent big.Rat value
+//	before the calculation is performed. Decimal values
+//	such as 0.1 are therefore processed exactly and are
+//	not subject to binary floating point conversion
+//	errors.
+//
+//	The result is returned as both a big.Float value and
+//	an instance of NumberStrKernel.
+//
+//	The returned result is guaranteed to be correctly
+//	rounded. The calculation is performed at a working
+//	precision which exceeds the required number of
+//	fractional digits. The calculation is then repeated
+//	at a higher precision in order to establish an error
+//	bound. If the error bound prevents the result from
+//	being rounded with certainty, the working precision
+//	is doubled and the calculation is repeated until the
+//	correctly rounded result is established.
 //
-//	Returns an instance of *big.Float configured for Pi
-//	up to 20k fractional digits.
+// ----------------------------------------------------------------
 //
-//	Pi to 20,001 digits. Including the integer '3' this
-//	is 20,001 digits. There are 20,000 fractional digits.
+// # Input Parameters
 //
-//	OEIS A000796
+//	num							*NumberStrKernel
 //
-//	https://oeis.org/A000796
-//	https://oeis.org/A000796/b000796.txt
+//		The number for which the arctangent will be
+//		computed.
 //
-//	If the user sets input parameter 'roundingType' to
-//	NumRoundType.NoRounding(), the entire 20,000
-//	fractional digits will be configured and returned
-//	as an instance of *big.Float.
+//		If 'num' is a nil pointer or contains an invalid
+//		numeric value, an error will be returned.
 //
-// ----------------------------------------------------------------
+//	requiredFractionalDigits	int
 //
-// # Input Parameters
+//		The number of accurate fractional digits required
+//		in the calculation result. The result will be
+//		rounded to this number of fractional digits
+//		using the rounding algorithm specified by input
+//		parameter 'roundingType'.
+//
+//		The number of big.Float precision bits required
+//		to store the result is computed internally by
+//		adding the estimated number of integer digits in
+//		the result, 'requiredFractionalDigits' and a
+//		buffer of extra digits. The working precision is
+//		increased automatically until the correctly
+//		rounded result has been established.
+//
+//		If this value is less than zero, an error will be
+//		returned.
 //
 //	roundingType				NumberRoundingType
 //
 //		This enumeration parameter is used to specify the
-//		type of rounding algorithm that will be applied for
-//		the	rounding of fractional digits contained in the
-//		current instance of NumberStrKernel.
-//
-//		If in doubt as to a suitable rounding method,
-//		'HalfAwayFromZero' is recommended.
+//		type of rounding algorithm that will be applied
+//		to the calculation result.
 //
 //		Possible values are listed as follows:
-//			NumRoundType.None()	- Invalid Value
-//			NumRoundType.NoRounding()
+//
 //			NumRoundType.HalfUpWithNegNums()
 //			NumRoundType.HalfDownWithNegNums()
 //			NumRoundType.HalfAwayFromZero()
@@ -300,235 +315,221 @@ func (mathFloatHelper *MathFloatHelper) FloatNumToIntFracRunes(
 //			NumRoundType.Ceiling()
 //			NumRoundType.Truncate()
 //
-//		Definitions:
-//
-//			NoRounding
+//		NumRoundType.None() and NumRoundType.NoRounding()
+//		are invalid. If either of these values is
+//		submitted, an error will be returned.
 //
-//				Signals that no rounding operation will be
-//				performed on fractional digits. The
-//				fractional digits will therefore remain
-//				unchanged.
+//	errorPrefix					interface{}
 //
-//			HalfUpWithNegNums
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//				Half Round Up Including Negative Numbers.
-//				This method is intuitive but may produce
-//				unexpected results when applied to negative
-//				numbers.
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//				'HalfUpWithNegNums' rounds .5 up.
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//					Examples of 'HalfUpWithNegNums'
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8
-//					7.4 rounds down to 7
-//					-7.4 rounds up to -7
-//					-7.5 rounds up to -7
-//					-7.6 rounds down to -8
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//			HalfDownWithNegNums
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//			Half Round Down Including Negative Numbers. This
-//			method is also considered intuitive but may
-//			produce unexpected results when applied to
-//			negative numbers.
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//			'HalfDownWithNegNums' rounds .5 down.
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//				Examples of HalfDownWithNegNums
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//				7.6 rounds up to 8
-//				7.5 rounds down to 7
-//				7.4 rounds down to 7
-//				-7.4 rounds up to -7
-//				-7.5 rounds down to -8
-//				-7.6 rounds down to -8
-//
-//			HalfAwayFromZero
-//
-//				The 'HalfAwayFromZero' method rounds .5 further
-//				away from zero.	It provides clear and consistent
-//				behavior when dealing with negative numbers.
-//
-//					Examples of HalfAwayFromZero
-//
-//					7.6 rounds away to 8
-//					7.5 rounds away to 8
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds away to -8
-//					-7.6 rounds away to -8
-//
-//			HalfTowardsZero
-//
-//				Round Half Towards Zero. 'HalfTowardsZero' rounds
-//				0.5	closer to zero. It provides clear and
-//				consistent behavior	when dealing with negative
-//				numbers.
-//
-//					Examples of HalfTowardsZero
-//
-//					7.6 rounds away to 8
-//					7.5 rounds to 7
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds to -7
-//					-7.6 rounds away to -8
-//
-//			HalfToEven
-//
-//				Round Half To Even Numbers. 'HalfToEven' is
-//				also called	Banker's Rounding. This method
-//				rounds 0.5 to the nearest even digit.
-//
-//					Examples of HalfToEven
-//
-//					7.5 rounds up to 8 (because 8 is an even
-//					number)	but 6.5 rounds down to 6 (because
-//					6 is an even number)
-//
-//					HalfToEven only applies to 0.5. Other
-//					numbers (not ending	in 0.5) round to
-//					nearest as usual, so:
-//
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8 (because 8 is an even number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds down to 6 (because 6 is an even number)
-//					6.4 rounds down to 6
-//
-//			HalfToOdd
-//
-//				Round Half to Odd Numbers. Similar to 'HalfToEven',
-//				but in this case 'HalfToOdd' rounds 0.5 towards odd
-//				numbers.
-//
-//					Examples of HalfToOdd
-//
-//					HalfToOdd only applies to 0.5. Other numbers
-//					(not ending	in 0.5) round to nearest as usual.
-//
-//					7.5 rounds down to 7 (because 7 is an odd number)
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//					6.5 rounds up to 7 (because 7 is an odd number)
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//					7.6 rounds up to 8
-//					7.5 rounds down to 7 (because 7 is an odd number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds up to 7 (because 7 is an odd number)
-//					6.4 rounds down to 6
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
 //
-//			Randomly
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
-//				Round Half Randomly. Uses a Random Number Generator
-//				to choose between rounding 0.5 up or down.
+// ----------------------------------------------------------------
 //
-//				All numbers other than 0.5 round to the nearest as
-//				usual.
+// # Return Values
 //
-//			Floor
+//	arcTangent					*big.Float
 //
-//				Yields the nearest integer down. Floor does not apply
-//				any	special treatment to 0.5.
+//		If this method completes successfully, this
+//		parameter will return the arctangent of 'num' in radians
+//		rounded to 'requiredFractionalDigits'.
 //
-//				Floor Function: The greatest integer that is less than
-//				or equal to x
+//	arcTangentNumStr			NumberStrKernel
 //
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//		If this method completes successfully, this
+//		parameter will return the arctangent of 'num' in radians
+//		rounded to 'requiredFractionalDigits' as an
+//		instance of NumberStrKernel.
 //
-//				In mathematics and computer science, the floor function
-//				is the function that takes as input a real number x,
-//				and gives as output the greatest integer less than or
-//				equal to x,	denoted floor(x) or ⌊x⌋.
+//	err							error
 //
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
 //
-//				Examples of Floor
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathFloatHelper *MathFloatHelper) AtanNumStrKernel(
+	num *NumberStrKernel,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	errorPrefix interface{}) (
+	arcTangent *big.Float,
+	arcTangentNumStr NumberStrKernel,
+	err error) {
+
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
+	}
+
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathFloatHelper."+
+			"AtanNumStrKernel()",
+		"")
+
+	if err != nil {
+		return arcTangent, arcTangentNumStr, err
+	}
+
+	var exactNum *big.Rat
+
+	exactNum,
+		err = new(mathBigRatHelperQuark).numStrKernelToRat(
+		num,
+		ePrefix.XCpy(
+			"num"))
+
+	if err != nil {
+		return arcTangent, arcTangentNumStr, err
+	}
+
+	return new(mathFloatHelperMolecule).atan(
+		exactNum,
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
+}
+
+//	ConstantE
 //
-//					Number     Floor
-//					 2           2
-//					 2.4         2
-//					 2.9         2
-//					-2.5        -3
-//					-2.7        -3
-//					-2          -2
+//	Returns the mathematical constant 'e' (Euler's
+//	number) to the number of fractional digits specified
+//	by input parameter 'requiredFractionalDigits'.
 //
-//			Ceiling
+//		e = 2.71828182845904523536028747135266249775724709...
 //
-//				Yields the nearest integer up. Ceiling does not
-//				apply any special treatment to 0.5.
+//	'e' is the base of the natural logarithm.
 //
-//				Ceiling Function: The least integer that is
-//				greater than or	equal to x.
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//	The result is returned as both a big.Float value and
+//	an instance of NumberStrKernel.
 //
-//				The ceiling function maps x to the least integer
-//				greater than or equal to x, denoted ceil(x) or
-//				⌈x⌉.[1]
+//	The returned result is guaranteed to be correctly
+//	rounded. The calculation is performed at a working
+//	precision which exceeds the required number of
+//	fractional digits. The calculation is then repeated
+//	at a higher precision in order to establish an error
+//	bound. If the error bound prevents the result from
+//	being rounded with certainty, the working precision
+//	is doubled and the calculation is repeated until the
+//	correctly rounded result is established.
 //
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+// ----------------------------------------------------------------
 //
-//					Examples of Ceiling
+// # Input Parameters
 //
-//						Number    Ceiling
-//						 2           2
-//						 2.4         3
-//						 2.9         3
-//						-2.5        -2
-//						-2.7        -2
-//						-2          -2
+//	requiredFractionalDigits	int
 //
-//			Truncate
+//		The number of accurate fractional digits required
+//		in the calculation result. The result will be
+//		rounded to this number of fractional digits
+//		using the rounding algorithm specified by input
+//		parameter 'roundingType'.
 //
-//				Apply NO Rounding whatsoever. The Round From Digit
-//				is dropped or deleted. The Round To Digit is NEVER
-//				changed.
+//		The number of big.Float precision bits required
+//		to store the result is computed internally by
+//		adding the estimated number of integer digits in
+//		the result, 'requiredFractionalDigits' and a
+//		buffer of extra digits. The working precision is
+//		increased automatically until the correctly
+//		rounded result has been established.
 //
-//				Examples of Truncate
+//		If this value is less than zero, an error will be
+//		returned.
 //
-//					Example-1
-//					Number: 23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:   23.14 - The Round From Digit
-//					is dropped.
+//	roundingType				NumberRoundingType
 //
-//					Example-2
-//					Number: -23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:  -23.14 - The Round From Digit
-//					is dropped.
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied
+//		to the calculation result.
 //
-//	roundToFractionalDigits		int
+//		Possible values are listed as follows:
 //
-//		When set to a positive integer value, this parameter
-//		controls the number of digits to the right of the radix
-//		point or decimal separator (a.k.a. decimal point). This
-//		controls the number of fractional digits remaining after
-//		completion of the number rounding operation.
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
 //
-//		For the purposes of this method, any
-//		'roundToFractionalDigits' value greater than 20,000 will
-//		trigger an error return.
+//		NumRoundType.None() and NumRoundType.NoRounding()
+//		are invalid. If either of these values is
+//		submitted, an error will be returned.
 //
-//	 errorPrefix                interface{}
+//	errorPrefix					interface{}
 //
 //		This object encapsulates error prefix text which
 //		is included in all returned error messages.
-//		Usually, it	contains the name of the calling
+//		Usually, it contains the name of the calling
 //		method or methods listed as a method or function
 //		chain of execution.
 //
@@ -587,31 +588,38 @@ func (mathFloatHelper *MathFloatHelper) FloatNumToIntFracRunes(
 //
 // # Return Values
 //
-//	*big.Float
+//	eulersNumber				*big.Float
 //
-//		A pointer to an instance of big.Float. If this
-//		method completes successfully, this instance will
-//		be configured with the value of Pi out to the
-//		specified number of decimal places.
+//		If this method completes successfully, this
+//		parameter will return the constant 'e'
+//		rounded to 'requiredFractionalDigits'.
 //
-//	error
+//	eulersNumberNumStr			NumberStrKernel
 //
-//		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
+//		If this method completes successfully, this
+//		parameter will return the constant 'e'
+//		rounded to 'requiredFractionalDigits' as an
+//		instance of NumberStrKernel.
 //
-//		If errors are encountered during processing, the
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
 //		returned error Type will encapsulate an error
-//		message. This returned error message will
-//		incorporate the method chain and text passed by
-//		input parameter, 'errorPrefix'. The 'errorPrefix'
-//		text will be attached to the beginning of the
-//		error message.
-func (mathFloatHelper *MathFloatHelper) PiTo20k(
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathFloatHelper *MathFloatHelper) ConstantE(
+	requiredFractionalDigits int,
 	roundingType NumberRoundingType,
-	roundToFractionalDigits int,
 	errorPrefix interface{}) (
-	*big.Float,
-	error) {
+	eulersNumber *big.Float,
+	eulersNumberNumStr NumberStrKernel,
+	err error) {
 
 	if mathFloatHelper.lock == nil {
 		mathFloatHelper.lock = new(sync.Mutex)
@@ -621,140 +629,97 @@ func (mathFloatHelper *MathFloatHelper) PiTo20k(
 
 	defer mathFloatHelper.lock.Unlock()
 
-	pi20k := new(big.Float).
-		SetInt64(0).
-		SetPrec(66504).
-		SetMode(big.AwayFromZero).
-		SetInt64(0)
-
 	var ePrefix *ePref.ErrPrefixDto
-	var err error
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"MathFloatHelper."+
-			"PiTo20k()",
+			"ConstantE()",
 		"")
 
 	if err != nil {
-		return pi20k, err
-	}
-
-	if roundToFractionalDigits > 20000 {
-
-		err = fmt.Errorf("\n%v\n"+
-			"Error: Input parameter 'roundToFractionalDigits' is invalid!\n"+
-			"'roundToFractionalDigits' exceeds the maximum limit of 20,000.\n"+
-			"roundToFractionalDigits = '%v'\n",
-			ePrefix.String(),
-			roundToFractionalDigits)
-
-		return pi20k, err
-	}
-
-	numStrKernel := NumberStrKernel{}
-
-	numStrKernel.numberValueType = NumValType.FloatingPoint()
-
-	numStrKernel.numberSign = NumSignVal.Positive()
-
-	numStrKernel.isNonZeroValue = true
-
-	numStrKernel.integerDigits,
-		numStrKernel.fractionalDigits = new(MathConstantsFloat).
-		Pi20KRunes(roundToFractionalDigits)
-
-	if roundingType != NumRoundType.NoRounding() &&
-		roundingType != NumRoundType.None() {
-
-		err = numStrKernel.Round(
-			roundingType,
-			roundToFractionalDigits,
-			ePrefix.XCpy("Pi20KRunes"))
-
-		if err != nil {
-
-			return pi20k, err
-
-		}
-	}
-
-	var pureNumStr string
-
-	pureNumStr,
-		_,
-		err = numStrKernel.FmtNumStrPure(
-		".",
-		true,
-		NumRoundType.NoRounding(),
-		0,
-		ePrefix.XCpy(
-			"numStrKernel"))
-
-	var ok bool
-	_,
-		ok = pi20k.SetString(pureNumStr)
-
-	if !ok {
-
-		err = fmt.Errorf("\n%v\n"+
-			"Error: pi20k.SetString(numStrKernel.GetPureNumberStr()) FAILED!\n"+
-			"big.Float was unable to set the Pi value to\n"+
-			"%v fractional digits.\n"+
-			"numStrKernel.GetPureNumberStr() = %v",
-			ePrefix.String(),
-			roundToFractionalDigits,
-			pureNumStr)
+		return eulersNumber, eulersNumberNumStr, err
 	}
 
-	return pi20k, err
+	return new(mathFloatHelperMolecule).constantE(
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
 }
 
-//	DigitsToPrecisionEstimate
+//	ConstantLn2
 //
-//	Computes an estimate of the number of precision
-//	bits required in order to store a given number
-//	of numeric digits in a type big.Float, floating
-//	point number.
+//	Returns the natural logarithm of two (ln(2)) to the
+//	number of fractional digits specified by input
+//	parameter 'requiredFractionalDigits'.
 //
-//	Precision bits are used in the configuration of
-//	big.Float types. The conversion factor is
-//	"3.3219789132197891321978913219789".
+//		ln(2) = 0.69314718055994530941723212145817656807550013...
 //
-//		Conversion Factor  x  Numeric Digit Capacity =
-//				Precision Bits
-//			(margin of error +/- 16)
+//	The result is returned as both a big.Float value and
+//	an instance of NumberStrKernel.
 //
-//	The number of precision bits returned is an
-//	estimate with a margin of error of plus or minus
-//	sixteen (+ or - 16).
+//	The returned result is guaranteed to be correctly
+//	rounded. The calculation is performed at a working
+//	precision which exceeds the required number of
+//	fractional digits. The calculation is then repeated
+//	at a higher precision in order to establish an error
+//	bound. If the error bound prevents the result from
+//	being rounded with certainty, the working precision
+//	is doubled and the calculation is repeated until the
+//	correctly rounded result is established.
 //
 // ----------------------------------------------------------------
 //
 // # Input Parameters
 //
-//	numNumericDigitsRequired	int64
+//	requiredFractionalDigits	int
 //
-//		The number of numeric digits to be stored and
-//		processed by a type big.Float floating point
-//		numeric value. This value represents the desired
-//		capacity for a big.Float number. This number of
-//		numeric digits should include both integer and
-//		fractional numeric digits as well as a buffer
-//		of extra digits necessary to perform accurate
-//		calculations. The number of buffer digits will
-//		vary depending on the complexity of pending
-//		calculations.
+//		The number of accurate fractional digits required
+//		in the calculation result. The result will be
+//		rounded to this number of fractional digits
+//		using the rounding algorithm specified by input
+//		parameter 'roundingType'.
 //
-//		If this value is less than one (+1), an error
-//		will be returned.
+//		The number of big.Float precision bits required
+//		to store the result is computed internally by
+//		adding the estimated number of integer digits in
+//		the result, 'requiredFractionalDigits' and a
+//		buffer of extra digits. The working precision is
+//		increased automatically until the correctly
+//		rounded result has been established.
+//
+//		If this value is less than zero, an error will be
+//		returned.
+//
+//	roundingType				NumberRoundingType
+//
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied
+//		to the calculation result.
+//
+//		Possible values are listed as follows:
+//
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
+//
+//		NumRoundType.None() and NumRoundType.NoRounding()
+//		are invalid. If either of these values is
+//		submitted, an error will be returned.
 //
 //	errorPrefix					interface{}
 //
 //		This object encapsulates error prefix text which
 //		is included in all returned error messages.
-//		Usually, it	contains the name of the calling
+//		Usually, it contains the name of the calling
 //		method or methods listed as a method or function
 //		chain of execution.
 //
@@ -813,42 +778,37 @@ func (mathFloatHelper *MathFloatHelper) PiTo20k(
 //
 // # Return Values
 //
-//	precisionBits				uint
-//
-//		Precision bits defines the number of bits in the
-//		mantissa of a big.Float numeric value. The number
-//		of precision bits controls the number of integer
-//		and fractional numeric digits that can be stored
-//		in an instance of big.Float.
+//	naturalLogOfTwo				*big.Float
 //
-//		If this method completes successfully, the value
-//		returned will represent the estimated number of
-//		precision bits required to store and process
-//		the number of numerical digits specified by input
-//		parameter, 'numNumericDigitsRequired'.
+//		If this method completes successfully, this
+//		parameter will return the constant ln(2)
+//		rounded to 'requiredFractionalDigits'.
 //
-//		This estimate for precision bits has a margin of
-//		error of plus or minus sixteen bits (+ or - 16).
+//	naturalLogOfTwoNumStr		NumberStrKernel
 //
-//		The value of 'precisionBits' returned by this
-//		method will always be a multiple of eight (+8).
+//		If this method completes successfully, this
+//		parameter will return the constant ln(2)
+//		rounded to 'requiredFractionalDigits' as an
+//		instance of NumberStrKernel.
 //
 //	err							error
 //
-//		If this method completes successfully, this
+//		If this method completes successfully, the
 //		returned error Type is set equal to 'nil'. If
 //		errors are encountered during processing, the
 //		returned error Type will encapsulate an error
 //		message.
 //
 //		If an error message is returned, the text value
-//		for input parameter 'errPrefDto' (error prefix)
+//		for input parameter 'errorPrefix' (error prefix)
 //		will be prefixed or attached at the beginning of
 //		the error message.
-func (mathFloatHelper *MathFloatHelper) DigitsToPrecisionEstimate(
-	numNumericDigitsRequired int64,
+func (mathFloatHelper *MathFloatHelper) ConstantLn2(
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
 	errorPrefix interface{}) (
-	precisionBits uint,
+	naturalLogOfTwo *big.Float,
+	naturalLogOfTwoNumStr NumberStrKernel,
 	err error) {
 
 	if mathFloatHelper.lock == nil {
@@ -865,79 +825,105 @@ func (mathFloatHelper *MathFloatHelper) DigitsToPrecisionEstimate(
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"MathFloatHelper."+
-			"DigitsToPrecisionEstimate()",
+			"ConstantLn2()",
 		"")
 
 	if err != nil {
-
-		return precisionBits, err
+		return naturalLogOfTwo, naturalLogOfTwoNumStr, err
 	}
 
-	precisionBits,
-		err = new(mathFloatHelperPreon).
-		estimateDigitsToPrecision(
-			numNumericDigitsRequired,
-			ePrefix)
-
-	return precisionBits, err
+	return new(mathFloatHelperMolecule).constantLn2(
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
 }
 
-//	PrecisionBitsFromRequiredDigits
+//	Cos
 //
-//	Generates the number of precision bits in the
-//	mantissa of a big.Float number based on the
-//	number of numerical digits required to produce
-//	an accurate calculation result.
+//	Computes the cosine of an angle expressed in radians
+//	to the number of fractional digits specified by input
+//	parameter 'requiredFractionalDigits'.
 //
-//	Be advised that the number of mantissa precision bits
-//	required to store a process an accurate numeric value
-//	includes both integer and fractional numeric digits.
+//		Examples:
+//			cos(0)		= 1
+//			cos(2.5)	= -0.80114361554693371483...
+//
+//	The result is returned as both a big.Float value and
+//	an instance of NumberStrKernel.
+//
+//	The returned result is guaranteed to be correctly
+//	rounded. The calculation is performed at a working
+//	precision which exceeds the required number of
+//	fractional digits. The calculation is then repeated
+//	at a higher precision in order to establish an error
+//	bound. If the error bound prevents the result from
+//	being rounded with certainty, the working precision
+//	is doubled and the calculation is repeated until the
+//	correctly rounded result is established.
 //
 // ----------------------------------------------------------------
 //
-//	# Input Parameters
+// # Input Parameters
 //
+//	radians						*big.Float
 //
-//	requiredIntegerDigits		int64
+//		The angle, expressed in radians, for which the
+//		cosine will be computed.
 //
-//		The number of integer digits required for the
-//		pending calculation.
+//		If 'radians' is a nil pointer or infinite, an
+//		error will be returned.
 //
-//		If this parameter has a value less than zero,
-//		an error will be returned.
+//		If the absolute value of 'radians' is greater
+//		than or equal to 10^1000, an error will be
+//		returned.
 //
-//		If the sum of parameters 'requiredIntegerDigits'
-//		and 'requiredFractionalDigits' is equal to zero,
-//		an error will be returned.
+//	requiredFractionalDigits	int
 //
-//	requiredFractionalDigits	int64
+//		The number of accurate fractional digits required
+//		in the calculation result. The result will be
+//		rounded to this number of fractional digits
+//		using the rounding algorithm specified by input
+//		parameter 'roundingType'.
 //
-//		The number of fractional digits required to
-//		ensure accuracy for the pending calculation.
+//		The number of big.Float precision bits required
+//		to store the result is computed internally by
+//		adding the estimated number of integer digits in
+//		the result, 'requiredFractionalDigits' and a
+//		buffer of extra digits. The working precision is
+//		increased automatically until the correctly
+//		rounded result has been established.
 //
-//		If this parameter has a value less than zero,
-//		an error will be returned.
+//		If this value is less than zero, an error will be
+//		returned.
 //
-//		If the sum of parameters 'requiredIntegerDigits'
-//		and 'requiredFractionalDigits' is equal to zero,
-//		an error will be returned.
+//	roundingType				NumberRoundingType
 //
-//	requestedBufferDigits		int64
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied
+//		to the calculation result.
 //
-//		The number of extra numerical digits required to
-//		ensure accuracy for the pending calculation. It
-//		is generally a good idea to add space for extra
-//		numerical digits to accommodate rounding and/or
-//		complex numerical calculations.
+//		Possible values are listed as follows:
 //
-//		If this parameter has a value less than zero,
-//		an error will be returned.
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
+//
+//		NumRoundType.None() and NumRoundType.NoRounding()
+//		are invalid. If either of these values is
+//		submitted, an error will be returned.
 //
 //	errorPrefix					interface{}
 //
 //		This object encapsulates error prefix text which
 //		is included in all returned error messages.
-//		Usually, it	contains the name of the calling
+//		Usually, it contains the name of the calling
 //		method or methods listed as a method or function
 //		chain of execution.
 //
@@ -996,34 +982,38 @@ func (mathFloatHelper *MathFloatHelper) DigitsToPrecisionEstimate(
 //
 // # Return Values
 //
-//	precisionBits				uint
+//	cosine						*big.Float
 //
 //		If this method completes successfully, this
-//		parameter will return the number of precision
-//		bits required to store and accurately process
-//		the number of numerical digits identified by
-//		input parameters, 'requiredIntegerDigits',
-//		'requiredFractionalDigits' and
-//		'requestedBufferDigits'.
+//		parameter will return the cosine of 'radians'
+//		rounded to 'requiredFractionalDigits'.
+//
+//	cosineNumStr				NumberStrKernel
+//
+//		If this method completes successfully, this
+//		parameter will return the cosine of 'radians'
+//		rounded to 'requiredFractionalDigits' as an
+//		instance of NumberStrKernel.
 //
 //	err							error
 //
 //		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
-//
-//		If errors are encountered during processing, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
 //		returned error Type will encapsulate an error
-//		message. This returned error message will
-//		incorporate the method chain and text passed by
-//		input parameter, 'errorPrefix'. The 'errorPrefix'
-//		text will be attached to the beginning of the
-//		error message.
-func (mathFloatHelper *MathFloatHelper) PrecisionBitsFromRequiredDigits(
-	requiredIntegerDigits,
-	requiredFractionalDigits,
-	requestedBufferDigits int64,
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathFloatHelper *MathFloatHelper) Cos(
+	radians *big.Float,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
 	errorPrefix interface{}) (
-	precisionBits uint,
+	cosine *big.Float,
+	cosineNumStr NumberStrKernel,
 	err error) {
 
 	if mathFloatHelper.lock == nil {
@@ -1040,87 +1030,219 @@ func (mathFloatHelper *MathFloatHelper) PrecisionBitsFromRequiredDigits(
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"MathFloatHelper."+
-			"PrecisionBitsFromRequiredDigits()",
+			"Cos()",
 		"")
 
 	if err != nil {
+		return cosine, cosineNumStr, err
+	}
 
-		return precisionBits, err
+	floatHelperMolecule := mathFloatHelperMolecule{}
+
+	var exactRadians *big.Rat
+
+	exactRadians,
+		err = floatHelperMolecule.bigFloatToRat(
+		radians,
+		"radians",
+		ePrefix)
+
+	if err != nil {
+		return cosine, cosineNumStr, err
 	}
 
-	precisionBits,
-		err = new(mathFloatHelperAtom).precisionBitsFromRequiredDigits(
-		requiredIntegerDigits,
+	return floatHelperMolecule.sinCosTan(
+		exactRadians,
+		"cos",
 		requiredFractionalDigits,
-		requestedBufferDigits,
+		roundingType,
 		ePrefix)
-
-	return precisionBits, err
 }
 
-//	PrecisionToDigitsEstimate
+//	CosNumStrKernel
 //
-//	Computes an estimate of the number of numerical
-//	digits which can be stored given the number of
-//	precision bits configured for a type big.Float,
-//	floating point number.
+//	Computes the cosine of an angle expressed in radians
+//	to the number of fractional digits specified by input
+//	parameter 'requiredFractionalDigits'.
 //
-//	Precision bits are used in the configuration of
-//	big.Float types. The conversion factor is:
-//		"3.3219789132197891321978913219789"
+//		Examples:
+//			cos(0)		= 1
+//			cos(2.5)	= -0.80114361554693371483...
 //
-//		Precision Bits / Conversion Factor =
-//				Numeric Digit Capacity
-//			(margin of error +/- 3)
+//	The numeric value of 'radians' is converted, without
+//	rounding, to the exactly equivalent big.Rat value
+//	before the calculation is performed. Decimal values
+//	such as 0.1 are therefore processed exactly and are
+//	not subject to binary floating point conversion
+//	errors.
 //
-//	The number of numerical digits returned is an
-//	estimate with a margin of error of plus or minus
-//	three (+ or - 3) numeric digits.
+//	The result is returned as both a big.Float value and
+//	an instance of NumberStrKernel.
+//
+//	The returned result is guaranteed to be correctly
+//	rounded. The calculation is performed at a working
+//	precision which exceeds the required number of
+//	fractional digits. The calculation is then repeated
+//	at a higher precision in order to establish an error
+//	bound. If the error bound prevents the result from
+//	being rounded with certainty, the working precision
+//	is doubled and the calculation is repeated until the
+//	correctly rounded result is established.
 //
 // ----------------------------------------------------------------
 //
 // # Input Parameters
 //
-//	precisionBits				uint
+//	radians						*NumberStrKernel
 //
-//		The number of bits of precision in the mantissa
-//		of a big.Float floating point numeric value.
+//		The angle, expressed in radians, for which the
+//		cosine will be computed.
 //
-//		If this value is less than eight (+8), an error
-//		will be returned.
+//		If 'radians' is a nil pointer or contains an
+//		invalid numeric value, an error will be
+//		returned.
+//
+//		If the absolute value of 'radians' is greater
+//		than or equal to 10^1000, an error will be
+//		returned.
+//
+//	requiredFractionalDigits	int
+//
+//		The number of accurate fractional digits required
+//		in the calculation result. The result will be
+//		rounded to this number of fractional digits
+//		using the rounding algorithm specified by input
+//		parameter 'roundingType'.
+//
+//		The number of big.Float precision bits required
+//		to store the result is computed internally by
+//		adding the estimated number of integer digits in
+//		the result, 'requiredFractionalDigits' and a
+//		buffer of extra digits. The working precision is
+//		increased automatically until the correctly
+//		rounded result has been established.
+//
+//		If this value is less than zero, an error will be
+//		returned.
+//
+//	roundingType				NumberRoundingType
+//
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied
+//		to the calculation result.
+//
+//		Possible values are listed as follows:
+//
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
+//
+//		NumRoundType.None() and NumRoundType.NoRounding()
+//		are invalid. If either of these values is
+//		submitted, an error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
 // ----------------------------------------------------------------
 //
 // # Return Values
 //
-//	totalNumOfNumericalDigits	int64
+//	cosine						*big.Float
 //
-//		If this method completes successfully, the value
-//		returned will represent the estimated total
-//		number of numerical digits which can be stored
-//		in a big.Float floating point number mantissa
-//		configured for the number of Precision Bits
-//		specified by input parameter 'precisionBits'.
+//		If this method completes successfully, this
+//		parameter will return the cosine of 'radians'
+//		rounded to 'requiredFractionalDigits'.
 //
-//		This estimate has a margin of error of plus or
-//		minus three (+ or - 3) numeric digits.
+//	cosineNumStr				NumberStrKernel
+//
+//		If this method completes successfully, this
+//		parameter will return the cosine of 'radians'
+//		rounded to 'requiredFractionalDigits' as an
+//		instance of NumberStrKernel.
 //
 //	err							error
 //
 //		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
-//
-//		If errors are encountered during processing, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
 //		returned error Type will encapsulate an error
-//		message. This returned error message will
-//		incorporate the method chain and text passed by
-//		input parameter, 'errorPrefix'. The 'errorPrefix'
-//		text will be attached to the beginning of the
-//		error message.
-func (mathFloatHelper *MathFloatHelper) PrecisionToDigitsEstimate(
-	precisionBits uint,
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathFloatHelper *MathFloatHelper) CosNumStrKernel(
+	radians *NumberStrKernel,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
 	errorPrefix interface{}) (
-	totalNumOfNumericalDigits int64,
+	cosine *big.Float,
+	cosineNumStr NumberStrKernel,
 	err error) {
 
 	if mathFloatHelper.lock == nil {
@@ -1137,220 +1259,353 @@ func (mathFloatHelper *MathFloatHelper) PrecisionToDigitsEstimate(
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"MathFloatHelper."+
-			"PrecisionToDigitsEstimate()",
+			"CosNumStrKernel()",
 		"")
 
 	if err != nil {
-
-		return totalNumOfNumericalDigits, err
+		return cosine, cosineNumStr, err
 	}
 
-	totalNumOfNumericalDigits,
-		err = new(mathFloatHelperPreon).
-		estimatePrecisionToDigits(
-			precisionBits,
-			ePrefix)
+	var exactRadians *big.Rat
 
-	return totalNumOfNumericalDigits, err
+	exactRadians,
+		err = new(mathBigRatHelperQuark).numStrKernelToRat(
+		radians,
+		ePrefix.XCpy(
+			"radians"))
+
+	if err != nil {
+		return cosine, cosineNumStr, err
+	}
+
+	return new(mathFloatHelperMolecule).sinCosTan(
+		exactRadians,
+		"cos",
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
 }
 
-// PrecisionToDigitsFactor
-//
-// Returns an instance of *big.Float configured with the
-// "Precision To Digits" conversion factor.
-//
-// Precision bits are used in the configuration of
-// big.Float types. The conversion factor is
-// "3.3219789132197891321978913219789".
+//	Exp
 //
-//		Precision Bits / Conversion Factor =
-//				Numeric Digit Capacity
-//			(margin of error +/- 3)
+//	Computes 'e' (Euler's number) raised to the power of
+//	a big.Float floating point exponent (e^exponent) to
+//	the number of fractional digits specified by input
+//	parameter 'requiredFractionalDigits'.
 //
-//	Conversely:
+//		Examples:
+//			e^1			= 2.71828182845904523536...
+//			e^2.5		= 12.18249396070347343807...
+//			e^-12.75	= 0.00000290232040865040...
 //
-//		Conversion Factor  x  Numeric Digit Capacity =
-//				Precision Bits
-//			(margin of error +/- 16)
+//	The result is returned as both a big.Float value and
+//	an instance of NumberStrKernel.
 //
-//	Precision, as used in connection with type big.Float,
-//	specifies the mantissa precision of a number in bits.
+//	The returned result is guaranteed to be correctly
+//	rounded. The calculation is performed at a working
+//	precision which exceeds the required number of
+//	fractional digits. The calculation is then repeated
+//	at a higher precision in order to establish an error
+//	bound. If the error bound prevents the result from
+//	being rounded with certainty, the working precision
+//	is doubled and the calculation is repeated until the
+//	correctly rounded result is established.
 //
-//	Also, remember that the number of numeric digits
-//	identified using this conversion factor includes
-//	both integer and fractional digits.
+// ----------------------------------------------------------------
 //
-//	For information on 'precision bits' and their
-//	relevance to type big.Float, reference:
+// # Input Parameters
 //
-//	https://pkg.go.dev/math/big#Float
+//	exponent					*big.Float
 //
-//	Bear in mind that this conversion factor may only be
-//	used to generate an estimate of numeric digits
-//	associated with a give precision bits value. This
-//	estimate may vary from the actual number of numeric
-//	digits. This estimate has a margin of error of plus
-//	or minus five (+ or - 3).
+//		The power to which 'e' will be raised.
 //
-// ----------------------------------------------------------------
+//		If 'exponent' is a nil pointer or infinite, an
+//		error will be returned.
 //
-// # Input Parameters
+//		If the result would contain more than 1,000,000
+//		integer digits, an error will be returned.
 //
-//	None
+//		If the magnitude of the result is too small to
+//		affect the required fractional digits, the result
+//		underflows to zero. With 'Ceiling' rounding, this
+//		positive result is rounded up to one unit in the
+//		last fractional digit.
 //
-// ----------------------------------------------------------------
+//	requiredFractionalDigits	int
 //
-// # Return Values
+//		The number of accurate fractional digits required
+//		in the calculation result. The result will be
+//		rounded to this number of fractional digits
+//		using the rounding algorithm specified by input
+//		parameter 'roundingType'.
 //
-//	*big.Float
+//		The number of big.Float precision bits required
+//		to store the result is computed internally by
+//		adding the estimated number of integer digits in
+//		the result, 'requiredFractionalDigits' and a
+//		buffer of extra digits. The working precision is
+//		increased automatically until the correctly
+//		rounded result has been established.
 //
-//		This method returns a pointer to an instance of
-//		big.Float configured with the conversion factor
-//		used to convert precision bits to the number of
-//		equivalent numeric digits.
-func (mathFloatHelper *MathFloatHelper) PrecisionToDigitsFactor() *big.Float {
-
-	if mathFloatHelper.lock == nil {
-		mathFloatHelper.lock = new(sync.Mutex)
-	}
-
-	mathFloatHelper.lock.Lock()
-
-	defer mathFloatHelper.lock.Unlock()
-
-	return new(mathFloatHelperPreon).
-		precisionToDigitsFactor()
-}
-
-// NativeNumStrToBigFloat
+//		If this value is less than zero, an error will be
+//		returned.
 //
-// Receives a Native Number String and converts that
-// string to a big.Float value.
+//	roundingType				NumberRoundingType
 //
-// The term 'Native' applies in the sense that the number
-// string format is designed to interoperate with the
-// Golang programming language library functions and
-// packages. Types like 'strconv', 'strings', 'math' and
-// 'big' (big.Int, big.Float, big.Rat) routinely parse
-// and convert this type of number string to numeric
-// values. In addition, Native Number Strings are
-// frequently consumed by external library functions such
-// as this one (String Mechanics 'strmech') to convert
-// strings to numeric values and numeric values to
-// strings.
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied
+//		to the calculation result.
 //
-// While this format is inconsistent with many national
-// and cultural formatting conventions, number strings
-// which fail to implement this standardized formatting
-// protocol will generate errors in some Golang library
-// functions.
+//		Possible values are listed as follows:
 //
-// The input parameter 'nativeNumStr' must be formatted
-// as a Native Number String in accordance with the
-// following criteria:
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
 //
-//  1. A Native Number String Consists of numeric
-//     character digits zero through nine inclusive
-//     (0-9).
+//		NumRoundType.None() and NumRoundType.NoRounding()
+//		are invalid. If either of these values is
+//		submitted, an error will be returned.
 //
-//  2. A Native Number String will include a period
-//     or decimal point ('.') to separate integer and
-//     fractional digits within a number string.
+//	errorPrefix					interface{}
 //
-//     Native Number String Floating Point Value:
-//     123.1234
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//  3. A Native Number String will always format
-//     negative numeric values with a leading minus sign
-//     ('-').
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//     Native Number String Negative Value:
-//     -123.2
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//  4. A Native Number String WILL NEVER include integer
-//     separators such as commas (',') to separate
-//     integer digits by thousands.
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//     NOT THIS: 1,000,000
-//     Native Number String: 1000000
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//  5. Native Number Strings will only consist of:
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//     (a)	Numeric digits zero through nine inclusive
-//     (0-9).
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//     (b)	A decimal point ('.') for floating point
-//     numbers.
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//     (c)	A leading minus sign ('-') in the case of
-//     negative numeric values.
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//     If the input parameter 'nativeNumStr' does NOT meet
-//     these criteria, an error will be returned.
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
 // ----------------------------------------------------------------
 //
-// # BE ADVISED
+// # Return Values
 //
-//	If the Native Number String ('nativeNumStr') fails to
-//	comply with Native Number String formatting
-//	requirements try the following method as a means of
-//	converting a 'dirty' number string to a valid Native
-//	Number String:
+//	raisedToExponent			*big.Float
 //
-//			NumStrHelper.DirtyToNativeNumStr()
+//		If this method completes successfully, this
+//		parameter will return 'e' raised to the power of 'exponent'
+//		rounded to 'requiredFractionalDigits'.
+//
+//	raisedToExponentNumStr		NumberStrKernel
+//
+//		If this method completes successfully, this
+//		parameter will return 'e' raised to the power of 'exponent'
+//		rounded to 'requiredFractionalDigits' as an
+//		instance of NumberStrKernel.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathFloatHelper *MathFloatHelper) Exp(
+	exponent *big.Float,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	errorPrefix interface{}) (
+	raisedToExponent *big.Float,
+	raisedToExponentNumStr NumberStrKernel,
+	err error) {
+
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
+	}
+
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathFloatHelper."+
+			"Exp()",
+		"")
+
+	if err != nil {
+		return raisedToExponent, raisedToExponentNumStr, err
+	}
+
+	floatHelperMolecule := mathFloatHelperMolecule{}
+
+	var exactExponent *big.Rat
+
+	exactExponent,
+		err = floatHelperMolecule.bigFloatToRat(
+		exponent,
+		"exponent",
+		ePrefix)
+
+	if err != nil {
+		return raisedToExponent, raisedToExponentNumStr, err
+	}
+
+	return floatHelperMolecule.exp(
+		exactExponent,
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
+}
+
+//	ExpNumStrKernel
+//
+//	Computes 'e' (Euler's number) raised to the power of
+//	a NumberStrKernel numeric exponent (e^exponent) to
+//	the number of fractional digits specified by input
+//	parameter 'requiredFractionalDigits'.
+//
+//		Examples:
+//			e^1			= 2.71828182845904523536...
+//			e^2.5		= 12.18249396070347343807...
+//			e^-12.75	= 0.00000290232040865040...
+//
+//	The numeric value of 'exponent' is converted, without
+//	rounding, to the exactly equivalent big.Rat value
+//	before the calculation is performed. Decimal values
+//	such as 0.1 are therefore processed exactly and are
+//	not subject to binary floating point conversion
+//	errors.
+//
+//	The result is returned as both a big.Float value and
+//	an instance of NumberStrKernel.
+//
+//	The returned result is guaranteed to be correctly
+//	rounded. The calculation is performed at a working
+//	precision which exceeds the required number of
+//	fractional digits. The calculation is then repeated
+//	at a higher precision in order to establish an error
+//	bound. If the error bound prevents the result from
+//	being rounded with certainty, the working precision
+//	is doubled and the calculation is repeated until the
+//	correctly rounded result is established.
 //
 // ----------------------------------------------------------------
 //
 // # Input Parameters
 //
-//	nativeNumStr				string
+//	exponent					*NumberStrKernel
 //
-//		This string contains the Native Number String which
-//		will be parsed to produce and return a big.Float
-//		value.
+//		The power to which 'e' will be raised.
 //
-//		If 'nativeNumStr' fails to meet the criteria for
-//		a Native Number String, an error will be
+//		If 'exponent' is a nil pointer or contains an
+//		invalid numeric value, an error will be
 //		returned.
 //
-//		A valid Native Number String must conform to the
-//		standardized formatting criteria defined below:
-//
-//	 	1. A Native Number String Consists of numeric
-//	 	   character digits zero through nine inclusive
-//	 	   (0-9).
+//		If the result would contain more than 1,000,000
+//		integer digits, an error will be returned.
 //
-//	 	2. A Native Number String will include a period
-//	 	   or decimal point ('.') to separate integer and
-//	 	   fractional digits within a number string.
+//		If the magnitude of the result is too small to
+//		affect the required fractional digits, the result
+//		underflows to zero. With 'Ceiling' rounding, this
+//		positive result is rounded up to one unit in the
+//		last fractional digit.
 //
-//	 	   Native Number String Floating Point Value:
-//	 	   				123.1234
+//	requiredFractionalDigits	int
 //
-//	 	3. A Native Number String will always format
-//	 	   negative numeric values with a leading minus sign
-//	 	   ('-').
+//		The number of accurate fractional digits required
+//		in the calculation result. The result will be
+//		rounded to this number of fractional digits
+//		using the rounding algorithm specified by input
+//		parameter 'roundingType'.
 //
-//	 	   Native Number String Negative Value:
-//	 	   				-123.2
+//		The number of big.Float precision bits required
+//		to store the result is computed internally by
+//		adding the estimated number of integer digits in
+//		the result, 'requiredFractionalDigits' and a
+//		buffer of extra digits. The working precision is
+//		increased automatically until the correctly
+//		rounded result has been established.
 //
-//	 	4. A Native Number String WILL NEVER include integer
-//	 	   separators such as commas (',') to separate
-//	 	   integer digits by thousands.
+//		If this value is less than zero, an error will be
+//		returned.
 //
-//	 	   					NOT THIS: 1,000,000
-//	 	   		Native Number String: 1000000
+//	roundingType				NumberRoundingType
 //
-//	 	5. Native Number Strings will only consist of:
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied
+//		to the calculation result.
 //
-//	 	   (a)	Numeric digits zero through nine inclusive (0-9).
+//		Possible values are listed as follows:
 //
-//	 	   (b)	A decimal point ('.') for floating point
-//	 	   		numbers.
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
 //
-//	 	   (c)	A leading minus sign ('-') in the case of
-//	 	   		negative numeric values.
+//		NumRoundType.None() and NumRoundType.NoRounding()
+//		are invalid. If either of these values is
+//		submitted, an error will be returned.
 //
 //	errorPrefix					interface{}
 //
@@ -1415,30 +1670,39 @@ func (mathFloatHelper *MathFloatHelper) PrecisionToDigitsFactor() *big.Float {
 //
 // # Return Values
 //
-//	big.Float
+//	raisedToExponent			*big.Float
 //
-//		If this method completes successfully, the pure
-//		number string passed as input value 'pureNumStr'
-//		will be converted and returned as a big.Float
-//		floating point value.
+//		If this method completes successfully, this
+//		parameter will return 'e' raised to the power of 'exponent'
+//		rounded to 'requiredFractionalDigits'.
 //
-//	error
+//	raisedToExponentNumStr		NumberStrKernel
 //
-//		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
+//		If this method completes successfully, this
+//		parameter will return 'e' raised to the power of 'exponent'
+//		rounded to 'requiredFractionalDigits' as an
+//		instance of NumberStrKernel.
 //
-//		If errors are encountered during processing, the
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
 //		returned error Type will encapsulate an error
-//		message. This returned error message will
-//		incorporate the method chain and text passed by
-//		input parameter, 'errorPrefix'. The 'errorPrefix'
-//		text will be attached to the beginning of the
-//		error message.
-func (mathFloatHelper *MathFloatHelper) NativeNumStrToBigFloat(
-	nativeNumStr string,
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathFloatHelper *MathFloatHelper) ExpNumStrKernel(
+	exponent *NumberStrKernel,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
 	errorPrefix interface{}) (
-	big.Float,
-	error) {
+	raisedToExponent *big.Float,
+	raisedToExponentNumStr NumberStrKernel,
+	err error) {
 
 	if mathFloatHelper.lock == nil {
 		mathFloatHelper.lock = new(sync.Mutex)
@@ -1450,228 +1714,103 @@ func (mathFloatHelper *MathFloatHelper) NativeNumStrToBigFloat(
 
 	var ePrefix *ePref.ErrPrefixDto
 
-	var err error
-
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"MathFloatHelper."+
-			"NativeNumStrToBigFloat()",
+			"ExpNumStrKernel()",
 		"")
 
 	if err != nil {
-		return big.Float{}, err
+		return raisedToExponent, raisedToExponentNumStr, err
 	}
 
-	return new(mathFloatHelperBoson).
-		nativeNumStrToBigFloat(
-			nativeNumStr,
-			2,
-			0,
-			big.AwayFromZero,
-			ePrefix)
+	var exactExponent *big.Rat
+
+	exactExponent,
+		err = new(mathBigRatHelperQuark).numStrKernelToRat(
+		exponent,
+		ePrefix.XCpy(
+			"exponent"))
+
+	if err != nil {
+		return raisedToExponent, raisedToExponentNumStr, err
+	}
+
+	return new(mathFloatHelperMolecule).exp(
+		exactExponent,
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
 }
 
-// NativeNumStrToBigFloatDto
+//	FloatToIntFracRunes
 //
-// Receives a Native Number String containing a numeric
-// value which will be converted and returned as a
-// big.Float floating point value encapsulated within
-// an instance of BigFloatDto.
+//	Receives one of several types of floating point
+//	values and converts that value to an integer digit
+//	rune array and a fractional digit rune array.
 //
-// The term 'Native' applies in the sense that the number
-// string format is designed to interoperate with the
-// Golang programming language library functions and
-// packages. Types like 'strconv', 'strings', 'math' and
-// 'big' (big.Int, big.Float, big.Rat) routinely parse
-// and convert this type of number string to numeric
-// values. In addition, Native Number Strings are
-// frequently consumed by external library functions such
-// as this one (String Mechanics 'strmech') to convert
-// strings to numeric values and numeric values to
-// strings.
-//
-// While this format is inconsistent with many national
-// and cultural formatting conventions, number strings
-// which fail to implement this standardized formatting
-// protocol will generate errors in some Golang library
-// functions.
-//
-// The input parameter 'nativeNumStr' must be formatted
-// as a Native Number String in accordance with the
-// following criteria:
-//
-//  1. A Native Number String Consists of numeric
-//     character digits zero through nine inclusive
-//     (0-9).
-//
-//  2. A Native Number String will include a period
-//     or decimal point ('.') to separate integer and
-//     fractional digits within a number string.
-//
-//     Native Number String Floating Point Value:
-//     123.1234
-//
-//  3. A Native Number String will always format
-//     negative numeric values with a leading minus sign
-//     ('-').
-//
-//     Native Number String Negative Value:
-//     -123.2
-//
-//  4. A Native Number String WILL NEVER include integer
-//     separators such as commas (',') to separate
-//     integer digits by thousands.
-//
-//     NOT THIS: 1,000,000
-//     Native Number String: 1000000
-//
-//  5. Native Number Strings will only consist of:
-//
-//     (a)	Numeric digits zero through nine inclusive
-//     (0-9).
-//
-//     (b)	A decimal point ('.') for floating point
-//     numbers.
+//	The integer and fractional digit rune arrays
+//	represent and absolute values extracted from the
+//	original floating point number.
 //
-//     (c)	A leading minus sign ('-') in the case of
-//     negative numeric values.
+//	The returned integer and fractional digits are stored
+//	in input parameters 'intDigits' and 'fracDigits'.
 //
-//     If the input parameter 'nativeNumStr' does NOT meet
-//     these criteria, an error will be returned.
+//	The positive or negative number sign for the returned
+//	numeric digits can be determined by examining the
+//	statistics returned by parameter 'numberStats'
+//	(numberStats.NumberSign).
 //
 // ----------------------------------------------------------------
 //
-// # BE ADVISED
-//
-//	If the Native Number String ('nativeNumStr') fails to
-//	comply with Native Number String formatting
-//	requirements try the following method as a means of
-//	converting a 'dirty' number string to a valid Native
-//	Number String:
-//
-//			NumStrHelper.DirtyToNativeNumStr()
-//
-// ----------------------------------------------------------------
+//	# Input Parameters
 //
-// # Input Parameters
+//	floatingPointNumber 		interface{}
 //
-//	nativeNumStr				string
+//		Numeric values passed by means of this empty
+//		interface MUST BE convertible to one of the
+//		following types:
 //
-//		This Native Number String contains the numeric
-//		character digits which will be analyzed and
-//		converted to a big.Float value in the returned
-//		instance of 'BigFloatDto'.
+//			float32
+//			float64
+//			*big.Float
 //
-//		If 'nativeNumStr' fails to meet the criteria for
-//		a Native Number String, an error will be
+//		If 'floatingPointNumber' is NOT convertible to
+//		one of the types listed above, an error will be
 //		returned.
 //
-//		A valid Native Number String must conform to the
-//		standardized formatting criteria defined below:
-//
-//	 	1. A Native Number String Consists of numeric
-//	 	   character digits zero through nine inclusive
-//	 	   (0-9).
-//
-//	 	2. A Native Number String will include a period
-//	 	   or decimal point ('.') to separate integer and
-//	 	   fractional digits within a number string.
-//
-//	 	   Native Number String Floating Point Value:
-//	 	   				123.1234
-//
-//	 	3. A Native Number String will always format
-//	 	   negative numeric values with a leading minus sign
-//	 	   ('-').
-//
-//	 	   Native Number String Negative Value:
-//	 	   				-123.2
-//
-//	 	4. A Native Number String WILL NEVER include integer
-//	 	   separators such as commas (',') to separate
-//	 	   integer digits by thousands.
-//
-//	 	   					NOT THIS: 1,000,000
-//	 	   		Native Number String: 1000000
-//
-//	 	5. Native Number Strings will only consist of:
-//
-//	 	   (a)	Numeric digits zero through nine inclusive (0-9).
-//
-//	 	   (b)	A decimal point ('.') for floating point
-//	 	   		numbers.
-//
-//	 	   (c)	A leading minus sign ('-') in the case of
-//	 	   		negative numeric values.
-//
-//	numOfExtraDigitsBuffer		int64
-//
-//		When configuring the big.Float numeric value
-//		returned by the BigFloatDto instance, the number
-//		of big.Float precision bits will be calculated
-//		based on the number of integer and fractional
-//		numeric digits contained in the Native Number
-//		String ('nativeNumStr'). To deal with
-//		contingencies and requirements often found in
-//		complex floating point operations, users have
-//		the option to arbitrarily increase the number
-//		of precision bits by specifying additional
-//		numeric digits via parameter,
-//		'numOfExtraDigitsBuffer'.
-//
-//		Note: The user has the option of overriding the
-//		automatic precision bits calculation by specifying
-//		a precision bits value directly through parameter,
-//		'precisionBitsOverride'.
-//
-//	precisionBitsOverride		uint
-//
-//		The term 'precision bits' refers to the number of
-//		bits in the mantissa of a big.Float floating point
-//		number. Effectively, 'precision bits' controls the
-//		precision, accuracy and numerical digit storage
-//		capacity for a big.Float floating point number.
-//
-//		Typically, this method will automatically
-//		calculate the value of big.Float precision bits
-//		using the parameter 'numOfExtraDigitsBuffer'
-//		listed above. However, if 'precisionBitsOverride'
-//		has a value greater than zero, the automatic
-//		precision bit calculation will be overridden and
-//		big.Float precision bits will be set to the value
-//		of this	precision bits specification
-//		('precisionBitsOverride').
-//
-//	roundingMode 				big.RoundingMode
+//	intDigits					*RuneArrayDto
 //
-//		Specifies the rounding algorithm which will be used
-//		internally to calculate the base value raised to the
-//		power of exponent.
+//		A pointer to an instance of RuneArrayDto. The
+//		integer numeric digits extracted from
+//		'floatingPointNumber' will be stored as text
+//		characters in the rune array encapsulated by
+//		this RuneArrayDto object.
 //
-//		Each instance of big.Float is configured with a
-//		rounding mode. Input parameter 'roundingMode'
-//		controls this configuration for the calculation
-//		and the big.Float value returned by this method.
+//		The positive or negative number sign for the
+//		extracted integer digits, can be determined by
+//		examining the statistics returned by parameter
+//		'numberStats' (numberStats.NumberSign).
 //
-//		The constant values available for big.Float
-//		rounding mode are listed as follows:
+//	fracDigits					*RuneArrayDto
 //
-//		big.ToNearestEven  		// == IEEE 754-2008 roundTiesToEven
-//		big.ToNearestAway       // == IEEE 754-2008 roundTiesToAway
-//		big.ToZero              // == IEEE 754-2008 roundTowardZero
-//		big.AwayFromZero        // no IEEE 754-2008 equivalent
-//		big.ToNegativeInf       // == IEEE 754-2008 roundTowardNegative
-//		big.ToPositiveInf       // == IEEE 754-2008 roundTowardPositive
+//		A pointer to an instance of RuneArrayDto. The
+//		fractional numeric digits extracted from
+//		'floatingPointNumber' will be stored as text
+//		characters in the rune array encapsulated by
+//		this RuneArrayDto object.
 //
-//		If in doubt as this setting, 'big.AwayFromZero' or
-//		'big.ToNearestEven' are common selections for rounding mode.
+//		The positive or negative number sign for the
+//		extracted integer digits, can be determined by
+//		examining the statistics returned by parameter
+//		'numberStats' (numberStats.NumberSign).
 //
 //	errorPrefix					interface{}
 //
 //		This object encapsulates error prefix text which
 //		is included in all returned error messages.
-//		Usually, it contains the name of the calling
+//		Usually, it	contains the name of the calling
 //		method or methods listed as a method or function
 //		chain of execution.
 //
@@ -1730,177 +1869,91 @@ func (mathFloatHelper *MathFloatHelper) NativeNumStrToBigFloat(
 //
 // # Return Values
 //
-//	BigFloatDto
+//	numberStats					NumberStrStatsDto
 //
-//		If this method completes successfully, a fully
-//		populated instance of BigFloatDto will be
-//		returned containing the big.Float value
-//		generated from the Native Number String parameter,
-//		'nativeNumStr'.
+//		This data transfer object will return critical
+//		statistics on the numeric value represented
+//		by the integer and fractional digits extracted
+//		from 'floatingPointNumber' and stored in the
+//		'intDigits' and 'fracDigits' RuneArrayDto
+//		objects.
 //
-//		type BigFloatDto struct {
-//			Value big.Float
-//				The actual value of the big.Float instance.
+//		type NumberStrStatsDto struct {
 //
-//			NumStrComponents PureNumberStrComponents
-//				This parameter profiles the actual big.Float
-//				floating point numeric value identified by
-//				structure element 'Value'.
+//		NumOfIntegerDigits					uint64
 //
-//				type PureNumberStrComponents struct {
+//			The total number of integer digits to the
+//			left of the radix point or, decimal point, in
+//			the subject numeric value.
 //
-//					NumStrStats NumberStrStatsDto
+//		NumOfSignificantIntegerDigits		uint64
 //
-//						This data transfer object will return key
-//						statistics on the numeric value encapsulated
-//						by the current instance of NumberStrKernel.
+//			The number of nonzero integer digits to the
+//			left of the radix point or, decimal point, in
+//			the subject numeric value.
 //
-//							type NumberStrStatsDto struct {
+//		NumOfFractionalDigits				uint64
 //
-//								NumOfIntegerDigits					uint64
+//			The total number of fractional digits to the
+//			right of the radix point or, decimal point,
+//			in the subject numeric value.
 //
-//									The total number of integer digits to the
-//									left of the radix point or, decimal point, in
-//									the subject numeric value.
+//		NumOfSignificantFractionalDigits	uint64
 //
-//								NumOfSignificantIntegerDigits		uint64
-//
-//									The number of nonzero integer digits to the
-//									left of the radix point or, decimal point, in
-//									the subject numeric value.
-//
-//								NumOfFractionalDigits				uint64
-//
-//									The total number of fractional digits to the
-//									right of the radix point or, decimal point,
-//									in the subject numeric value.
-//
-//								NumOfSignificantFractionalDigits	uint64
-//
-//									The number of nonzero fractional digits to
-//									the right of the radix point or, decimal
-//									point, in the subject numeric value.
-//
-//								NumberValueType 					NumericValueType
-//
-//									This enumeration value specifies whether the
-//									subject numeric value is classified either as
-//									an integer or a floating point number.
-//
-//									Possible enumeration values are listed as
-//									follows:
-//										NumValType.None()
-//										NumValType.FloatingPoint()
-//										NumValType.Integer()
-//
-//								NumberSign							NumericSignValueType
-//
-//									An enumeration specifying the number sign
-//									associated with the numeric value. Possible
-//									values are listed as follows:
-//										NumSignVal.None()		= Invalid Value
-//										NumSignVal.Negative()	= -1
-//										NumSignVal.Zero()		=  0
-//										NumSignVal.Positive()	=  1
-//
-//								IsZeroValue							bool
-//
-//									If 'true', the subject numeric value is equal
-//									to zero ('0').
-//
-//									If 'false', the subject numeric value is
-//									greater than or less than zero ('0').
-//							}
-//
-//
-//
-//					AbsoluteValueNumStr string
-//					The number string expressed as an absolute value.
-//
-//					AbsoluteValAllIntegerDigitsNumStr string
-//					Integer and fractional digits are combined
-//					in a single number string without a decimal
-//					point separating integer and fractional digits.
-//					This string DOES NOT contain a leading number
-//					sign (a.k.a. minus sign ('-')
-//				}
-//
-//			EstimatedPrecisionBits BigFloatPrecisionDto
-//
-//			This structure stores the components and final
-//			results value for a precision bits calculation.
-//			The number of precision bits configured for a
-//			big.Float floating point numeric value determines
-//			the storage capacity for a specific floating
-//			point number. As such, the calculation of a
-//			correct and adequate precision bits value can
-//			affect the accuracy of floating point calculations.
-//
-//			type BigFloatPrecisionDto struct {
-//
-//					NumIntegerDigits			int64
-//
-//						The actual or estimated number of integer digits
-//						in a big.Float floating point numeric value. The
-//						number of integer digits in a floating point
-//						number is one of the elements used to calculate
-//						the precision bits required to store that
-//						floating point number.
+//			The number of nonzero fractional digits to
+//			the right of the radix point or, decimal
+//			point, in the subject numeric value.
 //
-//					NumFractionalDigits			int64
+//		NumberValueType 					NumericValueType
 //
-//						The actual or estimated number of fractional
-//						digits in a big.Float floating point numeric
-//						value. The number of fractional digits in a
-//						floating point number is one of the elements used
-//						to calculate the precision bits required to store
-//						that floating point number.
+//			This enumeration value specifies whether the
+//			subject numeric value is classified either as
+//			an integer or a floating point number.
 //
-//					NumOfExtraDigitsBuffer		int64
+//			Possible enumeration values are listed as
+//			follows:
+//				NumValType.None()
+//				NumValType.FloatingPoint()
+//				NumValType.Integer()
 //
-//						When estimating the number of precision necessary
-//						to store or process big.Float floating point
-//						values, is generally a good idea to include a
-//						safety margin consisting of excess numeric digits.
+//		NumberSign							NumericSignValueType
 //
-//						This parameter stores the number of extra numeric
-//						digits used in a calculation of total require
-//						precision bits.
+//			An enumeration specifying the number sign
+//			associated with the numeric value. Possible
+//			values are listed as follows:
+//				NumSignVal.None()		= Invalid Value
+//				NumSignVal.Negative()	= -1
+//				NumSignVal.Zero()		=  0
+//				NumSignVal.Positive()	=  1
 //
-//					PrecisionBitsSpec uint
-//						This parameter represents the estimated number of
-//						bits required to store a specific floating point
-//						numeric value in an instance of type big.Float.
+//		IsZeroValue							bool
 //
-//						The 'PrecisionBitsSpec' value is usually generated
-//						by an internal calculation based on the estimated
-//						number of integer and fractional digits contained
-//						in a big.Float floating point number. However,
-//						users have the option to specify an arbitrary
-//						precision bits value.
-//			}
+//			If 'true', the subject numeric value is equal
+//			to zero ('0').
 //
+//			If 'false', the subject numeric value is
+//			greater than or less than zero ('0').
+//		}
 //
-//	error
+//	err							error
 //
 //		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'. If
-//		errors are encountered during processing, the
-//		returned error Type will encapsulate an error
-//		message.
+//		returned error Type is set equal to 'nil'.
 //
-//		If an error message is returned, the text value
-//		for input parameter 'errPrefDto' (error prefix)
-//		will be prefixed or attached at the beginning of
-//		the error message.
-func (mathFloatHelper *MathFloatHelper) NativeNumStrToBigFloatDto(
-	nativeNumStr string,
-	numOfExtraDigitsBuffer int64,
-	precisionBitsOverride uint,
-	roundingMode big.RoundingMode,
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (mathFloatHelper *MathFloatHelper) FloatNumToIntFracRunes(
+	floatingPointNumber interface{},
+	intDigits *RuneArrayDto,
+	fracDigits *RuneArrayDto,
 	errorPrefix interface{}) (
-	BigFloatDto,
-	error) {
+	numberStats NumberStrStatsDto,
+	err error) {
 
 	if mathFloatHelper.lock == nil {
 		mathFloatHelper.lock = new(sync.Mutex)
@@ -1912,625 +1965,4706 @@ func (mathFloatHelper *MathFloatHelper) NativeNumStrToBigFloatDto(
 
 	var ePrefix *ePref.ErrPrefixDto
 
-	var err error
-
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"MathFloatHelper."+
-			"NativeNumStrToBigFloatDto()",
+			"FloatNumToIntFracRunes()",
 		"")
 
 	if err != nil {
-		return BigFloatDto{}, err
+		return numberStats, err
 	}
 
-	return new(mathFloatHelperBoson).
-		bigFloatDtoFromPureNumStr(
-			nativeNumStr,
-			".",
-			true,
-			numOfExtraDigitsBuffer,
-			precisionBitsOverride,
-			roundingMode,
+	numberStats,
+		err = new(mathFloatHelperMechanics).
+		floatNumToIntFracRunes(
+			floatingPointNumber,
+			intDigits,
+			fracDigits,
 			ePrefix)
+
+	return numberStats, err
 }
 
-// NativeNumStrToFloat64
-//
-// Receives a Native Number string and converts that
-// string to a float64 floating point value.
-//
-// The term 'Native' applies in the sense that the number
-// string format is designed to interoperate with the
-// Golang programming language library functions and
-// packages. Types like 'strconv', 'strings', 'math' and
-// 'big' (big.Int, big.Float, big.Rat) routinely parse
-// and convert this type of number string to numeric
-// values. In addition, Native Number Strings are
-// frequently consumed by external library functions such
-// as this one (String Mechanics 'strmech') to convert
-// strings to numeric values and numeric values to
-// strings.
-//
-// While this format is inconsistent with many national
-// and cultural formatting conventions, number strings
-// which fail to implement this standardized formatting
-// protocol will generate errors in some Golang library
-// functions.
+//	PiTo20k
 //
-// The input parameter 'nativeNumStr' must be formatted
-// as a Native Number String in accordance with the
-// following criteria:
+//	Returns an instance of *big.Float configured for Pi
+//	up to 20k fractional digits.
 //
-//  1. A Native Number String Consists of numeric
-//     character digits zero through nine inclusive
-//     (0-9).
+//	Pi to 20,001 digits. Including the integer '3' this
+//	is 20,001 digits. There are 20,000 fractional digits.
 //
-//  2. A Native Number String will include a period
-//     or decimal point ('.') to separate integer and
-//     fractional digits within a number string.
+//	OEIS A000796
 //
-//     Native Number String Floating Point Value:
-//     123.1234
+//	https://oeis.org/A000796
+//	https://oeis.org/A000796/b000796.txt
 //
-//  3. A Native Number String will always format
-//     negative numeric values with a leading minus sign
-//     ('-').
+//	If the user sets input parameter 'roundingType' to
+//	NumRoundType.NoRounding(), the entire 20,000
+//	fractional digits will be configured and returned
+//	as an instance of *big.Float.
 //
-//     Native Number String Negative Value:
-//     -123.2
+// ----------------------------------------------------------------
 //
-//  4. A Native Number String WILL NEVER include integer
-//     separators such as commas (',') to separate
-//     integer digits by thousands.
+// # Input Parameters
 //
-//     NOT THIS: 1,000,000
-//     Native Number String: 1000000
+//	roundingType				NumberRoundingType
 //
-//  5. Native Number Strings will only consist of:
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied for
+//		the	rounding of fractional digits contained in the
+//		current instance of NumberStrKernel.
 //
-//     (a)	Numeric digits zero through nine inclusive
-//     (0-9).
+//		If in doubt as to a suitable rounding method,
+//		'HalfAwayFromZero' is recommended.
 //
-//     (b)	A decimal point ('.') for floating point
-//     numbers.
+//		Possible values are listed as follows:
+//			NumRoundType.None()	- Invalid Value
+//			NumRoundType.NoRounding()
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
 //
-//     (c)	A leading minus sign ('-') in the case of
-//     negative numeric values.
+//		Definitions:
 //
-//     If the input parameter 'nativeNumStr' does NOT meet
-//     these criteria, an error will be returned.
+//			NoRounding
 //
-// ----------------------------------------------------------------
+//				Signals that no rounding operation will be
+//				performed on fractional digits. The
+//				fractional digits will therefore remain
+//				unchanged.
 //
-// # BE ADVISED
+//			HalfUpWithNegNums
 //
-//	If the Native Number String ('nativeNumStr') fails to
-//	comply with Native Number String formatting
-//	requirements try the following method as a means of
-//	converting a 'dirty' number string to a valid Native
-//	Number String:
+//				Half Round Up Including Negative Numbers.
+//				This method is intuitive but may produce
+//				unexpected results when applied to negative
+//				numbers.
 //
-//			NumStrHelper.DirtyToNativeNumStr()
+//				'HalfUpWithNegNums' rounds .5 up.
 //
-// ----------------------------------------------------------------
+//					Examples of 'HalfUpWithNegNums'
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8
+//					7.4 rounds down to 7
+//					-7.4 rounds up to -7
+//					-7.5 rounds up to -7
+//					-7.6 rounds down to -8
 //
-// # Input Parameters
+//			HalfDownWithNegNums
 //
-//	nativeNumStr				string
+//			Half Round Down Including Negative Numbers. This
+//			method is also considered intuitive but may
+//			produce unexpected results when applied to
+//			negative numbers.
 //
-//		This string contains the Native Number String which
-//		will be parsed to produce and return a big.Float
-//		value.
+//			'HalfDownWithNegNums' rounds .5 down.
 //
-//		If 'nativeNumStr' fails to meet the criteria for
-//		a Native Number String, an error will be
-//		returned.
+//				Examples of HalfDownWithNegNums
 //
-//		A valid Native Number String must conform to the
-//		standardized formatting criteria defined below:
+//				7.6 rounds up to 8
+//				7.5 rounds down to 7
+//				7.4 rounds down to 7
+//				-7.4 rounds up to -7
+//				-7.5 rounds down to -8
+//				-7.6 rounds down to -8
 //
-//	 	1. A Native Number String Consists of numeric
-//	 	   character digits zero through nine inclusive
-//	 	   (0-9).
+//			HalfAwayFromZero
 //
-//	 	2. A Native Number String will include a period
-//	 	   or decimal point ('.') to separate integer and
-//	 	   fractional digits within a number string.
+//				The 'HalfAwayFromZero' method rounds .5 further
+//				away from zero.	It provides clear and consistent
+//				behavior when dealing with negative numbers.
 //
-//	 	   Native Number String Floating Point Value:
-//	 	   				123.1234
+//					Examples of HalfAwayFromZero
 //
-//	 	3. A Native Number String will always format
-//	 	   negative numeric values with a leading minus sign
-//	 	   ('-').
+//					7.6 rounds away to 8
+//					7.5 rounds away to 8
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds away to -8
+//					-7.6 rounds away to -8
 //
-//	 	   Native Number String Negative Value:
-//	 	   				-123.2
+//			HalfTowardsZero
 //
-//	 	4. A Native Number String WILL NEVER include integer
-//	 	   separators such as commas (',') to separate
-//	 	   integer digits by thousands.
+//				Round Half Towards Zero. 'HalfTowardsZero' rounds
+//				0.5	closer to zero. It provides clear and
+//				consistent behavior	when dealing with negative
+//				numbers.
 //
-//	 	   					NOT THIS: 1,000,000
-//	 	   		Native Number String: 1000000
+//					Examples of HalfTowardsZero
 //
-//	 	5. Native Number Strings will only consist of:
+//					7.6 rounds away to 8
+//					7.5 rounds to 7
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds to -7
+//					-7.6 rounds away to -8
 //
-//	 	   (a)	Numeric digits zero through nine inclusive (0-9).
+//			HalfToEven
 //
-//	 	   (b)	A decimal point ('.') for floating point
-//	 	   		numbers.
+//				Round Half To Even Numbers. 'HalfToEven' is
+//				also called	Banker's Rounding. This method
+//				rounds 0.5 to the nearest even digit.
 //
-//	 	   (c)	A leading minus sign ('-') in the case of
-//	 	   		negative numeric values.
+//					Examples of HalfToEven
 //
-//	errorPrefix					interface{}
+//					7.5 rounds up to 8 (because 8 is an even
+//					number)	but 6.5 rounds down to 6 (because
+//					6 is an even number)
 //
-//		This object encapsulates error prefix text which
-//		is included in all returned error messages.
-//		Usually, it contains the name of the calling
-//		method or methods listed as a method or function
-//		chain of execution.
+//					HalfToEven only applies to 0.5. Other
+//					numbers (not ending	in 0.5) round to
+//					nearest as usual, so:
 //
-//		If no error prefix information is needed, set this
-//		parameter to 'nil'.
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8 (because 8 is an even number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds down to 6 (because 6 is an even number)
+//					6.4 rounds down to 6
 //
-//		This empty interface must be convertible to one of
-//		the following types:
+//			HalfToOdd
 //
-//		1.	nil
-//				A nil value is valid and generates an
-//				empty collection of error prefix and
-//				error context information.
+//				Round Half to Odd Numbers. Similar to 'HalfToEven',
+//				but in this case 'HalfToOdd' rounds 0.5 towards odd
+//				numbers.
 //
-//		2.	string
-//				A string containing error prefix
-//				information.
+//					Examples of HalfToOdd
 //
-//		3.	[]string
-//				A one-dimensional slice of strings
-//				containing error prefix information.
+//					HalfToOdd only applies to 0.5. Other numbers
+//					(not ending	in 0.5) round to nearest as usual.
 //
-//		4.	[][2]string
-//				A two-dimensional slice of strings
-//		   		containing error prefix and error
-//		   		context information.
+//					7.5 rounds down to 7 (because 7 is an odd number)
 //
-//		5.	ErrPrefixDto
-//				An instance of ErrPrefixDto.
-//				Information from this object will
-//				be copied for use in error and
-//				informational messages.
+//					6.5 rounds up to 7 (because 7 is an odd number)
 //
-//		6.	*ErrPrefixDto
-//				A pointer to an instance of
-//				ErrPrefixDto. Information from
-//				this object will be copied for use
-//				in error and informational messages.
+//					7.6 rounds up to 8
+//					7.5 rounds down to 7 (because 7 is an odd number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds up to 7 (because 7 is an odd number)
+//					6.4 rounds down to 6
 //
-//		7.	IBasicErrorPrefix
-//				An interface to a method
-//				generating a two-dimensional slice
-//				of strings containing error prefix
-//				and error context information.
+//			Randomly
 //
-//		If parameter 'errorPrefix' is NOT convertible
-//		to one of the valid types listed above, it will
-//		be considered invalid and trigger the return of
-//		an error.
+//				Round Half Randomly. Uses a Random Number Generator
+//				to choose between rounding 0.5 up or down.
 //
-//		Types ErrPrefixDto and IBasicErrorPrefix are
-//		included in the 'errpref' software package:
-//			"github.com/MikeAustin71/errpref".
+//				All numbers other than 0.5 round to the nearest as
+//				usual.
 //
-// ----------------------------------------------------------------
+//			Floor
 //
-// # Return Values
+//				Yields the nearest integer down. Floor does not apply
+//				any	special treatment to 0.5.
 //
-//	float64
+//				Floor Function: The greatest integer that is less than
+//				or equal to x
 //
-//		If this method completes successfully, the pure
-//		number string passed as input value 'pureNumStr'
-//		will be converted and returned as a float64
-//		floating point value.
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
 //
-//	error
+//				In mathematics and computer science, the floor function
+//				is the function that takes as input a real number x,
+//				and gives as output the greatest integer less than or
+//				equal to x,	denoted floor(x) or ⌊x⌋.
 //
-//		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
 //
-//		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error
-//		message. This returned error message will
-//		incorporate the method chain and text passed by
-//		input parameter, 'errorPrefix'. The 'errorPrefix'
-//		text will be attached to the beginning of the
-//		error message.
-func (mathFloatHelper *MathFloatHelper) NativeNumStrToFloat64(
-	nativeNumStr string,
-	errorPrefix interface{}) (
-	float64,
-	error) {
-
-	if mathFloatHelper.lock == nil {
-		mathFloatHelper.lock = new(sync.Mutex)
-	}
-
-	mathFloatHelper.lock.Lock()
-
-	defer mathFloatHelper.lock.Unlock()
-
-	var ePrefix *ePref.ErrPrefixDto
-
-	var err error
-
-	var float64Num float64
-
-	ePrefix,
-		err = ePref.ErrPrefixDto{}.NewIEmpty(
-		errorPrefix,
-		"MathFloatHelper."+
-			"NativeNumStrToFloat64()",
-		"")
-
+//				Examples of Floor
+//
+//					Number     Floor
+//					 2           2
+//					 2.4         2
+//					 2.9         2
+//					-2.5        -3
+//					-2.7        -3
+//					-2          -2
+//
+//			Ceiling
+//
+//				Yields the nearest integer up. Ceiling does not
+//				apply any special treatment to 0.5.
+//
+//				Ceiling Function: The least integer that is
+//				greater than or	equal to x.
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//
+//				The ceiling function maps x to the least integer
+//				greater than or equal to x, denoted ceil(x) or
+//				⌈x⌉.[1]
+//
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//
+//					Examples of Ceiling
+//
+//						Number    Ceiling
+//						 2           2
+//						 2.4         3
+//						 2.9         3
+//						-2.5        -2
+//						-2.7        -2
+//						-2          -2
+//
+//			Truncate
+//
+//				Apply NO Rounding whatsoever. The Round From Digit
+//				is dropped or deleted. The Round To Digit is NEVER
+//				changed.
+//
+//				Examples of Truncate
+//
+//					Example-1
+//					Number: 23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:   23.14 - The Round From Digit
+//					is dropped.
+//
+//					Example-2
+//					Number: -23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:  -23.14 - The Round From Digit
+//					is dropped.
+//
+//	roundToFractionalDigits		int
+//
+//		When set to a positive integer value, this parameter
+//		controls the number of digits to the right of the radix
+//		point or decimal separator (a.k.a. decimal point). This
+//		controls the number of fractional digits remaining after
+//		completion of the number rounding operation.
+//
+//		For the purposes of this method, any
+//		'roundToFractionalDigits' value greater than 20,000 will
+//		trigger an error return.
+//
+//	 errorPrefix                interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it	contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	*big.Float
+//
+//		A pointer to an instance of big.Float. If this
+//		method completes successfully, this instance will
+//		be configured with the value of Pi out to the
+//		specified number of decimal places.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (mathFloatHelper *MathFloatHelper) PiTo20k(
+	roundingType NumberRoundingType,
+	roundToFractionalDigits int,
+	errorPrefix interface{}) (
+	*big.Float,
+	error) {
+
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
+	}
+
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	pi20k := new(big.Float).
+		SetInt64(0).
+		SetPrec(66504).
+		SetMode(big.AwayFromZero).
+		SetInt64(0)
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathFloatHelper."+
+			"PiTo20k()",
+		"")
+
+	if err != nil {
+		return pi20k, err
+	}
+
+	if roundToFractionalDigits > 20000 {
+
+		err = fmt.Errorf("\n%v\n"+
+			"Error: Input parameter 'roundToFractionalDigits' is invalid!\n"+
+			"'roundToFractionalDigits' exceeds the maximum limit of 20,000.\n"+
+			"roundToFractionalDigits = '%v'\n",
+			ePrefix.String(),
+			roundToFractionalDigits)
+
+		return pi20k, err
+	}
+
+	numStrKernel := NumberStrKernel{}
+
+	numStrKernel.numberValueType = NumValType.FloatingPoint()
+
+	numStrKernel.numberSign = NumSignVal.Positive()
+
+	numStrKernel.isNonZeroValue = true
+
+	numStrKernel.integerDigits,
+		numStrKernel.fractionalDigits = new(MathConstantsFloat).
+		Pi20KRunes(roundToFractionalDigits)
+
+	if roundingType != NumRoundType.NoRounding() &&
+		roundingType != NumRoundType.None() {
+
+		err = numStrKernel.Round(
+			roundingType,
+			roundToFractionalDigits,
+			ePrefix.XCpy("Pi20KRunes"))
+
+		if err != nil {
+
+			return pi20k, err
+
+		}
+	}
+
+	var pureNumStr string
+
+	pureNumStr,
+		_,
+		err = numStrKernel.FmtNumStrPure(
+		".",
+		true,
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	var ok bool
+	_,
+		ok = pi20k.SetString(pureNumStr)
+
+	if !ok {
+
+		err = fmt.Errorf("\n%v\n"+
+			"Error: pi20k.SetString(numStrKernel.GetPureNumberStr()) FAILED!\n"+
+			"big.Float was unable to set the Pi value to\n"+
+			"%v fractional digits.\n"+
+			"numStrKernel.GetPureNumberStr() = %v",
+			ePrefix.String(),
+			roundToFractionalDigits,
+			pureNumStr)
+	}
+
+	return pi20k, err
+}
+
+//	DigitsToPrecisionEstimate
+//
+//	Computes an estimate of the number of precision
+//	bits required in order to store a given number
+//	of numeric digits in a type big.Float, floating
+//	point number.
+//
+//	Precision bits are used in the configuration of
+//	big.Float types. The conversion factor is
+//	"3.3219789132197891321978913219789".
+//
+//		Conversion Factor  x  Numeric Digit Capacity =
+//				Precision Bits
+//			(margin of error +/- 16)
+//
+//	The number of precision bits returned is an
+//	estimate with a margin of error of plus or minus
+//	sixteen (+ or - 16).
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numNumericDigitsRequired	int64
+//
+//		The number of numeric digits to be stored and
+//		processed by a type big.Float floating point
+//		numeric value. This value represents the desired
+//		capacity for a big.Float number. This number of
+//		numeric digits should include both integer and
+//		fractional numeric digits as well as a buffer
+//		of extra digits necessary to perform accurate
+//		calculations. The number of buffer digits will
+//		vary depending on the complexity of pending
+//		calculations.
+//
+//		If this value is less than one (+1), an error
+//		will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it	contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	precisionBits				uint
+//
+//		Precision bits defines the number of bits in the
+//		mantissa of a big.Float numeric value. The number
+//		of precision bits controls the number of integer
+//		and fractional numeric digits that can be stored
+//		in an instance of big.Float.
+//
+//		If this method completes successfully, the value
+//		returned will represent the estimated number of
+//		precision bits required to store and process
+//		the number of numerical digits specified by input
+//		parameter, 'numNumericDigitsRequired'.
+//
+//		This estimate for precision bits has a margin of
+//		error of plus or minus sixteen bits (+ or - 16).
+//
+//		The value of 'precisionBits' returned by this
+//		method will always be a multiple of eight (+8).
+//
+//	err							error
+//
+//		If this method completes successfully, this
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathFloatHelper *MathFloatHelper) DigitsToPrecisionEstimate(
+	numNumericDigitsRequired int64,
+	errorPrefix interface{}) (
+	precisionBits uint,
+	err error) {
+
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
+	}
+
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathFloatHelper."+
+			"DigitsToPrecisionEstimate()",
+		"")
+
+	if err != nil {
+
+		return precisionBits, err
+	}
+
+	precisionBits,
+		err = new(mathFloatHelperPreon).
+		estimateDigitsToPrecision(
+			numNumericDigitsRequired,
+			ePrefix)
+
+	return precisionBits, err
+}
+
+//	PrecisionBitsFromRequiredDigits
+//
+//	Generates the number of precision bits in the
+//	mantissa of a big.Float number based on the
+//	number of numerical digits required to produce
+//	an accurate calculation result.
+//
+//	Be advised that the number of mantissa precision bits
+//	required to store a process an accurate numeric value
+//	includes both integer and fractional numeric digits.
+//
+// ----------------------------------------------------------------
+//
+//	# Input Parameters
+//
+//
+//	requiredIntegerDigits		int64
+//
+//		The number of integer digits required for the
+//		pending calculation.
+//
+//		If this parameter has a value less than zero,
+//		an error will be returned.
+//
+//		If the sum of parameters 'requiredIntegerDigits'
+//		and 'requiredFractionalDigits' is equal to zero,
+//		an error will be returned.
+//
+//	requiredFractionalDigits	int64
+//
+//		The number of fractional digits required to
+//		ensure accuracy for the pending calculation.
+//
+//		If this parameter has a value less than zero,
+//		an error will be returned.
+//
+//		If the sum of parameters 'requiredIntegerDigits'
+//		and 'requiredFractionalDigits' is equal to zero,
+//		an error will be returned.
+//
+//	requestedBufferDigits		int64
+//
+//		The number of extra numerical digits required to
+//		ensure accuracy for the pending calculation. It
+//		is generally a good idea to add space for extra
+//		numerical digits to accommodate rounding and/or
+//		complex numerical calculations.
+//
+//		If this parameter has a value less than zero,
+//		an error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it	contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	precisionBits				uint
+//
+//		If this method completes successfully, this
+//		parameter will return the number of precision
+//		bits required to store and accurately process
+//		the number of numerical digits identified by
+//		input parameters, 'requiredIntegerDigits',
+//		'requiredFractionalDigits' and
+//		'requestedBufferDigits'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (mathFloatHelper *MathFloatHelper) PrecisionBitsFromRequiredDigits(
+	requiredIntegerDigits,
+	requiredFractionalDigits,
+	requestedBufferDigits int64,
+	errorPrefix interface{}) (
+	precisionBits uint,
+	err error) {
+
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
+	}
+
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathFloatHelper."+
+			"PrecisionBitsFromRequiredDigits()",
+		"")
+
+	if err != nil {
+
+		return precisionBits, err
+	}
+
+	precisionBits,
+		err = new(mathFloatHelperAtom).precisionBitsFromRequiredDigits(
+		requiredIntegerDigits,
+		requiredFractionalDigits,
+		requestedBufferDigits,
+		ePrefix)
+
+	return precisionBits, err
+}
+
+//	PrecisionToDigitsEstimate
+//
+//	Computes an estimate of the number of numerical
+//	digits which can be stored given the number of
+//	precision bits configured for a type big.Float,
+//	floating point number.
+//
+//	Precision bits are used in the configuration of
+//	big.Float types. The conversion factor is:
+//		"3.3219789132197891321978913219789"
+//
+//		Precision Bits / Conversion Factor =
+//				Numeric Digit Capacity
+//			(margin of error +/- 3)
+//
+//	The number of numerical digits returned is an
+//	estimate with a margin of error of plus or minus
+//	three (+ or - 3) numeric digits.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	precisionBits				uint
+//
+//		The number of bits of precision in the mantissa
+//		of a big.Float floating point numeric value.
+//
+//		If this value is less than eight (+8), an error
+//		will be returned.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	totalNumOfNumericalDigits	int64
+//
+//		If this method completes successfully, the value
+//		returned will represent the estimated total
+//		number of numerical digits which can be stored
+//		in a big.Float floating point number mantissa
+//		configured for the number of Precision Bits
+//		specified by input parameter 'precisionBits'.
+//
+//		This estimate has a margin of error of plus or
+//		minus three (+ or - 3) numeric digits.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (mathFloatHelper *MathFloatHelper) PrecisionToDigitsEstimate(
+	precisionBits uint,
+	errorPrefix interface{}) (
+	totalNumOfNumericalDigits int64,
+	err error) {
+
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
+	}
+
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathFloatHelper."+
+			"PrecisionToDigitsEstimate()",
+		"")
+
+	if err != nil {
+
+		return totalNumOfNumericalDigits, err
+	}
+
+	totalNumOfNumericalDigits,
+		err = new(mathFloatHelperPreon).
+		estimatePrecisionToDigits(
+			precisionBits,
+			ePrefix)
+
+	return totalNumOfNumericalDigits, err
+}
+
+// PrecisionToDigitsFactor
+//
+// Returns an instance of *big.Float configured with the
+// "Precision To Digits" conversion factor.
+//
+// Precision bits are used in the configuration of
+// big.Float types. The conversion factor is
+// "3.3219789132197891321978913219789".
+//
+//		Precision Bits / Conversion Factor =
+//				Numeric Digit Capacity
+//			(margin of error +/- 3)
+//
+//	Conversely:
+//
+//		Conversion Factor  x  Numeric Digit Capacity =
+//				Precision Bits
+//			(margin of error +/- 16)
+//
+//	Precision, as used in connection with type big.Float,
+//	specifies the mantissa precision of a number in bits.
+//
+//	Also, remember that the number of numeric digits
+//	identified using this conversion factor includes
+//	both integer and fractional digits.
+//
+//	For information on 'precision bits' and their
+//	relevance to type big.Float, reference:
+//
+//	https://pkg.go.dev/math/big#Float
+//
+//	Bear in mind that this conversion factor may only be
+//	used to generate an estimate of numeric digits
+//	associated with a give precision bits value. This
+//	estimate may vary from the actual number of numeric
+//	digits. This estimate has a margin of error of plus
+//	or minus five (+ or - 3).
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	None
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	*big.Float
+//
+//		This method returns a pointer to an instance of
+//		big.Float configured with the conversion factor
+//		used to convert precision bits to the number of
+//		equivalent numeric digits.
+func (mathFloatHelper *MathFloatHelper) PrecisionToDigitsFactor() *big.Float {
+
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
+	}
+
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	return new(mathFloatHelperPreon).
+		precisionToDigitsFactor()
+}
+
+//	Ln
+//
+//	Computes the natural logarithm (base 'e') of a
+//	big.Float floating point number ('num') to the number
+//	of fractional digits specified by input parameter
+//	'requiredFractionalDigits'.
+//
+//		Examples:
+//			ln(1)		= 0
+//			ln(2.5)		= 0.91629073187415506518...
+//			ln(0.001)	= -6.90775527898213705205...
+//
+//	The result is returned as both a big.Float value and
+//	an instance of NumberStrKernel.
+//
+//	The returned result is guaranteed to be correctly
+//	rounded. The calculation is performed at a working
+//	precision which exceeds the required number of
+//	fractional digits. The calculation is then repeated
+//	at a higher precision in order to establish an error
+//	bound. If the error bound prevents the result from
+//	being rounded with certainty, the working precision
+//	is doubled and the calculation is repeated until the
+//	correctly rounded result is established.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	num							*big.Float
+//
+//		The number for which the natural logarithm will be
+//		computed.
+//
+//		If 'num' is a nil pointer, infinite, zero or
+//		negative, an error will be returned.
+//
+//	requiredFractionalDigits	int
+//
+//		The number of accurate fractional digits required
+//		in the calculation result. The result will be
+//		rounded to this number of fractional digits
+//		using the rounding algorithm specified by input
+//		parameter 'roundingType'.
+//
+//		The number of big.Float precision bits required
+//		to store the result is computed internally by
+//		adding the estimated number of integer digits in
+//		the result, 'requiredFractionalDigits' and a
+//		buffer of extra digits. The working precision is
+//		increased automatically until the correctly
+//		rounded result has been established.
+//
+//		If this value is less than zero, an error will be
+//		returned.
+//
+//	roundingType				NumberRoundingType
+//
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied
+//		to the calculation result.
+//
+//		Possible values are listed as follows:
+//
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
+//
+//		NumRoundType.None() and NumRoundType.NoRounding()
+//		are invalid. If either of these values is
+//		submitted, an error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	naturalLog					*big.Float
+//
+//		If this method completes successfully, this
+//		parameter will return the natural logarithm of 'num'
+//		rounded to 'requiredFractionalDigits'.
+//
+//	naturalLogNumStr			NumberStrKernel
+//
+//		If this method completes successfully, this
+//		parameter will return the natural logarithm of 'num'
+//		rounded to 'requiredFractionalDigits' as an
+//		instance of NumberStrKernel.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathFloatHelper *MathFloatHelper) Ln(
+	num *big.Float,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	errorPrefix interface{}) (
+	naturalLog *big.Float,
+	naturalLogNumStr NumberStrKernel,
+	err error) {
+
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
+	}
+
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathFloatHelper."+
+			"Ln()",
+		"")
+
+	if err != nil {
+		return naturalLog, naturalLogNumStr, err
+	}
+
+	floatHelperMolecule := mathFloatHelperMolecule{}
+
+	var exactNum *big.Rat
+
+	exactNum,
+		err = floatHelperMolecule.bigFloatToRat(
+		num,
+		"num",
+		ePrefix)
+
+	if err != nil {
+		return naturalLog, naturalLogNumStr, err
+	}
+
+	return floatHelperMolecule.ln(
+		exactNum,
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
+}
+
+//	LnNumStrKernel
+//
+//	Computes the natural logarithm (base 'e') of a
+//	NumberStrKernel numeric value ('num') to the number
+//	of fractional digits specified by input parameter
+//	'requiredFractionalDigits'.
+//
+//		Examples:
+//			ln(1)		= 0
+//			ln(2.5)		= 0.91629073187415506518...
+//			ln(0.001)	= -6.90775527898213705205...
+//
+//	The numeric value of 'num' is converted, without
+//	rounding, to the exactly equivalent big.Rat value
+//	before the calculation is performed. Decimal values
+//	such as 0.1 are therefore processed exactly and are
+//	not subject to binary floating point conversion
+//	errors.
+//
+//	The result is returned as both a big.Float value and
+//	an instance of NumberStrKernel.
+//
+//	The returned result is guaranteed to be correctly
+//	rounded. The calculation is performed at a working
+//	precision which exceeds the required number of
+//	fractional digits. The calculation is then repeated
+//	at a higher precision in order to establish an error
+//	bound. If the error bound prevents the result from
+//	being rounded with certainty, the working precision
+//	is doubled and the calculation is repeated until the
+//	correctly rounded result is established.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	num							*NumberStrKernel
+//
+//		The number for which the natural logarithm will be
+//		computed.
+//
+//		If 'num' is a nil pointer, zero or negative, an
+//		error will be returned.
+//
+//	requiredFractionalDigits	int
+//
+//		The number of accurate fractional digits required
+//		in the calculation result. The result will be
+//		rounded to this number of fractional digits
+//		using the rounding algorithm specified by input
+//		parameter 'roundingType'.
+//
+//		The number of big.Float precision bits required
+//		to store the result is computed internally by
+//		adding the estimated number of integer digits in
+//		the result, 'requiredFractionalDigits' and a
+//		buffer of extra digits. The working precision is
+//		increased automatically until the correctly
+//		rounded result has been established.
+//
+//		If this value is less than zero, an error will be
+//		returned.
+//
+//	roundingType				NumberRoundingType
+//
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied
+//		to the calculation result.
+//
+//		Possible values are listed as follows:
+//
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
+//
+//		NumRoundType.None() and NumRoundType.NoRounding()
+//		are invalid. If either of these values is
+//		submitted, an error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	naturalLog					*big.Float
+//
+//		If this method completes successfully, this
+//		parameter will return the natural logarithm of 'num'
+//		rounded to 'requiredFractionalDigits'.
+//
+//	naturalLogNumStr			NumberStrKernel
+//
+//		If this method completes successfully, this
+//		parameter will return the natural logarithm of 'num'
+//		rounded to 'requiredFractionalDigits' as an
+//		instance of NumberStrKernel.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathFloatHelper *MathFloatHelper) LnNumStrKernel(
+	num *NumberStrKernel,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	errorPrefix interface{}) (
+	naturalLog *big.Float,
+	naturalLogNumStr NumberStrKernel,
+	err error) {
+
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
+	}
+
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathFloatHelper."+
+			"LnNumStrKernel()",
+		"")
+
+	if err != nil {
+		return naturalLog, naturalLogNumStr, err
+	}
+
+	var exactNum *big.Rat
+
+	exactNum,
+		err = new(mathBigRatHelperQuark).numStrKernelToRat(
+		num,
+		ePrefix.XCpy(
+			"num"))
+
+	if err != nil {
+		return naturalLog, naturalLogNumStr, err
+	}
+
+	return new(mathFloatHelperMolecule).ln(
+		exactNum,
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
+}
+
+//	Log10
+//
+//	Computes the common logarithm (base 10) of a
+//	big.Float floating point number ('num') to the number
+//	of fractional digits specified by input parameter
+//	'requiredFractionalDigits'.
+//
+//		Examples:
+//			log10(1000)		= 3
+//			log10(0.001)	= -3
+//			log10(2.5)		= 0.39794000867203760957...
+//
+//	The result is returned as both a big.Float value and
+//	an instance of NumberStrKernel.
+//
+//	The returned result is guaranteed to be correctly
+//	rounded. The calculation is performed at a working
+//	precision which exceeds the required number of
+//	fractional digits. The calculation is then repeated
+//	at a higher precision in order to establish an error
+//	bound. If the error bound prevents the result from
+//	being rounded with certainty, the working precision
+//	is doubled and the calculation is repeated until the
+//	correctly rounded result is established.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	num							*big.Float
+//
+//		The number for which the common logarithm will be
+//		computed.
+//
+//		If 'num' is a nil pointer, infinite, zero or
+//		negative, an error will be returned.
+//
+//	requiredFractionalDigits	int
+//
+//		The number of accurate fractional digits required
+//		in the calculation result. The result will be
+//		rounded to this number of fractional digits
+//		using the rounding algorithm specified by input
+//		parameter 'roundingType'.
+//
+//		The number of big.Float precision bits required
+//		to store the result is computed internally by
+//		adding the estimated number of integer digits in
+//		the result, 'requiredFractionalDigits' and a
+//		buffer of extra digits. The working precision is
+//		increased automatically until the correctly
+//		rounded result has been established.
+//
+//		If this value is less than zero, an error will be
+//		returned.
+//
+//	roundingType				NumberRoundingType
+//
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied
+//		to the calculation result.
+//
+//		Possible values are listed as follows:
+//
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
+//
+//		NumRoundType.None() and NumRoundType.NoRounding()
+//		are invalid. If either of these values is
+//		submitted, an error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	commonLog					*big.Float
+//
+//		If this method completes successfully, this
+//		parameter will return the base 10 logarithm of 'num'
+//		rounded to 'requiredFractionalDigits'.
+//
+//	commonLogNumStr				NumberStrKernel
+//
+//		If this method completes successfully, this
+//		parameter will return the base 10 logarithm of 'num'
+//		rounded to 'requiredFractionalDigits' as an
+//		instance of NumberStrKernel.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathFloatHelper *MathFloatHelper) Log10(
+	num *big.Float,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	errorPrefix interface{}) (
+	commonLog *big.Float,
+	commonLogNumStr NumberStrKernel,
+	err error) {
+
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
+	}
+
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathFloatHelper."+
+			"Log10()",
+		"")
+
+	if err != nil {
+		return commonLog, commonLogNumStr, err
+	}
+
+	floatHelperMolecule := mathFloatHelperMolecule{}
+
+	var exactNum *big.Rat
+
+	exactNum,
+		err = floatHelperMolecule.bigFloatToRat(
+		num,
+		"num",
+		ePrefix)
+
+	if err != nil {
+		return commonLog, commonLogNumStr, err
+	}
+
+	return floatHelperMolecule.log10(
+		exactNum,
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
+}
+
+//	Log10NumStrKernel
+//
+//	Computes the common logarithm (base 10) of a
+//	NumberStrKernel numeric value ('num') to the number
+//	of fractional digits specified by input parameter
+//	'requiredFractionalDigits'.
+//
+//		Examples:
+//			log10(1000)		= 3
+//			log10(0.001)	= -3
+//			log10(2.5)		= 0.39794000867203760957...
+//
+//	The numeric value of 'num' is converted, without
+//	rounding, to the exactly equivalent big.Rat value
+//	before the calculation is performed. Decimal values
+//	such as 0.1 are therefore processed exactly and are
+//	not subject to binary floating point conversion
+//	errors.
+//
+//	The result is returned as both a big.Float value and
+//	an instance of NumberStrKernel.
+//
+//	The returned result is guaranteed to be correctly
+//	rounded. The calculation is performed at a working
+//	precision which exceeds the required number of
+//	fractional digits. The calculation is then repeated
+//	at a higher precision in order to establish an error
+//	bound. If the error bound prevents the result from
+//	being rounded with certainty, the working precision
+//	is doubled and the calculation is repeated until the
+//	correctly rounded result is established.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	num							*NumberStrKernel
+//
+//		The number for which the common logarithm will be
+//		computed.
+//
+//		If 'num' is a nil pointer, zero or negative, an
+//		error will be returned.
+//
+//	requiredFractionalDigits	int
+//
+//		The number of accurate fractional digits required
+//		in the calculation result. The result will be
+//		rounded to this number of fractional digits
+//		using the rounding algorithm specified by input
+//		parameter 'roundingType'.
+//
+//		The number of big.Float precision bits required
+//		to store the result is computed internally by
+//		adding the estimated number of integer digits in
+//		the result, 'requiredFractionalDigits' and a
+//		buffer of extra digits. The working precision is
+//		increased automatically until the correctly
+//		rounded result has been established.
+//
+//		If this value is less than zero, an error will be
+//		returned.
+//
+//	roundingType				NumberRoundingType
+//
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied
+//		to the calculation result.
+//
+//		Possible values are listed as follows:
+//
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
+//
+//		NumRoundType.None() and NumRoundType.NoRounding()
+//		are invalid. If either of these values is
+//		submitted, an error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	commonLog					*big.Float
+//
+//		If this method completes successfully, this
+//		parameter will return the base 10 logarithm of 'num'
+//		rounded to 'requiredFractionalDigits'.
+//
+//	commonLogNumStr				NumberStrKernel
+//
+//		If this method completes successfully, this
+//		parameter will return the base 10 logarithm of 'num'
+//		rounded to 'requiredFractionalDigits' as an
+//		instance of NumberStrKernel.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathFloatHelper *MathFloatHelper) Log10NumStrKernel(
+	num *NumberStrKernel,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	errorPrefix interface{}) (
+	commonLog *big.Float,
+	commonLogNumStr NumberStrKernel,
+	err error) {
+
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
+	}
+
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathFloatHelper."+
+			"Log10NumStrKernel()",
+		"")
+
+	if err != nil {
+		return commonLog, commonLogNumStr, err
+	}
+
+	var exactNum *big.Rat
+
+	exactNum,
+		err = new(mathBigRatHelperQuark).numStrKernelToRat(
+		num,
+		ePrefix.XCpy(
+			"num"))
+
+	if err != nil {
+		return commonLog, commonLogNumStr, err
+	}
+
+	return new(mathFloatHelperMolecule).log10(
+		exactNum,
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
+}
+
+// NativeNumStrToBigFloat
+//
+// Receives a Native Number String and converts that
+// string to a big.Float value.
+//
+// The term 'Native' applies in the sense that the number
+// string format is designed to interoperate with the
+// Golang programming language library functions and
+// packages. Types like 'strconv', 'strings', 'math' and
+// 'big' (big.Int, big.Float, big.Rat) routinely parse
+// and convert this type of number string to numeric
+// values. In addition, Native Number Strings are
+// frequently consumed by external library functions such
+// as this one (String Mechanics 'strmech') to convert
+// strings to numeric values and numeric values to
+// strings.
+//
+// While this format is inconsistent with many national
+// and cultural formatting conventions, number strings
+// which fail to implement this standardized formatting
+// protocol will generate errors in some Golang library
+// functions.
+//
+// The input parameter 'nativeNumStr' must be formatted
+// as a Native Number String in accordance with the
+// following criteria:
+//
+//  1. A Native Number String Consists of numeric
+//     character digits zero through nine inclusive
+//     (0-9).
+//
+//  2. A Native Number String will include a period
+//     or decimal point ('.') to separate integer and
+//     fractional digits within a number string.
+//
+//     Native Number String Floating Point Value:
+//     123.1234
+//
+//  3. A Native Number String will always format
+//     negative numeric values with a leading minus sign
+//     ('-').
+//
+//     Native Number String Negative Value:
+//     -123.2
+//
+//  4. A Native Number String WILL NEVER include integer
+//     separators such as commas (',') to separate
+//     integer digits by thousands.
+//
+//     NOT THIS: 1,000,000
+//     Native Number String: 1000000
+//
+//  5. Native Number Strings will only consist of:
+//
+//     (a)	Numeric digits zero through nine inclusive
+//     (0-9).
+//
+//     (b)	A decimal point ('.') for floating point
+//     numbers.
+//
+//     (c)	A leading minus sign ('-') in the case of
+//     negative numeric values.
+//
+//     If the input parameter 'nativeNumStr' does NOT meet
+//     these criteria, an error will be returned.
+//
+// ----------------------------------------------------------------
+//
+// # BE ADVISED
+//
+//	If the Native Number String ('nativeNumStr') fails to
+//	comply with Native Number String formatting
+//	requirements try the following method as a means of
+//	converting a 'dirty' number string to a valid Native
+//	Number String:
+//
+//			NumStrHelper.DirtyToNativeNumStr()
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	nativeNumStr				string
+//
+//		This string contains the Native Number String which
+//		will be parsed to produce and return a big.Float
+//		value.
+//
+//		If 'nativeNumStr' fails to meet the criteria for
+//		a Native Number String, an error will be
+//		returned.
+//
+//		A valid Native Number String must conform to the
+//		standardized formatting criteria defined below:
+//
+//	 	1. A Native Number String Consists of numeric
+//	 	   character digits zero through nine inclusive
+//	 	   (0-9).
+//
+//	 	2. A Native Number String will include a period
+//	 	   or decimal point ('.') to separate integer and
+//	 	   fractional digits within a number string.
+//
+//	 	   Native Number String Floating Point Value:
+//	 	   				123.1234
+//
+//	 	3. A Native Number String will always format
+//	 	   negative numeric values with a leading minus sign
+//	 	   ('-').
+//
+//	 	   Native Number String Negative Value:
+//	 	   				-123.2
+//
+//	 	4. A Native Number String WILL NEVER include integer
+//	 	   separators such as commas (',') to separate
+//	 	   integer digits by thousands.
+//
+//	 	   					NOT THIS: 1,000,000
+//	 	   		Native Number String: 1000000
+//
+//	 	5. Native Number Strings will only consist of:
+//
+//	 	   (a)	Numeric digits zero through nine inclusive (0-9).
+//
+//	 	   (b)	A decimal point ('.') for floating point
+//	 	   		numbers.
+//
+//	 	   (c)	A leading minus sign ('-') in the case of
+//	 	   		negative numeric values.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	big.Float
+//
+//		If this method completes successfully, the pure
+//		number string passed as input value 'pureNumStr'
+//		will be converted and returned as a big.Float
+//		floating point value.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (mathFloatHelper *MathFloatHelper) NativeNumStrToBigFloat(
+	nativeNumStr string,
+	errorPrefix interface{}) (
+	big.Float,
+	error) {
+
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
+	}
+
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathFloatHelper."+
+			"NativeNumStrToBigFloat()",
+		"")
+
+	if err != nil {
+		return big.Float{}, err
+	}
+
+	return new(mathFloatHelperBoson).
+		nativeNumStrToBigFloat(
+			nativeNumStr,
+			2,
+			0,
+			big.AwayFromZero,
+			ePrefix)
+}
+
+// NativeNumStrToBigFloatDto
+//
+// Receives a Native Number String containing a numeric
+// value which will be converted and returned as a
+// big.Float floating point value encapsulated within
+// an instance of BigFloatDto.
+//
+// The term 'Native' applies in the sense that the number
+// string format is designed to interoperate with the
+// Golang programming language library functions and
+// packages. Types like 'strconv', 'strings', 'math' and
+// 'big' (big.Int, big.Float, big.Rat) routinely parse
+// and convert this type of number string to numeric
+// values. In addition, Native Number Strings are
+// frequently consumed by external library functions such
+// as this one (String Mechanics 'strmech') to convert
+// strings to numeric values and numeric values to
+// strings.
+//
+// While this format is inconsistent with many national
+// and cultural formatting conventions, number strings
+// which fail to implement this standardized formatting
+// protocol will generate errors in some Golang library
+// functions.
+//
+// The input parameter 'nativeNumStr' must be formatted
+// as a Native Number String in accordance with the
+// following criteria:
+//
+//  1. A Native Number String Consists of numeric
+//     character digits zero through nine inclusive
+//     (0-9).
+//
+//  2. A Native Number String will include a period
+//     or decimal point ('.') to separate integer and
+//     fractional digits within a number string.
+//
+//     Native Number String Floating Point Value:
+//     123.1234
+//
+//  3. A Native Number String will always format
+//     negative numeric values with a leading minus sign
+//     ('-').
+//
+//     Native Number String Negative Value:
+//     -123.2
+//
+//  4. A Native Number String WILL NEVER include integer
+//     separators such as commas (',') to separate
+//     integer digits by thousands.
+//
+//     NOT THIS: 1,000,000
+//     Native Number String: 1000000
+//
+//  5. Native Number Strings will only consist of:
+//
+//     (a)	Numeric digits zero through nine inclusive
+//     (0-9).
+//
+//     (b)	A decimal point ('.') for floating point
+//     numbers.
+//
+//     (c)	A leading minus sign ('-') in the case of
+//     negative numeric values.
+//
+//     If the input parameter 'nativeNumStr' does NOT meet
+//     these criteria, an error will be returned.
+//
+// ----------------------------------------------------------------
+//
+// # BE ADVISED
+//
+//	If the Native Number String ('nativeNumStr') fails to
+//	comply with Native Number String formatting
+//	requirements try the following method as a means of
+//	converting a 'dirty' number string to a valid Native
+//	Number String:
+//
+//			NumStrHelper.DirtyToNativeNumStr()
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	nativeNumStr				string
+//
+//		This Native Number String contains the numeric
+//		character digits which will be analyzed and
+//		converted to a big.Float value in the returned
+//		instance of 'BigFloatDto'.
+//
+//		If 'nativeNumStr' fails to meet the criteria for
+//		a Native Number String, an error will be
+//		returned.
+//
+//		A valid Native Number String must conform to the
+//		standardized formatting criteria defined below:
+//
+//	 	1. A Native Number String Consists of numeric
+//	 	   character digits zero through nine inclusive
+//	 	   (0-9).
+//
+//	 	2. A Native Number String will include a period
+//	 	   or decimal point ('.') to separate integer and
+//	 	   fractional digits within a number string.
+//
+//	 	   Native Number String Floating Point Value:
+//	 	   				123.1234
+//
+//	 	3. A Native Number String will always format
+//	 	   negative numeric values with a leading minus sign
+//	 	   ('-').
+//
+//	 	   Native Number String Negative Value:
+//	 	   				-123.2
+//
+//	 	4. A Native Number String WILL NEVER include integer
+//	 	   separators such as commas (',') to separate
+//	 	   integer digits by thousands.
+//
+//	 	   					NOT THIS: 1,000,000
+//	 	   		Native Number String: 1000000
+//
+//	 	5. Native Number Strings will only consist of:
+//
+//	 	   (a)	Numeric digits zero through nine inclusive (0-9).
+//
+//	 	   (b)	A decimal point ('.') for floating point
+//	 	   		numbers.
+//
+//	 	   (c)	A leading minus sign ('-') in the case of
+//	 	   		negative numeric values.
+//
+//	numOfExtraDigitsBuffer		int64
+//
+//		When configuring the big.Float numeric value
+//		returned by the BigFloatDto instance, the number
+//		of big.Float precision bits will be calculated
+//		based on the number of integer and fractional
+//		numeric digits contained in the Native Number
+//		String ('nativeNumStr'). To deal with
+//		contingencies and requirements often found in
+//		complex floating point operations, users have
+//		the option to arbitrarily increase the number
+//		of precision bits by specifying additional
+//		numeric digits via parameter,
+//		'numOfExtraDigitsBuffer'.
+//
+//		Note: The user has the option of overriding the
+//		automatic precision bits calculation by specifying
+//		a precision bits value directly through parameter,
+//		'precisionBitsOverride'.
+//
+//	precisionBitsOverride		uint
+//
+//		The term 'precision bits' refers to the number of
+//		bits in the mantissa of a big.Float floating point
+//		number. Effectively, 'precision bits' controls the
+//		precision, accuracy and numerical digit storage
+//		capacity for a big.Float floating point number.
+//
+//		Typically, this method will automatically
+//		calculate the value of big.Float precision bits
+//		using the parameter 'numOfExtraDigitsBuffer'
+//		listed above. However, if 'precisionBitsOverride'
+//		has a value greater than zero, the automatic
+//		precision bit calculation will be overridden and
+//		big.Float precision bits will be set to the value
+//		of this	precision bits specification
+//		('precisionBitsOverride').
+//
+//	roundingMode 				big.RoundingMode
+//
+//		Specifies the rounding algorithm which will be used
+//		internally to calculate the base value raised to the
+//		power of exponent.
+//
+//		Each instance of big.Float is configured with a
+//		rounding mode. Input parameter 'roundingMode'
+//		controls this configuration for the calculation
+//		and the big.Float value returned by this method.
+//
+//		The constant values available for big.Float
+//		rounding mode are listed as follows:
+//
+//		big.ToNearestEven  		// == IEEE 754-2008 roundTiesToEven
+//		big.ToNearestAway       // == IEEE 754-2008 roundTiesToAway
+//		big.ToZero              // == IEEE 754-2008 roundTowardZero
+//		big.AwayFromZero        // no IEEE 754-2008 equivalent
+//		big.ToNegativeInf       // == IEEE 754-2008 roundTowardNegative
+//		big.ToPositiveInf       // == IEEE 754-2008 roundTowardPositive
+//
+//		If in doubt as this setting, 'big.AwayFromZero' or
+//		'big.ToNearestEven' are common selections for rounding mode.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	BigFloatDto
+//
+//		If this method completes successfully, a fully
+//		populated instance of BigFloatDto will be
+//		returned containing the big.Float value
+//		generated from the Native Number String parameter,
+//		'nativeNumStr'.
+//
+//		type BigFloatDto struct {
+//			Value big.Float
+//				The actual value of the big.Float instance.
+//
+//			NumStrComponents PureNumberStrComponents
+//				This parameter profiles the actual big.Float
+//				floating point numeric value identified by
+//				structure element 'Value'.
+//
+//				type PureNumberStrComponents struct {
+//
+//					NumStrStats NumberStrStatsDto
+//
+//						This data transfer object will return key
+//						statistics on the numeric value encapsulated
+//						by the current instance of NumberStrKernel.
+//
+//							type NumberStrStatsDto struct {
+//
+//								NumOfIntegerDigits					uint64
+//
+//									The total number of integer digits to the
+//									left of the radix point or, decimal point, in
+//									the subject numeric value.
+//
+//								NumOfSignificantIntegerDigits		uint64
+//
+//									The number of nonzero integer digits to the
+//									left of the radix point or, decimal point, in
+//									the subject numeric value.
+//
+//								NumOfFractionalDigits				uint64
+//
+//									The total number of fractional digits to the
+//									right of the radix point or, decimal point,
+//									in the subject numeric value.
+//
+//								NumOfSignificantFractionalDigits	uint64
+//
+//									The number of nonzero fractional digits to
+//									the right of the radix point or, decimal
+//									point, in the subject numeric value.
+//
+//								NumberValueType 					NumericValueType
+//
+//									This enumeration value specifies whether the
+//									subject numeric value is classified either as
+//									an integer or a floating point number.
+//
+//									Possible enumeration values are listed as
+//									follows:
+//										NumValType.None()
+//										NumValType.FloatingPoint()
+//										NumValType.Integer()
+//
+//								NumberSign							NumericSignValueType
+//
+//									An enumeration specifying the number sign
+//									associated with the numeric value. Possible
+//									values are listed as follows:
+//										NumSignVal.None()		= Invalid Value
+//										NumSignVal.Negative()	= -1
+//										NumSignVal.Zero()		=  0
+//										NumSignVal.Positive()	=  1
+//
+//								IsZeroValue							bool
+//
+//									If 'true', the subject numeric value is equal
+//									to zero ('0').
+//
+//									If 'false', the subject numeric value is
+//									greater than or less than zero ('0').
+//							}
+//
+//
+//
+//					AbsoluteValueNumStr string
+//					The number string expressed as an absolute value.
+//
+//					AbsoluteValAllIntegerDigitsNumStr string
+//					Integer and fractional digits are combined
+//					in a single number string without a decimal
+//					point separating integer and fractional digits.
+//					This string DOES NOT contain a leading number
+//					sign (a.k.a. minus sign ('-')
+//				}
+//
+//			EstimatedPrecisionBits BigFloatPrecisionDto
+//
+//			This structure stores the components and final
+//			results value for a precision bits calculation.
+//			The number of precision bits configured for a
+//			big.Float floating point numeric value determines
+//			the storage capacity for a specific floating
+//			point number. As such, the calculation of a
+//			correct and adequate precision bits value can
+//			affect the accuracy of floating point calculations.
+//
+//			type BigFloatPrecisionDto struct {
+//
+//					NumIntegerDigits			int64
+//
+//						The actual or estimated number of integer digits
+//						in a big.Float floating point numeric value. The
+//						number of integer digits in a floating point
+//						number is one of the elements used to calculate
+//						the precision bits required to store that
+//						floating point number.
+//
+//					NumFractionalDigits			int64
+//
+//						The actual or estimated number of fractional
+//						digits in a big.Float floating point numeric
+//						value. The number of fractional digits in a
+//						floating point number is one of the elements used
+//						to calculate the precision bits required to store
+//						that floating point number.
+//
+//					NumOfExtraDigitsBuffer		int64
+//
+//						When estimating the number of precision necessary
+//						to store or process big.Float floating point
+//						values, is generally a good idea to include a
+//						safety margin consisting of excess numeric digits.
+//
+//						This parameter stores the number of extra numeric
+//						digits used in a calculation of total require
+//						precision bits.
+//
+//					PrecisionBitsSpec uint
+//						This parameter represents the estimated number of
+//						bits required to store a specific floating point
+//						numeric value in an instance of type big.Float.
+//
+//						The 'PrecisionBitsSpec' value is usually generated
+//						by an internal calculation based on the estimated
+//						number of integer and fractional digits contained
+//						in a big.Float floating point number. However,
+//						users have the option to specify an arbitrary
+//						precision bits value.
+//			}
+//
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathFloatHelper *MathFloatHelper) NativeNumStrToBigFloatDto(
+	nativeNumStr string,
+	numOfExtraDigitsBuffer int64,
+	precisionBitsOverride uint,
+	roundingMode big.RoundingMode,
+	errorPrefix interface{}) (
+	BigFloatDto,
+	error) {
+
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
+	}
+
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathFloatHelper."+
+			"NativeNumStrToBigFloatDto()",
+		"")
+
+	if err != nil {
+		return BigFloatDto{}, err
+	}
+
+	return new(mathFloatHelperBoson).
+		bigFloatDtoFromPureNumStr(
+			nativeNumStr,
+			".",
+			true,
+			numOfExtraDigitsBuffer,
+			precisionBitsOverride,
+			roundingMode,
+			ePrefix)
+}
+
+// NativeNumStrToFloat64
+//
+// Receives a Native Number string and converts that
+// string to a float64 floating point value.
+//
+// The term 'Native' applies in the sense that the number
+// string format is designed to interoperate with the
+// Golang programming language library functions and
+// packages. Types like 'strconv', 'strings', 'math' and
+// 'big' (big.Int, big.Float, big.Rat) routinely parse
+// and convert this type of number string to numeric
+// values. In addition, Native Number Strings are
+// frequently consumed by external library functions such
+// as this one (String Mechanics 'strmech') to convert
+// strings to numeric values and numeric values to
+// strings.
+//
+// While this format is inconsistent with many national
+// and cultural formatting conventions, number strings
+// which fail to implement this standardized formatting
+// protocol will generate errors in some Golang library
+// functions.
+//
+// The input parameter 'nativeNumStr' must be formatted
+// as a Native Number String in accordance with the
+// following criteria:
+//
+//  1. A Native Number String Consists of numeric
+//     character digits zero through nine inclusive
+//     (0-9).
+//
+//  2. A Native Number String will include a period
+//     or decimal point ('.') to separate integer and
+//     fractional digits within a number string.
+//
+//     Native Number String Floating Point Value:
+//     123.1234
+//
+//  3. A Native Number String will always format
+//     negative numeric values with a leading minus sign
+//     ('-').
+//
+//     Native Number String Negative Value:
+//     -123.2
+//
+//  4. A Native Number String WILL NEVER include integer
+//     separators such as commas (',') to separate
+//     integer digits by thousands.
+//
+//     NOT THIS: 1,000,000
+//     Native Number String: 1000000
+//
+//  5. Native Number Strings will only consist of:
+//
+//     (a)	Numeric digits zero through nine inclusive
+//     (0-9).
+//
+//     (b)	A decimal point ('.') for floating point
+//     numbers.
+//
+//     (c)	A leading minus sign ('-') in the case of
+//     negative numeric values.
+//
+//     If the input parameter 'nativeNumStr' does NOT meet
+//     these criteria, an error will be returned.
+//
+// ----------------------------------------------------------------
+//
+// # BE ADVISED
+//
+//	If the Native Number String ('nativeNumStr') fails to
+//	comply with Native Number String formatting
+//	requirements try the following method as a means of
+//	converting a 'dirty' number string to a valid Native
+//	Number String:
+//
+//			NumStrHelper.DirtyToNativeNumStr()
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	nativeNumStr				string
+//
+//		This string contains the Native Number String which
+//		will be parsed to produce and return a big.Float
+//		value.
+//
+//		If 'nativeNumStr' fails to meet the criteria for
+//		a Native Number String, an error will be
+//		returned.
+//
+//		A valid Native Number String must conform to the
+//		standardized formatting criteria defined below:
+//
+//	 	1. A Native Number String Consists of numeric
+//	 	   character digits zero through nine inclusive
+//	 	   (0-9).
+//
+//	 	2. A Native Number String will include a period
+//	 	   or decimal point ('.') to separate integer and
+//	 	   fractional digits within a number string.
+//
+//	 	   Native Number String Floating Point Value:
+//	 	   				123.1234
+//
+//	 	3. A Native Number String will always format
+//	 	   negative numeric values with a leading minus sign
+//	 	   ('-').
+//
+//	 	   Native Number String Negative Value:
+//	 	   				-123.2
+//
+//	 	4. A Native Number String WILL NEVER include integer
+//	 	   separators such as commas (',') to separate
+//	 	   integer digits by thousands.
+//
+//	 	   					NOT THIS: 1,000,000
+//	 	   		Native Number String: 1000000
+//
+//	 	5. Native Number Strings will only consist of:
+//
+//	 	   (a)	Numeric digits zero through nine inclusive (0-9).
+//
+//	 	   (b)	A decimal point ('.') for floating point
+//	 	   		numbers.
+//
+//	 	   (c)	A leading minus sign ('-') in the case of
+//	 	   		negative numeric values.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	float64
+//
+//		If this method completes successfully, the pure
+//		number string passed as input value 'pureNumStr'
+//		will be converted and returned as a float64
+//		floating point value.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (mathFloatHelper *MathFloatHelper) NativeNumStrToFloat64(
+	nativeNumStr string,
+	errorPrefix interface{}) (
+	float64,
+	error) {
+
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
+	}
+
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	var err error
+
+	var float64Num float64
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathFloatHelper."+
+			"NativeNumStrToFloat64()",
+		"")
+
+	if err != nil {
+
+		return float64Num, err
+	}
+
+	return new(mathFloatHelperBoson).
+		pureNumStrToFloat64(
+			nativeNumStr,
+			ePrefix.XCpy(
+				"nativeNumStr"))
+}
+
+//	NthRoot
+//
+//	Computes the nth root of a big.Float floating point
+//	number ('radicand') to the number of fractional
+//	digits specified by input parameter
+//	'requiredFractionalDigits'.
+//
+//		Examples:
+//			Radicand: 27		nthRoot: 3		Result: 3
+//			Radicand: 2			nthRoot: 5		Result: 1.148698354997...
+//			Radicand: -32		nthRoot: 5		Result: -2
+//
+//	The result is returned as both a big.Float value and
+//	an instance of NumberStrKernel.
+//
+//	This method employs Newton's method to compute roots
+//	to an arbitrary number of significant digits. The
+//	number of big.Float precision bits required for the
+//	calculation is computed internally using the same
+//	algorithms employed by methods
+//	PrecisionBitsFromRequiredDigits() and
+//	DigitsToPrecisionEstimate().
+//
+// ----------------------------------------------------------------
+//
+//	# Input Parameters
+//
+//	radicand					*big.Float
+//
+//		The number from which the nth root will be
+//		extracted.
+//
+//		If 'radicand' is a nil pointer or infinite, an
+//		error will be returned.
+//
+//		If 'radicand' is negative and 'nthRoot' is an
+//		even number, an error will be returned.
+//
+//	nthRoot						int64
+//
+//		The root to be extracted from 'radicand'.
+//
+//		If 'nthRoot' is less than one (+1), an error will
+//		be returned.
+//
+//	requiredFractionalDigits	int
+//
+//		The number of accurate fractional digits required
+//		in the calculation result. The result will be
+//		rounded to this number of fractional digits
+//		using the rounding algorithm specified by input
+//		parameter 'roundingType'.
+//
+//		The number of big.Float precision bits required
+//		to store the result is computed internally by
+//		adding the estimated number of integer digits in
+//		the result, 'requiredFractionalDigits' and a
+//		buffer of extra digits. The calculation itself is
+//		performed at a higher working precision to ensure
+//		that all returned fractional digits are accurate.
+//
+//		If this value is less than zero, an error will be
+//		returned.
+//
+//	roundingType				NumberRoundingType
+//
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied
+//		to the calculation result.
+//
+//		Possible values are listed as follows:
+//
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
+//
+//		NumRoundType.None() and NumRoundType.NoRounding()
+//		are invalid. If either of these values is
+//		submitted, an error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	root						*big.Float
+//
+//		If this method completes successfully, this
+//		parameter will return the nth root of 'radicand'
+//		rounded to 'requiredFractionalDigits'.
+//
+//	rootNumStrKernel			NumberStrKernel
+//
+//		If this method completes successfully, this
+//		parameter will return the nth root of 'radicand'
+//		rounded to 'requiredFractionalDigits' as an
+//		instance of NumberStrKernel.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathFloatHelper *MathFloatHelper) NthRoot(
+	radicand *big.Float,
+	nthRoot int64,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	errorPrefix interface{}) (
+	root *big.Float,
+	rootNumStrKernel NumberStrKernel,
+	err error) {
+
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
+	}
+
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathFloatHelper."+
+			"NthRoot()",
+		"")
+
+	if err != nil {
+		return root, rootNumStrKernel, err
+	}
+
+	return new(mathFloatHelperNanobot).nthRoot(
+		radicand,
+		nthRoot,
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
+}
+
+//	Pow
+//
+//	Raises a big.Float floating point number ('base') to
+//	the power of a big.Float exponent ('exponent'). The
+//	result is computed to the number of fractional digits
+//	specified by input parameter
+//	'requiredFractionalDigits'.
+//
+//		Examples:
+//			2 ^ 10		= 1024
+//			2 ^ -2		= 0.25
+//			2 ^ 0.5		= 1.41421356237...
+//			-2 ^ 3		= -8
+//			10 ^ -1.5	= 0.03162277660...
+//
+//	Unlike methods RaiseToIntPositiveExponent() and
+//	RaiseToFloatPositiveExponent(), this method accepts
+//	negative exponents as well as exponents which are
+//	not integer values.
+//
+//	The result is returned as both a big.Float value and
+//	an instance of NumberStrKernel.
+//
+//	The number of big.Float precision bits required for
+//	the calculation is computed internally using the
+//	same algorithms employed by methods
+//	PrecisionBitsFromRequiredDigits() and
+//	DigitsToPrecisionEstimate().
+//
+// ----------------------------------------------------------------
+//
+//	# Algorithm
+//
+//	If 'exponent' is an integer value, the result is
+//	computed by repeated squaring and multiplication.
+//	Negative integer exponents are computed as the
+//	reciprocal of the corresponding positive power.
+//
+//	All other exponents are computed as:
+//
+//		base ^ exponent = e ^ (exponent * ln(base))
+//
+// ----------------------------------------------------------------
+//
+//	# Input Parameters
+//
+//	base						*big.Float
+//
+//		The number which will be raised to the power of
+//		'exponent'.
+//
+//		If 'base' is a nil pointer or infinite, an error
+//		will be returned.
+//
+//		If 'base' is negative and 'exponent' is not an
+//		integer value, an error will be returned because
+//		the result is not a real number.
+//
+//		If 'base' is zero and 'exponent' is negative, an
+//		error will be returned.
+//
+//	exponent					*big.Float
+//
+//		The power to which 'base' will be raised. This
+//		value may be positive or negative and may contain
+//		fractional digits.
+//
+//		If 'exponent' is a nil pointer or infinite, an
+//		error will be returned.
+//
+//		If 'exponent' is zero, the result is one (+1).
+//
+//		If the result would contain more than 1,000,000
+//		integer digits, an error will be returned.
+//
+//		If the magnitude of the result is too small to
+//		affect the required fractional digits, the result
+//		underflows to zero. With 'Ceiling' rounding, this
+//		positive result is rounded up to one unit in the
+//		last fractional digit.
+//
+//	requiredFractionalDigits	int
+//
+//		The number of accurate fractional digits required
+//		in the calculation result. The result will be
+//		rounded to this number of fractional digits
+//		using the rounding algorithm specified by input
+//		parameter 'roundingType'.
+//
+//		The number of big.Float precision bits required
+//		to store the result is computed internally by
+//		adding the estimated number of integer digits in
+//		the result, 'requiredFractionalDigits' and a
+//		buffer of extra digits. The calculation itself is
+//		performed at a higher working precision to ensure
+//		that all returned fractional digits are accurate.
+//
+//		If this value is less than zero, an error will be
+//		returned.
+//
+//	roundingType				NumberRoundingType
+//
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied
+//		to the calculation result.
+//
+//		Possible values are listed as follows:
+//
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
+//
+//		NumRoundType.None() and NumRoundType.NoRounding()
+//		are invalid. If either of these values is
+//		submitted, an error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	raisedToExponent			*big.Float
+//
+//		If this method completes successfully, this
+//		parameter will return 'base' raised to the power
+//		of 'exponent' and rounded to
+//		'requiredFractionalDigits'.
+//
+//	raisedToExponentNumStr		NumberStrKernel
+//
+//		If this method completes successfully, this
+//		parameter will return 'base' raised to the power
+//		of 'exponent' and rounded to
+//		'requiredFractionalDigits' as an instance of
+//		NumberStrKernel.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathFloatHelper *MathFloatHelper) Pow(
+	base *big.Float,
+	exponent *big.Float,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	errorPrefix interface{}) (
+	raisedToExponent *big.Float,
+	raisedToExponentNumStr NumberStrKernel,
+	err error) {
+
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
+	}
+
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathFloatHelper."+
+			"Pow()",
+		"")
+
+	if err != nil {
+		return raisedToExponent, raisedToExponentNumStr, err
+	}
+
+	return new(mathFloatHelperNanobot).pow(
+		base,
+		exponent,
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
+}
+
+//	PureNumStrToBigFloatDto
+//
+//	Receives a Pure Number String containing a numeric
+//	value which will be converted and returned as a
+//	big.Float floating point value.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	pureNumberStr				string
+//
+//		This Pure Number String contains the numeric
+//		character digits which will be analyzed and
+//		reported in the returned instance of
+//		'BigFloatDto'.
+//
+//		A "Pure Number String" is defined as follows:
+//
+//			1.	Consists of numeric character digits
+//				zero through nine inclusive (0-9).
+//
+//			2.	Optional: A Pure Number String may
+//				include a radix point or decimal
+//				separator. Decimal separators separate
+//				integer and fractional numeric digits in
+//				a Pure Number String. The decimal
+//				separator may consist of one or more text
+//				characters.
+//
+//			3.	Optional: A Pure Number String may
+//				include a negative number sign symbol
+//				consisting of a minus sign ('-'). The
+//				minus sign will identify the numeric
+//				value contained in the pure number string
+//				as a negative number. Only the minus sign
+//				('-') classifies a numeric value as a
+//				negative number in a Pure Number String.
+//
+//				If a leading or trailing minus sign ('-')
+//				is NOT present in the pure number string,
+//				the numeric value is assumed to be
+//				positive.
+//
+//			4.	Only numeric characters, the decimal
+//				separator and the minus sign will be
+//				processed by the Pure Number String
+//				parsing algorithm. All other characters
+//				will be	ignored.
+//
+//			5.	Pure Number Strings consist of a single
+//				numeric value. The entire Pure Number String
+//				will be parsed, or processed, and only one
+//				numeric value per Pure Number String will
+//				be returned.
+//
+//	decSeparatorChars			string
+//
+//		This string contains the character or characters
+//		which will be configured as the Decimal Separator
+//		Symbol.
+//
+//		The Decimal Separator is also known as the radix
+//		point and is used to separate integer and
+//		fractional digits within a formatted, floating
+//		point Number String.
+//
+//		In the US, UK, Australia, most of Canada and many
+//		other countries, the Decimal Separator is the
+//		period character ('.') known as the decimal
+//		point.
+//
+//		In France, Germany and many countries in the
+//		European Union, the Decimal Separator is the
+//		comma character (',').
+//
+//	leadingMinusSign			bool
+//
+//		In Pure Number Strings, a minus sign ('-')
+//		identifies a number as a negative numeric value.
+//
+//		When 'leadingMinusSign' is set to 'true', the
+//		Pure Number String parsing algorithm will search
+//		for a leading minus sign ('-') at the beginning of
+//		the number string. Leading minus signs represent
+//		the standard means for designating negative
+//		numeric values in the US, UK, Australia, most of
+//		Canada and many other parts of world.
+//
+//		Example Leading Minus Sign:
+//			"-123.456" or "- 123.456"
+//
+//		When 'leadingMinusSign' is set to 'false', the
+//		Pure Number String parsing algorithm will search
+//		for trailing minus signs ('-') located at the end
+//		of the number string. Trailing minus signs
+//		represent the standard for France, Germany and
+//		many countries in the European Union.
+//
+//		NOTE: Identification of a trailing minus sign in
+//		the Pure Number String input parameter,
+//		'pureNumberString', will immediately terminate
+//		the search for additional numeric characters.
+//
+//		Example Trailing Number Symbols:
+//			"123.456-" or "123.456 -"
+//
+//	numOfExtraDigitsBuffer		int64
+//
+//		When configuring the big.Float numeric value
+//		returned by the BigFloatDto instance,
+//		the number of big.Float precision bits will be
+//		calculated based on the number of integer and
+//		fractional numeric digits contained in the Pure
+//		Number String ('pureNumberStr'). To deal with
+//		contingencies and requirements often found in
+//		complex floating point operations, users have
+//		the option to arbitrarily increase the number
+//		of precision bits by specifying additional
+//		precision bits via parameter,
+//		'numOfExtraDigitsBuffer'.
+//
+//		Note: The user has the option of overriding the
+//		automatic precision bits calculation by specifying
+//		a precision bits value directly through parameter,
+//		'precisionBitsOverride'.
+//
+//		If in doubt as to this parameter value,
+//		specifying a margin of 2 digits is recommended.
+//
+//	precisionBitsOverride		uint
+//
+//		The term 'precision bits' refers to the number of
+//		bits in the mantissa of a big.Float floating point
+//		number. Effectively, 'precision bits' controls the
+//		precision, accuracy and numerical digit storage
+//		capacity for a big.Float floating point number.
+//
+//		Typically, this method will automatically
+//		calculate the value of big.Float precision bits
+//		using the parameter 'numOfExtraDigitsBuffer'
+//		listed above. However, if 'precisionBitsOverride'
+//		has a value greater than zero, the automatic
+//		precision bit calculation will be overridden and
+//		big.Float precision bits will be set to the value
+//		of this	precision bits specification
+//		('precisionBitsOverride').
+//
+//	roundingMode 				big.RoundingMode
+//
+//		Specifies the rounding algorithm which will be used
+//		internally to calculate the base value raised to the
+//		power of exponent.
+//
+//		Each instance of big.Float is configured with a
+//		rounding mode. Input parameter 'roundingMode'
+//		controls this configuration for the calculation
+//		and the big.Float value returned by this method.
+//
+//		The constant values available for big.Float
+//		rounding mode are listed as follows:
+//
+//		big.ToNearestEven  		// == IEEE 754-2008 roundTiesToEven
+//		big.ToNearestAway       // == IEEE 754-2008 roundTiesToAway
+//		big.ToZero              // == IEEE 754-2008 roundTowardZero
+//		big.AwayFromZero        // no IEEE 754-2008 equivalent
+//		big.ToNegativeInf       // == IEEE 754-2008 roundTowardNegative
+//		big.ToPositiveInf       // == IEEE 754-2008 roundTowardPositive
+//
+//		If in doubt as to this setting, 'big.ToNearestEven'
+//		is the default since its enumeration value is zero.
+//
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	BigFloatDto
+//
+//		If this method completes successfully, a fully
+//		populated instance of BigFloatDto will be
+//		returned containing the big.Float value
+//		generated from the Pure Number String parameter,
+//		'pureNumberStr'.
+//
+//		type BigFloatDto struct {
+//			Value big.Float
+//				The actual value of the big.Float instance.
+//
+//			NumStrComponents PureNumberStrComponents
+//				This parameter profiles the actual big.Float
+//				floating point numeric value identified by
+//				structure element 'Value'.
+//
+//				type PureNumberStrComponents struct {
+//
+//					NumStrStats NumberStrStatsDto
+//
+//						This data transfer object will return key
+//						statistics on the numeric value encapsulated
+//						by the current instance of NumberStrKernel.
+//
+//							type NumberStrStatsDto struct {
+//
+//								NumOfIntegerDigits					uint64
+//
+//									The total number of integer digits to the
+//									left of the radix point or, decimal point, in
+//									the subject numeric value.
+//
+//								NumOfSignificantIntegerDigits		uint64
+//
+//									The number of nonzero integer digits to the
+//									left of the radix point or, decimal point, in
+//									the subject numeric value.
+//
+//								NumOfFractionalDigits				uint64
+//
+//									The total number of fractional digits to the
+//									right of the radix point or, decimal point,
+//									in the subject numeric value.
+//
+//								NumOfSignificantFractionalDigits	uint64
+//
+//									The number of nonzero fractional digits to
+//									the right of the radix point or, decimal
+//									point, in the subject numeric value.
+//
+//								NumberValueType 					NumericValueType
+//
+//									This enumeration value specifies whether the
+//									subject numeric value is classified either as
+//									an integer or a floating point number.
+//
+//									Possible enumeration values are listed as
+//									follows:
+//										NumValType.None()
+//										NumValType.FloatingPoint()
+//										NumValType.Integer()
+//
+//								NumberSign							NumericSignValueType
+//
+//									An enumeration specifying the number sign
+//									associated with the numeric value. Possible
+//									values are listed as follows:
+//										NumSignVal.None()		= Invalid Value
+//										NumSignVal.Negative()	= -1
+//										NumSignVal.Zero()		=  0
+//										NumSignVal.Positive()	=  1
+//
+//								IsZeroValue							bool
+//
+//									If 'true', the subject numeric value is equal
+//									to zero ('0').
+//
+//									If 'false', the subject numeric value is
+//									greater than or less than zero ('0').
+//							}
+//
+//
+//
+//					AbsoluteValueNumStr string
+//					The number string expressed as an absolute value.
+//
+//					AbsoluteValAllIntegerDigitsNumStr string
+//					Integer and fractional digits are combined
+//					in a single number string without a decimal
+//					point separating integer and fractional digits.
+//					This string DOES NOT contain a leading number
+//					sign (a.k.a. minus sign ('-')
+//				}
+//
+//			EstimatedPrecisionBits BigFloatPrecisionDto
+//
+//			This structure stores the components and final
+//			results value for a precision bits calculation.
+//			The number of precision bits configured for a
+//			big.Float floating point numeric value determines
+//			the storage capacity for a specific floating
+//			point number. As such, the calculation of a
+//			correct and adequate precision bits value can
+//			affect the accuracy of floating point calculations.
+//
+//			type BigFloatPrecisionDto struct {
+//
+//					NumIntegerDigits			int64
+//
+//						The actual or estimated number of integer digits
+//						in a big.Float floating point numeric value. The
+//						number of integer digits in a floating point
+//						number is one of the elements used to calculate
+//						the precision bits required to store that
+//						floating point number.
+//
+//					NumFractionalDigits			int64
+//
+//						The actual or estimated number of fractional
+//						digits in a big.Float floating point numeric
+//						value. The number of fractional digits in a
+//						floating point number is one of the elements used
+//						to calculate the precision bits required to store
+//						that floating point number.
+//
+//					NumOfExtraDigitsBuffer		int64
+//
+//						When estimating the number of precision necessary
+//						to store or process big.Float floating point
+//						values, is generally a good idea to include a
+//						safety margin consisting of excess numeric digits.
+//
+//						This parameter stores the number of extra numeric
+//						digits used in a calculation of total require
+//						precision bits.
+//
+//					PrecisionBitsSpec uint
+//						This parameter represents the estimated number of
+//						bits required to store a specific floating point
+//						numeric value in an instance of type big.Float.
+//
+//						The 'PrecisionBitsSpec' value is usually generated
+//						by an internal calculation based on the estimated
+//						number of integer and fractional digits contained
+//						in a big.Float floating point number. However,
+//						users have the option to specify an arbitrary
+//						precision bits value.
+//			}
+//
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathFloatHelper *MathFloatHelper) PureNumStrToBigFloatDto(
+	pureNumberStr string,
+	decSeparatorChars string,
+	leadingMinusSign bool,
+	numOfExtraDigitsBuffer int64,
+	precisionBitsOverride uint,
+	roundingMode big.RoundingMode,
+	errorPrefix interface{}) (
+	BigFloatDto,
+	error) {
+
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
+	}
+
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathFloatHelper."+
+			"PureNumStrToBigFloatDto()",
+		"")
+
+	if err != nil {
+		return BigFloatDto{}, err
+	}
+
+	return new(mathFloatHelperBoson).
+		bigFloatDtoFromPureNumStr(
+			pureNumberStr,
+			decSeparatorChars,
+			leadingMinusSign,
+			numOfExtraDigitsBuffer,
+			precisionBitsOverride,
+			roundingMode,
+			ePrefix)
+}
+
+//	RaiseToFloatPositiveExponent
+//
+//	Receives a pointer to a big.Float floating point
+//	number and raises that number to the power specified
+//	by input parameter 'exponent'.
+//
+//		Example:	3.2 ^ 4 = 104.8576
+//					base ^ exponent = raisedToExponent
+//
+//	This method will only process positive exponents.
+//
+//	This method employs floating point mathematics and
+//	type big.Float to compute the base floating point
+//	value raised to the power of exponent.
+//
+// ----------------------------------------------------------------
+//
+//	# Input Parameters
+//
+//	base						*big.Float
+//
+//		This floating point value will be raised to the
+//		power of 'exponent' and returned to the calling
+//		function.
+//
+//	exponent					int64
+//
+//		This value will be used to raise 'base' to the
+//		power of 'exponent'.
+//
+//		Example:	3.2 ^ 4 = 104.8576
+//					base ^ exponent = raisedToExponent
+//
+//		If this value is less than zero, an error will be
+//		returned.
+//
+//	numOfExtraDigitsBuffer		int64
+//
+//		The term 'precision bits' refers to the number of
+//		bits in the mantissa of a big.Float floating
+//		point number. Effectively, 'precision bits'
+//		controls the precision, accuracy and numerical
+//		digit storage capacity for a big.Float floating
+//		point number
+//
+//		When configuring the big.Float numeric value
+//		returned by this method, the number of big.Float
+//		precision bits will be calculated based on the
+//		estimated number of integer and fractional
+//		numeric digits contained in the base floating
+//		point value ('base'). To deal with contingencies
+//		and requirements often found in complex floating
+//		point operations, users have the option to
+//		arbitrarily increase the number of precision bits
+//		by specifying additional numeric digits via
+//		parameter, 'numOfExtraDigitsBuffer'.
+//
+//		The automatic precision bits calculation will add
+//		the number of integer digits, fractional digits and
+//		'numOfExtraDigitsBuffer' to compute the estimated
+//		number of precision bits. As such this parameter
+//		provides of safety in terms of ensuring accurate
+//		calculation results.
+//
+//		The greater the value of this parameter, the
+//		greater the number of accurate significant digits
+//		produced in the calculation result.
+//
+//		Note: The user has the option of overriding the
+//		automatic precision bits calculation by specifying
+//		a precision bits value directly through parameter,
+//		'precisionBitsOverride'.
+//
+//	precisionBitsOverride		uint
+//
+//		The term 'precision bits' refers to the number of
+//		bits in the mantissa of a big.Float floating point
+//		number. Effectively, 'precision bits' controls the
+//		precision, accuracy and numerical digit storage
+//		capacity for a big.Float floating point number.
+//
+//		Typically, this method will automatically
+//		calculate the value of big.Float precision bits
+//		using the parameter 'numOfExtraDigitsBuffer'
+//		listed above. However, if 'precisionBitsOverride'
+//		has a value greater than zero, the automatic
+//		precision bit calculation will be overridden and
+//		big.Float precision bits will be set to the value
+//		of this	precision bits specification
+//		('precisionBitsOverride').
+//
+//	roundingMode 				big.RoundingMode
+//
+//		Specifies the rounding algorithm which will be used
+//		internally to calculate the base value raised to the
+//		power of exponent.
+//
+//		Each instance of big.Float is configured with a
+//		rounding mode. Input parameter 'roundingMode'
+//		controls this configuration for the calculation
+//		and the big.Float value returned by this method.
+//
+//		The constant values available for big.Float
+//		rounding mode are listed as follows:
+//
+//		big.ToNearestEven  		// == IEEE 754-2008 roundTiesToEven
+//		big.ToNearestAway       // == IEEE 754-2008 roundTiesToAway
+//		big.ToZero              // == IEEE 754-2008 roundTowardZero
+//		big.AwayFromZero        // no IEEE 754-2008 equivalent
+//		big.ToNegativeInf       // == IEEE 754-2008 roundTowardNegative
+//		big.ToPositiveInf       // == IEEE 754-2008 roundTowardPositive
+//
+//		If in doubt as this setting, 'big.AwayFromZero' or
+//		'big.ToNearestEven' are common selections for rounding mode.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it	contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	*big.Float
+//
+//		If this method completes successfully, this
+//		parameter will return 'base' value raised to the
+//		power of the 'exponent' value.
+//
+//		Example:	3.2 ^ 4 = 104.8576
+//					base ^ exponent = raisedToExponent
+//
+//	error
+//
+//		If this method completes successfully, this
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathFloatHelper *MathFloatHelper) RaiseToFloatPositiveExponent(
+	base *big.Float,
+	exponent int64,
+	numOfExtraDigitsBuffer int64,
+	precisionBitsOverride uint,
+	roundingMode big.RoundingMode,
+	errorPrefix interface{}) (
+	*big.Float,
+	error) {
+
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
+	}
+
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathFloatHelper."+
+			"RaiseToFloatPositiveExponent()",
+		"")
+
 	if err != nil {
+		return big.NewFloat(0), err
+	}
+
+	return new(mathFloatHelperMechanics).
+		raiseToFloatExponentConfig(
+			base,
+			exponent,
+			numOfExtraDigitsBuffer,
+			precisionBitsOverride,
+			roundingMode,
+			ePrefix)
+}
+
+//	RaiseToIntPositiveExponent
+//
+//	Receives a pointer to a big.Float floating point
+//	number and raises that number to the power specified
+//	by input parameter 'exponent'.
+//
+//		Example:	3.2 ^ 4 = 104.8576
+//					base ^ exponent = raisedToExponent
+//
+//	The floating point precision value required to
+//	support the calculation result ('raisedToExponent')
+//	is computed internally.
+//
+//	This method will only process positive exponents.
+//
+//	This method employs integer mathematics and type
+//	big.Int to compute the base floating point value
+//	raised to the power of an exponent. As such it
+//	produces highly accurate results.
+//
+// ----------------------------------------------------------------
+//
+//	# Input Parameters
+//
+//	base						*big.Float
+//
+//		This floating point value will be raised to the
+//		power of 'exponent' and returned to the calling
+//		function.
+//
+//	exponent					int64
+//
+//		This value will be used to raise 'base' to the
+//		power of 'exponent'.
+//
+//		Example:	3.2 ^ 4 = 104.8576
+//					base ^ exponent = raisedToExponent
+//
+//		If this value is less than zero, an error will be
+//		returned.
+//
+//	numOfExtraDigitsBuffer		int64
+//
+//		The term 'precision bits' refers to the number of
+//		bits in the mantissa of a big.Float floating
+//		point number. Effectively, 'precision bits'
+//		controls the precision, accuracy and numerical
+//		digit storage capacity for a big.Float floating
+//		point number.
+//
+//		When configuring the big.Float numeric value
+//		returned by this method, the number of big.Float
+//		precision bits will be automatically calculated
+//		based on the number of integer and fractional
+//		numeric digits contained in the base floating
+//		point value ('base'). To deal with contingencies
+//		and requirements often found in complex floating
+//		point operations, users have the option to
+//		arbitrarily increase the number of automatically
+//		calculated precision bits by specifying additional
+//		numeric digits via parameter,
+//		'numOfExtraDigitsBuffer'.
+//
+//		The automatic precision bits calculation will add
+//		the number of integer digits, fractional digits and
+//		'numOfExtraDigitsBuffer' to compute the estimated
+//		number of precision bits.
+//
+//		The greater the value of this parameter, the
+//		greater the number of accurate significant digits
+//		produced in the calculation result.
+//
+//		Note: The user has the option of overriding the
+//		automatic precision bits calculation by specifying
+//		a precision bits value directly through parameter,
+//		'precisionBitsOverride'.
+//
+//	precisionBitsOverride		uint
+//
+//		The term 'precision bits' refers to the number of
+//		bits in the mantissa of a big.Float floating point
+//		number. Effectively, 'precision bits' controls the
+//		precision, accuracy and numerical digit storage
+//		capacity for a big.Float floating point number.
+//
+//		Typically, this method will automatically
+//		calculate the value of big.Float precision bits
+//		using the parameter 'numOfExtraDigitsBuffer'
+//		listed above. However, if 'precisionBitsOverride'
+//		has a value greater than zero, the automatic
+//		precision bit calculation will be overridden and
+//		big.Float precision bits will be set to the value
+//		of this	precision bits specification
+//		('precisionBitsOverride').
+//
+//		If in doubt as to this number, identify the
+//		total number of integer and fractional digits
+//		required to store an accurate result and
+//		multiply this number times four (+4) to generate
+//		an estimate of precision bits required.
+//
+//	roundingMode 				big.RoundingMode
+//
+//		Specifies the rounding algorithm which will be used
+//		internally to calculate the base value raised to the
+//		power of exponent.
+//
+//		Each instance of big.Float is configured with a
+//		rounding mode. Input parameter 'roundingMode'
+//		controls this configuration for the calculation
+//		and the big.Float value returned by this method.
+//
+//		The constant values available for big.Float
+//		rounding mode are listed as follows:
+//
+//		big.ToNearestEven  		// == IEEE 754-2008 roundTiesToEven
+//		big.ToNearestAway       // == IEEE 754-2008 roundTiesToAway
+//		big.ToZero              // == IEEE 754-2008 roundTowardZero
+//		big.AwayFromZero        // no IEEE 754-2008 equivalent
+//		big.ToNegativeInf       // == IEEE 754-2008 roundTowardNegative
+//		big.ToPositiveInf       // == IEEE 754-2008 roundTowardPositive
+//
+//		If in doubt as this setting, 'big.AwayFromZero' is a
+//		common selection for rounding mode.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	*big.Float
+//
+//		If this method completes successfully, this
+//		parameter will return 'base' value raised to the
+//		power of the 'exponent' value.
+//
+//		Example:	3.2 ^ 4 = 104.8576
+//					base ^ exponent = raisedToExponent
+//
+//	error
+//
+//		If this method completes successfully, this
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathFloatHelper *MathFloatHelper) RaiseToIntPositiveExponent(
+	base *big.Float,
+	exponent int64,
+	numOfExtraDigitsBuffer int64,
+	precisionBitsOverride uint,
+	roundingMode big.RoundingMode,
+	errorPrefix interface{}) (
+	raisedToExponent *big.Float,
+	err error) {
 
-		return float64Num, err
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
 	}
 
-	return new(mathFloatHelperBoson).
-		pureNumStrToFloat64(
-			nativeNumStr,
-			ePrefix.XCpy(
-				"nativeNumStr"))
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathFloatHelper."+
+			"RaiseToIntPositiveExponent()",
+		"")
+
+	if err != nil {
+		return big.NewFloat(0), err
+	}
+
+	return new(mathFloatHelperQuark).
+		raiseToIntPositiveExponent(
+			base,
+			exponent,
+			numOfExtraDigitsBuffer,
+			precisionBitsOverride,
+			roundingMode,
+			ePrefix)
 }
 
-//	PureNumStrToBigFloatDto
+//	RoundBigFloat
+//
+//	This method will round a big.Float numeric value
+//	based on rounding specifications passed by input
+//	parameters, 'roundingType' and
+//	'roundToFractionalDigits'.
+//
+//	The big.Float numeric value to be rounded is passed
+//	by input parameter, 'numberToRound'. The final
+//	rounded value is returned through input parameter,
+//	'roundedNumber'.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numberToRound				*big.Float
+//
+//		A pointer to an instance of big.Float. This is
+//		the numeric value which will be rounded.
+//
+//
+//	roundedNumber				*big.Float
+//
+//		A pointer to an instance of big.Float. The result
+//		of the rounding operation performed on input
+//		parameter,'numberToRound', will be stored here.
+//
+//	roundingType				NumberRoundingType
+//
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied for
+//		the	rounding of fractional digits contained in the
+//		current instance of NumberStrKernel.
+//
+//		If in doubt as to a suitable rounding method,
+//		'HalfAwayFromZero' is recommended.
+//
+//		Possible values are listed as follows:
+//			NumRoundType.None()	- Invalid Value
+//			NumRoundType.NoRounding()
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
+//
+//		Definitions:
+//
+//			NoRounding
+//
+//				Signals that no rounding operation will be
+//				performed on fractional digits. The
+//				fractional digits will therefore remain
+//				unchanged.
+//
+//			HalfUpWithNegNums
+//
+//				Half Round Up Including Negative Numbers.
+//				This method is intuitive but may produce
+//				unexpected results when applied to negative
+//				numbers.
+//
+//				'HalfUpWithNegNums' rounds .5 up.
+//
+//					Examples of 'HalfUpWithNegNums'
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8
+//					7.4 rounds down to 7
+//					-7.4 rounds up to -7
+//					-7.5 rounds up to -7
+//					-7.6 rounds down to -8
+//
+//			HalfDownWithNegNums
+//
+//			Half Round Down Including Negative Numbers. This
+//			method is also considered intuitive but may
+//			produce unexpected results when applied to
+//			negative numbers.
+//
+//			'HalfDownWithNegNums' rounds .5 down.
+//
+//				Examples of HalfDownWithNegNums
+//
+//				7.6 rounds up to 8
+//				7.5 rounds down to 7
+//				7.4 rounds down to 7
+//				-7.4 rounds up to -7
+//				-7.5 rounds down to -8
+//				-7.6 rounds down to -8
+//
+//			HalfAwayFromZero
+//
+//				The 'HalfAwayFromZero' method rounds .5 further
+//				away from zero.	It provides clear and consistent
+//				behavior when dealing with negative numbers.
+//
+//					Examples of HalfAwayFromZero
+//
+//					7.6 rounds away to 8
+//					7.5 rounds away to 8
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds away to -8
+//					-7.6 rounds away to -8
+//
+//			HalfTowardsZero
+//
+//				Round Half Towards Zero. 'HalfTowardsZero' rounds
+//				0.5	closer to zero. It provides clear and
+//				consistent behavior	when dealing with negative
+//				numbers.
+//
+//					Examples of HalfTowardsZero
+//
+//					7.6 rounds away to 8
+//					7.5 rounds to 7
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds to -7
+//					-7.6 rounds away to -8
+//
+//			HalfToEven
+//
+//				Round Half To Even Numbers. 'HalfToEven' is
+//				also called	Banker's Rounding. This method
+//				rounds 0.5 to the nearest even digit.
+//
+//					Examples of HalfToEven
+//
+//					7.5 rounds up to 8 (because 8 is an even
+//					number)	but 6.5 rounds down to 6 (because
+//					6 is an even number)
+//
+//					HalfToEven only applies to 0.5. Other
+//					numbers (not ending	in 0.5) round to
+//					nearest as usual, so:
 //
-//	Receives a Pure Number String containing a numeric
-//	value which will be converted and returned as a
-//	big.Float floating point value.
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8 (because 8 is an even number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds down to 6 (because 6 is an even number)
+//					6.4 rounds down to 6
 //
-// ----------------------------------------------------------------
+//			HalfToOdd
 //
-// # Input Parameters
+//				Round Half to Odd Numbers. Similar to 'HalfToEven',
+//				but in this case 'HalfToOdd' rounds 0.5 towards odd
+//				numbers.
 //
-//	pureNumberStr				string
+//					Examples of HalfToOdd
 //
-//		This Pure Number String contains the numeric
-//		character digits which will be analyzed and
-//		reported in the returned instance of
-//		'BigFloatDto'.
+//					HalfToOdd only applies to 0.5. Other numbers
+//					(not ending	in 0.5) round to nearest as usual.
 //
-//		A "Pure Number String" is defined as follows:
+//					7.5 rounds down to 7 (because 7 is an odd number)
 //
-//			1.	Consists of numeric character digits
-//				zero through nine inclusive (0-9).
+//					6.5 rounds up to 7 (because 7 is an odd number)
 //
-//			2.	Optional: A Pure Number String may
-//				include a radix point or decimal
-//				separator. Decimal separators separate
-//				integer and fractional numeric digits in
-//				a Pure Number String. The decimal
-//				separator may consist of one or more text
-//				characters.
+//					7.6 rounds up to 8
+//					7.5 rounds down to 7 (because 7 is an odd number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds up to 7 (because 7 is an odd number)
+//					6.4 rounds down to 6
 //
-//			3.	Optional: A Pure Number String may
-//				include a negative number sign symbol
-//				consisting of a minus sign ('-'). The
-//				minus sign will identify the numeric
-//				value contained in the pure number string
-//				as a negative number. Only the minus sign
-//				('-') classifies a numeric value as a
-//				negative number in a Pure Number String.
+//			Randomly
 //
-//				If a leading or trailing minus sign ('-')
-//				is NOT present in the pure number string,
-//				the numeric value is assumed to be
-//				positive.
+//				Round Half Randomly. Uses a Random Number Generator
+//				to choose between rounding 0.5 up or down.
 //
-//			4.	Only numeric characters, the decimal
-//				separator and the minus sign will be
-//				processed by the Pure Number String
-//				parsing algorithm. All other characters
-//				will be	ignored.
+//				All numbers other than 0.5 round to the nearest as
+//				usual.
 //
-//			5.	Pure Number Strings consist of a single
-//				numeric value. The entire Pure Number String
-//				will be parsed, or processed, and only one
-//				numeric value per Pure Number String will
-//				be returned.
+//			Floor
 //
-//	decSeparatorChars			string
+//				Yields the nearest integer down. Floor does not apply
+//				any	special treatment to 0.5.
 //
-//		This string contains the character or characters
-//		which will be configured as the Decimal Separator
-//		Symbol.
+//				Floor Function: The greatest integer that is less than
+//				or equal to x
 //
-//		The Decimal Separator is also known as the radix
-//		point and is used to separate integer and
-//		fractional digits within a formatted, floating
-//		point Number String.
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
 //
-//		In the US, UK, Australia, most of Canada and many
-//		other countries, the Decimal Separator is the
-//		period character ('.') known as the decimal
-//		point.
+//				In mathematics and computer science, the floor function
+//				is the function that takes as input a real number x,
+//				and gives as output the greatest integer less than or
+//				equal to x,	denoted floor(x) or ⌊x⌋.
 //
-//		In France, Germany and many countries in the
-//		European Union, the Decimal Separator is the
-//		comma character (',').
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
 //
-//	leadingMinusSign			bool
+//				Examples of Floor
 //
-//		In Pure Number Strings, a minus sign ('-')
-//		identifies a number as a negative numeric value.
+//					Number     Floor
+//					 2           2
+//					 2.4         2
+//					 2.9         2
+//					-2.5        -3
+//					-2.7        -3
+//					-2          -2
 //
-//		When 'leadingMinusSign' is set to 'true', the
-//		Pure Number String parsing algorithm will search
-//		for a leading minus sign ('-') at the beginning of
-//		the number string. Leading minus signs represent
-//		the standard means for designating negative
-//		numeric values in the US, UK, Australia, most of
-//		Canada and many other parts of world.
+//			Ceiling
 //
-//		Example Leading Minus Sign:
-//			"-123.456" or "- 123.456"
+//				Yields the nearest integer up. Ceiling does not
+//				apply any special treatment to 0.5.
 //
-//		When 'leadingMinusSign' is set to 'false', the
-//		Pure Number String parsing algorithm will search
-//		for trailing minus signs ('-') located at the end
-//		of the number string. Trailing minus signs
-//		represent the standard for France, Germany and
-//		many countries in the European Union.
+//				Ceiling Function: The least integer that is
+//				greater than or	equal to x.
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
 //
-//		NOTE: Identification of a trailing minus sign in
-//		the Pure Number String input parameter,
-//		'pureNumberString', will immediately terminate
-//		the search for additional numeric characters.
+//				The ceiling function maps x to the least integer
+//				greater than or equal to x, denoted ceil(x) or
+//				⌈x⌉.[1]
 //
-//		Example Trailing Number Symbols:
-//			"123.456-" or "123.456 -"
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
 //
-//	numOfExtraDigitsBuffer		int64
+//					Examples of Ceiling
 //
-//		When configuring the big.Float numeric value
-//		returned by the BigFloatDto instance,
-//		the number of big.Float precision bits will be
-//		calculated based on the number of integer and
-//		fractional numeric digits contained in the Pure
-//		Number String ('pureNumberStr'). To deal with
-//		contingencies and requirements often found in
-//		complex floating point operations, users have
-//		the option to arbitrarily increase the number
-//		of precision bits by specifying additional
-//		precision bits via parameter,
-//		'numOfExtraDigitsBuffer'.
+//						Number    Ceiling
+//						 2           2
+//						 2.4         3
+//						 2.9         3
+//						-2.5        -2
+//						-2.7        -2
+//						-2          -2
 //
-//		Note: The user has the option of overriding the
-//		automatic precision bits calculation by specifying
-//		a precision bits value directly through parameter,
-//		'precisionBitsOverride'.
+//			Truncate
 //
-//		If in doubt as to this parameter value,
-//		specifying a margin of 2 digits is recommended.
+//				Apply NO Rounding whatsoever. The Round From Digit
+//				is dropped or deleted. The Round To Digit is NEVER
+//				changed.
 //
-//	precisionBitsOverride		uint
+//				Examples of Truncate
 //
-//		The term 'precision bits' refers to the number of
-//		bits in the mantissa of a big.Float floating point
-//		number. Effectively, 'precision bits' controls the
-//		precision, accuracy and numerical digit storage
-//		capacity for a big.Float floating point number.
+//					Example-1
+//					Number: 23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:   23.14 - The Round From Digit
+//					is dropped.
 //
-//		Typically, this method will automatically
-//		calculate the value of big.Float precision bits
-//		using the parameter 'numOfExtraDigitsBuffer'
-//		listed above. However, if 'precisionBitsOverride'
-//		has a value greater than zero, the automatic
-//		precision bit calculation will be overridden and
-//		big.Float precision bits will be set to the value
-//		of this	precision bits specification
-//		('precisionBitsOverride').
+//					Example-2
+//					Number: -23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:  -23.14 - The Round From Digit
+//					is dropped.
 //
-//	roundingMode 				big.RoundingMode
+//	roundToFractionalDigits		int
 //
-//		Specifies the rounding algorithm which will be used
-//		internally to calculate the base value raised to the
-//		power of exponent.
+//		When set to a positive integer value, this parameter
+//		controls the number of digits to the right of the radix
+//		point or decimal separator (a.k.a. decimal point). This
+//		controls the number of fractional digits remaining after
+//		completion of the number rounding operation.
 //
-//		Each instance of big.Float is configured with a
-//		rounding mode. Input parameter 'roundingMode'
-//		controls this configuration for the calculation
-//		and the big.Float value returned by this method.
+//		For the purposes of this method, any
+//		'roundToFractionalDigits' value greater than 20,000 will
+//		trigger an error return.
 //
-//		The constant values available for big.Float
-//		rounding mode are listed as follows:
+//	errorPrefix					interface{}
 //
-//		big.ToNearestEven  		// == IEEE 754-2008 roundTiesToEven
-//		big.ToNearestAway       // == IEEE 754-2008 roundTiesToAway
-//		big.ToZero              // == IEEE 754-2008 roundTowardZero
-//		big.AwayFromZero        // no IEEE 754-2008 equivalent
-//		big.ToNegativeInf       // == IEEE 754-2008 roundTowardNegative
-//		big.ToPositiveInf       // == IEEE 754-2008 roundTowardPositive
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it	contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//		If in doubt as to this setting, 'big.ToNearestEven'
-//		is the default since its enumeration value is zero.
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//	errPrefDto					*ePref.ErrPrefixDto
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//		This object encapsulates an error prefix string
-//		which is included in all returned error
-//		messages. Usually, it contains the name of the
-//		calling method or methods listed as a function
-//		chain.
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//		If no error prefix information is needed, set
-//		this parameter to 'nil'.
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//		Type ErrPrefixDto is included in the 'errpref'
-//		software package:
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
 //			"github.com/MikeAustin71/errpref".
 //
 // ----------------------------------------------------------------
 //
 // # Return Values
 //
-//	BigFloatDto
-//
-//		If this method completes successfully, a fully
-//		populated instance of BigFloatDto will be
-//		returned containing the big.Float value
-//		generated from the Pure Number String parameter,
-//		'pureNumberStr'.
+//	err							error
 //
-//		type BigFloatDto struct {
-//			Value big.Float
-//				The actual value of the big.Float instance.
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
 //
-//			NumStrComponents PureNumberStrComponents
-//				This parameter profiles the actual big.Float
-//				floating point numeric value identified by
-//				structure element 'Value'.
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (mathFloatHelper *MathFloatHelper) RoundBigFloat(
+	numberToRound *big.Float,
+	roundedNumber *big.Float,
+	roundingType NumberRoundingType,
+	roundToFractionalDigits int,
+	errorPrefix interface{}) (
+	err error) {
+
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
+	}
+
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathFloatHelper."+
+			"RoundBigFloat()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	err = new(mathFloatHelperQuark).
+		roundBigFloat(
+			numberToRound,
+			roundedNumber,
+			roundingType,
+			roundToFractionalDigits,
+			ePrefix)
+
+	return err
+}
+
+//	Sin
 //
-//				type PureNumberStrComponents struct {
+//	Computes the sine of an angle expressed in radians
+//	to the number of fractional digits specified by input
+//	parameter 'requiredFractionalDigits'.
 //
-//					NumStrStats NumberStrStatsDto
+//		Examples:
+//			sin(0)		= 0
+//			sin(2.5)	= 0.59847214410395649405...
 //
-//						This data transfer object will return key
-//						statistics on the numeric value encapsulated
-//						by the current instance of NumberStrKernel.
+//	The result is returned as both a big.Float value and
+//	an instance of NumberStrKernel.
 //
-//							type NumberStrStatsDto struct {
+//	The returned result is guaranteed to be correctly
+//	rounded. The calculation is performed at a working
+//	precision which exceeds the required number of
+//	fractional digits. The calculation is then repeated
+//	at a higher precision in order to establish an error
+//	bound. If the error bound prevents the result from
+//	being rounded with certainty, the working precision
+//	is doubled and the calculation is repeated until the
+//	correctly rounded result is established.
 //
-//								NumOfIntegerDigits					uint64
+// ----------------------------------------------------------------
 //
-//									The total number of integer digits to the
-//									left of the radix point or, decimal point, in
-//									the subject numeric value.
+// # Input Parameters
 //
-//								NumOfSignificantIntegerDigits		uint64
+//	radians						*big.Float
 //
-//									The number of nonzero integer digits to the
-//									left of the radix point or, decimal point, in
-//									the subject numeric value.
+//		The angle, expressed in radians, for which the
+//		sine will be computed.
 //
-//								NumOfFractionalDigits				uint64
+//		If 'radians' is a nil pointer or infinite, an
+//		error will be returned.
 //
-//									The total number of fractional digits to the
-//									right of the radix point or, decimal point,
-//									in the subject numeric value.
+//		If the absolute value of 'radians' is greater
+//		than or equal to 10^1000, an error will be
+//		returned.
 //
-//								NumOfSignificantFractionalDigits	uint64
+//	requiredFractionalDigits	int
 //
-//									The number of nonzero fractional digits to
-//									the right of the radix point or, decimal
-//									point, in the subject numeric value.
+//		The number of accurate fractional digits required
+//		in the calculation result. The result will be
+//		rounded to this number of fractional digits
+//		using the rounding algorithm specified by input
+//		parameter 'roundingType'.
 //
-//								NumberValueType 					NumericValueType
+//		The number of big.Float precision bits required
+//		to store the result is computed internally by
+//		adding the estimated number of integer digits in
+//		the result, 'requiredFractionalDigits' and a
+//		buffer of extra digits. The working precision is
+//		increased automatically until the correctly
+//		rounded result has been established.
 //
-//									This enumeration value specifies whether the
-//									subject numeric value is classified either as
-//									an integer or a floating point number.
+//		If this value is less than zero, an error will be
+//		returned.
 //
-//									Possible enumeration values are listed as
-//									follows:
-//										NumValType.None()
-//										NumValType.FloatingPoint()
-//										NumValType.Integer()
+//	roundingType				NumberRoundingType
 //
-//								NumberSign							NumericSignValueType
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied
+//		to the calculation result.
 //
-//									An enumeration specifying the number sign
-//									associated with the numeric value. Possible
-//									values are listed as follows:
-//										NumSignVal.None()		= Invalid Value
-//										NumSignVal.Negative()	= -1
-//										NumSignVal.Zero()		=  0
-//										NumSignVal.Positive()	=  1
+//		Possible values are listed as follows:
 //
-//								IsZeroValue							bool
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
 //
-//									If 'true', the subject numeric value is equal
-//									to zero ('0').
+//		NumRoundType.None() and NumRoundType.NoRounding()
+//		are invalid. If either of these values is
+//		submitted, an error will be returned.
 //
-//									If 'false', the subject numeric value is
-//									greater than or less than zero ('0').
-//							}
+//	errorPrefix					interface{}
 //
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//					AbsoluteValueNumStr string
-//					The number string expressed as an absolute value.
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//					AbsoluteValAllIntegerDigitsNumStr string
-//					Integer and fractional digits are combined
-//					in a single number string without a decimal
-//					point separating integer and fractional digits.
-//					This string DOES NOT contain a leading number
-//					sign (a.k.a. minus sign ('-')
-//				}
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//			EstimatedPrecisionBits BigFloatPrecisionDto
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//			This structure stores the components and final
-//			results value for a precision bits calculation.
-//			The number of precision bits configured for a
-//			big.Float floating point numeric value determines
-//			the storage capacity for a specific floating
-//			point number. As such, the calculation of a
-//			correct and adequate precision bits value can
-//			affect the accuracy of floating point calculations.
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//			type BigFloatPrecisionDto struct {
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//					NumIntegerDigits			int64
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//						The actual or estimated number of integer digits
-//						in a big.Float floating point numeric value. The
-//						number of integer digits in a floating point
-//						number is one of the elements used to calculate
-//						the precision bits required to store that
-//						floating point number.
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//					NumFractionalDigits			int64
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//						The actual or estimated number of fractional
-//						digits in a big.Float floating point numeric
-//						value. The number of fractional digits in a
-//						floating point number is one of the elements used
-//						to calculate the precision bits required to store
-//						that floating point number.
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
 //
-//					NumOfExtraDigitsBuffer		int64
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
-//						When estimating the number of precision necessary
-//						to store or process big.Float floating point
-//						values, is generally a good idea to include a
-//						safety margin consisting of excess numeric digits.
+// ----------------------------------------------------------------
 //
-//						This parameter stores the number of extra numeric
-//						digits used in a calculation of total require
-//						precision bits.
+// # Return Values
 //
-//					PrecisionBitsSpec uint
-//						This parameter represents the estimated number of
-//						bits required to store a specific floating point
-//						numeric value in an instance of type big.Float.
+//	sine						*big.Float
 //
-//						The 'PrecisionBitsSpec' value is usually generated
-//						by an internal calculation based on the estimated
-//						number of integer and fractional digits contained
-//						in a big.Float floating point number. However,
-//						users have the option to specify an arbitrary
-//						precision bits value.
-//			}
+//		If this method completes successfully, this
+//		parameter will return the sine of 'radians'
+//		rounded to 'requiredFractionalDigits'.
 //
+//	sineNumStr					NumberStrKernel
 //
-//	error
+//		If this method completes successfully, this
+//		parameter will return the sine of 'radians'
+//		rounded to 'requiredFractionalDigits' as an
+//		instance of NumberStrKernel.
+//
+//	err							error
 //
 //		If this method completes successfully, the
 //		returned error Type is set equal to 'nil'. If
@@ -2539,19 +6673,17 @@ func (mathFloatHelper *MathFloatHelper) NativeNumStrToFloat64(
 //		message.
 //
 //		If an error message is returned, the text value
-//		for input parameter 'errPrefDto' (error prefix)
+//		for input parameter 'errorPrefix' (error prefix)
 //		will be prefixed or attached at the beginning of
 //		the error message.
-func (mathFloatHelper *MathFloatHelper) PureNumStrToBigFloatDto(
-	pureNumberStr string,
-	decSeparatorChars string,
-	leadingMinusSign bool,
-	numOfExtraDigitsBuffer int64,
-	precisionBitsOverride uint,
-	roundingMode big.RoundingMode,
+func (mathFloatHelper *MathFloatHelper) Sin(
+	radians *big.Float,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
 	errorPrefix interface{}) (
-	BigFloatDto,
-	error) {
+	sine *big.Float,
+	sineNumStr NumberStrKernel,
+	err error) {
 
 	if mathFloatHelper.lock == nil {
 		mathFloatHelper.lock = new(sync.Mutex)
@@ -2563,150 +6695,133 @@ func (mathFloatHelper *MathFloatHelper) PureNumStrToBigFloatDto(
 
 	var ePrefix *ePref.ErrPrefixDto
 
-	var err error
-
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"MathFloatHelper."+
-			"PureNumStrToBigFloatDto()",
+			"Sin()",
 		"")
 
 	if err != nil {
-		return BigFloatDto{}, err
+		return sine, sineNumStr, err
 	}
 
-	return new(mathFloatHelperBoson).
-		bigFloatDtoFromPureNumStr(
-			pureNumberStr,
-			decSeparatorChars,
-			leadingMinusSign,
-			numOfExtraDigitsBuffer,
-			precisionBitsOverride,
-			roundingMode,
-			ePrefix)
+	floatHelperMolecule := mathFloatHelperMolecule{}
+
+	var exactRadians *big.Rat
+
+	exactRadians,
+		err = floatHelperMolecule.bigFloatToRat(
+		radians,
+		"radians",
+		ePrefix)
+
+	if err != nil {
+		return sine, sineNumStr, err
+	}
+
+	return floatHelperMolecule.sinCosTan(
+		exactRadians,
+		"sin",
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
 }
 
-//	RaiseToFloatPositiveExponent
-//
-//	Receives a pointer to a big.Float floating point
-//	number and raises that number to the power specified
-//	by input parameter 'exponent'.
-//
-//		Example:	3.2 ^ 4 = 104.8576
-//					base ^ exponent = raisedToExponent
+//	SinNumStrKernel
 //
-//	This method will only process positive exponents.
+//	Computes the sine of an angle expressed in radians
+//	to the number of fractional digits specified by input
+//	parameter 'requiredFractionalDigits'.
 //
-//	This method employs floating point mathematics and
-//	type big.Float to compute the base floating point
-//	value raised to the power of exponent.
+//		Examples:
+//			sin(0)		= 0
+//			sin(2.5)	= 0.59847214410395649405...
 //
-// ----------------------------------------------------------------
+//	The numeric value of 'radians' is converted, without
+//	rounding, to the exactly equivalent big.Rat value
+//	before the calculation is performed. Decimal values
+//	such as 0.1 are therefore processed exactly and are
+//	not subject to binary floating point conversion
+//	errors.
 //
-//	# Input Parameters
+//	The result is returned as both a big.Float value and
+//	an instance of NumberStrKernel.
 //
-//	base						*big.Float
+//	The returned result is guaranteed to be correctly
+//	rounded. The calculation is performed at a working
+//	precision which exceeds the required number of
+//	fractional digits. The calculation is then repeated
+//	at a higher precision in order to establish an error
+//	bound. If the error bound prevents the result from
+//	being rounded with certainty, the working precision
+//	is doubled and the calculation is repeated until the
+//	correctly rounded result is established.
 //
-//		This floating point value will be raised to the
-//		power of 'exponent' and returned to the calling
-//		function.
+// ----------------------------------------------------------------
 //
-//	exponent					int64
+// # Input Parameters
 //
-//		This value will be used to raise 'base' to the
-//		power of 'exponent'.
+//	radians						*NumberStrKernel
 //
-//		Example:	3.2 ^ 4 = 104.8576
-//					base ^ exponent = raisedToExponent
+//		The angle, expressed in radians, for which the
+//		sine will be computed.
 //
-//		If this value is less than zero, an error will be
+//		If 'radians' is a nil pointer or contains an
+//		invalid numeric value, an error will be
 //		returned.
 //
-//	numOfExtraDigitsBuffer		int64
-//
-//		The term 'precision bits' refers to the number of
-//		bits in the mantissa of a big.Float floating
-//		point number. Effectively, 'precision bits'
-//		controls the precision, accuracy and numerical
-//		digit storage capacity for a big.Float floating
-//		point number
-//
-//		When configuring the big.Float numeric value
-//		returned by this method, the number of big.Float
-//		precision bits will be calculated based on the
-//		estimated number of integer and fractional
-//		numeric digits contained in the base floating
-//		point value ('base'). To deal with contingencies
-//		and requirements often found in complex floating
-//		point operations, users have the option to
-//		arbitrarily increase the number of precision bits
-//		by specifying additional numeric digits via
-//		parameter, 'numOfExtraDigitsBuffer'.
-//
-//		The automatic precision bits calculation will add
-//		the number of integer digits, fractional digits and
-//		'numOfExtraDigitsBuffer' to compute the estimated
-//		number of precision bits. As such this parameter
-//		provides of safety in terms of ensuring accurate
-//		calculation results.
-//
-//		The greater the value of this parameter, the
-//		greater the number of accurate significant digits
-//		produced in the calculation result.
-//
-//		Note: The user has the option of overriding the
-//		automatic precision bits calculation by specifying
-//		a precision bits value directly through parameter,
-//		'precisionBitsOverride'.
+//		If the absolute value of 'radians' is greater
+//		than or equal to 10^1000, an error will be
+//		returned.
 //
-//	precisionBitsOverride		uint
+//	requiredFractionalDigits	int
 //
-//		The term 'precision bits' refers to the number of
-//		bits in the mantissa of a big.Float floating point
-//		number. Effectively, 'precision bits' controls the
-//		precision, accuracy and numerical digit storage
-//		capacity for a big.Float floating point number.
+//		The number of accurate fractional digits required
+//		in the calculation result. The result will be
+//		rounded to this number of fractional digits
+//		using the rounding algorithm specified by input
+//		parameter 'roundingType'.
 //
-//		Typically, this method will automatically
-//		calculate the value of big.Float precision bits
-//		using the parameter 'numOfExtraDigitsBuffer'
-//		listed above. However, if 'precisionBitsOverride'
-//		has a value greater than zero, the automatic
-//		precision bit calculation will be overridden and
-//		big.Float precision bits will be set to the value
-//		of this	precision bits specification
-//		('precisionBitsOverride').
+//		The number of big.Float precision bits required
+//		to store the result is computed internally by
+//		adding the estimated number of integer digits in
+//		the result, 'requiredFractionalDigits' and a
+//		buffer of extra digits. The working precision is
+//		increased automatically until the correctly
+//		rounded result has been established.
 //
-//	roundingMode 				big.RoundingMode
+//		If this value is less than zero, an error will be
+//		returned.
 //
-//		Specifies the rounding algorithm which will be used
-//		internally to calculate the base value raised to the
-//		power of exponent.
+//	roundingType				NumberRoundingType
 //
-//		Each instance of big.Float is configured with a
-//		rounding mode. Input parameter 'roundingMode'
-//		controls this configuration for the calculation
-//		and the big.Float value returned by this method.
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied
+//		to the calculation result.
 //
-//		The constant values available for big.Float
-//		rounding mode are listed as follows:
+//		Possible values are listed as follows:
 //
-//		big.ToNearestEven  		// == IEEE 754-2008 roundTiesToEven
-//		big.ToNearestAway       // == IEEE 754-2008 roundTiesToAway
-//		big.ToZero              // == IEEE 754-2008 roundTowardZero
-//		big.AwayFromZero        // no IEEE 754-2008 equivalent
-//		big.ToNegativeInf       // == IEEE 754-2008 roundTowardNegative
-//		big.ToPositiveInf       // == IEEE 754-2008 roundTowardPositive
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
 //
-//		If in doubt as this setting, 'big.AwayFromZero' or
-//		'big.ToNearestEven' are common selections for rounding mode.
+//		NumRoundType.None() and NumRoundType.NoRounding()
+//		are invalid. If either of these values is
+//		submitted, an error will be returned.
 //
 //	errorPrefix					interface{}
 //
 //		This object encapsulates error prefix text which
 //		is included in all returned error messages.
-//		Usually, it	contains the name of the calling
+//		Usually, it contains the name of the calling
 //		method or methods listed as a method or function
 //		chain of execution.
 //
@@ -2765,36 +6880,39 @@ func (mathFloatHelper *MathFloatHelper) PureNumStrToBigFloatDto(
 //
 // # Return Values
 //
-//	*big.Float
+//	sine						*big.Float
 //
 //		If this method completes successfully, this
-//		parameter will return 'base' value raised to the
-//		power of the 'exponent' value.
-//
-//		Example:	3.2 ^ 4 = 104.8576
-//					base ^ exponent = raisedToExponent
+//		parameter will return the sine of 'radians'
+//		rounded to 'requiredFractionalDigits'.
 //
-//	error
+//	sineNumStr					NumberStrKernel
 //
 //		If this method completes successfully, this
+//		parameter will return the sine of 'radians'
+//		rounded to 'requiredFractionalDigits' as an
+//		instance of NumberStrKernel.
+//
+//	err							error
+//
+//		If this method completes successfully, the
 //		returned error Type is set equal to 'nil'. If
 //		errors are encountered during processing, the
 //		returned error Type will encapsulate an error
 //		message.
 //
 //		If an error message is returned, the text value
-//		for input parameter 'errPrefDto' (error prefix)
+//		for input parameter 'errorPrefix' (error prefix)
 //		will be prefixed or attached at the beginning of
 //		the error message.
-func (mathFloatHelper *MathFloatHelper) RaiseToFloatPositiveExponent(
-	base *big.Float,
-	exponent int64,
-	numOfExtraDigitsBuffer int64,
-	precisionBitsOverride uint,
-	roundingMode big.RoundingMode,
+func (mathFloatHelper *MathFloatHelper) SinNumStrKernel(
+	radians *NumberStrKernel,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
 	errorPrefix interface{}) (
-	*big.Float,
-	error) {
+	sine *big.Float,
+	sineNumStr NumberStrKernel,
+	err error) {
 
 	if mathFloatHelper.lock == nil {
 		mathFloatHelper.lock = new(sync.Mutex)
@@ -2806,202 +6924,208 @@ func (mathFloatHelper *MathFloatHelper) RaiseToFloatPositiveExponent(
 
 	var ePrefix *ePref.ErrPrefixDto
 
-	var err error
-
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"MathFloatHelper."+
-			"RaiseToFloatPositiveExponent()",
+			"SinNumStrKernel()",
 		"")
 
 	if err != nil {
-		return big.NewFloat(0), err
+		return sine, sineNumStr, err
 	}
 
-	return new(mathFloatHelperMechanics).
-		raiseToFloatExponentConfig(
-			base,
-			exponent,
-			numOfExtraDigitsBuffer,
-			precisionBitsOverride,
-			roundingMode,
-			ePrefix)
+	var exactRadians *big.Rat
+
+	exactRadians,
+		err = new(mathBigRatHelperQuark).numStrKernelToRat(
+		radians,
+		ePrefix.XCpy(
+			"radians"))
+
+	if err != nil {
+		return sine, sineNumStr, err
+	}
+
+	return new(mathFloatHelperMolecule).sinCosTan(
+		exactRadians,
+		"sin",
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
 }
 
-//	RaiseToIntPositiveExponent
-//
-//	Receives a pointer to a big.Float floating point
-//	number and raises that number to the power specified
-//	by input parameter 'exponent'.
+//	Sqrt
 //
-//		Example:	3.2 ^ 4 = 104.8576
-//					base ^ exponent = raisedToExponent
+//	Computes the square root of a big.Float floating
+//	point number ('radicand') to the number of fractional
+//	digits specified by input parameter
+//	'requiredFractionalDigits'.
 //
-//	The floating point precision value required to
-//	support the calculation result ('raisedToExponent')
-//	is computed internally.
+//		Example:
+//			Radicand: 2
+//			Required Fractional Digits: 50
+//			Result:
+//			1.41421356237309504880168872420969807856967187537694
 //
-//	This method will only process positive exponents.
+//	The result is returned as both a big.Float value and
+//	an instance of NumberStrKernel.
 //
-//	This method employs integer mathematics and type
-//	big.Int to compute the base floating point value
-//	raised to the power of an exponent. As such it
-//	produces highly accurate results.
+//	The number of big.Float precision bits required for
+//	the calculation is computed internally using the
+//	same algorithms employed by methods
+//	PrecisionBitsFromRequiredDigits() and
+//	DigitsToPrecisionEstimate().
 //
 // ----------------------------------------------------------------
 //
 //	# Input Parameters
 //
-//	base						*big.Float
+//	radicand					*big.Float
 //
-//		This floating point value will be raised to the
-//		power of 'exponent' and returned to the calling
-//		function.
+//		The number from which the square root will be
+//		extracted.
 //
-//	exponent					int64
+//		If 'radicand' is a nil pointer, infinite or
+//		negative, an error will be returned.
 //
-//		This value will be used to raise 'base' to the
-//		power of 'exponent'.
+//	requiredFractionalDigits	int
 //
-//		Example:	3.2 ^ 4 = 104.8576
-//					base ^ exponent = raisedToExponent
+//		The number of accurate fractional digits required
+//		in the calculation result. The result will be
+//		rounded to this number of fractional digits
+//		using the rounding algorithm specified by input
+//		parameter 'roundingType'.
+//
+//		The number of big.Float precision bits required
+//		to store the result is computed internally by
+//		adding the estimated number of integer digits in
+//		the result, 'requiredFractionalDigits' and a
+//		buffer of extra digits. The calculation itself is
+//		performed at a higher working precision to ensure
+//		that all returned fractional digits are accurate.
 //
 //		If this value is less than zero, an error will be
 //		returned.
 //
-//	numOfExtraDigitsBuffer		int64
-//
-//		The term 'precision bits' refers to the number of
-//		bits in the mantissa of a big.Float floating
-//		point number. Effectively, 'precision bits'
-//		controls the precision, accuracy and numerical
-//		digit storage capacity for a big.Float floating
-//		point number.
-//
-//		When configuring the big.Float numeric value
-//		returned by this method, the number of big.Float
-//		precision bits will be automatically calculated
-//		based on the number of integer and fractional
-//		numeric digits contained in the base floating
-//		point value ('base'). To deal with contingencies
-//		and requirements often found in complex floating
-//		point operations, users have the option to
-//		arbitrarily increase the number of automatically
-//		calculated precision bits by specifying additional
-//		numeric digits via parameter,
-//		'numOfExtraDigitsBuffer'.
-//
-//		The automatic precision bits calculation will add
-//		the number of integer digits, fractional digits and
-//		'numOfExtraDigitsBuffer' to compute the estimated
-//		number of precision bits.
+//	roundingType				NumberRoundingType
 //
-//		The greater the value of this parameter, the
-//		greater the number of accurate significant digits
-//		produced in the calculation result.
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied
+//		to the calculation result.
 //
-//		Note: The user has the option of overriding the
-//		automatic precision bits calculation by specifying
-//		a precision bits value directly through parameter,
-//		'precisionBitsOverride'.
+//		Possible values are listed as follows:
 //
-//	precisionBitsOverride		uint
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
 //
-//		The term 'precision bits' refers to the number of
-//		bits in the mantissa of a big.Float floating point
-//		number. Effectively, 'precision bits' controls the
-//		precision, accuracy and numerical digit storage
-//		capacity for a big.Float floating point number.
+//		NumRoundType.None() and NumRoundType.NoRounding()
+//		are invalid. If either of these values is
+//		submitted, an error will be returned.
 //
-//		Typically, this method will automatically
-//		calculate the value of big.Float precision bits
-//		using the parameter 'numOfExtraDigitsBuffer'
-//		listed above. However, if 'precisionBitsOverride'
-//		has a value greater than zero, the automatic
-//		precision bit calculation will be overridden and
-//		big.Float precision bits will be set to the value
-//		of this	precision bits specification
-//		('precisionBitsOverride').
+//	errorPrefix					interface{}
 //
-//		If in doubt as to this number, identify the
-//		total number of integer and fractional digits
-//		required to store an accurate result and
-//		multiply this number times four (+4) to generate
-//		an estimate of precision bits required.
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//	roundingMode 				big.RoundingMode
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//		Specifies the rounding algorithm which will be used
-//		internally to calculate the base value raised to the
-//		power of exponent.
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//		Each instance of big.Float is configured with a
-//		rounding mode. Input parameter 'roundingMode'
-//		controls this configuration for the calculation
-//		and the big.Float value returned by this method.
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//		The constant values available for big.Float
-//		rounding mode are listed as follows:
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//		big.ToNearestEven  		// == IEEE 754-2008 roundTiesToEven
-//		big.ToNearestAway       // == IEEE 754-2008 roundTiesToAway
-//		big.ToZero              // == IEEE 754-2008 roundTowardZero
-//		big.AwayFromZero        // no IEEE 754-2008 equivalent
-//		big.ToNegativeInf       // == IEEE 754-2008 roundTowardNegative
-//		big.ToPositiveInf       // == IEEE 754-2008 roundTowardPositive
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//		If in doubt as this setting, 'big.AwayFromZero' is a
-//		common selection for rounding mode.
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//	errPrefDto					*ePref.ErrPrefixDto
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//		This object encapsulates an error prefix string
-//		which is included in all returned error
-//		messages. Usually, it contains the name of the
-//		calling method or methods listed as a function
-//		chain.
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//		If no error prefix information is needed, set
-//		this parameter to 'nil'.
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//		Type ErrPrefixDto is included in the 'errpref'
-//		software package:
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
 //			"github.com/MikeAustin71/errpref".
 //
 // ----------------------------------------------------------------
 //
 // # Return Values
 //
-//	*big.Float
+//	squareRoot					*big.Float
 //
 //		If this method completes successfully, this
-//		parameter will return 'base' value raised to the
-//		power of the 'exponent' value.
-//
-//		Example:	3.2 ^ 4 = 104.8576
-//					base ^ exponent = raisedToExponent
+//		parameter will return the square root of
+//		'radicand' rounded to 'requiredFractionalDigits'.
 //
-//	error
+//	squareRootNumStr			NumberStrKernel
 //
 //		If this method completes successfully, this
+//		parameter will return the square root of
+//		'radicand' rounded to 'requiredFractionalDigits'
+//		as an instance of NumberStrKernel.
+//
+//	err							error
+//
+//		If this method completes successfully, the
 //		returned error Type is set equal to 'nil'. If
 //		errors are encountered during processing, the
 //		returned error Type will encapsulate an error
 //		message.
 //
 //		If an error message is returned, the text value
-//		for input parameter 'errPrefDto' (error prefix)
+//		for input parameter 'errorPrefix' (error prefix)
 //		will be prefixed or attached at the beginning of
 //		the error message.
-func (mathFloatHelper *MathFloatHelper) RaiseToIntPositiveExponent(
-	base *big.Float,
-	exponent int64,
-	numOfExtraDigitsBuffer int64,
-	precisionBitsOverride uint,
-	roundingMode big.RoundingMode,
+func (mathFloatHelper *MathFloatHelper) Sqrt(
+	radicand *big.Float,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
 	errorPrefix interface{}) (
-	raisedToExponent *big.Float,
+	squareRoot *big.Float,
+	squareRootNumStr NumberStrKernel,
 	err error) {
 
 	if mathFloatHelper.lock == nil {
@@ -3018,64 +7142,87 @@ func (mathFloatHelper *MathFloatHelper) RaiseToIntPositiveExponent(
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"MathFloatHelper."+
-			"RaiseToIntPositiveExponent()",
+			"Sqrt()",
 		"")
 
 	if err != nil {
-		return big.NewFloat(0), err
+		return squareRoot, squareRootNumStr, err
 	}
 
-	return new(mathFloatHelperQuark).
-		raiseToIntPositiveExponent(
-			base,
-			exponent,
-			numOfExtraDigitsBuffer,
-			precisionBitsOverride,
-			roundingMode,
-			ePrefix)
+	return new(mathFloatHelperNanobot).nthRoot(
+		radicand,
+		2,
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
 }
 
-//	RoundBigFloat
+//	Tan
 //
-//	This method will round a big.Float numeric value
-//	based on rounding specifications passed by input
-//	parameters, 'roundingType' and
-//	'roundToFractionalDigits'.
+//	Computes the tangent of an angle expressed in radians
+//	to the number of fractional digits specified by input
+//	parameter 'requiredFractionalDigits'.
 //
-//	The big.Float numeric value to be rounded is passed
-//	by input parameter, 'numberToRound'. The final
-//	rounded value is returned through input parameter,
-//	'roundedNumber'.
+//		Examples:
+//			tan(0)		= 0
+//			tan(2.5)	= -0.74702229723866027935...
+//
+//	The result is returned as both a big.Float value and
+//	an instance of NumberStrKernel.
+//
+//	The returned result is guaranteed to be correctly
+//	rounded. The calculation is performed at a working
+//	precision which exceeds the required number of
+//	fractional digits. The calculation is then repeated
+//	at a higher precision in order to establish an error
+//	bound. If the error bound prevents the result from
+//	being rounded with certainty, the working precision
+//	is doubled and the calculation is repeated until the
+//	correctly rounded result is established.
 //
 // ----------------------------------------------------------------
 //
 // # Input Parameters
 //
-//	numberToRound				*big.Float
+//	radians						*big.Float
 //
-//		A pointer to an instance of big.Float. This is
-//		the numeric value which will be rounded.
+//		The angle, expressed in radians, for which the
+//		tangent will be computed.
 //
+//		If 'radians' is a nil pointer or infinite, an
+//		error will be returned.
 //
-//	roundedNumber				*big.Float
+//		If the absolute value of 'radians' is greater
+//		than or equal to 10^1000, an error will be
+//		returned.
 //
-//		A pointer to an instance of big.Float. The result
-//		of the rounding operation performed on input
-//		parameter,'numberToRound', will be stored here.
+//	requiredFractionalDigits	int
+//
+//		The number of accurate fractional digits required
+//		in the calculation result. The result will be
+//		rounded to this number of fractional digits
+//		using the rounding algorithm specified by input
+//		parameter 'roundingType'.
+//
+//		The number of big.Float precision bits required
+//		to store the result is computed internally by
+//		adding the estimated number of integer digits in
+//		the result, 'requiredFractionalDigits' and a
+//		buffer of extra digits. The working precision is
+//		increased automatically until the correctly
+//		rounded result has been established.
+//
+//		If this value is less than zero, an error will be
+//		returned.
 //
 //	roundingType				NumberRoundingType
 //
 //		This enumeration parameter is used to specify the
-//		type of rounding algorithm that will be applied for
-//		the	rounding of fractional digits contained in the
-//		current instance of NumberStrKernel.
-//
-//		If in doubt as to a suitable rounding method,
-//		'HalfAwayFromZero' is recommended.
+//		type of rounding algorithm that will be applied
+//		to the calculation result.
 //
 //		Possible values are listed as follows:
-//			NumRoundType.None()	- Invalid Value
-//			NumRoundType.NoRounding()
+//
 //			NumRoundType.HalfUpWithNegNums()
 //			NumRoundType.HalfDownWithNegNums()
 //			NumRoundType.HalfAwayFromZero()
@@ -3087,235 +7234,244 @@ func (mathFloatHelper *MathFloatHelper) RaiseToIntPositiveExponent(
 //			NumRoundType.Ceiling()
 //			NumRoundType.Truncate()
 //
-//		Definitions:
-//
-//			NoRounding
-//
-//				Signals that no rounding operation will be
-//				performed on fractional digits. The
-//				fractional digits will therefore remain
-//				unchanged.
-//
-//			HalfUpWithNegNums
-//
-//				Half Round Up Including Negative Numbers.
-//				This method is intuitive but may produce
-//				unexpected results when applied to negative
-//				numbers.
-//
-//				'HalfUpWithNegNums' rounds .5 up.
-//
-//					Examples of 'HalfUpWithNegNums'
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8
-//					7.4 rounds down to 7
-//					-7.4 rounds up to -7
-//					-7.5 rounds up to -7
-//					-7.6 rounds down to -8
-//
-//			HalfDownWithNegNums
-//
-//			Half Round Down Including Negative Numbers. This
-//			method is also considered intuitive but may
-//			produce unexpected results when applied to
-//			negative numbers.
-//
-//			'HalfDownWithNegNums' rounds .5 down.
-//
-//				Examples of HalfDownWithNegNums
-//
-//				7.6 rounds up to 8
-//				7.5 rounds down to 7
-//				7.4 rounds down to 7
-//				-7.4 rounds up to -7
-//				-7.5 rounds down to -8
-//				-7.6 rounds down to -8
-//
-//			HalfAwayFromZero
-//
-//				The 'HalfAwayFromZero' method rounds .5 further
-//				away from zero.	It provides clear and consistent
-//				behavior when dealing with negative numbers.
-//
-//					Examples of HalfAwayFromZero
-//
-//					7.6 rounds away to 8
-//					7.5 rounds away to 8
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds away to -8
-//					-7.6 rounds away to -8
-//
-//			HalfTowardsZero
+//		NumRoundType.None() and NumRoundType.NoRounding()
+//		are invalid. If either of these values is
+//		submitted, an error will be returned.
 //
-//				Round Half Towards Zero. 'HalfTowardsZero' rounds
-//				0.5	closer to zero. It provides clear and
-//				consistent behavior	when dealing with negative
-//				numbers.
+//	errorPrefix					interface{}
 //
-//					Examples of HalfTowardsZero
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//					7.6 rounds away to 8
-//					7.5 rounds to 7
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds to -7
-//					-7.6 rounds away to -8
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//			HalfToEven
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//				Round Half To Even Numbers. 'HalfToEven' is
-//				also called	Banker's Rounding. This method
-//				rounds 0.5 to the nearest even digit.
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//					Examples of HalfToEven
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//					7.5 rounds up to 8 (because 8 is an even
-//					number)	but 6.5 rounds down to 6 (because
-//					6 is an even number)
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//					HalfToEven only applies to 0.5. Other
-//					numbers (not ending	in 0.5) round to
-//					nearest as usual, so:
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8 (because 8 is an even number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds down to 6 (because 6 is an even number)
-//					6.4 rounds down to 6
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//			HalfToOdd
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//				Round Half to Odd Numbers. Similar to 'HalfToEven',
-//				but in this case 'HalfToOdd' rounds 0.5 towards odd
-//				numbers.
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//					Examples of HalfToOdd
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
 //
-//					HalfToOdd only applies to 0.5. Other numbers
-//					(not ending	in 0.5) round to nearest as usual.
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
-//					7.5 rounds down to 7 (because 7 is an odd number)
+// ----------------------------------------------------------------
 //
-//					6.5 rounds up to 7 (because 7 is an odd number)
+// # Return Values
 //
-//					7.6 rounds up to 8
-//					7.5 rounds down to 7 (because 7 is an odd number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds up to 7 (because 7 is an odd number)
-//					6.4 rounds down to 6
+//	tangent						*big.Float
 //
-//			Randomly
+//		If this method completes successfully, this
+//		parameter will return the tangent of 'radians'
+//		rounded to 'requiredFractionalDigits'.
 //
-//				Round Half Randomly. Uses a Random Number Generator
-//				to choose between rounding 0.5 up or down.
+//	tangentNumStr				NumberStrKernel
 //
-//				All numbers other than 0.5 round to the nearest as
-//				usual.
+//		If this method completes successfully, this
+//		parameter will return the tangent of 'radians'
+//		rounded to 'requiredFractionalDigits' as an
+//		instance of NumberStrKernel.
 //
-//			Floor
+//	err							error
 //
-//				Yields the nearest integer down. Floor does not apply
-//				any	special treatment to 0.5.
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
 //
-//				Floor Function: The greatest integer that is less than
-//				or equal to x
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathFloatHelper *MathFloatHelper) Tan(
+	radians *big.Float,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	errorPrefix interface{}) (
+	tangent *big.Float,
+	tangentNumStr NumberStrKernel,
+	err error) {
+
+	if mathFloatHelper.lock == nil {
+		mathFloatHelper.lock = new(sync.Mutex)
+	}
+
+	mathFloatHelper.lock.Lock()
+
+	defer mathFloatHelper.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"MathFloatHelper."+
+			"Tan()",
+		"")
+
+	if err != nil {
+		return tangent, tangentNumStr, err
+	}
+
+	floatHelperMolecule := mathFloatHelperMolecule{}
+
+	var exactRadians *big.Rat
+
+	exactRadians,
+		err = floatHelperMolecule.bigFloatToRat(
+		radians,
+		"radians",
+		ePrefix)
+
+	if err != nil {
+		return tangent, tangentNumStr, err
+	}
+
+	return floatHelperMolecule.sinCosTan(
+		exactRadians,
+		"tan",
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
+}
+
+//	TanNumStrKernel
 //
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//	Computes the tangent of an angle expressed in radians
+//	to the number of fractional digits specified by input
+//	parameter 'requiredFractionalDigits'.
 //
-//				In mathematics and computer science, the floor function
-//				is the function that takes as input a real number x,
-//				and gives as output the greatest integer less than or
-//				equal to x,	denoted floor(x) or ⌊x⌋.
+//		Examples:
+//			tan(0)		= 0
+//			tan(2.5)	= -0.74702229723866027935...
 //
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//	The numeric value of 'radians' is converted, without
+//	rounding, to the exactly equivalent big.Rat value
+//	before the calculation is performed. Decimal values
+//	such as 0.1 are therefore processed exactly and are
+//	not subject to binary floating point conversion
+//	errors.
 //
-//				Examples of Floor
+//	The result is returned as both a big.Float value and
+//	an instance of NumberStrKernel.
 //
-//					Number     Floor
-//					 2           2
-//					 2.4         2
-//					 2.9         2
-//					-2.5        -3
-//					-2.7        -3
-//					-2          -2
+//	The returned result is guaranteed to be correctly
+//	rounded. The calculation is performed at a working
+//	precision which exceeds the required number of
+//	fractional digits. The calculation is then repeated
+//	at a higher precision in order to establish an error
+//	bound. If the error bound prevents the result from
+//	being rounded with certainty, the working precision
+//	is doubled and the calculation is repeated until the
+//	correctly rounded result is established.
 //
-//			Ceiling
+// ----------------------------------------------------------------
 //
-//				Yields the nearest integer up. Ceiling does not
-//				apply any special treatment to 0.5.
+// # Input Parameters
 //
-//				Ceiling Function: The least integer that is
-//				greater than or	equal to x.
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//	radians						*NumberStrKernel
 //
-//				The ceiling function maps x to the least integer
-//				greater than or equal to x, denoted ceil(x) or
-//				⌈x⌉.[1]
+//		The angle, expressed in radians, for which the
+//		tangent will be computed.
 //
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//		If 'radians' is a nil pointer or contains an
+//		invalid numeric value, an error will be
+//		returned.
 //
-//					Examples of Ceiling
+//		If the absolute value of 'radians' is greater
+//		than or equal to 10^1000, an error will be
+//		returned.
 //
-//						Number    Ceiling
-//						 2           2
-//						 2.4         3
-//						 2.9         3
-//						-2.5        -2
-//						-2.7        -2
-//						-2          -2
+//	requiredFractionalDigits	int
 //
-//			Truncate
+//		The number of accurate fractional digits required
+//		in the calculation result. The result will be
+//		rounded to this number of fractional digits
+//		using the rounding algorithm specified by input
+//		parameter 'roundingType'.
 //
-//				Apply NO Rounding whatsoever. The Round From Digit
-//				is dropped or deleted. The Round To Digit is NEVER
-//				changed.
+//		The number of big.Float precision bits required
+//		to store the result is computed internally by
+//		adding the estimated number of integer digits in
+//		the result, 'requiredFractionalDigits' and a
+//		buffer of extra digits. The working precision is
+//		increased automatically until the correctly
+//		rounded result has been established.
 //
-//				Examples of Truncate
+//		If this value is less than zero, an error will be
+//		returned.
 //
-//					Example-1
-//					Number: 23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:   23.14 - The Round From Digit
-//					is dropped.
+//	roundingType				NumberRoundingType
 //
-//					Example-2
-//					Number: -23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:  -23.14 - The Round From Digit
-//					is dropped.
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied
+//		to the calculation result.
 //
-//	roundToFractionalDigits		int
+//		Possible values are listed as follows:
 //
-//		When set to a positive integer value, this parameter
-//		controls the number of digits to the right of the radix
-//		point or decimal separator (a.k.a. decimal point). This
-//		controls the number of fractional digits remaining after
-//		completion of the number rounding operation.
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
 //
-//		For the purposes of this method, any
-//		'roundToFractionalDigits' value greater than 20,000 will
-//		trigger an error return.
+//		NumRoundType.None() and NumRoundType.NoRounding()
+//		are invalid. If either of these values is
+//		submitted, an error will be returned.
 //
 //	errorPrefix					interface{}
 //
 //		This object encapsulates error prefix text which
 //		is included in all returned error messages.
-//		Usually, it	contains the name of the calling
+//		Usually, it contains the name of the calling
 //		method or methods listed as a method or function
 //		chain of execution.
 //
@@ -3374,24 +7530,38 @@ func (mathFloatHelper *MathFloatHelper) RaiseToIntPositiveExponent(
 //
 // # Return Values
 //
+//	tangent						*big.Float
+//
+//		If this method completes successfully, this
+//		parameter will return the tangent of 'radians'
+//		rounded to 'requiredFractionalDigits'.
+//
+//	tangentNumStr				NumberStrKernel
+//
+//		If this method completes successfully, this
+//		parameter will return the tangent of 'radians'
+//		rounded to 'requiredFractionalDigits' as an
+//		instance of NumberStrKernel.
+//
 //	err							error
 //
 //		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
-//
-//		If errors are encountered during processing, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
 //		returned error Type will encapsulate an error
-//		message. This returned error message will
-//		incorporate the method chain and text passed by
-//		input parameter, 'errorPrefix'. The 'errorPrefix'
-//		text will be attached to the beginning of the
-//		error message.
-func (mathFloatHelper *MathFloatHelper) RoundBigFloat(
-	numberToRound *big.Float,
-	roundedNumber *big.Float,
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (mathFloatHelper *MathFloatHelper) TanNumStrKernel(
+	radians *NumberStrKernel,
+	requiredFractionalDigits int,
 	roundingType NumberRoundingType,
-	roundToFractionalDigits int,
 	errorPrefix interface{}) (
+	tangent *big.Float,
+	tangentNumStr NumberStrKernel,
 	err error) {
 
 	if mathFloatHelper.lock == nil {
@@ -3408,20 +7578,29 @@ func (mathFloatHelper *MathFloatHelper) RoundBigFloat(
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"MathFloatHelper."+
-			"RoundBigFloat()",
+			"TanNumStrKernel()",
 		"")
 
 	if err != nil {
-		return err
+		return tangent, tangentNumStr, err
 	}
 
-	err = new(mathFloatHelperQuark).
-		roundBigFloat(
-			numberToRound,
-			roundedNumber,
-			roundingType,
-			roundToFractionalDigits,
-			ePrefix)
+	var exactRadians *big.Rat
 
-	return err
+	exactRadians,
+		err = new(mathBigRatHelperQuark).numStrKernelToRat(
+		radians,
+		ePrefix.XCpy(
+			"radians"))
+
+	if err != nil {
+		return tangent, tangentNumStr, err
+	}
+
+	return new(mathFloatHelperMolecule).sinCosTan(
+		exactRadians,
+		"tan",
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
 }
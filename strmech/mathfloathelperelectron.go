@@ -0,0 +1,977 @@
+package strmech
+
+import (
+	"math"
+	"math/big"
+	"sync"
+)
+
+// mathFloatHelperElectron
+//
+// Provides the low level big.Float algorithms used to
+// compute roots, powers, exponentials and logarithms
+// at arbitrary precision.
+//
+// Methods in this type perform no validation of input
+// parameters. Validation is the responsibility of the
+// calling method.
+type mathFloatHelperElectron struct {
+	lock *sync.Mutex
+}
+
+// atan
+//
+// Computes the inverse tangent (arctangent) of 'num'.
+// The result is returned in radians and falls within
+// the range -π/2 through +π/2.
+//
+// The returned value is configured with the number of
+// precision bits specified by input parameter
+// 'precisionBits'.
+//
+// ----------------------------------------------------------------
+//
+// # Algorithm
+//
+// If the absolute value of 'num' is greater than one
+// (+1), the identity atan(x) = π/2 - atan(1/x) is
+// applied. The argument is then reduced eight times
+// using the half angle identity:
+//
+//	atan(x) = 2 * atan(x / (1 + sqrt(1 + x^2)))
+//
+// Finally, the reduced argument is evaluated with the
+// Taylor series:
+//
+//	atan(x) = x - x^3/3 + x^5/5 - x^7/7 + ...
+func (floatHelperElectron *mathFloatHelperElectron) atan(
+	num *big.Float,
+	precisionBits uint) *big.Float {
+
+	if floatHelperElectron.lock == nil {
+		floatHelperElectron.lock = new(sync.Mutex)
+	}
+
+	floatHelperElectron.lock.Lock()
+
+	defer floatHelperElectron.lock.Unlock()
+
+	const halvingCount = 8
+
+	if num.Sign() == 0 {
+		return new(big.Float).SetPrec(precisionBits)
+	}
+
+	workingPrec := precisionBits + 64
+
+	one := new(big.Float).SetPrec(workingPrec).SetInt64(1)
+
+	reduced := new(big.Float).SetPrec(workingPrec).Abs(num)
+
+	useComplement := reduced.Cmp(one) > 0
+
+	if useComplement {
+		reduced.Quo(one, reduced)
+	}
+
+	divisor := new(big.Float).SetPrec(workingPrec)
+
+	for i := 0; i < halvingCount; i++ {
+
+		divisor.Mul(reduced, reduced)
+
+		divisor.Add(divisor, one)
+
+		divisor.Sqrt(divisor)
+
+		divisor.Add(divisor, one)
+
+		reduced.Quo(reduced, divisor)
+	}
+
+	result := floatHelperElectron.atanSeries(reduced, workingPrec)
+
+	result.SetMantExp(result, halvingCount)
+
+	if useComplement {
+
+		halfPi := floatHelperElectron.piSeries(workingPrec)
+
+		halfPi.SetMantExp(halfPi, -1)
+
+		result.Sub(halfPi, result)
+	}
+
+	if num.Sign() < 0 {
+		result.Neg(result)
+	}
+
+	return new(big.Float).SetPrec(precisionBits).Set(result)
+}
+
+// atanSeries
+//
+// Computes the inverse tangent of 'z' using the Taylor
+// series:
+//
+//	atan(z) = z - z^3/3 + z^5/5 - z^7/7 + ...
+//
+// The series converges for |z| <= 1. Convergence is
+// rapid for small values of 'z'. Callers should
+// arrange for the absolute value of 'z' to be less
+// than 0.2.
+//
+// The returned value is configured with the number of
+// precision bits specified by input parameter
+// 'precisionBits'.
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperElectron.
+func (floatHelperElectron *mathFloatHelperElectron) atanSeries(
+	z *big.Float,
+	precisionBits uint) *big.Float {
+
+	sum := new(big.Float).SetPrec(precisionBits).Set(z)
+
+	if z.Sign() == 0 {
+		return sum
+	}
+
+	zSquared := new(big.Float).SetPrec(precisionBits).Mul(z, z)
+
+	zPower := new(big.Float).SetPrec(precisionBits).Set(z)
+
+	term := new(big.Float).SetPrec(precisionBits)
+
+	divisor := new(big.Float).SetPrec(precisionBits)
+
+	threshold := sum.MantExp(nil) - int(precisionBits)
+
+	isNegativeTerm := true
+
+	for i := int64(3); ; i += 2 {
+
+		zPower.Mul(zPower, zSquared)
+
+		divisor.SetInt64(i)
+
+		term.Quo(zPower, divisor)
+
+		if term.Sign() == 0 ||
+			term.MantExp(nil) < threshold {
+
+			break
+		}
+
+		if isNegativeTerm {
+			sum.Sub(sum, term)
+		} else {
+			sum.Add(sum, term)
+		}
+
+		isNegativeTerm = !isNegativeTerm
+	}
+
+	return sum
+}
+
+// atanhSeries
+//
+// Computes the inverse hyperbolic tangent of 'z' using
+// the Taylor series:
+//
+//	atanh(z) = z + z^3/3 + z^5/5 + z^7/7 + ...
+//
+// The series converges for |z| < 1. Convergence is
+// rapid for small values of 'z'. Callers should
+// arrange for the absolute value of 'z' to be less
+// than 0.2.
+//
+// The returned value is configured with the number of
+// precision bits specified by input parameter
+// 'precisionBits'.
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperElectron.
+func (floatHelperElectron *mathFloatHelperElectron) atanhSeries(
+	z *big.Float,
+	precisionBits uint) *big.Float {
+
+	sum := new(big.Float).SetPrec(precisionBits).Set(z)
+
+	if z.Sign() == 0 {
+		return sum
+	}
+
+	zSquared := new(big.Float).SetPrec(precisionBits).Mul(z, z)
+
+	zPower := new(big.Float).SetPrec(precisionBits).Set(z)
+
+	term := new(big.Float).SetPrec(precisionBits)
+
+	divisor := new(big.Float).SetPrec(precisionBits)
+
+	threshold := sum.MantExp(nil) - int(precisionBits)
+
+	for i := int64(3); ; i += 2 {
+
+		zPower.Mul(zPower, zSquared)
+
+		divisor.SetInt64(i)
+
+		term.Quo(zPower, divisor)
+
+		if term.Sign() == 0 ||
+			term.MantExp(nil) < threshold {
+
+			break
+		}
+
+		sum.Add(sum, term)
+	}
+
+	return sum
+}
+
+// bitLength
+//
+// Returns the number of bits required to represent the
+// absolute value of 'num'.
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperElectron.
+func (floatHelperElectron *mathFloatHelperElectron) bitLength(
+	num int64) int {
+
+	return big.NewInt(num).BitLen()
+}
+
+// decimalRat
+//
+// Returns the exact rational value of the shortest
+// decimal number which uniquely identifies 'num' at the
+// precision of 'num'.
+//
+//	Example: A big.Float configured from the string
+//			 "0.1" returns 1/10 and NOT the binary
+//			 approximation of 0.1.
+//
+// Number strings are formatted from big.Float values
+// with this same shortest decimal representation
+// ('num.Text('f', -1)'). Treating inputs as decimal
+// values allows exact decimal results such as 10^-3 or
+// 0.01^0.5 to be identified and rounded correctly.
+func (floatHelperElectron *mathFloatHelperElectron) decimalRat(
+	num *big.Float) *big.Rat {
+
+	if floatHelperElectron.lock == nil {
+		floatHelperElectron.lock = new(sync.Mutex)
+	}
+
+	floatHelperElectron.lock.Lock()
+
+	defer floatHelperElectron.lock.Unlock()
+
+	decimalValue, ok := new(big.Rat).SetString(
+		num.Text('g', -1))
+
+	if !ok {
+		decimalValue, _ = num.Rat(nil)
+	}
+
+	return decimalValue
+}
+
+// exp
+//
+// Computes 'e' raised to the power of 'exponent'
+// (e^exponent).
+//
+// The returned value is configured with the number of
+// precision bits specified by input parameter
+// 'precisionBits'.
+//
+// The calling method is responsible for ensuring that
+// the result falls within the exponent range supported
+// by type big.Float.
+//
+// ----------------------------------------------------------------
+//
+// # Algorithm
+//
+// The exponent is first reduced by a multiple of ln(2):
+//
+//	exponent = k * ln(2) + r		|r| <= ln(2)/2
+//
+// The reduced value 'r' is then divided by 2^16 and
+// e^r is computed using the Taylor series. The result
+// is squared sixteen times and finally multiplied by
+// 2^k.
+func (floatHelperElectron *mathFloatHelperElectron) exp(
+	exponent *big.Float,
+	precisionBits uint) *big.Float {
+
+	if floatHelperElectron.lock == nil {
+		floatHelperElectron.lock = new(sync.Mutex)
+	}
+
+	floatHelperElectron.lock.Lock()
+
+	defer floatHelperElectron.lock.Unlock()
+
+	const squaringCount = 16
+
+	if exponent.Sign() == 0 {
+		return new(big.Float).SetPrec(precisionBits).SetInt64(1)
+	}
+
+	exponentFloat64, _ := exponent.Float64()
+
+	k := int64(math.Round(exponentFloat64 / math.Ln2))
+
+	workingPrec := precisionBits + 64 + squaringCount +
+		uint(floatHelperElectron.bitLength(k))
+
+	ln2 := floatHelperElectron.ln2Series(workingPrec)
+
+	reduced := new(big.Float).SetPrec(workingPrec).SetInt64(k)
+
+	reduced.Mul(reduced, ln2)
+
+	reduced.Sub(
+		new(big.Float).SetPrec(workingPrec).Set(exponent),
+		reduced)
+
+	reduced.SetMantExp(reduced, -squaringCount)
+
+	sum := new(big.Float).SetPrec(workingPrec).SetInt64(1)
+
+	term := new(big.Float).SetPrec(workingPrec).SetInt64(1)
+
+	divisor := new(big.Float).SetPrec(workingPrec)
+
+	for i := int64(1); ; i++ {
+
+		term.Mul(term, reduced)
+
+		divisor.SetInt64(i)
+
+		term.Quo(term, divisor)
+
+		if term.Sign() == 0 ||
+			term.MantExp(nil) < -int(workingPrec) {
+
+			break
+		}
+
+		sum.Add(sum, term)
+	}
+
+	for i := 0; i < squaringCount; i++ {
+		sum.Mul(sum, sum)
+	}
+
+	sum.SetMantExp(sum, int(k))
+
+	return new(big.Float).SetPrec(precisionBits).Set(sum)
+}
+
+// intDigitsFromLog10
+//
+// Receives the base 10 logarithm of a numeric value and
+// returns the number of integer digits required to
+// store that numeric value. If the numeric value is
+// less than one (+1), this method returns one (+1).
+//
+//	Examples:
+//		log10Value:  2.5  (316.2)	Integer Digits: 3
+//		log10Value: -1.2  (0.063)	Integer Digits: 1
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperElectron.
+func (floatHelperElectron *mathFloatHelperElectron) intDigitsFromLog10(
+	log10Value float64) int64 {
+
+	if log10Value < 0 {
+		return 1
+	}
+
+	return int64(math.Floor(log10Value)) + 1
+}
+
+// intPower
+//
+// Raises 'base' to the power of a non-negative integer
+// 'exponent' using the square and multiply algorithm.
+//
+//	Example:	3.2 ^ 4 = 104.8576
+//				base ^ exponent = raisedToExponent
+//
+// If 'exponent' is zero, this method returns one (+1).
+//
+// The returned value is configured with the number of
+// precision bits specified by input parameter
+// 'precisionBits'.
+func (floatHelperElectron *mathFloatHelperElectron) intPower(
+	base *big.Float,
+	exponent uint64,
+	precisionBits uint) *big.Float {
+
+	if floatHelperElectron.lock == nil {
+		floatHelperElectron.lock = new(sync.Mutex)
+	}
+
+	floatHelperElectron.lock.Lock()
+
+	defer floatHelperElectron.lock.Unlock()
+
+	result := new(big.Float).SetPrec(precisionBits).SetInt64(1)
+
+	square := new(big.Float).SetPrec(precisionBits).Set(base)
+
+	for exponent > 0 {
+
+		if exponent&1 == 1 {
+			result.Mul(result, square)
+		}
+
+		exponent >>= 1
+
+		if exponent > 0 {
+			square.Mul(square, square)
+		}
+	}
+
+	return result
+}
+
+// ln
+//
+// Computes the natural logarithm of 'num'.
+//
+// 'num' MUST be greater than zero. The calling method
+// is responsible for validating 'num'.
+//
+// The returned value is configured with the number of
+// precision bits specified by input parameter
+// 'precisionBits'.
+//
+// ----------------------------------------------------------------
+//
+// # Algorithm
+//
+// 'num' is decomposed into a mantissa and a binary
+// exponent:
+//
+//	num = m * 2^e		sqrt(0.5) <= m < sqrt(2)
+//
+//	ln(num) = ln(m) + e * ln(2)
+//
+// ln(m) is computed using the inverse hyperbolic tangent
+// series:
+//
+//	ln(m) = 2 * atanh((m - 1) / (m + 1))
+func (floatHelperElectron *mathFloatHelperElectron) ln(
+	num *big.Float,
+	precisionBits uint) *big.Float {
+
+	if floatHelperElectron.lock == nil {
+		floatHelperElectron.lock = new(sync.Mutex)
+	}
+
+	floatHelperElectron.lock.Lock()
+
+	defer floatHelperElectron.lock.Unlock()
+
+	mantissa := new(big.Float)
+
+	binaryExp := int64(num.MantExp(mantissa))
+
+	workingPrec := precisionBits + 64 +
+		uint(floatHelperElectron.bitLength(binaryExp))
+
+	mantissa.SetPrec(workingPrec)
+
+	// sqrt(0.5) = 0.70710678...
+	if mantissa.Cmp(big.NewFloat(0.7071067811865476)) < 0 {
+
+		mantissa.SetMantExp(mantissa, 1)
+
+		binaryExp--
+	}
+
+	one := new(big.Float).SetPrec(workingPrec).SetInt64(1)
+
+	numerator := new(big.Float).SetPrec(workingPrec).Sub(mantissa, one)
+
+	denominator := new(big.Float).SetPrec(workingPrec).Add(mantissa, one)
+
+	z := new(big.Float).SetPrec(workingPrec).Quo(numerator, denominator)
+
+	result := floatHelperElectron.atanhSeries(z, workingPrec)
+
+	result.SetMantExp(result, 1)
+
+	if binaryExp != 0 {
+
+		ln2 := floatHelperElectron.ln2Series(workingPrec)
+
+		ln2.Mul(
+			ln2,
+			new(big.Float).SetPrec(workingPrec).SetInt64(binaryExp))
+
+		result.Add(result, ln2)
+	}
+
+	return new(big.Float).SetPrec(precisionBits).Set(result)
+}
+
+// ln2Series
+//
+// Computes the natural logarithm of two (ln(2)) using
+// the inverse hyperbolic tangent series:
+//
+//	ln(2) = 2 * atanh(1/3)
+//
+// The returned value is configured with the number of
+// precision bits specified by input parameter
+// 'precisionBits'.
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperElectron.
+func (floatHelperElectron *mathFloatHelperElectron) ln2Series(
+	precisionBits uint) *big.Float {
+
+	workingPrec := precisionBits + 16
+
+	oneThird := new(big.Float).SetPrec(workingPrec).SetInt64(1)
+
+	oneThird.Quo(
+		oneThird,
+		new(big.Float).SetPrec(workingPrec).SetInt64(3))
+
+	result := floatHelperElectron.atanhSeries(oneThird, workingPrec)
+
+	result.SetMantExp(result, 1)
+
+	return new(big.Float).SetPrec(precisionBits).Set(result)
+}
+
+// log10Estimate
+//
+// Returns a float64 estimate of the base 10 logarithm of
+// the absolute value of 'num'. This estimate is used to
+// compute the number of integer digits in a calculation
+// result.
+//
+// 'num' MUST be a finite, non-zero value.
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperElectron.
+func (floatHelperElectron *mathFloatHelperElectron) log10Estimate(
+	num *big.Float) float64 {
+
+	mantissa := new(big.Float)
+
+	binaryExp := num.MantExp(mantissa)
+
+	mantissaFloat64, _ := mantissa.Float64()
+
+	return (math.Log2(math.Abs(mantissaFloat64)) +
+		float64(binaryExp)) * math.Log10(2)
+}
+
+// nthRoot
+//
+// Computes the nth root of 'radicand'.
+//
+//	Example:	nth root of 27 where n = 3 is 3
+//
+// 'nthRoot' MUST be greater than zero. If 'radicand' is
+// negative, 'nthRoot' MUST be an odd number. The calling
+// method is responsible for validating these input
+// parameters.
+//
+// The returned value is configured with the number of
+// precision bits specified by input parameter
+// 'precisionBits'.
+//
+// ----------------------------------------------------------------
+//
+// # Algorithm
+//
+// Square roots are computed with big.Float.Sqrt(). All
+// other roots are computed using Newton's method:
+//
+//	x(k+1) = ((n-1) * x(k) + radicand / x(k)^(n-1)) / n
+//
+// The initial estimate is computed with float64 math and
+// each iteration approximately doubles the number of
+// accurate digits.
+func (floatHelperElectron *mathFloatHelperElectron) nthRoot(
+	radicand *big.Float,
+	nthRoot int64,
+	precisionBits uint) *big.Float {
+
+	if floatHelperElectron.lock == nil {
+		floatHelperElectron.lock = new(sync.Mutex)
+	}
+
+	floatHelperElectron.lock.Lock()
+
+	defer floatHelperElectron.lock.Unlock()
+
+	if radicand.Sign() == 0 {
+		return new(big.Float).SetPrec(precisionBits)
+	}
+
+	workingPrec := precisionBits + 64 +
+		uint(floatHelperElectron.bitLength(nthRoot))
+
+	absRadicand := new(big.Float).SetPrec(workingPrec).Abs(radicand)
+
+	var root *big.Float
+
+	switch nthRoot {
+
+	case 1:
+
+		root = absRadicand
+
+	case 2:
+
+		root = new(big.Float).SetPrec(workingPrec).Sqrt(absRadicand)
+
+	default:
+
+		mantissa := new(big.Float)
+
+		binaryExp := int64(absRadicand.MantExp(mantissa))
+
+		quotientExp := binaryExp / nthRoot
+
+		remainderExp := binaryExp % nthRoot
+
+		if remainderExp < 0 {
+			remainderExp += nthRoot
+			quotientExp--
+		}
+
+		mantissaFloat64, _ := mantissa.Float64()
+
+		root = new(big.Float).SetPrec(workingPrec).SetFloat64(
+			math.Exp((math.Log(mantissaFloat64) +
+				float64(remainderExp)*math.Ln2) /
+				float64(nthRoot)))
+
+		root.SetMantExp(root, int(quotientExp))
+
+		nMinus1 := new(big.Float).SetPrec(workingPrec).SetInt64(nthRoot - 1)
+
+		nFloat := new(big.Float).SetPrec(workingPrec).SetInt64(nthRoot)
+
+		nextRoot := new(big.Float).SetPrec(workingPrec)
+
+		delta := new(big.Float).SetPrec(workingPrec)
+
+		var rootPower *big.Float
+
+		for i := 0; i < 1000; i++ {
+
+			rootPower = new(mathFloatHelperElectron).intPower(
+				root,
+				uint64(nthRoot-1),
+				workingPrec)
+
+			nextRoot.Quo(absRadicand, rootPower)
+
+			delta.Mul(nMinus1, root)
+
+			nextRoot.Add(nextRoot, delta)
+
+			nextRoot.Quo(nextRoot, nFloat)
+
+			delta.Sub(nextRoot, root)
+
+			root.Set(nextRoot)
+
+			if delta.Sign() == 0 ||
+				delta.MantExp(nil) <
+					root.MantExp(nil)-int(workingPrec)+8 {
+
+				break
+			}
+		}
+	}
+
+	if radicand.Sign() < 0 {
+		root.Neg(root)
+	}
+
+	return new(big.Float).SetPrec(precisionBits).Set(root)
+}
+
+// perfectIntRoot
+//
+// Determines whether 'num' is a perfect nth power and,
+// if so, returns the exact integer nth root of 'num'.
+//
+//	Example:	perfectIntRoot(125, 3) = 5, true
+//				perfectIntRoot(126, 3) = nil, false
+//
+// 'num' MUST be greater than zero and 'nthRoot' MUST be
+// greater than zero. The calling method is responsible
+// for validating these parameters.
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperElectron.
+func (floatHelperElectron *mathFloatHelperElectron) perfectIntRoot(
+	num *big.Int,
+	nthRoot int64) (
+	*big.Int,
+	bool) {
+
+	if nthRoot == 1 ||
+		num.Cmp(big.NewInt(1)) == 0 {
+
+		return new(big.Int).Set(num), true
+	}
+
+	// The smallest perfect nth power greater than one
+	// is 2^n which has a bit length of n+1.
+	if int64(num.BitLen()) <= nthRoot {
+		return nil, false
+	}
+
+	precisionBits := uint(int64(num.BitLen())/nthRoot) + 64
+
+	estimate := floatHelperElectron.nthRoot(
+		new(big.Float).SetPrec(precisionBits).SetInt(num),
+		nthRoot,
+		precisionBits)
+
+	estimate.Add(estimate, big.NewFloat(0.5))
+
+	root, _ := estimate.Int(nil)
+
+	bigNthRoot := big.NewInt(nthRoot)
+
+	candidate := new(big.Int)
+
+	for delta := int64(-1); delta <= 1; delta++ {
+
+		candidate.Add(root, big.NewInt(delta))
+
+		if candidate.Sign() <= 0 {
+			continue
+		}
+
+		if new(big.Int).Exp(candidate, bigNthRoot, nil).Cmp(num) == 0 {
+			return candidate, true
+		}
+	}
+
+	return nil, false
+}
+
+// piSeries
+//
+// Computes the value of Pi (π) using Machin's formula:
+//
+//	π = 16 * atan(1/5) - 4 * atan(1/239)
+//
+// The returned value is configured with the number of
+// precision bits specified by input parameter
+// 'precisionBits'.
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperElectron.
+func (floatHelperElectron *mathFloatHelperElectron) piSeries(
+	precisionBits uint) *big.Float {
+
+	workingPrec := precisionBits + 16
+
+	oneFifth := new(big.Float).SetPrec(workingPrec).SetInt64(1)
+
+	oneFifth.Quo(
+		oneFifth,
+		new(big.Float).SetPrec(workingPrec).SetInt64(5))
+
+	oneTwoThirtyNinth := new(big.Float).SetPrec(workingPrec).SetInt64(1)
+
+	oneTwoThirtyNinth.Quo(
+		oneTwoThirtyNinth,
+		new(big.Float).SetPrec(workingPrec).SetInt64(239))
+
+	pi := floatHelperElectron.atanSeries(oneFifth, workingPrec)
+
+	pi.SetMantExp(pi, 4)
+
+	term := floatHelperElectron.atanSeries(oneTwoThirtyNinth, workingPrec)
+
+	term.SetMantExp(term, 2)
+
+	pi.Sub(pi, term)
+
+	return new(big.Float).SetPrec(precisionBits).Set(pi)
+}
+
+// ratToFloat
+//
+// Converts the exact value 'num' to a big.Float
+// configured with 'precisionBits' plus 64 guard bits of
+// precision. The guard bits ensure that the rounding
+// error introduced by this conversion falls well below
+// the precision of the calculation result.
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperElectron.
+func (floatHelperElectron *mathFloatHelperElectron) ratToFloat(
+	num *big.Rat,
+	precisionBits uint) *big.Float {
+
+	return new(big.Float).SetPrec(precisionBits + 64).SetRat(num)
+}
+
+// sinCos
+//
+// Computes both the sine and the cosine of 'radians'.
+//
+// The returned values are configured with the number of
+// precision bits specified by input parameter
+// 'precisionBits'.
+//
+// ----------------------------------------------------------------
+//
+// # Algorithm
+//
+// The argument is first reduced by a multiple of π/2:
+//
+//	radians = k * π/2 + r		|r| <= π/4
+//
+// Pi is computed with enough additional precision bits
+// to offset the integer digits in 'radians'. sin(r) and
+// cos(r) are then evaluated with their Taylor series
+// and mapped to the correct quadrant using 'k' modulo
+// four.
+func (floatHelperElectron *mathFloatHelperElectron) sinCos(
+	radians *big.Float,
+	precisionBits uint) (
+	sine *big.Float,
+	cosine *big.Float) {
+
+	if floatHelperElectron.lock == nil {
+		floatHelperElectron.lock = new(sync.Mutex)
+	}
+
+	floatHelperElectron.lock.Lock()
+
+	defer floatHelperElectron.lock.Unlock()
+
+	if radians.Sign() == 0 {
+
+		return new(big.Float).SetPrec(precisionBits),
+			new(big.Float).SetPrec(precisionBits).SetInt64(1)
+	}
+
+	workingPrec := precisionBits + 64
+
+	piPrec := workingPrec + 64
+
+	if binaryExp := radians.MantExp(nil); binaryExp > 0 {
+		piPrec += uint(binaryExp)
+	}
+
+	halfPi := floatHelperElectron.piSeries(piPrec)
+
+	halfPi.SetMantExp(halfPi, -1)
+
+	quotient := new(big.Float).SetPrec(piPrec).Quo(radians, halfPi)
+
+	if quotient.Sign() < 0 {
+		quotient.Sub(quotient, big.NewFloat(0.5))
+	} else {
+		quotient.Add(quotient, big.NewFloat(0.5))
+	}
+
+	k, _ := quotient.Int(nil)
+
+	reduced := new(big.Float).SetPrec(piPrec).SetInt(k)
+
+	reduced.Mul(reduced, halfPi)
+
+	reduced.Sub(
+		new(big.Float).SetPrec(piPrec).Set(radians),
+		reduced)
+
+	reduced.SetPrec(workingPrec)
+
+	sinSum := new(big.Float).SetPrec(workingPrec).Set(reduced)
+
+	cosSum := new(big.Float).SetPrec(workingPrec).SetInt64(1)
+
+	if reduced.Sign() != 0 {
+
+		term := new(big.Float).SetPrec(workingPrec).Set(reduced)
+
+		divisor := new(big.Float).SetPrec(workingPrec)
+
+		threshold := reduced.MantExp(nil) - int(workingPrec)
+
+		for n := int64(2); ; n++ {
+
+			term.Mul(term, reduced)
+
+			divisor.SetInt64(n)
+
+			term.Quo(term, divisor)
+
+			if term.Sign() == 0 ||
+				term.MantExp(nil) < threshold {
+
+				break
+			}
+
+			// Terms follow the sign pattern: + + - - + + ...
+			// beginning with n = 0.
+			isNegativeTerm := (n/2)%2 == 1
+
+			sumToUpdate := sinSum
+
+			if n%2 == 0 {
+				sumToUpdate = cosSum
+			}
+
+			if isNegativeTerm {
+				sumToUpdate.Sub(sumToUpdate, term)
+			} else {
+				sumToUpdate.Add(sumToUpdate, term)
+			}
+		}
+	}
+
+	quadrant := new(big.Int).Mod(k, big.NewInt(4)).Int64()
+
+	switch quadrant {
+
+	case 1:
+
+		sinSum, cosSum = cosSum, sinSum.Neg(sinSum)
+
+	case 2:
+
+		sinSum.Neg(sinSum)
+
+		cosSum.Neg(cosSum)
+
+	case 3:
+
+		sinSum, cosSum = cosSum.Neg(cosSum), sinSum
+	}
+
+	sine = new(big.Float).SetPrec(precisionBits).Set(sinSum)
+
+	cosine = new(big.Float).SetPrec(precisionBits).Set(cosSum)
+
+	return sine, cosine
+}
@@ -0,0 +1,917 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"math"
+	"math/big"
+	"sync"
+)
+
+// mathFloatHelperMolecule
+//
+// Provides helper methods for type MathFloatHelper.
+// These methods compute transcendental functions and
+// mathematical constants to a required number of
+// fractional digits. Input values are received as exact
+// big.Rat values. All results are correctly rounded and
+// returned as both big.Float values and instances of
+// NumberStrKernel.
+type mathFloatHelperMolecule struct {
+	lock *sync.Mutex
+}
+
+// atan
+//
+// Computes the inverse tangent (arctangent) of 'num'.
+// The result is returned in radians and rounded to
+// 'requiredFractionalDigits'.
+//
+// The result is correctly rounded in accordance with
+// the rounding algorithm specified by input parameter
+// 'roundingType'.
+func (floatHelperMolecule *mathFloatHelperMolecule) atan(
+	num *big.Rat,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	errPrefDto *ePref.ErrPrefixDto) (
+	arcTangent *big.Float,
+	arcTangentNumStr NumberStrKernel,
+	err error) {
+
+	if floatHelperMolecule.lock == nil {
+		floatHelperMolecule.lock = new(sync.Mutex)
+	}
+
+	floatHelperMolecule.lock.Lock()
+
+	defer floatHelperMolecule.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"mathFloatHelperMolecule."+
+			"atan()",
+		"")
+
+	if err != nil {
+		return arcTangent, arcTangentNumStr, err
+	}
+
+	err = floatHelperMolecule.testInputValue(
+		num,
+		"num",
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
+
+	if err != nil {
+		return arcTangent, arcTangentNumStr, err
+	}
+
+	floatHelperNanobot := mathFloatHelperNanobot{}
+
+	if num.Sign() == 0 {
+
+		// atan(0) = 0
+		return floatHelperNanobot.getFinalResult(
+			big.NewFloat(0),
+			requiredFractionalDigits,
+			roundingType,
+			ePrefix)
+	}
+
+	var workingPrecisionBits uint
+
+	_,
+		workingPrecisionBits,
+		err = floatHelperNanobot.getPrecisionBits(
+		1,
+		requiredFractionalDigits,
+		0,
+		ePrefix)
+
+	if err != nil {
+		return arcTangent, arcTangentNumStr, err
+	}
+
+	return floatHelperNanobot.getCorrectlyRoundedResult(
+		func(precisionBits uint) *big.Float {
+			floatHelperElectron := mathFloatHelperElectron{}
+
+			return floatHelperElectron.atan(
+				floatHelperElectron.ratToFloat(
+					num,
+					precisionBits),
+				precisionBits)
+		},
+		workingPrecisionBits,
+		roundingType,
+		requiredFractionalDigits,
+		ePrefix.XCpy(
+			"arcTangent"))
+}
+
+// bigFloatToRat
+//
+// Converts 'inputValue' to the exactly equivalent big.Rat
+// value. Transcendental functions are computed from this
+// exact value.
+//
+// If 'inputValue' is a nil pointer or infinite, an
+// error is returned. 'inputValueName' is used to
+// identify the input value in error messages.
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperMolecule.
+func (floatHelperMolecule *mathFloatHelperMolecule) bigFloatToRat(
+	inputValue *big.Float,
+	inputValueName string,
+	ePrefix *ePref.ErrPrefixDto) (
+	exactValue *big.Rat,
+	err error) {
+
+	if inputValue == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter '%v' is a nil pointer!\n",
+			ePrefix.String(),
+			inputValueName)
+
+		return exactValue, err
+	}
+
+	if inputValue.IsInf() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter '%v' is invalid!\n"+
+			"'%v' is infinite.\n",
+			ePrefix.String(),
+			inputValueName,
+			inputValueName)
+
+		return exactValue, err
+	}
+
+	exactValue, _ = inputValue.Rat(nil)
+
+	return exactValue, err
+}
+
+// constantE
+//
+// Computes the mathematical constant 'e' (Euler's
+// number) rounded to 'requiredFractionalDigits'.
+//
+//	e = 2.71828182845904523536028747135266249775724709...
+//
+// The result is correctly rounded in accordance with
+// the rounding algorithm specified by input parameter
+// 'roundingType'.
+func (floatHelperMolecule *mathFloatHelperMolecule) constantE(
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	errPrefDto *ePref.ErrPrefixDto) (
+	eulersNumber *big.Float,
+	eulersNumberNumStr NumberStrKernel,
+	err error) {
+
+	if floatHelperMolecule.lock == nil {
+		floatHelperMolecule.lock = new(sync.Mutex)
+	}
+
+	floatHelperMolecule.lock.Lock()
+
+	defer floatHelperMolecule.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"mathFloatHelperMolecule."+
+			"constantE()",
+		"")
+
+	if err != nil {
+		return eulersNumber, eulersNumberNumStr, err
+	}
+
+	floatHelperNanobot := mathFloatHelperNanobot{}
+
+	err = floatHelperNanobot.testRoundingParams(
+		roundingType,
+		requiredFractionalDigits,
+		ePrefix)
+
+	if err != nil {
+		return eulersNumber, eulersNumberNumStr, err
+	}
+
+	var workingPrecisionBits uint
+
+	_,
+		workingPrecisionBits,
+		err = floatHelperNanobot.getPrecisionBits(
+		1,
+		requiredFractionalDigits,
+		0,
+		ePrefix)
+
+	if err != nil {
+		return eulersNumber, eulersNumberNumStr, err
+	}
+
+	return floatHelperNanobot.getCorrectlyRoundedResult(
+		func(precisionBits uint) *big.Float {
+			return new(mathFloatHelperElectron).exp(
+				big.NewFloat(1),
+				precisionBits)
+		},
+		workingPrecisionBits,
+		roundingType,
+		requiredFractionalDigits,
+		ePrefix.XCpy(
+			"eulersNumber"))
+}
+
+// constantLn2
+//
+// Computes the natural logarithm of two (ln(2)) rounded
+// to 'requiredFractionalDigits'.
+//
+//	ln(2) = 0.69314718055994530941723212145817656807550013...
+//
+// The result is correctly rounded in accordance with
+// the rounding algorithm specified by input parameter
+// 'roundingType'.
+func (floatHelperMolecule *mathFloatHelperMolecule) constantLn2(
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	errPrefDto *ePref.ErrPrefixDto) (
+	naturalLogOfTwo *big.Float,
+	naturalLogOfTwoNumStr NumberStrKernel,
+	err error) {
+
+	if floatHelperMolecule.lock == nil {
+		floatHelperMolecule.lock = new(sync.Mutex)
+	}
+
+	floatHelperMolecule.lock.Lock()
+
+	defer floatHelperMolecule.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"mathFloatHelperMolecule."+
+			"constantLn2()",
+		"")
+
+	if err != nil {
+		return naturalLogOfTwo, naturalLogOfTwoNumStr, err
+	}
+
+	floatHelperNanobot := mathFloatHelperNanobot{}
+
+	err = floatHelperNanobot.testRoundingParams(
+		roundingType,
+		requiredFractionalDigits,
+		ePrefix)
+
+	if err != nil {
+		return naturalLogOfTwo, naturalLogOfTwoNumStr, err
+	}
+
+	var workingPrecisionBits uint
+
+	_,
+		workingPrecisionBits,
+		err = floatHelperNanobot.getPrecisionBits(
+		1,
+		requiredFractionalDigits,
+		0,
+		ePrefix)
+
+	if err != nil {
+		return naturalLogOfTwo, naturalLogOfTwoNumStr, err
+	}
+
+	return floatHelperNanobot.getCorrectlyRoundedResult(
+		func(precisionBits uint) *big.Float {
+			return new(mathFloatHelperElectron).ln2Series(
+				precisionBits)
+		},
+		workingPrecisionBits,
+		roundingType,
+		requiredFractionalDigits,
+		ePrefix.XCpy(
+			"naturalLogOfTwo"))
+}
+
+// exp
+//
+// Computes 'e' raised to the power of 'exponent'
+// (e^exponent) rounded to 'requiredFractionalDigits'.
+//
+// The result is correctly rounded in accordance with
+// the rounding algorithm specified by input parameter
+// 'roundingType'.
+//
+// If the result would contain more than 1,000,000
+// integer digits, an error is returned.
+//
+// If the magnitude of the result is smaller than
+// one-hundredth of the last required fractional digit,
+// the result underflows and is rounded as a positive
+// value approaching zero. Depending on 'roundingType',
+// the rounded result is either zero or one unit in the
+// last fractional digit.
+func (floatHelperMolecule *mathFloatHelperMolecule) exp(
+	exponent *big.Rat,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	errPrefDto *ePref.ErrPrefixDto) (
+	raisedToExponent *big.Float,
+	raisedToExponentNumStr NumberStrKernel,
+	err error) {
+
+	if floatHelperMolecule.lock == nil {
+		floatHelperMolecule.lock = new(sync.Mutex)
+	}
+
+	floatHelperMolecule.lock.Lock()
+
+	defer floatHelperMolecule.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"mathFloatHelperMolecule."+
+			"exp()",
+		"")
+
+	if err != nil {
+		return raisedToExponent, raisedToExponentNumStr, err
+	}
+
+	err = floatHelperMolecule.testInputValue(
+		exponent,
+		"exponent",
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
+
+	if err != nil {
+		return raisedToExponent, raisedToExponentNumStr, err
+	}
+
+	floatHelperNanobot := mathFloatHelperNanobot{}
+
+	if exponent.Sign() == 0 {
+
+		// e^0 = 1
+		return floatHelperNanobot.getFinalResult(
+			big.NewFloat(1),
+			requiredFractionalDigits,
+			roundingType,
+			ePrefix)
+	}
+
+	const maxResultDigits = 1000000.0
+
+	exponentFloat64, _ := exponent.Float64()
+
+	exponentEstimate := new(big.Float).SetRat(exponent)
+
+	resultLog10 := exponentFloat64 / math.Ln10
+
+	if resultLog10 > maxResultDigits {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'exponent' is invalid!\n"+
+			"The magnitude of the result exceeds the maximum\n"+
+			"supported value of 10^%v.\n"+
+			"exponent = '%v'\n",
+			ePrefix.String(),
+			int64(maxResultDigits),
+			exponentEstimate.Text('g', 20))
+
+		return raisedToExponent, raisedToExponentNumStr, err
+	}
+
+	if resultLog10 < -float64(requiredFractionalDigits+2) {
+
+		// The result underflows. Any positive value less
+		// than 10^-(requiredFractionalDigits+1) produces
+		// the same rounded result.
+		underflowBits := int(
+			float64(requiredFractionalDigits+2)*math.Log2(10)) + 1
+
+		return floatHelperNanobot.getFinalResult(
+			new(big.Float).SetMantExp(
+				big.NewFloat(1),
+				-underflowBits),
+			requiredFractionalDigits,
+			roundingType,
+			ePrefix)
+	}
+
+	floatHelperElectron := mathFloatHelperElectron{}
+
+	var workingPrecisionBits uint
+
+	_,
+		workingPrecisionBits,
+		err = floatHelperNanobot.getPrecisionBits(
+		floatHelperElectron.intDigitsFromLog10(resultLog10),
+		requiredFractionalDigits,
+		floatHelperElectron.intDigitsFromLog10(
+			floatHelperElectron.log10Estimate(exponentEstimate)),
+		ePrefix)
+
+	if err != nil {
+		return raisedToExponent, raisedToExponentNumStr, err
+	}
+
+	return floatHelperNanobot.getCorrectlyRoundedResult(
+		func(precisionBits uint) *big.Float {
+			return floatHelperElectron.exp(
+				floatHelperElectron.ratToFloat(
+					exponent,
+					precisionBits),
+				precisionBits)
+		},
+		workingPrecisionBits,
+		roundingType,
+		requiredFractionalDigits,
+		ePrefix.XCpy(
+			"raisedToExponent"))
+}
+
+// ln
+//
+// Computes the natural logarithm of 'num' rounded to
+// 'requiredFractionalDigits'.
+//
+// 'num' must be greater than zero. Otherwise, an error
+// is returned.
+//
+// The result is correctly rounded in accordance with
+// the rounding algorithm specified by input parameter
+// 'roundingType'.
+func (floatHelperMolecule *mathFloatHelperMolecule) ln(
+	num *big.Rat,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	errPrefDto *ePref.ErrPrefixDto) (
+	naturalLog *big.Float,
+	naturalLogNumStr NumberStrKernel,
+	err error) {
+
+	if floatHelperMolecule.lock == nil {
+		floatHelperMolecule.lock = new(sync.Mutex)
+	}
+
+	floatHelperMolecule.lock.Lock()
+
+	defer floatHelperMolecule.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"mathFloatHelperMolecule."+
+			"ln()",
+		"")
+
+	if err != nil {
+		return naturalLog, naturalLogNumStr, err
+	}
+
+	err = floatHelperMolecule.testLogarithmInput(
+		num,
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
+
+	if err != nil {
+		return naturalLog, naturalLogNumStr, err
+	}
+
+	floatHelperNanobot := mathFloatHelperNanobot{}
+
+	if num.Cmp(big.NewRat(1, 1)) == 0 {
+
+		// ln(1) = 0
+		return floatHelperNanobot.getFinalResult(
+			big.NewFloat(0),
+			requiredFractionalDigits,
+			roundingType,
+			ePrefix)
+	}
+
+	floatHelperElectron := mathFloatHelperElectron{}
+
+	var workingPrecisionBits uint
+
+	_,
+		workingPrecisionBits,
+		err = floatHelperNanobot.getPrecisionBits(
+		floatHelperElectron.intDigitsFromLog10(
+			math.Log10(math.Abs(
+				floatHelperElectron.log10Estimate(
+					new(big.Float).SetRat(num))*math.Ln10))),
+		requiredFractionalDigits,
+		0,
+		ePrefix)
+
+	if err != nil {
+		return naturalLog, naturalLogNumStr, err
+	}
+
+	return floatHelperNanobot.getCorrectlyRoundedResult(
+		func(precisionBits uint) *big.Float {
+			return floatHelperElectron.ln(
+				floatHelperElectron.ratToFloat(
+					num,
+					precisionBits),
+				precisionBits)
+		},
+		workingPrecisionBits,
+		roundingType,
+		requiredFractionalDigits,
+		ePrefix.XCpy(
+			"naturalLog"))
+}
+
+// log10
+//
+// Computes the base 10 logarithm of 'num' rounded to
+// 'requiredFractionalDigits'.
+//
+// 'num' must be greater than zero. Otherwise, an error
+// is returned.
+//
+// The result is correctly rounded in accordance with
+// the rounding algorithm specified by input parameter
+// 'roundingType'.
+func (floatHelperMolecule *mathFloatHelperMolecule) log10(
+	num *big.Rat,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	errPrefDto *ePref.ErrPrefixDto) (
+	commonLog *big.Float,
+	commonLogNumStr NumberStrKernel,
+	err error) {
+
+	if floatHelperMolecule.lock == nil {
+		floatHelperMolecule.lock = new(sync.Mutex)
+	}
+
+	floatHelperMolecule.lock.Lock()
+
+	defer floatHelperMolecule.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"mathFloatHelperMolecule."+
+			"log10()",
+		"")
+
+	if err != nil {
+		return commonLog, commonLogNumStr, err
+	}
+
+	err = floatHelperMolecule.testLogarithmInput(
+		num,
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
+
+	if err != nil {
+		return commonLog, commonLogNumStr, err
+	}
+
+	floatHelperNanobot := mathFloatHelperNanobot{}
+
+	floatHelperElectron := mathFloatHelperElectron{}
+
+	log10Estimate := floatHelperElectron.log10Estimate(
+		new(big.Float).SetRat(num))
+
+	// log10(10^k) = k
+	powerOfTen := int64(math.Round(log10Estimate))
+
+	if math.Abs(float64(powerOfTen)) < 100000 {
+
+		tenToPower := new(big.Rat).SetInt(
+			new(big.Int).Exp(
+				big.NewInt(10),
+				big.NewInt(int64(math.Abs(float64(powerOfTen)))),
+				nil))
+
+		if powerOfTen < 0 {
+			tenToPower.Inv(tenToPower)
+		}
+
+		if num.Cmp(tenToPower) == 0 {
+
+			return floatHelperNanobot.getFinalResult(
+				new(big.Float).SetInt64(powerOfTen),
+				requiredFractionalDigits,
+				roundingType,
+				ePrefix)
+		}
+	}
+
+	var workingPrecisionBits uint
+
+	_,
+		workingPrecisionBits,
+		err = floatHelperNanobot.getPrecisionBits(
+		floatHelperElectron.intDigitsFromLog10(
+			math.Log10(math.Abs(log10Estimate))),
+		requiredFractionalDigits,
+		0,
+		ePrefix)
+
+	if err != nil {
+		return commonLog, commonLogNumStr, err
+	}
+
+	return floatHelperNanobot.getCorrectlyRoundedResult(
+		func(precisionBits uint) *big.Float {
+
+			result := floatHelperElectron.ln(
+				floatHelperElectron.ratToFloat(
+					num,
+					precisionBits),
+				precisionBits)
+
+			return result.Quo(
+				result,
+				floatHelperElectron.ln(
+					big.NewFloat(10),
+					precisionBits))
+		},
+		workingPrecisionBits,
+		roundingType,
+		requiredFractionalDigits,
+		ePrefix.XCpy(
+			"commonLog"))
+}
+
+// sinCosTan
+//
+// Computes the sine, cosine or tangent of 'radians'
+// rounded to 'requiredFractionalDigits'.
+//
+// Input parameter 'trigFunction' selects the
+// trigonometric function. It must be set to one of the
+// following values:
+//
+//	"sin"
+//	"cos"
+//	"tan"
+//
+// The result is correctly rounded in accordance with
+// the rounding algorithm specified by input parameter
+// 'roundingType'.
+//
+// If the absolute value of 'radians' is greater than or
+// equal to 10^1000, an error is returned.
+func (floatHelperMolecule *mathFloatHelperMolecule) sinCosTan(
+	radians *big.Rat,
+	trigFunction string,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	errPrefDto *ePref.ErrPrefixDto) (
+	result *big.Float,
+	resultNumStr NumberStrKernel,
+	err error) {
+
+	if floatHelperMolecule.lock == nil {
+		floatHelperMolecule.lock = new(sync.Mutex)
+	}
+
+	floatHelperMolecule.lock.Lock()
+
+	defer floatHelperMolecule.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"mathFloatHelperMolecule."+
+			"sinCosTan()",
+		"")
+
+	if err != nil {
+		return result, resultNumStr, err
+	}
+
+	if trigFunction != "sin" &&
+		trigFunction != "cos" &&
+		trigFunction != "tan" {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'trigFunction' is invalid!\n"+
+			"'trigFunction' must be set to \"sin\", \"cos\" or \"tan\".\n"+
+			"trigFunction = '%v'\n",
+			ePrefix.String(),
+			trigFunction)
+
+		return result, resultNumStr, err
+	}
+
+	err = floatHelperMolecule.testInputValue(
+		radians,
+		"radians",
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
+
+	if err != nil {
+		return result, resultNumStr, err
+	}
+
+	floatHelperNanobot := mathFloatHelperNanobot{}
+
+	if radians.Sign() == 0 {
+
+		// sin(0) = 0, cos(0) = 1, tan(0) = 0
+		exactResult := big.NewFloat(0)
+
+		if trigFunction == "cos" {
+			exactResult.SetInt64(1)
+		}
+
+		return floatHelperNanobot.getFinalResult(
+			exactResult,
+			requiredFractionalDigits,
+			roundingType,
+			ePrefix)
+	}
+
+	const maxRadiansDigits = 1000.0
+
+	floatHelperElectron := mathFloatHelperElectron{}
+
+	radiansEstimate := new(big.Float).SetRat(radians)
+
+	radiansLog10 := floatHelperElectron.log10Estimate(radiansEstimate)
+
+	if radiansLog10 >= maxRadiansDigits {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'radians' is invalid!\n"+
+			"The absolute value of 'radians' is greater than or\n"+
+			"equal to the maximum value of 10^%v.\n"+
+			"radians = '%v'\n",
+			ePrefix.String(),
+			int64(maxRadiansDigits),
+			radiansEstimate.Text('g', 20))
+
+		return result, resultNumStr, err
+	}
+
+	resultIntDigits := int64(1)
+
+	if trigFunction == "tan" {
+
+		radiansFloat64, _ := radiansEstimate.Float64()
+
+		tangentFloat64 := math.Abs(math.Tan(radiansFloat64))
+
+		if tangentFloat64 > 1 {
+			resultIntDigits = floatHelperElectron.intDigitsFromLog10(
+				math.Log10(tangentFloat64))
+		}
+	}
+
+	var workingPrecisionBits uint
+
+	_,
+		workingPrecisionBits,
+		err = floatHelperNanobot.getPrecisionBits(
+		resultIntDigits,
+		requiredFractionalDigits,
+		floatHelperElectron.intDigitsFromLog10(radiansLog10),
+		ePrefix)
+
+	if err != nil {
+		return result, resultNumStr, err
+	}
+
+	return floatHelperNanobot.getCorrectlyRoundedResult(
+		func(precisionBits uint) *big.Float {
+
+			sine, cosine := floatHelperElectron.sinCos(
+				floatHelperElectron.ratToFloat(
+					radians,
+					precisionBits),
+				precisionBits)
+
+			switch trigFunction {
+
+			case "sin":
+
+				return sine
+
+			case "cos":
+
+				return cosine
+
+			default:
+
+				return sine.Quo(sine, cosine)
+			}
+		},
+		workingPrecisionBits,
+		roundingType,
+		requiredFractionalDigits,
+		ePrefix.XCpy(
+			trigFunction+"(radians)"))
+}
+
+// testInputValue
+//
+// Validates the input value and rounding parameters
+// submitted for a transcendental function calculation.
+//
+// If 'inputValue' is a nil pointer, an error is
+// returned. 'inputValueName' is used to identify the
+// input value in error messages.
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperMolecule.
+func (floatHelperMolecule *mathFloatHelperMolecule) testInputValue(
+	inputValue *big.Rat,
+	inputValueName string,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	ePrefix *ePref.ErrPrefixDto) error {
+
+	if inputValue == nil {
+
+		return fmt.Errorf("%v\n"+
+			"Error: Input parameter '%v' is a nil pointer!\n",
+			ePrefix.String(),
+			inputValueName)
+	}
+
+	return new(mathFloatHelperNanobot).testRoundingParams(
+		roundingType,
+		requiredFractionalDigits,
+		ePrefix)
+}
+
+// testLogarithmInput
+//
+// Validates the input value and rounding parameters
+// submitted for a logarithm calculation. 'num' must be
+// a finite value greater than zero.
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperMolecule.
+func (floatHelperMolecule *mathFloatHelperMolecule) testLogarithmInput(
+	num *big.Rat,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	ePrefix *ePref.ErrPrefixDto) error {
+
+	err := floatHelperMolecule.testInputValue(
+		num,
+		"num",
+		requiredFractionalDigits,
+		roundingType,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	if num.Sign() <= 0 {
+
+		return fmt.Errorf("%v\n"+
+			"Error: Input parameter 'num' is invalid!\n"+
+			"Logarithms are only defined for numbers greater than zero.\n"+
+			"num = '%v'\n",
+			ePrefix.String(),
+			new(big.Float).SetRat(num).Text('g', 20))
+	}
+
+	return nil
+}
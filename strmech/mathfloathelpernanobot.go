@@ -0,0 +1,1289 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"math"
+	"math/big"
+	"sync"
+)
+
+// mathFloatHelperNanobot
+//
+// Provides helper methods for type MathFloatHelper.
+// These methods compute roots and powers to a required
+// number of fractional digits and return the results
+// as both big.Float values and instances of
+// NumberStrKernel. This type also provides the
+// rounding services used by the transcendental
+// functions implemented by type mathFloatHelperMolecule.
+type mathFloatHelperNanobot struct {
+	lock *sync.Mutex
+}
+
+// exactRationalPower
+//
+// Attempts to compute 'base' raised to the power of
+// 'exponent' as an exact rational value.
+//
+// Where 'exponent' = p/q in lowest terms, the result is
+// rational if, and only if, the numerator and
+// denominator of 'base' are both perfect qth powers. In
+// that case the result is computed exactly and
+// 'isExact' is set to 'true'.
+//
+//	Examples:
+//		10 ^ -3    = 1/1000
+//		100 ^ 1.5  = 1000
+//		9 ^ -0.5   = 1/3
+//
+// If the result is irrational, or if the exact result
+// would require more than 4,194,304 bits of storage,
+// 'isExact' is set to 'false' and the calling method
+// must compute an approximate result.
+//
+// If 'base' is negative, the calling method must ensure
+// that the denominator of 'exponent' is odd. If 'base'
+// is zero, the calling method must ensure that
+// 'exponent' is positive.
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperNanobot.
+func (floatHelperNanobot *mathFloatHelperNanobot) exactRationalPower(
+	base *big.Rat,
+	exponent *big.Rat) (
+	exactResult *big.Rat,
+	isExact bool) {
+
+	const maxExactPowerBits = 1 << 22
+
+	if base.Sign() == 0 {
+		return new(big.Rat), true
+	}
+
+	if !exponent.Denom().IsInt64() {
+		return exactResult, false
+	}
+
+	nthRoot := exponent.Denom().Int64()
+
+	floatHelperElectron := mathFloatHelperElectron{}
+
+	absBase := new(big.Rat).Abs(base)
+
+	var rootNum, rootDenom *big.Int
+
+	rootNum,
+		isExact = floatHelperElectron.perfectIntRoot(
+		absBase.Num(),
+		nthRoot)
+
+	if !isExact {
+		return exactResult, false
+	}
+
+	rootDenom,
+		isExact = floatHelperElectron.perfectIntRoot(
+		absBase.Denom(),
+		nthRoot)
+
+	if !isExact {
+		return exactResult, false
+	}
+
+	power := new(big.Int).Abs(exponent.Num())
+
+	exactResult = big.NewRat(1, 1)
+
+	if rootNum.Cmp(rootDenom) != 0 {
+
+		if !power.IsInt64() ||
+			float64(rootNum.BitLen()+rootDenom.BitLen())*
+				float64(power.Int64()) > maxExactPowerBits {
+
+			return nil, false
+		}
+
+		rootNum.Exp(rootNum, power, nil)
+
+		rootDenom.Exp(rootDenom, power, nil)
+
+		if exponent.Sign() < 0 {
+			rootNum, rootDenom = rootDenom, rootNum
+		}
+
+		exactResult.SetFrac(rootNum, rootDenom)
+	}
+
+	if base.Sign() < 0 &&
+		exponent.Num().Bit(0) == 1 {
+
+		exactResult.Neg(exactResult)
+	}
+
+	return exactResult, true
+}
+
+// getCorrectlyRoundedResult
+//
+// Computes a transcendental function result and rounds
+// that result to the number of fractional digits
+// specified by input parameter
+// 'requiredFractionalDigits'. The returned result is
+// guaranteed to be correctly rounded.
+//
+// The result is returned as both a big.Float value and
+// an instance of NumberStrKernel.
+//
+// ----------------------------------------------------------------
+//
+// # Algorithm
+//
+// Input parameter 'calculation' is a function which
+// computes the result at a specified number of
+// precision bits. The result is first computed at
+// 'workingPrecisionBits' and then recomputed with an
+// additional 64-bits of precision. The difference
+// between these two values, doubled, serves as an upper
+// bound for the error in the more precise value.
+//
+// If the more precise value minus the error bound and
+// the more precise value plus the error bound round to
+// the same result, that result is correctly rounded and
+// is returned. Otherwise, the working precision is
+// doubled and the process is repeated.
+//
+// This technique requires that 'calculation' NOT
+// produce a result which lies exactly on a rounding
+// boundary. Exact results such as e^0 = 1 must be
+// handled by the calling method.
+//
+// If a correctly rounded result cannot be established
+// after eight attempts, an error is returned.
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperNanobot.
+func (floatHelperNanobot *mathFloatHelperNanobot) getCorrectlyRoundedResult(
+	calculation func(precisionBits uint) *big.Float,
+	workingPrecisionBits uint,
+	roundingType NumberRoundingType,
+	requiredFractionalDigits int,
+	ePrefix *ePref.ErrPrefixDto) (
+	roundedResult *big.Float,
+	roundedNumStrKernel NumberStrKernel,
+	err error) {
+
+	const maxAttempts = 8
+
+	const guardBits = 64
+
+	var estimate, refined, errorBound, lowerBound, upperBound *big.Float
+
+	var lowerNumStrKernel, upperNumStrKernel NumberStrKernel
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+
+		estimate = calculation(workingPrecisionBits)
+
+		refined = calculation(workingPrecisionBits + guardBits)
+
+		boundsPrec := workingPrecisionBits + (2 * guardBits)
+
+		errorBound = new(big.Float).SetPrec(boundsPrec).Sub(refined, estimate)
+
+		errorBound.Abs(errorBound)
+
+		minimumBound := new(big.Float).SetPrec(boundsPrec).SetMantExp(
+			big.NewFloat(1),
+			refined.MantExp(nil)-int(workingPrecisionBits))
+
+		if errorBound.Cmp(minimumBound) < 0 {
+			errorBound.Set(minimumBound)
+		}
+
+		errorBound.SetMantExp(errorBound, 1)
+
+		lowerBound = new(big.Float).SetPrec(boundsPrec).Sub(refined, errorBound)
+
+		upperBound = new(big.Float).SetPrec(boundsPrec).Add(refined, errorBound)
+
+		_,
+			lowerNumStrKernel,
+			err = floatHelperNanobot.getRoundedResult(
+			lowerBound,
+			boundsPrec,
+			roundingType,
+			requiredFractionalDigits,
+			ePrefix.XCpy(
+				fmt.Sprintf("lowerBound attempt=%v",
+					attempt)))
+
+		if err != nil {
+			return roundedResult, roundedNumStrKernel, err
+		}
+
+		_,
+			upperNumStrKernel,
+			err = floatHelperNanobot.getRoundedResult(
+			upperBound,
+			boundsPrec,
+			roundingType,
+			requiredFractionalDigits,
+			ePrefix.XCpy(
+				fmt.Sprintf("upperBound attempt=%v",
+					attempt)))
+
+		if err != nil {
+			return roundedResult, roundedNumStrKernel, err
+		}
+
+		if new(numberStrKernelElectron).equal(
+			&lowerNumStrKernel,
+			&upperNumStrKernel) {
+
+			return floatHelperNanobot.getFinalResult(
+				refined,
+				requiredFractionalDigits,
+				roundingType,
+				ePrefix)
+		}
+
+		workingPrecisionBits *= 2
+	}
+
+	err = fmt.Errorf("%v\n"+
+		"Error: Unable to establish a correctly rounded result!\n"+
+		"The result could not be rounded to %v fractional digits\n"+
+		"after %v attempts. Final working precision bits = '%v'\n",
+		ePrefix.String(),
+		requiredFractionalDigits,
+		maxAttempts,
+		workingPrecisionBits)
+
+	return roundedResult, roundedNumStrKernel, err
+}
+
+// getFinalResult
+//
+// Receives a final calculation result and rounds that
+// result to the number of fractional digits specified
+// by input parameter 'requiredFractionalDigits'. The
+// number of precision bits used to store the rounded
+// result is computed from the number of integer digits
+// in 'finalResult'.
+//
+// Final results include exact results such as e^0 = 1
+// or ln(1) = 0 as well as transcendental results
+// verified by method getCorrectlyRoundedResult().
+//
+// The rounded result is returned as both a big.Float
+// value and an instance of NumberStrKernel.
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperNanobot.
+func (floatHelperNanobot *mathFloatHelperNanobot) getFinalResult(
+	finalResult *big.Float,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	ePrefix *ePref.ErrPrefixDto) (
+	roundedResult *big.Float,
+	roundedNumStrKernel NumberStrKernel,
+	err error) {
+
+	floatHelperElectron := mathFloatHelperElectron{}
+
+	resultIntDigits := int64(1)
+
+	if finalResult.Sign() != 0 {
+		resultIntDigits = floatHelperElectron.intDigitsFromLog10(
+			floatHelperElectron.log10Estimate(finalResult))
+	}
+
+	var precisionBits uint
+
+	precisionBits,
+		_,
+		err = floatHelperNanobot.getPrecisionBits(
+		resultIntDigits,
+		requiredFractionalDigits,
+		0,
+		ePrefix)
+
+	if err != nil {
+		return roundedResult, roundedNumStrKernel, err
+	}
+
+	return floatHelperNanobot.getRoundedResult(
+		finalResult,
+		precisionBits,
+		roundingType,
+		requiredFractionalDigits,
+		ePrefix.XCpy(
+			"roundedResult<-finalResult"))
+}
+
+// getPrecisionBits
+//
+// Computes the precision bits used to store a rounded
+// calculation result ('precisionBits') and the
+// precision bits used to perform the calculation
+// ('workingPrecisionBits').
+//
+// 'precisionBits' is computed from the estimated number
+// of integer digits in the result, the required number
+// of fractional digits and a buffer of twenty digits.
+//
+// 'workingPrecisionBits' adds a second buffer of twenty
+// digits plus the number of extra digits specified by
+// input parameter 'extraWorkingDigits'.
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperNanobot.
+func (floatHelperNanobot *mathFloatHelperNanobot) getPrecisionBits(
+	resultIntDigits int64,
+	requiredFractionalDigits int,
+	extraWorkingDigits int64,
+	ePrefix *ePref.ErrPrefixDto) (
+	precisionBits uint,
+	workingPrecisionBits uint,
+	err error) {
+
+	const bufferDigits = int64(20)
+
+	precisionBits,
+		err = new(mathFloatHelperAtom).
+		precisionBitsFromRequiredDigits(
+			resultIntDigits,
+			int64(requiredFractionalDigits),
+			bufferDigits,
+			ePrefix.XCpy(
+				"precisionBits"))
+
+	if err != nil {
+		return precisionBits, workingPrecisionBits, err
+	}
+
+	workingPrecisionBits,
+		err = new(mathFloatHelperPreon).
+		estimateDigitsToPrecision(
+			resultIntDigits+
+				int64(requiredFractionalDigits)+
+				(2*bufferDigits)+
+				extraWorkingDigits,
+			ePrefix.XCpy(
+				"workingPrecisionBits"))
+
+	return precisionBits, workingPrecisionBits, err
+}
+
+// getRoundedResult
+//
+// Receives a big.Float calculation result computed at
+// working precision and rounds that result to the
+// number of fractional digits specified by input
+// parameter 'requiredFractionalDigits'.
+//
+// The rounded result is returned as both a big.Float
+// value configured with 'precisionBits' and an instance
+// of NumberStrKernel.
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperNanobot.
+func (floatHelperNanobot *mathFloatHelperNanobot) getRoundedResult(
+	rawResult *big.Float,
+	precisionBits uint,
+	roundingType NumberRoundingType,
+	requiredFractionalDigits int,
+	ePrefix *ePref.ErrPrefixDto) (
+	roundedResult *big.Float,
+	roundedNumStrKernel NumberStrKernel,
+	err error) {
+
+	roundedResult = new(big.Float).SetPrec(precisionBits)
+
+	if rawResult == nil ||
+		rawResult.IsInf() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'rawResult' is invalid!\n"+
+			"'rawResult' is a nil pointer or an infinite value.\n",
+			ePrefix.String())
+
+		return roundedResult, roundedNumStrKernel, err
+	}
+
+	if roundingType == NumRoundType.NoRounding() {
+
+		var numberStats NumberStrStatsDto
+
+		numberStats,
+			err = new(mathFloatHelperMechanics).
+			floatNumToIntFracRunes(
+				rawResult,
+				&roundedNumStrKernel.integerDigits,
+				&roundedNumStrKernel.fractionalDigits,
+				ePrefix.XCpy(
+					"roundedNumStrKernel<-rawResult"))
+
+		if err != nil {
+			return roundedResult, roundedNumStrKernel, err
+		}
+
+		roundedNumStrKernel.isNonZeroValue = !numberStats.IsZeroValue
+
+		roundedNumStrKernel.numberSign = numberStats.NumberSign
+
+		roundedNumStrKernel.numberValueType = numberStats.NumberValueType
+
+	} else {
+
+		if requiredFractionalDigits < 0 {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'requiredFractionalDigits' is invalid!\n"+
+				"'requiredFractionalDigits' has a value less than zero.\n"+
+				"requiredFractionalDigits = '%v'\n",
+				ePrefix.String(),
+				requiredFractionalDigits)
+
+			return roundedResult, roundedNumStrKernel, err
+		}
+
+		// A big.Float value is an exact binary fraction.
+		// Rounding the exact rational value guarantees
+		// correct results for every rounding algorithm,
+		// including 'Ceiling' and 'Floor'.
+		exactRat, _ := rawResult.Rat(nil)
+
+		roundedNumStrKernel,
+			err = floatHelperNanobot.roundRatToNumStrKernel(
+			exactRat,
+			roundingType,
+			requiredFractionalDigits,
+			ePrefix)
+
+		if err != nil {
+			return roundedResult, roundedNumStrKernel, err
+		}
+	}
+
+	err = floatHelperNanobot.setResultFromNumStrKernel(
+		roundedResult,
+		&roundedNumStrKernel,
+		ePrefix)
+
+	return roundedResult, roundedNumStrKernel, err
+}
+
+// getRoundedRatResult
+//
+// Receives an exact rational calculation result and
+// rounds that result to the number of fractional digits
+// specified by input parameter
+// 'requiredFractionalDigits'.
+//
+// Because 'exactResult' is exact, the rounded result is
+// correct for every rounding algorithm, including
+// 'Ceiling', 'Floor' and 'Truncate', even where the
+// result is a finite decimal value such as 10^-3.
+//
+// The rounded result is returned as both a big.Float
+// value configured with 'precisionBits' and an instance
+// of NumberStrKernel.
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperNanobot.
+func (floatHelperNanobot *mathFloatHelperNanobot) getRoundedRatResult(
+	exactResult *big.Rat,
+	precisionBits uint,
+	roundingType NumberRoundingType,
+	requiredFractionalDigits int,
+	ePrefix *ePref.ErrPrefixDto) (
+	roundedResult *big.Float,
+	roundedNumStrKernel NumberStrKernel,
+	err error) {
+
+	roundedResult = new(big.Float).SetPrec(precisionBits)
+
+	if exactResult == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'exactResult' is a nil pointer!\n",
+			ePrefix.String())
+
+		return roundedResult, roundedNumStrKernel, err
+	}
+
+	roundedNumStrKernel,
+		err = floatHelperNanobot.roundRatToNumStrKernel(
+		exactResult,
+		roundingType,
+		requiredFractionalDigits,
+		ePrefix)
+
+	if err != nil {
+		return roundedResult, roundedNumStrKernel, err
+	}
+
+	err = floatHelperNanobot.setResultFromNumStrKernel(
+		roundedResult,
+		&roundedNumStrKernel,
+		ePrefix)
+
+	return roundedResult, roundedNumStrKernel, err
+}
+
+// roundRatToNumStrKernel
+//
+// Rounds an exact rational value to the number of
+// fractional digits specified by input parameter
+// 'requiredFractionalDigits' and returns the rounded
+// value as a new instance of NumberStrKernel.
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperNanobot.
+func (floatHelperNanobot *mathFloatHelperNanobot) roundRatToNumStrKernel(
+	exactRat *big.Rat,
+	roundingType NumberRoundingType,
+	requiredFractionalDigits int,
+	ePrefix *ePref.ErrPrefixDto) (
+	roundedNumStrKernel NumberStrKernel,
+	err error) {
+
+	if requiredFractionalDigits < 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'requiredFractionalDigits' is invalid!\n"+
+			"'requiredFractionalDigits' has a value less than zero.\n"+
+			"requiredFractionalDigits = '%v'\n",
+			ePrefix.String(),
+			requiredFractionalDigits)
+
+		return roundedNumStrKernel, err
+	}
+
+	scaledNumerator := new(big.Int).Mul(
+		exactRat.Num(),
+		new(big.Int).Exp(
+			big.NewInt(10),
+			big.NewInt(int64(requiredFractionalDigits)),
+			nil))
+
+	var scaledResult *big.Int
+
+	scaledResult,
+		err = new(bigDecimalAtom).roundBigIntQuotient(
+		scaledNumerator,
+		exactRat.Denom(),
+		roundingType,
+		ePrefix.XCpy(
+			"scaledResult"))
+
+	if err != nil {
+		return roundedNumStrKernel, err
+	}
+
+	numberSign := NumSignVal.Zero()
+
+	if scaledResult.Sign() > 0 {
+		numberSign = NumSignVal.Positive()
+	} else if scaledResult.Sign() < 0 {
+		numberSign = NumSignVal.Negative()
+	}
+
+	err = new(numStrMathArithmeticElectron).
+		setFromScaledDigits(
+			&roundedNumStrKernel,
+			[]rune(new(big.Int).Abs(scaledResult).Text(10)),
+			requiredFractionalDigits,
+			numberSign,
+			nil,
+			ePrefix.XCpy(
+				"roundedNumStrKernel<-scaledResult"))
+
+	return roundedNumStrKernel, err
+}
+
+// setResultFromNumStrKernel
+//
+// Sets the numeric value of 'roundedResult' from the
+// rounded value contained in 'roundedNumStrKernel'.
+// 'roundedResult' retains its original precision bits.
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperNanobot.
+func (floatHelperNanobot *mathFloatHelperNanobot) setResultFromNumStrKernel(
+	roundedResult *big.Float,
+	roundedNumStrKernel *NumberStrKernel,
+	ePrefix *ePref.ErrPrefixDto) (
+	err error) {
+
+	_,
+		err = new(numberStrKernelElectron).getSetIsNonZeroValue(
+		roundedNumStrKernel,
+		ePrefix.XCpy(
+			"roundedNumStrKernel"))
+
+	if err != nil {
+		return err
+	}
+
+	var pureNumberStr string
+
+	pureNumberStr,
+		_,
+		err = roundedNumStrKernel.FmtNumStrPure(
+		".",
+		true,
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"roundedNumStrKernel"))
+
+	if err != nil {
+		return err
+	}
+
+	var ok bool
+
+	_,
+		ok = roundedResult.SetString(
+		pureNumberStr)
+
+	if !ok {
+
+		err = fmt.Errorf("\n%v\n"+
+			"Error: roundedResult.SetString(pureNumberStr) FAILED!\n"+
+			"big.Float was unable to set the number string value\n"+
+			"for the rounded calculation result.\n"+
+			"pureNumberStr = %v",
+			ePrefix.String(),
+			pureNumberStr)
+
+	}
+
+	return err
+}
+
+// nthRoot
+//
+// Computes the nth root of 'radicand' and rounds the
+// result to the number of fractional digits specified
+// by input parameter 'requiredFractionalDigits'.
+//
+//	Example:	nth root of 27 where n = 3 is 3
+//
+// The number of precision bits required to store the
+// rounded result is computed from the estimated number
+// of integer digits in the result plus the required
+// number of fractional digits.
+//
+// 'radicand' is treated as the decimal value which it
+// represents. Where the root is a rational number, such
+// as the square root of 0.0625, it is computed exactly
+// and then rounded. All other roots are irrational and
+// are correctly rounded by method
+// getCorrectlyRoundedResult().
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	radicand					*big.Float
+//
+//		The number from which the nth root will be
+//		extracted.
+//
+//		If 'radicand' is negative and 'nthRoot' is an
+//		even number, an error will be returned.
+//
+//	nthRoot						int64
+//
+//		The root to be extracted from 'radicand'. If
+//		'nthRoot' is less than one (+1), an error will be
+//		returned.
+//
+//	requiredFractionalDigits	int
+//
+//		The number of accurate fractional digits required
+//		in the calculation result. If this value is less
+//		than zero, an error will be returned.
+//
+//	roundingType				NumberRoundingType
+//
+//		The rounding algorithm applied to the calculation
+//		result. NumRoundType.None() and
+//		NumRoundType.NoRounding() are invalid.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	root						*big.Float
+//
+//		The nth root of 'radicand' rounded to
+//		'requiredFractionalDigits'.
+//
+//	rootNumStrKernel			NumberStrKernel
+//
+//		The nth root of 'radicand' rounded to
+//		'requiredFractionalDigits' and returned as an
+//		instance of NumberStrKernel.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+func (floatHelperNanobot *mathFloatHelperNanobot) nthRoot(
+	radicand *big.Float,
+	nthRoot int64,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	errPrefDto *ePref.ErrPrefixDto) (
+	root *big.Float,
+	rootNumStrKernel NumberStrKernel,
+	err error) {
+
+	if floatHelperNanobot.lock == nil {
+		floatHelperNanobot.lock = new(sync.Mutex)
+	}
+
+	floatHelperNanobot.lock.Lock()
+
+	defer floatHelperNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"mathFloatHelperNanobot."+
+			"nthRoot()",
+		"")
+
+	if err != nil {
+		return root, rootNumStrKernel, err
+	}
+
+	if radicand == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'radicand' is a nil pointer!\n",
+			ePrefix.String())
+
+		return root, rootNumStrKernel, err
+	}
+
+	if radicand.IsInf() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'radicand' is invalid!\n"+
+			"'radicand' is infinite.\n",
+			ePrefix.String())
+
+		return root, rootNumStrKernel, err
+	}
+
+	if nthRoot < 1 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'nthRoot' is invalid!\n"+
+			"'nthRoot' must be greater than zero.\n"+
+			"nthRoot = '%v'\n",
+			ePrefix.String(),
+			nthRoot)
+
+		return root, rootNumStrKernel, err
+	}
+
+	if radicand.Sign() < 0 &&
+		nthRoot%2 == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'radicand' is invalid!\n"+
+			"'radicand' is negative and 'nthRoot' is an even number.\n"+
+			"Even roots of negative numbers are not real numbers.\n"+
+			"radicand = '%v'\n"+
+			"nthRoot  = '%v'\n",
+			ePrefix.String(),
+			radicand.Text('f', -1),
+			nthRoot)
+
+		return root, rootNumStrKernel, err
+	}
+
+	err = floatHelperNanobot.testRoundingParams(
+		roundingType,
+		requiredFractionalDigits,
+		ePrefix)
+
+	if err != nil {
+		return root, rootNumStrKernel, err
+	}
+
+	floatHelperElectron := mathFloatHelperElectron{}
+
+	resultIntDigits := int64(1)
+
+	if radicand.Sign() != 0 {
+
+		resultIntDigits = floatHelperElectron.intDigitsFromLog10(
+			floatHelperElectron.log10Estimate(radicand) /
+				float64(nthRoot))
+	}
+
+	var precisionBits, workingPrecisionBits uint
+
+	precisionBits,
+		workingPrecisionBits,
+		err = floatHelperNanobot.getPrecisionBits(
+		resultIntDigits,
+		requiredFractionalDigits,
+		int64(floatHelperElectron.bitLength(nthRoot)),
+		ePrefix)
+
+	if err != nil {
+		return root, rootNumStrKernel, err
+	}
+
+	radicandRat := floatHelperElectron.decimalRat(radicand)
+
+	exactResult, isExact := floatHelperNanobot.exactRationalPower(
+		radicandRat,
+		big.NewRat(1, nthRoot))
+
+	if isExact {
+
+		return floatHelperNanobot.getRoundedRatResult(
+			exactResult,
+			precisionBits,
+			roundingType,
+			requiredFractionalDigits,
+			ePrefix.XCpy(
+				"root"))
+	}
+
+	// The root is irrational and can never fall exactly
+	// on a rounding boundary.
+	return floatHelperNanobot.getCorrectlyRoundedResult(
+		func(precisionBits uint) *big.Float {
+
+			return floatHelperElectron.nthRoot(
+				new(big.Float).SetPrec(precisionBits).SetRat(radicandRat),
+				nthRoot,
+				precisionBits)
+		},
+		workingPrecisionBits,
+		roundingType,
+		requiredFractionalDigits,
+		ePrefix.XCpy(
+			"root"))
+}
+
+// pow
+//
+// Raises 'base' to the power of 'exponent' and rounds
+// the result to the number of fractional digits
+// specified by input parameter
+// 'requiredFractionalDigits'.
+//
+//	Examples:
+//		2 ^ 10   = 1024
+//		2 ^ -2   = 0.25
+//		2 ^ 0.5  = 1.41421356237...
+//
+// 'exponent' may be positive, negative, an integer or a
+// non-integer value.
+//
+// ----------------------------------------------------------------
+//
+// # Algorithm
+//
+// 'base' and 'exponent' are treated as the decimal
+// values which they represent. Where the result is a
+// rational number, it is computed exactly as a big.Rat
+// and then rounded. This includes all integer exponents
+// (10 ^ -3 = 1/1000) and those non-integer exponents
+// which produce rational results (100 ^ 1.5 = 1000).
+// Exact results are therefore rounded correctly by
+// every rounding algorithm, including 'Ceiling',
+// 'Floor' and 'Truncate'.
+//
+// All other results are irrational and are computed as:
+//
+//	base ^ exponent = e ^ (exponent * ln(base))
+//
+// Irrational results are correctly rounded by method
+// getCorrectlyRoundedResult().
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	base						*big.Float
+//
+//		The number to be raised to the power of
+//		'exponent'.
+//
+//		If 'base' is negative and 'exponent' is not an
+//		integer value, an error will be returned.
+//
+//		If 'base' is zero and 'exponent' is negative,
+//		an error will be returned.
+//
+//	exponent					*big.Float
+//
+//		The power to which 'base' will be raised.
+//
+//		If the result of this calculation would contain
+//		more than 1,000,000 integer digits, or if the
+//		magnitude of the result would be less than
+//		10^-1,000,000, an error will be returned.
+//
+//	requiredFractionalDigits	int
+//
+//		The number of accurate fractional digits required
+//		in the calculation result. If this value is less
+//		than zero, an error will be returned.
+//
+//	roundingType				NumberRoundingType
+//
+//		The rounding algorithm applied to the calculation
+//		result. NumRoundType.None() and
+//		NumRoundType.NoRounding() are invalid.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	raisedToExponent			*big.Float
+//
+//		'base' raised to the power of 'exponent' and
+//		rounded to 'requiredFractionalDigits'.
+//
+//	raisedToExponentNumStr		NumberStrKernel
+//
+//		'base' raised to the power of 'exponent' and
+//		rounded to 'requiredFractionalDigits', returned
+//		as an instance of NumberStrKernel.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+func (floatHelperNanobot *mathFloatHelperNanobot) pow(
+	base *big.Float,
+	exponent *big.Float,
+	requiredFractionalDigits int,
+	roundingType NumberRoundingType,
+	errPrefDto *ePref.ErrPrefixDto) (
+	raisedToExponent *big.Float,
+	raisedToExponentNumStr NumberStrKernel,
+	err error) {
+
+	if floatHelperNanobot.lock == nil {
+		floatHelperNanobot.lock = new(sync.Mutex)
+	}
+
+	floatHelperNanobot.lock.Lock()
+
+	defer floatHelperNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"mathFloatHelperNanobot."+
+			"pow()",
+		"")
+
+	if err != nil {
+		return raisedToExponent, raisedToExponentNumStr, err
+	}
+
+	if base == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'base' is a nil pointer!\n",
+			ePrefix.String())
+
+		return raisedToExponent, raisedToExponentNumStr, err
+	}
+
+	if exponent == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'exponent' is a nil pointer!\n",
+			ePrefix.String())
+
+		return raisedToExponent, raisedToExponentNumStr, err
+	}
+
+	if base.IsInf() ||
+		exponent.IsInf() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameters 'base' and 'exponent' are invalid!\n"+
+			"'base' or 'exponent' is infinite.\n",
+			ePrefix.String())
+
+		return raisedToExponent, raisedToExponentNumStr, err
+	}
+
+	err = floatHelperNanobot.testRoundingParams(
+		roundingType,
+		requiredFractionalDigits,
+		ePrefix)
+
+	if err != nil {
+		return raisedToExponent, raisedToExponentNumStr, err
+	}
+
+	floatHelperElectron := mathFloatHelperElectron{}
+
+	baseRat := floatHelperElectron.decimalRat(base)
+
+	exponentRat := floatHelperElectron.decimalRat(exponent)
+
+	isIntegerExponent := exponentRat.IsInt()
+
+	var rawResult *big.Float
+
+	var precisionBits, workingPrecisionBits uint
+
+	if exponent.Sign() == 0 ||
+		base.Sign() == 0 {
+
+		if base.Sign() == 0 &&
+			exponent.Sign() < 0 {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameters 'base' and 'exponent' are invalid!\n"+
+				"'base' is zero and 'exponent' is negative.\n"+
+				"Zero raised to a negative power is undefined.\n"+
+				"exponent = '%v'\n",
+				ePrefix.String(),
+				exponent.Text('f', -1))
+
+			return raisedToExponent, raisedToExponentNumStr, err
+		}
+
+		// x^0 = 1 and 0^y = 0 where y > 0
+		rawResult = big.NewFloat(0)
+
+		if exponent.Sign() == 0 {
+			rawResult.SetInt64(1)
+		}
+
+		precisionBits,
+			_,
+			err = floatHelperNanobot.getPrecisionBits(
+			1,
+			requiredFractionalDigits,
+			0,
+			ePrefix)
+
+		if err != nil {
+			return raisedToExponent, raisedToExponentNumStr, err
+		}
+
+		return floatHelperNanobot.getRoundedResult(
+			rawResult,
+			precisionBits,
+			roundingType,
+			requiredFractionalDigits,
+			ePrefix.XCpy(
+				"raisedToExponent"))
+	}
+
+	if base.Sign() < 0 &&
+		!isIntegerExponent {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameters 'base' and 'exponent' are invalid!\n"+
+			"'base' is negative and 'exponent' is not an integer value.\n"+
+			"The result is not a real number.\n"+
+			"base     = '%v'\n"+
+			"exponent = '%v'\n",
+			ePrefix.String(),
+			base.Text('f', -1),
+			exponent.Text('f', -1))
+
+		return raisedToExponent, raisedToExponentNumStr, err
+	}
+
+	exponentFloat64, _ := exponent.Float64()
+
+	resultLog10 := exponentFloat64 *
+		floatHelperElectron.log10Estimate(base)
+
+	const maxResultDigits = 1000000.0
+
+	if math.IsInf(resultLog10, 0) ||
+		math.IsNaN(resultLog10) ||
+		math.Abs(resultLog10) > maxResultDigits {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameters 'base' and 'exponent' are invalid!\n"+
+			"The magnitude of the result exceeds the supported range\n"+
+			"of 10^-%v through 10^%v.\n"+
+			"base     = '%v'\n"+
+			"exponent = '%v'\n",
+			ePrefix.String(),
+			int64(maxResultDigits),
+			int64(maxResultDigits),
+			base.Text('g', 20),
+			exponent.Text('g', 20))
+
+		return raisedToExponent, raisedToExponentNumStr, err
+	}
+
+	resultIntDigits := floatHelperElectron.intDigitsFromLog10(
+		resultLog10)
+
+	exactResult, isExact := floatHelperNanobot.exactRationalPower(
+		baseRat,
+		exponentRat)
+
+	if isExact {
+
+		precisionBits,
+			_,
+			err = floatHelperNanobot.getPrecisionBits(
+			resultIntDigits,
+			requiredFractionalDigits,
+			0,
+			ePrefix)
+
+		if err != nil {
+			return raisedToExponent, raisedToExponentNumStr, err
+		}
+
+		return floatHelperNanobot.getRoundedRatResult(
+			exactResult,
+			precisionBits,
+			roundingType,
+			requiredFractionalDigits,
+			ePrefix.XCpy(
+				"raisedToExponent"))
+	}
+
+	// base ^ exponent = e ^ (exponent * ln(base))
+	lnDigits := floatHelperElectron.intDigitsFromLog10(
+		math.Log10(math.Abs(resultLog10*math.Ln10) + 1))
+
+	_,
+		workingPrecisionBits,
+		err = floatHelperNanobot.getPrecisionBits(
+		resultIntDigits,
+		requiredFractionalDigits,
+		lnDigits,
+		ePrefix)
+
+	if err != nil {
+		return raisedToExponent, raisedToExponentNumStr, err
+	}
+
+	absBaseRat := new(big.Rat).Abs(baseRat)
+
+	// Negative base with a very large integer exponent
+	isNegativeResult := baseRat.Sign() < 0 &&
+		exponentRat.Num().Bit(0) == 1
+
+	return floatHelperNanobot.getCorrectlyRoundedResult(
+		func(precisionBits uint) *big.Float {
+
+			result := floatHelperElectron.ln(
+				new(big.Float).SetPrec(precisionBits).SetRat(absBaseRat),
+				precisionBits)
+
+			result.Mul(
+				result,
+				new(big.Float).SetPrec(precisionBits).SetRat(exponentRat))
+
+			result = floatHelperElectron.exp(
+				result,
+				precisionBits)
+
+			if isNegativeResult {
+				result.Neg(result)
+			}
+
+			return result
+		},
+		workingPrecisionBits,
+		roundingType,
+		requiredFractionalDigits,
+		ePrefix.XCpy(
+			"raisedToExponent"))
+}
+
+// testRoundingParams
+//
+// Validates the rounding type and the number of required
+// fractional digits submitted for a root or power
+// calculation.
+//
+// NumRoundType.None() and NumRoundType.NoRounding() are
+// invalid because calculation results are computed with
+// extra working digits which must be rounded away.
+//
+// This method does NOT lock the current instance of
+// mathFloatHelperNanobot.
+func (floatHelperNanobot *mathFloatHelperNanobot) testRoundingParams(
+	roundingType NumberRoundingType,
+	requiredFractionalDigits int,
+	ePrefix *ePref.ErrPrefixDto) error {
+
+	if !roundingType.XIsValid() ||
+		roundingType == NumRoundType.NoRounding() {
+
+		return fmt.Errorf("%v\n"+
+			"Error: Input parameter 'roundingType' is invalid!\n"+
+			"Root and power calculations require an explicit rounding type.\n"+
+			"roundingType string value  = '%v'\n"+
+			"roundingType integer value = '%v'\n",
+			ePrefix.String(),
+			roundingType.String(),
+			roundingType.XValueInt())
+	}
+
+	if requiredFractionalDigits < 0 {
+
+		return fmt.Errorf("%v\n"+
+			"Error: Input parameter 'requiredFractionalDigits' is invalid!\n"+
+			"'requiredFractionalDigits' is less than zero.\n"+
+			"requiredFractionalDigits = '%v'\n",
+			ePrefix.String(),
+			requiredFractionalDigits)
+	}
+
+	return nil
+}
@@ -62,9 +62,188 @@ type NumberStrKernel struct {
 	//
 	//	NumberStrKernel.SetDefaultNumStrFmtSpec()
 
+	jsonMarshalFormat NumberStrJsonFormat
+	// Specifies whether the numeric value will be
+	// encoded as a quoted string or as a bare number
+	// when this instance of NumberStrKernel is
+	// marshalled to JSON. If this value is invalid or
+	// set to 'None', the numeric value will be encoded
+	// as a quoted string.
+	//
+	// To set the JSON Marshal Format, use this method:
+	//
+	//	NumberStrKernel.SetJsonMarshalFormat()
+
 	lock *sync.Mutex
 }
 
+//	Add
+//
+//	Adds the numeric value of an incoming instance of
+//	NumberStrKernel ('addend') to the numeric value of
+//	the current NumberStrKernel instance and returns the
+//	sum as a new instance of NumberStrKernel.
+//
+//	The addition is performed directly on the integer and
+//	fractional digit arrays. No conversion to float64,
+//	big.Float or big.Rat is performed. Therefore, the
+//	returned sum is exact.
+//
+//	The number of fractional digits in the returned sum
+//	is equal to the greater number of fractional digits
+//	contained in the two addends.
+//
+//		Example
+//			Current Instance:	123.45
+//			addend:				-0.125
+//			sum:				123.325
+//
+//	The returned sum will be configured with the Default
+//	Number String Format Specification copied from the
+//	current instance of NumberStrKernel. Therefore, the
+//	sum is ready for formatting with any of the
+//	NumberStrKernel 'Fmt' methods.
+//
+// ----------------------------------------------------------------
+//
+// # BE ADVISED
+//
+//	Neither the current instance of NumberStrKernel nor
+//	the input parameter 'addend' will be modified by
+//	this method.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	addend						*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		numeric value of this instance will be added to
+//		that of the current instance of NumberStrKernel.
+//
+//		If 'addend' is invalid, an error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	sum							NumberStrKernel
+//
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		containing the sum of the current NumberStrKernel
+//		numeric value and that of 'addend'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) Add(
+	addend *NumberStrKernel,
+	errorPrefix interface{}) (
+	sum NumberStrKernel,
+	err error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"Add()",
+		"")
+
+	if err != nil {
+		return sum, err
+	}
+
+	err = new(numStrMathArithmeticNanobot).
+		addNumStrKernels(
+			&sum,
+			numStrKernel,
+			addend,
+			ePrefix.XCpy(
+				"sum<-numStrKernel+addend"))
+
+	return sum, err
+}
+
 //	AddFractionalDigit
 //
 //	Appends a single numeric digit to the end of the internal
@@ -1100,126 +1279,336 @@ func (numStrKernel *NumberStrKernel) DeleteLeadingTrailingIntegerChars(
 	return err
 }
 
-// Empty
+//	Divide
 //
-// Resets all internal member variables for the current
-// instance of NumberStrKernel to their initial or zero
-// values.
+//	Divides the numeric value of the current instance of
+//	NumberStrKernel by the numeric value of an incoming
+//	NumberStrKernel instance ('divisor') and returns the
+//	quotient as a new instance of NumberStrKernel.
 //
-// ----------------------------------------------------------------
+//	The division is performed directly on the integer and
+//	fractional digit arrays using long division. No
+//	conversion to float64, big.Float or big.Rat is
+//	performed.
 //
-// # IMPORTANT
+//	The quotient is computed exactly to one digit beyond
+//	the requested 'precision'. Any non-zero remainder is
+//	taken into account before the rounding algorithm
+//	specified by 'roundingType' is applied. As a result,
+//	the returned quotient is correctly rounded to
+//	'precision' fractional digits.
 //
-// This method will delete all pre-existing internal member
-// variable data values in the current instance of NumberStrKernel.
+//		Example
+//			Current Instance:	2
+//			divisor:			3
+//			precision:			5
+//			roundingType:		NumRoundType.HalfAwayFromZero()
+//			quotient:			0.66667
 //
-// ------------------------------------------------------------------------
+//	The returned quotient will be configured with the
+//	Default Number String Format Specification copied
+//	from the current instance of NumberStrKernel.
 //
-// Input Parameters
+// ----------------------------------------------------------------
 //
-//	NONE
+// # BE ADVISED
 //
-// ------------------------------------------------------------------------
+//	Neither the current instance of NumberStrKernel nor
+//	the input parameter 'divisor' will be modified by
+//	this method.
 //
-// Return Values
+// ----------------------------------------------------------------
 //
-//	NONE
-func (numStrKernel *NumberStrKernel) Empty() {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	new(numberStrKernelElectron).empty(
-		numStrKernel)
-
-	numStrKernel.lock.Unlock()
-
-	numStrKernel.lock = nil
-
-	return
-}
-
-// EmptyFractionalDigits - Sets the Fractional Digits rune array
-// to 'nil'. This effectively deletes the previous fractional
-// digits stored in that array.
+// # Input Parameters
 //
-// ----------------------------------------------------------------
+//	divisor						*NumberStrKernel
 //
-// # IMPORTANT
+//		A pointer to an instance of NumberStrKernel. The
+//		numeric value of the current NumberStrKernel
+//		instance will be divided by the numeric value of
+//		'divisor'.
 //
-// All the text characters contained in the member variable
-// NumberStrKernel.fractionalDigits will be deleted. The
-// NumberStrKernel.fractionalDigits rune array will set to a value
-// of 'nil'.
+//		If 'divisor' is invalid, or if 'divisor' has a
+//		numeric value of zero, an error will be returned.
 //
-// ----------------------------------------------------------------
+//	precision					int
 //
-// Input Parameters
+//		Specifies the number of fractional digits to the
+//		right of the radix point or decimal separator
+//		(a.k.a. decimal point) which will be returned in
+//		the quotient.
 //
-//	NONE
+//		If 'precision' is less than zero, an error will
+//		be returned.
 //
-// ----------------------------------------------------------------
+//	roundingType				NumberRoundingType
 //
-// Return Values
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied
+//		to the quotient.
 //
-//	NONE
-func (numStrKernel *NumberStrKernel) EmptyFractionalDigits() {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	_ = new(numberStrKernelAtom).emptyFractionalDigits(
-		numStrKernel,
-		nil)
-
-	return
-}
-
-// EmptyIntegerDigits - Sets the Integer Digits rune array
-// to 'nil'. This effectively deletes the previous integer
-// digits stored in that array.
+//		Possible values are listed as follows:
+//			NumRoundType.NoRounding()
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
 //
-// ----------------------------------------------------------------
+//		Since the quotient of a division operation may
+//		contain an infinite number of fractional digits,
+//		NumRoundType.NoRounding() is treated as
+//		NumRoundType.Truncate().
 //
-// # IMPORTANT
+//	errorPrefix					interface{}
 //
-// All the text characters contained in the member variable
-// NumberStrKernel.integerDigits will be deleted. The
-// NumberStrKernel.integerDigits rune array will set to a value of
-// 'nil'.
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-// ------------------------------------------------------------------------
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-// Input Parameters
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//	NONE
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-// ------------------------------------------------------------------------
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-// Return Values
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//	NONE
-func (numStrKernel *NumberStrKernel) EmptyIntegerDigits() {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	_ = new(numberStrKernelAtom).emptyIntegerDigits(
-		numStrKernel,
-		nil)
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	quotient					NumberStrKernel
+//
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		containing the result of dividing the current
+//		NumberStrKernel numeric value by 'divisor'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) Divide(
+	divisor *NumberStrKernel,
+	precision int,
+	roundingType NumberRoundingType,
+	errorPrefix interface{}) (
+	quotient NumberStrKernel,
+	err error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"Divide()",
+		"")
+
+	if err != nil {
+		return quotient, err
+	}
+
+	err = new(numStrMathArithmeticNanobot).
+		divideNumStrKernels(
+			&quotient,
+			numStrKernel,
+			divisor,
+			precision,
+			roundingType,
+			ePrefix.XCpy(
+				"quotient<-numStrKernel/divisor"))
+
+	return quotient, err
+}
+
+// Empty
+//
+// Resets all internal member variables for the current
+// instance of NumberStrKernel to their initial or zero
+// values.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// This method will delete all pre-existing internal member
+// variable data values in the current instance of NumberStrKernel.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//	NONE
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//	NONE
+func (numStrKernel *NumberStrKernel) Empty() {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	new(numberStrKernelElectron).empty(
+		numStrKernel)
+
+	numStrKernel.lock.Unlock()
+
+	numStrKernel.lock = nil
+
+	return
+}
+
+// EmptyFractionalDigits - Sets the Fractional Digits rune array
+// to 'nil'. This effectively deletes the previous fractional
+// digits stored in that array.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// All the text characters contained in the member variable
+// NumberStrKernel.fractionalDigits will be deleted. The
+// NumberStrKernel.fractionalDigits rune array will set to a value
+// of 'nil'.
+//
+// ----------------------------------------------------------------
+//
+// Input Parameters
+//
+//	NONE
+//
+// ----------------------------------------------------------------
+//
+// Return Values
+//
+//	NONE
+func (numStrKernel *NumberStrKernel) EmptyFractionalDigits() {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	_ = new(numberStrKernelAtom).emptyFractionalDigits(
+		numStrKernel,
+		nil)
+
+	return
+}
+
+// EmptyIntegerDigits - Sets the Integer Digits rune array
+// to 'nil'. This effectively deletes the previous integer
+// digits stored in that array.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// All the text characters contained in the member variable
+// NumberStrKernel.integerDigits will be deleted. The
+// NumberStrKernel.integerDigits rune array will set to a value of
+// 'nil'.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//	NONE
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//	NONE
+func (numStrKernel *NumberStrKernel) EmptyIntegerDigits() {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	_ = new(numberStrKernelAtom).emptyIntegerDigits(
+		numStrKernel,
+		nil)
 
 	return
 }
@@ -7778,370 +8167,323 @@ func (numStrKernel *NumberStrKernel) FmtCurrencySimple(
 			ePrefix.XCpy("numStrKernel"))
 }
 
-//	FmtNumericValue
+// FmtCurrencyWords
 //
-//	Converts the numeric value encapsulated by the current
-//	instance of NumberStrKernel to one of several numeric
-//	value types.
+// Spells out the numeric value of the current
+// NumberStrKernel instance as a currency amount in
+// words. This format is typically used when writing
+// cheques and legal documents.
 //
-//	The converted numeric value is returned through input
-//	parameter 'numericValue' defined as an empty interface
-//	(type interface{}).
+// The numeric value is first rounded to the number of
+// minor currency unit digits specified by the Country
+// Culture Specification ('CurrencyDecimalDigits'). The
+// integer value is then spelled out in words followed
+// by the currency unit name ('CurrencyName'). The minor
+// currency units are presented either as a fraction or
+// in words using the minor currency unit name
+// ('MinorCurrencyName').
 //
-//	The user has only to pass a pointer to one of the
-//	supported numeric value types through input parameter
-//	'numericValue'. Thereafter, the NumberStrKernel numeric
-//	value will be stored in the concrete numeric value type
-//	designated by parameter 'numericValue'.
+// The first letter of the returned string is always
+// capitalized.
 //
-//	The user also has the option of specifying a rounded
-//	numeric value return using input parameters
-//	'roundingType' and 'roundToFactionalDigits'.
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// This method will NOT change the numeric value of the
+// current NumberStrKernel instance.
 //
 // ----------------------------------------------------------------
 //
-// # Usage
+// # Input Parameters
 //
-//		intDigits := "12345"
-//		fracDigits := ""
+//	roundingType				NumberRoundingType
 //
-//		sMechNStrKernel := strmech.NumberStrKernel{}
-//		numberSign := strmech.NumSignVal.None()
+//		This parameter specifies the rounding algorithm
+//		applied when rounding the numeric value to the
+//		number of minor currency unit digits.
 //
-//		numStrKernel,
-//		err := sMechNStrKernel.NewFromStringDigits(
-//					intDigits,
-//					fracDigits,
-//					numberSign,
-//					ePrefix.XCpy(
-//					fmt.Sprintf(
-//					"intDigits=%v",
-//					intDigits)))
+//		If 'roundingType' is set to
+//		NumRoundType.NoRounding(), excess fractional
+//		digits will be truncated.
 //
-//		if err != nil {
-//			fmt.Println(
-//			fmt.Sprintf("%v",
-//			err.Error()))
+//		For a description of rounding algorithms,
+//		reference type NumberRoundingType.
 //
-//			return
-//		}
+//	countryCultureSpec			NumStrFmtCountryCultureSpec
 //
-//		var bigIntNumber *big.Int
+//		The Country Culture Specification identifies the
+//		language used to spell out the numeric value.
+//		The language is selected using the two character
+//		country code ('CountryCodeTwoChar'). Supported
+//		countries are listed as follows:
 //
-//		var roundType = strmech.NumRoundType.HalfAwayFromZero()
+//		 Country Code	Language	Default Scale
+//		 ------------	--------	-------------
+//			"US"		English		Short Scale
+//			"GB"		English		Short Scale
+//			"FR"		French		Long Scale
+//			"DE"		German		Long Scale
 //
-//		***************************************************
+//		British English ("GB") inserts the word "and"
+//		before the tens and units ("one hundred and
+//		five").
 //
-//		After returning from this method call,
-//	 'bigIntNumber' will be populated with
-//		the value of the NumberStrKernel value,
-//		'12345'.
-//		_,
-//		err = numStrKernel.FmtNumericValue(
-//				&bigIntNumber,
-//				roundType,
-//				0,
-//				ePrefix)
+//		Country Culture Specifications for these
+//		countries are created by the following methods:
 //
-//		if err != nil {
-//			fmt.Println(
-//			fmt.Sprintf("%v",
-//			err.Error()))
-//			return
-//		}
-//
-//		***************************************************
+//			NumStrFmtCountryCultureSpec.NewUS()
+//			NumStrFmtCountryCultureSpec.NewUK()
+//			NumStrFmtCountryCultureSpec.NewFrance()
+//			NumStrFmtCountryCultureSpec.NewGermany()
 //
-// ----------------------------------------------------------------
+//		If the country is not supported, an error will
+//		be returned.
 //
-// # Input Parameters
+//	numberScale					NumberWordsScale
 //
-//	numericValue				interface{}
+//		Specifies the naming system for large numbers.
+//		This parameter must be set to one of the
+//		following values:
 //
-//		An empty interface containing a pointer to a
-//		supported numeric value type. The concrete
-//		type identified by this pointer will be used
-//		to store the numeric value extracted and
-//		converted from the current NumberStrKernel
-//		instance.
+//		NumWordsScale.None()
+//			The default scale for the language will be
+//			applied.
 //
-//		An error will be returned if the concrete type
-//		identified by the pointer passed through this
-//		parameter does not match one of the supported
-//		types below.
+//		NumWordsScale.ShortScale()
+//			1,000,000,000 = "one billion"
 //
-//		Supported Numeric Value ('numericValue') Types:
+//		NumWordsScale.LongScale()
+//			1,000,000,000 = "one thousand million"
+//			1,000,000,000 = "un milliard"
+//			1,000,000,000 = "eine Milliarde"
 //
-//			*float32, *float64, *big.Float
-//			*BigFloatDto
-//			*TextFieldFormatDtoFloat64
-//			*TextFieldFormatDtoBigFloat
-//			*big.Rat
-//			*int8, *int16, *int, *int32, *int64,
-//			*big.Int
-//			*uint8, *uint16, *uint, *uint32, *uint64
-//			*NumberStrKernel
+//		Integer values may contain a maximum of 66
+//		significant digits. If this limit is exceeded,
+//		an error will be returned.
 //
-//		Any type passed through this empty interface which
-//		is not listed above will generate an error.
+//	minorUnitsInWords			bool
 //
-//	roundingType				NumberRoundingType
+//		If this parameter is set to 'false', the minor
+//		currency units will be presented as a fraction:
 //
-//		This enumeration parameter is used to specify the
-//		type of rounding algorithm that will be applied for
-//		the	rounding of fractional digits contained in the
-//		numeric value returned through input parameter
-//		numericValue.
+//			"One thousand two hundred thirty-four
+//			 dollars and 56/100"
 //
-//		'roundingType' is only applied in cases where the
-//		current NumberStrKernel instance consists of a
-//		floating point numeric value.
+//		If this parameter is set to 'true', the minor
+//		currency units will be spelled out in words:
 //
-//		If in doubt as to a suitable rounding method,
-//		'HalfAwayFromZero' is recommended.
+//			"One thousand two hundred thirty-four
+//			 dollars and fifty-six cents"
 //
-//		Possible values are listed as follows:
-//			NumRoundType.None()	- Invalid Value
-//			NumRoundType.NoRounding()
-//			NumRoundType.HalfUpWithNegNums()
-//			NumRoundType.HalfDownWithNegNums()
-//			NumRoundType.HalfAwayFromZero()
-//			NumRoundType.HalfTowardsZero()
-//			NumRoundType.HalfToEven()
-//			NumRoundType.HalfToOdd()
-//			NumRoundType.Randomly()
-//			NumRoundType.Floor()
-//			NumRoundType.Ceiling()
-//			NumRoundType.Truncate()
+//		When spelled out in words, zero minor units are
+//		omitted ("Five dollars").
 //
-//		Definitions:
+//	errorPrefix					interface{}
 //
-//			NoRounding
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//				Signals that no rounding operation will be
-//				performed on fractional digits. The
-//				fractional digits will therefore remain
-//				unchanged.
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//			HalfUpWithNegNums
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//				Half Round Up Including Negative Numbers.
-//				This method is intuitive but may produce
-//				unexpected results when applied to negative
-//				numbers.
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//				'HalfUpWithNegNums' rounds .5 up.
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//					Examples of 'HalfUpWithNegNums'
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8
-//					7.4 rounds down to 7
-//					-7.4 rounds up to -7
-//					-7.5 rounds up to -7
-//					-7.6 rounds down to -8
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//			HalfDownWithNegNums
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//			Half Round Down Including Negative Numbers. This
-//			method is also considered intuitive but may
-//			produce unexpected results when applied to
-//			negative numbers.
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//			'HalfDownWithNegNums' rounds .5 down.
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//				Examples of HalfDownWithNegNums
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//				7.6 rounds up to 8
-//				7.5 rounds down to 7
-//				7.4 rounds down to 7
-//				-7.4 rounds up to -7
-//				-7.5 rounds down to -8
-//				-7.6 rounds down to -8
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
 //
-//			HalfAwayFromZero
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
-//				The 'HalfAwayFromZero' method rounds .5 further
-//				away from zero.	It provides clear and consistent
-//				behavior when dealing with negative numbers.
+// ----------------------------------------------------------------
 //
-//					Examples of HalfAwayFromZero
+// # Return Values
 //
-//					7.6 rounds away to 8
-//					7.5 rounds away to 8
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds away to -8
-//					-7.6 rounds away to -8
+//	string
 //
-//			HalfTowardsZero
+//		If this method completes successfully, this
+//		parameter will return the numeric value of the
+//		current NumberStrKernel instance spelled out as a
+//		currency amount in words.
 //
-//				Round Half Towards Zero. 'HalfTowardsZero' rounds
-//				0.5	closer to zero. It provides clear and
-//				consistent behavior	when dealing with negative
-//				numbers.
+//			1234.56  US
+//				"One thousand two hundred thirty-four
+//				 dollars and 56/100"
 //
-//					Examples of HalfTowardsZero
+//			1234.56  France
+//				"Mille deux cent trente-quatre euros
+//				 et 56/100"
 //
-//					7.6 rounds away to 8
-//					7.5 rounds to 7
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds to -7
-//					-7.6 rounds away to -8
+//			1234.56  Germany
+//				"Eintausendzweihundertvierunddreißig Euro
+//				 und 56/100"
 //
-//			HalfToEven
+//	error
 //
-//				Round Half To Even Numbers. 'HalfToEven' is
-//				also called	Banker's Rounding. This method
-//				rounds 0.5 to the nearest even digit.
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
 //
-//					Examples of HalfToEven
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) FmtCurrencyWords(
+	roundingType NumberRoundingType,
+	countryCultureSpec NumStrFmtCountryCultureSpec,
+	numberScale NumberWordsScale,
+	minorUnitsInWords bool,
+	errorPrefix interface{}) (
+	string,
+	error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"FmtCurrencyWords()",
+		"")
+
+	if err != nil {
+		return "", err
+	}
+
+	return new(numStrNumberWordsNanobot).
+		formatCurrencyWords(
+			numStrKernel,
+			roundingType,
+			&countryCultureSpec,
+			numberScale,
+			minorUnitsInWords,
+			ePrefix.XCpy(
+				"numStrKernel"))
+}
+
+// FmtEngNotation
 //
-//					7.5 rounds up to 8 (because 8 is an even
-//					number)	but 6.5 rounds down to 6 (because
-//					6 is an even number)
+// Formats the numeric value of the current
+// NumberStrKernel instance as a number string in
+// Engineering Notation.
 //
-//					HalfToEven only applies to 0.5. Other
-//					numbers (not ending	in 0.5) round to
-//					nearest as usual, so:
+// In Engineering Notation, the exponent of ten is
+// always a multiple of three. Alternatively, the power
+// of ten may be expressed as an SI (International
+// System of Units) prefix symbol or name.
 //
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8 (because 8 is an even number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds down to 6 (because 6 is an even number)
-//					6.4 rounds down to 6
+// The decimal separator is always a period ('.') and
+// negative values are prefixed with a leading minus
+// sign ('-').
 //
-//			HalfToOdd
+//	Examples: Numeric Value 4,700 Unit Symbol "Ω"
 //
-//				Round Half to Odd Numbers. Similar to 'HalfToEven',
-//				but in this case 'HalfToOdd' rounds 0.5 towards odd
-//				numbers.
+//		EngNotFmt.Exponential()				"4.7 x 10^3 Ω"
+//		EngNotFmt.ENotUprCaseELeadPlus()	"4.7E+3 Ω"
+//		EngNotFmt.ENotUprCaseENoLeadPlus()	"4.7E3 Ω"
+//		EngNotFmt.ENotLwrCaseELeadPlus()	"4.7e+3 Ω"
+//		EngNotFmt.ENotLwrCaseENoLeadPlus()	"4.7e3 Ω"
+//		EngNotFmt.SIPrefixSymbol()			"4.7 kΩ"
+//		EngNotFmt.SIPrefixName()			"4.7 kiloΩ"
 //
-//					Examples of HalfToOdd
+//	Examples: Numeric Value 4,700 No Unit Symbol
 //
-//					HalfToOdd only applies to 0.5. Other numbers
-//					(not ending	in 0.5) round to nearest as usual.
+//		EngNotFmt.SIPrefixSymbol()			"4.7 k"
+//		EngNotFmt.SIPrefixName()			"4.7 kilo"
 //
-//					7.5 rounds down to 7 (because 7 is an odd number)
+// ----------------------------------------------------------------
 //
-//					6.5 rounds up to 7 (because 7 is an odd number)
+// # Input Parameters
 //
-//					7.6 rounds up to 8
-//					7.5 rounds down to 7 (because 7 is an odd number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds up to 7 (because 7 is an odd number)
-//					6.4 rounds down to 6
+//	engNotFmt					EngineeringNotationFormat
 //
-//			Randomly
+//		Specifies the Engineering Notation display
+//		format. If this value is invalid, an error will
+//		be returned.
 //
-//				Round Half Randomly. Uses a Random Number Generator
-//				to choose between rounding 0.5 up or down.
+//		For SI Prefix formats, the exponent must be in the
+//		range -24 through +24. Otherwise, an error will
+//		be returned. An exponent of zero is formatted
+//		without an SI prefix.
 //
-//				All numbers other than 0.5 round to the nearest as
-//				usual.
+//	coefficientRoundingType		NumberRoundingType
 //
-//			Floor
+//		The rounding algorithm applied to the fractional
+//		digits of the Engineering Notation coefficient.
+//		If this value is set to NumRoundType.NoRounding(),
+//		all significant digits are retained and
+//		'coefficientFracDigits' is ignored.
 //
-//				Yields the nearest integer down. Floor does not apply
-//				any	special treatment to 0.5.
+//	coefficientFracDigits		int
 //
-//				Floor Function: The greatest integer that is less than
-//				or equal to x
+//		The number of fractional digits retained in the
+//		coefficient after rounding.
 //
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//	unitSymbol					string
 //
-//				In mathematics and computer science, the floor function
-//				is the function that takes as input a real number x,
-//				and gives as output the greatest integer less than or
-//				equal to x,	denoted floor(x) or ⌊x⌋.
+//		An optional unit of measure symbol such as "Ω"
+//		or "m". If this string is empty, it is ignored.
 //
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//		For the SI Prefix formats, the unit symbol is
+//		appended directly to the SI prefix ("4.7 kΩ").
+//		For all other formats, the unit symbol is
+//		appended after a single space ("4.7E+3 Ω").
 //
-//				Examples of Floor
-//
-//					Number     Floor
-//					 2           2
-//					 2.4         2
-//					 2.9         2
-//					-2.5        -3
-//					-2.7        -3
-//					-2          -2
-//
-//			Ceiling
-//
-//				Yields the nearest integer up. Ceiling does not
-//				apply any special treatment to 0.5.
-//
-//				Ceiling Function: The least integer that is
-//				greater than or	equal to x.
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
-//
-//				The ceiling function maps x to the least integer
-//				greater than or equal to x, denoted ceil(x) or
-//				⌈x⌉.[1]
-//
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
-//
-//					Examples of Ceiling
-//
-//						Number    Ceiling
-//						 2           2
-//						 2.4         3
-//						 2.9         3
-//						-2.5        -2
-//						-2.7        -2
-//						-2          -2
-//
-//			Truncate
-//
-//				Apply NO Rounding whatsoever. The Round From Digit
-//				is dropped or deleted. The Round To Digit is NEVER
-//				changed.
-//
-//				Examples of Truncate
-//
-//					Example-1
-//					Number: 23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:   23.14 - The Round From Digit
-//					is dropped.
-//
-//					Example-2
-//					Number: -23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:  -23.14 - The Round From Digit
-//					is dropped.
-//
-//	roundToFractionalDigits int
-//
-//		When set to a positive integer value, this parameter
-//		controls the number of digits to the right of the
-//		radix point or decimal separator (a.k.a. decimal point)
-//		in floating point numeric values.
-//
-//		This value is equal to the number fractional digits which
-//		will remain in the floating point number after completion
-//		of the number rounding operation.
-//
-//		If parameter 'roundingType' is set to
-//		NumRoundType.NoRounding(), 'roundToFractionalDigits' is
-//		ignored and has no effect.
-//
-//	errorPrefix					interface{}
+//	errorPrefix					interface{}
 //
 //		This object encapsulates error prefix text which
 //		is included in all returned error messages.
@@ -8204,92 +8546,33 @@ func (numStrKernel *NumberStrKernel) FmtCurrencySimple(
 //
 // # Return Values
 //
-//	numberStats					NumberStrStatsDto
-//
-//		This data transfer object will a statistical
-//		profile of the integer and fractional digits used
-//		to generate the numeric value stored in input
-//		parameter 'numericValue'.
-//
-//		type NumberStrStatsDto struct {
-//
-//		NumOfIntegerDigits					uint64
-//
-//			The total number of integer digits to the
-//			left of the radix point or, decimal point, in
-//			the subject numeric value.
-//
-//		NumOfSignificantIntegerDigits		uint64
-//
-//			The number of nonzero integer digits to the
-//			left of the radix point or, decimal point, in
-//			the subject numeric value.
-//
-//		NumOfFractionalDigits				uint64
-//
-//			The total number of fractional digits to the
-//			right of the radix point or, decimal point,
-//			in the subject numeric value.
-//
-//		NumOfSignificantFractionalDigits	uint64
-//
-//			The number of nonzero fractional digits to
-//			the right of the radix point or, decimal
-//			point, in the subject numeric value.
-//
-//		NumberValueType 					NumericValueType
-//
-//			This enumeration value specifies whether the
-//			subject numeric value is classified either as
-//			an integer or a floating point number.
-//
-//			Possible enumeration values are listed as
-//			follows:
-//				NumValType.None()
-//				NumValType.FloatingPoint()
-//				NumValType.Integer()
-//
-//		NumberSign							NumericSignValueType
-//
-//			An enumeration specifying the number sign
-//			associated with the numeric value. Possible
-//			values are listed as follows:
-//				NumSignVal.None()		= Invalid Value
-//				NumSignVal.Negative()	= -1
-//				NumSignVal.Zero()		=  0
-//				NumSignVal.Positive()	=  1
-//
-//		IsZeroValue							bool
-//
-//			If 'true', the subject numeric value is equal
-//			to zero ('0').
+//	string
 //
-//			If 'false', the subject numeric value is
-//			greater than or less than zero ('0').
-//		}
+//		If this method completes successfully, this
+//		string will contain the numeric value of the
+//		current NumberStrKernel instance formatted in
+//		Engineering Notation.
 //
 //	error
 //
-//		If this method completes successfully, this returned
-//		error Type is set equal to 'nil'. If errors are
-//		encountered during processing, the returned error
-//		Type will encapsulate an error message.
-//
-//		If an error message is returned, the text value for
-//		input parameter 'errPrefDto' (error prefix) will be
-//		prefixed or attached at the beginning of the error
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
 //		message.
 //
-//		NOTE: If the numeric value of 'numStrKernel' exceeds
-//		the maximum value for type int, an error will be
-//		returned.
-func (numStrKernel *NumberStrKernel) FmtNumericValue(
-	numericValue interface{},
-	roundingType NumberRoundingType,
-	roundToFactionalDigits int,
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) FmtEngNotation(
+	engNotFmt EngineeringNotationFormat,
+	coefficientRoundingType NumberRoundingType,
+	coefficientFracDigits int,
+	unitSymbol string,
 	errorPrefix interface{}) (
-	numberStats NumberStrStatsDto,
-	err error) {
+	string,
+	error) {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -8300,91 +8583,194 @@ func (numStrKernel *NumberStrKernel) FmtNumericValue(
 	defer numStrKernel.lock.Unlock()
 
 	var ePrefix *ePref.ErrPrefixDto
+	var err error
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"FmtNumericValue()",
+			"FmtEngNotation()",
 		"")
 
 	if err != nil {
-		return numberStats, err
+		return "", err
 	}
 
-	numberStats,
-		err = new(numberStrKernelMolecule).
-		convertKernelToNumber(
-			numStrKernel,
-			numericValue,
-			roundingType,
-			roundToFactionalDigits,
-			ePrefix.XCpy(
-				"numStrKernel"))
+	engNotKernel := EngNotationKernel{}
 
-	return numberStats, err
+	engNotKernelNanobot := engNotationKernelNanobot{}
+
+	err = engNotKernelNanobot.setFromNumStrKernel(
+		&engNotKernel,
+		numStrKernel,
+		coefficientRoundingType,
+		coefficientFracDigits,
+		ePrefix.XCpy(
+			"engNotKernel<-numStrKernel"))
+
+	if err != nil {
+		return "", err
+	}
+
+	return engNotKernelNanobot.formatEngNotation(
+		&engNotKernel,
+		engNotFmt,
+		unitSymbol,
+		ePrefix.XCpy(
+			"engNotKernel"))
 }
 
-//	FmtNumStr
+//	FmtNumericValue
 //
-//	Returns a formatted number string using the
-//	numeric value provided by the current instance
-//	of NumberStrKernel.
+//	Converts the numeric value encapsulated by the current
+//	instance of NumberStrKernel to one of several numeric
+//	value types.
 //
-//	Input parameter 'numStrFmtSpec' provides options
-//	for customizing for currency symbols, integer
-//	separation, number sign management, radix point
-//	symbol. Input parameter 'roundingSpec' includes
-//	specifications for floating point number rounding.
+//	The converted numeric value is returned through input
+//	parameter 'numericValue' defined as an empty interface
+//	(type interface{}).
 //
-//	If required, users have the options of
-//	implementing the India or Chinese Numbering
-//	Systems for integer separation.
+//	The user has only to pass a pointer to one of the
+//	supported numeric value types through input parameter
+//	'numericValue'. Thereafter, the NumberStrKernel numeric
+//	value will be stored in the concrete numeric value type
+//	designated by parameter 'numericValue'.
+//
+//	The user also has the option of specifying a rounded
+//	numeric value return using input parameters
+//	'roundingType' and 'roundToFactionalDigits'.
 //
 // ----------------------------------------------------------------
 //
-// # Input Parameters
+// # Usage
 //
-//	roundingSpec				NumStrRoundingSpec
+//		intDigits := "12345"
+//		fracDigits := ""
 //
-//		The Number String Rounding Specification
-//		contains all the parameters required to
-//		configure a rounding algorithm for a
-//		floating point number string.
+//		sMechNStrKernel := strmech.NumberStrKernel{}
+//		numberSign := strmech.NumSignVal.None()
 //
-//		type NumStrRoundingSpec struct {
+//		numStrKernel,
+//		err := sMechNStrKernel.NewFromStringDigits(
+//					intDigits,
+//					fracDigits,
+//					numberSign,
+//					ePrefix.XCpy(
+//					fmt.Sprintf(
+//					"intDigits=%v",
+//					intDigits)))
 //
-//			roundingType NumberRoundingType
+//		if err != nil {
+//			fmt.Println(
+//			fmt.Sprintf("%v",
+//			err.Error()))
 //
-//			This enumeration parameter is used to specify the type
-//			of rounding algorithm that will be applied for the
-//			rounding of fractional digits in a number string.
+//			return
+//		}
 //
-//			Possible values are listed as follows:
-//				NumRoundType.None()
-//				NumRoundType.NoRounding()
-//				NumRoundType.HalfUpWithNegNums()
-//				NumRoundType.HalfDownWithNegNums()
-//				NumRoundType.HalfAwayFromZero()
-//				NumRoundType.HalfTowardsZero()
-//				NumRoundType.HalfToEven()
-//				NumRoundType.HalfToOdd()
-//				NumRoundType.Randomly()
-//				NumRoundType.Floor()
-//				NumRoundType.Ceiling()
-//				NumRoundType.Truncate()
+//		var bigIntNumber *big.Int
+//
+//		var roundType = strmech.NumRoundType.HalfAwayFromZero()
+//
+//		***************************************************
+//
+//		After returning from this method call,
+//	 'bigIntNumber' will be populated with
+//		the value of the NumberStrKernel value,
+//		'12345'.
+//		_,
+//		err = numStrKernel.FmtNumericValue(
+//				&bigIntNumber,
+//				roundType,
+//				0,
+//				ePrefix)
+//
+//		if err != nil {
+//			fmt.Println(
+//			fmt.Sprintf("%v",
+//			err.Error()))
+//			return
+//		}
+//
+//		***************************************************
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numericValue				interface{}
+//
+//		An empty interface containing a pointer to a
+//		supported numeric value type. The concrete
+//		type identified by this pointer will be used
+//		to store the numeric value extracted and
+//		converted from the current NumberStrKernel
+//		instance.
+//
+//		An error will be returned if the concrete type
+//		identified by the pointer passed through this
+//		parameter does not match one of the supported
+//		types below.
+//
+//		Supported Numeric Value ('numericValue') Types:
+//
+//			*float32, *float64, *big.Float
+//			*BigFloatDto
+//			*TextFieldFormatDtoFloat64
+//			*TextFieldFormatDtoBigFloat
+//			*big.Rat
+//			*int8, *int16, *int, *int32, *int64,
+//			*big.Int
+//			*uint8, *uint16, *uint, *uint32, *uint64
+//			*NumberStrKernel
+//
+//		Any type passed through this empty interface which
+//		is not listed above will generate an error.
+//
+//	roundingType				NumberRoundingType
+//
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied for
+//		the	rounding of fractional digits contained in the
+//		numeric value returned through input parameter
+//		numericValue.
+//
+//		'roundingType' is only applied in cases where the
+//		current NumberStrKernel instance consists of a
+//		floating point numeric value.
+//
+//		If in doubt as to a suitable rounding method,
+//		'HalfAwayFromZero' is recommended.
+//
+//		Possible values are listed as follows:
+//			NumRoundType.None()	- Invalid Value
+//			NumRoundType.NoRounding()
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
+//
+//		Definitions:
 //
 //			NoRounding
 //
-//				Signals that no rounding operation will be performed
-//				on fractional digits contained in a number string.
-//				The fractional digits will therefore remain unchanged.
+//				Signals that no rounding operation will be
+//				performed on fractional digits. The
+//				fractional digits will therefore remain
+//				unchanged.
 //
 //			HalfUpWithNegNums
 //
-//				Half Round Up Including Negative Numbers. This method
-//				is intuitive but may produce unexpected results when
-//				applied to negative numbers.
+//				Half Round Up Including Negative Numbers.
+//				This method is intuitive but may produce
+//				unexpected results when applied to negative
+//				numbers.
 //
 //				'HalfUpWithNegNums' rounds .5 up.
 //
@@ -8398,30 +8784,27 @@ func (numStrKernel *NumberStrKernel) FmtNumericValue(
 //
 //			HalfDownWithNegNums
 //
-//				Half Round Down Including Negative Numbers. This method
-//				is also considered intuitive but may produce unexpected
-//				results when applied to negative numbers.
+//			Half Round Down Including Negative Numbers. This
+//			method is also considered intuitive but may
+//			produce unexpected results when applied to
+//			negative numbers.
 //
-//				'HalfDownWithNegNums' rounds .5 down.
+//			'HalfDownWithNegNums' rounds .5 down.
 //
-//					Examples of HalfDownWithNegNums
+//				Examples of HalfDownWithNegNums
 //
-//					7.6 rounds up to 8
-//					7.5 rounds down to 7
-//					7.4 rounds down to 7
-//					-7.4 rounds up to -7
-//					-7.5 rounds down to -8
-//					-7.6 rounds down to -8
+//				7.6 rounds up to 8
+//				7.5 rounds down to 7
+//				7.4 rounds down to 7
+//				-7.4 rounds up to -7
+//				-7.5 rounds down to -8
+//				-7.6 rounds down to -8
 //
 //			HalfAwayFromZero
 //
-//				Round Half Away From Zero. This rounding method is treated
-//				as the default and this value is returned by method:
-//				NumberRoundingType(0).XGetDefaultRoundingType()
-//
-//				The 'HalfAwayFromZero' method rounds .5 further away from zero.
-//				It provides clear and consistent behavior when dealing with
-//				negative numbers.
+//				The 'HalfAwayFromZero' method rounds .5 further
+//				away from zero.	It provides clear and consistent
+//				behavior when dealing with negative numbers.
 //
 //					Examples of HalfAwayFromZero
 //
@@ -8434,9 +8817,10 @@ func (numStrKernel *NumberStrKernel) FmtNumericValue(
 //
 //			HalfTowardsZero
 //
-//				Round Half Towards Zero. 'HalfTowardsZero' rounds 0.5
-//				closer to zero. It provides clear and consistent behavior
-//				when dealing with negative numbers.
+//				Round Half Towards Zero. 'HalfTowardsZero' rounds
+//				0.5	closer to zero. It provides clear and
+//				consistent behavior	when dealing with negative
+//				numbers.
 //
 //					Examples of HalfTowardsZero
 //
@@ -8449,17 +8833,19 @@ func (numStrKernel *NumberStrKernel) FmtNumericValue(
 //
 //			HalfToEven
 //
-//				Round Half To Even Numbers. 'HalfToEven' is also called
-//				Banker's Rounding. This method rounds 0.5 to the nearest
-//				even digit.
+//				Round Half To Even Numbers. 'HalfToEven' is
+//				also called	Banker's Rounding. This method
+//				rounds 0.5 to the nearest even digit.
 //
 //					Examples of HalfToEven
 //
-//					7.5 rounds up to 8 (because 8 is an even number)
-//					but 6.5 rounds down to 6 (because 6 is an even number)
+//					7.5 rounds up to 8 (because 8 is an even
+//					number)	but 6.5 rounds down to 6 (because
+//					6 is an even number)
 //
-//					HalfToEven only applies to 0.5. Other numbers (not ending
-//					in 0.5) round to nearest as usual, so:
+//					HalfToEven only applies to 0.5. Other
+//					numbers (not ending	in 0.5) round to
+//					nearest as usual, so:
 //
 //					7.6 rounds up to 8
 //					7.5 rounds up to 8 (because 8 is an even number)
@@ -8470,13 +8856,14 @@ func (numStrKernel *NumberStrKernel) FmtNumericValue(
 //
 //			HalfToOdd
 //
-//				Round Half to Odd Numbers. Similar to 'HalfToEven', but
-//				in this case 'HalfToOdd' rounds 0.5 towards odd numbers.
+//				Round Half to Odd Numbers. Similar to 'HalfToEven',
+//				but in this case 'HalfToOdd' rounds 0.5 towards odd
+//				numbers.
 //
 //					Examples of HalfToOdd
 //
-//					HalfToOdd only applies to 0.5. Other numbers (not ending
-//					in 0.5) round to nearest as usual.
+//					HalfToOdd only applies to 0.5. Other numbers
+//					(not ending	in 0.5) round to nearest as usual.
 //
 //					7.5 rounds down to 7 (because 7 is an odd number)
 //
@@ -8491,65 +8878,75 @@ func (numStrKernel *NumberStrKernel) FmtNumericValue(
 //
 //			Randomly
 //
-//				Round Half Randomly. Uses a Random Number Generator to choose
-//				between rounding 0.5 up or down.
+//				Round Half Randomly. Uses a Random Number Generator
+//				to choose between rounding 0.5 up or down.
 //
-//				All numbers other than 0.5 round to the nearest as usual.
+//				All numbers other than 0.5 round to the nearest as
+//				usual.
 //
 //			Floor
 //
-//				Yields the nearest integer down. Floor does not apply any
-//				special treatment to 0.5.
+//				Yields the nearest integer down. Floor does not apply
+//				any	special treatment to 0.5.
 //
-//				Floor Function: The greatest integer that is less than or
-//				equal to x
-//				Source: https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//				Floor Function: The greatest integer that is less than
+//				or equal to x
 //
-//				In mathematics and computer science, the floor function is
-//				the function that takes as input a real number x, and gives
-//				as output the greatest integer less than or equal to x,
-//				denoted floor(x) or ⌊x⌋.
-//				Source: https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
 //
-//					Examples of Floor
+//				In mathematics and computer science, the floor function
+//				is the function that takes as input a real number x,
+//				and gives as output the greatest integer less than or
+//				equal to x,	denoted floor(x) or ⌊x⌋.
+//
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//
+//				Examples of Floor
 //
 //					Number     Floor
-//					2           2
-//					2.4         2
-//					2.9         2
+//					 2           2
+//					 2.4         2
+//					 2.9         2
 //					-2.5        -3
 //					-2.7        -3
 //					-2          -2
 //
 //			Ceiling
 //
-//				Yields the nearest integer up. Ceiling does not apply any
-//				special treatment to 0.5.
+//				Yields the nearest integer up. Ceiling does not
+//				apply any special treatment to 0.5.
 //
-//				Ceiling Function: The least integer that is greater than or
-//				equal to x.
-//				Source: https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//				Ceiling Function: The least integer that is
+//				greater than or	equal to x.
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
 //
-//				The ceiling function maps x to the least integer greater than
-//				or equal to x, denoted ceil(x) or ⌈x⌉.[1]
-//				Source: https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//				The ceiling function maps x to the least integer
+//				greater than or equal to x, denoted ceil(x) or
+//				⌈x⌉.[1]
+//
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
 //
 //					Examples of Ceiling
 //
-//					Number    Ceiling
-//					2           2
-//					2.4         3
-//					2.9         3
-//					-2.5        -2
-//					-2.7        -2
-//					-2          -2
+//						Number    Ceiling
+//						 2           2
+//						 2.4         3
+//						 2.9         3
+//						-2.5        -2
+//						-2.7        -2
+//						-2          -2
 //
 //			Truncate
 //
-//				Apply NO Rounding whatsoever. The Round From Digit is dropped
-//				or deleted. The Round To Digit is NEVER changed.
+//				Apply NO Rounding whatsoever. The Round From Digit
+//				is dropped or deleted. The Round To Digit is NEVER
+//				changed.
 //
-//					Examples of Truncate
+//				Examples of Truncate
 //
 //					Example-1
 //					Number: 23.14567
@@ -8558,7 +8955,8 @@ func (numStrKernel *NumberStrKernel) FmtNumericValue(
 //					Rounding Method: Truncate
 //					Round To Digit:   4
 //					Round From Digit: 5
-//					Rounded Number:   23.14 - The Round From Digit is dropped.
+//					Rounded Number:   23.14 - The Round From Digit
+//					is dropped.
 //
 //					Example-2
 //					Number: -23.14567
@@ -8567,81 +8965,29 @@ func (numStrKernel *NumberStrKernel) FmtNumericValue(
 //					Rounding Method: Truncate
 //					Round To Digit:   4
 //					Round From Digit: 5
-//					Rounded Number:  -23.14 - The Round From Digit is dropped.
-//
-//			roundToFractionalDigits int
-//
-//				When set to a positive integer value, this
-//				parameter controls the number of digits to
-//				the right of the radix point or decimal
-//				separator (a.k.a. decimal point) which will
-//				remain after completion of the number rounding
-//				operation.
-//		}
-//
-//	numStrFmtSpec				NumStrFormatSpec
-//
-//		This structure includes all parameters
-//		necessary for formatting a number string.
-//		These customization options provide maximum
-//		granularity in controlling the formatting
-//		of the returned Number String.
-//
-//		type NumStrFormatSpec struct {
-//
-//			decSeparator			DecimalSeparatorSpec
-//
-//				Contains the radix point or decimal
-//				separator character(s) which will
-//				separate integer and fractional
-//				numeric digits in a floating point
-//				number.
-//
-//			intSeparatorSpec 		IntegerSeparatorSpec
-//
-//				Integer Separator Specification. This
-//				parameter specifies the type of integer
-//				specifies the type of integer grouping and
-//				integer separator characters which will be
-//				applied to the number string formatting
-//				operations.
-//
-//			positiveNumberSign		NumStrNumberSymbolSpec
-//
-//				Positive number signs are commonly implied
-//				and not specified. However, the user as the
-//				option to specify a positive number sign
-//				character or characters for positive numeric
-//				values using a Number String Positive Number
-//				Sign Specification.
-//
-//				This specification can also be used to
-//				configure currency symbols.
-//
-//			negativeNumberSign		NumStrNumberSymbolSpec
+//					Rounded Number:  -23.14 - The Round From Digit
+//					is dropped.
 //
-//				The Number String Negative Number Sign
-//				Specification is used to configure negative
-//				number sign symbols for negative numeric values
-//				formatted and displayed in number stings.
+//	roundToFractionalDigits int
 //
-//				This specification can also be used to
-//				configured currency symbols.
+//		When set to a positive integer value, this parameter
+//		controls the number of digits to the right of the
+//		radix point or decimal separator (a.k.a. decimal point)
+//		in floating point numeric values.
 //
-//			numberFieldSpec			NumStrNumberFieldSpec
+//		This value is equal to the number fractional digits which
+//		will remain in the floating point number after completion
+//		of the number rounding operation.
 //
-//				This Number String Number Field Specification
-//				contains the field length and text
-//				justification parameter necessary to display
-//				a numeric value within a text number field
-//				for display as a number string.
-//		}
+//		If parameter 'roundingType' is set to
+//		NumRoundType.NoRounding(), 'roundToFractionalDigits' is
+//		ignored and has no effect.
 //
-//	 errorPrefix                interface{}
+//	errorPrefix					interface{}
 //
 //		This object encapsulates error prefix text which
 //		is included in all returned error messages.
-//		Usually, it	contains the name of the calling
+//		Usually, it contains the name of the calling
 //		method or methods listed as a method or function
 //		chain of execution.
 //
@@ -8700,29 +9046,92 @@ func (numStrKernel *NumberStrKernel) FmtNumericValue(
 //
 // # Return Values
 //
-//	string
+//	numberStats					NumberStrStatsDto
 //
-//		If this method completes successfully, a formatted
-//		Number String will be returned.
+//		This data transfer object will a statistical
+//		profile of the integer and fractional digits used
+//		to generate the numeric value stored in input
+//		parameter 'numericValue'.
+//
+//		type NumberStrStatsDto struct {
+//
+//		NumOfIntegerDigits					uint64
+//
+//			The total number of integer digits to the
+//			left of the radix point or, decimal point, in
+//			the subject numeric value.
+//
+//		NumOfSignificantIntegerDigits		uint64
+//
+//			The number of nonzero integer digits to the
+//			left of the radix point or, decimal point, in
+//			the subject numeric value.
+//
+//		NumOfFractionalDigits				uint64
+//
+//			The total number of fractional digits to the
+//			right of the radix point or, decimal point,
+//			in the subject numeric value.
+//
+//		NumOfSignificantFractionalDigits	uint64
+//
+//			The number of nonzero fractional digits to
+//			the right of the radix point or, decimal
+//			point, in the subject numeric value.
+//
+//		NumberValueType 					NumericValueType
+//
+//			This enumeration value specifies whether the
+//			subject numeric value is classified either as
+//			an integer or a floating point number.
+//
+//			Possible enumeration values are listed as
+//			follows:
+//				NumValType.None()
+//				NumValType.FloatingPoint()
+//				NumValType.Integer()
+//
+//		NumberSign							NumericSignValueType
+//
+//			An enumeration specifying the number sign
+//			associated with the numeric value. Possible
+//			values are listed as follows:
+//				NumSignVal.None()		= Invalid Value
+//				NumSignVal.Negative()	= -1
+//				NumSignVal.Zero()		=  0
+//				NumSignVal.Positive()	=  1
+//
+//		IsZeroValue							bool
+//
+//			If 'true', the subject numeric value is equal
+//			to zero ('0').
+//
+//			If 'false', the subject numeric value is
+//			greater than or less than zero ('0').
+//		}
 //
 //	error
 //
-//		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
 //
-//		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error
-//		message. This returned error message will
-//		incorporate the method chain and text passed by
-//		input parameter, 'errorPrefix'. The 'errorPrefix'
-//		text will be attached to the beginning of the
-//		error message.
-func (numStrKernel *NumberStrKernel) FmtNumStr(
-	roundingSpec NumStrRoundingSpec,
-	numStrFmtSpec NumStrFormatSpec,
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+//
+//		NOTE: If the numeric value of 'numStrKernel' exceeds
+//		the maximum value for type int, an error will be
+//		returned.
+func (numStrKernel *NumberStrKernel) FmtNumericValue(
+	numericValue interface{},
+	roundingType NumberRoundingType,
+	roundToFactionalDigits int,
 	errorPrefix interface{}) (
-	string,
-	error) {
+	numberStats NumberStrStatsDto,
+	err error) {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -8733,39 +9142,62 @@ func (numStrKernel *NumberStrKernel) FmtNumStr(
 	defer numStrKernel.lock.Unlock()
 
 	var ePrefix *ePref.ErrPrefixDto
-	var err error
-	var numStr string
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"FmtNumStr()",
+			"FmtNumericValue()",
 		"")
 
 	if err != nil {
-		return numStr, err
+		return numberStats, err
 	}
 
-	return new(numberStrKernelMolecule).
-		formatNumStr(
+	numberStats,
+		err = new(numberStrKernelMolecule).
+		convertKernelToNumber(
 			numStrKernel,
-			roundingSpec,
-			numStrFmtSpec,
-			ePrefix.XCpy("numStrKernel"))
+			numericValue,
+			roundingType,
+			roundToFactionalDigits,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	return numberStats, err
 }
 
-//	FmtNumStrComponents
+//	FmtNumStr
 //
-//	Creates and returns a fully formatted Number
-//	String generated from Number String formatting
-//	components passed as input parameters.
+//	Returns a formatted number string using the
+//	numeric value provided by the current instance
+//	of NumberStrKernel.
+//
+//	Input parameter 'numStrFmtSpec' provides options
+//	for customizing for currency symbols, integer
+//	separation, number sign management, radix point
+//	symbol. Input parameter 'roundingSpec' includes
+//	specifications for floating point number rounding.
+//
+//	If required, users have the options of
+//	implementing the India or Chinese Numbering
+//	Systems for integer separation.
+//
+//	Integer values may also be formatted as binary,
+//	octal or hexadecimal number strings using a
+//	Number String Format Specification created by
+//	NumStrFormatSpec.NewRadixNumFormat().
+//
+//	Numeric values may be formatted in Scientific
+//	Notation using a Number String Format
+//	Specification created by
+//	NumStrFormatSpec.NewSciNotationNumFormat().
 //
 // ----------------------------------------------------------------
 //
 // # Input Parameters
 //
-//	roundingSpec 				NumStrRoundingSpec
+//	roundingSpec				NumStrRoundingSpec
 //
 //		The Number String Rounding Specification
 //		contains all the parameters required to
@@ -8999,247 +9431,62 @@ func (numStrKernel *NumberStrKernel) FmtNumStr(
 //				operation.
 //		}
 //
-//	decSeparatorSpec			DecimalSeparatorSpec
-//
-//		This structure contains the radix point or
-//		decimal separator character(s) which will be used
-//		to separate integer and fractional digits within
-//		a formatted Number String.
+//	numStrFmtSpec				NumStrFormatSpec
 //
-//		In the US, UK, Australia and most of Canada, the
-//		decimal separator is the period character ('.')
-//		known as the decimal point.
+//		This structure includes all parameters
+//		necessary for formatting a number string.
+//		These customization options provide maximum
+//		granularity in controlling the formatting
+//		of the returned Number String.
 //
-//	intSeparatorSpec			IntegerSeparatorSpec
+//		type NumStrFormatSpec struct {
 //
-//		Integer Separator Specification. This type
-//		encapsulates the parameters required to format
-//		integer grouping and separation within a Number
-//		String.
+//			decSeparator			DecimalSeparatorSpec
 //
-//	numberSymbolsGroup  		NumStrNumberSymbolGroup
+//				Contains the radix point or decimal
+//				separator character(s) which will
+//				separate integer and fractional
+//				numeric digits in a floating point
+//				number.
 //
-//		This instance of NumStrNumberSymbolGroup contains the
-//		Number Symbol Specifications for negative numeric
-//		values, positive numeric values and zero numeric
-//		values.
+//			intSeparatorSpec 		IntegerSeparatorSpec
 //
-//		type NumStrNumberSymbolGroup struct {
-//
-//			negativeNumberSign NumStrNumberSymbolSpec
-//
-//				The Number String Negative Number Sign
-//				Specification is used to configure negative
-//				number sign symbols for negative numeric
-//				values formatted and displayed in number
-//				stings.
-//
-//				Example-1: Leading Number Sign Symbols
-//					Leading Number Sign Symbols for Negative
-//					Values
-//
-//					Leading Symbols: "- "
-//					Number String:   "- 123.456"
-//
-//				Example-2: Leading Number Sign Symbols
-//					Leading Number Sign Symbols for Negative
-//					Values
-//
-//					Leading Symbols: "-"
-//					Number String:   "-123.456"
-//
-//				Example-3: Trailing Number Sign Symbols
-//					Trailing Number Sign Symbols for Negative
-//					Values
-//
-//					Trailing Symbols: " -"
-//					Number String:   "123.456 -"
-//
-//				Example-4: Trailing Number Sign Symbols
-//					Trailing Number Sign Symbols for Negative
-//					Values
-//
-//					Trailing Symbols: "-"
-//					Number String:   "123.456-"
+//				Integer Separator Specification. This
+//				parameter specifies the type of integer
+//				specifies the type of integer grouping and
+//				integer separator characters which will be
+//				applied to the number string formatting
+//				operations.
 //
-//			positiveNumberSign NumStrNumberSymbolSpec
+//			positiveNumberSign		NumStrNumberSymbolSpec
 //
 //				Positive number signs are commonly implied
-//				and not specified. However, the user has
-//				the option to specify a positive number sign
+//				and not specified. However, the user as the
+//				option to specify a positive number sign
 //				character or characters for positive numeric
 //				values using a Number String Positive Number
 //				Sign Specification.
 //
-//				Example-1: Leading Number Sign Symbols
-//					Leading Number Sign Symbols for Positive
-//					Values
-//
-//					Leading Symbols: "+ "
-//					Number String:   "+ 123.456"
-//
-//				Example-2: Leading Number Sign Symbols
-//					Leading Number Sign Symbols for Positive
-//					Values
-//
-//					Leading Symbols: "+"
-//					Number String:   "+123.456"
-//
-//				Example-3: Trailing Number Sign Symbols
-//					Trailing Number Sign Symbols for Positive
-//					Values
-//
-//					Trailing Symbols: " +"
-//					Number String:   "123.456 +"
-//
-//				Example-4: Trailing Number Sign Symbols
-//					Trailing Number Sign Symbols for Positive
-//					Values
-//
-//					Trailing Symbols: "+"
-//					Number String:   "123.456+"
-//
-//			zeroNumberSign NumStrNumberSymbolSpec
-//
-//				The Number String Zero Number Sign
-//				Specification is used to configure number
-//				sign symbols for zero numeric values formatted
-//				and displayed in number stings. Zero number
-//				signs are commonly omitted because zero
-//				does not technically qualify as either a
-//				positive or negative value. However,
-//				the user has the option to configure number
-//				sign symbols for zero values if necessary.
-//
-//				Example-1: Leading Number Sign Symbols
-//					Leading Number Sign Symbols for Zero Values
-//
-//					Leading Symbols: "+"
-//					Trailing Symbols: ""
-//					Number String:   "+0.00"
-//
-//				Example-2: Leading Number Sign Symbols
-//					Leading Number Sign Symbols for Zero Values
-//
-//					Leading Symbols: "+ "
-//					Trailing Symbols: ""
-//					Number String:   "+ 0.00"
-//
-//				Example-3: Trailing Number Sign Symbols
-//					Trailing Number Sign Symbols for Zero Values
-//
-//					Leading Symbols: ""
-//					Trailing Symbols: " +"
-//					Number String:   "0.00 +"
-//
-//				Example-4: Trailing Number Sign Symbols
-//					Trailing Number Sign Symbols for Zero Values
-//
-//					Leading Symbols: ""
-//					Trailing Symbols: "+"
-//					Number String:   "0.00+"
-//
-//			currencySymbol NumStrNumberSymbolSpec
-//
-//				A Currency Symbol positioned next to a numeric
-//				value designates the number as a monetary amount.
-//
-//				Examples of Currency Symbols include the Dollar
-//				sign ('$'), Euro sign ('€') or Pound sign ('£').
-//
-//				This instance of NumStrNumberSymbolSpec is used
-//				to configure leading Currency Symbols, trailing
-//				Currency Symbols or both leading and trailing
-//				Currency Symbols.
-//
-//				Example-1: Leading Currency Symbols
-//
-//					Leading Currency Symbols: "$ "
-//					Number String:   "$ 123.456"
-//
-//				Example-2: Leading Currency Symbols
-//
-//					Leading Currency Symbols: "$"
-//					Number String:   "$123.456"
-//
-//				Example-3: Trailing Currency Symbols
-//					Trailing Currency Symbols for Positive Values
-//
-//					Trailing Currency Symbols: "€"
-//					Number String:   "123.456€"
-//
-//				Example-4: Trailing Currency Symbols
-//					Trailing Currency Symbols for Positive Values
-//
-//					Trailing Currency Symbols: " €"
-//					Number String:   "123.456 €"
-//		}
-//
-//	numberFieldSpec				NumStrNumberFieldSpec
-//
-//		This Number Field Specification contains all
-//		parameters necessary to format a Number String
-//		within a larger Number Field. In addition to
-//		specifying the length of number field, this
-//		object contains justification specifications
-//		for centering, left justifying or right
-//		justifying a Number String within a Number
-//		Field.
-//
-//		type NumStrNumberFieldSpec struct {
-//
-//			fieldLength int
-//
-//				This parameter defines the length of the
-//				text field in which the numeric value will
-//				be displayed within a number string.
-//
-//				If 'fieldLength' is less than the length
-//				of the numeric value string, it will be
-//				automatically set equal to the length of
-//				that numeric value string.
-//
-//				To automatically set the value of
-//				'fieldLength' to the string length of the
-//				numeric value, set this parameter to a
-//				value of minus one (-1).
-//
-//				If this parameter is submitted with a
-//				value less than minus one (-1) or greater
-//				than 1-million (1,000,000), an error will
-//				be returned.
-//
-//			fieldJustification TextJustify
-//
-//				An enumeration which specifies the
-//				justification of the numeric value string
-//				within the number field length specified
-//				by data field 'fieldLength'.
+//				This specification can also be used to
+//				configure currency symbols.
 //
-//				Text justification can only be evaluated in
-//				the context of a number string, field length
-//				and a 'textJustification' object of type
-//				TextJustify. This is because number strings
-//				with a field length equal to or less than the
-//				length of the numeric value string never use
-//				text justification. In these cases, text
-//				justification is completely ignored.
+//			negativeNumberSign		NumStrNumberSymbolSpec
 //
-//				If the field length parameter ('fieldLength')
-//				is greater than the length of the numeric
-//				value string, text justification must be equal
-//				to one of these three valid values:
+//				The Number String Negative Number Sign
+//				Specification is used to configure negative
+//				number sign symbols for negative numeric values
+//				formatted and displayed in number stings.
 //
-//				          TextJustify(0).Left()
-//				          TextJustify(0).Right()
-//				          TextJustify(0).Center()
+//				This specification can also be used to
+//				configured currency symbols.
 //
-//				You can also use the abbreviated text
-//				justification enumeration syntax as follows:
+//			numberFieldSpec			NumStrNumberFieldSpec
 //
-//				          TxtJustify.Left()
-//				          TxtJustify.Right()
-//				          TxtJustify.Center()
+//				This Number String Number Field Specification
+//				contains the field length and text
+//				justification parameter necessary to display
+//				a numeric value within a text number field
+//				for display as a number string.
 //		}
 //
 //	 errorPrefix                interface{}
@@ -9305,32 +9552,30 @@ func (numStrKernel *NumberStrKernel) FmtNumStr(
 //
 // # Return Values
 //
-//	numberString				string
+//	string
 //
 //		If this method completes successfully, a formatted
 //		Number String will be returned.
 //
-//	err							error
+//	error
 //
-//		If this method completes successfully, the returned
-//		error Type is set equal to 'nil'.
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
 //
 //		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error message.
-//	 	This returned error message will incorporate the method
-//	 	chain and text passed by input parameter, 'errorPrefix'.
-//	 	The 'errorPrefix' text will be attached to the beginning
-//	 	of the error message.
-func (numStrKernel *NumberStrKernel) FmtNumStrComponents(
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (numStrKernel *NumberStrKernel) FmtNumStr(
 	roundingSpec NumStrRoundingSpec,
-	decSeparatorSpec DecimalSeparatorSpec,
-	intSeparatorSpec IntegerSeparatorSpec,
-	numberSymbolsGroup NumStrNumberSymbolGroup,
-	numberFieldSpec NumStrNumberFieldSpec,
+	numStrFmtSpec NumStrFormatSpec,
 	errorPrefix interface{}) (
-	numberString string,
-	err error) {
-
+	string,
+	error) {
+
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
 	}
@@ -9340,31 +9585,18 @@ func (numStrKernel *NumberStrKernel) FmtNumStrComponents(
 	defer numStrKernel.lock.Unlock()
 
 	var ePrefix *ePref.ErrPrefixDto
+	var err error
+	var numStr string
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"FmtNumStrComponents()",
+			"FmtNumStr()",
 		"")
 
 	if err != nil {
-		return numberString, err
-	}
-
-	var numStrFmtSpec NumStrFormatSpec
-
-	numStrFmtSpec,
-		err = new(NumStrFormatSpec).NewNumFmtComponents(
-		decSeparatorSpec,
-		intSeparatorSpec,
-		numberSymbolsGroup,
-		numberFieldSpec,
-		ePrefix.XCpy(
-			"numStrFmtSpec<-"))
-
-	if err != nil {
-		return numberString, err
+		return numStr, err
 	}
 
 	return new(numberStrKernelMolecule).
@@ -9375,367 +9607,138 @@ func (numStrKernel *NumberStrKernel) FmtNumStrComponents(
 			ePrefix.XCpy("numStrKernel"))
 }
 
-// FmtNumStrDefault
+//	FmtNumStrComponents
 //
-// Returns a formatted number string using the numeric
-// value and the Default Number String Format
-// Specification configured for the current instance of
-// NumberStrKernel.
+//	Creates and returns a fully formatted Number
+//	String generated from Number String formatting
+//	components passed as input parameters.
 //
-// To explicitly set the Default Number String Format
-// Specification, use the following methods:
+// ----------------------------------------------------------------
 //
-//	NumberStrKernel.SetDefaultNumStrFmtSpec()
-//	NumberStrKernel.SetDefaultNumStrFmtSpecPure()
-//	NumberStrKernel.SetDefaultSignedNumStrFmtSpecSimple()
+// # Input Parameters
 //
-// ----------------------------------------------------------------
+//	roundingSpec 				NumStrRoundingSpec
 //
-// # BE ADVISED
+//		The Number String Rounding Specification
+//		contains all the parameters required to
+//		configure a rounding algorithm for a
+//		floating point number string.
 //
-//	(1)	No rounding will be performed on the numeric
-//		value formatted as a returned number string. If
-//		rounding is required in conjunction with the
-//		Default Format Number String Formatting
-//		Specification, the user has two options.
+//		type NumStrRoundingSpec struct {
 //
-//		a.	Call method NumberStrKernel.Round() and
-//			then call this method,
-//			NumberStrKernel.String().
+//			roundingType NumberRoundingType
 //
-//		b.	Do not call this method. Instead, call
-//			method NumberStrKernel.FmtNumStrDefaultRound().
+//			This enumeration parameter is used to specify the type
+//			of rounding algorithm that will be applied for the
+//			rounding of fractional digits in a number string.
 //
-//	(2)	This method will NOT delete or modify data values
-//		contained in the current instance of
-//		NumberStrKernel. However, if the Default
-//		NumberStrKernel Number String Format
-//		Specification is invalid,
-//		'NumberStrKernel.numStrFormatSpec' will be set to
-//		the default US (United States) Signed Number
-//		String Format Specification.
+//			Possible values are listed as follows:
+//				NumRoundType.None()
+//				NumRoundType.NoRounding()
+//				NumRoundType.HalfUpWithNegNums()
+//				NumRoundType.HalfDownWithNegNums()
+//				NumRoundType.HalfAwayFromZero()
+//				NumRoundType.HalfTowardsZero()
+//				NumRoundType.HalfToEven()
+//				NumRoundType.HalfToOdd()
+//				NumRoundType.Randomly()
+//				NumRoundType.Floor()
+//				NumRoundType.Ceiling()
+//				NumRoundType.Truncate()
 //
-//		All other NumberStrKernel data values will remain
-//		unchanged.
+//			NoRounding
 //
-//	(3)	This method is very similar to method:
+//				Signals that no rounding operation will be performed
+//				on fractional digits contained in a number string.
+//				The fractional digits will therefore remain unchanged.
 //
-//			NumberStrKernel.String()
+//			HalfUpWithNegNums
 //
-//		The only difference is that in case of error,
-//		this method returns a type 'error'.
+//				Half Round Up Including Negative Numbers. This method
+//				is intuitive but may produce unexpected results when
+//				applied to negative numbers.
 //
-// ----------------------------------------------------------------
+//				'HalfUpWithNegNums' rounds .5 up.
 //
-// # Input Parameters
+//					Examples of 'HalfUpWithNegNums'
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8
+//					7.4 rounds down to 7
+//					-7.4 rounds up to -7
+//					-7.5 rounds up to -7
+//					-7.6 rounds down to -8
 //
-//	errorPrefix					interface{}
+//			HalfDownWithNegNums
 //
-//		This object encapsulates error prefix text which
-//		is included in all returned error messages.
-//		Usually, it contains the name of the calling
-//		method or methods listed as a method or function
-//		chain of execution.
+//				Half Round Down Including Negative Numbers. This method
+//				is also considered intuitive but may produce unexpected
+//				results when applied to negative numbers.
 //
-//		If no error prefix information is needed, set this
-//		parameter to 'nil'.
+//				'HalfDownWithNegNums' rounds .5 down.
 //
-//		This empty interface must be convertible to one of
-//		the following types:
+//					Examples of HalfDownWithNegNums
 //
-//		1.	nil
-//				A nil value is valid and generates an
-//				empty collection of error prefix and
-//				error context information.
+//					7.6 rounds up to 8
+//					7.5 rounds down to 7
+//					7.4 rounds down to 7
+//					-7.4 rounds up to -7
+//					-7.5 rounds down to -8
+//					-7.6 rounds down to -8
 //
-//		2.	string
-//				A string containing error prefix
-//				information.
+//			HalfAwayFromZero
 //
-//		3.	[]string
-//				A one-dimensional slice of strings
-//				containing error prefix information.
+//				Round Half Away From Zero. This rounding method is treated
+//				as the default and this value is returned by method:
+//				NumberRoundingType(0).XGetDefaultRoundingType()
 //
-//		4.	[][2]string
-//				A two-dimensional slice of strings
-//		   		containing error prefix and error
-//		   		context information.
+//				The 'HalfAwayFromZero' method rounds .5 further away from zero.
+//				It provides clear and consistent behavior when dealing with
+//				negative numbers.
 //
-//		5.	ErrPrefixDto
-//				An instance of ErrPrefixDto.
-//				Information from this object will
-//				be copied for use in error and
-//				informational messages.
+//					Examples of HalfAwayFromZero
 //
-//		6.	*ErrPrefixDto
-//				A pointer to an instance of
-//				ErrPrefixDto. Information from
-//				this object will be copied for use
-//				in error and informational messages.
+//					7.6 rounds away to 8
+//					7.5 rounds away to 8
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds away to -8
+//					-7.6 rounds away to -8
 //
-//		7.	IBasicErrorPrefix
-//				An interface to a method
-//				generating a two-dimensional slice
-//				of strings containing error prefix
-//				and error context information.
+//			HalfTowardsZero
 //
-//		If parameter 'errorPrefix' is NOT convertible
-//		to one of the valid types listed above, it will
-//		be considered invalid and trigger the return of
-//		an error.
+//				Round Half Towards Zero. 'HalfTowardsZero' rounds 0.5
+//				closer to zero. It provides clear and consistent behavior
+//				when dealing with negative numbers.
 //
-//		Types ErrPrefixDto and IBasicErrorPrefix are
-//		included in the 'errpref' software package:
-//			"github.com/MikeAustin71/errpref".
+//					Examples of HalfTowardsZero
 //
-// ----------------------------------------------------------------
+//					7.6 rounds away to 8
+//					7.5 rounds to 7
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds to -7
+//					-7.6 rounds away to -8
 //
-// # Return Values
+//			HalfToEven
 //
-//	string
+//				Round Half To Even Numbers. 'HalfToEven' is also called
+//				Banker's Rounding. This method rounds 0.5 to the nearest
+//				even digit.
 //
-//		If this method completes successfully, a
-//		formatted Number String will be returned.
+//					Examples of HalfToEven
 //
-//		The Number String format used in configuring
-//		this Number String is taken from the Default
-//		Number String Formatting Specification previously
-//		configured in the current instance of
-//		NumberStrKernel.
+//					7.5 rounds up to 8 (because 8 is an even number)
+//					but 6.5 rounds down to 6 (because 6 is an even number)
 //
-//	error
+//					HalfToEven only applies to 0.5. Other numbers (not ending
+//					in 0.5) round to nearest as usual, so:
 //
-//		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
-//
-//		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error
-//		message. This returned error message will
-//		incorporate the method chain and text passed by
-//		input parameter, 'errorPrefix'. The 'errorPrefix'
-//		text will be attached to the beginning of the
-//		error message.
-func (numStrKernel *NumberStrKernel) FmtNumStrDefault(
-	errorPrefix interface{}) (
-	string,
-	error) {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	var ePrefix *ePref.ErrPrefixDto
-	var err error
-	var numStr string
-
-	ePrefix,
-		err = ePref.ErrPrefixDto{}.NewIEmpty(
-		errorPrefix,
-		"NumberStrKernel."+
-			"FmtNumStrDefaultRound()",
-		"")
-
-	if err != nil {
-		return numStr, err
-	}
-
-	// If numStrKernel.numStrFormatSpec
-	// is a NOP, it will be set to default
-	// US Signed Number Minus Format Spec
-	_,
-		err = new(numberStrKernelAtom).
-		testValidityOfNumStrKernel(
-			numStrKernel,
-			ePrefix.XCpy(
-				"numStrKernel"))
-
-	if err != nil {
-		return numStr, err
-	}
-
-	var roundingSpec NumStrRoundingSpec
-
-	roundingSpec,
-		err = new(NumStrRoundingSpec).
-		NewRoundingSpec(
-			NumRoundType.NoRounding(),
-			0,
-			ePrefix.XCpy("roundingSpec"))
-
-	if err != nil {
-		return numStr, err
-	}
-
-	return new(numberStrKernelMolecule).formatNumStr(
-		numStrKernel,
-		roundingSpec,
-		numStrKernel.numStrFormatSpec,
-		ePrefix.XCpy("numStrKernel"))
-}
-
-// FmtNumStrDefaultRound
-//
-// Returns a formatted number string using the
-// numeric value provided by the current instance
-// of NumberStrKernel.
-//
-// The number string format is taken from the Default
-// Number String Format Specification previously
-// configured for the current instance of
-// NumberStrKernel.
-//
-// To explicitly set the Default Number String Format
-// Specification, use the following methods:
-//
-//	NumberStrKernel.SetDefaultNumStrFmtSpec()
-//	NumberStrKernel.SetDefaultNumStrFmtSpecPure()
-//	NumberStrKernel.SetDefaultSignedNumStrFmtSpecSimple()
-//
-// ----------------------------------------------------------------
-//
-// # BE ADVISED
-//
-//	This method will NOT delete or modify data values
-//	contained in the current instance of NumberStrKernel.
-//	However, if the NumberStrKernel Default Number String
-//	Format Specification is invalid,
-//	'NumberStrKernel.numStrFormatSpec' will be set
-//	to the default US (United States) Signed Number
-//	String Format Specification.
-//
-//	All other NumberStrKernel data values will remain
-//	unchanged.
-//
-// ----------------------------------------------------------------
-//
-// # Input Parameters
-//
-//	roundingSpec				NumStrRoundingSpec
-//
-//		The Number String Rounding Specification
-//		contains all the parameters required to
-//		configure a rounding algorithm for a
-//		floating point number string.
-//
-//		type NumStrRoundingSpec struct {
-//
-//			roundingType NumberRoundingType
-//
-//			This enumeration parameter is used to specify the type
-//			of rounding algorithm that will be applied for the
-//			rounding of fractional digits in a number string.
-//
-//			Possible values are listed as follows:
-//				NumRoundType.None()
-//				NumRoundType.NoRounding()
-//				NumRoundType.HalfUpWithNegNums()
-//				NumRoundType.HalfDownWithNegNums()
-//				NumRoundType.HalfAwayFromZero()
-//				NumRoundType.HalfTowardsZero()
-//				NumRoundType.HalfToEven()
-//				NumRoundType.HalfToOdd()
-//				NumRoundType.Randomly()
-//				NumRoundType.Floor()
-//				NumRoundType.Ceiling()
-//				NumRoundType.Truncate()
-//
-//			NoRounding
-//
-//				Signals that no rounding operation will be performed
-//				on fractional digits contained in a number string.
-//				The fractional digits will therefore remain unchanged.
-//
-//			HalfUpWithNegNums
-//
-//				Half Round Up Including Negative Numbers. This method
-//				is intuitive but may produce unexpected results when
-//				applied to negative numbers.
-//
-//				'HalfUpWithNegNums' rounds .5 up.
-//
-//					Examples of 'HalfUpWithNegNums'
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8
-//					7.4 rounds down to 7
-//					-7.4 rounds up to -7
-//					-7.5 rounds up to -7
-//					-7.6 rounds down to -8
-//
-//			HalfDownWithNegNums
-//
-//				Half Round Down Including Negative Numbers. This method
-//				is also considered intuitive but may produce unexpected
-//				results when applied to negative numbers.
-//
-//				'HalfDownWithNegNums' rounds .5 down.
-//
-//					Examples of HalfDownWithNegNums
-//
-//					7.6 rounds up to 8
-//					7.5 rounds down to 7
-//					7.4 rounds down to 7
-//					-7.4 rounds up to -7
-//					-7.5 rounds down to -8
-//					-7.6 rounds down to -8
-//
-//			HalfAwayFromZero
-//
-//				Round Half Away From Zero. This rounding method is treated
-//				as the default and this value is returned by method:
-//				NumberRoundingType(0).XGetDefaultRoundingType()
-//
-//				The 'HalfAwayFromZero' method rounds .5 further away from zero.
-//				It provides clear and consistent behavior when dealing with
-//				negative numbers.
-//
-//					Examples of HalfAwayFromZero
-//
-//					7.6 rounds away to 8
-//					7.5 rounds away to 8
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds away to -8
-//					-7.6 rounds away to -8
-//
-//			HalfTowardsZero
-//
-//				Round Half Towards Zero. 'HalfTowardsZero' rounds 0.5
-//				closer to zero. It provides clear and consistent behavior
-//				when dealing with negative numbers.
-//
-//					Examples of HalfTowardsZero
-//
-//					7.6 rounds away to 8
-//					7.5 rounds to 7
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds to -7
-//					-7.6 rounds away to -8
-//
-//			HalfToEven
-//
-//				Round Half To Even Numbers. 'HalfToEven' is also called
-//				Banker's Rounding. This method rounds 0.5 to the nearest
-//				even digit.
-//
-//					Examples of HalfToEven
-//
-//					7.5 rounds up to 8 (because 8 is an even number)
-//					but 6.5 rounds down to 6 (because 6 is an even number)
-//
-//					HalfToEven only applies to 0.5. Other numbers (not ending
-//					in 0.5) round to nearest as usual, so:
-//
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8 (because 8 is an even number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds down to 6 (because 6 is an even number)
-//					6.4 rounds down to 6
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8 (because 8 is an even number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds down to 6 (because 6 is an even number)
+//					6.4 rounds down to 6
 //
 //			HalfToOdd
 //
@@ -9848,1370 +9851,3190 @@ func (numStrKernel *NumberStrKernel) FmtNumStrDefault(
 //				operation.
 //		}
 //
-//	 errorPrefix                interface{}
-//
-//		This object encapsulates error prefix text which
-//		is included in all returned error messages.
-//		Usually, it	contains the name of the calling
-//		method or methods listed as a method or function
-//		chain of execution.
+//	decSeparatorSpec			DecimalSeparatorSpec
 //
-//		If no error prefix information is needed, set this
-//		parameter to 'nil'.
+//		This structure contains the radix point or
+//		decimal separator character(s) which will be used
+//		to separate integer and fractional digits within
+//		a formatted Number String.
 //
-//		This empty interface must be convertible to one of
-//		the following types:
+//		In the US, UK, Australia and most of Canada, the
+//		decimal separator is the period character ('.')
+//		known as the decimal point.
 //
-//		1.	nil
-//				A nil value is valid and generates an
-//				empty collection of error prefix and
-//				error context information.
+//	intSeparatorSpec			IntegerSeparatorSpec
 //
-//		2.	string
-//				A string containing error prefix
-//				information.
+//		Integer Separator Specification. This type
+//		encapsulates the parameters required to format
+//		integer grouping and separation within a Number
+//		String.
 //
-//		3.	[]string
-//				A one-dimensional slice of strings
-//				containing error prefix information.
+//	numberSymbolsGroup  		NumStrNumberSymbolGroup
 //
-//		4.	[][2]string
-//				A two-dimensional slice of strings
-//		   		containing error prefix and error
-//		   		context information.
+//		This instance of NumStrNumberSymbolGroup contains the
+//		Number Symbol Specifications for negative numeric
+//		values, positive numeric values and zero numeric
+//		values.
 //
-//		5.	ErrPrefixDto
-//				An instance of ErrPrefixDto.
-//				Information from this object will
-//				be copied for use in error and
-//				informational messages.
+//		type NumStrNumberSymbolGroup struct {
 //
-//		6.	*ErrPrefixDto
-//				A pointer to an instance of
-//				ErrPrefixDto. Information from
-//				this object will be copied for use
-//				in error and informational messages.
+//			negativeNumberSign NumStrNumberSymbolSpec
 //
-//		7.	IBasicErrorPrefix
-//				An interface to a method
-//				generating a two-dimensional slice
-//				of strings containing error prefix
-//				and error context information.
+//				The Number String Negative Number Sign
+//				Specification is used to configure negative
+//				number sign symbols for negative numeric
+//				values formatted and displayed in number
+//				stings.
 //
-//		If parameter 'errorPrefix' is NOT convertible
-//		to one of the valid types listed above, it will
-//		be considered invalid and trigger the return of
-//		an error.
-//
-//		Types ErrPrefixDto and IBasicErrorPrefix are
-//		included in the 'errpref' software package:
-//			"github.com/MikeAustin71/errpref".
+//				Example-1: Leading Number Sign Symbols
+//					Leading Number Sign Symbols for Negative
+//					Values
 //
-// ----------------------------------------------------------------
+//					Leading Symbols: "- "
+//					Number String:   "- 123.456"
 //
-// # Return Values
+//				Example-2: Leading Number Sign Symbols
+//					Leading Number Sign Symbols for Negative
+//					Values
 //
-//	string
+//					Leading Symbols: "-"
+//					Number String:   "-123.456"
 //
-//		If this method completes successfully, a formatted
-//		Number String will be returned.
+//				Example-3: Trailing Number Sign Symbols
+//					Trailing Number Sign Symbols for Negative
+//					Values
 //
-//	error
+//					Trailing Symbols: " -"
+//					Number String:   "123.456 -"
 //
-//		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
+//				Example-4: Trailing Number Sign Symbols
+//					Trailing Number Sign Symbols for Negative
+//					Values
 //
-//		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error
-//		message. This returned error message will
-//		incorporate the method chain and text passed by
-//		input parameter, 'errorPrefix'. The 'errorPrefix'
-//		text will be attached to the beginning of the
-//		error message.
-func (numStrKernel *NumberStrKernel) FmtNumStrDefaultRound(
-	roundingSpec NumStrRoundingSpec,
-	errorPrefix interface{}) (
-	string,
-	error) {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	var ePrefix *ePref.ErrPrefixDto
-	var err error
-	var numStr string
-
-	ePrefix,
-		err = ePref.ErrPrefixDto{}.NewIEmpty(
-		errorPrefix,
-		"NumberStrKernel."+
-			"FmtNumStrDefaultRound()",
-		"")
-
-	if err != nil {
-		return numStr, err
-	}
-
-	// If numStrKernel.numStrFormatSpec
-	// is a NOP, it will be set to default
-	// US Signed Number Minus Format Spec
-	_,
-		err = new(numberStrKernelAtom).
-		testValidityOfNumStrKernel(
-			numStrKernel,
-			ePrefix.XCpy(
-				"numStrKernel"))
-
-	if err != nil {
-		return numStr, err
-	}
-
-	numStr,
-		err = new(numberStrKernelMolecule).formatNumStr(
-		numStrKernel,
-		roundingSpec,
-		numStrKernel.numStrFormatSpec,
-		ePrefix.XCpy("numStrKernel"))
-
-	return numStr, err
-}
-
-//	FmtNumStrElements
+//					Trailing Symbols: "-"
+//					Number String:   "123.456-"
 //
-//	Creates and returns a fully formatted Number
-//	String generated from Number String formatting
-//	components passed as input parameters.
+//			positiveNumberSign NumStrNumberSymbolSpec
 //
-//	Options include customizing for currency symbols,
-//	integer separation, number sign	management, radix
-//	point symbols, and floating point number rounding.
+//				Positive number signs are commonly implied
+//				and not specified. However, the user has
+//				the option to specify a positive number sign
+//				character or characters for positive numeric
+//				values using a Number String Positive Number
+//				Sign Specification.
 //
-//	If required, users have the options of
-//	implementing the India or Chinese Numbering
-//	Systems.
+//				Example-1: Leading Number Sign Symbols
+//					Leading Number Sign Symbols for Positive
+//					Values
 //
-//	This method offers the maximum degree of granular
-//	control over all aspects of the Number String
-//	formatting operation.
+//					Leading Symbols: "+ "
+//					Number String:   "+ 123.456"
 //
-//	In particular, it offers maximum flexibility in
-//	configuring integer separator characters and
-//	integer grouping sequences.
+//				Example-2: Leading Number Sign Symbols
+//					Leading Number Sign Symbols for Positive
+//					Values
 //
-// ----------------------------------------------------------------
+//					Leading Symbols: "+"
+//					Number String:   "+123.456"
 //
-// # Input Parameters
+//				Example-3: Trailing Number Sign Symbols
+//					Trailing Number Sign Symbols for Positive
+//					Values
 //
-//	roundingSpec 				NumStrRoundingSpec
+//					Trailing Symbols: " +"
+//					Number String:   "123.456 +"
 //
-//		The Number String Rounding Specification
-//		contains all the parameters required to
-//		configure a rounding algorithm for a
-//		floating point number string.
+//				Example-4: Trailing Number Sign Symbols
+//					Trailing Number Sign Symbols for Positive
+//					Values
 //
-//		type NumStrRoundingSpec struct {
+//					Trailing Symbols: "+"
+//					Number String:   "123.456+"
 //
-//			roundingType NumberRoundingType
+//			zeroNumberSign NumStrNumberSymbolSpec
 //
-//			This enumeration parameter is used to specify the type
-//			of rounding algorithm that will be applied for the
-//			rounding of fractional digits in a number string.
+//				The Number String Zero Number Sign
+//				Specification is used to configure number
+//				sign symbols for zero numeric values formatted
+//				and displayed in number stings. Zero number
+//				signs are commonly omitted because zero
+//				does not technically qualify as either a
+//				positive or negative value. However,
+//				the user has the option to configure number
+//				sign symbols for zero values if necessary.
 //
-//			Possible values are listed as follows:
-//				NumRoundType.None()
-//				NumRoundType.NoRounding()
-//				NumRoundType.HalfUpWithNegNums()
-//				NumRoundType.HalfDownWithNegNums()
-//				NumRoundType.HalfAwayFromZero()
-//				NumRoundType.HalfTowardsZero()
-//				NumRoundType.HalfToEven()
-//				NumRoundType.HalfToOdd()
-//				NumRoundType.Randomly()
-//				NumRoundType.Floor()
-//				NumRoundType.Ceiling()
-//				NumRoundType.Truncate()
+//				Example-1: Leading Number Sign Symbols
+//					Leading Number Sign Symbols for Zero Values
 //
-//			NoRounding
+//					Leading Symbols: "+"
+//					Trailing Symbols: ""
+//					Number String:   "+0.00"
 //
-//				Signals that no rounding operation will be performed
-//				on fractional digits contained in a number string.
-//				The fractional digits will therefore remain unchanged.
+//				Example-2: Leading Number Sign Symbols
+//					Leading Number Sign Symbols for Zero Values
 //
-//			HalfUpWithNegNums
+//					Leading Symbols: "+ "
+//					Trailing Symbols: ""
+//					Number String:   "+ 0.00"
 //
-//				Half Round Up Including Negative Numbers. This method
-//				is intuitive but may produce unexpected results when
-//				applied to negative numbers.
+//				Example-3: Trailing Number Sign Symbols
+//					Trailing Number Sign Symbols for Zero Values
 //
-//				'HalfUpWithNegNums' rounds .5 up.
+//					Leading Symbols: ""
+//					Trailing Symbols: " +"
+//					Number String:   "0.00 +"
 //
-//					Examples of 'HalfUpWithNegNums'
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8
-//					7.4 rounds down to 7
-//					-7.4 rounds up to -7
-//					-7.5 rounds up to -7
-//					-7.6 rounds down to -8
+//				Example-4: Trailing Number Sign Symbols
+//					Trailing Number Sign Symbols for Zero Values
 //
-//			HalfDownWithNegNums
+//					Leading Symbols: ""
+//					Trailing Symbols: "+"
+//					Number String:   "0.00+"
 //
-//				Half Round Down Including Negative Numbers. This method
-//				is also considered intuitive but may produce unexpected
-//				results when applied to negative numbers.
+//			currencySymbol NumStrNumberSymbolSpec
 //
-//				'HalfDownWithNegNums' rounds .5 down.
+//				A Currency Symbol positioned next to a numeric
+//				value designates the number as a monetary amount.
 //
-//					Examples of HalfDownWithNegNums
+//				Examples of Currency Symbols include the Dollar
+//				sign ('$'), Euro sign ('€') or Pound sign ('£').
 //
-//					7.6 rounds up to 8
-//					7.5 rounds down to 7
-//					7.4 rounds down to 7
-//					-7.4 rounds up to -7
-//					-7.5 rounds down to -8
-//					-7.6 rounds down to -8
+//				This instance of NumStrNumberSymbolSpec is used
+//				to configure leading Currency Symbols, trailing
+//				Currency Symbols or both leading and trailing
+//				Currency Symbols.
 //
-//			HalfAwayFromZero
+//				Example-1: Leading Currency Symbols
 //
-//				Round Half Away From Zero. This rounding method is treated
-//				as the default and this value is returned by method:
-//				NumberRoundingType(0).XGetDefaultRoundingType()
+//					Leading Currency Symbols: "$ "
+//					Number String:   "$ 123.456"
 //
-//				The 'HalfAwayFromZero' method rounds .5 further away from zero.
-//				It provides clear and consistent behavior when dealing with
-//				negative numbers.
+//				Example-2: Leading Currency Symbols
 //
-//					Examples of HalfAwayFromZero
+//					Leading Currency Symbols: "$"
+//					Number String:   "$123.456"
 //
-//					7.6 rounds away to 8
-//					7.5 rounds away to 8
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds away to -8
-//					-7.6 rounds away to -8
+//				Example-3: Trailing Currency Symbols
+//					Trailing Currency Symbols for Positive Values
 //
-//			HalfTowardsZero
+//					Trailing Currency Symbols: "€"
+//					Number String:   "123.456€"
 //
-//				Round Half Towards Zero. 'HalfTowardsZero' rounds 0.5
-//				closer to zero. It provides clear and consistent behavior
-//				when dealing with negative numbers.
-//
-//					Examples of HalfTowardsZero
-//
-//					7.6 rounds away to 8
-//					7.5 rounds to 7
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds to -7
-//					-7.6 rounds away to -8
-//
-//			HalfToEven
-//
-//				Round Half To Even Numbers. 'HalfToEven' is also called
-//				Banker's Rounding. This method rounds 0.5 to the nearest
-//				even digit.
-//
-//					Examples of HalfToEven
-//
-//					7.5 rounds up to 8 (because 8 is an even number)
-//					but 6.5 rounds down to 6 (because 6 is an even number)
-//
-//					HalfToEven only applies to 0.5. Other numbers (not ending
-//					in 0.5) round to nearest as usual, so:
-//
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8 (because 8 is an even number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds down to 6 (because 6 is an even number)
-//					6.4 rounds down to 6
-//
-//			HalfToOdd
-//
-//				Round Half to Odd Numbers. Similar to 'HalfToEven', but
-//				in this case 'HalfToOdd' rounds 0.5 towards odd numbers.
-//
-//					Examples of HalfToOdd
+//				Example-4: Trailing Currency Symbols
+//					Trailing Currency Symbols for Positive Values
 //
-//					HalfToOdd only applies to 0.5. Other numbers (not ending
-//					in 0.5) round to nearest as usual.
+//					Trailing Currency Symbols: " €"
+//					Number String:   "123.456 €"
+//		}
 //
-//					7.5 rounds down to 7 (because 7 is an odd number)
+//	numberFieldSpec				NumStrNumberFieldSpec
 //
-//					6.5 rounds up to 7 (because 7 is an odd number)
+//		This Number Field Specification contains all
+//		parameters necessary to format a Number String
+//		within a larger Number Field. In addition to
+//		specifying the length of number field, this
+//		object contains justification specifications
+//		for centering, left justifying or right
+//		justifying a Number String within a Number
+//		Field.
 //
-//					7.6 rounds up to 8
-//					7.5 rounds down to 7 (because 7 is an odd number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds up to 7 (because 7 is an odd number)
-//					6.4 rounds down to 6
+//		type NumStrNumberFieldSpec struct {
 //
-//			Randomly
+//			fieldLength int
 //
-//				Round Half Randomly. Uses a Random Number Generator to choose
-//				between rounding 0.5 up or down.
+//				This parameter defines the length of the
+//				text field in which the numeric value will
+//				be displayed within a number string.
 //
-//				All numbers other than 0.5 round to the nearest as usual.
+//				If 'fieldLength' is less than the length
+//				of the numeric value string, it will be
+//				automatically set equal to the length of
+//				that numeric value string.
 //
-//			Floor
+//				To automatically set the value of
+//				'fieldLength' to the string length of the
+//				numeric value, set this parameter to a
+//				value of minus one (-1).
 //
-//				Yields the nearest integer down. Floor does not apply any
-//				special treatment to 0.5.
+//				If this parameter is submitted with a
+//				value less than minus one (-1) or greater
+//				than 1-million (1,000,000), an error will
+//				be returned.
 //
-//				Floor Function: The greatest integer that is less than or
-//				equal to x
-//				Source: https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//			fieldJustification TextJustify
 //
-//				In mathematics and computer science, the floor function is
-//				the function that takes as input a real number x, and gives
-//				as output the greatest integer less than or equal to x,
-//				denoted floor(x) or ⌊x⌋.
-//				Source: https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//				An enumeration which specifies the
+//				justification of the numeric value string
+//				within the number field length specified
+//				by data field 'fieldLength'.
 //
-//					Examples of Floor
+//				Text justification can only be evaluated in
+//				the context of a number string, field length
+//				and a 'textJustification' object of type
+//				TextJustify. This is because number strings
+//				with a field length equal to or less than the
+//				length of the numeric value string never use
+//				text justification. In these cases, text
+//				justification is completely ignored.
 //
-//					Number     Floor
-//					2           2
-//					2.4         2
-//					2.9         2
-//					-2.5        -3
-//					-2.7        -3
-//					-2          -2
+//				If the field length parameter ('fieldLength')
+//				is greater than the length of the numeric
+//				value string, text justification must be equal
+//				to one of these three valid values:
 //
-//			Ceiling
+//				          TextJustify(0).Left()
+//				          TextJustify(0).Right()
+//				          TextJustify(0).Center()
 //
-//				Yields the nearest integer up. Ceiling does not apply any
-//				special treatment to 0.5.
+//				You can also use the abbreviated text
+//				justification enumeration syntax as follows:
 //
-//				Ceiling Function: The least integer that is greater than or
-//				equal to x.
-//				Source: https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//				          TxtJustify.Left()
+//				          TxtJustify.Right()
+//				          TxtJustify.Center()
+//		}
 //
-//				The ceiling function maps x to the least integer greater than
-//				or equal to x, denoted ceil(x) or ⌈x⌉.[1]
-//				Source: https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//	 errorPrefix                interface{}
 //
-//					Examples of Ceiling
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it	contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//					Number    Ceiling
-//					2           2
-//					2.4         3
-//					2.9         3
-//					-2.5        -2
-//					-2.7        -2
-//					-2          -2
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//			Truncate
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//				Apply NO Rounding whatsoever. The Round From Digit is dropped
-//				or deleted. The Round To Digit is NEVER changed.
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//					Examples of Truncate
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//					Example-1
-//					Number: 23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:   23.14 - The Round From Digit is dropped.
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//					Example-2
-//					Number: -23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:  -23.14 - The Round From Digit is dropped.
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//			roundToFractionalDigits int
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//				When set to a positive integer value, this
-//				parameter controls the number of digits to
-//				the right of the radix point or decimal
-//				separator (a.k.a. decimal point) which will
-//				remain after completion of the number rounding
-//				operation.
-//		}
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//	decSeparatorSpec				DecimalSeparatorSpec
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//		This structure contains the radix point or
-//		decimal separator character(s) which will be used
-//		to separate integer and fractional digits within
-//		a formatted Number String.
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
 //
-//		In the US, UK, Australia and most of Canada, the
-//		decimal separator is the period character ('.')
-//		known as the decimal point.
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
-//		In France, Germany and many countries in the
-//		European Union, the Decimal Separator is the
-//		comma character (',').
+// ----------------------------------------------------------------
 //
-//	intSeparatorSpec				IntegerSeparatorSpec
+// # Return Values
 //
-//		Number String Integer Separator Specification. This
-//		type encapsulates the parameters required to format
-//		integer grouping and separation within a Number
-//		String.
+//	numberString				string
 //
-//		Type IntegerSeparatorSpec is designed to manage
-//		integer separators, primarily thousands separators,
-//		for different countries and cultures. The term
-//		'integer separators' is used because this type
-//		manages both integer grouping and the characters
-//		used to separate integer groups.
+//		If this method completes successfully, a formatted
+//		Number String will be returned.
 //
-//		In the USA and many other countries, integer
-//		numbers are often separated by commas thereby
-//		grouping the number into thousands.
+//	err							error
 //
-//		Examples:
+//		If this method completes successfully, the returned
+//		error Type is set equal to 'nil'.
 //
-//			IntGroupingType.None()
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error message.
+//	 	This returned error message will incorporate the method
+//	 	chain and text passed by input parameter, 'errorPrefix'.
+//	 	The 'errorPrefix' text will be attached to the beginning
+//	 	of the error message.
+func (numStrKernel *NumberStrKernel) FmtNumStrComponents(
+	roundingSpec NumStrRoundingSpec,
+	decSeparatorSpec DecimalSeparatorSpec,
+	intSeparatorSpec IntegerSeparatorSpec,
+	numberSymbolsGroup NumStrNumberSymbolGroup,
+	numberFieldSpec NumStrNumberFieldSpec,
+	errorPrefix interface{}) (
+	numberString string,
+	err error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"FmtNumStrComponents()",
+		"")
+
+	if err != nil {
+		return numberString, err
+	}
+
+	var numStrFmtSpec NumStrFormatSpec
+
+	numStrFmtSpec,
+		err = new(NumStrFormatSpec).NewNumFmtComponents(
+		decSeparatorSpec,
+		intSeparatorSpec,
+		numberSymbolsGroup,
+		numberFieldSpec,
+		ePrefix.XCpy(
+			"numStrFmtSpec<-"))
+
+	if err != nil {
+		return numberString, err
+	}
+
+	return new(numberStrKernelMolecule).
+		formatNumStr(
+			numStrKernel,
+			roundingSpec,
+			numStrFmtSpec,
+			ePrefix.XCpy("numStrKernel"))
+}
+
+// FmtNumStrDefault
+//
+// Returns a formatted number string using the numeric
+// value and the Default Number String Format
+// Specification configured for the current instance of
+// NumberStrKernel.
+//
+// To explicitly set the Default Number String Format
+// Specification, use the following methods:
+//
+//	NumberStrKernel.SetDefaultNumStrFmtSpec()
+//	NumberStrKernel.SetDefaultNumStrFmtSpecPure()
+//	NumberStrKernel.SetDefaultSignedNumStrFmtSpecSimple()
+//
+// ----------------------------------------------------------------
+//
+// # BE ADVISED
+//
+//	(1)	No rounding will be performed on the numeric
+//		value formatted as a returned number string. If
+//		rounding is required in conjunction with the
+//		Default Format Number String Formatting
+//		Specification, the user has two options.
+//
+//		a.	Call method NumberStrKernel.Round() and
+//			then call this method,
+//			NumberStrKernel.String().
+//
+//		b.	Do not call this method. Instead, call
+//			method NumberStrKernel.FmtNumStrDefaultRound().
+//
+//	(2)	This method will NOT delete or modify data values
+//		contained in the current instance of
+//		NumberStrKernel. However, if the Default
+//		NumberStrKernel Number String Format
+//		Specification is invalid,
+//		'NumberStrKernel.numStrFormatSpec' will be set to
+//		the default US (United States) Signed Number
+//		String Format Specification.
+//
+//		All other NumberStrKernel data values will remain
+//		unchanged.
+//
+//	(3)	This method is very similar to method:
+//
+//			NumberStrKernel.String()
+//
+//		The only difference is that in case of error,
+//		this method returns a type 'error'.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	string
+//
+//		If this method completes successfully, a
+//		formatted Number String will be returned.
+//
+//		The Number String format used in configuring
+//		this Number String is taken from the Default
+//		Number String Formatting Specification previously
+//		configured in the current instance of
+//		NumberStrKernel.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (numStrKernel *NumberStrKernel) FmtNumStrDefault(
+	errorPrefix interface{}) (
+	string,
+	error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+	var numStr string
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"FmtNumStrDefaultRound()",
+		"")
+
+	if err != nil {
+		return numStr, err
+	}
+
+	// If numStrKernel.numStrFormatSpec
+	// is a NOP, it will be set to default
+	// US Signed Number Minus Format Spec
+	_,
+		err = new(numberStrKernelAtom).
+		testValidityOfNumStrKernel(
+			numStrKernel,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	var roundingSpec NumStrRoundingSpec
+
+	roundingSpec,
+		err = new(NumStrRoundingSpec).
+		NewRoundingSpec(
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy("roundingSpec"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	return new(numberStrKernelMolecule).formatNumStr(
+		numStrKernel,
+		roundingSpec,
+		numStrKernel.numStrFormatSpec,
+		ePrefix.XCpy("numStrKernel"))
+}
+
+// FmtNumStrDefaultRound
+//
+// Returns a formatted number string using the
+// numeric value provided by the current instance
+// of NumberStrKernel.
+//
+// The number string format is taken from the Default
+// Number String Format Specification previously
+// configured for the current instance of
+// NumberStrKernel.
+//
+// To explicitly set the Default Number String Format
+// Specification, use the following methods:
+//
+//	NumberStrKernel.SetDefaultNumStrFmtSpec()
+//	NumberStrKernel.SetDefaultNumStrFmtSpecPure()
+//	NumberStrKernel.SetDefaultSignedNumStrFmtSpecSimple()
+//
+// ----------------------------------------------------------------
+//
+// # BE ADVISED
+//
+//	This method will NOT delete or modify data values
+//	contained in the current instance of NumberStrKernel.
+//	However, if the NumberStrKernel Default Number String
+//	Format Specification is invalid,
+//	'NumberStrKernel.numStrFormatSpec' will be set
+//	to the default US (United States) Signed Number
+//	String Format Specification.
+//
+//	All other NumberStrKernel data values will remain
+//	unchanged.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	roundingSpec				NumStrRoundingSpec
+//
+//		The Number String Rounding Specification
+//		contains all the parameters required to
+//		configure a rounding algorithm for a
+//		floating point number string.
+//
+//		type NumStrRoundingSpec struct {
+//
+//			roundingType NumberRoundingType
+//
+//			This enumeration parameter is used to specify the type
+//			of rounding algorithm that will be applied for the
+//			rounding of fractional digits in a number string.
+//
+//			Possible values are listed as follows:
+//				NumRoundType.None()
+//				NumRoundType.NoRounding()
+//				NumRoundType.HalfUpWithNegNums()
+//				NumRoundType.HalfDownWithNegNums()
+//				NumRoundType.HalfAwayFromZero()
+//				NumRoundType.HalfTowardsZero()
+//				NumRoundType.HalfToEven()
+//				NumRoundType.HalfToOdd()
+//				NumRoundType.Randomly()
+//				NumRoundType.Floor()
+//				NumRoundType.Ceiling()
+//				NumRoundType.Truncate()
+//
+//			NoRounding
+//
+//				Signals that no rounding operation will be performed
+//				on fractional digits contained in a number string.
+//				The fractional digits will therefore remain unchanged.
+//
+//			HalfUpWithNegNums
+//
+//				Half Round Up Including Negative Numbers. This method
+//				is intuitive but may produce unexpected results when
+//				applied to negative numbers.
+//
+//				'HalfUpWithNegNums' rounds .5 up.
+//
+//					Examples of 'HalfUpWithNegNums'
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8
+//					7.4 rounds down to 7
+//					-7.4 rounds up to -7
+//					-7.5 rounds up to -7
+//					-7.6 rounds down to -8
+//
+//			HalfDownWithNegNums
+//
+//				Half Round Down Including Negative Numbers. This method
+//				is also considered intuitive but may produce unexpected
+//				results when applied to negative numbers.
+//
+//				'HalfDownWithNegNums' rounds .5 down.
+//
+//					Examples of HalfDownWithNegNums
+//
+//					7.6 rounds up to 8
+//					7.5 rounds down to 7
+//					7.4 rounds down to 7
+//					-7.4 rounds up to -7
+//					-7.5 rounds down to -8
+//					-7.6 rounds down to -8
+//
+//			HalfAwayFromZero
+//
+//				Round Half Away From Zero. This rounding method is treated
+//				as the default and this value is returned by method:
+//				NumberRoundingType(0).XGetDefaultRoundingType()
+//
+//				The 'HalfAwayFromZero' method rounds .5 further away from zero.
+//				It provides clear and consistent behavior when dealing with
+//				negative numbers.
+//
+//					Examples of HalfAwayFromZero
+//
+//					7.6 rounds away to 8
+//					7.5 rounds away to 8
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds away to -8
+//					-7.6 rounds away to -8
+//
+//			HalfTowardsZero
+//
+//				Round Half Towards Zero. 'HalfTowardsZero' rounds 0.5
+//				closer to zero. It provides clear and consistent behavior
+//				when dealing with negative numbers.
+//
+//					Examples of HalfTowardsZero
+//
+//					7.6 rounds away to 8
+//					7.5 rounds to 7
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds to -7
+//					-7.6 rounds away to -8
+//
+//			HalfToEven
+//
+//				Round Half To Even Numbers. 'HalfToEven' is also called
+//				Banker's Rounding. This method rounds 0.5 to the nearest
+//				even digit.
+//
+//					Examples of HalfToEven
+//
+//					7.5 rounds up to 8 (because 8 is an even number)
+//					but 6.5 rounds down to 6 (because 6 is an even number)
+//
+//					HalfToEven only applies to 0.5. Other numbers (not ending
+//					in 0.5) round to nearest as usual, so:
+//
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8 (because 8 is an even number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds down to 6 (because 6 is an even number)
+//					6.4 rounds down to 6
+//
+//			HalfToOdd
+//
+//				Round Half to Odd Numbers. Similar to 'HalfToEven', but
+//				in this case 'HalfToOdd' rounds 0.5 towards odd numbers.
+//
+//					Examples of HalfToOdd
+//
+//					HalfToOdd only applies to 0.5. Other numbers (not ending
+//					in 0.5) round to nearest as usual.
+//
+//					7.5 rounds down to 7 (because 7 is an odd number)
+//
+//					6.5 rounds up to 7 (because 7 is an odd number)
+//
+//					7.6 rounds up to 8
+//					7.5 rounds down to 7 (because 7 is an odd number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds up to 7 (because 7 is an odd number)
+//					6.4 rounds down to 6
+//
+//			Randomly
+//
+//				Round Half Randomly. Uses a Random Number Generator to choose
+//				between rounding 0.5 up or down.
+//
+//				All numbers other than 0.5 round to the nearest as usual.
+//
+//			Floor
+//
+//				Yields the nearest integer down. Floor does not apply any
+//				special treatment to 0.5.
+//
+//				Floor Function: The greatest integer that is less than or
+//				equal to x
+//				Source: https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//
+//				In mathematics and computer science, the floor function is
+//				the function that takes as input a real number x, and gives
+//				as output the greatest integer less than or equal to x,
+//				denoted floor(x) or ⌊x⌋.
+//				Source: https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//
+//					Examples of Floor
+//
+//					Number     Floor
+//					2           2
+//					2.4         2
+//					2.9         2
+//					-2.5        -3
+//					-2.7        -3
+//					-2          -2
+//
+//			Ceiling
+//
+//				Yields the nearest integer up. Ceiling does not apply any
+//				special treatment to 0.5.
+//
+//				Ceiling Function: The least integer that is greater than or
+//				equal to x.
+//				Source: https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//
+//				The ceiling function maps x to the least integer greater than
+//				or equal to x, denoted ceil(x) or ⌈x⌉.[1]
+//				Source: https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//
+//					Examples of Ceiling
+//
+//					Number    Ceiling
+//					2           2
+//					2.4         3
+//					2.9         3
+//					-2.5        -2
+//					-2.7        -2
+//					-2          -2
+//
+//			Truncate
+//
+//				Apply NO Rounding whatsoever. The Round From Digit is dropped
+//				or deleted. The Round To Digit is NEVER changed.
+//
+//					Examples of Truncate
+//
+//					Example-1
+//					Number: 23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:   23.14 - The Round From Digit is dropped.
+//
+//					Example-2
+//					Number: -23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:  -23.14 - The Round From Digit is dropped.
+//
+//			roundToFractionalDigits int
+//
+//				When set to a positive integer value, this
+//				parameter controls the number of digits to
+//				the right of the radix point or decimal
+//				separator (a.k.a. decimal point) which will
+//				remain after completion of the number rounding
+//				operation.
+//		}
+//
+//	 errorPrefix                interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it	contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	string
+//
+//		If this method completes successfully, a formatted
+//		Number String will be returned.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (numStrKernel *NumberStrKernel) FmtNumStrDefaultRound(
+	roundingSpec NumStrRoundingSpec,
+	errorPrefix interface{}) (
+	string,
+	error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+	var numStr string
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"FmtNumStrDefaultRound()",
+		"")
+
+	if err != nil {
+		return numStr, err
+	}
+
+	// If numStrKernel.numStrFormatSpec
+	// is a NOP, it will be set to default
+	// US Signed Number Minus Format Spec
+	_,
+		err = new(numberStrKernelAtom).
+		testValidityOfNumStrKernel(
+			numStrKernel,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	numStr,
+		err = new(numberStrKernelMolecule).formatNumStr(
+		numStrKernel,
+		roundingSpec,
+		numStrKernel.numStrFormatSpec,
+		ePrefix.XCpy("numStrKernel"))
+
+	return numStr, err
+}
+
+//	FmtNumStrElements
+//
+//	Creates and returns a fully formatted Number
+//	String generated from Number String formatting
+//	components passed as input parameters.
+//
+//	Options include customizing for currency symbols,
+//	integer separation, number sign	management, radix
+//	point symbols, and floating point number rounding.
+//
+//	If required, users have the options of
+//	implementing the India or Chinese Numbering
+//	Systems.
+//
+//	This method offers the maximum degree of granular
+//	control over all aspects of the Number String
+//	formatting operation.
+//
+//	In particular, it offers maximum flexibility in
+//	configuring integer separator characters and
+//	integer grouping sequences.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	roundingSpec 				NumStrRoundingSpec
+//
+//		The Number String Rounding Specification
+//		contains all the parameters required to
+//		configure a rounding algorithm for a
+//		floating point number string.
+//
+//		type NumStrRoundingSpec struct {
+//
+//			roundingType NumberRoundingType
+//
+//			This enumeration parameter is used to specify the type
+//			of rounding algorithm that will be applied for the
+//			rounding of fractional digits in a number string.
+//
+//			Possible values are listed as follows:
+//				NumRoundType.None()
+//				NumRoundType.NoRounding()
+//				NumRoundType.HalfUpWithNegNums()
+//				NumRoundType.HalfDownWithNegNums()
+//				NumRoundType.HalfAwayFromZero()
+//				NumRoundType.HalfTowardsZero()
+//				NumRoundType.HalfToEven()
+//				NumRoundType.HalfToOdd()
+//				NumRoundType.Randomly()
+//				NumRoundType.Floor()
+//				NumRoundType.Ceiling()
+//				NumRoundType.Truncate()
+//
+//			NoRounding
+//
+//				Signals that no rounding operation will be performed
+//				on fractional digits contained in a number string.
+//				The fractional digits will therefore remain unchanged.
+//
+//			HalfUpWithNegNums
+//
+//				Half Round Up Including Negative Numbers. This method
+//				is intuitive but may produce unexpected results when
+//				applied to negative numbers.
+//
+//				'HalfUpWithNegNums' rounds .5 up.
+//
+//					Examples of 'HalfUpWithNegNums'
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8
+//					7.4 rounds down to 7
+//					-7.4 rounds up to -7
+//					-7.5 rounds up to -7
+//					-7.6 rounds down to -8
+//
+//			HalfDownWithNegNums
+//
+//				Half Round Down Including Negative Numbers. This method
+//				is also considered intuitive but may produce unexpected
+//				results when applied to negative numbers.
+//
+//				'HalfDownWithNegNums' rounds .5 down.
+//
+//					Examples of HalfDownWithNegNums
+//
+//					7.6 rounds up to 8
+//					7.5 rounds down to 7
+//					7.4 rounds down to 7
+//					-7.4 rounds up to -7
+//					-7.5 rounds down to -8
+//					-7.6 rounds down to -8
+//
+//			HalfAwayFromZero
+//
+//				Round Half Away From Zero. This rounding method is treated
+//				as the default and this value is returned by method:
+//				NumberRoundingType(0).XGetDefaultRoundingType()
+//
+//				The 'HalfAwayFromZero' method rounds .5 further away from zero.
+//				It provides clear and consistent behavior when dealing with
+//				negative numbers.
+//
+//					Examples of HalfAwayFromZero
+//
+//					7.6 rounds away to 8
+//					7.5 rounds away to 8
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds away to -8
+//					-7.6 rounds away to -8
+//
+//			HalfTowardsZero
+//
+//				Round Half Towards Zero. 'HalfTowardsZero' rounds 0.5
+//				closer to zero. It provides clear and consistent behavior
+//				when dealing with negative numbers.
+//
+//					Examples of HalfTowardsZero
+//
+//					7.6 rounds away to 8
+//					7.5 rounds to 7
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds to -7
+//					-7.6 rounds away to -8
+//
+//			HalfToEven
+//
+//				Round Half To Even Numbers. 'HalfToEven' is also called
+//				Banker's Rounding. This method rounds 0.5 to the nearest
+//				even digit.
+//
+//					Examples of HalfToEven
+//
+//					7.5 rounds up to 8 (because 8 is an even number)
+//					but 6.5 rounds down to 6 (because 6 is an even number)
+//
+//					HalfToEven only applies to 0.5. Other numbers (not ending
+//					in 0.5) round to nearest as usual, so:
+//
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8 (because 8 is an even number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds down to 6 (because 6 is an even number)
+//					6.4 rounds down to 6
+//
+//			HalfToOdd
+//
+//				Round Half to Odd Numbers. Similar to 'HalfToEven', but
+//				in this case 'HalfToOdd' rounds 0.5 towards odd numbers.
+//
+//					Examples of HalfToOdd
+//
+//					HalfToOdd only applies to 0.5. Other numbers (not ending
+//					in 0.5) round to nearest as usual.
+//
+//					7.5 rounds down to 7 (because 7 is an odd number)
+//
+//					6.5 rounds up to 7 (because 7 is an odd number)
+//
+//					7.6 rounds up to 8
+//					7.5 rounds down to 7 (because 7 is an odd number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds up to 7 (because 7 is an odd number)
+//					6.4 rounds down to 6
+//
+//			Randomly
+//
+//				Round Half Randomly. Uses a Random Number Generator to choose
+//				between rounding 0.5 up or down.
+//
+//				All numbers other than 0.5 round to the nearest as usual.
+//
+//			Floor
+//
+//				Yields the nearest integer down. Floor does not apply any
+//				special treatment to 0.5.
+//
+//				Floor Function: The greatest integer that is less than or
+//				equal to x
+//				Source: https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//
+//				In mathematics and computer science, the floor function is
+//				the function that takes as input a real number x, and gives
+//				as output the greatest integer less than or equal to x,
+//				denoted floor(x) or ⌊x⌋.
+//				Source: https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//
+//					Examples of Floor
+//
+//					Number     Floor
+//					2           2
+//					2.4         2
+//					2.9         2
+//					-2.5        -3
+//					-2.7        -3
+//					-2          -2
+//
+//			Ceiling
+//
+//				Yields the nearest integer up. Ceiling does not apply any
+//				special treatment to 0.5.
+//
+//				Ceiling Function: The least integer that is greater than or
+//				equal to x.
+//				Source: https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//
+//				The ceiling function maps x to the least integer greater than
+//				or equal to x, denoted ceil(x) or ⌈x⌉.[1]
+//				Source: https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//
+//					Examples of Ceiling
+//
+//					Number    Ceiling
+//					2           2
+//					2.4         3
+//					2.9         3
+//					-2.5        -2
+//					-2.7        -2
+//					-2          -2
+//
+//			Truncate
+//
+//				Apply NO Rounding whatsoever. The Round From Digit is dropped
+//				or deleted. The Round To Digit is NEVER changed.
+//
+//					Examples of Truncate
+//
+//					Example-1
+//					Number: 23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:   23.14 - The Round From Digit is dropped.
+//
+//					Example-2
+//					Number: -23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:  -23.14 - The Round From Digit is dropped.
+//
+//			roundToFractionalDigits int
+//
+//				When set to a positive integer value, this
+//				parameter controls the number of digits to
+//				the right of the radix point or decimal
+//				separator (a.k.a. decimal point) which will
+//				remain after completion of the number rounding
+//				operation.
+//		}
+//
+//	decSeparatorSpec				DecimalSeparatorSpec
+//
+//		This structure contains the radix point or
+//		decimal separator character(s) which will be used
+//		to separate integer and fractional digits within
+//		a formatted Number String.
+//
+//		In the US, UK, Australia and most of Canada, the
+//		decimal separator is the period character ('.')
+//		known as the decimal point.
+//
+//		In France, Germany and many countries in the
+//		European Union, the Decimal Separator is the
+//		comma character (',').
+//
+//	intSeparatorSpec				IntegerSeparatorSpec
+//
+//		Number String Integer Separator Specification. This
+//		type encapsulates the parameters required to format
+//		integer grouping and separation within a Number
+//		String.
+//
+//		Type IntegerSeparatorSpec is designed to manage
+//		integer separators, primarily thousands separators,
+//		for different countries and cultures. The term
+//		'integer separators' is used because this type
+//		manages both integer grouping and the characters
+//		used to separate integer groups.
+//
+//		In the USA and many other countries, integer
+//		numbers are often separated by commas thereby
+//		grouping the number into thousands.
+//
+//		Examples:
+//
+//			IntGroupingType.None()
 //			(a.k.a Integer Separation Turned Off)
 //				'1000000000'
 //
-//			IntGroupingType.Thousands()
-//					'1,000,000,000'
+//			IntGroupingType.Thousands()
+//					'1,000,000,000'
+//
+//			IntGroupingType.IndiaNumbering()
+//				'6,78,90,00,00,00,00,000'
+//
+//			IntGroupingType.ChineseNumbering()
+//				'6,7890,0000,0000,0000'
+//
+//		Other countries and cultures use characters other
+//		than the comma to separate integers into thousands.
+//		Some countries and cultures do not use thousands
+//		separation and instead rely on multiple integer
+//		separation characters and grouping sequences for a
+//		single integer number. Notable examples of this
+//		are found in the 'India Number System' and
+//		'Chinese Numerals'.
+//
+//		Reference:
+//			https://en.wikipedia.org/wiki/Indian_numbering_system
+//			https://en.wikipedia.org/wiki/Chinese_numerals
+//			https://en.wikipedia.org/wiki/Decimal_separator
+//
+//		The IntegerSeparatorSpec type provides the
+//		flexibility necessary to process these complex
+//		number separation formats.
+//
+//		If integer separation is turned off, no error
+//		will be returned and integer digits will be
+//		displayed as a single string of numeric digits:
+//
+//			Integer Separation Turned Off: 1000000000
+//
+//	negativeNumberSign				NumStrNumberSymbolSpec
+//
+//		The Number String Negative Number Sign
+//		Specification is used to configure negative
+//		number sign symbols for negative numeric
+//		values formatted and displayed in number
+//		stings.
+//
+//		If this parameter is submitted as an empty or
+//		invalid Negative Number Sign Specification, it
+//		will be automatically converted to a 'NOP' or
+//		empty placeholder which will be ignored by Number
+//		String formatting algorithms. 'NOP' is a computer
+//		science term meaning 'No Operation'.
+//
+//		Example-1: Leading Number Sign Symbols
+//			Leading Number Sign Symbols for Negative
+//			Values
+//
+//			Leading Symbols: "- "
+//			Number String:   "- 123.456"
+//
+//		Example-2: Leading Number Sign Symbols
+//			Leading Number Sign Symbols for Negative
+//			Values
+//
+//			Leading Symbols: "-"
+//			Number String:   "-123.456"
+//
+//		Example-3: Trailing Number Sign Symbols
+//			Trailing Number Sign Symbols for Negative
+//			Values
+//
+//			Trailing Symbols: " -"
+//			Number String:   "123.456 -"
+//
+//		Example-4: Trailing Number Sign Symbols
+//			Trailing Number Sign Symbols for Negative
+//			Values
+//
+//			Trailing Symbols: "-"
+//			Number String:   "123.456-"
+//
+//	positiveNumberSign 				NumStrNumberSymbolSpec
+//
+//		Positive number signs are commonly implied
+//		and not specified. However, the user has
+//		the option to specify a positive number sign
+//		character or characters for positive numeric
+//		values using this input parameter.
+//
+//		If this parameter is submitted as an empty or
+//		invalid Positive Number Sign Specification, it
+//		will be automatically converted to a 'NOP' or
+//		empty placeholder which will be ignored by Number
+//		String formatting algorithms. 'NOP' is a computer
+//		science term meaning 'No Operation'.
+//
+//		Example-1: Leading Number Sign Symbols
+//			Leading Number Sign Symbols for Positive
+//			Values
+//
+//			Leading Symbols: "+ "
+//			Number String:   "+ 123.456"
+//
+//		Example-2: Leading Number Sign Symbols
+//			Leading Number Sign Symbols for Positive
+//			Values
+//
+//			Leading Symbols: "+"
+//			Number String:   "+123.456"
+//
+//		Example-3: Trailing Number Sign Symbols
+//			Trailing Number Sign Symbols for Positive
+//			Values
+//
+//			Trailing Symbols: " +"
+//			Number String:   "123.456 +"
+//
+//		Example-4: Trailing Number Sign Symbols
+//			Trailing Number Sign Symbols for Positive
+//			Values
+//
+//			Trailing Symbols: "+"
+//			Number String:   "123.456+"
+//
+//	zeroNumberSign					NumStrNumberSymbolSpec
+//
+//		The Number String Zero Number Sign
+//		Specification is used to configure number
+//		sign symbols for zero numeric values formatted
+//		and displayed in number stings. Zero number signs
+//		are commonly omitted because zero does not
+//		technically qualify as either a positive or
+//		negative value. However, the user has the option
+//		to configure number sign symbols for zero values
+//		if necessary.
+//
+//		If this parameter is submitted as an empty or
+//		invalid Zero Number Sign Specification, it will
+//		be automatically converted to a 'NOP' or empty
+//		placeholder which will be ignored by Number
+//		String formatting algorithms. 'NOP' is a computer
+//		science term meaning 'No Operation'.
+//
+//		Example-1: Leading Number Sign Symbols
+//			Leading Number Sign Symbols for Zero Values
+//
+//			Leading Symbols: "+"
+//			Trailing Symbols: ""
+//			Number String:   "+0.00"
+//
+//		Example-2: Leading Number Sign Symbols
+//			Leading Number Sign Symbols for Zero Values
+//
+//			Leading Symbols: "+ "
+//			Trailing Symbols: ""
+//			Number String:   "+ 0.00"
+//
+//		Example-3: Trailing Number Sign Symbols
+//			Trailing Number Sign Symbols for Zero Values
+//
+//			Leading Symbols: ""
+//			Trailing Symbols: " +"
+//			Number String:   "0.00 +"
+//
+//		Example-4: Trailing Number Sign Symbols
+//			Trailing Number Sign Symbols for Zero Values
+//
+//			Leading Symbols: ""
+//			Trailing Symbols: "+"
+//			Number String:   "0.00+"
+//
+//	currencySymbol					NumStrNumberSymbolSpec
+//
+//		A Currency Symbol positioned next to a numeric
+//		value designates the number as a monetary amount.
+//
+//		The Number String Currency Symbol Specification
+//		is used to configure currency symbols for
+//		positive, negative and zero numeric values
+//		formatted and displayed in number stings.
+//
+//		If this parameter is submitted as an empty or
+//		invalid Currency Symbol Specification, it will
+//		be automatically converted to a 'NOP' or empty
+//		placeholder which will be ignored by Number
+//		String formatting algorithms. 'NOP' is a computer
+//		science term meaning 'No Operation'.
+//
+//		Examples of Currency Symbols include the Dollar
+//		sign ('$'), Euro sign ('€') or Pound sign ('£').
+//
+//		This instance of NumStrNumberSymbolSpec is used
+//		to configure leading Currency Symbols, trailing
+//		Currency Symbols or both leading and trailing
+//		Currency Symbols.
+//
+//		Example-1: Leading Currency Symbols
+//
+//			Leading Currency Symbols: "$ "
+//			Number String:   "$ 123.456"
+//
+//		Example-2: Leading Currency Symbols
+//
+//			Leading Currency Symbols: "$"
+//			Number String:   "$123.456"
+//
+//		Example-3: Trailing Currency Symbols
+//			Trailing Currency Symbols for Positive Values
+//
+//			Trailing Currency Symbols: "€"
+//			Number String:   "123.456€"
+//
+//		Example-4: Trailing Currency Symbols
+//			Trailing Currency Symbols for Positive Values
+//
+//			Trailing Currency Symbols: " €"
+//			Number String:   "123.456 €"
+//
+//	numberFieldSpec				NumStrNumberFieldSpec
+//
+//		This Number Field Specification contains all
+//		parameters necessary to format a Number String
+//		within a larger Number Field. In addition to
+//		specifying the length of number field, this
+//		object contains justification specifications
+//		for centering, left justifying or right
+//		justifying a Number String within a Number
+//		Field.
+//
+//		type NumStrNumberFieldSpec struct {
+//
+//			fieldLength int
+//
+//				This parameter defines the length of the
+//				text field in which the numeric value will
+//				be displayed within a number string.
+//
+//				If 'fieldLength' is less than the length
+//				of the numeric value string, it will be
+//				automatically set equal to the length of
+//				that numeric value string.
+//
+//				To automatically set the value of
+//				'fieldLength' to the string length of the
+//				numeric value, set this parameter to a
+//				value of minus one (-1).
+//
+//				If this parameter is submitted with a
+//				value less than minus one (-1) or greater
+//				than 1-million (1,000,000), an error will
+//				be returned.
+//
+//			fieldJustification TextJustify
+//
+//				An enumeration which specifies the
+//				justification of the numeric value string
+//				within the number field length specified
+//				by data field 'fieldLength'.
+//
+//				Text justification can only be evaluated in
+//				the context of a number string, field length
+//				and a 'textJustification' object of type
+//				TextJustify. This is because number strings
+//				with a field length equal to or less than the
+//				length of the numeric value string never use
+//				text justification. In these cases, text
+//				justification is completely ignored.
+//
+//				If the field length parameter ('fieldLength')
+//				is greater than the length of the numeric
+//				value string, text justification must be equal
+//				to one of these three valid values:
+//
+//				          TextJustify(0).Left()
+//				          TextJustify(0).Right()
+//				          TextJustify(0).Center()
+//
+//				You can also use the abbreviated text
+//				justification enumeration syntax as follows:
+//
+//				          TxtJustify.Left()
+//				          TxtJustify.Right()
+//				          TxtJustify.Center()
+//		}
+//
+//	 errorPrefix                interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it	contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	string
+//
+//		If this method completes successfully, a formatted
+//		Number String will be returned.
+//
+//	error
+//
+//		If this method completes successfully, the returned
+//		error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error message.
+//	 	This returned error message will incorporate the method
+//	 	chain and text passed by input parameter, 'errorPrefix'.
+//	 	The 'errorPrefix' text will be attached to the beginning
+//	 	of the error message.
+func (numStrKernel *NumberStrKernel) FmtNumStrElements(
+	roundingSpec NumStrRoundingSpec,
+	decSeparatorSpec DecimalSeparatorSpec,
+	intSeparatorSpec IntegerSeparatorSpec,
+	negativeNumberSign NumStrNumberSymbolSpec,
+	positiveNumberSign NumStrNumberSymbolSpec,
+	zeroNumberSign NumStrNumberSymbolSpec,
+	currencySymbol NumStrNumberSymbolSpec,
+	numberFieldSpec NumStrNumberFieldSpec,
+	errorPrefix interface{}) (
+	string,
+	error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+	var numStr string
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"FmtNumStr()",
+		"")
+
+	if err != nil {
+		return numStr, err
+	}
+
+	return new(numberStrKernelAtom).
+		formatNumStrElements(
+			numStrKernel,
+			roundingSpec,
+			decSeparatorSpec,
+			intSeparatorSpec,
+			negativeNumberSign,
+			positiveNumberSign,
+			zeroNumberSign,
+			currencySymbol,
+			numberFieldSpec,
+			ePrefix.XCpy(
+				"numStrKernel->"))
+}
+
+// FmtNumStrNative
+//
+// Extracts the specifications necessary to format and
+// return a native number string from the current
+// instance of NumberStrKernel. The numeric value
+// represented by the returned Native String may
+// be rounded.
+//
+// The term 'Native' means that the number string format
+// is designed to interoperate with the Golang
+// programming language library functions and packages.
+// Types like 'strconv', 'strings', 'math' and 'big'
+// (big.Int, big.Float, big.Rat) routinely parse and
+// convert this type of number string to numeric values.
+// In addition, Native Number Strings are frequently
+// consumed by external library functions such as this
+// one (String Mechanics 'strmech') to convert strings
+// to numeric values and numeric values to strings.
+//
+// While this format is inconsistent with many national
+// and cultural formatting conventions, number strings
+// which fail to implement this standardized formatting
+// protocol will generate errors in some Golang library
+// functions.
+//
+// The 'Native' Number String returned by this method
+// therefore implements a standardized format defined as
+// follows:
+//
+//  1. A Native Number String Consists of numeric
+//     character digits zero through nine inclusive
+//     (0-9).
+//
+//  2. A Native Number String will include a period
+//     or decimal point ('.') to separate integer and
+//     fractional digits within a number string.
+//
+//     Native Number String Floating Point Value:
+//     123.1234
+//
+//  3. A Native Number String will always format
+//     negative numeric values with a leading minus sign
+//     ('-').
+//
+//     Native Number String Negative Value:
+//     -123.2
+//
+//  4. A Native Number String WILL NEVER include integer
+//     separators such as commas (',') to separate
+//     integer digits by thousands.
+//
+//     NOT THIS: 1,000,000
+//     Native Number String: 1000000
+//
+//  5. Native Number Strings will only consist of:
+//
+//     (a)	Numeric digits zero through nine inclusive (0-9).
+//
+//     (b)	A decimal point ('.') for floating point
+//     numbers.
+//
+//     (c)	A leading minus sign ('-') in the case of
+//     negative numeric values.
+//
+//  6. A Native Number String will NEVER include
+//     currency symbols.
+//
+//  7. A Native Number String will NEVER include
+//     leading integer zeros or trailing fractional
+//     zeros.
+//
+// Users also have the option of specifying rounding
+// parameters which will be used to round the numeric
+// value extracted from the current NumberStrKernel
+// instance before it is converted and returned as a
+// new instance of NumberStrKernel.
+//
+// Note that return parameter 'nativeNumStrStats'
+// will provide a statistical profile of the new
+// returned number string, 'nativeNumStr'.
+//
+// ----------------------------------------------------------------
+//
+// # BE ADVISED
+//
+//	(1)	This method will NOT change or modify the data
+//		values contained in the current instance of
+//		NumberStrKernel.
+//
+//	(2) The returned Native Number String will never
+//		contain leading integer zeros or trailing
+//		fractional zeros.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	roundingType				NumberRoundingType
+//
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied for
+//		the	rounding of fractional digits contained in the
+//		current instance of NumberStrKernel.
+//
+//		If in doubt as to a suitable rounding method,
+//		'HalfAwayFromZero' is recommended.
+//
+//		Possible values are listed as follows:
+//			NumRoundType.None()	- Invalid Value
+//			NumRoundType.NoRounding()
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
+//
+//		Definitions:
+//
+//			NoRounding
+//
+//				Signals that no rounding operation will be
+//				performed on fractional digits. The
+//				fractional digits will therefore remain
+//				unchanged.
+//
+//			HalfUpWithNegNums
+//
+//				Half Round Up Including Negative Numbers.
+//				This method is intuitive but may produce
+//				unexpected results when applied to negative
+//				numbers.
+//
+//				'HalfUpWithNegNums' rounds .5 up.
+//
+//					Examples of 'HalfUpWithNegNums'
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8
+//					7.4 rounds down to 7
+//					-7.4 rounds up to -7
+//					-7.5 rounds up to -7
+//					-7.6 rounds down to -8
+//
+//			HalfDownWithNegNums
+//
+//			Half Round Down Including Negative Numbers. This
+//			method is also considered intuitive but may
+//			produce unexpected results when applied to
+//			negative numbers.
+//
+//			'HalfDownWithNegNums' rounds .5 down.
+//
+//				Examples of HalfDownWithNegNums
+//
+//				7.6 rounds up to 8
+//				7.5 rounds down to 7
+//				7.4 rounds down to 7
+//				-7.4 rounds up to -7
+//				-7.5 rounds down to -8
+//				-7.6 rounds down to -8
+//
+//			HalfAwayFromZero
+//
+//				The 'HalfAwayFromZero' method rounds .5 further
+//				away from zero.	It provides clear and consistent
+//				behavior when dealing with negative numbers.
+//
+//					Examples of HalfAwayFromZero
+//
+//					7.6 rounds away to 8
+//					7.5 rounds away to 8
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds away to -8
+//					-7.6 rounds away to -8
+//
+//			HalfTowardsZero
+//
+//				Round Half Towards Zero. 'HalfTowardsZero' rounds
+//				0.5	closer to zero. It provides clear and
+//				consistent behavior	when dealing with negative
+//				numbers.
+//
+//					Examples of HalfTowardsZero
+//
+//					7.6 rounds away to 8
+//					7.5 rounds to 7
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds to -7
+//					-7.6 rounds away to -8
+//
+//			HalfToEven
+//
+//				Round Half To Even Numbers. 'HalfToEven' is
+//				also called	Banker's Rounding. This method
+//				rounds 0.5 to the nearest even digit.
+//
+//					Examples of HalfToEven
+//
+//					7.5 rounds up to 8 (because 8 is an even
+//					number)	but 6.5 rounds down to 6 (because
+//					6 is an even number)
+//
+//					HalfToEven only applies to 0.5. Other
+//					numbers (not ending	in 0.5) round to
+//					nearest as usual, so:
+//
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8 (because 8 is an even number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds down to 6 (because 6 is an even number)
+//					6.4 rounds down to 6
+//
+//			HalfToOdd
+//
+//				Round Half to Odd Numbers. Similar to 'HalfToEven',
+//				but in this case 'HalfToOdd' rounds 0.5 towards odd
+//				numbers.
+//
+//					Examples of HalfToOdd
+//
+//					HalfToOdd only applies to 0.5. Other numbers
+//					(not ending	in 0.5) round to nearest as usual.
+//
+//					7.5 rounds down to 7 (because 7 is an odd number)
+//
+//					6.5 rounds up to 7 (because 7 is an odd number)
+//
+//					7.6 rounds up to 8
+//					7.5 rounds down to 7 (because 7 is an odd number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds up to 7 (because 7 is an odd number)
+//					6.4 rounds down to 6
+//
+//			Randomly
+//
+//				Round Half Randomly. Uses a Random Number Generator
+//				to choose between rounding 0.5 up or down.
+//
+//				All numbers other than 0.5 round to the nearest as
+//				usual.
+//
+//			Floor
+//
+//				Yields the nearest integer down. Floor does not apply
+//				any	special treatment to 0.5.
+//
+//				Floor Function: The greatest integer that is less than
+//				or equal to x
+//
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//
+//				In mathematics and computer science, the floor function
+//				is the function that takes as input a real number x,
+//				and gives as output the greatest integer less than or
+//				equal to x,	denoted floor(x) or ⌊x⌋.
+//
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//
+//				Examples of Floor
+//
+//					Number     Floor
+//					 2           2
+//					 2.4         2
+//					 2.9         2
+//					-2.5        -3
+//					-2.7        -3
+//					-2          -2
+//
+//			Ceiling
+//
+//				Yields the nearest integer up. Ceiling does not
+//				apply any special treatment to 0.5.
+//
+//				Ceiling Function: The least integer that is
+//				greater than or	equal to x.
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//
+//				The ceiling function maps x to the least integer
+//				greater than or equal to x, denoted ceil(x) or
+//				⌈x⌉.[1]
+//
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//
+//					Examples of Ceiling
+//
+//						Number    Ceiling
+//						 2           2
+//						 2.4         3
+//						 2.9         3
+//						-2.5        -2
+//						-2.7        -2
+//						-2          -2
+//
+//			Truncate
+//
+//				Apply NO Rounding whatsoever. The Round From Digit
+//				is dropped or deleted. The Round To Digit is NEVER
+//				changed.
+//
+//				Examples of Truncate
+//
+//					Example-1
+//					Number: 23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:   23.14 - The Round From Digit
+//					is dropped.
+//
+//					Example-2
+//					Number: -23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:  -23.14 - The Round From Digit
+//					is dropped.
+//
+//	roundToFractionalDigits		int
+//
+//		When set to a positive integer value, this
+//		parameter controls the number of digits to the
+//		right of the radix point or decimal separator
+//		(a.k.a. decimal point). This controls the number
+//		of fractional digits remaining after completion
+//		of the number rounding operation.
+//
+//		If input parameter 'roundingType' is set to
+//		NumRoundType.NoRounding(),
+//		'roundToFractionalDigits' is ignored and no
+//		rounding operation is performed.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//			IntGroupingType.IndiaNumbering()
-//				'6,78,90,00,00,00,00,000'
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//			IntGroupingType.ChineseNumbering()
-//				'6,7890,0000,0000,0000'
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//		Other countries and cultures use characters other
-//		than the comma to separate integers into thousands.
-//		Some countries and cultures do not use thousands
-//		separation and instead rely on multiple integer
-//		separation characters and grouping sequences for a
-//		single integer number. Notable examples of this
-//		are found in the 'India Number System' and
-//		'Chinese Numerals'.
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
 //
-//		Reference:
-//			https://en.wikipedia.org/wiki/Indian_numbering_system
-//			https://en.wikipedia.org/wiki/Chinese_numerals
-//			https://en.wikipedia.org/wiki/Decimal_separator
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
-//		The IntegerSeparatorSpec type provides the
-//		flexibility necessary to process these complex
-//		number separation formats.
+// ----------------------------------------------------------------
 //
-//		If integer separation is turned off, no error
-//		will be returned and integer digits will be
-//		displayed as a single string of numeric digits:
+// # Return Values
 //
-//			Integer Separation Turned Off: 1000000000
+//	nativeNumStr				string
 //
-//	negativeNumberSign				NumStrNumberSymbolSpec
+//		If this method completes successfully, this
+//		string parameter will return a native number
+//		string representation of the numeric value
+//		contained in the current instance of
+//		NumberStrKernel.
 //
-//		The Number String Negative Number Sign
-//		Specification is used to configure negative
-//		number sign symbols for negative numeric
-//		values formatted and displayed in number
-//		stings.
+//		The native number string returned by this method
+//		will comply with the following criteria:
 //
-//		If this parameter is submitted as an empty or
-//		invalid Negative Number Sign Specification, it
-//		will be automatically converted to a 'NOP' or
-//		empty placeholder which will be ignored by Number
-//		String formatting algorithms. 'NOP' is a computer
-//		science term meaning 'No Operation'.
+//	 	1.	A Native Number String Consists of numeric
+//	 	  	character digits zero through nine inclusive
+//	 	  	(0-9).
 //
-//		Example-1: Leading Number Sign Symbols
-//			Leading Number Sign Symbols for Negative
-//			Values
+//	 	2.	A Native Number String will include a period
+//	 	  	or decimal point ('.') to separate integer and
+//	 	  	fractional digits within a number string.
 //
-//			Leading Symbols: "- "
-//			Number String:   "- 123.456"
+//	 	  	Native Number String Floating Point Value:
+//	 	   				123.1234
 //
-//		Example-2: Leading Number Sign Symbols
-//			Leading Number Sign Symbols for Negative
-//			Values
+//	 	3.	A Native Number String will always format
+//	 	  	negative numeric values with a leading minus sign
+//	 	  	('-').
 //
-//			Leading Symbols: "-"
-//			Number String:   "-123.456"
+//	 	  	Native Number String Negative Value:
+//	 	  					-123.2
 //
-//		Example-3: Trailing Number Sign Symbols
-//			Trailing Number Sign Symbols for Negative
-//			Values
+//	 	4.	A Native Number String WILL NEVER include integer
+//			separators such as commas (',') to separate
+//			integer digits by thousands.
 //
-//			Trailing Symbols: " -"
-//			Number String:   "123.456 -"
+//	 	   					NOT THIS: 1,000,000
+//	 	   		Native Number String: 1000000
 //
-//		Example-4: Trailing Number Sign Symbols
-//			Trailing Number Sign Symbols for Negative
-//			Values
+//	 	5.	Native Number Strings will only consist of:
 //
-//			Trailing Symbols: "-"
-//			Number String:   "123.456-"
+//			(a)	Numeric digits zero through nine inclusive (0-9).
 //
-//	positiveNumberSign 				NumStrNumberSymbolSpec
+//			(b)	A decimal point ('.') for floating point
+//				numbers.
 //
-//		Positive number signs are commonly implied
-//		and not specified. However, the user has
-//		the option to specify a positive number sign
-//		character or characters for positive numeric
-//		values using this input parameter.
+//			(c)	A leading minus sign ('-') in the case of
+//				negative numeric values.
 //
-//		If this parameter is submitted as an empty or
-//		invalid Positive Number Sign Specification, it
-//		will be automatically converted to a 'NOP' or
-//		empty placeholder which will be ignored by Number
-//		String formatting algorithms. 'NOP' is a computer
-//		science term meaning 'No Operation'.
+//		6.	A Native Number String will NEVER include
+//			currency symbols.
 //
-//		Example-1: Leading Number Sign Symbols
-//			Leading Number Sign Symbols for Positive
-//			Values
+//	nativeNumStrStats			NumberStrStatsDto
 //
-//			Leading Symbols: "+ "
-//			Number String:   "+ 123.456"
+//		This data transfer object will return critical
+//		statistics on the numeric value represented
+//		by the integer and fractional digits contained
+//		in the return parameter 'nativeNumStr'.
 //
-//		Example-2: Leading Number Sign Symbols
-//			Leading Number Sign Symbols for Positive
-//			Values
+//		type NumberStrStatsDto struct {
 //
-//			Leading Symbols: "+"
-//			Number String:   "+123.456"
+//		NumOfIntegerDigits					uint64
 //
-//		Example-3: Trailing Number Sign Symbols
-//			Trailing Number Sign Symbols for Positive
-//			Values
+//			The total number of integer digits to the
+//			left of the radix point or, decimal point, in
+//			the subject numeric value.
 //
-//			Trailing Symbols: " +"
-//			Number String:   "123.456 +"
+//		NumOfSignificantIntegerDigits		uint64
+//
+//			The number of nonzero integer digits to the
+//			left of the radix point or, decimal point, in
+//			the subject numeric value.
+//
+//		NumOfFractionalDigits				uint64
+//
+//			The total number of fractional digits to the
+//			right of the radix point or, decimal point,
+//			in the subject numeric value.
+//
+//		NumOfSignificantFractionalDigits	uint64
+//
+//			The number of nonzero fractional digits to
+//			the right of the radix point or, decimal
+//			point, in the subject numeric value.
+//
+//		NumberValueType 					NumericValueType
+//
+//			This enumeration value specifies whether the
+//			subject numeric value is classified either as
+//			an integer or a floating point number.
+//
+//			Possible enumeration values are listed as
+//			follows:
+//				NumValType.None()
+//				NumValType.FloatingPoint()
+//				NumValType.Integer()
+//
+//		NumberSign							NumericSignValueType
+//
+//			An enumeration specifying the number sign
+//			associated with the numeric value. Possible
+//			values are listed as follows:
+//				NumSignVal.None()		= Invalid Value
+//				NumSignVal.Negative()	= -1
+//				NumSignVal.Zero()		=  0
+//				NumSignVal.Positive()	=  1
+//
+//		IsZeroValue							bool
+//
+//			If 'true', the subject numeric value is equal
+//			to zero ('0').
+//
+//			If 'false', the subject numeric value is
+//			greater than or less than zero ('0').
+//		}
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (numStrKernel *NumberStrKernel) FmtNumStrNative(
+	roundingType NumberRoundingType,
+	roundToFractionalDigits int,
+	errorPrefix interface{}) (
+	nativeNumStr string,
+	nativeNumStrStats NumberStrStatsDto,
+	err error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"FmtNumStrNative()",
+		"")
+
+	if err != nil {
+		return nativeNumStr,
+			nativeNumStrStats,
+			err
+	}
+
+	nativeNumStr,
+		nativeNumStrStats,
+		err = new(numberStrKernelQuark).
+		getNativeNumStr(
+			numStrKernel,
+			roundingType,
+			roundToFractionalDigits,
+			ePrefix.XCpy("numStrKernel"))
+
+	return nativeNumStr,
+		nativeNumStrStats,
+		err
+}
+
+// FmtNumStrParams
 //
-//		Example-4: Trailing Number Sign Symbols
-//			Trailing Number Sign Symbols for Positive
-//			Values
+// Converts the numeric value contained in the current
+// instance of NumberStrKernel to a formatted number
+// string using the detailed specifications passed as
+// input parameters.
 //
-//			Trailing Symbols: "+"
-//			Number String:   "123.456+"
+// Although the input parameters involve some complexity,
+// they also offer maximum flexibility in configuring
+// number strings that conform with virtually every known
+// national or cultural number string formatting
+// convention currently in use.
 //
-//	zeroNumberSign					NumStrNumberSymbolSpec
+// ----------------------------------------------------------------
 //
-//		The Number String Zero Number Sign
-//		Specification is used to configure number
-//		sign symbols for zero numeric values formatted
-//		and displayed in number stings. Zero number signs
-//		are commonly omitted because zero does not
-//		technically qualify as either a positive or
-//		negative value. However, the user has the option
-//		to configure number sign symbols for zero values
-//		if necessary.
+// # Input Parameters
 //
-//		If this parameter is submitted as an empty or
-//		invalid Zero Number Sign Specification, it will
-//		be automatically converted to a 'NOP' or empty
-//		placeholder which will be ignored by Number
-//		String formatting algorithms. 'NOP' is a computer
-//		science term meaning 'No Operation'.
+//		roundingSpec 				NumStrRoundingSpec
 //
-//		Example-1: Leading Number Sign Symbols
-//			Leading Number Sign Symbols for Zero Values
+//			The Number String Rounding Specification
+//			contains all the parameters required to
+//			configure a rounding algorithm for a
+//			floating point number string.
 //
-//			Leading Symbols: "+"
-//			Trailing Symbols: ""
-//			Number String:   "+0.00"
+//			type NumStrRoundingSpec struct {
 //
-//		Example-2: Leading Number Sign Symbols
-//			Leading Number Sign Symbols for Zero Values
+//				roundingType NumberRoundingType
 //
-//			Leading Symbols: "+ "
-//			Trailing Symbols: ""
-//			Number String:   "+ 0.00"
+//				This enumeration parameter is used to specify the type
+//				of rounding algorithm that will be applied for the
+//				rounding of fractional digits in a number string.
 //
-//		Example-3: Trailing Number Sign Symbols
-//			Trailing Number Sign Symbols for Zero Values
+//				Possible values are listed as follows:
+//					NumRoundType.None()
+//					NumRoundType.NoRounding()
+//					NumRoundType.HalfUpWithNegNums()
+//					NumRoundType.HalfDownWithNegNums()
+//					NumRoundType.HalfAwayFromZero()
+//					NumRoundType.HalfTowardsZero()
+//					NumRoundType.HalfToEven()
+//					NumRoundType.HalfToOdd()
+//					NumRoundType.Randomly()
+//					NumRoundType.Floor()
+//					NumRoundType.Ceiling()
+//					NumRoundType.Truncate()
 //
-//			Leading Symbols: ""
-//			Trailing Symbols: " +"
-//			Number String:   "0.00 +"
+//				NoRounding
 //
-//		Example-4: Trailing Number Sign Symbols
-//			Trailing Number Sign Symbols for Zero Values
+//					Signals that no rounding operation will be performed
+//					on fractional digits contained in a number string.
+//					The fractional digits will therefore remain unchanged.
 //
-//			Leading Symbols: ""
-//			Trailing Symbols: "+"
-//			Number String:   "0.00+"
+//				HalfUpWithNegNums
 //
-//	currencySymbol					NumStrNumberSymbolSpec
+//					Half Round Up Including Negative Numbers. This method
+//					is intuitive but may produce unexpected results when
+//					applied to negative numbers.
 //
-//		A Currency Symbol positioned next to a numeric
-//		value designates the number as a monetary amount.
+//					'HalfUpWithNegNums' rounds .5 up.
 //
-//		The Number String Currency Symbol Specification
-//		is used to configure currency symbols for
-//		positive, negative and zero numeric values
-//		formatted and displayed in number stings.
+//						Examples of 'HalfUpWithNegNums'
+//						7.6 rounds up to 8
+//						7.5 rounds up to 8
+//						7.4 rounds down to 7
+//						-7.4 rounds up to -7
+//						-7.5 rounds up to -7
+//						-7.6 rounds down to -8
 //
-//		If this parameter is submitted as an empty or
-//		invalid Currency Symbol Specification, it will
-//		be automatically converted to a 'NOP' or empty
-//		placeholder which will be ignored by Number
-//		String formatting algorithms. 'NOP' is a computer
-//		science term meaning 'No Operation'.
+//				HalfDownWithNegNums
 //
-//		Examples of Currency Symbols include the Dollar
-//		sign ('$'), Euro sign ('€') or Pound sign ('£').
+//					Half Round Down Including Negative Numbers. This method
+//					is also considered intuitive but may produce unexpected
+//					results when applied to negative numbers.
 //
-//		This instance of NumStrNumberSymbolSpec is used
-//		to configure leading Currency Symbols, trailing
-//		Currency Symbols or both leading and trailing
-//		Currency Symbols.
+//					'HalfDownWithNegNums' rounds .5 down.
 //
-//		Example-1: Leading Currency Symbols
+//						Examples of HalfDownWithNegNums
 //
-//			Leading Currency Symbols: "$ "
-//			Number String:   "$ 123.456"
+//						7.6 rounds up to 8
+//						7.5 rounds down to 7
+//						7.4 rounds down to 7
+//						-7.4 rounds up to -7
+//						-7.5 rounds down to -8
+//						-7.6 rounds down to -8
 //
-//		Example-2: Leading Currency Symbols
+//				HalfAwayFromZero
 //
-//			Leading Currency Symbols: "$"
-//			Number String:   "$123.456"
+//					Round Half Away From Zero. This rounding method is treated
+//					as the default and this value is returned by method:
+//					NumberRoundingType(0).XGetDefaultRoundingType()
 //
-//		Example-3: Trailing Currency Symbols
-//			Trailing Currency Symbols for Positive Values
+//					The 'HalfAwayFromZero' method rounds .5 further away from zero.
+//					It provides clear and consistent behavior when dealing with
+//					negative numbers.
 //
-//			Trailing Currency Symbols: "€"
-//			Number String:   "123.456€"
+//						Examples of HalfAwayFromZero
 //
-//		Example-4: Trailing Currency Symbols
-//			Trailing Currency Symbols for Positive Values
+//						7.6 rounds away to 8
+//						7.5 rounds away to 8
+//						7.4 rounds to 7
+//						-7.4 rounds to -7
+//						-7.5 rounds away to -8
+//						-7.6 rounds away to -8
 //
-//			Trailing Currency Symbols: " €"
-//			Number String:   "123.456 €"
+//				HalfTowardsZero
 //
-//	numberFieldSpec				NumStrNumberFieldSpec
+//					Round Half Towards Zero. 'HalfTowardsZero' rounds 0.5
+//					closer to zero. It provides clear and consistent behavior
+//					when dealing with negative numbers.
 //
-//		This Number Field Specification contains all
-//		parameters necessary to format a Number String
-//		within a larger Number Field. In addition to
-//		specifying the length of number field, this
-//		object contains justification specifications
-//		for centering, left justifying or right
-//		justifying a Number String within a Number
-//		Field.
+//						Examples of HalfTowardsZero
 //
-//		type NumStrNumberFieldSpec struct {
+//						7.6 rounds away to 8
+//						7.5 rounds to 7
+//						7.4 rounds to 7
+//						-7.4 rounds to -7
+//						-7.5 rounds to -7
+//						-7.6 rounds away to -8
 //
-//			fieldLength int
+//				HalfToEven
 //
-//				This parameter defines the length of the
-//				text field in which the numeric value will
-//				be displayed within a number string.
+//					Round Half To Even Numbers. 'HalfToEven' is also called
+//					Banker's Rounding. This method rounds 0.5 to the nearest
+//					even digit.
 //
-//				If 'fieldLength' is less than the length
-//				of the numeric value string, it will be
-//				automatically set equal to the length of
-//				that numeric value string.
+//						Examples of HalfToEven
 //
-//				To automatically set the value of
-//				'fieldLength' to the string length of the
-//				numeric value, set this parameter to a
-//				value of minus one (-1).
+//						7.5 rounds up to 8 (because 8 is an even number)
+//						but 6.5 rounds down to 6 (because 6 is an even number)
 //
-//				If this parameter is submitted with a
-//				value less than minus one (-1) or greater
-//				than 1-million (1,000,000), an error will
-//				be returned.
+//						HalfToEven only applies to 0.5. Other numbers (not ending
+//						in 0.5) round to nearest as usual, so:
 //
-//			fieldJustification TextJustify
+//						7.6 rounds up to 8
+//						7.5 rounds up to 8 (because 8 is an even number)
+//						7.4 rounds down to 7
+//						6.6 rounds up to 7
+//						6.5 rounds down to 6 (because 6 is an even number)
+//						6.4 rounds down to 6
 //
-//				An enumeration which specifies the
-//				justification of the numeric value string
-//				within the number field length specified
-//				by data field 'fieldLength'.
+//				HalfToOdd
 //
-//				Text justification can only be evaluated in
-//				the context of a number string, field length
-//				and a 'textJustification' object of type
-//				TextJustify. This is because number strings
-//				with a field length equal to or less than the
-//				length of the numeric value string never use
-//				text justification. In these cases, text
-//				justification is completely ignored.
+//					Round Half to Odd Numbers. Similar to 'HalfToEven', but
+//					in this case 'HalfToOdd' rounds 0.5 towards odd numbers.
 //
-//				If the field length parameter ('fieldLength')
-//				is greater than the length of the numeric
-//				value string, text justification must be equal
-//				to one of these three valid values:
+//						Examples of HalfToOdd
 //
-//				          TextJustify(0).Left()
-//				          TextJustify(0).Right()
-//				          TextJustify(0).Center()
+//						HalfToOdd only applies to 0.5. Other numbers (not ending
+//						in 0.5) round to nearest as usual.
 //
-//				You can also use the abbreviated text
-//				justification enumeration syntax as follows:
+//						7.5 rounds down to 7 (because 7 is an odd number)
 //
-//				          TxtJustify.Left()
-//				          TxtJustify.Right()
-//				          TxtJustify.Center()
-//		}
+//						6.5 rounds up to 7 (because 7 is an odd number)
 //
-//	 errorPrefix                interface{}
+//						7.6 rounds up to 8
+//						7.5 rounds down to 7 (because 7 is an odd number)
+//						7.4 rounds down to 7
+//						6.6 rounds up to 7
+//						6.5 rounds up to 7 (because 7 is an odd number)
+//						6.4 rounds down to 6
 //
-//		This object encapsulates error prefix text which
-//		is included in all returned error messages.
-//		Usually, it	contains the name of the calling
-//		method or methods listed as a method or function
-//		chain of execution.
+//				Randomly
 //
-//		If no error prefix information is needed, set this
-//		parameter to 'nil'.
+//					Round Half Randomly. Uses a Random Number Generator to choose
+//					between rounding 0.5 up or down.
 //
-//		This empty interface must be convertible to one of
-//		the following types:
+//					All numbers other than 0.5 round to the nearest as usual.
 //
-//		1.	nil
-//				A nil value is valid and generates an
-//				empty collection of error prefix and
-//				error context information.
+//				Floor
 //
-//		2.	string
-//				A string containing error prefix
-//				information.
+//					Yields the nearest integer down. Floor does not apply any
+//					special treatment to 0.5.
 //
-//		3.	[]string
-//				A one-dimensional slice of strings
-//				containing error prefix information.
+//					Floor Function: The greatest integer that is less than or
+//					equal to x
+//					Source: https://www.mathsisfun.com/sets/function-floor-ceiling.html
 //
-//		4.	[][2]string
-//				A two-dimensional slice of strings
-//		   		containing error prefix and error
-//		   		context information.
+//					In mathematics and computer science, the floor function is
+//					the function that takes as input a real number x, and gives
+//					as output the greatest integer less than or equal to x,
+//					denoted floor(x) or ⌊x⌋.
+//					Source: https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
 //
-//		5.	ErrPrefixDto
-//				An instance of ErrPrefixDto.
-//				Information from this object will
-//				be copied for use in error and
-//				informational messages.
+//						Examples of Floor
 //
-//		6.	*ErrPrefixDto
-//				A pointer to an instance of
-//				ErrPrefixDto. Information from
-//				this object will be copied for use
-//				in error and informational messages.
+//						Number     Floor
+//						2           2
+//						2.4         2
+//						2.9         2
+//						-2.5        -3
+//						-2.7        -3
+//						-2          -2
 //
-//		7.	IBasicErrorPrefix
-//				An interface to a method
-//				generating a two-dimensional slice
-//				of strings containing error prefix
-//				and error context information.
+//				Ceiling
 //
-//		If parameter 'errorPrefix' is NOT convertible
-//		to one of the valid types listed above, it will
-//		be considered invalid and trigger the return of
-//		an error.
+//					Yields the nearest integer up. Ceiling does not apply any
+//					special treatment to 0.5.
 //
-//		Types ErrPrefixDto and IBasicErrorPrefix are
-//		included in the 'errpref' software package:
-//			"github.com/MikeAustin71/errpref".
+//					Ceiling Function: The least integer that is greater than or
+//					equal to x.
+//					Source: https://www.mathsisfun.com/sets/function-floor-ceiling.html
 //
-// ----------------------------------------------------------------
+//					The ceiling function maps x to the least integer greater than
+//					or equal to x, denoted ceil(x) or ⌈x⌉.[1]
+//					Source: https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
 //
-// # Return Values
+//						Examples of Ceiling
 //
-//	string
+//						Number    Ceiling
+//						2           2
+//						2.4         3
+//						2.9         3
+//						-2.5        -2
+//						-2.7        -2
+//						-2          -2
 //
-//		If this method completes successfully, a formatted
-//		Number String will be returned.
+//				Truncate
 //
-//	error
+//					Apply NO Rounding whatsoever. The Round From Digit is dropped
+//					or deleted. The Round To Digit is NEVER changed.
 //
-//		If this method completes successfully, the returned
-//		error Type is set equal to 'nil'.
+//						Examples of Truncate
 //
-//		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error message.
-//	 	This returned error message will incorporate the method
-//	 	chain and text passed by input parameter, 'errorPrefix'.
-//	 	The 'errorPrefix' text will be attached to the beginning
-//	 	of the error message.
-func (numStrKernel *NumberStrKernel) FmtNumStrElements(
-	roundingSpec NumStrRoundingSpec,
-	decSeparatorSpec DecimalSeparatorSpec,
-	intSeparatorSpec IntegerSeparatorSpec,
-	negativeNumberSign NumStrNumberSymbolSpec,
-	positiveNumberSign NumStrNumberSymbolSpec,
-	zeroNumberSign NumStrNumberSymbolSpec,
-	currencySymbol NumStrNumberSymbolSpec,
-	numberFieldSpec NumStrNumberFieldSpec,
-	errorPrefix interface{}) (
-	string,
-	error) {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	var ePrefix *ePref.ErrPrefixDto
-	var err error
-	var numStr string
-
-	ePrefix,
-		err = ePref.ErrPrefixDto{}.NewIEmpty(
-		errorPrefix,
-		"NumberStrKernel."+
-			"FmtNumStr()",
-		"")
-
-	if err != nil {
-		return numStr, err
-	}
-
-	return new(numberStrKernelAtom).
-		formatNumStrElements(
-			numStrKernel,
-			roundingSpec,
-			decSeparatorSpec,
-			intSeparatorSpec,
-			negativeNumberSign,
-			positiveNumberSign,
-			zeroNumberSign,
-			currencySymbol,
-			numberFieldSpec,
-			ePrefix.XCpy(
-				"numStrKernel->"))
-}
-
-// FmtNumStrNative
+//						Example-1
+//						Number: 23.14567
+//						Objective: Round to two decimal places to
+//						the right of the decimal point.
+//						Rounding Method: Truncate
+//						Round To Digit:   4
+//						Round From Digit: 5
+//						Rounded Number:   23.14 - The Round From Digit is dropped.
 //
-// Extracts the specifications necessary to format and
-// return a native number string from the current
-// instance of NumberStrKernel. The numeric value
-// represented by the returned Native String may
-// be rounded.
+//						Example-2
+//						Number: -23.14567
+//						Objective: Round to two decimal places to
+//						the right of the decimal point.
+//						Rounding Method: Truncate
+//						Round To Digit:   4
+//						Round From Digit: 5
+//						Rounded Number:  -23.14 - The Round From Digit is dropped.
 //
-// The term 'Native' means that the number string format
-// is designed to interoperate with the Golang
-// programming language library functions and packages.
-// Types like 'strconv', 'strings', 'math' and 'big'
-// (big.Int, big.Float, big.Rat) routinely parse and
-// convert this type of number string to numeric values.
-// In addition, Native Number Strings are frequently
-// consumed by external library functions such as this
-// one (String Mechanics 'strmech') to convert strings
-// to numeric values and numeric values to strings.
+//				roundToFractionalDigits int
 //
-// While this format is inconsistent with many national
-// and cultural formatting conventions, number strings
-// which fail to implement this standardized formatting
-// protocol will generate errors in some Golang library
-// functions.
+//					When set to a positive integer value, this
+//					parameter controls the number of digits to
+//					the right of the radix point or decimal
+//					separator (a.k.a. decimal point) which will
+//					remain after completion of the number rounding
+//					operation.
+//			}
 //
-// The 'Native' Number String returned by this method
-// therefore implements a standardized format defined as
-// follows:
+//		decSeparator					string
 //
-//  1. A Native Number String Consists of numeric
-//     character digits zero through nine inclusive
-//     (0-9).
+//			This string contains the character or characters
+//			which will be configured as the Decimal Separator
+//			Symbol or Symbols for the returned instance of
+//			NumStrFormatSpec.
 //
-//  2. A Native Number String will include a period
-//     or decimal point ('.') to separate integer and
-//     fractional digits within a number string.
+//			The decimal separator is also known as the radix
+//			point and is used to separate integer and fractional
+//			digits within a formatted Number String.
 //
-//     Native Number String Floating Point Value:
-//     123.1234
+//			In the US, UK, Australia and most of Canada, the
+//			decimal separator is the period character ('.')
+//			known as the decimal point.
 //
-//  3. A Native Number String will always format
-//     negative numeric values with a leading minus sign
-//     ('-').
+//			In France, Germany and many countries in the
+//			European Union, the Decimal Separator is the
+//			comma character (',').
 //
-//     Native Number String Negative Value:
-//     -123.2
+//		intSeparatorChars				string
 //
-//  4. A Native Number String WILL NEVER include integer
-//     separators such as commas (',') to separate
-//     integer digits by thousands.
+//			One or more characters used to separate groups of
+//			integers. This separator is also known as the
+//			'thousands' separator. It is used to separate
+//			groups of integer digits to the left of the
+//			decimal separator (a.k.a. decimal point). In the
+//			United States, the standard integer digits
+//			separator is the comma (",").
 //
-//     NOT THIS: 1,000,000
-//     Native Number String: 1000000
+//				United States Example:  1,000,000,000
 //
-//  5. Native Number Strings will only consist of:
+//			In many European countries, a single period ('.')
+//			is used as the integer separator character.
 //
-//     (a)	Numeric digits zero through nine inclusive (0-9).
+//				European Example: 1.000.000.000
 //
-//     (b)	A decimal point ('.') for floating point
-//     numbers.
+//			Other countries and cultures use spaces,
+//			apostrophes or multiple characters to separate
+//			integers.
 //
-//     (c)	A leading minus sign ('-') in the case of
-//     negative numeric values.
+//			If this input parameter contains a zero length
+//			string and parameter 'intGroupingType' is NOT
+//			equal to 'IntGroupingType.None()', an error will
+//			be returned.
 //
-//  6. A Native Number String will NEVER include
-//     currency symbols.
+//		intGroupingType					IntegerGroupingType
 //
-//  7. A Native Number String will NEVER include
-//     leading integer zeros or trailing fractional
-//     zeros.
+//			This instance of IntegerGroupingType defines the type
+//			of IntegerSeparatorSpec which will be returned. The
+//			enumeration IntegerGroupingType must be set to one
+//			of the following values:
 //
-// Users also have the option of specifying rounding
-// parameters which will be used to round the numeric
-// value extracted from the current NumberStrKernel
-// instance before it is converted and returned as a
-// new instance of NumberStrKernel.
+//				IntGroupingType.None()
+//				IntGroupingType.Thousands()
+//				IntGroupingType.IndiaNumbering()
+//				IntGroupingType.ChineseNumbering()
 //
-// Note that return parameter 'nativeNumStrStats'
-// will provide a statistical profile of the new
-// returned number string, 'nativeNumStr'.
+//		Note:	Setting 'intGroupingType' to a value of
+//				IntGroupingType.None() effectively turns
+//				off integer separation.
 //
-// ----------------------------------------------------------------
+//		Examples:
 //
-// # BE ADVISED
+//			IntGroupingType.None()
+//				'1000000000'
 //
-//	(1)	This method will NOT change or modify the data
-//		values contained in the current instance of
-//		NumberStrKernel.
+//			IntGroupingType.Thousands()
+//					'1,000,000,000'
 //
-//	(2) The returned Native Number String will never
-//		contain leading integer zeros or trailing
-//		fractional zeros.
+//			IntGroupingType.IndiaNumbering()
+//				'6,78,90,00,00,00,00,000'
 //
-// ----------------------------------------------------------------
+//			IntGroupingType.ChineseNumbering()
+//				'6,7890,0000,0000,0000'
 //
-// # Input Parameters
+//		leadingPosNumSign				string
 //
-//	roundingType				NumberRoundingType
+//			A string containing the leading positive number
+//			sign character or characters used to configure
+//			a Positive Number Sign Symbol in a number string
+//			with a positive numeric value.
 //
-//		This enumeration parameter is used to specify the
-//		type of rounding algorithm that will be applied for
-//		the	rounding of fractional digits contained in the
-//		current instance of NumberStrKernel.
+//			Leading number symbols can include any combination
+//			of characters such as plus signs ('+') and/or
+//			currency symbols ('$').
 //
-//		If in doubt as to a suitable rounding method,
-//		'HalfAwayFromZero' is recommended.
+//		trailingPosNumSign				string
 //
-//		Possible values are listed as follows:
-//			NumRoundType.None()	- Invalid Value
-//			NumRoundType.NoRounding()
-//			NumRoundType.HalfUpWithNegNums()
-//			NumRoundType.HalfDownWithNegNums()
-//			NumRoundType.HalfAwayFromZero()
-//			NumRoundType.HalfTowardsZero()
-//			NumRoundType.HalfToEven()
-//			NumRoundType.HalfToOdd()
-//			NumRoundType.Randomly()
-//			NumRoundType.Floor()
-//			NumRoundType.Ceiling()
-//			NumRoundType.Truncate()
+//			A string containing the trailing positive number
+//		 	sign character or characters used to configure a
+//		  	Positive Number Sign Symbol in a number string.
 //
-//		Definitions:
+//			Trailing number symbols can include any combination
+//			of characters to include plus signs ('+') and/or
+//		 	currency symbols ('$').
 //
-//			NoRounding
+//		positiveNumFieldSymPosition		NumberFieldSymbolPosition
 //
-//				Signals that no rounding operation will be
-//				performed on fractional digits. The
-//				fractional digits will therefore remain
-//				unchanged.
+//			Defines the position of the Positive Number Sign
+//			character, or characters, relative to a Number
+//			Field in which a number string is displayed.
+//			Possible valid values are listed as follows:
 //
-//			HalfUpWithNegNums
+//				NumFieldSymPos.InsideNumField()
+//					Example-1:
+//						Number Field Length: 8
+//						Numeric Value: 123.45
+//						Number Symbol: leading plus sign ('+')
+//						Number Symbol Position: Inside Number Field
+//				     	Number Text Justification: Right
+//						Formatted Number String: " +123.45"
+//						Number Field Index:       01234567
+//						Total Number String Length: 8
 //
-//				Half Round Up Including Negative Numbers.
-//				This method is intuitive but may produce
-//				unexpected results when applied to negative
-//				numbers.
+//					Example-2:
+//						Number Field Length: 8
+//						Numeric Value: 123.45
+//						Number Symbol: trailing plus sign ('+')
+//						Number Symbol Position: Inside Number Field
+//				     	Number Text Justification: Right
+//						Formatted Number String: " 123.45+"
+//						Number Field Index:       01234567
+//						Total Number String Length: 8
 //
-//				'HalfUpWithNegNums' rounds .5 up.
+//					For the 'NumFieldSymPos.InsideNumField()'
+//					specification, the final length of the number
+//					string is defined by the Number Field length.
 //
-//					Examples of 'HalfUpWithNegNums'
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8
-//					7.4 rounds down to 7
-//					-7.4 rounds up to -7
-//					-7.5 rounds up to -7
-//					-7.6 rounds down to -8
+//				NumFieldSymPos.OutsideNumField()
+//					Example-3:
+//						Number Field Length: 8
+//				     	Numeric Value: 123.45
+//				     	Number Symbol: leading plus sign ('+')
+//				     	Number Symbol Position: Outside Number Field
+//				     	Number Text Justification: Right
+//				     	Formatted Number String: "+  123.45"
+//						Number Field Index:       012345678
+//						Total Number String Length: 9
 //
-//			HalfDownWithNegNums
+//					Example-4:
+//						Number Field Length: 8
+//				     	Numeric Value: 123.45
+//				     	Number Symbol: trailing plus sign ('+')
+//				     	Number Symbol Position: Outside Number Field
+//				     	Number Text Justification: Right
+//				     	Formatted Number String: "  123.45+"
+//						Number Field Index:       012345678
+//						Total Number String Length: 9
 //
-//			Half Round Down Including Negative Numbers. This
-//			method is also considered intuitive but may
-//			produce unexpected results when applied to
-//			negative numbers.
+//					For the 'NumFieldSymPos.OutsideNumField()'
+//					specification, the final length of the
+//					number string is greater than the Number
+//					Field length.
 //
-//			'HalfDownWithNegNums' rounds .5 down.
+//		leadingNegNumSign				string
 //
-//				Examples of HalfDownWithNegNums
+//			A string containing the leading negative number
+//			sign character or characters used to configure
+//			Negative Number Sign Symbols in a number string
+//			with a negative numeric value.
 //
-//				7.6 rounds up to 8
-//				7.5 rounds down to 7
-//				7.4 rounds down to 7
-//				-7.4 rounds up to -7
-//				-7.5 rounds down to -8
-//				-7.6 rounds down to -8
+//			Leading number symbols can include any
+//			combination of characters such as minus signs
+//			('-') and/or currency symbols ('$').
 //
-//			HalfAwayFromZero
+//		trailingNegNumSign				string
 //
-//				The 'HalfAwayFromZero' method rounds .5 further
-//				away from zero.	It provides clear and consistent
-//				behavior when dealing with negative numbers.
+//			A string containing the trailing negative number
+//			sign character or characters used to configure
+//			Negative Number Sign Symbols in a number string
+//			with a negative numeric value.
 //
-//					Examples of HalfAwayFromZero
+//			Trailing number symbols can include any
+//			combination of characters such as minus signs
+//			('-') and/or currency symbols ('$').
 //
-//					7.6 rounds away to 8
-//					7.5 rounds away to 8
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds away to -8
-//					-7.6 rounds away to -8
+//		negativeNumFieldSymPosition		NumberFieldSymbolPosition
 //
-//			HalfTowardsZero
+//			Defines the position of the Negative Number Sign
+//			relative to a Number Field in which a number
+//			string is displayed. Possible valid values are
+//			listed as follows:
 //
-//				Round Half Towards Zero. 'HalfTowardsZero' rounds
-//				0.5	closer to zero. It provides clear and
-//				consistent behavior	when dealing with negative
-//				numbers.
+//				NumFieldSymPos.InsideNumField()
+//					Example-1:
+//						Number Field Length: 8
+//						Numeric Value: 123.45
+//						Number Symbol: leading minus sign ('-')
+//						Number Symbol Position: Inside Number Field
+//				     	Number Text Justification: Right
+//						Formatted Number String: " -123.45"
+//						Number Field Index:  01234567
+//						Total Number String Length: 8
 //
-//					Examples of HalfTowardsZero
+//					Example-2:
+//						Number Field Length: 8
+//						Numeric Value: 123.45
+//						Number Symbol: trailing minus sign ('-')
+//						Number Symbol Position: Inside Number Field
+//				     	Number Text Justification: Right
+//						Formatted Number String: " 123.45-"
+//						Number Field Index:       01234567
+//						Total Number String Length: 8
 //
-//					7.6 rounds away to 8
-//					7.5 rounds to 7
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds to -7
-//					-7.6 rounds away to -8
+//					Example-3:
+//						Number Field Length: 10
+//						Numeric Value: 123.45
+//						Number Symbol: before and after parentheses  ('()')
+//						Number Symbol Position: Inside Number Field
+//				     	Number Text Justification: Centered
+//						Formatted Number String: " (123.45) "
+//						Number Field Index:       0123456789
+//						Total Number String Length: 10
 //
-//			HalfToEven
+//					For the 'NumFieldSymPos.InsideNumField()'
+//					specification, the final length of the number
+//					string is defined by the Number Field length.
 //
-//				Round Half To Even Numbers. 'HalfToEven' is
-//				also called	Banker's Rounding. This method
-//				rounds 0.5 to the nearest even digit.
+//				NumFieldSymPos.OutsideNumField()
+//					Example-4:
+//						Number Field Length: 8
+//				     	Numeric Value: 123.45
+//				     	Number Symbol: leading minus sign ('-')
+//				     	Number Symbol Position: Outside Number Field
+//				     	Number Text Justification: Right
+//				     	Formatted Number String: "-  123.45"
+//						Number Field Index:       012345678
+//						Total Number String Length: 9
 //
-//					Examples of HalfToEven
+//					Example-5:
+//						Number Field Length: 8
+//				     	Numeric Value: 123.45
+//				     	Number Symbol: trailing minus sign ('-')
+//				     	Number Symbol Position: Outside Number Field
+//				     	Number Text Justification: Right
+//				     	Formatted Number String: "  123.45-"
+//						Number Field Index:       012345678
+//						Total Number String Length: 9
 //
-//					7.5 rounds up to 8 (because 8 is an even
-//					number)	but 6.5 rounds down to 6 (because
-//					6 is an even number)
+//					Example-6:
+//						Number Field Length: 8
+//						Numeric Value: 123.45
+//						Number Symbol: before and after parentheses  ('()')
+//						Number Symbol Position: Outside Number Field
+//				     	Number Text Justification: Centered
+//						Formatted Number String: "( 123.45 )"
+//						Number Field Index:       0123456789
+//						Total Number String Length: 10
 //
-//					HalfToEven only applies to 0.5. Other
-//					numbers (not ending	in 0.5) round to
-//					nearest as usual, so:
+//					For the 'NumFieldSymPos.OutsideNumField()'
+//					specification, the final length of the number
+//					string is greater than the Number Field length.
 //
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8 (because 8 is an even number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds down to 6 (because 6 is an even number)
-//					6.4 rounds down to 6
+//		leadingZeroNumSign				string
 //
-//			HalfToOdd
+//			A string containing the leading zero number sign
+//			character or characters used to configure a	Number
+//			Sign Symbol in a number string with a zero
+//			numeric value.
 //
-//				Round Half to Odd Numbers. Similar to 'HalfToEven',
-//				but in this case 'HalfToOdd' rounds 0.5 towards odd
-//				numbers.
+//			Leading number symbols can include any combination
+//			of characters such as plus signs ('+') and/or
+//			currency symbols ('$').
 //
-//					Examples of HalfToOdd
+//		trailingZeroNumSign				string
 //
-//					HalfToOdd only applies to 0.5. Other numbers
-//					(not ending	in 0.5) round to nearest as usual.
+//			A string containing the trailing zero number sign
+//			character or characters used to configure a Number
+//			Sign Symbol in a number string with a zero
+//			numeric value.
 //
-//					7.5 rounds down to 7 (because 7 is an odd number)
+//			Trailing number symbols can include any combination
+//			of characters such as plus signs ('+') and/or
+//		 	currency symbols ('$').
 //
-//					6.5 rounds up to 7 (because 7 is an odd number)
+//		zeroNumFieldSymPosition			NumberFieldSymbolPosition
 //
-//					7.6 rounds up to 8
-//					7.5 rounds down to 7 (because 7 is an odd number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds up to 7 (because 7 is an odd number)
-//					6.4 rounds down to 6
+//			Defines the position of the zero Number Sign
+//			relative to a Number Field in which a number
+//			string is displayed. Possible valid values are
+//			listed as follows:
 //
-//			Randomly
+//				NumFieldSymPos.InsideNumField()
+//					Example-1:
+//						Number Field Length: 8
+//						Numeric Value: 123.45
+//						Number Symbol: leading plus sign ('+')
+//						Number Symbol Position: Inside Number Field
+//				     	Number Text Justification: Right
+//						Formatted Number String: " +123.45"
+//						Number Field Index:       01234567
+//						Total Number String Length: 8
 //
-//				Round Half Randomly. Uses a Random Number Generator
-//				to choose between rounding 0.5 up or down.
+//					Example-2:
+//						Number Field Length: 8
+//						Numeric Value: 123.45
+//						Number Symbol: trailing plus sign ('+')
+//						Number Symbol Position: Inside Number Field
+//				     	Number Text Justification: Right
+//						Formatted Number String: " 123.45+"
+//						Number Field Index:       01234567
+//						Total Number String Length: 8
 //
-//				All numbers other than 0.5 round to the nearest as
-//				usual.
+//					For the 'NumFieldSymPos.InsideNumField()'
+//					specification, the final length of the
+//					number string is defined by the Number
+//					Field length.
 //
-//			Floor
+//				NumFieldSymPos.OutsideNumField()
+//					Example-3:
+//						Number Field Length: 8
+//				     	Numeric Value: 123.45
+//				     	Number Symbol: leading plus sign ('+')
+//				     	Number Symbol Position: Outside Number Field
+//				     	Number Text Justification: Right
+//				     	Formatted Number String: "+  123.45"
+//						Number Field Index:       012345678
+//						Total Number String Length: 9
 //
-//				Yields the nearest integer down. Floor does not apply
-//				any	special treatment to 0.5.
+//					Example-4:
+//						Number Field Length: 8
+//				     	Numeric Value: 123.45
+//				     	Number Symbol: trailing plus sign ('+')
+//				     	Number Symbol Position: Outside Number Field
+//				     	Number Text Justification: Right
+//				     	Formatted Number String: "  123.45+"
+//						Number Field Index:       012345678
+//						Total Number String Length: 9
 //
-//				Floor Function: The greatest integer that is less than
-//				or equal to x
+//					For the 'NumFieldSymPos.OutsideNumField()'
+//					specification, the final length of the number
+//					string is greater than the Number Field length.
 //
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//	leadingCurrencySymbol     		string
 //
-//				In mathematics and computer science, the floor function
-//				is the function that takes as input a real number x,
-//				and gives as output the greatest integer less than or
-//				equal to x,	denoted floor(x) or ⌊x⌋.
+//		A string containing one or more Leading
+//		Currency Symbol characters used to configure
+//		the returned instance of NumStrNumberSymbolSpec.
 //
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//		Leading Currency Symbol characters can include
+//		such symbols as the dollar sign ('$'), Euro sign
+//	 	('€') and Pound sign ('£').
 //
-//				Examples of Floor
+//		Leading Currency Symbols are prefixed or
+//		prepended to the beginning of number strings
+//		containing currency numeric values.
 //
-//					Number     Floor
-//					 2           2
-//					 2.4         2
-//					 2.9         2
-//					-2.5        -3
-//					-2.7        -3
-//					-2          -2
+//		Currency Symbols are optional. If Currency
+//		Symbols are not required, set
+//		'leadingCurrencySymbol' to 'nil'.
 //
-//			Ceiling
+//	trailingCurrencySymbol     		string
 //
-//				Yields the nearest integer up. Ceiling does not
-//				apply any special treatment to 0.5.
+//		A string containing one or more Trailing
+//		Currency Symbol characters used to configure
+//		the returned instance of NumStrNumberSymbolSpec.
 //
-//				Ceiling Function: The least integer that is
-//				greater than or	equal to x.
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//		Trailing Currency Symbol characters can include
+//		such symbols as the dollar sign ('$'), Euro sign
+//	 	('€') and Pound sign ('£').
 //
-//				The ceiling function maps x to the least integer
-//				greater than or equal to x, denoted ceil(x) or
-//				⌈x⌉.[1]
+//		Trailing Currency Symbols are suffixed or
+//		appended to the end of number strings containing
+//		currency numeric values.
 //
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//				Example: 125.34€
 //
-//					Examples of Ceiling
+//		Currency Symbols are optional. If Currency
+//		Symbols are not required, set
+//		'trailingCurrencySymbol' to 'nil'.
 //
-//						Number    Ceiling
-//						 2           2
-//						 2.4         3
-//						 2.9         3
-//						-2.5        -2
-//						-2.7        -2
-//						-2          -2
+//	currencyInsideNumSymbol			bool
 //
-//			Truncate
+//		This boolean parameter determines whether the
+//		currency symbol will be positioned inside or
+//		outside the negative number sign symbol.
 //
-//				Apply NO Rounding whatsoever. The Round From Digit
-//				is dropped or deleted. The Round To Digit is NEVER
-//				changed.
+//		If this parameter is set to 'false', the
+//		currency symbol will be positioned outside
+//		the negative number sign symbol.
 //
-//				Examples of Truncate
+//			Example-1 Outside:
+//				currencyInsideNumSymbol = false
+//				Number String = "$ -123.45"
 //
-//					Example-1
-//					Number: 23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:   23.14 - The Round From Digit
-//					is dropped.
+//			Example-2 Outside:
+//				currencyInsideNumSymbol = false
+//				Number String = "  123.45- €"
 //
-//					Example-2
-//					Number: -23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:  -23.14 - The Round From Digit
-//					is dropped.
+//		If this parameter is set to 'true', the
+//		currency symbol will be positioned inside
+//		the negative number sign symbol.
 //
-//	roundToFractionalDigits		int
+//			Example - 3 Inside:
+//				currencyInsideNumSymbol = true
+//				Number String = " - $123.45"
 //
-//		When set to a positive integer value, this
-//		parameter controls the number of digits to the
-//		right of the radix point or decimal separator
-//		(a.k.a. decimal point). This controls the number
-//		of fractional digits remaining after completion
-//		of the number rounding operation.
+//			Example - 4 Inside:
+//				currencyInsideNumSymbol = true
+//				Number String = "  123.45€ -"
 //
-//		If input parameter 'roundingType' is set to
-//		NumRoundType.NoRounding(),
-//		'roundToFractionalDigits' is ignored and no
-//		rounding operation is performed.
+//	currencyNumFieldSymPosition		NumberFieldSymbolPosition
 //
-//	errorPrefix					interface{}
+//		Defines the position of the Leading Currency
+//		Symbol ('leadingCurrencySymbol') relative to a
+//		Number Field in which a number string is
+//		displayed. Possible valid values are listed as
+//		follows:
 //
-//		This object encapsulates error prefix text which
-//		is included in all returned error messages.
-//		Usually, it contains the name of the calling
-//		method or methods listed as a method or function
-//		chain of execution.
+//			NumFieldSymPos.InsideNumField()
+//			NumFieldSymPos.OutsideNumField()
 //
-//		If no error prefix information is needed, set this
-//		parameter to 'nil'.
+//		Examples NumFieldSymPos.InsideNumField()
 //
-//		This empty interface must be convertible to one of
-//		the following types:
+//			Example-1:
+//				Number Field Length: 10
+//				Numeric Value: 123.45
+//				Leading Currency Symbol: Dollar sign ('$')
+//				Trailing Currency Symbol: Dollar sign ('$')
+//				Number Symbol Position: Inside Number Field
+//			    Number Text Justification: Right Justified
+//				Formatted Number String: " $123.45$"
+//				Number Field Index:------>012345679
+//				Total Number String Length: 10
 //
-//		1.	nil
-//				A nil value is valid and generates an
-//				empty collection of error prefix and
-//				error context information.
+//			Example-2:
+//				Number Field Length: 12
+//				Numeric Value: 123.45
+//				Leading Currency Symbol: Dollar sign ('$')
+//				Trailing Currency Symbol: Dollar sign ('$')
+//				Number Symbol Position: Inside Number Field
+//				Number Text Justification: Centered
+//				Formatted Number String: "  $123.45$  "
+//				Number Field Index:------>012345678901
+//				Total Number String Length: 12
 //
-//		2.	string
-//				A string containing error prefix
-//				information.
+//			For the 'NumFieldSymPos.InsideNumField()' specification,
+//			the final length of the number string is defined by the
+//			Number Field length.
 //
-//		3.	[]string
-//				A one-dimensional slice of strings
-//				containing error prefix information.
+//		Examples NumFieldSymPos.OutsideNumField()
 //
-//		4.	[][2]string
-//				A two-dimensional slice of strings
-//		   		containing error prefix and error
-//		   		context information.
+//			Example-3:
+//				Number Field Length: 8
+//			    Numeric Value: 123.45
+//				Leading Currency Symbol: Dollar sign ('$')
+//				Trailing Currency Symbol: Dollar sign ('$')
+//			    Number Symbol Position: Outside Number Field
+//			    Number Text Justification: Right Justified
+//			    Formatted Number String: "$  123.45$"
+//				Number Field Index:------>0123456789
+//				Total Number String Length: 10
 //
-//		5.	ErrPrefixDto
-//				An instance of ErrPrefixDto.
-//				Information from this object will
-//				be copied for use in error and
-//				informational messages.
+//			Example-4:
+//				Number Field Length: 10
+//				Numeric Value: 123.45
+//				Leading Currency Symbol: Dollar sign ('$')
+//				Trailing Currency Symbol: Dollar sign ('$')
+//				Number Symbol Position: Outside Number Field
+//			    Number Text Justification: Centered
+//				Formatted Number String: "$  123.45  $"
+//				Number Field Index:------>012345678901
+//				Total Number String Length: 12
 //
-//		6.	*ErrPrefixDto
-//				A pointer to an instance of
-//				ErrPrefixDto. Information from
-//				this object will be copied for use
-//				in error and informational messages.
+//			For the 'NumFieldSymPos.OutsideNumField()' specification,
+//			the final length of the number string is greater than
+//			the Number Field length.
 //
-//		7.	IBasicErrorPrefix
-//				An interface to a method
-//				generating a two-dimensional slice
-//				of strings containing error prefix
-//				and error context information.
+//		numFieldLength					int
 //
-//		If parameter 'errorPrefix' is NOT convertible
-//		to one of the valid types listed above, it will
-//		be considered invalid and trigger the return of
-//		an error.
+//			This parameter defines the length of the text
+//			field in which the numeric value will be
+//			displayed within a number string.
 //
-//		Types ErrPrefixDto and IBasicErrorPrefix are
-//		included in the 'errpref' software package:
-//			"github.com/MikeAustin71/errpref".
+//			If 'numFieldLength' is less than the length of
+//			the numeric value string, it will be
+//			automatically set equal to the length of that
+//			numeric value string.
 //
-// ----------------------------------------------------------------
+//			To automatically set the value of numFieldLength
+//			to the string length of the numeric value, set
+//			this parameter to a value of minus one (-1).
 //
-// # Return Values
+//			If this parameter is submitted with a value less
+//			than minus one (-1) or greater than 1-million
+//			(1,000,000), an error will be returned.
 //
-//	nativeNumStr				string
+//			Field Length Examples
 //
-//		If this method completes successfully, this
-//		string parameter will return a native number
-//		string representation of the numeric value
-//		contained in the current instance of
-//		NumberStrKernel.
+//				Example-1
+//	 	        FieldContents String = "1234.5678"
+//					FieldContents String Length = 9
+//					numFieldLength = 15
+//					numFieldJustification = TxtJustify.Center()
+//					Text Field String =
+//						"   1234.5678   "
 //
-//		The native number string returned by this method
-//		will comply with the following criteria:
+//				Example-2
+//	 	        FieldContents = "1234.5678"
+//					FieldContents String Length = 9
+//					numFieldLength = 15
+//					numFieldJustification = TxtJustify.Right()
+//					Text Field String =
+//						"      1234.5678"
 //
-//	 	1.	A Native Number String Consists of numeric
-//	 	  	character digits zero through nine inclusive
-//	 	  	(0-9).
+//				Example-3
+//	 	        FieldContents = "1234.5678"
+//					FieldContents String Length = 9
+//					numFieldLength = -1
+//					numFieldJustification = TxtJustify.Center()
+//						// Justification Ignored. Field Length
+//						// Equals -1
+//					Text Field String =
+//						"1234.5678"
 //
-//	 	2.	A Native Number String will include a period
-//	 	  	or decimal point ('.') to separate integer and
-//	 	  	fractional digits within a number string.
+//				Example-4
+//	 	        FieldContents = "1234.5678"
+//					FieldContents String Length = 9
+//					numFieldLength = 2
+//					numFieldJustification = TxtJustify.Center()
+//						// Ignored, because FieldLength Less
+//						// Than FieldContents String Length.
+//					Text Field String =
+//						"1234.5678"
 //
-//	 	  	Native Number String Floating Point Value:
-//	 	   				123.1234
+//		numFieldJustification		TextJustify
 //
-//	 	3.	A Native Number String will always format
-//	 	  	negative numeric values with a leading minus sign
-//	 	  	('-').
+//			An enumeration which specifies the justification
+//			of the numeric value within the number field
+//			length specified by input parameter
+//			'numFieldLength'.
 //
-//	 	  	Native Number String Negative Value:
-//	 	  					-123.2
+//			Text justification can only be evaluated in the
+//			context of a number string, field length and a
+//			'textJustification' object of type TextJustify.
+//			This is because number strings with a field
+//			length equal to or less than the length of the
+//			numeric value string never use text
+//			justification. In these cases, text justification
+//			is completely ignored.
 //
-//	 	4.	A Native Number String WILL NEVER include integer
-//			separators such as commas (',') to separate
-//			integer digits by thousands.
+//			If the field length parameter ('numFieldLength')
+//			is greater than the length of the numeric value
+//			string, text justification must be equal to one
+//			of these three valid values:
 //
-//	 	   					NOT THIS: 1,000,000
-//	 	   		Native Number String: 1000000
+//				TextJustify(0).Left()
+//				TextJustify(0).Right()
+//				TextJustify(0).Center()
 //
-//	 	5.	Native Number Strings will only consist of:
+//			You can also use the abbreviated text justification
+//			enumeration syntax as follows:
 //
-//			(a)	Numeric digits zero through nine inclusive (0-9).
+//				TxtJustify.Left()
+//				TxtJustify.Right()
+//				TxtJustify.Center()
 //
-//			(b)	A decimal point ('.') for floating point
-//				numbers.
+//			Text Justification Examples
 //
-//			(c)	A leading minus sign ('-') in the case of
-//				negative numeric values.
+//				Example-1
+//	 	        FieldContents String = "1234.5678"
+//					FieldContents String Length = 9
+//					numFieldLength = 15
+//					numFieldJustification = TxtJustify.Center()
+//					Text Field String =
+//						"   1234.5678   "
 //
-//		6.	A Native Number String will NEVER include
-//			currency symbols.
+//				Example-2
+//	 	        FieldContents = "1234.5678"
+//					FieldContents String Length = 9
+//					numFieldLength = 15
+//					numFieldJustification = TxtJustify.Right()
+//					Text Field String =
+//						"      1234.5678"
 //
-//	nativeNumStrStats			NumberStrStatsDto
+//				Example-3
+//	 	        FieldContents = "1234.5678"
+//					FieldContents String Length = 9
+//					numFieldLength = -1
+//					numFieldJustification = TxtJustify.Center()
+//						// Justification Ignored. Field Length
+//						// Equals -1
+//					Text Field String =
+//						"1234.5678"
 //
-//		This data transfer object will return critical
-//		statistics on the numeric value represented
-//		by the integer and fractional digits contained
-//		in the return parameter 'nativeNumStr'.
+//				Example-4
+//	 	        FieldContents = "1234.5678"
+//					FieldContents String Length = 9
+//					numFieldLength = 2
+//					numFieldJustification = TxtJustify.Center()
+//						// Ignored, because FieldLength Less
+//						// Than FieldContents String Length.
+//					Text Field String =
+//						"1234.5678"
 //
-//		type NumberStrStatsDto struct {
+//		errorPrefix					interface{}
 //
-//		NumOfIntegerDigits					uint64
+//			This object encapsulates error prefix text which
+//			is included in all returned error messages.
+//			Usually, it contains the name of the calling
+//			method or methods listed as a method or function
+//			chain of execution.
 //
-//			The total number of integer digits to the
-//			left of the radix point or, decimal point, in
-//			the subject numeric value.
+//			If no error prefix information is needed, set this
+//			parameter to 'nil'.
 //
-//		NumOfSignificantIntegerDigits		uint64
+//			This empty interface must be convertible to one of
+//			the following types:
 //
-//			The number of nonzero integer digits to the
-//			left of the radix point or, decimal point, in
-//			the subject numeric value.
+//			1.	nil
+//					A nil value is valid and generates an
+//					empty collection of error prefix and
+//					error context information.
 //
-//		NumOfFractionalDigits				uint64
+//			2.	string
+//					A string containing error prefix
+//					information.
 //
-//			The total number of fractional digits to the
-//			right of the radix point or, decimal point,
-//			in the subject numeric value.
+//			3.	[]string
+//					A one-dimensional slice of strings
+//					containing error prefix information.
 //
-//		NumOfSignificantFractionalDigits	uint64
+//			4.	[][2]string
+//					A two-dimensional slice of strings
+//			   		containing error prefix and error
+//			   		context information.
 //
-//			The number of nonzero fractional digits to
-//			the right of the radix point or, decimal
-//			point, in the subject numeric value.
+//			5.	ErrPrefixDto
+//					An instance of ErrPrefixDto.
+//					Information from this object will
+//					be copied for use in error and
+//					informational messages.
 //
-//		NumberValueType 					NumericValueType
+//			6.	*ErrPrefixDto
+//					A pointer to an instance of
+//					ErrPrefixDto. Information from
+//					this object will be copied for use
+//					in error and informational messages.
 //
-//			This enumeration value specifies whether the
-//			subject numeric value is classified either as
-//			an integer or a floating point number.
+//			7.	IBasicErrorPrefix
+//					An interface to a method
+//					generating a two-dimensional slice
+//					of strings containing error prefix
+//					and error context information.
 //
-//			Possible enumeration values are listed as
-//			follows:
-//				NumValType.None()
-//				NumValType.FloatingPoint()
-//				NumValType.Integer()
+//			If parameter 'errorPrefix' is NOT convertible
+//			to one of the valid types listed above, it will
+//			be considered invalid and trigger the return of
+//			an error.
 //
-//		NumberSign							NumericSignValueType
+//			Types ErrPrefixDto and IBasicErrorPrefix are
+//			included in the 'errpref' software package:
+//				"github.com/MikeAustin71/errpref".
 //
-//			An enumeration specifying the number sign
-//			associated with the numeric value. Possible
-//			values are listed as follows:
-//				NumSignVal.None()		= Invalid Value
-//				NumSignVal.Negative()	= -1
-//				NumSignVal.Zero()		=  0
-//				NumSignVal.Positive()	=  1
+// ----------------------------------------------------------------
 //
-//		IsZeroValue							bool
+// # Return Values
 //
-//			If 'true', the subject numeric value is equal
-//			to zero ('0').
+//	numberString				string
 //
-//			If 'false', the subject numeric value is
-//			greater than or less than zero ('0').
-//		}
+//		If this method completes successfully, a formatted
+//		Number String will be returned.
 //
 //	err							error
 //
@@ -11225,12 +13048,28 @@ func (numStrKernel *NumberStrKernel) FmtNumStrElements(
 //		input parameter, 'errorPrefix'. The 'errorPrefix'
 //		text will be attached to the beginning of the
 //		error message.
-func (numStrKernel *NumberStrKernel) FmtNumStrNative(
-	roundingType NumberRoundingType,
-	roundToFractionalDigits int,
+func (numStrKernel *NumberStrKernel) FmtNumStrParams(
+	roundingSpec NumStrRoundingSpec,
+	decSeparatorChars string,
+	intSeparatorChars string,
+	intGroupingType IntegerGroupingType,
+	leadingPosNumSign string,
+	trailingPosNumSign string,
+	positiveNumFieldSymPosition NumberFieldSymbolPosition,
+	leadingNegNumSign string,
+	trailingNegNumSign string,
+	negativeNumFieldSymPosition NumberFieldSymbolPosition,
+	leadingZeroNumSign string,
+	trailingZeroNumSign string,
+	zeroNumFieldSymPosition NumberFieldSymbolPosition,
+	leadingCurrencySymbols string,
+	trailingCurrencySymbols string,
+	currencyInsideNumSymbol bool,
+	currencyNumFieldSymPosition NumberFieldSymbolPosition,
+	numFieldLength int,
+	numFieldJustification TextJustify,
 	errorPrefix interface{}) (
-	nativeNumStr string,
-	nativeNumStrStats NumberStrStatsDto,
+	numberString string,
 	err error) {
 
 	if numStrKernel.lock == nil {
@@ -11247,942 +13086,659 @@ func (numStrKernel *NumberStrKernel) FmtNumStrNative(
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"FmtNumStrNative()",
+			"FmtNumStrParams()",
 		"")
 
 	if err != nil {
-		return nativeNumStr,
-			nativeNumStrStats,
-			err
+		return numberString, err
 	}
 
-	nativeNumStr,
-		nativeNumStrStats,
-		err = new(numberStrKernelQuark).
-		getNativeNumStr(
-			numStrKernel,
-			roundingType,
-			roundToFractionalDigits,
-			ePrefix.XCpy("numStrKernel"))
-
-	return nativeNumStr,
-		nativeNumStrStats,
-		err
-}
+	var numStrFmtSpec NumStrFormatSpec
 
-// FmtNumStrParams
-//
-// Converts the numeric value contained in the current
-// instance of NumberStrKernel to a formatted number
-// string using the detailed specifications passed as
-// input parameters.
-//
-// Although the input parameters involve some complexity,
-// they also offer maximum flexibility in configuring
-// number strings that conform with virtually every known
-// national or cultural number string formatting
-// convention currently in use.
-//
-// ----------------------------------------------------------------
-//
-// # Input Parameters
-//
-//		roundingSpec 				NumStrRoundingSpec
-//
-//			The Number String Rounding Specification
-//			contains all the parameters required to
-//			configure a rounding algorithm for a
-//			floating point number string.
-//
-//			type NumStrRoundingSpec struct {
-//
-//				roundingType NumberRoundingType
-//
-//				This enumeration parameter is used to specify the type
-//				of rounding algorithm that will be applied for the
-//				rounding of fractional digits in a number string.
-//
-//				Possible values are listed as follows:
-//					NumRoundType.None()
-//					NumRoundType.NoRounding()
-//					NumRoundType.HalfUpWithNegNums()
-//					NumRoundType.HalfDownWithNegNums()
-//					NumRoundType.HalfAwayFromZero()
-//					NumRoundType.HalfTowardsZero()
-//					NumRoundType.HalfToEven()
-//					NumRoundType.HalfToOdd()
-//					NumRoundType.Randomly()
-//					NumRoundType.Floor()
-//					NumRoundType.Ceiling()
-//					NumRoundType.Truncate()
-//
-//				NoRounding
-//
-//					Signals that no rounding operation will be performed
-//					on fractional digits contained in a number string.
-//					The fractional digits will therefore remain unchanged.
-//
-//				HalfUpWithNegNums
-//
-//					Half Round Up Including Negative Numbers. This method
-//					is intuitive but may produce unexpected results when
-//					applied to negative numbers.
-//
-//					'HalfUpWithNegNums' rounds .5 up.
-//
-//						Examples of 'HalfUpWithNegNums'
-//						7.6 rounds up to 8
-//						7.5 rounds up to 8
-//						7.4 rounds down to 7
-//						-7.4 rounds up to -7
-//						-7.5 rounds up to -7
-//						-7.6 rounds down to -8
-//
-//				HalfDownWithNegNums
-//
-//					Half Round Down Including Negative Numbers. This method
-//					is also considered intuitive but may produce unexpected
-//					results when applied to negative numbers.
-//
-//					'HalfDownWithNegNums' rounds .5 down.
-//
-//						Examples of HalfDownWithNegNums
-//
-//						7.6 rounds up to 8
-//						7.5 rounds down to 7
-//						7.4 rounds down to 7
-//						-7.4 rounds up to -7
-//						-7.5 rounds down to -8
-//						-7.6 rounds down to -8
-//
-//				HalfAwayFromZero
-//
-//					Round Half Away From Zero. This rounding method is treated
-//					as the default and this value is returned by method:
-//					NumberRoundingType(0).XGetDefaultRoundingType()
-//
-//					The 'HalfAwayFromZero' method rounds .5 further away from zero.
-//					It provides clear and consistent behavior when dealing with
-//					negative numbers.
-//
-//						Examples of HalfAwayFromZero
-//
-//						7.6 rounds away to 8
-//						7.5 rounds away to 8
-//						7.4 rounds to 7
-//						-7.4 rounds to -7
-//						-7.5 rounds away to -8
-//						-7.6 rounds away to -8
-//
-//				HalfTowardsZero
-//
-//					Round Half Towards Zero. 'HalfTowardsZero' rounds 0.5
-//					closer to zero. It provides clear and consistent behavior
-//					when dealing with negative numbers.
-//
-//						Examples of HalfTowardsZero
-//
-//						7.6 rounds away to 8
-//						7.5 rounds to 7
-//						7.4 rounds to 7
-//						-7.4 rounds to -7
-//						-7.5 rounds to -7
-//						-7.6 rounds away to -8
-//
-//				HalfToEven
-//
-//					Round Half To Even Numbers. 'HalfToEven' is also called
-//					Banker's Rounding. This method rounds 0.5 to the nearest
-//					even digit.
-//
-//						Examples of HalfToEven
-//
-//						7.5 rounds up to 8 (because 8 is an even number)
-//						but 6.5 rounds down to 6 (because 6 is an even number)
-//
-//						HalfToEven only applies to 0.5. Other numbers (not ending
-//						in 0.5) round to nearest as usual, so:
-//
-//						7.6 rounds up to 8
-//						7.5 rounds up to 8 (because 8 is an even number)
-//						7.4 rounds down to 7
-//						6.6 rounds up to 7
-//						6.5 rounds down to 6 (because 6 is an even number)
-//						6.4 rounds down to 6
-//
-//				HalfToOdd
-//
-//					Round Half to Odd Numbers. Similar to 'HalfToEven', but
-//					in this case 'HalfToOdd' rounds 0.5 towards odd numbers.
-//
-//						Examples of HalfToOdd
+	numStrFmtSpec,
+		err = new(NumStrFormatSpec).NewCurrencyParams(
+		decSeparatorChars,
+		intSeparatorChars,
+		intGroupingType,
+		leadingPosNumSign,
+		trailingPosNumSign,
+		positiveNumFieldSymPosition,
+		leadingNegNumSign,
+		trailingNegNumSign,
+		negativeNumFieldSymPosition,
+		leadingZeroNumSign,
+		trailingZeroNumSign,
+		zeroNumFieldSymPosition,
+		leadingCurrencySymbols,
+		trailingCurrencySymbols,
+		currencyInsideNumSymbol,
+		currencyNumFieldSymPosition,
+		numFieldLength,
+		numFieldJustification,
+		ePrefix.XCpy(
+			"numStrFmtSpec"))
+
+	if err != nil {
+		return numberString, err
+	}
+
+	numberString,
+		err = new(numberStrKernelMolecule).
+		formatNumStr(
+			numStrKernel,
+			roundingSpec,
+			numStrFmtSpec,
+			ePrefix.XCpy("numStrKernel"))
+
+	return numberString, err
+}
+
+// FmtNumStrPure
 //
-//						HalfToOdd only applies to 0.5. Other numbers (not ending
-//						in 0.5) round to nearest as usual.
+// Extracts the numeric value from the current instance
+// of NumberStrKernel and returns a formatted Pure Number
+// String.
 //
-//						7.5 rounds down to 7 (because 7 is an odd number)
+// A Pure Number String differs from a Native Number
+// String in that it offers more options for
+// customization. Pure Number Strings are better able to
+// match multinational and multicultural number
+// formatting conventions. Users have the option to
+// specify custom radix points or decimal separator
+// characters as well as designating leading or trailing
+// minus signs for negative numbers.
 //
-//						6.5 rounds up to 7 (because 7 is an odd number)
+// A Pure Number String is defined as follows:
 //
-//						7.6 rounds up to 8
-//						7.5 rounds down to 7 (because 7 is an odd number)
-//						7.4 rounds down to 7
-//						6.6 rounds up to 7
-//						6.5 rounds up to 7 (because 7 is an odd number)
-//						6.4 rounds down to 6
+//  1. A pure number string consists entirely of numeric
+//     digit characters (0-9).
 //
-//				Randomly
+//  2. A pure number string will separate integer and
+//     fractional digits with a radix point or decimal
+//     separator. This could be, but is not limited to,
+//     a decimal point ('.'). For example, many European
+//     countries use the comma (',') as a radix point.
 //
-//					Round Half Randomly. Uses a Random Number Generator to choose
-//					between rounding 0.5 up or down.
+//  3. A pure number string will designate negative values
+//     with a minus sign ('-'). This minus sign could be
+//     positioned as a leading or trailing minus sign.
 //
-//					All numbers other than 0.5 round to the nearest as usual.
+//  4. A pure number string will NEVER include integer
+//     separators such as commas (',') to separate
+//     integer digits by thousands.
 //
-//				Floor
+//     NOT THIS: 1,000,000
+//     Pure Number String: 1000000
 //
-//					Yields the nearest integer down. Floor does not apply any
-//					special treatment to 0.5.
+//  5. A pure number string will NEVER include currency
+//     symbols.
 //
-//					Floor Function: The greatest integer that is less than or
-//					equal to x
-//					Source: https://www.mathsisfun.com/sets/function-floor-ceiling.html
+// The numeric value extracted from the current instance
+// of NumberStrKernel will first be rounded according to
+// input parameter specifications before generating the
+// final Pure Number String.
 //
-//					In mathematics and computer science, the floor function is
-//					the function that takes as input a real number x, and gives
-//					as output the greatest integer less than or equal to x,
-//					denoted floor(x) or ⌊x⌋.
-//					Source: https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+// ----------------------------------------------------------------
 //
-//						Examples of Floor
+// # BE ADVISED
 //
-//						Number     Floor
-//						2           2
-//						2.4         2
-//						2.9         2
-//						-2.5        -3
-//						-2.7        -3
-//						-2          -2
+//	(1)	This method will NOT change or modify the data
+//		values contained in the current instance of
+//		NumberStrKernel.
 //
-//				Ceiling
+//	(2) This is one of few format methods which will
+//		return a string including leading integer zeros
+//		and/or trailing fractional zeros assuming that
+//		no rounding operation is performed.
 //
-//					Yields the nearest integer up. Ceiling does not apply any
-//					special treatment to 0.5.
+// ----------------------------------------------------------------
 //
-//					Ceiling Function: The least integer that is greater than or
-//					equal to x.
-//					Source: https://www.mathsisfun.com/sets/function-floor-ceiling.html
+// # Input Parameters
 //
-//					The ceiling function maps x to the least integer greater than
-//					or equal to x, denoted ceil(x) or ⌈x⌉.[1]
-//					Source: https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//	decSeparatorChars			string
 //
-//						Examples of Ceiling
+//		This string contains the character or characters
+//		which will be configured as the Decimal Separator
+//		Symbol.
 //
-//						Number    Ceiling
-//						2           2
-//						2.4         3
-//						2.9         3
-//						-2.5        -2
-//						-2.7        -2
-//						-2          -2
+//		The Decimal Separator is also known as the radix
+//		point and is used to separate integer and
+//		fractional digits within a floating point number
+//		string.
 //
-//				Truncate
+//		In the US, UK, Australia, most of Canada and many
+//		other countries the Decimal Separator is the
+//		period character ('.') known as the decimal
+//		point.
 //
-//					Apply NO Rounding whatsoever. The Round From Digit is dropped
-//					or deleted. The Round To Digit is NEVER changed.
+//		In France, Germany and many countries in the
+//		European Union, the Decimal Separator is the
+//		comma character (',').
 //
-//						Examples of Truncate
+//		If this parameter is submitted as an empty or
+//		zero length string, an error will be returned.
 //
-//						Example-1
-//						Number: 23.14567
-//						Objective: Round to two decimal places to
-//						the right of the decimal point.
-//						Rounding Method: Truncate
-//						Round To Digit:   4
-//						Round From Digit: 5
-//						Rounded Number:   23.14 - The Round From Digit is dropped.
+//	leadingMinusSign			bool
 //
-//						Example-2
-//						Number: -23.14567
-//						Objective: Round to two decimal places to
-//						the right of the decimal point.
-//						Rounding Method: Truncate
-//						Round To Digit:   4
-//						Round From Digit: 5
-//						Rounded Number:  -23.14 - The Round From Digit is dropped.
+//		In pure number strings, a minus sign ('-')
+//		identifies a number as a negative numeric value.
 //
-//				roundToFractionalDigits int
+//		When 'leadingMinusSign' is set to 'true', the
+//		pure number string returned by this method will
+//		format negative numeric values with a leading
+//		minus sign ('-') at the beginning of the number
+//		string.
 //
-//					When set to a positive integer value, this
-//					parameter controls the number of digits to
-//					the right of the radix point or decimal
-//					separator (a.k.a. decimal point) which will
-//					remain after completion of the number rounding
-//					operation.
-//			}
+//		Leading minus signs represent the standard means
+//		for designating negative numeric values in the
+//		US, UK, Australia, most of Canada and many other
+//		countries.
 //
-//		decSeparator					string
+//		Example Leading Minus Sign:
+//			"-123.456"
 //
-//			This string contains the character or characters
-//			which will be configured as the Decimal Separator
-//			Symbol or Symbols for the returned instance of
-//			NumStrFormatSpec.
+//		When 'leadingMinusSign' is set to 'false', the
+//		pure number string returned by this method will
+//		format negative numeric values with a trailing
+//		minus signs ('-') located at the end of the
+//		number string.
 //
-//			The decimal separator is also known as the radix
-//			point and is used to separate integer and fractional
-//			digits within a formatted Number String.
+//		Trailing minus signs represent the standard for
+//		France, Germany and many countries in the
+//		European Union.
 //
-//			In the US, UK, Australia and most of Canada, the
-//			decimal separator is the period character ('.')
-//			known as the decimal point.
+//		Example Trailing Number Symbols:
+//			"123.456-"
 //
-//			In France, Germany and many countries in the
-//			European Union, the Decimal Separator is the
-//			comma character (',').
+//	roundingType				NumberRoundingType
 //
-//		intSeparatorChars				string
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied for
+//		the	rounding of fractional digits contained in the
+//		current instance of NumberStrKernel.
 //
-//			One or more characters used to separate groups of
-//			integers. This separator is also known as the
-//			'thousands' separator. It is used to separate
-//			groups of integer digits to the left of the
-//			decimal separator (a.k.a. decimal point). In the
-//			United States, the standard integer digits
-//			separator is the comma (",").
+//		If in doubt as to a suitable rounding method,
+//		'HalfAwayFromZero' is recommended.
 //
-//				United States Example:  1,000,000,000
+//		Possible values are listed as follows:
+//			NumRoundType.None()	- Invalid Value
+//			NumRoundType.NoRounding()
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
 //
-//			In many European countries, a single period ('.')
-//			is used as the integer separator character.
+//		Definitions:
 //
-//				European Example: 1.000.000.000
+//			NoRounding
 //
-//			Other countries and cultures use spaces,
-//			apostrophes or multiple characters to separate
-//			integers.
+//				Signals that no rounding operation will be
+//				performed on fractional digits. The
+//				fractional digits will therefore remain
+//				unchanged.
 //
-//			If this input parameter contains a zero length
-//			string and parameter 'intGroupingType' is NOT
-//			equal to 'IntGroupingType.None()', an error will
-//			be returned.
+//			HalfUpWithNegNums
 //
-//		intGroupingType					IntegerGroupingType
+//				Half Round Up Including Negative Numbers.
+//				This method is intuitive but may produce
+//				unexpected results when applied to negative
+//				numbers.
 //
-//			This instance of IntegerGroupingType defines the type
-//			of IntegerSeparatorSpec which will be returned. The
-//			enumeration IntegerGroupingType must be set to one
-//			of the following values:
+//				'HalfUpWithNegNums' rounds .5 up.
 //
-//				IntGroupingType.None()
-//				IntGroupingType.Thousands()
-//				IntGroupingType.IndiaNumbering()
-//				IntGroupingType.ChineseNumbering()
+//					Examples of 'HalfUpWithNegNums'
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8
+//					7.4 rounds down to 7
+//					-7.4 rounds up to -7
+//					-7.5 rounds up to -7
+//					-7.6 rounds down to -8
 //
-//		Note:	Setting 'intGroupingType' to a value of
-//				IntGroupingType.None() effectively turns
-//				off integer separation.
+//			HalfDownWithNegNums
 //
-//		Examples:
+//			Half Round Down Including Negative Numbers. This
+//			method is also considered intuitive but may
+//			produce unexpected results when applied to
+//			negative numbers.
 //
-//			IntGroupingType.None()
-//				'1000000000'
+//			'HalfDownWithNegNums' rounds .5 down.
 //
-//			IntGroupingType.Thousands()
-//					'1,000,000,000'
+//				Examples of HalfDownWithNegNums
 //
-//			IntGroupingType.IndiaNumbering()
-//				'6,78,90,00,00,00,00,000'
+//				7.6 rounds up to 8
+//				7.5 rounds down to 7
+//				7.4 rounds down to 7
+//				-7.4 rounds up to -7
+//				-7.5 rounds down to -8
+//				-7.6 rounds down to -8
 //
-//			IntGroupingType.ChineseNumbering()
-//				'6,7890,0000,0000,0000'
+//			HalfAwayFromZero
 //
-//		leadingPosNumSign				string
+//				The 'HalfAwayFromZero' method rounds .5 further
+//				away from zero.	It provides clear and consistent
+//				behavior when dealing with negative numbers.
 //
-//			A string containing the leading positive number
-//			sign character or characters used to configure
-//			a Positive Number Sign Symbol in a number string
-//			with a positive numeric value.
+//					Examples of HalfAwayFromZero
 //
-//			Leading number symbols can include any combination
-//			of characters such as plus signs ('+') and/or
-//			currency symbols ('$').
+//					7.6 rounds away to 8
+//					7.5 rounds away to 8
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds away to -8
+//					-7.6 rounds away to -8
 //
-//		trailingPosNumSign				string
+//			HalfTowardsZero
 //
-//			A string containing the trailing positive number
-//		 	sign character or characters used to configure a
-//		  	Positive Number Sign Symbol in a number string.
+//				Round Half Towards Zero. 'HalfTowardsZero' rounds
+//				0.5	closer to zero. It provides clear and
+//				consistent behavior	when dealing with negative
+//				numbers.
 //
-//			Trailing number symbols can include any combination
-//			of characters to include plus signs ('+') and/or
-//		 	currency symbols ('$').
+//					Examples of HalfTowardsZero
 //
-//		positiveNumFieldSymPosition		NumberFieldSymbolPosition
+//					7.6 rounds away to 8
+//					7.5 rounds to 7
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds to -7
+//					-7.6 rounds away to -8
 //
-//			Defines the position of the Positive Number Sign
-//			character, or characters, relative to a Number
-//			Field in which a number string is displayed.
-//			Possible valid values are listed as follows:
+//			HalfToEven
 //
-//				NumFieldSymPos.InsideNumField()
-//					Example-1:
-//						Number Field Length: 8
-//						Numeric Value: 123.45
-//						Number Symbol: leading plus sign ('+')
-//						Number Symbol Position: Inside Number Field
-//				     	Number Text Justification: Right
-//						Formatted Number String: " +123.45"
-//						Number Field Index:       01234567
-//						Total Number String Length: 8
+//				Round Half To Even Numbers. 'HalfToEven' is
+//				also called	Banker's Rounding. This method
+//				rounds 0.5 to the nearest even digit.
 //
-//					Example-2:
-//						Number Field Length: 8
-//						Numeric Value: 123.45
-//						Number Symbol: trailing plus sign ('+')
-//						Number Symbol Position: Inside Number Field
-//				     	Number Text Justification: Right
-//						Formatted Number String: " 123.45+"
-//						Number Field Index:       01234567
-//						Total Number String Length: 8
+//					Examples of HalfToEven
 //
-//					For the 'NumFieldSymPos.InsideNumField()'
-//					specification, the final length of the number
-//					string is defined by the Number Field length.
+//					7.5 rounds up to 8 (because 8 is an even
+//					number)	but 6.5 rounds down to 6 (because
+//					6 is an even number)
 //
-//				NumFieldSymPos.OutsideNumField()
-//					Example-3:
-//						Number Field Length: 8
-//				     	Numeric Value: 123.45
-//				     	Number Symbol: leading plus sign ('+')
-//				     	Number Symbol Position: Outside Number Field
-//				     	Number Text Justification: Right
-//				     	Formatted Number String: "+  123.45"
-//						Number Field Index:       012345678
-//						Total Number String Length: 9
+//					HalfToEven only applies to 0.5. Other
+//					numbers (not ending	in 0.5) round to
+//					nearest as usual, so:
 //
-//					Example-4:
-//						Number Field Length: 8
-//				     	Numeric Value: 123.45
-//				     	Number Symbol: trailing plus sign ('+')
-//				     	Number Symbol Position: Outside Number Field
-//				     	Number Text Justification: Right
-//				     	Formatted Number String: "  123.45+"
-//						Number Field Index:       012345678
-//						Total Number String Length: 9
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8 (because 8 is an even number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds down to 6 (because 6 is an even number)
+//					6.4 rounds down to 6
 //
-//					For the 'NumFieldSymPos.OutsideNumField()'
-//					specification, the final length of the
-//					number string is greater than the Number
-//					Field length.
+//			HalfToOdd
 //
-//		leadingNegNumSign				string
+//				Round Half to Odd Numbers. Similar to 'HalfToEven',
+//				but in this case 'HalfToOdd' rounds 0.5 towards odd
+//				numbers.
 //
-//			A string containing the leading negative number
-//			sign character or characters used to configure
-//			Negative Number Sign Symbols in a number string
-//			with a negative numeric value.
+//					Examples of HalfToOdd
 //
-//			Leading number symbols can include any
-//			combination of characters such as minus signs
-//			('-') and/or currency symbols ('$').
+//					HalfToOdd only applies to 0.5. Other numbers
+//					(not ending	in 0.5) round to nearest as usual.
 //
-//		trailingNegNumSign				string
+//					7.5 rounds down to 7 (because 7 is an odd number)
 //
-//			A string containing the trailing negative number
-//			sign character or characters used to configure
-//			Negative Number Sign Symbols in a number string
-//			with a negative numeric value.
+//					6.5 rounds up to 7 (because 7 is an odd number)
 //
-//			Trailing number symbols can include any
-//			combination of characters such as minus signs
-//			('-') and/or currency symbols ('$').
+//					7.6 rounds up to 8
+//					7.5 rounds down to 7 (because 7 is an odd number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds up to 7 (because 7 is an odd number)
+//					6.4 rounds down to 6
 //
-//		negativeNumFieldSymPosition		NumberFieldSymbolPosition
+//			Randomly
 //
-//			Defines the position of the Negative Number Sign
-//			relative to a Number Field in which a number
-//			string is displayed. Possible valid values are
-//			listed as follows:
+//				Round Half Randomly. Uses a Random Number Generator
+//				to choose between rounding 0.5 up or down.
 //
-//				NumFieldSymPos.InsideNumField()
-//					Example-1:
-//						Number Field Length: 8
-//						Numeric Value: 123.45
-//						Number Symbol: leading minus sign ('-')
-//						Number Symbol Position: Inside Number Field
-//				     	Number Text Justification: Right
-//						Formatted Number String: " -123.45"
-//						Number Field Index:  01234567
-//						Total Number String Length: 8
+//				All numbers other than 0.5 round to the nearest as
+//				usual.
 //
-//					Example-2:
-//						Number Field Length: 8
-//						Numeric Value: 123.45
-//						Number Symbol: trailing minus sign ('-')
-//						Number Symbol Position: Inside Number Field
-//				     	Number Text Justification: Right
-//						Formatted Number String: " 123.45-"
-//						Number Field Index:       01234567
-//						Total Number String Length: 8
+//			Floor
 //
-//					Example-3:
-//						Number Field Length: 10
-//						Numeric Value: 123.45
-//						Number Symbol: before and after parentheses  ('()')
-//						Number Symbol Position: Inside Number Field
-//				     	Number Text Justification: Centered
-//						Formatted Number String: " (123.45) "
-//						Number Field Index:       0123456789
-//						Total Number String Length: 10
+//				Yields the nearest integer down. Floor does not apply
+//				any	special treatment to 0.5.
 //
-//					For the 'NumFieldSymPos.InsideNumField()'
-//					specification, the final length of the number
-//					string is defined by the Number Field length.
+//				Floor Function: The greatest integer that is less than
+//				or equal to x
 //
-//				NumFieldSymPos.OutsideNumField()
-//					Example-4:
-//						Number Field Length: 8
-//				     	Numeric Value: 123.45
-//				     	Number Symbol: leading minus sign ('-')
-//				     	Number Symbol Position: Outside Number Field
-//				     	Number Text Justification: Right
-//				     	Formatted Number String: "-  123.45"
-//						Number Field Index:       012345678
-//						Total Number String Length: 9
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
 //
-//					Example-5:
-//						Number Field Length: 8
-//				     	Numeric Value: 123.45
-//				     	Number Symbol: trailing minus sign ('-')
-//				     	Number Symbol Position: Outside Number Field
-//				     	Number Text Justification: Right
-//				     	Formatted Number String: "  123.45-"
-//						Number Field Index:       012345678
-//						Total Number String Length: 9
+//				In mathematics and computer science, the floor function
+//				is the function that takes as input a real number x,
+//				and gives as output the greatest integer less than or
+//				equal to x,	denoted floor(x) or ⌊x⌋.
+//
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
 //
-//					Example-6:
-//						Number Field Length: 8
-//						Numeric Value: 123.45
-//						Number Symbol: before and after parentheses  ('()')
-//						Number Symbol Position: Outside Number Field
-//				     	Number Text Justification: Centered
-//						Formatted Number String: "( 123.45 )"
-//						Number Field Index:       0123456789
-//						Total Number String Length: 10
+//				Examples of Floor
 //
-//					For the 'NumFieldSymPos.OutsideNumField()'
-//					specification, the final length of the number
-//					string is greater than the Number Field length.
+//					Number     Floor
+//					 2           2
+//					 2.4         2
+//					 2.9         2
+//					-2.5        -3
+//					-2.7        -3
+//					-2          -2
 //
-//		leadingZeroNumSign				string
+//			Ceiling
 //
-//			A string containing the leading zero number sign
-//			character or characters used to configure a	Number
-//			Sign Symbol in a number string with a zero
-//			numeric value.
+//				Yields the nearest integer up. Ceiling does not
+//				apply any special treatment to 0.5.
 //
-//			Leading number symbols can include any combination
-//			of characters such as plus signs ('+') and/or
-//			currency symbols ('$').
+//				Ceiling Function: The least integer that is
+//				greater than or	equal to x.
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
 //
-//		trailingZeroNumSign				string
+//				The ceiling function maps x to the least integer
+//				greater than or equal to x, denoted ceil(x) or
+//				⌈x⌉.[1]
 //
-//			A string containing the trailing zero number sign
-//			character or characters used to configure a Number
-//			Sign Symbol in a number string with a zero
-//			numeric value.
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
 //
-//			Trailing number symbols can include any combination
-//			of characters such as plus signs ('+') and/or
-//		 	currency symbols ('$').
+//					Examples of Ceiling
 //
-//		zeroNumFieldSymPosition			NumberFieldSymbolPosition
+//						Number    Ceiling
+//						 2           2
+//						 2.4         3
+//						 2.9         3
+//						-2.5        -2
+//						-2.7        -2
+//						-2          -2
 //
-//			Defines the position of the zero Number Sign
-//			relative to a Number Field in which a number
-//			string is displayed. Possible valid values are
-//			listed as follows:
+//			Truncate
 //
-//				NumFieldSymPos.InsideNumField()
-//					Example-1:
-//						Number Field Length: 8
-//						Numeric Value: 123.45
-//						Number Symbol: leading plus sign ('+')
-//						Number Symbol Position: Inside Number Field
-//				     	Number Text Justification: Right
-//						Formatted Number String: " +123.45"
-//						Number Field Index:       01234567
-//						Total Number String Length: 8
+//				Apply NO Rounding whatsoever. The Round From Digit
+//				is dropped or deleted. The Round To Digit is NEVER
+//				changed.
 //
-//					Example-2:
-//						Number Field Length: 8
-//						Numeric Value: 123.45
-//						Number Symbol: trailing plus sign ('+')
-//						Number Symbol Position: Inside Number Field
-//				     	Number Text Justification: Right
-//						Formatted Number String: " 123.45+"
-//						Number Field Index:       01234567
-//						Total Number String Length: 8
+//				Examples of Truncate
 //
-//					For the 'NumFieldSymPos.InsideNumField()'
-//					specification, the final length of the
-//					number string is defined by the Number
-//					Field length.
+//					Example-1
+//					Number: 23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:   23.14 - The Round From Digit
+//					is dropped.
 //
-//				NumFieldSymPos.OutsideNumField()
-//					Example-3:
-//						Number Field Length: 8
-//				     	Numeric Value: 123.45
-//				     	Number Symbol: leading plus sign ('+')
-//				     	Number Symbol Position: Outside Number Field
-//				     	Number Text Justification: Right
-//				     	Formatted Number String: "+  123.45"
-//						Number Field Index:       012345678
-//						Total Number String Length: 9
+//					Example-2
+//					Number: -23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:  -23.14 - The Round From Digit
+//					is dropped.
 //
-//					Example-4:
-//						Number Field Length: 8
-//				     	Numeric Value: 123.45
-//				     	Number Symbol: trailing plus sign ('+')
-//				     	Number Symbol Position: Outside Number Field
-//				     	Number Text Justification: Right
-//				     	Formatted Number String: "  123.45+"
-//						Number Field Index:       012345678
-//						Total Number String Length: 9
+//	roundToFractionalDigits		int
 //
-//					For the 'NumFieldSymPos.OutsideNumField()'
-//					specification, the final length of the number
-//					string is greater than the Number Field length.
+//		When set to a positive integer value, this
+//		parameter controls the number of digits to the
+//		right of the radix point or decimal separator
+//		(a.k.a. decimal point). This controls the number
+//		of fractional digits remaining after completion
+//		of the number rounding operation.
 //
-//	leadingCurrencySymbol     		string
+//		If input parameter 'roundingType' is set to
+//		NumRoundType.NoRounding(),
+//		'roundToFractionalDigits' is ignored and no
+//		rounding operation is performed.
 //
-//		A string containing one or more Leading
-//		Currency Symbol characters used to configure
-//		the returned instance of NumStrNumberSymbolSpec.
+//	errorPrefix					interface{}
 //
-//		Leading Currency Symbol characters can include
-//		such symbols as the dollar sign ('$'), Euro sign
-//	 	('€') and Pound sign ('£').
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//		Leading Currency Symbols are prefixed or
-//		prepended to the beginning of number strings
-//		containing currency numeric values.
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//		Currency Symbols are optional. If Currency
-//		Symbols are not required, set
-//		'leadingCurrencySymbol' to 'nil'.
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//	trailingCurrencySymbol     		string
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//		A string containing one or more Trailing
-//		Currency Symbol characters used to configure
-//		the returned instance of NumStrNumberSymbolSpec.
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//		Trailing Currency Symbol characters can include
-//		such symbols as the dollar sign ('$'), Euro sign
-//	 	('€') and Pound sign ('£').
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//		Trailing Currency Symbols are suffixed or
-//		appended to the end of number strings containing
-//		currency numeric values.
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//				Example: 125.34€
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//		Currency Symbols are optional. If Currency
-//		Symbols are not required, set
-//		'trailingCurrencySymbol' to 'nil'.
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//	currencyInsideNumSymbol			bool
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//		This boolean parameter determines whether the
-//		currency symbol will be positioned inside or
-//		outside the negative number sign symbol.
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
 //
-//		If this parameter is set to 'false', the
-//		currency symbol will be positioned outside
-//		the negative number sign symbol.
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
-//			Example-1 Outside:
-//				currencyInsideNumSymbol = false
-//				Number String = "$ -123.45"
+// ----------------------------------------------------------------
 //
-//			Example-2 Outside:
-//				currencyInsideNumSymbol = false
-//				Number String = "  123.45- €"
+// # Return Values
 //
-//		If this parameter is set to 'true', the
-//		currency symbol will be positioned inside
-//		the negative number sign symbol.
+//	pureNumberStr				string
 //
-//			Example - 3 Inside:
-//				currencyInsideNumSymbol = true
-//				Number String = " - $123.45"
+//		If this method completes successfully, a Pure
+//		number string containing the numeric value
+//		extracted from the current instance of
+//		NumberStrKernel will be returned.
 //
-//			Example - 4 Inside:
-//				currencyInsideNumSymbol = true
-//				Number String = "  123.45€ -"
+//		A Pure Number String is defined as follows:
 //
-//	currencyNumFieldSymPosition		NumberFieldSymbolPosition
+//		1.	A pure number string consists entirely of numeric
+//		  	digit characters (0-9).
 //
-//		Defines the position of the Leading Currency
-//		Symbol ('leadingCurrencySymbol') relative to a
-//		Number Field in which a number string is
-//		displayed. Possible valid values are listed as
-//		follows:
+//		2.	A pure number string will separate integer and
+//		  	fractional digits with a radix point or decimal
+//		  	separator. This could be, but is not limited to,
+//		  	a decimal point ('.'). For example, many European
+//		  	countries use the comma (',') as a radix point.
 //
-//			NumFieldSymPos.InsideNumField()
-//			NumFieldSymPos.OutsideNumField()
+//		3.	A pure number string will designate negative values
+//		  	with a minus sign ('-'). This minus sign could be
+//		  	positioned as a leading or trailing minus sign.
 //
-//		Examples NumFieldSymPos.InsideNumField()
+//		4.	A pure number string will NEVER include integer
+//		  	separators such as commas (',') to separate
+//		  	integer digits by thousands.
 //
-//			Example-1:
-//				Number Field Length: 10
-//				Numeric Value: 123.45
-//				Leading Currency Symbol: Dollar sign ('$')
-//				Trailing Currency Symbol: Dollar sign ('$')
-//				Number Symbol Position: Inside Number Field
-//			    Number Text Justification: Right Justified
-//				Formatted Number String: " $123.45$"
-//				Number Field Index:------>012345679
-//				Total Number String Length: 10
+//		  				  NOT THIS: 1,000,000
+//		  		Pure Number String: 1000000
 //
-//			Example-2:
-//				Number Field Length: 12
-//				Numeric Value: 123.45
-//				Leading Currency Symbol: Dollar sign ('$')
-//				Trailing Currency Symbol: Dollar sign ('$')
-//				Number Symbol Position: Inside Number Field
-//				Number Text Justification: Centered
-//				Formatted Number String: "  $123.45$  "
-//				Number Field Index:------>012345678901
-//				Total Number String Length: 12
+//		5.	A pure number string will NEVER include currency
+//				symbols.
 //
-//			For the 'NumFieldSymPos.InsideNumField()' specification,
-//			the final length of the number string is defined by the
-//			Number Field length.
+//	pureNumStrComponents		PureNumberStrComponents
 //
-//		Examples NumFieldSymPos.OutsideNumField()
+//		If this method completes successfully, this
+//		parameter will return an instance of
+//		PureNumberStrComponents. This data structure
+//		contains an analysis and profile information on
+//		the Pure Number String returned by paramter,
+//		'pureNumberStr'.
 //
-//			Example-3:
-//				Number Field Length: 8
-//			    Numeric Value: 123.45
-//				Leading Currency Symbol: Dollar sign ('$')
-//				Trailing Currency Symbol: Dollar sign ('$')
-//			    Number Symbol Position: Outside Number Field
-//			    Number Text Justification: Right Justified
-//			    Formatted Number String: "$  123.45$"
-//				Number Field Index:------>0123456789
-//				Total Number String Length: 10
+//		type PureNumberStrComponents struct {
 //
-//			Example-4:
-//				Number Field Length: 10
-//				Numeric Value: 123.45
-//				Leading Currency Symbol: Dollar sign ('$')
-//				Trailing Currency Symbol: Dollar sign ('$')
-//				Number Symbol Position: Outside Number Field
-//			    Number Text Justification: Centered
-//				Formatted Number String: "$  123.45  $"
-//				Number Field Index:------>012345678901
-//				Total Number String Length: 12
+//			NumStrStats NumberStrStatsDto
 //
-//			For the 'NumFieldSymPos.OutsideNumField()' specification,
-//			the final length of the number string is greater than
-//			the Number Field length.
+//				This data transfer object will return key
+//				statistics on the numeric value encapsulated
+//				by the current instance of NumberStrKernel.
 //
-//		numFieldLength					int
+//				type NumberStrStatsDto struct {
 //
-//			This parameter defines the length of the text
-//			field in which the numeric value will be
-//			displayed within a number string.
+//				NumOfIntegerDigits					uint64
 //
-//			If 'numFieldLength' is less than the length of
-//			the numeric value string, it will be
-//			automatically set equal to the length of that
-//			numeric value string.
+//					The total number of integer digits to the
+//					left of the radix point or, decimal point, in
+//					the subject numeric value.
 //
-//			To automatically set the value of numFieldLength
-//			to the string length of the numeric value, set
-//			this parameter to a value of minus one (-1).
+//				NumOfSignificantIntegerDigits		uint64
 //
-//			If this parameter is submitted with a value less
-//			than minus one (-1) or greater than 1-million
-//			(1,000,000), an error will be returned.
+//					The number of nonzero integer digits to the
+//					left of the radix point or, decimal point, in
+//					the subject numeric value.
 //
-//			Field Length Examples
+//				NumOfFractionalDigits				uint64
 //
-//				Example-1
-//	 	        FieldContents String = "1234.5678"
-//					FieldContents String Length = 9
-//					numFieldLength = 15
-//					numFieldJustification = TxtJustify.Center()
-//					Text Field String =
-//						"   1234.5678   "
+//					The total number of fractional digits to the
+//					right of the radix point or, decimal point,
+//					in the subject numeric value.
 //
-//				Example-2
-//	 	        FieldContents = "1234.5678"
-//					FieldContents String Length = 9
-//					numFieldLength = 15
-//					numFieldJustification = TxtJustify.Right()
-//					Text Field String =
-//						"      1234.5678"
+//				NumOfSignificantFractionalDigits	uint64
 //
-//				Example-3
-//	 	        FieldContents = "1234.5678"
-//					FieldContents String Length = 9
-//					numFieldLength = -1
-//					numFieldJustification = TxtJustify.Center()
-//						// Justification Ignored. Field Length
-//						// Equals -1
-//					Text Field String =
-//						"1234.5678"
+//					The number of nonzero fractional digits to
+//					the right of the radix point or, decimal
+//					point, in the subject numeric value.
 //
-//				Example-4
-//	 	        FieldContents = "1234.5678"
-//					FieldContents String Length = 9
-//					numFieldLength = 2
-//					numFieldJustification = TxtJustify.Center()
-//						// Ignored, because FieldLength Less
-//						// Than FieldContents String Length.
-//					Text Field String =
-//						"1234.5678"
+//				NumberValueType 					NumericValueType
 //
-//		numFieldJustification		TextJustify
+//					This enumeration value specifies whether the
+//					subject numeric value is classified either as
+//					an integer or a floating point number.
 //
-//			An enumeration which specifies the justification
-//			of the numeric value within the number field
-//			length specified by input parameter
-//			'numFieldLength'.
+//					Possible enumeration values are listed as
+//					follows:
+//						NumValType.None()
+//						NumValType.FloatingPoint()
+//						NumValType.Integer()
 //
-//			Text justification can only be evaluated in the
-//			context of a number string, field length and a
-//			'textJustification' object of type TextJustify.
-//			This is because number strings with a field
-//			length equal to or less than the length of the
-//			numeric value string never use text
-//			justification. In these cases, text justification
-//			is completely ignored.
+//				NumberSign							NumericSignValueType
 //
-//			If the field length parameter ('numFieldLength')
-//			is greater than the length of the numeric value
-//			string, text justification must be equal to one
-//			of these three valid values:
+//					An enumeration specifying the number sign
+//					associated with the numeric value. Possible
+//					values are listed as follows:
+//						NumSignVal.None()		= Invalid Value
+//						NumSignVal.Negative()	= -1
+//						NumSignVal.Zero()		=  0
+//						NumSignVal.Positive()	=  1
 //
-//				TextJustify(0).Left()
-//				TextJustify(0).Right()
-//				TextJustify(0).Center()
+//				IsZeroValue							bool
 //
-//			You can also use the abbreviated text justification
-//			enumeration syntax as follows:
+//					If 'true', the subject numeric value is equal
+//					to zero ('0').
 //
-//				TxtJustify.Left()
-//				TxtJustify.Right()
-//				TxtJustify.Center()
+//					If 'false', the subject numeric value is
+//					greater than or less than zero ('0').
+//				}
 //
-//			Text Justification Examples
 //
-//				Example-1
-//	 	        FieldContents String = "1234.5678"
-//					FieldContents String Length = 9
-//					numFieldLength = 15
-//					numFieldJustification = TxtJustify.Center()
-//					Text Field String =
-//						"   1234.5678   "
 //
-//				Example-2
-//	 	        FieldContents = "1234.5678"
-//					FieldContents String Length = 9
-//					numFieldLength = 15
-//					numFieldJustification = TxtJustify.Right()
-//					Text Field String =
-//						"      1234.5678"
+//			AbsoluteValueNumStr string
 //
-//				Example-3
-//	 	        FieldContents = "1234.5678"
-//					FieldContents String Length = 9
-//					numFieldLength = -1
-//					numFieldJustification = TxtJustify.Center()
-//						// Justification Ignored. Field Length
-//						// Equals -1
-//					Text Field String =
-//						"1234.5678"
+//			The number string expressed as an absolute value.
+//			Be advised, this number string may be a floating
+//			point number string containing fractional digits.
 //
-//				Example-4
-//	 	        FieldContents = "1234.5678"
-//					FieldContents String Length = 9
-//					numFieldLength = 2
-//					numFieldJustification = TxtJustify.Center()
-//						// Ignored, because FieldLength Less
-//						// Than FieldContents String Length.
-//					Text Field String =
-//						"1234.5678"
+//			AbsoluteValAllIntegerDigitsNumStr string
 //
-//		errorPrefix					interface{}
+//			Integer and fractional digits are combined
+//			in a single number string without a decimal
+//			point separating integer and fractional digits.
+//			This string DOES NOT contain a leading number
+//			sign (a.k.a. minus sign ('-')
 //
-//			This object encapsulates error prefix text which
-//			is included in all returned error messages.
-//			Usually, it contains the name of the calling
-//			method or methods listed as a method or function
-//			chain of execution.
+//			SignedAllIntegerDigitsNumStr string
 //
-//			If no error prefix information is needed, set this
-//			parameter to 'nil'.
+//			Integer and fractional digits are combined
+//			in a single number string without a decimal
+//			point separating integer and fractional digits.
+//			If the numeric value is negative, a leading
+//			minus sign will be prefixed at the beginning
+//			of the number string.
 //
-//			This empty interface must be convertible to one of
-//			the following types:
+//			NativeNumberStr string
 //
-//			1.	nil
-//					A nil value is valid and generates an
-//					empty collection of error prefix and
-//					error context information.
+//			A Native Number String representing the base
+//			numeric value used to generate these profile
+//			number string statistics.
 //
-//			2.	string
-//					A string containing error prefix
-//					information.
+//			A valid Native Number String must conform to the
+//			standardized formatting criteria defined below:
 //
-//			3.	[]string
-//					A one-dimensional slice of strings
-//					containing error prefix information.
+//			 	1. A Native Number String Consists of numeric
+//			 	   character digits zero through nine inclusive
+//			 	   (0-9).
 //
-//			4.	[][2]string
-//					A two-dimensional slice of strings
-//			   		containing error prefix and error
-//			   		context information.
+//			 	2. A Native Number String will include a period
+//			 	   or decimal point ('.') to separate integer and
+//			 	   fractional digits within a number string.
 //
-//			5.	ErrPrefixDto
-//					An instance of ErrPrefixDto.
-//					Information from this object will
-//					be copied for use in error and
-//					informational messages.
+//			 	   Native Number String Floating Point Value:
+//			 	   				123.1234
 //
-//			6.	*ErrPrefixDto
-//					A pointer to an instance of
-//					ErrPrefixDto. Information from
-//					this object will be copied for use
-//					in error and informational messages.
+//			 	3. A Native Number String will always format
+//			 	   negative numeric values with a leading minus sign
+//			 	   ('-').
 //
-//			7.	IBasicErrorPrefix
-//					An interface to a method
-//					generating a two-dimensional slice
-//					of strings containing error prefix
-//					and error context information.
+//			 	   Native Number String Negative Value:
+//			 	   				-123.2
 //
-//			If parameter 'errorPrefix' is NOT convertible
-//			to one of the valid types listed above, it will
-//			be considered invalid and trigger the return of
-//			an error.
+//			 	4. A Native Number String WILL NEVER include integer
+//			 	   separators such as commas (',') to separate
+//			 	   integer digits by thousands.
 //
-//			Types ErrPrefixDto and IBasicErrorPrefix are
-//			included in the 'errpref' software package:
-//				"github.com/MikeAustin71/errpref".
+//			 	   					NOT THIS: 1,000,000
+//			 	   		Native Number String: 1000000
 //
-// ----------------------------------------------------------------
+//			 	5. Native Number Strings will only consist of:
 //
-// # Return Values
+//			 	   (a)	Numeric digits zero through nine inclusive (0-9).
 //
-//	numberString				string
+//			 	   (b)	A decimal point ('.') for floating point
+//			 	   		numbers.
 //
-//		If this method completes successfully, a formatted
-//		Number String will be returned.
+//			 	   (c)	A leading minus sign ('-') in the case of
+//			 	   		negative numeric values.
+//
+//		}
 //
 //	err							error
 //
@@ -12196,28 +13752,14 @@ func (numStrKernel *NumberStrKernel) FmtNumStrNative(
 //		input parameter, 'errorPrefix'. The 'errorPrefix'
 //		text will be attached to the beginning of the
 //		error message.
-func (numStrKernel *NumberStrKernel) FmtNumStrParams(
-	roundingSpec NumStrRoundingSpec,
+func (numStrKernel *NumberStrKernel) FmtNumStrPure(
 	decSeparatorChars string,
-	intSeparatorChars string,
-	intGroupingType IntegerGroupingType,
-	leadingPosNumSign string,
-	trailingPosNumSign string,
-	positiveNumFieldSymPosition NumberFieldSymbolPosition,
-	leadingNegNumSign string,
-	trailingNegNumSign string,
-	negativeNumFieldSymPosition NumberFieldSymbolPosition,
-	leadingZeroNumSign string,
-	trailingZeroNumSign string,
-	zeroNumFieldSymPosition NumberFieldSymbolPosition,
-	leadingCurrencySymbols string,
-	trailingCurrencySymbols string,
-	currencyInsideNumSymbol bool,
-	currencyNumFieldSymPosition NumberFieldSymbolPosition,
-	numFieldLength int,
-	numFieldJustification TextJustify,
+	leadingMinusSign bool,
+	roundingType NumberRoundingType,
+	roundToFractionalDigits int,
 	errorPrefix interface{}) (
-	numberString string,
+	pureNumberStr string,
+	pureNumStrComponents PureNumberStrComponents,
 	err error) {
 
 	if numStrKernel.lock == nil {
@@ -12234,419 +13776,316 @@ func (numStrKernel *NumberStrKernel) FmtNumStrParams(
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"FmtNumStrParams()",
+			"FmtNumStrNative()",
 		"")
 
 	if err != nil {
-		return numberString, err
+		return pureNumberStr,
+			pureNumStrComponents,
+			err
 	}
 
-	var numStrFmtSpec NumStrFormatSpec
-
-	numStrFmtSpec,
-		err = new(NumStrFormatSpec).NewCurrencyParams(
+	return new(numberStrKernelQuark).getPureNumStr(
+		numStrKernel,
 		decSeparatorChars,
-		intSeparatorChars,
-		intGroupingType,
-		leadingPosNumSign,
-		trailingPosNumSign,
-		positiveNumFieldSymPosition,
-		leadingNegNumSign,
-		trailingNegNumSign,
-		negativeNumFieldSymPosition,
-		leadingZeroNumSign,
-		trailingZeroNumSign,
-		zeroNumFieldSymPosition,
-		leadingCurrencySymbols,
-		trailingCurrencySymbols,
-		currencyInsideNumSymbol,
-		currencyNumFieldSymPosition,
-		numFieldLength,
-		numFieldJustification,
+		leadingMinusSign,
+		roundingType,
+		roundToFractionalDigits,
 		ePrefix.XCpy(
-			"numStrFmtSpec"))
-
-	if err != nil {
-		return numberString, err
-	}
-
-	numberString,
-		err = new(numberStrKernelMolecule).
-		formatNumStr(
-			numStrKernel,
-			roundingSpec,
-			numStrFmtSpec,
-			ePrefix.XCpy("numStrKernel"))
-
-	return numberString, err
+			"numStrKernel"))
 }
 
-// FmtNumStrPure
-//
-// Extracts the numeric value from the current instance
-// of NumberStrKernel and returns a formatted Pure Number
-// String.
-//
-// A Pure Number String differs from a Native Number
-// String in that it offers more options for
-// customization. Pure Number Strings are better able to
-// match multinational and multicultural number
-// formatting conventions. Users have the option to
-// specify custom radix points or decimal separator
-// characters as well as designating leading or trailing
-// minus signs for negative numbers.
-//
-// A Pure Number String is defined as follows:
-//
-//  1. A pure number string consists entirely of numeric
-//     digit characters (0-9).
-//
-//  2. A pure number string will separate integer and
-//     fractional digits with a radix point or decimal
-//     separator. This could be, but is not limited to,
-//     a decimal point ('.'). For example, many European
-//     countries use the comma (',') as a radix point.
-//
-//  3. A pure number string will designate negative values
-//     with a minus sign ('-'). This minus sign could be
-//     positioned as a leading or trailing minus sign.
-//
-//  4. A pure number string will NEVER include integer
-//     separators such as commas (',') to separate
-//     integer digits by thousands.
-//
-//     NOT THIS: 1,000,000
-//     Pure Number String: 1000000
-//
-//  5. A pure number string will NEVER include currency
-//     symbols.
-//
-// The numeric value extracted from the current instance
-// of NumberStrKernel will first be rounded according to
-// input parameter specifications before generating the
-// final Pure Number String.
-//
-// ----------------------------------------------------------------
-//
-// # BE ADVISED
-//
-//	(1)	This method will NOT change or modify the data
-//		values contained in the current instance of
-//		NumberStrKernel.
-//
-//	(2) This is one of few format methods which will
-//		return a string including leading integer zeros
-//		and/or trailing fractional zeros assuming that
-//		no rounding operation is performed.
+// FmtNumberWords
 //
-// ----------------------------------------------------------------
-//
-// # Input Parameters
-//
-//	decSeparatorChars			string
-//
-//		This string contains the character or characters
-//		which will be configured as the Decimal Separator
-//		Symbol.
-//
-//		The Decimal Separator is also known as the radix
-//		point and is used to separate integer and
-//		fractional digits within a floating point number
-//		string.
-//
-//		In the US, UK, Australia, most of Canada and many
-//		other countries the Decimal Separator is the
-//		period character ('.') known as the decimal
-//		point.
-//
-//		In France, Germany and many countries in the
-//		European Union, the Decimal Separator is the
-//		comma character (',').
-//
-//		If this parameter is submitted as an empty or
-//		zero length string, an error will be returned.
-//
-//	leadingMinusSign			bool
-//
-//		In pure number strings, a minus sign ('-')
-//		identifies a number as a negative numeric value.
-//
-//		When 'leadingMinusSign' is set to 'true', the
-//		pure number string returned by this method will
-//		format negative numeric values with a leading
-//		minus sign ('-') at the beginning of the number
-//		string.
-//
-//		Leading minus signs represent the standard means
-//		for designating negative numeric values in the
-//		US, UK, Australia, most of Canada and many other
-//		countries.
-//
-//		Example Leading Minus Sign:
-//			"-123.456"
-//
-//		When 'leadingMinusSign' is set to 'false', the
-//		pure number string returned by this method will
-//		format negative numeric values with a trailing
-//		minus signs ('-') located at the end of the
-//		number string.
-//
-//		Trailing minus signs represent the standard for
-//		France, Germany and many countries in the
-//		European Union.
-//
-//		Example Trailing Number Symbols:
-//			"123.456-"
-//
-//	roundingType				NumberRoundingType
+// Spells out the numeric value of the current
+// NumberStrKernel instance as words in English, French
+// or German.
 //
-//		This enumeration parameter is used to specify the
-//		type of rounding algorithm that will be applied for
-//		the	rounding of fractional digits contained in the
-//		current instance of NumberStrKernel.
+// The integer value is spelled out as a cardinal number.
+// Fractional digits are read individually following the
+// decimal separator word ("point", "virgule" or
+// "Komma"). Negative values are preceded by "minus" or
+// "moins".
 //
-//		If in doubt as to a suitable rounding method,
-//		'HalfAwayFromZero' is recommended.
+//	Examples: -1234.56
 //
-//		Possible values are listed as follows:
-//			NumRoundType.None()	- Invalid Value
-//			NumRoundType.NoRounding()
-//			NumRoundType.HalfUpWithNegNums()
-//			NumRoundType.HalfDownWithNegNums()
-//			NumRoundType.HalfAwayFromZero()
-//			NumRoundType.HalfTowardsZero()
-//			NumRoundType.HalfToEven()
-//			NumRoundType.HalfToOdd()
-//			NumRoundType.Randomly()
-//			NumRoundType.Floor()
-//			NumRoundType.Ceiling()
-//			NumRoundType.Truncate()
+//	 US		"minus one thousand two hundred thirty-four
+//			 point five six"
 //
-//		Definitions:
+//	 France	"moins mille deux cent trente-quatre
+//			 virgule cinq six"
 //
-//			NoRounding
+//	 Germany "minus eintausendzweihundertvierunddreißig
+//			 Komma fünf sechs"
 //
-//				Signals that no rounding operation will be
-//				performed on fractional digits. The
-//				fractional digits will therefore remain
-//				unchanged.
+// To spell out currency amounts, see method
+// NumberStrKernel.FmtCurrencyWords().
 //
-//			HalfUpWithNegNums
+// ----------------------------------------------------------------
 //
-//				Half Round Up Including Negative Numbers.
-//				This method is intuitive but may produce
-//				unexpected results when applied to negative
-//				numbers.
+// # IMPORTANT
 //
-//				'HalfUpWithNegNums' rounds .5 up.
+// This method will NOT change the numeric value of the
+// current NumberStrKernel instance.
 //
-//					Examples of 'HalfUpWithNegNums'
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8
-//					7.4 rounds down to 7
-//					-7.4 rounds up to -7
-//					-7.5 rounds up to -7
-//					-7.6 rounds down to -8
+// ----------------------------------------------------------------
 //
-//			HalfDownWithNegNums
+// # Input Parameters
 //
-//			Half Round Down Including Negative Numbers. This
-//			method is also considered intuitive but may
-//			produce unexpected results when applied to
-//			negative numbers.
+//	roundingSpec				NumStrRoundingSpec
 //
-//			'HalfDownWithNegNums' rounds .5 down.
+//		The Number String Rounding Specification
+//		contains all the parameters required to
+//		configure a rounding algorithm for the numeric
+//		value before it is spelled out as words.
 //
-//				Examples of HalfDownWithNegNums
+//		To spell out the numeric value without rounding,
+//		configure 'roundingSpec' with
+//		NumRoundType.NoRounding().
 //
-//				7.6 rounds up to 8
-//				7.5 rounds down to 7
-//				7.4 rounds down to 7
-//				-7.4 rounds up to -7
-//				-7.5 rounds down to -8
-//				-7.6 rounds down to -8
+//	countryCultureSpec			NumStrFmtCountryCultureSpec
 //
-//			HalfAwayFromZero
+//		The Country Culture Specification identifies the
+//		language used to spell out the numeric value.
+//		The language is selected using the two character
+//		country code ('CountryCodeTwoChar'). Supported
+//		countries are listed as follows:
 //
-//				The 'HalfAwayFromZero' method rounds .5 further
-//				away from zero.	It provides clear and consistent
-//				behavior when dealing with negative numbers.
+//		 Country Code	Language	Default Scale
+//		 ------------	--------	-------------
+//			"US"		English		Short Scale
+//			"GB"		English		Short Scale
+//			"FR"		French		Long Scale
+//			"DE"		German		Long Scale
 //
-//					Examples of HalfAwayFromZero
+//		British English ("GB") inserts the word "and"
+//		before the tens and units ("one hundred and
+//		five").
 //
-//					7.6 rounds away to 8
-//					7.5 rounds away to 8
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds away to -8
-//					-7.6 rounds away to -8
+//		Country Culture Specifications for these
+//		countries are created by the following methods:
 //
-//			HalfTowardsZero
+//			NumStrFmtCountryCultureSpec.NewUS()
+//			NumStrFmtCountryCultureSpec.NewUK()
+//			NumStrFmtCountryCultureSpec.NewFrance()
+//			NumStrFmtCountryCultureSpec.NewGermany()
 //
-//				Round Half Towards Zero. 'HalfTowardsZero' rounds
-//				0.5	closer to zero. It provides clear and
-//				consistent behavior	when dealing with negative
-//				numbers.
+//		If the country is not supported, an error will
+//		be returned.
 //
-//					Examples of HalfTowardsZero
+//	numberScale					NumberWordsScale
 //
-//					7.6 rounds away to 8
-//					7.5 rounds to 7
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds to -7
-//					-7.6 rounds away to -8
+//		Specifies the naming system for large numbers.
+//		This parameter must be set to one of the
+//		following values:
 //
-//			HalfToEven
+//		NumWordsScale.None()
+//			The default scale for the language will be
+//			applied.
 //
-//				Round Half To Even Numbers. 'HalfToEven' is
-//				also called	Banker's Rounding. This method
-//				rounds 0.5 to the nearest even digit.
+//		NumWordsScale.ShortScale()
+//			1,000,000,000 = "one billion"
 //
-//					Examples of HalfToEven
+//		NumWordsScale.LongScale()
+//			1,000,000,000 = "one thousand million"
+//			1,000,000,000 = "un milliard"
+//			1,000,000,000 = "eine Milliarde"
 //
-//					7.5 rounds up to 8 (because 8 is an even
-//					number)	but 6.5 rounds down to 6 (because
-//					6 is an even number)
+//		Integer values may contain a maximum of 66
+//		significant digits. If this limit is exceeded,
+//		an error will be returned.
 //
-//					HalfToEven only applies to 0.5. Other
-//					numbers (not ending	in 0.5) round to
-//					nearest as usual, so:
+//	errorPrefix					interface{}
 //
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8 (because 8 is an even number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds down to 6 (because 6 is an even number)
-//					6.4 rounds down to 6
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//			HalfToOdd
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//				Round Half to Odd Numbers. Similar to 'HalfToEven',
-//				but in this case 'HalfToOdd' rounds 0.5 towards odd
-//				numbers.
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//					Examples of HalfToOdd
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//					HalfToOdd only applies to 0.5. Other numbers
-//					(not ending	in 0.5) round to nearest as usual.
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//					7.5 rounds down to 7 (because 7 is an odd number)
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//					6.5 rounds up to 7 (because 7 is an odd number)
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//					7.6 rounds up to 8
-//					7.5 rounds down to 7 (because 7 is an odd number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds up to 7 (because 7 is an odd number)
-//					6.4 rounds down to 6
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//			Randomly
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//				Round Half Randomly. Uses a Random Number Generator
-//				to choose between rounding 0.5 up or down.
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//				All numbers other than 0.5 round to the nearest as
-//				usual.
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
 //
-//			Floor
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
-//				Yields the nearest integer down. Floor does not apply
-//				any	special treatment to 0.5.
+// ----------------------------------------------------------------
 //
-//				Floor Function: The greatest integer that is less than
-//				or equal to x
+// # Return Values
 //
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//	string
 //
-//				In mathematics and computer science, the floor function
-//				is the function that takes as input a real number x,
-//				and gives as output the greatest integer less than or
-//				equal to x,	denoted floor(x) or ⌊x⌋.
+//		If this method completes successfully, this
+//		parameter will return the numeric value of the
+//		current NumberStrKernel instance spelled out as
+//		words.
 //
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//	error
 //
-//				Examples of Floor
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
 //
-//					Number     Floor
-//					 2           2
-//					 2.4         2
-//					 2.9         2
-//					-2.5        -3
-//					-2.7        -3
-//					-2          -2
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) FmtNumberWords(
+	roundingSpec NumStrRoundingSpec,
+	countryCultureSpec NumStrFmtCountryCultureSpec,
+	numberScale NumberWordsScale,
+	errorPrefix interface{}) (
+	string,
+	error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"FmtNumberWords()",
+		"")
+
+	if err != nil {
+		return "", err
+	}
+
+	return new(numStrNumberWordsNanobot).
+		formatNumberWords(
+			numStrKernel,
+			roundingSpec,
+			&countryCultureSpec,
+			numberScale,
+			ePrefix.XCpy(
+				"numStrKernel"))
+}
+
+// FmtOrdinalNumStr
 //
-//			Ceiling
+// Returns the integer value of the current
+// NumberStrKernel instance formatted as an ordinal number
+// using the suffix rules of a designated language.
 //
-//				Yields the nearest integer up. Ceiling does not
-//				apply any special treatment to 0.5.
+//	Examples:
+//		Value	Language			Ordinal
+//		1		EN					"1st"
+//		2		EN					"2nd"
+//		23		EN					"23rd"
+//		111		EN					"111th"
+//		1000000	EN					"1,000,000th"
+//		2		EN, superscript		"2ⁿᵈ"
+//		1		FR					"1er"
+//		1		FR, feminine		"1re"
+//		2		FR					"2e"
+//		1		DE					"1."
+//		1000000	DE					"1.000.000."
 //
-//				Ceiling Function: The least integer that is
-//				greater than or	equal to x.
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+// Integer digits are grouped by thousands using the
+// default integer separator of the designated language.
 //
-//				The ceiling function maps x to the least integer
-//				greater than or equal to x, denoted ceil(x) or
-//				⌈x⌉.[1]
+// The numeric value is first rounded according to
+// 'roundingSpec'. If the rounded value is negative or
+// contains non-zero fractional digits, an error will be
+// returned.
 //
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+// To format ordinal numbers with number symbols or
+// within a number field, see method:
 //
-//					Examples of Ceiling
+//	NumStrFormatSpec.NewOrdinalNumFormat()
 //
-//						Number    Ceiling
-//						 2           2
-//						 2.4         3
-//						 2.9         3
-//						-2.5        -2
-//						-2.7        -2
-//						-2          -2
+// ----------------------------------------------------------------
 //
-//			Truncate
+// # Input Parameters
 //
-//				Apply NO Rounding whatsoever. The Round From Digit
-//				is dropped or deleted. The Round To Digit is NEVER
-//				changed.
+//	roundingSpec				NumStrRoundingSpec
 //
-//				Examples of Truncate
+//		The Number String Rounding Specification applied
+//		to a copy of the current NumberStrKernel instance
+//		before formatting. To format integer values
+//		without rounding, configure this specification
+//		with NumRoundType.NoRounding().
+//
+//	languageCode				string
+//
+//		The two character ISO 639-1 language code which
+//		determines the ordinal suffix rules and the
+//		integer separator. This parameter is not case
+//		sensitive. Supported language codes are "EN"
+//		(English), "FR" (French) and "DE" (German).
 //
-//					Example-1
-//					Number: 23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:   23.14 - The Round From Digit
-//					is dropped.
+//		A BCP 47 language tag such as "en-US" or "fr-FR"
+//		may also be submitted. In that case, only the
+//		primary language subtag ("en", "fr") is used.
 //
-//					Example-2
-//					Number: -23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:  -23.14 - The Round From Digit
-//					is dropped.
+//	useFeminine					bool
 //
-//	roundToFractionalDigits		int
+//		When set to 'true', the feminine form of the
+//		ordinal suffix is applied where the language
+//		distinguishes grammatical gender. In French, the
+//		feminine form of "1er" is "1re".
 //
-//		When set to a positive integer value, this
-//		parameter controls the number of digits to the
-//		right of the radix point or decimal separator
-//		(a.k.a. decimal point). This controls the number
-//		of fractional digits remaining after completion
-//		of the number rounding operation.
+//	useSuperscript				bool
 //
-//		If input parameter 'roundingType' is set to
-//		NumRoundType.NoRounding(),
-//		'roundToFractionalDigits' is ignored and no
-//		rounding operation is performed.
+//		When set to 'true', ordinal suffixes are rendered
+//		with Unicode superscript characters ("1ˢᵗ",
+//		"1ᵉʳ"). German ordinals are not affected.
 //
 //	errorPrefix					interface{}
 //
@@ -12711,204 +14150,33 @@ func (numStrKernel *NumberStrKernel) FmtNumStrParams(
 //
 // # Return Values
 //
-//	pureNumberStr				string
-//
-//		If this method completes successfully, a Pure
-//		number string containing the numeric value
-//		extracted from the current instance of
-//		NumberStrKernel will be returned.
-//
-//		A Pure Number String is defined as follows:
-//
-//		1.	A pure number string consists entirely of numeric
-//		  	digit characters (0-9).
-//
-//		2.	A pure number string will separate integer and
-//		  	fractional digits with a radix point or decimal
-//		  	separator. This could be, but is not limited to,
-//		  	a decimal point ('.'). For example, many European
-//		  	countries use the comma (',') as a radix point.
-//
-//		3.	A pure number string will designate negative values
-//		  	with a minus sign ('-'). This minus sign could be
-//		  	positioned as a leading or trailing minus sign.
-//
-//		4.	A pure number string will NEVER include integer
-//		  	separators such as commas (',') to separate
-//		  	integer digits by thousands.
-//
-//		  				  NOT THIS: 1,000,000
-//		  		Pure Number String: 1000000
-//
-//		5.	A pure number string will NEVER include currency
-//				symbols.
-//
-//	pureNumStrComponents		PureNumberStrComponents
+//	string
 //
 //		If this method completes successfully, this
-//		parameter will return an instance of
-//		PureNumberStrComponents. This data structure
-//		contains an analysis and profile information on
-//		the Pure Number String returned by paramter,
-//		'pureNumberStr'.
-//
-//		type PureNumberStrComponents struct {
-//
-//			NumStrStats NumberStrStatsDto
-//
-//				This data transfer object will return key
-//				statistics on the numeric value encapsulated
-//				by the current instance of NumberStrKernel.
-//
-//				type NumberStrStatsDto struct {
-//
-//				NumOfIntegerDigits					uint64
-//
-//					The total number of integer digits to the
-//					left of the radix point or, decimal point, in
-//					the subject numeric value.
-//
-//				NumOfSignificantIntegerDigits		uint64
-//
-//					The number of nonzero integer digits to the
-//					left of the radix point or, decimal point, in
-//					the subject numeric value.
-//
-//				NumOfFractionalDigits				uint64
-//
-//					The total number of fractional digits to the
-//					right of the radix point or, decimal point,
-//					in the subject numeric value.
-//
-//				NumOfSignificantFractionalDigits	uint64
-//
-//					The number of nonzero fractional digits to
-//					the right of the radix point or, decimal
-//					point, in the subject numeric value.
-//
-//				NumberValueType 					NumericValueType
-//
-//					This enumeration value specifies whether the
-//					subject numeric value is classified either as
-//					an integer or a floating point number.
-//
-//					Possible enumeration values are listed as
-//					follows:
-//						NumValType.None()
-//						NumValType.FloatingPoint()
-//						NumValType.Integer()
-//
-//				NumberSign							NumericSignValueType
-//
-//					An enumeration specifying the number sign
-//					associated with the numeric value. Possible
-//					values are listed as follows:
-//						NumSignVal.None()		= Invalid Value
-//						NumSignVal.Negative()	= -1
-//						NumSignVal.Zero()		=  0
-//						NumSignVal.Positive()	=  1
-//
-//				IsZeroValue							bool
-//
-//					If 'true', the subject numeric value is equal
-//					to zero ('0').
-//
-//					If 'false', the subject numeric value is
-//					greater than or less than zero ('0').
-//				}
-//
-//
-//
-//			AbsoluteValueNumStr string
-//
-//			The number string expressed as an absolute value.
-//			Be advised, this number string may be a floating
-//			point number string containing fractional digits.
-//
-//			AbsoluteValAllIntegerDigitsNumStr string
-//
-//			Integer and fractional digits are combined
-//			in a single number string without a decimal
-//			point separating integer and fractional digits.
-//			This string DOES NOT contain a leading number
-//			sign (a.k.a. minus sign ('-')
-//
-//			SignedAllIntegerDigitsNumStr string
-//
-//			Integer and fractional digits are combined
-//			in a single number string without a decimal
-//			point separating integer and fractional digits.
-//			If the numeric value is negative, a leading
-//			minus sign will be prefixed at the beginning
-//			of the number string.
-//
-//			NativeNumberStr string
-//
-//			A Native Number String representing the base
-//			numeric value used to generate these profile
-//			number string statistics.
-//
-//			A valid Native Number String must conform to the
-//			standardized formatting criteria defined below:
-//
-//			 	1. A Native Number String Consists of numeric
-//			 	   character digits zero through nine inclusive
-//			 	   (0-9).
-//
-//			 	2. A Native Number String will include a period
-//			 	   or decimal point ('.') to separate integer and
-//			 	   fractional digits within a number string.
-//
-//			 	   Native Number String Floating Point Value:
-//			 	   				123.1234
-//
-//			 	3. A Native Number String will always format
-//			 	   negative numeric values with a leading minus sign
-//			 	   ('-').
-//
-//			 	   Native Number String Negative Value:
-//			 	   				-123.2
-//
-//			 	4. A Native Number String WILL NEVER include integer
-//			 	   separators such as commas (',') to separate
-//			 	   integer digits by thousands.
-//
-//			 	   					NOT THIS: 1,000,000
-//			 	   		Native Number String: 1000000
+//		parameter will return the integer value of the
+//		current NumberStrKernel instance formatted as an
+//		ordinal number.
 //
-//			 	5. Native Number Strings will only consist of:
-//
-//			 	   (a)	Numeric digits zero through nine inclusive (0-9).
-//
-//			 	   (b)	A decimal point ('.') for floating point
-//			 	   		numbers.
-//
-//			 	   (c)	A leading minus sign ('-') in the case of
-//			 	   		negative numeric values.
-//
-//		}
-//
-//	err							error
+//	error
 //
 //		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
-//
-//		If errors are encountered during processing, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
 //		returned error Type will encapsulate an error
-//		message. This returned error message will
-//		incorporate the method chain and text passed by
-//		input parameter, 'errorPrefix'. The 'errorPrefix'
-//		text will be attached to the beginning of the
-//		error message.
-func (numStrKernel *NumberStrKernel) FmtNumStrPure(
-	decSeparatorChars string,
-	leadingMinusSign bool,
-	roundingType NumberRoundingType,
-	roundToFractionalDigits int,
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) FmtOrdinalNumStr(
+	roundingSpec NumStrRoundingSpec,
+	languageCode string,
+	useFeminine bool,
+	useSuperscript bool,
 	errorPrefix interface{}) (
-	pureNumberStr string,
-	pureNumStrComponents PureNumberStrComponents,
-	err error) {
+	string,
+	error) {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -12919,26 +14187,53 @@ func (numStrKernel *NumberStrKernel) FmtNumStrPure(
 	defer numStrKernel.lock.Unlock()
 
 	var ePrefix *ePref.ErrPrefixDto
+	var err error
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"FmtNumStrNative()",
+			"FmtOrdinalNumStr()",
 		"")
 
 	if err != nil {
-		return pureNumberStr,
-			pureNumStrComponents,
-			err
+		return "", err
 	}
 
-	return new(numberStrKernelQuark).getPureNumStr(
+	var ordinalFmtSpec NumStrOrdinalFormatSpec
+
+	ordinalFmtSpec,
+		err = new(NumStrOrdinalFormatSpec).NewOrdinalFormat(
+		languageCode,
+		useFeminine,
+		useSuperscript,
+		ePrefix.XCpy(
+			"ordinalFmtSpec"))
+
+	if err != nil {
+		return "", err
+	}
+
+	var numberFieldSpec NumStrNumberFieldSpec
+
+	numberFieldSpec,
+		err = new(NumStrNumberFieldSpec).NewFieldSpec(
+		-1,
+		TxtJustify.Right(),
+		ePrefix.XCpy(
+			"numberFieldSpec"))
+
+	if err != nil {
+		return "", err
+	}
+
+	return new(numberStrKernelAtom).formatOrdinalNumStr(
 		numStrKernel,
-		decSeparatorChars,
-		leadingMinusSign,
-		roundingType,
-		roundToFractionalDigits,
+		roundingSpec,
+		ordinalFmtSpec,
+		NumStrNumberSymbolSpec{},
+		NumStrNumberSymbolSpec{},
+		numberFieldSpec,
 		ePrefix.XCpy(
 			"numStrKernel"))
 }
@@ -1,6 +1,8 @@
 package strmech
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	ePref "github.com/MikeAustin71/errpref"
 	"math/big"
@@ -8,17 +10,64 @@ import (
 	"sync"
 )
 
-//	GetDefaultNumStrFmtSpec
+// GetBestRationalApproximation
+//
+// Returns the best rational approximation of the numeric
+// value encapsulated by the current instance of
+// NumberStrKernel, subject to a maximum denominator, a
+// tolerance, or both.
+//
+// The numeric value is first converted, without rounding,
+// to the exactly equivalent big.Rat value. The
+// approximation is then computed from the continued
+// fraction convergents and semiconvergents of that value.
+//
+//	maxDenominator only:
+//		Returns the rational number closest to the
+//		subject value whose denominator does not exceed
+//		'maxDenominator'.
+//
+//	tolerance only:
+//		Returns the rational number with the smallest
+//		denominator whose absolute difference from the
+//		subject value does not exceed 'tolerance'.
+//
+//	maxDenominator and tolerance:
+//		Returns the rational number with the smallest
+//		denominator whose absolute difference from the
+//		subject value does not exceed 'tolerance'. If no
+//		such rational number has a denominator less than
+//		or equal to 'maxDenominator', an error is
+//		returned.
 //
-//	Returns a deep copy of the default Number String
-//	Formatting specifications ('NumStrFormatSpec')
-//	configured for the current instance of
-//	NumberStrKernel.
+// Example:
+//
+//	NumberStrKernel = 1.059463094359	(Semitone Ratio)
+//	maxDenominator = 100	Approximation = 89/84
 //
 // ----------------------------------------------------------------
 //
 // # Input Parameters
 //
+//	maxDenominator				*big.Int
+//
+//		The maximum denominator of the returned
+//		approximation. If this parameter is 'nil', no
+//		maximum denominator is applied. If this
+//		parameter is not 'nil', it must be greater than
+//		or equal to one (1).
+//
+//	tolerance					*big.Rat
+//
+//		The maximum absolute difference between the
+//		subject value and the returned approximation. If
+//		this parameter is 'nil', no tolerance is applied.
+//		If this parameter is not 'nil', it must be
+//		greater than or equal to zero (0).
+//
+//		'maxDenominator' and 'tolerance' may not both be
+//		'nil'.
+//
 //	errorPrefix					interface{}
 //
 //		This object encapsulates error prefix text which
@@ -27,11 +76,11 @@ import (
 //		method or methods listed as a method or function
 //		chain of execution.
 //
-//		If no error prefix information is needed, set
-//		this parameter to 'nil'.
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//		This empty interface must be convertible to one
-//		of the following types:
+//		This empty interface must be convertible to one of
+//		the following types:
 //
 //		1.	nil
 //				A nil value is valid and generates an
@@ -82,14 +131,14 @@ import (
 //
 // # Return Values
 //
-//	NumStrFormatSpec
+//	approximation				*big.Rat
 //
-//		If this method completes successfully, a deep
-//		copy of the default Number String Formatting
-//		specifications configured for the current
-//		instance of NumberStrKernel will be returned.
+//		If this method completes successfully, this
+//		parameter will return the best rational
+//		approximation of the numeric value encapsulated
+//		by the current instance of NumberStrKernel.
 //
-//	error
+//	err							error
 //
 //		If this method completes successfully, the
 //		returned error Type is set equal to 'nil'.
@@ -101,10 +150,12 @@ import (
 //		input parameter, 'errorPrefix'. The 'errorPrefix'
 //		text will be attached to the beginning of the
 //		error message.
-func (numStrKernel *NumberStrKernel) GetDefaultNumStrFmtSpec(
+func (numStrKernel *NumberStrKernel) GetBestRationalApproximation(
+	maxDenominator *big.Int,
+	tolerance *big.Rat,
 	errorPrefix interface{}) (
-	NumStrFormatSpec,
-	error) {
+	approximation *big.Rat,
+	err error) {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -116,108 +167,152 @@ func (numStrKernel *NumberStrKernel) GetDefaultNumStrFmtSpec(
 
 	var ePrefix *ePref.ErrPrefixDto
 
-	var err error
+	approximation = big.NewRat(0, 1)
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"GetDefaultNumStrFmtSpec()",
+			"GetBestRationalApproximation()",
 		"")
 
 	if err != nil {
-		return NumStrFormatSpec{}, err
+		return approximation, err
 	}
 
-	if new(numStrFmtSpecNanobot).isNOP(
-		&numStrKernel.numStrFormatSpec) {
+	mathBigRatHelpQuark := mathBigRatHelperQuark{}
 
-		// This is a NOP!
-		// numStrKernel.numStrFormatSpec is invalid.
-		// Set default numStrKernel.numStrFormatSpec
-		// to stand US Signed Number Minus Format
-		// Specification.
-		numStrKernel.numStrFormatSpec,
-			err = new(NumStrFormatSpec).NewSignedNumDefaultsUSMinus(
-			NumStrNumberFieldSpec{
-				fieldLength:        -1,
-				fieldJustification: TxtJustify.Right(),
-			},
-			ePrefix.XCpy(
-				"numStrKernel.numStrFormatSpec "+
-					"Default US NumStrNumberFieldSpec"))
+	var bigRatNum *big.Rat
 
-		if err != nil {
-			return NumStrFormatSpec{}, err
-		}
+	bigRatNum,
+		err = mathBigRatHelpQuark.numStrKernelToRat(
+		numStrKernel,
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	if err != nil {
+		return approximation, err
 	}
 
-	return numStrKernel.numStrFormatSpec.CopyOut(
-		ePrefix.XCpy("<-numStrKernel.numStrFormatSpec"))
+	return mathBigRatHelpQuark.bestRationalApproximation(
+		bigRatNum,
+		maxDenominator,
+		tolerance,
+		ePrefix.XCpy(
+			"bigRatNum<-numStrKernel"))
 }
 
-//	GetExcessFractionalTrailingZerosCount
+// GetContinuedFractionConvergents
 //
-//	Returns the count of excess fractional trailing
-//	zeros for the numeric value contained in the current
-//	instance of NumberStrKernel.
+// Computes the simple continued fraction expansion of the
+// numeric value encapsulated by the current instance of
+// NumberStrKernel and returns the convergents together
+// with their partial quotients and approximation errors.
 //
-//	If the fractional digits value is zero, the count
-//	will NOT include the first zero to the right of the
-//	decimal point.
+// The numeric value is first converted, without rounding,
+// to the exactly equivalent big.Rat value. Since that
+// value is rational, the continued fraction expansion is
+// finite.
 //
-//	This method differs significantly from method:
+// ----------------------------------------------------------------
 //
-//		NumberStrKernel.GetFractionalTrailingZerosCount()
+// # Input Parameters
 //
-// ----------------------------------------------------------------
+//	maxConvergents				int
 //
-// # Usage
+//		The maximum number of convergents to be returned.
+//		If this value is less than one (1), all
+//		convergents will be returned.
 //
-//	Example-1
-//		Numeric Value:  5.1000
-//		Count of Excess Fractional Trailing Zeros: 3
+//	errorPrefix					interface{}
 //
-//	Example-2
-//		Numeric Value:  5.000
-//		Count of Excess Fractional Trailing Zeros: 2
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//	Example-3
-//		Numeric Value:  50.320
-//		Count of Excess Fractional Trailing Zeros: 1
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//	Example-4
-//		Numeric Value:  0.000
-//		Count of Excess Fractional Trailing Zeros: 2
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-// ----------------------------------------------------------------
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//	# Input Parameters
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//	NONE
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
 // ----------------------------------------------------------------
 //
 // # Return Values
 //
-//	uint64
+//	convergents					[]ContinuedFractionConvergentDto
 //
-//		Returns the number of excess trailing zeros for
-//		the fractional part of the numeric value
-//		contained in the current instance of
-//		NumberStrKernel.
+//		If this method completes successfully, this
+//		array will contain the convergents of the
+//		current NumberStrKernel numeric value in order of
+//		increasing accuracy. Each element includes the
+//		partial quotient, the convergent and the exact
+//		absolute error of the convergent.
 //
-//		NOTE: If the fractional value is zero, the
-//		returned count of excess leading zeros will NOT
-//		include the first zero to the right of the
-//		decimal point.
+//	err							error
 //
-//			Example
-//				Numeric Value:  000
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
 //
-//				Count of Excess Fractional Trailing Zeros:
-//								2
-func (numStrKernel *NumberStrKernel) GetExcessFractionalTrailingZerosCount() uint64 {
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (numStrKernel *NumberStrKernel) GetContinuedFractionConvergents(
+	maxConvergents int,
+	errorPrefix interface{}) (
+	convergents []ContinuedFractionConvergentDto,
+	err error) {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -227,81 +322,137 @@ func (numStrKernel *NumberStrKernel) GetExcessFractionalTrailingZerosCount() uin
 
 	defer numStrKernel.lock.Unlock()
 
-	var excessFracTrailingZeros uint64 = 0
-
-	lenFracChars :=
-		uint64(len(numStrKernel.fractionalDigits.CharsArray))
+	var ePrefix *ePref.ErrPrefixDto
 
-	if lenFracChars == 0 {
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"GetContinuedFractionConvergents()",
+		"")
 
-		return excessFracTrailingZeros
+	if err != nil {
+		return convergents, err
 	}
 
-	excessFracTrailingZeros =
-		numStrKernel.fractionalDigits.GetCountTrailingZeros()
+	mathBigRatHelpQuark := mathBigRatHelperQuark{}
 
-	if excessFracTrailingZeros < lenFracChars {
+	var bigRatNum *big.Rat
 
-		return excessFracTrailingZeros
-	}
+	bigRatNum,
+		err = mathBigRatHelpQuark.numStrKernelToRat(
+		numStrKernel,
+		ePrefix.XCpy(
+			"numStrKernel"))
 
-	excessFracTrailingZeros--
+	if err != nil {
+		return convergents, err
+	}
 
-	return excessFracTrailingZeros
+	return mathBigRatHelpQuark.continuedFractionConvergents(
+		bigRatNum,
+		maxConvergents,
+		ePrefix.XCpy(
+			"bigRatNum<-numStrKernel"))
 }
 
-//	GetExcessIntegerLeadingZerosCount
-//
-//	Returns the count of excess integer leading zeros.
-//
-//	If the integer value is zero, the count will NOT
-//	include the first zero to the left of the decimal
-//	point.
-//
-//	This method differs significantly from method:
+//	GetDefaultNumStrFmtSpec
 //
-//		NumberStrKernel.GetIntegerLeadingZerosCount()
+//	Returns a deep copy of the default Number String
+//	Formatting specifications ('NumStrFormatSpec')
+//	configured for the current instance of
+//	NumberStrKernel.
 //
 // ----------------------------------------------------------------
 //
-// # Usage
+// # Input Parameters
 //
-//	Example-1
-//		Numeric Value:  0001
-//		Count of Excess Integer Leading Zeros: 3
+//	errorPrefix					interface{}
 //
-//	Example-2
-//		Numeric Value:  000
-//		Count of Excess Integer Leading Zeros: 2
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//	Example-3
-//		Numeric Value:  050.0032
-//		Count of Excess Integer Leading Zeros: 1
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
 //
-// ----------------------------------------------------------------
+//		This empty interface must be convertible to one
+//		of the following types:
 //
-//	# Input Parameters
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//	NONE
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-// ----------------------------------------------------------------
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-// # Return Values
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//	uint64
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//		Returns the number of excess leading zeros for
-//		the integer part of the numeric value represented
-//		by this instance of NumberStrKernel.
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//		NOTE: If the integer value is zero, the returned
-//		count of excess leading zeros will NOT include
-//		the first zero to the left of the decimal point.
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//			Example
-//				Numeric Value:  000
-//				Count of Excess Integer Leading Zeros: 2
-func (numStrKernel *NumberStrKernel) GetExcessIntegerLeadingZerosCount() uint64 {
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumStrFormatSpec
+//
+//		If this method completes successfully, a deep
+//		copy of the default Number String Formatting
+//		specifications configured for the current
+//		instance of NumberStrKernel will be returned.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (numStrKernel *NumberStrKernel) GetDefaultNumStrFmtSpec(
+	errorPrefix interface{}) (
+	NumStrFormatSpec,
+	error) {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -311,106 +462,176 @@ func (numStrKernel *NumberStrKernel) GetExcessIntegerLeadingZerosCount() uint64
 
 	defer numStrKernel.lock.Unlock()
 
-	var excessIntLeadingZeros uint64 = 0
+	var ePrefix *ePref.ErrPrefixDto
 
-	lenIntChars :=
-		uint64(len(numStrKernel.integerDigits.CharsArray))
+	var err error
 
-	if lenIntChars == 0 {
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"GetDefaultNumStrFmtSpec()",
+		"")
 
-		return excessIntLeadingZeros
+	if err != nil {
+		return NumStrFormatSpec{}, err
 	}
 
-	excessIntLeadingZeros =
-		numStrKernel.integerDigits.GetCountLeadingZeros()
+	if new(numStrFmtSpecNanobot).isNOP(
+		&numStrKernel.numStrFormatSpec) {
 
-	if excessIntLeadingZeros < lenIntChars {
+		// This is a NOP!
+		// numStrKernel.numStrFormatSpec is invalid.
+		// Set default numStrKernel.numStrFormatSpec
+		// to stand US Signed Number Minus Format
+		// Specification.
+		numStrKernel.numStrFormatSpec,
+			err = new(NumStrFormatSpec).NewSignedNumDefaultsUSMinus(
+			NumStrNumberFieldSpec{
+				fieldLength:        -1,
+				fieldJustification: TxtJustify.Right(),
+			},
+			ePrefix.XCpy(
+				"numStrKernel.numStrFormatSpec "+
+					"Default US NumStrNumberFieldSpec"))
 
-		return excessIntLeadingZeros
+		if err != nil {
+			return NumStrFormatSpec{}, err
+		}
 	}
 
-	excessIntLeadingZeros--
-
-	return excessIntLeadingZeros
+	return numStrKernel.numStrFormatSpec.CopyOut(
+		ePrefix.XCpy("<-numStrKernel.numStrFormatSpec"))
 }
 
-// GetFractionalDigits - Returns an instance of RuneArrayDto
-// containing a deep copy of the fractional digits rune array
-// encapsulated by the current instance of NumberStrKernel.
+// GetEngineeringNotation
+//
+// Converts the numeric value of the current
+// NumberStrKernel instance to Engineering Notation and
+// returns the result as a new instance of
+// EngNotationKernel.
+//
+// In Engineering Notation, the exponent of ten is
+// always a multiple of three and the coefficient has
+// one to three integer digits.
+//
+//	Examples:
+//		Numeric Value:	265,200,000
+//		Engineering Notation: 265.2 x 10^6
+//
+//		Numeric Value:	0.0000033
+//		Engineering Notation: 3.3 x 10^-6
+//
+// The returned EngNotationKernel may be formatted with
+// any of the display formats defined by enumeration
+// EngineeringNotationFormat, including the SI Prefix
+// formats ("3.3 μ" or "3.3 micro"). Reference method
+// EngNotationKernel.FmtEngNotation().
 //
 // ----------------------------------------------------------------
 //
-// Input Parameters
+// # Input Parameters
 //
-//	errorPrefix                interface{}
-//	   - This object encapsulates error prefix text which is
-//	     included in all returned error messages. Usually, it
-//	     contains the name of the calling method or methods
-//	     listed as a method or function chain of execution.
+//	coefficientRoundingType		NumberRoundingType
 //
-//	     If no error prefix information is needed, set this parameter
-//	     to 'nil'.
+//		The rounding algorithm applied to the fractional
+//		digits of the Engineering Notation coefficient.
+//		If this value is set to NumRoundType.NoRounding(),
+//		all significant digits are retained and
+//		'coefficientFracDigits' is ignored.
 //
-//	     This empty interface must be convertible to one of the
-//	     following types:
+//	coefficientFracDigits		int
 //
+//		The number of fractional digits retained in the
+//		coefficient after rounding.
 //
-//	     1. nil - A nil value is valid and generates an empty
-//	              collection of error prefix and error context
-//	              information.
+//	errorPrefix					interface{}
 //
-//	     2. string - A string containing error prefix information.
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//	     3. []string A one-dimensional slice of strings containing
-//	                 error prefix information
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//	     4. [][2]string A two-dimensional slice of strings containing
-//	                    error prefix and error context information.
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//	     5. ErrPrefixDto - An instance of ErrPrefixDto. The
-//	                       ErrorPrefixInfo from this object will be
-//	                       copied to 'errPrefDto'.
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//	     6. *ErrPrefixDto - A pointer to an instance of ErrPrefixDto.
-//	                        ErrorPrefixInfo from this object will be
-//	                       copied to 'errPrefDto'.
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//	     7. IBasicErrorPrefix - An interface to a method generating
-//	                            a two-dimensional slice of strings
-//	                            containing error prefix and error
-//	                            context information.
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//	     If parameter 'errorPrefix' is NOT convertible to one of
-//	     the valid types listed above, it will be considered
-//	     invalid and trigger the return of an error.
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//	     Types ErrPrefixDto and IBasicErrorPrefix are included in
-//	     the 'errpref' software package, "github.com/MikeAustin71/errpref".
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-// ------------------------------------------------------------------------
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-// Return Values
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//		copyOfFractionalDigits     RuneArrayDto
-//	    - If this method completes successfully, the parameter will
-//	      return an instance of RuneArrayDto populated with a deep
-//	      copy of the fractional numeric digits contained in the
-//	      current instance of NumberStrKernel.
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
+// ----------------------------------------------------------------
 //
-//	 err                        error
-//	    - If this method completes successfully and no errors are
-//	      encountered this return value is set to 'nil'. Otherwise,
-//	      if errors are encountered, this return value will contain
-//	      an appropriate error message.
+// # Return Values
 //
-//	      If an error message is returned, the text value of input
-//	      parameter 'errorPrefix' will be inserted or prefixed at
-//	      the beginning of the error message.
-func (numStrKernel *NumberStrKernel) GetFractionalDigits(
+//	EngNotationKernel
+//
+//		This returned instance of EngNotationKernel will
+//		be configured with the numeric value contained in
+//		the current instance of NumberStrKernel.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) GetEngineeringNotation(
+	coefficientRoundingType NumberRoundingType,
+	coefficientFracDigits int,
 	errorPrefix interface{}) (
-	copyOfFractionalDigits RuneArrayDto,
-	err error) {
+	EngNotationKernel,
+	error) {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -422,100 +643,94 @@ func (numStrKernel *NumberStrKernel) GetFractionalDigits(
 
 	var ePrefix *ePref.ErrPrefixDto
 
+	var err error
+
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"GetFractionalDigits()",
+			"GetEngineeringNotation()",
 		"")
 
 	if err != nil {
-		return copyOfFractionalDigits, err
+		return EngNotationKernel{}, err
 	}
 
-	if len(numStrKernel.fractionalDigits.CharsArray) == 0 {
-		return copyOfFractionalDigits, err
-	}
+	newEngNotKernel := EngNotationKernel{}
 
-	copyOfFractionalDigits,
-		err = numStrKernel.fractionalDigits.CopyOut(
-		ePrefix.XCpy("copyOfFractionalDigits<-" +
-			"numStrKernel.fractionalDigits"))
+	err = new(engNotationKernelNanobot).setFromNumStrKernel(
+		&newEngNotKernel,
+		numStrKernel,
+		coefficientRoundingType,
+		coefficientFracDigits,
+		ePrefix.XCpy(
+			"newEngNotKernel<-numStrKernel"))
 
-	return copyOfFractionalDigits, err
+	return newEngNotKernel, err
 }
 
-// GetFractionalRuneArray - A deep copy of the fractional digits
-// rune array encapsulated by the current instance of
-// NumberStrKernel.
+//	GetExcessFractionalTrailingZerosCount
 //
-// ----------------------------------------------------------------
+//	Returns the count of excess fractional trailing
+//	zeros for the numeric value contained in the current
+//	instance of NumberStrKernel.
 //
-// Input Parameters
+//	If the fractional digits value is zero, the count
+//	will NOT include the first zero to the right of the
+//	decimal point.
 //
-//	NONE
+//	This method differs significantly from method:
 //
-// ------------------------------------------------------------------------
+//		NumberStrKernel.GetFractionalTrailingZerosCount()
 //
-// Return Values
+// ----------------------------------------------------------------
 //
-//	[]rune
-//	   - This method returns a rune array consisting of the
-//	     fractional digits portion of the numeric value represented
-//	     by the current instance of NumberStrKernel.  This source
-//	     rune array is identified as internal member variable
-//	     'NumberStrKernel.fractionalDigits'.
-func (numStrKernel *NumberStrKernel) GetFractionalRuneArray() []rune {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	return numStrKernel.fractionalDigits.GetRuneArray()
-}
-
-//	GetFractionalRuneArrayDto
+// # Usage
 //
-//	Returns a deep copy of the fractional digits
-//	RuneArrayDto object maintained by the current
-//	instance of NumberStrKernel.
+//	Example-1
+//		Numeric Value:  5.1000
+//		Count of Excess Fractional Trailing Zeros: 3
 //
-//	This RuneArrayDto object encapsulates the fractional
-//	digits rune array containing the fractional portion
-//	of the numeric value represented by the current
-//	NumberStrKernel instance.
+//	Example-2
+//		Numeric Value:  5.000
+//		Count of Excess Fractional Trailing Zeros: 2
+//
+//	Example-3
+//		Numeric Value:  50.320
+//		Count of Excess Fractional Trailing Zeros: 1
+//
+//	Example-4
+//		Numeric Value:  0.000
+//		Count of Excess Fractional Trailing Zeros: 2
 //
 // ----------------------------------------------------------------
 //
-// Input Parameters
+//	# Input Parameters
 //
 //	NONE
 //
-// ------------------------------------------------------------------------
+// ----------------------------------------------------------------
 //
-// Return Values
+// # Return Values
 //
-//	RuneArrayDto
+//	uint64
 //
-//		This method returns an instance of RuneArrayDto
-//		consisting of the fractional digits portion of the
-//		numeric value represented by the current instance
-//		of NumberStrKernel. This RuneArrayDto member
-//		variable identified as:
+//		Returns the number of excess trailing zeros for
+//		the fractional part of the numeric value
+//		contained in the current instance of
+//		NumberStrKernel.
 //
-//	     	'NumberStrKernel.fractionalDigits'
+//		NOTE: If the fractional value is zero, the
+//		returned count of excess leading zeros will NOT
+//		include the first zero to the right of the
+//		decimal point.
 //
-//		This RuneArrayDto object encapsulates the fractional
-//		digits rune array for the current instance of
-//		NumberStrKernel.
-func (numStrKernel *NumberStrKernel) GetFractionalRuneArrayDto(
-	errorPrefix interface{}) (
-	RuneArrayDto,
-	error) {
+//			Example
+//				Numeric Value:  000
+//
+//				Count of Excess Fractional Trailing Zeros:
+//								2
+func (numStrKernel *NumberStrKernel) GetExcessFractionalTrailingZerosCount() uint64 {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -525,89 +740,56 @@ func (numStrKernel *NumberStrKernel) GetFractionalRuneArrayDto(
 
 	defer numStrKernel.lock.Unlock()
 
-	var runeArrayDto RuneArrayDto
-	var err error
-
-	runeArrayDto,
-		err = numStrKernel.fractionalDigits.CopyOut(
-		errorPrefix)
+	var excessFracTrailingZeros uint64 = 0
 
-	return runeArrayDto, err
-}
+	lenFracChars :=
+		uint64(len(numStrKernel.fractionalDigits.CharsArray))
 
-//	GetFractionalString
-//
-//	Converts the fractional rune array contained in the
-//	current instance of NumberStrKernel to a string
-//	and returns it to the calling function.
-//
-// ----------------------------------------------------------------
-//
-// # Input Parameters
-//
-//	NONE
-//
-// ------------------------------------------------------------------------
-//
-// # Return Values
-//
-//	string
-//
-//		This method returns a string consisting of the
-//		fractional digits portion of the numeric value
-//		represented by the current instance of
-//		NumberStrKernel. This source of these fractional
-//		digits is identified as internal member variable
-//		'NumberStrKernel.fractionalDigits'.
-func (numStrKernel *NumberStrKernel) GetFractionalString() string {
+	if lenFracChars == 0 {
 
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
+		return excessFracTrailingZeros
 	}
 
-	numStrKernel.lock.Lock()
+	excessFracTrailingZeros =
+		numStrKernel.fractionalDigits.GetCountTrailingZeros()
 
-	defer numStrKernel.lock.Unlock()
+	if excessFracTrailingZeros < lenFracChars {
 
-	return numStrKernel.fractionalDigits.GetCharacterString()
+		return excessFracTrailingZeros
+	}
+
+	excessFracTrailingZeros--
+
+	return excessFracTrailingZeros
 }
 
-//	GetFractionalTrailingZerosCount
+//	GetExcessIntegerLeadingZerosCount
 //
-//	Returns the count of all fractional digit trailing
-//	zeros.
+//	Returns the count of excess integer leading zeros.
+//
+//	If the integer value is zero, the count will NOT
+//	include the first zero to the left of the decimal
+//	point.
 //
 //	This method differs significantly from method:
 //
-//		NumberStrKernel.GetExcessFractionalTrailingZerosCount()
+//		NumberStrKernel.GetIntegerLeadingZerosCount()
 //
 // ----------------------------------------------------------------
 //
 // # Usage
 //
 //	Example-1
-//		Numeric Value:  1.000
-//		Count of Total Fractional Trailing Zeros: 3
+//		Numeric Value:  0001
+//		Count of Excess Integer Leading Zeros: 3
 //
 //	Example-2
-//		Numeric Value:  0.000
-//		Count of Total Fractional Trailing Zeros: 3
+//		Numeric Value:  000
+//		Count of Excess Integer Leading Zeros: 2
 //
 //	Example-3
-//		Numeric Value:  0.0
-//		Count of Total Fractional Trailing Zeros: 1
-//
-//	Example-4
-//		Numeric Value:  050.3200
-//		Count of Total Fractional Trailing Zeros: 2
-//
-//	Example-5
-//		Numeric Value:  50.32
-//		Count of Total Fractional Trailing Zeros: 0
-//
-//	Example-6
-//		Numeric Value:  97
-//		Count of Total Fractional Trailing Zeros: 0
+//		Numeric Value:  050.0032
+//		Count of Excess Integer Leading Zeros: 1
 //
 // ----------------------------------------------------------------
 //
@@ -621,10 +803,18 @@ func (numStrKernel *NumberStrKernel) GetFractionalString() string {
 //
 //	uint64
 //
-//		Returns the number of total trailing zeros for
-//		the fractional part of the numeric value represented
+//		Returns the number of excess leading zeros for
+//		the integer part of the numeric value represented
 //		by this instance of NumberStrKernel.
-func (numStrKernel *NumberStrKernel) GetFractionalTrailingZerosCount() uint64 {
+//
+//		NOTE: If the integer value is zero, the returned
+//		count of excess leading zeros will NOT include
+//		the first zero to the left of the decimal point.
+//
+//			Example
+//				Numeric Value:  000
+//				Count of Excess Integer Leading Zeros: 2
+func (numStrKernel *NumberStrKernel) GetExcessIntegerLeadingZerosCount() uint64 {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -634,24 +824,31 @@ func (numStrKernel *NumberStrKernel) GetFractionalTrailingZerosCount() uint64 {
 
 	defer numStrKernel.lock.Unlock()
 
-	var totalFracTrailingZeros uint64 = 0
+	var excessIntLeadingZeros uint64 = 0
 
-	lenFracChars :=
-		uint64(len(numStrKernel.fractionalDigits.CharsArray))
+	lenIntChars :=
+		uint64(len(numStrKernel.integerDigits.CharsArray))
 
-	if lenFracChars == 0 {
+	if lenIntChars == 0 {
 
-		return totalFracTrailingZeros
+		return excessIntLeadingZeros
 	}
 
-	totalFracTrailingZeros =
-		numStrKernel.fractionalDigits.GetCountTrailingZeros()
+	excessIntLeadingZeros =
+		numStrKernel.integerDigits.GetCountLeadingZeros()
 
-	return totalFracTrailingZeros
+	if excessIntLeadingZeros < lenIntChars {
+
+		return excessIntLeadingZeros
+	}
+
+	excessIntLeadingZeros--
+
+	return excessIntLeadingZeros
 }
 
-// GetIntegerDigits - Returns an instance of RuneArrayDto
-// containing a deep copy of the integer digits rune array
+// GetFractionalDigits - Returns an instance of RuneArrayDto
+// containing a deep copy of the fractional digits rune array
 // encapsulated by the current instance of NumberStrKernel.
 //
 // ----------------------------------------------------------------
@@ -707,10 +904,10 @@ func (numStrKernel *NumberStrKernel) GetFractionalTrailingZerosCount() uint64 {
 //
 // Return Values
 //
-//		copyOfIntegerDigits        RuneArrayDto
-//	    - If this method completes successfully, this parameter will
+//		copyOfFractionalDigits     RuneArrayDto
+//	    - If this method completes successfully, the parameter will
 //	      return an instance of RuneArrayDto populated with a deep
-//	      copy of the integer numeric digits contained in the
+//	      copy of the fractional numeric digits contained in the
 //	      current instance of NumberStrKernel.
 //
 //
@@ -723,9 +920,9 @@ func (numStrKernel *NumberStrKernel) GetFractionalTrailingZerosCount() uint64 {
 //	      If an error message is returned, the text value of input
 //	      parameter 'errorPrefix' will be inserted or prefixed at
 //	      the beginning of the error message.
-func (numStrKernel *NumberStrKernel) GetIntegerDigits(
+func (numStrKernel *NumberStrKernel) GetFractionalDigits(
 	errorPrefix interface{}) (
-	copyOfIntegerDigits RuneArrayDto,
+	copyOfFractionalDigits RuneArrayDto,
 	err error) {
 
 	if numStrKernel.lock == nil {
@@ -742,112 +939,46 @@ func (numStrKernel *NumberStrKernel) GetIntegerDigits(
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"GetIntegerDigits()",
+			"GetFractionalDigits()",
 		"")
 
-	if len(numStrKernel.integerDigits.CharsArray) == 0 {
-		return copyOfIntegerDigits, err
+	if err != nil {
+		return copyOfFractionalDigits, err
 	}
 
-	copyOfIntegerDigits,
-		err = numStrKernel.integerDigits.CopyOut(
-		ePrefix.XCpy("copyOfIntegerDigits<-numStrKernel.integerDigits"))
+	if len(numStrKernel.fractionalDigits.CharsArray) == 0 {
+		return copyOfFractionalDigits, err
+	}
 
-	return copyOfIntegerDigits, err
+	copyOfFractionalDigits,
+		err = numStrKernel.fractionalDigits.CopyOut(
+		ePrefix.XCpy("copyOfFractionalDigits<-" +
+			"numStrKernel.fractionalDigits"))
+
+	return copyOfFractionalDigits, err
 }
 
-//	GetIntegerLeadingZerosCount
-//
-//	Returns the count of all integer leading zeros.
-//
-//	This method differs significantly from method:
-//
-//		NumberStrKernel.GetExcessIntegerLeadingZerosCount()
+// GetFractionalRuneArray - A deep copy of the fractional digits
+// rune array encapsulated by the current instance of
+// NumberStrKernel.
 //
 // ----------------------------------------------------------------
 //
-// # Usage
+// Input Parameters
 //
-//	Example-1
-//		Numeric Value:  0001
-//		Count of Total Integer Leading Zeros: 3
+//	NONE
 //
-//	Example-2
-//		Numeric Value:  000
-//		Count of Total Integer Leading Zeros: 3
-//
-//	Example-3
-//		Numeric Value:  050.0032
-//		Count of Total Integer Leading Zeros: 1
-//
-// ----------------------------------------------------------------
-//
-//	# Input Parameters
-//
-//	NONE
-//
-// ----------------------------------------------------------------
-//
-// # Return Values
-//
-//	uint64
-//
-//		Returns the number of total leading zeros for
-//		the integer part of the numeric value represented
-//		by this instance of NumberStrKernel.
-func (numStrKernel *NumberStrKernel) GetIntegerLeadingZerosCount() uint64 {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	var totalIntLeadingZeros uint64 = 0
-
-	lenIntChars :=
-		uint64(len(numStrKernel.integerDigits.CharsArray))
-
-	if lenIntChars == 0 {
-
-		return totalIntLeadingZeros
-	}
-
-	totalIntLeadingZeros =
-		numStrKernel.integerDigits.GetCountLeadingZeros()
-
-	return totalIntLeadingZeros
-}
-
-//	GetIntegerRuneArray
-//
-//	Returns a deep copy of the integer digits rune array
-//	encapsulated by the current instance of
-//	NumberStrKernel.
-//
-// ----------------------------------------------------------------
-//
-// Input Parameters
-//
-//	NONE
-//
-// ------------------------------------------------------------------------
+// ------------------------------------------------------------------------
 //
 // Return Values
 //
 //	[]rune
-//
-//		This method returns a rune array consisting of
-//		the integer digits portion of the numeric value
-//		represented by the current instance of
-//		NumberStrKernel. This rune array is encapsulated
-//		in the RuneArrayDto member variable identified
-//		as:
-//
-//	     	'NumberStrKernel.integerDigits'.
-func (numStrKernel *NumberStrKernel) GetIntegerRuneArray() []rune {
+//	   - This method returns a rune array consisting of the
+//	     fractional digits portion of the numeric value represented
+//	     by the current instance of NumberStrKernel.  This source
+//	     rune array is identified as internal member variable
+//	     'NumberStrKernel.fractionalDigits'.
+func (numStrKernel *NumberStrKernel) GetFractionalRuneArray() []rune {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -857,18 +988,18 @@ func (numStrKernel *NumberStrKernel) GetIntegerRuneArray() []rune {
 
 	defer numStrKernel.lock.Unlock()
 
-	return numStrKernel.integerDigits.GetRuneArray()
+	return numStrKernel.fractionalDigits.GetRuneArray()
 }
 
-//	GetIntegerRuneArrayDto
+//	GetFractionalRuneArrayDto
 //
-//	Returns a deep copy of the integer digits
+//	Returns a deep copy of the fractional digits
 //	RuneArrayDto object maintained by the current
 //	instance of NumberStrKernel.
 //
-//	This RuneArrayDto object encapsulates the integer
-//	digits rune array containing the integer portion of
-//	the numeric value represented by the current
+//	This RuneArrayDto object encapsulates the fractional
+//	digits rune array containing the fractional portion
+//	of the numeric value represented by the current
 //	NumberStrKernel instance.
 //
 // ----------------------------------------------------------------
@@ -884,17 +1015,17 @@ func (numStrKernel *NumberStrKernel) GetIntegerRuneArray() []rune {
 //	RuneArrayDto
 //
 //		This method returns an instance of RuneArrayDto
-//		consisting of the integer digits portion of the
+//		consisting of the fractional digits portion of the
 //		numeric value represented by the current instance
 //		of NumberStrKernel. This RuneArrayDto member
 //		variable identified as:
 //
-//	     	'NumberStrKernel.integerDigits'
+//	     	'NumberStrKernel.fractionalDigits'
 //
-//		This RuneArrayDto object encapsulates the integer
+//		This RuneArrayDto object encapsulates the fractional
 //		digits rune array for the current instance of
 //		NumberStrKernel.
-func (numStrKernel *NumberStrKernel) GetIntegerRuneArrayDto(
+func (numStrKernel *NumberStrKernel) GetFractionalRuneArrayDto(
 	errorPrefix interface{}) (
 	RuneArrayDto,
 	error) {
@@ -911,33 +1042,37 @@ func (numStrKernel *NumberStrKernel) GetIntegerRuneArrayDto(
 	var err error
 
 	runeArrayDto,
-		err = numStrKernel.integerDigits.CopyOut(
+		err = numStrKernel.fractionalDigits.CopyOut(
 		errorPrefix)
 
 	return runeArrayDto, err
 }
 
-// GetIntegerString - Converts the integer rune array contained in
-// the current instance of NumberStrKernel to a string and returns
-// it to the calling function.
+//	GetFractionalString
+//
+//	Converts the fractional rune array contained in the
+//	current instance of NumberStrKernel to a string
+//	and returns it to the calling function.
 //
 // ----------------------------------------------------------------
 //
-// Input Parameters
+// # Input Parameters
 //
 //	NONE
 //
 // ------------------------------------------------------------------------
 //
-// Return Values
+// # Return Values
 //
 //	string
-//	   - This method returns a string consisting of the integer
-//	     digits portion of the numeric value represented by the
-//	     current instance of NumberStrKernel. This source of these
-//	     integer digits is identified as internal member variable
-//	     'NumberStrKernel.integerDigits'.
-func (numStrKernel *NumberStrKernel) GetIntegerString() string {
+//
+//		This method returns a string consisting of the
+//		fractional digits portion of the numeric value
+//		represented by the current instance of
+//		NumberStrKernel. This source of these fractional
+//		digits is identified as internal member variable
+//		'NumberStrKernel.fractionalDigits'.
+func (numStrKernel *NumberStrKernel) GetFractionalString() string {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -947,22 +1082,49 @@ func (numStrKernel *NumberStrKernel) GetIntegerString() string {
 
 	defer numStrKernel.lock.Unlock()
 
-	return numStrKernel.integerDigits.GetCharacterString()
+	return numStrKernel.fractionalDigits.GetCharacterString()
 }
 
-// GetInternalParameterDiagnostics
+//	GetFractionalTrailingZerosCount
 //
-// Returns formatted text output detailing the
-// NumberStrKernel member variable names and their
-// corresponding values contained in the current instance
-// of NumberStrKernel.
+//	Returns the count of all fractional digit trailing
+//	zeros.
 //
-// If an error is encountered, the error message is
-// included in the string returned by this method.
+//	This method differs significantly from method:
+//
+//		NumberStrKernel.GetExcessFractionalTrailingZerosCount()
 //
 // ----------------------------------------------------------------
 //
-// # Input Parameters
+// # Usage
+//
+//	Example-1
+//		Numeric Value:  1.000
+//		Count of Total Fractional Trailing Zeros: 3
+//
+//	Example-2
+//		Numeric Value:  0.000
+//		Count of Total Fractional Trailing Zeros: 3
+//
+//	Example-3
+//		Numeric Value:  0.0
+//		Count of Total Fractional Trailing Zeros: 1
+//
+//	Example-4
+//		Numeric Value:  050.3200
+//		Count of Total Fractional Trailing Zeros: 2
+//
+//	Example-5
+//		Numeric Value:  50.32
+//		Count of Total Fractional Trailing Zeros: 0
+//
+//	Example-6
+//		Numeric Value:  97
+//		Count of Total Fractional Trailing Zeros: 0
+//
+// ----------------------------------------------------------------
+//
+//	# Input Parameters
 //
 //	NONE
 //
@@ -970,13 +1132,12 @@ func (numStrKernel *NumberStrKernel) GetIntegerString() string {
 //
 // # Return Values
 //
-//	string
+//	uint64
 //
-//		Returns a formatted text string detailing all the
-//		internal member variable names and their
-//		corresponding values for the current instance of
-//		NumberStrKernel.
-func (numStrKernel *NumberStrKernel) GetInternalParameterDiagnostics() string {
+//		Returns the number of total trailing zeros for
+//		the fractional part of the numeric value represented
+//		by this instance of NumberStrKernel.
+func (numStrKernel *NumberStrKernel) GetFractionalTrailingZerosCount() uint64 {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -986,229 +1147,220 @@ func (numStrKernel *NumberStrKernel) GetInternalParameterDiagnostics() string {
 
 	defer numStrKernel.lock.Unlock()
 
-	var ePrefix *ePref.ErrPrefixDto
-	var err error
+	var totalFracTrailingZeros uint64 = 0
 
-	ePrefix,
-		err = ePref.ErrPrefixDto{}.NewIEmpty(
-		nil,
-		"NumberStrKernel."+
-			"GetInternalParameterDiagnostics()",
-		"")
+	lenFracChars :=
+		uint64(len(numStrKernel.fractionalDigits.CharsArray))
 
-	if err != nil {
-		errOut := fmt.Sprintf("%v\n"+
-			"Error Message:\n"+
-			"%v",
-			"NumberStrKernel."+
-				"GetInternalParameterDiagnostics()",
-			err.Error())
+	if lenFracChars == 0 {
 
-		return errOut
+		return totalFracTrailingZeros
 	}
 
-	strBuilder := strings.Builder{}
-
-	err = new(numberStrKernelNanobot).
-		getParameterTextListing(
-			&strBuilder,
-			false,
-			numStrKernel,
-			ePrefix.XCpy(
-				"numStrKernel"))
-
-	if err != nil {
-		errOut := fmt.Sprintf("%v\n"+
-			"Error Message:\n"+
-			"%v",
-			ePrefix.String(),
-			err.Error())
-
-		return errOut
-	}
+	totalFracTrailingZeros =
+		numStrKernel.fractionalDigits.GetCountTrailingZeros()
 
-	return strBuilder.String()
+	return totalFracTrailingZeros
 }
 
-//	GetIntFracDigitsKernel
+// GetIntegerDigits - Returns an instance of RuneArrayDto
+// containing a deep copy of the integer digits rune array
+// encapsulated by the current instance of NumberStrKernel.
 //
-//	Returns a new instance of NumberStrKernel,
-//	'consolidatedIntegerKernel', containing integer
-//	digits representing a consolidation of the integer
-//	and fractional digits in the current NumberStrKernel
-//	instance.
+// ----------------------------------------------------------------
 //
-//	NOTE: All leading integer zeros are deleted from
-//	the returned instance of 'consolidatedIntegerKernel'.
+// Input Parameters
 //
-//	In addition, this method also returns an int64 value,
-//	(exponent) specifying the location of the radix
-//	point, or decimal point, in the integer digits.
+//	errorPrefix                interface{}
+//	   - This object encapsulates error prefix text which is
+//	     included in all returned error messages. Usually, it
+//	     contains the name of the calling method or methods
+//	     listed as a method or function chain of execution.
 //
-//	The actual numeric value is there for equal to:
-//		consolidatedIntegerKernel x 10^exponent
+//	     If no error prefix information is needed, set this parameter
+//	     to 'nil'.
 //
-// ----------------------------------------------------------------
+//	     This empty interface must be convertible to one of the
+//	     following types:
 //
-// # BE ADVISED
 //
-//	(1) All leading zeros are deleted from the returned
-//		instance of 'consolidatedIntegerKernel'. Any
-//		trailing zeros are NOT deleted.
+//	     1. nil - A nil value is valid and generates an empty
+//	              collection of error prefix and error context
+//	              information.
 //
-//	(2)	The number sign of the returned instance of
-//		'consolidatedIntegerKernel' is ALWAYS positive.
+//	     2. string - A string containing error prefix information.
 //
-// ----------------------------------------------------------------
+//	     3. []string A one-dimensional slice of strings containing
+//	                 error prefix information
 //
-// # Usage
+//	     4. [][2]string A two-dimensional slice of strings containing
+//	                    error prefix and error context information.
 //
-//	Example-1
-//		 Current NumberStrKernel Value:	1234.5678
-//		Returned NumberStrKernel Value:	12345678
-//			 Returned 'exponent' Value: -4
+//	     5. ErrPrefixDto - An instance of ErrPrefixDto. The
+//	                       ErrorPrefixInfo from this object will be
+//	                       copied to 'errPrefDto'.
 //
-//	Example-2
-//		 Current NumberStrKernel Value:	001234.5678
-//		Returned NumberStrKernel Value:	12345678
-//			 Returned 'exponent' Value: -4
-//		NOTE: Leading Integer Zeros are Deleted!
+//	     6. *ErrPrefixDto - A pointer to an instance of ErrPrefixDto.
+//	                        ErrorPrefixInfo from this object will be
+//	                       copied to 'errPrefDto'.
 //
-//	Example-3
-//		 Current NumberStrKernel Value:	1234
-//		Returned NumberStrKernel Value:	1234
-//			 Returned 'exponent' Value: 0
+//	     7. IBasicErrorPrefix - An interface to a method generating
+//	                            a two-dimensional slice of strings
+//	                            containing error prefix and error
+//	                            context information.
 //
-//	Example-4
-//		 Current NumberStrKernel Value:	-1234.5678
-//		Returned NumberStrKernel Value:	-12345678
-//			 Returned 'exponent' Value: -4
+//	     If parameter 'errorPrefix' is NOT convertible to one of
+//	     the valid types listed above, it will be considered
+//	     invalid and trigger the return of an error.
 //
-//	Example-5
-//		 Current NumberStrKernel Value:	-1234
-//		Returned NumberStrKernel Value:	-1234
-//			 Returned 'exponent' Value: 0
+//	     Types ErrPrefixDto and IBasicErrorPrefix are included in
+//	     the 'errpref' software package, "github.com/MikeAustin71/errpref".
 //
-// ----------------------------------------------------------------
+// ------------------------------------------------------------------------
 //
-//	# Input Parameters
+// Return Values
 //
-//	errorPrefix					interface{}
+//		copyOfIntegerDigits        RuneArrayDto
+//	    - If this method completes successfully, this parameter will
+//	      return an instance of RuneArrayDto populated with a deep
+//	      copy of the integer numeric digits contained in the
+//	      current instance of NumberStrKernel.
 //
-//		This object encapsulates error prefix text which
-//		is included in all returned error messages.
-//		Usually, it	contains the name of the calling
-//		method or methods listed as a method or function
-//		chain of execution.
 //
-//		If no error prefix information is needed, set this
-//		parameter to 'nil'.
+//	 err                        error
+//	    - If this method completes successfully and no errors are
+//	      encountered this return value is set to 'nil'. Otherwise,
+//	      if errors are encountered, this return value will contain
+//	      an appropriate error message.
 //
-//		This empty interface must be convertible to one of
-//		the following types:
+//	      If an error message is returned, the text value of input
+//	      parameter 'errorPrefix' will be inserted or prefixed at
+//	      the beginning of the error message.
+func (numStrKernel *NumberStrKernel) GetIntegerDigits(
+	errorPrefix interface{}) (
+	copyOfIntegerDigits RuneArrayDto,
+	err error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"GetIntegerDigits()",
+		"")
+
+	if len(numStrKernel.integerDigits.CharsArray) == 0 {
+		return copyOfIntegerDigits, err
+	}
+
+	copyOfIntegerDigits,
+		err = numStrKernel.integerDigits.CopyOut(
+		ePrefix.XCpy("copyOfIntegerDigits<-numStrKernel.integerDigits"))
+
+	return copyOfIntegerDigits, err
+}
+
+//	GetIntegerLeadingZerosCount
 //
-//		1.	nil
-//				A nil value is valid and generates an
-//				empty collection of error prefix and
-//				error context information.
+//	Returns the count of all integer leading zeros.
 //
-//		2.	string
-//				A string containing error prefix
-//				information.
+//	This method differs significantly from method:
 //
-//		3.	[]string
-//				A one-dimensional slice of strings
-//				containing error prefix information.
+//		NumberStrKernel.GetExcessIntegerLeadingZerosCount()
 //
-//		4.	[][2]string
-//				A two-dimensional slice of strings
-//		   		containing error prefix and error
-//		   		context information.
+// ----------------------------------------------------------------
 //
-//		5.	ErrPrefixDto
-//				An instance of ErrPrefixDto.
-//				Information from this object will
-//				be copied for use in error and
-//				informational messages.
+// # Usage
 //
-//		6.	*ErrPrefixDto
-//				A pointer to an instance of
-//				ErrPrefixDto. Information from
-//				this object will be copied for use
-//				in error and informational messages.
+//	Example-1
+//		Numeric Value:  0001
+//		Count of Total Integer Leading Zeros: 3
 //
-//		7.	IBasicErrorPrefix
-//				An interface to a method
-//				generating a two-dimensional slice
-//				of strings containing error prefix
-//				and error context information.
+//	Example-2
+//		Numeric Value:  000
+//		Count of Total Integer Leading Zeros: 3
 //
-//		If parameter 'errorPrefix' is NOT convertible
-//		to one of the valid types listed above, it will
-//		be considered invalid and trigger the return of
-//		an error.
+//	Example-3
+//		Numeric Value:  050.0032
+//		Count of Total Integer Leading Zeros: 1
 //
-//		Types ErrPrefixDto and IBasicErrorPrefix are
-//		included in the 'errpref' software package:
-//			"github.com/MikeAustin71/errpref".
+// ----------------------------------------------------------------
+//
+//	# Input Parameters
+//
+//	NONE
 //
 // ----------------------------------------------------------------
 //
 // # Return Values
 //
-//	consolidatedIntegerKernel	NumberStrKernel
-//
-//		This method returns an instance of
-//		NumberStrKernel which consolidates the integer
-//		and fractional digits of the current
-//		NumberStrKernel instance to form a single
-//		integer value.
+//	uint64
 //
-//		Example-1:
-//		 	Current NumberStrKernel Value:	1234.5678
-//			Returned NumberStrKernel Value:	12345678
+//		Returns the number of total leading zeros for
+//		the integer part of the numeric value represented
+//		by this instance of NumberStrKernel.
+func (numStrKernel *NumberStrKernel) GetIntegerLeadingZerosCount() uint64 {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var totalIntLeadingZeros uint64 = 0
+
+	lenIntChars :=
+		uint64(len(numStrKernel.integerDigits.CharsArray))
+
+	if lenIntChars == 0 {
+
+		return totalIntLeadingZeros
+	}
+
+	totalIntLeadingZeros =
+		numStrKernel.integerDigits.GetCountLeadingZeros()
+
+	return totalIntLeadingZeros
+}
+
+//	GetIntegerRuneArray
 //
-//		Example-2:
-//		 	Current NumberStrKernel Value:	0001234.5678
-//			Returned NumberStrKernel Value:	12345678
+//	Returns a deep copy of the integer digits rune array
+//	encapsulated by the current instance of
+//	NumberStrKernel.
 //
-//		NOTE: All leading zeros are deleted in the
-//		returned instance of 'consolidatedIntegerKernel'.
+// ----------------------------------------------------------------
 //
-//	exponent					int64
+// Input Parameters
 //
-//		This returned exponent value identifies the
-//		location of the radix point, or decimal point,
-//		within the integer digits returned by parameter,
-//		'consolidatedIntegerKernel'.
+//	NONE
 //
-//		Example-1
-//			 Current NumberStrKernel Value:	1234.5678
-//			Returned NumberStrKernel Value:	12345678
-//				 Returned 'exponent' Value: -4
+// ------------------------------------------------------------------------
 //
-//		Example-2
-//			 Current NumberStrKernel Value:	1234
-//			Returned NumberStrKernel Value:	1234
-//				 Returned 'exponent' Value: 0
+// Return Values
 //
-//	err							error
+//	[]rune
 //
-//		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
+//		This method returns a rune array consisting of
+//		the integer digits portion of the numeric value
+//		represented by the current instance of
+//		NumberStrKernel. This rune array is encapsulated
+//		in the RuneArrayDto member variable identified
+//		as:
 //
-//		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error
-//		message. This returned error message will
-//		incorporate the method chain and text passed by
-//		input parameter, 'errorPrefix'. The 'errorPrefix'
-//		text will be attached to the beginning of the
-//		error message.
-func (numStrKernel *NumberStrKernel) GetIntFracDigitsKernel(
-	errorPrefix interface{}) (
-	consolidatedIntegerKernel NumberStrKernel,
-	exponent int64,
-	err error) {
+//	     	'NumberStrKernel.integerDigits'.
+func (numStrKernel *NumberStrKernel) GetIntegerRuneArray() []rune {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -1218,475 +1370,345 @@ func (numStrKernel *NumberStrKernel) GetIntFracDigitsKernel(
 
 	defer numStrKernel.lock.Unlock()
 
-	var ePrefix *ePref.ErrPrefixDto
+	return numStrKernel.integerDigits.GetRuneArray()
+}
 
-	ePrefix,
-		err = ePref.ErrPrefixDto{}.NewIEmpty(
-		errorPrefix,
-		"NumberStrKernel."+
-			"GetIntFracDigitsKernel()",
-		"")
+//	GetIntegerRuneArrayDto
+//
+//	Returns a deep copy of the integer digits
+//	RuneArrayDto object maintained by the current
+//	instance of NumberStrKernel.
+//
+//	This RuneArrayDto object encapsulates the integer
+//	digits rune array containing the integer portion of
+//	the numeric value represented by the current
+//	NumberStrKernel instance.
+//
+// ----------------------------------------------------------------
+//
+// Input Parameters
+//
+//	NONE
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//	RuneArrayDto
+//
+//		This method returns an instance of RuneArrayDto
+//		consisting of the integer digits portion of the
+//		numeric value represented by the current instance
+//		of NumberStrKernel. This RuneArrayDto member
+//		variable identified as:
+//
+//	     	'NumberStrKernel.integerDigits'
+//
+//		This RuneArrayDto object encapsulates the integer
+//		digits rune array for the current instance of
+//		NumberStrKernel.
+func (numStrKernel *NumberStrKernel) GetIntegerRuneArrayDto(
+	errorPrefix interface{}) (
+	RuneArrayDto,
+	error) {
 
-	if err != nil {
-		return consolidatedIntegerKernel, exponent, err
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
 	}
 
-	var consolidatedRuneArrayDto RuneArrayDto
-
-	runeArrayDto := RuneArrayDto{}
+	numStrKernel.lock.Lock()
 
-	consolidatedRuneArrayDto,
-		err = runeArrayDto.NewRuneArrayDtos(
-		ePrefix.XCpy(
-			"numStrKernel integer+fractional"),
-		numStrKernel.integerDigits,
-		numStrKernel.fractionalDigits)
+	defer numStrKernel.lock.Unlock()
 
-	if err != nil {
-		return consolidatedIntegerKernel, exponent, err
-	}
+	var runeArrayDto RuneArrayDto
+	var err error
 
-	nopRuneArrayDto := runeArrayDto.NewNOP()
+	runeArrayDto,
+		err = numStrKernel.integerDigits.CopyOut(
+		errorPrefix)
 
-	err = new(numberStrKernelNanobot).setWithRuneArrayDto(
-		&consolidatedIntegerKernel,
-		&consolidatedRuneArrayDto,
-		&nopRuneArrayDto,
-		numStrKernel.numberSign,
-		ePrefix)
+	return runeArrayDto, err
+}
 
-	leadingIntZeros :=
-		consolidatedIntegerKernel.GetIntegerLeadingZerosCount()
+// GetIntegerString - Converts the integer rune array contained in
+// the current instance of NumberStrKernel to a string and returns
+// it to the calling function.
+//
+// ----------------------------------------------------------------
+//
+// Input Parameters
+//
+//	NONE
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//	string
+//	   - This method returns a string consisting of the integer
+//	     digits portion of the numeric value represented by the
+//	     current instance of NumberStrKernel. This source of these
+//	     integer digits is identified as internal member variable
+//	     'NumberStrKernel.integerDigits'.
+func (numStrKernel *NumberStrKernel) GetIntegerString() string {
 
-	if leadingIntZeros > 0 {
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
 
-		err = consolidatedIntegerKernel.integerDigits.
-			DeleteLeadingTrailingChars(
-				leadingIntZeros,
-				false,
-				ePrefix.XCpy(
-					"numStrKernel.integerDigits<-"))
+	numStrKernel.lock.Lock()
 
-	}
+	defer numStrKernel.lock.Unlock()
 
-	return consolidatedIntegerKernel, exponent, err
+	return numStrKernel.integerDigits.GetCharacterString()
 }
 
-// GetIntFracDigitsStr
+// GetInternalParameterDiagnostics
 //
-// Returns a string containing all the integer and
-// fractional numeric digits extracted from the current
-// instance of NumberStrKernel. These integer and
-// fractional digits are therefore concatenated and
-// returned as a single string.
+// Returns formatted text output detailing the
+// NumberStrKernel member variable names and their
+// corresponding values contained in the current instance
+// of NumberStrKernel.
 //
-// If the combined integer and fractional digits
-// represent a negative numeric value, a leading or
-// trailing minus sign may be included depending on
-// specifications contained in input parameters,
-// 'includeNegativeNumSign' and 'leadingMinusSign'.
+// If an error is encountered, the error message is
+// included in the string returned by this method.
 //
 // ----------------------------------------------------------------
 //
-// # BE ADVISED
+// # Input Parameters
 //
-//	(1)	Depending on the rounding specifications
-//		contained in input parameters 'roundingType' and
-//		'roundToFractionalDigits', the numeric value
-//		represented by the current instance of
-//		NumberStrKernel will be rounded before being
-//		converted into a concatenated string of integer
-//		and fractional digits.
+//	NONE
 //
-//	(2)	If the combined integer and fractional digits
-//		represent a negative numeric value, a leading or
-//		trailing minus sign may be included in the
-//		returned string depending on specifications
-//		contained in input parameters,
-//		'includeNegativeNumSign' and 'leadingMinusSign'.
+// ----------------------------------------------------------------
 //
-//	(3)	This method will NOT alter, modify or change any
-//		data values contained in the current instance of
+// # Return Values
+//
+//	string
+//
+//		Returns a formatted text string detailing all the
+//		internal member variable names and their
+//		corresponding values for the current instance of
 //		NumberStrKernel.
+func (numStrKernel *NumberStrKernel) GetInternalParameterDiagnostics() string {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		nil,
+		"NumberStrKernel."+
+			"GetInternalParameterDiagnostics()",
+		"")
+
+	if err != nil {
+		errOut := fmt.Sprintf("%v\n"+
+			"Error Message:\n"+
+			"%v",
+			"NumberStrKernel."+
+				"GetInternalParameterDiagnostics()",
+			err.Error())
+
+		return errOut
+	}
+
+	strBuilder := strings.Builder{}
+
+	err = new(numberStrKernelNanobot).
+		getParameterTextListing(
+			&strBuilder,
+			false,
+			numStrKernel,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	if err != nil {
+		errOut := fmt.Sprintf("%v\n"+
+			"Error Message:\n"+
+			"%v",
+			ePrefix.String(),
+			err.Error())
+
+		return errOut
+	}
+
+	return strBuilder.String()
+}
+
+//	GetIntFracDigitsKernel
 //
-// ----------------------------------------------------------------
+//	Returns a new instance of NumberStrKernel,
+//	'consolidatedIntegerKernel', containing integer
+//	digits representing a consolidation of the integer
+//	and fractional digits in the current NumberStrKernel
+//	instance.
 //
-// # Input Parameters
+//	NOTE: All leading integer zeros are deleted from
+//	the returned instance of 'consolidatedIntegerKernel'.
 //
-//	includeNegativeNumSign		bool
+//	In addition, this method also returns an int64 value,
+//	(exponent) specifying the location of the radix
+//	point, or decimal point, in the integer digits.
 //
-//		If this parameter is set to 'true' and the
-//		numeric value of the current NumberStrKernel
-//		instance is negative, a leading or trailing minus
-//		sign ('-') will be included in the returned
-//		string of integer and fractional digits.
+//	The actual numeric value is there for equal to:
+//		consolidatedIntegerKernel x 10^exponent
 //
-//		The positioning of the minus sign as a leading or
-//		tailing minus sign is controlled by input
-//		parameter 'leadingMinusSign'.
+// ----------------------------------------------------------------
 //
-//	leadingMinusSign			bool
+// # BE ADVISED
 //
-//		If the numeric value of the current
-//		NumberStrKernel instance is negative and input
-//		parameter 'includeNegativeNumSign' is set to
-//		'true', 'leadingMinusSign' will control where
-//		the minus sign is included in the returned
-//		string of integer and fractional digits.
+//	(1) All leading zeros are deleted from the returned
+//		instance of 'consolidatedIntegerKernel'. Any
+//		trailing zeros are NOT deleted.
 //
-//		When this parameter is set to 'true', the
-//		minus sign will be prefixed at the beginning
-//		of the returned string.
+//	(2)	The number sign of the returned instance of
+//		'consolidatedIntegerKernel' is ALWAYS positive.
 //
-//		If this parameter is set to 'false', the minus
-//		sign will be appended to the end of the returned
-//		string.
+// ----------------------------------------------------------------
 //
-//		Again, if parameter 'includeNegativeNumSign' is
-//		set to 'false', or if the numeric value is
-//		positive, this parameter will be ignored and have
-//		no effect.
-//
-//	roundingType				NumberRoundingType
-//
-//		The 'roundingType' setting determines whether
-//		the numeric value contained in the current
-//		instance of NumberStrKernel will be rounded
-//		before it is converted into a concatenated
-//		string of integer and fractional digits.
-//
-//		If no rounding operation is required, set this
-//		parameter to NumRoundType.NoRounding().
-//
-//		'roundingType' is used to specify the type of
-//		rounding algorithm that will be applied for the
-//		rounding of fractional digits contained in the
-//		current instance of NumberStrKernel.
-//
-//		If in doubt as to a suitable rounding method,
-//		'HalfAwayFromZero' is recommended.
-//
-//		Possible values are listed as follows:
-//			NumRoundType.None()	- Invalid Value
-//			NumRoundType.NoRounding()
-//			NumRoundType.HalfUpWithNegNums()
-//			NumRoundType.HalfDownWithNegNums()
-//			NumRoundType.HalfAwayFromZero()
-//			NumRoundType.HalfTowardsZero()
-//			NumRoundType.HalfToEven()
-//			NumRoundType.HalfToOdd()
-//			NumRoundType.Randomly()
-//			NumRoundType.Floor()
-//			NumRoundType.Ceiling()
-//			NumRoundType.Truncate()
-//
-//		Definitions:
-//
-//			NoRounding
-//
-//				Signals that no rounding operation will be
-//				performed on fractional digits. The
-//				fractional digits will therefore remain
-//				unchanged.
-//
-//			HalfUpWithNegNums
-//
-//				Half Round Up Including Negative Numbers.
-//				This method is intuitive but may produce
-//				unexpected results when applied to negative
-//				numbers.
-//
-//				'HalfUpWithNegNums' rounds .5 up.
-//
-//					Examples of 'HalfUpWithNegNums'
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8
-//					7.4 rounds down to 7
-//					-7.4 rounds up to -7
-//					-7.5 rounds up to -7
-//					-7.6 rounds down to -8
-//
-//			HalfDownWithNegNums
-//
-//			Half Round Down Including Negative Numbers. This
-//			method is also considered intuitive but may
-//			produce unexpected results when applied to
-//			negative numbers.
-//
-//			'HalfDownWithNegNums' rounds .5 down.
+// # Usage
 //
-//				Examples of HalfDownWithNegNums
+//	Example-1
+//		 Current NumberStrKernel Value:	1234.5678
+//		Returned NumberStrKernel Value:	12345678
+//			 Returned 'exponent' Value: -4
 //
-//				7.6 rounds up to 8
-//				7.5 rounds down to 7
-//				7.4 rounds down to 7
-//				-7.4 rounds up to -7
-//				-7.5 rounds down to -8
-//				-7.6 rounds down to -8
+//	Example-2
+//		 Current NumberStrKernel Value:	001234.5678
+//		Returned NumberStrKernel Value:	12345678
+//			 Returned 'exponent' Value: -4
+//		NOTE: Leading Integer Zeros are Deleted!
 //
-//			HalfAwayFromZero
+//	Example-3
+//		 Current NumberStrKernel Value:	1234
+//		Returned NumberStrKernel Value:	1234
+//			 Returned 'exponent' Value: 0
 //
-//				The 'HalfAwayFromZero' method rounds .5 further
-//				away from zero.	It provides clear and consistent
-//				behavior when dealing with negative numbers.
+//	Example-4
+//		 Current NumberStrKernel Value:	-1234.5678
+//		Returned NumberStrKernel Value:	-12345678
+//			 Returned 'exponent' Value: -4
 //
-//					Examples of HalfAwayFromZero
+//	Example-5
+//		 Current NumberStrKernel Value:	-1234
+//		Returned NumberStrKernel Value:	-1234
+//			 Returned 'exponent' Value: 0
 //
-//					7.6 rounds away to 8
-//					7.5 rounds away to 8
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds away to -8
-//					-7.6 rounds away to -8
+// ----------------------------------------------------------------
 //
-//			HalfTowardsZero
+//	# Input Parameters
 //
-//				Round Half Towards Zero. 'HalfTowardsZero' rounds
-//				0.5	closer to zero. It provides clear and
-//				consistent behavior	when dealing with negative
-//				numbers.
+//	errorPrefix					interface{}
 //
-//					Examples of HalfTowardsZero
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it	contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//					7.6 rounds away to 8
-//					7.5 rounds to 7
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds to -7
-//					-7.6 rounds away to -8
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//			HalfToEven
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//				Round Half To Even Numbers. 'HalfToEven' is
-//				also called	Banker's Rounding. This method
-//				rounds 0.5 to the nearest even digit.
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//					Examples of HalfToEven
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//					7.5 rounds up to 8 (because 8 is an even
-//					number)	but 6.5 rounds down to 6 (because
-//					6 is an even number)
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//					HalfToEven only applies to 0.5. Other
-//					numbers (not ending	in 0.5) round to
-//					nearest as usual, so:
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8 (because 8 is an even number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds down to 6 (because 6 is an even number)
-//					6.4 rounds down to 6
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//			HalfToOdd
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//				Round Half to Odd Numbers. Similar to 'HalfToEven',
-//				but in this case 'HalfToOdd' rounds 0.5 towards odd
-//				numbers.
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//					Examples of HalfToOdd
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
 //
-//					HalfToOdd only applies to 0.5. Other numbers
-//					(not ending	in 0.5) round to nearest as usual.
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
-//					7.5 rounds down to 7 (because 7 is an odd number)
+// ----------------------------------------------------------------
 //
-//					6.5 rounds up to 7 (because 7 is an odd number)
+// # Return Values
 //
-//					7.6 rounds up to 8
-//					7.5 rounds down to 7 (because 7 is an odd number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds up to 7 (because 7 is an odd number)
-//					6.4 rounds down to 6
+//	consolidatedIntegerKernel	NumberStrKernel
 //
-//			Randomly
+//		This method returns an instance of
+//		NumberStrKernel which consolidates the integer
+//		and fractional digits of the current
+//		NumberStrKernel instance to form a single
+//		integer value.
 //
-//				Round Half Randomly. Uses a Random Number Generator
-//				to choose between rounding 0.5 up or down.
+//		Example-1:
+//		 	Current NumberStrKernel Value:	1234.5678
+//			Returned NumberStrKernel Value:	12345678
 //
-//				All numbers other than 0.5 round to the nearest as
-//				usual.
+//		Example-2:
+//		 	Current NumberStrKernel Value:	0001234.5678
+//			Returned NumberStrKernel Value:	12345678
 //
-//			Floor
+//		NOTE: All leading zeros are deleted in the
+//		returned instance of 'consolidatedIntegerKernel'.
 //
-//				Yields the nearest integer down. Floor does not apply
-//				any	special treatment to 0.5.
+//	exponent					int64
 //
-//				Floor Function: The greatest integer that is less than
-//				or equal to x
+//		This returned exponent value identifies the
+//		location of the radix point, or decimal point,
+//		within the integer digits returned by parameter,
+//		'consolidatedIntegerKernel'.
 //
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//		Example-1
+//			 Current NumberStrKernel Value:	1234.5678
+//			Returned NumberStrKernel Value:	12345678
+//				 Returned 'exponent' Value: -4
 //
-//				In mathematics and computer science, the floor function
-//				is the function that takes as input a real number x,
-//				and gives as output the greatest integer less than or
-//				equal to x,	denoted floor(x) or ⌊x⌋.
+//		Example-2
+//			 Current NumberStrKernel Value:	1234
+//			Returned NumberStrKernel Value:	1234
+//				 Returned 'exponent' Value: 0
 //
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//	err							error
 //
-//				Examples of Floor
-//
-//					Number     Floor
-//					 2           2
-//					 2.4         2
-//					 2.9         2
-//					-2.5        -3
-//					-2.7        -3
-//					-2          -2
-//
-//			Ceiling
-//
-//				Yields the nearest integer up. Ceiling does not
-//				apply any special treatment to 0.5.
-//
-//				Ceiling Function: The least integer that is
-//				greater than or	equal to x.
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
-//
-//				The ceiling function maps x to the least integer
-//				greater than or equal to x, denoted ceil(x) or
-//				⌈x⌉.[1]
-//
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
-//
-//					Examples of Ceiling
-//
-//						Number    Ceiling
-//						 2           2
-//						 2.4         3
-//						 2.9         3
-//						-2.5        -2
-//						-2.7        -2
-//						-2          -2
-//
-//			Truncate
-//
-//				Apply NO Rounding whatsoever. The Round From Digit
-//				is dropped or deleted. The Round To Digit is NEVER
-//				changed.
-//
-//				Examples of Truncate
-//
-//					Example-1
-//					Number: 23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:   23.14 - The Round From Digit
-//					is dropped.
-//
-//					Example-2
-//					Number: -23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:  -23.14 - The Round From Digit
-//					is dropped.
-//
-//	roundToFractionalDigits		int
-//
-//		When set to a positive integer value, this
-//		parameter controls the number of digits to the
-//		right of the radix point or decimal separator
-//		(a.k.a. decimal point). This controls the number
-//		of fractional digits remaining after completion
-//		of the number rounding operation.
-//
-//		If input parameter 'roundingType' is set to
-//		NumRoundType.NoRounding(),
-//		'roundToFractionalDigits' is ignored and no
-//		rounding operation is performed.
-//
-//	errorPrefix					interface{}
-//
-//		This object encapsulates error prefix text which
-//		is included in all returned error messages.
-//		Usually, it contains the name of the calling
-//		method or methods listed as a method or function
-//		chain of execution.
-//
-//		If no error prefix information is needed, set this
-//		parameter to 'nil'.
-//
-//		This empty interface must be convertible to one of
-//		the following types:
-//
-//		1.	nil
-//				A nil value is valid and generates an
-//				empty collection of error prefix and
-//				error context information.
-//
-//		2.	string
-//				A string containing error prefix
-//				information.
-//
-//		3.	[]string
-//				A one-dimensional slice of strings
-//				containing error prefix information.
-//
-//		4.	[][2]string
-//				A two-dimensional slice of strings
-//		   		containing error prefix and error
-//		   		context information.
-//
-//		5.	ErrPrefixDto
-//				An instance of ErrPrefixDto.
-//				Information from this object will
-//				be copied for use in error and
-//				informational messages.
-//
-//		6.	*ErrPrefixDto
-//				A pointer to an instance of
-//				ErrPrefixDto. Information from
-//				this object will be copied for use
-//				in error and informational messages.
-//
-//		7.	IBasicErrorPrefix
-//				An interface to a method
-//				generating a two-dimensional slice
-//				of strings containing error prefix
-//				and error context information.
-//
-//		If parameter 'errorPrefix' is NOT convertible
-//		to one of the valid types listed above, it will
-//		be considered invalid and trigger the return of
-//		an error.
-//
-//		Types ErrPrefixDto and IBasicErrorPrefix are
-//		included in the 'errpref' software package:
-//			"github.com/MikeAustin71/errpref".
-//
-// ----------------------------------------------------------------
-//
-// # Return Values
-//
-//	string
-//
-//		If this method completes successfully, a string
-//		will be returned containing the concatenated
-//		integer and fractional digits extracted from the
-//		current instance of NumberStrKernel.
-//
-//		If the current instance of NumberStrKernel
-//		represents a negative numeric value, a leading
-//		or trailing minus sign may be included in the
-//		returned string depending on the settings for
-//		input parameters, 'includeNegativeNumSign' and
-//		'roundToFractionalDigits'.
-//
-//	error
-//
-//		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
 //
 //		If errors are encountered during processing, the
 //		returned error Type will encapsulate an error
@@ -1695,14 +1717,11 @@ func (numStrKernel *NumberStrKernel) GetIntFracDigitsKernel(
 //		input parameter, 'errorPrefix'. The 'errorPrefix'
 //		text will be attached to the beginning of the
 //		error message.
-func (numStrKernel *NumberStrKernel) GetIntFracDigitsStr(
-	includeNegativeNumSign bool,
-	leadingMinusSign bool,
-	roundingType NumberRoundingType,
-	roundToFractionalDigits int,
+func (numStrKernel *NumberStrKernel) GetIntFracDigitsKernel(
 	errorPrefix interface{}) (
-	string,
-	error) {
+	consolidatedIntegerKernel NumberStrKernel,
+	exponent int64,
+	err error) {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -1714,442 +1733,397 @@ func (numStrKernel *NumberStrKernel) GetIntFracDigitsStr(
 
 	var ePrefix *ePref.ErrPrefixDto
 
-	var err error
-
-	var concatenatedIntFracDigits string
-
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"GetIntFracDigitsStr()",
+			"GetIntFracDigitsKernel()",
 		"")
 
 	if err != nil {
-		return concatenatedIntFracDigits, err
+		return consolidatedIntegerKernel, exponent, err
 	}
 
-	var allIntFracDigits RuneArrayDto
+	var consolidatedRuneArrayDto RuneArrayDto
 
-	allIntFracDigits,
-		err = new(numberStrKernelMolecule).getAllIntFracDigits(
-		numStrKernel,
-		includeNegativeNumSign,
-		leadingMinusSign,
-		roundingType,
-		roundToFractionalDigits,
+	runeArrayDto := RuneArrayDto{}
+
+	consolidatedRuneArrayDto,
+		err = runeArrayDto.NewRuneArrayDtos(
 		ePrefix.XCpy(
-			""))
+			"numStrKernel integer+fractional"),
+		numStrKernel.integerDigits,
+		numStrKernel.fractionalDigits)
 
-	return allIntFracDigits.GetCharacterString(), err
+	if err != nil {
+		return consolidatedIntegerKernel, exponent, err
+	}
+
+	nopRuneArrayDto := runeArrayDto.NewNOP()
+
+	err = new(numberStrKernelNanobot).setWithRuneArrayDto(
+		&consolidatedIntegerKernel,
+		&consolidatedRuneArrayDto,
+		&nopRuneArrayDto,
+		numStrKernel.numberSign,
+		ePrefix)
+
+	leadingIntZeros :=
+		consolidatedIntegerKernel.GetIntegerLeadingZerosCount()
+
+	if leadingIntZeros > 0 {
+
+		err = consolidatedIntegerKernel.integerDigits.
+			DeleteLeadingTrailingChars(
+				leadingIntZeros,
+				false,
+				ePrefix.XCpy(
+					"numStrKernel.integerDigits<-"))
+
+	}
+
+	return consolidatedIntegerKernel, exponent, err
 }
 
-//	GetIsNonZeroValue
-//
-//	Returns a boolean value signaling whether the
-//	numeric value represented by the current instance
-//	of NumberStrKernel is a Non-Zero value.
-//
-// ----------------------------------------------------------------
+// GetIntFracDigitsStr
 //
-// # Input Parameters
+// Returns a string containing all the integer and
+// fractional numeric digits extracted from the current
+// instance of NumberStrKernel. These integer and
+// fractional digits are therefore concatenated and
+// returned as a single string.
 //
-//	NONE
+// If the combined integer and fractional digits
+// represent a negative numeric value, a leading or
+// trailing minus sign may be included depending on
+// specifications contained in input parameters,
+// 'includeNegativeNumSign' and 'leadingMinusSign'.
 //
 // ----------------------------------------------------------------
 //
-// # Return Values
+// # BE ADVISED
 //
-//	isNonZeroValue				bool
+//	(1)	Depending on the rounding specifications
+//		contained in input parameters 'roundingType' and
+//		'roundToFractionalDigits', the numeric value
+//		represented by the current instance of
+//		NumberStrKernel will be rounded before being
+//		converted into a concatenated string of integer
+//		and fractional digits.
 //
-//		If this boolean value is set to false it signals
-//		that the numeric value of the current
-//		NumberStrKernel instance is equal to zero.
-//
-//		If this boolean value is set to 'true' it signals
-//		that the numeric value of the current
-//		NumberStrKernel instance is greater than or less
-//		than zero.
-func (numStrKernel *NumberStrKernel) GetIsNonZeroValue() (
-	isNonZeroValue bool) {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	isNonZeroValue,
-		_ = new(numberStrKernelElectron).getSetIsNonZeroValue(
-		numStrKernel,
-		nil)
-
-	return isNonZeroValue
-}
-
-// GetNumberOfFractionalDigits
+//	(2)	If the combined integer and fractional digits
+//		represent a negative numeric value, a leading or
+//		trailing minus sign may be included in the
+//		returned string depending on specifications
+//		contained in input parameters,
+//		'includeNegativeNumSign' and 'leadingMinusSign'.
 //
-// Returns the number of fractional digits in the
-// current instance of NumberStrKernel.
-func (numStrKernel *NumberStrKernel) GetNumberOfFractionalDigits() int {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	return len(numStrKernel.fractionalDigits.CharsArray)
-}
-
-// GetNumberOfIntegerDigits
+//	(3)	This method will NOT alter, modify or change any
+//		data values contained in the current instance of
+//		NumberStrKernel.
 //
-// Returns the number of integer digits in the current
-// instance of NumberStrKernel.
-func (numStrKernel *NumberStrKernel) GetNumberOfIntegerDigits() int {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	return len(numStrKernel.integerDigits.CharsArray)
-}
-
-//	GetNumberOfNumericDigits
+// ----------------------------------------------------------------
 //
-//	Returns the number of numeric digits contained in
-//	the current instance of NumberStrKernel.
+// # Input Parameters
 //
-//	The number of numeric digits is computed by adding
-//	the number of integer digits plus the number of
-//	fractional digits contained in the current instance
-//	of NumberStrKernel.
+//	includeNegativeNumSign		bool
 //
-// ----------------------------------------------------------------
+//		If this parameter is set to 'true' and the
+//		numeric value of the current NumberStrKernel
+//		instance is negative, a leading or trailing minus
+//		sign ('-') will be included in the returned
+//		string of integer and fractional digits.
 //
-// # Input Parameters
+//		The positioning of the minus sign as a leading or
+//		tailing minus sign is controlled by input
+//		parameter 'leadingMinusSign'.
 //
-//	NONE
+//	leadingMinusSign			bool
 //
-// ----------------------------------------------------------------
+//		If the numeric value of the current
+//		NumberStrKernel instance is negative and input
+//		parameter 'includeNegativeNumSign' is set to
+//		'true', 'leadingMinusSign' will control where
+//		the minus sign is included in the returned
+//		string of integer and fractional digits.
 //
-// # Return Values
+//		When this parameter is set to 'true', the
+//		minus sign will be prefixed at the beginning
+//		of the returned string.
 //
-//	int
+//		If this parameter is set to 'false', the minus
+//		sign will be appended to the end of the returned
+//		string.
 //
-//		This method returns the number of integer digits
-//		plus the number of fractional digits currently
-//		residing in the current instance of
-//		NumberStrKernel.
-func (numStrKernel *NumberStrKernel) GetNumberOfNumericDigits() int {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	return numStrKernel.integerDigits.GetRuneArrayLength() +
-		numStrKernel.fractionalDigits.GetRuneArrayLength()
-
-}
-
-// GetNumberSign - Returns the Number Sign associated with the
-// current NumberStrKernel instance as an enumeration value of
-// type NumericSignValueType.
+//		Again, if parameter 'includeNegativeNumSign' is
+//		set to 'false', or if the numeric value is
+//		positive, this parameter will be ignored and have
+//		no effect.
 //
-// If the current number sign value is invalid, an error will be
-// returned.
+//	roundingType				NumberRoundingType
 //
-// Valid return values for Number Sign are:
+//		The 'roundingType' setting determines whether
+//		the numeric value contained in the current
+//		instance of NumberStrKernel will be rounded
+//		before it is converted into a concatenated
+//		string of integer and fractional digits.
 //
-//	NumSignVal.Negative()
-//	NumSignVal.Zero()
-//	NumSignVal.Positive()
+//		If no rounding operation is required, set this
+//		parameter to NumRoundType.NoRounding().
 //
-// ----------------------------------------------------------------
+//		'roundingType' is used to specify the type of
+//		rounding algorithm that will be applied for the
+//		rounding of fractional digits contained in the
+//		current instance of NumberStrKernel.
 //
-// Input Parameters
+//		If in doubt as to a suitable rounding method,
+//		'HalfAwayFromZero' is recommended.
 //
-//	errorPrefix                interface{}
-//	   - This object encapsulates error prefix text which is
-//	     included in all returned error messages. Usually, it
-//	     contains the name of the calling method or methods
-//	     listed as a method or function chain of execution.
+//		Possible values are listed as follows:
+//			NumRoundType.None()	- Invalid Value
+//			NumRoundType.NoRounding()
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
 //
-//	     If no error prefix information is needed, set this parameter
-//	     to 'nil'.
+//		Definitions:
 //
-//	     This empty interface must be convertible to one of the
-//	     following types:
+//			NoRounding
 //
+//				Signals that no rounding operation will be
+//				performed on fractional digits. The
+//				fractional digits will therefore remain
+//				unchanged.
 //
-//	     1. nil - A nil value is valid and generates an empty
-//	              collection of error prefix and error context
-//	              information.
+//			HalfUpWithNegNums
 //
-//	     2. string - A string containing error prefix information.
+//				Half Round Up Including Negative Numbers.
+//				This method is intuitive but may produce
+//				unexpected results when applied to negative
+//				numbers.
 //
-//	     3. []string A one-dimensional slice of strings containing
-//	                 error prefix information
+//				'HalfUpWithNegNums' rounds .5 up.
 //
-//	     4. [][2]string A two-dimensional slice of strings containing
-//	                    error prefix and error context information.
+//					Examples of 'HalfUpWithNegNums'
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8
+//					7.4 rounds down to 7
+//					-7.4 rounds up to -7
+//					-7.5 rounds up to -7
+//					-7.6 rounds down to -8
 //
-//	     5. ErrPrefixDto - An instance of ErrPrefixDto. The
-//	                       ErrorPrefixInfo from this object will be
-//	                       copied to 'errPrefDto'.
+//			HalfDownWithNegNums
 //
-//	     6. *ErrPrefixDto - A pointer to an instance of ErrPrefixDto.
-//	                        ErrorPrefixInfo from this object will be
-//	                       copied to 'errPrefDto'.
+//			Half Round Down Including Negative Numbers. This
+//			method is also considered intuitive but may
+//			produce unexpected results when applied to
+//			negative numbers.
 //
-//	     7. IBasicErrorPrefix - An interface to a method generating
-//	                            a two-dimensional slice of strings
-//	                            containing error prefix and error
-//	                            context information.
+//			'HalfDownWithNegNums' rounds .5 down.
 //
-//	     If parameter 'errorPrefix' is NOT convertible to one of
-//	     the valid types listed above, it will be considered
-//	     invalid and trigger the return of an error.
+//				Examples of HalfDownWithNegNums
 //
-//	     Types ErrPrefixDto and IBasicErrorPrefix are included in
-//	     the 'errpref' software package, "github.com/MikeAustin71/errpref".
+//				7.6 rounds up to 8
+//				7.5 rounds down to 7
+//				7.4 rounds down to 7
+//				-7.4 rounds up to -7
+//				-7.5 rounds down to -8
+//				-7.6 rounds down to -8
 //
-// ------------------------------------------------------------------------
+//			HalfAwayFromZero
 //
-// Return Values
+//				The 'HalfAwayFromZero' method rounds .5 further
+//				away from zero.	It provides clear and consistent
+//				behavior when dealing with negative numbers.
 //
-//	NumericSignValueType
-//	   - If this method completes successfully, this parameter
-//	     will return an enumeration value of type
-//	     NumericSignValueType specifying the number sign associated
-//	     with the numeric value represented by the current instance
-//	     of NumberStrKernel. Possible valid return values are
-//	     listed as follows:
-//	       NumSignVal.Negative()
-//	       NumSignVal.Zero()
-//	       NumSignVal.Positive()
+//					Examples of HalfAwayFromZero
 //
+//					7.6 rounds away to 8
+//					7.5 rounds away to 8
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds away to -8
+//					-7.6 rounds away to -8
 //
-//	error
-//	   - If this method completes successfully and no errors are
-//	     encountered this return value is set to 'nil'. Otherwise,
-//	     if errors are encountered, this return value will contain
-//	     an appropriate error message.
+//			HalfTowardsZero
 //
-//	     If an error occurs, the text value of input parameter
-//	     'errorPrefix' will be inserted or prefixed at the
-//	     beginning of the error message.
-func (numStrKernel *NumberStrKernel) GetNumberSign(
-	errorPrefix interface{}) (
-	NumericSignValueType,
-	error) {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	var ePrefix *ePref.ErrPrefixDto
-	var err error
-
-	ePrefix,
-		err = ePref.ErrPrefixDto{}.NewIEmpty(
-		errorPrefix,
-		"NumberStrKernel."+
-			"GetNumberSign()",
-		"")
-
-	if err != nil {
-		return NumericSignValueType(-2), err
-	}
-
-	if !numStrKernel.numberSign.XIsValid() {
-
-		err = fmt.Errorf("%v\n"+
-			"Error: The current instance of 'NumberStrKernel' is invalid\n"+
-			"because member variable Number Sign Value contains an invalid value.\n"+
-			"Number Sign should be set to minus one (-1), zero (0) or plus one (1).\n"+
-			"The current value of Number Sign is '%v'\n",
-			ePrefix.String(),
-			numStrKernel.numberSign.XArithmeticValue())
-
-		return NumericSignValueType(-2), err
-	}
-
-	return numStrKernel.numberSign, err
-}
-
-//	GetNumberSignAsInt
+//				Round Half Towards Zero. 'HalfTowardsZero' rounds
+//				0.5	closer to zero. It provides clear and
+//				consistent behavior	when dealing with negative
+//				numbers.
 //
-//	Returns the Number Sign associated with the
-//	current NumberStrKernel instance as an integer
-//	value.
+//					Examples of HalfTowardsZero
 //
-//	If the current number sign value is invalid,
-//	an error will be returned.
+//					7.6 rounds away to 8
+//					7.5 rounds to 7
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds to -7
+//					-7.6 rounds away to -8
 //
-//	Valid return values for Number Sign are:
+//			HalfToEven
 //
-//		-1 Minus One for negative values
-//		 0 Zero for value of zero
-//		 1 Plus One (+1) for positive values
+//				Round Half To Even Numbers. 'HalfToEven' is
+//				also called	Banker's Rounding. This method
+//				rounds 0.5 to the nearest even digit.
 //
-// ----------------------------------------------------------------
+//					Examples of HalfToEven
 //
-// # Input Parameters
+//					7.5 rounds up to 8 (because 8 is an even
+//					number)	but 6.5 rounds down to 6 (because
+//					6 is an even number)
 //
-//	 errorPrefix                interface{}
+//					HalfToEven only applies to 0.5. Other
+//					numbers (not ending	in 0.5) round to
+//					nearest as usual, so:
 //
-//		This object encapsulates error prefix text which is
-//		included in all returned error messages. Usually, it
-//		contains the name of the calling method or methods
-//		listed as a method or function chain of execution.
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8 (because 8 is an even number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds down to 6 (because 6 is an even number)
+//					6.4 rounds down to 6
 //
-//		If no error prefix information is needed, set this
-//		parameter to 'nil'.
+//			HalfToOdd
 //
-//		This empty interface must be convertible to one of
-//		the following types:
+//				Round Half to Odd Numbers. Similar to 'HalfToEven',
+//				but in this case 'HalfToOdd' rounds 0.5 towards odd
+//				numbers.
 //
-//		1. nil - A nil value is valid and generates an empty
-//		   collection of error prefix and error context
-//		   information.
+//					Examples of HalfToOdd
 //
-//		2. string - A string containing error prefix
-//			information.
+//					HalfToOdd only applies to 0.5. Other numbers
+//					(not ending	in 0.5) round to nearest as usual.
 //
-//		3. []string A one-dimensional slice of strings
-//			containing error prefix information.
+//					7.5 rounds down to 7 (because 7 is an odd number)
 //
-//		4. [][2]string A two-dimensional slice of strings
-//		   containing error prefix and error context
-//		   information.
+//					6.5 rounds up to 7 (because 7 is an odd number)
 //
-//		5. ErrPrefixDto - An instance of ErrPrefixDto.
-//			Information from this object will be copied for use
-//			in error and informational messages.
+//					7.6 rounds up to 8
+//					7.5 rounds down to 7 (because 7 is an odd number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds up to 7 (because 7 is an odd number)
+//					6.4 rounds down to 6
 //
-//		6. *ErrPrefixDto - A pointer to an instance of
-//			ErrPrefixDto. Information from this object will be
-//			copied for use in error and informational messages.
+//			Randomly
 //
-//		7. IBasicErrorPrefix - An interface to a method
-//			generating a two-dimensional slice of strings
-//			containing error prefix and error context
-//			information.
+//				Round Half Randomly. Uses a Random Number Generator
+//				to choose between rounding 0.5 up or down.
 //
-//		If parameter 'errorPrefix' is NOT convertible to one
-//		of the valid types listed above, it will be
-//		considered invalid and trigger the return of an
-//		error.
+//				All numbers other than 0.5 round to the nearest as
+//				usual.
 //
-//		Types ErrPrefixDto and IBasicErrorPrefix are included
-//		in the 'errpref' software package,
-//		"github.com/MikeAustin71/errpref".
+//			Floor
 //
-// ----------------------------------------------------------------
+//				Yields the nearest integer down. Floor does not apply
+//				any	special treatment to 0.5.
 //
-// # Return Values
+//				Floor Function: The greatest integer that is less than
+//				or equal to x
 //
-//	int
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
 //
-//		If this method completes successfully, this parameter will
-//		return an integer value specifying the number sign
-//		associated with the numeric value represented by the
-//		current instance of NumberStrKernel. Possible valid return
-//		values are listed as follows:
+//				In mathematics and computer science, the floor function
+//				is the function that takes as input a real number x,
+//				and gives as output the greatest integer less than or
+//				equal to x,	denoted floor(x) or ⌊x⌋.
 //
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
 //
-//			-1 Minus One for negative values
-//			 0 Zero for value of zero
-//			 1 Plus One (+1) for positive values
+//				Examples of Floor
 //
+//					Number     Floor
+//					 2           2
+//					 2.4         2
+//					 2.9         2
+//					-2.5        -3
+//					-2.7        -3
+//					-2          -2
 //
-//	error
+//			Ceiling
 //
-//		If this method completes successfully, the returned
-//		error Type is set equal to 'nil'.
+//				Yields the nearest integer up. Ceiling does not
+//				apply any special treatment to 0.5.
 //
-//		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error message.
-//	 	This returned error message will incorporate the method
-//	 	chain and text passed by input parameter, 'errorPrefix'.
-//	 	The 'errorPrefix' text will be attached to the beginning
-//	 	of the error message.
-func (numStrKernel *NumberStrKernel) GetNumberSignAsInt(
-	errorPrefix interface{}) (
-	int,
-	error) {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	var ePrefix *ePref.ErrPrefixDto
-	var err error
-
-	ePrefix,
-		err = ePref.ErrPrefixDto{}.NewIEmpty(
-		errorPrefix,
-		"NumberStrKernel."+
-			"GetNumberSignAsInt()",
-		"")
-
-	if err != nil {
-		return -99, err
-	}
-
-	if !numStrKernel.numberSign.XIsValid() {
-
-		err = fmt.Errorf("%v\n"+
-			"Error: The current instance of 'NumberStrKernel' is invalid\n"+
-			"because member variable Number Sign Value contains an invalid value.\n"+
-			"Number Sign should be set to minus one (-1), zero (0) or plus one (1).\n"+
-			"The current value of Number Sign is '%v'\n",
-			ePrefix.String(),
-			numStrKernel.numberSign.XArithmeticValue())
-
-		return -99, err
-	}
-
-	return numStrKernel.numberSign.XArithmeticValue(), err
-}
-
-//	GetNumericValueStats
+//				Ceiling Function: The least integer that is
+//				greater than or	equal to x.
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
 //
-//	Returns a type of NumberStrStatsDto containing
-//	statistics on the nature of the numeric value
-//	encapsulated by the current instance of
-//	NumberStrKernel.
+//				The ceiling function maps x to the least integer
+//				greater than or equal to x, denoted ceil(x) or
+//				⌈x⌉.[1]
 //
-// ----------------------------------------------------------------
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
 //
-//	# Input Parameters
+//					Examples of Ceiling
 //
-//	errorPrefix					interface{}
+//						Number    Ceiling
+//						 2           2
+//						 2.4         3
+//						 2.9         3
+//						-2.5        -2
+//						-2.7        -2
+//						-2          -2
 //
-//		This object encapsulates error prefix text which
-//		is included in all returned error messages.
-//		Usually, it	contains the name of the calling
+//			Truncate
+//
+//				Apply NO Rounding whatsoever. The Round From Digit
+//				is dropped or deleted. The Round To Digit is NEVER
+//				changed.
+//
+//				Examples of Truncate
+//
+//					Example-1
+//					Number: 23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:   23.14 - The Round From Digit
+//					is dropped.
+//
+//					Example-2
+//					Number: -23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:  -23.14 - The Round From Digit
+//					is dropped.
+//
+//	roundToFractionalDigits		int
+//
+//		When set to a positive integer value, this
+//		parameter controls the number of digits to the
+//		right of the radix point or decimal separator
+//		(a.k.a. decimal point). This controls the number
+//		of fractional digits remaining after completion
+//		of the number rounding operation.
+//
+//		If input parameter 'roundingType' is set to
+//		NumRoundType.NoRounding(),
+//		'roundToFractionalDigits' is ignored and no
+//		rounding operation is performed.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
 //		method or methods listed as a method or function
 //		chain of execution.
 //
@@ -2208,68 +2182,19 @@ func (numStrKernel *NumberStrKernel) GetNumberSignAsInt(
 //
 // # Return Values
 //
-//	numStrStatsDto				NumberStrStatsDto
-//
-//		This data transfer object will return key
-//		statistics on the numeric value encapsulated
-//		by the current instance of NumberStrKernel.
-//
-//		type NumberStrStatsDto struct {
-//
-//		NumOfIntegerDigits					uint64
-//
-//			The total number of integer digits to the
-//			left of the radix point or, decimal point, in
-//			the subject numeric value.
-//
-//		NumOfSignificantIntegerDigits		uint64
-//
-//			The number of nonzero integer digits to the
-//			left of the radix point or, decimal point, in
-//			the subject numeric value.
-//
-//		NumOfFractionalDigits				uint64
-//
-//			The total number of fractional digits to the
-//			right of the radix point or, decimal point,
-//			in the subject numeric value.
-//
-//		NumOfSignificantFractionalDigits	uint64
-//
-//			The number of nonzero fractional digits to
-//			the right of the radix point or, decimal
-//			point, in the subject numeric value.
-//
-//		NumberValueType 					NumericValueType
-//
-//			This enumeration value specifies whether the
-//			subject numeric value is classified either as
-//			an integer or a floating point number.
-//
-//			Possible enumeration values are listed as
-//			follows:
-//				NumValType.None()
-//				NumValType.FloatingPoint()
-//				NumValType.Integer()
-//
-//		NumberSign							NumericSignValueType
-//
-//			An enumeration specifying the number sign
-//			associated with the numeric value. Possible
-//			values are listed as follows:
-//				NumSignVal.None()		= Invalid Value
-//				NumSignVal.Negative()	= -1
-//				NumSignVal.Zero()		=  0
-//				NumSignVal.Positive()	=  1
-//
-//		IsZeroValue							bool
+//	string
 //
-//			If 'true', the subject numeric value is equal
-//			to zero ('0').
+//		If this method completes successfully, a string
+//		will be returned containing the concatenated
+//		integer and fractional digits extracted from the
+//		current instance of NumberStrKernel.
 //
-//			If 'false', the subject numeric value is
-//			greater than or less than zero ('0').
-//		}
+//		If the current instance of NumberStrKernel
+//		represents a negative numeric value, a leading
+//		or trailing minus sign may be included in the
+//		returned string depending on the settings for
+//		input parameters, 'includeNegativeNumSign' and
+//		'roundToFractionalDigits'.
 //
 //	error
 //
@@ -2283,9 +2208,13 @@ func (numStrKernel *NumberStrKernel) GetNumberSignAsInt(
 //		input parameter, 'errorPrefix'. The 'errorPrefix'
 //		text will be attached to the beginning of the
 //		error message.
-func (numStrKernel *NumberStrKernel) GetNumericValueStats(
+func (numStrKernel *NumberStrKernel) GetIntFracDigitsStr(
+	includeNegativeNumSign bool,
+	leadingMinusSign bool,
+	roundingType NumberRoundingType,
+	roundToFractionalDigits int,
 	errorPrefix interface{}) (
-	NumberStrStatsDto,
+	string,
 	error) {
 
 	if numStrKernel.lock == nil {
@@ -2297,29 +2226,42 @@ func (numStrKernel *NumberStrKernel) GetNumericValueStats(
 	defer numStrKernel.lock.Unlock()
 
 	var ePrefix *ePref.ErrPrefixDto
+
 	var err error
 
+	var concatenatedIntFracDigits string
+
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"GetNumericValueStats()",
+			"GetIntFracDigitsStr()",
 		"")
 
 	if err != nil {
-		return NumberStrStatsDto{}, err
+		return concatenatedIntFracDigits, err
 	}
 
-	return new(numberStrKernelAtom).
-		calcNumStrKernelStats(
-			numStrKernel,
-			ePrefix.XCpy("numStrKernel"))
+	var allIntFracDigits RuneArrayDto
+
+	allIntFracDigits,
+		err = new(numberStrKernelMolecule).getAllIntFracDigits(
+		numStrKernel,
+		includeNegativeNumSign,
+		leadingMinusSign,
+		roundingType,
+		roundToFractionalDigits,
+		ePrefix.XCpy(
+			""))
+
+	return allIntFracDigits.GetCharacterString(), err
 }
 
-//	GetNumericValueType
+//	GetIsNonZeroValue
 //
-//	Returns the Numeric Value Type associated with
-//	the current instance of NumberStrKernel.
+//	Returns a boolean value signaling whether the
+//	numeric value represented by the current instance
+//	of NumberStrKernel is a Non-Zero value.
 //
 // ----------------------------------------------------------------
 //
@@ -2331,19 +2273,18 @@ func (numStrKernel *NumberStrKernel) GetNumericValueStats(
 //
 // # Return Values
 //
-//	NumericValueType
-//		This enumeration value specifies the type of
-//		numeric value contained in the current instance
-//		of NumberStrKernel. The contained numeric value
-//		is classified either as an integer or a floating
-//		point value.
+//	isNonZeroValue				bool
 //
-//		Possible enumeration values are listed as
-//		follows:
-//			NumValType.None()
-//			NumValType.FloatingPoint()
-//			NumValType.Integer()
-func (numStrKernel *NumberStrKernel) GetNumericValueType() NumericValueType {
+//		If this boolean value is set to false it signals
+//		that the numeric value of the current
+//		NumberStrKernel instance is equal to zero.
+//
+//		If this boolean value is set to 'true' it signals
+//		that the numeric value of the current
+//		NumberStrKernel instance is greater than or less
+//		than zero.
+func (numStrKernel *NumberStrKernel) GetIsNonZeroValue() (
+	isNonZeroValue bool) {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -2353,100 +2294,228 @@ func (numStrKernel *NumberStrKernel) GetNumericValueType() NumericValueType {
 
 	defer numStrKernel.lock.Unlock()
 
-	var newNumericValueType NumericValueType
-
-	newNumericValueType,
-		_ = new(numberStrKernelQuark).getSetNumValueType(
+	isNonZeroValue,
+		_ = new(numberStrKernelElectron).getSetIsNonZeroValue(
 		numStrKernel,
 		nil)
 
-	return newNumericValueType
+	return isNonZeroValue
 }
 
-// GetParameterTextListing - Returns formatted text output
-// detailing the member variable names and their corresponding
-// values contained in the current instance of
-// NumberStrKernel ('numStrKernel').
-//
-// ----------------------------------------------------------------
-//
-// Input Parameters
+// GetJsonMarshalFormat
 //
-//	 strBuilder                 *strings.Builder
-//	    - A pointer to an instance of *strings.Builder. The
-//	      formatted text characters produced by this method will be
-//	      written to this instance of strings.Builder.
+// Returns the JSON Marshal Format configured for the
+// current instance of NumberStrKernel.
 //
+// The JSON Marshal Format specifies whether the numeric
+// value will be encoded as a quoted string or as a bare
+// number when the current instance of NumberStrKernel is
+// marshalled to JSON.
 //
-//		displayFunctionChain       bool
-//		   - Set 'displayFunctionChain' to 'true' and a list of the
-//		     functions which led to this result will be included in
-//		     the formatted text output.
+// ----------------------------------------------------------------
 //
+// # Input Parameters
 //
-//	 errorPrefix                interface{}
-//	    - This object encapsulates error prefix text which is
-//	      included in all returned error messages. Usually, it
-//	      contains the name of the calling method or methods
-//	      listed as a method or function chain of execution.
+//	-- NONE --
 //
-//	      If no error prefix information is needed, set this
-//	      parameter to 'nil'.
+// ----------------------------------------------------------------
 //
-//	      This empty interface must be convertible to one of the
-//	      following types:
+// # Return Values
 //
+//	NumberStrJsonFormat
 //
-//	      1. nil - A nil value is valid and generates an empty
-//	               collection of error prefix and error context
-//	               information.
+//		An enumeration value specifying the JSON encoding
+//		format applied by method
+//		NumberStrKernel.MarshalJSON(). Possible values
+//		are listed as follows:
 //
-//	      2. string - A string containing error prefix information.
+//			NumStrJsonFmt.None()
+//			NumStrJsonFmt.QuotedString()
+//			NumStrJsonFmt.BareNumber()
 //
-//	      3. []string A one-dimensional slice of strings containing
-//	                  error prefix information
+//		A value of NumStrJsonFmt.None() signals that the
+//		JSON Marshal Format has not been configured and
+//		the default format, NumStrJsonFmt.QuotedString(),
+//		will be applied.
+func (numStrKernel *NumberStrKernel) GetJsonMarshalFormat() NumberStrJsonFormat {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	return numStrKernel.jsonMarshalFormat
+}
+
+// GetNumberOfFractionalDigits
 //
-//	      4. [][2]string A two-dimensional slice of strings
-//	         containing error prefix and error context information.
+// Returns the number of fractional digits in the
+// current instance of NumberStrKernel.
+func (numStrKernel *NumberStrKernel) GetNumberOfFractionalDigits() int {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	return len(numStrKernel.fractionalDigits.CharsArray)
+}
+
+// GetNumberOfIntegerDigits
 //
-//	      5. ErrPrefixDto - An instance of ErrPrefixDto. The
-//	                        ErrorPrefixInfo from this object will be
-//	                        copied to 'errPrefDto'.
+// Returns the number of integer digits in the current
+// instance of NumberStrKernel.
+func (numStrKernel *NumberStrKernel) GetNumberOfIntegerDigits() int {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	return len(numStrKernel.integerDigits.CharsArray)
+}
+
+//	GetNumberOfNumericDigits
 //
-//	      6. *ErrPrefixDto - A pointer to an instance of
-//	                         ErrPrefixDto. ErrorPrefixInfo from this
-//	                         object will be copied to 'errPrefDto'.
+//	Returns the number of numeric digits contained in
+//	the current instance of NumberStrKernel.
 //
-//	      7. IBasicErrorPrefix - An interface to a method generating
-//	                             a two-dimensional slice of strings
-//	                             containing error prefix and error
-//	                             context information.
+//	The number of numeric digits is computed by adding
+//	the number of integer digits plus the number of
+//	fractional digits contained in the current instance
+//	of NumberStrKernel.
 //
-//	      If parameter 'errorPrefix' is NOT convertible to one of
-//	      the valid types listed above, it will be considered
-//	      invalid and trigger the return of an error.
+// ----------------------------------------------------------------
 //
-//	      Types ErrPrefixDto and IBasicErrorPrefix are included in
-//	      the 'errpref' software package,
-//	      "github.com/MikeAustin71/errpref".
+// # Input Parameters
+//
+//	NONE
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	int
+//
+//		This method returns the number of integer digits
+//		plus the number of fractional digits currently
+//		residing in the current instance of
+//		NumberStrKernel.
+func (numStrKernel *NumberStrKernel) GetNumberOfNumericDigits() int {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	return numStrKernel.integerDigits.GetRuneArrayLength() +
+		numStrKernel.fractionalDigits.GetRuneArrayLength()
+
+}
+
+// GetNumberSign - Returns the Number Sign associated with the
+// current NumberStrKernel instance as an enumeration value of
+// type NumericSignValueType.
+//
+// If the current number sign value is invalid, an error will be
+// returned.
+//
+// Valid return values for Number Sign are:
+//
+//	NumSignVal.Negative()
+//	NumSignVal.Zero()
+//	NumSignVal.Positive()
 //
 // ----------------------------------------------------------------
 //
+// Input Parameters
+//
+//	errorPrefix                interface{}
+//	   - This object encapsulates error prefix text which is
+//	     included in all returned error messages. Usually, it
+//	     contains the name of the calling method or methods
+//	     listed as a method or function chain of execution.
+//
+//	     If no error prefix information is needed, set this parameter
+//	     to 'nil'.
+//
+//	     This empty interface must be convertible to one of the
+//	     following types:
+//
+//
+//	     1. nil - A nil value is valid and generates an empty
+//	              collection of error prefix and error context
+//	              information.
+//
+//	     2. string - A string containing error prefix information.
+//
+//	     3. []string A one-dimensional slice of strings containing
+//	                 error prefix information
+//
+//	     4. [][2]string A two-dimensional slice of strings containing
+//	                    error prefix and error context information.
+//
+//	     5. ErrPrefixDto - An instance of ErrPrefixDto. The
+//	                       ErrorPrefixInfo from this object will be
+//	                       copied to 'errPrefDto'.
+//
+//	     6. *ErrPrefixDto - A pointer to an instance of ErrPrefixDto.
+//	                        ErrorPrefixInfo from this object will be
+//	                       copied to 'errPrefDto'.
+//
+//	     7. IBasicErrorPrefix - An interface to a method generating
+//	                            a two-dimensional slice of strings
+//	                            containing error prefix and error
+//	                            context information.
+//
+//	     If parameter 'errorPrefix' is NOT convertible to one of
+//	     the valid types listed above, it will be considered
+//	     invalid and trigger the return of an error.
+//
+//	     Types ErrPrefixDto and IBasicErrorPrefix are included in
+//	     the 'errpref' software package, "github.com/MikeAustin71/errpref".
+//
+// ------------------------------------------------------------------------
+//
 // Return Values
 //
+//	NumericSignValueType
+//	   - If this method completes successfully, this parameter
+//	     will return an enumeration value of type
+//	     NumericSignValueType specifying the number sign associated
+//	     with the numeric value represented by the current instance
+//	     of NumberStrKernel. Possible valid return values are
+//	     listed as follows:
+//	       NumSignVal.Negative()
+//	       NumSignVal.Zero()
+//	       NumSignVal.Positive()
+//
+//
 //	error
-//	   - If this method completes successfully, this returned error
-//	     Type is set equal to 'nil'. If errors are encountered during
-//	     processing, the returned error Type will encapsulate an error
-//	     message.
+//	   - If this method completes successfully and no errors are
+//	     encountered this return value is set to 'nil'. Otherwise,
+//	     if errors are encountered, this return value will contain
+//	     an appropriate error message.
 //
-//	     If an error message is returned, the text value for input
-//	     parameter 'errPrefDto' (error prefix) will be prefixed or
-//	     attached at the beginning of the error message.
-func (numStrKernel *NumberStrKernel) GetParameterTextListing(
-	strBuilder *strings.Builder,
-	displayFunctionChain bool,
-	errorPrefix interface{}) error {
+//	     If an error occurs, the text value of input parameter
+//	     'errorPrefix' will be inserted or prefixed at the
+//	     beginning of the error message.
+func (numStrKernel *NumberStrKernel) GetNumberSign(
+	errorPrefix interface{}) (
+	NumericSignValueType,
+	error) {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -2463,396 +2532,305 @@ func (numStrKernel *NumberStrKernel) GetParameterTextListing(
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"GetNumberSignAsInt()",
+			"GetNumberSign()",
 		"")
 
 	if err != nil {
-		return err
+		return NumericSignValueType(-2), err
 	}
 
-	return new(numberStrKernelNanobot).
-		getParameterTextListing(
-			strBuilder,
-			displayFunctionChain,
-			numStrKernel,
-			ePrefix.XCpy(
-				"numStrKernel"))
+	if !numStrKernel.numberSign.XIsValid() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The current instance of 'NumberStrKernel' is invalid\n"+
+			"because member variable Number Sign Value contains an invalid value.\n"+
+			"Number Sign should be set to minus one (-1), zero (0) or plus one (1).\n"+
+			"The current value of Number Sign is '%v'\n",
+			ePrefix.String(),
+			numStrKernel.numberSign.XArithmeticValue())
+
+		return NumericSignValueType(-2), err
+	}
+
+	return numStrKernel.numberSign, err
 }
 
-//	GetScientificNotation
+//	GetNumberSignAsInt
 //
-//	Converts the numeric value contained in the current
-//	instance of NumberStrKernel to a scientific notation
-//	value and returns that value as an instance of
-//	SciNotationKernel.
+//	Returns the Number Sign associated with the
+//	current NumberStrKernel instance as an integer
+//	value.
 //
-// ----------------------------------------------------------------
+//	If the current number sign value is invalid,
+//	an error will be returned.
 //
-//	# Definition of Terms
+//	Valid return values for Number Sign are:
 //
-//	In scientific notation, nonzero numbers are written
-//	in the form	m × 10n or m times ten raised to the
-//	power of n, where n is an integer, and the coefficient
-//	m is a nonzero real number (usually between 1 and 10
-//	in absolute value, and nearly always written as a
-//	terminating decimal).
+//		-1 Minus One for negative values
+//		 0 Zero for value of zero
+//		 1 Plus One (+1) for positive values
 //
-//										Wikipedia
+// ----------------------------------------------------------------
 //
-//	The Scientific Notation value consists of a
-//	significand and exponent which are used to express a
-//	numeric value in the form:
+// # Input Parameters
 //
-//		significand x 10^exponent = numeric value
+//	 errorPrefix                interface{}
 //
-//	Example
+//		This object encapsulates error prefix text which is
+//		included in all returned error messages. Usually, it
+//		contains the name of the calling method or methods
+//		listed as a method or function chain of execution.
 //
-//		Numeric Value				=	265,200,000
-//		Scientific Notation Value	=	'2.652 x 10^8'
-//		significand 				=	'2.652'
-//		significand integer digits 	= 	'2'
-//	    significand fractional digits =	'652'
-//		exponent    				= 	'8'  (10^8)
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-// ----------------------------------------------------------------
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//	# Input Parameters
+//		1. nil - A nil value is valid and generates an empty
+//		   collection of error prefix and error context
+//		   information.
 //
-//	significandRoundingType				NumberRoundingType
+//		2. string - A string containing error prefix
+//			information.
 //
-//		This enumeration parameter is used to specify the
-//		type of rounding algorithm which will be applied
-//		when rounding fractional digits contained in the
-//		significand of the returned Scientific Notation
-//		value.
+//		3. []string A one-dimensional slice of strings
+//			containing error prefix information.
 //
-//		If in doubt as to a suitable rounding method,
-//		'HalfAwayFromZero' is recommended.
+//		4. [][2]string A two-dimensional slice of strings
+//		   containing error prefix and error context
+//		   information.
 //
-//		Possible values are listed as follows:
-//			NumRoundType.None()	- Invalid Value
-//			NumRoundType.NoRounding()
-//			NumRoundType.HalfUpWithNegNums()
-//			NumRoundType.HalfDownWithNegNums()
-//			NumRoundType.HalfAwayFromZero()
-//			NumRoundType.HalfTowardsZero()
-//			NumRoundType.HalfToEven()
-//			NumRoundType.HalfToOdd()
-//			NumRoundType.Randomly()
-//			NumRoundType.Floor()
-//			NumRoundType.Ceiling()
-//			NumRoundType.Truncate()
+//		5. ErrPrefixDto - An instance of ErrPrefixDto.
+//			Information from this object will be copied for use
+//			in error and informational messages.
 //
-//		Definitions:
+//		6. *ErrPrefixDto - A pointer to an instance of
+//			ErrPrefixDto. Information from this object will be
+//			copied for use in error and informational messages.
 //
-//			NoRounding
+//		7. IBasicErrorPrefix - An interface to a method
+//			generating a two-dimensional slice of strings
+//			containing error prefix and error context
+//			information.
 //
-//				Signals that no rounding operation will be
-//				performed on fractional digits. The
-//				fractional digits will therefore remain
-//				unchanged.
+//		If parameter 'errorPrefix' is NOT convertible to one
+//		of the valid types listed above, it will be
+//		considered invalid and trigger the return of an
+//		error.
 //
-//			HalfUpWithNegNums
+//		Types ErrPrefixDto and IBasicErrorPrefix are included
+//		in the 'errpref' software package,
+//		"github.com/MikeAustin71/errpref".
 //
-//				Half Round Up Including Negative Numbers.
-//				This method is intuitive but may produce
-//				unexpected results when applied to negative
-//				numbers.
+// ----------------------------------------------------------------
 //
-//				'HalfUpWithNegNums' rounds .5 up.
+// # Return Values
 //
-//					Examples of 'HalfUpWithNegNums'
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8
-//					7.4 rounds down to 7
-//					-7.4 rounds up to -7
-//					-7.5 rounds up to -7
-//					-7.6 rounds down to -8
+//	int
 //
-//			HalfDownWithNegNums
+//		If this method completes successfully, this parameter will
+//		return an integer value specifying the number sign
+//		associated with the numeric value represented by the
+//		current instance of NumberStrKernel. Possible valid return
+//		values are listed as follows:
 //
-//			Half Round Down Including Negative Numbers. This
-//			method is also considered intuitive but may
-//			produce unexpected results when applied to
-//			negative numbers.
 //
-//			'HalfDownWithNegNums' rounds .5 down.
+//			-1 Minus One for negative values
+//			 0 Zero for value of zero
+//			 1 Plus One (+1) for positive values
 //
-//				Examples of HalfDownWithNegNums
 //
-//				7.6 rounds up to 8
-//				7.5 rounds down to 7
-//				7.4 rounds down to 7
-//				-7.4 rounds up to -7
-//				-7.5 rounds down to -8
-//				-7.6 rounds down to -8
+//	error
 //
-//			HalfAwayFromZero
+//		If this method completes successfully, the returned
+//		error Type is set equal to 'nil'.
 //
-//				The 'HalfAwayFromZero' method rounds .5 further
-//				away from zero.	It provides clear and consistent
-//				behavior when dealing with negative numbers.
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error message.
+//	 	This returned error message will incorporate the method
+//	 	chain and text passed by input parameter, 'errorPrefix'.
+//	 	The 'errorPrefix' text will be attached to the beginning
+//	 	of the error message.
+func (numStrKernel *NumberStrKernel) GetNumberSignAsInt(
+	errorPrefix interface{}) (
+	int,
+	error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"GetNumberSignAsInt()",
+		"")
+
+	if err != nil {
+		return -99, err
+	}
+
+	if !numStrKernel.numberSign.XIsValid() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The current instance of 'NumberStrKernel' is invalid\n"+
+			"because member variable Number Sign Value contains an invalid value.\n"+
+			"Number Sign should be set to minus one (-1), zero (0) or plus one (1).\n"+
+			"The current value of Number Sign is '%v'\n",
+			ePrefix.String(),
+			numStrKernel.numberSign.XArithmeticValue())
+
+		return -99, err
+	}
+
+	return numStrKernel.numberSign.XArithmeticValue(), err
+}
+
+//	GetNumericValueStats
 //
-//					Examples of HalfAwayFromZero
+//	Returns a type of NumberStrStatsDto containing
+//	statistics on the nature of the numeric value
+//	encapsulated by the current instance of
+//	NumberStrKernel.
 //
-//					7.6 rounds away to 8
-//					7.5 rounds away to 8
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds away to -8
-//					-7.6 rounds away to -8
+// ----------------------------------------------------------------
 //
-//			HalfTowardsZero
+//	# Input Parameters
 //
-//				Round Half Towards Zero. 'HalfTowardsZero' rounds
-//				0.5	closer to zero. It provides clear and
-//				consistent behavior	when dealing with negative
-//				numbers.
+//	errorPrefix					interface{}
 //
-//					Examples of HalfTowardsZero
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it	contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//					7.6 rounds away to 8
-//					7.5 rounds to 7
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds to -7
-//					-7.6 rounds away to -8
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//			HalfToEven
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//				Round Half To Even Numbers. 'HalfToEven' is
-//				also called	Banker's Rounding. This method
-//				rounds 0.5 to the nearest even digit.
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//					Examples of HalfToEven
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//					7.5 rounds up to 8 (because 8 is an even
-//					number)	but 6.5 rounds down to 6 (because
-//					6 is an even number)
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//					HalfToEven only applies to 0.5. Other
-//					numbers (not ending	in 0.5) round to
-//					nearest as usual, so:
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8 (because 8 is an even number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds down to 6 (because 6 is an even number)
-//					6.4 rounds down to 6
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//			HalfToOdd
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//				Round Half to Odd Numbers. Similar to 'HalfToEven',
-//				but in this case 'HalfToOdd' rounds 0.5 towards odd
-//				numbers.
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//					Examples of HalfToOdd
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
 //
-//					HalfToOdd only applies to 0.5. Other numbers
-//					(not ending	in 0.5) round to nearest as usual.
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
-//					7.5 rounds down to 7 (because 7 is an odd number)
+// ----------------------------------------------------------------
 //
-//					6.5 rounds up to 7 (because 7 is an odd number)
+// # Return Values
 //
-//					7.6 rounds up to 8
-//					7.5 rounds down to 7 (because 7 is an odd number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds up to 7 (because 7 is an odd number)
-//					6.4 rounds down to 6
+//	numStrStatsDto				NumberStrStatsDto
 //
-//			Randomly
+//		This data transfer object will return key
+//		statistics on the numeric value encapsulated
+//		by the current instance of NumberStrKernel.
 //
-//				Round Half Randomly. Uses a Random Number Generator
-//				to choose between rounding 0.5 up or down.
+//		type NumberStrStatsDto struct {
 //
-//				All numbers other than 0.5 round to the nearest as
-//				usual.
+//		NumOfIntegerDigits					uint64
 //
-//			Floor
+//			The total number of integer digits to the
+//			left of the radix point or, decimal point, in
+//			the subject numeric value.
 //
-//				Yields the nearest integer down. Floor does not apply
-//				any	special treatment to 0.5.
+//		NumOfSignificantIntegerDigits		uint64
 //
-//				Floor Function: The greatest integer that is less than
-//				or equal to x
+//			The number of nonzero integer digits to the
+//			left of the radix point or, decimal point, in
+//			the subject numeric value.
 //
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
-//
-//				In mathematics and computer science, the floor function
-//				is the function that takes as input a real number x,
-//				and gives as output the greatest integer less than or
-//				equal to x,	denoted floor(x) or ⌊x⌋.
-//
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
-//
-//				Examples of Floor
-//
-//					Number     Floor
-//					 2           2
-//					 2.4         2
-//					 2.9         2
-//					-2.5        -3
-//					-2.7        -3
-//					-2          -2
-//
-//			Ceiling
-//
-//				Yields the nearest integer up. Ceiling does not
-//				apply any special treatment to 0.5.
-//
-//				Ceiling Function: The least integer that is
-//				greater than or	equal to x.
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
-//
-//				The ceiling function maps x to the least integer
-//				greater than or equal to x, denoted ceil(x) or
-//				⌈x⌉.[1]
-//
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
-//
-//					Examples of Ceiling
-//
-//						Number    Ceiling
-//						 2           2
-//						 2.4         3
-//						 2.9         3
-//						-2.5        -2
-//						-2.7        -2
-//						-2          -2
-//
-//			Truncate
-//
-//				Apply NO Rounding whatsoever. The Round From Digit
-//				is dropped or deleted. The Round To Digit is NEVER
-//				changed.
-//
-//				Examples of Truncate
-//
-//					Example-1
-//					Number: 23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:   23.14 - The Round From Digit
-//					is dropped.
-//
-//					Example-2
-//					Number: -23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:  -23.14 - The Round From Digit
-//					is dropped.
-//
-//	significandRoundToFactionalDigits	int
-//
-//		This parameter in conjunction with
-//		'significandRoundingType' to specify the number of digits
-//		to the right of the radix point, or decimal point, which
-//		will be returned as the Scientific Notation significand
-//		value.
-//
-//		When set to a positive integer value, this parameter
-//		controls the number of digits to the right of the
-//		radix point or decimal separator (a.k.a. decimal point).
-//		This value is equal to the number fractional digits which
-//		will remain in the floating point number after completion
-//		of the number rounding operation.
-//
-//		If parameter 'roundingType' is set to NumRoundType.NoRounding(),
-//		'significandRoundToFactionalDigits' is ignored and has no
-//		effect.
-//
-//		if 'significandRoundToFactionalDigits' is set to a value
-//		greater than the number of fractional digits in the
-//		'significand', the number of fractional digits will be
-//		extended with zero values and reflected in the numeric
-//		value returned through parameter 'numericValue'.
-//
-//		NOTE: Rounding the significand to zero is considered bad
-//		form. Common practice always retains at least one digit
-//		to the right of the decimal point in Scientific Notation.
-//
-//
-//	errorPrefix							interface{}
-//
-//		This object encapsulates error prefix text which
-//		is included in all returned error messages.
-//		Usually, it	contains the name of the calling
-//		method or methods listed as a method or function
-//		chain of execution.
-//
-//		If no error prefix information is needed, set this
-//		parameter to 'nil'.
-//
-//		This empty interface must be convertible to one of
-//		the following types:
-//
-//		1.	nil
-//				A nil value is valid and generates an
-//				empty collection of error prefix and
-//				error context information.
-//
-//		2.	string
-//				A string containing error prefix
-//				information.
+//		NumOfFractionalDigits				uint64
 //
-//		3.	[]string
-//				A one-dimensional slice of strings
-//				containing error prefix information.
+//			The total number of fractional digits to the
+//			right of the radix point or, decimal point,
+//			in the subject numeric value.
 //
-//		4.	[][2]string
-//				A two-dimensional slice of strings
-//		   		containing error prefix and error
-//		   		context information.
+//		NumOfSignificantFractionalDigits	uint64
 //
-//		5.	ErrPrefixDto
-//				An instance of ErrPrefixDto.
-//				Information from this object will
-//				be copied for use in error and
-//				informational messages.
+//			The number of nonzero fractional digits to
+//			the right of the radix point or, decimal
+//			point, in the subject numeric value.
 //
-//		6.	*ErrPrefixDto
-//				A pointer to an instance of
-//				ErrPrefixDto. Information from
-//				this object will be copied for use
-//				in error and informational messages.
+//		NumberValueType 					NumericValueType
 //
-//		7.	IBasicErrorPrefix
-//				An interface to a method
-//				generating a two-dimensional slice
-//				of strings containing error prefix
-//				and error context information.
+//			This enumeration value specifies whether the
+//			subject numeric value is classified either as
+//			an integer or a floating point number.
 //
-//		If parameter 'errorPrefix' is NOT convertible
-//		to one of the valid types listed above, it will
-//		be considered invalid and trigger the return of
-//		an error.
+//			Possible enumeration values are listed as
+//			follows:
+//				NumValType.None()
+//				NumValType.FloatingPoint()
+//				NumValType.Integer()
 //
-//		Types ErrPrefixDto and IBasicErrorPrefix are
-//		included in the 'errpref' software package:
-//			"github.com/MikeAustin71/errpref".
+//		NumberSign							NumericSignValueType
 //
-// ----------------------------------------------------------------
+//			An enumeration specifying the number sign
+//			associated with the numeric value. Possible
+//			values are listed as follows:
+//				NumSignVal.None()		= Invalid Value
+//				NumSignVal.Negative()	= -1
+//				NumSignVal.Zero()		=  0
+//				NumSignVal.Positive()	=  1
 //
-// # Return Values
+//		IsZeroValue							bool
 //
-//	sciNotKernel				SciNotationKernel
+//			If 'true', the subject numeric value is equal
+//			to zero ('0').
 //
-//		This returned instance of SciNotationKernel will
-//		be configured with the numeric value contained in
-//		the current instance of NumberStrKernel.
+//			If 'false', the subject numeric value is
+//			greater than or less than zero ('0').
+//		}
 //
 //	error
 //
@@ -2866,11 +2844,9 @@ func (numStrKernel *NumberStrKernel) GetParameterTextListing(
 //		input parameter, 'errorPrefix'. The 'errorPrefix'
 //		text will be attached to the beginning of the
 //		error message.
-func (numStrKernel *NumberStrKernel) GetScientificNotation(
-	significandRoundingType NumberRoundingType,
-	significandRoundToFactionalDigits int,
+func (numStrKernel *NumberStrKernel) GetNumericValueStats(
 	errorPrefix interface{}) (
-	SciNotationKernel,
+	NumberStrStatsDto,
 	error) {
 
 	if numStrKernel.lock == nil {
@@ -2882,38 +2858,53 @@ func (numStrKernel *NumberStrKernel) GetScientificNotation(
 	defer numStrKernel.lock.Unlock()
 
 	var ePrefix *ePref.ErrPrefixDto
-
 	var err error
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"GetScientificNotation()",
+			"GetNumericValueStats()",
 		"")
 
 	if err != nil {
-		return SciNotationKernel{}, err
+		return NumberStrStatsDto{}, err
 	}
 
-	return new(numberStrKernelMechanics).
-		convertToSciNotation(
+	return new(numberStrKernelAtom).
+		calcNumStrKernelStats(
 			numStrKernel,
-			significandRoundingType,
-			significandRoundToFactionalDigits,
-			ePrefix.XCpy(
-				"numStrKernel"))
+			ePrefix.XCpy("numStrKernel"))
 }
 
-// IsFloatingPointValue
+//	GetNumericValueType
 //
-// If the current instance of NumberStrKernel
-// encapsulates a floating point numeric value, this
-// method returns 'true'.
+//	Returns the Numeric Value Type associated with
+//	the current instance of NumberStrKernel.
 //
-// If the numeric value is not a floating point value,
-// this method returns 'false'.
-func (numStrKernel *NumberStrKernel) IsFloatingPointValue() bool {
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	NONE
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumericValueType
+//		This enumeration value specifies the type of
+//		numeric value contained in the current instance
+//		of NumberStrKernel. The contained numeric value
+//		is classified either as an integer or a floating
+//		point value.
+//
+//		Possible enumeration values are listed as
+//		follows:
+//			NumValType.None()
+//			NumValType.FloatingPoint()
+//			NumValType.Integer()
+func (numStrKernel *NumberStrKernel) GetNumericValueType() NumericValueType {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -2930,164 +2921,92 @@ func (numStrKernel *NumberStrKernel) IsFloatingPointValue() bool {
 		numStrKernel,
 		nil)
 
-	return newNumericValueType == NumValType.FloatingPoint()
+	return newNumericValueType
 }
 
-// IsValidInstance
-//
-// Performs a diagnostic review of the data values
-// encapsulated in the current NumberStrKernel
-// instance to determine if they are valid.
-//
-// If any data element evaluates as invalid, this
-// method will return a boolean value of 'false'.
-//
-// If all data elements are determined to be valid,
-// this method returns a boolean value of 'true'.
-//
-// This method is functionally equivalent to
-// NumberStrKernel.IsValidInstanceError() with
-// the sole exceptions being that this method takes
-// no input parameters and returns a boolean value.
-//
-// ----------------------------------------------------------------
-//
-// # Input Parameters
-//
-//	-- NONE --
+// GetParameterTextListing - Returns formatted text output
+// detailing the member variable names and their corresponding
+// values contained in the current instance of
+// NumberStrKernel ('numStrKernel').
 //
 // ----------------------------------------------------------------
 //
-// # Return Values
-//
-//	bool
-//
-//		If any of the internal member data values
-//		contained in the current instance of
-//		NumberStrKernel are found to be invalid, this
-//		method will return a boolean value of 'false'.
-//
-//		If all internal member data variables contained
-//		in the current instance of NumberStrKernel are
-//		found to be valid, this method returns a boolean
-//		value of 'true'.
-func (numStrKernel *NumberStrKernel) IsValidInstance() bool {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	isValid,
-		_ := new(numberStrKernelAtom).
-		testValidityOfNumStrKernel(
-			numStrKernel,
-			nil)
-
-	return isValid
-}
-
-// IsValidInstanceError
+// Input Parameters
 //
-// Performs a diagnostic review of the data values
-// encapsulated in the current NumberStrKernel
-// instance to determine if they are valid.
+//	 strBuilder                 *strings.Builder
+//	    - A pointer to an instance of *strings.Builder. The
+//	      formatted text characters produced by this method will be
+//	      written to this instance of strings.Builder.
 //
-// If any data element evaluates as invalid, this
-// method will return an error.
 //
-// ----------------------------------------------------------------
+//		displayFunctionChain       bool
+//		   - Set 'displayFunctionChain' to 'true' and a list of the
+//		     functions which led to this result will be included in
+//		     the formatted text output.
 //
-// # Input Parameters
 //
-//	errorPrefix					interface{}
+//	 errorPrefix                interface{}
+//	    - This object encapsulates error prefix text which is
+//	      included in all returned error messages. Usually, it
+//	      contains the name of the calling method or methods
+//	      listed as a method or function chain of execution.
 //
-//		This object encapsulates error prefix text which
-//		is included in all returned error messages.
-//		Usually, it contains the name of the calling
-//		method or methods listed as a method or function
-//		chain of execution.
+//	      If no error prefix information is needed, set this
+//	      parameter to 'nil'.
 //
-//		If no error prefix information is needed, set this
-//		parameter to 'nil'.
+//	      This empty interface must be convertible to one of the
+//	      following types:
 //
-//		This empty interface must be convertible to one of
-//		the following types:
 //
-//		1.	nil
-//				A nil value is valid and generates an
-//				empty collection of error prefix and
-//				error context information.
+//	      1. nil - A nil value is valid and generates an empty
+//	               collection of error prefix and error context
+//	               information.
 //
-//		2.	string
-//				A string containing error prefix
-//				information.
+//	      2. string - A string containing error prefix information.
 //
-//		3.	[]string
-//				A one-dimensional slice of strings
-//				containing error prefix information.
+//	      3. []string A one-dimensional slice of strings containing
+//	                  error prefix information
 //
-//		4.	[][2]string
-//				A two-dimensional slice of strings
-//		   		containing error prefix and error
-//		   		context information.
+//	      4. [][2]string A two-dimensional slice of strings
+//	         containing error prefix and error context information.
 //
-//		5.	ErrPrefixDto
-//				An instance of ErrPrefixDto.
-//				Information from this object will
-//				be copied for use in error and
-//				informational messages.
+//	      5. ErrPrefixDto - An instance of ErrPrefixDto. The
+//	                        ErrorPrefixInfo from this object will be
+//	                        copied to 'errPrefDto'.
 //
-//		6.	*ErrPrefixDto
-//				A pointer to an instance of
-//				ErrPrefixDto. Information from
-//				this object will be copied for use
-//				in error and informational messages.
+//	      6. *ErrPrefixDto - A pointer to an instance of
+//	                         ErrPrefixDto. ErrorPrefixInfo from this
+//	                         object will be copied to 'errPrefDto'.
 //
-//		7.	IBasicErrorPrefix
-//				An interface to a method
-//				generating a two-dimensional slice
-//				of strings containing error prefix
-//				and error context information.
+//	      7. IBasicErrorPrefix - An interface to a method generating
+//	                             a two-dimensional slice of strings
+//	                             containing error prefix and error
+//	                             context information.
 //
-//		If parameter 'errorPrefix' is NOT convertible
-//		to one of the valid types listed above, it will
-//		be considered invalid and trigger the return of
-//		an error.
+//	      If parameter 'errorPrefix' is NOT convertible to one of
+//	      the valid types listed above, it will be considered
+//	      invalid and trigger the return of an error.
 //
-//		Types ErrPrefixDto and IBasicErrorPrefix are
-//		included in the 'errpref' software package:
-//			"github.com/MikeAustin71/errpref".
+//	      Types ErrPrefixDto and IBasicErrorPrefix are included in
+//	      the 'errpref' software package,
+//	      "github.com/MikeAustin71/errpref".
 //
 // ----------------------------------------------------------------
 //
-// # Return Values
+// Return Values
 //
 //	error
+//	   - If this method completes successfully, this returned error
+//	     Type is set equal to 'nil'. If errors are encountered during
+//	     processing, the returned error Type will encapsulate an error
+//	     message.
 //
-//		If any of the internal member data variables
-//		contained in the current instance of
-//		NumberStrKernel are found to be invalid, this
-//		method will return an error configured with
-//		an appropriate message identifying the invalid
-//		member data variable.
-//
-//		If all internal member data variables evaluate
-//		as valid, this returned error value will be set
-//		to 'nil'.
-//
-//		If errors are encountered during processing or if
-//		any internal member data values are found to be
-//		invalid, the returned error Type will encapsulate
-//		an appropriate error message. This returned error
-//		message will incorporate the method chain and text
-//		passed by input parameter, 'errorPrefix'. The
-//		'errorPrefix' text will be attached to the
-//		beginning of the error message.
-func (numStrKernel *NumberStrKernel) IsValidInstanceError(
+//	     If an error message is returned, the text value for input
+//	     parameter 'errPrefDto' (error prefix) will be prefixed or
+//	     attached at the beginning of the error message.
+func (numStrKernel *NumberStrKernel) GetParameterTextListing(
+	strBuilder *strings.Builder,
+	displayFunctionChain bool,
 	errorPrefix interface{}) error {
 
 	if numStrKernel.lock == nil {
@@ -3099,336 +3018,74 @@ func (numStrKernel *NumberStrKernel) IsValidInstanceError(
 	defer numStrKernel.lock.Unlock()
 
 	var ePrefix *ePref.ErrPrefixDto
-
 	var err error
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"IsValidInstanceError()",
+			"GetNumberSignAsInt()",
 		"")
 
 	if err != nil {
 		return err
 	}
 
-	_,
-		err = new(numberStrKernelAtom).
-		testValidityOfNumStrKernel(
+	return new(numberStrKernelNanobot).
+		getParameterTextListing(
+			strBuilder,
+			displayFunctionChain,
 			numStrKernel,
 			ePrefix.XCpy(
 				"numStrKernel"))
-
-	return err
-}
-
-// IsZeroValue
-//
-// If this method returns 'true', it means that the
-// numeric value of the current NumberStrKernel instance
-// is zero (0).
-//
-// If this method returns 'false' it means that the
-// numeric value of current NumberStrKernel instance is
-// nonzero. A nonzero value will be less than or greater
-// than zero.
-func (numStrKernel *NumberStrKernel) IsZeroValue() bool {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	var isNonZeroValue bool
-
-	isNonZeroValue,
-		_ = new(numberStrKernelElectron).getSetIsNonZeroValue(
-		numStrKernel,
-		nil)
-
-	return !isNonZeroValue
 }
 
-//	NewFromBigRat
+//	GetScientificNotation
 //
-//	Creates a new instance of NumberStrKernel converted
-//	from a numeric value passed as a *big.Rat type
-//	through input parameter 'bigRatNum'.
+//	Converts the numeric value contained in the current
+//	instance of NumberStrKernel to a scientific notation
+//	value and returns that value as an instance of
+//	SciNotationKernel.
 //
 // ----------------------------------------------------------------
 //
-// # BE ADVISED
+//	# Definition of Terms
 //
-//	(1)	big.Rat Rounding Algorithm
-//		Before being converted to an instance of
-//		NumberStrKernel, the big.Rat numeric value will
-//		be rounded by the Golang 'big' package function:
+//	In scientific notation, nonzero numbers are written
+//	in the form	m × 10n or m times ten raised to the
+//	power of n, where n is an integer, and the coefficient
+//	m is a nonzero real number (usually between 1 and 10
+//	in absolute value, and nearly always written as a
+//	terminating decimal).
 //
-//			(*Rat) FloatString
+//										Wikipedia
 //
-//		The rounding algorithm used is described in the
-//		big.Rat documentation as:
+//	The Scientific Notation value consists of a
+//	significand and exponent which are used to express a
+//	numeric value in the form:
 //
-//			The last digit is rounded to nearest, with
-//			halves rounded away from zero.
-//				https://pkg.go.dev/math/big#Rat
+//		significand x 10^exponent = numeric value
 //
-//	(2)	Number of fractional digits
-//		During the rounding operation, the number of
-//		fractional digits to the right of the radix point
-//		(decimal point) will be controlled by input
-//		paramter 'roundToFractionalDigits'.
-//
-// ----------------------------------------------------------------
-//
-// # Input Parameters
-//
-//	bigRatNum					*big.Rat
-//
-//		A pointer to an instance of the numeric value
-//		type big.Rat. This numeric value will be
-//		converted to, and returned as, a Native Number
-//		String.
-//
-//		Before being converted to a Native Number String,
-//		this numeric value will be rounded by the Golang
-//		'big' package functions as specified by input
-//		parameter, 'roundToFractionalDigits'.
-//
-//	roundToFractionalDigits		int
-//
-//		When set to a positive integer value, this
-//		parameter controls the number of digits to the
-//		right of the radix point or decimal separator
-//		(a.k.a. decimal point). Effectively this defines
-//		the number of fractional digits remaining after
-//		completion of the number rounding operation
-//		performed by the Golang package functions.
-//
-//	errorPrefix					interface{}
-//
-//		This object encapsulates error prefix text which
-//		is included in all returned error messages.
-//		Usually, it contains the name of the calling
-//		method or methods listed as a method or function
-//		chain of execution.
-//
-//		If no error prefix information is needed, set this
-//		parameter to 'nil'.
-//
-//		This empty interface must be convertible to one of
-//		the following types:
-//
-//		1.	nil
-//				A nil value is valid and generates an
-//				empty collection of error prefix and
-//				error context information.
-//
-//		2.	string
-//				A string containing error prefix
-//				information.
-//
-//		3.	[]string
-//				A one-dimensional slice of strings
-//				containing error prefix information.
-//
-//		4.	[][2]string
-//				A two-dimensional slice of strings
-//		   		containing error prefix and error
-//		   		context information.
-//
-//		5.	ErrPrefixDto
-//				An instance of ErrPrefixDto.
-//				Information from this object will
-//				be copied for use in error and
-//				informational messages.
-//
-//		6.	*ErrPrefixDto
-//				A pointer to an instance of
-//				ErrPrefixDto. Information from
-//				this object will be copied for use
-//				in error and informational messages.
-//
-//		7.	IBasicErrorPrefix
-//				An interface to a method
-//				generating a two-dimensional slice
-//				of strings containing error prefix
-//				and error context information.
-//
-//		If parameter 'errorPrefix' is NOT convertible
-//		to one of the valid types listed above, it will
-//		be considered invalid and trigger the return of
-//		an error.
-//
-//		Types ErrPrefixDto and IBasicErrorPrefix are
-//		included in the 'errpref' software package:
-//			"github.com/MikeAustin71/errpref".
-//
-// ----------------------------------------------------------------
-//
-// # Return Values
-//
-//	NumberStrKernel
-//
-//		If this method completes successfully, a new instance
-//		of NumberStrKernel will be returned configured and
-//		populated with the numeric value passed in paramter,
-//		'numericValue'.
-//
-//	error
-//
-//		If this method completes successfully, the returned
-//		error Type is set equal to 'nil'.
-//
-//		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error message.
-//	 	This returned error message will incorporate the method
-//	 	chain and text passed by input parameter, 'errorPrefix'.
-//	 	The 'errorPrefix' text will be attached to the beginning
-//	 	of the error message.
-func (numStrKernel *NumberStrKernel) NewFromBigRat(
-	bigRatNum *big.Rat,
-	roundToFractionalDigits int,
-	errorPrefix interface{}) (
-	NumberStrKernel,
-	error) {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	var ePrefix *ePref.ErrPrefixDto
-	var err error
-
-	newNumStrKernel := NumberStrKernel{}
-
-	ePrefix,
-		err = ePref.ErrPrefixDto{}.NewIEmpty(
-		errorPrefix,
-		"NumberStrKernel."+
-			"NewFromBigRat()",
-		"")
-
-	if err != nil {
-		return newNumStrKernel, err
-	}
-
-	var nativeNumStr string
-
-	nativeNumStr,
-		err = new(MathBigRatHelper).
-		BigRatToNativeNumStr(
-			bigRatNum,
-			roundToFractionalDigits,
-			ePrefix)
-
-	if err != nil {
-
-		return newNumStrKernel, err
-	}
-
-	err = new(numberStrKernelQuark).
-		setNumStrKernelFromNativeNumStr(
-			&newNumStrKernel,
-			nativeNumStr,
-			ePrefix.XCpy(
-				"newNumStrKernel"))
-
-	return newNumStrKernel, err
-}
-
-//	NewFromNumericValue
-//
-//	Creates a new instance of NumberStrKernel based on
-//	a numeric value passed as an empty interface.
-//
-//
-//	The numeric value passed to this method by input
-//	parameter 'numericValue' MUST BE convertible to one
-//	of the types defined below:
-//
-//		float32, float64, big.Float
-//		*float32, *float64, *big.Float
-//		*BigFloatDto, BigFloatDto
-//		int8, int16, int, int32, int64, big.Int
-//		*int8, *int16, *int, *int32, *int64, *big.Int
-//		uint8, uint16, uint, uint32, uint64
-//		*uint8, *uint16, *uint, *uint32, *uint64
-//		*TextFieldFormatDtoFloat64, TextFieldFormatDtoFloat64
-//		*TextFieldFormatDtoBigFloat, TextFieldFormatDtoBigFloat
-//		*NumberStrKernel, NumberStrKernel
-//
-//	This numeric value is then used to configure and
-//	return a new instance of NumberStrKernel.
-//
-// ----------------------------------------------------------------
-//
-// # BE ADVISED
-//
-//	(1)	big.Rat Accuracy
-//		This method will default big.Rat and *big.Rat
-//		values default to 2,000 decimal places of
-//		accuracy. Adjust rounding parameters as
-//		required or use the following method for more
-//		granular control over big.Rat rounding:
-//			NewFromNumericValue.NewFromBigRat()
-//
-//	(2) big.Rat Rounding Algorithm
-//		Before being converted to an instance of
-//		NumberStrKernel, the big.Rat numeric value will
-//		be rounded by the Golang 'big' package function:
-//
-//			(*Rat) FloatString
-//
-//		The rounding algorithm used is described in the
-//		big.Rat documentation as:
+//	Example
 //
-//			The last digit is rounded to nearest, with
-//			halves rounded away from zero.
-//				https://pkg.go.dev/math/big#Rat
+//		Numeric Value				=	265,200,000
+//		Scientific Notation Value	=	'2.652 x 10^8'
+//		significand 				=	'2.652'
+//		significand integer digits 	= 	'2'
+//	    significand fractional digits =	'652'
+//		exponent    				= 	'8'  (10^8)
 //
 // ----------------------------------------------------------------
 //
-// # Input Parameters
-//
-//	numericValue				interface{}
-//
-//		Numeric values passed by means of this empty
-//		interface MUST BE convertible to one of the
-//		following types:
-//
-//			float32, float64, big.Float
-//			*float32, *float64, *big.Float
-//			*BigFloatDto, BigFloatDto
-//			int8, int16, int, int32, int64, big.Int
-//			*int8, *int16, *int, *int32, *int64, *big.Int
-//			uint8, uint16, uint, uint32, uint64
-//			*uint8, *uint16, *uint, *uint32, *uint64
-//			*TextFieldFormatDtoFloat64, TextFieldFormatDtoFloat64
-//			*TextFieldFormatDtoBigFloat, TextFieldFormatDtoBigFloat
-//			*NumberStrKernel, NumberStrKernel
-//
-//		This numeric value will be used to configure and
-//		return a new instance of NumberStrKernel.
-//
-//		If 'numericValue' is NOT convertible to one of
-//		the types listed above, an error will be
-//		returned.
+//	# Input Parameters
 //
-//	roundingType				NumberRoundingType
+//	significandRoundingType				NumberRoundingType
 //
 //		This enumeration parameter is used to specify the
-//		type of rounding algorithm that will be applied for
-//		the	rounding of fractional digits contained in the
-//		returned instance of NumberStrKernel.
+//		type of rounding algorithm which will be applied
+//		when rounding fractional digits contained in the
+//		significand of the returned Scientific Notation
+//		value.
 //
 //		If in doubt as to a suitable rounding method,
 //		'HalfAwayFromZero' is recommended.
@@ -3659,27 +3316,41 @@ func (numStrKernel *NumberStrKernel) NewFromBigRat(
 //					Rounded Number:  -23.14 - The Round From Digit
 //					is dropped.
 //
-//	roundToFractionalDigits		int
+//	significandRoundToFactionalDigits	int
+//
+//		This parameter in conjunction with
+//		'significandRoundingType' to specify the number of digits
+//		to the right of the radix point, or decimal point, which
+//		will be returned as the Scientific Notation significand
+//		value.
 //
 //		When set to a positive integer value, this parameter
-//		controls the number of digits to the right of the radix
-//		point or decimal separator (a.k.a. decimal point). This
-//		controls the number of fractional digits remaining after
-//		completion of the number rounding operation.
+//		controls the number of digits to the right of the
+//		radix point or decimal separator (a.k.a. decimal point).
+//		This value is equal to the number fractional digits which
+//		will remain in the floating point number after completion
+//		of the number rounding operation.
 //
-//		This parameter is ignored if 'roundingType' is
-//		set to 'NumRoundType.NoRounding()'.
+//		If parameter 'roundingType' is set to NumRoundType.NoRounding(),
+//		'significandRoundToFactionalDigits' is ignored and has no
+//		effect.
 //
-//		This parameter will be used to control the number
-//		of fractional digits to the right of the decimal
-//		point in the returned instance of
-//		NumberStrKernel.
+//		if 'significandRoundToFactionalDigits' is set to a value
+//		greater than the number of fractional digits in the
+//		'significand', the number of fractional digits will be
+//		extended with zero values and reflected in the numeric
+//		value returned through parameter 'numericValue'.
 //
-//	errorPrefix					interface{}
+//		NOTE: Rounding the significand to zero is considered bad
+//		form. Common practice always retains at least one digit
+//		to the right of the decimal point in Scientific Notation.
+//
+//
+//	errorPrefix							interface{}
 //
 //		This object encapsulates error prefix text which
 //		is included in all returned error messages.
-//		Usually, it contains the name of the calling
+//		Usually, it	contains the name of the calling
 //		method or methods listed as a method or function
 //		chain of execution.
 //
@@ -3738,30 +3409,29 @@ func (numStrKernel *NumberStrKernel) NewFromBigRat(
 //
 // # Return Values
 //
-//	NumberStrKernel
+//	sciNotKernel				SciNotationKernel
 //
-//		If this method completes successfully, a new instance
-//		of NumberStrKernel will be returned configured and
-//		populated with the numeric value passed in paramter,
-//		'numericValue'.
+//		This returned instance of SciNotationKernel will
+//		be configured with the numeric value contained in
+//		the current instance of NumberStrKernel.
 //
 //	error
 //
-//		If this method completes successfully, the returned
-//		error Type is set equal to 'nil'.
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
 //
 //		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error message.
-//	 	This returned error message will incorporate the method
-//	 	chain and text passed by input parameter, 'errorPrefix'.
-//	 	The 'errorPrefix' text will be attached to the beginning
-//	 	of the error message.
-func (numStrKernel *NumberStrKernel) NewFromNumericValue(
-	numericValue interface{},
-	roundingType NumberRoundingType,
-	roundToFractionalDigits int,
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (numStrKernel *NumberStrKernel) GetScientificNotation(
+	significandRoundingType NumberRoundingType,
+	significandRoundToFactionalDigits int,
 	errorPrefix interface{}) (
-	NumberStrKernel,
+	SciNotationKernel,
 	error) {
 
 	if numStrKernel.lock == nil {
@@ -3773,107 +3443,134 @@ func (numStrKernel *NumberStrKernel) NewFromNumericValue(
 	defer numStrKernel.lock.Unlock()
 
 	var ePrefix *ePref.ErrPrefixDto
-	var err error
 
-	newNumStrKernel := NumberStrKernel{}
+	var err error
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"NewFromNumericValue()",
+			"GetScientificNotation()",
 		"")
 
 	if err != nil {
-		return newNumStrKernel, err
+		return SciNotationKernel{}, err
 	}
 
-	err = new(numberStrKernelMolecule).
-		convertNumericValueToKernel(
-			&newNumStrKernel,
-			numericValue,
-			ePrefix)
+	return new(numberStrKernelMechanics).
+		convertToSciNotation(
+			numStrKernel,
+			significandRoundingType,
+			significandRoundToFactionalDigits,
+			ePrefix.XCpy(
+				"numStrKernel"))
+}
 
-	if err != nil ||
-		roundingType == NumRoundType.NoRounding() {
+// IsFloatingPointValue
+//
+// If the current instance of NumberStrKernel
+// encapsulates a floating point numeric value, this
+// method returns 'true'.
+//
+// If the numeric value is not a floating point value,
+// this method returns 'false'.
+func (numStrKernel *NumberStrKernel) IsFloatingPointValue() bool {
 
-		return newNumStrKernel, err
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
 	}
 
-	var numStrRoundingSpec NumStrRoundingSpec
+	numStrKernel.lock.Lock()
 
-	numStrRoundingSpec,
-		err =
-		new(NumStrRoundingSpec).NewRoundingSpec(
-			roundingType,
-			roundToFractionalDigits,
-			ePrefix)
+	defer numStrKernel.lock.Unlock()
 
-	if err != nil {
-		return newNumStrKernel, err
-	}
+	var newNumericValueType NumericValueType
 
-	err = new(numStrMathRoundingNanobot).roundNumStrKernel(
-		&newNumStrKernel,
-		numStrRoundingSpec,
-		ePrefix)
+	newNumericValueType,
+		_ = new(numberStrKernelQuark).getSetNumValueType(
+		numStrKernel,
+		nil)
 
-	return newNumStrKernel, err
+	return newNumericValueType == NumValType.FloatingPoint()
 }
 
-//	NewFromRuneDto
+// IsValidInstance
 //
-//	Creates and returns a new instance of NumberStrKernel
-//	generated from input parameters containing integer
-//	digit and fractional digit Rune Array Data Transfer
-//	Objects (RuneArrayDto).
+// Performs a diagnostic review of the data values
+// encapsulated in the current NumberStrKernel
+// instance to determine if they are valid.
 //
-// ----------------------------------------------------------------
+// If any data element evaluates as invalid, this
+// method will return a boolean value of 'false'.
 //
-// # Input Parameters
+// If all data elements are determined to be valid,
+// this method returns a boolean value of 'true'.
 //
-//	integerDigits				*RuneArrayDto
+// This method is functionally equivalent to
+// NumberStrKernel.IsValidInstanceError() with
+// the sole exceptions being that this method takes
+// no input parameters and returns a boolean value.
 //
-//		A pointer to a rune array data transfer object used to
-//		configure the integer digits array contained within
-//		the returned instance of NumberStrKernel.
+// ----------------------------------------------------------------
 //
-//	fractionalDigits			*RuneArrayDto
+// # Input Parameters
 //
-//		A pointer to a rune array data transfer object used to
-//		configure the fractional digits array contained within
-//		the returned instance of NumberStrKernel.
+//	-- NONE --
 //
-//	numberSign					NumericSignValueType
+// ----------------------------------------------------------------
 //
-//		The Number Sign is specified by means of a
-//		NumericSignValueType enumeration value.
+// # Return Values
 //
-//		Possible values are listed as follows:
+//	bool
 //
-//			NumSignVal.None()     = Ignored. Numeric Value
-//									will be set to positive.
+//		If any of the internal member data values
+//		contained in the current instance of
+//		NumberStrKernel are found to be invalid, this
+//		method will return a boolean value of 'false'.
 //
-//			NumSignVal.Negative() = Valid Value
+//		If all internal member data variables contained
+//		in the current instance of NumberStrKernel are
+//		found to be valid, this method returns a boolean
+//		value of 'true'.
+func (numStrKernel *NumberStrKernel) IsValidInstance() bool {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	isValid,
+		_ := new(numberStrKernelAtom).
+		testValidityOfNumStrKernel(
+			numStrKernel,
+			nil)
+
+	return isValid
+}
+
+// IsValidInstanceError
 //
-//			NumSignVal.Zero()     = Zero numeric values are
-//									automatically assigned
-//									NumSignVal.Zero().
+// Performs a diagnostic review of the data values
+// encapsulated in the current NumberStrKernel
+// instance to determine if they are valid.
 //
-//			NumSignVal.Positive() = Default value for
-//									non-zero numeric values.
+// If any data element evaluates as invalid, this
+// method will return an error.
 //
-//		If 'numberSign' is set to any value other than
-//		NumSignVal.Negative(), it will be ignored and
-//		the final number sign will be automatically
-//		assigned.
+// ----------------------------------------------------------------
 //
-//	 errorPrefix                interface{}
+// # Input Parameters
 //
-//		This object encapsulates error prefix text which is
-//		included in all returned error messages. Usually, it
-//		contains the name of the calling method or methods
-//		listed as a method or function chain of execution.
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
 //		If no error prefix information is needed, set this
 //		parameter to 'nil'.
@@ -3881,71 +3578,78 @@ func (numStrKernel *NumberStrKernel) NewFromNumericValue(
 //		This empty interface must be convertible to one of
 //		the following types:
 //
-//		1. nil - A nil value is valid and generates an empty
-//		   collection of error prefix and error context
-//		   information.
-//
-//		2. string - A string containing error prefix
-//			information.
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//		3. []string A one-dimensional slice of strings
-//			containing error prefix information.
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//		4. [][2]string A two-dimensional slice of strings
-//		   containing error prefix and error context
-//		   information.
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//		5. ErrPrefixDto - An instance of ErrPrefixDto.
-//			Information from this object will be copied for use
-//			in error and informational messages.
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//		6. *ErrPrefixDto - A pointer to an instance of
-//			ErrPrefixDto. Information from this object will be
-//			copied for use in error and informational messages.
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//		7. IBasicErrorPrefix - An interface to a method
-//			generating a two-dimensional slice of strings
-//			containing error prefix and error context
-//			information.
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//		If parameter 'errorPrefix' is NOT convertible to one
-//		of the valid types listed above, it will be
-//		considered invalid and trigger the return of an
-//		error.
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//		Types ErrPrefixDto and IBasicErrorPrefix are included
-//		in the 'errpref' software package,
-//		"github.com/MikeAustin71/errpref".
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
 // ----------------------------------------------------------------
 //
 // # Return Values
 //
-//	NumberStrKernel
-//
-//		If this method completes successfully, a new instance
-//		of NumberStrKernel will be returned configured with
-//		the numeric value extracted from input parameters
-//		'integerDigits' and 'fractionalDigits'.
-//
 //	error
 //
-//		If this method completes successfully, the returned
-//		error Type is set equal to 'nil'.
+//		If any of the internal member data variables
+//		contained in the current instance of
+//		NumberStrKernel are found to be invalid, this
+//		method will return an error configured with
+//		an appropriate message identifying the invalid
+//		member data variable.
 //
-//		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error message.
-//	 	This returned error message will incorporate the method
-//	 	chain and text passed by input parameter, 'errorPrefix'.
-//	 	The 'errorPrefix' text will be attached to the beginning
-//	 	of the error message.
-func (numStrKernel *NumberStrKernel) NewFromRuneDto(
-	integerDigits *RuneArrayDto,
-	fractionalDigits *RuneArrayDto,
-	numberSign NumericSignValueType,
-	errorPrefix interface{}) (
-	NumberStrKernel,
-	error) {
+//		If all internal member data variables evaluate
+//		as valid, this returned error value will be set
+//		to 'nil'.
+//
+//		If errors are encountered during processing or if
+//		any internal member data values are found to be
+//		invalid, the returned error Type will encapsulate
+//		an appropriate error message. This returned error
+//		message will incorporate the method chain and text
+//		passed by input parameter, 'errorPrefix'. The
+//		'errorPrefix' text will be attached to the
+//		beginning of the error message.
+func (numStrKernel *NumberStrKernel) IsValidInstanceError(
+	errorPrefix interface{}) error {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -3956,165 +3660,125 @@ func (numStrKernel *NumberStrKernel) NewFromRuneDto(
 	defer numStrKernel.lock.Unlock()
 
 	var ePrefix *ePref.ErrPrefixDto
-	var err error
 
-	newNumStrKernel := NumberStrKernel{}
+	var err error
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"NewFromRuneDto()",
+			"IsValidInstanceError()",
 		"")
 
 	if err != nil {
-		return newNumStrKernel, err
+		return err
 	}
 
-	err = new(numberStrKernelNanobot).setWithRuneArrayDto(
-		&newNumStrKernel,
-		integerDigits,
-		fractionalDigits,
-		numberSign,
-		ePrefix)
+	_,
+		err = new(numberStrKernelAtom).
+		testValidityOfNumStrKernel(
+			numStrKernel,
+			ePrefix.XCpy(
+				"numStrKernel"))
 
-	return newNumStrKernel, err
+	return err
 }
 
-//	NewFromRuneDigits
-//
-//	Creates and returns a new instance of NumberStrKernel
-//	generated from input parameters containing integer
-//	digit and fractional digit rune arrays.
-//
-// ----------------------------------------------------------------
-//
-// # BE ADVISED
-//
-//	This method will retain leading integer zeros.
-//		Example:
-//								Input String: 0012345.678
-//			Converted Internal Numeric Value: 0012345.678
-//
-// ----------------------------------------------------------------
-//
-// # Input Parameters
-//
-//	integerDigits				[]rune
-//
-//		A rune array containing numeric digits used to
-//		populate the integer part of the numeric value
-//		contained in the returned instance of
-//		NumberStrKernel.
-//
-//	fractionalDigits			[]rune
-//
-//		A rune array containing numeric digits used to
-//		populate the fractional part of the numeric
-//		value contained in the returned instance of
-//		NumberStrKernel.
-//
-//	numberSign					NumericSignValueType
-//
-//		The Number Sign is specified by means of a
-//		NumericSignValueType enumeration value.
-//
-//		Possible values are listed as follows:
-//
-//			NumSignVal.None()     = Ignored. Numeric Value
-//									will be set to positive.
-//
-//			NumSignVal.Negative() = Valid Value
+// IsZeroValue
 //
-//			NumSignVal.Zero()     = Zero numeric values are
-//									automatically assigned
-//									NumSignVal.Zero().
+// If this method returns 'true', it means that the
+// numeric value of the current NumberStrKernel instance
+// is zero (0).
 //
-//			NumSignVal.Positive() = Default value for
-//									non-zero numeric values.
+// If this method returns 'false' it means that the
+// numeric value of current NumberStrKernel instance is
+// nonzero. A nonzero value will be less than or greater
+// than zero.
+func (numStrKernel *NumberStrKernel) IsZeroValue() bool {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var isNonZeroValue bool
+
+	isNonZeroValue,
+		_ = new(numberStrKernelElectron).getSetIsNonZeroValue(
+		numStrKernel,
+		nil)
+
+	return !isNonZeroValue
+}
+
+// MarshalJSON
 //
-//		If 'numberSign' is set to any value other than
-//		NumSignVal.Negative(), it will be ignored and
-//		the final number sign will be automatically
-//		assigned.
+// Implements the 'json.Marshaler' interface for type
+// NumberStrKernel.
 //
-//	 errorPrefix                interface{}
+// The numeric value of the current NumberStrKernel
+// instance is encoded as a Native Number String. No
+// rounding is applied and no conversion to float64 is
+// performed. Therefore, all significant digits are
+// preserved.
 //
-//		This object encapsulates error prefix text which is
-//		included in all returned error messages. Usually, it
-//		contains the name of the calling method or methods
-//		listed as a method or function chain of execution.
+// The JSON encoding format is controlled by the JSON
+// Marshal Format configured for the current instance.
+// Reference method:
 //
-//		If no error prefix information is needed, set this
-//		parameter to 'nil'.
+//	NumberStrKernel.SetJsonMarshalFormat()
 //
-//		This empty interface must be convertible to one of
-//		the following types:
+//	NumStrJsonFmt.QuotedString()	"-1234.5678"
+//	NumStrJsonFmt.BareNumber()		-1234.5678
 //
-//		1. nil - A nil value is valid and generates an empty
-//		   collection of error prefix and error context
-//		   information.
+// If the JSON Marshal Format is invalid or set to
+// NumStrJsonFmt.None(), the numeric value will be encoded
+// as a quoted string.
 //
-//		2. string - A string containing error prefix
-//			information.
+// If the current instance of NumberStrKernel is empty
+// and contains zero integer digits and zero fractional
+// digits, the JSON value 'null' will be returned.
 //
-//		3. []string A one-dimensional slice of strings
-//			containing error prefix information.
+// ----------------------------------------------------------------
 //
-//		4. [][2]string A two-dimensional slice of strings
-//		   containing error prefix and error context
-//		   information.
+// # BE ADVISED
 //
-//		5. ErrPrefixDto - An instance of ErrPrefixDto.
-//			Information from this object will be copied for use
-//			in error and informational messages.
+//	This method is implemented with a value receiver.
+//	This allows NumberStrKernel values, as well as
+//	pointers, embedded in structures to be marshalled
+//	by the 'encoding/json' package.
 //
-//		6. *ErrPrefixDto - A pointer to an instance of
-//			ErrPrefixDto. Information from this object will be
-//			copied for use in error and informational messages.
+//	The current instance of NumberStrKernel will NOT be
+//	modified.
 //
-//		7. IBasicErrorPrefix - An interface to a method
-//			generating a two-dimensional slice of strings
-//			containing error prefix and error context
-//			information.
+// ----------------------------------------------------------------
 //
-//		If parameter 'errorPrefix' is NOT convertible to one
-//		of the valid types listed above, it will be
-//		considered invalid and trigger the return of an
-//		error.
+// # Input Parameters
 //
-//		Types ErrPrefixDto and IBasicErrorPrefix are included
-//		in the 'errpref' software package,
-//		"github.com/MikeAustin71/errpref".
+//	-- NONE --
 //
 // ----------------------------------------------------------------
 //
 // # Return Values
 //
-//	NumberStrKernel
+//	[]byte
 //
-//		If this method completes successfully, a new instance
-//		of NumberStrKernel will be returned configured with
-//		the numeric value extracted from input parameters
-//		'integerDigits' and 'fractionalDigits'.
+//		If this method completes successfully, this byte
+//		array will contain the JSON encoding of the
+//		current NumberStrKernel numeric value.
 //
 //	error
 //
-//		If this method completes successfully, the returned
-//		error Type is set equal to 'nil'.
-//
-//		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error message.
-//	 	This returned error message will incorporate the method
-//	 	chain and text passed by input parameter, 'errorPrefix'.
-//	 	The 'errorPrefix' text will be attached to the beginning
-//	 	of the error message.
-func (numStrKernel *NumberStrKernel) NewFromRuneDigits(
-	integerDigits []rune,
-	fractionalDigits []rune,
-	numberSign NumericSignValueType,
-	errorPrefix interface{}) (
-	NumberStrKernel,
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+func (numStrKernel NumberStrKernel) MarshalJSON() (
+	[]byte,
 	error) {
 
 	if numStrKernel.lock == nil {
@@ -4128,98 +3792,195 @@ func (numStrKernel *NumberStrKernel) NewFromRuneDigits(
 	var ePrefix *ePref.ErrPrefixDto
 	var err error
 
-	newNumStrKernel := NumberStrKernel{}
-
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
-		errorPrefix,
+		nil,
 		"NumberStrKernel."+
-			"NewFromRuneDigits()",
+			"MarshalJSON()",
 		"")
 
 	if err != nil {
-		return newNumStrKernel, err
+		return nil, err
 	}
 
-	err = new(numberStrKernelNanobot).setWithRunes(
-		&newNumStrKernel,
-		integerDigits,
-		fractionalDigits,
-		numberSign,
-		ePrefix)
+	var nativeNumStr string
 
-	return newNumStrKernel, err
+	nativeNumStr,
+		err = new(numberStrKernelMolecule).
+		getMarshalNumStr(
+			&numStrKernel,
+			ePrefix.XCpy(
+				"nativeNumStr<-numStrKernel"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(nativeNumStr) == 0 {
+
+		return []byte("null"), err
+	}
+
+	if numStrKernel.jsonMarshalFormat ==
+		NumStrJsonFmt.BareNumber() {
+
+		return []byte(nativeNumStr), err
+	}
+
+	return []byte("\"" + nativeNumStr + "\""), err
 }
 
-//	NewFromStringDigits
+// MarshalText
 //
-//	Creates and returns a new instance of NumberStrKernel
-//	generated from input parameters containing integer
-//	digit and fractional digit strings.
+// Implements the 'encoding.TextMarshaler' interface for
+// type NumberStrKernel.
+//
+// The numeric value of the current NumberStrKernel
+// instance is returned as a Native Number String. No
+// rounding is applied and no conversion to float64 is
+// performed. Therefore, all significant digits are
+// preserved.
+//
+//	Example:	-1234.5678
+//
+// If the current instance of NumberStrKernel is empty
+// and contains zero integer digits and zero fractional
+// digits, an empty byte array will be returned.
 //
 // ----------------------------------------------------------------
 //
 // # BE ADVISED
 //
-//	This method will retain leading integer zeros.
-//		Example:
-//								Input String: 0012345.678
-//			Converted Internal Numeric Value: 0012345.678
+//	This method is implemented with a value receiver.
+//	This allows NumberStrKernel values, as well as
+//	pointers, embedded in structures to be marshalled
+//	by encoding packages such as 'encoding/json' and
+//	'encoding/xml'.
+//
+//	The current instance of NumberStrKernel will NOT be
+//	modified.
 //
 // ----------------------------------------------------------------
 //
 // # Input Parameters
 //
-//	integerDigits				string
+//	-- NONE --
 //
-//		A string of numeric digits used to populate the
-//		integer part of the numeric value contained in the
-//		returned instance of NumberStrKernel.
+// ----------------------------------------------------------------
 //
-//		This string should contain only numeric digit
-//		characters. Do NOT include number signs such as
-//		minus signs.
+// # Return Values
 //
-//	fractionalDigits			string
+//	[]byte
 //
-//		A string of numeric digits used to populate the
-//		fractional part of the numeric value contained in
-//		the returned instance of NumberStrKernel
+//		If this method completes successfully, this byte
+//		array will contain the numeric value of the
+//		current NumberStrKernel instance formatted as a
+//		Native Number String.
 //
-//		This string should contain only numeric digit
-//		characters. Do NOT include number signs such as
-//		minus signs.
+//	error
 //
-//	numberSign					NumericSignValueType
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+func (numStrKernel NumberStrKernel) MarshalText() (
+	[]byte,
+	error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		nil,
+		"NumberStrKernel."+
+			"MarshalText()",
+		"")
+
+	if err != nil {
+		return nil, err
+	}
+
+	var nativeNumStr string
+
+	nativeNumStr,
+		err = new(numberStrKernelMolecule).
+		getMarshalNumStr(
+			&numStrKernel,
+			ePrefix.XCpy(
+				"nativeNumStr<-numStrKernel"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(nativeNumStr), err
+}
+
+//	Multiply
 //
-//		The Number Sign is specified by means of a
-//		NumericSignValueType enumeration value.
+//	Multiplies the numeric value of the current instance
+//	of NumberStrKernel by the numeric value of an
+//	incoming NumberStrKernel instance ('multiplier') and
+//	returns the product as a new instance of
+//	NumberStrKernel.
 //
-//		Possible values are listed as follows:
+//	The multiplication is performed directly on the
+//	integer and fractional digit arrays. No conversion to
+//	float64, big.Float or big.Rat is performed.
+//	Therefore, the returned product is exact.
 //
-//			NumSignVal.None()     = Ignored. Numeric Value
-//									will be set to positive.
+//	The number of fractional digits in the returned
+//	product is equal to the sum of the fractional digits
+//	contained in the two operands.
 //
-//			NumSignVal.Negative() = Valid Value
+//		Example
+//			Current Instance:	-1.5
+//			multiplier:			2.25
+//			product:			-3.375
 //
-//			NumSignVal.Zero()     = Zero numeric values are
-//									automatically assigned
-//									NumSignVal.Zero().
+//	The returned product will be configured with the
+//	Default Number String Format Specification copied
+//	from the current instance of NumberStrKernel.
 //
-//			NumSignVal.Positive() = Default value for
-//									non-zero numeric values.
+// ----------------------------------------------------------------
 //
-//		If 'numberSign' is set to any value other than
-//		NumSignVal.Negative(), it will be ignored and
-//		the final number sign will be automatically
-//		assigned.
+// # BE ADVISED
 //
-//	 errorPrefix                interface{}
+//	Neither the current instance of NumberStrKernel nor
+//	the input parameter 'multiplier' will be modified by
+//	this method.
 //
-//		This object encapsulates error prefix text which is
-//		included in all returned error messages. Usually, it
-//		contains the name of the calling method or methods
-//		listed as a method or function chain of execution.
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	multiplier					*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		numeric value of the current NumberStrKernel
+//		instance will be multiplied by the numeric value
+//		of 'multiplier'.
+//
+//		If 'multiplier' is invalid, an error will be
+//		returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
 //		If no error prefix information is needed, set this
 //		parameter to 'nil'.
@@ -4227,71 +3988,79 @@ func (numStrKernel *NumberStrKernel) NewFromRuneDigits(
 //		This empty interface must be convertible to one of
 //		the following types:
 //
-//		1. nil - A nil value is valid and generates an empty
-//		   collection of error prefix and error context
-//		   information.
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//		2. string - A string containing error prefix
-//			information.
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//		3. []string A one-dimensional slice of strings
-//			containing error prefix information.
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//		4. [][2]string A two-dimensional slice of strings
-//		   containing error prefix and error context
-//		   information.
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//		5. ErrPrefixDto - An instance of ErrPrefixDto.
-//			Information from this object will be copied for use
-//			in error and informational messages.
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//		6. *ErrPrefixDto - A pointer to an instance of
-//			ErrPrefixDto. Information from this object will be
-//			copied for use in error and informational messages.
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//		7. IBasicErrorPrefix - An interface to a method
-//			generating a two-dimensional slice of strings
-//			containing error prefix and error context
-//			information.
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//		If parameter 'errorPrefix' is NOT convertible to one
-//		of the valid types listed above, it will be
-//		considered invalid and trigger the return of an
-//		error.
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
 //
-//		Types ErrPrefixDto and IBasicErrorPrefix are included
-//		in the 'errpref' software package,
-//		"github.com/MikeAustin71/errpref".
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
 // ----------------------------------------------------------------
 //
 // # Return Values
 //
-//	NumberStrKernel
+//	product						NumberStrKernel
 //
-//		If this method completes successfully, a new instance
-//		of NumberStrKernel will be returned configured with
-//		the numeric value extracted from input parameters
-//		'integerDigits' and 'fractionalDigits'.
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		containing the product of the current
+//		NumberStrKernel numeric value and 'multiplier'.
 //
-//	error
+//	err							error
 //
-//		If this method completes successfully, the returned
-//		error Type is set equal to 'nil'.
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
 //
-//		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error message.
-//	 	This returned error message will incorporate the method
-//	 	chain and text passed by input parameter, 'errorPrefix'.
-//	 	The 'errorPrefix' text will be attached to the beginning
-//	 	of the error message.
-func (numStrKernel *NumberStrKernel) NewFromStringDigits(
-	integerDigits string,
-	fractionalDigits string,
-	numberSign NumericSignValueType,
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) Multiply(
+	multiplier *NumberStrKernel,
 	errorPrefix interface{}) (
-	NumberStrKernel,
-	error) {
+	product NumberStrKernel,
+	err error) {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -4302,127 +4071,92 @@ func (numStrKernel *NumberStrKernel) NewFromStringDigits(
 	defer numStrKernel.lock.Unlock()
 
 	var ePrefix *ePref.ErrPrefixDto
-	var err error
-
-	newNumStrKernel := NumberStrKernel{}
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"NewFromStringDigits()",
+			"Multiply()",
 		"")
 
 	if err != nil {
-		return newNumStrKernel, err
+		return product, err
 	}
 
-	err = new(numberStrKernelNanobot).setWithRunes(
-		&newNumStrKernel,
-		[]rune(integerDigits),
-		[]rune(fractionalDigits),
-		numberSign,
-		ePrefix)
+	err = new(numStrMathArithmeticNanobot).
+		multiplyNumStrKernels(
+			&product,
+			numStrKernel,
+			multiplier,
+			ePrefix.XCpy(
+				"product<-numStrKernel x multiplier"))
 
-	return newNumStrKernel, err
+	return product, err
 }
 
-//	NewParseCustomNumberStr
-//
-//	Receives a raw or dirty number string and proceeds to
-//	return the extracted numeric value as a type
-//	NumberStrKernel.
+//	NewFromBigRat
 //
-//	This method provides a series of custom parsing
-//	parameters which allows users to exercise granular
-//	control over the number string parsing operation.
+//	Creates a new instance of NumberStrKernel converted
+//	from a numeric value passed as a *big.Rat type
+//	through input parameter 'bigRatNum'.
 //
 // ----------------------------------------------------------------
 //
-// # Input Parameters
+// # BE ADVISED
 //
-//	rawNumStr					string
+//	(1)	big.Rat Rounding Algorithm
+//		Before being converted to an instance of
+//		NumberStrKernel, the big.Rat numeric value will
+//		be rounded by the Golang 'big' package function:
 //
-//		This parameter is the raw number string
-//		containing numeric digit characters which will
-//		be extracted and processed to identify the
-//		numeric value. This numeric value will in
-//		turn form the basis of the new NumberStrKernel
-//		instance returned to the calling function.
-//
-//	startSearchIndex			int
-//
-//		The character index in the Raw Number String
-//		('rawNumStr') at which the search for numeric
-//		digit characters will begin.
-//
-//		Set this value to zero (0) in order	begin the
-//		search for numeric characters at the first
-//		character in the Raw Number String ('rawNumStr').
-//
-//	characterSearchLength		int
-//
-//		The actual number of characters within the Raw
-//		Number String ('rawNumStr') that are included in
-//		the search for numeric character digits.
-//
-//		If this value is set to -1, the search length will
-//		be configured to include the last index in
-//		'rawNumStr'. In other words the search will proceed
-//		to the end of 'rawNumStr'.
+//			(*Rat) FloatString
 //
-//	negativeNumSignSearchSpecs	NegNumSearchSpecCollection
+//		The rounding algorithm used is described in the
+//		big.Rat documentation as:
 //
-//		Type NegNumSearchSpecCollection is a collection of
-//		NegativeNumberSearchSpec object. This collection
-//		represents all the negative number signs which
-//		might be located with the Raw Number String
-//		('rawNumStr').
+//			The last digit is rounded to nearest, with
+//			halves rounded away from zero.
+//				https://pkg.go.dev/math/big#Rat
 //
-//		This collection allows the flexibility for
-//		locating such negative number signs as leading
-//		minus signs ('-'), trailing minus signs ('-'),
-//		parentheses ('()') or any other negative number
-//		sign characters which might be associated with
-//		a particular national or cultural usage.
+//	(2)	Number of fractional digits
+//		During the rounding operation, the number of
+//		fractional digits to the right of the radix point
+//		(decimal point) will be controlled by input
+//		paramter 'roundToFractionalDigits'.
 //
-//	decimalSeparator			DecimalSeparatorSpec
+// ----------------------------------------------------------------
 //
-//		Type DecimalSeparatorSpec is used to specify the
-//		radix point or decimal separator which will
-//		separate integer and fractional digits in the
-//		Raw Number String ('rawNumStr'). In the US, the
-//		period ('.') or decimal point separates integer
-//		and fractional digits within a floating point
-//		numeric value. In Europe, the comma (',')
-//		performs this function.
+// # Input Parameters
 //
-//	numParsingTerminators		[]string
+//	bigRatNum					*big.Rat
 //
-//		An array of strings. If any one of these strings
-//		is encountered while searching the Raw Number
-//		String ('rawNumStr'), the search operation will
-//		be immediately terminated.
+//		A pointer to an instance of the numeric value
+//		type big.Rat. This numeric value will be
+//		converted to, and returned as, a Native Number
+//		String.
 //
-//	requestRemainderString		bool
+//		Before being converted to a Native Number String,
+//		this numeric value will be rounded by the Golang
+//		'big' package functions as specified by input
+//		parameter, 'roundToFractionalDigits'.
 //
-//		If this parameter is set to 'true', the Number
-//		String Search Results ('numberStrSearchResults')
-//		returned by this method	will include the
-//		remaining string characters	which were NOT
-//		included in the search operation.
+//	roundToFractionalDigits		int
 //
-//		This feature may be useful when either
-//		'characterSearchLength' or 'numParsingTerminators'
-//		parameters are used to arbitrarily terminate the
-//		search operation.
+//		When set to a positive integer value, this
+//		parameter controls the number of digits to the
+//		right of the radix point or decimal separator
+//		(a.k.a. decimal point). Effectively this defines
+//		the number of fractional digits remaining after
+//		completion of the number rounding operation
+//		performed by the Golang package functions.
 //
-//	 errorPrefix                interface{}
+//	errorPrefix					interface{}
 //
-//		This object encapsulates error prefix text which is
-//		included in all returned error messages. Usually, it
-//		contains the name of the calling method or methods
-//		listed as a method or function chain of execution.
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
 //		If no error prefix information is needed, set this
 //		parameter to 'nil'.
@@ -4430,78 +4164,61 @@ func (numStrKernel *NumberStrKernel) NewFromStringDigits(
 //		This empty interface must be convertible to one of
 //		the following types:
 //
-//		1. nil - A nil value is valid and generates an empty
-//		   collection of error prefix and error context
-//		   information.
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//		2. string - A string containing error prefix
-//			information.
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//		3. []string A one-dimensional slice of strings
-//			containing error prefix information.
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//		4. [][2]string A two-dimensional slice of strings
-//		   containing error prefix and error context
-//		   information.
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//		5. ErrPrefixDto - An instance of ErrPrefixDto.
-//			Information from this object will be copied for use
-//			in error and informational messages.
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//		6. *ErrPrefixDto - A pointer to an instance of
-//			ErrPrefixDto. Information from this object will be
-//			copied for use in error and informational messages.
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//		7. IBasicErrorPrefix - An interface to a method
-//			generating a two-dimensional slice of strings
-//			containing error prefix and error context
-//			information.
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//		If parameter 'errorPrefix' is NOT convertible to one
-//		of the valid types listed above, it will be
-//		considered invalid and trigger the return of an
-//		error.
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
 //
-//		Types ErrPrefixDto and IBasicErrorPrefix are included
-//		in the 'errpref' software package,
-//		"github.com/MikeAustin71/errpref".
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
 // ----------------------------------------------------------------
 //
 // # Return Values
 //
-//	numberStrSearchResults		CharSearchNumStrParseResultsDto
-//
-//		This structure contains public member variables
-//		containing operational data describing the
-//		success or failure of the number string parsing
-//		operation and the search for numeric digits in
-//		the Raw Number String, 'rawNumStr'.
-//
-//		Users are advised to check the following member
-//		variable values after a number string parsing
-//		operation:
-//
-//			FoundNumericDigits		bool
-//
-//				Signals a successful Number String Parsing
-//				operation. When set to 'true', this means
-//				one or more numeric	digit characters ('0'
-//				through '9' inclusive) were	located in the
-//				Raw Number String, 'rawNumStr'.
-//
-//			FoundNonZeroValue		bool
-//
-//				When set to 'true' this signals that the
-//				search operation has detected one or more
-//				nonzero numeric digits.
-//
 //	NumberStrKernel
 //
 //		If this method completes successfully, a new instance
-//		of NumberStrKernel will be returned configured with
-//		the numeric value parsed from the Raw Number String,
-//		'rawNumStr'.
+//		of NumberStrKernel will be returned configured and
+//		populated with the numeric value passed in paramter,
+//		'numericValue'.
 //
 //	error
 //
@@ -4514,18 +4231,12 @@ func (numStrKernel *NumberStrKernel) NewFromStringDigits(
 //	 	chain and text passed by input parameter, 'errorPrefix'.
 //	 	The 'errorPrefix' text will be attached to the beginning
 //	 	of the error message.
-func (numStrKernel *NumberStrKernel) NewParseCustomNumberStr(
-	rawNumStr string,
-	startSearchIndex int,
-	characterSearchLength int,
-	negativeNumSignSearchSpecs NegNumSearchSpecCollection,
-	decimalSeparator DecimalSeparatorSpec,
-	numParsingTerminators []string,
-	requestRemainderString bool,
+func (numStrKernel *NumberStrKernel) NewFromBigRat(
+	bigRatNum *big.Rat,
+	roundToFractionalDigits int,
 	errorPrefix interface{}) (
-	numberStrSearchResults CharSearchNumStrParseResultsDto,
-	numberStrKernel NumberStrKernel,
-	err error) {
+	NumberStrKernel,
+	error) {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -4536,213 +4247,129 @@ func (numStrKernel *NumberStrKernel) NewParseCustomNumberStr(
 	defer numStrKernel.lock.Unlock()
 
 	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	newNumStrKernel := NumberStrKernel{}
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"NewParseCustomNumberStr()",
+			"NewFromBigRat()",
 		"")
 
 	if err != nil {
-		return numberStrSearchResults, numberStrKernel, err
+		return newNumStrKernel, err
 	}
 
-	numParsingTerminatorsCol := RuneArrayCollection{}
-
-	lenStrArray := len(numParsingTerminators)
+	var nativeNumStr string
 
-	for i := 0; i < lenStrArray; i++ {
+	nativeNumStr,
+		err = new(MathBigRatHelper).
+		BigRatToNativeNumStr(
+			bigRatNum,
+			roundToFractionalDigits,
+			ePrefix)
 
-		err = numParsingTerminatorsCol.AddRuneArrayString(
-			numParsingTerminators[i],
-			CharSearchType.LinearTargetStartingIndex(),
-			ePrefix.XCpy(
-				fmt.Sprintf("numParsingTerminators[%v]",
-					i)))
+	if err != nil {
 
-		if err != nil {
-			return numberStrSearchResults, numberStrKernel, err
-		}
+		return newNumStrKernel, err
 	}
 
-	var runeDto RuneArrayDto
-
-	runeDto,
-		err = RuneArrayDto{}.NewString(
-		rawNumStr,
-		CharSearchType.LinearTargetStartingIndex(),
-		ePrefix.XCpy("rawNumStr"))
+	err = new(numberStrKernelQuark).
+		setNumStrKernelFromNativeNumStr(
+			&newNumStrKernel,
+			nativeNumStr,
+			ePrefix.XCpy(
+				"newNumStrKernel"))
 
-	if err != nil {
-		return numberStrSearchResults, numberStrKernel, err
-	}
+	return newNumStrKernel, err
+}
 
-	numberStrSearchResults,
-		numberStrKernel,
-		err = new(numStrHelperQuark).extractNumRunes(
-		runeDto,
-		"rawNumStr",
-		startSearchIndex,
-		characterSearchLength,
-		negativeNumSignSearchSpecs,
-		decimalSeparator,
-		numParsingTerminatorsCol,
-		requestRemainderString,
-		ePrefix)
-
-	return numberStrSearchResults, numberStrKernel, err
-}
-
-// NewParseDirtyNumberStr
-//
-// Receives a Dirty Number String, extracts a valid
-// Native Number String, calculates a numeric value,
-// and proceeds to configure and return a new instance
-// of NumberStrKernel.
+//	NewFromNumericValue
 //
-// A "Dirty Number String" is a malformed number string
-// containing numeric digits which will be converted to a
-// properly formatted Native Number String and used to
-// configure the returned instance of NumberStrKernel.
+//	Creates a new instance of NumberStrKernel based on
+//	a numeric value passed as an empty interface.
 //
-//	Examples Of Dirty Number Strings
 //
-//		$1,254.65
-//		1 000 000,00 €
-//		1.000.000,00 €
-//		6,78,90,00,00,00,00,000
-//		6,7890,0000,0000,0000
+//	The numeric value passed to this method by input
+//	parameter 'numericValue' MUST BE convertible to one
+//	of the types defined below:
 //
-// All the examples shown above are valid number string
-// formats used by different countries and cultures.
-// There is nothing wrong with these formats. The term
-// "Dirty" simply distinguishes these formats from the
-// Native Number String format required by many
-// functions and packages in the Go Programming
-// Language. The Native Number String format is one
-// which is used as a standard format for numeric
-// conversions performed in the Go Programming Language
-// as well as many other programming languages.
+//		float32, float64, big.Float
+//		*float32, *float64, *big.Float
+//		*BigFloatDto, BigFloatDto
+//		int8, int16, int, int32, int64, big.Int
+//		*int8, *int16, *int, *int32, *int64, *big.Int
+//		uint8, uint16, uint, uint32, uint64
+//		*uint8, *uint16, *uint, *uint32, *uint64
+//		*TextFieldFormatDtoFloat64, TextFieldFormatDtoFloat64
+//		*TextFieldFormatDtoBigFloat, TextFieldFormatDtoBigFloat
+//		*NumberStrKernel, NumberStrKernel
 //
-// The Dirty Number String passed as input parameter
-// 'dirtyNumberStr' is expected to comply with the
-// following requirements:
+//	This numeric value is then used to configure and
+//	return a new instance of NumberStrKernel.
 //
-//  1. The dirty number string must contain numeric
-//     digit characters zero to nine inclusive (0-9).
+// ----------------------------------------------------------------
 //
-//  2. The dirty number string must contain a radix
-//     point or decimal separator to separate
-//     integer and fractional digits in a floating
-//     point numeric value. This decimal separator
-//     is specified by input parameter,
-//     'decimalSeparator'.
+// # BE ADVISED
 //
-//     If no decimal separator is identified in the
-//     dirty number string, the numeric value is
-//     assumed to be an integer value.
+//	(1)	big.Rat Accuracy
+//		This method will default big.Rat and *big.Rat
+//		values default to 2,000 decimal places of
+//		accuracy. Adjust rounding parameters as
+//		required or use the following method for more
+//		granular control over big.Rat rounding:
+//			NewFromNumericValue.NewFromBigRat()
 //
-//  3. The dirty number string must designate
-//     negative numeric values using one of the
-//     following three negative number symbols:
+//	(2) big.Rat Rounding Algorithm
+//		Before being converted to an instance of
+//		NumberStrKernel, the big.Rat numeric value will
+//		be rounded by the Golang 'big' package function:
 //
-//     (a)	A Leading Minus Sign ('-').
-//     Example: -123.45
+//			(*Rat) FloatString
 //
-//     (b)	A Trailing Minus Sign ('-').
-//     Example: 123.45-
+//		The rounding algorithm used is described in the
+//		big.Rat documentation as:
 //
-//     (c) A combination of leading and trailing
-//     Parentheses ('()').
-//     Example: (123.45)
+//			The last digit is rounded to nearest, with
+//			halves rounded away from zero.
+//				https://pkg.go.dev/math/big#Rat
 //
 // ----------------------------------------------------------------
 //
 // # Input Parameters
 //
-//	dirtyNumberStr				string
-//
-//		This number string contains the numeric digits
-//		which will be extracted, converted to a valid
-//		numeric value and used to configure the
-//		returned instance of NumberStrKernel.
-//
-//		The 'dirtyNumberStr' is expected to comply with
-//		the following requirements:
-//
-//		1.	The dirty number string must contain numeric
-//			digit characters zero to nine inclusive (0-9).
-//
-//		2.	The dirty number string must contain a radix
-//			point or decimal separator to separate
-//			integer and fractional digits in a floating
-//			point numeric value. This decimal separator
-//			is specified by input parameter,
-//			'decimalSeparator'.
-//
-//			If no decimal separator is identified in the
-//			dirty number string, the numeric value is
-//			assumed to be an integer value.
-//
-//		3.	The dirty number string must designate
-//			negative numeric values using one of the
-//			following three negative number symbols:
-//
-//			(a)	A Leading Minus Sign ('-').
-//				Example: -123.45
-//
-//			(b)	A Trailing Minus Sign ('-').
-//				Example: 123.45-
-//
-//			(c) A combination of leading and trailing
-//				Parentheses ('()').
-//				Example: (123.45)
-//
-//		If 'dirtyNumberStr' does not contain any numeric
-//		digits, an error will be returned.
-//
-//	decimalSeparator			string
-//
-//		Type DecimalSeparatorSpec is used to specify the
-//		radix point or decimal separator which will
-//		separate integer and fractional digits in the
-//		dirty number string passed as input parameter
-//		'dirtyNumberStr'.
-//
-//		The decimal separator will typically consist of
-//		one or more non-numeric characters.
+//	numericValue				interface{}
 //
-//		If 'decimalSeparator' consists of an empty
-//		or zero length sting, it is assumed that the
-//		numeric value contained in input parameter
-//		'dirtyNumberStr' is an integer value.
+//		Numeric values passed by means of this empty
+//		interface MUST BE convertible to one of the
+//		following types:
 //
-//		In the US, Australia, UK, most of Canada and many
-//		other countries the period ('.'), or decimal
-//		point, separates integer and fractional digits
-//		within a floating point numeric value.
+//			float32, float64, big.Float
+//			*float32, *float64, *big.Float
+//			*BigFloatDto, BigFloatDto
+//			int8, int16, int, int32, int64, big.Int
+//			*int8, *int16, *int, *int32, *int64, *big.Int
+//			uint8, uint16, uint, uint32, uint64
+//			*uint8, *uint16, *uint, *uint32, *uint64
+//			*TextFieldFormatDtoFloat64, TextFieldFormatDtoFloat64
+//			*TextFieldFormatDtoBigFloat, TextFieldFormatDtoBigFloat
+//			*NumberStrKernel, NumberStrKernel
 //
-//		Other countries, including many in the European
-//		Union, use the comma (',') to separate integer
-//		and fractional digits within a number string.
+//		This numeric value will be used to configure and
+//		return a new instance of NumberStrKernel.
 //
-//		If 'decimalSeparator' contains any one of the
-//		following invalid characters, an error will be
+//		If 'numericValue' is NOT convertible to one of
+//		the types listed above, an error will be
 //		returned.
 //
-//			Invalid Decimal Separator Characters
-//							'-'
-//							'('
-//							')'
-//
 //	roundingType				NumberRoundingType
 //
 //		This enumeration parameter is used to specify the
 //		type of rounding algorithm that will be applied for
 //		the	rounding of fractional digits contained in the
-//		new returned instance of NumberStrKernel
-//		(newNumStrKernel).
+//		returned instance of NumberStrKernel.
 //
 //		If in doubt as to a suitable rounding method,
 //		'HalfAwayFromZero' is recommended.
@@ -4975,17 +4602,19 @@ func (numStrKernel *NumberStrKernel) NewParseCustomNumberStr(
 //
 //	roundToFractionalDigits		int
 //
-//		When set to a positive integer value, this
-//		parameter controls the number of digits to the
-//		right of the radix point or decimal separator
-//		(a.k.a. decimal point). This controls the number
-//		of fractional digits remaining after completion
-//		of the number rounding operation.
+//		When set to a positive integer value, this parameter
+//		controls the number of digits to the right of the radix
+//		point or decimal separator (a.k.a. decimal point). This
+//		controls the number of fractional digits remaining after
+//		completion of the number rounding operation.
 //
-//		If input parameter 'roundingType' is set to
-//		NumRoundType.NoRounding(),
-//		'roundToFractionalDigits' is ignored and no
-//		rounding operation is performed.
+//		This parameter is ignored if 'roundingType' is
+//		set to 'NumRoundType.NoRounding()'.
+//
+//		This parameter will be used to control the number
+//		of fractional digits to the right of the decimal
+//		point in the returned instance of
+//		NumberStrKernel.
 //
 //	errorPrefix					interface{}
 //
@@ -5050,100 +4679,31 @@ func (numStrKernel *NumberStrKernel) NewParseCustomNumberStr(
 //
 // # Return Values
 //
-//	newNumStrKernel				NumberStrKernel
-//
-//		If this method completes successfully, a new
-//		instance of NumberStrKernel will be returned
-//		configured with the numeric value parsed from
-//		the Dirty Number String input parameter
-//		'dirtyNumberStr'.
-//
-//	numStrStatsDto				NumberStrStatsDto
-//
-//		If this method completes successfully, an
-//		instance of NumberStrStatsDto will be returned
-//		containing a profile and key statistics on the
-//		numeric value encapsulated in the returned
-//		instance of NumberStrKernel, 'newNumStrKernel'.
-//
-//		type NumberStrStatsDto struct {
-//
-//		NumOfIntegerDigits					uint64
-//
-//			The total number of integer digits to the
-//			left of the radix point or, decimal point, in
-//			the subject numeric value.
-//
-//		NumOfSignificantIntegerDigits		uint64
+//	NumberStrKernel
 //
-//			The number of nonzero integer digits to the
-//			left of the radix point or, decimal point, in
-//			the subject numeric value.
+//		If this method completes successfully, a new instance
+//		of NumberStrKernel will be returned configured and
+//		populated with the numeric value passed in paramter,
+//		'numericValue'.
 //
-//		NumOfFractionalDigits				uint64
+//	error
 //
-//			The total number of fractional digits to the
-//			right of the radix point or, decimal point,
-//			in the subject numeric value.
+//		If this method completes successfully, the returned
+//		error Type is set equal to 'nil'.
 //
-//		NumOfSignificantFractionalDigits	uint64
-//
-//			The number of nonzero fractional digits to
-//			the right of the radix point or, decimal
-//			point, in the subject numeric value.
-//
-//		NumberValueType 					NumericValueType
-//
-//			This enumeration value specifies whether the
-//			subject numeric value is classified either as
-//			an integer or a floating point number.
-//
-//			Possible enumeration values are listed as
-//			follows:
-//				NumValType.None()
-//				NumValType.FloatingPoint()
-//				NumValType.Integer()
-//
-//		NumberSign							NumericSignValueType
-//
-//			An enumeration specifying the number sign
-//			associated with the numeric value. Possible
-//			values are listed as follows:
-//				NumSignVal.None()		= Invalid Value
-//				NumSignVal.Negative()	= -1
-//				NumSignVal.Zero()		=  0
-//				NumSignVal.Positive()	=  1
-//
-//		IsZeroValue							bool
-//
-//			If 'true', the subject numeric value is equal
-//			to zero ('0').
-//
-//			If 'false', the subject numeric value is
-//			greater than or less than zero ('0').
-//		}
-//
-//	err							error
-//
-//		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'. If
-//		errors are encountered during processing, the
-//		returned error Type will encapsulate an error
-//		message.
-//
-//		If an error message is returned, the text value
-//		for input parameter 'errPrefDto' (error prefix)
-//		will be prefixed or attached at the beginning of
-//		the error message.
-func (numStrKernel *NumberStrKernel) NewParseDirtyNumberStr(
-	dirtyNumberStr string,
-	decimalSeparator string,
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error message.
+//	 	This returned error message will incorporate the method
+//	 	chain and text passed by input parameter, 'errorPrefix'.
+//	 	The 'errorPrefix' text will be attached to the beginning
+//	 	of the error message.
+func (numStrKernel *NumberStrKernel) NewFromNumericValue(
+	numericValue interface{},
 	roundingType NumberRoundingType,
 	roundToFractionalDigits int,
 	errorPrefix interface{}) (
-	newNumStrKernel NumberStrKernel,
-	numStrStatsDto NumberStrStatsDto,
-	err error) {
+	NumberStrKernel,
+	error) {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -5154,301 +4714,349 @@ func (numStrKernel *NumberStrKernel) NewParseDirtyNumberStr(
 	defer numStrKernel.lock.Unlock()
 
 	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	newNumStrKernel := NumberStrKernel{}
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"NewParseDirtyNumberStr()",
+			"NewFromNumericValue()",
 		"")
 
 	if err != nil {
-		return newNumStrKernel,
-			numStrStatsDto,
-			err
+		return newNumStrKernel, err
 	}
 
-	numStrStatsDto,
-		err = new(numberStrKernelMechanics).
-		setNumStrKernelFromRoundedDirtyNumStr(
+	err = new(numberStrKernelMolecule).
+		convertNumericValueToKernel(
 			&newNumStrKernel,
-			dirtyNumberStr,
-			decimalSeparator,
+			numericValue,
+			ePrefix)
+
+	if err != nil ||
+		roundingType == NumRoundType.NoRounding() {
+
+		return newNumStrKernel, err
+	}
+
+	var numStrRoundingSpec NumStrRoundingSpec
+
+	numStrRoundingSpec,
+		err =
+		new(NumStrRoundingSpec).NewRoundingSpec(
 			roundingType,
 			roundToFractionalDigits,
-			ePrefix.XCpy(
-				"newNumStrKernel"))
+			ePrefix)
 
-	return newNumStrKernel,
-		numStrStatsDto,
-		err
+	if err != nil {
+		return newNumStrKernel, err
+	}
+
+	err = new(numStrMathRoundingNanobot).roundNumStrKernel(
+		&newNumStrKernel,
+		numStrRoundingSpec,
+		ePrefix)
+
+	return newNumStrKernel, err
 }
 
-//	NewParseFrenchNumberStr
+//	NewFromRuneDto
 //
-//	This method parses an incoming number string
-//	using standard decimal separator and negative
-//	number sign parameters commonly applied in
-//	France.
+//	Creates and returns a new instance of NumberStrKernel
+//	generated from input parameters containing integer
+//	digit and fractional digit Rune Array Data Transfer
+//	Objects (RuneArrayDto).
 //
-//	The radix point or decimal separator used to
-//	separate integer and fractional digits in a
-//	floating point numeric value is assumed to be
-//	the comma (',') character.
+// ----------------------------------------------------------------
 //
-//	The integer digits separator is assumed to be
-//	a space character (' ').
+// # Input Parameters
 //
-//	The negative number sign used to designate
-//	numbers with a negative value is assumed to
-//	be a leading minus sign ('-').
+//	integerDigits				*RuneArrayDto
 //
-//	Within in the European Union many, if not
-//	most, of the member countries subscribe to
-//	the decimal separator and negative number
-//	formatting standards implemented by either
-//	France or Germany.
+//		A pointer to a rune array data transfer object used to
+//		configure the integer digits array contained within
+//		the returned instance of NumberStrKernel.
 //
-//	For information on German Number String
-//	parsing conventions, see method:
+//	fractionalDigits			*RuneArrayDto
 //
-//		NumberStrKernel.NewParseGermanNumberStr()
+//		A pointer to a rune array data transfer object used to
+//		configure the fractional digits array contained within
+//		the returned instance of NumberStrKernel.
 //
-//	If custom decimal separator and negative number
-//	characters are required, see method:
+//	numberSign					NumericSignValueType
 //
-//		NumberStrKernel.NewParseCustomNumberStr()
+//		The Number Sign is specified by means of a
+//		NumericSignValueType enumeration value.
 //
-// ----------------------------------------------------------------
+//		Possible values are listed as follows:
 //
-// # Examples of French Number Strings
+//			NumSignVal.None()     = Ignored. Numeric Value
+//									will be set to positive.
 //
-//	The radix point or decimal separator is set to the
-//	comma character (','):
+//			NumSignVal.Negative() = Valid Value
 //
-//		French Example-1
-//		123,45 (The fractional digits are "45")
+//			NumSignVal.Zero()     = Zero numeric values are
+//									automatically assigned
+//									NumSignVal.Zero().
 //
-//	The integer group separator is a space character
-//	(' ').
+//			NumSignVal.Positive() = Default value for
+//									non-zero numeric values.
 //
-//	The integer group specification is set to 'thousands'.
-//	This means that integer digits will be separated into
-//	'thousands' with each group containing three digits each:
+//		If 'numberSign' is set to any value other than
+//		NumSignVal.Negative(), it will be ignored and
+//		the final number sign will be automatically
+//		assigned.
 //
-//		French Example-2
-//		1 000 000 000
+//	 errorPrefix                interface{}
 //
-//	The negative number sign is set to a leading minus sign
-//	('-').
+//		This object encapsulates error prefix text which is
+//		included in all returned error messages. Usually, it
+//		contains the name of the calling method or methods
+//		listed as a method or function chain of execution.
 //
-//		French Example-3
-//		-1 000 000 000
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//	The positive number sign is set to a blank or empty
-//	string ("").
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//		French Example-4
-//		1 000 000 000
+//		1. nil - A nil value is valid and generates an empty
+//		   collection of error prefix and error context
+//		   information.
 //
-//	The zero number format is set to a blank or empty
-//	string ("").
+//		2. string - A string containing error prefix
+//			information.
 //
-//		French Example-5
-//			0,0
+//		3. []string A one-dimensional slice of strings
+//			containing error prefix information.
 //
-// ----------------------------------------------------------------
+//		4. [][2]string A two-dimensional slice of strings
+//		   containing error prefix and error context
+//		   information.
 //
-// # Reference
+//		5. ErrPrefixDto - An instance of ErrPrefixDto.
+//			Information from this object will be copied for use
+//			in error and informational messages.
 //
-//	https://www.ibm.com/support/pages/english-and-french-currency-formats
-//	https://freeformatter.com/france-standards-code-snippets.html
-//	https://docs.microsoft.com/en-us/globalization/locale/currency-formatting
+//		6. *ErrPrefixDto - A pointer to an instance of
+//			ErrPrefixDto. Information from this object will be
+//			copied for use in error and informational messages.
 //
-// ----------------------------------------------------------------
+//		7. IBasicErrorPrefix - An interface to a method
+//			generating a two-dimensional slice of strings
+//			containing error prefix and error context
+//			information.
 //
-// # Input Parameters
+//		If parameter 'errorPrefix' is NOT convertible to one
+//		of the valid types listed above, it will be
+//		considered invalid and trigger the return of an
+//		error.
 //
-//	rawNumStr					string
+//		Types ErrPrefixDto and IBasicErrorPrefix are included
+//		in the 'errpref' software package,
+//		"github.com/MikeAustin71/errpref".
 //
-//		This parameter is the raw number string
-//		containing numeric digit characters which will
-//		be extracted and processed to identify the
-//		numeric value. This numeric value will in
-//		turn form the basis of the new NumberStrKernel
-//		instance returned to the calling function.
+// ----------------------------------------------------------------
 //
-//	startSearchIndex			int
+// # Return Values
 //
-//		The character index in the Raw Number String
-//		('rawNumStr') at which the search for numeric
-//		digit characters will begin.
+//	NumberStrKernel
 //
-//		Set this value to zero (0) in order	begin the
-//		search for numeric characters at the first
-//		character in the Raw Number String ('rawNumStr').
+//		If this method completes successfully, a new instance
+//		of NumberStrKernel will be returned configured with
+//		the numeric value extracted from input parameters
+//		'integerDigits' and 'fractionalDigits'.
 //
-//	breakOnCharSearchLength		int
+//	error
 //
-//		The actual number of characters within the Raw
-//		Number String ('rawNumStr') that are included in
-//		the search for numeric character digits.
+//		If this method completes successfully, the returned
+//		error Type is set equal to 'nil'.
 //
-//		If this parameter is set to a value greater than
-//		zero ('0'), the Number String Parsing algorithm
-//		will search the specified number of text
-//		characters and then automatically terminate the
-//		search for numeric digits.
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error message.
+//	 	This returned error message will incorporate the method
+//	 	chain and text passed by input parameter, 'errorPrefix'.
+//	 	The 'errorPrefix' text will be attached to the beginning
+//	 	of the error message.
+func (numStrKernel *NumberStrKernel) NewFromRuneDto(
+	integerDigits *RuneArrayDto,
+	fractionalDigits *RuneArrayDto,
+	numberSign NumericSignValueType,
+	errorPrefix interface{}) (
+	NumberStrKernel,
+	error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	newNumStrKernel := NumberStrKernel{}
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"NewFromRuneDto()",
+		"")
+
+	if err != nil {
+		return newNumStrKernel, err
+	}
+
+	err = new(numberStrKernelNanobot).setWithRuneArrayDto(
+		&newNumStrKernel,
+		integerDigits,
+		fractionalDigits,
+		numberSign,
+		ePrefix)
+
+	return newNumStrKernel, err
+}
+
+//	NewFromRuneDigits
 //
-//		If this value is set to value less than one (+1),
-//		the search length will be configured to include
-//		the last character in 'rawNumStr'. In other words
-//		the search for numeric characters will proceed to
-//		the end of the string, 'rawNumStr'.
+//	Creates and returns a new instance of NumberStrKernel
+//	generated from input parameters containing integer
+//	digit and fractional digit rune arrays.
 //
-//	breakOnCharDelimiters		[]string
+// ----------------------------------------------------------------
 //
-//		An array of strings. If any one of these strings
-//		is encountered while searching the Raw Number
-//		String ('rawNumStr'), the search for numeric
-//		digits will be automatically terminated.
+// # BE ADVISED
 //
-//		If this string array is set to nil, this
-//		parameter will be ignored by the Number String
-//		parsing algorithm.
+//	This method will retain leading integer zeros.
+//		Example:
+//								Input String: 0012345.678
+//			Converted Internal Numeric Value: 0012345.678
 //
-//	requestRemainderString		bool
+// ----------------------------------------------------------------
 //
-//		If this parameter is set to 'true', the Number
-//		String Search Results ('numberStrSearchResults')
-//		returned by this method	will include the
-//		remaining string characters	which were NOT
-//		included in the search operation.
+// # Input Parameters
 //
-//		This feature may be useful when either
-//		'characterSearchLength' or 'numParsingTerminators'
-//		parameters are used to arbitrarily terminate the
-//		search operation.
+//	integerDigits				[]rune
 //
+//		A rune array containing numeric digits used to
+//		populate the integer part of the numeric value
+//		contained in the returned instance of
+//		NumberStrKernel.
 //
-//	 errorPrefix                interface{}
+//	fractionalDigits			[]rune
 //
-//		This object encapsulates error prefix text which
-//		is included in all returned error messages.
-//		Usually, it	contains the name of the calling
-//		method or methods listed as a method or function
-//		chain of execution.
+//		A rune array containing numeric digits used to
+//		populate the fractional part of the numeric
+//		value contained in the returned instance of
+//		NumberStrKernel.
 //
-//		If no error prefix information is needed, set this
-//		parameter to 'nil'.
+//	numberSign					NumericSignValueType
 //
-//		This empty interface must be convertible to one of
-//		the following types:
+//		The Number Sign is specified by means of a
+//		NumericSignValueType enumeration value.
 //
-//		1.	nil
-//				A nil value is valid and generates an
-//				empty collection of error prefix and
-//				error context information.
+//		Possible values are listed as follows:
 //
-//		2.	string
-//				A string containing error prefix
-//				information.
+//			NumSignVal.None()     = Ignored. Numeric Value
+//									will be set to positive.
 //
-//		3.	[]string
-//				A one-dimensional slice of strings
-//				containing error prefix information.
+//			NumSignVal.Negative() = Valid Value
 //
-//		4.	[][2]string
-//				A two-dimensional slice of strings
-//		   		containing error prefix and error
-//		   		context information.
+//			NumSignVal.Zero()     = Zero numeric values are
+//									automatically assigned
+//									NumSignVal.Zero().
 //
-//		5.	ErrPrefixDto
-//				An instance of ErrPrefixDto.
-//				Information from this object will
-//				be copied for use in error and
-//				informational messages.
+//			NumSignVal.Positive() = Default value for
+//									non-zero numeric values.
 //
-//		6.	*ErrPrefixDto
-//				A pointer to an instance of
-//				ErrPrefixDto. Information from
-//				this object will be copied for use
-//				in error and informational messages.
+//		If 'numberSign' is set to any value other than
+//		NumSignVal.Negative(), it will be ignored and
+//		the final number sign will be automatically
+//		assigned.
 //
-//		7.	IBasicErrorPrefix
-//				An interface to a method
-//				generating a two-dimensional slice
-//				of strings containing error prefix
-//				and error context information.
+//	 errorPrefix                interface{}
 //
-//		If parameter 'errorPrefix' is NOT convertible
-//		to one of the valid types listed above, it will
-//		be considered invalid and trigger the return of
-//		an error.
+//		This object encapsulates error prefix text which is
+//		included in all returned error messages. Usually, it
+//		contains the name of the calling method or methods
+//		listed as a method or function chain of execution.
 //
-//		Types ErrPrefixDto and IBasicErrorPrefix are
-//		included in the 'errpref' software package:
-//			"github.com/MikeAustin71/errpref".
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-// ----------------------------------------------------------------
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-// # Return Values
+//		1. nil - A nil value is valid and generates an empty
+//		   collection of error prefix and error context
+//		   information.
 //
-//	numberStrSearchResults		CharSearchNumStrParseResultsDto
+//		2. string - A string containing error prefix
+//			information.
 //
-//		This structure contains public member variables
-//		containing operational data describing the
-//		success or failure of the number string parsing
-//		operation and the search for numeric digits in
-//		the Raw Number String, 'rawNumStr'.
+//		3. []string A one-dimensional slice of strings
+//			containing error prefix information.
 //
-//		Users are advised to check the following member
-//		variable values after a number string parsing
-//		operation:
+//		4. [][2]string A two-dimensional slice of strings
+//		   containing error prefix and error context
+//		   information.
 //
-//			FoundNumericDigits		bool
+//		5. ErrPrefixDto - An instance of ErrPrefixDto.
+//			Information from this object will be copied for use
+//			in error and informational messages.
 //
-//				Signals a successful Number String Parsing
-//				operation. When set to 'true', this means
-//				one or more numeric	digit characters ('0'
-//				through '9' inclusive) were	located in the
-//				Raw Number String, 'rawNumStr'.
+//		6. *ErrPrefixDto - A pointer to an instance of
+//			ErrPrefixDto. Information from this object will be
+//			copied for use in error and informational messages.
 //
-//			FoundNonZeroValue		bool
+//		7. IBasicErrorPrefix - An interface to a method
+//			generating a two-dimensional slice of strings
+//			containing error prefix and error context
+//			information.
 //
-//				When set to 'true' this signals that the
-//				search operation has detected one or more
-//				nonzero numeric digits.
+//		If parameter 'errorPrefix' is NOT convertible to one
+//		of the valid types listed above, it will be
+//		considered invalid and trigger the return of an
+//		error.
 //
-//	nStrKernel					NumberStrKernel
+//		Types ErrPrefixDto and IBasicErrorPrefix are included
+//		in the 'errpref' software package,
+//		"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberStrKernel
 //
 //		If this method completes successfully, a new instance
 //		of NumberStrKernel will be returned configured with
-//		the numeric value parsed from the Raw Number String,
-//		'rawNumStr'.
+//		the numeric value extracted from input parameters
+//		'integerDigits' and 'fractionalDigits'.
 //
-//	err							error
+//	error
 //
-//		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
+//		If this method completes successfully, the returned
+//		error Type is set equal to 'nil'.
 //
 //		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error
-//		message. This returned error message will
-//		incorporate the method chain and text passed by
-//		input parameter, 'errorPrefix'. The 'errorPrefix'
-//		text will be attached to the beginning of the
-//		error message.
-func (numStrKernel *NumberStrKernel) NewParseFrenchNumberStr(
-	rawNumStr string,
-	startSearchIndex int,
-	breakOnCharSearchLength int,
-	breakOnCharDelimiters []string,
-	requestRemainderString bool,
-	errorPrefix interface{}) (
-	numberStrSearchResults CharSearchNumStrParseResultsDto,
-	nStrKernel NumberStrKernel,
-	err error) {
+//		returned error Type will encapsulate an error message.
+//	 	This returned error message will incorporate the method
+//	 	chain and text passed by input parameter, 'errorPrefix'.
+//	 	The 'errorPrefix' text will be attached to the beginning
+//	 	of the error message.
+func (numStrKernel *NumberStrKernel) NewFromRuneDigits(
+	integerDigits []rune,
+	fractionalDigits []rune,
+	numberSign NumericSignValueType,
+	errorPrefix interface{}) (
+	NumberStrKernel,
+	error) {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -5459,240 +5067,244 @@ func (numStrKernel *NumberStrKernel) NewParseFrenchNumberStr(
 	defer numStrKernel.lock.Unlock()
 
 	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	newNumStrKernel := NumberStrKernel{}
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"NewParseFrenchNumberStr()",
+			"NewFromRuneDigits()",
 		"")
 
 	if err != nil {
-		return numberStrSearchResults, nStrKernel, err
-	}
-
-	numParsingTerminatorsCol := RuneArrayCollection{}
-
-	lenStrArray := len(breakOnCharDelimiters)
-
-	for i := 0; i < lenStrArray; i++ {
-
-		err = numParsingTerminatorsCol.AddStringDefault(
-			breakOnCharDelimiters[i],
-			ePrefix.XCpy(
-				fmt.Sprintf("breakOnCharDelimiters[%v]",
-					i)))
-
-		if err != nil {
-			return numberStrSearchResults, nStrKernel, err
-		}
-	}
-
-	var runeDto RuneArrayDto
-
-	runeDto = RuneArrayDto{}.NewStringDefault(
-		rawNumStr)
-
-	var decSeparator DecimalSeparatorSpec
-
-	decSeparator,
-		err = new(DecimalSeparatorSpec).NewStr(
-		",",
-		ePrefix.XCpy("decSeparator"))
-
-	if err != nil {
-
-		return numberStrSearchResults, nStrKernel, err
-	}
-
-	negativeNumSearchSpecs := NegNumSearchSpecCollection{}
-
-	err = negativeNumSearchSpecs.AddLeadingNegNumSearchStr(
-		"-",
-		ePrefix.XCpy("Leading minus sign '-'"))
-
-	if err != nil {
-
-		return numberStrSearchResults, nStrKernel, err
+		return newNumStrKernel, err
 	}
 
-	numberStrSearchResults,
-		nStrKernel,
-		err = new(numStrHelperQuark).extractNumRunes(
-		runeDto,
-		"rawNumStr",
-		startSearchIndex,
-		breakOnCharSearchLength,
-		negativeNumSearchSpecs,
-		decSeparator,
-		numParsingTerminatorsCol,
-		requestRemainderString,
+	err = new(numberStrKernelNanobot).setWithRunes(
+		&newNumStrKernel,
+		integerDigits,
+		fractionalDigits,
+		numberSign,
 		ePrefix)
 
-	return numberStrSearchResults, nStrKernel, err
+	return newNumStrKernel, err
 }
 
-//	NewParseGermanNumberStr
+//	NewFromStringDigits
 //
-//	This method parses an incoming number string
-//	using standard decimal separator and negative
-//	number sign parameters commonly applied in
-//	Germany.
+//	Creates and returns a new instance of NumberStrKernel
+//	generated from input parameters containing integer
+//	digit and fractional digit strings.
 //
-//	The radix point or decimal separator used to
-//	separate integer and fractional digits in a
-//	floating point numeric value is assumed to be
-//	the comma (',') character.
+// ----------------------------------------------------------------
 //
-//	The integer digits separator is assumed to be
-//	a period character ('.').
+// # BE ADVISED
 //
-//	The negative number sign used to designate
-//	numbers with a negative value is assumed to
-//	be a trailing minus sign ('-').
+//	This method will retain leading integer zeros.
+//		Example:
+//								Input String: 0012345.678
+//			Converted Internal Numeric Value: 0012345.678
 //
 // ----------------------------------------------------------------
 //
-// # German Number String Examples
+// # Input Parameters
 //
-//	The radix point or decimal separator is set to the
-//	comma character (','):
+//	integerDigits				string
 //
-//		German Example-1
-//			123,45 (The fractional digits are "45")
+//		A string of numeric digits used to populate the
+//		integer part of the numeric value contained in the
+//		returned instance of NumberStrKernel.
 //
-//	The integer group separator is a space character
-//	('.').
+//		This string should contain only numeric digit
+//		characters. Do NOT include number signs such as
+//		minus signs.
 //
-//	The integer group specification is set to
-//	'thousands'. This means that integer digits will be
-//	separated into 'thousands' with each group containing
-//	three digits each:
+//	fractionalDigits			string
 //
-//		German Example-2:
-//		1.000.000,00
+//		A string of numeric digits used to populate the
+//		fractional part of the numeric value contained in
+//		the returned instance of NumberStrKernel
 //
-//	The currency symbol used in the Germany is the
-//	Euro symbol ('€').
+//		This string should contain only numeric digit
+//		characters. Do NOT include number signs such as
+//		minus signs.
 //
-//		German Example-3
-//		1.000.000,00 €
+//	numberSign					NumericSignValueType
 //
-//	The negative number sign is set to a trailing minus
-//	sign ('-').
+//		The Number Sign is specified by means of a
+//		NumericSignValueType enumeration value.
 //
-//		German Example-4
-//		1.000.000,00- €
+//		Possible values are listed as follows:
 //
-//	The positive number sign is set to a trailing
-//	Euro symbol.
+//			NumSignVal.None()     = Ignored. Numeric Value
+//									will be set to positive.
 //
-//		German Example-5
-//		1.000.000,00 €
+//			NumSignVal.Negative() = Valid Value
 //
-//	The zero number format is set to a trailing
-//	Euro symbol.
+//			NumSignVal.Zero()     = Zero numeric values are
+//									automatically assigned
+//									NumSignVal.Zero().
 //
-//		German Example-6
-//			0,00 €
+//			NumSignVal.Positive() = Default value for
+//									non-zero numeric values.
 //
-// ----------------------------------------------------------------
+//		If 'numberSign' is set to any value other than
+//		NumSignVal.Negative(), it will be ignored and
+//		the final number sign will be automatically
+//		assigned.
 //
-// # BE ADVISED
+//	 errorPrefix                interface{}
 //
-//	Within in the European Union many, if not
-//	most, of the member countries subscribe to
-//	the decimal separator and negative number
-//	formatting standards implemented by either
-//	Germany or France.
+//		This object encapsulates error prefix text which is
+//		included in all returned error messages. Usually, it
+//		contains the name of the calling method or methods
+//		listed as a method or function chain of execution.
 //
-//	For information on French Number String
-//	parsing conventions, see method:
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//		NumberStrKernel.NewParseFrenchNumberStr()
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//	If custom decimal separator and negative number
-//	characters are required, see method:
+//		1. nil - A nil value is valid and generates an empty
+//		   collection of error prefix and error context
+//		   information.
 //
-//		NumberStrKernel.NewParseCustomNumberStr()
+//		2. string - A string containing error prefix
+//			information.
 //
-// ----------------------------------------------------------------
+//		3. []string A one-dimensional slice of strings
+//			containing error prefix information.
 //
-// # Reference
+//		4. [][2]string A two-dimensional slice of strings
+//		   containing error prefix and error context
+//		   information.
 //
-// https://freeformatter.com/germany-standards-code-snippets.html
-// https://www.evertype.com/standards/euro/formats.html
+//		5. ErrPrefixDto - An instance of ErrPrefixDto.
+//			Information from this object will be copied for use
+//			in error and informational messages.
 //
-// ----------------------------------------------------------------
+//		6. *ErrPrefixDto - A pointer to an instance of
+//			ErrPrefixDto. Information from this object will be
+//			copied for use in error and informational messages.
 //
-// # Input Parameters
+//		7. IBasicErrorPrefix - An interface to a method
+//			generating a two-dimensional slice of strings
+//			containing error prefix and error context
+//			information.
 //
-//	rawNumStr					string
+//		If parameter 'errorPrefix' is NOT convertible to one
+//		of the valid types listed above, it will be
+//		considered invalid and trigger the return of an
+//		error.
 //
-//		This parameter is the raw number string
-//		containing numeric digit characters which will
-//		be extracted and processed to identify the
-//		numeric value. This numeric value will in
-//		turn form the basis of the new NumberStrKernel
-//		instance returned to the calling function.
+//		Types ErrPrefixDto and IBasicErrorPrefix are included
+//		in the 'errpref' software package,
+//		"github.com/MikeAustin71/errpref".
 //
-//	startSearchIndex			int
+// ----------------------------------------------------------------
 //
-//		The character index in the Raw Number String
-//		('rawNumStr') at which the search for numeric
-//		digit characters will begin.
+// # Return Values
 //
-//		Set this value to zero (0) in order	begin the
-//		search for numeric characters at the first
-//		character in the Raw Number String ('rawNumStr').
+//	NumberStrKernel
 //
-//	breakOnCharSearchLength		int
+//		If this method completes successfully, a new instance
+//		of NumberStrKernel will be returned configured with
+//		the numeric value extracted from input parameters
+//		'integerDigits' and 'fractionalDigits'.
 //
-//		The actual number of characters within the Raw
-//		Number String ('rawNumStr') that are included in
-//		the search for numeric character digits.
+//	error
 //
-//		If this parameter is set to a value greater than
-//		zero ('0'), the Number String Parsing algorithm
-//		will search the specified number of text
-//		characters and then automatically terminate the
-//		search for numeric digits.
+//		If this method completes successfully, the returned
+//		error Type is set equal to 'nil'.
 //
-//		If this value is set to value less than one (+1),
-//		the search length will be configured to include
-//		the last character in 'rawNumStr'. In other words
-//		the search for numeric characters will proceed to
-//		the end of the string, 'rawNumStr'.
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error message.
+//	 	This returned error message will incorporate the method
+//	 	chain and text passed by input parameter, 'errorPrefix'.
+//	 	The 'errorPrefix' text will be attached to the beginning
+//	 	of the error message.
+func (numStrKernel *NumberStrKernel) NewFromStringDigits(
+	integerDigits string,
+	fractionalDigits string,
+	numberSign NumericSignValueType,
+	errorPrefix interface{}) (
+	NumberStrKernel,
+	error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	newNumStrKernel := NumberStrKernel{}
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"NewFromStringDigits()",
+		"")
+
+	if err != nil {
+		return newNumStrKernel, err
+	}
+
+	err = new(numberStrKernelNanobot).setWithRunes(
+		&newNumStrKernel,
+		[]rune(integerDigits),
+		[]rune(fractionalDigits),
+		numberSign,
+		ePrefix)
+
+	return newNumStrKernel, err
+}
+
+// NewParseBinaryNumberStr
 //
-//	breakOnCharDelimiters		[]string
+//	Receives a binary number string and returns
+//	the extracted integer value as a new instance of
+//	NumberStrKernel.
 //
-//		An array of strings. If any one of these strings
-//		is encountered while searching the Raw Number
-//		String ('rawNumStr'), the search for numeric
-//		digits will be automatically terminated.
+//	Leading and trailing white space is ignored. The
+//	number string may begin with a leading plus ('+')
+//	or minus ('-') sign. Underscore characters ('_')
+//	may be used to separate digits. An underscore may
+//	appear between two digits or directly after the
+//	radix prefix.
 //
-//		If this string array is set to nil, this
-//		parameter will be ignored by the Number String
-//		parsing algorithm.
+//	The parsed value is converted to base 10 and may
+//	be of any size. There is no int64 overflow limit.
 //
-//	requestRemainderString		bool
+//	Examples:
+//		"0b1010"		= 10
+//		"-1111_0000"	= -240
 //
-//		If this parameter is set to 'true', the Number
-//		String Search Results ('numberStrSearchResults')
-//		returned by this method	will include the
-//		remaining string characters	which were NOT
-//		included in the search operation.
+// ----------------------------------------------------------------
 //
-//		This feature may be useful when either
-//		'characterSearchLength' or 'numParsingTerminators'
-//		parameters are used to arbitrarily terminate the
-//		search operation.
+// # Input Parameters
 //
-//	 errorPrefix                interface{}
+//	radixNumStr					string
+//
+//		A binary number string. The radix prefix
+//		("0b") is optional. If any character other
+//		than a binary digit, a sign, a digit
+//		separator ('_') or the radix prefix is
+//		encountered, an error will be returned.
+//
+//	errorPrefix					interface{}
 //
 //		This object encapsulates error prefix text which
 //		is included in all returned error messages.
-//		Usually, it	contains the name of the calling
+//		Usually, it contains the name of the calling
 //		method or methods listed as a method or function
 //		chain of execution.
 //
@@ -5751,62 +5363,89 @@ func (numStrKernel *NumberStrKernel) NewParseFrenchNumberStr(
 //
 // # Return Values
 //
-//	numberStrSearchResults		CharSearchNumStrParseResultsDto
+//	newNumStrKernel				NumberStrKernel
 //
-//		This structure contains public member variables
-//		containing operational data describing the
-//		success or failure of the number string parsing
-//		operation and the search for numeric digits in
-//		the Raw Number String, 'rawNumStr'.
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		containing the base 10 integer value parsed
+//		from 'radixNumStr'.
 //
-//		Users are advised to check the following member
-//		variable values after a number string parsing
-//		operation:
+//	numStrStatsDto				NumberStrStatsDto
 //
-//			FoundNumericDigits		bool
+//		This data transfer object will return key
+//		statistics on the numeric value encapsulated
+//		by the returned instance of NumberStrKernel,
+//		'newNumStrKernel'.
 //
-//				Signals a successful Number String Parsing
-//				operation. When set to 'true', this means
-//				one or more numeric	digit characters ('0'
-//				through '9' inclusive) were	located in the
-//				Raw Number String, 'rawNumStr'.
+//		type NumberStrStatsDto struct {
 //
-//			FoundNonZeroValue		bool
+//			NumOfIntegerDigits					uint64
 //
-//				When set to 'true' this signals that the
-//				search operation has detected one or more
-//				nonzero numeric digits.
+//				The total number of integer digits to the
+//				left of the radix point or, decimal point, in
+//				the subject numeric value.
 //
-//	nStrKernel					NumberStrKernel
+//			NumOfSignificantIntegerDigits		uint64
 //
-//		If this method completes successfully, a new instance
-//		of NumberStrKernel will be returned configured with
-//		the numeric value parsed from the Raw Number String,
-//		'rawNumStr'.
+//				The number of nonzero integer digits to the
+//				left of the radix point or, decimal point, in
+//				the subject numeric value.
+//
+//			NumOfFractionalDigits				uint64
+//
+//				The total number of fractional digits to the
+//				right of the radix point or, decimal point,
+//				in the subject numeric value.
+//
+//			NumOfSignificantFractionalDigits	uint64
+//
+//				The number of nonzero fractional digits to
+//				the right of the radix point or, decimal
+//				point, in the subject numeric value.
+//
+//			NumberValueType 					NumericValueType
+//
+//				This enumeration value specifies whether the
+//				subject numeric value is classified either as
+//				an integer or a floating point number.
+//
+//			NumberSign							NumericSignValueType
+//
+//				An enumeration specifying the number sign
+//				associated with the numeric value. Possible
+//				values are listed as follows:
+//					NumSignVal.None()		= Invalid Value
+//					NumSignVal.Negative()	= -1
+//					NumSignVal.Zero()		=  0
+//					NumSignVal.Positive()	=  1
+//
+//			IsZeroValue							bool
+//
+//				If 'true', the subject numeric value is equal
+//				to zero ('0').
+//
+//				If 'false', the subject numeric value is
+//				greater than or less than zero ('0').
+//		}
 //
 //	err							error
 //
 //		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
-//
-//		If errors are encountered during processing, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
 //		returned error Type will encapsulate an error
-//		message. This returned error message will
-//		incorporate the method chain and text passed by
-//		input parameter, 'errorPrefix'. The 'errorPrefix'
-//		text will be attached to the beginning of the
-//		error message.
-func (numStrKernel *NumberStrKernel) NewParseGermanNumberStr(
-	rawNumStr string,
-	startSearchIndex int,
-	breakOnCharSearchLength int,
-	breakOnCharDelimiters []string,
-	requestRemainderString bool,
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) NewParseBinaryNumberStr(
+	radixNumStr string,
 	errorPrefix interface{}) (
-	numberStrSearchResults CharSearchNumStrParseResultsDto,
-	nStrKernel NumberStrKernel,
+	newNumStrKernel NumberStrKernel,
+	numStrStatsDto NumberStrStatsDto,
 	err error) {
-
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
 	}
@@ -5821,578 +5460,800 @@ func (numStrKernel *NumberStrKernel) NewParseGermanNumberStr(
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"NewParseGermanNumberStr()",
+			"NewParseBinaryNumberStr()",
 		"")
 
 	if err != nil {
-		return numberStrSearchResults, nStrKernel, err
+		return newNumStrKernel,
+			numStrStatsDto,
+			err
 	}
 
-	numParsingTerminatorsCol := RuneArrayCollection{}
-
-	lenStrArray := len(breakOnCharDelimiters)
-
-	for i := 0; i < lenStrArray; i++ {
-
-		err = numParsingTerminatorsCol.AddStringDefault(
-			breakOnCharDelimiters[i],
+	numStrStatsDto,
+		err = new(numberStrKernelMechanics).
+		setNumStrKernelFromRadixNumStr(
+			&newNumStrKernel,
+			radixNumStr,
+			NumStrFmtType.Binary(),
 			ePrefix.XCpy(
-				fmt.Sprintf("breakOnCharDelimiters[%v]",
-					i)))
-
-		if err != nil {
-			return numberStrSearchResults, nStrKernel, err
-		}
-	}
-
-	var runeDto RuneArrayDto
-
-	runeDto = RuneArrayDto{}.NewStringDefault(
-		rawNumStr)
-
-	if err != nil {
-
-		return numberStrSearchResults, nStrKernel, err
-	}
-
-	var decSeparator DecimalSeparatorSpec
-
-	decSeparator,
-		err = new(DecimalSeparatorSpec).NewStr(
-		",",
-		ePrefix.XCpy("decSeparator"))
-
-	if err != nil {
-
-		return numberStrSearchResults, nStrKernel, err
-	}
-
-	negativeNumSearchSpecs := NegNumSearchSpecCollection{}
-
-	err = negativeNumSearchSpecs.AddTrailingNegNumSearchStr(
-		"-",
-		ePrefix.XCpy("Trailing minus sign '-'"))
-
-	if err != nil {
-
-		return numberStrSearchResults, nStrKernel, err
-	}
-
-	numberStrSearchResults,
-		nStrKernel,
-		err = new(numStrHelperQuark).extractNumRunes(
-		runeDto,
-		"rawNumStr",
-		startSearchIndex,
-		breakOnCharSearchLength,
-		negativeNumSearchSpecs,
-		decSeparator,
-		numParsingTerminatorsCol,
-		requestRemainderString,
-		ePrefix)
+				"newNumStrKernel"))
 
-	return numberStrSearchResults, nStrKernel, err
+	return newNumStrKernel,
+		numStrStatsDto,
+		err
 }
 
-// NewParseNativeNumberStr
+//	NewParseCustomNumberStr
 //
-// Receives a Native Number String, calculates the
-// numeric value contained therein and proceeds to
-// configure and return a new instance of NumberStrKernel
-// using that calculated numeric value.
+//	Receives a raw or dirty number string and proceeds to
+//	return the extracted numeric value as a type
+//	NumberStrKernel.
 //
-// The term 'Native Number String' means that the number
-// string format is designed to interoperate with the
-// Golang programming language library functions and
-// packages. Types like 'strconv', 'strings', 'math'
-// and 'big' (big.Int, big.Float, big.Rat) routinely
-// parse and convert this type of number string to
-// numeric values. In addition, Native Number Strings are
-// frequently consumed by external library functions such
-// as this one (String Mechanics 'strmech') to convert
-// strings to numeric values and numeric values to
-// strings.
+//	This method provides a series of custom parsing
+//	parameters which allows users to exercise granular
+//	control over the number string parsing operation.
 //
-// While this format is inconsistent with many national
-// and cultural formatting conventions, number strings
-// which fail to implement this standardized formatting
-// protocol will generate errors in some Golang library
-// functions.
+//	Native digits in the Arabic-Indic, Extended
+//	Arabic-Indic, Devanagari, Thai and full-width digit
+//	systems are recognized and normalized to internal
+//	digit values. See type NumberDigitSystem.
 //
-//	Examples Of Native Number Strings
-//		1000000
-//		12.5483
-//		-1000000
-//		-12.5483
+//	All numeric digits in 'rawNumStr' must belong to the
+//	same digit system. Strings mixing digits from two or
+//	more digit systems, such as "1٢3", are rejected with
+//	an error.
 //
-// A valid Native Number String must conform to the
-// standardized formatting criteria defined below:
-//
-//  1. A Native Number String Consists of numeric
-//     character digits zero through nine inclusive
-//     (0-9).
-//
-//  2. A Native Number String will include a period
-//     or decimal point ('.') to separate integer and
-//     fractional digits within a number string.
+// ----------------------------------------------------------------
 //
-//     Native Number String Floating Point Value:
-//     123.1234
+// # Input Parameters
 //
-//  3. A Native Number String will always format
-//     negative numeric values with a leading minus sign
-//     ('-').
+//	rawNumStr					string
 //
-//     Native Number String Negative Value:
-//     -123.2
+//		This parameter is the raw number string
+//		containing numeric digit characters which will
+//		be extracted and processed to identify the
+//		numeric value. This numeric value will in
+//		turn form the basis of the new NumberStrKernel
+//		instance returned to the calling function.
 //
-//  4. A Native Number String WILL NEVER include integer
-//     separators such as commas (',') to separate
-//     integer digits by thousands.
+//	startSearchIndex			int
 //
-//     NOT THIS: 1,000,000
-//     Native Number String: 1000000
+//		The character index in the Raw Number String
+//		('rawNumStr') at which the search for numeric
+//		digit characters will begin.
 //
-//  5. Native Number Strings will only consist of:
+//		Set this value to zero (0) in order	begin the
+//		search for numeric characters at the first
+//		character in the Raw Number String ('rawNumStr').
 //
-//     (a)	Numeric digits zero through nine inclusive (0-9).
+//	characterSearchLength		int
 //
-//     (b)	A decimal point ('.') for floating point
-//     numbers.
+//		The actual number of characters within the Raw
+//		Number String ('rawNumStr') that are included in
+//		the search for numeric character digits.
 //
-//     (c)	A leading minus sign ('-') in the case of
-//     negative numeric values.
+//		If this value is set to -1, the search length will
+//		be configured to include the last index in
+//		'rawNumStr'. In other words the search will proceed
+//		to the end of 'rawNumStr'.
 //
-//  6. A Native Number String will NEVER include
-//     currency symbols.
+//	negativeNumSignSearchSpecs	NegNumSearchSpecCollection
 //
-//  7. A Native Number String will NEVER include
-//     leading integer zeros or trailing fractional
-//     zeros.
+//		Type NegNumSearchSpecCollection is a collection of
+//		NegativeNumberSearchSpec object. This collection
+//		represents all the negative number signs which
+//		might be located with the Raw Number String
+//		('rawNumStr').
 //
-// ----------------------------------------------------------------
+//		This collection allows the flexibility for
+//		locating such negative number signs as leading
+//		minus signs ('-'), trailing minus signs ('-'),
+//		parentheses ('()') or any other negative number
+//		sign characters which might be associated with
+//		a particular national or cultural usage.
 //
-// # BE ADVISED
+//	decimalSeparator			DecimalSeparatorSpec
 //
-//	This method will delete any leading integer zero values
-//	or trailing fractional zeros.
+//		Type DecimalSeparatorSpec is used to specify the
+//		radix point or decimal separator which will
+//		separate integer and fractional digits in the
+//		Raw Number String ('rawNumStr'). In the US, the
+//		period ('.') or decimal point separates integer
+//		and fractional digits within a floating point
+//		numeric value. In Europe, the comma (',')
+//		performs this function.
 //
-//		Example:
-//			Input String:  00123.45600
-//			Converted Native Number String: 123.456
+//	numParsingTerminators		[]string
 //
-// ----------------------------------------------------------------
+//		An array of strings. If any one of these strings
+//		is encountered while searching the Raw Number
+//		String ('rawNumStr'), the search operation will
+//		be immediately terminated.
 //
-// # Input Parameters
+//	requestRemainderString		bool
 //
-//		nativeNumStr				string
+//		If this parameter is set to 'true', the Number
+//		String Search Results ('numberStrSearchResults')
+//		returned by this method	will include the
+//		remaining string characters	which were NOT
+//		included in the search operation.
 //
-//			A Native Number String containing the numeric
-//			character digits which will be converted to, and
-//			stored in, the NumberStrKernel object passed as
-//			input parameter 'numStrKernel'.
+//		This feature may be useful when either
+//		'characterSearchLength' or 'numParsingTerminators'
+//		parameters are used to arbitrarily terminate the
+//		search operation.
 //
-//			The term 'Native Number String' means that the
-//			number string format is designed to interoperate
-//			with the Golang programming language library
-//			functions and packages. Types like 'strconv',
-//			'strings', 'math' and 'big' (big.Int, big.Float,
-//			big.Rat) routinely parse and convert this type of
-//			number string to generate numeric values. In
-//			addition, Native Number Strings are frequently
-//			consumed by external library functions such	as
-//			this one (String Mechanics 'strmech') to convert
-//			strings to numeric values and numeric values to
-//			strings.
+//	 errorPrefix                interface{}
 //
-//			If 'nativeNumStr' fails to meet the formatting
-//			criteria for a Native Number String defined
-//			below, an error will be returned.
+//		This object encapsulates error prefix text which is
+//		included in all returned error messages. Usually, it
+//		contains the name of the calling method or methods
+//		listed as a method or function chain of execution.
 //
-//			A valid Native Number String must conform to the
-//			standardized formatting criteria defined below:
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//		 	1.	A Native Number String Consists of numeric
-//		 	  	character digits zero through nine inclusive
-//		 	  	(0-9).
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//		 	2.	A Native Number String will include a period
-//		 	  	or decimal point ('.') to separate integer and
-//		 	  	fractional digits within a number string.
+//		1. nil - A nil value is valid and generates an empty
+//		   collection of error prefix and error context
+//		   information.
 //
-//		 	  	Native Number String Floating Point Value:
-//		 	   				123.1234
+//		2. string - A string containing error prefix
+//			information.
 //
-//		 	3.	A Native Number String will always format
-//		 	  	negative numeric values with a leading minus sign
-//		 	  	('-').
+//		3. []string A one-dimensional slice of strings
+//			containing error prefix information.
 //
-//		 	  	Native Number String Negative Value:
-//		 	  					-123.2
+//		4. [][2]string A two-dimensional slice of strings
+//		   containing error prefix and error context
+//		   information.
 //
-//		 	4.	A Native Number String WILL NEVER include integer
-//				separators such as commas (',') to separate
-//				integer digits by thousands.
+//		5. ErrPrefixDto - An instance of ErrPrefixDto.
+//			Information from this object will be copied for use
+//			in error and informational messages.
 //
-//		 	   					NOT THIS: 1,000,000
-//		 	   		Native Number String: 1000000
+//		6. *ErrPrefixDto - A pointer to an instance of
+//			ErrPrefixDto. Information from this object will be
+//			copied for use in error and informational messages.
 //
-//		 	5.	Native Number Strings will only consist of:
+//		7. IBasicErrorPrefix - An interface to a method
+//			generating a two-dimensional slice of strings
+//			containing error prefix and error context
+//			information.
 //
-//				(a)	Numeric digits zero through nine inclusive (0-9).
+//		If parameter 'errorPrefix' is NOT convertible to one
+//		of the valid types listed above, it will be
+//		considered invalid and trigger the return of an
+//		error.
 //
-//				(b)	A decimal point ('.') for floating point
-//					numbers.
+//		Types ErrPrefixDto and IBasicErrorPrefix are included
+//		in the 'errpref' software package,
+//		"github.com/MikeAustin71/errpref".
 //
-//				(c)	A leading minus sign ('-') in the case of
-//					negative numeric values.
+// ----------------------------------------------------------------
 //
-//			6.	A Native Number String will NEVER include
-//				currency symbols.
+// # Return Values
 //
-//	 	7.	A Native Number String will NEVER include
-//				leading integer zeros or trailing fractional
-//				zeros.
+//	numberStrSearchResults		CharSearchNumStrParseResultsDto
 //
-//		roundingType				NumberRoundingType
+//		This structure contains public member variables
+//		containing operational data describing the
+//		success or failure of the number string parsing
+//		operation and the search for numeric digits in
+//		the Raw Number String, 'rawNumStr'.
 //
-//			This enumeration parameter is used to specify the
-//			type of rounding algorithm that will be applied for
-//			the	rounding of fractional digits contained in the
-//			new returned instance of NumberStrKernel
-//			(newNumStrKernel).
+//		Users are advised to check the following member
+//		variable values after a number string parsing
+//		operation:
 //
-//			If in doubt as to a suitable rounding method,
-//			'HalfAwayFromZero' is recommended.
+//			FoundNumericDigits		bool
 //
-//			Possible values are listed as follows:
-//				NumRoundType.None()	- Invalid Value
-//				NumRoundType.NoRounding()
-//				NumRoundType.HalfUpWithNegNums()
-//				NumRoundType.HalfDownWithNegNums()
-//				NumRoundType.HalfAwayFromZero()
-//				NumRoundType.HalfTowardsZero()
-//				NumRoundType.HalfToEven()
-//				NumRoundType.HalfToOdd()
-//				NumRoundType.Randomly()
-//				NumRoundType.Floor()
-//				NumRoundType.Ceiling()
-//				NumRoundType.Truncate()
+//				Signals a successful Number String Parsing
+//				operation. When set to 'true', this means
+//				one or more numeric	digit characters ('0'
+//				through '9' inclusive) were	located in the
+//				Raw Number String, 'rawNumStr'.
 //
-//			Definitions:
+//			FoundNonZeroValue		bool
 //
-//				NoRounding
+//				When set to 'true' this signals that the
+//				search operation has detected one or more
+//				nonzero numeric digits.
 //
-//					Signals that no rounding operation will be
-//					performed on fractional digits. The
-//					fractional digits will therefore remain
-//					unchanged.
+//	NumberStrKernel
 //
-//				HalfUpWithNegNums
+//		If this method completes successfully, a new instance
+//		of NumberStrKernel will be returned configured with
+//		the numeric value parsed from the Raw Number String,
+//		'rawNumStr'.
 //
-//					Half Round Up Including Negative Numbers.
-//					This method is intuitive but may produce
-//					unexpected results when applied to negative
-//					numbers.
+//	error
 //
-//					'HalfUpWithNegNums' rounds .5 up.
+//		If this method completes successfully, the returned
+//		error Type is set equal to 'nil'.
 //
-//						Examples of 'HalfUpWithNegNums'
-//						7.6 rounds up to 8
-//						7.5 rounds up to 8
-//						7.4 rounds down to 7
-//						-7.4 rounds up to -7
-//						-7.5 rounds up to -7
-//						-7.6 rounds down to -8
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error message.
+//	 	This returned error message will incorporate the method
+//	 	chain and text passed by input parameter, 'errorPrefix'.
+//	 	The 'errorPrefix' text will be attached to the beginning
+//	 	of the error message.
+func (numStrKernel *NumberStrKernel) NewParseCustomNumberStr(
+	rawNumStr string,
+	startSearchIndex int,
+	characterSearchLength int,
+	negativeNumSignSearchSpecs NegNumSearchSpecCollection,
+	decimalSeparator DecimalSeparatorSpec,
+	numParsingTerminators []string,
+	requestRemainderString bool,
+	errorPrefix interface{}) (
+	numberStrSearchResults CharSearchNumStrParseResultsDto,
+	numberStrKernel NumberStrKernel,
+	err error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"NewParseCustomNumberStr()",
+		"")
+
+	if err != nil {
+		return numberStrSearchResults, numberStrKernel, err
+	}
+
+	numParsingTerminatorsCol := RuneArrayCollection{}
+
+	lenStrArray := len(numParsingTerminators)
+
+	for i := 0; i < lenStrArray; i++ {
+
+		err = numParsingTerminatorsCol.AddRuneArrayString(
+			numParsingTerminators[i],
+			CharSearchType.LinearTargetStartingIndex(),
+			ePrefix.XCpy(
+				fmt.Sprintf("numParsingTerminators[%v]",
+					i)))
+
+		if err != nil {
+			return numberStrSearchResults, numberStrKernel, err
+		}
+	}
+
+	var runeDto RuneArrayDto
+
+	runeDto,
+		err = RuneArrayDto{}.NewString(
+		rawNumStr,
+		CharSearchType.LinearTargetStartingIndex(),
+		ePrefix.XCpy("rawNumStr"))
+
+	if err != nil {
+		return numberStrSearchResults, numberStrKernel, err
+	}
+
+	numberStrSearchResults,
+		numberStrKernel,
+		err = new(numStrHelperQuark).extractNumRunes(
+		runeDto,
+		"rawNumStr",
+		startSearchIndex,
+		characterSearchLength,
+		negativeNumSignSearchSpecs,
+		decimalSeparator,
+		numParsingTerminatorsCol,
+		requestRemainderString,
+		ePrefix)
+
+	return numberStrSearchResults, numberStrKernel, err
+}
+
+// NewParseDirtyNumberStr
 //
-//				HalfDownWithNegNums
+// Receives a Dirty Number String, extracts a valid
+// Native Number String, calculates a numeric value,
+// and proceeds to configure and return a new instance
+// of NumberStrKernel.
 //
-//				Half Round Down Including Negative Numbers. This
-//				method is also considered intuitive but may
-//				produce unexpected results when applied to
-//				negative numbers.
+// A "Dirty Number String" is a malformed number string
+// containing numeric digits which will be converted to a
+// properly formatted Native Number String and used to
+// configure the returned instance of NumberStrKernel.
 //
-//				'HalfDownWithNegNums' rounds .5 down.
+//	Examples Of Dirty Number Strings
 //
-//					Examples of HalfDownWithNegNums
+//		$1,254.65
+//		1 000 000,00 €
+//		1.000.000,00 €
+//		6,78,90,00,00,00,00,000
+//		6,7890,0000,0000,0000
 //
-//					7.6 rounds up to 8
-//					7.5 rounds down to 7
-//					7.4 rounds down to 7
-//					-7.4 rounds up to -7
-//					-7.5 rounds down to -8
-//					-7.6 rounds down to -8
+// All the examples shown above are valid number string
+// formats used by different countries and cultures.
+// There is nothing wrong with these formats. The term
+// "Dirty" simply distinguishes these formats from the
+// Native Number String format required by many
+// functions and packages in the Go Programming
+// Language. The Native Number String format is one
+// which is used as a standard format for numeric
+// conversions performed in the Go Programming Language
+// as well as many other programming languages.
 //
-//				HalfAwayFromZero
+// Native digits in the Arabic-Indic, Extended
+// Arabic-Indic, Devanagari, Thai and full-width digit
+// systems are recognized and normalized to internal
+// digit values.
 //
-//					The 'HalfAwayFromZero' method rounds .5 further
-//					away from zero.	It provides clear and consistent
-//					behavior when dealing with negative numbers.
+//	Example: "١٢٣٫٤٥" with decimal separator "٫"
+//		is parsed as 123.45
 //
-//						Examples of HalfAwayFromZero
+// All numeric digits in 'dirtyNumberStr' must belong to
+// the same digit system. Strings mixing digits from two
+// or more digit systems, such as "1٢3", are rejected
+// with an error.
 //
-//						7.6 rounds away to 8
-//						7.5 rounds away to 8
-//						7.4 rounds to 7
-//						-7.4 rounds to -7
-//						-7.5 rounds away to -8
-//						-7.6 rounds away to -8
+// The Dirty Number String passed as input parameter
+// 'dirtyNumberStr' is expected to comply with the
+// following requirements:
 //
-//				HalfTowardsZero
+//  1. The dirty number string must contain numeric
+//     digit characters zero to nine inclusive (0-9).
 //
-//					Round Half Towards Zero. 'HalfTowardsZero' rounds
-//					0.5	closer to zero. It provides clear and
-//					consistent behavior	when dealing with negative
-//					numbers.
+//  2. The dirty number string must contain a radix
+//     point or decimal separator to separate
+//     integer and fractional digits in a floating
+//     point numeric value. This decimal separator
+//     is specified by input parameter,
+//     'decimalSeparator'.
 //
-//						Examples of HalfTowardsZero
+//     If no decimal separator is identified in the
+//     dirty number string, the numeric value is
+//     assumed to be an integer value.
 //
-//						7.6 rounds away to 8
-//						7.5 rounds to 7
-//						7.4 rounds to 7
-//						-7.4 rounds to -7
-//						-7.5 rounds to -7
-//						-7.6 rounds away to -8
+//  3. The dirty number string must designate
+//     negative numeric values using one of the
+//     following three negative number symbols:
 //
-//				HalfToEven
+//     (a)	A Leading Minus Sign ('-').
+//     Example: -123.45
 //
-//					Round Half To Even Numbers. 'HalfToEven' is
-//					also called	Banker's Rounding. This method
-//					rounds 0.5 to the nearest even digit.
+//     (b)	A Trailing Minus Sign ('-').
+//     Example: 123.45-
 //
-//						Examples of HalfToEven
+//     (c) A combination of leading and trailing
+//     Parentheses ('()').
+//     Example: (123.45)
 //
-//						7.5 rounds up to 8 (because 8 is an even
-//						number)	but 6.5 rounds down to 6 (because
-//						6 is an even number)
+// ----------------------------------------------------------------
 //
-//						HalfToEven only applies to 0.5. Other
-//						numbers (not ending	in 0.5) round to
-//						nearest as usual, so:
+// # Input Parameters
 //
-//						7.6 rounds up to 8
-//						7.5 rounds up to 8 (because 8 is an even number)
-//						7.4 rounds down to 7
-//						6.6 rounds up to 7
-//						6.5 rounds down to 6 (because 6 is an even number)
-//						6.4 rounds down to 6
+//	dirtyNumberStr				string
 //
-//				HalfToOdd
+//		This number string contains the numeric digits
+//		which will be extracted, converted to a valid
+//		numeric value and used to configure the
+//		returned instance of NumberStrKernel.
 //
-//					Round Half to Odd Numbers. Similar to 'HalfToEven',
-//					but in this case 'HalfToOdd' rounds 0.5 towards odd
-//					numbers.
+//		The 'dirtyNumberStr' is expected to comply with
+//		the following requirements:
 //
-//						Examples of HalfToOdd
+//		1.	The dirty number string must contain numeric
+//			digit characters zero to nine inclusive (0-9).
 //
-//						HalfToOdd only applies to 0.5. Other numbers
-//						(not ending	in 0.5) round to nearest as usual.
+//		2.	The dirty number string must contain a radix
+//			point or decimal separator to separate
+//			integer and fractional digits in a floating
+//			point numeric value. This decimal separator
+//			is specified by input parameter,
+//			'decimalSeparator'.
 //
-//						7.5 rounds down to 7 (because 7 is an odd number)
+//			If no decimal separator is identified in the
+//			dirty number string, the numeric value is
+//			assumed to be an integer value.
 //
-//						6.5 rounds up to 7 (because 7 is an odd number)
+//		3.	The dirty number string must designate
+//			negative numeric values using one of the
+//			following three negative number symbols:
 //
-//						7.6 rounds up to 8
-//						7.5 rounds down to 7 (because 7 is an odd number)
-//						7.4 rounds down to 7
-//						6.6 rounds up to 7
-//						6.5 rounds up to 7 (because 7 is an odd number)
-//						6.4 rounds down to 6
+//			(a)	A Leading Minus Sign ('-').
+//				Example: -123.45
 //
-//				Randomly
+//			(b)	A Trailing Minus Sign ('-').
+//				Example: 123.45-
 //
-//					Round Half Randomly. Uses a Random Number Generator
-//					to choose between rounding 0.5 up or down.
+//			(c) A combination of leading and trailing
+//				Parentheses ('()').
+//				Example: (123.45)
 //
-//					All numbers other than 0.5 round to the nearest as
-//					usual.
+//		If 'dirtyNumberStr' does not contain any numeric
+//		digits, an error will be returned.
 //
-//				Floor
+//	decimalSeparator			string
 //
-//					Yields the nearest integer down. Floor does not apply
-//					any	special treatment to 0.5.
+//		Type DecimalSeparatorSpec is used to specify the
+//		radix point or decimal separator which will
+//		separate integer and fractional digits in the
+//		dirty number string passed as input parameter
+//		'dirtyNumberStr'.
 //
-//					Floor Function: The greatest integer that is less than
-//					or equal to x
+//		The decimal separator will typically consist of
+//		one or more non-numeric characters.
 //
-//					Source:
-//						https://www.mathsisfun.com/sets/function-floor-ceiling.html
-//
-//					In mathematics and computer science, the floor function
-//					is the function that takes as input a real number x,
-//					and gives as output the greatest integer less than or
-//					equal to x,	denoted floor(x) or ⌊x⌋.
-//
-//					Source:
-//						https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//		If 'decimalSeparator' consists of an empty
+//		or zero length sting, it is assumed that the
+//		numeric value contained in input parameter
+//		'dirtyNumberStr' is an integer value.
 //
-//					Examples of Floor
+//		In the US, Australia, UK, most of Canada and many
+//		other countries the period ('.'), or decimal
+//		point, separates integer and fractional digits
+//		within a floating point numeric value.
 //
-//						Number     Floor
-//						 2           2
-//						 2.4         2
-//						 2.9         2
-//						-2.5        -3
-//						-2.7        -3
-//						-2          -2
+//		Other countries, including many in the European
+//		Union, use the comma (',') to separate integer
+//		and fractional digits within a number string.
 //
-//				Ceiling
+//		If 'decimalSeparator' contains any one of the
+//		following invalid characters, an error will be
+//		returned.
 //
-//					Yields the nearest integer up. Ceiling does not
-//					apply any special treatment to 0.5.
+//			Invalid Decimal Separator Characters
+//							'-'
+//							'('
+//							')'
 //
-//					Ceiling Function: The least integer that is
-//					greater than or	equal to x.
-//					Source:
-//						https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//	roundingType				NumberRoundingType
 //
-//					The ceiling function maps x to the least integer
-//					greater than or equal to x, denoted ceil(x) or
-//					⌈x⌉.[1]
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied for
+//		the	rounding of fractional digits contained in the
+//		new returned instance of NumberStrKernel
+//		(newNumStrKernel).
 //
-//					Source:
-//						https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//		If in doubt as to a suitable rounding method,
+//		'HalfAwayFromZero' is recommended.
 //
-//						Examples of Ceiling
+//		Possible values are listed as follows:
+//			NumRoundType.None()	- Invalid Value
+//			NumRoundType.NoRounding()
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
 //
-//							Number    Ceiling
-//							 2           2
-//							 2.4         3
-//							 2.9         3
-//							-2.5        -2
-//							-2.7        -2
-//							-2          -2
+//		Definitions:
 //
-//				Truncate
+//			NoRounding
 //
-//					Apply NO Rounding whatsoever. The Round From Digit
-//					is dropped or deleted. The Round To Digit is NEVER
-//					changed.
+//				Signals that no rounding operation will be
+//				performed on fractional digits. The
+//				fractional digits will therefore remain
+//				unchanged.
 //
-//					Examples of Truncate
+//			HalfUpWithNegNums
 //
-//						Example-1
-//						Number: 23.14567
-//						Objective: Round to two decimal places to
-//						the right of the decimal point.
-//						Rounding Method: Truncate
-//						Round To Digit:   4
-//						Round From Digit: 5
-//						Rounded Number:   23.14 - The Round From Digit
-//						is dropped.
+//				Half Round Up Including Negative Numbers.
+//				This method is intuitive but may produce
+//				unexpected results when applied to negative
+//				numbers.
 //
-//						Example-2
-//						Number: -23.14567
-//						Objective: Round to two decimal places to
-//						the right of the decimal point.
-//						Rounding Method: Truncate
-//						Round To Digit:   4
-//						Round From Digit: 5
-//						Rounded Number:  -23.14 - The Round From Digit
-//						is dropped.
+//				'HalfUpWithNegNums' rounds .5 up.
 //
-//		roundToFractionalDigits		int
+//					Examples of 'HalfUpWithNegNums'
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8
+//					7.4 rounds down to 7
+//					-7.4 rounds up to -7
+//					-7.5 rounds up to -7
+//					-7.6 rounds down to -8
 //
-//			When set to a positive integer value, this
-//			parameter controls the number of digits to the
-//			right of the radix point or decimal separator
-//			(a.k.a. decimal point). This controls the number
-//			of fractional digits remaining after completion
-//			of the number rounding operation.
+//			HalfDownWithNegNums
 //
-//			If input parameter 'roundingType' is set to
-//			NumRoundType.NoRounding(),
-//			'roundToFractionalDigits' is ignored and no
-//			rounding operation is performed.
+//			Half Round Down Including Negative Numbers. This
+//			method is also considered intuitive but may
+//			produce unexpected results when applied to
+//			negative numbers.
 //
-//		errorPrefix					interface{}
+//			'HalfDownWithNegNums' rounds .5 down.
 //
-//			This object encapsulates error prefix text which
-//			is included in all returned error messages.
-//			Usually, it contains the name of the calling
-//			method or methods listed as a method or function
-//			chain of execution.
+//				Examples of HalfDownWithNegNums
 //
-//			If no error prefix information is needed, set this
-//			parameter to 'nil'.
+//				7.6 rounds up to 8
+//				7.5 rounds down to 7
+//				7.4 rounds down to 7
+//				-7.4 rounds up to -7
+//				-7.5 rounds down to -8
+//				-7.6 rounds down to -8
 //
-//			This empty interface must be convertible to one of
-//			the following types:
+//			HalfAwayFromZero
 //
-//			1.	nil
-//					A nil value is valid and generates an
-//					empty collection of error prefix and
-//					error context information.
+//				The 'HalfAwayFromZero' method rounds .5 further
+//				away from zero.	It provides clear and consistent
+//				behavior when dealing with negative numbers.
 //
-//			2.	string
-//					A string containing error prefix
-//					information.
+//					Examples of HalfAwayFromZero
 //
-//			3.	[]string
-//					A one-dimensional slice of strings
-//					containing error prefix information.
+//					7.6 rounds away to 8
+//					7.5 rounds away to 8
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds away to -8
+//					-7.6 rounds away to -8
 //
-//			4.	[][2]string
-//					A two-dimensional slice of strings
-//			   		containing error prefix and error
-//			   		context information.
+//			HalfTowardsZero
 //
-//			5.	ErrPrefixDto
-//					An instance of ErrPrefixDto.
-//					Information from this object will
-//					be copied for use in error and
-//					informational messages.
+//				Round Half Towards Zero. 'HalfTowardsZero' rounds
+//				0.5	closer to zero. It provides clear and
+//				consistent behavior	when dealing with negative
+//				numbers.
 //
-//			6.	*ErrPrefixDto
-//					A pointer to an instance of
-//					ErrPrefixDto. Information from
-//					this object will be copied for use
-//					in error and informational messages.
+//					Examples of HalfTowardsZero
 //
-//			7.	IBasicErrorPrefix
-//					An interface to a method
-//					generating a two-dimensional slice
-//					of strings containing error prefix
-//					and error context information.
+//					7.6 rounds away to 8
+//					7.5 rounds to 7
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds to -7
+//					-7.6 rounds away to -8
 //
-//			If parameter 'errorPrefix' is NOT convertible
-//			to one of the valid types listed above, it will
-//			be considered invalid and trigger the return of
-//			an error.
+//			HalfToEven
 //
-//			Types ErrPrefixDto and IBasicErrorPrefix are
-//			included in the 'errpref' software package:
-//				"github.com/MikeAustin71/errpref".
+//				Round Half To Even Numbers. 'HalfToEven' is
+//				also called	Banker's Rounding. This method
+//				rounds 0.5 to the nearest even digit.
 //
-// ----------------------------------------------------------------
+//					Examples of HalfToEven
 //
-// # Return Values
+//					7.5 rounds up to 8 (because 8 is an even
+//					number)	but 6.5 rounds down to 6 (because
+//					6 is an even number)
 //
-//	newNumStrKernel				NumberStrKernel
+//					HalfToEven only applies to 0.5. Other
+//					numbers (not ending	in 0.5) round to
+//					nearest as usual, so:
 //
-//		If this method completes successfully, a new
-//		instance of NumberStrKernel will be returned
-//		configured with the numeric value parsed from
-//		the Native Number String input parameter
-//		'nativeNumStr'.
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8 (because 8 is an even number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds down to 6 (because 6 is an even number)
+//					6.4 rounds down to 6
 //
-//	numStrStatsDto				NumberStrStatsDto
+//			HalfToOdd
 //
-//		If this method completes successfully, an
-//		instance of NumberStrStatsDto will be returned
-//		containing a profile and key statistics on the
-//		numeric value encapsulated in the returned
-//		instance of NumberStrKernel, 'newNumStrKernel'.
+//				Round Half to Odd Numbers. Similar to 'HalfToEven',
+//				but in this case 'HalfToOdd' rounds 0.5 towards odd
+//				numbers.
 //
-//		type NumberStrStatsDto struct {
+//					Examples of HalfToOdd
 //
-//		NumOfIntegerDigits					uint64
+//					HalfToOdd only applies to 0.5. Other numbers
+//					(not ending	in 0.5) round to nearest as usual.
 //
-//			The total number of integer digits to the
-//			left of the radix point or, decimal point, in
-//			the subject numeric value.
+//					7.5 rounds down to 7 (because 7 is an odd number)
 //
-//		NumOfSignificantIntegerDigits		uint64
+//					6.5 rounds up to 7 (because 7 is an odd number)
+//
+//					7.6 rounds up to 8
+//					7.5 rounds down to 7 (because 7 is an odd number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds up to 7 (because 7 is an odd number)
+//					6.4 rounds down to 6
+//
+//			Randomly
+//
+//				Round Half Randomly. Uses a Random Number Generator
+//				to choose between rounding 0.5 up or down.
+//
+//				All numbers other than 0.5 round to the nearest as
+//				usual.
+//
+//			Floor
+//
+//				Yields the nearest integer down. Floor does not apply
+//				any	special treatment to 0.5.
+//
+//				Floor Function: The greatest integer that is less than
+//				or equal to x
+//
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//
+//				In mathematics and computer science, the floor function
+//				is the function that takes as input a real number x,
+//				and gives as output the greatest integer less than or
+//				equal to x,	denoted floor(x) or ⌊x⌋.
+//
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//
+//				Examples of Floor
+//
+//					Number     Floor
+//					 2           2
+//					 2.4         2
+//					 2.9         2
+//					-2.5        -3
+//					-2.7        -3
+//					-2          -2
+//
+//			Ceiling
+//
+//				Yields the nearest integer up. Ceiling does not
+//				apply any special treatment to 0.5.
+//
+//				Ceiling Function: The least integer that is
+//				greater than or	equal to x.
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//
+//				The ceiling function maps x to the least integer
+//				greater than or equal to x, denoted ceil(x) or
+//				⌈x⌉.[1]
+//
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//
+//					Examples of Ceiling
+//
+//						Number    Ceiling
+//						 2           2
+//						 2.4         3
+//						 2.9         3
+//						-2.5        -2
+//						-2.7        -2
+//						-2          -2
+//
+//			Truncate
+//
+//				Apply NO Rounding whatsoever. The Round From Digit
+//				is dropped or deleted. The Round To Digit is NEVER
+//				changed.
+//
+//				Examples of Truncate
+//
+//					Example-1
+//					Number: 23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:   23.14 - The Round From Digit
+//					is dropped.
+//
+//					Example-2
+//					Number: -23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:  -23.14 - The Round From Digit
+//					is dropped.
+//
+//	roundToFractionalDigits		int
+//
+//		When set to a positive integer value, this
+//		parameter controls the number of digits to the
+//		right of the radix point or decimal separator
+//		(a.k.a. decimal point). This controls the number
+//		of fractional digits remaining after completion
+//		of the number rounding operation.
+//
+//		If input parameter 'roundingType' is set to
+//		NumRoundType.NoRounding(),
+//		'roundToFractionalDigits' is ignored and no
+//		rounding operation is performed.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	newNumStrKernel				NumberStrKernel
+//
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		configured with the numeric value parsed from
+//		the Dirty Number String input parameter
+//		'dirtyNumberStr'.
+//
+//	numStrStatsDto				NumberStrStatsDto
+//
+//		If this method completes successfully, an
+//		instance of NumberStrStatsDto will be returned
+//		containing a profile and key statistics on the
+//		numeric value encapsulated in the returned
+//		instance of NumberStrKernel, 'newNumStrKernel'.
+//
+//		type NumberStrStatsDto struct {
+//
+//		NumOfIntegerDigits					uint64
+//
+//			The total number of integer digits to the
+//			left of the radix point or, decimal point, in
+//			the subject numeric value.
+//
+//		NumOfSignificantIntegerDigits		uint64
 //
 //			The number of nonzero integer digits to the
 //			left of the radix point or, decimal point, in
@@ -6453,8 +6314,9 @@ func (numStrKernel *NumberStrKernel) NewParseGermanNumberStr(
 //		for input parameter 'errPrefDto' (error prefix)
 //		will be prefixed or attached at the beginning of
 //		the error message.
-func (numStrKernel *NumberStrKernel) NewParseNativeNumberStr(
-	nativeNumStr string,
+func (numStrKernel *NumberStrKernel) NewParseDirtyNumberStr(
+	dirtyNumberStr string,
+	decimalSeparator string,
 	roundingType NumberRoundingType,
 	roundToFractionalDigits int,
 	errorPrefix interface{}) (
@@ -6476,7 +6338,7 @@ func (numStrKernel *NumberStrKernel) NewParseNativeNumberStr(
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"NewParseNativeNumberStr()",
+			"NewParseDirtyNumberStr()",
 		"")
 
 	if err != nil {
@@ -6487,9 +6349,10 @@ func (numStrKernel *NumberStrKernel) NewParseNativeNumberStr(
 
 	numStrStatsDto,
 		err = new(numberStrKernelMechanics).
-		setNumStrKernelFromRoundedNativeNumStr(
+		setNumStrKernelFromRoundedDirtyNumStr(
 			&newNumStrKernel,
-			nativeNumStr,
+			dirtyNumberStr,
+			decimalSeparator,
 			roundingType,
 			roundToFractionalDigits,
 			ePrefix.XCpy(
@@ -6500,386 +6363,270 @@ func (numStrKernel *NumberStrKernel) NewParseNativeNumberStr(
 		err
 }
 
-// NewParsePureNumberStr
+//	NewParseFrenchNumberStr
 //
-// Receives a Pure Number String and proceeds to return
-// the extracted numeric value as a new instance of type
-// NumberStrKernel.
+//	This method parses an incoming number string
+//	using standard decimal separator and negative
+//	number sign parameters commonly applied in
+//	France.
 //
-// A Pure Number String is defined as follows:
+//	The radix point or decimal separator used to
+//	separate integer and fractional digits in a
+//	floating point numeric value is assumed to be
+//	the comma (',') character.
 //
-//  1. A pure number string consists entirely of numeric
-//     digit characters (0-9).
+//	The integer digits separator is assumed to be
+//	a space character (' ').
 //
-//  2. A pure number string will separate integer and
-//     fractional digits with a radix point or decimal
-//     separator. This could be, but is not limited to,
-//     a decimal point ('.'). For example, many European
-//     countries use the comma (',') as a radix point.
+//	The negative number sign used to designate
+//	numbers with a negative value is assumed to
+//	be a leading minus sign ('-').
 //
-//  3. A pure number string will designate negative values
-//     with a minus sign ('-'). This minus sign could be
-//     positioned as a leading or trailing minus sign.
+//	Within in the European Union many, if not
+//	most, of the member countries subscribe to
+//	the decimal separator and negative number
+//	formatting standards implemented by either
+//	France or Germany.
 //
-//  4. A pure number string will NEVER include integer
-//     separators such as commas (',') to separate
-//     integer digits by thousands.
+//	For information on German Number String
+//	parsing conventions, see method:
 //
-//     NOT THIS: 1,000,000
-//     Pure Number String: 1000000
+//		NumberStrKernel.NewParseGermanNumberStr()
 //
-//  5. A pure number string will NEVER include currency
-//     symbols.
-//
-// Users also have the option of specifying rounding
-// parameters which will be used to round the numeric
-// value extracted from 'pureNumberStr' before it is
-// converted and returned as a new instance of
-// NumberStrKernel.
+//	If custom decimal separator and negative number
+//	characters are required, see method:
 //
-// Note that return parameter 'pureNumStrComponents'
-// will provide a statistical profile of the new
-// returned instance of NumberStrKernel,
-// 'newNumStrKernel'.
+//		NumberStrKernel.NewParseCustomNumberStr()
 //
 // ----------------------------------------------------------------
 //
-// # Input Parameters
+// # Examples of French Number Strings
 //
-//	pureNumberStr				string
+//	The radix point or decimal separator is set to the
+//	comma character (','):
 //
-//		This strings contains the numeric character
-//		digits from	which a numeric value will be
-//		extracted and returned as a NumberStrKernel.
+//		French Example-1
+//		123,45 (The fractional digits are "45")
 //
-//		A "Pure Number String" is defined as follows:
+//	The integer group separator is a space character
+//	(' ').
 //
-//			1.	Consists of numeric character digits
-//				zero through nine inclusive (0-9).
+//	The integer group specification is set to 'thousands'.
+//	This means that integer digits will be separated into
+//	'thousands' with each group containing three digits each:
 //
-//			2.	Option: A Pure Number String may include
-//				a radix point or decimal separator.
-//				Decimal separators separate integer and
-//				fractional numeric digits in a pure
-//				number string. The decimal separator may
-//				consist of one or more text characters.
+//		French Example-2
+//		1 000 000 000
 //
-//				In the US, UK, Australia, most of Canada
-//				and many other countries, the decimal
-//				separator is the period character ('.')
-//				known as the decimal point.
+//	The negative number sign is set to a leading minus sign
+//	('-').
 //
-//				In France, Germany and many countries in
-//				the European Union, the Decimal Separator
-//				is the comma character (',').
+//		French Example-3
+//		-1 000 000 000
 //
-//			3.	Optional: A Pure Number String may
-//				include a negative number sign symbol
-//				consisting of a minus sign ('-'). The
-//				minus sign will identify the numeric
-//				value contained in the pure number string
-//				as a negative number. Only the minus sign
-//				('-') classifies a numeric value as a
-//				negative number in a Pure Number String.
+//	The positive number sign is set to a blank or empty
+//	string ("").
 //
-//				If a leading or trailing minus sign ('-')
-//				is NOT present in the pure number string,
-//				the numeric value is assumed to be
-//				positive.
+//		French Example-4
+//		1 000 000 000
 //
-//			4.	Only numeric characters, the decimal
-//				separator and the minus sign will be
-//				processed by the pure number string
-//				parsing algorithm. All other characters
-//				will be	ignored.
+//	The zero number format is set to a blank or empty
+//	string ("").
 //
-//			5.	Pure Number Strings consist of a single
-//				numeric value. The entire Pure Number String
-//				will be parsed, or processed, and only one
-//				numeric value per Pure Number String will
-//				be returned.
+//		French Example-5
+//			0,0
 //
-//	decSeparatorChars			string
+// ----------------------------------------------------------------
 //
-//		This string contains the character or characters
-//		which will be configured as the Decimal Separator
-//		Symbol.
+// # Reference
 //
-//		The Decimal Separator is also known as the radix
-//		point and is used to separate integer and
-//		fractional digits within a formatted, floating
-//		point Number String.
+//	https://www.ibm.com/support/pages/english-and-french-currency-formats
+//	https://freeformatter.com/france-standards-code-snippets.html
+//	https://docs.microsoft.com/en-us/globalization/locale/currency-formatting
 //
-//		In the US, UK, Australia, most of Canada and many
-//		other countries the Decimal Separator is the
-//		period character ('.') known as the decimal
-//		point.
+// ----------------------------------------------------------------
 //
-//		In France, Germany and many countries in the
-//		European Union, the Decimal Separator is the
-//		comma character (',').
+// # Input Parameters
 //
-//		The Decimal Separator is used to identify and
-//		separate integer and fractional digits while
-//		parsing the Pure Number String.
+//	rawNumStr					string
 //
-//	leadingMinusSign			bool
+//		This parameter is the raw number string
+//		containing numeric digit characters which will
+//		be extracted and processed to identify the
+//		numeric value. This numeric value will in
+//		turn form the basis of the new NumberStrKernel
+//		instance returned to the calling function.
 //
-//		In pure number strings, a minus sign ('-')
-//		identifies a number as a negative numeric value.
+//	startSearchIndex			int
 //
-//		When 'leadingMinusSign' is set to 'true', the
-//		pure number string parsing algorithm will search
-//		for a leading minus sign ('-') at the beginning of
-//		the number string. Leading minus signs represent
-//		the standard means for designating negative
-//		numeric values in the US, UK, Australia, most of
-//		Canada and many other parts of world.
+//		The character index in the Raw Number String
+//		('rawNumStr') at which the search for numeric
+//		digit characters will begin.
 //
-//		Example Leading Minus Sign:
-//			"-123.456" or "- 123.456"
+//		Set this value to zero (0) in order	begin the
+//		search for numeric characters at the first
+//		character in the Raw Number String ('rawNumStr').
 //
-//		When 'leadingMinusSign' is set to 'false', the
-//		pure number string parsing algorithm will search
-//		for trailing minus signs ('-') located at the end
-//		of the number string. Trailing minus signs
-//		represent the standard for France, Germany and
-//		many countries in the European Union.
+//	breakOnCharSearchLength		int
 //
-//		NOTE: Identification of a trailing minus sign in
-//		the pure number string input parameter,
-//		'pureNumberString', will immediately terminate
-//		the search for numeric characters.
+//		The actual number of characters within the Raw
+//		Number String ('rawNumStr') that are included in
+//		the search for numeric character digits.
 //
-//		Example Trailing Number Symbols:
-//			"123.456-" or "123.456 -"
+//		If this parameter is set to a value greater than
+//		zero ('0'), the Number String Parsing algorithm
+//		will search the specified number of text
+//		characters and then automatically terminate the
+//		search for numeric digits.
 //
-//	 errorPrefix                interface{}
+//		If this value is set to value less than one (+1),
+//		the search length will be configured to include
+//		the last character in 'rawNumStr'. In other words
+//		the search for numeric characters will proceed to
+//		the end of the string, 'rawNumStr'.
 //
-//		This object encapsulates error prefix text which is
-//		included in all returned error messages. Usually, it
-//		contains the name of the calling method or methods
-//		listed as a method or function chain of execution.
+//	breakOnCharDelimiters		[]string
 //
-//		If no error prefix information is needed, set this
-//		parameter to 'nil'.
+//		An array of strings. If any one of these strings
+//		is encountered while searching the Raw Number
+//		String ('rawNumStr'), the search for numeric
+//		digits will be automatically terminated.
 //
-//		This empty interface must be convertible to one of
-//		the following types:
+//		If this string array is set to nil, this
+//		parameter will be ignored by the Number String
+//		parsing algorithm.
 //
-//		1. nil - A nil value is valid and generates an empty
-//		   collection of error prefix and error context
-//		   information.
+//	requestRemainderString		bool
 //
-//		2. string - A string containing error prefix
-//			information.
+//		If this parameter is set to 'true', the Number
+//		String Search Results ('numberStrSearchResults')
+//		returned by this method	will include the
+//		remaining string characters	which were NOT
+//		included in the search operation.
 //
-//		3. []string A one-dimensional slice of strings
-//			containing error prefix information.
+//		This feature may be useful when either
+//		'characterSearchLength' or 'numParsingTerminators'
+//		parameters are used to arbitrarily terminate the
+//		search operation.
 //
-//		4. [][2]string A two-dimensional slice of strings
-//		   containing error prefix and error context
-//		   information.
 //
-//		5. ErrPrefixDto - An instance of ErrPrefixDto.
-//			Information from this object will be copied for use
-//			in error and informational messages.
+//	 errorPrefix                interface{}
 //
-//		6. *ErrPrefixDto - A pointer to an instance of
-//			ErrPrefixDto. Information from this object will be
-//			copied for use in error and informational messages.
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it	contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//		7. IBasicErrorPrefix - An interface to a method
-//			generating a two-dimensional slice of strings
-//			containing error prefix and error context
-//			information.
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//		If parameter 'errorPrefix' is NOT convertible to one
-//		of the valid types listed above, it will be
-//		considered invalid and trigger the return of an
-//		error.
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//		Types ErrPrefixDto and IBasicErrorPrefix are included
-//		in the 'errpref' software package,
-//		"github.com/MikeAustin71/errpref".
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-// ----------------------------------------------------------------
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-// # Return Values
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//	newNumStrKernel 			NumberStrKernel
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//		If this method completes successfully, a new instance
-//		of NumberStrKernel will be returned configured with
-//		the numeric value parsed and extracted from input
-//		paramter, 'pureNumberStr'.
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//	pureNumStrComponents		PureNumberStrComponents
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//		If this method completes successfully, this
-//		parameter will return an instance of
-//		PureNumberStrComponents. This data structure
-//		contains an analysis and profile information on
-//		the returned instance of NumberStrKernel
-//		'newNumStrKernel'.
-//
-//		type PureNumberStrComponents struct {
-//
-//			NumStrStats NumberStrStatsDto
-//
-//				This data transfer object will return key
-//				statistics on the numeric value encapsulated
-//				by the current instance of NumberStrKernel.
-//
-//				type NumberStrStatsDto struct {
-//
-//				NumOfIntegerDigits					uint64
-//
-//					The total number of integer digits to the
-//					left of the radix point or, decimal point, in
-//					the subject numeric value.
-//
-//				NumOfSignificantIntegerDigits		uint64
-//
-//					The number of nonzero integer digits to the
-//					left of the radix point or, decimal point, in
-//					the subject numeric value.
-//
-//				NumOfFractionalDigits				uint64
-//
-//					The total number of fractional digits to the
-//					right of the radix point or, decimal point,
-//					in the subject numeric value.
-//
-//				NumOfSignificantFractionalDigits	uint64
-//
-//					The number of nonzero fractional digits to
-//					the right of the radix point or, decimal
-//					point, in the subject numeric value.
-//
-//				NumberValueType 					NumericValueType
-//
-//					This enumeration value specifies whether the
-//					subject numeric value is classified either as
-//					an integer or a floating point number.
-//
-//					Possible enumeration values are listed as
-//					follows:
-//						NumValType.None()
-//						NumValType.FloatingPoint()
-//						NumValType.Integer()
-//
-//				NumberSign							NumericSignValueType
-//
-//					An enumeration specifying the number sign
-//					associated with the numeric value. Possible
-//					values are listed as follows:
-//						NumSignVal.None()		= Invalid Value
-//						NumSignVal.Negative()	= -1
-//						NumSignVal.Zero()		=  0
-//						NumSignVal.Positive()	=  1
-//
-//				IsZeroValue							bool
-//
-//					If 'true', the subject numeric value is equal
-//					to zero ('0').
-//
-//					If 'false', the subject numeric value is
-//					greater than or less than zero ('0').
-//				}
-//
-//
-//
-//			AbsoluteValueNumStr string
-//
-//			The number string expressed as an absolute value.
-//			Be advised, this number string may be a floating
-//			point number string containing fractional digits.
-//
-//			AbsoluteValAllIntegerDigitsNumStr string
-//
-//			Integer and fractional digits are combined
-//			in a single number string without a decimal
-//			point separating integer and fractional digits.
-//			This string DOES NOT contain a leading number
-//			sign (a.k.a. minus sign ('-')
-//
-//			SignedAllIntegerDigitsNumStr string
-//
-//			Integer and fractional digits are combined
-//			in a single number string without a decimal
-//			point separating integer and fractional digits.
-//			If the numeric value is negative, a leading
-//			minus sign will be prefixed at the beginning
-//			of the number string.
-//
-//			NativeNumberStr string
-//
-//			A Native Number String representing the base
-//			numeric value used to generate these profile
-//			number string statistics.
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//			A valid Native Number String must conform to the
-//			standardized formatting criteria defined below:
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
 //
-//			 	1. A Native Number String Consists of numeric
-//			 	   character digits zero through nine inclusive
-//			 	   (0-9).
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
-//			 	2. A Native Number String will include a period
-//			 	   or decimal point ('.') to separate integer and
-//			 	   fractional digits within a number string.
+// ----------------------------------------------------------------
 //
-//			 	   Native Number String Floating Point Value:
-//			 	   				123.1234
+// # Return Values
 //
-//			 	3. A Native Number String will always format
-//			 	   negative numeric values with a leading minus sign
-//			 	   ('-').
+//	numberStrSearchResults		CharSearchNumStrParseResultsDto
 //
-//			 	   Native Number String Negative Value:
-//			 	   				-123.2
+//		This structure contains public member variables
+//		containing operational data describing the
+//		success or failure of the number string parsing
+//		operation and the search for numeric digits in
+//		the Raw Number String, 'rawNumStr'.
 //
-//			 	4. A Native Number String WILL NEVER include integer
-//			 	   separators such as commas (',') to separate
-//			 	   integer digits by thousands.
+//		Users are advised to check the following member
+//		variable values after a number string parsing
+//		operation:
 //
-//			 	   					NOT THIS: 1,000,000
-//			 	   		Native Number String: 1000000
+//			FoundNumericDigits		bool
 //
-//			 	5. Native Number Strings will only consist of:
+//				Signals a successful Number String Parsing
+//				operation. When set to 'true', this means
+//				one or more numeric	digit characters ('0'
+//				through '9' inclusive) were	located in the
+//				Raw Number String, 'rawNumStr'.
 //
-//			 	   (a)	Numeric digits zero through nine inclusive (0-9).
+//			FoundNonZeroValue		bool
 //
-//			 	   (b)	A decimal point ('.') for floating point
-//			 	   		numbers.
+//				When set to 'true' this signals that the
+//				search operation has detected one or more
+//				nonzero numeric digits.
 //
-//			 	   (c)	A leading minus sign ('-') in the case of
-//			 	   		negative numeric values.
+//	nStrKernel					NumberStrKernel
 //
-//		}
+//		If this method completes successfully, a new instance
+//		of NumberStrKernel will be returned configured with
+//		the numeric value parsed from the Raw Number String,
+//		'rawNumStr'.
 //
 //	err							error
 //
 //		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'. If
-//		errors are encountered during processing, the
-//		returned error Type will encapsulate an error
-//		message.
+//		returned error Type is set equal to 'nil'.
 //
-//		If an error message is returned, the text value
-//		for input parameter 'errPrefDto' (error prefix)
-//		will be prefixed or attached at the beginning of
-//		the error message.
-func (numStrKernel *NumberStrKernel) NewParsePureNumberStr(
-	pureNumberStr string,
-	decSeparatorChars string,
-	leadingMinusSign bool,
-	roundingType NumberRoundingType,
-	roundToFractionalDigits int,
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (numStrKernel *NumberStrKernel) NewParseFrenchNumberStr(
+	rawNumStr string,
+	startSearchIndex int,
+	breakOnCharSearchLength int,
+	breakOnCharDelimiters []string,
+	requestRemainderString bool,
 	errorPrefix interface{}) (
-	newNumStrKernel NumberStrKernel,
-	pureNumStrComponents PureNumberStrComponents,
+	numberStrSearchResults CharSearchNumStrParseResultsDto,
+	nStrKernel NumberStrKernel,
 	err error) {
 
 	if numStrKernel.lock == nil {
@@ -6896,56 +6643,171 @@ func (numStrKernel *NumberStrKernel) NewParsePureNumberStr(
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"NewParsePureNumberStr()",
+			"NewParseFrenchNumberStr()",
 		"")
 
 	if err != nil {
-		return newNumStrKernel,
-			pureNumStrComponents,
-			err
+		return numberStrSearchResults, nStrKernel, err
 	}
 
-	pureNumStrComponents,
-		err = new(numberStrKernelMechanics).
-		setNumStrKernelFromRoundedPureNumStr(
-			&newNumStrKernel,
-			pureNumberStr,
-			decSeparatorChars,
-			leadingMinusSign,
-			roundingType,
-			roundToFractionalDigits,
+	numParsingTerminatorsCol := RuneArrayCollection{}
+
+	lenStrArray := len(breakOnCharDelimiters)
+
+	for i := 0; i < lenStrArray; i++ {
+
+		err = numParsingTerminatorsCol.AddStringDefault(
+			breakOnCharDelimiters[i],
 			ePrefix.XCpy(
-				"newNumStrKernel"))
+				fmt.Sprintf("breakOnCharDelimiters[%v]",
+					i)))
 
-	return newNumStrKernel,
-		pureNumStrComponents,
-		err
-}
+		if err != nil {
+			return numberStrSearchResults, nStrKernel, err
+		}
+	}
 
-//	NewParseUSNumberStr
-//
-//	This method parses an incoming number string
-//	using standard decimal separator and negative
-//	number sign parameters commonly applied in the
-//	US (United States).
-//
-//	The radix point or decimal separator used to
-//	separate integer and fractional digits in a
-//	floating point numeric value is therefore
-//	assumed to be the period ('.') or decimal
-//	point.
-//
-//	The negative number signs used to designate
-//	numbers with a negative value are assumed to
-//	be either a leading minus sign ('-') or
-//	parentheses ('()').
-//
-// ----------------------------------------------------------------
-//
-// # Input Parameters
-//
-//	rawNumStr					string
-//
+	var runeDto RuneArrayDto
+
+	runeDto = RuneArrayDto{}.NewStringDefault(
+		rawNumStr)
+
+	var decSeparator DecimalSeparatorSpec
+
+	decSeparator,
+		err = new(DecimalSeparatorSpec).NewStr(
+		",",
+		ePrefix.XCpy("decSeparator"))
+
+	if err != nil {
+
+		return numberStrSearchResults, nStrKernel, err
+	}
+
+	negativeNumSearchSpecs := NegNumSearchSpecCollection{}
+
+	err = negativeNumSearchSpecs.AddLeadingNegNumSearchStr(
+		"-",
+		ePrefix.XCpy("Leading minus sign '-'"))
+
+	if err != nil {
+
+		return numberStrSearchResults, nStrKernel, err
+	}
+
+	numberStrSearchResults,
+		nStrKernel,
+		err = new(numStrHelperQuark).extractNumRunes(
+		runeDto,
+		"rawNumStr",
+		startSearchIndex,
+		breakOnCharSearchLength,
+		negativeNumSearchSpecs,
+		decSeparator,
+		numParsingTerminatorsCol,
+		requestRemainderString,
+		ePrefix)
+
+	return numberStrSearchResults, nStrKernel, err
+}
+
+//	NewParseGermanNumberStr
+//
+//	This method parses an incoming number string
+//	using standard decimal separator and negative
+//	number sign parameters commonly applied in
+//	Germany.
+//
+//	The radix point or decimal separator used to
+//	separate integer and fractional digits in a
+//	floating point numeric value is assumed to be
+//	the comma (',') character.
+//
+//	The integer digits separator is assumed to be
+//	a period character ('.').
+//
+//	The negative number sign used to designate
+//	numbers with a negative value is assumed to
+//	be a trailing minus sign ('-').
+//
+// ----------------------------------------------------------------
+//
+// # German Number String Examples
+//
+//	The radix point or decimal separator is set to the
+//	comma character (','):
+//
+//		German Example-1
+//			123,45 (The fractional digits are "45")
+//
+//	The integer group separator is a space character
+//	('.').
+//
+//	The integer group specification is set to
+//	'thousands'. This means that integer digits will be
+//	separated into 'thousands' with each group containing
+//	three digits each:
+//
+//		German Example-2:
+//		1.000.000,00
+//
+//	The currency symbol used in the Germany is the
+//	Euro symbol ('€').
+//
+//		German Example-3
+//		1.000.000,00 €
+//
+//	The negative number sign is set to a trailing minus
+//	sign ('-').
+//
+//		German Example-4
+//		1.000.000,00- €
+//
+//	The positive number sign is set to a trailing
+//	Euro symbol.
+//
+//		German Example-5
+//		1.000.000,00 €
+//
+//	The zero number format is set to a trailing
+//	Euro symbol.
+//
+//		German Example-6
+//			0,00 €
+//
+// ----------------------------------------------------------------
+//
+// # BE ADVISED
+//
+//	Within in the European Union many, if not
+//	most, of the member countries subscribe to
+//	the decimal separator and negative number
+//	formatting standards implemented by either
+//	Germany or France.
+//
+//	For information on French Number String
+//	parsing conventions, see method:
+//
+//		NumberStrKernel.NewParseFrenchNumberStr()
+//
+//	If custom decimal separator and negative number
+//	characters are required, see method:
+//
+//		NumberStrKernel.NewParseCustomNumberStr()
+//
+// ----------------------------------------------------------------
+//
+// # Reference
+//
+// https://freeformatter.com/germany-standards-code-snippets.html
+// https://www.evertype.com/standards/euro/formats.html
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	rawNumStr					string
+//
 //		This parameter is the raw number string
 //		containing numeric digit characters which will
 //		be extracted and processed to identify the
@@ -7113,7 +6975,7 @@ func (numStrKernel *NumberStrKernel) NewParsePureNumberStr(
 //		input parameter, 'errorPrefix'. The 'errorPrefix'
 //		text will be attached to the beginning of the
 //		error message.
-func (numStrKernel *NumberStrKernel) NewParseUSNumberStr(
+func (numStrKernel *NumberStrKernel) NewParseGermanNumberStr(
 	rawNumStr string,
 	startSearchIndex int,
 	breakOnCharSearchLength int,
@@ -7138,7 +7000,7 @@ func (numStrKernel *NumberStrKernel) NewParseUSNumberStr(
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"NewParseUSNumberStr()",
+			"NewParseGermanNumberStr()",
 		"")
 
 	if err != nil {
@@ -7151,9 +7013,8 @@ func (numStrKernel *NumberStrKernel) NewParseUSNumberStr(
 
 	for i := 0; i < lenStrArray; i++ {
 
-		err = numParsingTerminatorsCol.AddRuneArrayString(
+		err = numParsingTerminatorsCol.AddStringDefault(
 			breakOnCharDelimiters[i],
-			CharSearchType.LinearTargetStartingIndex(),
 			ePrefix.XCpy(
 				fmt.Sprintf("breakOnCharDelimiters[%v]",
 					i)))
@@ -7165,11 +7026,8 @@ func (numStrKernel *NumberStrKernel) NewParseUSNumberStr(
 
 	var runeDto RuneArrayDto
 
-	runeDto,
-		err = RuneArrayDto{}.NewString(
-		rawNumStr,
-		CharSearchType.LinearTargetStartingIndex(),
-		ePrefix.XCpy("rawNumStr"))
+	runeDto = RuneArrayDto{}.NewStringDefault(
+		rawNumStr)
 
 	if err != nil {
 
@@ -7180,8 +7038,8 @@ func (numStrKernel *NumberStrKernel) NewParseUSNumberStr(
 
 	decSeparator,
 		err = new(DecimalSeparatorSpec).NewStr(
-		".",
-		ePrefix.XCpy("decSeparator<-"))
+		",",
+		ePrefix.XCpy("decSeparator"))
 
 	if err != nil {
 
@@ -7190,19 +7048,9 @@ func (numStrKernel *NumberStrKernel) NewParseUSNumberStr(
 
 	negativeNumSearchSpecs := NegNumSearchSpecCollection{}
 
-	err = negativeNumSearchSpecs.AddLeadingNegNumSearchStr(
+	err = negativeNumSearchSpecs.AddTrailingNegNumSearchStr(
 		"-",
-		ePrefix.XCpy("Leading minus sign '-'"))
-
-	if err != nil {
-
-		return numberStrSearchResults, nStrKernel, err
-	}
-
-	err = negativeNumSearchSpecs.AddLeadingAndTrailingNegNumSearchStr(
-		"(",
-		")",
-		ePrefix.XCpy("Leading Trailing '()'"))
+		ePrefix.XCpy("Trailing minus sign '-'"))
 
 	if err != nil {
 
@@ -7225,965 +7073,865 @@ func (numStrKernel *NumberStrKernel) NewParseUSNumberStr(
 	return numberStrSearchResults, nStrKernel, err
 }
 
-// RationalizeFractionalIntegerDigits
-//
-// If fractional digits are present in this instance of
-// NumberStrKernel, this method will ensure that integer
-// digits are also present.
-//
-// If fractional digits are present and no integer digits
-// are found, this method will configure a zero ('0') in
-// the integer digits rune array.
-//
-// Example:
-//
-//	.752 will be converted to 0.752
-func (numStrKernel *NumberStrKernel) RationalizeFractionalIntegerDigits() {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	_ = new(numberStrKernelElectron).rationalizeFractionalIntegerDigits(
-		numStrKernel,
-		nil)
-
-	return
-}
-
-//	Round
+// NewParseHexadecimalNumberStr
 //
-//	Rounds the fractional digits contained in the current
-//	instance of NumberStrKernel.
+//	Receives a hexadecimal number string and returns
+//	the extracted integer value as a new instance of
+//	NumberStrKernel.
 //
-// ----------------------------------------------------------------
+//	Leading and trailing white space is ignored. The
+//	number string may begin with a leading plus ('+')
+//	or minus ('-') sign. Underscore characters ('_')
+//	may be used to separate digits. An underscore may
+//	appear between two digits or directly after the
+//	radix prefix.
 //
-// # IMPORTANT
+//	The parsed value is converted to base 10 and may
+//	be of any size. There is no int64 overflow limit.
 //
-//	This method may alter the numeric value contained
-//	within the current instance of NumberStrKernel.
+//	Examples:
+//		"0x1F"			= 31
+//		"-ff"			= -255
+//		"0xDEAD_BEEF"	= 3735928559
 //
 // ----------------------------------------------------------------
 //
 // # Input Parameters
 //
-//	roundingType				NumberRoundingType
+//	radixNumStr					string
 //
-//		This enumeration parameter is used to specify the
-//		type of rounding algorithm that will be applied for
-//		the	rounding of fractional digits contained in the
-//		current instance of NumberStrKernel.
+//		A hexadecimal number string. The radix prefix
+//		("0x") is optional. If any character other
+//		than a hexadecimal digit, a sign, a digit
+//		separator ('_') or the radix prefix is
+//		encountered, an error will be returned.
 //
-//		If in doubt as to a suitable rounding method,
-//		'HalfAwayFromZero' is recommended.
+//	errorPrefix					interface{}
 //
-//		Possible values are listed as follows:
-//			NumRoundType.None()	- Invalid Value
-//			NumRoundType.NoRounding()
-//			NumRoundType.HalfUpWithNegNums()
-//			NumRoundType.HalfDownWithNegNums()
-//			NumRoundType.HalfAwayFromZero()
-//			NumRoundType.HalfTowardsZero()
-//			NumRoundType.HalfToEven()
-//			NumRoundType.HalfToOdd()
-//			NumRoundType.Randomly()
-//			NumRoundType.Floor()
-//			NumRoundType.Ceiling()
-//			NumRoundType.Truncate()
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//		Definitions:
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//			NoRounding
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//				Signals that no rounding operation will be
-//				performed on fractional digits. The
-//				fractional digits will therefore remain
-//				unchanged.
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//			HalfUpWithNegNums
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//				Half Round Up Including Negative Numbers.
-//				This method is intuitive but may produce
-//				unexpected results when applied to negative
-//				numbers.
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//				'HalfUpWithNegNums' rounds .5 up.
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//					Examples of 'HalfUpWithNegNums'
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8
-//					7.4 rounds down to 7
-//					-7.4 rounds up to -7
-//					-7.5 rounds up to -7
-//					-7.6 rounds down to -8
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//			HalfDownWithNegNums
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//			Half Round Down Including Negative Numbers. This
-//			method is also considered intuitive but may
-//			produce unexpected results when applied to
-//			negative numbers.
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//			'HalfDownWithNegNums' rounds .5 down.
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
 //
-//				Examples of HalfDownWithNegNums
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
-//				7.6 rounds up to 8
-//				7.5 rounds down to 7
-//				7.4 rounds down to 7
-//				-7.4 rounds up to -7
-//				-7.5 rounds down to -8
-//				-7.6 rounds down to -8
+// ----------------------------------------------------------------
 //
-//			HalfAwayFromZero
+// # Return Values
 //
-//				The 'HalfAwayFromZero' method rounds .5 further
-//				away from zero.	It provides clear and consistent
-//				behavior when dealing with negative numbers.
+//	newNumStrKernel				NumberStrKernel
 //
-//					Examples of HalfAwayFromZero
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		containing the base 10 integer value parsed
+//		from 'radixNumStr'.
 //
-//					7.6 rounds away to 8
-//					7.5 rounds away to 8
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds away to -8
-//					-7.6 rounds away to -8
+//	numStrStatsDto				NumberStrStatsDto
 //
-//			HalfTowardsZero
+//		This data transfer object will return key
+//		statistics on the numeric value encapsulated
+//		by the returned instance of NumberStrKernel,
+//		'newNumStrKernel'.
 //
-//				Round Half Towards Zero. 'HalfTowardsZero' rounds
-//				0.5	closer to zero. It provides clear and
-//				consistent behavior	when dealing with negative
-//				numbers.
+//		type NumberStrStatsDto struct {
 //
-//					Examples of HalfTowardsZero
+//			NumOfIntegerDigits					uint64
 //
-//					7.6 rounds away to 8
-//					7.5 rounds to 7
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds to -7
-//					-7.6 rounds away to -8
+//				The total number of integer digits to the
+//				left of the radix point or, decimal point, in
+//				the subject numeric value.
 //
-//			HalfToEven
+//			NumOfSignificantIntegerDigits		uint64
 //
-//				Round Half To Even Numbers. 'HalfToEven' is
-//				also called	Banker's Rounding. This method
-//				rounds 0.5 to the nearest even digit.
+//				The number of nonzero integer digits to the
+//				left of the radix point or, decimal point, in
+//				the subject numeric value.
 //
-//					Examples of HalfToEven
+//			NumOfFractionalDigits				uint64
 //
-//					7.5 rounds up to 8 (because 8 is an even
-//					number)	but 6.5 rounds down to 6 (because
-//					6 is an even number)
+//				The total number of fractional digits to the
+//				right of the radix point or, decimal point,
+//				in the subject numeric value.
 //
-//					HalfToEven only applies to 0.5. Other
-//					numbers (not ending	in 0.5) round to
-//					nearest as usual, so:
+//			NumOfSignificantFractionalDigits	uint64
 //
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8 (because 8 is an even number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds down to 6 (because 6 is an even number)
-//					6.4 rounds down to 6
+//				The number of nonzero fractional digits to
+//				the right of the radix point or, decimal
+//				point, in the subject numeric value.
 //
-//			HalfToOdd
+//			NumberValueType 					NumericValueType
 //
-//				Round Half to Odd Numbers. Similar to 'HalfToEven',
-//				but in this case 'HalfToOdd' rounds 0.5 towards odd
-//				numbers.
+//				This enumeration value specifies whether the
+//				subject numeric value is classified either as
+//				an integer or a floating point number.
 //
-//					Examples of HalfToOdd
+//			NumberSign							NumericSignValueType
 //
-//					HalfToOdd only applies to 0.5. Other numbers
-//					(not ending	in 0.5) round to nearest as usual.
+//				An enumeration specifying the number sign
+//				associated with the numeric value. Possible
+//				values are listed as follows:
+//					NumSignVal.None()		= Invalid Value
+//					NumSignVal.Negative()	= -1
+//					NumSignVal.Zero()		=  0
+//					NumSignVal.Positive()	=  1
 //
-//					7.5 rounds down to 7 (because 7 is an odd number)
+//			IsZeroValue							bool
 //
-//					6.5 rounds up to 7 (because 7 is an odd number)
+//				If 'true', the subject numeric value is equal
+//				to zero ('0').
 //
-//					7.6 rounds up to 8
-//					7.5 rounds down to 7 (because 7 is an odd number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds up to 7 (because 7 is an odd number)
-//					6.4 rounds down to 6
+//				If 'false', the subject numeric value is
+//				greater than or less than zero ('0').
+//		}
 //
-//			Randomly
+//	err							error
 //
-//				Round Half Randomly. Uses a Random Number Generator
-//				to choose between rounding 0.5 up or down.
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
 //
-//				All numbers other than 0.5 round to the nearest as
-//				usual.
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) NewParseHexadecimalNumberStr(
+	radixNumStr string,
+	errorPrefix interface{}) (
+	newNumStrKernel NumberStrKernel,
+	numStrStatsDto NumberStrStatsDto,
+	err error) {
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"NewParseHexadecimalNumberStr()",
+		"")
+
+	if err != nil {
+		return newNumStrKernel,
+			numStrStatsDto,
+			err
+	}
+
+	numStrStatsDto,
+		err = new(numberStrKernelMechanics).
+		setNumStrKernelFromRadixNumStr(
+			&newNumStrKernel,
+			radixNumStr,
+			NumStrFmtType.Hexadecimal(),
+			ePrefix.XCpy(
+				"newNumStrKernel"))
+
+	return newNumStrKernel,
+		numStrStatsDto,
+		err
+}
+
+// NewParseNativeNumberStr
 //
-//			Floor
+// Receives a Native Number String, calculates the
+// numeric value contained therein and proceeds to
+// configure and return a new instance of NumberStrKernel
+// using that calculated numeric value.
 //
-//				Yields the nearest integer down. Floor does not apply
-//				any	special treatment to 0.5.
+// The term 'Native Number String' means that the number
+// string format is designed to interoperate with the
+// Golang programming language library functions and
+// packages. Types like 'strconv', 'strings', 'math'
+// and 'big' (big.Int, big.Float, big.Rat) routinely
+// parse and convert this type of number string to
+// numeric values. In addition, Native Number Strings are
+// frequently consumed by external library functions such
+// as this one (String Mechanics 'strmech') to convert
+// strings to numeric values and numeric values to
+// strings.
 //
-//				Floor Function: The greatest integer that is less than
-//				or equal to x
+// While this format is inconsistent with many national
+// and cultural formatting conventions, number strings
+// which fail to implement this standardized formatting
+// protocol will generate errors in some Golang library
+// functions.
 //
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//	Examples Of Native Number Strings
+//		1000000
+//		12.5483
+//		-1000000
+//		-12.5483
 //
-//				In mathematics and computer science, the floor function
-//				is the function that takes as input a real number x,
-//				and gives as output the greatest integer less than or
-//				equal to x,	denoted floor(x) or ⌊x⌋.
+// A valid Native Number String must conform to the
+// standardized formatting criteria defined below:
 //
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//  1. A Native Number String Consists of numeric
+//     character digits zero through nine inclusive
+//     (0-9).
 //
-//				Examples of Floor
+//  2. A Native Number String will include a period
+//     or decimal point ('.') to separate integer and
+//     fractional digits within a number string.
 //
-//					Number     Floor
-//					 2           2
-//					 2.4         2
-//					 2.9         2
-//					-2.5        -3
-//					-2.7        -3
-//					-2          -2
+//     Native Number String Floating Point Value:
+//     123.1234
 //
-//			Ceiling
+//  3. A Native Number String will always format
+//     negative numeric values with a leading minus sign
+//     ('-').
 //
-//				Yields the nearest integer up. Ceiling does not
-//				apply any special treatment to 0.5.
+//     Native Number String Negative Value:
+//     -123.2
 //
-//				Ceiling Function: The least integer that is
-//				greater than or	equal to x.
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//  4. A Native Number String WILL NEVER include integer
+//     separators such as commas (',') to separate
+//     integer digits by thousands.
 //
-//				The ceiling function maps x to the least integer
-//				greater than or equal to x, denoted ceil(x) or
-//				⌈x⌉.[1]
+//     NOT THIS: 1,000,000
+//     Native Number String: 1000000
 //
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//  5. Native Number Strings will only consist of:
 //
-//					Examples of Ceiling
+//     (a)	Numeric digits zero through nine inclusive (0-9).
 //
-//						Number    Ceiling
-//						 2           2
-//						 2.4         3
-//						 2.9         3
-//						-2.5        -2
-//						-2.7        -2
-//						-2          -2
+//     (b)	A decimal point ('.') for floating point
+//     numbers.
 //
-//			Truncate
+//     (c)	A leading minus sign ('-') in the case of
+//     negative numeric values.
 //
-//				Apply NO Rounding whatsoever. The Round From Digit
-//				is dropped or deleted. The Round To Digit is NEVER
-//				changed.
+//  6. A Native Number String will NEVER include
+//     currency symbols.
 //
-//				Examples of Truncate
+//  7. A Native Number String will NEVER include
+//     leading integer zeros or trailing fractional
+//     zeros.
 //
-//					Example-1
-//					Number: 23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:   23.14 - The Round From Digit
-//					is dropped.
+// ----------------------------------------------------------------
 //
-//					Example-2
-//					Number: -23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:  -23.14 - The Round From Digit
-//					is dropped.
+// # BE ADVISED
 //
-//	roundToFractionalDigits		int
+//	This method will delete any leading integer zero values
+//	or trailing fractional zeros.
 //
-//		When set to a positive integer value, this parameter
-//		controls the number of digits to the right of the radix
-//		point or decimal separator (a.k.a. decimal point). This
-//		controls the number of fractional digits remaining after
-//		completion of the number rounding operation.
+//		Example:
+//			Input String:  00123.45600
+//			Converted Native Number String: 123.456
 //
-//	 errorPrefix                interface{}
+// ----------------------------------------------------------------
 //
-//		This object encapsulates error prefix text which
-//		is included in all returned error messages.
-//		Usually, it	contains the name of the calling
-//		method or methods listed as a method or function
-//		chain of execution.
+// # Input Parameters
 //
-//		If no error prefix information is needed, set this
-//		parameter to 'nil'.
+//		nativeNumStr				string
 //
-//		This empty interface must be convertible to one of
-//		the following types:
+//			A Native Number String containing the numeric
+//			character digits which will be converted to, and
+//			stored in, the NumberStrKernel object passed as
+//			input parameter 'numStrKernel'.
 //
-//		1.	nil
-//				A nil value is valid and generates an
-//				empty collection of error prefix and
-//				error context information.
+//			The term 'Native Number String' means that the
+//			number string format is designed to interoperate
+//			with the Golang programming language library
+//			functions and packages. Types like 'strconv',
+//			'strings', 'math' and 'big' (big.Int, big.Float,
+//			big.Rat) routinely parse and convert this type of
+//			number string to generate numeric values. In
+//			addition, Native Number Strings are frequently
+//			consumed by external library functions such	as
+//			this one (String Mechanics 'strmech') to convert
+//			strings to numeric values and numeric values to
+//			strings.
 //
-//		2.	string
-//				A string containing error prefix
-//				information.
+//			If 'nativeNumStr' fails to meet the formatting
+//			criteria for a Native Number String defined
+//			below, an error will be returned.
 //
-//		3.	[]string
-//				A one-dimensional slice of strings
-//				containing error prefix information.
+//			A valid Native Number String must conform to the
+//			standardized formatting criteria defined below:
 //
-//		4.	[][2]string
-//				A two-dimensional slice of strings
-//		   		containing error prefix and error
-//		   		context information.
+//		 	1.	A Native Number String Consists of numeric
+//		 	  	character digits zero through nine inclusive
+//		 	  	(0-9).
 //
-//		5.	ErrPrefixDto
-//				An instance of ErrPrefixDto.
-//				Information from this object will
-//				be copied for use in error and
-//				informational messages.
+//		 	2.	A Native Number String will include a period
+//		 	  	or decimal point ('.') to separate integer and
+//		 	  	fractional digits within a number string.
 //
-//		6.	*ErrPrefixDto
-//				A pointer to an instance of
-//				ErrPrefixDto. Information from
-//				this object will be copied for use
-//				in error and informational messages.
+//		 	  	Native Number String Floating Point Value:
+//		 	   				123.1234
 //
-//		7.	IBasicErrorPrefix
-//				An interface to a method
-//				generating a two-dimensional slice
-//				of strings containing error prefix
-//				and error context information.
+//		 	3.	A Native Number String will always format
+//		 	  	negative numeric values with a leading minus sign
+//		 	  	('-').
 //
-//		If parameter 'errorPrefix' is NOT convertible
-//		to one of the valid types listed above, it will
-//		be considered invalid and trigger the return of
-//		an error.
+//		 	  	Native Number String Negative Value:
+//		 	  					-123.2
 //
-//		Types ErrPrefixDto and IBasicErrorPrefix are
-//		included in the 'errpref' software package:
-//			"github.com/MikeAustin71/errpref".
+//		 	4.	A Native Number String WILL NEVER include integer
+//				separators such as commas (',') to separate
+//				integer digits by thousands.
 //
-// ----------------------------------------------------------------
+//		 	   					NOT THIS: 1,000,000
+//		 	   		Native Number String: 1000000
 //
-// # Return Values
+//		 	5.	Native Number Strings will only consist of:
 //
-//	error
+//				(a)	Numeric digits zero through nine inclusive (0-9).
 //
-//		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
+//				(b)	A decimal point ('.') for floating point
+//					numbers.
 //
-//		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error
-//		message. This returned error message will
-//		incorporate the method chain and text passed by
-//		input parameter, 'errorPrefix'. The 'errorPrefix'
-//		text will be attached to the beginning of the
-//		error message.
-func (numStrKernel *NumberStrKernel) Round(
-	roundingType NumberRoundingType,
-	roundToFractionalDigits int,
-	errorPrefix interface{}) error {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	var ePrefix *ePref.ErrPrefixDto
-	var err error
-
-	ePrefix,
-		err = ePref.ErrPrefixDto{}.NewIEmpty(
-		errorPrefix,
-		"NumberStrKernel."+
-			"Round()",
-		"")
-
-	if err != nil {
-		return err
-	}
-
-	var numStrRoundingSpec NumStrRoundingSpec
-
-	numStrRoundingSpec,
-		err =
-		new(NumStrRoundingSpec).NewRoundingSpec(
-			roundingType,
-			roundToFractionalDigits,
-			ePrefix)
-
-	if err != nil {
-		return err
-	}
-
-	return new(numStrMathRoundingNanobot).roundNumStrKernel(
-		numStrKernel,
-		numStrRoundingSpec,
-		ePrefix.XCpy("numStrKernel"))
-}
-
-// SetDefaultNumStrFmtSpec
+//				(c)	A leading minus sign ('-') in the case of
+//					negative numeric values.
 //
-// Sets the default Number String Format Specification
-// for the current instance of to NumberStrKernel.
+//			6.	A Native Number String will NEVER include
+//				currency symbols.
 //
-// The default Number String Format Specification is
-// used and applied in formatting the number string for
-// screen display, file output or printing.
+//	 	7.	A Native Number String will NEVER include
+//				leading integer zeros or trailing fractional
+//				zeros.
 //
-// The internal member variable controlling default
-// number string formatting which will be modified
-// by this method is:
+//		roundingType				NumberRoundingType
 //
-//	NumberStrKernel.numStrFormatSpec
+//			This enumeration parameter is used to specify the
+//			type of rounding algorithm that will be applied for
+//			the	rounding of fractional digits contained in the
+//			new returned instance of NumberStrKernel
+//			(newNumStrKernel).
 //
-// The default Number String Format Specification is
-// used and applied by the following methods:
+//			If in doubt as to a suitable rounding method,
+//			'HalfAwayFromZero' is recommended.
 //
-//	NumberStrKernel.FmtSignedNumStrPure()
-//	NumberStrKernel.String()
+//			Possible values are listed as follows:
+//				NumRoundType.None()	- Invalid Value
+//				NumRoundType.NoRounding()
+//				NumRoundType.HalfUpWithNegNums()
+//				NumRoundType.HalfDownWithNegNums()
+//				NumRoundType.HalfAwayFromZero()
+//				NumRoundType.HalfTowardsZero()
+//				NumRoundType.HalfToEven()
+//				NumRoundType.HalfToOdd()
+//				NumRoundType.Randomly()
+//				NumRoundType.Floor()
+//				NumRoundType.Ceiling()
+//				NumRoundType.Truncate()
 //
-// ----------------------------------------------------------------
+//			Definitions:
 //
-// # BE ADVISED
+//				NoRounding
 //
-//	This method will NOT alter the numeric value
-//	configured for the current instance of
-//	NumberStrKernel. This method will only reconfigure
-//	the internal member variable controlling default
-//	number string formatting:
+//					Signals that no rounding operation will be
+//					performed on fractional digits. The
+//					fractional digits will therefore remain
+//					unchanged.
 //
-//		NumberStrKernel.numStrFormatSpec
+//				HalfUpWithNegNums
 //
-// ----------------------------------------------------------------
-//
-// # Input Parameters
+//					Half Round Up Including Negative Numbers.
+//					This method is intuitive but may produce
+//					unexpected results when applied to negative
+//					numbers.
 //
-//	defaultNumStrFmt			NumStrFormatSpec
+//					'HalfUpWithNegNums' rounds .5 up.
 //
-//		An instance of Number String Format Specification
-//		('NumStrFormatSpec').
+//						Examples of 'HalfUpWithNegNums'
+//						7.6 rounds up to 8
+//						7.5 rounds up to 8
+//						7.4 rounds down to 7
+//						-7.4 rounds up to -7
+//						-7.5 rounds up to -7
+//						-7.6 rounds down to -8
 //
-//		A deep copy of this instance will be stored in the
-//		current instance of NumberStrKernel. This format
-//		specification will be used to format the number
-//		string for screen displays, file output or
-//		printing.
+//				HalfDownWithNegNums
 //
-//		If 'defaultNumStrFmt' is found to be invalid, an
-//		error will be returned.
+//				Half Round Down Including Negative Numbers. This
+//				method is also considered intuitive but may
+//				produce unexpected results when applied to
+//				negative numbers.
 //
-//	errorPrefix					interface{}
+//				'HalfDownWithNegNums' rounds .5 down.
 //
-//		This object encapsulates error prefix text which
-//		is included in all returned error messages.
-//		Usually, it contains the name of the calling
-//		method or methods listed as a method or function
-//		chain of execution.
+//					Examples of HalfDownWithNegNums
 //
-//		If no error prefix information is needed, set this
-//		parameter to 'nil'.
+//					7.6 rounds up to 8
+//					7.5 rounds down to 7
+//					7.4 rounds down to 7
+//					-7.4 rounds up to -7
+//					-7.5 rounds down to -8
+//					-7.6 rounds down to -8
 //
-//		This empty interface must be convertible to one of
-//		the following types:
+//				HalfAwayFromZero
 //
-//		1.	nil
-//				A nil value is valid and generates an
-//				empty collection of error prefix and
-//				error context information.
+//					The 'HalfAwayFromZero' method rounds .5 further
+//					away from zero.	It provides clear and consistent
+//					behavior when dealing with negative numbers.
 //
-//		2.	string
-//				A string containing error prefix
-//				information.
+//						Examples of HalfAwayFromZero
 //
-//		3.	[]string
-//				A one-dimensional slice of strings
-//				containing error prefix information.
+//						7.6 rounds away to 8
+//						7.5 rounds away to 8
+//						7.4 rounds to 7
+//						-7.4 rounds to -7
+//						-7.5 rounds away to -8
+//						-7.6 rounds away to -8
 //
-//		4.	[][2]string
-//				A two-dimensional slice of strings
-//		   		containing error prefix and error
-//		   		context information.
+//				HalfTowardsZero
 //
-//		5.	ErrPrefixDto
-//				An instance of ErrPrefixDto.
-//				Information from this object will
-//				be copied for use in error and
-//				informational messages.
+//					Round Half Towards Zero. 'HalfTowardsZero' rounds
+//					0.5	closer to zero. It provides clear and
+//					consistent behavior	when dealing with negative
+//					numbers.
 //
-//		6.	*ErrPrefixDto
-//				A pointer to an instance of
-//				ErrPrefixDto. Information from
-//				this object will be copied for use
-//				in error and informational messages.
+//						Examples of HalfTowardsZero
 //
-//		7.	IBasicErrorPrefix
-//				An interface to a method
-//				generating a two-dimensional slice
-//				of strings containing error prefix
-//				and error context information.
+//						7.6 rounds away to 8
+//						7.5 rounds to 7
+//						7.4 rounds to 7
+//						-7.4 rounds to -7
+//						-7.5 rounds to -7
+//						-7.6 rounds away to -8
 //
-//		If parameter 'errorPrefix' is NOT convertible
-//		to one of the valid types listed above, it will
-//		be considered invalid and trigger the return of
-//		an error.
+//				HalfToEven
 //
-//		Types ErrPrefixDto and IBasicErrorPrefix are
-//		included in the 'errpref' software package:
-//			"github.com/MikeAustin71/errpref".
+//					Round Half To Even Numbers. 'HalfToEven' is
+//					also called	Banker's Rounding. This method
+//					rounds 0.5 to the nearest even digit.
 //
-// ----------------------------------------------------------------
+//						Examples of HalfToEven
 //
-// # Return Values
+//						7.5 rounds up to 8 (because 8 is an even
+//						number)	but 6.5 rounds down to 6 (because
+//						6 is an even number)
 //
-//	error
+//						HalfToEven only applies to 0.5. Other
+//						numbers (not ending	in 0.5) round to
+//						nearest as usual, so:
 //
-//		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
+//						7.6 rounds up to 8
+//						7.5 rounds up to 8 (because 8 is an even number)
+//						7.4 rounds down to 7
+//						6.6 rounds up to 7
+//						6.5 rounds down to 6 (because 6 is an even number)
+//						6.4 rounds down to 6
 //
-//		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error
-//		message. This returned error message will
-//		incorporate the method chain and text passed by
-//		input parameter, 'errorPrefix'. The 'errorPrefix'
-//		text will be attached to the beginning of the
-//		error message.
-func (numStrKernel *NumberStrKernel) SetDefaultNumStrFmtSpec(
-	defaultNumStrFmt NumStrFormatSpec,
-	errorPrefix interface{}) error {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	var ePrefix *ePref.ErrPrefixDto
-
-	var err error
-
-	ePrefix,
-		err = ePref.ErrPrefixDto{}.NewIEmpty(
-		errorPrefix,
-		"NumberStrKernel."+
-			"SetDefaultNumStrFmtSpec()",
-		"")
-
-	if err != nil {
-		return err
-	}
-
-	_,
-		err = new(numStrFmtSpecElectron).
-		testValidityNumStrFormatSpec(
-			&defaultNumStrFmt,
-			ePrefix.XCpy(
-				"defaultNumStrFmt invalid!"))
-
-	if err != nil {
-		return err
-	}
-
-	err = numStrKernel.numStrFormatSpec.CopyIn(
-		&defaultNumStrFmt,
-		ePrefix.XCpy(
-			"numStrKernel.numStrFormatSpec<-defaultNumStrFmt"))
-
-	return err
-}
-
-//	SetDefaultNumStrFmtSpecElements
+//				HalfToOdd
 //
-//	Sets the default Number String Format Specification
-//	for the current instance of to NumberStrKernel.
+//					Round Half to Odd Numbers. Similar to 'HalfToEven',
+//					but in this case 'HalfToOdd' rounds 0.5 towards odd
+//					numbers.
 //
-//	Options include customizing for currency symbols,
-//	integer separation, number sign	management, radix
-//	point symbols, and floating point number rounding.
+//						Examples of HalfToOdd
 //
-//	If required, users have the options of
-//	implementing the India or Chinese Numbering
-//	Systems for integer separation.
+//						HalfToOdd only applies to 0.5. Other numbers
+//						(not ending	in 0.5) round to nearest as usual.
 //
-//	This method offers the maximum degree of granular
-//	control over all aspects of the Number String
-//	formatting operation.
+//						7.5 rounds down to 7 (because 7 is an odd number)
 //
-//	In particular, it offers maximum flexibility in
-//	configuring integer separator characters and
-//	integer grouping sequences.
+//						6.5 rounds up to 7 (because 7 is an odd number)
 //
-// ----------------------------------------------------------------
+//						7.6 rounds up to 8
+//						7.5 rounds down to 7 (because 7 is an odd number)
+//						7.4 rounds down to 7
+//						6.6 rounds up to 7
+//						6.5 rounds up to 7 (because 7 is an odd number)
+//						6.4 rounds down to 6
 //
-// # BE ADVISED
+//				Randomly
 //
-//	(1)	If Currency Symbol formatting is NOT required,
-//		set the Currency Symbol parameter to a 'NOP'.
-//		'NOP' is a computer science term for
-//		'No Operation'.
+//					Round Half Randomly. Uses a Random Number Generator
+//					to choose between rounding 0.5 up or down.
 //
-//			currencySymbol :=
-//				new(NumStrNumberSymbolSpec).NewNOP()
+//					All numbers other than 0.5 round to the nearest as
+//					usual.
 //
-//	(2)	This method will NOT alter the numeric value
-//		configured for the current instance of
-//		NumberStrKernel. This method will only
-//		reconfigure the internal member variable
-//		controlling default number string formatting:
+//				Floor
 //
-//			NumberStrKernel.numStrFormatSpec
+//					Yields the nearest integer down. Floor does not apply
+//					any	special treatment to 0.5.
 //
-// ----------------------------------------------------------------
+//					Floor Function: The greatest integer that is less than
+//					or equal to x
 //
-// # Input Parameters
+//					Source:
+//						https://www.mathsisfun.com/sets/function-floor-ceiling.html
 //
-//	decSeparatorSpec				DecimalSeparatorSpec
+//					In mathematics and computer science, the floor function
+//					is the function that takes as input a real number x,
+//					and gives as output the greatest integer less than or
+//					equal to x,	denoted floor(x) or ⌊x⌋.
 //
-//		This structure contains the radix point or
-//		decimal separator character(s) which will be used
-//		to separate integer and fractional digits within
-//		a formatted Number String.
+//					Source:
+//						https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
 //
-//		In the US, UK, Australia and most of Canada, the
-//		decimal separator is the period character ('.')
-//		known as the decimal point.
+//					Examples of Floor
 //
-//		In France, Germany and many countries in the
-//		European Union, the Decimal Separator is the
-//		comma character (',').
+//						Number     Floor
+//						 2           2
+//						 2.4         2
+//						 2.9         2
+//						-2.5        -3
+//						-2.7        -3
+//						-2          -2
 //
-//	intSeparatorSpec				IntegerSeparatorSpec
+//				Ceiling
 //
-//		Number String Integer Separator Specification. This
-//		type encapsulates the parameters required to format
-//		integer grouping and separation within a Number
-//		String.
+//					Yields the nearest integer up. Ceiling does not
+//					apply any special treatment to 0.5.
 //
-//		Type IntegerSeparatorSpec is designed to manage
-//		integer separators, primarily thousands separators,
-//		for different countries and cultures. The term
-//		'integer separators' is used because this type
-//		manages both integer grouping and the characters
-//		used to separate integer groups.
+//					Ceiling Function: The least integer that is
+//					greater than or	equal to x.
+//					Source:
+//						https://www.mathsisfun.com/sets/function-floor-ceiling.html
 //
-//		In the USA and many other countries, integer
-//		numbers are often separated by commas thereby
-//		grouping the number into thousands.
+//					The ceiling function maps x to the least integer
+//					greater than or equal to x, denoted ceil(x) or
+//					⌈x⌉.[1]
 //
-//		Examples:
+//					Source:
+//						https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
 //
-//			IntGroupingType.None()
-//			(a.k.a Integer Separation Turned Off)
-//				'1000000000'
+//						Examples of Ceiling
 //
-//			IntGroupingType.Thousands()
-//					'1,000,000,000'
+//							Number    Ceiling
+//							 2           2
+//							 2.4         3
+//							 2.9         3
+//							-2.5        -2
+//							-2.7        -2
+//							-2          -2
 //
-//			IntGroupingType.IndiaNumbering()
-//				'6,78,90,00,00,00,00,000'
+//				Truncate
 //
-//			IntGroupingType.ChineseNumbering()
-//				'6,7890,0000,0000,0000'
+//					Apply NO Rounding whatsoever. The Round From Digit
+//					is dropped or deleted. The Round To Digit is NEVER
+//					changed.
 //
-//		Other countries and cultures use characters other
-//		than the comma to separate integers into thousands.
-//		Some countries and cultures do not use thousands
-//		separation and instead rely on multiple integer
-//		separation characters and grouping sequences for a
-//		single integer number. Notable examples of this
-//		are found in the 'India Number System' and
-//		'Chinese Numerals'.
+//					Examples of Truncate
 //
-//		Reference:
-//			https://en.wikipedia.org/wiki/Indian_numbering_system
-//			https://en.wikipedia.org/wiki/Chinese_numerals
-//			https://en.wikipedia.org/wiki/Decimal_separator
+//						Example-1
+//						Number: 23.14567
+//						Objective: Round to two decimal places to
+//						the right of the decimal point.
+//						Rounding Method: Truncate
+//						Round To Digit:   4
+//						Round From Digit: 5
+//						Rounded Number:   23.14 - The Round From Digit
+//						is dropped.
 //
-//		The IntegerSeparatorSpec type provides the
-//		flexibility necessary to process these complex
-//		number separation formats.
+//						Example-2
+//						Number: -23.14567
+//						Objective: Round to two decimal places to
+//						the right of the decimal point.
+//						Rounding Method: Truncate
+//						Round To Digit:   4
+//						Round From Digit: 5
+//						Rounded Number:  -23.14 - The Round From Digit
+//						is dropped.
 //
-//		If integer separation is turned off, no error
-//		will be returned and integer digits will be
-//		displayed as a single string of numeric digits:
+//		roundToFractionalDigits		int
 //
-//			Integer Separation Turned Off: 1000000000
+//			When set to a positive integer value, this
+//			parameter controls the number of digits to the
+//			right of the radix point or decimal separator
+//			(a.k.a. decimal point). This controls the number
+//			of fractional digits remaining after completion
+//			of the number rounding operation.
 //
-//	negativeNumberSign				NumStrNumberSymbolSpec
+//			If input parameter 'roundingType' is set to
+//			NumRoundType.NoRounding(),
+//			'roundToFractionalDigits' is ignored and no
+//			rounding operation is performed.
 //
-//		The Number String Negative Number Sign
-//		Specification is used to configure negative
-//		number sign symbols for negative numeric
-//		values formatted and displayed in number
-//		stings.
+//		errorPrefix					interface{}
 //
-//		If this parameter is submitted as an empty or
-//		invalid Negative Number Sign Specification, it
-//		will be automatically converted to a 'NOP' or
-//		empty placeholder which will be ignored by Number
-//		String formatting algorithms. 'NOP' is a computer
-//		science term meaning 'No Operation'.
+//			This object encapsulates error prefix text which
+//			is included in all returned error messages.
+//			Usually, it contains the name of the calling
+//			method or methods listed as a method or function
+//			chain of execution.
 //
-//		Example-1: Leading Number Sign Symbols
-//			Leading Number Sign Symbols for Negative
-//			Values
+//			If no error prefix information is needed, set this
+//			parameter to 'nil'.
 //
-//			Leading Symbols: "- "
-//			Number String:   "- 123.456"
+//			This empty interface must be convertible to one of
+//			the following types:
 //
-//		Example-2: Leading Number Sign Symbols
-//			Leading Number Sign Symbols for Negative
-//			Values
+//			1.	nil
+//					A nil value is valid and generates an
+//					empty collection of error prefix and
+//					error context information.
 //
-//			Leading Symbols: "-"
-//			Number String:   "-123.456"
+//			2.	string
+//					A string containing error prefix
+//					information.
 //
-//		Example-3: Trailing Number Sign Symbols
-//			Trailing Number Sign Symbols for Negative
-//			Values
+//			3.	[]string
+//					A one-dimensional slice of strings
+//					containing error prefix information.
 //
-//			Trailing Symbols: " -"
-//			Number String:   "123.456 -"
+//			4.	[][2]string
+//					A two-dimensional slice of strings
+//			   		containing error prefix and error
+//			   		context information.
 //
-//		Example-4: Trailing Number Sign Symbols
-//			Trailing Number Sign Symbols for Negative
-//			Values
+//			5.	ErrPrefixDto
+//					An instance of ErrPrefixDto.
+//					Information from this object will
+//					be copied for use in error and
+//					informational messages.
 //
-//			Trailing Symbols: "-"
-//			Number String:   "123.456-"
+//			6.	*ErrPrefixDto
+//					A pointer to an instance of
+//					ErrPrefixDto. Information from
+//					this object will be copied for use
+//					in error and informational messages.
 //
-//	positiveNumberSign 				NumStrNumberSymbolSpec
+//			7.	IBasicErrorPrefix
+//					An interface to a method
+//					generating a two-dimensional slice
+//					of strings containing error prefix
+//					and error context information.
 //
-//		Positive number signs are commonly implied
-//		and not specified. However, the user has
-//		the option to specify a positive number sign
-//		character or characters for positive numeric
-//		values using this input parameter.
+//			If parameter 'errorPrefix' is NOT convertible
+//			to one of the valid types listed above, it will
+//			be considered invalid and trigger the return of
+//			an error.
 //
-//		If this parameter is submitted as an empty or
-//		invalid Positive Number Sign Specification, it
-//		will be automatically converted to a 'NOP' or
-//		empty placeholder which will be ignored by Number
-//		String formatting algorithms. 'NOP' is a computer
-//		science term meaning 'No Operation'.
+//			Types ErrPrefixDto and IBasicErrorPrefix are
+//			included in the 'errpref' software package:
+//				"github.com/MikeAustin71/errpref".
 //
-//		Example-1: Leading Number Sign Symbols
-//			Leading Number Sign Symbols for Positive
-//			Values
+// ----------------------------------------------------------------
 //
-//			Leading Symbols: "+ "
-//			Number String:   "+ 123.456"
+// # Return Values
 //
-//		Example-2: Leading Number Sign Symbols
-//			Leading Number Sign Symbols for Positive
-//			Values
+//	newNumStrKernel				NumberStrKernel
 //
-//			Leading Symbols: "+"
-//			Number String:   "+123.456"
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		configured with the numeric value parsed from
+//		the Native Number String input parameter
+//		'nativeNumStr'.
 //
-//		Example-3: Trailing Number Sign Symbols
-//			Trailing Number Sign Symbols for Positive
-//			Values
+//	numStrStatsDto				NumberStrStatsDto
 //
-//			Trailing Symbols: " +"
-//			Number String:   "123.456 +"
+//		If this method completes successfully, an
+//		instance of NumberStrStatsDto will be returned
+//		containing a profile and key statistics on the
+//		numeric value encapsulated in the returned
+//		instance of NumberStrKernel, 'newNumStrKernel'.
 //
-//		Example-4: Trailing Number Sign Symbols
-//			Trailing Number Sign Symbols for Positive
-//			Values
+//		type NumberStrStatsDto struct {
 //
-//			Trailing Symbols: "+"
-//			Number String:   "123.456+"
+//		NumOfIntegerDigits					uint64
 //
-//	zeroNumberSign					NumStrNumberSymbolSpec
+//			The total number of integer digits to the
+//			left of the radix point or, decimal point, in
+//			the subject numeric value.
 //
-//		The Number String Zero Number Sign
-//		Specification is used to configure number
-//		sign symbols for zero numeric values formatted
-//		and displayed in number stings. Zero number signs
-//		are commonly omitted because zero does not
-//		technically qualify as either a positive or
-//		negative value. However, the user has the option
-//		to configure number sign symbols for zero values
-//		if necessary.
+//		NumOfSignificantIntegerDigits		uint64
 //
-//		If this parameter is submitted as an empty or
-//		invalid Zero Number Sign Specification, it will
-//		be automatically converted to a 'NOP' or empty
-//		placeholder which will be ignored by Number
-//		String formatting algorithms. 'NOP' is a computer
-//		science term meaning 'No Operation'.
+//			The number of nonzero integer digits to the
+//			left of the radix point or, decimal point, in
+//			the subject numeric value.
 //
-//		Example-1: Leading Number Sign Symbols
-//			Leading Number Sign Symbols for Zero Values
-//
-//			Leading Symbols: "+"
-//			Trailing Symbols: ""
-//			Number String:   "+0.00"
-//
-//		Example-2: Leading Number Sign Symbols
-//			Leading Number Sign Symbols for Zero Values
-//
-//			Leading Symbols: "+ "
-//			Trailing Symbols: ""
-//			Number String:   "+ 0.00"
-//
-//		Example-3: Trailing Number Sign Symbols
-//			Trailing Number Sign Symbols for Zero Values
-//
-//			Leading Symbols: ""
-//			Trailing Symbols: " +"
-//			Number String:   "0.00 +"
-//
-//		Example-4: Trailing Number Sign Symbols
-//			Trailing Number Sign Symbols for Zero Values
-//
-//			Leading Symbols: ""
-//			Trailing Symbols: "+"
-//			Number String:   "0.00+"
-//
-//	currencySymbol					NumStrNumberSymbolSpec
-//
-//		A Currency Symbol positioned next to a numeric
-//		value designates the number as a monetary amount.
-//
-//		The Number String Currency Symbol Specification
-//		is used to configure currency symbols for
-//		positive, negative and zero numeric values
-//		formatted and displayed in number stings.
-//
-//		If Currency Symbol formatting is NOT required,
-//		set this parameter to a 'NOP'. 'NOP' is a
-//		computer science term for 'No Operation'.
-//
-//			currencySymbol :=
-//				new(NumStrNumberSymbolSpec).NewNOP()
-//
-//		If this parameter is submitted as an empty or
-//		invalid Currency Symbol Specification, it will
-//		be automatically converted to a 'NOP' or empty
-//		placeholder which will be ignored by Number
-//		String formatting algorithms. 'NOP' is a computer
-//		science term meaning 'No Operation'.
-//
-//		Examples of Currency Symbols include the Dollar
-//		sign ('$'), Euro sign ('€') or Pound sign ('£').
-//
-//		This instance of NumStrNumberSymbolSpec is used
-//		to configure leading Currency Symbols, trailing
-//		Currency Symbols or both leading and trailing
-//		Currency Symbols.
-//
-//		Example-1: Leading Currency Symbols
+//		NumOfFractionalDigits				uint64
 //
-//			Leading Currency Symbols: "$ "
-//			Number String:   "$ 123.456"
+//			The total number of fractional digits to the
+//			right of the radix point or, decimal point,
+//			in the subject numeric value.
 //
-//		Example-2: Leading Currency Symbols
+//		NumOfSignificantFractionalDigits	uint64
 //
-//			Leading Currency Symbols: "$"
-//			Number String:   "$123.456"
+//			The number of nonzero fractional digits to
+//			the right of the radix point or, decimal
+//			point, in the subject numeric value.
 //
-//		Example-3: Trailing Currency Symbols
-//			Trailing Currency Symbols for Positive Values
+//		NumberValueType 					NumericValueType
 //
-//			Trailing Currency Symbols: "€"
-//			Number String:   "123.456€"
+//			This enumeration value specifies whether the
+//			subject numeric value is classified either as
+//			an integer or a floating point number.
 //
-//		Example-4: Trailing Currency Symbols
-//			Trailing Currency Symbols for Positive Values
+//			Possible enumeration values are listed as
+//			follows:
+//				NumValType.None()
+//				NumValType.FloatingPoint()
+//				NumValType.Integer()
 //
-//			Trailing Currency Symbols: " €"
-//			Number String:   "123.456 €"
+//		NumberSign							NumericSignValueType
 //
-//	numberFieldSpec				NumStrNumberFieldSpec
+//			An enumeration specifying the number sign
+//			associated with the numeric value. Possible
+//			values are listed as follows:
+//				NumSignVal.None()		= Invalid Value
+//				NumSignVal.Negative()	= -1
+//				NumSignVal.Zero()		=  0
+//				NumSignVal.Positive()	=  1
 //
-//		This Number Field Specification contains all
-//		parameters necessary to format a Number String
-//		within a larger Number Field. In addition to
-//		specifying the length of number field, this
-//		object contains justification specifications
-//		for centering, left justifying or right
-//		justifying a Number String within a Number
-//		Field.
+//		IsZeroValue							bool
 //
-//		type NumStrNumberFieldSpec struct {
+//			If 'true', the subject numeric value is equal
+//			to zero ('0').
 //
-//			fieldLength int
+//			If 'false', the subject numeric value is
+//			greater than or less than zero ('0').
+//		}
 //
-//				This parameter defines the length of the
-//				text field in which the numeric value will
-//				be displayed within a number string.
+//	err							error
 //
-//				If 'fieldLength' is less than the length
-//				of the numeric value string, it will be
-//				automatically set equal to the length of
-//				that numeric value string.
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
 //
-//				To automatically set the value of
-//				'fieldLength' to the string length of the
-//				numeric value, set this parameter to a
-//				value of minus one (-1).
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) NewParseNativeNumberStr(
+	nativeNumStr string,
+	roundingType NumberRoundingType,
+	roundToFractionalDigits int,
+	errorPrefix interface{}) (
+	newNumStrKernel NumberStrKernel,
+	numStrStatsDto NumberStrStatsDto,
+	err error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"NewParseNativeNumberStr()",
+		"")
+
+	if err != nil {
+		return newNumStrKernel,
+			numStrStatsDto,
+			err
+	}
+
+	numStrStatsDto,
+		err = new(numberStrKernelMechanics).
+		setNumStrKernelFromRoundedNativeNumStr(
+			&newNumStrKernel,
+			nativeNumStr,
+			roundingType,
+			roundToFractionalDigits,
+			ePrefix.XCpy(
+				"newNumStrKernel"))
+
+	return newNumStrKernel,
+		numStrStatsDto,
+		err
+}
+
+// NewParseOctalNumberStr
 //
-//				If this parameter is submitted with a
-//				value less than minus one (-1) or greater
-//				than 1-million (1,000,000), an error will
-//				be returned.
+//	Receives a octal number string and returns
+//	the extracted integer value as a new instance of
+//	NumberStrKernel.
 //
-//			fieldJustification TextJustify
+//	Leading and trailing white space is ignored. The
+//	number string may begin with a leading plus ('+')
+//	or minus ('-') sign. Underscore characters ('_')
+//	may be used to separate digits. An underscore may
+//	appear between two digits or directly after the
+//	radix prefix.
 //
-//				An enumeration which specifies the
-//				justification of the numeric value string
-//				within the number field length specified
-//				by data field 'fieldLength'.
+//	The parsed value is converted to base 10 and may
+//	be of any size. There is no int64 overflow limit.
 //
-//				Text justification can only be evaluated in
-//				the context of a number string, field length
-//				and a 'textJustification' object of type
-//				TextJustify. This is because number strings
-//				with a field length equal to or less than the
-//				length of the numeric value string never use
-//				text justification. In these cases, text
-//				justification is completely ignored.
+//	Examples:
+//		"0o17"			= 15
+//		"-777"			= -511
 //
-//				If the field length parameter ('fieldLength')
-//				is greater than the length of the numeric
-//				value string, text justification must be equal
-//				to one of these three valid values:
+// ----------------------------------------------------------------
 //
-//				          TextJustify(0).Left()
-//				          TextJustify(0).Right()
-//				          TextJustify(0).Center()
+// # Input Parameters
 //
-//				You can also use the abbreviated text
-//				justification enumeration syntax as follows:
+//	radixNumStr					string
 //
-//				          TxtJustify.Left()
-//				          TxtJustify.Right()
-//				          TxtJustify.Center()
-//		}
+//		A octal number string. The radix prefix
+//		("0o") is optional. If any character other
+//		than a octal digit, a sign, a digit
+//		separator ('_') or the radix prefix is
+//		encountered, an error will be returned.
 //
-//	 errorPrefix                interface{}
+//	errorPrefix					interface{}
 //
 //		This object encapsulates error prefix text which
 //		is included in all returned error messages.
-//		Usually, it	contains the name of the calling
+//		Usually, it contains the name of the calling
 //		method or methods listed as a method or function
 //		chain of execution.
 //
@@ -8242,206 +7990,208 @@ func (numStrKernel *NumberStrKernel) SetDefaultNumStrFmtSpec(
 //
 // # Return Values
 //
-//	error
+//	newNumStrKernel				NumberStrKernel
 //
-//		If this method completes successfully, the returned
-//		error Type is set equal to 'nil'.
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		containing the base 10 integer value parsed
+//		from 'radixNumStr'.
 //
-//		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error message.
-//	 	This returned error message will incorporate the method
-//	 	chain and text passed by input parameter, 'errorPrefix'.
-//	 	The 'errorPrefix' text will be attached to the beginning
-//	 	of the error message.
-func (numStrKernel *NumberStrKernel) SetDefaultNumStrFmtSpecElements(
-	decSeparatorSpec DecimalSeparatorSpec,
-	intSeparatorSpec IntegerSeparatorSpec,
-	negativeNumberSign NumStrNumberSymbolSpec,
-	positiveNumberSign NumStrNumberSymbolSpec,
-	zeroNumberSign NumStrNumberSymbolSpec,
-	currencySymbol NumStrNumberSymbolSpec,
-	numberFieldSpec NumStrNumberFieldSpec,
-	errorPrefix interface{}) error {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	var ePrefix *ePref.ErrPrefixDto
-	var err error
-
-	ePrefix,
-		err = ePref.ErrPrefixDto{}.NewIEmpty(
-		errorPrefix,
-		"NumberStrKernel."+
-			"SetDefaultNumStrFmtSpecElements()",
-		"")
-
-	if err != nil {
-		return err
-	}
-
-	return numStrKernel.numStrFormatSpec.
-		SetNumFmtElements(
-			decSeparatorSpec,
-			intSeparatorSpec,
-			negativeNumberSign,
-			positiveNumberSign,
-			zeroNumberSign,
-			currencySymbol,
-			numberFieldSpec,
-			ePrefix.XCpy(
-				"numStrKernel->"))
-}
-
-// SetDefaultNumStrFmtSpecPure
+//	numStrStatsDto				NumberStrStatsDto
 //
-// Sets the default Number String Format Specification
-// for the current instance of to NumberStrKernel.
+//		This data transfer object will return key
+//		statistics on the numeric value encapsulated
+//		by the returned instance of NumberStrKernel,
+//		'newNumStrKernel'.
 //
-// The default Number String Format Specification is
-// used and applied in formatting the number string for
-// screen display, file output or printing.
+//		type NumberStrStatsDto struct {
 //
-// The internal member variable controlling default
-// number string formatting which will be modified
-// by this method is:
+//			NumOfIntegerDigits					uint64
 //
-//	NumberStrKernel.numStrFormatSpec
+//				The total number of integer digits to the
+//				left of the radix point or, decimal point, in
+//				the subject numeric value.
 //
-// The default Number String Format Specification is
-// used and applied by the following methods:
+//			NumOfSignificantIntegerDigits		uint64
 //
-//	NumberStrKernel.FmtNumStrDefaultRound()
-//	NumberStrKernel.String()
+//				The number of nonzero integer digits to the
+//				left of the radix point or, decimal point, in
+//				the subject numeric value.
 //
-// ----------------------------------------------------------------
+//			NumOfFractionalDigits				uint64
 //
-// # BE ADVISED
+//				The total number of fractional digits to the
+//				right of the radix point or, decimal point,
+//				in the subject numeric value.
 //
-//	This method will NOT alter the numeric value
-//	configured for the current instance of
-//	NumberStrKernel. This method will only reconfigure
-//	the internal member variable controlling default
-//	number string formatting:
+//			NumOfSignificantFractionalDigits	uint64
 //
-//		NumberStrKernel.numStrFormatSpec
+//				The number of nonzero fractional digits to
+//				the right of the radix point or, decimal
+//				point, in the subject numeric value.
 //
-// ----------------------------------------------------------------
+//			NumberValueType 					NumericValueType
 //
-// # Input Parameters
+//				This enumeration value specifies whether the
+//				subject numeric value is classified either as
+//				an integer or a floating point number.
 //
-//	decSeparatorChars			string
+//			NumberSign							NumericSignValueType
 //
-//		This string contains the character or characters
-//		which will be configured as the Decimal Separator
-//		Symbol or Symbols for the returned number string.
+//				An enumeration specifying the number sign
+//				associated with the numeric value. Possible
+//				values are listed as follows:
+//					NumSignVal.None()		= Invalid Value
+//					NumSignVal.Negative()	= -1
+//					NumSignVal.Zero()		=  0
+//					NumSignVal.Positive()	=  1
 //
-//		The decimal separator is also known as the radix
-//		point and is used to separate integer and
-//		fractional digits within a formatted floating
-//		point number string.
+//			IsZeroValue							bool
 //
-//		In the US, UK, Australia and most of Canada, the
-//		decimal separator is the period character ('.')
-//		also known as the decimal point.
+//				If 'true', the subject numeric value is equal
+//				to zero ('0').
 //
-//		In France, Germany and many countries in the
-//		European Union, the Decimal Separator is the
-//		comma character (',').
+//				If 'false', the subject numeric value is
+//				greater than or less than zero ('0').
+//		}
 //
-//	leadingNumSymbols			bool
+//	err							error
 //
-//		In Pure Number Strings, positive numeric values
-//		are NOT configured with leading or trailing plus
-//		signs ('+'). Negative values on the other hand,
-//		are always designated by leading or trailing
-//		minus sign ('-').
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
 //
-//		This parameter, 'leadingNumSymbols', controls
-//		the positioning of minus signs for negative
-//		numeric values within a	Number String.
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) NewParseOctalNumberStr(
+	radixNumStr string,
+	errorPrefix interface{}) (
+	newNumStrKernel NumberStrKernel,
+	numStrStatsDto NumberStrStatsDto,
+	err error) {
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"NewParseOctalNumberStr()",
+		"")
+
+	if err != nil {
+		return newNumStrKernel,
+			numStrStatsDto,
+			err
+	}
+
+	numStrStatsDto,
+		err = new(numberStrKernelMechanics).
+		setNumStrKernelFromRadixNumStr(
+			&newNumStrKernel,
+			radixNumStr,
+			NumStrFmtType.Octal(),
+			ePrefix.XCpy(
+				"newNumStrKernel"))
+
+	return newNumStrKernel,
+		numStrStatsDto,
+		err
+}
+
+// NewParsePercentNumberStr
 //
-//		When set to 'true', the returned number string
-//		will configure minus signs for negative numbers
-//		at the beginning of, or on the left side of, the
-//		numeric value. In these cases, the minus sign is
-//		said to be configured as a leading minus sign.
-//		This is the positioning format used in the US,
-//		UK, Australia and most of Canada. In addition,
-//		library functions in 'Go' and other programming
-//		languages generally expect leading minus signs
-//		for negative numbers.
+//	Receives a percent, per mille or basis point number
+//	string and returns the unscaled numeric value as a
+//	new instance of NumberStrKernel.
 //
-//			Example Leading Minus Sign:
-//				"-123.456"
+//	The numeric value extracted from 'percentNumStr' is
+//	divided by 100 (Percent), 1,000 (Per Mille) or
+//	10,000 (Basis Points).
 //
-//		When parameter 'leadingNumSymbols' is set to
-//		'false', the returned number string will
-//		configure minus signs for negative numbers at the
-//		end of, or on the right side of, the numeric
-//		value. With this positioning format, the minus
-//		sign is said to be configured as a trailing minus
-//		sign. This is the positioning format used in
-//		France, Germany and many countries in the
-//		European Union.
+//	Examples:
+//		"12.5%"			= 0.125
+//		"-12.5 %"		= -0.125
+//		"12,5 %"		= 0.125 (decimal separator ',')
+//		"125‰"			= 0.125
+//		"1,250 bp"		= 0.125
 //
-//			Example Trailing Minus Sign:
-//				"123.456-"
+//	This method reverses the formatting performed by
+//	NumberStrKernel.FmtNumStr() when the Number String
+//	Format Specification is configured by
+//	NumStrFormatSpec.NewPercentNumFormat().
 //
-//	numFieldLength					int
+// ----------------------------------------------------------------
 //
-//		This parameter defines the length of the text
-//		field in which the numeric value will be
-//		displayed within a number string.
+// # Input Parameters
 //
-//		If 'numFieldLength' is less than the length of the
-//		numeric value string, it will be automatically set
-//		equal to the length of that numeric value string.
+//	percentNumStr				string
 //
-//		To automatically set the value of fieldLength to
-//		the string length of the numeric value, set this
-//		parameter to a value of minus one (-1).
+//		The percent, per mille or basis point number
+//		string to be parsed. The following symbols are
+//		recognized:
 //
-//		If this parameter is submitted with a value less
-//		than minus one (-1) or greater than 1-million
-//		(1,000,000), an error will be returned.
+//			"%"			Percent
+//			"‰"			Per Mille
+//			"‱"			Basis Points
+//			"bp", "bps"	Basis Points
 //
-//	numFieldJustification		TextJustify
+//		Spaces and non-breaking spaces are ignored.
+//		Integer separators may separate integer digits.
+//		Negative values may be designated with a leading
+//		or trailing minus sign ('-') or with surrounding
+//		parentheses ('()').
 //
-//		An enumeration which specifies the justification
-//		of the numeric value within the number field
-//		length specified by input parameter
-//		'numFieldLength'.
+//		The percent symbol must appear exactly once,
+//		immediately before or after the numeric digits.
+//		If 'percentNumStr' contains more than one decimal
+//		separator, more than one percent symbol or any
+//		other characters, an error will be returned.
 //
-//		Text justification can only be evaluated in the
-//		context of a number string, field length and a
-//		'textJustification' object of type TextJustify.
-//		This is because number strings with a field length
-//		equal to or less than the length of the numeric
-//		value string never use text justification. In
-//		these cases, text justification is completely
-//		ignored.
+//			Invalid Examples:
+//				"1.2.3%"
+//				"12.5% 3"
+//				"abc12%"
+//				"12.5%%"
 //
-//		If the field length parameter ('numFieldLength')
-//		is greater than the length of the numeric value
-//		string, text justification must be equal to one
-//		of these three valid values:
+//	percentFmtType				NumStrFormatTypeCode
 //
-//			TextJustify(0).Left()
-//			TextJustify(0).Right()
-//			TextJustify(0).Center()
+//		Specifies the scale of 'percentNumStr'. Valid
+//		values are:
 //
-//		You can also use the abbreviated text justification
-//		enumeration syntax as follows:
+//			NumStrFmtType.None()
+//			NumStrFmtType.Percent()
+//			NumStrFmtType.PerMille()
+//			NumStrFmtType.BasisPoint()
 //
-//			TxtJustify.Left()
-//			TxtJustify.Right()
-//			TxtJustify.Center()
+//		If this parameter is set to NumStrFmtType.None(),
+//		the scale is determined by the symbol found in
+//		'percentNumStr'. In this case, if no symbol is
+//		found, an error will be returned.
+//
+//		If a symbol found in 'percentNumStr' conflicts
+//		with this parameter, an error will be returned.
+//
+//	decimalSeparator			string
+//
+//		The radix point or decimal separator which
+//		separates integer and fractional digits in
+//		'percentNumStr'.
+//
+//		In the US, the decimal separator is the period
+//		('.'). In France and Germany, the decimal
+//		separator is the comma (',').
 //
 //	errorPrefix					interface{}
 //
@@ -8506,24 +8256,39 @@ func (numStrKernel *NumberStrKernel) SetDefaultNumStrFmtSpecElements(
 //
 // # Return Values
 //
-//	error
+//	newNumStrKernel				NumberStrKernel
 //
-//		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		containing the unscaled numeric value parsed from
+//		'percentNumStr'.
 //
-//		If errors are encountered during processing, the
+//	numStrStatsDto				NumberStrStatsDto
+//
+//		This data transfer object will return key
+//		statistics on the numeric value encapsulated
+//		by 'newNumStrKernel'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
 //		returned error Type will encapsulate an error
-//		message. This returned error message will
-//		incorporate the method chain and text passed by
-//		input parameter, 'errorPrefix'. The 'errorPrefix'
-//		text will be attached to the beginning of the
-//		error message.
-func (numStrKernel *NumberStrKernel) SetDefaultNumStrFmtSpecPure(
-	decSeparatorChars string,
-	leadingNumSymbols bool,
-	numFieldLength int,
-	numFieldJustification TextJustify,
-	errorPrefix interface{}) error {
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) NewParsePercentNumberStr(
+	percentNumStr string,
+	percentFmtType NumStrFormatTypeCode,
+	decimalSeparator string,
+	errorPrefix interface{}) (
+	newNumStrKernel NumberStrKernel,
+	numStrStatsDto NumberStrStatsDto,
+	err error) {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -8535,401 +8300,415 @@ func (numStrKernel *NumberStrKernel) SetDefaultNumStrFmtSpecPure(
 
 	var ePrefix *ePref.ErrPrefixDto
 
-	var err error
-
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"SetDefaultNumStrFmtSpecPure()",
+			"NewParsePercentNumberStr()",
 		"")
 
 	if err != nil {
-		return err
+		return newNumStrKernel,
+			numStrStatsDto,
+			err
 	}
 
-	numStrKernel.numStrFormatSpec.Empty()
-
-	numStrKernel.numStrFormatSpec,
-		err = new(NumStrFormatSpec).NewSignedPureNumberStr(
-		decSeparatorChars,
-		leadingNumSymbols,
-		numFieldLength,
-		numFieldJustification,
-		ePrefix.XCpy(
-			"numStrKernel.numStrFormatSpec<-"))
+	numStrStatsDto,
+		err = new(numberStrKernelMechanics).
+		setNumStrKernelFromPercentNumStr(
+			&newNumStrKernel,
+			percentNumStr,
+			percentFmtType,
+			decimalSeparator,
+			ePrefix.XCpy(
+				"newNumStrKernel"))
 
-	return err
+	return newNumStrKernel,
+		numStrStatsDto,
+		err
 }
 
-//	SetDefaultSignedNumStrFmtSpecBasic
+// NewParsePureNumberStr
 //
-//	Reconfigures the current instance of
-//	NumberStrKernel with a new default Signed Number
-//	String Format using the basic set of input parameters.
-//
-//	This Number String Format is designed to format
-//	'Signed' integer or floating point numeric values.
+// Receives a Pure Number String and proceeds to return
+// the extracted numeric value as a new instance of type
+// NumberStrKernel.
 //
-//	A signed number is an integer or floating point
-//	numeric value which does NOT contain currency
-//	symbols.
+// A Pure Number String is defined as follows:
 //
-//	This method is one step above NewSignedNumSimple()
-//	in terms of complexity and customization options.
+//  1. A pure number string consists entirely of numeric
+//     digit characters (0-9).
 //
-//	This method provides additional options for multiple
-//	integer grouping types including India and Chinese
-//	Numbering.
+//  2. A pure number string will separate integer and
+//     fractional digits with a radix point or decimal
+//     separator. This could be, but is not limited to,
+//     a decimal point ('.'). For example, many European
+//     countries use the comma (',') as a radix point.
 //
-//	Negative number sign symbols may be configured as
-//	leading negative number signs, trailing negative
-//	number signs or both. The combination of leading
-//	and trailing negative number signs allows for the
-//	configuration symbols like parentheses for the
-//	formatting of negative numbers.
+//  3. A pure number string will designate negative values
+//     with a minus sign ('-'). This minus sign could be
+//     positioned as a leading or trailing minus sign.
 //
-//		Example: (125.34)
+//  4. A pure number string will NEVER include integer
+//     separators such as commas (',') to separate
+//     integer digits by thousands.
 //
-// ----------------------------------------------------------------
+//     NOT THIS: 1,000,000
+//     Pure Number String: 1000000
 //
-// # BE ADVISED
+//  5. A pure number string will NEVER include currency
+//     symbols.
 //
-//	This method will NOT alter the numeric value
-//	configured for the current instance of
-//	NumberStrKernel. This method will only reconfigure
-//	the internal member variable controlling default
-//	number string formatting:
+// Users also have the option of specifying rounding
+// parameters which will be used to round the numeric
+// value extracted from 'pureNumberStr' before it is
+// converted and returned as a new instance of
+// NumberStrKernel.
 //
-//		NumberStrKernel.numStrFormatSpec
+// Note that return parameter 'pureNumStrComponents'
+// will provide a statistical profile of the new
+// returned instance of NumberStrKernel,
+// 'newNumStrKernel'.
 //
 // ----------------------------------------------------------------
 //
 // # Input Parameters
 //
-//	decSeparatorChars				string
+//	pureNumberStr				string
+//
+//		This strings contains the numeric character
+//		digits from	which a numeric value will be
+//		extracted and returned as a NumberStrKernel.
+//
+//		A "Pure Number String" is defined as follows:
+//
+//			1.	Consists of numeric character digits
+//				zero through nine inclusive (0-9).
+//
+//			2.	Option: A Pure Number String may include
+//				a radix point or decimal separator.
+//				Decimal separators separate integer and
+//				fractional numeric digits in a pure
+//				number string. The decimal separator may
+//				consist of one or more text characters.
+//
+//				In the US, UK, Australia, most of Canada
+//				and many other countries, the decimal
+//				separator is the period character ('.')
+//				known as the decimal point.
+//
+//				In France, Germany and many countries in
+//				the European Union, the Decimal Separator
+//				is the comma character (',').
+//
+//			3.	Optional: A Pure Number String may
+//				include a negative number sign symbol
+//				consisting of a minus sign ('-'). The
+//				minus sign will identify the numeric
+//				value contained in the pure number string
+//				as a negative number. Only the minus sign
+//				('-') classifies a numeric value as a
+//				negative number in a Pure Number String.
+//
+//				If a leading or trailing minus sign ('-')
+//				is NOT present in the pure number string,
+//				the numeric value is assumed to be
+//				positive.
+//
+//			4.	Only numeric characters, the decimal
+//				separator and the minus sign will be
+//				processed by the pure number string
+//				parsing algorithm. All other characters
+//				will be	ignored.
+//
+//			5.	Pure Number Strings consist of a single
+//				numeric value. The entire Pure Number String
+//				will be parsed, or processed, and only one
+//				numeric value per Pure Number String will
+//				be returned.
+//
+//	decSeparatorChars			string
 //
 //		This string contains the character or characters
 //		which will be configured as the Decimal Separator
-//		Symbol or Symbols for the returned instance of
-//		NumStrFormatSpec.
+//		Symbol.
 //
-//		The decimal separator is also known as the radix
-//		point and is used to separate integer and fractional
-//		digits within a formatted Number String.
+//		The Decimal Separator is also known as the radix
+//		point and is used to separate integer and
+//		fractional digits within a formatted, floating
+//		point Number String.
 //
-//		In the US, UK, Australia and most of Canada, the
-//		decimal separator is the period character ('.')
-//		known as the decimal point.
+//		In the US, UK, Australia, most of Canada and many
+//		other countries the Decimal Separator is the
+//		period character ('.') known as the decimal
+//		point.
 //
 //		In France, Germany and many countries in the
 //		European Union, the Decimal Separator is the
 //		comma character (',').
 //
-//	intSeparatorChars				string
+//		The Decimal Separator is used to identify and
+//		separate integer and fractional digits while
+//		parsing the Pure Number String.
 //
-//		One or more characters used to separate groups of
-//		integers. This separator is also known as the
-//		'thousands' separator. It is used to separate
-//		groups of integer digits to the left of the
-//		decimal separator (a.k.a. decimal point). In the
-//		United States, the standard integer digits
-//		separator is the comma (",").
+//	leadingMinusSign			bool
 //
-//			United States Example:  1,000,000,000
+//		In pure number strings, a minus sign ('-')
+//		identifies a number as a negative numeric value.
 //
-//		In many European countries, a single period ('.')
-//		is used as the integer separator character.
+//		When 'leadingMinusSign' is set to 'true', the
+//		pure number string parsing algorithm will search
+//		for a leading minus sign ('-') at the beginning of
+//		the number string. Leading minus signs represent
+//		the standard means for designating negative
+//		numeric values in the US, UK, Australia, most of
+//		Canada and many other parts of world.
 //
-//			European Example: 1.000.000.000
+//		Example Leading Minus Sign:
+//			"-123.456" or "- 123.456"
 //
-//		Other countries and cultures use spaces,
-//		apostrophes or multiple characters to separate
-//		integers.
+//		When 'leadingMinusSign' is set to 'false', the
+//		pure number string parsing algorithm will search
+//		for trailing minus signs ('-') located at the end
+//		of the number string. Trailing minus signs
+//		represent the standard for France, Germany and
+//		many countries in the European Union.
 //
-//		If this input parameter contains a zero length
-//		string and parameter 'intGroupingType' is NOT
-//		equal to 'IntGroupingType.None()', an error will
-//		be returned.
+//		NOTE: Identification of a trailing minus sign in
+//		the pure number string input parameter,
+//		'pureNumberString', will immediately terminate
+//		the search for numeric characters.
 //
-//	intGroupingType					IntegerGroupingType
+//		Example Trailing Number Symbols:
+//			"123.456-" or "123.456 -"
 //
-//		This instance of IntegerGroupingType defines the type
-//		of IntegerSeparatorSpec which will be returned. The
-//		enumeration IntegerGroupingType must be set to one
-//		of the following values:
+//	 errorPrefix                interface{}
 //
-//			IntGroupingType.None()
-//			IntGroupingType.Thousands()
-//			IntGroupingType.IndiaNumbering()
-//			IntGroupingType.ChineseNumbering()
+//		This object encapsulates error prefix text which is
+//		included in all returned error messages. Usually, it
+//		contains the name of the calling method or methods
+//		listed as a method or function chain of execution.
 //
-//		Note:	Setting 'intGroupingType' to a value of
-//				IntGroupingType.None() effectively turns
-//				off integer separation.
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//		Examples:
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//			IntGroupingType.None()
-//				'1000000000'
+//		1. nil - A nil value is valid and generates an empty
+//		   collection of error prefix and error context
+//		   information.
 //
-//			IntGroupingType.Thousands()
-//					'1,000,000,000'
+//		2. string - A string containing error prefix
+//			information.
 //
-//			IntGroupingType.IndiaNumbering()
-//				'6,78,90,00,00,00,00,000'
+//		3. []string A one-dimensional slice of strings
+//			containing error prefix information.
 //
-//			IntGroupingType.ChineseNumbering()
-//				'6,7890,0000,0000,0000'
+//		4. [][2]string A two-dimensional slice of strings
+//		   containing error prefix and error context
+//		   information.
 //
-//	leadingNegativeNumSign			string
+//		5. ErrPrefixDto - An instance of ErrPrefixDto.
+//			Information from this object will be copied for use
+//			in error and informational messages.
 //
-//		A string containing the leading negative number
-//		sign character or characters used to configure
-//		Negative Number Sign Symbols in a number string
-//		with a negative numeric value.
+//		6. *ErrPrefixDto - A pointer to an instance of
+//			ErrPrefixDto. Information from this object will be
+//			copied for use in error and informational messages.
 //
-//		The most common configuration for a leading
-//		negative number sign would be a leading minus
-//		sign ('-').
+//		7. IBasicErrorPrefix - An interface to a method
+//			generating a two-dimensional slice of strings
+//			containing error prefix and error context
+//			information.
 //
-//		Another option is to configure a single
-//		parenthesis ("(") to be matched by a trailing
-//		negative number sign with the closing parenthesis
-//		(")"). This combination would effectively enclose
-//		negative numbers in parentheses.
-//			Example "(125.67)"
+//		If parameter 'errorPrefix' is NOT convertible to one
+//		of the valid types listed above, it will be
+//		considered invalid and trigger the return of an
+//		error.
 //
-//	trailingNegativeNumSign			string
+//		Types ErrPrefixDto and IBasicErrorPrefix are included
+//		in the 'errpref' software package,
+//		"github.com/MikeAustin71/errpref".
 //
-//		A string containing the trailing negative number
-//		sign character or characters used to configure
-//		Negative Number Sign Symbols in a number string
-//		with a negative numeric value.
+// ----------------------------------------------------------------
 //
-//		The most common configuration for a trailing
-//		negative number sign would be a trailing minus
-//		sign ('-').
+// # Return Values
 //
-//		Another option is to configure a single
-//		closing parenthesis (")") to be matched by a
-//		leading negative number sign with the opening
-//		parenthesis ("("). This combination would
-//		effectively enclose negative numbers in
-//		parentheses.
-//			Example "(125.67)"
+//	newNumStrKernel 			NumberStrKernel
 //
-//	fieldLength						int
+//		If this method completes successfully, a new instance
+//		of NumberStrKernel will be returned configured with
+//		the numeric value parsed and extracted from input
+//		paramter, 'pureNumberStr'.
 //
-//		This parameter defines the length of the text
-//		field in which the numeric value will be
-//		displayed within a number string.
+//	pureNumStrComponents		PureNumberStrComponents
 //
-//		If 'fieldLength' is less than the length of
-//		the numeric value string, it will be
-//		automatically set equal to the length of that
-//		numeric value string.
+//		If this method completes successfully, this
+//		parameter will return an instance of
+//		PureNumberStrComponents. This data structure
+//		contains an analysis and profile information on
+//		the returned instance of NumberStrKernel
+//		'newNumStrKernel'.
 //
-//		To automatically set the value of 'fieldLength'
-//		to the string length of the numeric value, set
-//		this parameter to a value of minus one (-1).
+//		type PureNumberStrComponents struct {
 //
-//		If this parameter is submitted with a value less
-//		than minus one (-1) or greater than 1-million
-//		(1,000,000), an error will be returned.
+//			NumStrStats NumberStrStatsDto
 //
-//		Field Length Examples
+//				This data transfer object will return key
+//				statistics on the numeric value encapsulated
+//				by the current instance of NumberStrKernel.
 //
-//			Example-1
-//	         FieldContents String = "1234.5678"
-//				FieldContents String Length = 9
-//				fieldLength = 15
-//				fieldJustification = TxtJustify.Center()
-//				Text Field String =
-//					"   1234.5678   "
+//				type NumberStrStatsDto struct {
 //
-//			Example-2
-//	         FieldContents = "1234.5678"
-//				FieldContents String Length = 9
-//				fieldLength = 15
-//				fieldJustification = TxtJustify.Right()
-//				Text Field String =
-//					"      1234.5678"
+//				NumOfIntegerDigits					uint64
 //
-//			Example-3
-//	         FieldContents = "1234.5678"
-//				FieldContents String Length = 9
-//				fieldLength = -1
-//				fieldJustification = TxtJustify.Center()
-//					// Justification Ignored. Field Length
-//					// Equals -1
-//				Text Field String =
-//					"1234.5678"
+//					The total number of integer digits to the
+//					left of the radix point or, decimal point, in
+//					the subject numeric value.
 //
-//			Example-4
-//	         FieldContents = "1234.5678"
-//				FieldContents String Length = 9
-//				fieldLength = 2
-//				fieldJustification = TxtJustify.Center()
-//					// Ignored, because FieldLength Less
-//					// Than FieldContents String Length.
-//				Text Field String =
-//					"1234.5678"
+//				NumOfSignificantIntegerDigits		uint64
 //
-//	fieldJustification			TextJustify
+//					The number of nonzero integer digits to the
+//					left of the radix point or, decimal point, in
+//					the subject numeric value.
 //
-//		An enumeration which specifies the justification
-//		of the numeric value within the number field
-//		length specified by input parameter
-//		'fieldLength'.
+//				NumOfFractionalDigits				uint64
 //
-//		Text justification can only be evaluated in the
-//		context of a number string, field length and a
-//		'fieldJustification' object of type TextJustify.
-//		This is because number strings with a field
-//		length equal to or less than the length of the
-//		numeric value string never use text
-//		justification. In these cases, text justification
-//		is completely ignored.
+//					The total number of fractional digits to the
+//					right of the radix point or, decimal point,
+//					in the subject numeric value.
 //
-//		If the field length parameter ('fieldLength')
-//		is greater than the length of the numeric value
-//		string, text justification must be equal to one
-//		of these three valid values:
+//				NumOfSignificantFractionalDigits	uint64
 //
-//			TextJustify(0).Left()
-//			TextJustify(0).Right()
-//			TextJustify(0).Center()
+//					The number of nonzero fractional digits to
+//					the right of the radix point or, decimal
+//					point, in the subject numeric value.
 //
-//		You can also use the abbreviated text justification
-//		enumeration syntax as follows:
+//				NumberValueType 					NumericValueType
 //
-//			TxtJustify.Left()
-//			TxtJustify.Right()
-//			TxtJustify.Center()
+//					This enumeration value specifies whether the
+//					subject numeric value is classified either as
+//					an integer or a floating point number.
 //
-//		Text Justification Examples
+//					Possible enumeration values are listed as
+//					follows:
+//						NumValType.None()
+//						NumValType.FloatingPoint()
+//						NumValType.Integer()
 //
-//			Example-1
-//	         FieldContents String = "1234.5678"
-//				FieldContents String Length = 9
-//				fieldLength = 15
-//				fieldJustification = TxtJustify.Center()
-//				Text Field String =
-//					"   1234.5678   "
+//				NumberSign							NumericSignValueType
 //
-//			Example-2
-//	         FieldContents = "1234.5678"
-//				FieldContents String Length = 9
-//				fieldLength = 15
-//				fieldJustification = TxtJustify.Right()
-//				Text Field String =
-//					"      1234.5678"
+//					An enumeration specifying the number sign
+//					associated with the numeric value. Possible
+//					values are listed as follows:
+//						NumSignVal.None()		= Invalid Value
+//						NumSignVal.Negative()	= -1
+//						NumSignVal.Zero()		=  0
+//						NumSignVal.Positive()	=  1
 //
-//			Example-3
-//	         FieldContents = "1234.5678"
-//				FieldContents String Length = 9
-//				fieldLength = -1
-//				fieldJustification = TxtJustify.Center()
-//					// Justification Ignored. Field Length
-//					// Equals -1
-//				Text Field String =
-//					"1234.5678"
+//				IsZeroValue							bool
 //
-//			Example-4
-//	         FieldContents = "1234.5678"
-//				FieldContents String Length = 9
-//				fieldLength = 2
-//				fieldJustification = TxtJustify.Center()
-//					// Ignored, because FieldLength Less
-//					// Than FieldContents String Length.
-//				Text Field String =
-//					"1234.5678"
+//					If 'true', the subject numeric value is equal
+//					to zero ('0').
 //
-//	errorPrefix					interface{}
+//					If 'false', the subject numeric value is
+//					greater than or less than zero ('0').
+//				}
 //
-//		This object encapsulates error prefix text which
-//		is included in all returned error messages.
-//		Usually, it contains the name of the calling
-//		method or methods listed as a method or function
-//		chain of execution.
 //
-//		If no error prefix information is needed, set this
-//		parameter to 'nil'.
 //
-//		This empty interface must be convertible to one of
-//		the following types:
+//			AbsoluteValueNumStr string
 //
-//		1.	nil
-//				A nil value is valid and generates an
-//				empty collection of error prefix and
-//				error context information.
+//			The number string expressed as an absolute value.
+//			Be advised, this number string may be a floating
+//			point number string containing fractional digits.
 //
-//		2.	string
-//				A string containing error prefix
-//				information.
+//			AbsoluteValAllIntegerDigitsNumStr string
 //
-//		3.	[]string
-//				A one-dimensional slice of strings
-//				containing error prefix information.
+//			Integer and fractional digits are combined
+//			in a single number string without a decimal
+//			point separating integer and fractional digits.
+//			This string DOES NOT contain a leading number
+//			sign (a.k.a. minus sign ('-')
 //
-//		4.	[][2]string
-//				A two-dimensional slice of strings
-//		   		containing error prefix and error
-//		   		context information.
+//			SignedAllIntegerDigitsNumStr string
 //
-//		5.	ErrPrefixDto
-//				An instance of ErrPrefixDto.
-//				Information from this object will
-//				be copied for use in error and
-//				informational messages.
+//			Integer and fractional digits are combined
+//			in a single number string without a decimal
+//			point separating integer and fractional digits.
+//			If the numeric value is negative, a leading
+//			minus sign will be prefixed at the beginning
+//			of the number string.
 //
-//		6.	*ErrPrefixDto
-//				A pointer to an instance of
-//				ErrPrefixDto. Information from
-//				this object will be copied for use
-//				in error and informational messages.
+//			NativeNumberStr string
 //
-//		7.	IBasicErrorPrefix
-//				An interface to a method
-//				generating a two-dimensional slice
-//				of strings containing error prefix
-//				and error context information.
+//			A Native Number String representing the base
+//			numeric value used to generate these profile
+//			number string statistics.
 //
-//		If parameter 'errorPrefix' is NOT convertible
-//		to one of the valid types listed above, it will
-//		be considered invalid and trigger the return of
-//		an error.
+//			A valid Native Number String must conform to the
+//			standardized formatting criteria defined below:
 //
-//		Types ErrPrefixDto and IBasicErrorPrefix are
-//		included in the 'errpref' software package:
-//			"github.com/MikeAustin71/errpref".
+//			 	1. A Native Number String Consists of numeric
+//			 	   character digits zero through nine inclusive
+//			 	   (0-9).
 //
-// ----------------------------------------------------------------
+//			 	2. A Native Number String will include a period
+//			 	   or decimal point ('.') to separate integer and
+//			 	   fractional digits within a number string.
 //
-// # Return Values
+//			 	   Native Number String Floating Point Value:
+//			 	   				123.1234
 //
-//	error
+//			 	3. A Native Number String will always format
+//			 	   negative numeric values with a leading minus sign
+//			 	   ('-').
 //
-//		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
+//			 	   Native Number String Negative Value:
+//			 	   				-123.2
 //
-//		If errors are encountered during processing, the
+//			 	4. A Native Number String WILL NEVER include integer
+//			 	   separators such as commas (',') to separate
+//			 	   integer digits by thousands.
+//
+//			 	   					NOT THIS: 1,000,000
+//			 	   		Native Number String: 1000000
+//
+//			 	5. Native Number Strings will only consist of:
+//
+//			 	   (a)	Numeric digits zero through nine inclusive (0-9).
+//
+//			 	   (b)	A decimal point ('.') for floating point
+//			 	   		numbers.
+//
+//			 	   (c)	A leading minus sign ('-') in the case of
+//			 	   		negative numeric values.
+//
+//		}
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
 //		returned error Type will encapsulate an error
-//		message. This returned error message will
-//		incorporate the method chain and text passed by
-//		input parameter, 'errorPrefix'. The 'errorPrefix'
-//		text will be attached to the beginning of the
-//		error message.
-func (numStrKernel *NumberStrKernel) SetDefaultSignedNumStrFmtSpecBasic(
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) NewParsePureNumberStr(
+	pureNumberStr string,
 	decSeparatorChars string,
-	intSeparatorChars string,
-	intGroupingType IntegerGroupingType,
-	leadingNegativeNumSign string,
-	trailingNegativeNumSign string,
-	fieldLength int,
-	fieldJustification TextJustify,
-	errorPrefix interface{}) error {
+	leadingMinusSign bool,
+	roundingType NumberRoundingType,
+	roundToFractionalDigits int,
+	errorPrefix interface{}) (
+	newNumStrKernel NumberStrKernel,
+	pureNumStrComponents PureNumberStrComponents,
+	err error) {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -8941,209 +8720,98 @@ func (numStrKernel *NumberStrKernel) SetDefaultSignedNumStrFmtSpecBasic(
 
 	var ePrefix *ePref.ErrPrefixDto
 
-	var err error
-
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"SetDefaultSignedNumStrFmtSpecBasic()",
+			"NewParsePureNumberStr()",
 		"")
 
 	if err != nil {
-		return err
+		return newNumStrKernel,
+			pureNumStrComponents,
+			err
 	}
 
-	numStrKernel.numStrFormatSpec.Empty()
-
-	numStrKernel.numStrFormatSpec,
-		err = new(NumStrFormatSpec).NewSignedNumBasic(
-		decSeparatorChars,
-		intSeparatorChars,
-		intGroupingType,
-		leadingNegativeNumSign,
-		trailingNegativeNumSign,
-		fieldLength,
-		fieldJustification,
-		ePrefix.XCpy(
-			"<-numStrKernel.numStrFormatSpec"))
+	pureNumStrComponents,
+		err = new(numberStrKernelMechanics).
+		setNumStrKernelFromRoundedPureNumStr(
+			&newNumStrKernel,
+			pureNumberStr,
+			decSeparatorChars,
+			leadingMinusSign,
+			roundingType,
+			roundToFractionalDigits,
+			ePrefix.XCpy(
+				"newNumStrKernel"))
 
-	return err
+	return newNumStrKernel,
+		pureNumStrComponents,
+		err
 }
 
-// SetDefaultSignedNumStrFmtSpecSimple
-//
-// Sets the default Number String Format Specification
-// for the current instance of to NumberStrKernel.
-//
-// The default Number String Format Specification is
-// used and applied in formatting the number string for
-// screen displays, file output or printing.
-//
-// The internal member variable controlling default
-// number string formatting which will be modified
-// by this method is:
-//
-//	NumberStrKernel.numStrFormatSpec
-//
-// The default Number String Format Specification is
-// used and applied by the following methods:
+// NewParseRadixNumberStr
 //
-//	NumberStrKernel.FmtNumStrDefaultRound()
-//	NumberStrKernel.String()
+//	Receives a binary, octal or hexadecimal number
+//	string and returns the extracted integer value as
+//	a new instance of NumberStrKernel.
 //
-// ----------------------------------------------------------------
+//	The base of the number string may be specified
+//	explicitly or detected from the radix prefix.
 //
-// # BE ADVISED
+//	Leading and trailing white space is ignored. The
+//	number string may begin with a leading plus ('+')
+//	or minus ('-') sign. Underscore characters ('_')
+//	may be used to separate digits. An underscore may
+//	appear between two digits or directly after the
+//	radix prefix.
 //
-//	This method will NOT alter the numeric value
-//	configured for the current instance of
-//	NumberStrKernel. This method will only reconfigure
-//	the internal member variable controlling default
-//	number string formatting:
+//	The parsed value is converted to base 10 and may
+//	be of any size. There is no int64 overflow limit.
 //
-//		NumberStrKernel.numStrFormatSpec
+//	Examples:
+//		"0x1F"			= 31
+//		"-0o17"			= -15
+//		"0b1010_0101"	= 165
 //
 // ----------------------------------------------------------------
 //
 // # Input Parameters
 //
-//	decSeparatorChars			string
-//
-//		This string contains the character or characters
-//		which will be configured as the Decimal Separator
-//		Symbol or Symbols for the returned number string.
-//
-//		The decimal separator is also known as the radix
-//		point and is used to separate integer and
-//		fractional digits within a formatted floating
-//		point number string.
-//
-//		In the US, UK, Australia and most of Canada, the
-//		decimal separator is the period character ('.')
-//		also known as the decimal point.
-//
-//		In France, Germany and many countries in the
-//		European Union, the Decimal Separator is the
-//		comma character (',').
-//
-//	intSeparatorChars			string
-//
-//		One or more characters used to separate groups of
-//		integers. This separator is also known as the
-//		'thousands' separator. It is used to separate
-//		groups of integer digits to the left of the
-//		decimal separator (a.k.a. decimal point). In the
-//		United States, the standard integer digits
-//		separator is the comma (",").
-//
-//			United States Example:  1,000,000,000
-//
-//		In many European countries, a single period ('.')
-//		is used as the integer separator character.
-//
-//			European Example: 1.000.000.000
-//
-//		Other countries and cultures use spaces,
-//		apostrophes or multiple characters to separate
-//		integers.
-//
-//		If this input parameter contains a zero length
-//		string, no error will be returned and integer
-//		separation will be turned off. As a result,
-//		integer digits will be displayed as a single
-//		string of numeric digits:
-//
-//			Integer Separation Turned Off: 1000000000
-//
-//	leadingNumSymbols			bool
-//
-//		In Simple Number Strings, positive numeric values
-//		are NOT configured with leading or trailing plus
-//		signs ('+'). Negative values on the other hand,
-//		are always designated by leading or trailing
-//		minus sign ('-').
-//
-//		This parameter, 'leadingNumSymbols', controls
-//		the positioning of minus signs for negative
-//		numeric values within a	Number String.
-//
-//		When set to 'true', the returned number string
-//		will configure minus signs for negative numbers
-//		at the beginning of, or on the left side of, the
-//		numeric value. In these cases, the minus sign is
-//		said to be configured as a leading minus sign.
-//		This is the positioning format used in the US,
-//		UK, Australia and most of Canada. In addition,
-//		library functions in 'Go' and other programming
-//		languages generally expect leading minus signs
-//		for negative numbers.
-//
-//			Example Leading Minus Sign:
-//				"-123.456"
-//
-//		When parameter 'leadingNumSymbols' is set to
-//		'false', the returned number string will
-//		configure minus signs for negative numbers at the
-//		end of, or on the right side of, the numeric
-//		value. With this positioning format, the minus
-//		sign is said to be configured as a trailing minus
-//		sign. This is the positioning format used in
-//		France, Germany and many countries in the
-//		European Union.
-//
-//			Example Trailing Minus Sign:
-//				"123.456-"
-//
-//	numFieldLength					int
-//
-//		This parameter defines the length of the text
-//		field in which the numeric value will be
-//		displayed within a number string.
-//
-//		If 'numFieldLength' is less than the length of the
-//		numeric value string, it will be automatically set
-//		equal to the length of that numeric value string.
-//
-//		To automatically set the value of fieldLength to
-//		the string length of the numeric value, set this
-//		parameter to a value of minus one (-1).
+//	radixNumStr					string
 //
-//		If this parameter is submitted with a value less
-//		than minus one (-1) or greater than 1-million
-//		(1,000,000), an error will be returned.
+//		A binary, octal or hexadecimal number string.
+//		If any character other than a valid digit, a
+//		sign, a digit separator ('_') or the radix
+//		prefix is encountered, an error will be
+//		returned.
 //
-//	numFieldJustification		TextJustify
+//	radixFmtType				NumStrFormatTypeCode
 //
-//		An enumeration which specifies the justification
-//		of the numeric value within the number field
-//		length specified by input parameter
-//		'numFieldLength'.
+//		Specifies the base of 'radixNumStr'. Valid
+//		values are:
 //
-//		Text justification can only be evaluated in the
-//		context of a number string, field length and a
-//		'textJustification' object of type TextJustify.
-//		This is because number strings with a field length
-//		equal to or less than the length of the numeric
-//		value string never use text justification. In
-//		these cases, text justification is completely
-//		ignored.
+//			NumStrFmtType.Binary()
+//			NumStrFmtType.Octal()
+//			NumStrFmtType.Hexadecimal()
+//			NumStrFmtType.None()
 //
-//		If the field length parameter ('numFieldLength')
-//		is greater than the length of the numeric value
-//		string, text justification must be equal to one
-//		of these three valid values:
+//		If this parameter is set to Binary, Octal or
+//		Hexadecimal, the radix prefix is optional. If
+//		present, the prefix must match the specified
+//		base.
 //
-//			TextJustify(0).Left()
-//			TextJustify(0).Right()
-//			TextJustify(0).Center()
+//		If this parameter is set to NumStrFmtType.None(),
+//		the base will be detected from the radix prefix.
+//		In this case, 'radixNumStr' must begin with one
+//		of the following prefixes or an error will be
+//		returned:
 //
-//		You can also use the abbreviated text justification
-//		enumeration syntax as follows:
+//			Binary      = "0b" or "0B"
+//			Octal       = "0o" or "0O"
+//			Hexadecimal = "0x" or "0X"
 //
-//			TxtJustify.Left()
-//			TxtJustify.Right()
-//			TxtJustify.Center()
+//		Any other value will trigger an error.
 //
 //	errorPrefix					interface{}
 //
@@ -9208,26 +8876,90 @@ func (numStrKernel *NumberStrKernel) SetDefaultSignedNumStrFmtSpecBasic(
 //
 // # Return Values
 //
-//	error
+//	newNumStrKernel				NumberStrKernel
 //
-//		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		containing the base 10 integer value parsed
+//		from 'radixNumStr'.
 //
-//		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error
-//		message. This returned error message will
-//		incorporate the method chain and text passed by
-//		input parameter, 'errorPrefix'. The 'errorPrefix'
-//		text will be attached to the beginning of the
-//		error message.
-func (numStrKernel *NumberStrKernel) SetDefaultSignedNumStrFmtSpecSimple(
-	decSeparatorChars string,
-	intSeparatorChars string,
-	leadingNumSymbols bool,
-	numFieldLength int,
-	numFieldJustification TextJustify,
-	errorPrefix interface{}) error {
-
+//	numStrStatsDto				NumberStrStatsDto
+//
+//		This data transfer object will return key
+//		statistics on the numeric value encapsulated
+//		by the returned instance of NumberStrKernel,
+//		'newNumStrKernel'.
+//
+//		type NumberStrStatsDto struct {
+//
+//			NumOfIntegerDigits					uint64
+//
+//				The total number of integer digits to the
+//				left of the radix point or, decimal point, in
+//				the subject numeric value.
+//
+//			NumOfSignificantIntegerDigits		uint64
+//
+//				The number of nonzero integer digits to the
+//				left of the radix point or, decimal point, in
+//				the subject numeric value.
+//
+//			NumOfFractionalDigits				uint64
+//
+//				The total number of fractional digits to the
+//				right of the radix point or, decimal point,
+//				in the subject numeric value.
+//
+//			NumOfSignificantFractionalDigits	uint64
+//
+//				The number of nonzero fractional digits to
+//				the right of the radix point or, decimal
+//				point, in the subject numeric value.
+//
+//			NumberValueType 					NumericValueType
+//
+//				This enumeration value specifies whether the
+//				subject numeric value is classified either as
+//				an integer or a floating point number.
+//
+//			NumberSign							NumericSignValueType
+//
+//				An enumeration specifying the number sign
+//				associated with the numeric value. Possible
+//				values are listed as follows:
+//					NumSignVal.None()		= Invalid Value
+//					NumSignVal.Negative()	= -1
+//					NumSignVal.Zero()		=  0
+//					NumSignVal.Positive()	=  1
+//
+//			IsZeroValue							bool
+//
+//				If 'true', the subject numeric value is equal
+//				to zero ('0').
+//
+//				If 'false', the subject numeric value is
+//				greater than or less than zero ('0').
+//		}
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) NewParseRadixNumberStr(
+	radixNumStr string,
+	radixFmtType NumStrFormatTypeCode,
+	errorPrefix interface{}) (
+	newNumStrKernel NumberStrKernel,
+	numStrStatsDto NumberStrStatsDto,
+	err error) {
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
 	}
@@ -9238,100 +8970,72 @@ func (numStrKernel *NumberStrKernel) SetDefaultSignedNumStrFmtSpecSimple(
 
 	var ePrefix *ePref.ErrPrefixDto
 
-	var err error
-
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"SetDefaultSignedNumStrFmtSpecSimple()",
+			"NewParseRadixNumberStr()",
 		"")
 
 	if err != nil {
-		return err
+		return newNumStrKernel,
+			numStrStatsDto,
+			err
 	}
 
-	numStrKernel.numStrFormatSpec.Empty()
-
-	numStrKernel.numStrFormatSpec,
-		err = new(NumStrFormatSpec).NewSignedNumSimple(
-		decSeparatorChars,
-		intSeparatorChars,
-		leadingNumSymbols,
-		numFieldLength,
-		numFieldJustification,
-		ePrefix.XCpy(
-			"numStrKernel.numStrFormatSpec<-"))
+	numStrStatsDto,
+		err = new(numberStrKernelMechanics).
+		setNumStrKernelFromRadixNumStr(
+			&newNumStrKernel,
+			radixNumStr,
+			radixFmtType,
+			ePrefix.XCpy(
+				"newNumStrKernel"))
 
-	return err
+	return newNumStrKernel,
+		numStrStatsDto,
+		err
 }
 
-// SetFromBigRat
-//
-// Deletes and resets the internal values for the
-// current instance of NumberStrKernel using a numeric
-// value passed as a pointer to a big.Rat number
-// (*big.Rat).
-//
-// The big.Rat numeric value passed through input
-// paramter 'roundToFractionalDigits' will be used
-// round that numeric value before it is converted
-// and used to configure the current instance of
-// NumberStrKernel.
-//
-// ----------------------------------------------------------------
-//
-// # BE ADVISED
-//
-//	(1)	big.Rat Rounding Algorithm
-//		Before being converted to an instance of
-//		NumberStrKernel, the big.Rat numeric value will
-//		be rounded by the Golang 'big' package function:
-//
-//			(*Rat) FloatString
-//
-//		The rounding algorithm used is described in the
-//		big.Rat documentation as:
-//
-//			The last digit is rounded to nearest, with
-//			halves rounded away from zero.
-//				https://pkg.go.dev/math/big#Rat
-//
-//	(2)	Number of fractional digits
-//		During the rounding operation, the number of
-//		fractional digits to the right of the radix point
-//		(decimal point) will be controlled by input
-//		paramter 'roundToFractionalDigits'.
+// NewParseRomanNumeral
 //
-// ----------------------------------------------------------------
-//
-// # IMPORTANT
+//	Receives a string of Roman numerals and returns the
+//	extracted integer value as a new instance of
+//	NumberStrKernel.
 //
-//	This method will delete and reconfigure all
-//	pre-existing data values contained in the current
-//	instance of NumberStrKernel.
+//	Parsing is strict. Only canonical Roman numerals
+//	are accepted. Non-canonical forms such as "IIII",
+//	"VX", "IC" or "MMMM" will generate an error.
+//
+//	The Roman numerals must consist entirely of upper
+//	case or entirely of lower case characters. Mixed
+//	case strings such as "Xiv" will generate an error.
+//	Leading and trailing white space is NOT permitted.
+//
+//	Numerals in vinculum, or overline, form (a numeral
+//	followed by a combining overline U+0305) are
+//	accepted for values from 4,000 through 3,999,999.
+//	This is the form generated by NumberStrKernel
+//	.FmtNumStr() when the Roman Numeral Format
+//	Specification enables the vinculum option.
+//
+//	Examples:
+//		"MCMXCIV"	= 1994
+//		"mcmxciv"	= 1994
+//		"I̅V̅I"		= 4001
+//		"IIII"		= error
+//		"VX"		= error
 //
 // ----------------------------------------------------------------
 //
 // # Input Parameters
 //
-//	bigRatNum					*big.Rat
-//		A pointer to an instance of a big.Rat numeric
-//		value. This numeric value will be used to
-//		reconfigure the current instance of
-//		NumberStrKernel.
-//
-//	roundToFractionalDigits		int
-//
-//		When set to a positive integer value, this
-//		parameter controls the number of digits to the
-//		right of the radix point or decimal separator
-//		(a.k.a. decimal point). Effectively this defines
-//		the number of fractional digits remaining after
-//		completion of the number rounding operation
-//		performed by the Golang package function:
+//	romanNumeralStr				string
 //
-//			func (*Rat) FloatString
+//		A string of Roman numerals. If this string is
+//		empty, contains characters other than Roman
+//		numerals or is not in canonical form, an error
+//		will be returned.
 //
 //	errorPrefix					interface{}
 //
@@ -9396,21 +9100,37 @@ func (numStrKernel *NumberStrKernel) SetDefaultSignedNumStrFmtSpecSimple(
 //
 // # Return Values
 //
-//	error
+//	newNumStrKernel				NumberStrKernel
 //
-//		If this method completes successfully, the returned
-//		error Type is set equal to 'nil'.
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		containing the integer value parsed from
+//		'romanNumeralStr'.
 //
-//		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error message.
-//	 	This returned error message will incorporate the method
-//	 	chain and text passed by input parameter, 'errorPrefix'.
-//	 	The 'errorPrefix' text will be attached to the beginning
-//	 	of the error message.
-func (numStrKernel *NumberStrKernel) SetFromBigRat(
-	bigRatNum *big.Rat,
-	roundToFractionalDigits int,
-	errorPrefix interface{}) error {
+//	numStrStatsDto				NumberStrStatsDto
+//
+//		This data transfer object will return key
+//		statistics on the numeric value encapsulated
+//		by 'newNumStrKernel'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) NewParseRomanNumeral(
+	romanNumeralStr string,
+	errorPrefix interface{}) (
+	newNumStrKernel NumberStrKernel,
+	numStrStatsDto NumberStrStatsDto,
+	err error) {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -9421,465 +9141,316 @@ func (numStrKernel *NumberStrKernel) SetFromBigRat(
 	defer numStrKernel.lock.Unlock()
 
 	var ePrefix *ePref.ErrPrefixDto
-	var err error
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"SetFromBigRat()",
+			"NewParseRomanNumeral()",
 		"")
 
 	if err != nil {
-		return err
-	}
-
-	var nativeNumStr string
-
-	nativeNumStr,
-		err = new(MathBigRatHelper).
-		BigRatToNativeNumStr(
-			bigRatNum,
-			roundToFractionalDigits,
-			ePrefix)
-
-	if err != nil {
-
-		return err
+		return newNumStrKernel,
+			numStrStatsDto,
+			err
 	}
 
-	err = new(numberStrKernelQuark).
-		setNumStrKernelFromNativeNumStr(
-			numStrKernel,
-			nativeNumStr,
+	numStrStatsDto,
+		err = new(numberStrKernelMechanics).
+		setNumStrKernelFromRomanNumeral(
+			&newNumStrKernel,
+			romanNumeralStr,
 			ePrefix.XCpy(
-				"numStrKernel"))
+				"newNumStrKernel"))
 
-	return err
+	return newNumStrKernel,
+		numStrStatsDto,
+		err
 }
 
-// SetFromDirtyNumberStr
+// NewParseSIPrefixNumberStr
 //
-// Deletes and resets the internal values for the
-// current instance of NumberStrKernel using a numeric
-// value extracted and calculated from a Dirty Number
-// String passed as input parameter 'dirtyNumberStr'.
+// Parses a number string containing a numeric value
+// followed by an optional SI (International System of
+// Units) prefix symbol or name and returns the numeric
+// value as a new instance of NumberStrKernel.
 //
-// A "Dirty Number String" is a malformed number string
-// containing numeric digits which will be converted to a
-// properly formatted Native Number String and used to
-// generate a numeric value used in reconfiguring the
-// current instance of NumberStrKernel.
+// This method is the counterpart to the SI Prefix
+// formats generated by EngNotationKernel.FmtEngNotation().
 //
-//	Examples Of Dirty Number Strings
+//	Examples:
+//		"3.3µ"        =>  0.0000033
+//		"2.2 mega"    =>  2200000
+//		"-4.7k"       => -4700
+//		"4.7 kΩ"      =>  4700  (unitSymbol = "Ω")
+//		"15"          =>  15
 //
-//		$1,254.65
-//		1 000 000,00 €
-//		1.000.000,00 €
-//		6,78,90,00,00,00,00,000
-//		6,7890,0000,0000,0000
+// The numeric value must be formatted as a native
+// number string: an optional leading plus ('+') or
+// minus ('-') sign, followed by numeric digits and an
+// optional period ('.') as the decimal separator.
 //
-// All the examples shown above are valid number string
-// formats used by different countries and cultures.
-// There is nothing wrong with these formats. The term
-// "Dirty" simply distinguishes these formats from the
-// Native Number String format required by many
-// functions and packages in the Go Programming
-// Language. The Native Number String format is one
-// which is used as a standard format for numeric
-// conversions performed in the Go Programming Language
-// as well as many other programming languages.
+// Spaces between the numeric value and the SI prefix
+// are optional. SI prefix symbols are case-sensitive
+// ('m' = milli, 'M' = mega) while SI prefix names are
+// NOT case-sensitive. For the 'micro' prefix, the Greek
+// small letter mu ('μ' U+03BC), the micro sign
+// ('µ' U+00B5) and the ASCII substitute 'u' are all
+// accepted.
 //
-// The Dirty Number String passed as input parameter
-// 'dirtyNumberStr' is expected to comply with the
-// following requirements:
+// ----------------------------------------------------------------
 //
-//  1. The dirty number string must contain numeric
-//     digit characters zero to nine inclusive (0-9).
+// # Input Parameters
 //
-//  2. The dirty number string must contain a radix
-//     point or decimal separator to separate
-//     integer and fractional digits in a floating
-//     point numeric value. This decimal separator
-//     is specified by input parameter,
-//     'decimalSeparator'.
+//	siPrefixNumStr				string
 //
-//     If no decimal separator is identified in the
-//     dirty number string, the numeric value is
-//     assumed to be an integer value.
+//		The number string to be parsed. If this string
+//		does not begin with a valid numeric value, or if
+//		the trailing SI prefix is invalid, an error will
+//		be returned.
 //
-//  3. The dirty number string must designate
-//     negative numeric values using one of the
-//     following three negative number symbols:
+//	unitSymbol					string
 //
-//     (a)	A Leading Minus Sign ('-').
-//     Example: -123.45
+//		An optional unit of measure symbol such as "Ω".
+//		If 'siPrefixNumStr' ends with this symbol, it
+//		will be removed before the SI prefix is parsed.
+//		If this string is empty, it is ignored.
 //
-//     (b)	A Trailing Minus Sign ('-').
-//     Example: 123.45-
+//	errorPrefix					interface{}
 //
-//     (c) A combination of leading and trailing
-//     Parentheses ('()').
-//     Example: (123.45)
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-// ----------------------------------------------------------------
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-// # IMPORTANT
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//	This method will delete and overwrite all pre-existing
-//	data values in the current instance of NumberStrKernel.
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-// ----------------------------------------------------------------
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-// # Input Parameters
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//	dirtyNumberStr				string
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//		This number string contains the numeric digits
-//		which will be extracted, converted to a valid
-//		numeric value and used to configure the
-//		returned instance of NumberStrKernel.
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//		The 'dirtyNumberStr' is expected to comply with
-//		the following requirements:
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//		1.	The dirty number string must contain numeric
-//			digit characters zero to nine inclusive (0-9).
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//		2.	The dirty number string must contain a radix
-//			point or decimal separator to separate
-//			integer and fractional digits in a floating
-//			point numeric value. This decimal separator
-//			is specified by input parameter,
-//			'decimalSeparator'.
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
 //
-//			If no decimal separator is identified in the
-//			dirty number string, the numeric value is
-//			assumed to be an integer value.
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
-//		3.	The dirty number string must designate
-//			negative numeric values using one of the
-//			following three negative number symbols:
+// ----------------------------------------------------------------
 //
-//			(a)	A Leading Minus Sign ('-').
-//				Example: -123.45
+// # Return Values
 //
-//			(b)	A Trailing Minus Sign ('-').
-//				Example: 123.45-
+//	newNumStrKernel				NumberStrKernel
 //
-//			(c) A combination of leading and trailing
-//				Parentheses ('()').
-//				Example: (123.45)
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		containing the numeric value parsed from
+//		'siPrefixNumStr'.
 //
-//		If 'dirtyNumberStr' does not contain any numeric
-//		digits, an error will be returned.
+//	numStrStatsDto				NumberStrStatsDto
 //
-//	decimalSeparator			string
+//		This data transfer object will return key
+//		statistics on the numeric value encapsulated
+//		by 'newNumStrKernel'.
 //
-//		Type DecimalSeparatorSpec is used to specify the
-//		radix point or decimal separator which will
-//		separate integer and fractional digits in the
-//		dirty number string passed as input parameter
-//		'dirtyNumberStr'.
+//	err							error
 //
-//		The decimal separator will typically consist of
-//		one or more non-numeric characters.
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
 //
-//		If 'decimalSeparator' consists of an empty
-//		or zero length sting, it is assumed that the
-//		numeric value contained in input parameter
-//		'dirtyNumberStr' is an integer value.
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) NewParseSIPrefixNumberStr(
+	siPrefixNumStr string,
+	unitSymbol string,
+	errorPrefix interface{}) (
+	newNumStrKernel NumberStrKernel,
+	numStrStatsDto NumberStrStatsDto,
+	err error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"NewParseSIPrefixNumberStr()",
+		"")
+
+	if err != nil {
+		return newNumStrKernel,
+			numStrStatsDto,
+			err
+	}
+
+	newNumStrKernel,
+		err = new(engNotationKernelNanobot).parseSIPrefixNumStr(
+		siPrefixNumStr,
+		unitSymbol,
+		ePrefix.XCpy(
+			"siPrefixNumStr"))
+
+	if err != nil {
+		return newNumStrKernel,
+			numStrStatsDto,
+			err
+	}
+
+	numStrStatsDto,
+		err = new(numberStrKernelAtom).calcNumStrKernelStats(
+		&newNumStrKernel,
+		ePrefix.XCpy(
+			"newNumStrKernel"))
+
+	return newNumStrKernel,
+		numStrStatsDto,
+		err
+}
+
+// NewParseSciNotationStr
 //
-//		In the US, Australia, UK, most of Canada and many
-//		other countries the period ('.'), or decimal
-//		point, separates integer and fractional digits
-//		within a floating point numeric value.
+// Parses a number string formatted in Scientific
+// Notation or E-Notation and returns the numeric value
+// as a new instance of NumberStrKernel.
 //
-//		Other countries, including many in the European
-//		Union, use the comma (',') to separate integer
-//		and fractional digits within a number string.
+// The exponent is expanded exactly into the integer and
+// fractional digit arrays of the returned
+// NumberStrKernel. No rounding is applied.
 //
-//		If 'decimalSeparator' contains any one of the
-//		following invalid characters, an error will be
-//		returned.
+//	Examples:
+//		"1.602E-19"  => 0.0000000000000000001602
+//		"6.022e+23"  => 602200000000000000000000
+//		"-3.1×10^8"  => -310000000
+//		"2,5E-3"     => 0.0025  (German decimal separator)
 //
-//			Invalid Decimal Separator Characters
-//							'-'
-//							'('
-//							')'
+// To generate Scientific Notation number strings, see
+// methods NumberStrKernel.GetScientificNotation() and
+// NumStrFormatSpec.NewSciNotationNumFormat().
 //
-//	roundingType				NumberRoundingType
+// ----------------------------------------------------------------
 //
-//		This enumeration parameter is used to specify the
-//		type of rounding algorithm that will be applied for
-//		the	rounding of fractional digits contained in the
-//		reconfigured current instance of NumberStrKernel.
+// # Input Parameters
 //
-//		If in doubt as to a suitable rounding method,
-//		'HalfAwayFromZero' is recommended.
+//	sciNotStr					string
 //
-//		Possible values are listed as follows:
-//			NumRoundType.None()	- Invalid Value
-//			NumRoundType.NoRounding()
-//			NumRoundType.HalfUpWithNegNums()
-//			NumRoundType.HalfDownWithNegNums()
-//			NumRoundType.HalfAwayFromZero()
-//			NumRoundType.HalfTowardsZero()
-//			NumRoundType.HalfToEven()
-//			NumRoundType.HalfToOdd()
-//			NumRoundType.Randomly()
-//			NumRoundType.Floor()
-//			NumRoundType.Ceiling()
-//			NumRoundType.Truncate()
+//		The Scientific Notation number string to be
+//		parsed. Leading and trailing white space is
+//		ignored.
 //
-//		Definitions:
+//		The significand consists of an optional leading
+//		plus ('+') or minus ('-') sign followed by
+//		numeric digits and an optional decimal separator
+//		as specified by input parameter 'decSeparator'.
 //
-//			NoRounding
+//		The significand must be followed by one of these
+//		exponent formats:
 //
-//				Signals that no rounding operation will be
-//				performed on fractional digits. The
-//				fractional digits will therefore remain
-//				unchanged.
+//		E-Notation:
+//			"6.022E23"  "6.022E+23"  "1.602e-19"
 //
-//			HalfUpWithNegNums
+//		Exponential:
+//			"-3.1×10^8"  "2.652 x 10^8"  "2.652*10^-8"
 //
-//				Half Round Up Including Negative Numbers.
-//				This method is intuitive but may produce
-//				unexpected results when applied to negative
-//				numbers.
+//		Exponential with superscript exponent:
+//			"5.21×10⁻⁵"  "1.23 x 10⁵"
 //
-//				'HalfUpWithNegNums' rounds .5 up.
+//		The exponent sign may be specified with a plus
+//		sign ('+'), a minus sign ('-') or the Unicode
+//		minus sign ('−' U+2212).
 //
-//					Examples of 'HalfUpWithNegNums'
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8
-//					7.4 rounds down to 7
-//					-7.4 rounds up to -7
-//					-7.5 rounds up to -7
-//					-7.6 rounds down to -8
+//		If 'sciNotStr' is empty, or if the significand
+//		or exponent is malformed, an error will be
+//		returned. Error messages identify the zero based
+//		character index at which the error was detected.
 //
-//			HalfDownWithNegNums
+//	decSeparator				DecimalSeparatorSpec
 //
-//			Half Round Down Including Negative Numbers. This
-//			method is also considered intuitive but may
-//			produce unexpected results when applied to
-//			negative numbers.
+//		This structure contains the radix point or
+//		decimal separator character(s) used to separate
+//		integer and fractional digits in the significand
+//		of 'sciNotStr'.
 //
-//			'HalfDownWithNegNums' rounds .5 down.
+//		In the US, the decimal separator is the period
+//		('.') or decimal point. In Germany and France,
+//		the decimal separator is the comma (',').
 //
-//				Examples of HalfDownWithNegNums
+//		If 'decSeparator' is empty or invalid, an error
+//		will be returned.
 //
-//				7.6 rounds up to 8
-//				7.5 rounds down to 7
-//				7.4 rounds down to 7
-//				-7.4 rounds up to -7
-//				-7.5 rounds down to -8
-//				-7.6 rounds down to -8
+//	errorPrefix					interface{}
 //
-//			HalfAwayFromZero
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//				The 'HalfAwayFromZero' method rounds .5 further
-//				away from zero.	It provides clear and consistent
-//				behavior when dealing with negative numbers.
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//					Examples of HalfAwayFromZero
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//					7.6 rounds away to 8
-//					7.5 rounds away to 8
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds away to -8
-//					-7.6 rounds away to -8
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//			HalfTowardsZero
-//
-//				Round Half Towards Zero. 'HalfTowardsZero' rounds
-//				0.5	closer to zero. It provides clear and
-//				consistent behavior	when dealing with negative
-//				numbers.
-//
-//					Examples of HalfTowardsZero
-//
-//					7.6 rounds away to 8
-//					7.5 rounds to 7
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds to -7
-//					-7.6 rounds away to -8
-//
-//			HalfToEven
-//
-//				Round Half To Even Numbers. 'HalfToEven' is
-//				also called	Banker's Rounding. This method
-//				rounds 0.5 to the nearest even digit.
-//
-//					Examples of HalfToEven
-//
-//					7.5 rounds up to 8 (because 8 is an even
-//					number)	but 6.5 rounds down to 6 (because
-//					6 is an even number)
-//
-//					HalfToEven only applies to 0.5. Other
-//					numbers (not ending	in 0.5) round to
-//					nearest as usual, so:
-//
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8 (because 8 is an even number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds down to 6 (because 6 is an even number)
-//					6.4 rounds down to 6
-//
-//			HalfToOdd
-//
-//				Round Half to Odd Numbers. Similar to 'HalfToEven',
-//				but in this case 'HalfToOdd' rounds 0.5 towards odd
-//				numbers.
-//
-//					Examples of HalfToOdd
-//
-//					HalfToOdd only applies to 0.5. Other numbers
-//					(not ending	in 0.5) round to nearest as usual.
-//
-//					7.5 rounds down to 7 (because 7 is an odd number)
-//
-//					6.5 rounds up to 7 (because 7 is an odd number)
-//
-//					7.6 rounds up to 8
-//					7.5 rounds down to 7 (because 7 is an odd number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds up to 7 (because 7 is an odd number)
-//					6.4 rounds down to 6
-//
-//			Randomly
-//
-//				Round Half Randomly. Uses a Random Number Generator
-//				to choose between rounding 0.5 up or down.
-//
-//				All numbers other than 0.5 round to the nearest as
-//				usual.
-//
-//			Floor
-//
-//				Yields the nearest integer down. Floor does not apply
-//				any	special treatment to 0.5.
-//
-//				Floor Function: The greatest integer that is less than
-//				or equal to x
-//
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
-//
-//				In mathematics and computer science, the floor function
-//				is the function that takes as input a real number x,
-//				and gives as output the greatest integer less than or
-//				equal to x,	denoted floor(x) or ⌊x⌋.
-//
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
-//
-//				Examples of Floor
-//
-//					Number     Floor
-//					 2           2
-//					 2.4         2
-//					 2.9         2
-//					-2.5        -3
-//					-2.7        -3
-//					-2          -2
-//
-//			Ceiling
-//
-//				Yields the nearest integer up. Ceiling does not
-//				apply any special treatment to 0.5.
-//
-//				Ceiling Function: The least integer that is
-//				greater than or	equal to x.
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
-//
-//				The ceiling function maps x to the least integer
-//				greater than or equal to x, denoted ceil(x) or
-//				⌈x⌉.[1]
-//
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
-//
-//					Examples of Ceiling
-//
-//						Number    Ceiling
-//						 2           2
-//						 2.4         3
-//						 2.9         3
-//						-2.5        -2
-//						-2.7        -2
-//						-2          -2
-//
-//			Truncate
-//
-//				Apply NO Rounding whatsoever. The Round From Digit
-//				is dropped or deleted. The Round To Digit is NEVER
-//				changed.
-//
-//				Examples of Truncate
-//
-//					Example-1
-//					Number: 23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:   23.14 - The Round From Digit
-//					is dropped.
-//
-//					Example-2
-//					Number: -23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:  -23.14 - The Round From Digit
-//					is dropped.
-//
-//	roundToFractionalDigits		int
-//
-//		When set to a positive integer value, this
-//		parameter controls the number of digits to the
-//		right of the radix point or decimal separator
-//		(a.k.a. decimal point). This controls the number
-//		of fractional digits remaining after completion
-//		of the number rounding operation.
-//
-//		If input parameter 'roundingType' is set to
-//		NumRoundType.NoRounding(),
-//		'roundToFractionalDigits' is ignored and no
-//		rounding operation is performed.
-//
-//	errorPrefix					interface{}
-//
-//		This object encapsulates error prefix text which
-//		is included in all returned error messages.
-//		Usually, it contains the name of the calling
-//		method or methods listed as a method or function
-//		chain of execution.
-//
-//		If no error prefix information is needed, set this
-//		parameter to 'nil'.
-//
-//		This empty interface must be convertible to one of
-//		the following types:
-//
-//		1.	nil
-//				A nil value is valid and generates an
-//				empty collection of error prefix and
-//				error context information.
-//
-//		2.	string
-//				A string containing error prefix
-//				information.
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
 //		3.	[]string
 //				A one-dimensional slice of strings
@@ -9921,70 +9492,18 @@ func (numStrKernel *NumberStrKernel) SetFromBigRat(
 //
 // # Return Values
 //
-//	numStrStatsDto				NumberStrStatsDto
-//
-//		If this method completes successfully, an
-//		instance of NumberStrStatsDto will be returned
-//		containing a profile and key statistics on the
-//		reconfigured numeric value encapsulated in the
-//		current instance of NumberStrKernel.
-//
-//		type NumberStrStatsDto struct {
-//
-//		NumOfIntegerDigits					uint64
-//
-//			The total number of integer digits to the
-//			left of the radix point or, decimal point, in
-//			the subject numeric value.
-//
-//		NumOfSignificantIntegerDigits		uint64
-//
-//			The number of nonzero integer digits to the
-//			left of the radix point or, decimal point, in
-//			the subject numeric value.
-//
-//		NumOfFractionalDigits				uint64
-//
-//			The total number of fractional digits to the
-//			right of the radix point or, decimal point,
-//			in the subject numeric value.
-//
-//		NumOfSignificantFractionalDigits	uint64
-//
-//			The number of nonzero fractional digits to
-//			the right of the radix point or, decimal
-//			point, in the subject numeric value.
-//
-//		NumberValueType 					NumericValueType
-//
-//			This enumeration value specifies whether the
-//			subject numeric value is classified either as
-//			an integer or a floating point number.
-//
-//			Possible enumeration values are listed as
-//			follows:
-//				NumValType.None()
-//				NumValType.FloatingPoint()
-//				NumValType.Integer()
-//
-//		NumberSign							NumericSignValueType
-//
-//			An enumeration specifying the number sign
-//			associated with the numeric value. Possible
-//			values are listed as follows:
-//				NumSignVal.None()		= Invalid Value
-//				NumSignVal.Negative()	= -1
-//				NumSignVal.Zero()		=  0
-//				NumSignVal.Positive()	=  1
+//	newNumStrKernel				NumberStrKernel
 //
-//		IsZeroValue							bool
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		containing the numeric value parsed from
+//		'sciNotStr'.
 //
-//			If 'true', the subject numeric value is equal
-//			to zero ('0').
+//	numStrStatsDto				NumberStrStatsDto
 //
-//			If 'false', the subject numeric value is
-//			greater than or less than zero ('0').
-//		}
+//		This data transfer object will return key
+//		statistics on the numeric value encapsulated
+//		by 'newNumStrKernel'.
 //
 //	err							error
 //
@@ -9995,15 +9514,14 @@ func (numStrKernel *NumberStrKernel) SetFromBigRat(
 //		message.
 //
 //		If an error message is returned, the text value
-//		for input parameter 'errPrefDto' (error prefix)
+//		for input parameter 'errorPrefix' (error prefix)
 //		will be prefixed or attached at the beginning of
 //		the error message.
-func (numStrKernel *NumberStrKernel) SetFromDirtyNumberStr(
-	dirtyNumberStr string,
-	decimalSeparator string,
-	roundingType NumberRoundingType,
-	roundToFractionalDigits int,
+func (numStrKernel *NumberStrKernel) NewParseSciNotationStr(
+	sciNotStr string,
+	decSeparator DecimalSeparatorSpec,
 	errorPrefix interface{}) (
+	newNumStrKernel NumberStrKernel,
 	numStrStatsDto NumberStrStatsDto,
 	err error) {
 
@@ -10021,182 +9539,384 @@ func (numStrKernel *NumberStrKernel) SetFromDirtyNumberStr(
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"SetFromDirtyNumberStr()",
+			"NewParseSciNotationStr()",
 		"")
 
 	if err != nil {
-		return numStrStatsDto, err
+		return newNumStrKernel,
+			numStrStatsDto,
+			err
 	}
 
 	numStrStatsDto,
 		err = new(numberStrKernelMechanics).
-		setNumStrKernelFromRoundedDirtyNumStr(
-			numStrKernel,
-			dirtyNumberStr,
-			decimalSeparator,
-			roundingType,
-			roundToFractionalDigits,
+		setNumStrKernelFromSciNotationStr(
+			&newNumStrKernel,
+			sciNotStr,
+			decSeparator,
 			ePrefix.XCpy(
-				"numStrKernel"))
+				"newNumStrKernel"))
 
-	return numStrStatsDto,
+	return newNumStrKernel,
+		numStrStatsDto,
 		err
 }
 
-// SetFromNativeNumberStr
+//	NewParseUSNumberStr
 //
-// Deletes and resets the internal values for the
-// current instance of NumberStrKernel using a numeric
-// value extracted and calculated from a Native Number
-// String passed as input parameter 'nativeNumStr'.
+//	This method parses an incoming number string
+//	using standard decimal separator and negative
+//	number sign parameters commonly applied in the
+//	US (United States).
 //
-// The term 'Native Number String' means that the number
-// string format is designed to interoperate with the
-// Golang programming language library functions and
-// packages. Types like 'strconv', 'strings', 'math'
-// and 'big' (big.Int, big.Float, big.Rat) routinely
-// parse and convert this type of number string to
-// numeric values. In addition, Native Number Strings are
-// frequently consumed by external library functions such
-// as this one (String Mechanics 'strmech') to convert
-// strings to numeric values and numeric values to
-// strings.
+//	The radix point or decimal separator used to
+//	separate integer and fractional digits in a
+//	floating point numeric value is therefore
+//	assumed to be the period ('.') or decimal
+//	point.
 //
-// While this format is inconsistent with many national
-// and cultural formatting conventions, number strings
-// which fail to implement this standardized formatting
-// protocol will generate errors in some Golang library
-// functions.
+//	The negative number signs used to designate
+//	numbers with a negative value are assumed to
+//	be either a leading minus sign ('-') or
+//	parentheses ('()').
 //
-//	Examples Of Native Number Strings
-//		1000000
-//		12.5483
-//		-1000000
-//		-12.5483
+// ----------------------------------------------------------------
 //
-// A valid Native Number String must conform to the
-// standardized formatting criteria defined below:
+// # Input Parameters
 //
-//  1. A Native Number String Consists of numeric
-//     character digits zero through nine inclusive
-//     (0-9).
+//	rawNumStr					string
 //
-//  2. A Native Number String will include a period
-//     or decimal point ('.') to separate integer and
-//     fractional digits within a number string.
+//		This parameter is the raw number string
+//		containing numeric digit characters which will
+//		be extracted and processed to identify the
+//		numeric value. This numeric value will in
+//		turn form the basis of the new NumberStrKernel
+//		instance returned to the calling function.
 //
-//     Native Number String Floating Point Value:
-//     123.1234
+//	startSearchIndex			int
 //
-//  3. A Native Number String will always format
-//     negative numeric values with a leading minus sign
-//     ('-').
+//		The character index in the Raw Number String
+//		('rawNumStr') at which the search for numeric
+//		digit characters will begin.
 //
-//     Native Number String Negative Value:
-//     -123.2
+//		Set this value to zero (0) in order	begin the
+//		search for numeric characters at the first
+//		character in the Raw Number String ('rawNumStr').
 //
-//  4. A Native Number String WILL NEVER include integer
-//     separators such as commas (',') to separate
-//     integer digits by thousands.
+//	breakOnCharSearchLength		int
 //
-//     NOT THIS: 1,000,000
-//     Native Number String: 1000000
+//		The actual number of characters within the Raw
+//		Number String ('rawNumStr') that are included in
+//		the search for numeric character digits.
 //
-//  5. Native Number Strings will only consist of:
+//		If this parameter is set to a value greater than
+//		zero ('0'), the Number String Parsing algorithm
+//		will search the specified number of text
+//		characters and then automatically terminate the
+//		search for numeric digits.
 //
-//     (a)	Numeric digits zero through nine inclusive (0-9).
+//		If this value is set to value less than one (+1),
+//		the search length will be configured to include
+//		the last character in 'rawNumStr'. In other words
+//		the search for numeric characters will proceed to
+//		the end of the string, 'rawNumStr'.
 //
-//     (b)	A decimal point ('.') for floating point
-//     numbers.
+//	breakOnCharDelimiters		[]string
 //
-//     (c)	A leading minus sign ('-') in the case of
-//     negative numeric values.
+//		An array of strings. If any one of these strings
+//		is encountered while searching the Raw Number
+//		String ('rawNumStr'), the search for numeric
+//		digits will be automatically terminated.
 //
-//  6. A Native Number String will NEVER include
-//     currency symbols.
+//		If this string array is set to nil, this
+//		parameter will be ignored by the Number String
+//		parsing algorithm.
 //
-// ----------------------------------------------------------------
+//	requestRemainderString		bool
 //
-// # IMPORTANT
+//		If this parameter is set to 'true', the Number
+//		String Search Results ('numberStrSearchResults')
+//		returned by this method	will include the
+//		remaining string characters	which were NOT
+//		included in the search operation.
 //
-//	This method will delete and overwrite all pre-existing
-//	data values in the current instance of NumberStrKernel.
+//		This feature may be useful when either
+//		'characterSearchLength' or 'numParsingTerminators'
+//		parameters are used to arbitrarily terminate the
+//		search operation.
 //
-// ----------------------------------------------------------------
+//	 errorPrefix                interface{}
 //
-// # Input Parameters
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it	contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//	nativeNumStr				string
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//		A Native Number String containing the numeric
-//		character digits which will be converted to, and
-//		stored in, the NumberStrKernel object passed as
-//		input parameter 'numStrKernel'.
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//		The term 'Native Number String' means that the
-//		number string format is designed to interoperate
-//		with the Golang programming language library
-//		functions and packages. Types like 'strconv',
-//		'strings', 'math' and 'big' (big.Int, big.Float,
-//		big.Rat) routinely parse and convert this type of
-//		number string to generate numeric values. In
-//		addition, Native Number Strings are frequently
-//		consumed by external library functions such	as
-//		this one (String Mechanics 'strmech') to convert
-//		strings to numeric values and numeric values to
-//		strings.
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//		If 'nativeNumStr' fails to meet the formatting
-//		criteria for a Native Number String defined
-//		below, an error will be returned.
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//		A valid Native Number String must conform to the
-//		standardized formatting criteria defined below:
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//	 	1.	A Native Number String Consists of numeric
-//	 	  	character digits zero through nine inclusive
-//	 	  	(0-9).
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//	 	2.	A Native Number String will include a period
-//	 	  	or decimal point ('.') to separate integer and
-//	 	  	fractional digits within a number string.
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//	 	  	Native Number String Floating Point Value:
-//	 	   				123.1234
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//	 	3.	A Native Number String will always format
-//	 	  	negative numeric values with a leading minus sign
-//	 	  	('-').
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//	 	  	Native Number String Negative Value:
-//	 	  					-123.2
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
 //
-//	 	4.	A Native Number String WILL NEVER include integer
-//			separators such as commas (',') to separate
-//			integer digits by thousands.
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
-//	 	   					NOT THIS: 1,000,000
-//	 	   		Native Number String: 1000000
+// ----------------------------------------------------------------
 //
-//	 	5.	Native Number Strings will only consist of:
+// # Return Values
 //
-//			(a)	Numeric digits zero through nine inclusive (0-9).
+//	numberStrSearchResults		CharSearchNumStrParseResultsDto
 //
-//			(b)	A decimal point ('.') for floating point
-//				numbers.
+//		This structure contains public member variables
+//		containing operational data describing the
+//		success or failure of the number string parsing
+//		operation and the search for numeric digits in
+//		the Raw Number String, 'rawNumStr'.
 //
-//			(c)	A leading minus sign ('-') in the case of
-//				negative numeric values.
+//		Users are advised to check the following member
+//		variable values after a number string parsing
+//		operation:
 //
-//		6.	A Native Number String will NEVER include
-//			currency symbols.
+//			FoundNumericDigits		bool
 //
-//	roundingType				NumberRoundingType
+//				Signals a successful Number String Parsing
+//				operation. When set to 'true', this means
+//				one or more numeric	digit characters ('0'
+//				through '9' inclusive) were	located in the
+//				Raw Number String, 'rawNumStr'.
+//
+//			FoundNonZeroValue		bool
+//
+//				When set to 'true' this signals that the
+//				search operation has detected one or more
+//				nonzero numeric digits.
+//
+//	nStrKernel					NumberStrKernel
+//
+//		If this method completes successfully, a new instance
+//		of NumberStrKernel will be returned configured with
+//		the numeric value parsed from the Raw Number String,
+//		'rawNumStr'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (numStrKernel *NumberStrKernel) NewParseUSNumberStr(
+	rawNumStr string,
+	startSearchIndex int,
+	breakOnCharSearchLength int,
+	breakOnCharDelimiters []string,
+	requestRemainderString bool,
+	errorPrefix interface{}) (
+	numberStrSearchResults CharSearchNumStrParseResultsDto,
+	nStrKernel NumberStrKernel,
+	err error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"NewParseUSNumberStr()",
+		"")
+
+	if err != nil {
+		return numberStrSearchResults, nStrKernel, err
+	}
+
+	numParsingTerminatorsCol := RuneArrayCollection{}
+
+	lenStrArray := len(breakOnCharDelimiters)
+
+	for i := 0; i < lenStrArray; i++ {
+
+		err = numParsingTerminatorsCol.AddRuneArrayString(
+			breakOnCharDelimiters[i],
+			CharSearchType.LinearTargetStartingIndex(),
+			ePrefix.XCpy(
+				fmt.Sprintf("breakOnCharDelimiters[%v]",
+					i)))
+
+		if err != nil {
+			return numberStrSearchResults, nStrKernel, err
+		}
+	}
+
+	var runeDto RuneArrayDto
+
+	runeDto,
+		err = RuneArrayDto{}.NewString(
+		rawNumStr,
+		CharSearchType.LinearTargetStartingIndex(),
+		ePrefix.XCpy("rawNumStr"))
+
+	if err != nil {
+
+		return numberStrSearchResults, nStrKernel, err
+	}
+
+	var decSeparator DecimalSeparatorSpec
+
+	decSeparator,
+		err = new(DecimalSeparatorSpec).NewStr(
+		".",
+		ePrefix.XCpy("decSeparator<-"))
+
+	if err != nil {
+
+		return numberStrSearchResults, nStrKernel, err
+	}
+
+	negativeNumSearchSpecs := NegNumSearchSpecCollection{}
+
+	err = negativeNumSearchSpecs.AddLeadingNegNumSearchStr(
+		"-",
+		ePrefix.XCpy("Leading minus sign '-'"))
+
+	if err != nil {
+
+		return numberStrSearchResults, nStrKernel, err
+	}
+
+	err = negativeNumSearchSpecs.AddLeadingAndTrailingNegNumSearchStr(
+		"(",
+		")",
+		ePrefix.XCpy("Leading Trailing '()'"))
+
+	if err != nil {
+
+		return numberStrSearchResults, nStrKernel, err
+	}
+
+	numberStrSearchResults,
+		nStrKernel,
+		err = new(numStrHelperQuark).extractNumRunes(
+		runeDto,
+		"rawNumStr",
+		startSearchIndex,
+		breakOnCharSearchLength,
+		negativeNumSearchSpecs,
+		decSeparator,
+		numParsingTerminatorsCol,
+		requestRemainderString,
+		ePrefix)
+
+	return numberStrSearchResults, nStrKernel, err
+}
+
+// RationalizeFractionalIntegerDigits
+//
+// If fractional digits are present in this instance of
+// NumberStrKernel, this method will ensure that integer
+// digits are also present.
+//
+// If fractional digits are present and no integer digits
+// are found, this method will configure a zero ('0') in
+// the integer digits rune array.
+//
+// Example:
+//
+//	.752 will be converted to 0.752
+func (numStrKernel *NumberStrKernel) RationalizeFractionalIntegerDigits() {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	_ = new(numberStrKernelElectron).rationalizeFractionalIntegerDigits(
+		numStrKernel,
+		nil)
+
+	return
+}
+
+//	Round
+//
+//	Rounds the fractional digits contained in the current
+//	instance of NumberStrKernel.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	This method may alter the numeric value contained
+//	within the current instance of NumberStrKernel.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	roundingType				NumberRoundingType
 //
 //		This enumeration parameter is used to specify the
 //		type of rounding algorithm that will be applied for
 //		the	rounding of fractional digits contained in the
-//		reconfigured current instance of NumberStrKernel.
+//		current instance of NumberStrKernel.
 //
 //		If in doubt as to a suitable rounding method,
 //		'HalfAwayFromZero' is recommended.
@@ -10429,23 +10149,17 @@ func (numStrKernel *NumberStrKernel) SetFromDirtyNumberStr(
 //
 //	roundToFractionalDigits		int
 //
-//		When set to a positive integer value, this
-//		parameter controls the number of digits to the
-//		right of the radix point or decimal separator
-//		(a.k.a. decimal point). This controls the number
-//		of fractional digits remaining after completion
-//		of the number rounding operation.
-//
-//		If input parameter 'roundingType' is set to
-//		NumRoundType.NoRounding(),
-//		'roundToFractionalDigits' is ignored and no
-//		rounding operation is performed.
+//		When set to a positive integer value, this parameter
+//		controls the number of digits to the right of the radix
+//		point or decimal separator (a.k.a. decimal point). This
+//		controls the number of fractional digits remaining after
+//		completion of the number rounding operation.
 //
-//	errorPrefix					interface{}
+//	 errorPrefix                interface{}
 //
 //		This object encapsulates error prefix text which
 //		is included in all returned error messages.
-//		Usually, it contains the name of the calling
+//		Usually, it	contains the name of the calling
 //		method or methods listed as a method or function
 //		chain of execution.
 //
@@ -10504,90 +10218,22 @@ func (numStrKernel *NumberStrKernel) SetFromDirtyNumberStr(
 //
 // # Return Values
 //
-//	numStrStatsDto				NumberStrStatsDto
-//
-//		If this method completes successfully, an
-//		instance of NumberStrStatsDto will be returned
-//		containing a profile and key statistics on the
-//		updated numeric value encapsulated in the current
-//		instance of NumberStrKernel.
-//
-//		type NumberStrStatsDto struct {
-//
-//		NumOfIntegerDigits					uint64
-//
-//			The total number of integer digits to the
-//			left of the radix point or, decimal point, in
-//			the subject numeric value.
-//
-//		NumOfSignificantIntegerDigits		uint64
-//
-//			The number of nonzero integer digits to the
-//			left of the radix point or, decimal point, in
-//			the subject numeric value.
-//
-//		NumOfFractionalDigits				uint64
-//
-//			The total number of fractional digits to the
-//			right of the radix point or, decimal point,
-//			in the subject numeric value.
-//
-//		NumOfSignificantFractionalDigits	uint64
-//
-//			The number of nonzero fractional digits to
-//			the right of the radix point or, decimal
-//			point, in the subject numeric value.
-//
-//		NumberValueType 					NumericValueType
-//
-//			This enumeration value specifies whether the
-//			subject numeric value is classified either as
-//			an integer or a floating point number.
-//
-//			Possible enumeration values are listed as
-//			follows:
-//				NumValType.None()
-//				NumValType.FloatingPoint()
-//				NumValType.Integer()
+//	error
 //
-//		NumberSign							NumericSignValueType
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
 //
-//			An enumeration specifying the number sign
-//			associated with the numeric value. Possible
-//			values are listed as follows:
-//				NumSignVal.None()		= Invalid Value
-//				NumSignVal.Negative()	= -1
-//				NumSignVal.Zero()		=  0
-//				NumSignVal.Positive()	=  1
-//
-//		IsZeroValue							bool
-//
-//			If 'true', the subject numeric value is equal
-//			to zero ('0').
-//
-//			If 'false', the subject numeric value is
-//			greater than or less than zero ('0').
-//		}
-//
-//	err							error
-//
-//		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'. If
-//		errors are encountered during processing, the
+//		If errors are encountered during processing, the
 //		returned error Type will encapsulate an error
-//		message.
-//
-//		If an error message is returned, the text value
-//		for input parameter 'errPrefDto' (error prefix)
-//		will be prefixed or attached at the beginning of
-//		the error message.
-func (numStrKernel *NumberStrKernel) SetFromNativeNumberStr(
-	nativeNumStr string,
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (numStrKernel *NumberStrKernel) Round(
 	roundingType NumberRoundingType,
 	roundToFractionalDigits int,
-	errorPrefix interface{}) (
-	numStrStatsDto NumberStrStatsDto,
-	err error) {
+	errorPrefix interface{}) error {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -10598,373 +10244,302 @@ func (numStrKernel *NumberStrKernel) SetFromNativeNumberStr(
 	defer numStrKernel.lock.Unlock()
 
 	var ePrefix *ePref.ErrPrefixDto
+	var err error
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"SetFromNativeNumberStr()",
+			"Round()",
 		"")
 
 	if err != nil {
-		return numStrStatsDto, err
+		return err
 	}
 
-	numStrStatsDto,
-		err = new(numberStrKernelMechanics).
-		setNumStrKernelFromRoundedNativeNumStr(
-			numStrKernel,
-			nativeNumStr,
+	var numStrRoundingSpec NumStrRoundingSpec
+
+	numStrRoundingSpec,
+		err =
+		new(NumStrRoundingSpec).NewRoundingSpec(
 			roundingType,
 			roundToFractionalDigits,
-			ePrefix.XCpy(
-				"numStrKernel"))
+			ePrefix)
 
-	return numStrStatsDto,
-		err
+	if err != nil {
+		return err
+	}
+
+	return new(numStrMathRoundingNanobot).roundNumStrKernel(
+		numStrKernel,
+		numStrRoundingSpec,
+		ePrefix.XCpy("numStrKernel"))
 }
 
-// SetFromNumericValue
-//
-//	Deletes and resets the internal values for the
-//	current instance of NumberStrKernel using a numeric
-//	value passed as an empty interface.
-//
-//	The numeric value passed to this method by input
-//	parameter 'numericValue' MUST BE convertible to one
-//	of the types defined below:
-//
-//		float32, float64, big.Float
-//		*float32, *float64, *big.Float
-//		*BigFloatDto, BigFloatDto
-//		int8, int16, int, int32, int64,
-//		big.Int, *big.Int
-//		*int8, *int16, *int, *int32, *int64,
-//		uint8, uint16, uint, uint32, uint64
-//		*uint8, *uint16, *uint, *uint32, *uint64
-//		*TextFieldFormatDtoFloat64, TextFieldFormatDtoFloat64
-//		*TextFieldFormatDtoBigFloat, TextFieldFormatDtoBigFloat
-//		*NumberStrKernel, NumberStrKernel
-//
-//	This numeric value is then used to reconfigure the
-//	current instance NumberStrKernel.
-//
-// ----------------------------------------------------------------
-//
-// # BE ADVISED
-//
-//	(1)	big.Rat Accuracy
-//		This method will default big.Rat and *big.Rat
-//		values default to 2,000 decimal places of
-//		accuracy. Adjust rounding parameters as
-//		required or use the following method for more
-//		granular control over big.Rat rounding:
-//			NewFromNumericValue.SetFromBigRat()
-//
-//	(2) big.Rat Rounding Algorithm
-//		Before being converted to an instance of
-//		NumberStrKernel, the big.Rat numeric value will
-//		be rounded by the Golang 'big' package function:
+// RoundToIncrement
 //
-//			(*Rat) FloatString
+// Rounds the numeric value contained in the current
+// instance of NumberStrKernel to the nearest multiple of
+// the rounding increment specified by input parameter
+// 'roundingIncrement'.
 //
-//		The rounding algorithm used is described in the
-//		big.Rat documentation as:
+// Rounding to increments is typically used in cash
+// handling. For example, Swiss Franc cash amounts are
+// rounded to increments of 0.05 (5 Rappen).
 //
-//			The last digit is rounded to nearest, with
-//			halves rounded away from zero.
-//				https://pkg.go.dev/math/big#Rat
+//	Examples:
+//		Value   Increment  Rounding Type      Result
+//		 1.23     0.05     HalfAwayFromZero     1.25
+//		 1.225    0.05     HalfToEven           1.20
+//		 7.13     0.25     Ceiling              7.25
+//		-1.21     0.25     Floor               -1.25
+//		 1250     100      HalfToEven           1200
 //
 // ----------------------------------------------------------------
 //
 // # IMPORTANT
 //
-//	This method will delete and reconfigure all
-//	pre-existing data values contained in the current
-//	instance of NumberStrKernel.
+//	This method will modify the numeric value contained
+//	in the current instance of NumberStrKernel.
 //
 // ----------------------------------------------------------------
 //
 // # Input Parameters
 //
-//	numericValue				interface{}
-//
-//		Numeric values passed by means of this empty
-//		interface MUST BE convertible to one of the
-//		following types:
-//
-//	     	float32, float64, big.Float
-//			*float32, *float64, *big.Float
-//			*BigFloatDto, BigFloatDto
-//			*big.Rat, big.Rat
-//			int8, int16, int, int32, int64, big.Int
-//			*int8, *int16, *int, *int32, *int64,
-//			*big.Int, big.Int
-//			uint8, uint16, uint, uint32, uint64
-//			*uint8, *uint16, *uint, *uint32, *uint64
-//			*TextFieldFormatDtoFloat64, TextFieldFormatDtoFloat64
-//			*TextFieldFormatDtoBigFloat, TextFieldFormatDtoBigFloat
-//			*NumberStrKernel, NumberStrKernel
-//
-//		This numeric value will be used to reconfigure
-//		the current instance of NumberStrKernel.
-//
-//		If 'numericValue' is NOT convertible to one of
-//		the types listed above, an error will be
-//		returned.
-//
 //	roundingType				NumberRoundingType
 //
-//		This enumeration parameter is used to specify the
-//		type of rounding algorithm that will be applied for
-//		the	rounding of fractional digits contained in the
-//		reconfigured instance of NumberStrKernel.
+//		This enumeration parameter specifies the rounding
+//		algorithm which will be applied in the number
+//		rounding operation. Valid values are listed as
+//		follows:
 //
-//		If in doubt as to a suitable rounding method,
-//		'HalfAwayFromZero' is recommended.
+//			NumRoundType.NoRounding(),
+//			NumRoundType.HalfUpWithNegNums(),
+//			NumRoundType.HalfDownWithNegNums(),
+//			NumRoundType.HalfAwayFromZero(),
+//			NumRoundType.HalfTowardsZero(),
+//			NumRoundType.HalfToEven(),
+//			NumRoundType.HalfToOdd(),
+//			NumRoundType.Randomly(),
+//			NumRoundType.Floor(),
+//			NumRoundType.Ceiling(),
+//			NumRoundType.Truncate(),
+//
+//		All rounding algorithms, including 'Floor' and
+//		'Ceiling', are applied with respect to the
+//		rounding target.
+//
+//	roundingIncrement			string
+//
+//		A pure number string specifying the rounding
+//		increment. The numeric value will be rounded to
+//		the nearest multiple of this increment.
+//
+//		This string may only contain numeric digits and
+//		a single period ('.') as a decimal separator.
+//		Leading and trailing white space is ignored. The
+//		numeric value of the rounding increment must be
+//		greater than zero (0).
+//
+//		The number of fractional digits in the rounded
+//		result will be equal to the number of fractional
+//		digits in 'roundingIncrement'.
+//
+//			Examples:
+//				"0.05" - Swiss Rappen (5-cent increments)
+//				"0.25" - Quarter increments
+//				"100"  - Round to the nearest hundred
+//
+//		If 'roundingIncrement' is invalid, an error will
+//		be returned.
 //
-//		Possible values are listed as follows:
-//			NumRoundType.None()	- Invalid Value
-//			NumRoundType.NoRounding()
-//			NumRoundType.HalfUpWithNegNums()
-//			NumRoundType.HalfDownWithNegNums()
-//			NumRoundType.HalfAwayFromZero()
-//			NumRoundType.HalfTowardsZero()
-//			NumRoundType.HalfToEven()
-//			NumRoundType.HalfToOdd()
-//			NumRoundType.Randomly()
-//			NumRoundType.Floor()
-//			NumRoundType.Ceiling()
-//			NumRoundType.Truncate()
+//	errorPrefix					interface{}
 //
-//		Definitions:
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//			NoRounding
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//				Signals that no rounding operation will be
-//				performed on fractional digits. The
-//				fractional digits will therefore remain
-//				unchanged.
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//			HalfUpWithNegNums
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//				Half Round Up Including Negative Numbers.
-//				This method is intuitive but may produce
-//				unexpected results when applied to negative
-//				numbers.
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//				'HalfUpWithNegNums' rounds .5 up.
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//					Examples of 'HalfUpWithNegNums'
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8
-//					7.4 rounds down to 7
-//					-7.4 rounds up to -7
-//					-7.5 rounds up to -7
-//					-7.6 rounds down to -8
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//			HalfDownWithNegNums
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//			Half Round Down Including Negative Numbers. This
-//			method is also considered intuitive but may
-//			produce unexpected results when applied to
-//			negative numbers.
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//			'HalfDownWithNegNums' rounds .5 down.
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//				Examples of HalfDownWithNegNums
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
 //
-//				7.6 rounds up to 8
-//				7.5 rounds down to 7
-//				7.4 rounds down to 7
-//				-7.4 rounds up to -7
-//				-7.5 rounds down to -8
-//				-7.6 rounds down to -8
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
-//			HalfAwayFromZero
+// ----------------------------------------------------------------
 //
-//				The 'HalfAwayFromZero' method rounds .5 further
-//				away from zero.	It provides clear and consistent
-//				behavior when dealing with negative numbers.
+// # Return Values
 //
-//					Examples of HalfAwayFromZero
+//	error
 //
-//					7.6 rounds away to 8
-//					7.5 rounds away to 8
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds away to -8
-//					-7.6 rounds away to -8
-//
-//			HalfTowardsZero
-//
-//				Round Half Towards Zero. 'HalfTowardsZero' rounds
-//				0.5	closer to zero. It provides clear and
-//				consistent behavior	when dealing with negative
-//				numbers.
-//
-//					Examples of HalfTowardsZero
-//
-//					7.6 rounds away to 8
-//					7.5 rounds to 7
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds to -7
-//					-7.6 rounds away to -8
-//
-//			HalfToEven
-//
-//				Round Half To Even Numbers. 'HalfToEven' is
-//				also called	Banker's Rounding. This method
-//				rounds 0.5 to the nearest even digit.
-//
-//					Examples of HalfToEven
-//
-//					7.5 rounds up to 8 (because 8 is an even
-//					number)	but 6.5 rounds down to 6 (because
-//					6 is an even number)
-//
-//					HalfToEven only applies to 0.5. Other
-//					numbers (not ending	in 0.5) round to
-//					nearest as usual, so:
-//
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8 (because 8 is an even number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds down to 6 (because 6 is an even number)
-//					6.4 rounds down to 6
-//
-//			HalfToOdd
-//
-//				Round Half to Odd Numbers. Similar to 'HalfToEven',
-//				but in this case 'HalfToOdd' rounds 0.5 towards odd
-//				numbers.
-//
-//					Examples of HalfToOdd
-//
-//					HalfToOdd only applies to 0.5. Other numbers
-//					(not ending	in 0.5) round to nearest as usual.
-//
-//					7.5 rounds down to 7 (because 7 is an odd number)
-//
-//					6.5 rounds up to 7 (because 7 is an odd number)
-//
-//					7.6 rounds up to 8
-//					7.5 rounds down to 7 (because 7 is an odd number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds up to 7 (because 7 is an odd number)
-//					6.4 rounds down to 6
-//
-//			Randomly
-//
-//				Round Half Randomly. Uses a Random Number Generator
-//				to choose between rounding 0.5 up or down.
-//
-//				All numbers other than 0.5 round to the nearest as
-//				usual.
-//
-//			Floor
-//
-//				Yields the nearest integer down. Floor does not apply
-//				any	special treatment to 0.5.
-//
-//				Floor Function: The greatest integer that is less than
-//				or equal to x
-//
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
-//
-//				In mathematics and computer science, the floor function
-//				is the function that takes as input a real number x,
-//				and gives as output the greatest integer less than or
-//				equal to x,	denoted floor(x) or ⌊x⌋.
-//
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
-//
-//				Examples of Floor
-//
-//					Number     Floor
-//					 2           2
-//					 2.4         2
-//					 2.9         2
-//					-2.5        -3
-//					-2.7        -3
-//					-2          -2
-//
-//			Ceiling
-//
-//				Yields the nearest integer up. Ceiling does not
-//				apply any special treatment to 0.5.
-//
-//				Ceiling Function: The least integer that is
-//				greater than or	equal to x.
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
-//
-//				The ceiling function maps x to the least integer
-//				greater than or equal to x, denoted ceil(x) or
-//				⌈x⌉.[1]
-//
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
 //
-//					Examples of Ceiling
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) RoundToIncrement(
+	roundingType NumberRoundingType,
+	roundingIncrement string,
+	errorPrefix interface{}) error {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"RoundToIncrement()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	var numStrRoundingSpec NumStrRoundingSpec
+
+	numStrRoundingSpec,
+		err =
+		new(NumStrRoundingSpec).NewRoundingSpecIncrement(
+			roundingType,
+			roundingIncrement,
+			ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrMathRoundingNanobot).roundNumStrKernel(
+		numStrKernel,
+		numStrRoundingSpec,
+		ePrefix.XCpy("numStrKernel"))
+}
+
+// RoundToSignificantDigits
 //
-//						Number    Ceiling
-//						 2           2
-//						 2.4         3
-//						 2.9         3
-//						-2.5        -2
-//						-2.7        -2
-//						-2          -2
+// Rounds the numeric value contained in the current
+// instance of NumberStrKernel to the number of
+// significant digits specified by input parameter
+// 'roundToSignificantDigits'.
 //
-//			Truncate
+//	Examples:
+//		Value       Significant  Rounding Type      Result
+//		             Digits
+//		 123456        2         HalfAwayFromZero   120000
+//		 0.0012345     3         HalfToEven         0.00123
+//		-2.5           4         HalfAwayFromZero  -2.500
+//		 9.99          2         HalfAwayFromZero   10
+//		 1.21          2         Ceiling            1.3
 //
-//				Apply NO Rounding whatsoever. The Round From Digit
-//				is dropped or deleted. The Round To Digit is NEVER
-//				changed.
+// ----------------------------------------------------------------
 //
-//				Examples of Truncate
+// # IMPORTANT
 //
-//					Example-1
-//					Number: 23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:   23.14 - The Round From Digit
-//					is dropped.
+//	This method will modify the numeric value contained
+//	in the current instance of NumberStrKernel.
 //
-//					Example-2
-//					Number: -23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:  -23.14 - The Round From Digit
-//					is dropped.
+// ----------------------------------------------------------------
 //
-//	roundToFractionalDigits		int
+// # Input Parameters
 //
-//		When set to a positive integer value, this
-//		parameter controls the number of digits to the
-//		right of the radix point or decimal separator
-//		(a.k.a. decimal point). This controls the number
-//		of fractional digits remaining after completion
-//		of the number rounding operation.
+//	roundingType				NumberRoundingType
 //
-//		This parameter is ignored if 'roundingType' is
-//		set to 'NumRoundType.NoRounding()'.
+//		This enumeration parameter specifies the rounding
+//		algorithm which will be applied in the number
+//		rounding operation. Valid values are listed as
+//		follows:
 //
-//		This parameter will be used to control the number
-//		of fractional digits to the right of the decimal
-//		point in the reconfigured instance of
-//		NumberStrKernel.
+//			NumRoundType.NoRounding(),
+//			NumRoundType.HalfUpWithNegNums(),
+//			NumRoundType.HalfDownWithNegNums(),
+//			NumRoundType.HalfAwayFromZero(),
+//			NumRoundType.HalfTowardsZero(),
+//			NumRoundType.HalfToEven(),
+//			NumRoundType.HalfToOdd(),
+//			NumRoundType.Randomly(),
+//			NumRoundType.Floor(),
+//			NumRoundType.Ceiling(),
+//			NumRoundType.Truncate(),
+//
+//		All rounding algorithms, including 'Floor' and
+//		'Ceiling', are applied with respect to the
+//		rounding target.
+//
+//	roundToSignificantDigits	int
+//
+//		The number of significant digits which will
+//		remain after completion of the number rounding
+//		operation. Trailing zeros are added where
+//		necessary in order to display the specified
+//		number of significant digits.
+//
+//			Examples:
+//				123456   rounded to 2 significant digits = 120000
+//				0.012345 rounded to 3 significant digits = 0.0123
+//				2.5      rounded to 4 significant digits = 2.500
+//				9.99     rounded to 2 significant digits = 10
+//
+//		If 'roundToSignificantDigits' is less than one
+//		(1), an error will be returned.
 //
 //	errorPrefix					interface{}
 //
@@ -11032,19 +10607,18 @@ func (numStrKernel *NumberStrKernel) SetFromNativeNumberStr(
 //	error
 //
 //		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'.
-//
-//		If errors are encountered during processing, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
 //		returned error Type will encapsulate an error
-//		message. This returned error message will
-//		incorporate the method chain and text passed by
-//		input parameter, 'errorPrefix'. The 'errorPrefix'
-//		text will be attached to the beginning of the
-//		error message.
-func (numStrKernel *NumberStrKernel) SetFromNumericValue(
-	numericValue interface{},
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) RoundToSignificantDigits(
 	roundingType NumberRoundingType,
-	roundToFractionalDigits int,
+	roundToSignificantDigits int,
 	errorPrefix interface{}) error {
 
 	if numStrKernel.lock == nil {
@@ -11062,33 +10636,20 @@ func (numStrKernel *NumberStrKernel) SetFromNumericValue(
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"SetFromNumericValue()",
+			"RoundToSignificantDigits()",
 		"")
 
 	if err != nil {
 		return err
 	}
 
-	err = new(numberStrKernelMolecule).
-		convertNumericValueToKernel(
-			numStrKernel,
-			numericValue,
-			ePrefix.XCpy(
-				"numStrKernel"))
-
-	if err != nil ||
-		roundingType == NumRoundType.NoRounding() {
-
-		return err
-	}
-
 	var numStrRoundingSpec NumStrRoundingSpec
 
 	numStrRoundingSpec,
 		err =
-		new(NumStrRoundingSpec).NewRoundingSpec(
+		new(NumStrRoundingSpec).NewRoundingSpecSignificantDigits(
 			roundingType,
-			roundToFractionalDigits,
+			roundToSignificantDigits,
 			ePrefix)
 
 	if err != nil {
@@ -11098,715 +10659,4891 @@ func (numStrKernel *NumberStrKernel) SetFromNumericValue(
 	return new(numStrMathRoundingNanobot).roundNumStrKernel(
 		numStrKernel,
 		numStrRoundingSpec,
-		ePrefix)
+		ePrefix.XCpy("numStrKernel"))
 }
 
-// SetFromPureNumberStr
+// Scan
 //
-// Receives an instance of NumberStrKernel and proceeds
-// to reconfigure that instance based on the numeric
-// value extracted from a Pure Number String.
-//
-// A Pure Number String differs from a Native Number
-// String in that it offers more options for
-// customization. Pure Number Strings are better able to
-// match multinational and multicultural number
-// formatting conventions. Users have the option to
-// specify custom radix points or decimal separator
-// characters as well as designating leading or trailing
-// minus signs for negative numbers.
-//
-// A Pure Number String is defined as follows:
+// Implements the 'sql.Scanner' interface for type
+// NumberStrKernel.
 //
-//  1. A pure number string consists entirely of numeric
-//     digit characters (0-9).
+// Scan receives a value read from a database column by
+// a 'database/sql' driver and uses that value to reset
+// the numeric value of the current NumberStrKernel
+// instance. No conversion to float64 is performed.
+// Therefore, the scanned numeric value is exact.
 //
-//  2. A pure number string will separate integer and
-//     fractional digits with a radix point or decimal
-//     separator. This could be, but is not limited to,
-//     a decimal point ('.'). For example, many European
-//     countries use the comma (',') as a radix point.
+// The database value passed by input parameter 'src'
+// must be one of the following types:
 //
-//  3. A pure number string will designate negative values
-//     with a minus sign ('-'). This minus sign could be
-//     positioned as a leading or trailing minus sign.
+//	nil
+//		A database NULL value. The numeric value of the
+//		current NumberStrKernel instance will be deleted
+//		leaving an empty NumberStrKernel instance.
 //
-//  4. A pure number string will NEVER include integer
-//     separators such as commas (',') to separate
-//     integer digits by thousands.
+//	int64
+//		An integer value.
 //
-//     NOT THIS: 1,000,000
-//     Pure Number String: 1000000
+//	[]byte
+//	string
+//		A Native Number String or an E-Notation number
+//		string such as those returned for DECIMAL or
+//		NUMERIC columns. Empty strings, or strings
+//		consisting entirely of white space, are NOT
+//		treated as a NULL value and will trigger an
+//		error.
 //
-//  5. A pure number string will NEVER include currency
-//     symbols.
+// Floating point values (float32 and float64) are NOT
+// supported and will trigger an error. Database columns
+// scanned into a NumberStrKernel should be defined as
+// DECIMAL, NUMERIC or text columns.
 //
-// Users also have the option of specifying rounding
-// parameters which will be used to round the numeric
-// value extracted from 'pureNumberStr' before it is
-// converted and stored in the reconfigured current
-// instance of 'NumberStrKernel'.
+// ----------------------------------------------------------------
 //
-// Note that return parameter 'pureNumStrComponents'
-// will provide a statistical profile of the new
-// returned instance of NumberStrKernel,
-// 'newNumStrKernel'.
+// # BE ADVISED
 //
-// ----------------------------------------------------------------
+//	Only the numeric value of the current
+//	NumberStrKernel instance is modified by this method.
+//	The Default Number String Format Specification and
+//	the JSON Marshal Format are NOT changed.
 //
-// # IMPORTANT
+//	If an error is returned, the current instance of
+//	NumberStrKernel will NOT be modified.
 //
-//	This method will delete and reconfigure all
-//	pre-existing data values contained in the current
-//	instance of NumberStrKernel.
+//	Decoded numeric values are limited to 10,000
+//	numeric digits. For E-Notation number strings, this
+//	limit includes the digits added by expanding the
+//	exponent. Values exceeding this limit are rejected
+//	with an error.
 //
 // ----------------------------------------------------------------
 //
 // # Input Parameters
 //
-//	pureNumberStr				string
+//	src							interface{}
 //
-//		This strings contains the numeric character
-//		digits from	which a numeric value will be
-//		extracted. This numeric value will be used
-//		to reconfigure the instance of NumberStrKernel
-//		passed as input parameter 'numStrKernel'.
+//		The value read from the database by a
+//		'database/sql' driver. If this value is not one
+//		of the types listed above, an error will be
+//		returned.
 //
-//		A "Pure Number String" is defined as follows:
+// ----------------------------------------------------------------
 //
-//			1.	Consists of numeric character digits
-//				zero through nine inclusive (0-9).
+// # Return Values
 //
-//			2.	Option: A Pure Number String may include
-//				a radix point or decimal separator.
-//				Decimal separators separate integer and
-//				fractional numeric digits in a pure
-//				number string. The decimal separator may
-//				consist of one or more text characters.
+//	error
 //
-//				In the US, UK, Australia, most of Canada
-//				and many other countries, the decimal
-//				separator is the period character ('.')
-//				known as the decimal point.
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+func (numStrKernel *NumberStrKernel) Scan(
+	src interface{}) error {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		nil,
+		"NumberStrKernel."+
+			"Scan()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(numberStrKernelMechanics).
+		setNumStrKernelFromSqlValue(
+			numStrKernel,
+			src,
+			ePrefix.XCpy(
+				"numStrKernel<-src"))
+}
+
+// SetDefaultNumStrFmtSpec
 //
-//				In France, Germany and many countries in
-//				the European Union, the Decimal Separator
-//				is the comma character (',').
+// Sets the default Number String Format Specification
+// for the current instance of to NumberStrKernel.
 //
-//			3.	Optional: A Pure Number String may
-//				include a negative number sign symbol
-//				consisting of a minus sign ('-'). The
-//				minus sign will identify the numeric
-//				value contained in the pure number string
-//				as a negative number. Only the minus sign
-//				('-') classifies a numeric value as a
-//				negative number in a Pure Number String.
+// The default Number String Format Specification is
+// used and applied in formatting the number string for
+// screen display, file output or printing.
 //
-//				If a leading or trailing minus sign ('-')
-//				is NOT present in the pure number string,
-//				the numeric value is assumed to be
-//				positive.
+// The internal member variable controlling default
+// number string formatting which will be modified
+// by this method is:
 //
-//			4.	Only numeric characters, the decimal
-//				separator and the minus sign will be
-//				processed by the pure number string
-//				parsing algorithm. All other characters
-//				will be	ignored.
+//	NumberStrKernel.numStrFormatSpec
 //
-//			5.	Pure Number Strings consist of a single
-//				numeric value. The entire Pure Number String
-//				will be parsed, or processed, and only one
-//				numeric value per Pure Number String will
-//				be returned.
+// The default Number String Format Specification is
+// used and applied by the following methods:
 //
-//	decSeparatorChars			string
+//	NumberStrKernel.FmtSignedNumStrPure()
+//	NumberStrKernel.String()
 //
-//		This string contains the character or characters
-//		which will be configured as the Decimal Separator
-//		Symbol.
+// ----------------------------------------------------------------
 //
-//		The Decimal Separator is also known as the radix
-//		point and is used to separate integer and
-//		fractional digits within a formatted, floating
-//		point Number String.
+// # BE ADVISED
 //
-//		In the US, UK, Australia, most of Canada and many
-//		other countries the Decimal Separator is the
-//		period character ('.') known as the decimal
-//		point.
+//	This method will NOT alter the numeric value
+//	configured for the current instance of
+//	NumberStrKernel. This method will only reconfigure
+//	the internal member variable controlling default
+//	number string formatting:
 //
-//		In France, Germany and many countries in the
-//		European Union, the Decimal Separator is the
-//		comma character (',').
+//		NumberStrKernel.numStrFormatSpec
 //
-//		The Decimal Separator is used to identify and
-//		separate integer and fractional digits while
-//		parsing the Pure Number String.
+// ----------------------------------------------------------------
 //
-//	leadingMinusSign			bool
+// # Input Parameters
 //
-//		In pure number strings, a minus sign ('-')
-//		identifies a number as a negative numeric value.
+//	defaultNumStrFmt			NumStrFormatSpec
 //
-//		When 'leadingMinusSign' is set to 'true', the
-//		pure number string parsing algorithm will search
-//		for a leading minus sign ('-') at the beginning of
-//		the number string. Leading minus signs represent
-//		the standard means for designating negative
-//		numeric values in the US, UK, Australia, most of
-//		Canada and many other parts of world.
+//		An instance of Number String Format Specification
+//		('NumStrFormatSpec').
 //
-//		Example Leading Minus Sign:
-//			"-123.456" or "- 123.456"
+//		A deep copy of this instance will be stored in the
+//		current instance of NumberStrKernel. This format
+//		specification will be used to format the number
+//		string for screen displays, file output or
+//		printing.
 //
-//		When 'leadingMinusSign' is set to 'false', the
-//		pure number string parsing algorithm will search
-//		for trailing minus signs ('-') located at the end
-//		of the number string. Trailing minus signs
-//		represent the standard for France, Germany and
-//		many countries in the European Union.
+//		If 'defaultNumStrFmt' is found to be invalid, an
+//		error will be returned.
 //
-//		NOTE: Identification of a trailing minus sign in
-//		the pure number string input parameter,
-//		'pureNumberString', will immediately terminate
-//		the search for numeric characters.
+//	errorPrefix					interface{}
 //
-//		Example Trailing Number Symbols:
-//			"123.456-" or "123.456 -"
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//	roundingType				NumberRoundingType
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//		This enumeration parameter is used to specify the
-//		type of rounding algorithm that will be applied for
-//		the	rounding of fractional digits contained in the
-//		new returned instance of NumberStrKernel
-//		(newNumStrKernel).
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//		If in doubt as to a suitable rounding method,
-//		'HalfAwayFromZero' is recommended.
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//		Possible values are listed as follows:
-//			NumRoundType.None()	- Invalid Value
-//			NumRoundType.NoRounding()
-//			NumRoundType.HalfUpWithNegNums()
-//			NumRoundType.HalfDownWithNegNums()
-//			NumRoundType.HalfAwayFromZero()
-//			NumRoundType.HalfTowardsZero()
-//			NumRoundType.HalfToEven()
-//			NumRoundType.HalfToOdd()
-//			NumRoundType.Randomly()
-//			NumRoundType.Floor()
-//			NumRoundType.Ceiling()
-//			NumRoundType.Truncate()
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//		Definitions:
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//			NoRounding
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//				Signals that no rounding operation will be
-//				performed on fractional digits. The
-//				fractional digits will therefore remain
-//				unchanged.
-//
-//			HalfUpWithNegNums
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//				Half Round Up Including Negative Numbers.
-//				This method is intuitive but may produce
-//				unexpected results when applied to negative
-//				numbers.
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//				'HalfUpWithNegNums' rounds .5 up.
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//					Examples of 'HalfUpWithNegNums'
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8
-//					7.4 rounds down to 7
-//					-7.4 rounds up to -7
-//					-7.5 rounds up to -7
-//					-7.6 rounds down to -8
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
 //
-//			HalfDownWithNegNums
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
-//			Half Round Down Including Negative Numbers. This
-//			method is also considered intuitive but may
-//			produce unexpected results when applied to
-//			negative numbers.
+// ----------------------------------------------------------------
 //
-//			'HalfDownWithNegNums' rounds .5 down.
+// # Return Values
 //
-//				Examples of HalfDownWithNegNums
+//	error
 //
-//				7.6 rounds up to 8
-//				7.5 rounds down to 7
-//				7.4 rounds down to 7
-//				-7.4 rounds up to -7
-//				-7.5 rounds down to -8
-//				-7.6 rounds down to -8
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
 //
-//			HalfAwayFromZero
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (numStrKernel *NumberStrKernel) SetDefaultNumStrFmtSpec(
+	defaultNumStrFmt NumStrFormatSpec,
+	errorPrefix interface{}) error {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"SetDefaultNumStrFmtSpec()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	_,
+		err = new(numStrFmtSpecElectron).
+		testValidityNumStrFormatSpec(
+			&defaultNumStrFmt,
+			ePrefix.XCpy(
+				"defaultNumStrFmt invalid!"))
+
+	if err != nil {
+		return err
+	}
+
+	err = numStrKernel.numStrFormatSpec.CopyIn(
+		&defaultNumStrFmt,
+		ePrefix.XCpy(
+			"numStrKernel.numStrFormatSpec<-defaultNumStrFmt"))
+
+	return err
+}
+
+//	SetDefaultNumStrFmtSpecElements
 //
-//				The 'HalfAwayFromZero' method rounds .5 further
-//				away from zero.	It provides clear and consistent
-//				behavior when dealing with negative numbers.
+//	Sets the default Number String Format Specification
+//	for the current instance of to NumberStrKernel.
 //
-//					Examples of HalfAwayFromZero
+//	Options include customizing for currency symbols,
+//	integer separation, number sign	management, radix
+//	point symbols, and floating point number rounding.
 //
-//					7.6 rounds away to 8
-//					7.5 rounds away to 8
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds away to -8
-//					-7.6 rounds away to -8
+//	If required, users have the options of
+//	implementing the India or Chinese Numbering
+//	Systems for integer separation.
 //
-//			HalfTowardsZero
+//	This method offers the maximum degree of granular
+//	control over all aspects of the Number String
+//	formatting operation.
 //
-//				Round Half Towards Zero. 'HalfTowardsZero' rounds
-//				0.5	closer to zero. It provides clear and
-//				consistent behavior	when dealing with negative
-//				numbers.
+//	In particular, it offers maximum flexibility in
+//	configuring integer separator characters and
+//	integer grouping sequences.
 //
-//					Examples of HalfTowardsZero
+// ----------------------------------------------------------------
 //
-//					7.6 rounds away to 8
-//					7.5 rounds to 7
-//					7.4 rounds to 7
-//					-7.4 rounds to -7
-//					-7.5 rounds to -7
-//					-7.6 rounds away to -8
+// # BE ADVISED
 //
-//			HalfToEven
+//	(1)	If Currency Symbol formatting is NOT required,
+//		set the Currency Symbol parameter to a 'NOP'.
+//		'NOP' is a computer science term for
+//		'No Operation'.
 //
-//				Round Half To Even Numbers. 'HalfToEven' is
-//				also called	Banker's Rounding. This method
-//				rounds 0.5 to the nearest even digit.
+//			currencySymbol :=
+//				new(NumStrNumberSymbolSpec).NewNOP()
 //
-//					Examples of HalfToEven
+//	(2)	This method will NOT alter the numeric value
+//		configured for the current instance of
+//		NumberStrKernel. This method will only
+//		reconfigure the internal member variable
+//		controlling default number string formatting:
 //
-//					7.5 rounds up to 8 (because 8 is an even
-//					number)	but 6.5 rounds down to 6 (because
-//					6 is an even number)
+//			NumberStrKernel.numStrFormatSpec
 //
-//					HalfToEven only applies to 0.5. Other
-//					numbers (not ending	in 0.5) round to
-//					nearest as usual, so:
+// ----------------------------------------------------------------
 //
-//					7.6 rounds up to 8
-//					7.5 rounds up to 8 (because 8 is an even number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds down to 6 (because 6 is an even number)
-//					6.4 rounds down to 6
+// # Input Parameters
 //
-//			HalfToOdd
+//	decSeparatorSpec				DecimalSeparatorSpec
 //
-//				Round Half to Odd Numbers. Similar to 'HalfToEven',
-//				but in this case 'HalfToOdd' rounds 0.5 towards odd
-//				numbers.
+//		This structure contains the radix point or
+//		decimal separator character(s) which will be used
+//		to separate integer and fractional digits within
+//		a formatted Number String.
 //
-//					Examples of HalfToOdd
+//		In the US, UK, Australia and most of Canada, the
+//		decimal separator is the period character ('.')
+//		known as the decimal point.
 //
-//					HalfToOdd only applies to 0.5. Other numbers
-//					(not ending	in 0.5) round to nearest as usual.
+//		In France, Germany and many countries in the
+//		European Union, the Decimal Separator is the
+//		comma character (',').
 //
-//					7.5 rounds down to 7 (because 7 is an odd number)
+//	intSeparatorSpec				IntegerSeparatorSpec
 //
-//					6.5 rounds up to 7 (because 7 is an odd number)
+//		Number String Integer Separator Specification. This
+//		type encapsulates the parameters required to format
+//		integer grouping and separation within a Number
+//		String.
 //
-//					7.6 rounds up to 8
-//					7.5 rounds down to 7 (because 7 is an odd number)
-//					7.4 rounds down to 7
-//					6.6 rounds up to 7
-//					6.5 rounds up to 7 (because 7 is an odd number)
-//					6.4 rounds down to 6
+//		Type IntegerSeparatorSpec is designed to manage
+//		integer separators, primarily thousands separators,
+//		for different countries and cultures. The term
+//		'integer separators' is used because this type
+//		manages both integer grouping and the characters
+//		used to separate integer groups.
 //
-//			Randomly
+//		In the USA and many other countries, integer
+//		numbers are often separated by commas thereby
+//		grouping the number into thousands.
 //
-//				Round Half Randomly. Uses a Random Number Generator
-//				to choose between rounding 0.5 up or down.
+//		Examples:
 //
-//				All numbers other than 0.5 round to the nearest as
-//				usual.
+//			IntGroupingType.None()
+//			(a.k.a Integer Separation Turned Off)
+//				'1000000000'
 //
-//			Floor
+//			IntGroupingType.Thousands()
+//					'1,000,000,000'
 //
-//				Yields the nearest integer down. Floor does not apply
-//				any	special treatment to 0.5.
+//			IntGroupingType.IndiaNumbering()
+//				'6,78,90,00,00,00,00,000'
 //
-//				Floor Function: The greatest integer that is less than
-//				or equal to x
+//			IntGroupingType.ChineseNumbering()
+//				'6,7890,0000,0000,0000'
 //
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//		Other countries and cultures use characters other
+//		than the comma to separate integers into thousands.
+//		Some countries and cultures do not use thousands
+//		separation and instead rely on multiple integer
+//		separation characters and grouping sequences for a
+//		single integer number. Notable examples of this
+//		are found in the 'India Number System' and
+//		'Chinese Numerals'.
 //
-//				In mathematics and computer science, the floor function
-//				is the function that takes as input a real number x,
-//				and gives as output the greatest integer less than or
-//				equal to x,	denoted floor(x) or ⌊x⌋.
+//		Reference:
+//			https://en.wikipedia.org/wiki/Indian_numbering_system
+//			https://en.wikipedia.org/wiki/Chinese_numerals
+//			https://en.wikipedia.org/wiki/Decimal_separator
 //
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//		The IntegerSeparatorSpec type provides the
+//		flexibility necessary to process these complex
+//		number separation formats.
 //
-//				Examples of Floor
+//		If integer separation is turned off, no error
+//		will be returned and integer digits will be
+//		displayed as a single string of numeric digits:
 //
-//					Number     Floor
-//					 2           2
-//					 2.4         2
-//					 2.9         2
-//					-2.5        -3
-//					-2.7        -3
-//					-2          -2
+//			Integer Separation Turned Off: 1000000000
 //
-//			Ceiling
+//	negativeNumberSign				NumStrNumberSymbolSpec
 //
-//				Yields the nearest integer up. Ceiling does not
-//				apply any special treatment to 0.5.
+//		The Number String Negative Number Sign
+//		Specification is used to configure negative
+//		number sign symbols for negative numeric
+//		values formatted and displayed in number
+//		stings.
 //
-//				Ceiling Function: The least integer that is
-//				greater than or	equal to x.
-//				Source:
-//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//		If this parameter is submitted as an empty or
+//		invalid Negative Number Sign Specification, it
+//		will be automatically converted to a 'NOP' or
+//		empty placeholder which will be ignored by Number
+//		String formatting algorithms. 'NOP' is a computer
+//		science term meaning 'No Operation'.
 //
-//				The ceiling function maps x to the least integer
-//				greater than or equal to x, denoted ceil(x) or
-//				⌈x⌉.[1]
+//		Example-1: Leading Number Sign Symbols
+//			Leading Number Sign Symbols for Negative
+//			Values
 //
-//				Source:
-//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//			Leading Symbols: "- "
+//			Number String:   "- 123.456"
 //
-//					Examples of Ceiling
+//		Example-2: Leading Number Sign Symbols
+//			Leading Number Sign Symbols for Negative
+//			Values
 //
-//						Number    Ceiling
-//						 2           2
-//						 2.4         3
-//						 2.9         3
-//						-2.5        -2
-//						-2.7        -2
-//						-2          -2
+//			Leading Symbols: "-"
+//			Number String:   "-123.456"
 //
-//			Truncate
+//		Example-3: Trailing Number Sign Symbols
+//			Trailing Number Sign Symbols for Negative
+//			Values
 //
-//				Apply NO Rounding whatsoever. The Round From Digit
-//				is dropped or deleted. The Round To Digit is NEVER
-//				changed.
+//			Trailing Symbols: " -"
+//			Number String:   "123.456 -"
 //
-//				Examples of Truncate
+//		Example-4: Trailing Number Sign Symbols
+//			Trailing Number Sign Symbols for Negative
+//			Values
 //
-//					Example-1
-//					Number: 23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:   23.14 - The Round From Digit
-//					is dropped.
+//			Trailing Symbols: "-"
+//			Number String:   "123.456-"
 //
-//					Example-2
-//					Number: -23.14567
-//					Objective: Round to two decimal places to
-//					the right of the decimal point.
-//					Rounding Method: Truncate
-//					Round To Digit:   4
-//					Round From Digit: 5
-//					Rounded Number:  -23.14 - The Round From Digit
-//					is dropped.
+//	positiveNumberSign 				NumStrNumberSymbolSpec
 //
-//	roundToFractionalDigits		int
+//		Positive number signs are commonly implied
+//		and not specified. However, the user has
+//		the option to specify a positive number sign
+//		character or characters for positive numeric
+//		values using this input parameter.
 //
-//		When set to a positive integer value, this
-//		parameter controls the number of digits to the
-//		right of the radix point or decimal separator
-//		(a.k.a. decimal point). This controls the number
-//		of fractional digits remaining after completion
-//		of the number rounding operation.
+//		If this parameter is submitted as an empty or
+//		invalid Positive Number Sign Specification, it
+//		will be automatically converted to a 'NOP' or
+//		empty placeholder which will be ignored by Number
+//		String formatting algorithms. 'NOP' is a computer
+//		science term meaning 'No Operation'.
 //
-//		If input parameter 'roundingType' is set to
-//		NumRoundType.NoRounding(),
-//		'roundToFractionalDigits' is ignored and no
-//		rounding operation is performed.
+//		Example-1: Leading Number Sign Symbols
+//			Leading Number Sign Symbols for Positive
+//			Values
 //
-//	errorPrefix					interface{}
+//			Leading Symbols: "+ "
+//			Number String:   "+ 123.456"
 //
-//		This object encapsulates error prefix text which
-//		is included in all returned error messages.
-//		Usually, it contains the name of the calling
-//		method or methods listed as a method or function
-//		chain of execution.
+//		Example-2: Leading Number Sign Symbols
+//			Leading Number Sign Symbols for Positive
+//			Values
 //
-//		If no error prefix information is needed, set this
-//		parameter to 'nil'.
+//			Leading Symbols: "+"
+//			Number String:   "+123.456"
 //
-//		This empty interface must be convertible to one of
-//		the following types:
+//		Example-3: Trailing Number Sign Symbols
+//			Trailing Number Sign Symbols for Positive
+//			Values
 //
-//		1.	nil
-//				A nil value is valid and generates an
-//				empty collection of error prefix and
-//				error context information.
+//			Trailing Symbols: " +"
+//			Number String:   "123.456 +"
 //
-//		2.	string
-//				A string containing error prefix
-//				information.
+//		Example-4: Trailing Number Sign Symbols
+//			Trailing Number Sign Symbols for Positive
+//			Values
 //
-//		3.	[]string
-//				A one-dimensional slice of strings
-//				containing error prefix information.
+//			Trailing Symbols: "+"
+//			Number String:   "123.456+"
 //
-//		4.	[][2]string
-//				A two-dimensional slice of strings
-//		   		containing error prefix and error
-//		   		context information.
+//	zeroNumberSign					NumStrNumberSymbolSpec
 //
-//		5.	ErrPrefixDto
-//				An instance of ErrPrefixDto.
-//				Information from this object will
-//				be copied for use in error and
-//				informational messages.
+//		The Number String Zero Number Sign
+//		Specification is used to configure number
+//		sign symbols for zero numeric values formatted
+//		and displayed in number stings. Zero number signs
+//		are commonly omitted because zero does not
+//		technically qualify as either a positive or
+//		negative value. However, the user has the option
+//		to configure number sign symbols for zero values
+//		if necessary.
 //
-//		6.	*ErrPrefixDto
-//				A pointer to an instance of
-//				ErrPrefixDto. Information from
-//				this object will be copied for use
-//				in error and informational messages.
+//		If this parameter is submitted as an empty or
+//		invalid Zero Number Sign Specification, it will
+//		be automatically converted to a 'NOP' or empty
+//		placeholder which will be ignored by Number
+//		String formatting algorithms. 'NOP' is a computer
+//		science term meaning 'No Operation'.
 //
-//		7.	IBasicErrorPrefix
-//				An interface to a method
-//				generating a two-dimensional slice
-//				of strings containing error prefix
-//				and error context information.
+//		Example-1: Leading Number Sign Symbols
+//			Leading Number Sign Symbols for Zero Values
 //
-//		If parameter 'errorPrefix' is NOT convertible
-//		to one of the valid types listed above, it will
-//		be considered invalid and trigger the return of
-//		an error.
+//			Leading Symbols: "+"
+//			Trailing Symbols: ""
+//			Number String:   "+0.00"
 //
-//		Types ErrPrefixDto and IBasicErrorPrefix are
-//		included in the 'errpref' software package:
-//			"github.com/MikeAustin71/errpref".
+//		Example-2: Leading Number Sign Symbols
+//			Leading Number Sign Symbols for Zero Values
 //
-// ----------------------------------------------------------------
+//			Leading Symbols: "+ "
+//			Trailing Symbols: ""
+//			Number String:   "+ 0.00"
 //
-// # Return Values
+//		Example-3: Trailing Number Sign Symbols
+//			Trailing Number Sign Symbols for Zero Values
 //
-//	pureNumStrComponents		PureNumberStrComponents
+//			Leading Symbols: ""
+//			Trailing Symbols: " +"
+//			Number String:   "0.00 +"
 //
-//		If this method completes successfully, this
-//		parameter will return an instance of
-//		PureNumberStrComponents. This data structure
-//		contains an analysis and profile information on
-//		the reconfigured instance of NumberStrKernel
-//		passed as input parameter 'numStrKernel'.
+//		Example-4: Trailing Number Sign Symbols
+//			Trailing Number Sign Symbols for Zero Values
 //
-//		type PureNumberStrComponents struct {
+//			Leading Symbols: ""
+//			Trailing Symbols: "+"
+//			Number String:   "0.00+"
 //
-//			NumStrStats NumberStrStatsDto
+//	currencySymbol					NumStrNumberSymbolSpec
 //
-//				This data transfer object will return key
-//				statistics on the numeric value encapsulated
-//				by the current instance of NumberStrKernel.
+//		A Currency Symbol positioned next to a numeric
+//		value designates the number as a monetary amount.
 //
-//				type NumberStrStatsDto struct {
+//		The Number String Currency Symbol Specification
+//		is used to configure currency symbols for
+//		positive, negative and zero numeric values
+//		formatted and displayed in number stings.
 //
-//				NumOfIntegerDigits					uint64
+//		If Currency Symbol formatting is NOT required,
+//		set this parameter to a 'NOP'. 'NOP' is a
+//		computer science term for 'No Operation'.
 //
-//					The total number of integer digits to the
-//					left of the radix point or, decimal point, in
-//					the subject numeric value.
+//			currencySymbol :=
+//				new(NumStrNumberSymbolSpec).NewNOP()
 //
-//				NumOfSignificantIntegerDigits		uint64
+//		If this parameter is submitted as an empty or
+//		invalid Currency Symbol Specification, it will
+//		be automatically converted to a 'NOP' or empty
+//		placeholder which will be ignored by Number
+//		String formatting algorithms. 'NOP' is a computer
+//		science term meaning 'No Operation'.
 //
-//					The number of nonzero integer digits to the
+//		Examples of Currency Symbols include the Dollar
+//		sign ('$'), Euro sign ('€') or Pound sign ('£').
+//
+//		This instance of NumStrNumberSymbolSpec is used
+//		to configure leading Currency Symbols, trailing
+//		Currency Symbols or both leading and trailing
+//		Currency Symbols.
+//
+//		Example-1: Leading Currency Symbols
+//
+//			Leading Currency Symbols: "$ "
+//			Number String:   "$ 123.456"
+//
+//		Example-2: Leading Currency Symbols
+//
+//			Leading Currency Symbols: "$"
+//			Number String:   "$123.456"
+//
+//		Example-3: Trailing Currency Symbols
+//			Trailing Currency Symbols for Positive Values
+//
+//			Trailing Currency Symbols: "€"
+//			Number String:   "123.456€"
+//
+//		Example-4: Trailing Currency Symbols
+//			Trailing Currency Symbols for Positive Values
+//
+//			Trailing Currency Symbols: " €"
+//			Number String:   "123.456 €"
+//
+//	numberFieldSpec				NumStrNumberFieldSpec
+//
+//		This Number Field Specification contains all
+//		parameters necessary to format a Number String
+//		within a larger Number Field. In addition to
+//		specifying the length of number field, this
+//		object contains justification specifications
+//		for centering, left justifying or right
+//		justifying a Number String within a Number
+//		Field.
+//
+//		type NumStrNumberFieldSpec struct {
+//
+//			fieldLength int
+//
+//				This parameter defines the length of the
+//				text field in which the numeric value will
+//				be displayed within a number string.
+//
+//				If 'fieldLength' is less than the length
+//				of the numeric value string, it will be
+//				automatically set equal to the length of
+//				that numeric value string.
+//
+//				To automatically set the value of
+//				'fieldLength' to the string length of the
+//				numeric value, set this parameter to a
+//				value of minus one (-1).
+//
+//				If this parameter is submitted with a
+//				value less than minus one (-1) or greater
+//				than 1-million (1,000,000), an error will
+//				be returned.
+//
+//			fieldJustification TextJustify
+//
+//				An enumeration which specifies the
+//				justification of the numeric value string
+//				within the number field length specified
+//				by data field 'fieldLength'.
+//
+//				Text justification can only be evaluated in
+//				the context of a number string, field length
+//				and a 'textJustification' object of type
+//				TextJustify. This is because number strings
+//				with a field length equal to or less than the
+//				length of the numeric value string never use
+//				text justification. In these cases, text
+//				justification is completely ignored.
+//
+//				If the field length parameter ('fieldLength')
+//				is greater than the length of the numeric
+//				value string, text justification must be equal
+//				to one of these three valid values:
+//
+//				          TextJustify(0).Left()
+//				          TextJustify(0).Right()
+//				          TextJustify(0).Center()
+//
+//				You can also use the abbreviated text
+//				justification enumeration syntax as follows:
+//
+//				          TxtJustify.Left()
+//				          TxtJustify.Right()
+//				          TxtJustify.Center()
+//		}
+//
+//	 errorPrefix                interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it	contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the returned
+//		error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error message.
+//	 	This returned error message will incorporate the method
+//	 	chain and text passed by input parameter, 'errorPrefix'.
+//	 	The 'errorPrefix' text will be attached to the beginning
+//	 	of the error message.
+func (numStrKernel *NumberStrKernel) SetDefaultNumStrFmtSpecElements(
+	decSeparatorSpec DecimalSeparatorSpec,
+	intSeparatorSpec IntegerSeparatorSpec,
+	negativeNumberSign NumStrNumberSymbolSpec,
+	positiveNumberSign NumStrNumberSymbolSpec,
+	zeroNumberSign NumStrNumberSymbolSpec,
+	currencySymbol NumStrNumberSymbolSpec,
+	numberFieldSpec NumStrNumberFieldSpec,
+	errorPrefix interface{}) error {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"SetDefaultNumStrFmtSpecElements()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return numStrKernel.numStrFormatSpec.
+		SetNumFmtElements(
+			decSeparatorSpec,
+			intSeparatorSpec,
+			negativeNumberSign,
+			positiveNumberSign,
+			zeroNumberSign,
+			currencySymbol,
+			numberFieldSpec,
+			ePrefix.XCpy(
+				"numStrKernel->"))
+}
+
+// SetDefaultNumStrFmtSpecPure
+//
+// Sets the default Number String Format Specification
+// for the current instance of to NumberStrKernel.
+//
+// The default Number String Format Specification is
+// used and applied in formatting the number string for
+// screen display, file output or printing.
+//
+// The internal member variable controlling default
+// number string formatting which will be modified
+// by this method is:
+//
+//	NumberStrKernel.numStrFormatSpec
+//
+// The default Number String Format Specification is
+// used and applied by the following methods:
+//
+//	NumberStrKernel.FmtNumStrDefaultRound()
+//	NumberStrKernel.String()
+//
+// ----------------------------------------------------------------
+//
+// # BE ADVISED
+//
+//	This method will NOT alter the numeric value
+//	configured for the current instance of
+//	NumberStrKernel. This method will only reconfigure
+//	the internal member variable controlling default
+//	number string formatting:
+//
+//		NumberStrKernel.numStrFormatSpec
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	decSeparatorChars			string
+//
+//		This string contains the character or characters
+//		which will be configured as the Decimal Separator
+//		Symbol or Symbols for the returned number string.
+//
+//		The decimal separator is also known as the radix
+//		point and is used to separate integer and
+//		fractional digits within a formatted floating
+//		point number string.
+//
+//		In the US, UK, Australia and most of Canada, the
+//		decimal separator is the period character ('.')
+//		also known as the decimal point.
+//
+//		In France, Germany and many countries in the
+//		European Union, the Decimal Separator is the
+//		comma character (',').
+//
+//	leadingNumSymbols			bool
+//
+//		In Pure Number Strings, positive numeric values
+//		are NOT configured with leading or trailing plus
+//		signs ('+'). Negative values on the other hand,
+//		are always designated by leading or trailing
+//		minus sign ('-').
+//
+//		This parameter, 'leadingNumSymbols', controls
+//		the positioning of minus signs for negative
+//		numeric values within a	Number String.
+//
+//		When set to 'true', the returned number string
+//		will configure minus signs for negative numbers
+//		at the beginning of, or on the left side of, the
+//		numeric value. In these cases, the minus sign is
+//		said to be configured as a leading minus sign.
+//		This is the positioning format used in the US,
+//		UK, Australia and most of Canada. In addition,
+//		library functions in 'Go' and other programming
+//		languages generally expect leading minus signs
+//		for negative numbers.
+//
+//			Example Leading Minus Sign:
+//				"-123.456"
+//
+//		When parameter 'leadingNumSymbols' is set to
+//		'false', the returned number string will
+//		configure minus signs for negative numbers at the
+//		end of, or on the right side of, the numeric
+//		value. With this positioning format, the minus
+//		sign is said to be configured as a trailing minus
+//		sign. This is the positioning format used in
+//		France, Germany and many countries in the
+//		European Union.
+//
+//			Example Trailing Minus Sign:
+//				"123.456-"
+//
+//	numFieldLength					int
+//
+//		This parameter defines the length of the text
+//		field in which the numeric value will be
+//		displayed within a number string.
+//
+//		If 'numFieldLength' is less than the length of the
+//		numeric value string, it will be automatically set
+//		equal to the length of that numeric value string.
+//
+//		To automatically set the value of fieldLength to
+//		the string length of the numeric value, set this
+//		parameter to a value of minus one (-1).
+//
+//		If this parameter is submitted with a value less
+//		than minus one (-1) or greater than 1-million
+//		(1,000,000), an error will be returned.
+//
+//	numFieldJustification		TextJustify
+//
+//		An enumeration which specifies the justification
+//		of the numeric value within the number field
+//		length specified by input parameter
+//		'numFieldLength'.
+//
+//		Text justification can only be evaluated in the
+//		context of a number string, field length and a
+//		'textJustification' object of type TextJustify.
+//		This is because number strings with a field length
+//		equal to or less than the length of the numeric
+//		value string never use text justification. In
+//		these cases, text justification is completely
+//		ignored.
+//
+//		If the field length parameter ('numFieldLength')
+//		is greater than the length of the numeric value
+//		string, text justification must be equal to one
+//		of these three valid values:
+//
+//			TextJustify(0).Left()
+//			TextJustify(0).Right()
+//			TextJustify(0).Center()
+//
+//		You can also use the abbreviated text justification
+//		enumeration syntax as follows:
+//
+//			TxtJustify.Left()
+//			TxtJustify.Right()
+//			TxtJustify.Center()
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (numStrKernel *NumberStrKernel) SetDefaultNumStrFmtSpecPure(
+	decSeparatorChars string,
+	leadingNumSymbols bool,
+	numFieldLength int,
+	numFieldJustification TextJustify,
+	errorPrefix interface{}) error {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"SetDefaultNumStrFmtSpecPure()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	numStrKernel.numStrFormatSpec.Empty()
+
+	numStrKernel.numStrFormatSpec,
+		err = new(NumStrFormatSpec).NewSignedPureNumberStr(
+		decSeparatorChars,
+		leadingNumSymbols,
+		numFieldLength,
+		numFieldJustification,
+		ePrefix.XCpy(
+			"numStrKernel.numStrFormatSpec<-"))
+
+	return err
+}
+
+//	SetDefaultSignedNumStrFmtSpecBasic
+//
+//	Reconfigures the current instance of
+//	NumberStrKernel with a new default Signed Number
+//	String Format using the basic set of input parameters.
+//
+//	This Number String Format is designed to format
+//	'Signed' integer or floating point numeric values.
+//
+//	A signed number is an integer or floating point
+//	numeric value which does NOT contain currency
+//	symbols.
+//
+//	This method is one step above NewSignedNumSimple()
+//	in terms of complexity and customization options.
+//
+//	This method provides additional options for multiple
+//	integer grouping types including India and Chinese
+//	Numbering.
+//
+//	Negative number sign symbols may be configured as
+//	leading negative number signs, trailing negative
+//	number signs or both. The combination of leading
+//	and trailing negative number signs allows for the
+//	configuration symbols like parentheses for the
+//	formatting of negative numbers.
+//
+//		Example: (125.34)
+//
+// ----------------------------------------------------------------
+//
+// # BE ADVISED
+//
+//	This method will NOT alter the numeric value
+//	configured for the current instance of
+//	NumberStrKernel. This method will only reconfigure
+//	the internal member variable controlling default
+//	number string formatting:
+//
+//		NumberStrKernel.numStrFormatSpec
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	decSeparatorChars				string
+//
+//		This string contains the character or characters
+//		which will be configured as the Decimal Separator
+//		Symbol or Symbols for the returned instance of
+//		NumStrFormatSpec.
+//
+//		The decimal separator is also known as the radix
+//		point and is used to separate integer and fractional
+//		digits within a formatted Number String.
+//
+//		In the US, UK, Australia and most of Canada, the
+//		decimal separator is the period character ('.')
+//		known as the decimal point.
+//
+//		In France, Germany and many countries in the
+//		European Union, the Decimal Separator is the
+//		comma character (',').
+//
+//	intSeparatorChars				string
+//
+//		One or more characters used to separate groups of
+//		integers. This separator is also known as the
+//		'thousands' separator. It is used to separate
+//		groups of integer digits to the left of the
+//		decimal separator (a.k.a. decimal point). In the
+//		United States, the standard integer digits
+//		separator is the comma (",").
+//
+//			United States Example:  1,000,000,000
+//
+//		In many European countries, a single period ('.')
+//		is used as the integer separator character.
+//
+//			European Example: 1.000.000.000
+//
+//		Other countries and cultures use spaces,
+//		apostrophes or multiple characters to separate
+//		integers.
+//
+//		If this input parameter contains a zero length
+//		string and parameter 'intGroupingType' is NOT
+//		equal to 'IntGroupingType.None()', an error will
+//		be returned.
+//
+//	intGroupingType					IntegerGroupingType
+//
+//		This instance of IntegerGroupingType defines the type
+//		of IntegerSeparatorSpec which will be returned. The
+//		enumeration IntegerGroupingType must be set to one
+//		of the following values:
+//
+//			IntGroupingType.None()
+//			IntGroupingType.Thousands()
+//			IntGroupingType.IndiaNumbering()
+//			IntGroupingType.ChineseNumbering()
+//
+//		Note:	Setting 'intGroupingType' to a value of
+//				IntGroupingType.None() effectively turns
+//				off integer separation.
+//
+//		Examples:
+//
+//			IntGroupingType.None()
+//				'1000000000'
+//
+//			IntGroupingType.Thousands()
+//					'1,000,000,000'
+//
+//			IntGroupingType.IndiaNumbering()
+//				'6,78,90,00,00,00,00,000'
+//
+//			IntGroupingType.ChineseNumbering()
+//				'6,7890,0000,0000,0000'
+//
+//	leadingNegativeNumSign			string
+//
+//		A string containing the leading negative number
+//		sign character or characters used to configure
+//		Negative Number Sign Symbols in a number string
+//		with a negative numeric value.
+//
+//		The most common configuration for a leading
+//		negative number sign would be a leading minus
+//		sign ('-').
+//
+//		Another option is to configure a single
+//		parenthesis ("(") to be matched by a trailing
+//		negative number sign with the closing parenthesis
+//		(")"). This combination would effectively enclose
+//		negative numbers in parentheses.
+//			Example "(125.67)"
+//
+//	trailingNegativeNumSign			string
+//
+//		A string containing the trailing negative number
+//		sign character or characters used to configure
+//		Negative Number Sign Symbols in a number string
+//		with a negative numeric value.
+//
+//		The most common configuration for a trailing
+//		negative number sign would be a trailing minus
+//		sign ('-').
+//
+//		Another option is to configure a single
+//		closing parenthesis (")") to be matched by a
+//		leading negative number sign with the opening
+//		parenthesis ("("). This combination would
+//		effectively enclose negative numbers in
+//		parentheses.
+//			Example "(125.67)"
+//
+//	fieldLength						int
+//
+//		This parameter defines the length of the text
+//		field in which the numeric value will be
+//		displayed within a number string.
+//
+//		If 'fieldLength' is less than the length of
+//		the numeric value string, it will be
+//		automatically set equal to the length of that
+//		numeric value string.
+//
+//		To automatically set the value of 'fieldLength'
+//		to the string length of the numeric value, set
+//		this parameter to a value of minus one (-1).
+//
+//		If this parameter is submitted with a value less
+//		than minus one (-1) or greater than 1-million
+//		(1,000,000), an error will be returned.
+//
+//		Field Length Examples
+//
+//			Example-1
+//	         FieldContents String = "1234.5678"
+//				FieldContents String Length = 9
+//				fieldLength = 15
+//				fieldJustification = TxtJustify.Center()
+//				Text Field String =
+//					"   1234.5678   "
+//
+//			Example-2
+//	         FieldContents = "1234.5678"
+//				FieldContents String Length = 9
+//				fieldLength = 15
+//				fieldJustification = TxtJustify.Right()
+//				Text Field String =
+//					"      1234.5678"
+//
+//			Example-3
+//	         FieldContents = "1234.5678"
+//				FieldContents String Length = 9
+//				fieldLength = -1
+//				fieldJustification = TxtJustify.Center()
+//					// Justification Ignored. Field Length
+//					// Equals -1
+//				Text Field String =
+//					"1234.5678"
+//
+//			Example-4
+//	         FieldContents = "1234.5678"
+//				FieldContents String Length = 9
+//				fieldLength = 2
+//				fieldJustification = TxtJustify.Center()
+//					// Ignored, because FieldLength Less
+//					// Than FieldContents String Length.
+//				Text Field String =
+//					"1234.5678"
+//
+//	fieldJustification			TextJustify
+//
+//		An enumeration which specifies the justification
+//		of the numeric value within the number field
+//		length specified by input parameter
+//		'fieldLength'.
+//
+//		Text justification can only be evaluated in the
+//		context of a number string, field length and a
+//		'fieldJustification' object of type TextJustify.
+//		This is because number strings with a field
+//		length equal to or less than the length of the
+//		numeric value string never use text
+//		justification. In these cases, text justification
+//		is completely ignored.
+//
+//		If the field length parameter ('fieldLength')
+//		is greater than the length of the numeric value
+//		string, text justification must be equal to one
+//		of these three valid values:
+//
+//			TextJustify(0).Left()
+//			TextJustify(0).Right()
+//			TextJustify(0).Center()
+//
+//		You can also use the abbreviated text justification
+//		enumeration syntax as follows:
+//
+//			TxtJustify.Left()
+//			TxtJustify.Right()
+//			TxtJustify.Center()
+//
+//		Text Justification Examples
+//
+//			Example-1
+//	         FieldContents String = "1234.5678"
+//				FieldContents String Length = 9
+//				fieldLength = 15
+//				fieldJustification = TxtJustify.Center()
+//				Text Field String =
+//					"   1234.5678   "
+//
+//			Example-2
+//	         FieldContents = "1234.5678"
+//				FieldContents String Length = 9
+//				fieldLength = 15
+//				fieldJustification = TxtJustify.Right()
+//				Text Field String =
+//					"      1234.5678"
+//
+//			Example-3
+//	         FieldContents = "1234.5678"
+//				FieldContents String Length = 9
+//				fieldLength = -1
+//				fieldJustification = TxtJustify.Center()
+//					// Justification Ignored. Field Length
+//					// Equals -1
+//				Text Field String =
+//					"1234.5678"
+//
+//			Example-4
+//	         FieldContents = "1234.5678"
+//				FieldContents String Length = 9
+//				fieldLength = 2
+//				fieldJustification = TxtJustify.Center()
+//					// Ignored, because FieldLength Less
+//					// Than FieldContents String Length.
+//				Text Field String =
+//					"1234.5678"
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (numStrKernel *NumberStrKernel) SetDefaultSignedNumStrFmtSpecBasic(
+	decSeparatorChars string,
+	intSeparatorChars string,
+	intGroupingType IntegerGroupingType,
+	leadingNegativeNumSign string,
+	trailingNegativeNumSign string,
+	fieldLength int,
+	fieldJustification TextJustify,
+	errorPrefix interface{}) error {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"SetDefaultSignedNumStrFmtSpecBasic()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	numStrKernel.numStrFormatSpec.Empty()
+
+	numStrKernel.numStrFormatSpec,
+		err = new(NumStrFormatSpec).NewSignedNumBasic(
+		decSeparatorChars,
+		intSeparatorChars,
+		intGroupingType,
+		leadingNegativeNumSign,
+		trailingNegativeNumSign,
+		fieldLength,
+		fieldJustification,
+		ePrefix.XCpy(
+			"<-numStrKernel.numStrFormatSpec"))
+
+	return err
+}
+
+// SetDefaultSignedNumStrFmtSpecSimple
+//
+// Sets the default Number String Format Specification
+// for the current instance of to NumberStrKernel.
+//
+// The default Number String Format Specification is
+// used and applied in formatting the number string for
+// screen displays, file output or printing.
+//
+// The internal member variable controlling default
+// number string formatting which will be modified
+// by this method is:
+//
+//	NumberStrKernel.numStrFormatSpec
+//
+// The default Number String Format Specification is
+// used and applied by the following methods:
+//
+//	NumberStrKernel.FmtNumStrDefaultRound()
+//	NumberStrKernel.String()
+//
+// ----------------------------------------------------------------
+//
+// # BE ADVISED
+//
+//	This method will NOT alter the numeric value
+//	configured for the current instance of
+//	NumberStrKernel. This method will only reconfigure
+//	the internal member variable controlling default
+//	number string formatting:
+//
+//		NumberStrKernel.numStrFormatSpec
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	decSeparatorChars			string
+//
+//		This string contains the character or characters
+//		which will be configured as the Decimal Separator
+//		Symbol or Symbols for the returned number string.
+//
+//		The decimal separator is also known as the radix
+//		point and is used to separate integer and
+//		fractional digits within a formatted floating
+//		point number string.
+//
+//		In the US, UK, Australia and most of Canada, the
+//		decimal separator is the period character ('.')
+//		also known as the decimal point.
+//
+//		In France, Germany and many countries in the
+//		European Union, the Decimal Separator is the
+//		comma character (',').
+//
+//	intSeparatorChars			string
+//
+//		One or more characters used to separate groups of
+//		integers. This separator is also known as the
+//		'thousands' separator. It is used to separate
+//		groups of integer digits to the left of the
+//		decimal separator (a.k.a. decimal point). In the
+//		United States, the standard integer digits
+//		separator is the comma (",").
+//
+//			United States Example:  1,000,000,000
+//
+//		In many European countries, a single period ('.')
+//		is used as the integer separator character.
+//
+//			European Example: 1.000.000.000
+//
+//		Other countries and cultures use spaces,
+//		apostrophes or multiple characters to separate
+//		integers.
+//
+//		If this input parameter contains a zero length
+//		string, no error will be returned and integer
+//		separation will be turned off. As a result,
+//		integer digits will be displayed as a single
+//		string of numeric digits:
+//
+//			Integer Separation Turned Off: 1000000000
+//
+//	leadingNumSymbols			bool
+//
+//		In Simple Number Strings, positive numeric values
+//		are NOT configured with leading or trailing plus
+//		signs ('+'). Negative values on the other hand,
+//		are always designated by leading or trailing
+//		minus sign ('-').
+//
+//		This parameter, 'leadingNumSymbols', controls
+//		the positioning of minus signs for negative
+//		numeric values within a	Number String.
+//
+//		When set to 'true', the returned number string
+//		will configure minus signs for negative numbers
+//		at the beginning of, or on the left side of, the
+//		numeric value. In these cases, the minus sign is
+//		said to be configured as a leading minus sign.
+//		This is the positioning format used in the US,
+//		UK, Australia and most of Canada. In addition,
+//		library functions in 'Go' and other programming
+//		languages generally expect leading minus signs
+//		for negative numbers.
+//
+//			Example Leading Minus Sign:
+//				"-123.456"
+//
+//		When parameter 'leadingNumSymbols' is set to
+//		'false', the returned number string will
+//		configure minus signs for negative numbers at the
+//		end of, or on the right side of, the numeric
+//		value. With this positioning format, the minus
+//		sign is said to be configured as a trailing minus
+//		sign. This is the positioning format used in
+//		France, Germany and many countries in the
+//		European Union.
+//
+//			Example Trailing Minus Sign:
+//				"123.456-"
+//
+//	numFieldLength					int
+//
+//		This parameter defines the length of the text
+//		field in which the numeric value will be
+//		displayed within a number string.
+//
+//		If 'numFieldLength' is less than the length of the
+//		numeric value string, it will be automatically set
+//		equal to the length of that numeric value string.
+//
+//		To automatically set the value of fieldLength to
+//		the string length of the numeric value, set this
+//		parameter to a value of minus one (-1).
+//
+//		If this parameter is submitted with a value less
+//		than minus one (-1) or greater than 1-million
+//		(1,000,000), an error will be returned.
+//
+//	numFieldJustification		TextJustify
+//
+//		An enumeration which specifies the justification
+//		of the numeric value within the number field
+//		length specified by input parameter
+//		'numFieldLength'.
+//
+//		Text justification can only be evaluated in the
+//		context of a number string, field length and a
+//		'textJustification' object of type TextJustify.
+//		This is because number strings with a field length
+//		equal to or less than the length of the numeric
+//		value string never use text justification. In
+//		these cases, text justification is completely
+//		ignored.
+//
+//		If the field length parameter ('numFieldLength')
+//		is greater than the length of the numeric value
+//		string, text justification must be equal to one
+//		of these three valid values:
+//
+//			TextJustify(0).Left()
+//			TextJustify(0).Right()
+//			TextJustify(0).Center()
+//
+//		You can also use the abbreviated text justification
+//		enumeration syntax as follows:
+//
+//			TxtJustify.Left()
+//			TxtJustify.Right()
+//			TxtJustify.Center()
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (numStrKernel *NumberStrKernel) SetDefaultSignedNumStrFmtSpecSimple(
+	decSeparatorChars string,
+	intSeparatorChars string,
+	leadingNumSymbols bool,
+	numFieldLength int,
+	numFieldJustification TextJustify,
+	errorPrefix interface{}) error {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"SetDefaultSignedNumStrFmtSpecSimple()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	numStrKernel.numStrFormatSpec.Empty()
+
+	numStrKernel.numStrFormatSpec,
+		err = new(NumStrFormatSpec).NewSignedNumSimple(
+		decSeparatorChars,
+		intSeparatorChars,
+		leadingNumSymbols,
+		numFieldLength,
+		numFieldJustification,
+		ePrefix.XCpy(
+			"numStrKernel.numStrFormatSpec<-"))
+
+	return err
+}
+
+// SetFromBigRat
+//
+// Deletes and resets the internal values for the
+// current instance of NumberStrKernel using a numeric
+// value passed as a pointer to a big.Rat number
+// (*big.Rat).
+//
+// The big.Rat numeric value passed through input
+// paramter 'roundToFractionalDigits' will be used
+// round that numeric value before it is converted
+// and used to configure the current instance of
+// NumberStrKernel.
+//
+// ----------------------------------------------------------------
+//
+// # BE ADVISED
+//
+//	(1)	big.Rat Rounding Algorithm
+//		Before being converted to an instance of
+//		NumberStrKernel, the big.Rat numeric value will
+//		be rounded by the Golang 'big' package function:
+//
+//			(*Rat) FloatString
+//
+//		The rounding algorithm used is described in the
+//		big.Rat documentation as:
+//
+//			The last digit is rounded to nearest, with
+//			halves rounded away from zero.
+//				https://pkg.go.dev/math/big#Rat
+//
+//	(2)	Number of fractional digits
+//		During the rounding operation, the number of
+//		fractional digits to the right of the radix point
+//		(decimal point) will be controlled by input
+//		paramter 'roundToFractionalDigits'.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	This method will delete and reconfigure all
+//	pre-existing data values contained in the current
+//	instance of NumberStrKernel.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	bigRatNum					*big.Rat
+//		A pointer to an instance of a big.Rat numeric
+//		value. This numeric value will be used to
+//		reconfigure the current instance of
+//		NumberStrKernel.
+//
+//	roundToFractionalDigits		int
+//
+//		When set to a positive integer value, this
+//		parameter controls the number of digits to the
+//		right of the radix point or decimal separator
+//		(a.k.a. decimal point). Effectively this defines
+//		the number of fractional digits remaining after
+//		completion of the number rounding operation
+//		performed by the Golang package function:
+//
+//			func (*Rat) FloatString
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the returned
+//		error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error message.
+//	 	This returned error message will incorporate the method
+//	 	chain and text passed by input parameter, 'errorPrefix'.
+//	 	The 'errorPrefix' text will be attached to the beginning
+//	 	of the error message.
+func (numStrKernel *NumberStrKernel) SetFromBigRat(
+	bigRatNum *big.Rat,
+	roundToFractionalDigits int,
+	errorPrefix interface{}) error {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"SetFromBigRat()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	var nativeNumStr string
+
+	nativeNumStr,
+		err = new(MathBigRatHelper).
+		BigRatToNativeNumStr(
+			bigRatNum,
+			roundToFractionalDigits,
+			ePrefix)
+
+	if err != nil {
+
+		return err
+	}
+
+	err = new(numberStrKernelQuark).
+		setNumStrKernelFromNativeNumStr(
+			numStrKernel,
+			nativeNumStr,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	return err
+}
+
+// SetFromDirtyNumberStr
+//
+// Deletes and resets the internal values for the
+// current instance of NumberStrKernel using a numeric
+// value extracted and calculated from a Dirty Number
+// String passed as input parameter 'dirtyNumberStr'.
+//
+// A "Dirty Number String" is a malformed number string
+// containing numeric digits which will be converted to a
+// properly formatted Native Number String and used to
+// generate a numeric value used in reconfiguring the
+// current instance of NumberStrKernel.
+//
+//	Examples Of Dirty Number Strings
+//
+//		$1,254.65
+//		1 000 000,00 €
+//		1.000.000,00 €
+//		6,78,90,00,00,00,00,000
+//		6,7890,0000,0000,0000
+//
+// All the examples shown above are valid number string
+// formats used by different countries and cultures.
+// There is nothing wrong with these formats. The term
+// "Dirty" simply distinguishes these formats from the
+// Native Number String format required by many
+// functions and packages in the Go Programming
+// Language. The Native Number String format is one
+// which is used as a standard format for numeric
+// conversions performed in the Go Programming Language
+// as well as many other programming languages.
+//
+// The Dirty Number String passed as input parameter
+// 'dirtyNumberStr' is expected to comply with the
+// following requirements:
+//
+//  1. The dirty number string must contain numeric
+//     digit characters zero to nine inclusive (0-9).
+//
+//  2. The dirty number string must contain a radix
+//     point or decimal separator to separate
+//     integer and fractional digits in a floating
+//     point numeric value. This decimal separator
+//     is specified by input parameter,
+//     'decimalSeparator'.
+//
+//     If no decimal separator is identified in the
+//     dirty number string, the numeric value is
+//     assumed to be an integer value.
+//
+//  3. The dirty number string must designate
+//     negative numeric values using one of the
+//     following three negative number symbols:
+//
+//     (a)	A Leading Minus Sign ('-').
+//     Example: -123.45
+//
+//     (b)	A Trailing Minus Sign ('-').
+//     Example: 123.45-
+//
+//     (c) A combination of leading and trailing
+//     Parentheses ('()').
+//     Example: (123.45)
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	This method will delete and overwrite all pre-existing
+//	data values in the current instance of NumberStrKernel.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	dirtyNumberStr				string
+//
+//		This number string contains the numeric digits
+//		which will be extracted, converted to a valid
+//		numeric value and used to configure the
+//		returned instance of NumberStrKernel.
+//
+//		The 'dirtyNumberStr' is expected to comply with
+//		the following requirements:
+//
+//		1.	The dirty number string must contain numeric
+//			digit characters zero to nine inclusive (0-9).
+//
+//		2.	The dirty number string must contain a radix
+//			point or decimal separator to separate
+//			integer and fractional digits in a floating
+//			point numeric value. This decimal separator
+//			is specified by input parameter,
+//			'decimalSeparator'.
+//
+//			If no decimal separator is identified in the
+//			dirty number string, the numeric value is
+//			assumed to be an integer value.
+//
+//		3.	The dirty number string must designate
+//			negative numeric values using one of the
+//			following three negative number symbols:
+//
+//			(a)	A Leading Minus Sign ('-').
+//				Example: -123.45
+//
+//			(b)	A Trailing Minus Sign ('-').
+//				Example: 123.45-
+//
+//			(c) A combination of leading and trailing
+//				Parentheses ('()').
+//				Example: (123.45)
+//
+//		If 'dirtyNumberStr' does not contain any numeric
+//		digits, an error will be returned.
+//
+//	decimalSeparator			string
+//
+//		Type DecimalSeparatorSpec is used to specify the
+//		radix point or decimal separator which will
+//		separate integer and fractional digits in the
+//		dirty number string passed as input parameter
+//		'dirtyNumberStr'.
+//
+//		The decimal separator will typically consist of
+//		one or more non-numeric characters.
+//
+//		If 'decimalSeparator' consists of an empty
+//		or zero length sting, it is assumed that the
+//		numeric value contained in input parameter
+//		'dirtyNumberStr' is an integer value.
+//
+//		In the US, Australia, UK, most of Canada and many
+//		other countries the period ('.'), or decimal
+//		point, separates integer and fractional digits
+//		within a floating point numeric value.
+//
+//		Other countries, including many in the European
+//		Union, use the comma (',') to separate integer
+//		and fractional digits within a number string.
+//
+//		If 'decimalSeparator' contains any one of the
+//		following invalid characters, an error will be
+//		returned.
+//
+//			Invalid Decimal Separator Characters
+//							'-'
+//							'('
+//							')'
+//
+//	roundingType				NumberRoundingType
+//
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied for
+//		the	rounding of fractional digits contained in the
+//		reconfigured current instance of NumberStrKernel.
+//
+//		If in doubt as to a suitable rounding method,
+//		'HalfAwayFromZero' is recommended.
+//
+//		Possible values are listed as follows:
+//			NumRoundType.None()	- Invalid Value
+//			NumRoundType.NoRounding()
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
+//
+//		Definitions:
+//
+//			NoRounding
+//
+//				Signals that no rounding operation will be
+//				performed on fractional digits. The
+//				fractional digits will therefore remain
+//				unchanged.
+//
+//			HalfUpWithNegNums
+//
+//				Half Round Up Including Negative Numbers.
+//				This method is intuitive but may produce
+//				unexpected results when applied to negative
+//				numbers.
+//
+//				'HalfUpWithNegNums' rounds .5 up.
+//
+//					Examples of 'HalfUpWithNegNums'
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8
+//					7.4 rounds down to 7
+//					-7.4 rounds up to -7
+//					-7.5 rounds up to -7
+//					-7.6 rounds down to -8
+//
+//			HalfDownWithNegNums
+//
+//			Half Round Down Including Negative Numbers. This
+//			method is also considered intuitive but may
+//			produce unexpected results when applied to
+//			negative numbers.
+//
+//			'HalfDownWithNegNums' rounds .5 down.
+//
+//				Examples of HalfDownWithNegNums
+//
+//				7.6 rounds up to 8
+//				7.5 rounds down to 7
+//				7.4 rounds down to 7
+//				-7.4 rounds up to -7
+//				-7.5 rounds down to -8
+//				-7.6 rounds down to -8
+//
+//			HalfAwayFromZero
+//
+//				The 'HalfAwayFromZero' method rounds .5 further
+//				away from zero.	It provides clear and consistent
+//				behavior when dealing with negative numbers.
+//
+//					Examples of HalfAwayFromZero
+//
+//					7.6 rounds away to 8
+//					7.5 rounds away to 8
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds away to -8
+//					-7.6 rounds away to -8
+//
+//			HalfTowardsZero
+//
+//				Round Half Towards Zero. 'HalfTowardsZero' rounds
+//				0.5	closer to zero. It provides clear and
+//				consistent behavior	when dealing with negative
+//				numbers.
+//
+//					Examples of HalfTowardsZero
+//
+//					7.6 rounds away to 8
+//					7.5 rounds to 7
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds to -7
+//					-7.6 rounds away to -8
+//
+//			HalfToEven
+//
+//				Round Half To Even Numbers. 'HalfToEven' is
+//				also called	Banker's Rounding. This method
+//				rounds 0.5 to the nearest even digit.
+//
+//					Examples of HalfToEven
+//
+//					7.5 rounds up to 8 (because 8 is an even
+//					number)	but 6.5 rounds down to 6 (because
+//					6 is an even number)
+//
+//					HalfToEven only applies to 0.5. Other
+//					numbers (not ending	in 0.5) round to
+//					nearest as usual, so:
+//
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8 (because 8 is an even number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds down to 6 (because 6 is an even number)
+//					6.4 rounds down to 6
+//
+//			HalfToOdd
+//
+//				Round Half to Odd Numbers. Similar to 'HalfToEven',
+//				but in this case 'HalfToOdd' rounds 0.5 towards odd
+//				numbers.
+//
+//					Examples of HalfToOdd
+//
+//					HalfToOdd only applies to 0.5. Other numbers
+//					(not ending	in 0.5) round to nearest as usual.
+//
+//					7.5 rounds down to 7 (because 7 is an odd number)
+//
+//					6.5 rounds up to 7 (because 7 is an odd number)
+//
+//					7.6 rounds up to 8
+//					7.5 rounds down to 7 (because 7 is an odd number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds up to 7 (because 7 is an odd number)
+//					6.4 rounds down to 6
+//
+//			Randomly
+//
+//				Round Half Randomly. Uses a Random Number Generator
+//				to choose between rounding 0.5 up or down.
+//
+//				All numbers other than 0.5 round to the nearest as
+//				usual.
+//
+//			Floor
+//
+//				Yields the nearest integer down. Floor does not apply
+//				any	special treatment to 0.5.
+//
+//				Floor Function: The greatest integer that is less than
+//				or equal to x
+//
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//
+//				In mathematics and computer science, the floor function
+//				is the function that takes as input a real number x,
+//				and gives as output the greatest integer less than or
+//				equal to x,	denoted floor(x) or ⌊x⌋.
+//
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//
+//				Examples of Floor
+//
+//					Number     Floor
+//					 2           2
+//					 2.4         2
+//					 2.9         2
+//					-2.5        -3
+//					-2.7        -3
+//					-2          -2
+//
+//			Ceiling
+//
+//				Yields the nearest integer up. Ceiling does not
+//				apply any special treatment to 0.5.
+//
+//				Ceiling Function: The least integer that is
+//				greater than or	equal to x.
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//
+//				The ceiling function maps x to the least integer
+//				greater than or equal to x, denoted ceil(x) or
+//				⌈x⌉.[1]
+//
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//
+//					Examples of Ceiling
+//
+//						Number    Ceiling
+//						 2           2
+//						 2.4         3
+//						 2.9         3
+//						-2.5        -2
+//						-2.7        -2
+//						-2          -2
+//
+//			Truncate
+//
+//				Apply NO Rounding whatsoever. The Round From Digit
+//				is dropped or deleted. The Round To Digit is NEVER
+//				changed.
+//
+//				Examples of Truncate
+//
+//					Example-1
+//					Number: 23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:   23.14 - The Round From Digit
+//					is dropped.
+//
+//					Example-2
+//					Number: -23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:  -23.14 - The Round From Digit
+//					is dropped.
+//
+//	roundToFractionalDigits		int
+//
+//		When set to a positive integer value, this
+//		parameter controls the number of digits to the
+//		right of the radix point or decimal separator
+//		(a.k.a. decimal point). This controls the number
+//		of fractional digits remaining after completion
+//		of the number rounding operation.
+//
+//		If input parameter 'roundingType' is set to
+//		NumRoundType.NoRounding(),
+//		'roundToFractionalDigits' is ignored and no
+//		rounding operation is performed.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	numStrStatsDto				NumberStrStatsDto
+//
+//		If this method completes successfully, an
+//		instance of NumberStrStatsDto will be returned
+//		containing a profile and key statistics on the
+//		reconfigured numeric value encapsulated in the
+//		current instance of NumberStrKernel.
+//
+//		type NumberStrStatsDto struct {
+//
+//		NumOfIntegerDigits					uint64
+//
+//			The total number of integer digits to the
+//			left of the radix point or, decimal point, in
+//			the subject numeric value.
+//
+//		NumOfSignificantIntegerDigits		uint64
+//
+//			The number of nonzero integer digits to the
+//			left of the radix point or, decimal point, in
+//			the subject numeric value.
+//
+//		NumOfFractionalDigits				uint64
+//
+//			The total number of fractional digits to the
+//			right of the radix point or, decimal point,
+//			in the subject numeric value.
+//
+//		NumOfSignificantFractionalDigits	uint64
+//
+//			The number of nonzero fractional digits to
+//			the right of the radix point or, decimal
+//			point, in the subject numeric value.
+//
+//		NumberValueType 					NumericValueType
+//
+//			This enumeration value specifies whether the
+//			subject numeric value is classified either as
+//			an integer or a floating point number.
+//
+//			Possible enumeration values are listed as
+//			follows:
+//				NumValType.None()
+//				NumValType.FloatingPoint()
+//				NumValType.Integer()
+//
+//		NumberSign							NumericSignValueType
+//
+//			An enumeration specifying the number sign
+//			associated with the numeric value. Possible
+//			values are listed as follows:
+//				NumSignVal.None()		= Invalid Value
+//				NumSignVal.Negative()	= -1
+//				NumSignVal.Zero()		=  0
+//				NumSignVal.Positive()	=  1
+//
+//		IsZeroValue							bool
+//
+//			If 'true', the subject numeric value is equal
+//			to zero ('0').
+//
+//			If 'false', the subject numeric value is
+//			greater than or less than zero ('0').
+//		}
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) SetFromDirtyNumberStr(
+	dirtyNumberStr string,
+	decimalSeparator string,
+	roundingType NumberRoundingType,
+	roundToFractionalDigits int,
+	errorPrefix interface{}) (
+	numStrStatsDto NumberStrStatsDto,
+	err error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"SetFromDirtyNumberStr()",
+		"")
+
+	if err != nil {
+		return numStrStatsDto, err
+	}
+
+	numStrStatsDto,
+		err = new(numberStrKernelMechanics).
+		setNumStrKernelFromRoundedDirtyNumStr(
+			numStrKernel,
+			dirtyNumberStr,
+			decimalSeparator,
+			roundingType,
+			roundToFractionalDigits,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	return numStrStatsDto,
+		err
+}
+
+// SetFromNativeNumberStr
+//
+// Deletes and resets the internal values for the
+// current instance of NumberStrKernel using a numeric
+// value extracted and calculated from a Native Number
+// String passed as input parameter 'nativeNumStr'.
+//
+// The term 'Native Number String' means that the number
+// string format is designed to interoperate with the
+// Golang programming language library functions and
+// packages. Types like 'strconv', 'strings', 'math'
+// and 'big' (big.Int, big.Float, big.Rat) routinely
+// parse and convert this type of number string to
+// numeric values. In addition, Native Number Strings are
+// frequently consumed by external library functions such
+// as this one (String Mechanics 'strmech') to convert
+// strings to numeric values and numeric values to
+// strings.
+//
+// While this format is inconsistent with many national
+// and cultural formatting conventions, number strings
+// which fail to implement this standardized formatting
+// protocol will generate errors in some Golang library
+// functions.
+//
+//	Examples Of Native Number Strings
+//		1000000
+//		12.5483
+//		-1000000
+//		-12.5483
+//
+// A valid Native Number String must conform to the
+// standardized formatting criteria defined below:
+//
+//  1. A Native Number String Consists of numeric
+//     character digits zero through nine inclusive
+//     (0-9).
+//
+//  2. A Native Number String will include a period
+//     or decimal point ('.') to separate integer and
+//     fractional digits within a number string.
+//
+//     Native Number String Floating Point Value:
+//     123.1234
+//
+//  3. A Native Number String will always format
+//     negative numeric values with a leading minus sign
+//     ('-').
+//
+//     Native Number String Negative Value:
+//     -123.2
+//
+//  4. A Native Number String WILL NEVER include integer
+//     separators such as commas (',') to separate
+//     integer digits by thousands.
+//
+//     NOT THIS: 1,000,000
+//     Native Number String: 1000000
+//
+//  5. Native Number Strings will only consist of:
+//
+//     (a)	Numeric digits zero through nine inclusive (0-9).
+//
+//     (b)	A decimal point ('.') for floating point
+//     numbers.
+//
+//     (c)	A leading minus sign ('-') in the case of
+//     negative numeric values.
+//
+//  6. A Native Number String will NEVER include
+//     currency symbols.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	This method will delete and overwrite all pre-existing
+//	data values in the current instance of NumberStrKernel.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	nativeNumStr				string
+//
+//		A Native Number String containing the numeric
+//		character digits which will be converted to, and
+//		stored in, the NumberStrKernel object passed as
+//		input parameter 'numStrKernel'.
+//
+//		The term 'Native Number String' means that the
+//		number string format is designed to interoperate
+//		with the Golang programming language library
+//		functions and packages. Types like 'strconv',
+//		'strings', 'math' and 'big' (big.Int, big.Float,
+//		big.Rat) routinely parse and convert this type of
+//		number string to generate numeric values. In
+//		addition, Native Number Strings are frequently
+//		consumed by external library functions such	as
+//		this one (String Mechanics 'strmech') to convert
+//		strings to numeric values and numeric values to
+//		strings.
+//
+//		If 'nativeNumStr' fails to meet the formatting
+//		criteria for a Native Number String defined
+//		below, an error will be returned.
+//
+//		A valid Native Number String must conform to the
+//		standardized formatting criteria defined below:
+//
+//	 	1.	A Native Number String Consists of numeric
+//	 	  	character digits zero through nine inclusive
+//	 	  	(0-9).
+//
+//	 	2.	A Native Number String will include a period
+//	 	  	or decimal point ('.') to separate integer and
+//	 	  	fractional digits within a number string.
+//
+//	 	  	Native Number String Floating Point Value:
+//	 	   				123.1234
+//
+//	 	3.	A Native Number String will always format
+//	 	  	negative numeric values with a leading minus sign
+//	 	  	('-').
+//
+//	 	  	Native Number String Negative Value:
+//	 	  					-123.2
+//
+//	 	4.	A Native Number String WILL NEVER include integer
+//			separators such as commas (',') to separate
+//			integer digits by thousands.
+//
+//	 	   					NOT THIS: 1,000,000
+//	 	   		Native Number String: 1000000
+//
+//	 	5.	Native Number Strings will only consist of:
+//
+//			(a)	Numeric digits zero through nine inclusive (0-9).
+//
+//			(b)	A decimal point ('.') for floating point
+//				numbers.
+//
+//			(c)	A leading minus sign ('-') in the case of
+//				negative numeric values.
+//
+//		6.	A Native Number String will NEVER include
+//			currency symbols.
+//
+//	roundingType				NumberRoundingType
+//
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied for
+//		the	rounding of fractional digits contained in the
+//		reconfigured current instance of NumberStrKernel.
+//
+//		If in doubt as to a suitable rounding method,
+//		'HalfAwayFromZero' is recommended.
+//
+//		Possible values are listed as follows:
+//			NumRoundType.None()	- Invalid Value
+//			NumRoundType.NoRounding()
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
+//
+//		Definitions:
+//
+//			NoRounding
+//
+//				Signals that no rounding operation will be
+//				performed on fractional digits. The
+//				fractional digits will therefore remain
+//				unchanged.
+//
+//			HalfUpWithNegNums
+//
+//				Half Round Up Including Negative Numbers.
+//				This method is intuitive but may produce
+//				unexpected results when applied to negative
+//				numbers.
+//
+//				'HalfUpWithNegNums' rounds .5 up.
+//
+//					Examples of 'HalfUpWithNegNums'
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8
+//					7.4 rounds down to 7
+//					-7.4 rounds up to -7
+//					-7.5 rounds up to -7
+//					-7.6 rounds down to -8
+//
+//			HalfDownWithNegNums
+//
+//			Half Round Down Including Negative Numbers. This
+//			method is also considered intuitive but may
+//			produce unexpected results when applied to
+//			negative numbers.
+//
+//			'HalfDownWithNegNums' rounds .5 down.
+//
+//				Examples of HalfDownWithNegNums
+//
+//				7.6 rounds up to 8
+//				7.5 rounds down to 7
+//				7.4 rounds down to 7
+//				-7.4 rounds up to -7
+//				-7.5 rounds down to -8
+//				-7.6 rounds down to -8
+//
+//			HalfAwayFromZero
+//
+//				The 'HalfAwayFromZero' method rounds .5 further
+//				away from zero.	It provides clear and consistent
+//				behavior when dealing with negative numbers.
+//
+//					Examples of HalfAwayFromZero
+//
+//					7.6 rounds away to 8
+//					7.5 rounds away to 8
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds away to -8
+//					-7.6 rounds away to -8
+//
+//			HalfTowardsZero
+//
+//				Round Half Towards Zero. 'HalfTowardsZero' rounds
+//				0.5	closer to zero. It provides clear and
+//				consistent behavior	when dealing with negative
+//				numbers.
+//
+//					Examples of HalfTowardsZero
+//
+//					7.6 rounds away to 8
+//					7.5 rounds to 7
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds to -7
+//					-7.6 rounds away to -8
+//
+//			HalfToEven
+//
+//				Round Half To Even Numbers. 'HalfToEven' is
+//				also called	Banker's Rounding. This method
+//				rounds 0.5 to the nearest even digit.
+//
+//					Examples of HalfToEven
+//
+//					7.5 rounds up to 8 (because 8 is an even
+//					number)	but 6.5 rounds down to 6 (because
+//					6 is an even number)
+//
+//					HalfToEven only applies to 0.5. Other
+//					numbers (not ending	in 0.5) round to
+//					nearest as usual, so:
+//
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8 (because 8 is an even number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds down to 6 (because 6 is an even number)
+//					6.4 rounds down to 6
+//
+//			HalfToOdd
+//
+//				Round Half to Odd Numbers. Similar to 'HalfToEven',
+//				but in this case 'HalfToOdd' rounds 0.5 towards odd
+//				numbers.
+//
+//					Examples of HalfToOdd
+//
+//					HalfToOdd only applies to 0.5. Other numbers
+//					(not ending	in 0.5) round to nearest as usual.
+//
+//					7.5 rounds down to 7 (because 7 is an odd number)
+//
+//					6.5 rounds up to 7 (because 7 is an odd number)
+//
+//					7.6 rounds up to 8
+//					7.5 rounds down to 7 (because 7 is an odd number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds up to 7 (because 7 is an odd number)
+//					6.4 rounds down to 6
+//
+//			Randomly
+//
+//				Round Half Randomly. Uses a Random Number Generator
+//				to choose between rounding 0.5 up or down.
+//
+//				All numbers other than 0.5 round to the nearest as
+//				usual.
+//
+//			Floor
+//
+//				Yields the nearest integer down. Floor does not apply
+//				any	special treatment to 0.5.
+//
+//				Floor Function: The greatest integer that is less than
+//				or equal to x
+//
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//
+//				In mathematics and computer science, the floor function
+//				is the function that takes as input a real number x,
+//				and gives as output the greatest integer less than or
+//				equal to x,	denoted floor(x) or ⌊x⌋.
+//
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//
+//				Examples of Floor
+//
+//					Number     Floor
+//					 2           2
+//					 2.4         2
+//					 2.9         2
+//					-2.5        -3
+//					-2.7        -3
+//					-2          -2
+//
+//			Ceiling
+//
+//				Yields the nearest integer up. Ceiling does not
+//				apply any special treatment to 0.5.
+//
+//				Ceiling Function: The least integer that is
+//				greater than or	equal to x.
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//
+//				The ceiling function maps x to the least integer
+//				greater than or equal to x, denoted ceil(x) or
+//				⌈x⌉.[1]
+//
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//
+//					Examples of Ceiling
+//
+//						Number    Ceiling
+//						 2           2
+//						 2.4         3
+//						 2.9         3
+//						-2.5        -2
+//						-2.7        -2
+//						-2          -2
+//
+//			Truncate
+//
+//				Apply NO Rounding whatsoever. The Round From Digit
+//				is dropped or deleted. The Round To Digit is NEVER
+//				changed.
+//
+//				Examples of Truncate
+//
+//					Example-1
+//					Number: 23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:   23.14 - The Round From Digit
+//					is dropped.
+//
+//					Example-2
+//					Number: -23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:  -23.14 - The Round From Digit
+//					is dropped.
+//
+//	roundToFractionalDigits		int
+//
+//		When set to a positive integer value, this
+//		parameter controls the number of digits to the
+//		right of the radix point or decimal separator
+//		(a.k.a. decimal point). This controls the number
+//		of fractional digits remaining after completion
+//		of the number rounding operation.
+//
+//		If input parameter 'roundingType' is set to
+//		NumRoundType.NoRounding(),
+//		'roundToFractionalDigits' is ignored and no
+//		rounding operation is performed.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	numStrStatsDto				NumberStrStatsDto
+//
+//		If this method completes successfully, an
+//		instance of NumberStrStatsDto will be returned
+//		containing a profile and key statistics on the
+//		updated numeric value encapsulated in the current
+//		instance of NumberStrKernel.
+//
+//		type NumberStrStatsDto struct {
+//
+//		NumOfIntegerDigits					uint64
+//
+//			The total number of integer digits to the
+//			left of the radix point or, decimal point, in
+//			the subject numeric value.
+//
+//		NumOfSignificantIntegerDigits		uint64
+//
+//			The number of nonzero integer digits to the
+//			left of the radix point or, decimal point, in
+//			the subject numeric value.
+//
+//		NumOfFractionalDigits				uint64
+//
+//			The total number of fractional digits to the
+//			right of the radix point or, decimal point,
+//			in the subject numeric value.
+//
+//		NumOfSignificantFractionalDigits	uint64
+//
+//			The number of nonzero fractional digits to
+//			the right of the radix point or, decimal
+//			point, in the subject numeric value.
+//
+//		NumberValueType 					NumericValueType
+//
+//			This enumeration value specifies whether the
+//			subject numeric value is classified either as
+//			an integer or a floating point number.
+//
+//			Possible enumeration values are listed as
+//			follows:
+//				NumValType.None()
+//				NumValType.FloatingPoint()
+//				NumValType.Integer()
+//
+//		NumberSign							NumericSignValueType
+//
+//			An enumeration specifying the number sign
+//			associated with the numeric value. Possible
+//			values are listed as follows:
+//				NumSignVal.None()		= Invalid Value
+//				NumSignVal.Negative()	= -1
+//				NumSignVal.Zero()		=  0
+//				NumSignVal.Positive()	=  1
+//
+//		IsZeroValue							bool
+//
+//			If 'true', the subject numeric value is equal
+//			to zero ('0').
+//
+//			If 'false', the subject numeric value is
+//			greater than or less than zero ('0').
+//		}
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) SetFromNativeNumberStr(
+	nativeNumStr string,
+	roundingType NumberRoundingType,
+	roundToFractionalDigits int,
+	errorPrefix interface{}) (
+	numStrStatsDto NumberStrStatsDto,
+	err error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"SetFromNativeNumberStr()",
+		"")
+
+	if err != nil {
+		return numStrStatsDto, err
+	}
+
+	numStrStatsDto,
+		err = new(numberStrKernelMechanics).
+		setNumStrKernelFromRoundedNativeNumStr(
+			numStrKernel,
+			nativeNumStr,
+			roundingType,
+			roundToFractionalDigits,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	return numStrStatsDto,
+		err
+}
+
+// SetFromNumericValue
+//
+//	Deletes and resets the internal values for the
+//	current instance of NumberStrKernel using a numeric
+//	value passed as an empty interface.
+//
+//	The numeric value passed to this method by input
+//	parameter 'numericValue' MUST BE convertible to one
+//	of the types defined below:
+//
+//		float32, float64, big.Float
+//		*float32, *float64, *big.Float
+//		*BigFloatDto, BigFloatDto
+//		int8, int16, int, int32, int64,
+//		big.Int, *big.Int
+//		*int8, *int16, *int, *int32, *int64,
+//		uint8, uint16, uint, uint32, uint64
+//		*uint8, *uint16, *uint, *uint32, *uint64
+//		*TextFieldFormatDtoFloat64, TextFieldFormatDtoFloat64
+//		*TextFieldFormatDtoBigFloat, TextFieldFormatDtoBigFloat
+//		*NumberStrKernel, NumberStrKernel
+//
+//	This numeric value is then used to reconfigure the
+//	current instance NumberStrKernel.
+//
+// ----------------------------------------------------------------
+//
+// # BE ADVISED
+//
+//	(1)	big.Rat Accuracy
+//		This method will default big.Rat and *big.Rat
+//		values default to 2,000 decimal places of
+//		accuracy. Adjust rounding parameters as
+//		required or use the following method for more
+//		granular control over big.Rat rounding:
+//			NewFromNumericValue.SetFromBigRat()
+//
+//	(2) big.Rat Rounding Algorithm
+//		Before being converted to an instance of
+//		NumberStrKernel, the big.Rat numeric value will
+//		be rounded by the Golang 'big' package function:
+//
+//			(*Rat) FloatString
+//
+//		The rounding algorithm used is described in the
+//		big.Rat documentation as:
+//
+//			The last digit is rounded to nearest, with
+//			halves rounded away from zero.
+//				https://pkg.go.dev/math/big#Rat
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	This method will delete and reconfigure all
+//	pre-existing data values contained in the current
+//	instance of NumberStrKernel.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numericValue				interface{}
+//
+//		Numeric values passed by means of this empty
+//		interface MUST BE convertible to one of the
+//		following types:
+//
+//	     	float32, float64, big.Float
+//			*float32, *float64, *big.Float
+//			*BigFloatDto, BigFloatDto
+//			*big.Rat, big.Rat
+//			int8, int16, int, int32, int64, big.Int
+//			*int8, *int16, *int, *int32, *int64,
+//			*big.Int, big.Int
+//			uint8, uint16, uint, uint32, uint64
+//			*uint8, *uint16, *uint, *uint32, *uint64
+//			*TextFieldFormatDtoFloat64, TextFieldFormatDtoFloat64
+//			*TextFieldFormatDtoBigFloat, TextFieldFormatDtoBigFloat
+//			*NumberStrKernel, NumberStrKernel
+//
+//		This numeric value will be used to reconfigure
+//		the current instance of NumberStrKernel.
+//
+//		If 'numericValue' is NOT convertible to one of
+//		the types listed above, an error will be
+//		returned.
+//
+//	roundingType				NumberRoundingType
+//
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied for
+//		the	rounding of fractional digits contained in the
+//		reconfigured instance of NumberStrKernel.
+//
+//		If in doubt as to a suitable rounding method,
+//		'HalfAwayFromZero' is recommended.
+//
+//		Possible values are listed as follows:
+//			NumRoundType.None()	- Invalid Value
+//			NumRoundType.NoRounding()
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
+//
+//		Definitions:
+//
+//			NoRounding
+//
+//				Signals that no rounding operation will be
+//				performed on fractional digits. The
+//				fractional digits will therefore remain
+//				unchanged.
+//
+//			HalfUpWithNegNums
+//
+//				Half Round Up Including Negative Numbers.
+//				This method is intuitive but may produce
+//				unexpected results when applied to negative
+//				numbers.
+//
+//				'HalfUpWithNegNums' rounds .5 up.
+//
+//					Examples of 'HalfUpWithNegNums'
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8
+//					7.4 rounds down to 7
+//					-7.4 rounds up to -7
+//					-7.5 rounds up to -7
+//					-7.6 rounds down to -8
+//
+//			HalfDownWithNegNums
+//
+//			Half Round Down Including Negative Numbers. This
+//			method is also considered intuitive but may
+//			produce unexpected results when applied to
+//			negative numbers.
+//
+//			'HalfDownWithNegNums' rounds .5 down.
+//
+//				Examples of HalfDownWithNegNums
+//
+//				7.6 rounds up to 8
+//				7.5 rounds down to 7
+//				7.4 rounds down to 7
+//				-7.4 rounds up to -7
+//				-7.5 rounds down to -8
+//				-7.6 rounds down to -8
+//
+//			HalfAwayFromZero
+//
+//				The 'HalfAwayFromZero' method rounds .5 further
+//				away from zero.	It provides clear and consistent
+//				behavior when dealing with negative numbers.
+//
+//					Examples of HalfAwayFromZero
+//
+//					7.6 rounds away to 8
+//					7.5 rounds away to 8
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds away to -8
+//					-7.6 rounds away to -8
+//
+//			HalfTowardsZero
+//
+//				Round Half Towards Zero. 'HalfTowardsZero' rounds
+//				0.5	closer to zero. It provides clear and
+//				consistent behavior	when dealing with negative
+//				numbers.
+//
+//					Examples of HalfTowardsZero
+//
+//					7.6 rounds away to 8
+//					7.5 rounds to 7
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds to -7
+//					-7.6 rounds away to -8
+//
+//			HalfToEven
+//
+//				Round Half To Even Numbers. 'HalfToEven' is
+//				also called	Banker's Rounding. This method
+//				rounds 0.5 to the nearest even digit.
+//
+//					Examples of HalfToEven
+//
+//					7.5 rounds up to 8 (because 8 is an even
+//					number)	but 6.5 rounds down to 6 (because
+//					6 is an even number)
+//
+//					HalfToEven only applies to 0.5. Other
+//					numbers (not ending	in 0.5) round to
+//					nearest as usual, so:
+//
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8 (because 8 is an even number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds down to 6 (because 6 is an even number)
+//					6.4 rounds down to 6
+//
+//			HalfToOdd
+//
+//				Round Half to Odd Numbers. Similar to 'HalfToEven',
+//				but in this case 'HalfToOdd' rounds 0.5 towards odd
+//				numbers.
+//
+//					Examples of HalfToOdd
+//
+//					HalfToOdd only applies to 0.5. Other numbers
+//					(not ending	in 0.5) round to nearest as usual.
+//
+//					7.5 rounds down to 7 (because 7 is an odd number)
+//
+//					6.5 rounds up to 7 (because 7 is an odd number)
+//
+//					7.6 rounds up to 8
+//					7.5 rounds down to 7 (because 7 is an odd number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds up to 7 (because 7 is an odd number)
+//					6.4 rounds down to 6
+//
+//			Randomly
+//
+//				Round Half Randomly. Uses a Random Number Generator
+//				to choose between rounding 0.5 up or down.
+//
+//				All numbers other than 0.5 round to the nearest as
+//				usual.
+//
+//			Floor
+//
+//				Yields the nearest integer down. Floor does not apply
+//				any	special treatment to 0.5.
+//
+//				Floor Function: The greatest integer that is less than
+//				or equal to x
+//
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//
+//				In mathematics and computer science, the floor function
+//				is the function that takes as input a real number x,
+//				and gives as output the greatest integer less than or
+//				equal to x,	denoted floor(x) or ⌊x⌋.
+//
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//
+//				Examples of Floor
+//
+//					Number     Floor
+//					 2           2
+//					 2.4         2
+//					 2.9         2
+//					-2.5        -3
+//					-2.7        -3
+//					-2          -2
+//
+//			Ceiling
+//
+//				Yields the nearest integer up. Ceiling does not
+//				apply any special treatment to 0.5.
+//
+//				Ceiling Function: The least integer that is
+//				greater than or	equal to x.
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//
+//				The ceiling function maps x to the least integer
+//				greater than or equal to x, denoted ceil(x) or
+//				⌈x⌉.[1]
+//
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//
+//					Examples of Ceiling
+//
+//						Number    Ceiling
+//						 2           2
+//						 2.4         3
+//						 2.9         3
+//						-2.5        -2
+//						-2.7        -2
+//						-2          -2
+//
+//			Truncate
+//
+//				Apply NO Rounding whatsoever. The Round From Digit
+//				is dropped or deleted. The Round To Digit is NEVER
+//				changed.
+//
+//				Examples of Truncate
+//
+//					Example-1
+//					Number: 23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:   23.14 - The Round From Digit
+//					is dropped.
+//
+//					Example-2
+//					Number: -23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:  -23.14 - The Round From Digit
+//					is dropped.
+//
+//	roundToFractionalDigits		int
+//
+//		When set to a positive integer value, this
+//		parameter controls the number of digits to the
+//		right of the radix point or decimal separator
+//		(a.k.a. decimal point). This controls the number
+//		of fractional digits remaining after completion
+//		of the number rounding operation.
+//
+//		This parameter is ignored if 'roundingType' is
+//		set to 'NumRoundType.NoRounding()'.
+//
+//		This parameter will be used to control the number
+//		of fractional digits to the right of the decimal
+//		point in the reconfigured instance of
+//		NumberStrKernel.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (numStrKernel *NumberStrKernel) SetFromNumericValue(
+	numericValue interface{},
+	roundingType NumberRoundingType,
+	roundToFractionalDigits int,
+	errorPrefix interface{}) error {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"SetFromNumericValue()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	err = new(numberStrKernelMolecule).
+		convertNumericValueToKernel(
+			numStrKernel,
+			numericValue,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	if err != nil ||
+		roundingType == NumRoundType.NoRounding() {
+
+		return err
+	}
+
+	var numStrRoundingSpec NumStrRoundingSpec
+
+	numStrRoundingSpec,
+		err =
+		new(NumStrRoundingSpec).NewRoundingSpec(
+			roundingType,
+			roundToFractionalDigits,
+			ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrMathRoundingNanobot).roundNumStrKernel(
+		numStrKernel,
+		numStrRoundingSpec,
+		ePrefix)
+}
+
+// SetFromPureNumberStr
+//
+// Receives an instance of NumberStrKernel and proceeds
+// to reconfigure that instance based on the numeric
+// value extracted from a Pure Number String.
+//
+// A Pure Number String differs from a Native Number
+// String in that it offers more options for
+// customization. Pure Number Strings are better able to
+// match multinational and multicultural number
+// formatting conventions. Users have the option to
+// specify custom radix points or decimal separator
+// characters as well as designating leading or trailing
+// minus signs for negative numbers.
+//
+// A Pure Number String is defined as follows:
+//
+//  1. A pure number string consists entirely of numeric
+//     digit characters (0-9).
+//
+//  2. A pure number string will separate integer and
+//     fractional digits with a radix point or decimal
+//     separator. This could be, but is not limited to,
+//     a decimal point ('.'). For example, many European
+//     countries use the comma (',') as a radix point.
+//
+//  3. A pure number string will designate negative values
+//     with a minus sign ('-'). This minus sign could be
+//     positioned as a leading or trailing minus sign.
+//
+//  4. A pure number string will NEVER include integer
+//     separators such as commas (',') to separate
+//     integer digits by thousands.
+//
+//     NOT THIS: 1,000,000
+//     Pure Number String: 1000000
+//
+//  5. A pure number string will NEVER include currency
+//     symbols.
+//
+// Users also have the option of specifying rounding
+// parameters which will be used to round the numeric
+// value extracted from 'pureNumberStr' before it is
+// converted and stored in the reconfigured current
+// instance of 'NumberStrKernel'.
+//
+// Note that return parameter 'pureNumStrComponents'
+// will provide a statistical profile of the new
+// returned instance of NumberStrKernel,
+// 'newNumStrKernel'.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	This method will delete and reconfigure all
+//	pre-existing data values contained in the current
+//	instance of NumberStrKernel.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	pureNumberStr				string
+//
+//		This strings contains the numeric character
+//		digits from	which a numeric value will be
+//		extracted. This numeric value will be used
+//		to reconfigure the instance of NumberStrKernel
+//		passed as input parameter 'numStrKernel'.
+//
+//		A "Pure Number String" is defined as follows:
+//
+//			1.	Consists of numeric character digits
+//				zero through nine inclusive (0-9).
+//
+//			2.	Option: A Pure Number String may include
+//				a radix point or decimal separator.
+//				Decimal separators separate integer and
+//				fractional numeric digits in a pure
+//				number string. The decimal separator may
+//				consist of one or more text characters.
+//
+//				In the US, UK, Australia, most of Canada
+//				and many other countries, the decimal
+//				separator is the period character ('.')
+//				known as the decimal point.
+//
+//				In France, Germany and many countries in
+//				the European Union, the Decimal Separator
+//				is the comma character (',').
+//
+//			3.	Optional: A Pure Number String may
+//				include a negative number sign symbol
+//				consisting of a minus sign ('-'). The
+//				minus sign will identify the numeric
+//				value contained in the pure number string
+//				as a negative number. Only the minus sign
+//				('-') classifies a numeric value as a
+//				negative number in a Pure Number String.
+//
+//				If a leading or trailing minus sign ('-')
+//				is NOT present in the pure number string,
+//				the numeric value is assumed to be
+//				positive.
+//
+//			4.	Only numeric characters, the decimal
+//				separator and the minus sign will be
+//				processed by the pure number string
+//				parsing algorithm. All other characters
+//				will be	ignored.
+//
+//			5.	Pure Number Strings consist of a single
+//				numeric value. The entire Pure Number String
+//				will be parsed, or processed, and only one
+//				numeric value per Pure Number String will
+//				be returned.
+//
+//	decSeparatorChars			string
+//
+//		This string contains the character or characters
+//		which will be configured as the Decimal Separator
+//		Symbol.
+//
+//		The Decimal Separator is also known as the radix
+//		point and is used to separate integer and
+//		fractional digits within a formatted, floating
+//		point Number String.
+//
+//		In the US, UK, Australia, most of Canada and many
+//		other countries the Decimal Separator is the
+//		period character ('.') known as the decimal
+//		point.
+//
+//		In France, Germany and many countries in the
+//		European Union, the Decimal Separator is the
+//		comma character (',').
+//
+//		The Decimal Separator is used to identify and
+//		separate integer and fractional digits while
+//		parsing the Pure Number String.
+//
+//	leadingMinusSign			bool
+//
+//		In pure number strings, a minus sign ('-')
+//		identifies a number as a negative numeric value.
+//
+//		When 'leadingMinusSign' is set to 'true', the
+//		pure number string parsing algorithm will search
+//		for a leading minus sign ('-') at the beginning of
+//		the number string. Leading minus signs represent
+//		the standard means for designating negative
+//		numeric values in the US, UK, Australia, most of
+//		Canada and many other parts of world.
+//
+//		Example Leading Minus Sign:
+//			"-123.456" or "- 123.456"
+//
+//		When 'leadingMinusSign' is set to 'false', the
+//		pure number string parsing algorithm will search
+//		for trailing minus signs ('-') located at the end
+//		of the number string. Trailing minus signs
+//		represent the standard for France, Germany and
+//		many countries in the European Union.
+//
+//		NOTE: Identification of a trailing minus sign in
+//		the pure number string input parameter,
+//		'pureNumberString', will immediately terminate
+//		the search for numeric characters.
+//
+//		Example Trailing Number Symbols:
+//			"123.456-" or "123.456 -"
+//
+//	roundingType				NumberRoundingType
+//
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied for
+//		the	rounding of fractional digits contained in the
+//		new returned instance of NumberStrKernel
+//		(newNumStrKernel).
+//
+//		If in doubt as to a suitable rounding method,
+//		'HalfAwayFromZero' is recommended.
+//
+//		Possible values are listed as follows:
+//			NumRoundType.None()	- Invalid Value
+//			NumRoundType.NoRounding()
+//			NumRoundType.HalfUpWithNegNums()
+//			NumRoundType.HalfDownWithNegNums()
+//			NumRoundType.HalfAwayFromZero()
+//			NumRoundType.HalfTowardsZero()
+//			NumRoundType.HalfToEven()
+//			NumRoundType.HalfToOdd()
+//			NumRoundType.Randomly()
+//			NumRoundType.Floor()
+//			NumRoundType.Ceiling()
+//			NumRoundType.Truncate()
+//
+//		Definitions:
+//
+//			NoRounding
+//
+//				Signals that no rounding operation will be
+//				performed on fractional digits. The
+//				fractional digits will therefore remain
+//				unchanged.
+//
+//			HalfUpWithNegNums
+//
+//				Half Round Up Including Negative Numbers.
+//				This method is intuitive but may produce
+//				unexpected results when applied to negative
+//				numbers.
+//
+//				'HalfUpWithNegNums' rounds .5 up.
+//
+//					Examples of 'HalfUpWithNegNums'
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8
+//					7.4 rounds down to 7
+//					-7.4 rounds up to -7
+//					-7.5 rounds up to -7
+//					-7.6 rounds down to -8
+//
+//			HalfDownWithNegNums
+//
+//			Half Round Down Including Negative Numbers. This
+//			method is also considered intuitive but may
+//			produce unexpected results when applied to
+//			negative numbers.
+//
+//			'HalfDownWithNegNums' rounds .5 down.
+//
+//				Examples of HalfDownWithNegNums
+//
+//				7.6 rounds up to 8
+//				7.5 rounds down to 7
+//				7.4 rounds down to 7
+//				-7.4 rounds up to -7
+//				-7.5 rounds down to -8
+//				-7.6 rounds down to -8
+//
+//			HalfAwayFromZero
+//
+//				The 'HalfAwayFromZero' method rounds .5 further
+//				away from zero.	It provides clear and consistent
+//				behavior when dealing with negative numbers.
+//
+//					Examples of HalfAwayFromZero
+//
+//					7.6 rounds away to 8
+//					7.5 rounds away to 8
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds away to -8
+//					-7.6 rounds away to -8
+//
+//			HalfTowardsZero
+//
+//				Round Half Towards Zero. 'HalfTowardsZero' rounds
+//				0.5	closer to zero. It provides clear and
+//				consistent behavior	when dealing with negative
+//				numbers.
+//
+//					Examples of HalfTowardsZero
+//
+//					7.6 rounds away to 8
+//					7.5 rounds to 7
+//					7.4 rounds to 7
+//					-7.4 rounds to -7
+//					-7.5 rounds to -7
+//					-7.6 rounds away to -8
+//
+//			HalfToEven
+//
+//				Round Half To Even Numbers. 'HalfToEven' is
+//				also called	Banker's Rounding. This method
+//				rounds 0.5 to the nearest even digit.
+//
+//					Examples of HalfToEven
+//
+//					7.5 rounds up to 8 (because 8 is an even
+//					number)	but 6.5 rounds down to 6 (because
+//					6 is an even number)
+//
+//					HalfToEven only applies to 0.5. Other
+//					numbers (not ending	in 0.5) round to
+//					nearest as usual, so:
+//
+//					7.6 rounds up to 8
+//					7.5 rounds up to 8 (because 8 is an even number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds down to 6 (because 6 is an even number)
+//					6.4 rounds down to 6
+//
+//			HalfToOdd
+//
+//				Round Half to Odd Numbers. Similar to 'HalfToEven',
+//				but in this case 'HalfToOdd' rounds 0.5 towards odd
+//				numbers.
+//
+//					Examples of HalfToOdd
+//
+//					HalfToOdd only applies to 0.5. Other numbers
+//					(not ending	in 0.5) round to nearest as usual.
+//
+//					7.5 rounds down to 7 (because 7 is an odd number)
+//
+//					6.5 rounds up to 7 (because 7 is an odd number)
+//
+//					7.6 rounds up to 8
+//					7.5 rounds down to 7 (because 7 is an odd number)
+//					7.4 rounds down to 7
+//					6.6 rounds up to 7
+//					6.5 rounds up to 7 (because 7 is an odd number)
+//					6.4 rounds down to 6
+//
+//			Randomly
+//
+//				Round Half Randomly. Uses a Random Number Generator
+//				to choose between rounding 0.5 up or down.
+//
+//				All numbers other than 0.5 round to the nearest as
+//				usual.
+//
+//			Floor
+//
+//				Yields the nearest integer down. Floor does not apply
+//				any	special treatment to 0.5.
+//
+//				Floor Function: The greatest integer that is less than
+//				or equal to x
+//
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//
+//				In mathematics and computer science, the floor function
+//				is the function that takes as input a real number x,
+//				and gives as output the greatest integer less than or
+//				equal to x,	denoted floor(x) or ⌊x⌋.
+//
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//
+//				Examples of Floor
+//
+//					Number     Floor
+//					 2           2
+//					 2.4         2
+//					 2.9         2
+//					-2.5        -3
+//					-2.7        -3
+//					-2          -2
+//
+//			Ceiling
+//
+//				Yields the nearest integer up. Ceiling does not
+//				apply any special treatment to 0.5.
+//
+//				Ceiling Function: The least integer that is
+//				greater than or	equal to x.
+//				Source:
+//					https://www.mathsisfun.com/sets/function-floor-ceiling.html
+//
+//				The ceiling function maps x to the least integer
+//				greater than or equal to x, denoted ceil(x) or
+//				⌈x⌉.[1]
+//
+//				Source:
+//					https://en.wikipedia.org/wiki/Floor_and_ceiling_functions
+//
+//					Examples of Ceiling
+//
+//						Number    Ceiling
+//						 2           2
+//						 2.4         3
+//						 2.9         3
+//						-2.5        -2
+//						-2.7        -2
+//						-2          -2
+//
+//			Truncate
+//
+//				Apply NO Rounding whatsoever. The Round From Digit
+//				is dropped or deleted. The Round To Digit is NEVER
+//				changed.
+//
+//				Examples of Truncate
+//
+//					Example-1
+//					Number: 23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:   23.14 - The Round From Digit
+//					is dropped.
+//
+//					Example-2
+//					Number: -23.14567
+//					Objective: Round to two decimal places to
+//					the right of the decimal point.
+//					Rounding Method: Truncate
+//					Round To Digit:   4
+//					Round From Digit: 5
+//					Rounded Number:  -23.14 - The Round From Digit
+//					is dropped.
+//
+//	roundToFractionalDigits		int
+//
+//		When set to a positive integer value, this
+//		parameter controls the number of digits to the
+//		right of the radix point or decimal separator
+//		(a.k.a. decimal point). This controls the number
+//		of fractional digits remaining after completion
+//		of the number rounding operation.
+//
+//		If input parameter 'roundingType' is set to
+//		NumRoundType.NoRounding(),
+//		'roundToFractionalDigits' is ignored and no
+//		rounding operation is performed.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	pureNumStrComponents		PureNumberStrComponents
+//
+//		If this method completes successfully, this
+//		parameter will return an instance of
+//		PureNumberStrComponents. This data structure
+//		contains an analysis and profile information on
+//		the reconfigured instance of NumberStrKernel
+//		passed as input parameter 'numStrKernel'.
+//
+//		type PureNumberStrComponents struct {
+//
+//			NumStrStats NumberStrStatsDto
+//
+//				This data transfer object will return key
+//				statistics on the numeric value encapsulated
+//				by the current instance of NumberStrKernel.
+//
+//				type NumberStrStatsDto struct {
+//
+//				NumOfIntegerDigits					uint64
+//
+//					The total number of integer digits to the
+//					left of the radix point or, decimal point, in
+//					the subject numeric value.
+//
+//				NumOfSignificantIntegerDigits		uint64
+//
+//					The number of nonzero integer digits to the
 //					left of the radix point or, decimal point, in
 //					the subject numeric value.
 //
-//				NumOfFractionalDigits				uint64
+//				NumOfFractionalDigits				uint64
+//
+//					The total number of fractional digits to the
+//					right of the radix point or, decimal point,
+//					in the subject numeric value.
+//
+//				NumOfSignificantFractionalDigits	uint64
+//
+//					The number of nonzero fractional digits to
+//					the right of the radix point or, decimal
+//					point, in the subject numeric value.
+//
+//				NumberValueType 					NumericValueType
+//
+//					This enumeration value specifies whether the
+//					subject numeric value is classified either as
+//					an integer or a floating point number.
+//
+//					Possible enumeration values are listed as
+//					follows:
+//						NumValType.None()
+//						NumValType.FloatingPoint()
+//						NumValType.Integer()
+//
+//				NumberSign							NumericSignValueType
+//
+//					An enumeration specifying the number sign
+//					associated with the numeric value. Possible
+//					values are listed as follows:
+//						NumSignVal.None()		= Invalid Value
+//						NumSignVal.Negative()	= -1
+//						NumSignVal.Zero()		=  0
+//						NumSignVal.Positive()	=  1
+//
+//				IsZeroValue							bool
+//
+//					If 'true', the subject numeric value is equal
+//					to zero ('0').
+//
+//					If 'false', the subject numeric value is
+//					greater than or less than zero ('0').
+//				}
+//
+//
+//
+//			AbsoluteValueNumStr string
+//
+//			The number string expressed as an absolute value.
+//			Be advised, this number string may be a floating
+//			point number string containing fractional digits.
+//
+//			AbsoluteValAllIntegerDigitsNumStr string
+//
+//			Integer and fractional digits are combined
+//			in a single number string without a decimal
+//			point separating integer and fractional digits.
+//			This string DOES NOT contain a leading number
+//			sign (a.k.a. minus sign ('-')
+//
+//			SignedAllIntegerDigitsNumStr string
+//
+//			Integer and fractional digits are combined
+//			in a single number string without a decimal
+//			point separating integer and fractional digits.
+//			If the numeric value is negative, a leading
+//			minus sign will be prefixed at the beginning
+//			of the number string.
+//
+//			NativeNumberStr string
+//
+//			A Native Number String representing the base
+//			numeric value used to generate these profile
+//			number string statistics.
+//
+//			A valid Native Number String must conform to the
+//			standardized formatting criteria defined below:
+//
+//			 	1. A Native Number String Consists of numeric
+//			 	   character digits zero through nine inclusive
+//			 	   (0-9).
+//
+//			 	2. A Native Number String will include a period
+//			 	   or decimal point ('.') to separate integer and
+//			 	   fractional digits within a number string.
+//
+//			 	   Native Number String Floating Point Value:
+//			 	   				123.1234
+//
+//			 	3. A Native Number String will always format
+//			 	   negative numeric values with a leading minus sign
+//			 	   ('-').
+//
+//			 	   Native Number String Negative Value:
+//			 	   				-123.2
+//
+//			 	4. A Native Number String WILL NEVER include integer
+//			 	   separators such as commas (',') to separate
+//			 	   integer digits by thousands.
+//
+//			 	   					NOT THIS: 1,000,000
+//			 	   		Native Number String: 1000000
+//
+//			 	5. Native Number Strings will only consist of:
+//
+//			 	   (a)	Numeric digits zero through nine inclusive (0-9).
+//
+//			 	   (b)	A decimal point ('.') for floating point
+//			 	   		numbers.
+//
+//			 	   (c)	A leading minus sign ('-') in the case of
+//			 	   		negative numeric values.
+//
+//		}
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) SetFromPureNumberStr(
+	pureNumberStr string,
+	decSeparatorChars string,
+	leadingMinusSign bool,
+	roundingType NumberRoundingType,
+	roundToFractionalDigits int,
+	errorPrefix interface{}) (
+	pureNumStrComponents PureNumberStrComponents,
+	err error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"SetFromNativeNumberStr()",
+		"")
+
+	if err != nil {
+		return pureNumStrComponents, err
+	}
+
+	pureNumStrComponents,
+		err = new(numberStrKernelMechanics).
+		setNumStrKernelFromRoundedPureNumStr(
+			numStrKernel,
+			pureNumberStr,
+			decSeparatorChars,
+			leadingMinusSign,
+			roundingType,
+			roundToFractionalDigits,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	return pureNumStrComponents, err
+}
+
+// SetFromSciNotationStr
+//
+// Deletes and resets the numeric value of the current
+// NumberStrKernel instance using the value parsed from
+// a number string formatted in Scientific Notation or
+// E-Notation.
+//
+// The exponent is expanded exactly into the integer and
+// fractional digit arrays of the current
+// NumberStrKernel instance. No rounding is applied.
+//
+//	Examples:
+//		"1.602E-19"  => 0.0000000000000000001602
+//		"6.022e+23"  => 602200000000000000000000
+//		"-3.1×10^8"  => -310000000
+//		"2,5E-3"     => 0.0025  (German decimal separator)
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	This method will delete and reset all pre-existing
+//	numeric data values in the current instance of
+//	NumberStrKernel.
+//
+//	If an error is returned, the current instance of
+//	NumberStrKernel will NOT be modified.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	sciNotStr					string
+//
+//		The Scientific Notation number string to be
+//		parsed. Leading and trailing white space is
+//		ignored.
+//
+//		The significand consists of an optional leading
+//		plus ('+') or minus ('-') sign followed by
+//		numeric digits and an optional decimal separator
+//		as specified by input parameter 'decSeparator'.
+//
+//		The significand must be followed by one of these
+//		exponent formats:
+//
+//		E-Notation:
+//			"6.022E23"  "6.022E+23"  "1.602e-19"
+//
+//		Exponential:
+//			"-3.1×10^8"  "2.652 x 10^8"  "2.652*10^-8"
+//
+//		Exponential with superscript exponent:
+//			"5.21×10⁻⁵"  "1.23 x 10⁵"
+//
+//		The exponent sign may be specified with a plus
+//		sign ('+'), a minus sign ('-') or the Unicode
+//		minus sign ('−' U+2212).
+//
+//		If 'sciNotStr' is empty, or if the significand
+//		or exponent is malformed, an error will be
+//		returned. Error messages identify the zero based
+//		character index at which the error was detected.
+//
+//	decSeparator				DecimalSeparatorSpec
+//
+//		This structure contains the radix point or
+//		decimal separator character(s) used to separate
+//		integer and fractional digits in the significand
+//		of 'sciNotStr'.
+//
+//		In the US, the decimal separator is the period
+//		('.') or decimal point. In Germany and France,
+//		the decimal separator is the comma (',').
+//
+//		If 'decSeparator' is empty or invalid, an error
+//		will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	numStrStatsDto				NumberStrStatsDto
+//
+//		This data transfer object will return key
+//		statistics on the numeric value encapsulated
+//		by the current instance of NumberStrKernel.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) SetFromSciNotationStr(
+	sciNotStr string,
+	decSeparator DecimalSeparatorSpec,
+	errorPrefix interface{}) (
+	numStrStatsDto NumberStrStatsDto,
+	err error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"SetFromSciNotationStr()",
+		"")
+
+	if err != nil {
+		return numStrStatsDto, err
+	}
+
+	var newNumStrKernel NumberStrKernel
+
+	numStrStatsDto,
+		err = new(numberStrKernelMechanics).
+		setNumStrKernelFromSciNotationStr(
+			&newNumStrKernel,
+			sciNotStr,
+			decSeparator,
+			ePrefix.XCpy(
+				"newNumStrKernel"))
+
+	if err != nil {
+		return numStrStatsDto, err
+	}
+
+	err = new(numberStrKernelNanobot).copy(
+		numStrKernel,
+		&newNumStrKernel,
+		ePrefix.XCpy(
+			"numStrKernel<-newNumStrKernel"))
+
+	return numStrStatsDto, err
+}
+
+// SetJsonMarshalFormat
+//
+// Sets the JSON Marshal Format for the current instance
+// of NumberStrKernel.
+//
+// The JSON Marshal Format specifies whether the numeric
+// value will be encoded as a quoted string or as a bare
+// number when the current instance of NumberStrKernel is
+// marshalled to JSON. Reference method:
+//
+//	NumberStrKernel.MarshalJSON()
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	jsonMarshalFormat			NumberStrJsonFormat
+//
+//		An enumeration value specifying the JSON encoding
+//		format. Valid values are listed as follows:
+//
+//			NumStrJsonFmt.QuotedString()
+//				The numeric value will be encoded as a
+//				JSON string.
+//					"-1234.5678"
+//
+//			NumStrJsonFmt.BareNumber()
+//				The numeric value will be encoded as a
+//				bare JSON number.
+//					-1234.5678
+//
+//		If 'jsonMarshalFormat' is set to any other
+//		value, including NumStrJsonFmt.None(), an error
+//		will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) SetJsonMarshalFormat(
+	jsonMarshalFormat NumberStrJsonFormat,
+	errorPrefix interface{}) (
+	err error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"SetJsonMarshalFormat()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if !jsonMarshalFormat.XIsValid() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'jsonMarshalFormat' is invalid!\n"+
+			"'jsonMarshalFormat' must be set to one of the following\n"+
+			"two valid values:\n"+
+			"  NumStrJsonFmt.QuotedString()\n"+
+			"  NumStrJsonFmt.BareNumber()\n"+
+			"'jsonMarshalFormat' Integer Value = '%v'\n"+
+			"'jsonMarshalFormat'  String Value = '%v'\n",
+			ePrefix.String(),
+			jsonMarshalFormat.XValueInt(),
+			jsonMarshalFormat.String())
+
+		return err
+	}
+
+	numStrKernel.jsonMarshalFormat = jsonMarshalFormat
+
+	return err
+}
+
+// SetNumberSign - Sets the Number Sign for the numeric value
+// represented by the current instance of NumberStrKernel.
+//
+// The Number Sign is specified by means of a
+// NumericSignValueType enumeration value.
 //
-//					The total number of fractional digits to the
-//					right of the radix point or, decimal point,
-//					in the subject numeric value.
+// Possible values are listed as follows:
 //
-//				NumOfSignificantFractionalDigits	uint64
+//	NumSignVal.None()     = -2 - Invalid Value
+//	NumSignVal.Negative() = -1 - Valid Value
+//	NumSignVal.Zero()     =  0 - Valid Value
+//	NumSignVal.Positive() =  1 - Valid Value
+func (numStrKernel *NumberStrKernel) SetNumberSign(
+	numberSign NumericSignValueType,
+	errorPrefix interface{}) (
+	err error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"SetNumberSign()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if !numberSign.XIsValid() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numberSign' is invalid\n"+
+			"Number Sign must be set to one of the following"+
+			"three valid values:\n"+
+			"  NumSignVal.Negative()\n"+
+			"  NumSignVal.Zero()\n"+
+			"  NumSignVal.Positive()"+
+			"Input parameter Number Sign Integer Value = '%v'\n"+
+			"Input parameter Number Sign  String Value = '%v'\n",
+			ePrefix.String(),
+			numberSign.XValueInt(),
+			numberSign.String())
+
+		return err
+	}
+
+	numStrKernel.numberSign = numberSign
+
+	return err
+}
+
+// SetNumberSignInt - Sets the Number Sign of the current
+// NumberStrKernel instance based on an integer input value.
 //
-//					The number of nonzero fractional digits to
-//					the right of the radix point or, decimal
-//					point, in the subject numeric value.
+// Possible valid integer input values are listed as follows:
 //
-//				NumberValueType 					NumericValueType
+//	-1 - Minus One
+//	 0 - Zero
+//	 1 - Plus One
 //
-//					This enumeration value specifies whether the
-//					subject numeric value is classified either as
-//					an integer or a floating point number.
+// Invalid input value will generate an error.
+func (numStrKernel *NumberStrKernel) SetNumberSignInt(
+	numberSign int,
+	errorPrefix interface{}) (
+	err error) {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"SetNumberSignInt()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if numberSign > 1 ||
+		numberSign < -1 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numberSign' is invalid!\n"+
+			"'numberSign' must be set to one of three values:"+
+			"     0 = Numeric Value of Zero ('0').\n"+
+			"     1 = + or positive number sign\n"+
+			"    -1 = - or negative number sign\n"+
+			"Input parameter 'numberSign' = '%v'\n",
+			ePrefix.String(),
+			numberSign)
+
+		return err
+	}
+
+	if numberSign == 0 {
+		numStrKernel.isNonZeroValue = false
+	} else {
+		numStrKernel.isNonZeroValue = true
+	}
+
+	numStrKernel.numberSign = NumericSignValueType(numberSign)
+
+	return err
+}
+
+//	SetRuneDigits
 //
-//					Possible enumeration values are listed as
-//					follows:
-//						NumValType.None()
-//						NumValType.FloatingPoint()
-//						NumValType.Integer()
+//	Deletes resets the internal values for the current
+//	instance of	NumberStrKernel using input parameters
+//	containing integer digit and fractional digit
+//	rune arrays.
 //
-//				NumberSign							NumericSignValueType
+// ----------------------------------------------------------------
 //
-//					An enumeration specifying the number sign
-//					associated with the numeric value. Possible
-//					values are listed as follows:
-//						NumSignVal.None()		= Invalid Value
-//						NumSignVal.Negative()	= -1
-//						NumSignVal.Zero()		=  0
-//						NumSignVal.Positive()	=  1
+// # IMPORTANT
 //
-//				IsZeroValue							bool
+//	This method will delete, overwrite and reset all
+//	pre-existing data values in the current instance of
+//	NumberStrKernel.
 //
-//					If 'true', the subject numeric value is equal
-//					to zero ('0').
+// ----------------------------------------------------------------
 //
-//					If 'false', the subject numeric value is
-//					greater than or less than zero ('0').
-//				}
+// # Input Parameters
 //
+//	integerDigits				[]rune
 //
+//		This rune array contains the numeric digits used to
+//		populate the integer part of the numeric value
+//		contained in the current instance of NumberStrKernel.
 //
-//			AbsoluteValueNumStr string
+//	fractionalDigits			[]rune
 //
-//			The number string expressed as an absolute value.
-//			Be advised, this number string may be a floating
-//			point number string containing fractional digits.
+//		This rune array contains the numeric digits used to
+//		populate the fractional part of the numeric value
+//		contained in the current instance of NumberStrKernel.
 //
-//			AbsoluteValAllIntegerDigitsNumStr string
+//	numberSign					NumericSignValueType
 //
-//			Integer and fractional digits are combined
-//			in a single number string without a decimal
-//			point separating integer and fractional digits.
-//			This string DOES NOT contain a leading number
-//			sign (a.k.a. minus sign ('-')
+//		The Number Sign for the new numeric value is
+//		specified by means of a	NumericSignValueType
+//		enumeration value.
 //
-//			SignedAllIntegerDigitsNumStr string
+//		Possible values are listed as follows:
 //
-//			Integer and fractional digits are combined
-//			in a single number string without a decimal
-//			point separating integer and fractional digits.
-//			If the numeric value is negative, a leading
-//			minus sign will be prefixed at the beginning
-//			of the number string.
+//			NumSignVal.None()     = Ignored. Numeric Value
+//									will be set to positive.
 //
-//			NativeNumberStr string
+//			NumSignVal.Negative() = Valid Value
 //
-//			A Native Number String representing the base
-//			numeric value used to generate these profile
-//			number string statistics.
+//			NumSignVal.Zero()     = Zero numeric values are
+//									automatically assigned
+//									NumSignVal.Zero().
 //
-//			A valid Native Number String must conform to the
-//			standardized formatting criteria defined below:
+//			NumSignVal.Positive() = Default value for
+//									non-zero numeric values.
 //
-//			 	1. A Native Number String Consists of numeric
-//			 	   character digits zero through nine inclusive
-//			 	   (0-9).
+//		If 'numberSign' is set to any value other than
+//		NumSignVal.Negative(), it will be ignored and
+//		the final number sign will be automatically
+//		assigned.
 //
-//			 	2. A Native Number String will include a period
-//			 	   or decimal point ('.') to separate integer and
-//			 	   fractional digits within a number string.
+//	 errorPrefix                interface{}
 //
-//			 	   Native Number String Floating Point Value:
-//			 	   				123.1234
+//		This object encapsulates error prefix text which is
+//		included in all returned error messages. Usually, it
+//		contains the name of the calling method or methods
+//		listed as a method or function chain of execution.
 //
-//			 	3. A Native Number String will always format
-//			 	   negative numeric values with a leading minus sign
-//			 	   ('-').
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//			 	   Native Number String Negative Value:
-//			 	   				-123.2
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1. nil - A nil value is valid and generates an empty
+//		   collection of error prefix and error context
+//		   information.
 //
-//			 	4. A Native Number String WILL NEVER include integer
-//			 	   separators such as commas (',') to separate
-//			 	   integer digits by thousands.
+//		2. string - A string containing error prefix
+//			information.
 //
-//			 	   					NOT THIS: 1,000,000
-//			 	   		Native Number String: 1000000
+//		3. []string A one-dimensional slice of strings
+//			containing error prefix information.
 //
-//			 	5. Native Number Strings will only consist of:
+//		4. [][2]string A two-dimensional slice of strings
+//		   containing error prefix and error context
+//		   information.
 //
-//			 	   (a)	Numeric digits zero through nine inclusive (0-9).
+//		5. ErrPrefixDto - An instance of ErrPrefixDto.
+//			Information from this object will be copied for use
+//			in error and informational messages.
 //
-//			 	   (b)	A decimal point ('.') for floating point
-//			 	   		numbers.
+//		6. *ErrPrefixDto - A pointer to an instance of
+//			ErrPrefixDto. Information from this object will be
+//			copied for use in error and informational messages.
 //
-//			 	   (c)	A leading minus sign ('-') in the case of
-//			 	   		negative numeric values.
+//		7. IBasicErrorPrefix - An interface to a method
+//			generating a two-dimensional slice of strings
+//			containing error prefix and error context
+//			information.
 //
-//		}
+//		If parameter 'errorPrefix' is NOT convertible to one
+//		of the valid types listed above, it will be
+//		considered invalid and trigger the return of an
+//		error.
 //
-//	err							error
+//		Types ErrPrefixDto and IBasicErrorPrefix are included
+//		in the 'errpref' software package,
+//		"github.com/MikeAustin71/errpref".
 //
-//		If this method completes successfully, the
-//		returned error Type is set equal to 'nil'. If
-//		errors are encountered during processing, the
-//		returned error Type will encapsulate an error
-//		message.
+// ----------------------------------------------------------------
 //
-//		If an error message is returned, the text value
-//		for input parameter 'errPrefDto' (error prefix)
-//		will be prefixed or attached at the beginning of
-//		the error message.
-func (numStrKernel *NumberStrKernel) SetFromPureNumberStr(
-	pureNumberStr string,
-	decSeparatorChars string,
-	leadingMinusSign bool,
-	roundingType NumberRoundingType,
-	roundToFractionalDigits int,
-	errorPrefix interface{}) (
-	pureNumStrComponents PureNumberStrComponents,
-	err error) {
-
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
-	}
-
-	numStrKernel.lock.Lock()
-
-	defer numStrKernel.lock.Unlock()
-
-	var ePrefix *ePref.ErrPrefixDto
-
-	ePrefix,
-		err = ePref.ErrPrefixDto{}.NewIEmpty(
-		errorPrefix,
-		"NumberStrKernel."+
-			"SetFromNativeNumberStr()",
-		"")
-
-	if err != nil {
-		return pureNumStrComponents, err
-	}
-
-	pureNumStrComponents,
-		err = new(numberStrKernelMechanics).
-		setNumStrKernelFromRoundedPureNumStr(
-			numStrKernel,
-			pureNumberStr,
-			decSeparatorChars,
-			leadingMinusSign,
-			roundingType,
-			roundToFractionalDigits,
-			ePrefix.XCpy(
-				"numStrKernel"))
-
-	return pureNumStrComponents, err
-}
-
-// SetNumberSign - Sets the Number Sign for the numeric value
-// represented by the current instance of NumberStrKernel.
+// # Return Values
 //
-// The Number Sign is specified by means of a
-// NumericSignValueType enumeration value.
+//	error
 //
-// Possible values are listed as follows:
+//		If this method completes successfully, the returned
+//		error Type is set equal to 'nil'.
 //
-//	NumSignVal.None()     = -2 - Invalid Value
-//	NumSignVal.Negative() = -1 - Valid Value
-//	NumSignVal.Zero()     =  0 - Valid Value
-//	NumSignVal.Positive() =  1 - Valid Value
-func (numStrKernel *NumberStrKernel) SetNumberSign(
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error message.
+//	 	This returned error message will incorporate the method
+//	 	chain and text passed by input parameter, 'errorPrefix'.
+//	 	The 'errorPrefix' text will be attached to the beginning
+//	 	of the error message.
+func (numStrKernel *NumberStrKernel) SetRuneDigits(
+	integerDigits []rune,
+	fractionalDigits []rune,
 	numberSign NumericSignValueType,
-	errorPrefix interface{}) (
-	err error) {
+	errorPrefix interface{}) error {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -11817,55 +15554,152 @@ func (numStrKernel *NumberStrKernel) SetNumberSign(
 	defer numStrKernel.lock.Unlock()
 
 	var ePrefix *ePref.ErrPrefixDto
+	var err error
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"SetNumberSign()",
+			"SetRuneDigits()",
 		"")
 
 	if err != nil {
 		return err
 	}
 
-	if !numberSign.XIsValid() {
-
-		err = fmt.Errorf("%v\n"+
-			"Error: Input parameter 'numberSign' is invalid\n"+
-			"Number Sign must be set to one of the following"+
-			"three valid values:\n"+
-			"  NumSignVal.Negative()\n"+
-			"  NumSignVal.Zero()\n"+
-			"  NumSignVal.Positive()"+
-			"Input parameter Number Sign Integer Value = '%v'\n"+
-			"Input parameter Number Sign  String Value = '%v'\n",
-			ePrefix.String(),
-			numberSign.XValueInt(),
-			numberSign.String())
-
-		return err
-	}
-
-	numStrKernel.numberSign = numberSign
-
-	return err
+	return new(numberStrKernelNanobot).setWithRunes(
+		numStrKernel,
+		integerDigits,
+		fractionalDigits,
+		numberSign,
+		ePrefix)
 }
 
-// SetNumberSignInt - Sets the Number Sign of the current
-// NumberStrKernel instance based on an integer input value.
+//	SetRuneDto
+//
+//	Deletes resets the internal values for the current
+//	instance of	NumberStrKernel using input parameters
+//	containing integer digits and fractional digits
+//	formatted as Rune Array Data Transfer Objects.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	This method will delete, overwrite and reset all
+//	pre-existing data values in the current instance of
+//	NumberStrKernel.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	integerDigits				*RuneArrayDto
+//
+//		A pointer to a rune array data transfer object used to
+//		configure the integer digits array contained within
+//		the current instance of NumberStrKernel.
+//
+//	fractionalDigits			*RuneArrayDto
+//
+//		A pointer to a rune array data transfer object used to
+//		configure the fractional digits array contained within
+//		the current instance of NumberStrKernel.
+//
+//	numberSign					NumericSignValueType
+//
+//		The Number Sign for the new numeric value is
+//		specified by means of a	NumericSignValueType
+//		enumeration value.
+//
+//		Possible values are listed as follows:
+//
+//			NumSignVal.None()     = Ignored. Numeric Value
+//									will be set to positive.
+//
+//			NumSignVal.Negative() = Valid Value
+//
+//			NumSignVal.Zero()     = Zero numeric values are
+//									automatically assigned
+//									NumSignVal.Zero().
+//
+//			NumSignVal.Positive() = Default value for
+//									non-zero numeric values.
+//
+//		If 'numberSign' is set to any value other than
+//		NumSignVal.Negative(), it will be ignored and
+//		the final number sign will be automatically
+//		assigned.
+//
+//	 errorPrefix                interface{}
+//
+//		This object encapsulates error prefix text which is
+//		included in all returned error messages. Usually, it
+//		contains the name of the calling method or methods
+//		listed as a method or function chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1. nil - A nil value is valid and generates an empty
+//		   collection of error prefix and error context
+//		   information.
+//
+//		2. string - A string containing error prefix
+//			information.
+//
+//		3. []string A one-dimensional slice of strings
+//			containing error prefix information.
+//
+//		4. [][2]string A two-dimensional slice of strings
+//		   containing error prefix and error context
+//		   information.
+//
+//		5. ErrPrefixDto - An instance of ErrPrefixDto.
+//			Information from this object will be copied for use
+//			in error and informational messages.
+//
+//		6. *ErrPrefixDto - A pointer to an instance of
+//			ErrPrefixDto. Information from this object will be
+//			copied for use in error and informational messages.
+//
+//		7. IBasicErrorPrefix - An interface to a method
+//			generating a two-dimensional slice of strings
+//			containing error prefix and error context
+//			information.
+//
+//		If parameter 'errorPrefix' is NOT convertible to one
+//		of the valid types listed above, it will be
+//		considered invalid and trigger the return of an
+//		error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are included
+//		in the 'errpref' software package,
+//		"github.com/MikeAustin71/errpref".
 //
-// Possible valid integer input values are listed as follows:
+// ----------------------------------------------------------------
 //
-//	-1 - Minus One
-//	 0 - Zero
-//	 1 - Plus One
+// # Return Values
 //
-// Invalid input value will generate an error.
-func (numStrKernel *NumberStrKernel) SetNumberSignInt(
-	numberSign int,
-	errorPrefix interface{}) (
-	err error) {
+//	error
+//
+//		If this method completes successfully, the returned
+//		error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error message.
+//	 	This returned error message will incorporate the method
+//	 	chain and text passed by input parameter, 'errorPrefix'.
+//	 	The 'errorPrefix' text will be attached to the beginning
+//	 	of the error message.
+func (numStrKernel *NumberStrKernel) SetRuneDto(
+	integerDigits *RuneArrayDto,
+	fractionalDigits *RuneArrayDto,
+	numberSign NumericSignValueType,
+	errorPrefix interface{}) error {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -11876,51 +15710,33 @@ func (numStrKernel *NumberStrKernel) SetNumberSignInt(
 	defer numStrKernel.lock.Unlock()
 
 	var ePrefix *ePref.ErrPrefixDto
+	var err error
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"SetNumberSignInt()",
+			"SetRuneDto()",
 		"")
 
 	if err != nil {
 		return err
 	}
 
-	if numberSign > 1 ||
-		numberSign < -1 {
-
-		err = fmt.Errorf("%v\n"+
-			"Error: Input parameter 'numberSign' is invalid!\n"+
-			"'numberSign' must be set to one of three values:"+
-			"     0 = Numeric Value of Zero ('0').\n"+
-			"     1 = + or positive number sign\n"+
-			"    -1 = - or negative number sign\n"+
-			"Input parameter 'numberSign' = '%v'\n",
-			ePrefix.String(),
-			numberSign)
-
-		return err
-	}
-
-	if numberSign == 0 {
-		numStrKernel.isNonZeroValue = false
-	} else {
-		numStrKernel.isNonZeroValue = true
-	}
-
-	numStrKernel.numberSign = NumericSignValueType(numberSign)
-
-	return err
+	return new(numberStrKernelNanobot).setWithRuneArrayDto(
+		numStrKernel,
+		integerDigits,
+		fractionalDigits,
+		numberSign,
+		ePrefix)
 }
 
-//	SetRuneDigits
+//	SetStringDigits
 //
 //	Deletes resets the internal values for the current
 //	instance of	NumberStrKernel using input parameters
 //	containing integer digit and fractional digit
-//	rune arrays.
+//	strings.
 //
 // ----------------------------------------------------------------
 //
@@ -11934,17 +15750,17 @@ func (numStrKernel *NumberStrKernel) SetNumberSignInt(
 //
 // # Input Parameters
 //
-//	integerDigits				[]rune
+//	integerDigits				string
 //
-//		This rune array contains the numeric digits used to
-//		populate the integer part of the numeric value
-//		contained in the current instance of NumberStrKernel.
+//		A string of numeric digits used to populate the
+//		integer part of the numeric value contained in the
+//		current instance of NumberStrKernel
 //
-//	fractionalDigits			[]rune
+//	fractionalDigits			string
 //
-//		This rune array contains the numeric digits used to
-//		populate the fractional part of the numeric value
-//		contained in the current instance of NumberStrKernel.
+//		A string of numeric digits used to populate the
+//		fractional part of the numeric value contained in
+//		the current instance of NumberStrKernel
 //
 //	numberSign					NumericSignValueType
 //
@@ -12035,104 +15851,267 @@ func (numStrKernel *NumberStrKernel) SetNumberSignInt(
 //	 	chain and text passed by input parameter, 'errorPrefix'.
 //	 	The 'errorPrefix' text will be attached to the beginning
 //	 	of the error message.
-func (numStrKernel *NumberStrKernel) SetRuneDigits(
-	integerDigits []rune,
-	fractionalDigits []rune,
+func (numStrKernel *NumberStrKernel) SetStringDigits(
+	integerDigits string,
+	fractionalDigits string,
 	numberSign NumericSignValueType,
 	errorPrefix interface{}) error {
 
-	if numStrKernel.lock == nil {
-		numStrKernel.lock = new(sync.Mutex)
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernel."+
+			"SetStringDigits()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(numberStrKernelNanobot).setWithRunes(
+		numStrKernel,
+		[]rune(integerDigits),
+		[]rune(fractionalDigits),
+		numberSign,
+		ePrefix)
+}
+
+// String
+//
+// Returns a formatted number string using the numeric
+// value and the Default Number String Format
+// Specification configured for the current instance of
+// NumberStrKernel.
+//
+// To explicitly set the Default Number String Format
+// Specification, use the following methods:
+//
+//	NumberStrKernel.SetDefaultNumStrFmtSpec()
+//	NumberStrKernel.SetDefaultNumStrFmtSpecPure()
+//	NumberStrKernel.SetDefaultSignedNumStrFmtSpecSimple()
+//
+// If the Default Number String Format is uninitialized
+// or invalid, it will be automatically set and defaulted
+// to the US (United States) Signed Number String Format
+// Specification.
+//
+// ----------------------------------------------------------------
+//
+// # BE ADVISED
+//
+//	(1)	No rounding will be performed on the numeric
+//		value formatted as a returned number string. If
+//		rounding is required in conjunction with the
+//		Default Format Number String Formatting
+//		Specification, the user has two options.
+//
+//		a.	Call method NumberStrKernel.Round() and
+//			then call this method, NumberStrKernel.String().
+//
+//		b.	Do not call this method. Instead, call
+//			method NumberStrKernel.FmtNumStrDefaultRound().
+//
+//	(2)	This method will NOT delete or modify data values
+//		contained in the current instance of
+//		NumberStrKernel. However, if the Default
+//		NumberStrKernel Number String Format
+//		Specification is invalid, internal member
+//		variable 'NumberStrKernel.numStrFormatSpec' will
+//		be set to the default US (United States) Signed
+//		Number String Format Specification.
+//
+//		All other NumberStrKernel data values will remain
+//		unchanged.
+//
+//	(3)	If errors are encountered, the returned string
+//		will be configured with an appropriate error
+//		message.
+//
+//	(4)	This method is very similar to method:
+//
+//			NumberStrKernel.FmtNumStrDefault()
+//
+//		The only difference is that in case of error,
+//		this method DOES NOT return a type 'error'.
+//		Instead, the error message is configured in the
+//		returned 'string'.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	None
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	string
+//
+//		If this method completes successfully, a
+//		formatted Number String will be returned.
+//
+//		The Number String format used in configuring
+//		this Number String is taken from the Default
+//		Number String Formatting Specification previously
+//		configured in the current instance of
+//		NumberStrKernel.
+//
+//		If errors are encountered, this returned string
+//		will be configured with an appropriate error
+//		message.
+func (numStrKernel *NumberStrKernel) String() string {
+
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
+	}
+
+	numStrKernel.lock.Lock()
+
+	defer numStrKernel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+	var numStr string
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		nil,
+		"NumberStrKernel."+
+			"String()",
+		"")
+
+	if err != nil {
+
+		numStr = err.Error()
+
+		return numStr
+	}
+
+	// If numStrKernel.numStrFormatSpec
+	// is a NOP, it will be set to default
+	// US Signed Number Minus Format Spec
+	_,
+		err = new(numberStrKernelAtom).
+		testValidityOfNumStrKernel(
+			numStrKernel,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	if err != nil {
+
+		numStr = err.Error()
+		return numStr
+	}
+
+	var roundingSpec NumStrRoundingSpec
+
+	roundingSpec,
+		err = new(NumStrRoundingSpec).
+		NewRoundingSpec(
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy("roundingSpec"))
+
+	if err != nil {
+
+		numStr = err.Error()
+		return numStr
 	}
 
-	numStrKernel.lock.Lock()
+	var tempNumStrFormatSpec NumStrFormatSpec
 
-	defer numStrKernel.lock.Unlock()
+	err = tempNumStrFormatSpec.CopyIn(
+		&numStrKernel.numStrFormatSpec,
+		ePrefix)
 
-	var ePrefix *ePref.ErrPrefixDto
-	var err error
+	if err != nil {
 
-	ePrefix,
-		err = ePref.ErrPrefixDto{}.NewIEmpty(
-		errorPrefix,
-		"NumberStrKernel."+
-			"SetRuneDigits()",
-		"")
+		numStr = err.Error()
+		return numStr
+	}
+
+	numStr,
+		err = new(numberStrKernelMolecule).formatNumStr(
+		numStrKernel,
+		roundingSpec,
+		numStrKernel.numStrFormatSpec,
+		ePrefix.XCpy("numStrKernel"))
 
 	if err != nil {
-		return err
+
+		numStr = err.Error()
 	}
 
-	return new(numberStrKernelNanobot).setWithRunes(
-		numStrKernel,
-		integerDigits,
-		fractionalDigits,
-		numberSign,
-		ePrefix)
+	return numStr
 }
 
-//	SetRuneDto
-//
-//	Deletes resets the internal values for the current
-//	instance of	NumberStrKernel using input parameters
-//	containing integer digits and fractional digits
-//	formatted as Rune Array Data Transfer Objects.
-//
-// ----------------------------------------------------------------
+//	Subtract
 //
-// # IMPORTANT
-//
-//	This method will delete, overwrite and reset all
-//	pre-existing data values in the current instance of
+//	Subtracts the numeric value of an incoming instance
+//	of NumberStrKernel ('subtrahend') from the numeric
+//	value of the current NumberStrKernel instance and
+//	returns the difference as a new instance of
 //	NumberStrKernel.
 //
-// ----------------------------------------------------------------
-//
-// # Input Parameters
-//
-//	integerDigits				*RuneArrayDto
+//	The subtraction is performed directly on the integer
+//	and fractional digit arrays. No conversion to
+//	float64, big.Float or big.Rat is performed.
+//	Therefore, the returned difference is exact.
 //
-//		A pointer to a rune array data transfer object used to
-//		configure the integer digits array contained within
-//		the current instance of NumberStrKernel.
+//	The number of fractional digits in the returned
+//	difference is equal to the greater number of
+//	fractional digits contained in the two operands.
 //
-//	fractionalDigits			*RuneArrayDto
+//		Example
+//			Current Instance:	10.5
+//			subtrahend:			20.75
+//			difference:			-10.25
 //
-//		A pointer to a rune array data transfer object used to
-//		configure the fractional digits array contained within
-//		the current instance of NumberStrKernel.
+//	The returned difference will be configured with the
+//	Default Number String Format Specification copied
+//	from the current instance of NumberStrKernel.
 //
-//	numberSign					NumericSignValueType
+// ----------------------------------------------------------------
 //
-//		The Number Sign for the new numeric value is
-//		specified by means of a	NumericSignValueType
-//		enumeration value.
+// # BE ADVISED
 //
-//		Possible values are listed as follows:
+//	Neither the current instance of NumberStrKernel nor
+//	the input parameter 'subtrahend' will be modified by
+//	this method.
 //
-//			NumSignVal.None()     = Ignored. Numeric Value
-//									will be set to positive.
+// ----------------------------------------------------------------
 //
-//			NumSignVal.Negative() = Valid Value
+// # Input Parameters
 //
-//			NumSignVal.Zero()     = Zero numeric values are
-//									automatically assigned
-//									NumSignVal.Zero().
+//	subtrahend					*NumberStrKernel
 //
-//			NumSignVal.Positive() = Default value for
-//									non-zero numeric values.
+//		A pointer to an instance of NumberStrKernel. The
+//		numeric value of this instance will be subtracted
+//		from that of the current instance of
+//		NumberStrKernel.
 //
-//		If 'numberSign' is set to any value other than
-//		NumSignVal.Negative(), it will be ignored and
-//		the final number sign will be automatically
-//		assigned.
+//		If 'subtrahend' is invalid, an error will be
+//		returned.
 //
-//	 errorPrefix                interface{}
+//	errorPrefix					interface{}
 //
-//		This object encapsulates error prefix text which is
-//		included in all returned error messages. Usually, it
-//		contains the name of the calling method or methods
-//		listed as a method or function chain of execution.
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
 //		If no error prefix information is needed, set this
 //		parameter to 'nil'.
@@ -12140,62 +16119,80 @@ func (numStrKernel *NumberStrKernel) SetRuneDigits(
 //		This empty interface must be convertible to one of
 //		the following types:
 //
-//		1. nil - A nil value is valid and generates an empty
-//		   collection of error prefix and error context
-//		   information.
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//		2. string - A string containing error prefix
-//			information.
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//		3. []string A one-dimensional slice of strings
-//			containing error prefix information.
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//		4. [][2]string A two-dimensional slice of strings
-//		   containing error prefix and error context
-//		   information.
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//		5. ErrPrefixDto - An instance of ErrPrefixDto.
-//			Information from this object will be copied for use
-//			in error and informational messages.
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//		6. *ErrPrefixDto - A pointer to an instance of
-//			ErrPrefixDto. Information from this object will be
-//			copied for use in error and informational messages.
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//		7. IBasicErrorPrefix - An interface to a method
-//			generating a two-dimensional slice of strings
-//			containing error prefix and error context
-//			information.
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//		If parameter 'errorPrefix' is NOT convertible to one
-//		of the valid types listed above, it will be
-//		considered invalid and trigger the return of an
-//		error.
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
 //
-//		Types ErrPrefixDto and IBasicErrorPrefix are included
-//		in the 'errpref' software package,
-//		"github.com/MikeAustin71/errpref".
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
 // ----------------------------------------------------------------
 //
 // # Return Values
 //
-//	error
+//	difference					NumberStrKernel
 //
-//		If this method completes successfully, the returned
-//		error Type is set equal to 'nil'.
+//		If this method completes successfully, a new
+//		instance of NumberStrKernel will be returned
+//		containing the result of subtracting
+//		'subtrahend' from the current NumberStrKernel
+//		numeric value.
 //
-//		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error message.
-//	 	This returned error message will incorporate the method
-//	 	chain and text passed by input parameter, 'errorPrefix'.
-//	 	The 'errorPrefix' text will be attached to the beginning
-//	 	of the error message.
-func (numStrKernel *NumberStrKernel) SetRuneDto(
-	integerDigits *RuneArrayDto,
-	fractionalDigits *RuneArrayDto,
-	numberSign NumericSignValueType,
-	errorPrefix interface{}) error {
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernel *NumberStrKernel) Subtract(
+	subtrahend *NumberStrKernel,
+	errorPrefix interface{}) (
+	difference NumberStrKernel,
+	err error) {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -12206,131 +16203,88 @@ func (numStrKernel *NumberStrKernel) SetRuneDto(
 	defer numStrKernel.lock.Unlock()
 
 	var ePrefix *ePref.ErrPrefixDto
-	var err error
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumberStrKernel."+
-			"SetRuneDto()",
+			"Subtract()",
 		"")
 
 	if err != nil {
-		return err
+		return difference, err
 	}
 
-	return new(numberStrKernelNanobot).setWithRuneArrayDto(
-		numStrKernel,
-		integerDigits,
-		fractionalDigits,
-		numberSign,
-		ePrefix)
+	err = new(numStrMathArithmeticNanobot).
+		subtractNumStrKernels(
+			&difference,
+			numStrKernel,
+			subtrahend,
+			ePrefix.XCpy(
+				"difference<-numStrKernel-subtrahend"))
+
+	return difference, err
 }
 
-//	SetStringDigits
-//
-//	Deletes resets the internal values for the current
-//	instance of	NumberStrKernel using input parameters
-//	containing integer digit and fractional digit
-//	strings.
-//
-// ----------------------------------------------------------------
-//
-// # IMPORTANT
-//
-//	This method will delete, overwrite and reset all
-//	pre-existing data values in the current instance of
-//	NumberStrKernel.
-//
-// ----------------------------------------------------------------
-//
-// # Input Parameters
-//
-//	integerDigits				string
-//
-//		A string of numeric digits used to populate the
-//		integer part of the numeric value contained in the
-//		current instance of NumberStrKernel
-//
-//	fractionalDigits			string
-//
-//		A string of numeric digits used to populate the
-//		fractional part of the numeric value contained in
-//		the current instance of NumberStrKernel
-//
-//	numberSign					NumericSignValueType
-//
-//		The Number Sign for the new numeric value is
-//		specified by means of a	NumericSignValueType
-//		enumeration value.
-//
-//		Possible values are listed as follows:
+// UnmarshalJSON
 //
-//			NumSignVal.None()     = Ignored. Numeric Value
-//									will be set to positive.
-//
-//			NumSignVal.Negative() = Valid Value
-//
-//			NumSignVal.Zero()     = Zero numeric values are
-//									automatically assigned
-//									NumSignVal.Zero().
-//
-//			NumSignVal.Positive() = Default value for
-//									non-zero numeric values.
-//
-//		If 'numberSign' is set to any value other than
-//		NumSignVal.Negative(), it will be ignored and
-//		the final number sign will be automatically
-//		assigned.
+// Implements the 'json.Unmarshaler' interface for type
+// NumberStrKernel.
 //
-//	 errorPrefix                interface{}
+// The JSON value passed by input parameter 'data' is
+// parsed directly into the integer and fractional digit
+// arrays of the current NumberStrKernel instance. No
+// conversion to float64 is performed. Therefore, the
+// parsed numeric value is exact and no precision is lost
+// regardless of the number of digits.
 //
-//		This object encapsulates error prefix text which is
-//		included in all returned error messages. Usually, it
-//		contains the name of the calling method or methods
-//		listed as a method or function chain of execution.
+// The JSON value may be a bare JSON number or a JSON
+// string containing a Native Number String or an
+// E-Notation number string.
 //
-//		If no error prefix information is needed, set this
-//		parameter to 'nil'.
+//	Examples:
+//		-1234.5678
+//		"-1234.5678"
+//		6.022E+23
+//		"123456789012345678901234567890.123456789"
 //
-//		This empty interface must be convertible to one of
-//		the following types:
+// Consistent with the conventions of the 'encoding/json'
+// package, the JSON value 'null' is treated as a no-op
+// and the current NumberStrKernel instance is NOT
+// modified.
 //
-//		1. nil - A nil value is valid and generates an empty
-//		   collection of error prefix and error context
-//		   information.
+// Empty JSON strings (""), and JSON strings consisting
+// entirely of white space, do NOT represent a numeric
+// value and will trigger an error.
 //
-//		2. string - A string containing error prefix
-//			information.
+// ----------------------------------------------------------------
 //
-//		3. []string A one-dimensional slice of strings
-//			containing error prefix information.
+// # BE ADVISED
 //
-//		4. [][2]string A two-dimensional slice of strings
-//		   containing error prefix and error context
-//		   information.
+//	Only the numeric value of the current
+//	NumberStrKernel instance is modified by this method.
+//	The Default Number String Format Specification and
+//	the JSON Marshal Format are NOT changed.
 //
-//		5. ErrPrefixDto - An instance of ErrPrefixDto.
-//			Information from this object will be copied for use
-//			in error and informational messages.
+//	If an error is returned, the current instance of
+//	NumberStrKernel will NOT be modified.
 //
-//		6. *ErrPrefixDto - A pointer to an instance of
-//			ErrPrefixDto. Information from this object will be
-//			copied for use in error and informational messages.
+//	Decoded numeric values are limited to 10,000
+//	numeric digits. For E-Notation number strings, this
+//	limit includes the digits added by expanding the
+//	exponent. Values exceeding this limit are rejected
+//	with an error.
 //
-//		7. IBasicErrorPrefix - An interface to a method
-//			generating a two-dimensional slice of strings
-//			containing error prefix and error context
-//			information.
+// ----------------------------------------------------------------
 //
-//		If parameter 'errorPrefix' is NOT convertible to one
-//		of the valid types listed above, it will be
-//		considered invalid and trigger the return of an
-//		error.
+// # Input Parameters
 //
-//		Types ErrPrefixDto and IBasicErrorPrefix are included
-//		in the 'errpref' software package,
-//		"github.com/MikeAustin71/errpref".
+//	data						[]byte
+//
+//		The JSON encoding of a numeric value. If 'data'
+//		is neither 'null', a valid JSON number nor a
+//		valid JSON string containing a number string, an
+//		error will be returned.
 //
 // ----------------------------------------------------------------
 //
@@ -12338,20 +16292,13 @@ func (numStrKernel *NumberStrKernel) SetRuneDto(
 //
 //	error
 //
-//		If this method completes successfully, the returned
-//		error Type is set equal to 'nil'.
-//
-//		If errors are encountered during processing, the
-//		returned error Type will encapsulate an error message.
-//	 	This returned error message will incorporate the method
-//	 	chain and text passed by input parameter, 'errorPrefix'.
-//	 	The 'errorPrefix' text will be attached to the beginning
-//	 	of the error message.
-func (numStrKernel *NumberStrKernel) SetStringDigits(
-	integerDigits string,
-	fractionalDigits string,
-	numberSign NumericSignValueType,
-	errorPrefix interface{}) error {
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+func (numStrKernel *NumberStrKernel) UnmarshalJSON(
+	data []byte) error {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -12366,108 +16313,127 @@ func (numStrKernel *NumberStrKernel) SetStringDigits(
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
-		errorPrefix,
+		nil,
 		"NumberStrKernel."+
-			"SetStringDigits()",
+			"UnmarshalJSON()",
 		"")
 
 	if err != nil {
 		return err
 	}
 
-	return new(numberStrKernelNanobot).setWithRunes(
-		numStrKernel,
-		[]rune(integerDigits),
-		[]rune(fractionalDigits),
-		numberSign,
-		ePrefix)
+	jsonStr := strings.TrimSpace(string(data))
+
+	if jsonStr == "null" {
+		return err
+	}
+
+	if len(jsonStr) > 0 &&
+		jsonStr[0] == '"' {
+
+		var encodedNumStr string
+
+		err = json.Unmarshal(
+			[]byte(jsonStr),
+			&encodedNumStr)
+
+		if err != nil {
+
+			return fmt.Errorf("%v\n"+
+				"Error: Input parameter 'data' contains an invalid JSON string.\n"+
+				"data = '%v'\n"+
+				"Error=\n%v\n",
+				ePrefix.String(),
+				jsonStr,
+				err.Error())
+		}
+
+		jsonStr = encodedNumStr
+
+	} else if len(jsonStr) == 0 {
+
+		return fmt.Errorf("%v\n"+
+			"Error: Input parameter 'data' is empty!\n",
+			ePrefix.String())
+	}
+
+	return new(numberStrKernelMechanics).
+		setNumStrKernelFromEncodedNumStr(
+			numStrKernel,
+			jsonStr,
+			ePrefix.XCpy(
+				"numStrKernel<-data"))
 }
 
-// String
+// UnmarshalText
 //
-// Returns a formatted number string using the numeric
-// value and the Default Number String Format
-// Specification configured for the current instance of
-// NumberStrKernel.
+// Implements the 'encoding.TextUnmarshaler' interface
+// for type NumberStrKernel.
 //
-// To explicitly set the Default Number String Format
-// Specification, use the following methods:
+// The text passed by input parameter 'text' is parsed
+// directly into the integer and fractional digit arrays
+// of the current NumberStrKernel instance. No conversion
+// to float64 is performed. Therefore, the parsed numeric
+// value is exact and no precision is lost regardless of
+// the number of digits.
 //
-//	NumberStrKernel.SetDefaultNumStrFmtSpec()
-//	NumberStrKernel.SetDefaultNumStrFmtSpecPure()
-//	NumberStrKernel.SetDefaultSignedNumStrFmtSpecSimple()
+// Leading and trailing white space is ignored. The text
+// may be formatted as a Native Number String with an
+// optional leading plus sign ('+') or as an E-Notation
+// number string using the period ('.') as a decimal
+// separator.
 //
-// If the Default Number String Format is uninitialized
-// or invalid, it will be automatically set and defaulted
-// to the US (United States) Signed Number String Format
-// Specification.
+//	Examples:
+//		"-1234.5678"
+//		"+42"
+//		"6.022E+23"
+//
+// If 'text' is empty, or consists entirely of white
+// space, an error will be returned and the current
+// NumberStrKernel instance will NOT be modified.
 //
 // ----------------------------------------------------------------
 //
 // # BE ADVISED
 //
-//	(1)	No rounding will be performed on the numeric
-//		value formatted as a returned number string. If
-//		rounding is required in conjunction with the
-//		Default Format Number String Formatting
-//		Specification, the user has two options.
-//
-//		a.	Call method NumberStrKernel.Round() and
-//			then call this method, NumberStrKernel.String().
-//
-//		b.	Do not call this method. Instead, call
-//			method NumberStrKernel.FmtNumStrDefaultRound().
-//
-//	(2)	This method will NOT delete or modify data values
-//		contained in the current instance of
-//		NumberStrKernel. However, if the Default
-//		NumberStrKernel Number String Format
-//		Specification is invalid, internal member
-//		variable 'NumberStrKernel.numStrFormatSpec' will
-//		be set to the default US (United States) Signed
-//		Number String Format Specification.
-//
-//		All other NumberStrKernel data values will remain
-//		unchanged.
-//
-//	(3)	If errors are encountered, the returned string
-//		will be configured with an appropriate error
-//		message.
-//
-//	(4)	This method is very similar to method:
+//	Only the numeric value of the current
+//	NumberStrKernel instance is modified by this method.
+//	The Default Number String Format Specification and
+//	the JSON Marshal Format are NOT changed.
 //
-//			NumberStrKernel.FmtNumStrDefault()
+//	If an error is returned, the current instance of
+//	NumberStrKernel will NOT be modified.
 //
-//		The only difference is that in case of error,
-//		this method DOES NOT return a type 'error'.
-//		Instead, the error message is configured in the
-//		returned 'string'.
+//	Decoded numeric values are limited to 10,000
+//	numeric digits. For E-Notation number strings, this
+//	limit includes the digits added by expanding the
+//	exponent. Values exceeding this limit are rejected
+//	with an error.
 //
 // ----------------------------------------------------------------
 //
 // # Input Parameters
 //
-//	None
+//	text						[]byte
+//
+//		The text encoding of a numeric value. If 'text'
+//		is neither a valid Native Number String nor a
+//		valid E-Notation number string, an error will be
+//		returned.
 //
 // ----------------------------------------------------------------
 //
 // # Return Values
 //
-//	string
-//
-//		If this method completes successfully, a
-//		formatted Number String will be returned.
-//
-//		The Number String format used in configuring
-//		this Number String is taken from the Default
-//		Number String Formatting Specification previously
-//		configured in the current instance of
-//		NumberStrKernel.
+//	error
 //
-//		If errors are encountered, this returned string
-//		will be configured with an appropriate error
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
 //		message.
-func (numStrKernel *NumberStrKernel) String() string {
+func (numStrKernel *NumberStrKernel) UnmarshalText(
+	text []byte) error {
 
 	if numStrKernel.lock == nil {
 		numStrKernel.lock = new(sync.Mutex)
@@ -12479,76 +16445,124 @@ func (numStrKernel *NumberStrKernel) String() string {
 
 	var ePrefix *ePref.ErrPrefixDto
 	var err error
-	var numStr string
 
 	ePrefix,
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		nil,
 		"NumberStrKernel."+
-			"String()",
+			"UnmarshalText()",
 		"")
 
 	if err != nil {
-
-		numStr = err.Error()
-
-		return numStr
+		return err
 	}
 
-	// If numStrKernel.numStrFormatSpec
-	// is a NOP, it will be set to default
-	// US Signed Number Minus Format Spec
-	_,
-		err = new(numberStrKernelAtom).
-		testValidityOfNumStrKernel(
+	return new(numberStrKernelMechanics).
+		setNumStrKernelFromEncodedNumStr(
 			numStrKernel,
+			string(text),
 			ePrefix.XCpy(
-				"numStrKernel"))
+				"numStrKernel<-text"))
+}
 
-	if err != nil {
+// Value
+//
+// Implements the 'driver.Valuer' interface for type
+// NumberStrKernel.
+//
+// The numeric value of the current NumberStrKernel
+// instance is returned as a Native Number String. No
+// rounding is applied and no conversion to float64 is
+// performed. Native Number Strings are accepted by most
+// databases for DECIMAL, NUMERIC and text columns.
+//
+//	Example:	"-1234.5678"
+//
+// If the current instance of NumberStrKernel is empty
+// and contains zero integer digits and zero fractional
+// digits, a 'nil' value, signaling a database NULL
+// value, will be returned.
+//
+// ----------------------------------------------------------------
+//
+// # BE ADVISED
+//
+//	This method is implemented with a value receiver.
+//	This allows both NumberStrKernel values and pointers
+//	to be passed as arguments to 'database/sql' methods.
+//
+//	The current instance of NumberStrKernel will NOT be
+//	modified.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	-- NONE --
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	driver.Value
+//
+//		If this method completes successfully, this
+//		value will contain the numeric value of the
+//		current NumberStrKernel instance formatted as a
+//		Native Number String of type 'string'.
+//
+//		If the current instance of NumberStrKernel is
+//		empty, this value is set to 'nil'.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+func (numStrKernel NumberStrKernel) Value() (
+	driver.Value,
+	error) {
 
-		numStr = err.Error()
-		return numStr
+	if numStrKernel.lock == nil {
+		numStrKernel.lock = new(sync.Mutex)
 	}
 
-	var roundingSpec NumStrRoundingSpec
-
-	roundingSpec,
-		err = new(NumStrRoundingSpec).
-		NewRoundingSpec(
-			NumRoundType.NoRounding(),
-			0,
-			ePrefix.XCpy("roundingSpec"))
-
-	if err != nil {
+	numStrKernel.lock.Lock()
 
-		numStr = err.Error()
-		return numStr
-	}
+	defer numStrKernel.lock.Unlock()
 
-	var tempNumStrFormatSpec NumStrFormatSpec
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
 
-	err = tempNumStrFormatSpec.CopyIn(
-		&numStrKernel.numStrFormatSpec,
-		ePrefix)
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		nil,
+		"NumberStrKernel."+
+			"Value()",
+		"")
 
 	if err != nil {
-
-		numStr = err.Error()
-		return numStr
+		return nil, err
 	}
 
-	numStr,
-		err = new(numberStrKernelMolecule).formatNumStr(
-		numStrKernel,
-		roundingSpec,
-		numStrKernel.numStrFormatSpec,
-		ePrefix.XCpy("numStrKernel"))
+	var nativeNumStr string
+
+	nativeNumStr,
+		err = new(numberStrKernelMolecule).
+		getMarshalNumStr(
+			&numStrKernel,
+			ePrefix.XCpy(
+				"nativeNumStr<-numStrKernel"))
 
 	if err != nil {
+		return nil, err
+	}
 
-		numStr = err.Error()
+	if len(nativeNumStr) == 0 {
+		return nil, err
 	}
 
-	return numStr
+	return nativeNumStr, err
 }
@@ -3,6 +3,9 @@ package strmech
 import (
 	"fmt"
 	ePref "github.com/MikeAustin71/errpref"
+	"math/big"
+	"strconv"
+	"strings"
 	"sync"
 )
 
@@ -694,6 +697,271 @@ func (numStrKernelAtom *numberStrKernelAtom) emptyIntegerDigits(
 	return err
 }
 
+// formatDigitCountNumStr
+//
+// Formats the numeric value of a NumberStrKernel as a
+// number string after applying a Digit Count
+// Specification.
+//
+// A copy of 'numStrKernel' is first rounded according to
+// 'roundingSpec'. Next, fractional digits in excess of
+// the maximum specified by 'digitCountSpec' are rounded
+// away, trailing fractional zeros are removed until the
+// minimum number of fractional digits is reached and
+// short fractional values are padded with zeros. Finally,
+// the integer digits are padded with leading zeros to the
+// minimum number of integer digits and the result is
+// formatted using the standard number string format
+// elements.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		numeric value of this instance will be formatted.
+//		This instance will NOT be modified.
+//
+//	roundingSpec				NumStrRoundingSpec
+//
+//		The Number String Rounding Specification applied
+//		to the numeric value before the digit counts are
+//		applied.
+//
+//	digitCountSpec				NumStrDigitCountSpec
+//
+//		Specifies the minimum number of integer digits
+//		and the minimum and maximum number of fractional
+//		digits. If this specification is NOP, all digits
+//		remaining after rounding are displayed.
+//
+//	decSeparator				DecimalSeparatorSpec
+//
+//		The Decimal Separator Specification applied to
+//		the numeric value.
+//
+//	intSeparatorSpec			IntegerSeparatorSpec
+//
+//		The Integer Separator Specification applied to
+//		the integer digits of the numeric value.
+//
+//	negativeNumberSign			NumStrNumberSymbolSpec
+//
+//		The Number String Negative Number Sign
+//		Specification.
+//
+//	positiveNumberSign			NumStrNumberSymbolSpec
+//
+//		The Number String Positive Number Sign
+//		Specification.
+//
+//	zeroNumberSign				NumStrNumberSymbolSpec
+//
+//		The Number String Zero Number Sign
+//		Specification.
+//
+//	currencySymbol				NumStrNumberSymbolSpec
+//
+//		The Number String Currency Symbol Specification.
+//
+//	numberFieldSpec				NumStrNumberFieldSpec
+//
+//		This Number Field Specification contains the
+//		field length and text justification parameters
+//		used to display the formatted number string.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	numStr						string
+//
+//		If this method completes successfully, the
+//		numeric value of 'numStrKernel' will be returned
+//		as a formatted number string.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelAtom *numberStrKernelAtom) formatDigitCountNumStr(
+	numStrKernel *NumberStrKernel,
+	roundingSpec NumStrRoundingSpec,
+	digitCountSpec NumStrDigitCountSpec,
+	decSeparator DecimalSeparatorSpec,
+	intSeparatorSpec IntegerSeparatorSpec,
+	negativeNumberSign NumStrNumberSymbolSpec,
+	positiveNumberSign NumStrNumberSymbolSpec,
+	zeroNumberSign NumStrNumberSymbolSpec,
+	currencySymbol NumStrNumberSymbolSpec,
+	numberFieldSpec NumStrNumberFieldSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	numStr string,
+	err error) {
+
+	if numStrKernelAtom.lock == nil {
+		numStrKernelAtom.lock = new(sync.Mutex)
+	}
+
+	numStrKernelAtom.lock.Lock()
+
+	defer numStrKernelAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelAtom."+
+			"formatDigitCountNumStr()",
+		"")
+
+	if err != nil {
+
+		return numStr, err
+	}
+
+	if numStrKernel == nil {
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return numStr, err
+	}
+
+	err = roundingSpec.IsValidInstanceError(
+		ePrefix.XCpy(
+			"roundingSpec"))
+
+	if err != nil {
+
+		return numStr, err
+	}
+
+	err = digitCountSpec.IsValidInstanceError(
+		ePrefix.XCpy(
+			"digitCountSpec"))
+
+	if err != nil {
+
+		return numStr, err
+	}
+
+	var newNumStrKernel NumberStrKernel
+
+	err = new(numberStrKernelNanobot).copy(
+		&newNumStrKernel,
+		numStrKernel,
+		ePrefix.XCpy(
+			"newNumStrKernel<-numStrKernel"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	numStrMathRound := numStrMathRoundingNanobot{}
+
+	err = numStrMathRound.roundNumStrKernel(
+		&newNumStrKernel,
+		roundingSpec,
+		ePrefix.XCpy(
+			"newNumStrKernel Rounding"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	if digitCountSpec.IsNOP() {
+
+		digitCountSpec.maxFractionalDigits = -1
+
+		digitCountSpec.roundingType = NumRoundType.NoRounding()
+	}
+
+	if digitCountSpec.roundingType != NumRoundType.NoRounding() &&
+		digitCountSpec.maxFractionalDigits > -1 &&
+		newNumStrKernel.GetNumberOfFractionalDigits() >
+			digitCountSpec.maxFractionalDigits {
+
+		var digitCountRounding NumStrRoundingSpec
+
+		digitCountRounding,
+			err = new(NumStrRoundingSpec).NewRoundingSpec(
+			digitCountSpec.roundingType,
+			digitCountSpec.maxFractionalDigits,
+			ePrefix.XCpy(
+				"digitCountRounding"))
+
+		if err != nil {
+			return numStr, err
+		}
+
+		err = numStrMathRound.roundNumStrKernel(
+			&newNumStrKernel,
+			digitCountRounding,
+			ePrefix.XCpy(
+				"newNumStrKernel<-digitCountRounding"))
+
+		if err != nil {
+			return numStr, err
+		}
+	}
+
+	fracDigits := newNumStrKernel.fractionalDigits.CharsArray
+
+	for len(fracDigits) > digitCountSpec.minFractionalDigits &&
+		fracDigits[len(fracDigits)-1] == '0' {
+
+		fracDigits = fracDigits[:len(fracDigits)-1]
+	}
+
+	for len(fracDigits) < digitCountSpec.minFractionalDigits {
+
+		fracDigits = append(fracDigits, '0')
+	}
+
+	newNumStrKernel.fractionalDigits.CharsArray = fracDigits
+
+	return new(numStrHelperNanobot).formatNumStrElements(
+		&newNumStrKernel.integerDigits,
+		&newNumStrKernel.fractionalDigits,
+		newNumStrKernel.numberSign,
+		decSeparator,
+		intSeparatorSpec,
+		negativeNumberSign,
+		positiveNumberSign,
+		zeroNumberSign,
+		currencySymbol,
+		digitCountSpec.minIntegerDigits,
+		numberFieldSpec,
+		ePrefix.XCpy(
+			"<-newNumStrKernel"))
+}
+
 //	formatNumStrElements
 //
 //	Creates and returns a fully formatted Number String
@@ -1412,11 +1680,1856 @@ func (numStrKernelAtom *numberStrKernelAtom) formatNumStrElements(
 		positiveNumberSign,
 		zeroNumberSign,
 		currencySymbol,
+		1,
 		numberFieldSpec,
 		ePrefix.XCpy(
 			"<-newNumStrKernel"))
 }
 
+// formatOrdinalNumStr
+//
+// Formats the integer value of a NumberStrKernel as an
+// ordinal number ("1st", "1er", "1.").
+//
+// The numeric value of 'numStrKernel' is first rounded
+// according to 'roundingSpec'. If the rounded value is
+// negative or contains non-zero fractional digits, an
+// error will be returned.
+//
+// Only the Positive and Zero Number Sign Specifications
+// are applied to the formatted ordinal number.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		integer value of this instance will be formatted.
+//		This instance will NOT be modified.
+//
+//	roundingSpec				NumStrRoundingSpec
+//
+//		The Number String Rounding Specification applied
+//		to a copy of 'numStrKernel' before formatting.
+//
+//	ordinalFmtSpec				NumStrOrdinalFormatSpec
+//
+//		Specifies the language, grammatical gender and
+//		superscript options for the formatted ordinal
+//		number. If this specification is NOP, an error
+//		will be returned.
+//
+//	positiveNumberSign			NumStrNumberSymbolSpec
+//
+//		The Number String Positive Number Sign
+//		Specification applied to positive ordinal
+//		numbers.
+//
+//	zeroNumberSign				NumStrNumberSymbolSpec
+//
+//		The Number String Zero Number Sign Specification
+//		applied when the ordinal number is zero.
+//
+//	numberFieldSpec				NumStrNumberFieldSpec
+//
+//		This Number Field Specification contains the
+//		field length and text justification parameters
+//		used to display the formatted number string.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	numStr						string
+//
+//		If this method completes successfully, the
+//		integer value of 'numStrKernel' will be returned
+//		as a formatted ordinal number.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelAtom *numberStrKernelAtom) formatOrdinalNumStr(
+	numStrKernel *NumberStrKernel,
+	roundingSpec NumStrRoundingSpec,
+	ordinalFmtSpec NumStrOrdinalFormatSpec,
+	positiveNumberSign NumStrNumberSymbolSpec,
+	zeroNumberSign NumStrNumberSymbolSpec,
+	numberFieldSpec NumStrNumberFieldSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	numStr string,
+	err error) {
+
+	if numStrKernelAtom.lock == nil {
+		numStrKernelAtom.lock = new(sync.Mutex)
+	}
+
+	numStrKernelAtom.lock.Lock()
+
+	defer numStrKernelAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelAtom."+
+			"formatOrdinalNumStr()",
+		"")
+
+	if err != nil {
+
+		return numStr, err
+	}
+
+	if numStrKernel == nil {
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return numStr, err
+	}
+
+	if ordinalFmtSpec.ordinalFmtType !=
+		NumStrFmtType.Ordinal() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'ordinalFmtSpec' is invalid!\n"+
+			"'ordinalFmtSpec' is NOP and has not been configured\n"+
+			"for ordinal number formatting.\n",
+			ePrefix.String())
+
+		return numStr, err
+	}
+
+	var newNumStrKernel NumberStrKernel
+
+	err = new(numberStrKernelNanobot).copy(
+		&newNumStrKernel,
+		numStrKernel,
+		ePrefix.XCpy(
+			"newNumStrKernel<-numStrKernel"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	err = new(numStrMathRoundingNanobot).roundNumStrKernel(
+		&newNumStrKernel,
+		roundingSpec,
+		ePrefix.XCpy(
+			"newNumStrKernel Rounding"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	var scaledDigits []rune
+	var scale int
+	var numberSign NumericSignValueType
+
+	scaledDigits,
+		scale,
+		numberSign,
+		err = new(numStrMathArithmeticMolecule).
+		getValidatedDigits(
+			&newNumStrKernel,
+			ePrefix.XCpy(
+				"newNumStrKernel"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	lenIntDigits := len(scaledDigits) - scale
+
+	for i := lenIntDigits; i < len(scaledDigits); i++ {
+
+		if scaledDigits[i] != '0' {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Ordinal number formats are only valid for\n"+
+				"integer values. The numeric value contains non-zero\n"+
+				"fractional digits.\n"+
+				"Numeric Value = '%v'\n",
+				ePrefix.String(),
+				newNumStrKernel.String())
+
+			return numStr, err
+		}
+	}
+
+	intDigits := strings.TrimLeft(
+		string(scaledDigits[:lenIntDigits]),
+		"0")
+
+	if len(intDigits) == 0 {
+
+		intDigits = "0"
+
+		numberSign = NumSignVal.Zero()
+	}
+
+	if numberSign == NumSignVal.Negative() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Ordinal number formats are only valid for\n"+
+			"non-negative integer values. Negative values cannot\n"+
+			"be formatted as ordinal numbers.\n"+
+			"Numeric Value = '%v'\n",
+			ePrefix.String(),
+			newNumStrKernel.String())
+
+		return numStr, err
+	}
+
+	var tempNumStr string
+
+	tempNumStr,
+		err = new(numStrOrdinalQuark).formatOrdinal(
+		intDigits,
+		ordinalFmtSpec.languageCode,
+		ordinalFmtSpec.useFeminine,
+		ordinalFmtSpec.useSuperscript,
+		ePrefix.XCpy(
+			"tempNumStr<-intDigits"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	return new(numberStrKernelElectron).applyNumSignSymbols(
+		tempNumStr,
+		numberSign,
+		NumStrNumberSymbolSpec{},
+		positiveNumberSign,
+		zeroNumberSign,
+		numberFieldSpec,
+		ePrefix.XCpy(
+			"numStr<-tempNumStr"))
+}
+
+// formatPercentNumStr
+//
+// Scales the numeric value of a NumberStrKernel and
+// formats the result as a percentage, per mille value or
+// basis point number string.
+//
+// A copy of 'numStrKernel' is first multiplied by 100
+// (Percent), 1,000 (Per Mille) or 10,000 (Basis Points).
+// The scaled value is then rounded according to
+// 'roundingSpec' and formatted using the standard
+// number string format elements. The percent symbol is
+// supplied by 'currencySymbol'.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		numeric value of this instance will be scaled and
+//		formatted. This instance will NOT be modified.
+//
+//	roundingSpec				NumStrRoundingSpec
+//
+//		The Number String Rounding Specification applied
+//		to the scaled numeric value.
+//
+//	percentFmtSpec				NumStrPercentFormatSpec
+//
+//		Specifies the scale factor applied to the
+//		numeric value. If this specification is NOP, an
+//		error will be returned.
+//
+//	digitCountSpec				NumStrDigitCountSpec
+//
+//		Specifies the minimum and maximum digit counts
+//		applied to the scaled numeric value. If this
+//		specification is NOP, all digits remaining after
+//		rounding are displayed.
+//
+//	decSeparator				DecimalSeparatorSpec
+//
+//		The Decimal Separator Specification applied to
+//		the scaled numeric value.
+//
+//	intSeparatorDto				IntegerSeparatorSpec
+//
+//		The Integer Separator Specification applied to
+//		the integer digits of the scaled numeric value.
+//
+//	negativeNumberSign			NumStrNumberSymbolSpec
+//
+//		The Number String Negative Number Sign
+//		Specification.
+//
+//	positiveNumberSign			NumStrNumberSymbolSpec
+//
+//		The Number String Positive Number Sign
+//		Specification.
+//
+//	zeroNumberSign				NumStrNumberSymbolSpec
+//
+//		The Number String Zero Number Sign
+//		Specification.
+//
+//	currencySymbol				NumStrNumberSymbolSpec
+//
+//		The Symbol Specification used to position the
+//		percent ("%"), per mille ("‰") or basis point
+//		("bp") symbol within the formatted number string.
+//
+//	numberFieldSpec				NumStrNumberFieldSpec
+//
+//		This Number Field Specification contains the
+//		field length and text justification parameters
+//		used to display the formatted number string.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	numStr						string
+//
+//		If this method completes successfully, the scaled
+//		numeric value of 'numStrKernel' will be returned
+//		as a formatted percent, per mille or basis point
+//		number string.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelAtom *numberStrKernelAtom) formatPercentNumStr(
+	numStrKernel *NumberStrKernel,
+	roundingSpec NumStrRoundingSpec,
+	percentFmtSpec NumStrPercentFormatSpec,
+	digitCountSpec NumStrDigitCountSpec,
+	decSeparator DecimalSeparatorSpec,
+	intSeparatorDto IntegerSeparatorSpec,
+	negativeNumberSign NumStrNumberSymbolSpec,
+	positiveNumberSign NumStrNumberSymbolSpec,
+	zeroNumberSign NumStrNumberSymbolSpec,
+	currencySymbol NumStrNumberSymbolSpec,
+	numberFieldSpec NumStrNumberFieldSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	numStr string,
+	err error) {
+
+	if numStrKernelAtom.lock == nil {
+		numStrKernelAtom.lock = new(sync.Mutex)
+	}
+
+	numStrKernelAtom.lock.Lock()
+
+	defer numStrKernelAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelAtom."+
+			"formatPercentNumStr()",
+		"")
+
+	if err != nil {
+
+		return numStr, err
+	}
+
+	if numStrKernel == nil {
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return numStr, err
+	}
+
+	scaleExponent,
+		_,
+		ok := new(numStrPercentFormatSpecAtom).getPercentParams(
+		percentFmtSpec.percentFmtType)
+
+	if !ok {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'percentFmtSpec' is invalid!\n"+
+			"'percentFmtSpec' is NOP and has not been configured\n"+
+			"for percent, per mille or basis point formatting.\n",
+			ePrefix.String())
+
+		return numStr, err
+	}
+
+	var newNumStrKernel NumberStrKernel
+
+	err = new(numberStrKernelNanobot).copy(
+		&newNumStrKernel,
+		numStrKernel,
+		ePrefix.XCpy(
+			"newNumStrKernel<-numStrKernel"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	err = new(numberStrKernelQuark).shiftDecimalPoint(
+		&newNumStrKernel,
+		scaleExponent,
+		ePrefix.XCpy(
+			"newNumStrKernel"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	if !digitCountSpec.IsNOP() {
+
+		return new(numberStrKernelAtom).formatDigitCountNumStr(
+			&newNumStrKernel,
+			roundingSpec,
+			digitCountSpec,
+			decSeparator,
+			intSeparatorDto,
+			negativeNumberSign,
+			positiveNumberSign,
+			zeroNumberSign,
+			currencySymbol,
+			numberFieldSpec,
+			ePrefix.XCpy(
+				"newNumStrKernel->"))
+	}
+
+	return new(numberStrKernelAtom).formatNumStrElements(
+		&newNumStrKernel,
+		roundingSpec,
+		decSeparator,
+		intSeparatorDto,
+		negativeNumberSign,
+		positiveNumberSign,
+		zeroNumberSign,
+		currencySymbol,
+		numberFieldSpec,
+		ePrefix.XCpy(
+			"newNumStrKernel->"))
+}
+
+// formatRadixNumStr
+//
+// Formats the integer value of a NumberStrKernel as a
+// binary, octal or hexadecimal number string.
+//
+// The numeric value of 'numStrKernel' is first rounded
+// according to 'roundingSpec'. If the rounded value
+// contains non-zero fractional digits, an error will be
+// returned.
+//
+// If the Radix Format Specification designates a two's
+// complement bit width, the value is formatted as a
+// two's complement bit pattern and no number sign
+// symbols are applied. Otherwise, the absolute value is
+// formatted and the number sign symbols are applied in
+// sign-magnitude form.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		integer value of this instance will be formatted.
+//		This instance will NOT be modified.
+//
+//	roundingSpec				NumStrRoundingSpec
+//
+//		The Number String Rounding Specification applied
+//		to a copy of 'numStrKernel' before formatting.
+//
+//	radixFmtSpec				NumStrRadixFormatSpec
+//
+//		Specifies the base, radix prefix, digit case and
+//		negative value handling for the formatted number
+//		string. If this specification is NOP, an error
+//		will be returned.
+//
+//	intSeparatorSpec			IntegerSeparatorSpec
+//
+//		Specifies the grouping of the formatted digits
+//		and the separator characters inserted between
+//		groups.
+//
+//	negativeNumberSign			NumStrNumberSymbolSpec
+//
+//		The Number String Negative Number Sign
+//		Specification applied to negative values
+//		formatted in sign-magnitude form.
+//
+//	positiveNumberSign			NumStrNumberSymbolSpec
+//
+//		The Number String Positive Number Sign
+//		Specification applied to positive values
+//		formatted in sign-magnitude form.
+//
+//	zeroNumberSign				NumStrNumberSymbolSpec
+//
+//		The Number String Zero Number Sign Specification
+//		applied to zero values formatted in
+//		sign-magnitude form.
+//
+//	numberFieldSpec				NumStrNumberFieldSpec
+//
+//		This Number Field Specification contains the
+//		field length and text justification parameters
+//		used to display the formatted number string.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	numStr						string
+//
+//		If this method completes successfully, the
+//		integer value of 'numStrKernel' will be returned
+//		as a formatted binary, octal or hexadecimal
+//		Number String.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelAtom *numberStrKernelAtom) formatRadixNumStr(
+	numStrKernel *NumberStrKernel,
+	roundingSpec NumStrRoundingSpec,
+	radixFmtSpec NumStrRadixFormatSpec,
+	intSeparatorSpec IntegerSeparatorSpec,
+	negativeNumberSign NumStrNumberSymbolSpec,
+	positiveNumberSign NumStrNumberSymbolSpec,
+	zeroNumberSign NumStrNumberSymbolSpec,
+	numberFieldSpec NumStrNumberFieldSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	numStr string,
+	err error) {
+
+	if numStrKernelAtom.lock == nil {
+		numStrKernelAtom.lock = new(sync.Mutex)
+	}
+
+	numStrKernelAtom.lock.Lock()
+
+	defer numStrKernelAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelAtom."+
+			"formatRadixNumStr()",
+		"")
+
+	if err != nil {
+
+		return numStr, err
+	}
+
+	if numStrKernel == nil {
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return numStr, err
+	}
+
+	radixAtom := numStrRadixFormatSpecAtom{}
+
+	radix,
+		ok := radixAtom.getRadixParams(
+		radixFmtSpec.radixFmtType)
+
+	if !ok {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'radixFmtSpec' is invalid!\n"+
+			"'radixFmtSpec' is NOP and has not been configured\n"+
+			"for Binary, Octal or Hexadecimal formatting.\n",
+			ePrefix.String())
+
+		return numStr, err
+	}
+
+	err = radixAtom.testValidity(
+		&radixFmtSpec,
+		ePrefix.XCpy(
+			"radixFmtSpec"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	var newNumStrKernel NumberStrKernel
+
+	err = new(numberStrKernelNanobot).copy(
+		&newNumStrKernel,
+		numStrKernel,
+		ePrefix.XCpy(
+			"newNumStrKernel<-numStrKernel"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	err = new(numStrMathRoundingNanobot).roundNumStrKernel(
+		&newNumStrKernel,
+		roundingSpec,
+		ePrefix.XCpy(
+			"newNumStrKernel Rounding"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	var scaledDigits []rune
+	var scale int
+	var numberSign NumericSignValueType
+
+	scaledDigits,
+		scale,
+		numberSign,
+		err = new(numStrMathArithmeticMolecule).
+		getValidatedDigits(
+			&newNumStrKernel,
+			ePrefix.XCpy(
+				"newNumStrKernel"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	lenIntDigits := len(scaledDigits) - scale
+
+	for i := lenIntDigits; i < len(scaledDigits); i++ {
+
+		if scaledDigits[i] != '0' {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Binary, Octal and Hexadecimal formats are\n"+
+				"only valid for integer values. The numeric value\n"+
+				"contains non-zero fractional digits.\n"+
+				"Numeric Value = '%v'\n",
+				ePrefix.String(),
+				newNumStrKernel.String())
+
+			return numStr, err
+		}
+	}
+
+	intValue := big.NewInt(0)
+
+	if lenIntDigits > 0 {
+
+		_,
+			ok = intValue.SetString(
+			string(scaledDigits[:lenIntDigits]),
+			10)
+
+		if !ok {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: The integer digits could not be converted\n"+
+				"to a big.Int value!\n"+
+				"Integer Digits = '%v'\n",
+				ePrefix.String(),
+				string(scaledDigits[:lenIntDigits]))
+
+			return numStr, err
+		}
+	}
+
+	if numberSign == NumSignVal.Negative() {
+		intValue.Neg(intValue)
+	}
+
+	var radixDigits string
+
+	bitWidth := radixFmtSpec.twosComplementBitWidth
+
+	if bitWidth > 0 {
+
+		minValue := new(big.Int).Lsh(
+			big.NewInt(1),
+			uint(bitWidth-1))
+
+		maxValue := new(big.Int).Sub(
+			minValue,
+			big.NewInt(1))
+
+		minValue.Neg(minValue)
+
+		if intValue.Cmp(minValue) < 0 ||
+			intValue.Cmp(maxValue) > 0 {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: The numeric value cannot be represented as a\n"+
+				"two's complement value with the specified bit width.\n"+
+				"Bit Width     = '%v'\n"+
+				"Minimum Value = '%v'\n"+
+				"Maximum Value = '%v'\n"+
+				"Numeric Value = '%v'\n",
+				ePrefix.String(),
+				bitWidth,
+				minValue.String(),
+				maxValue.String(),
+				intValue.String())
+
+			return numStr, err
+		}
+
+		if intValue.Sign() < 0 {
+
+			intValue.Add(
+				intValue,
+				new(big.Int).Lsh(
+					big.NewInt(1),
+					uint(bitWidth)))
+		}
+
+		bitsPerDigit := 1
+
+		if radix == 8 {
+			bitsPerDigit = 3
+		} else if radix == 16 {
+			bitsPerDigit = 4
+		}
+
+		radixDigits = intValue.Text(radix)
+
+		requiredDigits :=
+			(bitWidth + bitsPerDigit - 1) / bitsPerDigit
+
+		if len(radixDigits) < requiredDigits {
+
+			radixDigits =
+				strings.Repeat("0", requiredDigits-len(radixDigits)) +
+					radixDigits
+		}
+
+		// Two's complement values carry no number sign
+		numberSign = NumSignVal.Positive()
+
+		negativeNumberSign = NumStrNumberSymbolSpec{}
+
+		positiveNumberSign = NumStrNumberSymbolSpec{}
+
+		zeroNumberSign = NumStrNumberSymbolSpec{}
+
+	} else {
+
+		radixDigits = new(big.Int).Abs(intValue).Text(radix)
+
+	}
+
+	if radixFmtSpec.useUpperCaseDigits {
+		radixDigits = strings.ToUpper(radixDigits)
+	}
+
+	var numStrWithIntSeps []rune
+
+	numStrWithIntSeps,
+		err = new(integerSeparatorSpecMolecule).applyRadixIntSeparators(
+		&intSeparatorSpec,
+		[]rune(radixDigits),
+		ePrefix.XCpy("intSeparatorSpec<-radixDigits"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	tempNumStr := string(numStrWithIntSeps)
+
+	if radixFmtSpec.useRadixPrefix {
+
+		tempNumStr =
+			radixAtom.getRadixPrefix(radix) + tempNumStr
+	}
+
+	return new(numberStrKernelElectron).applyNumSignSymbols(
+		tempNumStr,
+		numberSign,
+		negativeNumberSign,
+		positiveNumberSign,
+		zeroNumberSign,
+		numberFieldSpec,
+		ePrefix.XCpy(
+			"numStr<-tempNumStr"))
+}
+
+// formatRomanNumeralNumStr
+//
+// Formats the integer value of a NumberStrKernel as a
+// string of Roman numerals.
+//
+// The numeric value of 'numStrKernel' is first rounded
+// according to 'roundingSpec'. If the rounded value is
+// zero, negative or contains non-zero fractional
+// digits, an error will be returned.
+//
+// Only the Positive Number Sign Specification is applied
+// to the formatted Roman numerals.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		integer value of this instance will be formatted.
+//		This instance will NOT be modified.
+//
+//	roundingSpec				NumStrRoundingSpec
+//
+//		The Number String Rounding Specification applied
+//		to a copy of 'numStrKernel' before formatting.
+//
+//	romanNumFmtSpec				NumStrRomanNumeralFormatSpec
+//
+//		Specifies the character case and vinculum
+//		options for the formatted Roman numerals. If
+//		this specification is NOP, an error will be
+//		returned.
+//
+//	positiveNumberSign			NumStrNumberSymbolSpec
+//
+//		The Number String Positive Number Sign
+//		Specification applied to the formatted Roman
+//		numerals.
+//
+//	numberFieldSpec				NumStrNumberFieldSpec
+//
+//		This Number Field Specification contains the
+//		field length and text justification parameters
+//		used to display the formatted number string.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	numStr						string
+//
+//		If this method completes successfully, the
+//		integer value of 'numStrKernel' will be returned
+//		as a formatted string of Roman numerals.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelAtom *numberStrKernelAtom) formatRomanNumeralNumStr(
+	numStrKernel *NumberStrKernel,
+	roundingSpec NumStrRoundingSpec,
+	romanNumFmtSpec NumStrRomanNumeralFormatSpec,
+	positiveNumberSign NumStrNumberSymbolSpec,
+	numberFieldSpec NumStrNumberFieldSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	numStr string,
+	err error) {
+
+	if numStrKernelAtom.lock == nil {
+		numStrKernelAtom.lock = new(sync.Mutex)
+	}
+
+	numStrKernelAtom.lock.Lock()
+
+	defer numStrKernelAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelAtom."+
+			"formatRomanNumeralNumStr()",
+		"")
+
+	if err != nil {
+
+		return numStr, err
+	}
+
+	if numStrKernel == nil {
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return numStr, err
+	}
+
+	if romanNumFmtSpec.romanNumeralFmtType !=
+		NumStrFmtType.RomanNumeral() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'romanNumFmtSpec' is invalid!\n"+
+			"'romanNumFmtSpec' is NOP and has not been configured\n"+
+			"for Roman numeral formatting.\n",
+			ePrefix.String())
+
+		return numStr, err
+	}
+
+	var newNumStrKernel NumberStrKernel
+
+	err = new(numberStrKernelNanobot).copy(
+		&newNumStrKernel,
+		numStrKernel,
+		ePrefix.XCpy(
+			"newNumStrKernel<-numStrKernel"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	err = new(numStrMathRoundingNanobot).roundNumStrKernel(
+		&newNumStrKernel,
+		roundingSpec,
+		ePrefix.XCpy(
+			"newNumStrKernel Rounding"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	var scaledDigits []rune
+	var scale int
+	var numberSign NumericSignValueType
+
+	scaledDigits,
+		scale,
+		numberSign,
+		err = new(numStrMathArithmeticMolecule).
+		getValidatedDigits(
+			&newNumStrKernel,
+			ePrefix.XCpy(
+				"newNumStrKernel"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	lenIntDigits := len(scaledDigits) - scale
+
+	for i := lenIntDigits; i < len(scaledDigits); i++ {
+
+		if scaledDigits[i] != '0' {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Roman numeral formats are only valid for\n"+
+				"integer values. The numeric value contains non-zero\n"+
+				"fractional digits.\n"+
+				"Numeric Value = '%v'\n",
+				ePrefix.String(),
+				newNumStrKernel.String())
+
+			return numStr, err
+		}
+	}
+
+	intValue := big.NewInt(0)
+
+	if lenIntDigits > 0 {
+
+		_,
+			ok := intValue.SetString(
+			string(scaledDigits[:lenIntDigits]),
+			10)
+
+		if !ok {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: The integer digits could not be converted\n"+
+				"to a big.Int value!\n"+
+				"Integer Digits = '%v'\n",
+				ePrefix.String(),
+				string(scaledDigits[:lenIntDigits]))
+
+			return numStr, err
+		}
+	}
+
+	if numberSign == NumSignVal.Negative() ||
+		intValue.Sign() == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Roman numeral formats are only valid for\n"+
+			"positive integer values. Zero and negative values\n"+
+			"cannot be formatted as Roman numerals.\n"+
+			"Numeric Value = '%v'\n",
+			ePrefix.String(),
+			newNumStrKernel.String())
+
+		return numStr, err
+	}
+
+	if !intValue.IsInt64() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The numeric value is too large to be\n"+
+			"formatted as Roman numerals.\n"+
+			"Numeric Value = '%v'\n",
+			ePrefix.String(),
+			newNumStrKernel.String())
+
+		return numStr, err
+	}
+
+	var tempNumStr string
+
+	tempNumStr,
+		err = new(numStrRomanNumeralQuark).formatRomanNumeral(
+		intValue.Int64(),
+		romanNumFmtSpec.useLowerCase,
+		romanNumFmtSpec.useVinculum,
+		ePrefix.XCpy(
+			"tempNumStr<-intValue"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	return new(numberStrKernelElectron).applyNumSignSymbols(
+		tempNumStr,
+		NumSignVal.Positive(),
+		NumStrNumberSymbolSpec{},
+		positiveNumberSign,
+		NumStrNumberSymbolSpec{},
+		numberFieldSpec,
+		ePrefix.XCpy(
+			"numStr<-tempNumStr"))
+}
+
+// formatSciNotationNumStr
+//
+// Formats the numeric value of a NumberStrKernel as a
+// Scientific Notation number string.
+//
+// The numeric value of 'numStrKernel' is first rounded
+// according to 'roundingSpec'. Next, the significand and
+// exponent are computed and the significand is rounded
+// according to the Scientific Notation Format
+// Specification. Finally, the exponent is formatted and
+// the number sign symbols are applied to the complete
+// Scientific Notation number string.
+//
+//	Examples:
+//		Value: -265,200,000
+//		Standard, 3 fractional digits,
+//		ENotUprCaseELeadPlus = "-2.652E+8"
+//
+//		Value: 0.0000521
+//		Standard, 2 fractional digits,
+//		Exponential, superscript = "5.21×10⁻⁵"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		numeric value of this instance will be formatted.
+//		This instance will NOT be modified.
+//
+//	roundingSpec				NumStrRoundingSpec
+//
+//		The Number String Rounding Specification applied
+//		to a copy of 'numStrKernel' before the
+//		significand and exponent are computed.
+//
+//	sciNotFmtSpec				SciNotationFormatSpec
+//
+//		Specifies the calculation type, significand
+//		rounding, exponent display format, minimum
+//		exponent digits and superscript exponent output.
+//		If this specification is NOP or invalid, an error
+//		will be returned.
+//
+//	decSeparator				DecimalSeparatorSpec
+//
+//		The decimal separator inserted between the
+//		integer and fractional digits of the significand.
+//
+//	negativeNumberSign			NumStrNumberSymbolSpec
+//
+//		The Number String Negative Number Sign
+//		Specification applied to negative values.
+//
+//	positiveNumberSign			NumStrNumberSymbolSpec
+//
+//		The Number String Positive Number Sign
+//		Specification applied to positive values.
+//
+//	zeroNumberSign				NumStrNumberSymbolSpec
+//
+//		The Number String Zero Number Sign Specification
+//		applied to zero values.
+//
+//	numberFieldSpec				NumStrNumberFieldSpec
+//
+//		This Number Field Specification contains the
+//		field length and text justification parameters
+//		used to display the formatted number string.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	numStr						string
+//
+//		If this method completes successfully, the
+//		numeric value of 'numStrKernel' will be returned
+//		as a formatted Scientific Notation Number String.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelAtom *numberStrKernelAtom) formatSciNotationNumStr(
+	numStrKernel *NumberStrKernel,
+	roundingSpec NumStrRoundingSpec,
+	sciNotFmtSpec SciNotationFormatSpec,
+	decSeparator DecimalSeparatorSpec,
+	negativeNumberSign NumStrNumberSymbolSpec,
+	positiveNumberSign NumStrNumberSymbolSpec,
+	zeroNumberSign NumStrNumberSymbolSpec,
+	numberFieldSpec NumStrNumberFieldSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	numStr string,
+	err error) {
+
+	if numStrKernelAtom.lock == nil {
+		numStrKernelAtom.lock = new(sync.Mutex)
+	}
+
+	numStrKernelAtom.lock.Lock()
+
+	defer numStrKernelAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelAtom."+
+			"formatSciNotationNumStr()",
+		"")
+
+	if err != nil {
+
+		return numStr, err
+	}
+
+	if numStrKernel == nil {
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return numStr, err
+	}
+
+	if !sciNotFmtSpec.sciNotFmt.XIsValid() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sciNotFmtSpec' is invalid!\n"+
+			"'sciNotFmtSpec' is NOP and has not been configured\n"+
+			"for Scientific Notation formatting.\n",
+			ePrefix.String())
+
+		return numStr, err
+	}
+
+	err = new(sciNotationFormatSpecAtom).testValidity(
+		&sciNotFmtSpec,
+		ePrefix.XCpy(
+			"sciNotFmtSpec"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	var newNumStrKernel NumberStrKernel
+
+	err = new(numberStrKernelNanobot).copy(
+		&newNumStrKernel,
+		numStrKernel,
+		ePrefix.XCpy(
+			"newNumStrKernel<-numStrKernel"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	err = new(numStrMathRoundingNanobot).roundNumStrKernel(
+		&newNumStrKernel,
+		roundingSpec,
+		ePrefix.XCpy(
+			"newNumStrKernel Rounding"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	var significand NumberStrKernel
+	var exponent int
+
+	significand,
+		exponent,
+		err = new(numberStrKernelAtom).getSciNotationComponents(
+		&newNumStrKernel,
+		sciNotFmtSpec.sciNotCalcType,
+		sciNotFmtSpec.significandRoundingType,
+		sciNotFmtSpec.significandFracDigits,
+		ePrefix.XCpy(
+			"newNumStrKernel"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	fracDigits := significand.fractionalDigits.CharsArray
+
+	if sciNotFmtSpec.significandRoundingType !=
+		NumRoundType.NoRounding() {
+
+		// Delete optional trailing zeros
+		for len(fracDigits) > sciNotFmtSpec.significandMinFracDigits &&
+			fracDigits[len(fracDigits)-1] == '0' {
+
+			fracDigits = fracDigits[:len(fracDigits)-1]
+		}
+	}
+
+	tempNumStr := string(significand.integerDigits.CharsArray)
+
+	if len(fracDigits) > 0 {
+
+		if decSeparator.GetNumberOfSeparatorChars() == 0 {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: The significand contains fractional digits\n"+
+				"and the number of decimal separator characters\n"+
+				"specified is zero. Input parameter 'decSeparator'\n"+
+				"is invalid!\n",
+				ePrefix.String())
+
+			return numStr, err
+		}
+
+		tempNumStr += decSeparator.GetDecimalSeparatorStr() +
+			string(fracDigits)
+	}
+
+	absExponent := exponent
+
+	if absExponent < 0 {
+		absExponent = -absExponent
+	}
+
+	exponentDigits := strconv.Itoa(absExponent)
+
+	if len(exponentDigits) < sciNotFmtSpec.minExponentDigits {
+
+		exponentDigits = strings.Repeat(
+			"0",
+			sciNotFmtSpec.minExponentDigits-len(exponentDigits)) +
+			exponentDigits
+	}
+
+	var exponentSign string
+
+	switch sciNotFmtSpec.sciNotFmt {
+
+	case SciNotFmt.Exponential():
+
+		if exponent < 0 {
+			exponentSign = "-"
+		}
+
+		if sciNotFmtSpec.useSuperscriptExponent {
+
+			var superscriptExp []rune
+
+			superscriptExp,
+				err = new(numberStrKernelAtom).
+				getSuperscriptRunes(
+					[]rune(exponentSign+exponentDigits),
+					ePrefix.XCpy(
+						"exponentDigits"))
+
+			if err != nil {
+				return numStr, err
+			}
+
+			tempNumStr += "×10" + string(superscriptExp)
+
+		} else {
+
+			tempNumStr += " x 10^" + exponentSign + exponentDigits
+		}
+
+	default:
+
+		if exponent < 0 {
+
+			exponentSign = "-"
+
+		} else if sciNotFmtSpec.sciNotFmt == SciNotFmt.ENotUprCaseELeadPlus() ||
+			sciNotFmtSpec.sciNotFmt == SciNotFmt.ENotLwrCaseELeadPlus() {
+
+			exponentSign = "+"
+		}
+
+		eChar := "E"
+
+		if sciNotFmtSpec.sciNotFmt == SciNotFmt.ENotLwrCaseELeadPlus() ||
+			sciNotFmtSpec.sciNotFmt == SciNotFmt.ENotLwrCaseENoLeadPlus() {
+
+			eChar = "e"
+		}
+
+		tempNumStr += eChar + exponentSign + exponentDigits
+	}
+
+	return new(numberStrKernelElectron).applyNumSignSymbols(
+		tempNumStr,
+		significand.numberSign,
+		negativeNumberSign,
+		positiveNumberSign,
+		zeroNumberSign,
+		numberFieldSpec,
+		ePrefix.XCpy(
+			"numStr<-tempNumStr"))
+}
+
+// getSciNotationComponents
+//
+// Computes the significand and exponent of the numeric
+// value encapsulated by a NumberStrKernel.
+//
+// For the Standard Calculation Type, the significand has
+// a single non-zero integer digit. For the Engineering
+// Calculation Type, the exponent is a multiple of three
+// and the significand has one to three integer digits.
+//
+// The fractional digits of the significand are rounded
+// according to 'significandRoundingType' and
+// 'significandFracDigits'. If rounding carries the
+// significand out of range (9.99 => 10.0), the exponent
+// is adjusted accordingly (1.00 x 10^n+1).
+//
+// A zero value returns a significand of zero and an
+// exponent of zero.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		significand and exponent will be computed from
+//		the numeric value of this instance. This instance
+//		will NOT be modified.
+//
+//	sciNotCalcType				ScientificNotationCalcType
+//
+//		Specifies a Standard or Engineering calculation.
+//		Any other value will trigger an error.
+//
+//	significandRoundingType		NumberRoundingType
+//
+//		The rounding algorithm applied to the fractional
+//		digits of the significand. If this value is set
+//		to NumRoundType.NoRounding(), all significant
+//		digits are retained.
+//
+//	significandFracDigits		int
+//
+//		The number of fractional digits in the returned
+//		significand.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	significand					NumberStrKernel
+//
+//		The significand, or mantissa, of the Scientific
+//		Notation value. The number sign of the
+//		significand is the number sign of the original
+//		numeric value.
+//
+//	exponent					int
+//
+//		The power of ten by which the significand is
+//		multiplied to produce the original numeric value.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelAtom *numberStrKernelAtom) getSciNotationComponents(
+	numStrKernel *NumberStrKernel,
+	sciNotCalcType ScientificNotationCalcType,
+	significandRoundingType NumberRoundingType,
+	significandFracDigits int,
+	errPrefDto *ePref.ErrPrefixDto) (
+	significand NumberStrKernel,
+	exponent int,
+	err error) {
+
+	if numStrKernelAtom.lock == nil {
+		numStrKernelAtom.lock = new(sync.Mutex)
+	}
+
+	numStrKernelAtom.lock.Lock()
+
+	defer numStrKernelAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelAtom."+
+			"getSciNotationComponents()",
+		"")
+
+	if err != nil {
+
+		return significand, exponent, err
+	}
+
+	if numStrKernel == nil {
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return significand, exponent, err
+	}
+
+	exponentStep := 1
+
+	switch sciNotCalcType {
+
+	case ScientificNotationCalcType(0).Standard():
+
+		exponentStep = 1
+
+	case ScientificNotationCalcType(0).Engineering():
+
+		exponentStep = 3
+
+	default:
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sciNotCalcType' is invalid!\n"+
+			"'sciNotCalcType' must be Standard or Engineering.\n"+
+			"sciNotCalcType integer value = '%v'\n",
+			ePrefix.String(),
+			int(sciNotCalcType))
+
+		return significand, exponent, err
+	}
+
+	var roundingSpec NumStrRoundingSpec
+
+	roundingSpec,
+		err = new(NumStrRoundingSpec).NewRoundingSpec(
+		significandRoundingType,
+		significandFracDigits,
+		ePrefix.XCpy(
+			"roundingSpec"))
+
+	if err != nil {
+		return significand, exponent, err
+	}
+
+	var scaledDigits []rune
+	var scale int
+	var numberSign NumericSignValueType
+
+	scaledDigits,
+		scale,
+		numberSign,
+		err = new(numStrMathArithmeticMolecule).
+		getValidatedDigits(
+			numStrKernel,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	if err != nil {
+		return significand, exponent, err
+	}
+
+	firstNonZero := -1
+
+	for i := 0; i < len(scaledDigits); i++ {
+
+		if scaledDigits[i] != '0' {
+			firstNonZero = i
+			break
+		}
+	}
+
+	nStrKernelNanobot := numberStrKernelNanobot{}
+
+	if firstNonZero < 0 {
+		// Zero Value
+
+		err = nStrKernelNanobot.setWithRunes(
+			&significand,
+			[]rune{'0'},
+			[]rune{},
+			NumSignVal.Zero(),
+			ePrefix.XCpy(
+				"significand<-Zero"))
+
+		if err != nil {
+			return significand, exponent, err
+		}
+
+		err = new(numStrMathRoundingNanobot).roundNumStrKernel(
+			&significand,
+			roundingSpec,
+			ePrefix.XCpy(
+				"significand Rounding"))
+
+		return significand, exponent, err
+	}
+
+	lastNonZero := len(scaledDigits) - 1
+
+	for lastNonZero > firstNonZero &&
+		scaledDigits[lastNonZero] == '0' {
+
+		lastNonZero--
+	}
+
+	sigDigits := make([]rune, lastNonZero-firstNonZero+1)
+
+	copy(sigDigits, scaledDigits[firstNonZero:lastNonZero+1])
+
+	exponent = len(scaledDigits) - scale - firstNonZero - 1
+
+	// Floor the exponent to a multiple of 'exponentStep'
+	adjustedExponent := exponent -
+		((exponent%exponentStep)+exponentStep)%exponentStep
+
+	numOfIntDigits := exponent - adjustedExponent + 1
+
+	exponent = adjustedExponent
+
+	for len(sigDigits) < numOfIntDigits {
+		sigDigits = append(sigDigits, '0')
+	}
+
+	if significandRoundingType == NumRoundType.NoRounding() {
+
+		err = nStrKernelNanobot.setWithRunes(
+			&significand,
+			sigDigits[:numOfIntDigits],
+			sigDigits[numOfIntDigits:],
+			numberSign,
+			ePrefix.XCpy(
+				"significand<-sigDigits"))
+
+		return significand, exponent, err
+	}
+
+	// Round the exact significand value,
+	// sigDigits / 10^(number of fractional digits),
+	// to 'significandFracDigits'. This honors the
+	// fractional digits for every rounding algorithm,
+	// including 'Ceiling' and 'Floor'.
+	scaledNumerator,
+		_ := new(big.Int).SetString(
+		string(sigDigits),
+		10)
+
+	if numberSign == NumSignVal.Negative() {
+		scaledNumerator.Neg(scaledNumerator)
+	}
+
+	tenToPower := new(big.Int).Exp(
+		big.NewInt(10),
+		big.NewInt(int64(significandFracDigits)),
+		nil)
+
+	scaledNumerator.Mul(scaledNumerator, tenToPower)
+
+	var scaledSignificand *big.Int
+
+	scaledSignificand,
+		err = new(bigDecimalAtom).roundBigIntQuotient(
+		scaledNumerator,
+		new(big.Int).Exp(
+			big.NewInt(10),
+			big.NewInt(int64(len(sigDigits)-numOfIntDigits)),
+			nil),
+		significandRoundingType,
+		ePrefix.XCpy(
+			"scaledSignificand"))
+
+	if err != nil {
+		return significand, exponent, err
+	}
+
+	scaledSignificand.Abs(scaledSignificand)
+
+	if scaledSignificand.Cmp(
+		new(big.Int).Mul(
+			tenToPower,
+			new(big.Int).Exp(
+				big.NewInt(10),
+				big.NewInt(int64(numOfIntDigits)),
+				nil))) >= 0 {
+
+		// Rounding carried the significand to the next
+		// power of ten. Example: 9.99 => 10.0
+		exponent += exponentStep
+
+		scaledSignificand.Set(tenToPower)
+	}
+
+	err = new(numStrMathArithmeticElectron).
+		setFromScaledDigits(
+			&significand,
+			[]rune(scaledSignificand.Text(10)),
+			significandFracDigits,
+			numberSign,
+			nil,
+			ePrefix.XCpy(
+				"significand<-scaledSignificand"))
+
+	return significand, exponent, err
+}
+
+// getSuperscriptRunes
+//
+// Converts a rune array containing numeric digits and
+// optional plus ('+') or minus ('-') signs to the
+// equivalent Unicode superscript characters.
+//
+//	Example:
+//		"-12" => "⁻¹²"
+//
+// If any rune cannot be converted, an error is returned.
+//
+// This method does NOT lock the current instance of
+// numberStrKernelAtom.
+func (numStrKernelAtom *numberStrKernelAtom) getSuperscriptRunes(
+	digitRunes []rune,
+	errPrefDto *ePref.ErrPrefixDto) (
+	superscriptRunes []rune,
+	err error) {
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelAtom."+
+			"getSuperscriptRunes()",
+		"")
+
+	if err != nil {
+
+		return superscriptRunes, err
+	}
+
+	superscriptDigits := []rune{
+		'⁰', '¹', '²', '³', '⁴', '⁵', '⁶', '⁷', '⁸', '⁹'}
+
+	superscriptRunes = make([]rune, 0, len(digitRunes))
+
+	for i := 0; i < len(digitRunes); i++ {
+
+		switch {
+
+		case digitRunes[i] >= '0' && digitRunes[i] <= '9':
+
+			superscriptRunes = append(
+				superscriptRunes,
+				superscriptDigits[digitRunes[i]-'0'])
+
+		case digitRunes[i] == '-':
+
+			superscriptRunes = append(
+				superscriptRunes,
+				'⁻')
+
+		case digitRunes[i] == '+':
+
+			superscriptRunes = append(
+				superscriptRunes,
+				'⁺')
+
+		default:
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'digitRunes' is invalid!\n"+
+				"'digitRunes' contains a character which cannot\n"+
+				"be converted to superscript.\n"+
+				"digitRunes[%v] = '%v'\n",
+				ePrefix.String(),
+				i,
+				string(digitRunes[i]))
+
+			return superscriptRunes, err
+		}
+	}
+
+	return superscriptRunes, err
+}
+
 //	prepareCompareNumStrKernels
 //
 //	This method receives pointers to two instances of
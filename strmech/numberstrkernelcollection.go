@@ -0,0 +1,1751 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
+
+// NumberStrKernelCollection
+//
+// A collection of NumberStrKernel objects.
+//
+// In addition to storing NumberStrKernel values, this
+// type provides aggregate statistical calculations
+// including the sum, mean, median, minimum, maximum,
+// variance, standard deviation and percentiles of the
+// numeric values in the collection.
+//
+// All statistical calculations are performed with exact
+// integer arithmetic. Numeric values are never converted
+// to floating point types and therefore no precision is
+// lost. Results are returned as new instances of
+// NumberStrKernel. Where a calculation cannot be
+// represented exactly, such as the mean or the standard
+// deviation, the result is rounded once according to a
+// Rounding Specification supplied by the caller.
+//
+// Returned NumberStrKernel results are configured with
+// the Number String Format Specification of the first
+// member of the collection.
+//
+// For statistics computed on a list of native number
+// strings, see also:
+//
+//	NumStrMath.NativeNumStrStats()
+//	NumberStrKernel.GetNumericValueStats()
+type NumberStrKernelCollection struct {
+	numStrKernels []NumberStrKernel
+
+	lock *sync.Mutex
+}
+
+// AddNativeNumStr
+//
+// Parses a Native Number String and adds the resulting
+// NumberStrKernel to the collection maintained by the
+// current instance of NumberStrKernelCollection.
+//
+// A Native Number String is a string of numeric digits
+// which may include a leading minus sign ('-') and a
+// period ('.') decimal separator.
+//
+//	Examples:	"1234.5678"	"-0.25"	"100"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	nativeNumStr				string
+//
+//		A Native Number String which will be parsed and
+//		added to the collection as a new NumberStrKernel.
+//
+//		If this string is empty or invalid, an error will
+//		be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelCol *NumberStrKernelCollection) AddNativeNumStr(
+	nativeNumStr string,
+	errorPrefix interface{}) error {
+
+	if numStrKernelCol.lock == nil {
+		numStrKernelCol.lock = new(sync.Mutex)
+	}
+
+	numStrKernelCol.lock.Lock()
+
+	defer numStrKernelCol.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernelCollection."+
+			"AddNativeNumStr()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	var newNumStrKernel NumberStrKernel
+
+	_,
+		err = new(numberStrKernelMechanics).
+		setNumStrKernelFromRoundedNativeNumStr(
+			&newNumStrKernel,
+			nativeNumStr,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"nativeNumStr"))
+
+	if err != nil {
+		return err
+	}
+
+	numStrKernelCol.numStrKernels = append(
+		numStrKernelCol.numStrKernels,
+		newNumStrKernel)
+
+	return err
+}
+
+// AddNumStrKernel
+//
+// Adds a deep copy of a NumberStrKernel to the
+// collection maintained by the current instance of
+// NumberStrKernelCollection.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. A
+//		deep copy of this instance will be added to the
+//		collection.
+//
+//		If this instance is invalid, an error will be
+//		returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelCol *NumberStrKernelCollection) AddNumStrKernel(
+	numStrKernel *NumberStrKernel,
+	errorPrefix interface{}) error {
+
+	if numStrKernelCol.lock == nil {
+		numStrKernelCol.lock = new(sync.Mutex)
+	}
+
+	numStrKernelCol.lock.Lock()
+
+	defer numStrKernelCol.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernelCollection."+
+			"AddNumStrKernel()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if numStrKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	_,
+		err = new(numberStrKernelAtom).
+		testValidityOfNumStrKernel(
+			numStrKernel,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	if err != nil {
+		return err
+	}
+
+	var newNumStrKernel NumberStrKernel
+
+	err = new(numberStrKernelNanobot).copy(
+		&newNumStrKernel,
+		numStrKernel,
+		ePrefix.XCpy(
+			"newNumStrKernel<-numStrKernel"))
+
+	if err != nil {
+		return err
+	}
+
+	numStrKernelCol.numStrKernels = append(
+		numStrKernelCol.numStrKernels,
+		newNumStrKernel)
+
+	return err
+}
+
+// Empty
+//
+// Deletes all NumberStrKernel objects in the collection
+// maintained by the current instance of
+// NumberStrKernelCollection.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// This method will delete all pre-existing internal
+// member variable data values in the current instance
+// of NumberStrKernelCollection.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	NONE
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NONE
+func (numStrKernelCol *NumberStrKernelCollection) Empty() {
+
+	if numStrKernelCol.lock == nil {
+		numStrKernelCol.lock = new(sync.Mutex)
+	}
+
+	numStrKernelCol.lock.Lock()
+
+	for i := 0; i < len(numStrKernelCol.numStrKernels); i++ {
+		numStrKernelCol.numStrKernels[i].Empty()
+	}
+
+	numStrKernelCol.numStrKernels = nil
+
+	numStrKernelCol.lock.Unlock()
+
+	numStrKernelCol.lock = nil
+}
+
+// GetMaximum
+//
+// Returns a deep copy of the NumberStrKernel with the
+// greatest numeric value in the collection maintained by
+// the current instance of NumberStrKernelCollection.
+//
+// If two or more collection members share the greatest
+// value, the member with the lowest index is returned.
+//
+// If the collection is empty, an error will be returned.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberStrKernel
+//
+//		A deep copy of the collection member with the
+//		greatest numeric value.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelCol *NumberStrKernelCollection) GetMaximum(
+	errorPrefix interface{}) (
+	NumberStrKernel,
+	error) {
+
+	if numStrKernelCol.lock == nil {
+		numStrKernelCol.lock = new(sync.Mutex)
+	}
+
+	numStrKernelCol.lock.Lock()
+
+	defer numStrKernelCol.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernelCollection."+
+			"GetMaximum()",
+		"")
+
+	if err != nil {
+		return NumberStrKernel{}, err
+	}
+
+	return new(numberStrKernelCollectionAtom).
+		getExtremeValue(
+			numStrKernelCol,
+			true,
+			ePrefix.XCpy(
+				"numStrKernelCol"))
+}
+
+// GetMean
+//
+// Computes and returns the arithmetic mean, or average,
+// of the numeric values in the collection maintained by
+// the current instance of NumberStrKernelCollection.
+//
+// The exact sum of all values is divided by the number
+// of values using integer arithmetic. The result is
+// rounded once, according to input parameter
+// 'roundingSpec'.
+//
+// If the collection is empty, an error will be returned.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	roundingSpec				NumStrRoundingSpec
+//
+//		Specifies the rounding algorithm and the number
+//		of fractional digits to which the returned mean
+//		will be rounded.
+//
+//		If the rounding type is set to 'NoRounding', the
+//		mean will be truncated to the specified number of
+//		fractional digits.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberStrKernel
+//
+//		The arithmetic mean of the collection values
+//		rounded according to 'roundingSpec'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelCol *NumberStrKernelCollection) GetMean(
+	roundingSpec NumStrRoundingSpec,
+	errorPrefix interface{}) (
+	NumberStrKernel,
+	error) {
+
+	if numStrKernelCol.lock == nil {
+		numStrKernelCol.lock = new(sync.Mutex)
+	}
+
+	numStrKernelCol.lock.Lock()
+
+	defer numStrKernelCol.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernelCollection."+
+			"GetMean()",
+		"")
+
+	if err != nil {
+		return NumberStrKernel{}, err
+	}
+
+	return new(numberStrKernelCollectionAtom).
+		getMean(
+			numStrKernelCol,
+			roundingSpec,
+			ePrefix.XCpy(
+				"numStrKernelCol"))
+}
+
+// GetMedian
+//
+// Computes and returns the median of the numeric values
+// in the collection maintained by the current instance
+// of NumberStrKernelCollection.
+//
+// If the collection contains an odd number of values,
+// the median is the middle value of the sorted
+// collection. If the collection contains an even number
+// of values, the median is the average of the two middle
+// values.
+//
+// The median is always computed exactly. No rounding is
+// performed.
+//
+// This method is equivalent to calling GetPercentile()
+// with a percentile of "50".
+//
+// If the collection is empty, an error will be returned.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberStrKernel
+//
+//		The exact median of the collection values.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelCol *NumberStrKernelCollection) GetMedian(
+	errorPrefix interface{}) (
+	NumberStrKernel,
+	error) {
+
+	if numStrKernelCol.lock == nil {
+		numStrKernelCol.lock = new(sync.Mutex)
+	}
+
+	numStrKernelCol.lock.Lock()
+
+	defer numStrKernelCol.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernelCollection."+
+			"GetMedian()",
+		"")
+
+	if err != nil {
+		return NumberStrKernel{}, err
+	}
+
+	return new(numberStrKernelCollectionAtom).
+		getPercentile(
+			numStrKernelCol,
+			"50",
+			ePrefix.XCpy(
+				"numStrKernelCol"))
+}
+
+// GetMinimum
+//
+// Returns a deep copy of the NumberStrKernel with the
+// smallest numeric value in the collection maintained by
+// the current instance of NumberStrKernelCollection.
+//
+// If two or more collection members share the smallest
+// value, the member with the lowest index is returned.
+//
+// If the collection is empty, an error will be returned.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberStrKernel
+//
+//		A deep copy of the collection member with the
+//		smallest numeric value.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelCol *NumberStrKernelCollection) GetMinimum(
+	errorPrefix interface{}) (
+	NumberStrKernel,
+	error) {
+
+	if numStrKernelCol.lock == nil {
+		numStrKernelCol.lock = new(sync.Mutex)
+	}
+
+	numStrKernelCol.lock.Lock()
+
+	defer numStrKernelCol.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernelCollection."+
+			"GetMinimum()",
+		"")
+
+	if err != nil {
+		return NumberStrKernel{}, err
+	}
+
+	return new(numberStrKernelCollectionAtom).
+		getExtremeValue(
+			numStrKernelCol,
+			false,
+			ePrefix.XCpy(
+				"numStrKernelCol"))
+}
+
+// GetNumberOfNumStrKernels
+//
+// Returns the number of NumberStrKernel objects in the
+// collection maintained by the current instance of
+// NumberStrKernelCollection.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	NONE
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	int
+//
+//		The number of NumberStrKernel objects in the
+//		collection.
+func (numStrKernelCol *NumberStrKernelCollection) GetNumberOfNumStrKernels() int {
+
+	if numStrKernelCol.lock == nil {
+		numStrKernelCol.lock = new(sync.Mutex)
+	}
+
+	numStrKernelCol.lock.Lock()
+
+	defer numStrKernelCol.lock.Unlock()
+
+	return len(numStrKernelCol.numStrKernels)
+}
+
+// GetNumStrKernelAtIndex
+//
+// Returns a deep copy of the NumberStrKernel located at
+// a specified index in the collection maintained by the
+// current instance of NumberStrKernelCollection.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	index						int
+//
+//		The index of the collection member to be
+//		returned.
+//
+//		If this value is less than zero, or greater than
+//		the last index in the collection, an error will
+//		be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberStrKernel
+//
+//		A deep copy of the collection member located at
+//		'index'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelCol *NumberStrKernelCollection) GetNumStrKernelAtIndex(
+	index int,
+	errorPrefix interface{}) (
+	NumberStrKernel,
+	error) {
+
+	if numStrKernelCol.lock == nil {
+		numStrKernelCol.lock = new(sync.Mutex)
+	}
+
+	numStrKernelCol.lock.Lock()
+
+	defer numStrKernelCol.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernelCollection."+
+			"GetNumStrKernelAtIndex()",
+		"")
+
+	var numStrKernel NumberStrKernel
+
+	if err != nil {
+		return numStrKernel, err
+	}
+
+	lenCol := len(numStrKernelCol.numStrKernels)
+
+	if index < 0 ||
+		index >= lenCol {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'index' is out of range!\n"+
+			"The collection contains %v NumberStrKernel values.\n"+
+			"index = '%v'\n",
+			ePrefix.String(),
+			lenCol,
+			index)
+
+		return numStrKernel, err
+	}
+
+	err = new(numberStrKernelNanobot).copy(
+		&numStrKernel,
+		&numStrKernelCol.numStrKernels[index],
+		ePrefix.XCpy(
+			fmt.Sprintf(
+				"numStrKernel<-numStrKernels[%v]",
+				index)))
+
+	return numStrKernel, err
+}
+
+// GetPercentile
+//
+// Computes and returns the value at a specified
+// percentile of the numeric values in the collection
+// maintained by the current instance of
+// NumberStrKernelCollection.
+//
+// The percentile is calculated using linear
+// interpolation between the two closest ranks. This is
+// the same method used by the spreadsheet function
+// PERCENTILE.INC.
+//
+// The percentile is always computed exactly. No
+// rounding is performed.
+//
+//	Example:
+//		Collection Values:	10, 20, 30, 40
+//		25th Percentile:	17.5
+//		50th Percentile:	25
+//		90th Percentile:	37
+//
+// If the collection is empty, an error will be returned.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	percentileNumStr			string
+//
+//		A Native Number String specifying the percentile
+//		to be computed. This value must be greater than or
+//		equal to zero and less than or equal to 100.
+//
+//		Examples: "25", "50", "99.9"
+//
+//		If this value is invalid or outside the range
+//		0 - 100, an error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberStrKernel
+//
+//		The exact value at the specified percentile of
+//		the collection values.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelCol *NumberStrKernelCollection) GetPercentile(
+	percentileNumStr string,
+	errorPrefix interface{}) (
+	NumberStrKernel,
+	error) {
+
+	if numStrKernelCol.lock == nil {
+		numStrKernelCol.lock = new(sync.Mutex)
+	}
+
+	numStrKernelCol.lock.Lock()
+
+	defer numStrKernelCol.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernelCollection."+
+			"GetPercentile()",
+		"")
+
+	if err != nil {
+		return NumberStrKernel{}, err
+	}
+
+	return new(numberStrKernelCollectionAtom).
+		getPercentile(
+			numStrKernelCol,
+			percentileNumStr,
+			ePrefix.XCpy(
+				"numStrKernelCol"))
+}
+
+// GetStandardDeviation
+//
+// Computes and returns the standard deviation of the
+// numeric values in the collection maintained by the
+// current instance of NumberStrKernelCollection.
+//
+// The standard deviation is the square root of the
+// variance. The variance is computed exactly and the
+// square root is calculated with integer arithmetic. The
+// result is rounded once, according to input parameter
+// 'roundingSpec'.
+//
+// If the collection is empty, an error will be returned.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	isSampleStdDev				bool
+//
+//		If this parameter is set to 'true', the sample
+//		standard deviation will be computed using a
+//		divisor of (n-1). In this case, the collection
+//		must contain at least two values or an error will
+//		be returned.
+//
+//		If this parameter is set to 'false', the
+//		population standard deviation will be computed
+//		using a divisor of (n).
+//
+//	roundingSpec				NumStrRoundingSpec
+//
+//		Specifies the rounding algorithm and the number
+//		of fractional digits to which the returned
+//		standard deviation will be rounded.
+//
+//		If the rounding type is set to 'NoRounding', the
+//		standard deviation will be truncated to the
+//		specified number of fractional digits.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberStrKernel
+//
+//		The standard deviation of the collection values
+//		rounded according to 'roundingSpec'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelCol *NumberStrKernelCollection) GetStandardDeviation(
+	isSampleStdDev bool,
+	roundingSpec NumStrRoundingSpec,
+	errorPrefix interface{}) (
+	NumberStrKernel,
+	error) {
+
+	if numStrKernelCol.lock == nil {
+		numStrKernelCol.lock = new(sync.Mutex)
+	}
+
+	numStrKernelCol.lock.Lock()
+
+	defer numStrKernelCol.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernelCollection."+
+			"GetStandardDeviation()",
+		"")
+
+	if err != nil {
+		return NumberStrKernel{}, err
+	}
+
+	return new(numberStrKernelCollectionAtom).
+		getStandardDeviation(
+			numStrKernelCol,
+			isSampleStdDev,
+			roundingSpec,
+			ePrefix.XCpy(
+				"numStrKernelCol"))
+}
+
+// GetSum
+//
+// Computes and returns the exact sum of the numeric
+// values in the collection maintained by the current
+// instance of NumberStrKernelCollection.
+//
+// The number of fractional digits in the returned sum is
+// equal to the greatest number of fractional digits
+// contained in any collection member. No rounding is
+// performed.
+//
+// If the collection is empty, an error will be returned.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberStrKernel
+//
+//		The exact sum of the collection values.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelCol *NumberStrKernelCollection) GetSum(
+	errorPrefix interface{}) (
+	NumberStrKernel,
+	error) {
+
+	if numStrKernelCol.lock == nil {
+		numStrKernelCol.lock = new(sync.Mutex)
+	}
+
+	numStrKernelCol.lock.Lock()
+
+	defer numStrKernelCol.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernelCollection."+
+			"GetSum()",
+		"")
+
+	if err != nil {
+		return NumberStrKernel{}, err
+	}
+
+	return new(numberStrKernelCollectionAtom).
+		getSum(
+			numStrKernelCol,
+			ePrefix.XCpy(
+				"numStrKernelCol"))
+}
+
+// GetVariance
+//
+// Computes and returns the variance of the numeric
+// values in the collection maintained by the current
+// instance of NumberStrKernelCollection.
+//
+// The variance is computed exactly with integer
+// arithmetic. The final division is rounded once,
+// according to input parameter 'roundingSpec'.
+//
+// If the collection is empty, an error will be returned.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	isSampleVariance			bool
+//
+//		If this parameter is set to 'true', the sample
+//		variance will be computed using a divisor of
+//		(n-1). In this case, the collection must contain
+//		at least two values or an error will be returned.
+//
+//		If this parameter is set to 'false', the
+//		population variance will be computed using a
+//		divisor of (n).
+//
+//	roundingSpec				NumStrRoundingSpec
+//
+//		Specifies the rounding algorithm and the number
+//		of fractional digits to which the returned
+//		variance will be rounded.
+//
+//		If the rounding type is set to 'NoRounding', the
+//		variance will be truncated to the specified
+//		number of fractional digits.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberStrKernel
+//
+//		The variance of the collection values rounded
+//		according to 'roundingSpec'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelCol *NumberStrKernelCollection) GetVariance(
+	isSampleVariance bool,
+	roundingSpec NumStrRoundingSpec,
+	errorPrefix interface{}) (
+	NumberStrKernel,
+	error) {
+
+	if numStrKernelCol.lock == nil {
+		numStrKernelCol.lock = new(sync.Mutex)
+	}
+
+	numStrKernelCol.lock.Lock()
+
+	defer numStrKernelCol.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumberStrKernelCollection."+
+			"GetVariance()",
+		"")
+
+	if err != nil {
+		return NumberStrKernel{}, err
+	}
+
+	return new(numberStrKernelCollectionAtom).
+		getVariance(
+			numStrKernelCol,
+			isSampleVariance,
+			roundingSpec,
+			ePrefix.XCpy(
+				"numStrKernelCol"))
+}
+
+// New
+//
+// Returns a new, empty instance of
+// NumberStrKernelCollection.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	NONE
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumberStrKernelCollection
+//
+//		A new, empty instance of
+//		NumberStrKernelCollection.
+func (numStrKernelCol NumberStrKernelCollection) New() NumberStrKernelCollection {
+
+	if numStrKernelCol.lock == nil {
+		numStrKernelCol.lock = new(sync.Mutex)
+	}
+
+	numStrKernelCol.lock.Lock()
+
+	defer numStrKernelCol.lock.Unlock()
+
+	return NumberStrKernelCollection{}
+}
@@ -0,0 +1,943 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"math/big"
+	"sort"
+	"sync"
+)
+
+// numberStrKernelCollectionAtom
+//
+// Provides helper methods for type
+// NumberStrKernelCollection.
+type numberStrKernelCollectionAtom struct {
+	lock *sync.Mutex
+}
+
+// getExtremeValue
+//
+// Returns a deep copy of the collection member with
+// either the greatest or the smallest numeric value.
+//
+// If two or more collection members share the extreme
+// value, the member with the lowest index is returned.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernelCol				*NumberStrKernelCollection
+//
+//		A pointer to an instance of
+//		NumberStrKernelCollection which will be searched
+//		for the maximum or minimum numeric value.
+//
+//		If this collection is empty, an error will be
+//		returned.
+//
+//	findMaximum					bool
+//
+//		If this parameter is set to 'true', the collection
+//		member with the greatest numeric value will be
+//		returned.
+//
+//		If this parameter is set to 'false', the
+//		collection member with the smallest numeric value
+//		will be returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	extremeValue				NumberStrKernel
+//
+//		A deep copy of the collection member containing
+//		the maximum or minimum numeric value.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelColAtom *numberStrKernelCollectionAtom) getExtremeValue(
+	numStrKernelCol *NumberStrKernelCollection,
+	findMaximum bool,
+	errPrefDto *ePref.ErrPrefixDto) (
+	extremeValue NumberStrKernel,
+	err error) {
+
+	if numStrKernelColAtom.lock == nil {
+		numStrKernelColAtom.lock = new(sync.Mutex)
+	}
+
+	numStrKernelColAtom.lock.Lock()
+
+	defer numStrKernelColAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelCollectionAtom."+
+			"getExtremeValue()",
+		"")
+
+	if err != nil {
+
+		return extremeValue, err
+	}
+
+	var scaledValues []*big.Int
+
+	scaledValues,
+		_,
+		err = new(numberStrKernelCollectionElectron).
+		getScaledValues(
+			numStrKernelCol,
+			ePrefix.XCpy(
+				"numStrKernelCol"))
+
+	if err != nil {
+
+		return extremeValue, err
+	}
+
+	extremeIdx := 0
+
+	var comparison int
+
+	for i := 1; i < len(scaledValues); i++ {
+
+		comparison = scaledValues[i].Cmp(scaledValues[extremeIdx])
+
+		if findMaximum && comparison > 0 {
+
+			extremeIdx = i
+
+		} else if !findMaximum && comparison < 0 {
+
+			extremeIdx = i
+		}
+	}
+
+	err = new(numberStrKernelNanobot).copy(
+		&extremeValue,
+		&numStrKernelCol.numStrKernels[extremeIdx],
+		ePrefix.XCpy(
+			fmt.Sprintf(
+				"extremeValue<-numStrKernels[%v]",
+				extremeIdx)))
+
+	return extremeValue, err
+}
+
+// getMean
+//
+// Computes the arithmetic mean, or average, of the
+// numeric values contained in a
+// NumberStrKernelCollection.
+//
+// The mean is calculated by dividing the exact sum of
+// all values by the number of values. The division is
+// performed with integer arithmetic and the result is
+// rounded once, according to the Rounding Specification
+// passed by the caller.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernelCol				*NumberStrKernelCollection
+//
+//		A pointer to an instance of
+//		NumberStrKernelCollection. The mean of the numeric
+//		values in this collection will be computed.
+//
+//		If this collection is empty, an error will be
+//		returned.
+//
+//	roundingSpec				NumStrRoundingSpec
+//
+//		Specifies the rounding algorithm and the number
+//		of fractional digits to which the returned mean
+//		will be rounded.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	mean						NumberStrKernel
+//
+//		The arithmetic mean of the collection values
+//		rounded according to 'roundingSpec'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelColAtom *numberStrKernelCollectionAtom) getMean(
+	numStrKernelCol *NumberStrKernelCollection,
+	roundingSpec NumStrRoundingSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	mean NumberStrKernel,
+	err error) {
+
+	if numStrKernelColAtom.lock == nil {
+		numStrKernelColAtom.lock = new(sync.Mutex)
+	}
+
+	numStrKernelColAtom.lock.Lock()
+
+	defer numStrKernelColAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelCollectionAtom."+
+			"getMean()",
+		"")
+
+	if err != nil {
+
+		return mean, err
+	}
+
+	var scaledValues []*big.Int
+	var scale int
+
+	scaledValues,
+		scale,
+		err = new(numberStrKernelCollectionElectron).
+		getScaledValues(
+			numStrKernelCol,
+			ePrefix.XCpy(
+				"numStrKernelCol"))
+
+	if err != nil {
+
+		return mean, err
+	}
+
+	sum := new(big.Int)
+
+	for i := 0; i < len(scaledValues); i++ {
+		sum.Add(sum, scaledValues[i])
+	}
+
+	// denominator = n x 10^scale
+	denominator := new(big.Int).Mul(
+		big.NewInt(int64(len(scaledValues))),
+		new(big.Int).Exp(
+			big.NewInt(10),
+			big.NewInt(int64(scale)),
+			nil))
+
+	return new(numberStrKernelCollectionQuark).
+		roundedRatioToNumStrKernel(
+			sum,
+			denominator,
+			roundingSpec,
+			&numStrKernelCol.numStrKernels[0].numStrFormatSpec,
+			ePrefix.XCpy(
+				"mean"))
+}
+
+// getPercentile
+//
+// Computes the value at a specified percentile of the
+// numeric values contained in a
+// NumberStrKernelCollection.
+//
+// The percentile is calculated using linear
+// interpolation between the two closest ranks. This is
+// the same method used by the spreadsheet function
+// PERCENTILE.INC.
+//
+//	Sorted Values:	x[0] ... x[n-1]
+//	h = (n-1) x percentile / 100
+//	result = x[floor(h)] +
+//		(h - floor(h)) x (x[floor(h)+1] - x[floor(h)])
+//
+// All calculations are performed with exact integer
+// arithmetic. Because the interpolation only requires
+// multiplication by a terminating decimal fraction, the
+// returned value is exact and no rounding is applied.
+// Trailing fractional zeros beyond the precision of the
+// collection values are removed.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernelCol				*NumberStrKernelCollection
+//
+//		A pointer to an instance of
+//		NumberStrKernelCollection. The percentile will be
+//		computed from the numeric values in this
+//		collection.
+//
+//		If this collection is empty, an error will be
+//		returned.
+//
+//	percentileNumStr			string
+//
+//		A native number string specifying the percentile
+//		to be computed. This value must be greater than or
+//		equal to zero and less than or equal to 100.
+//
+//		Examples: "25", "50", "99.9"
+//
+//		If this value is invalid or outside the range
+//		0 - 100, an error will be returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	percentile					NumberStrKernel
+//
+//		The exact value at the specified percentile of
+//		the collection values.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelColAtom *numberStrKernelCollectionAtom) getPercentile(
+	numStrKernelCol *NumberStrKernelCollection,
+	percentileNumStr string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	percentile NumberStrKernel,
+	err error) {
+
+	if numStrKernelColAtom.lock == nil {
+		numStrKernelColAtom.lock = new(sync.Mutex)
+	}
+
+	numStrKernelColAtom.lock.Lock()
+
+	defer numStrKernelColAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelCollectionAtom."+
+			"getPercentile()",
+		"")
+
+	if err != nil {
+
+		return percentile, err
+	}
+
+	var pctKernel NumberStrKernel
+
+	_,
+		err = new(numberStrKernelMechanics).
+		setNumStrKernelFromRoundedNativeNumStr(
+			&pctKernel,
+			percentileNumStr,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"percentileNumStr"))
+
+	if err != nil {
+
+		return percentile, err
+	}
+
+	var pctDigits []rune
+	var pctScale int
+	var pctSign NumericSignValueType
+
+	pctDigits,
+		pctScale,
+		pctSign,
+		err = new(numStrMathArithmeticMolecule).
+		getValidatedDigits(
+			&pctKernel,
+			ePrefix.XCpy(
+				"percentileNumStr"))
+
+	if err != nil {
+
+		return percentile, err
+	}
+
+	pctValue, ok := new(big.Int).SetString(string(pctDigits), 10)
+
+	if !ok {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'percentileNumStr' is invalid!\n"+
+			"percentileNumStr = '%v'\n",
+			ePrefix.String(),
+			percentileNumStr)
+
+		return percentile, err
+	}
+
+	// fractionDenominator = 100 x 10^pctScale
+	fractionDenominator := new(big.Int).Exp(
+		big.NewInt(10),
+		big.NewInt(int64(pctScale+2)),
+		nil)
+
+	if pctSign == NumSignVal.Negative() ||
+		pctValue.Cmp(fractionDenominator) > 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'percentileNumStr' is out of range!\n"+
+			"The percentile must be greater than or equal to zero\n"+
+			"and less than or equal to 100.\n"+
+			"percentileNumStr = '%v'\n",
+			ePrefix.String(),
+			percentileNumStr)
+
+		return percentile, err
+	}
+
+	var scaledValues []*big.Int
+	var scale int
+
+	scaledValues,
+		scale,
+		err = new(numberStrKernelCollectionElectron).
+		getScaledValues(
+			numStrKernelCol,
+			ePrefix.XCpy(
+				"numStrKernelCol"))
+
+	if err != nil {
+
+		return percentile, err
+	}
+
+	sort.Slice(scaledValues, func(i, j int) bool {
+		return scaledValues[i].Cmp(scaledValues[j]) < 0
+	})
+
+	rankNumerator := new(big.Int).Mul(
+		big.NewInt(int64(len(scaledValues)-1)),
+		pctValue)
+
+	lowerRank,
+		fractionNumerator := new(big.Int).QuoRem(
+		rankNumerator,
+		fractionDenominator,
+		new(big.Int))
+
+	lowerIdx := int(lowerRank.Int64())
+
+	numStrFormatSpec :=
+		&numStrKernelCol.numStrKernels[0].numStrFormatSpec
+
+	quark := numberStrKernelCollectionQuark{}
+
+	if fractionNumerator.Sign() == 0 {
+
+		return quark.scaledIntToNumStrKernel(
+			scaledValues[lowerIdx],
+			scale,
+			numStrFormatSpec,
+			ePrefix.XCpy(
+				"percentile"))
+	}
+
+	// result = x[lo] x fractionDenominator +
+	//		(x[lo+1] - x[lo]) x fractionNumerator
+	result := new(big.Int).Mul(
+		new(big.Int).Sub(
+			scaledValues[lowerIdx+1],
+			scaledValues[lowerIdx]),
+		fractionNumerator)
+
+	result.Add(
+		result,
+		new(big.Int).Mul(
+			scaledValues[lowerIdx],
+			fractionDenominator))
+
+	resultScale := scale + pctScale + 2
+
+	bigTen := big.NewInt(10)
+
+	remainder := new(big.Int)
+
+	trimmed := new(big.Int)
+
+	for resultScale > scale {
+
+		trimmed.QuoRem(result, bigTen, remainder)
+
+		if remainder.Sign() != 0 {
+			break
+		}
+
+		result.Set(trimmed)
+
+		resultScale--
+	}
+
+	return quark.scaledIntToNumStrKernel(
+		result,
+		resultScale,
+		numStrFormatSpec,
+		ePrefix.XCpy(
+			"percentile"))
+}
+
+// getStandardDeviation
+//
+// Computes the standard deviation of the numeric values
+// contained in a NumberStrKernelCollection.
+//
+// The standard deviation is equal to the square root of
+// the variance. The variance is computed exactly and the
+// square root is calculated with integer arithmetic. The
+// result is rounded once, according to the Rounding
+// Specification passed by the caller.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernelCol				*NumberStrKernelCollection
+//
+//		A pointer to an instance of
+//		NumberStrKernelCollection. The standard deviation
+//		of the numeric values in this collection will be
+//		computed.
+//
+//		If this collection is empty, an error will be
+//		returned.
+//
+//	isSampleStdDev				bool
+//
+//		If this parameter is set to 'true', the sample
+//		standard deviation will be computed using a
+//		divisor of (n-1). In this case, the collection
+//		must contain at least two values or an error will
+//		be returned.
+//
+//		If this parameter is set to 'false', the
+//		population standard deviation will be computed
+//		using a divisor of (n).
+//
+//	roundingSpec				NumStrRoundingSpec
+//
+//		Specifies the rounding algorithm and the number
+//		of fractional digits to which the returned
+//		standard deviation will be rounded.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	stdDev						NumberStrKernel
+//
+//		The standard deviation of the collection values
+//		rounded according to 'roundingSpec'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelColAtom *numberStrKernelCollectionAtom) getStandardDeviation(
+	numStrKernelCol *NumberStrKernelCollection,
+	isSampleStdDev bool,
+	roundingSpec NumStrRoundingSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	stdDev NumberStrKernel,
+	err error) {
+
+	if numStrKernelColAtom.lock == nil {
+		numStrKernelColAtom.lock = new(sync.Mutex)
+	}
+
+	numStrKernelColAtom.lock.Lock()
+
+	defer numStrKernelColAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelCollectionAtom."+
+			"getStandardDeviation()",
+		"")
+
+	if err != nil {
+
+		return stdDev, err
+	}
+
+	var numerator, denominator *big.Int
+
+	numerator,
+		denominator,
+		err = new(numberStrKernelCollectionElectron).
+		getVarianceRatio(
+			numStrKernelCol,
+			isSampleStdDev,
+			ePrefix.XCpy(
+				"numStrKernelCol"))
+
+	if err != nil {
+
+		return stdDev, err
+	}
+
+	return new(numberStrKernelCollectionQuark).
+		roundedSqrtRatioToNumStrKernel(
+			numerator,
+			denominator,
+			roundingSpec,
+			&numStrKernelCol.numStrKernels[0].numStrFormatSpec,
+			ePrefix.XCpy(
+				"stdDev"))
+}
+
+// getSum
+//
+// Computes the exact sum of the numeric values contained
+// in a NumberStrKernelCollection.
+//
+// The number of fractional digits in the returned sum is
+// equal to the greatest number of fractional digits
+// contained in any collection member. No rounding is
+// performed.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernelCol				*NumberStrKernelCollection
+//
+//		A pointer to an instance of
+//		NumberStrKernelCollection. The numeric values in
+//		this collection will be summed.
+//
+//		If this collection is empty, an error will be
+//		returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	sum							NumberStrKernel
+//
+//		The exact sum of all collection values.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelColAtom *numberStrKernelCollectionAtom) getSum(
+	numStrKernelCol *NumberStrKernelCollection,
+	errPrefDto *ePref.ErrPrefixDto) (
+	sum NumberStrKernel,
+	err error) {
+
+	if numStrKernelColAtom.lock == nil {
+		numStrKernelColAtom.lock = new(sync.Mutex)
+	}
+
+	numStrKernelColAtom.lock.Lock()
+
+	defer numStrKernelColAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelCollectionAtom."+
+			"getSum()",
+		"")
+
+	if err != nil {
+
+		return sum, err
+	}
+
+	var scaledValues []*big.Int
+	var scale int
+
+	scaledValues,
+		scale,
+		err = new(numberStrKernelCollectionElectron).
+		getScaledValues(
+			numStrKernelCol,
+			ePrefix.XCpy(
+				"numStrKernelCol"))
+
+	if err != nil {
+
+		return sum, err
+	}
+
+	scaledSum := new(big.Int)
+
+	for i := 0; i < len(scaledValues); i++ {
+		scaledSum.Add(scaledSum, scaledValues[i])
+	}
+
+	return new(numberStrKernelCollectionQuark).
+		scaledIntToNumStrKernel(
+			scaledSum,
+			scale,
+			&numStrKernelCol.numStrKernels[0].numStrFormatSpec,
+			ePrefix.XCpy(
+				"sum"))
+}
+
+// getVariance
+//
+// Computes the variance of the numeric values contained
+// in a NumberStrKernelCollection.
+//
+// The variance is computed exactly with integer
+// arithmetic. The final division is rounded once,
+// according to the Rounding Specification passed by the
+// caller.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernelCol				*NumberStrKernelCollection
+//
+//		A pointer to an instance of
+//		NumberStrKernelCollection. The variance of the
+//		numeric values in this collection will be
+//		computed.
+//
+//		If this collection is empty, an error will be
+//		returned.
+//
+//	isSampleVariance			bool
+//
+//		If this parameter is set to 'true', the sample
+//		variance will be computed using a divisor of
+//		(n-1). In this case, the collection must contain
+//		at least two values or an error will be returned.
+//
+//		If this parameter is set to 'false', the
+//		population variance will be computed using a
+//		divisor of (n).
+//
+//	roundingSpec				NumStrRoundingSpec
+//
+//		Specifies the rounding algorithm and the number
+//		of fractional digits to which the returned
+//		variance will be rounded.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	variance					NumberStrKernel
+//
+//		The variance of the collection values rounded
+//		according to 'roundingSpec'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelColAtom *numberStrKernelCollectionAtom) getVariance(
+	numStrKernelCol *NumberStrKernelCollection,
+	isSampleVariance bool,
+	roundingSpec NumStrRoundingSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	variance NumberStrKernel,
+	err error) {
+
+	if numStrKernelColAtom.lock == nil {
+		numStrKernelColAtom.lock = new(sync.Mutex)
+	}
+
+	numStrKernelColAtom.lock.Lock()
+
+	defer numStrKernelColAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelCollectionAtom."+
+			"getVariance()",
+		"")
+
+	if err != nil {
+
+		return variance, err
+	}
+
+	var numerator, denominator *big.Int
+
+	numerator,
+		denominator,
+		err = new(numberStrKernelCollectionElectron).
+		getVarianceRatio(
+			numStrKernelCol,
+			isSampleVariance,
+			ePrefix.XCpy(
+				"numStrKernelCol"))
+
+	if err != nil {
+
+		return variance, err
+	}
+
+	return new(numberStrKernelCollectionQuark).
+		roundedRatioToNumStrKernel(
+			numerator,
+			denominator,
+			roundingSpec,
+			&numStrKernelCol.numStrKernels[0].numStrFormatSpec,
+			ePrefix.XCpy(
+				"variance"))
+}
@@ -0,0 +1,392 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"math/big"
+	"sync"
+)
+
+// numberStrKernelCollectionElectron
+//
+// Provides helper methods for type
+// NumberStrKernelCollection.
+type numberStrKernelCollectionElectron struct {
+	lock *sync.Mutex
+}
+
+// getScaledValues
+//
+// Converts the numeric values of all NumberStrKernel
+// instances in a NumberStrKernelCollection to signed
+// integers scaled by a common power of ten.
+//
+// The common scale is equal to the greatest number of
+// fractional digits contained in any member of the
+// collection. Converting all values to a common scale
+// allows statistical calculations to be performed with
+// exact integer arithmetic.
+//
+//	Example:
+//		Collection Values:	1.5, -2.25, 10
+//		scale:				2
+//		scaledValues:		150, -225, 1000
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernelCol				*NumberStrKernelCollection
+//
+//		A pointer to an instance of
+//		NumberStrKernelCollection. The numeric values of
+//		the collection members will be converted to
+//		scaled integers.
+//
+//		If this collection is empty, an error will be
+//		returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	scaledValues				[]*big.Int
+//
+//		An array of signed integers. Each element is
+//		equal to the numeric value of the corresponding
+//		collection member multiplied by 10^scale.
+//
+//	scale						int
+//
+//		The number of fractional digits represented in
+//		each element of 'scaledValues'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelColElectron *numberStrKernelCollectionElectron) getScaledValues(
+	numStrKernelCol *NumberStrKernelCollection,
+	errPrefDto *ePref.ErrPrefixDto) (
+	scaledValues []*big.Int,
+	scale int,
+	err error) {
+
+	if numStrKernelColElectron.lock == nil {
+		numStrKernelColElectron.lock = new(sync.Mutex)
+	}
+
+	numStrKernelColElectron.lock.Lock()
+
+	defer numStrKernelColElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelCollectionElectron."+
+			"getScaledValues()",
+		"")
+
+	if err != nil {
+
+		return scaledValues, scale, err
+	}
+
+	if numStrKernelCol == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernelCol' is a nil pointer!\n",
+			ePrefix.String())
+
+		return scaledValues, scale, err
+	}
+
+	lenCol := len(numStrKernelCol.numStrKernels)
+
+	if lenCol == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The Number String Kernel Collection is empty!\n"+
+			"The collection contains zero NumberStrKernel values.\n",
+			ePrefix.String())
+
+		return scaledValues, scale, err
+	}
+
+	nStrMathArithMolecule := numStrMathArithmeticMolecule{}
+
+	scaledValues = make([]*big.Int, lenCol)
+
+	scales := make([]int, lenCol)
+
+	var digits []rune
+	var numberSign NumericSignValueType
+
+	for i := 0; i < lenCol; i++ {
+
+		digits,
+			scales[i],
+			numberSign,
+			err = nStrMathArithMolecule.getValidatedDigits(
+			&numStrKernelCol.numStrKernels[i],
+			ePrefix.XCpy(
+				fmt.Sprintf(
+					"numStrKernels[%v]",
+					i)))
+
+		if err != nil {
+
+			return scaledValues, scale, err
+		}
+
+		scaledValues[i] = new(big.Int)
+
+		_, ok := scaledValues[i].SetString(string(digits), 10)
+
+		if !ok {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Collection member numStrKernels[%v] contains\n"+
+				"invalid numeric digits.\n"+
+				"digits = '%v'\n",
+				ePrefix.String(),
+				i,
+				string(digits))
+
+			return scaledValues, scale, err
+		}
+
+		if numberSign == NumSignVal.Negative() {
+			scaledValues[i].Neg(scaledValues[i])
+		}
+
+		if scales[i] > scale {
+			scale = scales[i]
+		}
+	}
+
+	for i := 0; i < lenCol; i++ {
+
+		if scales[i] == scale {
+			continue
+		}
+
+		scaledValues[i].Mul(
+			scaledValues[i],
+			new(big.Int).Exp(
+				big.NewInt(10),
+				big.NewInt(int64(scale-scales[i])),
+				nil))
+	}
+
+	return scaledValues, scale, err
+}
+
+// getVarianceRatio
+//
+// Computes the exact variance of the numeric values
+// contained in a NumberStrKernelCollection and returns
+// that variance as a ratio of two integers.
+//
+// The variance is computed with the following formula
+// using exact integer arithmetic:
+//
+//	n = number of values
+//	S1 = sum of all values
+//	S2 = sum of the squares of all values
+//
+//	Population Variance = (n x S2 - S1^2) / (n x n)
+//	Sample Variance     = (n x S2 - S1^2) / (n x (n-1))
+//
+// Because the numerator and denominator are returned
+// separately, no precision is lost. The caller is
+// responsible for performing the final division, or
+// square root calculation, and applying the required
+// rounding.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernelCol				*NumberStrKernelCollection
+//
+//		A pointer to an instance of
+//		NumberStrKernelCollection. The variance of the
+//		numeric values contained in this collection will
+//		be computed.
+//
+//		If this collection is empty, an error will be
+//		returned.
+//
+//	isSampleVariance			bool
+//
+//		If this parameter is set to 'true', the sample
+//		variance will be computed using a divisor of
+//		(n-1). In this case, the collection must contain
+//		at least two values or an error will be returned.
+//
+//		If this parameter is set to 'false', the
+//		population variance will be computed using a
+//		divisor of (n).
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	numerator					*big.Int
+//
+//		The numerator of the exact variance. This value
+//		is always greater than or equal to zero.
+//
+//	denominator					*big.Int
+//
+//		The denominator of the exact variance. This
+//		value is always greater than zero.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelColElectron *numberStrKernelCollectionElectron) getVarianceRatio(
+	numStrKernelCol *NumberStrKernelCollection,
+	isSampleVariance bool,
+	errPrefDto *ePref.ErrPrefixDto) (
+	numerator *big.Int,
+	denominator *big.Int,
+	err error) {
+
+	if numStrKernelColElectron.lock == nil {
+		numStrKernelColElectron.lock = new(sync.Mutex)
+	}
+
+	numStrKernelColElectron.lock.Lock()
+
+	defer numStrKernelColElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelCollectionElectron."+
+			"getVarianceRatio()",
+		"")
+
+	if err != nil {
+
+		return numerator, denominator, err
+	}
+
+	var scaledValues []*big.Int
+	var scale int
+
+	scaledValues,
+		scale,
+		err = new(numberStrKernelCollectionElectron).
+		getScaledValues(
+			numStrKernelCol,
+			ePrefix.XCpy(
+				"numStrKernelCol"))
+
+	if err != nil {
+
+		return numerator, denominator, err
+	}
+
+	lenValues := int64(len(scaledValues))
+
+	if isSampleVariance &&
+		lenValues < 2 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The Sample Variance requires at least two values.\n"+
+			"The Number String Kernel Collection contains only one value.\n",
+			ePrefix.String())
+
+		return numerator, denominator, err
+	}
+
+	sum := new(big.Int)
+
+	sumOfSquares := new(big.Int)
+
+	square := new(big.Int)
+
+	for i := 0; i < len(scaledValues); i++ {
+
+		sum.Add(sum, scaledValues[i])
+
+		square.Mul(scaledValues[i], scaledValues[i])
+
+		sumOfSquares.Add(sumOfSquares, square)
+	}
+
+	n := big.NewInt(lenValues)
+
+	// numerator = n x S2 - S1^2
+	numerator = new(big.Int).Sub(
+		new(big.Int).Mul(n, sumOfSquares),
+		new(big.Int).Mul(sum, sum))
+
+	divisor := big.NewInt(lenValues)
+
+	if isSampleVariance {
+		divisor.SetInt64(lenValues - 1)
+	}
+
+	// denominator = n x divisor x 10^(2 x scale)
+	denominator = new(big.Int).Mul(
+		new(big.Int).Mul(n, divisor),
+		new(big.Int).Exp(
+			big.NewInt(10),
+			big.NewInt(int64(2*scale)),
+			nil))
+
+	return numerator, denominator, err
+}
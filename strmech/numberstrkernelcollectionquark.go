@@ -0,0 +1,545 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"math/big"
+	"sync"
+)
+
+// numberStrKernelCollectionQuark
+//
+// Provides helper methods for type
+// NumberStrKernelCollection.
+//
+// The methods in this type convert scaled integer
+// values, and ratios of scaled integer values, to
+// instances of NumberStrKernel. No conversion to float64
+// is performed.
+type numberStrKernelCollectionQuark struct {
+	lock *sync.Mutex
+}
+
+// roundedRatioToNumStrKernel
+//
+// Divides 'numerator' by 'denominator' and returns the
+// rounded quotient as a new instance of NumberStrKernel.
+//
+// The quotient is computed exactly using integer
+// arithmetic and then rounded once to the number of
+// fractional digits specified by 'roundingSpec'.
+//
+//	Example:
+//		numerator		= 2
+//		denominator		= 3
+//		roundingType	= NumRoundType.HalfAwayFromZero()
+//		fractional digits = 4
+//		quotient		= 0.6667
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numerator					*big.Int
+//
+//		The dividend. This value may be positive,
+//		negative or zero.
+//
+//	denominator					*big.Int
+//
+//		The divisor. If this value is zero, an error
+//		will be returned.
+//
+//	roundingSpec				NumStrRoundingSpec
+//
+//		The rounding type and the number of fractional
+//		digits applied to the quotient.
+//
+//		If the rounding type is set to
+//		NumRoundType.NoRounding(), the quotient will be
+//		truncated to the specified number of fractional
+//		digits.
+//
+//	numStrFormatSpec			*NumStrFormatSpec
+//
+//		A pointer to an instance of NumStrFormatSpec. A
+//		deep copy of this format specification will be
+//		stored as the default Number String Format
+//		Specification for the returned NumberStrKernel.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	quotient					NumberStrKernel
+//
+//		If this method completes successfully, this
+//		parameter will return the rounded quotient of
+//		'numerator' divided by 'denominator'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelColQuark *numberStrKernelCollectionQuark) roundedRatioToNumStrKernel(
+	numerator *big.Int,
+	denominator *big.Int,
+	roundingSpec NumStrRoundingSpec,
+	numStrFormatSpec *NumStrFormatSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	quotient NumberStrKernel,
+	err error) {
+
+	if numStrKernelColQuark.lock == nil {
+		numStrKernelColQuark.lock = new(sync.Mutex)
+	}
+
+	numStrKernelColQuark.lock.Lock()
+
+	defer numStrKernelColQuark.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelCollectionQuark."+
+			"roundedRatioToNumStrKernel()",
+		"")
+
+	if err != nil {
+
+		return quotient, err
+	}
+
+	if numerator == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numerator' is a nil pointer!\n",
+			ePrefix.String())
+
+		return quotient, err
+	}
+
+	roundToFractionalDigits :=
+		roundingSpec.GetRoundToFractionalDigits()
+
+	if roundToFractionalDigits < 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'roundingSpec' is invalid!\n"+
+			"The number of fractional digits is less than zero.\n"+
+			"roundToFractionalDigits = '%v'\n",
+			ePrefix.String(),
+			roundToFractionalDigits)
+
+		return quotient, err
+	}
+
+	scaledNumerator := new(big.Int).Mul(
+		numerator,
+		new(big.Int).Exp(
+			big.NewInt(10),
+			big.NewInt(int64(roundToFractionalDigits)),
+			nil))
+
+	var scaledQuotient *big.Int
+
+	scaledQuotient,
+		err = new(bigDecimalAtom).roundBigIntQuotient(
+		scaledNumerator,
+		denominator,
+		roundingSpec.GetRoundingType(),
+		ePrefix.XCpy(
+			"scaledQuotient"))
+
+	if err != nil {
+
+		return quotient, err
+	}
+
+	return new(numberStrKernelCollectionQuark).
+		scaledIntToNumStrKernel(
+			scaledQuotient,
+			roundToFractionalDigits,
+			numStrFormatSpec,
+			ePrefix.XCpy(
+				"quotient<-scaledQuotient"))
+}
+
+// roundedSqrtRatioToNumStrKernel
+//
+// Computes the square root of the ratio 'numerator'
+// divided by 'denominator' and returns the rounded
+// result as a new instance of NumberStrKernel.
+//
+// The square root is computed using integer arithmetic.
+// The returned value is correctly rounded to the number
+// of fractional digits specified by 'roundingSpec'. No
+// intermediate rounding is performed.
+//
+//	Example:
+//		numerator		= 2
+//		denominator		= 1
+//		roundingType	= NumRoundType.HalfAwayFromZero()
+//		fractional digits = 6
+//		square root		= 1.414214
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numerator					*big.Int
+//
+//		The numerator of the ratio. If this value is
+//		less than zero, an error will be returned.
+//
+//	denominator					*big.Int
+//
+//		The denominator of the ratio. If this value is
+//		less than or equal to zero, an error will be
+//		returned.
+//
+//	roundingSpec				NumStrRoundingSpec
+//
+//		The rounding type and the number of fractional
+//		digits applied to the square root.
+//
+//		If the rounding type is set to
+//		NumRoundType.NoRounding(), the square root will
+//		be truncated to the specified number of
+//		fractional digits.
+//
+//	numStrFormatSpec			*NumStrFormatSpec
+//
+//		A pointer to an instance of NumStrFormatSpec. A
+//		deep copy of this format specification will be
+//		stored as the default Number String Format
+//		Specification for the returned NumberStrKernel.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	squareRoot					NumberStrKernel
+//
+//		If this method completes successfully, this
+//		parameter will return the rounded square root of
+//		'numerator' divided by 'denominator'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelColQuark *numberStrKernelCollectionQuark) roundedSqrtRatioToNumStrKernel(
+	numerator *big.Int,
+	denominator *big.Int,
+	roundingSpec NumStrRoundingSpec,
+	numStrFormatSpec *NumStrFormatSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	squareRoot NumberStrKernel,
+	err error) {
+
+	if numStrKernelColQuark.lock == nil {
+		numStrKernelColQuark.lock = new(sync.Mutex)
+	}
+
+	numStrKernelColQuark.lock.Lock()
+
+	defer numStrKernelColQuark.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelCollectionQuark."+
+			"roundedSqrtRatioToNumStrKernel()",
+		"")
+
+	if err != nil {
+
+		return squareRoot, err
+	}
+
+	if numerator == nil ||
+		denominator == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameters 'numerator' and 'denominator'\n"+
+			"must NOT be nil pointers!\n",
+			ePrefix.String())
+
+		return squareRoot, err
+	}
+
+	if numerator.Sign() < 0 ||
+		denominator.Sign() <= 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The square root ratio is invalid!\n"+
+			"'numerator' must be greater than or equal to zero and\n"+
+			"'denominator' must be greater than zero.\n"+
+			"numerator   = '%v'\n"+
+			"denominator = '%v'\n",
+			ePrefix.String(),
+			numerator.String(),
+			denominator.String())
+
+		return squareRoot, err
+	}
+
+	roundToFractionalDigits :=
+		roundingSpec.GetRoundToFractionalDigits()
+
+	if roundToFractionalDigits < 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'roundingSpec' is invalid!\n"+
+			"The number of fractional digits is less than zero.\n"+
+			"roundToFractionalDigits = '%v'\n",
+			ePrefix.String(),
+			roundToFractionalDigits)
+
+		return squareRoot, err
+	}
+
+	// x = numerator x 10^(2 x fractional digits)
+	x := new(big.Int).Mul(
+		numerator,
+		new(big.Int).Exp(
+			big.NewInt(10),
+			big.NewInt(int64(2*roundToFractionalDigits)),
+			nil))
+
+	// floor(sqrt(x/denominator)) is equal to
+	// floor(sqrt(floor(x/denominator)))
+	floorRoot := new(big.Int).Sqrt(
+		new(big.Int).Quo(x, denominator))
+
+	// The exact square root lies in the interval
+	// [floorRoot, floorRoot+1). Classify its position
+	// in quarters so that roundBigIntQuotient() can
+	// apply the rounding type:
+	//	0 = exactly floorRoot
+	//	1 = less than floorRoot + 1/2
+	//	2 = exactly floorRoot + 1/2
+	//	3 = greater than floorRoot + 1/2
+	var quarterOffset int64
+
+	if new(big.Int).Mul(
+		new(big.Int).Mul(floorRoot, floorRoot),
+		denominator).Cmp(x) != 0 {
+
+		// Compare 4x with denominator x (2 x floorRoot + 1)^2
+		twoRootPlusOne := new(big.Int).Add(
+			new(big.Int).Lsh(floorRoot, 1),
+			big.NewInt(1))
+
+		halfCmp := new(big.Int).Lsh(x, 2).Cmp(
+			new(big.Int).Mul(
+				new(big.Int).Mul(twoRootPlusOne, twoRootPlusOne),
+				denominator))
+
+		quarterOffset = int64(2 + halfCmp)
+	}
+
+	var scaledRoot *big.Int
+
+	scaledRoot,
+		err = new(bigDecimalAtom).roundBigIntQuotient(
+		new(big.Int).Add(
+			new(big.Int).Lsh(floorRoot, 2),
+			big.NewInt(quarterOffset)),
+		big.NewInt(4),
+		roundingSpec.GetRoundingType(),
+		ePrefix.XCpy(
+			"scaledRoot"))
+
+	if err != nil {
+
+		return squareRoot, err
+	}
+
+	return new(numberStrKernelCollectionQuark).
+		scaledIntToNumStrKernel(
+			scaledRoot,
+			roundToFractionalDigits,
+			numStrFormatSpec,
+			ePrefix.XCpy(
+				"squareRoot<-scaledRoot"))
+}
+
+// scaledIntToNumStrKernel
+//
+// Receives a signed integer value scaled by 10^scale
+// and returns the equivalent numeric value as a new
+// instance of NumberStrKernel.
+//
+//	Example:
+//		scaledValue	= -123456
+//		scale		= 3
+//		result		= -123.456
+//
+// The returned NumberStrKernel will contain exactly
+// 'scale' fractional digits.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	scaledValue					*big.Int
+//
+//		The signed integer value to be converted.
+//
+//	scale						int
+//
+//		The number of trailing digits in 'scaledValue'
+//		which will be configured as fractional digits.
+//		If this value is less than zero, an error will
+//		be returned.
+//
+//	numStrFormatSpec			*NumStrFormatSpec
+//
+//		A pointer to an instance of NumStrFormatSpec. A
+//		deep copy of this format specification will be
+//		stored as the default Number String Format
+//		Specification for the returned NumberStrKernel.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	numStrKernel				NumberStrKernel
+//
+//		If this method completes successfully, this
+//		parameter will return the numeric value of
+//		'scaledValue' divided by 10^scale.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelColQuark *numberStrKernelCollectionQuark) scaledIntToNumStrKernel(
+	scaledValue *big.Int,
+	scale int,
+	numStrFormatSpec *NumStrFormatSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	numStrKernel NumberStrKernel,
+	err error) {
+
+	if numStrKernelColQuark.lock == nil {
+		numStrKernelColQuark.lock = new(sync.Mutex)
+	}
+
+	numStrKernelColQuark.lock.Lock()
+
+	defer numStrKernelColQuark.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelCollectionQuark."+
+			"scaledIntToNumStrKernel()",
+		"")
+
+	if err != nil {
+
+		return numStrKernel, err
+	}
+
+	if scaledValue == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'scaledValue' is a nil pointer!\n",
+			ePrefix.String())
+
+		return numStrKernel, err
+	}
+
+	numberSign := NumSignVal.Positive()
+
+	if scaledValue.Sign() < 0 {
+		numberSign = NumSignVal.Negative()
+	}
+
+	err = new(numStrMathArithmeticElectron).
+		setFromScaledDigits(
+			&numStrKernel,
+			[]rune(new(big.Int).Abs(scaledValue).Text(10)),
+			scale,
+			numberSign,
+			numStrFormatSpec,
+			ePrefix.XCpy(
+				"numStrKernel<-scaledValue"))
+
+	return numStrKernel, err
+}
@@ -3,6 +3,7 @@ package strmech
 import (
 	"fmt"
 	ePref "github.com/MikeAustin71/errpref"
+	"strings"
 	"sync"
 )
 
@@ -12,6 +13,217 @@ type numberStrKernelElectron struct {
 	lock *sync.Mutex
 }
 
+// applyNumSignSymbols
+//
+// Receives a formatted number string containing numeric
+// digits and applies the number sign symbols associated
+// with the designated number sign. The result is then
+// justified within the number field specified by input
+// parameter 'numberFieldSpec'.
+//
+// Number symbols configured for positioning inside the
+// number field are attached to 'tempNumStr' before text
+// justification. Number symbols configured for
+// positioning outside the number field are attached to
+// the justified number string.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	tempNumStr					string
+//
+//		The formatted numeric digits to which number
+//		sign symbols will be applied. This string should
+//		NOT contain a number sign.
+//
+//	numberSign					NumericSignValueType
+//
+//		The number sign of the numeric value represented
+//		by 'tempNumStr'. This value determines which of
+//		the number sign specifications will be applied.
+//
+//	negativeNumberSign			NumStrNumberSymbolSpec
+//
+//		The Number String Negative Number Sign
+//		Specification. If 'numberSign' is negative and
+//		this specification is NOP, an error will be
+//		returned.
+//
+//	positiveNumberSign			NumStrNumberSymbolSpec
+//
+//		The Number String Positive Number Sign
+//		Specification.
+//
+//	zeroNumberSign				NumStrNumberSymbolSpec
+//
+//		The Number String Zero Number Sign Specification.
+//
+//	numberFieldSpec				NumStrNumberFieldSpec
+//
+//		This Number Field Specification contains the
+//		field length and text justification parameters
+//		used to display the formatted number string. If
+//		this specification is invalid, it will be set to
+//		NOP and the number string will not be justified.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	numStr						string
+//
+//		If this method completes successfully, this
+//		string will contain 'tempNumStr' with the number
+//		sign symbols applied and justified within the
+//		number field.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelElectron *numberStrKernelElectron) applyNumSignSymbols(
+	tempNumStr string,
+	numberSign NumericSignValueType,
+	negativeNumberSign NumStrNumberSymbolSpec,
+	positiveNumberSign NumStrNumberSymbolSpec,
+	zeroNumberSign NumStrNumberSymbolSpec,
+	numberFieldSpec NumStrNumberFieldSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	numStr string,
+	err error) {
+
+	if numStrKernelElectron.lock == nil {
+		numStrKernelElectron.lock = new(sync.Mutex)
+	}
+
+	numStrKernelElectron.lock.Lock()
+
+	defer numStrKernelElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelElectron."+
+			"applyNumSignSymbols()",
+		"")
+
+	if err != nil {
+
+		return numStr, err
+	}
+
+	var numSignSymbolSpec NumStrNumberSymbolSpec
+
+	switch numberSign {
+
+	case NumSignVal.Negative():
+
+		if negativeNumberSign.IsNOP() {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: The numeric value is negative however\n"+
+				"no negative number sign has been configured.\n",
+				ePrefix.String())
+
+			return numStr, err
+		}
+
+		numSignSymbolSpec = negativeNumberSign
+
+	case NumSignVal.Positive():
+
+		numSignSymbolSpec = positiveNumberSign
+
+	default:
+
+		numSignSymbolSpec = zeroNumberSign
+	}
+
+	var outsideNumFieldLeadingSymbols,
+		outsideNumFieldTrailingSymbols string
+
+	if !numSignSymbolSpec.IsNOP() {
+
+		leadingSymbols :=
+			numSignSymbolSpec.GetLeadingNumberSymbolStr()
+
+		if numSignSymbolSpec.GetLeadingNumberSymbolPosition() ==
+			NumFieldSymPos.OutsideNumField() {
+
+			outsideNumFieldLeadingSymbols = leadingSymbols
+
+		} else {
+
+			tempNumStr = leadingSymbols + tempNumStr
+		}
+
+		trailingSymbols :=
+			numSignSymbolSpec.GetTrailingNumberSymbolStr()
+
+		if numSignSymbolSpec.GetTrailingNumberSymbolPosition() ==
+			NumFieldSymPos.OutsideNumField() {
+
+			outsideNumFieldTrailingSymbols = trailingSymbols
+
+		} else {
+
+			tempNumStr = tempNumStr + trailingSymbols
+		}
+	}
+
+	if !numberFieldSpec.IsValidInstance() {
+
+		numberFieldSpec.SetNOP()
+	}
+
+	numStr,
+		err = new(strMechNanobot).justifyTextInStrField(
+		tempNumStr,
+		numberFieldSpec.GetNumFieldLength(),
+		numberFieldSpec.GetNumFieldJustification(),
+		ePrefix.XCpy("numStr<-tempNumStr"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	numStr = new(numStrNumberFieldSpecAtom).replaceFillChars(
+		&numberFieldSpec,
+		numStr,
+		tempNumStr)
+
+	numStr = outsideNumFieldLeadingSymbols +
+		numStr +
+		outsideNumFieldTrailingSymbols
+
+	return numStr, err
+}
+
 //	empty
 //
 //	Receives a pointer to an instance of NumberStrKernel and
@@ -75,6 +287,8 @@ func (numStrKernelElectron *numberStrKernelElectron) empty(
 	numStrKernel.isNonZeroValue = false
 
 	numStrKernel.numStrFormatSpec.Empty()
+
+	numStrKernel.jsonMarshalFormat = NumStrJsonFmt.None()
 }
 
 // equal - Receives a pointer to two instances of
@@ -139,6 +353,12 @@ func (numStrKernelElectron *numberStrKernelElectron) equal(
 		return false
 	}
 
+	if numStrKernel1.jsonMarshalFormat !=
+		numStrKernel2.jsonMarshalFormat {
+
+		return false
+	}
+
 	return true
 }
 
@@ -373,6 +593,84 @@ func (numStrKernelElectron *numberStrKernelElectron) equalizeNumStrDigitsLengths
 	return err
 }
 
+// formatZeroLiteral
+//
+// Formats the literal text displayed for zero values by
+// a number format pattern whose zero sub-pattern
+// contains no digit placeholders
+// ("#,##0.00;(#,##0.00);-"). The literal text is
+// justified within the number field specified by
+// 'numberFieldSpec'.
+//
+// If 'zeroLiteral' is an empty string, the returned
+// number string consists solely of fill characters or,
+// if no field length is specified, is empty.
+func (numStrKernelElectron *numberStrKernelElectron) formatZeroLiteral(
+	zeroLiteral string,
+	numberFieldSpec NumStrNumberFieldSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	numStr string,
+	err error) {
+
+	if numStrKernelElectron.lock == nil {
+		numStrKernelElectron.lock = new(sync.Mutex)
+	}
+
+	numStrKernelElectron.lock.Lock()
+
+	defer numStrKernelElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelElectron."+
+			"formatZeroLiteral()",
+		"")
+
+	if err != nil {
+		return numStr, err
+	}
+
+	if !numberFieldSpec.IsValidInstance() {
+
+		numberFieldSpec.SetNOP()
+	}
+
+	if len(zeroLiteral) == 0 {
+
+		fieldLen := numberFieldSpec.GetNumFieldLength()
+
+		if fieldLen > 0 {
+
+			numStr = strings.Repeat(
+				string(numberFieldSpec.GetFillChar()),
+				fieldLen)
+		}
+
+		return numStr, err
+	}
+
+	numStr,
+		err = new(strMechNanobot).justifyTextInStrField(
+		zeroLiteral,
+		numberFieldSpec.GetNumFieldLength(),
+		numberFieldSpec.GetNumFieldJustification(),
+		ePrefix.XCpy("numStr<-zeroLiteral"))
+
+	if err != nil {
+		return numStr, err
+	}
+
+	numStr = new(numStrNumberFieldSpecAtom).replaceFillChars(
+		&numberFieldSpec,
+		numStr,
+		zeroLiteral)
+
+	return numStr, err
+}
+
 //	getSetIsNonZeroValue
 //
 //	Receives a pointer to an instance of
@@ -3,9 +3,23 @@ package strmech
 import (
 	"fmt"
 	ePref "github.com/MikeAustin71/errpref"
+	"strconv"
+	"strings"
 	"sync"
 )
 
+// maxEncodedNumStrDigits - The maximum number of numeric
+// digits which may be produced when a number string
+// extracted from a text, JSON or database encoding is
+// decoded. For E-Notation number strings, the count
+// includes the digits added by expanding the exponent.
+//
+// Encoded values are frequently received from untrusted
+// sources. This limit prevents a short string such as
+// "1e1000000" from consuming excessive memory and
+// processing time.
+const maxEncodedNumStrDigits = 10000
+
 // numberStrKernelMechanics
 //
 // Provides helper methods for type NumberStrKernel.
@@ -1379,7 +1393,862 @@ func (numStrKernelMech *numberStrKernelMechanics) convertToSciNotation(
 		ePrefix.XCpy(
 			"sciNotKernel.significand"))
 
-	return sciNotKernel, err
+	return sciNotKernel, err
+}
+
+// setNumStrKernelFromEncodedNumStr
+//
+// Receives a number string extracted from a text, JSON
+// or database encoding and uses the parsed numeric value
+// to reset the numeric value of a NumberStrKernel
+// instance.
+//
+// The number string is parsed directly into the integer
+// and fractional digit arrays of 'numStrKernel'. No
+// conversion to float64 is performed. Therefore, the
+// parsed numeric value is exact and no precision is
+// lost regardless of the number of digits.
+//
+// Leading and trailing white space is ignored. The
+// number string may be formatted as a Native Number
+// String with an optional leading plus sign ('+') or as
+// an E-Notation number string using the period ('.') as
+// a decimal separator.
+//
+//	Examples:
+//		"-1234.5678"	=> -1234.5678
+//		"+42"			=> 42
+//		"6.022E+23"		=> 602200000000000000000000
+//		"1.602e-19"		=> 0.0000000000000000001602
+//
+// If 'encodedNumStr' is an empty string, or consists
+// entirely of white space, an error will be returned.
+// Empty NumberStrKernel instances, the equivalent of a
+// 'null' or missing value, are produced only by JSON
+// 'null' values and database NULL values.
+//
+// ----------------------------------------------------------------
+//
+// # BE ADVISED
+//
+//	Decoded numeric values are limited to 10,000 numeric
+//	digits (maxEncodedNumStrDigits). For E-Notation
+//	number strings, this limit includes the integer or
+//	fractional digits added by expanding the exponent.
+//	Therefore, "1e10000" is rejected while "1e9999" is
+//	accepted. Number strings exceeding this limit are
+//	rejected before the exponent is expanded.
+//
+//	Only the numeric value of 'numStrKernel' is
+//	modified by this method. The Default Number String
+//	Format Specification and the JSON Marshal Format
+//	configured for 'numStrKernel' are NOT changed.
+//
+//	If an error is returned, 'numStrKernel' will NOT be
+//	modified.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		numeric value of this instance will be deleted
+//		and replaced by the value parsed from
+//		'encodedNumStr'.
+//
+//	encodedNumStr				string
+//
+//		The number string to be parsed. If this string
+//		is neither a valid Native Number String nor a
+//		valid E-Notation number string, an error will be
+//		returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelMech *numberStrKernelMechanics) setNumStrKernelFromEncodedNumStr(
+	numStrKernel *NumberStrKernel,
+	encodedNumStr string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if numStrKernelMech.lock == nil {
+		numStrKernelMech.lock = new(sync.Mutex)
+	}
+
+	numStrKernelMech.lock.Lock()
+
+	defer numStrKernelMech.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelMechanics."+
+			"setNumStrKernelFromEncodedNumStr()",
+		"")
+
+	if err != nil {
+
+		return err
+	}
+
+	if numStrKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	encodedNumStr = strings.TrimSpace(encodedNumStr)
+
+	if len(encodedNumStr) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'encodedNumStr' is empty!\n"+
+			"'encodedNumStr' is an empty string or consists\n"+
+			"entirely of white space.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if encodedNumStr[0] == '+' {
+		encodedNumStr = encodedNumStr[1:]
+	}
+
+	if strings.Count(encodedNumStr, ".") > 1 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'encodedNumStr' is invalid!\n"+
+			"'encodedNumStr' contains more than one decimal point.\n"+
+			"encodedNumStr = '%v'\n",
+			ePrefix.String(),
+			encodedNumStr)
+
+		return err
+	}
+
+	mantissaStr := encodedNumStr
+
+	var exponentMagnitude int64
+
+	if expIdx := strings.IndexAny(encodedNumStr, "eE"); expIdx >= 0 {
+
+		mantissaStr = encodedNumStr[:expIdx]
+
+		var exponentValue int64
+
+		exponentValue,
+			err = strconv.ParseInt(
+			encodedNumStr[expIdx+1:],
+			10,
+			32)
+
+		if err != nil {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'encodedNumStr' is invalid!\n"+
+				"The exponent of 'encodedNumStr' is invalid or out of range.\n"+
+				"encodedNumStr = '%v'\n",
+				ePrefix.String(),
+				encodedNumStr)
+
+			return err
+		}
+
+		exponentMagnitude = exponentValue
+
+		if exponentMagnitude < 0 {
+			exponentMagnitude = -exponentMagnitude
+		}
+	}
+
+	numOfDigits := int64(0)
+
+	for _, charRune := range mantissaStr {
+
+		if charRune >= '0' && charRune <= '9' {
+			numOfDigits++
+		}
+	}
+
+	if numOfDigits+exponentMagnitude > maxEncodedNumStrDigits {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'encodedNumStr' is invalid!\n"+
+			"The decoded value of 'encodedNumStr' would exceed the\n"+
+			"maximum of %v numeric digits.\n"+
+			"Number of mantissa digits = '%v'\n"+
+			"Exponent magnitude        = '%v'\n",
+			ePrefix.String(),
+			maxEncodedNumStrDigits,
+			numOfDigits,
+			exponentMagnitude)
+
+		return err
+	}
+
+	var newNumStrKernel NumberStrKernel
+
+	if strings.ContainsAny(encodedNumStr, "eE") {
+
+		var decSeparator DecimalSeparatorSpec
+
+		decSeparator,
+			err = new(DecimalSeparatorSpec).NewStr(
+			".",
+			ePrefix.XCpy(
+				"decSeparator"))
+
+		if err != nil {
+
+			return err
+		}
+
+		_,
+			err = new(numberStrKernelMechanics).
+			setNumStrKernelFromSciNotationStr(
+				&newNumStrKernel,
+				encodedNumStr,
+				decSeparator,
+				ePrefix.XCpy(
+					"newNumStrKernel<-encodedNumStr"))
+
+	} else {
+
+		_,
+			err = new(numberStrKernelMechanics).
+			setNumStrKernelFromRoundedNativeNumStr(
+				&newNumStrKernel,
+				encodedNumStr,
+				NumRoundType.NoRounding(),
+				0,
+				ePrefix.XCpy(
+					"newNumStrKernel<-encodedNumStr"))
+	}
+
+	if err != nil {
+
+		return err
+	}
+
+	err = numStrKernel.integerDigits.CopyIn(
+		&newNumStrKernel.integerDigits,
+		ePrefix.XCpy(
+			"numStrKernel.integerDigits<-"+
+				"newNumStrKernel.integerDigits"))
+
+	if err != nil {
+
+		return err
+	}
+
+	err = numStrKernel.fractionalDigits.CopyIn(
+		&newNumStrKernel.fractionalDigits,
+		ePrefix.XCpy(
+			"numStrKernel.fractionalDigits<-"+
+				"newNumStrKernel.fractionalDigits"))
+
+	if err != nil {
+
+		return err
+	}
+
+	numStrKernel.numberValueType =
+		newNumStrKernel.numberValueType
+
+	numStrKernel.numberSign =
+		newNumStrKernel.numberSign
+
+	numStrKernel.isNonZeroValue =
+		newNumStrKernel.isNonZeroValue
+
+	return err
+}
+
+// setNumStrKernelFromPercentNumStr
+//
+// Parses a percent, per mille or basis point number
+// string and reconfigures the NumberStrKernel instance
+// passed as input parameter 'numStrKernel' with the
+// resulting unscaled numeric value.
+//
+// The numeric value extracted from 'percentNumStr' is
+// divided by 100 (Percent), 1,000 (Per Mille) or 10,000
+// (Basis Points).
+//
+//	Examples:
+//		"12.5%"		= 0.125
+//		"12,5 %"	= 0.125 (decimal separator ',')
+//		"125‰"		= 0.125
+//		"1,250 bp"	= 0.125
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the data values contained in input parameter
+//	'numStrKernel' will be deleted and replaced.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		numeric value of this instance will be deleted
+//		and replaced by the value parsed from
+//		'percentNumStr'.
+//
+//	percentNumStr				string
+//
+//		The percent, per mille or basis point number
+//		string to be parsed. Recognized symbols are "%",
+//		"‰", "‱", "bp" and "bps".
+//
+//	percentFmtType				NumStrFormatTypeCode
+//
+//		Specifies the scale of 'percentNumStr'. Valid
+//		values are:
+//
+//			NumStrFmtType.None()
+//			NumStrFmtType.Percent()
+//			NumStrFmtType.PerMille()
+//			NumStrFmtType.BasisPoint()
+//
+//		If this parameter is set to NumStrFmtType.None(),
+//		the scale is determined by the symbol found in
+//		'percentNumStr'. In this case, if no symbol is
+//		found, an error will be returned.
+//
+//		If a symbol found in 'percentNumStr' conflicts
+//		with this parameter, an error will be returned.
+//
+//	decimalSeparator			string
+//
+//		The radix point or decimal separator which
+//		separates integer and fractional digits in
+//		'percentNumStr'.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	numStrStatsDto				NumberStrStatsDto
+//
+//		This data transfer object will return key
+//		statistics on the numeric value encapsulated
+//		by 'numStrKernel' after it has been reset.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelMech *numberStrKernelMechanics) setNumStrKernelFromPercentNumStr(
+	numStrKernel *NumberStrKernel,
+	percentNumStr string,
+	percentFmtType NumStrFormatTypeCode,
+	decimalSeparator string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	numStrStatsDto NumberStrStatsDto,
+	err error) {
+
+	if numStrKernelMech.lock == nil {
+		numStrKernelMech.lock = new(sync.Mutex)
+	}
+
+	numStrKernelMech.lock.Lock()
+
+	defer numStrKernelMech.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelMechanics."+
+			"setNumStrKernelFromPercentNumStr()",
+		"")
+
+	if err != nil {
+
+		return numStrStatsDto, err
+	}
+
+	if numStrKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return numStrStatsDto, err
+	}
+
+	nStrPercentFmtSpecAtom := numStrPercentFormatSpecAtom{}
+
+	numStr,
+		symbolFmtType,
+		err := nStrPercentFmtSpecAtom.
+		extractPercentSymbol(
+			percentNumStr,
+			decimalSeparator,
+			ePrefix.XCpy(
+				"percentNumStr"))
+
+	if err != nil {
+
+		return numStrStatsDto, err
+	}
+
+	if percentFmtType == NumStrFmtType.None() {
+
+		percentFmtType = symbolFmtType
+
+		if percentFmtType == NumStrFmtType.None() {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'percentNumStr' is invalid!\n"+
+				"'percentFmtType' is 'None' and no percent, per mille\n"+
+				"or basis point symbol was found in 'percentNumStr'.\n"+
+				"percentNumStr = '%v'\n",
+				ePrefix.String(),
+				percentNumStr)
+
+			return numStrStatsDto, err
+		}
+
+	} else if symbolFmtType != NumStrFmtType.None() &&
+		symbolFmtType != percentFmtType {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'percentNumStr' is invalid!\n"+
+			"The symbol found in 'percentNumStr' conflicts with\n"+
+			"input parameter 'percentFmtType'.\n"+
+			"percentNumStr  = '%v'\n"+
+			"percentFmtType = '%v'\n",
+			ePrefix.String(),
+			percentNumStr,
+			percentFmtType.String())
+
+		return numStrStatsDto, err
+	}
+
+	scaleExponent,
+		_,
+		ok := nStrPercentFmtSpecAtom.getPercentParams(
+		percentFmtType)
+
+	if !ok {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'percentFmtType' is invalid!\n"+
+			"'percentFmtType' must be None, Percent, PerMille\n"+
+			"or BasisPoint.\n"+
+			"percentFmtType = '%v'\n",
+			ePrefix.String(),
+			percentFmtType.String())
+
+		return numStrStatsDto, err
+	}
+
+	_,
+		err = new(numberStrKernelMechanics).
+		setNumStrKernelFromRoundedNativeNumStr(
+			numStrKernel,
+			numStr,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"numStrKernel<-numStr"))
+
+	if err != nil {
+
+		return numStrStatsDto, err
+	}
+
+	err = new(numberStrKernelQuark).shiftDecimalPoint(
+		numStrKernel,
+		-scaleExponent,
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	if err != nil {
+
+		return numStrStatsDto, err
+	}
+
+	numStrStatsDto,
+		err = new(numberStrKernelAtom).
+		calcNumStrKernelStats(
+			numStrKernel,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	return numStrStatsDto, err
+}
+
+// setNumStrKernelFromRadixNumStr
+//
+// Deletes and resets the numeric value of a
+// NumberStrKernel instance using the integer value
+// parsed from a binary, octal or hexadecimal number
+// string.
+//
+// The parsed value is converted to base 10 and may be
+// of any size.
+//
+// For a description of valid binary, octal and
+// hexadecimal number strings, see method:
+//
+//	numberStrKernelQuark.parseRadixNumStr()
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the data values contained in input parameter
+//	'numStrKernel' will be deleted and replaced.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		numeric value of this instance will be deleted
+//		and replaced by the value parsed from
+//		'radixNumStr'.
+//
+//	radixNumStr					string
+//
+//		The binary, octal or hexadecimal number string
+//		to be parsed.
+//
+//	radixFmtType				NumStrFormatTypeCode
+//
+//		Specifies the base of 'radixNumStr'. Valid
+//		values are:
+//
+//			NumStrFmtType.Binary()
+//			NumStrFmtType.Octal()
+//			NumStrFmtType.Hexadecimal()
+//			NumStrFmtType.None()
+//
+//		If this parameter is set to NumStrFmtType.None(),
+//		the base will be detected from the radix prefix
+//		("0b", "0o" or "0x").
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	numStrStatsDto				NumberStrStatsDto
+//
+//		This data transfer object will return key
+//		statistics on the numeric value encapsulated
+//		by 'numStrKernel' after it has been reset.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelMech *numberStrKernelMechanics) setNumStrKernelFromRadixNumStr(
+	numStrKernel *NumberStrKernel,
+	radixNumStr string,
+	radixFmtType NumStrFormatTypeCode,
+	errPrefDto *ePref.ErrPrefixDto) (
+	numStrStatsDto NumberStrStatsDto,
+	err error) {
+
+	if numStrKernelMech.lock == nil {
+		numStrKernelMech.lock = new(sync.Mutex)
+	}
+
+	numStrKernelMech.lock.Lock()
+
+	defer numStrKernelMech.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelMechanics."+
+			"setNumStrKernelFromRadixNumStr()",
+		"")
+
+	if err != nil {
+
+		return numStrStatsDto, err
+	}
+
+	if numStrKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return numStrStatsDto, err
+	}
+
+	numStrKernelQuark := numberStrKernelQuark{}
+
+	intValue,
+		_,
+		err := numStrKernelQuark.parseRadixNumStr(
+		radixNumStr,
+		radixFmtType,
+		ePrefix.XCpy(
+			"radixNumStr"))
+
+	if err != nil {
+
+		return numStrStatsDto, err
+	}
+
+	err = numStrKernelQuark.
+		setNumStrKernelFromNativeNumStr(
+			numStrKernel,
+			intValue.String(),
+			ePrefix.XCpy(
+				"numStrKernel<-intValue"))
+
+	if err != nil {
+
+		return numStrStatsDto, err
+	}
+
+	numStrStatsDto,
+		err = new(numberStrKernelAtom).
+		calcNumStrKernelStats(
+			numStrKernel,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	return numStrStatsDto, err
+}
+
+// setNumStrKernelFromRomanNumeral
+//
+// Parses a string of Roman numerals and reconfigures the
+// NumberStrKernel instance passed as input parameter
+// 'numStrKernel' with the resulting integer value.
+//
+// Parsing is strict. Only canonical Roman numerals
+// consisting entirely of upper case or entirely of lower
+// case characters are accepted. Non-canonical forms such
+// as "IIII" or "VX" will generate an error. Numerals in
+// vinculum, or overline, form are accepted for values
+// from 4,000 through 3,999,999.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the data values contained in input parameter
+//	'numStrKernel' will be deleted and replaced.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		numeric value of this instance will be deleted
+//		and replaced by the value parsed from
+//		'romanNumeralStr'.
+//
+//	romanNumeralStr				string
+//
+//		The string of Roman numerals to be parsed.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	numStrStatsDto				NumberStrStatsDto
+//
+//		This data transfer object will return key
+//		statistics on the numeric value encapsulated
+//		by 'numStrKernel' after it has been reset.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelMech *numberStrKernelMechanics) setNumStrKernelFromRomanNumeral(
+	numStrKernel *NumberStrKernel,
+	romanNumeralStr string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	numStrStatsDto NumberStrStatsDto,
+	err error) {
+
+	if numStrKernelMech.lock == nil {
+		numStrKernelMech.lock = new(sync.Mutex)
+	}
+
+	numStrKernelMech.lock.Lock()
+
+	defer numStrKernelMech.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelMechanics."+
+			"setNumStrKernelFromRomanNumeral()",
+		"")
+
+	if err != nil {
+
+		return numStrStatsDto, err
+	}
+
+	if numStrKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return numStrStatsDto, err
+	}
+
+	var intValue int64
+
+	intValue,
+		err = new(numStrRomanNumeralQuark).parseRomanNumeral(
+		romanNumeralStr,
+		ePrefix.XCpy(
+			"romanNumeralStr"))
+
+	if err != nil {
+
+		return numStrStatsDto, err
+	}
+
+	err = new(numberStrKernelQuark).
+		setNumStrKernelFromNativeNumStr(
+			numStrKernel,
+			strconv.FormatInt(intValue, 10),
+			ePrefix.XCpy(
+				"numStrKernel<-intValue"))
+
+	if err != nil {
+
+		return numStrStatsDto, err
+	}
+
+	numStrStatsDto,
+		err = new(numberStrKernelAtom).
+		calcNumStrKernelStats(
+			numStrKernel,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	return numStrStatsDto, err
 }
 
 // setNumStrKernelFromRoundedDirtyNumStr
@@ -3228,3 +4097,403 @@ func (numStrKernelMech *numberStrKernelMechanics) setNumStrKernelFromRoundedPure
 
 	return pureNumStrComponents, err
 }
+
+// setNumStrKernelFromSciNotationStr
+//
+// Deletes and resets the numeric value of a
+// NumberStrKernel instance using the value parsed from
+// a number string formatted in Scientific Notation or
+// E-Notation.
+//
+// The exponent is expanded exactly into the integer and
+// fractional digit arrays of 'numStrKernel'. No
+// rounding is applied.
+//
+//	Examples:
+//		"1.602E-19"  => 0.0000000000000000001602
+//		"6.022e+23"  => 602200000000000000000000
+//		"-3.1×10^8"  => -310000000
+//
+// For a description of valid Scientific Notation
+// strings, see method:
+//
+//	numberStrKernelQuark.parseSciNotationStr()
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the data values contained in input parameter
+//	'numStrKernel' will be deleted and replaced.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		numeric value of this instance will be deleted
+//		and replaced by the value parsed from
+//		'sciNotStr'.
+//
+//	sciNotStr					string
+//
+//		The Scientific Notation number string to be
+//		parsed.
+//
+//	decSeparator				DecimalSeparatorSpec
+//
+//		The decimal separator used to separate integer
+//		and fractional digits in the significand of
+//		'sciNotStr'.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	numStrStatsDto				NumberStrStatsDto
+//
+//		This data transfer object will return key
+//		statistics on the numeric value encapsulated
+//		by 'numStrKernel' after it has been reset.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelMech *numberStrKernelMechanics) setNumStrKernelFromSciNotationStr(
+	numStrKernel *NumberStrKernel,
+	sciNotStr string,
+	decSeparator DecimalSeparatorSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	numStrStatsDto NumberStrStatsDto,
+	err error) {
+
+	if numStrKernelMech.lock == nil {
+		numStrKernelMech.lock = new(sync.Mutex)
+	}
+
+	numStrKernelMech.lock.Lock()
+
+	defer numStrKernelMech.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelMechanics."+
+			"setNumStrKernelFromSciNotationStr()",
+		"")
+
+	if err != nil {
+
+		return numStrStatsDto, err
+	}
+
+	if numStrKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return numStrStatsDto, err
+	}
+
+	significand,
+		exponent,
+		err := new(numberStrKernelQuark).parseSciNotationStr(
+		sciNotStr,
+		decSeparator,
+		ePrefix.XCpy(
+			"sciNotStr"))
+
+	if err != nil {
+
+		return numStrStatsDto, err
+	}
+
+	var bigDec BigDecimal
+
+	bigDecElectron := bigDecimalElectron{}
+
+	bigDecElectron.setComponents(
+		&bigDec,
+		significand,
+		exponent)
+
+	var integerDigits, fractionalDigits []rune
+	var numberSign NumericSignValueType
+
+	integerDigits,
+		fractionalDigits,
+		numberSign,
+		err = bigDecElectron.getDigitRunes(
+		&bigDec,
+		ePrefix.XCpy(
+			"sciNotStr"))
+
+	if err != nil {
+
+		return numStrStatsDto, err
+	}
+
+	err = new(numberStrKernelNanobot).setWithRunes(
+		numStrKernel,
+		integerDigits,
+		fractionalDigits,
+		numberSign,
+		ePrefix.XCpy(
+			"numStrKernel<-sciNotStr"))
+
+	if err != nil {
+
+		return numStrStatsDto, err
+	}
+
+	numStrStatsDto,
+		err = new(numberStrKernelAtom).
+		calcNumStrKernelStats(
+			numStrKernel,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	return numStrStatsDto, err
+}
+
+// setNumStrKernelFromSqlValue
+//
+// Receives a value read from a database column by a
+// 'database/sql' driver and uses that value to reset the
+// numeric value of a NumberStrKernel instance.
+//
+// This method supports the implementation of the
+// 'sql.Scanner' interface by type NumberStrKernel.
+//
+// The database value passed by input parameter
+// 'sqlValue' must be one of the following types:
+//
+//	nil
+//		A database NULL value. The numeric value of
+//		'numStrKernel' will be deleted leaving an
+//		empty NumberStrKernel instance.
+//
+//	int64
+//		An integer value.
+//
+//	[]byte
+//	string
+//		A Native Number String or an E-Notation number
+//		string such as those returned for DECIMAL or
+//		NUMERIC columns. Empty strings, or strings
+//		consisting entirely of white space, will
+//		trigger an error.
+//
+// Floating point values (float32 and float64) are NOT
+// supported and will trigger an error. Floating point
+// values cannot represent most decimal fractions
+// exactly. Database columns holding values to be
+// scanned into a NumberStrKernel should be defined as
+// DECIMAL, NUMERIC or text columns.
+//
+// ----------------------------------------------------------------
+//
+// # BE ADVISED
+//
+//	Only the numeric value of 'numStrKernel' is
+//	modified by this method. The Default Number String
+//	Format Specification and the JSON Marshal Format
+//	configured for 'numStrKernel' are NOT changed.
+//
+//	If an error is returned, 'numStrKernel' will NOT be
+//	modified.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		numeric value of this instance will be deleted
+//		and replaced by the value extracted from
+//		'sqlValue'.
+//
+//	sqlValue					interface{}
+//
+//		The value read from the database by a
+//		'database/sql' driver. If this value is not one
+//		of the types listed above, an error will be
+//		returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelMech *numberStrKernelMechanics) setNumStrKernelFromSqlValue(
+	numStrKernel *NumberStrKernel,
+	sqlValue interface{},
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if numStrKernelMech.lock == nil {
+		numStrKernelMech.lock = new(sync.Mutex)
+	}
+
+	numStrKernelMech.lock.Lock()
+
+	defer numStrKernelMech.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelMechanics."+
+			"setNumStrKernelFromSqlValue()",
+		"")
+
+	if err != nil {
+
+		return err
+	}
+
+	if numStrKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	var encodedNumStr string
+
+	switch sqlValue := sqlValue.(type) {
+
+	case nil:
+
+		// Only a database NULL value produces an empty
+		// NumberStrKernel.
+		numStrKernel.integerDigits.Empty()
+
+		numStrKernel.fractionalDigits.Empty()
+
+		numStrKernel.numberValueType = NumValType.None()
+
+		numStrKernel.numberSign = NumSignVal.None()
+
+		numStrKernel.isNonZeroValue = false
+
+		return err
+
+	case int64:
+
+		encodedNumStr = strconv.FormatInt(sqlValue, 10)
+
+	case []byte:
+
+		encodedNumStr = string(sqlValue)
+
+	case string:
+
+		encodedNumStr = sqlValue
+
+	case float32, float64:
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sqlValue' is a floating point value.\n"+
+			"Floating point values cannot be converted to a NumberStrKernel\n"+
+			"without risking a loss of precision. Database columns scanned\n"+
+			"into a NumberStrKernel must be DECIMAL, NUMERIC or text columns.\n"+
+			"sqlValue type  = '%T'\n"+
+			"sqlValue value = '%v'\n",
+			ePrefix.String(),
+			sqlValue,
+			sqlValue)
+
+		return err
+
+	default:
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sqlValue' is an unsupported type.\n"+
+			"Supported types are nil, int64, []byte and string.\n"+
+			"sqlValue type = '%T'\n",
+			ePrefix.String(),
+			sqlValue)
+
+		return err
+	}
+
+	if len(strings.TrimSpace(encodedNumStr)) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sqlValue' is an empty string.\n"+
+			"Empty text values cannot be converted to a NumberStrKernel.\n"+
+			"Use a database NULL value to represent a missing number.\n"+
+			"sqlValue type = '%T'\n",
+			ePrefix.String(),
+			sqlValue)
+
+		return err
+	}
+
+	err = new(numberStrKernelMechanics).
+		setNumStrKernelFromEncodedNumStr(
+			numStrKernel,
+			encodedNumStr,
+			ePrefix.XCpy(
+				"numStrKernel<-sqlValue"))
+
+	return err
+}
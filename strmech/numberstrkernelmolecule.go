@@ -952,6 +952,38 @@ func (numStrKernelMolecule *numberStrKernelMolecule) convertNumericValueToKernel
 //				numeric digits in a floating point
 //				number.
 //
+//			digitCountSpec			NumStrDigitCountSpec
+//
+//				The Digit Count Specification. If this
+//				specification is configured, the minimum
+//				integer digits and the minimum and
+//				maximum fractional digits are applied
+//				to standard and percent formats after
+//				rounding.
+//
+//			zeroDigitCountSpec		NumStrDigitCountSpec
+//
+//				The Digit Count Specification applied
+//				to zero values. If this specification is
+//				NOP, 'digitCountSpec' is applied to zero
+//				values.
+//
+//			zeroLiteral				string
+//
+//				If configured, zero values are displayed
+//				as this literal text, justified within
+//				the number field.
+//
+//			digitSystem				NumberDigitSystem
+//
+//				Specifies the digit system used to
+//				display numeric digits. If set to a
+//				native digit system such as Arabic-Indic
+//				or Devanagari, all Latin (ASCII) digits
+//				in the final number string are converted
+//				to native digits. Radix formats are
+//				always displayed with Latin digits.
+//
 //			intSeparatorSpec 		IntegerSeparatorSpec
 //
 //				Integer Separator Specification. This
@@ -991,6 +1023,45 @@ func (numStrKernelMolecule *numberStrKernelMolecule) convertNumericValueToKernel
 //				This specification can also be used to
 //				configure currency symbols.
 //
+//			ordinalFmtSpec			NumStrOrdinalFormatSpec
+//
+//				The Ordinal Format Specification. If this
+//				specification is configured, the integer
+//				value of 'numStrKernel' will be formatted
+//				as an ordinal number.
+//
+//			percentFmtSpec			NumStrPercentFormatSpec
+//
+//				The Percent Format Specification. If this
+//				specification is configured, the numeric
+//				value of 'numStrKernel' will be scaled
+//				and formatted as a percentage, per mille
+//				value or basis points.
+//
+//			radixFmtSpec			NumStrRadixFormatSpec
+//
+//				The Radix Format Specification. If this
+//				specification is configured for Binary,
+//				Octal or Hexadecimal formatting, the
+//				integer value of 'numStrKernel' will be
+//				formatted in that base. Otherwise, the
+//				numeric value is formatted in base 10.
+//
+//			romanNumFmtSpec			NumStrRomanNumeralFormatSpec
+//
+//				The Roman Numeral Format Specification.
+//				If this specification is configured, the
+//				integer value of 'numStrKernel' will be
+//				formatted as Roman numerals.
+//
+//			sciNotFmtSpec			SciNotationFormatSpec
+//
+//				The Scientific Notation Format
+//				Specification. If this specification is
+//				configured, the numeric value of
+//				'numStrKernel' will be formatted in
+//				Scientific Notation.
+//
 //			zeroNumberSign		NumStrNumberSymbolSpec
 //
 //				The Number String Zero Number Symbol
@@ -1153,18 +1224,214 @@ func (numStrKernelMolecule *numberStrKernelMolecule) formatNumStr(
 		return numStr, err
 	}
 
-	return new(numberStrKernelAtom).formatNumStrElements(
-		numStrKernel,
-		roundingSpec,
-		decSeparator,
-		intSeparatorDto,
-		negativeNumberSign,
-		positiveNumberSign,
-		zeroNumberSign,
-		currencySymbol,
-		numberFieldSpec,
-		ePrefix.XCpy(
-			"numStrKernel->"))
+	digitCountSpec := nStrFormatSpec.digitCountSpec
+
+	if !nStrFormatSpec.zeroDigitCountSpec.IsNOP() ||
+		nStrFormatSpec.hasZeroLiteral {
+
+		var isNonZeroValue bool
+
+		isNonZeroValue,
+			err = new(numberStrKernelElectron).getSetIsNonZeroValue(
+			numStrKernel,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			return numStr, err
+		}
+
+		if !isNonZeroValue &&
+			nStrFormatSpec.hasZeroLiteral {
+
+			return new(numberStrKernelElectron).formatZeroLiteral(
+				nStrFormatSpec.zeroLiteral,
+				numberFieldSpec,
+				ePrefix.XCpy(
+					"numStr<-zeroLiteral"))
+		}
+
+		if !isNonZeroValue {
+			digitCountSpec = nStrFormatSpec.zeroDigitCountSpec
+		}
+	}
+
+	if !nStrFormatSpec.sciNotFmtSpec.IsNOP() {
+
+		var sciNotFmtSpec SciNotationFormatSpec
+
+		sciNotFmtSpec,
+			err = nStrFormatSpec.GetSciNotationFormatSpec(
+			ePrefix.XCpy(
+				"sciNotFmtSpec<-nStrFormatSpec"))
+
+		if err != nil {
+			return numStr, err
+		}
+
+		numStr,
+			err = new(numberStrKernelAtom).formatSciNotationNumStr(
+			numStrKernel,
+			roundingSpec,
+			sciNotFmtSpec,
+			decSeparator,
+			negativeNumberSign,
+			positiveNumberSign,
+			zeroNumberSign,
+			numberFieldSpec,
+			ePrefix.XCpy(
+				"numStrKernel->"))
+
+	} else if !nStrFormatSpec.radixFmtSpec.IsNOP() {
+
+		var radixFmtSpec NumStrRadixFormatSpec
+
+		radixFmtSpec,
+			err = nStrFormatSpec.GetRadixFormatSpec(
+			ePrefix.XCpy(
+				"radixFmtSpec<-nStrFormatSpec"))
+
+		if err != nil {
+			return numStr, err
+		}
+
+		numStr,
+			err = new(numberStrKernelAtom).formatRadixNumStr(
+			numStrKernel,
+			roundingSpec,
+			radixFmtSpec,
+			intSeparatorDto,
+			negativeNumberSign,
+			positiveNumberSign,
+			zeroNumberSign,
+			numberFieldSpec,
+			ePrefix.XCpy(
+				"numStrKernel->"))
+
+	} else if !nStrFormatSpec.romanNumFmtSpec.IsNOP() {
+
+		var romanNumFmtSpec NumStrRomanNumeralFormatSpec
+
+		romanNumFmtSpec,
+			err = nStrFormatSpec.GetRomanNumeralFormatSpec(
+			ePrefix.XCpy(
+				"romanNumFmtSpec<-nStrFormatSpec"))
+
+		if err != nil {
+			return numStr, err
+		}
+
+		numStr,
+			err = new(numberStrKernelAtom).formatRomanNumeralNumStr(
+			numStrKernel,
+			roundingSpec,
+			romanNumFmtSpec,
+			positiveNumberSign,
+			numberFieldSpec,
+			ePrefix.XCpy(
+				"numStrKernel->"))
+
+	} else if !nStrFormatSpec.ordinalFmtSpec.IsNOP() {
+
+		var ordinalFmtSpec NumStrOrdinalFormatSpec
+
+		ordinalFmtSpec,
+			err = nStrFormatSpec.GetOrdinalFormatSpec(
+			ePrefix.XCpy(
+				"ordinalFmtSpec<-nStrFormatSpec"))
+
+		if err != nil {
+			return numStr, err
+		}
+
+		numStr,
+			err = new(numberStrKernelAtom).formatOrdinalNumStr(
+			numStrKernel,
+			roundingSpec,
+			ordinalFmtSpec,
+			positiveNumberSign,
+			zeroNumberSign,
+			numberFieldSpec,
+			ePrefix.XCpy(
+				"numStrKernel->"))
+
+	} else if !nStrFormatSpec.percentFmtSpec.IsNOP() {
+
+		var percentFmtSpec NumStrPercentFormatSpec
+
+		percentFmtSpec,
+			err = nStrFormatSpec.GetPercentFormatSpec(
+			ePrefix.XCpy(
+				"percentFmtSpec<-nStrFormatSpec"))
+
+		if err != nil {
+			return numStr, err
+		}
+
+		numStr,
+			err = new(numberStrKernelAtom).formatPercentNumStr(
+			numStrKernel,
+			roundingSpec,
+			percentFmtSpec,
+			digitCountSpec,
+			decSeparator,
+			intSeparatorDto,
+			negativeNumberSign,
+			positiveNumberSign,
+			zeroNumberSign,
+			currencySymbol,
+			numberFieldSpec,
+			ePrefix.XCpy(
+				"numStrKernel->"))
+
+	} else if !digitCountSpec.IsNOP() {
+
+		numStr,
+			err = new(numberStrKernelAtom).formatDigitCountNumStr(
+			numStrKernel,
+			roundingSpec,
+			digitCountSpec,
+			decSeparator,
+			intSeparatorDto,
+			negativeNumberSign,
+			positiveNumberSign,
+			zeroNumberSign,
+			currencySymbol,
+			numberFieldSpec,
+			ePrefix.XCpy(
+				"numStrKernel->"))
+
+	} else {
+
+		numStr,
+			err = new(numberStrKernelAtom).formatNumStrElements(
+			numStrKernel,
+			roundingSpec,
+			decSeparator,
+			intSeparatorDto,
+			negativeNumberSign,
+			positiveNumberSign,
+			zeroNumberSign,
+			currencySymbol,
+			numberFieldSpec,
+			ePrefix.XCpy(
+				"numStrKernel->"))
+	}
+
+	if err != nil {
+		return numStr, err
+	}
+
+	// Radix formats (binary, octal, hexadecimal) are
+	// always displayed with Latin (ASCII) digits.
+	if nStrFormatSpec.radixFmtSpec.IsNOP() {
+
+		numStr = new(numStrDigitSystemQuark).convertAsciiDigits(
+			numStr,
+			nStrFormatSpec.digitSystem)
+	}
+
+	return numStr, err
 }
 
 // getAllIntFracDigits
@@ -1683,3 +1950,134 @@ func (numStrKernelMolecule *numberStrKernelMolecule) getAllIntFracDigits(
 
 	return allIntFracDigits, err
 }
+
+// getMarshalNumStr
+//
+// Returns the numeric value of a NumberStrKernel
+// instance formatted as a Native Number String suitable
+// for use in text, JSON and database encodings.
+//
+// No rounding is applied. All significant integer and
+// fractional digits are included in the returned Native
+// Number String. Therefore, the returned string
+// represents the exact numeric value contained in
+// 'numStrKernel'.
+//
+//	Examples:
+//		"-1234.5678"
+//		"0.000000000000000000001"
+//		"123456789012345678901234567890"
+//
+// If 'numStrKernel' is empty and contains zero integer
+// digits and zero fractional digits, an empty string
+// will be returned and no error will be generated. An
+// empty NumberStrKernel is treated as the equivalent of
+// a 'null' or missing value.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		numeric value encapsulated by this instance will
+//		be returned as a Native Number String.
+//
+//		'numStrKernel' will NOT be modified by this
+//		method.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	nativeNumStr				string
+//
+//		If this method completes successfully, this
+//		parameter will return the numeric value of
+//		'numStrKernel' formatted as a Native Number
+//		String.
+//
+//		If 'numStrKernel' is empty, this parameter will
+//		return an empty string.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelMolecule *numberStrKernelMolecule) getMarshalNumStr(
+	numStrKernel *NumberStrKernel,
+	errPrefDto *ePref.ErrPrefixDto) (
+	nativeNumStr string,
+	err error) {
+
+	if numStrKernelMolecule.lock == nil {
+		numStrKernelMolecule.lock = new(sync.Mutex)
+	}
+
+	numStrKernelMolecule.lock.Lock()
+
+	defer numStrKernelMolecule.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrKernelMolecule."+
+			"getMarshalNumStr()",
+		"")
+
+	if err != nil {
+
+		return nativeNumStr, err
+	}
+
+	if numStrKernel == nil {
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return nativeNumStr, err
+	}
+
+	if numStrKernel.integerDigits.GetRuneArrayLength() == 0 &&
+		numStrKernel.fractionalDigits.GetRuneArrayLength() == 0 {
+
+		return nativeNumStr, err
+	}
+
+	nativeNumStr,
+		_,
+		err = new(numberStrKernelQuark).getNativeNumStr(
+		numStrKernel,
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"nativeNumStr<-numStrKernel"))
+
+	return nativeNumStr, err
+}
@@ -209,6 +209,9 @@ func (numStrKernelNanobot *numberStrKernelNanobot) copy(
 	destinationNumStrKernel.isNonZeroValue =
 		sourceNumStrKernel.isNonZeroValue
 
+	destinationNumStrKernel.jsonMarshalFormat =
+		sourceNumStrKernel.jsonMarshalFormat
+
 	// This is NOT a NOP. Defaults were set in call to
 	// numStrKernelAtom.testValidityOfNumStrKernel(
 	//			sourceNumStrKernel,
@@ -621,6 +624,20 @@ func (numStrKernelNanobot *numberStrKernelNanobot) getParameterTextListing(
 		return err
 	}
 
+	// Build JSON Marshal Format
+
+	txtStrLabel = "JSON Marshal Format"
+
+	err = txtFormatCol.AddLine2Col(
+		txtStrLabel,
+		numStrKernel.jsonMarshalFormat,
+		ePrefix.XCpy(
+			"JSON Marshal Format"))
+
+	if err != nil {
+		return err
+	}
+
 	// Trailing Title Marquee
 	// Top Blank Line
 	txtFormatCol.AddLineBlank(
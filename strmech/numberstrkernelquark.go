@@ -3,6 +3,8 @@ package strmech
 import (
 	"fmt"
 	ePref "github.com/MikeAustin71/errpref"
+	"math/big"
+	"strings"
 	"sync"
 )
 
@@ -2092,6 +2094,758 @@ func (numStrKernelQuark *numberStrKernelQuark) equalizeNStrFracDigitsLengths(
 	return err
 }
 
+// parseRadixNumStr
+//
+// Parses a binary, octal or hexadecimal number string
+// and returns the integer value as a type *big.Int.
+// The numeric value may be of any size.
+//
+// Leading and trailing white space is ignored. The
+// number string may begin with a leading plus ('+') or
+// minus ('-') sign, followed by an optional radix
+// prefix:
+//
+//	Binary      = "0b" or "0B"
+//	Octal       = "0o" or "0O"
+//	Hexadecimal = "0x" or "0X"
+//
+// Underscore characters ('_') may be used to separate
+// digits. An underscore may appear between two digits or
+// directly after the radix prefix. Leading, trailing and
+// consecutive underscores are invalid.
+//
+//	Examples:
+//		"0x1F"          = 31
+//		"-0o17"         = -15
+//		"0b1010_0101"   = 165
+//		"0xDEAD_BEEF"   = 3735928559
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	radixNumStr					string
+//
+//		The binary, octal or hexadecimal number string
+//		to be parsed.
+//
+//	radixFmtType				NumStrFormatTypeCode
+//
+//		Specifies the base of 'radixNumStr'. Valid
+//		values are:
+//
+//			NumStrFmtType.Binary()
+//			NumStrFmtType.Octal()
+//			NumStrFmtType.Hexadecimal()
+//			NumStrFmtType.None()
+//
+//		If this parameter is set to one of the first
+//		three values, the radix prefix is optional. If
+//		present, it must match the specified base.
+//
+//		If this parameter is set to NumStrFmtType.None(),
+//		the base will be detected from the radix prefix.
+//		In this case, 'radixNumStr' must contain a valid
+//		radix prefix.
+//
+//		Any other value will trigger an error.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	intValue					*big.Int
+//
+//		If this method completes successfully, this
+//		parameter will return the integer value parsed
+//		from 'radixNumStr'.
+//
+//	detectedRadixFmtType		NumStrFormatTypeCode
+//
+//		The base of 'radixNumStr' expressed as one of
+//		the following values:
+//
+//			NumStrFmtType.Binary()
+//			NumStrFmtType.Octal()
+//			NumStrFmtType.Hexadecimal()
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelQuark *numberStrKernelQuark) parseRadixNumStr(
+	radixNumStr string,
+	radixFmtType NumStrFormatTypeCode,
+	errPrefDto *ePref.ErrPrefixDto) (
+	intValue *big.Int,
+	detectedRadixFmtType NumStrFormatTypeCode,
+	err error) {
+
+	if numStrKernelQuark.lock == nil {
+		numStrKernelQuark.lock = new(sync.Mutex)
+	}
+
+	numStrKernelQuark.lock.Lock()
+
+	defer numStrKernelQuark.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelQuark."+
+			"parseRadixNumStr()",
+		"")
+
+	if err != nil {
+		return intValue, detectedRadixFmtType, err
+	}
+
+	radixAtom := numStrRadixFormatSpecAtom{}
+
+	if radixFmtType != NumStrFmtType.None() {
+
+		if _, ok := radixAtom.getRadixParams(radixFmtType); !ok {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'radixFmtType' is invalid!\n"+
+				"'radixFmtType' must be Binary, Octal, Hexadecimal or None.\n"+
+				"radixFmtType = '%v'\n",
+				ePrefix.String(),
+				radixFmtType.String())
+
+			return intValue, detectedRadixFmtType, err
+		}
+	}
+
+	numRunes := []rune(strings.TrimSpace(radixNumStr))
+
+	lenNumRunes := len(numRunes)
+
+	idx := 0
+
+	isNegative := false
+
+	if lenNumRunes > 0 &&
+		(numRunes[0] == '-' || numRunes[0] == '+') {
+
+		isNegative = numRunes[0] == '-'
+
+		idx++
+	}
+
+	detectedRadixFmtType = radixFmtType
+
+	hasPrefix := false
+
+	if lenNumRunes-idx >= 2 &&
+		numRunes[idx] == '0' {
+
+		var prefixFmtType NumStrFormatTypeCode
+
+		switch numRunes[idx+1] {
+
+		case 'b', 'B':
+
+			prefixFmtType = NumStrFmtType.Binary()
+
+		case 'o', 'O':
+
+			prefixFmtType = NumStrFmtType.Octal()
+
+		case 'x', 'X':
+
+			prefixFmtType = NumStrFmtType.Hexadecimal()
+
+		}
+
+		// In hexadecimal strings, "0b" is a valid pair
+		// of digits and is therefore NOT a prefix.
+		if prefixFmtType != NumStrFmtType.None() &&
+			(radixFmtType == NumStrFmtType.None() ||
+				radixFmtType == prefixFmtType) {
+
+			detectedRadixFmtType = prefixFmtType
+
+			hasPrefix = true
+
+			idx += 2
+		}
+	}
+
+	if detectedRadixFmtType == NumStrFmtType.None() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'radixNumStr' is invalid!\n"+
+			"The base could not be detected because 'radixNumStr'\n"+
+			"does not begin with a radix prefix (0b, 0o or 0x).\n"+
+			"radixNumStr = '%v'\n",
+			ePrefix.String(),
+			radixNumStr)
+
+		return intValue, detectedRadixFmtType, err
+	}
+
+	radix,
+		_ := radixAtom.getRadixParams(detectedRadixFmtType)
+
+	digits := make([]rune, 0, lenNumRunes-idx)
+
+	lastWasUnderscore := false
+
+	for i := idx; i < lenNumRunes; i++ {
+
+		if numRunes[i] == '_' {
+
+			if lastWasUnderscore ||
+				(len(digits) == 0 && !hasPrefix) ||
+				i == lenNumRunes-1 {
+
+				err = fmt.Errorf("%v\n"+
+					"Error: Input parameter 'radixNumStr' is invalid!\n"+
+					"'radixNumStr' contains a misplaced digit separator ('_').\n"+
+					"Underscores are only valid between digits or\n"+
+					"directly after a radix prefix.\n"+
+					"radixNumStr = '%v'\n"+
+					"Character Index = '%v'\n",
+					ePrefix.String(),
+					radixNumStr,
+					i)
+
+				return intValue, detectedRadixFmtType, err
+			}
+
+			lastWasUnderscore = true
+
+			continue
+		}
+
+		lastWasUnderscore = false
+
+		var digitValue int
+
+		switch {
+
+		case numRunes[i] >= '0' && numRunes[i] <= '9':
+
+			digitValue = int(numRunes[i] - '0')
+
+		case numRunes[i] >= 'a' && numRunes[i] <= 'f':
+
+			digitValue = int(numRunes[i]-'a') + 10
+
+		case numRunes[i] >= 'A' && numRunes[i] <= 'F':
+
+			digitValue = int(numRunes[i]-'A') + 10
+
+		default:
+
+			digitValue = radix
+		}
+
+		if digitValue >= radix {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'radixNumStr' is invalid!\n"+
+				"'radixNumStr' contains a character which is NOT a\n"+
+				"valid %v digit.\n"+
+				"radixNumStr = '%v'\n"+
+				"Invalid Character = '%v'\n"+
+				"Character Index = '%v'\n",
+				ePrefix.String(),
+				detectedRadixFmtType.String(),
+				radixNumStr,
+				string(numRunes[i]),
+				i)
+
+			return intValue, detectedRadixFmtType, err
+		}
+
+		digits = append(digits, numRunes[i])
+	}
+
+	if len(digits) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'radixNumStr' is invalid!\n"+
+			"'radixNumStr' does not contain any %v digits.\n"+
+			"radixNumStr = '%v'\n",
+			ePrefix.String(),
+			detectedRadixFmtType.String(),
+			radixNumStr)
+
+		return intValue, detectedRadixFmtType, err
+	}
+
+	var ok bool
+
+	intValue,
+		ok = new(big.Int).SetString(
+		string(digits),
+		radix)
+
+	if !ok {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Conversion of 'radixNumStr' to a big.Int failed!\n"+
+			"radixNumStr = '%v'\n",
+			ePrefix.String(),
+			radixNumStr)
+
+		return intValue, detectedRadixFmtType, err
+	}
+
+	if isNegative {
+		intValue.Neg(intValue)
+	}
+
+	return intValue, detectedRadixFmtType, err
+}
+
+// parseSciNotationStr
+//
+// Parses a number string formatted in Scientific
+// Notation or E-Notation and returns the exact numeric
+// value as an integer significand and an integer
+// exponent:
+//
+//	numeric value = significand x 10^exponent
+//
+// The exponent returned by this method incorporates
+// the fractional digits of the parsed significand.
+//
+//	Example:
+//		Sci Notation String: "1.602E-19"
+//		significand: 1602
+//		exponent:    -22
+//
+// ----------------------------------------------------------------
+//
+// # Valid Scientific Notation Strings
+//
+// Leading and trailing white space is ignored. The
+// significand consists of an optional leading plus ('+')
+// or minus ('-') sign followed by numeric digits and an
+// optional decimal separator as specified by input
+// parameter 'decSeparator'.
+//
+// The significand must be followed by one of these
+// exponent formats:
+//
+//	E-Notation:
+//		"6.022E23"  "6.022E+23"  "1.602e-19"
+//
+//	Exponential:
+//		"-3.1×10^8"  "2.652 x 10^8"  "2.652 X 10^-8"
+//		"2.652*10^8"
+//
+//	Exponential with superscript exponent:
+//		"5.21×10⁻⁵"  "1.23 x 10⁵"
+//
+// The exponent sign may be specified with a plus sign
+// ('+'), a minus sign ('-') or the Unicode minus sign
+// ('−' U+2212). Spaces are permitted between the
+// significand and the multiplication sign ('x', 'X',
+// '×', or '*') and between the multiplication sign and
+// "10".
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	sciNotStr					string
+//
+//		The Scientific Notation number string to be
+//		parsed. If this string is empty or malformed,
+//		an error will be returned. Error messages
+//		identify the zero based character index at which
+//		the error was detected.
+//
+//	decSeparator				DecimalSeparatorSpec
+//
+//		The decimal separator used to separate integer
+//		and fractional digits in the significand. In the
+//		US, this is the period ('.'). In Germany, it is
+//		the comma (',').
+//
+//		If 'decSeparator' is empty or invalid, an error
+//		will be returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	significand					*big.Int
+//
+//		The signed integer significand containing all
+//		the digits parsed from the significand of
+//		'sciNotStr'.
+//
+//	exponent					*big.Int
+//
+//		The power of ten by which 'significand' must be
+//		multiplied to produce the numeric value of
+//		'sciNotStr'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelQuark *numberStrKernelQuark) parseSciNotationStr(
+	sciNotStr string,
+	decSeparator DecimalSeparatorSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	significand *big.Int,
+	exponent *big.Int,
+	err error) {
+
+	if numStrKernelQuark.lock == nil {
+		numStrKernelQuark.lock = new(sync.Mutex)
+	}
+
+	numStrKernelQuark.lock.Lock()
+
+	defer numStrKernelQuark.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelQuark."+
+			"parseSciNotationStr()",
+		"")
+
+	if err != nil {
+
+		return significand, exponent, err
+	}
+
+	err = decSeparator.IsValidInstanceError(
+		ePrefix.XCpy(
+			"decSeparator"))
+
+	if err != nil {
+
+		return significand, exponent, err
+	}
+
+	decSepRunes := decSeparator.GetDecimalSeparatorRunes()
+
+	lenDecSepRunes := len(decSepRunes)
+
+	sciNotRunes := []rune(strings.TrimSpace(sciNotStr))
+
+	lenSciNotRunes := len(sciNotRunes)
+
+	if lenSciNotRunes == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sciNotStr' is invalid!\n"+
+			"'sciNotStr' is an empty string.\n",
+			ePrefix.String())
+
+		return significand, exponent, err
+	}
+
+	idx := 0
+
+	isNegative := false
+
+	if sciNotRunes[0] == '-' ||
+		sciNotRunes[0] == '+' {
+
+		isNegative = sciNotRunes[0] == '-'
+
+		idx++
+	}
+
+	var significandDigits []rune
+
+	numOfFracDigits := 0
+
+	foundDecSeparator := false
+
+	for idx < lenSciNotRunes {
+
+		if sciNotRunes[idx] >= '0' &&
+			sciNotRunes[idx] <= '9' {
+
+			significandDigits = append(
+				significandDigits,
+				sciNotRunes[idx])
+
+			if foundDecSeparator {
+				numOfFracDigits++
+			}
+
+			idx++
+
+			continue
+		}
+
+		if !foundDecSeparator &&
+			idx+lenDecSepRunes <= lenSciNotRunes &&
+			string(sciNotRunes[idx:idx+lenDecSepRunes]) ==
+				string(decSepRunes) {
+
+			foundDecSeparator = true
+
+			idx += lenDecSepRunes
+
+			continue
+		}
+
+		break
+	}
+
+	if len(significandDigits) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sciNotStr' is invalid!\n"+
+			"'sciNotStr' does not contain a valid significand.\n"+
+			"sciNotStr = '%v'\n",
+			ePrefix.String(),
+			sciNotStr)
+
+		return significand, exponent, err
+	}
+
+	for idx < lenSciNotRunes &&
+		sciNotRunes[idx] == ' ' {
+
+		idx++
+	}
+
+	if idx >= lenSciNotRunes {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sciNotStr' is invalid!\n"+
+			"'sciNotStr' does not contain an exponent.\n"+
+			"sciNotStr = '%v'\n",
+			ePrefix.String(),
+			sciNotStr)
+
+		return significand, exponent, err
+	}
+
+	useSuperscript := false
+
+	switch sciNotRunes[idx] {
+
+	case 'E', 'e':
+
+		idx++
+
+	case 'x', 'X', '×', '*':
+
+		idx++
+
+		for idx < lenSciNotRunes &&
+			sciNotRunes[idx] == ' ' {
+
+			idx++
+		}
+
+		if idx+2 > lenSciNotRunes ||
+			string(sciNotRunes[idx:idx+2]) != "10" {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'sciNotStr' is invalid!\n"+
+				"Expected \"10\" after the multiplication sign\n"+
+				"at character index '%v'.\n"+
+				"sciNotStr = '%v'\n",
+				ePrefix.String(),
+				idx,
+				sciNotStr)
+
+			return significand, exponent, err
+		}
+
+		idx += 2
+
+		if idx < lenSciNotRunes &&
+			sciNotRunes[idx] == '^' {
+
+			idx++
+
+		} else {
+
+			useSuperscript = true
+		}
+
+	default:
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sciNotStr' is invalid!\n"+
+			"Invalid character '%v' at character index '%v'.\n"+
+			"Expected an exponent marker ('E', 'e' or 'x 10^').\n"+
+			"sciNotStr = '%v'\n",
+			ePrefix.String(),
+			string(sciNotRunes[idx]),
+			idx,
+			sciNotStr)
+
+		return significand, exponent, err
+	}
+
+	exponentStartIdx := idx
+
+	isNegativeExponent := false
+
+	if idx < lenSciNotRunes {
+
+		switch sciNotRunes[idx] {
+
+		case '-', '−', '⁻':
+
+			isNegativeExponent = true
+
+			idx++
+
+		case '+', '⁺':
+
+			idx++
+		}
+	}
+
+	var exponentDigits []rune
+
+	for ; idx < lenSciNotRunes; idx++ {
+
+		if useSuperscript {
+
+			digitValue := -1
+
+			for j, superscriptDigit := range []rune("⁰¹²³⁴⁵⁶⁷⁸⁹") {
+
+				if sciNotRunes[idx] == superscriptDigit {
+					digitValue = j
+					break
+				}
+			}
+
+			if digitValue < 0 {
+				break
+			}
+
+			exponentDigits = append(
+				exponentDigits,
+				rune('0'+digitValue))
+
+			continue
+		}
+
+		if sciNotRunes[idx] < '0' ||
+			sciNotRunes[idx] > '9' {
+
+			break
+		}
+
+		exponentDigits = append(
+			exponentDigits,
+			sciNotRunes[idx])
+	}
+
+	if len(exponentDigits) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sciNotStr' is invalid!\n"+
+			"The exponent beginning at character index '%v'\n"+
+			"is malformed and contains no numeric digits.\n"+
+			"sciNotStr = '%v'\n",
+			ePrefix.String(),
+			exponentStartIdx,
+			sciNotStr)
+
+		return significand, exponent, err
+	}
+
+	if idx < lenSciNotRunes {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sciNotStr' is invalid!\n"+
+			"The exponent is malformed. Invalid character '%v'\n"+
+			"at character index '%v'.\n"+
+			"sciNotStr = '%v'\n",
+			ePrefix.String(),
+			string(sciNotRunes[idx]),
+			idx,
+			sciNotStr)
+
+		return significand, exponent, err
+	}
+
+	significand,
+		_ = new(big.Int).SetString(
+		string(significandDigits),
+		10)
+
+	if isNegative {
+		significand.Neg(significand)
+	}
+
+	exponent,
+		_ = new(big.Int).SetString(
+		string(exponentDigits),
+		10)
+
+	if isNegativeExponent {
+		exponent.Neg(exponent)
+	}
+
+	exponent.Sub(
+		exponent,
+		big.NewInt(int64(numOfFracDigits)))
+
+	return significand, exponent, err
+}
+
 //	roundNumStrKernel
 //
 //	This method receives a pointer to an instance of
@@ -3242,3 +3996,184 @@ func (numStrKernelQuark *numberStrKernelQuark) setNumStrKernelFromPureNumStr(
 
 	return pureNumStrComponents, err
 }
+
+// shiftDecimalPoint
+//
+// Multiplies the numeric value of a NumberStrKernel by
+// ten raised to the power of 'places'. This is
+// accomplished by shifting the decimal point 'places'
+// digits to the right (positive 'places') or to the
+// left (negative 'places').
+//
+//	Examples:
+//		Value: 0.1234	places: 2	Result: 12.34
+//		Value: 12.5		places: -2	Result: 0.125
+//
+// When the decimal point is shifted to the left,
+// trailing zeros in the resulting fractional digits are
+// deleted.
+//
+// The number sign of 'numStrKernel' is preserved. All
+// other data values contained in 'numStrKernel',
+// including the Number String Format Specification,
+// will be deleted and reset.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		numeric value of this instance will be scaled by
+//		ten raised to the power of 'places'.
+//
+//	places						int
+//
+//		The number of digit positions by which the
+//		decimal point will be shifted. Positive values
+//		shift the decimal point to the right, negative
+//		values shift it to the left.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrKernelQuark *numberStrKernelQuark) shiftDecimalPoint(
+	numStrKernel *NumberStrKernel,
+	places int,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if numStrKernelQuark.lock == nil {
+		numStrKernelQuark.lock = new(sync.Mutex)
+	}
+
+	numStrKernelQuark.lock.Lock()
+
+	defer numStrKernelQuark.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numberStrKernelQuark."+
+			"shiftDecimalPoint()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if numStrKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	intDigits := numStrKernel.integerDigits.CharsArray
+
+	fracDigits := numStrKernel.fractionalDigits.CharsArray
+
+	lenIntDigits := len(intDigits)
+
+	allDigits := make([]rune, 0, lenIntDigits+len(fracDigits))
+
+	allDigits = append(allDigits, intDigits...)
+
+	allDigits = append(allDigits, fracDigits...)
+
+	decPointIdx := lenIntDigits + places
+
+	if decPointIdx < 0 {
+
+		leadingZeros := make([]rune, -decPointIdx)
+
+		for i := range leadingZeros {
+			leadingZeros[i] = '0'
+		}
+
+		allDigits = append(leadingZeros, allDigits...)
+
+		decPointIdx = 0
+	}
+
+	for len(allDigits) < decPointIdx {
+		allDigits = append(allDigits, '0')
+	}
+
+	newIntDigits := allDigits[:decPointIdx]
+
+	newFracDigits := allDigits[decPointIdx:]
+
+	for len(newIntDigits) > 1 &&
+		newIntDigits[0] == '0' {
+
+		newIntDigits = newIntDigits[1:]
+	}
+
+	if places < 0 {
+
+		for len(newFracDigits) > 0 &&
+			newFracDigits[len(newFracDigits)-1] == '0' {
+
+			newFracDigits = newFracDigits[:len(newFracDigits)-1]
+		}
+	}
+
+	var nativeNumStr []rune
+
+	if numStrKernel.numberSign == NumSignVal.Negative() {
+		nativeNumStr = append(nativeNumStr, '-')
+	}
+
+	if len(newIntDigits) == 0 {
+		nativeNumStr = append(nativeNumStr, '0')
+	} else {
+		nativeNumStr = append(nativeNumStr, newIntDigits...)
+	}
+
+	if len(newFracDigits) > 0 {
+
+		nativeNumStr = append(nativeNumStr, '.')
+
+		nativeNumStr = append(nativeNumStr, newFracDigits...)
+	}
+
+	return new(numberStrKernelQuark).setNumStrKernelFromNativeNumStr(
+		numStrKernel,
+		string(nativeNumStr),
+		ePrefix.XCpy(
+			"numStrKernel<-nativeNumStr"))
+}
@@ -0,0 +1,1045 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
+
+// NumStrDigitCountSpec
+//
+// Number String Digit Count Specification. This type
+// contains the parameters which control the minimum
+// number of integer digits and the minimum and maximum
+// number of fractional digits displayed in a formatted
+// number string.
+//
+// When configured as a member of NumStrFormatSpec, this
+// specification is applied by NumberStrKernel.FmtNumStr()
+// after the rounding specification passed to that method.
+// Fractional digits in excess of the maximum are rounded
+// away, trailing fractional zeros are removed until the
+// minimum is reached and short values are padded with
+// zeros.
+//
+//	Examples:
+//		minIntegerDigits = 1
+//		minFractionalDigits = 0
+//		maxFractionalDigits = 2
+//			1234.5     "1,234.5"
+//			1234.567   "1,234.57"
+//			1234       "1,234"
+//
+//		minIntegerDigits = 3
+//		minFractionalDigits = 2
+//		maxFractionalDigits = 2
+//			7.5        "007.50"
+//
+// These digit counts correspond to the '0' and '#'
+// digit placeholders in CLDR and Excel style number
+// patterns. See method NumStrFormatSpec.NewNumFmtPattern().
+//
+// Digit counts are applied to standard, currency and
+// percent formats. They are ignored by scientific
+// notation, radix, Roman numeral and ordinal formats.
+//
+// An empty or zero value instance of
+// NumStrDigitCountSpec is treated as a NOP, or 'No
+// Operation', specification. In this case all digits
+// remaining after rounding are displayed.
+type NumStrDigitCountSpec struct {
+	minIntegerDigits int
+	//	The minimum number of integer digits displayed.
+	//	Integer values with fewer digits are padded with
+	//	leading zeros. Values of zero or one signal that
+	//	a single integer digit will be displayed.
+
+	minFractionalDigits int
+	//	The minimum number of fractional digits
+	//	displayed. Values with fewer fractional digits
+	//	are padded with trailing zeros.
+
+	maxFractionalDigits int
+	//	The maximum number of fractional digits
+	//	displayed. Values with more fractional digits
+	//	are rounded using 'roundingType'. A value of
+	//	minus one (-1) signals that there is no maximum.
+
+	roundingType NumberRoundingType
+	//	The rounding algorithm applied when the number
+	//	of fractional digits exceeds
+	//	'maxFractionalDigits'. If this value is set to
+	//	NumRoundType.NoRounding(), 'maxFractionalDigits'
+	//	is ignored.
+	//
+	//	A value of NumRoundType.None() signals that this
+	//	specification is NOP, or Not Operational.
+
+	lock *sync.Mutex
+}
+
+// CopyIn
+//
+// Copies the data fields from an incoming instance of
+// NumStrDigitCountSpec ('incomingDigitCountSpec') to the
+// data fields of the current NumStrDigitCountSpec
+// instance.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the member variable data values in the current
+//	NumStrDigitCountSpec instance will be deleted and
+//	replaced.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	incomingDigitCountSpec		*NumStrDigitCountSpec
+//
+//		A pointer to an instance of NumStrDigitCountSpec.
+//		This method will NOT change the values of
+//		internal member variables contained in this
+//		instance.
+//
+//		If this instance is invalid, an error will be
+//		returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrDigitCountSpec *NumStrDigitCountSpec) CopyIn(
+	incomingDigitCountSpec *NumStrDigitCountSpec,
+	errorPrefix interface{}) error {
+
+	if nStrDigitCountSpec.lock == nil {
+		nStrDigitCountSpec.lock = new(sync.Mutex)
+	}
+
+	nStrDigitCountSpec.lock.Lock()
+
+	defer nStrDigitCountSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrDigitCountSpec."+
+			"CopyIn()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrDigitCountSpecAtom).copy(
+		nStrDigitCountSpec,
+		incomingDigitCountSpec,
+		ePrefix.XCpy(
+			"nStrDigitCountSpec<-incomingDigitCountSpec"))
+}
+
+// CopyOut
+//
+// Returns a deep copy of the current
+// NumStrDigitCountSpec instance.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	deepCopyDigitCountSpec		NumStrDigitCountSpec
+//
+//		If this method completes successfully, a deep
+//		copy of the current NumStrDigitCountSpec
+//		instance will be returned.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrDigitCountSpec *NumStrDigitCountSpec) CopyOut(
+	errorPrefix interface{}) (
+	deepCopyDigitCountSpec NumStrDigitCountSpec,
+	err error) {
+
+	if nStrDigitCountSpec.lock == nil {
+		nStrDigitCountSpec.lock = new(sync.Mutex)
+	}
+
+	nStrDigitCountSpec.lock.Lock()
+
+	defer nStrDigitCountSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrDigitCountSpec."+
+			"CopyOut()",
+		"")
+
+	if err != nil {
+		return deepCopyDigitCountSpec, err
+	}
+
+	err = new(numStrDigitCountSpecAtom).copy(
+		&deepCopyDigitCountSpec,
+		nStrDigitCountSpec,
+		ePrefix.XCpy(
+			"deepCopyDigitCountSpec<-nStrDigitCountSpec"))
+
+	return deepCopyDigitCountSpec, err
+}
+
+// Empty
+//
+// Resets all internal member variables for the current
+// instance of NumStrDigitCountSpec to their initial or
+// zero values. Afterwards, the current instance is NOP,
+// or Not Operational.
+func (nStrDigitCountSpec *NumStrDigitCountSpec) Empty() {
+
+	if nStrDigitCountSpec.lock == nil {
+		nStrDigitCountSpec.lock = new(sync.Mutex)
+	}
+
+	nStrDigitCountSpec.lock.Lock()
+
+	new(numStrDigitCountSpecAtom).empty(
+		nStrDigitCountSpec)
+
+	nStrDigitCountSpec.lock.Unlock()
+
+	nStrDigitCountSpec.lock = nil
+}
+
+// Equal
+//
+// Receives a pointer to another instance of
+// NumStrDigitCountSpec and proceeds to compare its
+// internal member variables to those of the current
+// instance. If all member variables are equivalent,
+// this method returns 'true'.
+func (nStrDigitCountSpec *NumStrDigitCountSpec) Equal(
+	incomingDigitCountSpec *NumStrDigitCountSpec) bool {
+
+	if nStrDigitCountSpec.lock == nil {
+		nStrDigitCountSpec.lock = new(sync.Mutex)
+	}
+
+	nStrDigitCountSpec.lock.Lock()
+
+	defer nStrDigitCountSpec.lock.Unlock()
+
+	return new(numStrDigitCountSpecAtom).equal(
+		nStrDigitCountSpec,
+		incomingDigitCountSpec)
+}
+
+// GetMaxFractionalDigits
+//
+// Returns the maximum number of fractional digits
+// displayed in a formatted number string. A value of
+// minus one (-1) signals that there is no maximum.
+func (nStrDigitCountSpec *NumStrDigitCountSpec) GetMaxFractionalDigits() int {
+
+	if nStrDigitCountSpec.lock == nil {
+		nStrDigitCountSpec.lock = new(sync.Mutex)
+	}
+
+	nStrDigitCountSpec.lock.Lock()
+
+	defer nStrDigitCountSpec.lock.Unlock()
+
+	return nStrDigitCountSpec.maxFractionalDigits
+}
+
+// GetMinFractionalDigits
+//
+// Returns the minimum number of fractional digits
+// displayed in a formatted number string.
+func (nStrDigitCountSpec *NumStrDigitCountSpec) GetMinFractionalDigits() int {
+
+	if nStrDigitCountSpec.lock == nil {
+		nStrDigitCountSpec.lock = new(sync.Mutex)
+	}
+
+	nStrDigitCountSpec.lock.Lock()
+
+	defer nStrDigitCountSpec.lock.Unlock()
+
+	return nStrDigitCountSpec.minFractionalDigits
+}
+
+// GetMinIntegerDigits
+//
+// Returns the minimum number of integer digits
+// displayed in a formatted number string.
+func (nStrDigitCountSpec *NumStrDigitCountSpec) GetMinIntegerDigits() int {
+
+	if nStrDigitCountSpec.lock == nil {
+		nStrDigitCountSpec.lock = new(sync.Mutex)
+	}
+
+	nStrDigitCountSpec.lock.Lock()
+
+	defer nStrDigitCountSpec.lock.Unlock()
+
+	return nStrDigitCountSpec.minIntegerDigits
+}
+
+// GetRoundingType
+//
+// Returns the rounding algorithm applied when the
+// number of fractional digits exceeds the maximum.
+//
+// A value of NumRoundType.None() signals that the
+// current instance of NumStrDigitCountSpec is NOP.
+func (nStrDigitCountSpec *NumStrDigitCountSpec) GetRoundingType() NumberRoundingType {
+
+	if nStrDigitCountSpec.lock == nil {
+		nStrDigitCountSpec.lock = new(sync.Mutex)
+	}
+
+	nStrDigitCountSpec.lock.Lock()
+
+	defer nStrDigitCountSpec.lock.Unlock()
+
+	return nStrDigitCountSpec.roundingType
+}
+
+// IsNOP
+//
+// Stands for 'Is No Operation'. If this method returns
+// 'true', the current instance of NumStrDigitCountSpec
+// is not configured and all digits remaining after
+// rounding will be displayed.
+func (nStrDigitCountSpec *NumStrDigitCountSpec) IsNOP() bool {
+
+	if nStrDigitCountSpec.lock == nil {
+		nStrDigitCountSpec.lock = new(sync.Mutex)
+	}
+
+	nStrDigitCountSpec.lock.Lock()
+
+	defer nStrDigitCountSpec.lock.Unlock()
+
+	return nStrDigitCountSpec.roundingType ==
+		NumRoundType.None()
+}
+
+// IsValidInstanceError
+//
+// Performs a diagnostic review of the data values
+// encapsulated in the current NumStrDigitCountSpec
+// instance to determine if they are valid.
+//
+// A NOP instance is considered valid.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrDigitCountSpec *NumStrDigitCountSpec) IsValidInstanceError(
+	errorPrefix interface{}) error {
+
+	if nStrDigitCountSpec.lock == nil {
+		nStrDigitCountSpec.lock = new(sync.Mutex)
+	}
+
+	nStrDigitCountSpec.lock.Lock()
+
+	defer nStrDigitCountSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrDigitCountSpec."+
+			"IsValidInstanceError()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrDigitCountSpecAtom).testValidity(
+		nStrDigitCountSpec,
+		ePrefix.XCpy(
+			"nStrDigitCountSpec"))
+}
+
+// NewDigitCounts
+//
+// Creates and returns a new instance of
+// NumStrDigitCountSpec configured with minimum and
+// maximum digit counts.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	minIntegerDigits			int
+//
+//		The minimum number of integer digits displayed.
+//		Integer values with fewer digits are padded with
+//		leading zeros. Valid values are zero through
+//		one hundred (100).
+//
+//	minFractionalDigits			int
+//
+//		The minimum number of fractional digits
+//		displayed. Values with fewer fractional digits
+//		are padded with trailing zeros. Valid values are
+//		zero through one hundred (100).
+//
+//	maxFractionalDigits			int
+//
+//		The maximum number of fractional digits
+//		displayed. This value must be greater than or
+//		equal to 'minFractionalDigits' and less than or
+//		equal to one hundred (100). A value of minus one
+//		(-1) signals that there is no maximum.
+//
+//	roundingType				NumberRoundingType
+//
+//		The rounding algorithm applied when the number of
+//		fractional digits exceeds 'maxFractionalDigits'.
+//		If this value is set to NumRoundType.NoRounding(),
+//		'maxFractionalDigits' is ignored.
+//
+//		NumRoundType.None() is invalid and will generate
+//		an error.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	newDigitCountSpec			NumStrDigitCountSpec
+//
+//		If this method completes successfully, a new,
+//		fully populated instance of NumStrDigitCountSpec
+//		will be returned.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrDigitCountSpec *NumStrDigitCountSpec) NewDigitCounts(
+	minIntegerDigits int,
+	minFractionalDigits int,
+	maxFractionalDigits int,
+	roundingType NumberRoundingType,
+	errorPrefix interface{}) (
+	newDigitCountSpec NumStrDigitCountSpec,
+	err error) {
+
+	if nStrDigitCountSpec.lock == nil {
+		nStrDigitCountSpec.lock = new(sync.Mutex)
+	}
+
+	nStrDigitCountSpec.lock.Lock()
+
+	defer nStrDigitCountSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrDigitCountSpec."+
+			"NewDigitCounts()",
+		"")
+
+	if err != nil {
+		return newDigitCountSpec, err
+	}
+
+	if roundingType == NumRoundType.None() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'roundingType' is invalid!\n"+
+			"'roundingType' is set to 'None'.\n",
+			ePrefix.String())
+
+		return newDigitCountSpec, err
+	}
+
+	newDigitCountSpec.minIntegerDigits = minIntegerDigits
+
+	newDigitCountSpec.minFractionalDigits = minFractionalDigits
+
+	newDigitCountSpec.maxFractionalDigits = maxFractionalDigits
+
+	newDigitCountSpec.roundingType = roundingType
+
+	err = new(numStrDigitCountSpecAtom).testValidity(
+		&newDigitCountSpec,
+		ePrefix.XCpy(
+			"newDigitCountSpec"))
+
+	if err != nil {
+		return NumStrDigitCountSpec{}, err
+	}
+
+	return newDigitCountSpec, err
+}
+
+// numStrDigitCountSpecAtom - Provides helper methods
+// for type NumStrDigitCountSpec.
+type numStrDigitCountSpecAtom struct {
+	lock *sync.Mutex
+}
+
+// copy
+//
+// Copies all data from input parameter
+// 'sourceDigitCountSpec' to input parameter
+// 'destinationDigitCountSpec'. The source instance is
+// validated before the copy operation is performed.
+func (nStrDigitCountSpecAtom *numStrDigitCountSpecAtom) copy(
+	destinationDigitCountSpec *NumStrDigitCountSpec,
+	sourceDigitCountSpec *NumStrDigitCountSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrDigitCountSpecAtom.lock == nil {
+		nStrDigitCountSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrDigitCountSpecAtom.lock.Lock()
+
+	defer nStrDigitCountSpecAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrDigitCountSpecAtom."+
+			"copy()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if destinationDigitCountSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'destinationDigitCountSpec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if sourceDigitCountSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sourceDigitCountSpec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	err = new(numStrDigitCountSpecAtom).testValidity(
+		sourceDigitCountSpec,
+		ePrefix.XCpy(
+			"sourceDigitCountSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	destinationDigitCountSpec.minIntegerDigits =
+		sourceDigitCountSpec.minIntegerDigits
+
+	destinationDigitCountSpec.minFractionalDigits =
+		sourceDigitCountSpec.minFractionalDigits
+
+	destinationDigitCountSpec.maxFractionalDigits =
+		sourceDigitCountSpec.maxFractionalDigits
+
+	destinationDigitCountSpec.roundingType =
+		sourceDigitCountSpec.roundingType
+
+	return err
+}
+
+// empty
+//
+// Resets all member variables of input parameter
+// 'digitCountSpec' to their zero values.
+func (nStrDigitCountSpecAtom *numStrDigitCountSpecAtom) empty(
+	digitCountSpec *NumStrDigitCountSpec) {
+
+	if nStrDigitCountSpecAtom.lock == nil {
+		nStrDigitCountSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrDigitCountSpecAtom.lock.Lock()
+
+	defer nStrDigitCountSpecAtom.lock.Unlock()
+
+	if digitCountSpec == nil {
+		return
+	}
+
+	digitCountSpec.minIntegerDigits = 0
+
+	digitCountSpec.minFractionalDigits = 0
+
+	digitCountSpec.maxFractionalDigits = 0
+
+	digitCountSpec.roundingType = NumRoundType.None()
+}
+
+// equal
+//
+// Compares the member variables of two instances of
+// NumStrDigitCountSpec and returns 'true' if they are
+// equivalent in all respects.
+func (nStrDigitCountSpecAtom *numStrDigitCountSpecAtom) equal(
+	digitCountSpec1 *NumStrDigitCountSpec,
+	digitCountSpec2 *NumStrDigitCountSpec) bool {
+
+	if nStrDigitCountSpecAtom.lock == nil {
+		nStrDigitCountSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrDigitCountSpecAtom.lock.Lock()
+
+	defer nStrDigitCountSpecAtom.lock.Unlock()
+
+	if digitCountSpec1 == nil ||
+		digitCountSpec2 == nil {
+
+		return false
+	}
+
+	if digitCountSpec1.minIntegerDigits !=
+		digitCountSpec2.minIntegerDigits {
+
+		return false
+	}
+
+	if digitCountSpec1.minFractionalDigits !=
+		digitCountSpec2.minFractionalDigits {
+
+		return false
+	}
+
+	if digitCountSpec1.maxFractionalDigits !=
+		digitCountSpec2.maxFractionalDigits {
+
+		return false
+	}
+
+	if digitCountSpec1.roundingType !=
+		digitCountSpec2.roundingType {
+
+		return false
+	}
+
+	return true
+}
+
+// testValidity
+//
+// Performs a diagnostic review of the member variables
+// contained in an instance of NumStrDigitCountSpec. If
+// any member variable is invalid, an error is returned.
+//
+// A NOP instance is considered valid.
+func (nStrDigitCountSpecAtom *numStrDigitCountSpecAtom) testValidity(
+	digitCountSpec *NumStrDigitCountSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrDigitCountSpecAtom.lock == nil {
+		nStrDigitCountSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrDigitCountSpecAtom.lock.Lock()
+
+	defer nStrDigitCountSpecAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrDigitCountSpecAtom."+
+			"testValidity()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if digitCountSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'digitCountSpec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if digitCountSpec.roundingType == NumRoundType.None() {
+		return err
+	}
+
+	if !digitCountSpec.roundingType.XIsValid() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The digit count rounding type is invalid!\n"+
+			"roundingType integer value = '%v'\n",
+			ePrefix.String(),
+			digitCountSpec.roundingType.XValueInt())
+
+		return err
+	}
+
+	if digitCountSpec.minIntegerDigits < 0 ||
+		digitCountSpec.minIntegerDigits > 100 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The minimum number of integer digits is invalid!\n"+
+			"'minIntegerDigits' must be in the range 0 to 100.\n"+
+			"minIntegerDigits = '%v'\n",
+			ePrefix.String(),
+			digitCountSpec.minIntegerDigits)
+
+		return err
+	}
+
+	if digitCountSpec.minFractionalDigits < 0 ||
+		digitCountSpec.minFractionalDigits > 100 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The minimum number of fractional digits is invalid!\n"+
+			"'minFractionalDigits' must be in the range 0 to 100.\n"+
+			"minFractionalDigits = '%v'\n",
+			ePrefix.String(),
+			digitCountSpec.minFractionalDigits)
+
+		return err
+	}
+
+	if digitCountSpec.maxFractionalDigits == -1 {
+		return err
+	}
+
+	if digitCountSpec.maxFractionalDigits < digitCountSpec.minFractionalDigits ||
+		digitCountSpec.maxFractionalDigits > 100 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The maximum number of fractional digits is invalid!\n"+
+			"'maxFractionalDigits' must be -1 or in the range\n"+
+			"'minFractionalDigits' to 100.\n"+
+			"minFractionalDigits = '%v'\n"+
+			"maxFractionalDigits = '%v'\n",
+			ePrefix.String(),
+			digitCountSpec.minFractionalDigits,
+			digitCountSpec.maxFractionalDigits)
+	}
+
+	return err
+}
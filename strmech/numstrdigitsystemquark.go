@@ -0,0 +1,281 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
+
+// numStrDigitSystemQuark - Provides low level helper methods
+// used to convert numeric digits between the Latin (ASCII)
+// digit system and the native digit systems defined by
+// enumeration NumberDigitSystem.
+type numStrDigitSystemQuark struct {
+	lock *sync.Mutex
+}
+
+// convertAsciiDigits - Receives a string and converts all
+// Latin (ASCII) digits '0' through '9' to the equivalent
+// digits in the digit system specified by input parameter
+// 'digitSystem'.
+//
+// All other characters in 'numStr' are left unchanged.
+//
+// If 'digitSystem' is set to 'None', 'Latin' or an invalid
+// value, 'numStr' is returned unchanged.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStr						string
+//
+//		A string which may contain Latin (ASCII) numeric
+//		digits. Typically, this is a formatted number string.
+//
+//	digitSystem					NumberDigitSystem
+//
+//		Specifies the target digit system used to convert
+//		Latin (ASCII) digits in 'numStr'.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	string
+//
+//		A copy of 'numStr' with all Latin (ASCII) digits
+//		converted to the digit system specified by
+//		'digitSystem'.
+func (digitSysQuark *numStrDigitSystemQuark) convertAsciiDigits(
+	numStr string,
+	digitSystem NumberDigitSystem) string {
+
+	if digitSysQuark.lock == nil {
+		digitSysQuark.lock = new(sync.Mutex)
+	}
+
+	digitSysQuark.lock.Lock()
+
+	defer digitSysQuark.lock.Unlock()
+
+	zeroDigit := digitSystem.XZeroDigit()
+
+	if zeroDigit == '0' {
+		return numStr
+	}
+
+	numRunes := []rune(numStr)
+
+	for i := 0; i < len(numRunes); i++ {
+
+		if numRunes[i] >= '0' &&
+			numRunes[i] <= '9' {
+
+			numRunes[i] = zeroDigit + (numRunes[i] - '0')
+		}
+	}
+
+	return string(numRunes)
+}
+
+// normalizeNativeDigits - Receives an array of runes and
+// returns a new array in which every native digit belonging
+// to a digit system defined by enumeration NumberDigitSystem
+// is converted to the equivalent Latin (ASCII) digit '0'
+// through '9'.
+//
+// All other characters are copied unchanged. The original
+// rune array is NOT modified.
+//
+// All numeric digits in 'charsArray' must belong to the
+// same digit system. If 'charsArray' contains digits from
+// more than one digit system, such as "1٢3", an error is
+// returned.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	charsArray					[]rune
+//
+//		An array of runes which may contain native numeric
+//		digits such as Arabic-Indic, Devanagari, Thai or
+//		full-width digits.
+//
+//	charsArrayLabel				string
+//
+//		The name or label associated with input parameter
+//		'charsArray' which will be used in error messages
+//		returned by this method.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	normalizedChars				[]rune
+//
+//		A new array of runes in which all native numeric
+//		digits have been converted to Latin (ASCII) digits.
+//
+//	numOfConversions			int
+//
+//		The number of native digits converted to Latin
+//		(ASCII) digits.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (digitSysQuark *numStrDigitSystemQuark) normalizeNativeDigits(
+	charsArray []rune,
+	charsArrayLabel string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	normalizedChars []rune,
+	numOfConversions int,
+	err error) {
+
+	if digitSysQuark.lock == nil {
+		digitSysQuark.lock = new(sync.Mutex)
+	}
+
+	digitSysQuark.lock.Lock()
+
+	defer digitSysQuark.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrDigitSystemQuark."+
+			"normalizeNativeDigits()",
+		"")
+
+	if err != nil {
+		return normalizedChars, numOfConversions, err
+	}
+
+	if len(charsArrayLabel) == 0 {
+		charsArrayLabel = "charsArray"
+	}
+
+	lenCharsArray := len(charsArray)
+
+	if lenCharsArray == 0 {
+		return normalizedChars, numOfConversions, err
+	}
+
+	normalizedChars = make([]rune, lenCharsArray)
+
+	var ok bool
+	var asciiDigit, zeroDigit, firstZeroDigit rune
+	var foundDigit bool
+
+	for i := 0; i < lenCharsArray; i++ {
+
+		if charsArray[i] >= '0' &&
+			charsArray[i] <= '9' {
+
+			normalizedChars[i] = charsArray[i]
+
+			zeroDigit = '0'
+
+		} else {
+
+			asciiDigit, ok =
+				digitSysQuark.nativeDigitToAscii(charsArray[i])
+
+			if !ok {
+
+				normalizedChars[i] = charsArray[i]
+
+				continue
+			}
+
+			normalizedChars[i] = asciiDigit
+
+			numOfConversions++
+
+			zeroDigit = charsArray[i] - (asciiDigit - '0')
+		}
+
+		if !foundDigit {
+
+			firstZeroDigit = zeroDigit
+
+			foundDigit = true
+
+		} else if zeroDigit != firstZeroDigit {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter '%v' is invalid!\n"+
+				"'%v' contains numeric digits from more than\n"+
+				"one digit system. All numeric digits must be\n"+
+				"taken from the same digit system.\n"+
+				"%v = '%v'\n",
+				ePrefix.String(),
+				charsArrayLabel,
+				charsArrayLabel,
+				charsArrayLabel,
+				string(charsArray))
+
+			return nil, 0, err
+		}
+	}
+
+	return normalizedChars, numOfConversions, err
+}
+
+// nativeDigitToAscii - Receives a single rune and determines
+// whether it is a native digit in one of the non-Latin digit
+// systems defined by enumeration NumberDigitSystem.
+//
+// If 'nativeChar' is a native digit, this method returns the
+// equivalent Latin (ASCII) digit and a boolean value of
+// 'true'. Otherwise, 'nativeChar' is returned unchanged with
+// a boolean value of 'false'.
+//
+// This method does NOT acquire the lock and is intended for
+// use by other methods of this type.
+func (digitSysQuark *numStrDigitSystemQuark) nativeDigitToAscii(
+	nativeChar rune) (
+	asciiDigit rune,
+	isNativeDigit bool) {
+
+	for digitSystem := NumDigitSys.ArabicIndic(); digitSystem <= NumDigitSys.FullWidth(); digitSystem++ {
+
+		zeroDigit := digitSystem.XZeroDigit()
+
+		if nativeChar >= zeroDigit &&
+			nativeChar <= zeroDigit+9 {
+
+			return '0' + (nativeChar - zeroDigit), true
+		}
+	}
+
+	return nativeChar, false
+}
@@ -1,6 +1,9 @@
 package strmech
 
-import "sync"
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
 
 // NumStrFmtCharReplacementSpec
 //
@@ -56,3 +59,196 @@ type NumStrFmtCharReplacementSpec struct {
 
 	lock *sync.Mutex
 }
+
+// FmtNumericDigits
+//
+// Formats a string of numeric digits using the Number
+// Format string and Number Replacement Character
+// configured in the current instance of
+// NumStrFmtCharReplacementSpec.
+//
+// Each Number Replacement Character in the Number Format
+// string is replaced, in order, by the next digit in
+// input parameter 'numericDigits'.
+//
+//	Example:
+//		NumberFormat		= "(NNN) NNN-NNNN"
+//		NumReplacementChar	= 'N'
+//		numericDigits		= "2125550100"
+//		Formatted String	= "(212) 555-0100"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numericDigits				string
+//
+//		A string of numeric digit characters ('0' - '9').
+//		The number of digits must be equal to the number
+//		of Number Replacement Characters in the Number
+//		Format string.
+//
+//		If this string contains non-numeric characters,
+//		or if the number of digits does not match the
+//		number of Number Replacement Characters, an error
+//		will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	string
+//
+//		The Number Format string with all Number
+//		Replacement Characters replaced by numeric
+//		digits.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (charReplacementSpec *NumStrFmtCharReplacementSpec) FmtNumericDigits(
+	numericDigits string,
+	errorPrefix interface{}) (
+	string,
+	error) {
+
+	if charReplacementSpec.lock == nil {
+		charReplacementSpec.lock = new(sync.Mutex)
+	}
+
+	charReplacementSpec.lock.Lock()
+
+	defer charReplacementSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrFmtCharReplacementSpec."+
+			"FmtNumericDigits()",
+		"")
+
+	if err != nil {
+		return "", err
+	}
+
+	return new(numStrFmtCharReplacementSpecElectron).
+		replaceNumericDigits(
+			charReplacementSpec,
+			[]rune(numericDigits),
+			ePrefix.XCpy(
+				"numericDigits"))
+}
+
+// GetNumOfReplacementChars
+//
+// Returns the number of Number Replacement Characters
+// contained in the Number Format string of the current
+// instance of NumStrFmtCharReplacementSpec.
+//
+// This value is equal to the number of numeric digits
+// required by method FmtNumericDigits().
+//
+//	Example:
+//		NumberFormat		= "(NNN) NNN-NNNN"
+//		NumReplacementChar	= 'N'
+//		Return Value		= 10
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	NONE
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	int
+//
+//		The number of Number Replacement Characters in
+//		the Number Format string.
+func (charReplacementSpec *NumStrFmtCharReplacementSpec) GetNumOfReplacementChars() int {
+
+	if charReplacementSpec.lock == nil {
+		charReplacementSpec.lock = new(sync.Mutex)
+	}
+
+	charReplacementSpec.lock.Lock()
+
+	defer charReplacementSpec.lock.Unlock()
+
+	return new(numStrFmtCharReplacementSpecElectron).
+		getNumOfReplacementChars(
+			charReplacementSpec)
+}
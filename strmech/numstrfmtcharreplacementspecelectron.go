@@ -0,0 +1,268 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"strings"
+	"sync"
+)
+
+// numStrFmtCharReplacementSpecElectron
+//
+// Provides helper methods for type
+// NumStrFmtCharReplacementSpec.
+type numStrFmtCharReplacementSpecElectron struct {
+	lock *sync.Mutex
+}
+
+// getNumOfReplacementChars
+//
+// Returns the number of Number Replacement Characters
+// contained in the Number Format string of a
+// NumStrFmtCharReplacementSpec instance.
+//
+// If input parameter 'charReplacementSpec' is a nil
+// pointer, or if the Number Replacement Character is
+// zero, this method returns zero.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	charReplacementSpec			*NumStrFmtCharReplacementSpec
+//
+//		A pointer to an instance of
+//		NumStrFmtCharReplacementSpec. The Number Format
+//		string in this instance will be searched for
+//		Number Replacement Characters.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	int
+//
+//		The number of Number Replacement Characters
+//		contained in the Number Format string.
+func (nStrFmtCharReplaceElectron *numStrFmtCharReplacementSpecElectron) getNumOfReplacementChars(
+	charReplacementSpec *NumStrFmtCharReplacementSpec) int {
+
+	if nStrFmtCharReplaceElectron.lock == nil {
+		nStrFmtCharReplaceElectron.lock = new(sync.Mutex)
+	}
+
+	nStrFmtCharReplaceElectron.lock.Lock()
+
+	defer nStrFmtCharReplaceElectron.lock.Unlock()
+
+	if charReplacementSpec == nil ||
+		charReplacementSpec.NumReplacementChar == 0 {
+
+		return 0
+	}
+
+	return strings.Count(
+		charReplacementSpec.NumberFormat,
+		string(charReplacementSpec.NumReplacementChar))
+}
+
+// replaceNumericDigits
+//
+// Replaces each Number Replacement Character in the
+// Number Format string of a NumStrFmtCharReplacementSpec
+// instance with the next numeric digit from input
+// parameter 'numericDigits'.
+//
+//	Example:
+//		NumberFormat		= "(NNN) NNN-NNNN"
+//		NumReplacementChar	= 'N'
+//		numericDigits		= "2125550100"
+//		Formatted String	= "(212) 555-0100"
+//
+// The number of digits in 'numericDigits' must be equal
+// to the number of Number Replacement Characters in the
+// Number Format string. Otherwise, an error is returned.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	charReplacementSpec			*NumStrFmtCharReplacementSpec
+//
+//		A pointer to an instance of
+//		NumStrFmtCharReplacementSpec. The Number Format
+//		string in this instance will be used to format
+//		the numeric digits.
+//
+//		If the Number Format string is empty or the
+//		Number Replacement Character is zero, an error
+//		will be returned.
+//
+//	numericDigits				[]rune
+//
+//		An array of numeric digit characters ('0' - '9')
+//		which will replace the Number Replacement
+//		Characters in the Number Format string.
+//
+//		If this array contains non-numeric characters, an
+//		error will be returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	formattedStr				string
+//
+//		The Number Format string with all Number
+//		Replacement Characters replaced by numeric
+//		digits.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrFmtCharReplaceElectron *numStrFmtCharReplacementSpecElectron) replaceNumericDigits(
+	charReplacementSpec *NumStrFmtCharReplacementSpec,
+	numericDigits []rune,
+	errPrefDto *ePref.ErrPrefixDto) (
+	formattedStr string,
+	err error) {
+
+	if nStrFmtCharReplaceElectron.lock == nil {
+		nStrFmtCharReplaceElectron.lock = new(sync.Mutex)
+	}
+
+	nStrFmtCharReplaceElectron.lock.Lock()
+
+	defer nStrFmtCharReplaceElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrFmtCharReplacementSpecElectron."+
+			"replaceNumericDigits()",
+		"")
+
+	if err != nil {
+		return formattedStr, err
+	}
+
+	if charReplacementSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'charReplacementSpec' is a nil pointer!\n",
+			ePrefix.String())
+
+		return formattedStr, err
+	}
+
+	if len(charReplacementSpec.NumberFormat) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: 'charReplacementSpec.NumberFormat' is invalid!\n"+
+			"'NumberFormat' is an empty string.\n",
+			ePrefix.String())
+
+		return formattedStr, err
+	}
+
+	if charReplacementSpec.NumReplacementChar == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: 'charReplacementSpec.NumReplacementChar' is invalid!\n"+
+			"'NumReplacementChar' has a value of zero.\n",
+			ePrefix.String())
+
+		return formattedStr, err
+	}
+
+	for i := 0; i < len(numericDigits); i++ {
+
+		if numericDigits[i] < '0' ||
+			numericDigits[i] > '9' {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'numericDigits' is invalid!\n"+
+				"'numericDigits' contains a non-numeric character.\n"+
+				"numericDigits[%v] = '%v'\n",
+				ePrefix.String(),
+				i,
+				string(numericDigits[i]))
+
+			return formattedStr, err
+		}
+	}
+
+	fmtRunes := []rune(charReplacementSpec.NumberFormat)
+
+	digitIdx := 0
+
+	for i := 0; i < len(fmtRunes); i++ {
+
+		if fmtRunes[i] != charReplacementSpec.NumReplacementChar {
+			continue
+		}
+
+		if digitIdx >= len(numericDigits) {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'numericDigits' is invalid!\n"+
+				"The number of digits is less than the number of\n"+
+				"replacement characters in the Number Format.\n"+
+				"NumberFormat    = '%v'\n"+
+				"numericDigits   = '%v'\n",
+				ePrefix.String(),
+				charReplacementSpec.NumberFormat,
+				string(numericDigits))
+
+			return formattedStr, err
+		}
+
+		fmtRunes[i] = numericDigits[digitIdx]
+
+		digitIdx++
+	}
+
+	if digitIdx != len(numericDigits) {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numericDigits' is invalid!\n"+
+			"The number of digits is greater than the number of\n"+
+			"replacement characters in the Number Format.\n"+
+			"NumberFormat    = '%v'\n"+
+			"numericDigits   = '%v'\n",
+			ePrefix.String(),
+			charReplacementSpec.NumberFormat,
+			string(numericDigits))
+
+		return formattedStr, err
+	}
+
+	formattedStr = string(fmtRunes)
+
+	return formattedStr, err
+}
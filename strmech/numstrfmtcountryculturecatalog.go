@@ -0,0 +1,1094 @@
+package strmech
+
+// numStrCountryCultureCatalogEntry
+//
+// Contains the country identification data and number
+// formatting parameters for a single country culture,
+// or locale, included in the Country Culture Catalog.
+//
+// Each entry is used to generate the Signed Number and
+// Currency Number String Format Specifications
+// encapsulated by an instance of
+// NumStrFmtCountryCultureSpec.
+//
+// The catalog entries for the United States, the United
+// Kingdom, Germany and France follow the CLDR and are
+// independent of the dedicated country setup methods
+// such as NumStrFmtCountryCultureSpec.NewUS() and
+// NumStrFmtCountryCultureSpec.NewGermany().
+type numStrCountryCultureCatalogEntry struct {
+	countryCultureName string
+	//	The ISO 3166 name of the country or culture.
+
+	countryCultureOfficialStateName string
+	//	The ISO 3166 official state name of the country
+	//	or culture.
+
+	countryCodeTwoChar string
+	//	The ISO 3166-1 alpha-2 Two Character country code.
+
+	countryCodeThreeChar string
+	//	The ISO 3166-1 alpha-3 Three Character country code.
+
+	countryCodeNumber string
+	//	The ISO 3166-1 numeric country code.
+
+	localeTag string
+	//	The BCP 47 language tag ("language-REGION")
+	//	identifying this country culture.
+
+	currencyCode string
+	//	The ISO 4217 alphabetic currency code. The
+	//	currency code number, currency name and number
+	//	of minor unit digits are read from the ISO 4217
+	//	registry, 'iso4217CurrencyRecords'.
+
+	currencySymbols string
+	//	The currency symbol or symbols used by this
+	//	locale. This locale presentation may differ
+	//	from the currency symbol recorded in the ISO
+	//	4217 registry ("NT$" versus "$" in "zh-TW").
+
+	minorCurrencyName string
+	//	The name of the minor currency unit.
+
+	minorCurrencySymbols string
+	//	The minor currency symbol or symbols.
+
+	decimalSeparator string
+	//	The decimal separator character or characters.
+
+	integerSeparator string
+	//	The integer separator character or characters
+	//	used to group integer digits.
+
+	intGroupingType IntegerGroupingType
+	//	The type of integer grouping applied to integer
+	//	digits. Examples: Thousands or India Numbering.
+
+	leadingNegNumSign string
+	//	The leading negative number sign.
+
+	trailingNegNumSign string
+	//	The trailing negative number sign.
+
+	leadingCurrencySymbols string
+	//	The leading currency symbols including any
+	//	spacing applied between the currency symbols
+	//	and the numeric digits.
+
+	trailingCurrencySymbols string
+	//	The trailing currency symbols including any
+	//	spacing applied between the numeric digits
+	//	and the currency symbols.
+
+	currencyInsideNumSymbol bool
+	//	When set to 'true', the currency symbol is
+	//	positioned inside the negative number sign
+	//	("-$123.45"). When set to 'false', the currency
+	//	symbol is positioned outside the negative number
+	//	sign ("€ -123,45").
+}
+
+// numStrCountryCultureCatalog
+//
+// The Country Culture Catalog. This catalog contains
+// number formatting parameters for several dozen
+// country cultures. Entries are listed in alphabetical
+// order by country name.
+//
+// Where a country supports more than one locale, the
+// first entry listed for that country is treated as the
+// default entry for the country code.
+//
+// Number formatting parameters are based on the Unicode
+// Common Locale Data Repository (CLDR).
+//
+//	https://cldr.unicode.org/
+//
+// Only the locale presentation of the currency (symbols,
+// separators and sign placement) is recorded here. The
+// ISO 4217 currency data is read from the registry,
+// 'iso4217CurrencyRecords', using 'currencyCode'.
+//
+// Note that all entries use the Latin (ASCII) digits
+// '0' through '9'. Accordingly, Arabic locales such as
+// "ar-EG" and "ar-SA" are configured with the CLDR
+// symbols for the Latin numbering system: the decimal
+// separator ('.'), the thousands separator (',') and a
+// minus sign preceded by a left-to-right mark
+// ('\u200E-').
+var numStrCountryCultureCatalog = []numStrCountryCultureCatalogEntry{
+	{
+		countryCultureName:              "Argentina",
+		countryCultureOfficialStateName: "Argentine Republic",
+		countryCodeTwoChar:              "AR",
+		countryCodeThreeChar:            "ARG",
+		countryCodeNumber:               "032",
+		localeTag:                       "es-AR",
+		currencyCode:                    "ARS",
+		currencySymbols:                 "$",
+		minorCurrencyName:               "Centavo",
+		decimalSeparator:                ",",
+		integerSeparator:                ".",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "$\u00A0",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Australia",
+		countryCultureOfficialStateName: "Commonwealth of Australia",
+		countryCodeTwoChar:              "AU",
+		countryCodeThreeChar:            "AUS",
+		countryCodeNumber:               "036",
+		localeTag:                       "en-AU",
+		currencyCode:                    "AUD",
+		currencySymbols:                 "$",
+		minorCurrencyName:               "Cent",
+		minorCurrencySymbols:            "c",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "$",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Austria",
+		countryCultureOfficialStateName: "Republic of Austria",
+		countryCodeTwoChar:              "AT",
+		countryCodeThreeChar:            "AUT",
+		countryCodeNumber:               "040",
+		localeTag:                       "de-AT",
+		currencyCode:                    "EUR",
+		currencySymbols:                 "€",
+		minorCurrencyName:               "Cent",
+		minorCurrencySymbols:            "c",
+		decimalSeparator:                ",",
+		integerSeparator:                "\u00A0",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "€\u00A0",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Belgium",
+		countryCultureOfficialStateName: "Kingdom of Belgium",
+		countryCodeTwoChar:              "BE",
+		countryCodeThreeChar:            "BEL",
+		countryCodeNumber:               "056",
+		localeTag:                       "nl-BE",
+		currencyCode:                    "EUR",
+		currencySymbols:                 "€",
+		minorCurrencyName:               "Cent",
+		minorCurrencySymbols:            "c",
+		decimalSeparator:                ",",
+		integerSeparator:                ".",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "€\u00A0",
+		currencyInsideNumSymbol:         false,
+	},
+	{
+		countryCultureName:              "Belgium",
+		countryCultureOfficialStateName: "Kingdom of Belgium",
+		countryCodeTwoChar:              "BE",
+		countryCodeThreeChar:            "BEL",
+		countryCodeNumber:               "056",
+		localeTag:                       "fr-BE",
+		currencyCode:                    "EUR",
+		currencySymbols:                 "€",
+		minorCurrencyName:               "Cent",
+		minorCurrencySymbols:            "c",
+		decimalSeparator:                ",",
+		integerSeparator:                "\u202F",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		trailingCurrencySymbols:         "\u00A0€",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Brazil",
+		countryCultureOfficialStateName: "Federative Republic of Brazil",
+		countryCodeTwoChar:              "BR",
+		countryCodeThreeChar:            "BRA",
+		countryCodeNumber:               "076",
+		localeTag:                       "pt-BR",
+		currencyCode:                    "BRL",
+		currencySymbols:                 "R$",
+		minorCurrencyName:               "Centavo",
+		decimalSeparator:                ",",
+		integerSeparator:                ".",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "R$\u00A0",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Canada",
+		countryCultureOfficialStateName: "Canada",
+		countryCodeTwoChar:              "CA",
+		countryCodeThreeChar:            "CAN",
+		countryCodeNumber:               "124",
+		localeTag:                       "en-CA",
+		currencyCode:                    "CAD",
+		currencySymbols:                 "$",
+		minorCurrencyName:               "Cent",
+		minorCurrencySymbols:            "¢",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "$",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Canada",
+		countryCultureOfficialStateName: "Canada",
+		countryCodeTwoChar:              "CA",
+		countryCodeThreeChar:            "CAN",
+		countryCodeNumber:               "124",
+		localeTag:                       "fr-CA",
+		currencyCode:                    "CAD",
+		currencySymbols:                 "$",
+		minorCurrencyName:               "Cent",
+		minorCurrencySymbols:            "¢",
+		decimalSeparator:                ",",
+		integerSeparator:                "\u00A0",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		trailingCurrencySymbols:         "\u00A0$",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Chile",
+		countryCultureOfficialStateName: "Republic of Chile",
+		countryCodeTwoChar:              "CL",
+		countryCodeThreeChar:            "CHL",
+		countryCodeNumber:               "152",
+		localeTag:                       "es-CL",
+		currencyCode:                    "CLP",
+		currencySymbols:                 "$",
+		decimalSeparator:                ",",
+		integerSeparator:                ".",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "$",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "China",
+		countryCultureOfficialStateName: "People's Republic of China",
+		countryCodeTwoChar:              "CN",
+		countryCodeThreeChar:            "CHN",
+		countryCodeNumber:               "156",
+		localeTag:                       "zh-CN",
+		currencyCode:                    "CNY",
+		currencySymbols:                 "¥",
+		minorCurrencyName:               "Fen",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "¥",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Colombia",
+		countryCultureOfficialStateName: "Republic of Colombia",
+		countryCodeTwoChar:              "CO",
+		countryCodeThreeChar:            "COL",
+		countryCodeNumber:               "170",
+		localeTag:                       "es-CO",
+		currencyCode:                    "COP",
+		currencySymbols:                 "$",
+		minorCurrencyName:               "Centavo",
+		decimalSeparator:                ",",
+		integerSeparator:                ".",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "$\u00A0",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Czechia",
+		countryCultureOfficialStateName: "Czech Republic",
+		countryCodeTwoChar:              "CZ",
+		countryCodeThreeChar:            "CZE",
+		countryCodeNumber:               "203",
+		localeTag:                       "cs-CZ",
+		currencyCode:                    "CZK",
+		currencySymbols:                 "Kč",
+		minorCurrencyName:               "Haler",
+		decimalSeparator:                ",",
+		integerSeparator:                "\u00A0",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		trailingCurrencySymbols:         "\u00A0Kč",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Denmark",
+		countryCultureOfficialStateName: "Kingdom of Denmark",
+		countryCodeTwoChar:              "DK",
+		countryCodeThreeChar:            "DNK",
+		countryCodeNumber:               "208",
+		localeTag:                       "da-DK",
+		currencyCode:                    "DKK",
+		currencySymbols:                 "kr.",
+		minorCurrencyName:               "Ore",
+		decimalSeparator:                ",",
+		integerSeparator:                ".",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		trailingCurrencySymbols:         "\u00A0kr.",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Egypt",
+		countryCultureOfficialStateName: "Arab Republic of Egypt",
+		countryCodeTwoChar:              "EG",
+		countryCodeThreeChar:            "EGY",
+		countryCodeNumber:               "818",
+		localeTag:                       "ar-EG",
+		currencyCode:                    "EGP",
+		currencySymbols:                 "ج.م.",
+		minorCurrencyName:               "Piastre",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "\u200E-",
+		trailingCurrencySymbols:         "\u00A0ج.م.\u200F",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Finland",
+		countryCultureOfficialStateName: "Republic of Finland",
+		countryCodeTwoChar:              "FI",
+		countryCodeThreeChar:            "FIN",
+		countryCodeNumber:               "246",
+		localeTag:                       "fi-FI",
+		currencyCode:                    "EUR",
+		currencySymbols:                 "€",
+		minorCurrencyName:               "Cent",
+		minorCurrencySymbols:            "c",
+		decimalSeparator:                ",",
+		integerSeparator:                "\u00A0",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "\u2212",
+		trailingCurrencySymbols:         "\u00A0€",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "France",
+		countryCultureOfficialStateName: "French Republic",
+		countryCodeTwoChar:              "FR",
+		countryCodeThreeChar:            "FRA",
+		countryCodeNumber:               "250",
+		localeTag:                       "fr-FR",
+		currencyCode:                    "EUR",
+		currencySymbols:                 "€",
+		minorCurrencyName:               "Cent",
+		minorCurrencySymbols:            "c",
+		decimalSeparator:                ",",
+		integerSeparator:                "\u202F",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		trailingCurrencySymbols:         "\u00A0€",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Germany",
+		countryCultureOfficialStateName: "Federal Republic of Germany",
+		countryCodeTwoChar:              "DE",
+		countryCodeThreeChar:            "DEU",
+		countryCodeNumber:               "276",
+		localeTag:                       "de-DE",
+		currencyCode:                    "EUR",
+		currencySymbols:                 "€",
+		minorCurrencyName:               "Cent",
+		minorCurrencySymbols:            "c",
+		decimalSeparator:                ",",
+		integerSeparator:                ".",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		trailingCurrencySymbols:         "\u00A0€",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Greece",
+		countryCultureOfficialStateName: "Hellenic Republic",
+		countryCodeTwoChar:              "GR",
+		countryCodeThreeChar:            "GRC",
+		countryCodeNumber:               "300",
+		localeTag:                       "el-GR",
+		currencyCode:                    "EUR",
+		currencySymbols:                 "€",
+		minorCurrencyName:               "Cent",
+		minorCurrencySymbols:            "c",
+		decimalSeparator:                ",",
+		integerSeparator:                ".",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		trailingCurrencySymbols:         "\u00A0€",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Hong Kong",
+		countryCultureOfficialStateName: "Hong Kong Special Administrative Region of China",
+		countryCodeTwoChar:              "HK",
+		countryCodeThreeChar:            "HKG",
+		countryCodeNumber:               "344",
+		localeTag:                       "zh-HK",
+		currencyCode:                    "HKD",
+		currencySymbols:                 "HK$",
+		minorCurrencyName:               "Cent",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "HK$",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Hungary",
+		countryCultureOfficialStateName: "Hungary",
+		countryCodeTwoChar:              "HU",
+		countryCodeThreeChar:            "HUN",
+		countryCodeNumber:               "348",
+		localeTag:                       "hu-HU",
+		currencyCode:                    "HUF",
+		currencySymbols:                 "Ft",
+		minorCurrencyName:               "Filler",
+		decimalSeparator:                ",",
+		integerSeparator:                "\u00A0",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		trailingCurrencySymbols:         "\u00A0Ft",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "India",
+		countryCultureOfficialStateName: "Republic of India",
+		countryCodeTwoChar:              "IN",
+		countryCodeThreeChar:            "IND",
+		countryCodeNumber:               "356",
+		localeTag:                       "en-IN",
+		currencyCode:                    "INR",
+		currencySymbols:                 "₹",
+		minorCurrencyName:               "Paisa",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.IndiaNumbering(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "₹",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "India",
+		countryCultureOfficialStateName: "Republic of India",
+		countryCodeTwoChar:              "IN",
+		countryCodeThreeChar:            "IND",
+		countryCodeNumber:               "356",
+		localeTag:                       "hi-IN",
+		currencyCode:                    "INR",
+		currencySymbols:                 "₹",
+		minorCurrencyName:               "Paisa",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.IndiaNumbering(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "₹",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Indonesia",
+		countryCultureOfficialStateName: "Republic of Indonesia",
+		countryCodeTwoChar:              "ID",
+		countryCodeThreeChar:            "IDN",
+		countryCodeNumber:               "360",
+		localeTag:                       "id-ID",
+		currencyCode:                    "IDR",
+		currencySymbols:                 "Rp",
+		minorCurrencyName:               "Sen",
+		decimalSeparator:                ",",
+		integerSeparator:                ".",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "Rp\u00A0",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Ireland",
+		countryCultureOfficialStateName: "Ireland",
+		countryCodeTwoChar:              "IE",
+		countryCodeThreeChar:            "IRL",
+		countryCodeNumber:               "372",
+		localeTag:                       "en-IE",
+		currencyCode:                    "EUR",
+		currencySymbols:                 "€",
+		minorCurrencyName:               "Cent",
+		minorCurrencySymbols:            "c",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "€",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Israel",
+		countryCultureOfficialStateName: "State of Israel",
+		countryCodeTwoChar:              "IL",
+		countryCodeThreeChar:            "ISR",
+		countryCodeNumber:               "376",
+		localeTag:                       "he-IL",
+		currencyCode:                    "ILS",
+		currencySymbols:                 "₪",
+		minorCurrencyName:               "Agora",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "\u200E-",
+		trailingCurrencySymbols:         "\u00A0₪",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Italy",
+		countryCultureOfficialStateName: "Italian Republic",
+		countryCodeTwoChar:              "IT",
+		countryCodeThreeChar:            "ITA",
+		countryCodeNumber:               "380",
+		localeTag:                       "it-IT",
+		currencyCode:                    "EUR",
+		currencySymbols:                 "€",
+		minorCurrencyName:               "Cent",
+		minorCurrencySymbols:            "c",
+		decimalSeparator:                ",",
+		integerSeparator:                ".",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		trailingCurrencySymbols:         "\u00A0€",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Japan",
+		countryCultureOfficialStateName: "Japan",
+		countryCodeTwoChar:              "JP",
+		countryCodeThreeChar:            "JPN",
+		countryCodeNumber:               "392",
+		localeTag:                       "ja-JP",
+		currencyCode:                    "JPY",
+		currencySymbols:                 "￥",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "￥",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Mexico",
+		countryCultureOfficialStateName: "United Mexican States",
+		countryCodeTwoChar:              "MX",
+		countryCodeThreeChar:            "MEX",
+		countryCodeNumber:               "484",
+		localeTag:                       "es-MX",
+		currencyCode:                    "MXN",
+		currencySymbols:                 "$",
+		minorCurrencyName:               "Centavo",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "$",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Morocco",
+		countryCultureOfficialStateName: "Kingdom of Morocco",
+		countryCodeTwoChar:              "MA",
+		countryCodeThreeChar:            "MAR",
+		countryCodeNumber:               "504",
+		localeTag:                       "ar-MA",
+		currencyCode:                    "MAD",
+		currencySymbols:                 "د.م.",
+		minorCurrencyName:               "Centime",
+		decimalSeparator:                ",",
+		integerSeparator:                ".",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "\u200E-",
+		trailingCurrencySymbols:         "\u00A0د.م.\u200F",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Netherlands",
+		countryCultureOfficialStateName: "Kingdom of the Netherlands",
+		countryCodeTwoChar:              "NL",
+		countryCodeThreeChar:            "NLD",
+		countryCodeNumber:               "528",
+		localeTag:                       "nl-NL",
+		currencyCode:                    "EUR",
+		currencySymbols:                 "€",
+		minorCurrencyName:               "Cent",
+		minorCurrencySymbols:            "c",
+		decimalSeparator:                ",",
+		integerSeparator:                ".",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "€\u00A0",
+		currencyInsideNumSymbol:         false,
+	},
+	{
+		countryCultureName:              "New Zealand",
+		countryCultureOfficialStateName: "New Zealand",
+		countryCodeTwoChar:              "NZ",
+		countryCodeThreeChar:            "NZL",
+		countryCodeNumber:               "554",
+		localeTag:                       "en-NZ",
+		currencyCode:                    "NZD",
+		currencySymbols:                 "$",
+		minorCurrencyName:               "Cent",
+		minorCurrencySymbols:            "c",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "$",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Nigeria",
+		countryCultureOfficialStateName: "Federal Republic of Nigeria",
+		countryCodeTwoChar:              "NG",
+		countryCodeThreeChar:            "NGA",
+		countryCodeNumber:               "566",
+		localeTag:                       "en-NG",
+		currencyCode:                    "NGN",
+		currencySymbols:                 "₦",
+		minorCurrencyName:               "Kobo",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "₦",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Norway",
+		countryCultureOfficialStateName: "Kingdom of Norway",
+		countryCodeTwoChar:              "NO",
+		countryCodeThreeChar:            "NOR",
+		countryCodeNumber:               "578",
+		localeTag:                       "nb-NO",
+		currencyCode:                    "NOK",
+		currencySymbols:                 "kr",
+		minorCurrencyName:               "Ore",
+		decimalSeparator:                ",",
+		integerSeparator:                "\u00A0",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "\u2212",
+		trailingCurrencySymbols:         "\u00A0kr",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Philippines",
+		countryCultureOfficialStateName: "Republic of the Philippines",
+		countryCodeTwoChar:              "PH",
+		countryCodeThreeChar:            "PHL",
+		countryCodeNumber:               "608",
+		localeTag:                       "en-PH",
+		currencyCode:                    "PHP",
+		currencySymbols:                 "₱",
+		minorCurrencyName:               "Sentimo",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "₱",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Poland",
+		countryCultureOfficialStateName: "Republic of Poland",
+		countryCodeTwoChar:              "PL",
+		countryCodeThreeChar:            "POL",
+		countryCodeNumber:               "616",
+		localeTag:                       "pl-PL",
+		currencyCode:                    "PLN",
+		currencySymbols:                 "zł",
+		minorCurrencyName:               "Grosz",
+		decimalSeparator:                ",",
+		integerSeparator:                "\u00A0",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		trailingCurrencySymbols:         "\u00A0zł",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Portugal",
+		countryCultureOfficialStateName: "Portuguese Republic",
+		countryCodeTwoChar:              "PT",
+		countryCodeThreeChar:            "PRT",
+		countryCodeNumber:               "620",
+		localeTag:                       "pt-PT",
+		currencyCode:                    "EUR",
+		currencySymbols:                 "€",
+		minorCurrencyName:               "Cent",
+		minorCurrencySymbols:            "c",
+		decimalSeparator:                ",",
+		integerSeparator:                "\u00A0",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		trailingCurrencySymbols:         "\u00A0€",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Russia",
+		countryCultureOfficialStateName: "Russian Federation",
+		countryCodeTwoChar:              "RU",
+		countryCodeThreeChar:            "RUS",
+		countryCodeNumber:               "643",
+		localeTag:                       "ru-RU",
+		currencyCode:                    "RUB",
+		currencySymbols:                 "₽",
+		minorCurrencyName:               "Kopek",
+		decimalSeparator:                ",",
+		integerSeparator:                "\u202F",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		trailingCurrencySymbols:         "\u00A0₽",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Saudi Arabia",
+		countryCultureOfficialStateName: "Kingdom of Saudi Arabia",
+		countryCodeTwoChar:              "SA",
+		countryCodeThreeChar:            "SAU",
+		countryCodeNumber:               "682",
+		localeTag:                       "ar-SA",
+		currencyCode:                    "SAR",
+		currencySymbols:                 "ر.س.",
+		minorCurrencyName:               "Halala",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "\u200E-",
+		trailingCurrencySymbols:         "\u00A0ر.س.\u200F",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Singapore",
+		countryCultureOfficialStateName: "Republic of Singapore",
+		countryCodeTwoChar:              "SG",
+		countryCodeThreeChar:            "SGP",
+		countryCodeNumber:               "702",
+		localeTag:                       "en-SG",
+		currencyCode:                    "SGD",
+		currencySymbols:                 "$",
+		minorCurrencyName:               "Cent",
+		minorCurrencySymbols:            "c",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "$",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "South Africa",
+		countryCultureOfficialStateName: "Republic of South Africa",
+		countryCodeTwoChar:              "ZA",
+		countryCodeThreeChar:            "ZAF",
+		countryCodeNumber:               "710",
+		localeTag:                       "en-ZA",
+		currencyCode:                    "ZAR",
+		currencySymbols:                 "R",
+		minorCurrencyName:               "Cent",
+		minorCurrencySymbols:            "c",
+		decimalSeparator:                ",",
+		integerSeparator:                "\u00A0",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "R",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "South Korea",
+		countryCultureOfficialStateName: "Republic of Korea",
+		countryCodeTwoChar:              "KR",
+		countryCodeThreeChar:            "KOR",
+		countryCodeNumber:               "410",
+		localeTag:                       "ko-KR",
+		currencyCode:                    "KRW",
+		currencySymbols:                 "₩",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "₩",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Spain",
+		countryCultureOfficialStateName: "Kingdom of Spain",
+		countryCodeTwoChar:              "ES",
+		countryCodeThreeChar:            "ESP",
+		countryCodeNumber:               "724",
+		localeTag:                       "es-ES",
+		currencyCode:                    "EUR",
+		currencySymbols:                 "€",
+		minorCurrencyName:               "Cent",
+		minorCurrencySymbols:            "c",
+		decimalSeparator:                ",",
+		integerSeparator:                ".",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		trailingCurrencySymbols:         "\u00A0€",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Sweden",
+		countryCultureOfficialStateName: "Kingdom of Sweden",
+		countryCodeTwoChar:              "SE",
+		countryCodeThreeChar:            "SWE",
+		countryCodeNumber:               "752",
+		localeTag:                       "sv-SE",
+		currencyCode:                    "SEK",
+		currencySymbols:                 "kr",
+		minorCurrencyName:               "Ore",
+		decimalSeparator:                ",",
+		integerSeparator:                "\u00A0",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "\u2212",
+		trailingCurrencySymbols:         "\u00A0kr",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Switzerland",
+		countryCultureOfficialStateName: "Swiss Confederation",
+		countryCodeTwoChar:              "CH",
+		countryCodeThreeChar:            "CHE",
+		countryCodeNumber:               "756",
+		localeTag:                       "de-CH",
+		currencyCode:                    "CHF",
+		currencySymbols:                 "CHF",
+		minorCurrencyName:               "Rappen",
+		decimalSeparator:                ".",
+		integerSeparator:                "\u2019",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "CHF\u00A0",
+		currencyInsideNumSymbol:         false,
+	},
+	{
+		countryCultureName:              "Switzerland",
+		countryCultureOfficialStateName: "Swiss Confederation",
+		countryCodeTwoChar:              "CH",
+		countryCodeThreeChar:            "CHE",
+		countryCodeNumber:               "756",
+		localeTag:                       "fr-CH",
+		currencyCode:                    "CHF",
+		currencySymbols:                 "CHF",
+		minorCurrencyName:               "Centime",
+		decimalSeparator:                ",",
+		integerSeparator:                "\u202F",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		trailingCurrencySymbols:         "\u00A0CHF",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Switzerland",
+		countryCultureOfficialStateName: "Swiss Confederation",
+		countryCodeTwoChar:              "CH",
+		countryCodeThreeChar:            "CHE",
+		countryCodeNumber:               "756",
+		localeTag:                       "it-CH",
+		currencyCode:                    "CHF",
+		currencySymbols:                 "CHF",
+		minorCurrencyName:               "Centesimo",
+		decimalSeparator:                ".",
+		integerSeparator:                "\u2019",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "CHF\u00A0",
+		currencyInsideNumSymbol:         false,
+	},
+	{
+		countryCultureName:              "Taiwan",
+		countryCultureOfficialStateName: "Taiwan, Province of China",
+		countryCodeTwoChar:              "TW",
+		countryCodeThreeChar:            "TWN",
+		countryCodeNumber:               "158",
+		localeTag:                       "zh-TW",
+		currencyCode:                    "TWD",
+		currencySymbols:                 "$",
+		minorCurrencyName:               "Cent",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "$",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Thailand",
+		countryCultureOfficialStateName: "Kingdom of Thailand",
+		countryCodeTwoChar:              "TH",
+		countryCodeThreeChar:            "THA",
+		countryCodeNumber:               "764",
+		localeTag:                       "th-TH",
+		currencyCode:                    "THB",
+		currencySymbols:                 "฿",
+		minorCurrencyName:               "Satang",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "฿",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Turkey",
+		countryCultureOfficialStateName: "Republic of Türkiye",
+		countryCodeTwoChar:              "TR",
+		countryCodeThreeChar:            "TUR",
+		countryCodeNumber:               "792",
+		localeTag:                       "tr-TR",
+		currencyCode:                    "TRY",
+		currencySymbols:                 "₺",
+		minorCurrencyName:               "Kurus",
+		decimalSeparator:                ",",
+		integerSeparator:                ".",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "₺",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Ukraine",
+		countryCultureOfficialStateName: "Ukraine",
+		countryCodeTwoChar:              "UA",
+		countryCodeThreeChar:            "UKR",
+		countryCodeNumber:               "804",
+		localeTag:                       "uk-UA",
+		currencyCode:                    "UAH",
+		currencySymbols:                 "₴",
+		minorCurrencyName:               "Kopiyka",
+		decimalSeparator:                ",",
+		integerSeparator:                "\u00A0",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		trailingCurrencySymbols:         "\u00A0₴",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "United Arab Emirates",
+		countryCultureOfficialStateName: "United Arab Emirates",
+		countryCodeTwoChar:              "AE",
+		countryCodeThreeChar:            "ARE",
+		countryCodeNumber:               "784",
+		localeTag:                       "ar-AE",
+		currencyCode:                    "AED",
+		currencySymbols:                 "د.إ.",
+		minorCurrencyName:               "Fils",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "\u200E-",
+		trailingCurrencySymbols:         "\u00A0د.إ.\u200F",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "United Kingdom",
+		countryCultureOfficialStateName: "United Kingdom of Great Britain and Northern Ireland",
+		countryCodeTwoChar:              "GB",
+		countryCodeThreeChar:            "GBR",
+		countryCodeNumber:               "826",
+		localeTag:                       "en-GB",
+		currencyCode:                    "GBP",
+		currencySymbols:                 "£",
+		minorCurrencyName:               "Penny",
+		minorCurrencySymbols:            "p",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "£",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "United States",
+		countryCultureOfficialStateName: "United States of America",
+		countryCodeTwoChar:              "US",
+		countryCodeThreeChar:            "USA",
+		countryCodeNumber:               "840",
+		localeTag:                       "en-US",
+		currencyCode:                    "USD",
+		currencySymbols:                 "$",
+		minorCurrencyName:               "Cent",
+		minorCurrencySymbols:            "¢",
+		decimalSeparator:                ".",
+		integerSeparator:                ",",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		leadingCurrencySymbols:          "$",
+		currencyInsideNumSymbol:         true,
+	},
+	{
+		countryCultureName:              "Vietnam",
+		countryCultureOfficialStateName: "Socialist Republic of Viet Nam",
+		countryCodeTwoChar:              "VN",
+		countryCodeThreeChar:            "VNM",
+		countryCodeNumber:               "704",
+		localeTag:                       "vi-VN",
+		currencyCode:                    "VND",
+		currencySymbols:                 "₫",
+		decimalSeparator:                ",",
+		integerSeparator:                ".",
+		intGroupingType:                 IntGroupingType.Thousands(),
+		leadingNegNumSign:               "-",
+		trailingCurrencySymbols:         "\u00A0₫",
+		currencyInsideNumSymbol:         true,
+	},
+}
+
+// numStrCountryCultureLanguageDefaults
+//
+// Maps a BCP 47 primary language subtag to the locale
+// tag of the default Country Culture Catalog entry for
+// that language. This map is used to resolve locale
+// tags which do not include a region subtag, such as
+// "ja" or "pt".
+var numStrCountryCultureLanguageDefaults = map[string]string{
+	"ar": "ar-EG",
+	"cs": "cs-CZ",
+	"da": "da-DK",
+	"de": "de-DE",
+	"el": "el-GR",
+	"en": "en-US",
+	"es": "es-ES",
+	"fi": "fi-FI",
+	"fr": "fr-FR",
+	"he": "he-IL",
+	"hi": "hi-IN",
+	"hu": "hu-HU",
+	"id": "id-ID",
+	"it": "it-IT",
+	"ja": "ja-JP",
+	"ko": "ko-KR",
+	"nb": "nb-NO",
+	"nl": "nl-NL",
+	"no": "nb-NO",
+	"pl": "pl-PL",
+	"pt": "pt-BR",
+	"ru": "ru-RU",
+	"sv": "sv-SE",
+	"th": "th-TH",
+	"tr": "tr-TR",
+	"uk": "uk-UA",
+	"vi": "vi-VN",
+	"zh": "zh-CN",
+}
@@ -102,6 +102,15 @@ type NumStrFmtCountryCultureSpec struct {
 	//	ISO 3166-1 numeric
 	//	https://en.wikipedia.org/wiki/ISO_3166-1_numeric
 
+	LocaleTag string
+	//	Optional
+	//	The BCP 47 language tag identifying the language
+	//	and region, or locale, associated with the
+	//	current Country Culture Specification instance.
+	//	Examples: "en-US", "de-CH", "fr-CA".
+	//	BCP 47 Wikipedia
+	//	https://en.wikipedia.org/wiki/IETF_language_tag
+
 	TelephoneNumberFormat NumStrFmtCountryTelephoneNumSpec
 
 	CurrencyCode string
@@ -544,6 +553,49 @@ func (nStrFmtCountryCultureSpec *NumStrFmtCountryCultureSpec) Equal(
 		incomingCountryCulture)
 }
 
+//	GetCatalogLocaleTags
+//
+//	Returns an array of strings containing the BCP 47
+//	language tags, or locale tags, for all country
+//	cultures included in the Country Culture Catalog.
+//
+//	Any of these locale tags may be passed to method
+//	NumStrFmtCountryCultureSpec.NewLocaleTag() in order
+//	to generate a new instance of
+//	NumStrFmtCountryCultureSpec.
+//
+//	The locale tags are listed in alphabetical order by
+//	country name.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	-- NONE --
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	[]string
+//
+//		An array of strings containing the BCP 47 locale
+//		tags for all entries in the Country Culture
+//		Catalog. Examples: "de-CH", "en-IN", "pt-BR".
+func (nStrFmtCountryCultureSpec *NumStrFmtCountryCultureSpec) GetCatalogLocaleTags() []string {
+
+	if nStrFmtCountryCultureSpec.lock == nil {
+		nStrFmtCountryCultureSpec.lock = new(sync.Mutex)
+	}
+
+	nStrFmtCountryCultureSpec.lock.Lock()
+
+	defer nStrFmtCountryCultureSpec.lock.Unlock()
+
+	return new(numStrFmtCountryCultureSpecQuark).
+		getLocaleTags()
+}
+
 //	New
 //
 //	Creates and returns a new instance of
@@ -735,6 +787,178 @@ func (nStrFmtCountryCultureSpec *NumStrFmtCountryCultureSpec) New(
 	return newCountryCultureSpec, err
 }
 
+//	NewCountryCode
+//
+//	Returns a new instance of NumStrFmtCountryCultureSpec
+//	configured with country and Number String formatting
+//	parameters taken from the Country Culture Catalog.
+//
+//	The catalog entry is identified by an ISO 3166-1
+//	country code. If the country supports more than one
+//	locale, the default locale for that country is used.
+//	For example, country code "CH" (Switzerland) returns
+//	the locale "de-CH" while country code "BE" (Belgium)
+//	returns the locale "nl-BE". To select a specific
+//	locale, see method:
+//
+//		NumStrFmtCountryCultureSpec.NewLocaleTag()
+//
+//	The Country Culture Catalog contains several dozen
+//	country cultures. For a list of all catalog entries,
+//	see method:
+//
+//		NumStrFmtCountryCultureSpec.GetCatalogLocaleTags()
+//
+// ----------------------------------------------------------------
+//
+//	# Input Parameters
+//
+//	countryCode					string
+//
+//		An ISO 3166-1 alpha-2 ("CH"), alpha-3 ("CHE") or
+//		numeric ("756") country code identifying the
+//		country whose formatting parameters will be
+//		used to configure the returned instance of
+//		NumStrFmtCountryCultureSpec. The country code is
+//		not case sensitive.
+//
+//		Like all other catalog entries, country codes
+//		"US", "GB", "FR" and "DE" return the CLDR
+//		formats for the default locales "en-US",
+//		"en-GB", "fr-FR" and "de-DE". These formats
+//		differ from those returned by methods NewUS(),
+//		NewUK(), NewFrance() and NewGermany(). Example:
+//		for country code "US", the currency value
+//		-1234567.89 is formatted as "-$1,234,567.89"
+//		while method NewUS() returns "$ (1,234,567.89)".
+//
+//		If 'countryCode' is not found in the Country
+//		Culture Catalog, an error will be returned.
+//
+//	 errorPrefix                interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it	contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumStrFmtCountryCultureSpec
+//
+//		If this method completes successfully, a new
+//		instance of NumStrFmtCountryCultureSpec
+//		will be returned configured with country and
+//		Number String formatting specifications for
+//		the country identified by 'countryCode'.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (nStrFmtCountryCultureSpec *NumStrFmtCountryCultureSpec) NewCountryCode(
+	countryCode string,
+	errorPrefix interface{}) (
+	NumStrFmtCountryCultureSpec,
+	error) {
+
+	if nStrFmtCountryCultureSpec.lock == nil {
+		nStrFmtCountryCultureSpec.lock = new(sync.Mutex)
+	}
+
+	nStrFmtCountryCultureSpec.lock.Lock()
+
+	defer nStrFmtCountryCultureSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	var err error
+
+	var newCountryCultureSpec NumStrFmtCountryCultureSpec
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrFmtCountryCultureSpec."+
+			"NewCountryCode()",
+		"")
+
+	if err != nil {
+		return newCountryCultureSpec, err
+	}
+
+	err = new(numStrFmtCountryCultureSpecMech).
+		setCountryCode(
+			&newCountryCultureSpec,
+			countryCode,
+			ePrefix.XCpy(
+				"newCountryCultureSpec<-"))
+
+	return newCountryCultureSpec, err
+}
+
 //	NewFrance
 //
 //	Returns a new instance of NumStrFmtCountryCultureSpec
@@ -1005,6 +1229,175 @@ func (nStrFmtCountryCultureSpec *NumStrFmtCountryCultureSpec) NewGermany(
 	return newCountryCultureSpec, err
 }
 
+//	NewLocaleTag
+//
+//	Returns a new instance of NumStrFmtCountryCultureSpec
+//	configured with country and Number String formatting
+//	parameters taken from the Country Culture Catalog.
+//
+//	The catalog entry is identified by a BCP 47 language
+//	tag, or locale tag, such as "en-IN", "de-CH" or
+//	"pt-BR". The locale tag is not case sensitive and the
+//	underscore character ('_') is accepted as a subtag
+//	separator ("pt_BR").
+//
+//	If the locale tag includes a region subtag, but the
+//	language is not found in the catalog, the default
+//	locale for the region is used. Example: "gsw-CH"
+//	returns the locale "de-CH".
+//
+//	If the locale tag does NOT include a region subtag,
+//	the default locale for the language is used.
+//	Example: "ja" returns the locale "ja-JP".
+//
+//	Locale tags "en-US", "en-GB", "fr-FR" and "de-DE"
+//	return the CLDR formats for these locales. These
+//	formats differ from those returned by methods
+//	NewUS(), NewUK(), NewFrance() and NewGermany().
+//
+//	For a list of all locale tags included in the
+//	Country Culture Catalog, see method:
+//
+//		NumStrFmtCountryCultureSpec.GetCatalogLocaleTags()
+//
+// ----------------------------------------------------------------
+//
+//	# Input Parameters
+//
+//	localeTag					string
+//
+//		A BCP 47 language tag identifying the locale
+//		whose formatting parameters will be used to
+//		configure the returned instance of
+//		NumStrFmtCountryCultureSpec.
+//
+//		If 'localeTag' is not found in the Country
+//		Culture Catalog, an error will be returned.
+//
+//	 errorPrefix                interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it	contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumStrFmtCountryCultureSpec
+//
+//		If this method completes successfully, a new
+//		instance of NumStrFmtCountryCultureSpec
+//		will be returned configured with country and
+//		Number String formatting specifications for
+//		the locale identified by 'localeTag'.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'.
+//
+//		If errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message. This returned error message will
+//		incorporate the method chain and text passed by
+//		input parameter, 'errorPrefix'. The 'errorPrefix'
+//		text will be attached to the beginning of the
+//		error message.
+func (nStrFmtCountryCultureSpec *NumStrFmtCountryCultureSpec) NewLocaleTag(
+	localeTag string,
+	errorPrefix interface{}) (
+	NumStrFmtCountryCultureSpec,
+	error) {
+
+	if nStrFmtCountryCultureSpec.lock == nil {
+		nStrFmtCountryCultureSpec.lock = new(sync.Mutex)
+	}
+
+	nStrFmtCountryCultureSpec.lock.Lock()
+
+	defer nStrFmtCountryCultureSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	var err error
+
+	var newCountryCultureSpec NumStrFmtCountryCultureSpec
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrFmtCountryCultureSpec."+
+			"NewLocaleTag()",
+		"")
+
+	if err != nil {
+		return newCountryCultureSpec, err
+	}
+
+	err = new(numStrFmtCountryCultureSpecMech).
+		setLocaleTag(
+			&newCountryCultureSpec,
+			localeTag,
+			ePrefix.XCpy(
+				"newCountryCultureSpec<-"))
+
+	return newCountryCultureSpec, err
+}
+
 //	NewUK
 //
 //	Returns a new instance of NumStrFmtCountryCultureSpec
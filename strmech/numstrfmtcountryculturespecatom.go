@@ -59,6 +59,8 @@ func (nStrFmtCountryCultureAtom *numStrFmtCountryCultureSpecAtom) empty(
 
 	countryCultureSpec.CountryCodeNumber = ""
 
+	countryCultureSpec.LocaleTag = ""
+
 	countryCultureSpec.CurrencyCode = ""
 
 	countryCultureSpec.CurrencyCodeNo = ""
@@ -74,6 +76,9 @@ func (nStrFmtCountryCultureAtom *numStrFmtCountryCultureSpecAtom) empty(
 	countryCultureSpec.CurrencyNumStrFormat.Empty()
 
 	countryCultureSpec.SignedNumStrFormat.Empty()
+
+	new(numStrFmtCountryTelephoneNumSpecElectron).empty(
+		&countryCultureSpec.TelephoneNumberFormat)
 }
 
 //	copyNumStrFormatSpec
@@ -352,6 +357,11 @@ func (nStrFmtCountryCultureAtom *numStrFmtCountryCultureSpecAtom) equal(
 	countryCultureOne.CountryCodeNumber =
 		countryCultureTwo.CountryCodeNumber
 
+	if countryCultureOne.LocaleTag != countryCultureTwo.LocaleTag {
+
+		return false
+	}
+
 	countryCultureOne.CurrencyCode =
 		countryCultureTwo.CurrencyCode
 
@@ -3,6 +3,7 @@ package strmech
 import (
 	"fmt"
 	ePref "github.com/MikeAustin71/errpref"
+	"strconv"
 	"sync"
 )
 
@@ -178,6 +179,9 @@ func (nStrFmtCountryCultureMech *numStrFmtCountryCultureSpecMech) copyCountryCul
 	destinationSpec.CountryCodeNumber =
 		sourceSpec.CountryCodeNumber
 
+	destinationSpec.LocaleTag =
+		sourceSpec.LocaleTag
+
 	destinationSpec.CurrencyCode =
 		sourceSpec.CurrencyCode
 
@@ -224,9 +228,389 @@ func (nStrFmtCountryCultureMech *numStrFmtCountryCultureSpecMech) copyCountryCul
 		ePrefix.XCpy(
 			"destinationSpec.SignedNumStrFormat"))
 
+	if err != nil {
+		return err
+	}
+
+	err = new(numStrFmtCountryTelephoneNumSpecElectron).copy(
+		&destinationSpec.TelephoneNumberFormat,
+		&sourceSpec.TelephoneNumberFormat,
+		ePrefix.XCpy(
+			"destinationSpec.TelephoneNumberFormat"))
+
 	return err
 }
 
+//	setCountryCatalogEntry
+//
+//	Receives a pointer to an instance of
+//	NumStrFmtCountryCultureSpec and proceeds to configure
+//	that instance with the country and Number String
+//	formatting specifications contained in a Country
+//	Culture Catalog entry.
+//
+//	The Signed Number and Currency Number String Format
+//	Specifications are generated from the number
+//	formatting parameters recorded in the catalog entry.
+//	If the catalog entry is flagged as a legacy country
+//	setup, the dedicated country setup method (US, UK,
+//	France or Germany) is called instead.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	Be advised that the data fields in 'countryNStrFmtSpec'
+//	instance NumStrFmtCountryCultureSpec will be deleted
+//	and overwritten.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	countryNStrFmtSpec	*NumStrFmtCountryCultureSpec
+//
+//		A pointer to a NumStrFmtCountryCultureSpec instance.
+//		All the member variable data fields in this object
+//		will be replaced with country and Number String
+//		Formatting specifications taken from input
+//		parameter 'catalogEntry'.
+//
+//	catalogEntry		numStrCountryCultureCatalogEntry
+//
+//		An entry from the Country Culture Catalog
+//		containing the country identification data and
+//		number formatting parameters which will be used
+//		to configure 'countryNStrFmtSpec'.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, this
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrFmtCountryCultureMech *numStrFmtCountryCultureSpecMech) setCountryCatalogEntry(
+	countryNStrFmtSpec *NumStrFmtCountryCultureSpec,
+	catalogEntry numStrCountryCultureCatalogEntry,
+	errPrefDto *ePref.ErrPrefixDto) (err error) {
+
+	if nStrFmtCountryCultureMech.lock == nil {
+		nStrFmtCountryCultureMech.lock = new(sync.Mutex)
+	}
+
+	nStrFmtCountryCultureMech.lock.Lock()
+
+	defer nStrFmtCountryCultureMech.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"nStrFmtCountryCultureMech."+
+			"setCountryCatalogEntry()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if countryNStrFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'countryNStrFmtSpec' is invalid!\n"+
+			"'countryNStrFmtSpec' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	new(numStrFmtCountryCultureSpecAtom).empty(
+		countryNStrFmtSpec)
+
+	nStrFmtSpecNanobot := numStrFmtSpecNanobot{}
+
+	err = nStrFmtSpecNanobot.setCurrencyParams(
+		&countryNStrFmtSpec.CurrencyNumStrFormat,
+		[]rune(catalogEntry.decimalSeparator),
+		[]rune(catalogEntry.integerSeparator),
+		catalogEntry.intGroupingType,
+		nil,
+		nil,
+		NumFieldSymPos.InsideNumField(),
+		[]rune(catalogEntry.leadingNegNumSign),
+		[]rune(catalogEntry.trailingNegNumSign),
+		NumFieldSymPos.InsideNumField(),
+		nil,
+		nil,
+		NumFieldSymPos.InsideNumField(),
+		[]rune(catalogEntry.leadingCurrencySymbols),
+		[]rune(catalogEntry.trailingCurrencySymbols),
+		catalogEntry.currencyInsideNumSymbol,
+		NumFieldSymPos.InsideNumField(),
+		-1,
+		TxtJustify.Right(),
+		ePrefix.XCpy(
+			"countryNStrFmtSpec.CurrencyNumStrFormat"))
+
+	if err != nil {
+		return err
+	}
+
+	err = nStrFmtSpecNanobot.setSignedNumParams(
+		&countryNStrFmtSpec.SignedNumStrFormat,
+		[]rune(catalogEntry.decimalSeparator),
+		[]rune(catalogEntry.integerSeparator),
+		catalogEntry.intGroupingType,
+		nil,
+		nil,
+		NumFieldSymPos.InsideNumField(),
+		[]rune(catalogEntry.leadingNegNumSign),
+		[]rune(catalogEntry.trailingNegNumSign),
+		NumFieldSymPos.InsideNumField(),
+		nil,
+		nil,
+		NumFieldSymPos.InsideNumField(),
+		-1,
+		TxtJustify.Right(),
+		ePrefix.XCpy(
+			"countryNStrFmtSpec.SignedNumStrFormat"))
+
+	if err != nil {
+		return err
+	}
+
+	var countryIdNo uint64
+
+	countryIdNo,
+		err = strconv.ParseUint(
+		catalogEntry.countryCodeNumber,
+		10,
+		64)
+
+	if err != nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: catalogEntry.countryCodeNumber is invalid!\n"+
+			"catalogEntry.countryCodeNumber = '%v'\n"+
+			"Error = \n%v\n",
+			ePrefix.String(),
+			catalogEntry.countryCodeNumber,
+			err.Error())
+
+		return err
+	}
+
+	countryNStrFmtSpec.IdNo = countryIdNo
+	countryNStrFmtSpec.IdString = catalogEntry.countryCodeNumber
+	countryNStrFmtSpec.Description = "Country Setup"
+	countryNStrFmtSpec.Tag = ""
+	countryNStrFmtSpec.CountryIdNo = countryIdNo
+	countryNStrFmtSpec.CountryIdString = catalogEntry.countryCodeNumber
+	countryNStrFmtSpec.CountryDescription =
+		"Country Setup - " + catalogEntry.countryCultureName
+	countryNStrFmtSpec.CountryTag = ""
+	countryNStrFmtSpec.CountryCultureName = catalogEntry.countryCultureName
+	countryNStrFmtSpec.CountryCultureOfficialStateName =
+		catalogEntry.countryCultureOfficialStateName
+	countryNStrFmtSpec.CountryAbbreviatedName = catalogEntry.countryCultureName
+
+	countryNStrFmtSpec.CountryAlternateNames =
+		[]string{
+			catalogEntry.countryCultureOfficialStateName}
+
+	countryNStrFmtSpec.CountryCodeTwoChar = catalogEntry.countryCodeTwoChar
+	countryNStrFmtSpec.CountryCodeThreeChar = catalogEntry.countryCodeThreeChar
+	countryNStrFmtSpec.CountryCodeNumber = catalogEntry.countryCodeNumber
+	countryNStrFmtSpec.LocaleTag = catalogEntry.localeTag
+
+	// The ISO 4217 currency data is taken from the
+	// registry. Only the locale presentation of the
+	// currency is recorded in the catalog entry.
+	var curISO4217Spec CurrencyISO4217Spec
+
+	err = new(currencyISO4217SpecAtom).setFromCode(
+		&curISO4217Spec,
+		catalogEntry.currencyCode,
+		ePrefix.XCpy(
+			"curISO4217Spec<-catalogEntry.currencyCode"))
+
+	if err != nil {
+		return err
+	}
+
+	countryNStrFmtSpec.CurrencyDecimalDigits = curISO4217Spec.MinorUnits
+	countryNStrFmtSpec.CurrencyCode = curISO4217Spec.AlphabeticCode
+	countryNStrFmtSpec.CurrencyCodeNo = curISO4217Spec.NumericCode
+	countryNStrFmtSpec.CurrencyName = curISO4217Spec.CurrencyName
+	countryNStrFmtSpec.CurrencySymbols = []rune(catalogEntry.currencySymbols)
+
+	countryNStrFmtSpec.MinorCurrencyName = catalogEntry.minorCurrencyName
+	countryNStrFmtSpec.MinorCurrencySymbols =
+		[]rune(catalogEntry.minorCurrencySymbols)
+
+	telephoneCatalogEntry,
+		isFound := new(numStrFmtCountryTelephoneNumSpecQuark).
+		findCatalogEntry(catalogEntry.countryCodeTwoChar)
+
+	if isFound {
+
+		err = new(numStrFmtCountryTelephoneNumSpecElectron).
+			setFromCatalogEntry(
+				&countryNStrFmtSpec.TelephoneNumberFormat,
+				telephoneCatalogEntry,
+				ePrefix.XCpy(
+					"countryNStrFmtSpec.TelephoneNumberFormat"))
+	}
+
+	return err
+}
+
+//	setCountryCode
+//
+//	Receives a pointer to an instance of
+//	NumStrFmtCountryCultureSpec and proceeds to configure
+//	that instance with the country and Number String
+//	formatting specifications contained in the Country
+//	Culture Catalog entry identified by input parameter
+//	'countryCode'.
+//
+//	If the country supports more than one locale, the
+//	default catalog entry for that country is used.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	Be advised that the data fields in 'countryNStrFmtSpec'
+//	instance NumStrFmtCountryCultureSpec will be deleted
+//	and overwritten.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	countryNStrFmtSpec	*NumStrFmtCountryCultureSpec
+//
+//		A pointer to a NumStrFmtCountryCultureSpec instance.
+//		All the member variable data fields in this object
+//		will be replaced with country and Number String
+//		Formatting specifications for the country
+//		identified by 'countryCode'.
+//
+//	countryCode			string
+//
+//		An ISO 3166-1 alpha-2 ("CH"), alpha-3 ("CHE") or
+//		numeric ("756") country code identifying the
+//		Country Culture Catalog entry used to configure
+//		'countryNStrFmtSpec'.
+//
+//		If 'countryCode' is not found in the Country
+//		Culture Catalog, an error will be returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, this
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrFmtCountryCultureMech *numStrFmtCountryCultureSpecMech) setCountryCode(
+	countryNStrFmtSpec *NumStrFmtCountryCultureSpec,
+	countryCode string,
+	errPrefDto *ePref.ErrPrefixDto) (err error) {
+
+	if nStrFmtCountryCultureMech.lock == nil {
+		nStrFmtCountryCultureMech.lock = new(sync.Mutex)
+	}
+
+	nStrFmtCountryCultureMech.lock.Lock()
+
+	defer nStrFmtCountryCultureMech.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"nStrFmtCountryCultureMech."+
+			"setCountryCode()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	var catalogEntry numStrCountryCultureCatalogEntry
+
+	catalogEntry,
+		err = new(numStrFmtCountryCultureSpecQuark).
+		findCountryCodeEntry(
+			countryCode,
+			ePrefix.XCpy(
+				"catalogEntry<-countryCode"))
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrFmtCountryCultureSpecMech).
+		setCountryCatalogEntry(
+			countryNStrFmtSpec,
+			catalogEntry,
+			ePrefix.XCpy(
+				"countryNStrFmtSpec<-catalogEntry"))
+}
+
 //	setCountryFrance
 //
 //	Receives a pointer to an instance of
@@ -364,8 +748,9 @@ func (nStrFmtCountryCultureMech *numStrFmtCountryCultureSpecMech) setCountryFran
 	countryNStrFmtSpec.CountryCodeTwoChar = "FR"
 	countryNStrFmtSpec.CountryCodeThreeChar = "FRA"
 	countryNStrFmtSpec.CountryCodeNumber = "250"
+	countryNStrFmtSpec.LocaleTag = "fr-FR"
 	countryNStrFmtSpec.CurrencyDecimalDigits = 2
-	countryNStrFmtSpec.CurrencyCode = "FRF"
+	countryNStrFmtSpec.CurrencyCode = "EUR"
 	countryNStrFmtSpec.CurrencyCodeNo = "978"
 	countryNStrFmtSpec.CurrencyName = "Euro"
 	countryNStrFmtSpec.CurrencySymbols = []rune{'\U000020ac'}
@@ -373,6 +758,13 @@ func (nStrFmtCountryCultureMech *numStrFmtCountryCultureSpecMech) setCountryFran
 	countryNStrFmtSpec.MinorCurrencyName = "Cent"
 	countryNStrFmtSpec.MinorCurrencySymbols = []rune{'\U00000063'}
 
+	err = new(numStrFmtCountryTelephoneNumSpecNanobot).
+		setCountryCode(
+			&countryNStrFmtSpec.TelephoneNumberFormat,
+			"FR",
+			ePrefix.XCpy(
+				"countryNStrFmtSpec.TelephoneNumberFormat"))
+
 	return err
 }
 
@@ -513,8 +905,9 @@ func (nStrFmtCountryCultureMech *numStrFmtCountryCultureSpecMech) setCountryGerm
 	countryNStrFmtSpec.CountryCodeTwoChar = "DE"
 	countryNStrFmtSpec.CountryCodeThreeChar = "DEU"
 	countryNStrFmtSpec.CountryCodeNumber = "276"
+	countryNStrFmtSpec.LocaleTag = "de-DE"
 	countryNStrFmtSpec.CurrencyDecimalDigits = 2
-	countryNStrFmtSpec.CurrencyCode = "DEM"
+	countryNStrFmtSpec.CurrencyCode = "EUR"
 	countryNStrFmtSpec.CurrencyCodeNo = "978"
 	countryNStrFmtSpec.CurrencyName = "Euro"
 	countryNStrFmtSpec.CurrencySymbols = []rune{'\U000020ac'}
@@ -522,6 +915,13 @@ func (nStrFmtCountryCultureMech *numStrFmtCountryCultureSpecMech) setCountryGerm
 	countryNStrFmtSpec.MinorCurrencyName = "Cent"
 	countryNStrFmtSpec.MinorCurrencySymbols = []rune{'\U00000063'}
 
+	err = new(numStrFmtCountryTelephoneNumSpecNanobot).
+		setCountryCode(
+			&countryNStrFmtSpec.TelephoneNumberFormat,
+			"DE",
+			ePrefix.XCpy(
+				"countryNStrFmtSpec.TelephoneNumberFormat"))
+
 	return err
 }
 
@@ -664,6 +1064,7 @@ func (nStrFmtCountryCultureMech *numStrFmtCountryCultureSpecMech) setCountryUK(
 	countryNStrFmtSpec.CountryCodeTwoChar = "GB"
 	countryNStrFmtSpec.CountryCodeThreeChar = "GBR"
 	countryNStrFmtSpec.CountryCodeNumber = "826"
+	countryNStrFmtSpec.LocaleTag = "en-GB"
 	countryNStrFmtSpec.CurrencyDecimalDigits = 2
 	countryNStrFmtSpec.CurrencyCode = "GBP"
 	countryNStrFmtSpec.CurrencyCodeNo = "826"
@@ -672,6 +1073,13 @@ func (nStrFmtCountryCultureMech *numStrFmtCountryCultureSpecMech) setCountryUK(
 	countryNStrFmtSpec.MinorCurrencyName = "Pence"
 	countryNStrFmtSpec.MinorCurrencySymbols = []rune{'\U000000a2'}
 
+	err = new(numStrFmtCountryTelephoneNumSpecNanobot).
+		setCountryCode(
+			&countryNStrFmtSpec.TelephoneNumberFormat,
+			"GB",
+			ePrefix.XCpy(
+				"countryNStrFmtSpec.TelephoneNumberFormat"))
+
 	return err
 }
 
@@ -815,152 +1223,46 @@ func (nStrFmtCountryCultureMech *numStrFmtCountryCultureSpecMech) setCountryUS(
 	countryNStrFmtSpec.CountryCodeTwoChar = "US"
 	countryNStrFmtSpec.CountryCodeThreeChar = "USA"
 
-	countryNStrFmtSpec.TelephoneNumberFormat =
-		NumStrFmtCountryTelephoneNumSpec{
-			CountryName:                         "United States",
-			CountryCodeTwoChar:                  "US",
-			CountryCodeThreeChar:                "USA",
-			InternationalDirectDialingNo:        "011",
-			InternationalPrefix:                 "011",
-			TrunkPrefix:                         "1",
-			CountryTelephoneCode:                "1",
-			AreaCodeMaxNumOfDigits:              "3",
-			AreaCodeMinNumOfDigits:              "3",
-			SubscriberNumMaxNumOfDigitsExternal: "7",
-			SubscriberNumMinNumOfDigitsExternal: "7",
-			SubscriberNumMaxNumOfDigitsInternal: "7",
-			SubscriberNumMinNumOfDigitsInternal: "7",
-			MobileNumMaxNumOfDigitsExternal:     "7",
-			MobileNumMinNumOfDigitsExternal:     "7",
-			MobileNumMaxNumOfDigitsInternal:     "7",
-			MobileNumMinNumOfDigitsInternal:     "7",
-			PhoneExtNumMaxNumOfDigitsExternal:   "4",
-			PhoneExtNumMinNumOfDigitsExternal:   "4",
-			PhoneExtNumMaxNumOfDigitsInternal:   "4",
-			PhoneExtNumMinNumOfDigitsInternal:   "4",
-			SubscriberFmtFullExternal: NumStrFmtTelephoneNumSpec{
-				PhoneNoDialFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "1NNNNNNNNNN",
-					NumReplacementChar: 'N',
-				},
-				PhoneNoDisplayFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "1 (NNN) NNN-NNNN",
-					NumReplacementChar: 'N',
-				},
-			},
-			SubscriberFmtAbbrExternal: NumStrFmtTelephoneNumSpec{
-				PhoneNoDialFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "1NNNNNNNNNN",
-					NumReplacementChar: 'N',
-				},
-				PhoneNoDisplayFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "(NNN) NNN-NNNN",
-					NumReplacementChar: 'N',
-				},
-			},
-			SubscriberFmtFullInternal: NumStrFmtTelephoneNumSpec{
-				PhoneNoDialFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "1NNNNNNNNNN",
-					NumReplacementChar: 'N',
-				},
-				PhoneNoDisplayFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "1 (NNN) NNN-NNNN",
-					NumReplacementChar: 'N',
-				},
-			},
-			SubscriberFmtAbbrInternal: NumStrFmtTelephoneNumSpec{
-				PhoneNoDialFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "1NNNNNNNNNN",
-					NumReplacementChar: 'N',
-				},
-				PhoneNoDisplayFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "(NNN) NNN-NNNN",
-					NumReplacementChar: 'N',
-				},
-			},
-			MobileFmtFullExternal: NumStrFmtTelephoneNumSpec{
-				PhoneNoDialFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "1NNNNNNNNNN",
-					NumReplacementChar: 'N',
-				},
-				PhoneNoDisplayFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "1 (NNN) NNN-NNNN",
-					NumReplacementChar: 'N',
-				},
-			},
-			MobileFmtAbbrExternal: NumStrFmtTelephoneNumSpec{
-				PhoneNoDialFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "1NNNNNNNNNN",
-					NumReplacementChar: 'N',
-				},
-				PhoneNoDisplayFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "(NNN) NNN-NNNN",
-					NumReplacementChar: 'N',
-				},
-			},
-			MobileFmtFullInternal: NumStrFmtTelephoneNumSpec{
-				PhoneNoDialFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "1NNNNNNNNNN",
-					NumReplacementChar: 'N',
-				},
-				PhoneNoDisplayFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "1 (NNN) NNN-NNNN",
-					NumReplacementChar: 'N',
-				},
-			},
-			MobileFmtAbbrInternal: NumStrFmtTelephoneNumSpec{
-				PhoneNoDialFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "1NNNNNNNNNN",
-					NumReplacementChar: 'N',
-				},
-				PhoneNoDisplayFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "(NNN) NNN-NNNN",
-					NumReplacementChar: 'N',
-				},
-			},
-			PhoneExtFmtFullExternal: NumStrFmtTelephoneNumSpec{
-				PhoneNoDialFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "NNNN",
-					NumReplacementChar: 'N',
-				},
-				PhoneNoDisplayFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "NNNN",
-					NumReplacementChar: 'N',
-				},
-			},
-			PhoneExtFmtAbbrExternal: NumStrFmtTelephoneNumSpec{
-				PhoneNoDialFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "NNNN",
-					NumReplacementChar: 'N',
-				},
-				PhoneNoDisplayFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "NNNN",
-					NumReplacementChar: 'N',
-				},
-			},
-			PhoneExtFmtFullInternal: NumStrFmtTelephoneNumSpec{
-				PhoneNoDialFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "NNNN",
-					NumReplacementChar: 'N',
-				},
-				PhoneNoDisplayFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "NNNN",
-					NumReplacementChar: 'N',
-				},
-			},
-			PhoneExtFmtAbbrInternal: NumStrFmtTelephoneNumSpec{
-				PhoneNoDialFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "NNNN",
-					NumReplacementChar: 'N',
-				},
-				PhoneNoDisplayFmt: NumStrFmtCharReplacementSpec{
-					NumberFormat:       "NNNN",
-					NumReplacementChar: 'N',
-				},
-			},
-		}
+	err = new(numStrFmtCountryTelephoneNumSpecNanobot).
+		setCountryCode(
+			&countryNStrFmtSpec.TelephoneNumberFormat,
+			"US",
+			ePrefix.XCpy(
+				"countryNStrFmtSpec.TelephoneNumberFormat"))
+
+	if err != nil {
+		return err
+	}
+
+	// The United States Country Setup has always
+	// specified four digit telephone extensions. The
+	// Telephone Catalog accepts one to six extension
+	// digits for parsing purposes.
+	telNumFmt := &countryNStrFmtSpec.TelephoneNumberFormat
+
+	telNumFmt.PhoneExtNumMaxNumOfDigitsExternal = "4"
+	telNumFmt.PhoneExtNumMinNumOfDigitsExternal = "4"
+	telNumFmt.PhoneExtNumMaxNumOfDigitsInternal = "4"
+	telNumFmt.PhoneExtNumMinNumOfDigitsInternal = "4"
+
+	phoneExtFmt := NumStrFmtTelephoneNumSpec{
+		PhoneNoDialFmt: NumStrFmtCharReplacementSpec{
+			NumberFormat:       "NNNN",
+			NumReplacementChar: 'N',
+		},
+		PhoneNoDisplayFmt: NumStrFmtCharReplacementSpec{
+			NumberFormat:       "NNNN",
+			NumReplacementChar: 'N',
+		},
+	}
+
+	telNumFmt.PhoneExtFmtFullExternal = phoneExtFmt
+	telNumFmt.PhoneExtFmtAbbrExternal = phoneExtFmt
+	telNumFmt.PhoneExtFmtFullInternal = phoneExtFmt
+	telNumFmt.PhoneExtFmtAbbrInternal = phoneExtFmt
 
 	countryNStrFmtSpec.CountryCodeNumber = "840"
+	countryNStrFmtSpec.LocaleTag = "en-US"
 	countryNStrFmtSpec.CurrencyDecimalDigits = 2
 	countryNStrFmtSpec.CurrencyCode = "USD"
 	countryNStrFmtSpec.CurrencyCodeNo = "840"
@@ -971,3 +1273,125 @@ func (nStrFmtCountryCultureMech *numStrFmtCountryCultureSpecMech) setCountryUS(
 
 	return err
 }
+
+//	setLocaleTag
+//
+//	Receives a pointer to an instance of
+//	NumStrFmtCountryCultureSpec and proceeds to configure
+//	that instance with the country and Number String
+//	formatting specifications contained in the Country
+//	Culture Catalog entry identified by the BCP 47
+//	language tag passed by input parameter 'localeTag'.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	Be advised that the data fields in 'countryNStrFmtSpec'
+//	instance NumStrFmtCountryCultureSpec will be deleted
+//	and overwritten.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	countryNStrFmtSpec	*NumStrFmtCountryCultureSpec
+//
+//		A pointer to a NumStrFmtCountryCultureSpec instance.
+//		All the member variable data fields in this object
+//		will be replaced with country and Number String
+//		Formatting specifications for the locale
+//		identified by 'localeTag'.
+//
+//	localeTag			string
+//
+//		A BCP 47 language tag such as "en-IN", "de-CH" or
+//		"pt-BR" identifying the Country Culture Catalog
+//		entry used to configure 'countryNStrFmtSpec'.
+//
+//		If 'localeTag' includes a region subtag, but the
+//		language is not found in the catalog, the default
+//		entry for the region is used. If 'localeTag' does
+//		not include a region subtag, the default entry for
+//		the language is used.
+//
+//		If no matching entry is found in the Country
+//		Culture Catalog, an error will be returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, this
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrFmtCountryCultureMech *numStrFmtCountryCultureSpecMech) setLocaleTag(
+	countryNStrFmtSpec *NumStrFmtCountryCultureSpec,
+	localeTag string,
+	errPrefDto *ePref.ErrPrefixDto) (err error) {
+
+	if nStrFmtCountryCultureMech.lock == nil {
+		nStrFmtCountryCultureMech.lock = new(sync.Mutex)
+	}
+
+	nStrFmtCountryCultureMech.lock.Lock()
+
+	defer nStrFmtCountryCultureMech.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"nStrFmtCountryCultureMech."+
+			"setLocaleTag()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	var catalogEntry numStrCountryCultureCatalogEntry
+
+	catalogEntry,
+		err = new(numStrFmtCountryCultureSpecQuark).
+		findLocaleTagEntry(
+			localeTag,
+			ePrefix.XCpy(
+				"catalogEntry<-localeTag"))
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrFmtCountryCultureSpecMech).
+		setCountryCatalogEntry(
+			countryNStrFmtSpec,
+			catalogEntry,
+			ePrefix.XCpy(
+				"countryNStrFmtSpec<-catalogEntry"))
+}
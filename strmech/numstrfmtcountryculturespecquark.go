@@ -0,0 +1,375 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"strings"
+	"sync"
+)
+
+// numStrFmtCountryCultureSpecQuark
+//
+// Provides helper methods for type
+// NumStrFmtCountryCultureSpec.
+//
+// The methods of this type are used to search the
+// Country Culture Catalog.
+type numStrFmtCountryCultureSpecQuark struct {
+	lock *sync.Mutex
+}
+
+//	findCountryCodeEntry
+//
+//	Searches the Country Culture Catalog for an entry
+//	matching the country code passed by input parameter
+//	'countryCode'.
+//
+//	'countryCode' may be submitted as an ISO 3166-1
+//	alpha-2 Two Character code ("CH"), an ISO 3166-1
+//	alpha-3 Three Character code ("CHE") or an ISO
+//	3166-1 numeric code ("756"). The search is not case
+//	sensitive.
+//
+//	If the country supports more than one locale, the
+//	default catalog entry for that country is returned.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	countryCode					string
+//
+//		The ISO 3166-1 alpha-2, alpha-3 or numeric country
+//		code identifying the catalog entry to be returned.
+//
+//		If no matching entry is found in the Country
+//		Culture Catalog, an error will be returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	catalogEntry				numStrCountryCultureCatalogEntry
+//
+//		If this method completes successfully, this
+//		parameter will return the Country Culture Catalog
+//		entry matching input parameter 'countryCode'.
+//
+//	err							error
+//
+//		If this method completes successfully, this
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrFmtCountryCultureQuark *numStrFmtCountryCultureSpecQuark) findCountryCodeEntry(
+	countryCode string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	catalogEntry numStrCountryCultureCatalogEntry,
+	err error) {
+
+	if nStrFmtCountryCultureQuark.lock == nil {
+		nStrFmtCountryCultureQuark.lock = new(sync.Mutex)
+	}
+
+	nStrFmtCountryCultureQuark.lock.Lock()
+
+	defer nStrFmtCountryCultureQuark.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrFmtCountryCultureSpecQuark."+
+			"findCountryCodeEntry()",
+		"")
+
+	if err != nil {
+		return catalogEntry, err
+	}
+
+	searchCode := strings.ToUpper(
+		strings.TrimSpace(countryCode))
+
+	if len(searchCode) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'countryCode' is invalid!\n"+
+			"'countryCode' is an empty string.\n",
+			ePrefix.String())
+
+		return catalogEntry, err
+	}
+
+	for i := 0; i < len(numStrCountryCultureCatalog); i++ {
+
+		if numStrCountryCultureCatalog[i].countryCodeTwoChar == searchCode ||
+			numStrCountryCultureCatalog[i].countryCodeThreeChar == searchCode ||
+			numStrCountryCultureCatalog[i].countryCodeNumber == searchCode {
+
+			catalogEntry = numStrCountryCultureCatalog[i]
+
+			return catalogEntry, err
+		}
+	}
+
+	err = fmt.Errorf("%v\n"+
+		"Error: Input parameter 'countryCode' is invalid!\n"+
+		"'countryCode' was NOT found in the Country Culture Catalog.\n"+
+		"countryCode = '%v'\n",
+		ePrefix.String(),
+		countryCode)
+
+	return catalogEntry, err
+}
+
+//	findLocaleTagEntry
+//
+//	Searches the Country Culture Catalog for an entry
+//	matching the BCP 47 language tag passed by input
+//	parameter 'localeTag'.
+//
+//	The search is not case sensitive and the underscore
+//	character ('_') is accepted as a subtag separator.
+//	Therefore, "de-CH", "de_CH" and "DE-ch" are treated
+//	as equivalent locale tags. Script subtags such as
+//	"Hant" in "zh-Hant-TW" are ignored.
+//
+//	The search proceeds as follows:
+//
+//	(1)	If 'localeTag' includes a region subtag and an
+//		entry exists for the language and region, that
+//		entry is returned.
+//
+//	(2)	If 'localeTag' includes a region subtag, but no
+//		entry exists for the language, the default entry
+//		for the region (country) is returned.
+//
+//	(3)	If 'localeTag' does NOT include a region subtag,
+//		the default entry for the language is returned.
+//		Example: "ja" returns the entry for "ja-JP".
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	localeTag					string
+//
+//		The BCP 47 language tag identifying the catalog
+//		entry to be returned. Examples: "en-IN", "de-CH",
+//		"pt-BR".
+//
+//		If no matching entry is found in the Country
+//		Culture Catalog, an error will be returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	catalogEntry				numStrCountryCultureCatalogEntry
+//
+//		If this method completes successfully, this
+//		parameter will return the Country Culture Catalog
+//		entry matching input parameter 'localeTag'.
+//
+//	err							error
+//
+//		If this method completes successfully, this
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrFmtCountryCultureQuark *numStrFmtCountryCultureSpecQuark) findLocaleTagEntry(
+	localeTag string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	catalogEntry numStrCountryCultureCatalogEntry,
+	err error) {
+
+	if nStrFmtCountryCultureQuark.lock == nil {
+		nStrFmtCountryCultureQuark.lock = new(sync.Mutex)
+	}
+
+	nStrFmtCountryCultureQuark.lock.Lock()
+
+	defer nStrFmtCountryCultureQuark.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrFmtCountryCultureSpecQuark."+
+			"findLocaleTagEntry()",
+		"")
+
+	if err != nil {
+		return catalogEntry, err
+	}
+
+	subTags := strings.Split(
+		strings.ReplaceAll(
+			strings.TrimSpace(localeTag),
+			"_",
+			"-"),
+		"-")
+
+	language := strings.ToLower(subTags[0])
+
+	if len(language) < 2 ||
+		len(language) > 3 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'localeTag' is invalid!\n"+
+			"'localeTag' does NOT begin with a valid language subtag.\n"+
+			"localeTag = '%v'\n",
+			ePrefix.String(),
+			localeTag)
+
+		return catalogEntry, err
+	}
+
+	var region string
+
+	for i := 1; i < len(subTags); i++ {
+
+		// Script subtags consist of four characters
+		// and are skipped.
+		if len(subTags[i]) == 2 ||
+			len(subTags[i]) == 3 {
+
+			region = strings.ToUpper(subTags[i])
+
+			break
+		}
+	}
+
+	searchTag := language
+
+	if len(region) == 0 {
+
+		var ok bool
+
+		searchTag,
+			ok = numStrCountryCultureLanguageDefaults[language]
+
+		if !ok {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'localeTag' is invalid!\n"+
+				"'localeTag' was NOT found in the Country Culture Catalog.\n"+
+				"localeTag = '%v'\n",
+				ePrefix.String(),
+				localeTag)
+
+			return catalogEntry, err
+		}
+
+	} else {
+
+		searchTag += "-" + region
+	}
+
+	defaultRegionIdx := -1
+
+	for i := 0; i < len(numStrCountryCultureCatalog); i++ {
+
+		if numStrCountryCultureCatalog[i].localeTag == searchTag {
+
+			catalogEntry = numStrCountryCultureCatalog[i]
+
+			return catalogEntry, err
+		}
+
+		if defaultRegionIdx == -1 &&
+			len(region) > 0 &&
+			(numStrCountryCultureCatalog[i].countryCodeTwoChar == region ||
+				numStrCountryCultureCatalog[i].countryCodeNumber == region) {
+
+			defaultRegionIdx = i
+		}
+	}
+
+	if defaultRegionIdx > -1 {
+
+		catalogEntry = numStrCountryCultureCatalog[defaultRegionIdx]
+
+		return catalogEntry, err
+	}
+
+	err = fmt.Errorf("%v\n"+
+		"Error: Input parameter 'localeTag' is invalid!\n"+
+		"'localeTag' was NOT found in the Country Culture Catalog.\n"+
+		"localeTag = '%v'\n",
+		ePrefix.String(),
+		localeTag)
+
+	return catalogEntry, err
+}
+
+// getLocaleTags
+//
+// Returns an array of strings containing the BCP 47
+// language tags for all entries in the Country Culture
+// Catalog.
+//
+// The locale tags are listed in alphabetical order by
+// country name.
+func (nStrFmtCountryCultureQuark *numStrFmtCountryCultureSpecQuark) getLocaleTags() []string {
+
+	if nStrFmtCountryCultureQuark.lock == nil {
+		nStrFmtCountryCultureQuark.lock = new(sync.Mutex)
+	}
+
+	nStrFmtCountryCultureQuark.lock.Lock()
+
+	defer nStrFmtCountryCultureQuark.lock.Unlock()
+
+	localeTags := make([]string, len(numStrCountryCultureCatalog))
+
+	for i := 0; i < len(numStrCountryCultureCatalog); i++ {
+		localeTags[i] = numStrCountryCultureCatalog[i].localeTag
+	}
+
+	return localeTags
+}
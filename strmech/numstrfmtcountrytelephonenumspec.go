@@ -1,6 +1,9 @@
 package strmech
 
-import "sync"
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
 
 // NumStrFmtCountryTelephoneNumSpec
 //
@@ -110,6 +113,17 @@ type NumStrFmtCountryTelephoneNumSpec struct {
 	//	Mobile Number when calling from inside
 	//	the host country.
 
+	MobileNumPrefixes string
+	//	Optional
+	//	A comma delimited list of the leading digits
+	//	which identify mobile numbers dialed without an
+	//	area code. If the National Significant Number
+	//	begins with one of these prefixes and satisfies
+	//	the Mobile Number digit limits, it is parsed as
+	//	a mobile number with an empty area code.
+	//
+	//	Example India: "6,7,8,9"
+
 	PhoneExtNumMaxNumOfDigitsExternal string
 	//	The Maximum Number of numeric digits in the
 	//	Phone Extension Number when calling from outside
@@ -336,3 +350,656 @@ type NumStrFmtCountryTelephoneNumSpec struct {
 
 	lock *sync.Mutex
 }
+
+// CopyIn
+//
+// Copies the data fields from an incoming instance of
+// NumStrFmtCountryTelephoneNumSpec
+// ('incomingTelephoneNumSpec') to the data fields of the
+// current NumStrFmtCountryTelephoneNumSpec instance.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// All the data fields in the current
+// NumStrFmtCountryTelephoneNumSpec instance will be
+// deleted and overwritten.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	incomingTelephoneNumSpec	*NumStrFmtCountryTelephoneNumSpec
+//
+//		A pointer to an instance of
+//		NumStrFmtCountryTelephoneNumSpec. The data values
+//		in this object will be copied to the current
+//		NumStrFmtCountryTelephoneNumSpec instance.
+//
+//		'incomingTelephoneNumSpec' will NOT be changed or
+//		modified.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrFmtTelNumSpec *NumStrFmtCountryTelephoneNumSpec) CopyIn(
+	incomingTelephoneNumSpec *NumStrFmtCountryTelephoneNumSpec,
+	errorPrefix interface{}) (
+	err error) {
+
+	if nStrFmtTelNumSpec.lock == nil {
+		nStrFmtTelNumSpec.lock = new(sync.Mutex)
+	}
+
+	nStrFmtTelNumSpec.lock.Lock()
+
+	defer nStrFmtTelNumSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrFmtCountryTelephoneNumSpec."+
+			"CopyIn()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrFmtCountryTelephoneNumSpecElectron).copy(
+		nStrFmtTelNumSpec,
+		incomingTelephoneNumSpec,
+		ePrefix.XCpy(
+			"nStrFmtTelNumSpec<-incomingTelephoneNumSpec"))
+}
+
+// CopyOut
+//
+// Returns a deep copy of the current
+// NumStrFmtCountryTelephoneNumSpec instance.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	deepCopyTelephoneNumSpec	NumStrFmtCountryTelephoneNumSpec
+//
+//		If this method completes successfully, a deep
+//		copy of the current
+//		NumStrFmtCountryTelephoneNumSpec instance will be
+//		returned.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrFmtTelNumSpec *NumStrFmtCountryTelephoneNumSpec) CopyOut(
+	errorPrefix interface{}) (
+	deepCopyTelephoneNumSpec NumStrFmtCountryTelephoneNumSpec,
+	err error) {
+
+	if nStrFmtTelNumSpec.lock == nil {
+		nStrFmtTelNumSpec.lock = new(sync.Mutex)
+	}
+
+	nStrFmtTelNumSpec.lock.Lock()
+
+	defer nStrFmtTelNumSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrFmtCountryTelephoneNumSpec."+
+			"CopyOut()",
+		"")
+
+	if err != nil {
+		return deepCopyTelephoneNumSpec, err
+	}
+
+	err = new(numStrFmtCountryTelephoneNumSpecElectron).copy(
+		&deepCopyTelephoneNumSpec,
+		nStrFmtTelNumSpec,
+		ePrefix.XCpy(
+			"deepCopyTelephoneNumSpec<-nStrFmtTelNumSpec"))
+
+	return deepCopyTelephoneNumSpec, err
+}
+
+// Empty
+//
+// Resets all internal member variables for the current
+// instance of NumStrFmtCountryTelephoneNumSpec to their
+// initial or zero values.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// This method will delete all pre-existing internal
+// member variable data values in the current instance
+// of NumStrFmtCountryTelephoneNumSpec.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	NONE
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NONE
+func (nStrFmtTelNumSpec *NumStrFmtCountryTelephoneNumSpec) Empty() {
+
+	if nStrFmtTelNumSpec.lock == nil {
+		nStrFmtTelNumSpec.lock = new(sync.Mutex)
+	}
+
+	nStrFmtTelNumSpec.lock.Lock()
+
+	new(numStrFmtCountryTelephoneNumSpecElectron).empty(
+		nStrFmtTelNumSpec)
+
+	nStrFmtTelNumSpec.lock.Unlock()
+
+	nStrFmtTelNumSpec.lock = nil
+}
+
+// GetCatalogCountryCodes
+//
+// Returns the ISO 3166-1 alpha-2 Two Character country
+// codes for all countries included in the bundled
+// Telephone Catalog.
+//
+// Any of these country codes may be passed to method
+// NumStrFmtCountryTelephoneNumSpec.NewCountryCode() or
+// TelephoneNumberDto.NewParseTelephoneNumber().
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	NONE
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	[]string
+//
+//		An array of ISO 3166-1 alpha-2 Two Character
+//		country codes.
+func (nStrFmtTelNumSpec *NumStrFmtCountryTelephoneNumSpec) GetCatalogCountryCodes() []string {
+
+	if nStrFmtTelNumSpec.lock == nil {
+		nStrFmtTelNumSpec.lock = new(sync.Mutex)
+	}
+
+	nStrFmtTelNumSpec.lock.Lock()
+
+	defer nStrFmtTelNumSpec.lock.Unlock()
+
+	countryCodes := make([]string, len(numStrTelephoneCatalog))
+
+	for i := 0; i < len(numStrTelephoneCatalog); i++ {
+
+		countryCodes[i] =
+			numStrTelephoneCatalog[i].countryCodeTwoChar
+	}
+
+	return countryCodes
+}
+
+// NewCountryCode
+//
+// Creates and returns a new instance of
+// NumStrFmtCountryTelephoneNumSpec configured with the
+// telephone numbering plan data for the country
+// identified by input parameter 'countryCode'.
+//
+// Numbering plan data is taken from the bundled
+// Telephone Catalog. Reference method:
+//
+//	NumStrFmtCountryTelephoneNumSpec.GetCatalogCountryCodes()
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	countryCode					string
+//
+//		The ISO 3166-1 alpha-2 ("GB") or alpha-3 ("GBR")
+//		country code identifying the country. This
+//		parameter is not case sensitive.
+//
+//		If 'countryCode' is not found in the Telephone
+//		Catalog, an error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumStrFmtCountryTelephoneNumSpec
+//
+//		If this method completes successfully, a new
+//		instance of NumStrFmtCountryTelephoneNumSpec
+//		configured for the country identified by
+//		'countryCode' will be returned.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrFmtTelNumSpec *NumStrFmtCountryTelephoneNumSpec) NewCountryCode(
+	countryCode string,
+	errorPrefix interface{}) (
+	NumStrFmtCountryTelephoneNumSpec,
+	error) {
+
+	if nStrFmtTelNumSpec.lock == nil {
+		nStrFmtTelNumSpec.lock = new(sync.Mutex)
+	}
+
+	nStrFmtTelNumSpec.lock.Lock()
+
+	defer nStrFmtTelNumSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrFmtCountryTelephoneNumSpec."+
+			"NewCountryCode()",
+		"")
+
+	newTelephoneNumSpec := NumStrFmtCountryTelephoneNumSpec{}
+
+	if err != nil {
+		return newTelephoneNumSpec, err
+	}
+
+	err = new(numStrFmtCountryTelephoneNumSpecNanobot).
+		setCountryCode(
+			&newTelephoneNumSpec,
+			countryCode,
+			ePrefix.XCpy(
+				"newTelephoneNumSpec<-countryCode"))
+
+	return newTelephoneNumSpec, err
+}
+
+// ParseTelephoneNumber
+//
+// Parses a raw telephone number string using the
+// numbering plan data contained in the current instance
+// of NumStrFmtCountryTelephoneNumSpec. The components of
+// the parsed telephone number are returned in a new
+// instance of TelephoneNumberDto.
+//
+// The raw telephone number may be submitted in national
+// format, or in international format beginning with a
+// plus sign ('+') or the International Prefix followed
+// by the Country Telephone Code of the current instance.
+//
+//	Examples for the United Kingdom:
+//		"020 7946 0958"
+//		"+44 20 7946 0958"
+//		"+44 (0)20 7946 0958"
+//		"00 44 20 7946 0958"
+//
+// The number of area code, subscriber and extension
+// digits is validated against the digit limits specified
+// by the current instance.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	rawTelephoneNum				string
+//
+//		The raw telephone number string to be parsed.
+//
+//		If this string is empty or invalid, an error will
+//		be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	TelephoneNumberDto
+//
+//		If this method completes successfully, a new
+//		instance of TelephoneNumberDto containing the
+//		parsed telephone number will be returned.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrFmtTelNumSpec *NumStrFmtCountryTelephoneNumSpec) ParseTelephoneNumber(
+	rawTelephoneNum string,
+	errorPrefix interface{}) (
+	TelephoneNumberDto,
+	error) {
+
+	if nStrFmtTelNumSpec.lock == nil {
+		nStrFmtTelNumSpec.lock = new(sync.Mutex)
+	}
+
+	nStrFmtTelNumSpec.lock.Lock()
+
+	defer nStrFmtTelNumSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrFmtCountryTelephoneNumSpec."+
+			"ParseTelephoneNumber()",
+		"")
+
+	newTelephoneNumDto := TelephoneNumberDto{}
+
+	if err != nil {
+		return newTelephoneNumDto, err
+	}
+
+	err = new(numStrFmtCountryTelephoneNumSpecAtom).
+		parseTelephoneNumber(
+			&newTelephoneNumDto,
+			nStrFmtTelNumSpec,
+			rawTelephoneNum,
+			"",
+			ePrefix.XCpy(
+				"newTelephoneNumDto<-rawTelephoneNum"))
+
+	return newTelephoneNumDto, err
+}
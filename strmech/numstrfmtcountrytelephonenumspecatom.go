@@ -0,0 +1,816 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// numStrFmtCountryTelephoneNumSpecAtom
+//
+// Provides helper methods for type
+// NumStrFmtCountryTelephoneNumSpec.
+type numStrFmtCountryTelephoneNumSpecAtom struct {
+	lock *sync.Mutex
+}
+
+// parseTelephoneNumber
+//
+// Parses a raw telephone number string according to the
+// numbering plan data contained in an instance of
+// NumStrFmtCountryTelephoneNumSpec. The resulting
+// country, area code, subscriber number and extension
+// components are stored in an instance of
+// TelephoneNumberDto.
+//
+// The raw telephone number may be submitted in national
+// or international format:
+//
+//	"(212) 555-0100 x42"
+//	"1 212 555 0100"
+//	"+44 20 7946 0958"
+//	"+44 (0)20 7946 0958"
+//	"00 44 20 7946 0958"
+//	"020 7946 0958"
+//
+// Digits may be separated by spaces, hyphens ('-'),
+// periods ('.'), slashes ('/') or parentheses. A number
+// beginning with a plus sign ('+') or with the
+// International Prefix is treated as an international
+// number and must begin with the Country Telephone Code.
+// A national number beginning with the Trunk Prefix has
+// the Trunk Prefix removed.
+//
+// An extension may follow the telephone number. The
+// extension must be introduced by "x", "ext", "ext.",
+// "extension", "#", ";ext=" or ",".
+//
+// If the number of area code digits is fixed, the area
+// code is taken from the leading digits of the National
+// Significant Number. If the number of area code digits
+// varies, the first group of digits is used as the area
+// code provided that its length is valid. Otherwise, the
+// shortest valid area code is used.
+//
+// The number of subscriber digits must satisfy the
+// Subscriber Number or Mobile Number digit limits. The
+// 'External' limits are applied to international numbers
+// and the 'Internal' limits are applied to national
+// numbers.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// All pre-existing data values in 'telephoneNumDto' will
+// be deleted and overwritten.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	telephoneNumDto				*TelephoneNumberDto
+//
+//		A pointer to an instance of TelephoneNumberDto.
+//		The components of the parsed telephone number will
+//		be stored in this instance.
+//
+//	telephoneNumSpec			*NumStrFmtCountryTelephoneNumSpec
+//
+//		A pointer to an instance of
+//		NumStrFmtCountryTelephoneNumSpec containing the
+//		numbering plan data used to parse the telephone
+//		number.
+//
+//	rawTelephoneNum				string
+//
+//		The raw telephone number string to be parsed.
+//
+//		If this string is empty or invalid, an error will
+//		be returned.
+//
+//	callerIntlPrefix			string
+//
+//		An optional International Prefix used by the
+//		caller's country. If 'rawTelephoneNum' begins with
+//		this prefix, it will be treated as an
+//		international number. Example: "011" for a number
+//		dialed from the United States.
+//
+//		If this parameter is an empty string, it is
+//		ignored.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrFmtTelNumAtom *numStrFmtCountryTelephoneNumSpecAtom) parseTelephoneNumber(
+	telephoneNumDto *TelephoneNumberDto,
+	telephoneNumSpec *NumStrFmtCountryTelephoneNumSpec,
+	rawTelephoneNum string,
+	callerIntlPrefix string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrFmtTelNumAtom.lock == nil {
+		nStrFmtTelNumAtom.lock = new(sync.Mutex)
+	}
+
+	nStrFmtTelNumAtom.lock.Lock()
+
+	defer nStrFmtTelNumAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrFmtCountryTelephoneNumSpecAtom."+
+			"parseTelephoneNumber()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if telephoneNumDto == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'telephoneNumDto' is invalid!\n"+
+			"'telephoneNumDto' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if telephoneNumSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'telephoneNumSpec' is invalid!\n"+
+			"'telephoneNumSpec' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	nStrFmtTelNumQuark := numStrFmtCountryTelephoneNumSpecQuark{}
+
+	var areaCodeMin, areaCodeMax,
+		subNumMinExternal, subNumMaxExternal,
+		subNumMinInternal, subNumMaxInternal,
+		mobileNumMinExternal, mobileNumMaxExternal,
+		mobileNumMinInternal, mobileNumMaxInternal,
+		phoneExtMinExternal, phoneExtMaxExternal,
+		phoneExtMinInternal, phoneExtMaxInternal int
+
+	digitLimits := []struct {
+		limitStr  string
+		limitName string
+		limit     *int
+	}{
+		{telephoneNumSpec.AreaCodeMinNumOfDigits,
+			"AreaCodeMinNumOfDigits", &areaCodeMin},
+		{telephoneNumSpec.AreaCodeMaxNumOfDigits,
+			"AreaCodeMaxNumOfDigits", &areaCodeMax},
+		{telephoneNumSpec.SubscriberNumMinNumOfDigitsExternal,
+			"SubscriberNumMinNumOfDigitsExternal", &subNumMinExternal},
+		{telephoneNumSpec.SubscriberNumMaxNumOfDigitsExternal,
+			"SubscriberNumMaxNumOfDigitsExternal", &subNumMaxExternal},
+		{telephoneNumSpec.SubscriberNumMinNumOfDigitsInternal,
+			"SubscriberNumMinNumOfDigitsInternal", &subNumMinInternal},
+		{telephoneNumSpec.SubscriberNumMaxNumOfDigitsInternal,
+			"SubscriberNumMaxNumOfDigitsInternal", &subNumMaxInternal},
+		{telephoneNumSpec.MobileNumMinNumOfDigitsExternal,
+			"MobileNumMinNumOfDigitsExternal", &mobileNumMinExternal},
+		{telephoneNumSpec.MobileNumMaxNumOfDigitsExternal,
+			"MobileNumMaxNumOfDigitsExternal", &mobileNumMaxExternal},
+		{telephoneNumSpec.MobileNumMinNumOfDigitsInternal,
+			"MobileNumMinNumOfDigitsInternal", &mobileNumMinInternal},
+		{telephoneNumSpec.MobileNumMaxNumOfDigitsInternal,
+			"MobileNumMaxNumOfDigitsInternal", &mobileNumMaxInternal},
+		{telephoneNumSpec.PhoneExtNumMinNumOfDigitsExternal,
+			"PhoneExtNumMinNumOfDigitsExternal", &phoneExtMinExternal},
+		{telephoneNumSpec.PhoneExtNumMaxNumOfDigitsExternal,
+			"PhoneExtNumMaxNumOfDigitsExternal", &phoneExtMaxExternal},
+		{telephoneNumSpec.PhoneExtNumMinNumOfDigitsInternal,
+			"PhoneExtNumMinNumOfDigitsInternal", &phoneExtMinInternal},
+		{telephoneNumSpec.PhoneExtNumMaxNumOfDigitsInternal,
+			"PhoneExtNumMaxNumOfDigitsInternal", &phoneExtMaxInternal},
+	}
+
+	for i := 0; i < len(digitLimits); i++ {
+
+		*digitLimits[i].limit,
+			err = nStrFmtTelNumQuark.getDigitLimit(
+			digitLimits[i].limitStr,
+			digitLimits[i].limitName,
+			ePrefix.XCpy(
+				"telephoneNumSpec."+
+					digitLimits[i].limitName))
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if areaCodeMin < 0 {
+		areaCodeMin = 0
+	}
+
+	if areaCodeMax < 0 {
+		areaCodeMax = 0
+	}
+
+	if areaCodeMin > areaCodeMax {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'telephoneNumSpec' is invalid!\n"+
+			"'AreaCodeMinNumOfDigits' is greater than 'AreaCodeMaxNumOfDigits'.\n"+
+			"AreaCodeMinNumOfDigits = '%v'\n"+
+			"AreaCodeMaxNumOfDigits = '%v'\n",
+			ePrefix.String(),
+			areaCodeMin,
+			areaCodeMax)
+
+		return err
+	}
+
+	telNumRunes,
+		extensionRunes := nStrFmtTelNumQuark.
+		splitTelephoneNumStr(rawTelephoneNum)
+
+	if len(telNumRunes) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'rawTelephoneNum' is invalid!\n"+
+			"'rawTelephoneNum' does not contain a telephone number.\n"+
+			"rawTelephoneNum = '%v'\n",
+			ePrefix.String(),
+			rawTelephoneNum)
+
+		return err
+	}
+
+	// Parse the extension
+	var extensionDigits, extensionMarker []rune
+
+	for i := 0; i < len(extensionRunes); i++ {
+
+		if extensionRunes[i] >= '0' &&
+			extensionRunes[i] <= '9' {
+
+			extensionDigits = append(
+				extensionDigits,
+				extensionRunes[i])
+
+			continue
+		}
+
+		if extensionRunes[i] == ' ' ||
+			extensionRunes[i] == '.' ||
+			extensionRunes[i] == ':' ||
+			extensionRunes[i] == '=' {
+
+			continue
+		}
+
+		if len(extensionDigits) > 0 {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'rawTelephoneNum' is invalid!\n"+
+				"The telephone number extension contains invalid characters.\n"+
+				"rawTelephoneNum = '%v'\n",
+				ePrefix.String(),
+				rawTelephoneNum)
+
+			return err
+		}
+
+		extensionMarker = append(
+			extensionMarker,
+			unicode.ToLower(extensionRunes[i]))
+	}
+
+	if len(extensionRunes) > 0 {
+
+		switch string(extensionMarker) {
+		case "x", "ext", "extension", "#", ";ext", ",":
+		default:
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'rawTelephoneNum' is invalid!\n"+
+				"The telephone number contains invalid characters.\n"+
+				"rawTelephoneNum = '%v'\n",
+				ePrefix.String(),
+				rawTelephoneNum)
+
+			return err
+		}
+
+		if len(extensionDigits) == 0 {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'rawTelephoneNum' is invalid!\n"+
+				"The telephone number extension does not contain any digits.\n"+
+				"rawTelephoneNum = '%v'\n",
+				ePrefix.String(),
+				rawTelephoneNum)
+
+			return err
+		}
+	}
+
+	// Extract the telephone number digits and
+	// digit groups
+	var digits []rune
+	var groupStarts, groupEnds []int
+	var groupInParens []bool
+
+	isInternational := false
+	isInParens := false
+	lastWasDigit := false
+
+	for i := 0; i < len(telNumRunes); i++ {
+
+		if telNumRunes[i] >= '0' &&
+			telNumRunes[i] <= '9' {
+
+			if !lastWasDigit {
+
+				groupStarts = append(
+					groupStarts,
+					len(digits))
+
+				groupInParens = append(
+					groupInParens,
+					isInParens)
+			}
+
+			digits = append(digits, telNumRunes[i])
+
+			lastWasDigit = true
+
+			continue
+		}
+
+		if lastWasDigit {
+
+			groupEnds = append(
+				groupEnds,
+				len(digits))
+
+			lastWasDigit = false
+		}
+
+		switch telNumRunes[i] {
+		case ' ', '-', '.', '/':
+
+		case '+':
+
+			if len(digits) > 0 ||
+				isInternational {
+
+				err = fmt.Errorf("%v\n"+
+					"Error: Input parameter 'rawTelephoneNum' is invalid!\n"+
+					"The plus sign ('+') must precede all telephone number digits.\n"+
+					"rawTelephoneNum = '%v'\n",
+					ePrefix.String(),
+					rawTelephoneNum)
+
+				return err
+			}
+
+			isInternational = true
+
+		case '(':
+
+			if isInParens {
+
+				err = fmt.Errorf("%v\n"+
+					"Error: Input parameter 'rawTelephoneNum' is invalid!\n"+
+					"The telephone number contains nested parentheses.\n"+
+					"rawTelephoneNum = '%v'\n",
+					ePrefix.String(),
+					rawTelephoneNum)
+
+				return err
+			}
+
+			isInParens = true
+
+		case ')':
+
+			if !isInParens {
+
+				err = fmt.Errorf("%v\n"+
+					"Error: Input parameter 'rawTelephoneNum' is invalid!\n"+
+					"The telephone number contains unbalanced parentheses.\n"+
+					"rawTelephoneNum = '%v'\n",
+					ePrefix.String(),
+					rawTelephoneNum)
+
+				return err
+			}
+
+			isInParens = false
+
+		default:
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'rawTelephoneNum' is invalid!\n"+
+				"The telephone number contains an invalid character.\n"+
+				"rawTelephoneNum = '%v'\n"+
+				"Invalid Character = '%v'\n",
+				ePrefix.String(),
+				rawTelephoneNum,
+				string(telNumRunes[i]))
+
+			return err
+		}
+	}
+
+	if lastWasDigit {
+
+		groupEnds = append(
+			groupEnds,
+			len(digits))
+	}
+
+	if isInParens {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'rawTelephoneNum' is invalid!\n"+
+			"The telephone number contains unbalanced parentheses.\n"+
+			"rawTelephoneNum = '%v'\n",
+			ePrefix.String(),
+			rawTelephoneNum)
+
+		return err
+	}
+
+	if len(digits) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'rawTelephoneNum' is invalid!\n"+
+			"'rawTelephoneNum' does not contain any numeric digits.\n"+
+			"rawTelephoneNum = '%v'\n",
+			ePrefix.String(),
+			rawTelephoneNum)
+
+		return err
+	}
+
+	digitsStr := string(digits)
+
+	countryTelCode := strings.TrimSpace(
+		telephoneNumSpec.CountryTelephoneCode)
+
+	trunkPrefix := strings.TrimSpace(
+		telephoneNumSpec.TrunkPrefix)
+
+	offset := 0
+
+	if !isInternational &&
+		len(countryTelCode) > 0 {
+
+		intlPrefixes := []string{
+			strings.TrimSpace(telephoneNumSpec.InternationalPrefix),
+			strings.TrimSpace(callerIntlPrefix),
+		}
+
+		for i := 0; i < len(intlPrefixes); i++ {
+
+			if len(intlPrefixes[i]) == 0 {
+				continue
+			}
+
+			if strings.HasPrefix(digitsStr, intlPrefixes[i]) &&
+				strings.HasPrefix(
+					digitsStr[len(intlPrefixes[i]):],
+					countryTelCode) {
+
+				isInternational = true
+
+				offset = len(intlPrefixes[i])
+
+				break
+			}
+		}
+	}
+
+	subNumMin := subNumMinInternal
+	subNumMax := subNumMaxInternal
+	mobileNumMin := mobileNumMinInternal
+	mobileNumMax := mobileNumMaxInternal
+	phoneExtMin := phoneExtMinInternal
+	phoneExtMax := phoneExtMaxInternal
+
+	if isInternational {
+
+		subNumMin = subNumMinExternal
+		subNumMax = subNumMaxExternal
+		mobileNumMin = mobileNumMinExternal
+		mobileNumMax = mobileNumMaxExternal
+		phoneExtMin = phoneExtMinExternal
+		phoneExtMax = phoneExtMaxExternal
+	}
+
+	isDigitCountInRange := func(
+		numOfDigits, minDigits, maxDigits int) bool {
+
+		if minDigits >= 0 &&
+			numOfDigits < minDigits {
+
+			return false
+		}
+
+		if maxDigits >= 0 &&
+			numOfDigits > maxDigits {
+
+			return false
+		}
+
+		return true
+	}
+
+	isSubscriberLenValid := func(numOfDigits int) bool {
+
+		if numOfDigits < 1 {
+			return false
+		}
+
+		return isDigitCountInRange(
+			numOfDigits,
+			subNumMin,
+			subNumMax) ||
+			isDigitCountInRange(
+				numOfDigits,
+				mobileNumMin,
+				mobileNumMax)
+	}
+
+	if isInternational {
+
+		if len(countryTelCode) == 0 {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'telephoneNumSpec' is invalid!\n"+
+				"'CountryTelephoneCode' is empty. International telephone\n"+
+				"numbers cannot be parsed.\n",
+				ePrefix.String())
+
+			return err
+		}
+
+		if !strings.HasPrefix(digitsStr[offset:], countryTelCode) {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'rawTelephoneNum' is invalid!\n"+
+				"The telephone number does not begin with the Country\n"+
+				"Telephone Code for %v.\n"+
+				"CountryTelephoneCode = '%v'\n"+
+				"rawTelephoneNum = '%v'\n",
+				ePrefix.String(),
+				telephoneNumSpec.CountryName,
+				countryTelCode,
+				rawTelephoneNum)
+
+			return err
+		}
+
+		offset += len(countryTelCode)
+
+		// Remove a Trunk Prefix enclosed in parentheses.
+		// Example: "+44 (0)20 7946 0958"
+		if len(trunkPrefix) > 0 {
+
+			for i := 0; i < len(groupStarts); i++ {
+
+				if groupStarts[i] == offset &&
+					groupInParens[i] &&
+					digitsStr[groupStarts[i]:groupEnds[i]] == trunkPrefix {
+
+					offset = groupEnds[i]
+
+					break
+				}
+			}
+		}
+
+	} else if len(trunkPrefix) > 0 &&
+		strings.HasPrefix(digitsStr, trunkPrefix) {
+
+		minSubscriberDigits := subNumMin
+
+		if mobileNumMin >= 0 &&
+			(minSubscriberDigits < 0 ||
+				mobileNumMin < minSubscriberDigits) {
+
+			minSubscriberDigits = mobileNumMin
+		}
+
+		if minSubscriberDigits < 0 {
+			minSubscriberDigits = 0
+		}
+
+		if len(digitsStr)-len(trunkPrefix) >=
+			areaCodeMin+minSubscriberDigits {
+
+			offset = len(trunkPrefix)
+		}
+	}
+
+	natSignificantNum := digitsStr[offset:]
+
+	lenNatSignificantNum := len(natSignificantNum)
+
+	// Mobile numbers identified by a mobile prefix are
+	// dialed without an area code.
+	isMobileNum := false
+
+	if isDigitCountInRange(
+		lenNatSignificantNum,
+		mobileNumMin,
+		mobileNumMax) {
+
+		mobileNumPrefixes := strings.Split(
+			telephoneNumSpec.MobileNumPrefixes,
+			",")
+
+		for i := 0; i < len(mobileNumPrefixes); i++ {
+
+			mobileNumPrefix := strings.TrimSpace(
+				mobileNumPrefixes[i])
+
+			if len(mobileNumPrefix) > 0 &&
+				strings.HasPrefix(
+					natSignificantNum,
+					mobileNumPrefix) {
+
+				isMobileNum = true
+
+				break
+			}
+		}
+	}
+
+	areaCodeLen := -1
+
+	if areaCodeMax == 0 {
+
+		areaCodeLen = 0
+
+	} else if areaCodeMin == areaCodeMax {
+
+		areaCodeLen = areaCodeMax
+
+		if isMobileNum {
+			areaCodeLen = 0
+		}
+
+	} else {
+
+		// Variable length area code. Use the first group
+		// of digits if its length is valid.
+		for i := 0; i < len(groupStarts); i++ {
+
+			if groupStarts[i] <= offset &&
+				offset < groupEnds[i] {
+
+				groupLen := groupEnds[i] - offset
+
+				if groupLen >= areaCodeMin &&
+					groupLen <= areaCodeMax &&
+					isSubscriberLenValid(
+						lenNatSignificantNum-groupLen) {
+
+					areaCodeLen = groupLen
+				}
+
+				break
+			}
+		}
+
+		// A digit group which forms a valid area code
+		// takes precedence over a mobile prefix.
+		if areaCodeLen < 0 &&
+			isMobileNum {
+
+			areaCodeLen = 0
+		}
+
+		for i := areaCodeMin; areaCodeLen < 0 && i <= areaCodeMax; i++ {
+
+			if isSubscriberLenValid(lenNatSignificantNum - i) {
+
+				areaCodeLen = i
+			}
+		}
+	}
+
+	if areaCodeLen < 0 ||
+		areaCodeLen >= lenNatSignificantNum ||
+		!isSubscriberLenValid(lenNatSignificantNum-areaCodeLen) {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'rawTelephoneNum' is invalid!\n"+
+			"The number of telephone number digits is invalid for %v.\n"+
+			"rawTelephoneNum = '%v'\n"+
+			"National Significant Number = '%v'\n",
+			ePrefix.String(),
+			telephoneNumSpec.CountryName,
+			rawTelephoneNum,
+			natSignificantNum)
+
+		return err
+	}
+
+	if len(extensionDigits) > 0 {
+
+		if phoneExtMax == 0 {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'rawTelephoneNum' is invalid!\n"+
+				"Telephone number extensions are not supported for %v.\n"+
+				"rawTelephoneNum = '%v'\n",
+				ePrefix.String(),
+				telephoneNumSpec.CountryName,
+				rawTelephoneNum)
+
+			return err
+		}
+
+		if !isDigitCountInRange(
+			len(extensionDigits),
+			phoneExtMin,
+			phoneExtMax) {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'rawTelephoneNum' is invalid!\n"+
+				"The number of extension digits is invalid for %v.\n"+
+				"rawTelephoneNum = '%v'\n"+
+				"Extension = '%v'\n",
+				ePrefix.String(),
+				telephoneNumSpec.CountryName,
+				rawTelephoneNum,
+				string(extensionDigits))
+
+			return err
+		}
+	}
+
+	err = new(numStrFmtCountryTelephoneNumSpecElectron).copy(
+		&telephoneNumDto.telephoneNumSpec,
+		telephoneNumSpec,
+		ePrefix.XCpy(
+			"telephoneNumDto.telephoneNumSpec<-telephoneNumSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	telephoneNumDto.CountryName = telephoneNumSpec.CountryName
+
+	telephoneNumDto.CountryCodeTwoChar =
+		telephoneNumSpec.CountryCodeTwoChar
+
+	telephoneNumDto.CountryTelephoneCode = countryTelCode
+
+	telephoneNumDto.AreaCode =
+		natSignificantNum[:areaCodeLen]
+
+	telephoneNumDto.SubscriberNumber =
+		natSignificantNum[areaCodeLen:]
+
+	telephoneNumDto.Extension = string(extensionDigits)
+
+	return err
+}
@@ -0,0 +1,656 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
+
+// numStrFmtCountryTelephoneNumSpecElectron
+//
+// Provides helper methods for type
+// NumStrFmtCountryTelephoneNumSpec.
+type numStrFmtCountryTelephoneNumSpecElectron struct {
+	lock *sync.Mutex
+}
+
+// copy
+//
+// Copies all data from a source instance of
+// NumStrFmtCountryTelephoneNumSpec to a destination
+// instance of NumStrFmtCountryTelephoneNumSpec.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// All pre-existing data values in the destination
+// instance, 'destinationSpec', will be deleted and
+// overwritten.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	destinationSpec				*NumStrFmtCountryTelephoneNumSpec
+//
+//		A pointer to an instance of
+//		NumStrFmtCountryTelephoneNumSpec. All data values
+//		in this instance will be overwritten with those
+//		of 'sourceSpec'.
+//
+//	sourceSpec					*NumStrFmtCountryTelephoneNumSpec
+//
+//		A pointer to an instance of
+//		NumStrFmtCountryTelephoneNumSpec. All data values
+//		in this instance will be copied to
+//		'destinationSpec'.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrFmtTelNumElectron *numStrFmtCountryTelephoneNumSpecElectron) copy(
+	destinationSpec *NumStrFmtCountryTelephoneNumSpec,
+	sourceSpec *NumStrFmtCountryTelephoneNumSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrFmtTelNumElectron.lock == nil {
+		nStrFmtTelNumElectron.lock = new(sync.Mutex)
+	}
+
+	nStrFmtTelNumElectron.lock.Lock()
+
+	defer nStrFmtTelNumElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrFmtCountryTelephoneNumSpecElectron."+
+			"copy()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if destinationSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'destinationSpec' is invalid!\n"+
+			"'destinationSpec' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if sourceSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sourceSpec' is invalid!\n"+
+			"'sourceSpec' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	destinationSpec.CountryName =
+		sourceSpec.CountryName
+
+	destinationSpec.CountryCodeTwoChar =
+		sourceSpec.CountryCodeTwoChar
+
+	destinationSpec.CountryCodeThreeChar =
+		sourceSpec.CountryCodeThreeChar
+
+	destinationSpec.InternationalDirectDialingNo =
+		sourceSpec.InternationalDirectDialingNo
+
+	destinationSpec.InternationalPrefix =
+		sourceSpec.InternationalPrefix
+
+	destinationSpec.TrunkPrefix =
+		sourceSpec.TrunkPrefix
+
+	destinationSpec.CountryTelephoneCode =
+		sourceSpec.CountryTelephoneCode
+
+	destinationSpec.AreaCodeMaxNumOfDigits =
+		sourceSpec.AreaCodeMaxNumOfDigits
+
+	destinationSpec.AreaCodeMinNumOfDigits =
+		sourceSpec.AreaCodeMinNumOfDigits
+
+	destinationSpec.SubscriberNumMaxNumOfDigitsExternal =
+		sourceSpec.SubscriberNumMaxNumOfDigitsExternal
+
+	destinationSpec.SubscriberNumMinNumOfDigitsExternal =
+		sourceSpec.SubscriberNumMinNumOfDigitsExternal
+
+	destinationSpec.SubscriberNumMaxNumOfDigitsInternal =
+		sourceSpec.SubscriberNumMaxNumOfDigitsInternal
+
+	destinationSpec.SubscriberNumMinNumOfDigitsInternal =
+		sourceSpec.SubscriberNumMinNumOfDigitsInternal
+
+	destinationSpec.MobileNumMaxNumOfDigitsExternal =
+		sourceSpec.MobileNumMaxNumOfDigitsExternal
+
+	destinationSpec.MobileNumMinNumOfDigitsExternal =
+		sourceSpec.MobileNumMinNumOfDigitsExternal
+
+	destinationSpec.MobileNumMaxNumOfDigitsInternal =
+		sourceSpec.MobileNumMaxNumOfDigitsInternal
+
+	destinationSpec.MobileNumMinNumOfDigitsInternal =
+		sourceSpec.MobileNumMinNumOfDigitsInternal
+
+	destinationSpec.MobileNumPrefixes =
+		sourceSpec.MobileNumPrefixes
+
+	destinationSpec.PhoneExtNumMaxNumOfDigitsExternal =
+		sourceSpec.PhoneExtNumMaxNumOfDigitsExternal
+
+	destinationSpec.PhoneExtNumMinNumOfDigitsExternal =
+		sourceSpec.PhoneExtNumMinNumOfDigitsExternal
+
+	destinationSpec.PhoneExtNumMaxNumOfDigitsInternal =
+		sourceSpec.PhoneExtNumMaxNumOfDigitsInternal
+
+	destinationSpec.PhoneExtNumMinNumOfDigitsInternal =
+		sourceSpec.PhoneExtNumMinNumOfDigitsInternal
+
+	nStrFmtTelNumQuark := numStrFmtCountryTelephoneNumSpecQuark{}
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&destinationSpec.SubscriberFmtFullExternal,
+		&sourceSpec.SubscriberFmtFullExternal)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&destinationSpec.SubscriberFmtAbbrExternal,
+		&sourceSpec.SubscriberFmtAbbrExternal)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&destinationSpec.SubscriberFmtFullInternal,
+		&sourceSpec.SubscriberFmtFullInternal)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&destinationSpec.SubscriberFmtAbbrInternal,
+		&sourceSpec.SubscriberFmtAbbrInternal)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&destinationSpec.MobileFmtFullExternal,
+		&sourceSpec.MobileFmtFullExternal)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&destinationSpec.MobileFmtAbbrExternal,
+		&sourceSpec.MobileFmtAbbrExternal)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&destinationSpec.MobileFmtFullInternal,
+		&sourceSpec.MobileFmtFullInternal)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&destinationSpec.MobileFmtAbbrInternal,
+		&sourceSpec.MobileFmtAbbrInternal)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&destinationSpec.PhoneExtFmtFullExternal,
+		&sourceSpec.PhoneExtFmtFullExternal)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&destinationSpec.PhoneExtFmtAbbrExternal,
+		&sourceSpec.PhoneExtFmtAbbrExternal)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&destinationSpec.PhoneExtFmtFullInternal,
+		&sourceSpec.PhoneExtFmtFullInternal)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&destinationSpec.PhoneExtFmtAbbrInternal,
+		&sourceSpec.PhoneExtFmtAbbrInternal)
+	return err
+}
+
+// empty
+//
+// Receives a pointer to an instance of
+// NumStrFmtCountryTelephoneNumSpec and proceeds to reset
+// all member variable data values to their zero or
+// uninitialized states.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// All pre-existing data values in 'telephoneNumSpec'
+// will be deleted.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	telephoneNumSpec			*NumStrFmtCountryTelephoneNumSpec
+//
+//		A pointer to an instance of
+//		NumStrFmtCountryTelephoneNumSpec. All data values
+//		in this instance will be reset to their zero
+//		values.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NONE
+func (nStrFmtTelNumElectron *numStrFmtCountryTelephoneNumSpecElectron) empty(
+	telephoneNumSpec *NumStrFmtCountryTelephoneNumSpec) {
+
+	if nStrFmtTelNumElectron.lock == nil {
+		nStrFmtTelNumElectron.lock = new(sync.Mutex)
+	}
+
+	nStrFmtTelNumElectron.lock.Lock()
+
+	defer nStrFmtTelNumElectron.lock.Unlock()
+
+	if telephoneNumSpec == nil {
+		return
+	}
+
+	telephoneNumSpec.CountryName = ""
+
+	telephoneNumSpec.CountryCodeTwoChar = ""
+
+	telephoneNumSpec.CountryCodeThreeChar = ""
+
+	telephoneNumSpec.InternationalDirectDialingNo = ""
+
+	telephoneNumSpec.InternationalPrefix = ""
+
+	telephoneNumSpec.TrunkPrefix = ""
+
+	telephoneNumSpec.CountryTelephoneCode = ""
+
+	telephoneNumSpec.AreaCodeMaxNumOfDigits = ""
+
+	telephoneNumSpec.AreaCodeMinNumOfDigits = ""
+
+	telephoneNumSpec.SubscriberNumMaxNumOfDigitsExternal = ""
+
+	telephoneNumSpec.SubscriberNumMinNumOfDigitsExternal = ""
+
+	telephoneNumSpec.SubscriberNumMaxNumOfDigitsInternal = ""
+
+	telephoneNumSpec.SubscriberNumMinNumOfDigitsInternal = ""
+
+	telephoneNumSpec.MobileNumMaxNumOfDigitsExternal = ""
+
+	telephoneNumSpec.MobileNumMinNumOfDigitsExternal = ""
+
+	telephoneNumSpec.MobileNumMaxNumOfDigitsInternal = ""
+
+	telephoneNumSpec.MobileNumMinNumOfDigitsInternal = ""
+
+	telephoneNumSpec.MobileNumPrefixes = ""
+
+	telephoneNumSpec.PhoneExtNumMaxNumOfDigitsExternal = ""
+
+	telephoneNumSpec.PhoneExtNumMinNumOfDigitsExternal = ""
+
+	telephoneNumSpec.PhoneExtNumMaxNumOfDigitsInternal = ""
+
+	telephoneNumSpec.PhoneExtNumMinNumOfDigitsInternal = ""
+
+	emptyTelNumSpec := NumStrFmtTelephoneNumSpec{}
+
+	nStrFmtTelNumQuark := numStrFmtCountryTelephoneNumSpecQuark{}
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.SubscriberFmtFullExternal,
+		&emptyTelNumSpec)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.SubscriberFmtAbbrExternal,
+		&emptyTelNumSpec)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.SubscriberFmtFullInternal,
+		&emptyTelNumSpec)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.SubscriberFmtAbbrInternal,
+		&emptyTelNumSpec)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.MobileFmtFullExternal,
+		&emptyTelNumSpec)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.MobileFmtAbbrExternal,
+		&emptyTelNumSpec)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.MobileFmtFullInternal,
+		&emptyTelNumSpec)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.MobileFmtAbbrInternal,
+		&emptyTelNumSpec)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.PhoneExtFmtFullExternal,
+		&emptyTelNumSpec)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.PhoneExtFmtAbbrExternal,
+		&emptyTelNumSpec)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.PhoneExtFmtFullInternal,
+		&emptyTelNumSpec)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.PhoneExtFmtAbbrInternal,
+		&emptyTelNumSpec)
+}
+
+// setFromCatalogEntry
+//
+// Configures an instance of
+// NumStrFmtCountryTelephoneNumSpec using the telephone
+// numbering plan data contained in a Telephone Catalog
+// entry.
+//
+// The same digit counts are applied to both the
+// 'External' and 'Internal' member variables. Subscriber
+// and Mobile Number formats are configured identically.
+// The Phone Extension formats are left empty. As a
+// result, extension digits are presented unformatted.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// All pre-existing data values in 'telephoneNumSpec'
+// will be deleted and overwritten.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	telephoneNumSpec			*NumStrFmtCountryTelephoneNumSpec
+//
+//		A pointer to an instance of
+//		NumStrFmtCountryTelephoneNumSpec. All data values
+//		in this instance will be overwritten with data
+//		generated from 'catalogEntry'.
+//
+//	catalogEntry				numStrTelephoneCatalogEntry
+//
+//		A Telephone Catalog entry containing the
+//		numbering plan data for a single country.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrFmtTelNumElectron *numStrFmtCountryTelephoneNumSpecElectron) setFromCatalogEntry(
+	telephoneNumSpec *NumStrFmtCountryTelephoneNumSpec,
+	catalogEntry numStrTelephoneCatalogEntry,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrFmtTelNumElectron.lock == nil {
+		nStrFmtTelNumElectron.lock = new(sync.Mutex)
+	}
+
+	nStrFmtTelNumElectron.lock.Lock()
+
+	defer nStrFmtTelNumElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrFmtCountryTelephoneNumSpecElectron."+
+			"setFromCatalogEntry()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if telephoneNumSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'telephoneNumSpec' is invalid!\n"+
+			"'telephoneNumSpec' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	telephoneNumSpec.CountryName = catalogEntry.countryName
+	telephoneNumSpec.CountryCodeTwoChar = catalogEntry.countryCodeTwoChar
+	telephoneNumSpec.CountryCodeThreeChar = catalogEntry.countryCodeThreeChar
+	telephoneNumSpec.InternationalDirectDialingNo = catalogEntry.internationalPrefix
+	telephoneNumSpec.InternationalPrefix = catalogEntry.internationalPrefix
+	telephoneNumSpec.TrunkPrefix = catalogEntry.trunkPrefix
+	telephoneNumSpec.CountryTelephoneCode = catalogEntry.countryTelephoneCode
+	telephoneNumSpec.AreaCodeMaxNumOfDigits = catalogEntry.areaCodeMaxNumOfDigits
+	telephoneNumSpec.AreaCodeMinNumOfDigits = catalogEntry.areaCodeMinNumOfDigits
+
+	telephoneNumSpec.SubscriberNumMaxNumOfDigitsExternal =
+		catalogEntry.subscriberNumMaxNumOfDigits
+	telephoneNumSpec.SubscriberNumMinNumOfDigitsExternal =
+		catalogEntry.subscriberNumMinNumOfDigits
+	telephoneNumSpec.SubscriberNumMaxNumOfDigitsInternal =
+		catalogEntry.subscriberNumMaxNumOfDigits
+	telephoneNumSpec.SubscriberNumMinNumOfDigitsInternal =
+		catalogEntry.subscriberNumMinNumOfDigits
+
+	telephoneNumSpec.MobileNumMaxNumOfDigitsExternal =
+		catalogEntry.mobileNumMaxNumOfDigits
+	telephoneNumSpec.MobileNumMinNumOfDigitsExternal =
+		catalogEntry.mobileNumMinNumOfDigits
+	telephoneNumSpec.MobileNumMaxNumOfDigitsInternal =
+		catalogEntry.mobileNumMaxNumOfDigits
+	telephoneNumSpec.MobileNumMinNumOfDigitsInternal =
+		catalogEntry.mobileNumMinNumOfDigits
+
+	telephoneNumSpec.MobileNumPrefixes =
+		catalogEntry.mobileNumPrefixes
+
+	telephoneNumSpec.PhoneExtNumMaxNumOfDigitsExternal =
+		catalogEntry.phoneExtNumMaxNumOfDigits
+	telephoneNumSpec.PhoneExtNumMinNumOfDigitsExternal =
+		catalogEntry.phoneExtNumMinNumOfDigits
+	telephoneNumSpec.PhoneExtNumMaxNumOfDigitsInternal =
+		catalogEntry.phoneExtNumMaxNumOfDigits
+	telephoneNumSpec.PhoneExtNumMinNumOfDigitsInternal =
+		catalogEntry.phoneExtNumMinNumOfDigits
+
+	fullExternal := NumStrFmtTelephoneNumSpec{
+		PhoneNoDialFmt: NumStrFmtCharReplacementSpec{
+			NumberFormat: catalogEntry.countryTelephoneCode +
+				catalogEntry.dialFmt,
+			NumReplacementChar: 'N',
+		},
+		PhoneNoDisplayFmt: NumStrFmtCharReplacementSpec{
+			NumberFormat:       catalogEntry.fullDisplayFmt,
+			NumReplacementChar: 'N',
+		},
+	}
+
+	abbrExternal := NumStrFmtTelephoneNumSpec{
+		PhoneNoDialFmt: NumStrFmtCharReplacementSpec{
+			NumberFormat: catalogEntry.countryTelephoneCode +
+				catalogEntry.dialFmt,
+			NumReplacementChar: 'N',
+		},
+		PhoneNoDisplayFmt: NumStrFmtCharReplacementSpec{
+			NumberFormat:       catalogEntry.abbrDisplayFmt,
+			NumReplacementChar: 'N',
+		},
+	}
+
+	fullInternal := NumStrFmtTelephoneNumSpec{
+		PhoneNoDialFmt: NumStrFmtCharReplacementSpec{
+			NumberFormat: catalogEntry.trunkPrefix +
+				catalogEntry.dialFmt,
+			NumReplacementChar: 'N',
+		},
+		PhoneNoDisplayFmt: NumStrFmtCharReplacementSpec{
+			NumberFormat:       catalogEntry.fullDisplayFmt,
+			NumReplacementChar: 'N',
+		},
+	}
+
+	abbrInternal := NumStrFmtTelephoneNumSpec{
+		PhoneNoDialFmt: NumStrFmtCharReplacementSpec{
+			NumberFormat: catalogEntry.trunkPrefix +
+				catalogEntry.dialFmt,
+			NumReplacementChar: 'N',
+		},
+		PhoneNoDisplayFmt: NumStrFmtCharReplacementSpec{
+			NumberFormat:       catalogEntry.abbrDisplayFmt,
+			NumReplacementChar: 'N',
+		},
+	}
+
+	phoneExt := NumStrFmtTelephoneNumSpec{
+		PhoneNoDialFmt: NumStrFmtCharReplacementSpec{
+			NumReplacementChar: 'N',
+		},
+		PhoneNoDisplayFmt: NumStrFmtCharReplacementSpec{
+			NumReplacementChar: 'N',
+		},
+	}
+
+	nStrFmtTelNumQuark := numStrFmtCountryTelephoneNumSpecQuark{}
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.SubscriberFmtFullExternal,
+		&fullExternal)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.SubscriberFmtAbbrExternal,
+		&abbrExternal)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.SubscriberFmtFullInternal,
+		&fullInternal)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.SubscriberFmtAbbrInternal,
+		&abbrInternal)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.MobileFmtFullExternal,
+		&fullExternal)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.MobileFmtAbbrExternal,
+		&abbrExternal)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.MobileFmtFullInternal,
+		&fullInternal)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.MobileFmtAbbrInternal,
+		&abbrInternal)
+
+	if len(catalogEntry.mobileDisplayFmt) > 0 {
+
+		telephoneNumSpec.MobileFmtFullExternal.
+			PhoneNoDisplayFmt.NumberFormat =
+			catalogEntry.mobileDisplayFmt
+
+		telephoneNumSpec.MobileFmtAbbrExternal.
+			PhoneNoDisplayFmt.NumberFormat =
+			catalogEntry.mobileDisplayFmt
+
+		telephoneNumSpec.MobileFmtFullInternal.
+			PhoneNoDisplayFmt.NumberFormat =
+			catalogEntry.mobileDisplayFmt
+
+		telephoneNumSpec.MobileFmtAbbrInternal.
+			PhoneNoDisplayFmt.NumberFormat =
+			catalogEntry.mobileDisplayFmt
+	}
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.PhoneExtFmtFullExternal,
+		&phoneExt)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.PhoneExtFmtAbbrExternal,
+		&phoneExt)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.PhoneExtFmtFullInternal,
+		&phoneExt)
+
+	nStrFmtTelNumQuark.copyTelephoneNumSpec(
+		&telephoneNumSpec.PhoneExtFmtAbbrInternal,
+		&phoneExt)
+
+	return err
+}
@@ -0,0 +1,432 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"strings"
+	"sync"
+)
+
+// numStrFmtCountryTelephoneNumSpecNanobot
+//
+// Provides helper methods for type
+// NumStrFmtCountryTelephoneNumSpec.
+type numStrFmtCountryTelephoneNumSpecNanobot struct {
+	lock *sync.Mutex
+}
+
+// parseTelephoneNumber
+//
+// Parses a raw telephone number string and stores the
+// resulting telephone number components in an instance
+// of TelephoneNumberDto.
+//
+// If the raw telephone number begins with a plus sign
+// ('+'), or with the International Prefix of the default
+// country, the country is identified from the Country
+// Telephone Code contained in the raw telephone number.
+// Countries sharing a Country Telephone Code are
+// resolved in favor of the default country. Otherwise,
+// the primary country for that Country Telephone Code is
+// selected.
+//
+// All other telephone numbers are parsed as national
+// numbers of the default country.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// All pre-existing data values in 'telephoneNumDto' will
+// be deleted and overwritten.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	telephoneNumDto				*TelephoneNumberDto
+//
+//		A pointer to an instance of TelephoneNumberDto.
+//		The components of the parsed telephone number will
+//		be stored in this instance.
+//
+//	rawTelephoneNum				string
+//
+//		The raw telephone number string to be parsed.
+//
+//			Examples:
+//				"+44 20 7946 0958"
+//				"(212) 555-0100 x42"
+//
+//	defaultCountryCode			string
+//
+//		The ISO 3166-1 alpha-2 or alpha-3 country code of
+//		the default country. National telephone numbers
+//		are parsed using the numbering plan of the
+//		default country.
+//
+//		If 'rawTelephoneNum' is a national number and
+//		this parameter is empty, an error will be
+//		returned. If this parameter is not empty and
+//		specifies a country which is not included in the
+//		Telephone Catalog, an error will be returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrFmtTelNumNanobot *numStrFmtCountryTelephoneNumSpecNanobot) parseTelephoneNumber(
+	telephoneNumDto *TelephoneNumberDto,
+	rawTelephoneNum string,
+	defaultCountryCode string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrFmtTelNumNanobot.lock == nil {
+		nStrFmtTelNumNanobot.lock = new(sync.Mutex)
+	}
+
+	nStrFmtTelNumNanobot.lock.Lock()
+
+	defer nStrFmtTelNumNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrFmtCountryTelephoneNumSpecNanobot."+
+			"parseTelephoneNumber()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if telephoneNumDto == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'telephoneNumDto' is invalid!\n"+
+			"'telephoneNumDto' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	nStrFmtTelNumQuark := numStrFmtCountryTelephoneNumSpecQuark{}
+
+	var defaultEntry numStrTelephoneCatalogEntry
+	var isDefaultFound bool
+
+	defaultCountryCode = strings.TrimSpace(defaultCountryCode)
+
+	if len(defaultCountryCode) > 0 {
+
+		defaultEntry,
+			isDefaultFound = nStrFmtTelNumQuark.findCatalogEntry(
+			defaultCountryCode)
+
+		if !isDefaultFound {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'defaultCountryCode' is invalid!\n"+
+				"'defaultCountryCode' was not found in the Telephone Catalog.\n"+
+				"defaultCountryCode = '%v'\n",
+				ePrefix.String(),
+				defaultCountryCode)
+
+			return err
+		}
+	}
+
+	telNumRunes,
+		_ := nStrFmtTelNumQuark.splitTelephoneNumStr(rawTelephoneNum)
+
+	isInternational := len(telNumRunes) > 0 &&
+		telNumRunes[0] == '+'
+
+	var digits []rune
+
+	for i := 0; i < len(telNumRunes); i++ {
+
+		if telNumRunes[i] >= '0' &&
+			telNumRunes[i] <= '9' {
+
+			digits = append(digits, telNumRunes[i])
+		}
+	}
+
+	digitsStr := string(digits)
+
+	callerIntlPrefix := ""
+
+	if isDefaultFound {
+
+		callerIntlPrefix = defaultEntry.internationalPrefix
+
+		if !isInternational &&
+			len(callerIntlPrefix) > 0 &&
+			strings.HasPrefix(digitsStr, callerIntlPrefix) {
+
+			isInternational = true
+
+			digitsStr = digitsStr[len(callerIntlPrefix):]
+		}
+	}
+
+	var telephoneNumSpec NumStrFmtCountryTelephoneNumSpec
+
+	nStrFmtTelNumElectron := numStrFmtCountryTelephoneNumSpecElectron{}
+
+	if isInternational {
+
+		var countryEntry numStrTelephoneCatalogEntry
+		isCountryFound := false
+
+		for i := 0; i < len(numStrTelephoneCatalog); i++ {
+
+			telCode := numStrTelephoneCatalog[i].countryTelephoneCode
+
+			if !strings.HasPrefix(digitsStr, telCode) {
+				continue
+			}
+
+			if !isCountryFound ||
+				len(telCode) > len(countryEntry.countryTelephoneCode) {
+
+				countryEntry = numStrTelephoneCatalog[i]
+
+				isCountryFound = true
+
+				continue
+			}
+
+			if len(telCode) < len(countryEntry.countryTelephoneCode) {
+				continue
+			}
+
+			// Same Country Telephone Code
+			if isDefaultFound &&
+				countryEntry.countryCodeTwoChar ==
+					defaultEntry.countryCodeTwoChar {
+
+				continue
+			}
+
+			if (isDefaultFound &&
+				numStrTelephoneCatalog[i].countryCodeTwoChar ==
+					defaultEntry.countryCodeTwoChar) ||
+				(numStrTelephoneCatalog[i].isTelephoneCodeDefault &&
+					!countryEntry.isTelephoneCodeDefault) {
+
+				countryEntry = numStrTelephoneCatalog[i]
+			}
+		}
+
+		if !isCountryFound {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'rawTelephoneNum' is invalid!\n"+
+				"The Country Telephone Code was not found in the\n"+
+				"Telephone Catalog.\n"+
+				"rawTelephoneNum = '%v'\n",
+				ePrefix.String(),
+				rawTelephoneNum)
+
+			return err
+		}
+
+		err = nStrFmtTelNumElectron.setFromCatalogEntry(
+			&telephoneNumSpec,
+			countryEntry,
+			ePrefix.XCpy(
+				"telephoneNumSpec<-countryEntry"))
+
+	} else {
+
+		if !isDefaultFound {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'defaultCountryCode' is invalid!\n"+
+				"'rawTelephoneNum' is a national telephone number and\n"+
+				"'defaultCountryCode' is empty.\n"+
+				"rawTelephoneNum = '%v'\n",
+				ePrefix.String(),
+				rawTelephoneNum)
+
+			return err
+		}
+
+		err = nStrFmtTelNumElectron.setFromCatalogEntry(
+			&telephoneNumSpec,
+			defaultEntry,
+			ePrefix.XCpy(
+				"telephoneNumSpec<-defaultEntry"))
+
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrFmtCountryTelephoneNumSpecAtom).
+		parseTelephoneNumber(
+			telephoneNumDto,
+			&telephoneNumSpec,
+			rawTelephoneNum,
+			callerIntlPrefix,
+			ePrefix.XCpy(
+				"telephoneNumDto<-rawTelephoneNum"))
+}
+
+// setCountryCode
+//
+// Configures an instance of
+// NumStrFmtCountryTelephoneNumSpec with the telephone
+// numbering plan data for the country identified by
+// input parameter 'countryCode'.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// All pre-existing data values in 'telephoneNumSpec'
+// will be deleted and overwritten.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	telephoneNumSpec			*NumStrFmtCountryTelephoneNumSpec
+//
+//		A pointer to an instance of
+//		NumStrFmtCountryTelephoneNumSpec. All data values
+//		in this instance will be overwritten with the
+//		telephone numbering plan data for the country
+//		identified by 'countryCode'.
+//
+//	countryCode					string
+//
+//		The ISO 3166-1 alpha-2 ("GB") or alpha-3 ("GBR")
+//		country code identifying the country. This
+//		parameter is not case sensitive.
+//
+//		If 'countryCode' is not found in the Telephone
+//		Catalog, an error will be returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrFmtTelNumNanobot *numStrFmtCountryTelephoneNumSpecNanobot) setCountryCode(
+	telephoneNumSpec *NumStrFmtCountryTelephoneNumSpec,
+	countryCode string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrFmtTelNumNanobot.lock == nil {
+		nStrFmtTelNumNanobot.lock = new(sync.Mutex)
+	}
+
+	nStrFmtTelNumNanobot.lock.Lock()
+
+	defer nStrFmtTelNumNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrFmtCountryTelephoneNumSpecNanobot."+
+			"setCountryCode()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if telephoneNumSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'telephoneNumSpec' is invalid!\n"+
+			"'telephoneNumSpec' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	catalogEntry,
+		isFound := new(numStrFmtCountryTelephoneNumSpecQuark).
+		findCatalogEntry(countryCode)
+
+	if !isFound {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'countryCode' is invalid!\n"+
+			"'countryCode' was not found in the Telephone Catalog.\n"+
+			"countryCode = '%v'\n",
+			ePrefix.String(),
+			countryCode)
+
+		return err
+	}
+
+	return new(numStrFmtCountryTelephoneNumSpecElectron).
+		setFromCatalogEntry(
+			telephoneNumSpec,
+			catalogEntry,
+			ePrefix.XCpy(
+				"telephoneNumSpec<-catalogEntry"))
+}
@@ -0,0 +1,350 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// numStrFmtCountryTelephoneNumSpecQuark
+//
+// Provides helper methods for type
+// NumStrFmtCountryTelephoneNumSpec.
+type numStrFmtCountryTelephoneNumSpecQuark struct {
+	lock *sync.Mutex
+}
+
+// copyTelephoneNumSpec
+//
+// Copies the dialing and display formats from a source
+// instance of NumStrFmtTelephoneNumSpec to a destination
+// instance of NumStrFmtTelephoneNumSpec.
+//
+// If either input parameter is a nil pointer, this
+// method takes no action.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	destinationTelNumSpec		*NumStrFmtTelephoneNumSpec
+//
+//		A pointer to an instance of
+//		NumStrFmtTelephoneNumSpec. The dialing and display
+//		formats in this instance will be overwritten with
+//		those of 'sourceTelNumSpec'.
+//
+//	sourceTelNumSpec			*NumStrFmtTelephoneNumSpec
+//
+//		A pointer to an instance of
+//		NumStrFmtTelephoneNumSpec. The dialing and display
+//		formats in this instance will be copied to
+//		'destinationTelNumSpec'.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NONE
+func (nStrFmtTelNumQuark *numStrFmtCountryTelephoneNumSpecQuark) copyTelephoneNumSpec(
+	destinationTelNumSpec *NumStrFmtTelephoneNumSpec,
+	sourceTelNumSpec *NumStrFmtTelephoneNumSpec) {
+
+	if nStrFmtTelNumQuark.lock == nil {
+		nStrFmtTelNumQuark.lock = new(sync.Mutex)
+	}
+
+	nStrFmtTelNumQuark.lock.Lock()
+
+	defer nStrFmtTelNumQuark.lock.Unlock()
+
+	if destinationTelNumSpec == nil ||
+		sourceTelNumSpec == nil {
+
+		return
+	}
+
+	destinationTelNumSpec.PhoneNoDialFmt.NumberFormat =
+		sourceTelNumSpec.PhoneNoDialFmt.NumberFormat
+
+	destinationTelNumSpec.PhoneNoDialFmt.NumReplacementChar =
+		sourceTelNumSpec.PhoneNoDialFmt.NumReplacementChar
+
+	destinationTelNumSpec.PhoneNoDisplayFmt.NumberFormat =
+		sourceTelNumSpec.PhoneNoDisplayFmt.NumberFormat
+
+	destinationTelNumSpec.PhoneNoDisplayFmt.NumReplacementChar =
+		sourceTelNumSpec.PhoneNoDisplayFmt.NumReplacementChar
+}
+
+// findCatalogEntry
+//
+// Searches the Telephone Catalog for an entry matching
+// the country code passed by input parameter
+// 'countryCode'.
+//
+// 'countryCode' may be submitted as an ISO 3166-1
+// alpha-2 Two Character code ("GB") or an ISO 3166-1
+// alpha-3 Three Character code ("GBR"). The search is
+// not case sensitive.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	countryCode					string
+//
+//		The ISO 3166-1 alpha-2 or alpha-3 country code
+//		identifying the catalog entry to be returned.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	catalogEntry				numStrTelephoneCatalogEntry
+//
+//		If a matching entry is found, this parameter will
+//		return the Telephone Catalog entry matching input
+//		parameter 'countryCode'.
+//
+//	isFound						bool
+//
+//		If a matching entry is found in the Telephone
+//		Catalog, this parameter is set to 'true'.
+func (nStrFmtTelNumQuark *numStrFmtCountryTelephoneNumSpecQuark) findCatalogEntry(
+	countryCode string) (
+	catalogEntry numStrTelephoneCatalogEntry,
+	isFound bool) {
+
+	if nStrFmtTelNumQuark.lock == nil {
+		nStrFmtTelNumQuark.lock = new(sync.Mutex)
+	}
+
+	nStrFmtTelNumQuark.lock.Lock()
+
+	defer nStrFmtTelNumQuark.lock.Unlock()
+
+	searchCode := strings.ToUpper(
+		strings.TrimSpace(countryCode))
+
+	if len(searchCode) == 0 {
+		return catalogEntry, isFound
+	}
+
+	for i := 0; i < len(numStrTelephoneCatalog); i++ {
+
+		if numStrTelephoneCatalog[i].countryCodeTwoChar == searchCode ||
+			numStrTelephoneCatalog[i].countryCodeThreeChar == searchCode {
+
+			catalogEntry = numStrTelephoneCatalog[i]
+
+			isFound = true
+
+			break
+		}
+	}
+
+	return catalogEntry, isFound
+}
+
+// getDigitLimit
+//
+// Converts a digit count stored as a string in an
+// instance of NumStrFmtCountryTelephoneNumSpec to an
+// integer value.
+//
+// An empty string signals that no limit has been
+// specified. In this case, a value of minus one (-1) is
+// returned.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	digitLimitStr				string
+//
+//		A string containing a digit count. Leading and
+//		trailing white space is ignored.
+//
+//		If this string contains a value which cannot be
+//		converted to an integer greater than or equal to
+//		zero, an error will be returned.
+//
+//	digitLimitName				string
+//
+//		The name of the member variable containing
+//		'digitLimitStr'. This name is used in error
+//		messages.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	digitLimit					int
+//
+//		The digit count converted to an integer. If
+//		'digitLimitStr' is empty, this value is set to
+//		minus one (-1).
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrFmtTelNumQuark *numStrFmtCountryTelephoneNumSpecQuark) getDigitLimit(
+	digitLimitStr string,
+	digitLimitName string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	digitLimit int,
+	err error) {
+
+	if nStrFmtTelNumQuark.lock == nil {
+		nStrFmtTelNumQuark.lock = new(sync.Mutex)
+	}
+
+	nStrFmtTelNumQuark.lock.Lock()
+
+	defer nStrFmtTelNumQuark.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrFmtCountryTelephoneNumSpecQuark."+
+			"getDigitLimit()",
+		"")
+
+	digitLimit = -1
+
+	if err != nil {
+		return digitLimit, err
+	}
+
+	digitLimitStr = strings.TrimSpace(digitLimitStr)
+
+	if len(digitLimitStr) == 0 {
+		return digitLimit, err
+	}
+
+	var convertedLimit int
+
+	convertedLimit,
+		err = strconv.Atoi(digitLimitStr)
+
+	if err != nil ||
+		convertedLimit < 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Telephone Number Specification '%v' is invalid!\n"+
+			"'%v' must be an integer greater than or equal to zero.\n"+
+			"%v = '%v'\n",
+			ePrefix.String(),
+			digitLimitName,
+			digitLimitName,
+			digitLimitName,
+			digitLimitStr)
+
+		return digitLimit, err
+	}
+
+	digitLimit = convertedLimit
+
+	return digitLimit, err
+}
+
+// splitTelephoneNumStr
+//
+// Splits a raw telephone number string into the
+// telephone number component and the extension
+// component.
+//
+// The extension component begins with the first letter,
+// hash ('#'), semicolon (';') or comma (',') character
+// found in the raw telephone number string.
+//
+//	Example:
+//		rawTelephoneNum	= "(212) 555-0100 x42"
+//		telNumRunes		= "(212) 555-0100 "
+//		extensionRunes	= "x42"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	rawTelephoneNum				string
+//
+//		The raw telephone number string to be split.
+//		Leading and trailing white space is removed
+//		before the string is split.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	telNumRunes					[]rune
+//
+//		The telephone number component of
+//		'rawTelephoneNum'.
+//
+//	extensionRunes				[]rune
+//
+//		The extension component of 'rawTelephoneNum'. If
+//		no extension is present, this array is empty.
+func (nStrFmtTelNumQuark *numStrFmtCountryTelephoneNumSpecQuark) splitTelephoneNumStr(
+	rawTelephoneNum string) (
+	telNumRunes []rune,
+	extensionRunes []rune) {
+
+	if nStrFmtTelNumQuark.lock == nil {
+		nStrFmtTelNumQuark.lock = new(sync.Mutex)
+	}
+
+	nStrFmtTelNumQuark.lock.Lock()
+
+	defer nStrFmtTelNumQuark.lock.Unlock()
+
+	telNumRunes = []rune(
+		strings.TrimSpace(rawTelephoneNum))
+
+	for i := 0; i < len(telNumRunes); i++ {
+
+		if unicode.IsLetter(telNumRunes[i]) ||
+			telNumRunes[i] == '#' ||
+			telNumRunes[i] == ';' ||
+			telNumRunes[i] == ',' {
+
+			extensionRunes = telNumRunes[i:]
+
+			telNumRunes = telNumRunes[:i]
+
+			break
+		}
+	}
+
+	return telNumRunes, extensionRunes
+}
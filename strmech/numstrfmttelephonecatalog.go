@@ -0,0 +1,553 @@
+package strmech
+
+// numStrTelephoneCatalogEntry
+//
+// Contains the telephone numbering plan data for a
+// single country included in the Telephone Catalog.
+//
+// Each entry is used to generate an instance of
+// NumStrFmtCountryTelephoneNumSpec. Digit counts are
+// stored as strings in order to match the corresponding
+// member variables of NumStrFmtCountryTelephoneNumSpec.
+//
+// The display formats use the character 'N' as a
+// placeholder for numeric digits. If the number of area
+// code digits is fixed ('areaCodeMinNumOfDigits' equals
+// 'areaCodeMaxNumOfDigits'), the display formats cover
+// both the area code and the subscriber number. If the
+// number of area code digits varies, the display formats
+// cover only the subscriber number.
+type numStrTelephoneCatalogEntry struct {
+	countryName string
+	//	The name of the country.
+
+	countryCodeTwoChar string
+	//	The ISO 3166-1 alpha-2 Two Character country code.
+
+	countryCodeThreeChar string
+	//	The ISO 3166-1 alpha-3 Three Character country code.
+
+	internationalPrefix string
+	//	The prefix dialed within this country in order to
+	//	place an international call.
+
+	trunkPrefix string
+	//	The prefix dialed within this country in order to
+	//	place a national call outside the local area.
+	//	Countries with closed numbering plans do not use
+	//	a trunk prefix.
+
+	countryTelephoneCode string
+	//	The ITU-T E.164 country calling code.
+
+	areaCodeMinNumOfDigits string
+	//	The minimum number of area code digits.
+
+	areaCodeMaxNumOfDigits string
+	//	The maximum number of area code digits. Countries
+	//	with closed numbering plans do not use an area
+	//	code and specify a value of "0".
+
+	subscriberNumMinNumOfDigits string
+	//	The minimum number of subscriber number digits.
+
+	subscriberNumMaxNumOfDigits string
+	//	The maximum number of subscriber number digits.
+
+	mobileNumMinNumOfDigits string
+	//	The minimum number of mobile number digits
+	//	following the mobile prefix.
+
+	mobileNumMaxNumOfDigits string
+	//	The maximum number of mobile number digits
+	//	following the mobile prefix.
+
+	mobileNumPrefixes string
+	//	A comma delimited list of the leading digits
+	//	identifying mobile numbers which are dialed
+	//	without an area code. An empty string signals
+	//	that mobile numbers share the area code and
+	//	subscriber number layout of fixed line numbers.
+	//
+	//	Example India: "6,7,8,9"
+
+	phoneExtNumMinNumOfDigits string
+	//	The minimum number of extension number digits.
+
+	phoneExtNumMaxNumOfDigits string
+	//	The maximum number of extension number digits.
+
+	fullDisplayFmt string
+	//	The display format used for international
+	//	presentation. If the number of area code digits
+	//	is fixed, this format begins with the country
+	//	calling code.
+	//
+	//	Example US: "1 (NNN) NNN-NNNN"
+
+	abbrDisplayFmt string
+	//	The display format used for national
+	//	presentation. If the number of area code digits
+	//	is fixed, this format includes the trunk prefix
+	//	where one is customarily displayed.
+	//
+	//	Example US: "(NNN) NNN-NNNN"
+
+	mobileDisplayFmt string
+	//	The display format used for mobile numbers dialed
+	//	without an area code. An empty string signals
+	//	that mobile numbers use the full and abbreviated
+	//	display formats.
+	//
+	//	Example India: "NNNNN NNNNN"
+
+	dialFmt string
+	//	The dialing format for the National Significant
+	//	Number (area code plus subscriber number). The
+	//	country calling code or the trunk prefix is added
+	//	to this format when generating the external and
+	//	internal dialing formats.
+	//
+	//	Example US: "NNNNNNNNNN"
+
+	isTelephoneCodeDefault bool
+	//	When set to 'true', this entry is the default
+	//	entry for a country calling code shared by more
+	//	than one country. Example: "1" is shared by the
+	//	United States and Canada.
+}
+
+// numStrTelephoneCatalog
+//
+// The Telephone Catalog. This catalog contains telephone
+// numbering plan data for the countries supported by
+// NumStrFmtCountryTelephoneNumSpec.NewCountryCode().
+// Entries are listed in alphabetical order by country
+// name.
+//
+// Digit counts and display formats describe the most
+// common geographic numbers in each country. Numbers
+// which do not match a display format are presented
+// using a default grouping.
+//
+//	Resources:
+//		https://www.itu.int/oth/T0202.aspx?parent=T0202
+//		http://www.wtng.info/
+var numStrTelephoneCatalog = []numStrTelephoneCatalogEntry{
+	{
+		countryName:                 "Australia",
+		countryCodeTwoChar:          "AU",
+		countryCodeThreeChar:        "AUS",
+		internationalPrefix:         "0011",
+		trunkPrefix:                 "0",
+		countryTelephoneCode:        "61",
+		areaCodeMinNumOfDigits:      "1",
+		areaCodeMaxNumOfDigits:      "1",
+		subscriberNumMinNumOfDigits: "8",
+		subscriberNumMaxNumOfDigits: "8",
+		mobileNumMinNumOfDigits:     "8",
+		mobileNumMaxNumOfDigits:     "8",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "61 N NNNN NNNN",
+		abbrDisplayFmt:              "(0N) NNNN NNNN",
+		dialFmt:                     "NNNNNNNNN",
+	},
+	{
+		countryName:                 "Belgium",
+		countryCodeTwoChar:          "BE",
+		countryCodeThreeChar:        "BEL",
+		internationalPrefix:         "00",
+		trunkPrefix:                 "0",
+		countryTelephoneCode:        "32",
+		areaCodeMinNumOfDigits:      "1",
+		areaCodeMaxNumOfDigits:      "2",
+		subscriberNumMinNumOfDigits: "6",
+		subscriberNumMaxNumOfDigits: "7",
+		mobileNumMinNumOfDigits:     "8",
+		mobileNumMaxNumOfDigits:     "8",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "NNN NN NN",
+		abbrDisplayFmt:              "NNN NN NN",
+		dialFmt:                     "NNNNNNNN",
+	},
+	{
+		countryName:                 "Canada",
+		countryCodeTwoChar:          "CA",
+		countryCodeThreeChar:        "CAN",
+		internationalPrefix:         "011",
+		trunkPrefix:                 "1",
+		countryTelephoneCode:        "1",
+		areaCodeMinNumOfDigits:      "3",
+		areaCodeMaxNumOfDigits:      "3",
+		subscriberNumMinNumOfDigits: "7",
+		subscriberNumMaxNumOfDigits: "7",
+		mobileNumMinNumOfDigits:     "7",
+		mobileNumMaxNumOfDigits:     "7",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "1 (NNN) NNN-NNNN",
+		abbrDisplayFmt:              "(NNN) NNN-NNNN",
+		dialFmt:                     "NNNNNNNNNN",
+	},
+	{
+		countryName:                 "China",
+		countryCodeTwoChar:          "CN",
+		countryCodeThreeChar:        "CHN",
+		internationalPrefix:         "00",
+		trunkPrefix:                 "0",
+		countryTelephoneCode:        "86",
+		areaCodeMinNumOfDigits:      "2",
+		areaCodeMaxNumOfDigits:      "3",
+		subscriberNumMinNumOfDigits: "7",
+		subscriberNumMaxNumOfDigits: "8",
+		mobileNumMinNumOfDigits:     "8",
+		mobileNumMaxNumOfDigits:     "9",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "NNNN NNNN",
+		abbrDisplayFmt:              "NNNN NNNN",
+		dialFmt:                     "NNNNNNNNNN",
+	},
+	{
+		countryName:                 "Denmark",
+		countryCodeTwoChar:          "DK",
+		countryCodeThreeChar:        "DNK",
+		internationalPrefix:         "00",
+		countryTelephoneCode:        "45",
+		areaCodeMinNumOfDigits:      "0",
+		areaCodeMaxNumOfDigits:      "0",
+		subscriberNumMinNumOfDigits: "8",
+		subscriberNumMaxNumOfDigits: "8",
+		mobileNumMinNumOfDigits:     "8",
+		mobileNumMaxNumOfDigits:     "8",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "45 NN NN NN NN",
+		abbrDisplayFmt:              "NN NN NN NN",
+		dialFmt:                     "NNNNNNNN",
+	},
+	{
+		countryName:                 "France",
+		countryCodeTwoChar:          "FR",
+		countryCodeThreeChar:        "FRA",
+		internationalPrefix:         "00",
+		trunkPrefix:                 "0",
+		countryTelephoneCode:        "33",
+		areaCodeMinNumOfDigits:      "1",
+		areaCodeMaxNumOfDigits:      "1",
+		subscriberNumMinNumOfDigits: "8",
+		subscriberNumMaxNumOfDigits: "8",
+		mobileNumMinNumOfDigits:     "8",
+		mobileNumMaxNumOfDigits:     "8",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "33 N NN NN NN NN",
+		abbrDisplayFmt:              "0N NN NN NN NN",
+		dialFmt:                     "NNNNNNNNN",
+	},
+	{
+		countryName:                 "Germany",
+		countryCodeTwoChar:          "DE",
+		countryCodeThreeChar:        "DEU",
+		internationalPrefix:         "00",
+		trunkPrefix:                 "0",
+		countryTelephoneCode:        "49",
+		areaCodeMinNumOfDigits:      "2",
+		areaCodeMaxNumOfDigits:      "5",
+		subscriberNumMinNumOfDigits: "3",
+		subscriberNumMaxNumOfDigits: "8",
+		mobileNumMinNumOfDigits:     "7",
+		mobileNumMaxNumOfDigits:     "8",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "NNNNNNNN",
+		abbrDisplayFmt:              "NNNNNNNN",
+		dialFmt:                     "NNNNNNNNNN",
+	},
+	{
+		countryName:                 "Hong Kong",
+		countryCodeTwoChar:          "HK",
+		countryCodeThreeChar:        "HKG",
+		internationalPrefix:         "001",
+		countryTelephoneCode:        "852",
+		areaCodeMinNumOfDigits:      "0",
+		areaCodeMaxNumOfDigits:      "0",
+		subscriberNumMinNumOfDigits: "8",
+		subscriberNumMaxNumOfDigits: "8",
+		mobileNumMinNumOfDigits:     "8",
+		mobileNumMaxNumOfDigits:     "8",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "852 NNNN NNNN",
+		abbrDisplayFmt:              "NNNN NNNN",
+		dialFmt:                     "NNNNNNNN",
+	},
+	{
+		countryName:                 "India",
+		countryCodeTwoChar:          "IN",
+		countryCodeThreeChar:        "IND",
+		internationalPrefix:         "00",
+		trunkPrefix:                 "0",
+		countryTelephoneCode:        "91",
+		areaCodeMinNumOfDigits:      "2",
+		areaCodeMaxNumOfDigits:      "4",
+		subscriberNumMinNumOfDigits: "6",
+		subscriberNumMaxNumOfDigits: "8",
+		mobileNumMinNumOfDigits:     "10",
+		mobileNumMaxNumOfDigits:     "10",
+		mobileNumPrefixes:           "6,7,8,9",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "NNNN NNNN",
+		abbrDisplayFmt:              "NNNN NNNN",
+		mobileDisplayFmt:            "NNNNN NNNNN",
+		dialFmt:                     "NNNNNNNNNN",
+	},
+	{
+		countryName:                 "Ireland",
+		countryCodeTwoChar:          "IE",
+		countryCodeThreeChar:        "IRL",
+		internationalPrefix:         "00",
+		trunkPrefix:                 "0",
+		countryTelephoneCode:        "353",
+		areaCodeMinNumOfDigits:      "1",
+		areaCodeMaxNumOfDigits:      "3",
+		subscriberNumMinNumOfDigits: "5",
+		subscriberNumMaxNumOfDigits: "7",
+		mobileNumMinNumOfDigits:     "7",
+		mobileNumMaxNumOfDigits:     "7",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "NNN NNNN",
+		abbrDisplayFmt:              "NNN NNNN",
+		dialFmt:                     "NNNNNNNNN",
+	},
+	{
+		countryName:                 "Japan",
+		countryCodeTwoChar:          "JP",
+		countryCodeThreeChar:        "JPN",
+		internationalPrefix:         "010",
+		trunkPrefix:                 "0",
+		countryTelephoneCode:        "81",
+		areaCodeMinNumOfDigits:      "1",
+		areaCodeMaxNumOfDigits:      "4",
+		subscriberNumMinNumOfDigits: "5",
+		subscriberNumMaxNumOfDigits: "8",
+		mobileNumMinNumOfDigits:     "8",
+		mobileNumMaxNumOfDigits:     "8",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "NNNN-NNNN",
+		abbrDisplayFmt:              "NNNN-NNNN",
+		dialFmt:                     "NNNNNNNNNN",
+	},
+	{
+		countryName:                 "Netherlands",
+		countryCodeTwoChar:          "NL",
+		countryCodeThreeChar:        "NLD",
+		internationalPrefix:         "00",
+		trunkPrefix:                 "0",
+		countryTelephoneCode:        "31",
+		areaCodeMinNumOfDigits:      "1",
+		areaCodeMaxNumOfDigits:      "3",
+		subscriberNumMinNumOfDigits: "6",
+		subscriberNumMaxNumOfDigits: "7",
+		mobileNumMinNumOfDigits:     "8",
+		mobileNumMaxNumOfDigits:     "8",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "NNN NNNN",
+		abbrDisplayFmt:              "NNN NNNN",
+		dialFmt:                     "NNNNNNNNN",
+	},
+	{
+		countryName:                 "New Zealand",
+		countryCodeTwoChar:          "NZ",
+		countryCodeThreeChar:        "NZL",
+		internationalPrefix:         "00",
+		trunkPrefix:                 "0",
+		countryTelephoneCode:        "64",
+		areaCodeMinNumOfDigits:      "1",
+		areaCodeMaxNumOfDigits:      "1",
+		subscriberNumMinNumOfDigits: "7",
+		subscriberNumMaxNumOfDigits: "7",
+		mobileNumMinNumOfDigits:     "7",
+		mobileNumMaxNumOfDigits:     "9",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "64 N NNN NNNN",
+		abbrDisplayFmt:              "(0N) NNN NNNN",
+		dialFmt:                     "NNNNNNNN",
+	},
+	{
+		countryName:                 "Norway",
+		countryCodeTwoChar:          "NO",
+		countryCodeThreeChar:        "NOR",
+		internationalPrefix:         "00",
+		countryTelephoneCode:        "47",
+		areaCodeMinNumOfDigits:      "0",
+		areaCodeMaxNumOfDigits:      "0",
+		subscriberNumMinNumOfDigits: "8",
+		subscriberNumMaxNumOfDigits: "8",
+		mobileNumMinNumOfDigits:     "8",
+		mobileNumMaxNumOfDigits:     "8",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "47 NN NN NN NN",
+		abbrDisplayFmt:              "NN NN NN NN",
+		dialFmt:                     "NNNNNNNN",
+	},
+	{
+		countryName:                 "Singapore",
+		countryCodeTwoChar:          "SG",
+		countryCodeThreeChar:        "SGP",
+		internationalPrefix:         "000",
+		countryTelephoneCode:        "65",
+		areaCodeMinNumOfDigits:      "0",
+		areaCodeMaxNumOfDigits:      "0",
+		subscriberNumMinNumOfDigits: "8",
+		subscriberNumMaxNumOfDigits: "8",
+		mobileNumMinNumOfDigits:     "8",
+		mobileNumMaxNumOfDigits:     "8",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "65 NNNN NNNN",
+		abbrDisplayFmt:              "NNNN NNNN",
+		dialFmt:                     "NNNNNNNN",
+	},
+	{
+		countryName:                 "South Africa",
+		countryCodeTwoChar:          "ZA",
+		countryCodeThreeChar:        "ZAF",
+		internationalPrefix:         "00",
+		trunkPrefix:                 "0",
+		countryTelephoneCode:        "27",
+		areaCodeMinNumOfDigits:      "2",
+		areaCodeMaxNumOfDigits:      "2",
+		subscriberNumMinNumOfDigits: "7",
+		subscriberNumMaxNumOfDigits: "7",
+		mobileNumMinNumOfDigits:     "7",
+		mobileNumMaxNumOfDigits:     "7",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "27 NN NNN NNNN",
+		abbrDisplayFmt:              "0NN NNN NNNN",
+		dialFmt:                     "NNNNNNNNN",
+	},
+	{
+		countryName:                 "South Korea",
+		countryCodeTwoChar:          "KR",
+		countryCodeThreeChar:        "KOR",
+		internationalPrefix:         "001",
+		trunkPrefix:                 "0",
+		countryTelephoneCode:        "82",
+		areaCodeMinNumOfDigits:      "1",
+		areaCodeMaxNumOfDigits:      "2",
+		subscriberNumMinNumOfDigits: "7",
+		subscriberNumMaxNumOfDigits: "8",
+		mobileNumMinNumOfDigits:     "7",
+		mobileNumMaxNumOfDigits:     "8",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "NNNN NNNN",
+		abbrDisplayFmt:              "NNNN NNNN",
+		dialFmt:                     "NNNNNNNNNN",
+	},
+	{
+		countryName:                 "Spain",
+		countryCodeTwoChar:          "ES",
+		countryCodeThreeChar:        "ESP",
+		internationalPrefix:         "00",
+		countryTelephoneCode:        "34",
+		areaCodeMinNumOfDigits:      "0",
+		areaCodeMaxNumOfDigits:      "0",
+		subscriberNumMinNumOfDigits: "9",
+		subscriberNumMaxNumOfDigits: "9",
+		mobileNumMinNumOfDigits:     "9",
+		mobileNumMaxNumOfDigits:     "9",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "34 NNN NNN NNN",
+		abbrDisplayFmt:              "NNN NNN NNN",
+		dialFmt:                     "NNNNNNNNN",
+	},
+	{
+		countryName:                 "Sweden",
+		countryCodeTwoChar:          "SE",
+		countryCodeThreeChar:        "SWE",
+		internationalPrefix:         "00",
+		trunkPrefix:                 "0",
+		countryTelephoneCode:        "46",
+		areaCodeMinNumOfDigits:      "1",
+		areaCodeMaxNumOfDigits:      "3",
+		subscriberNumMinNumOfDigits: "5",
+		subscriberNumMaxNumOfDigits: "8",
+		mobileNumMinNumOfDigits:     "7",
+		mobileNumMaxNumOfDigits:     "8",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "NNN NN NN",
+		abbrDisplayFmt:              "NNN NN NN",
+		dialFmt:                     "NNNNNNNNN",
+	},
+	{
+		countryName:                 "Switzerland",
+		countryCodeTwoChar:          "CH",
+		countryCodeThreeChar:        "CHE",
+		internationalPrefix:         "00",
+		trunkPrefix:                 "0",
+		countryTelephoneCode:        "41",
+		areaCodeMinNumOfDigits:      "2",
+		areaCodeMaxNumOfDigits:      "2",
+		subscriberNumMinNumOfDigits: "7",
+		subscriberNumMaxNumOfDigits: "7",
+		mobileNumMinNumOfDigits:     "7",
+		mobileNumMaxNumOfDigits:     "7",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "41 NN NNN NN NN",
+		abbrDisplayFmt:              "0NN NNN NN NN",
+		dialFmt:                     "NNNNNNNNN",
+	},
+	{
+		countryName:                 "United Kingdom",
+		countryCodeTwoChar:          "GB",
+		countryCodeThreeChar:        "GBR",
+		internationalPrefix:         "00",
+		trunkPrefix:                 "0",
+		countryTelephoneCode:        "44",
+		areaCodeMinNumOfDigits:      "2",
+		areaCodeMaxNumOfDigits:      "5",
+		subscriberNumMinNumOfDigits: "4",
+		subscriberNumMaxNumOfDigits: "8",
+		mobileNumMinNumOfDigits:     "6",
+		mobileNumMaxNumOfDigits:     "6",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "NNNN NNNN",
+		abbrDisplayFmt:              "NNNN NNNN",
+		dialFmt:                     "NNNNNNNNNN",
+	},
+	{
+		countryName:                 "United States",
+		countryCodeTwoChar:          "US",
+		countryCodeThreeChar:        "USA",
+		internationalPrefix:         "011",
+		trunkPrefix:                 "1",
+		countryTelephoneCode:        "1",
+		areaCodeMinNumOfDigits:      "3",
+		areaCodeMaxNumOfDigits:      "3",
+		subscriberNumMinNumOfDigits: "7",
+		subscriberNumMaxNumOfDigits: "7",
+		mobileNumMinNumOfDigits:     "7",
+		mobileNumMaxNumOfDigits:     "7",
+		phoneExtNumMinNumOfDigits:   "1",
+		phoneExtNumMaxNumOfDigits:   "6",
+		fullDisplayFmt:              "1 (NNN) NNN-NNNN",
+		abbrDisplayFmt:              "(NNN) NNN-NNNN",
+		dialFmt:                     "NNNNNNNNNN",
+		isTelephoneCodeDefault:      true,
+	},
+}
@@ -0,0 +1,1176 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// numStrFmtPatternPadPosition - Identifies the location of
+// the pad escape ('*') within a number format pattern.
+type numStrFmtPatternPadPosition int
+
+const (
+	numStrFmtPatPadNone numStrFmtPatternPadPosition = iota
+	numStrFmtPatPadBeforePrefix
+	numStrFmtPatPadAfterPrefix
+	numStrFmtPatPadBeforeSuffix
+	numStrFmtPatPadAfterSuffix
+)
+
+// numStrFmtPatternDto - Holds the components extracted
+// from a CLDR or Excel style number format pattern string
+// by numStrFmtPatternQuark.parsePattern().
+//
+// The digit, grouping, exponent and padding components
+// are taken from the positive sub-pattern. The negative
+// sub-pattern contributes only its prefix and suffix
+// text. The zero sub-pattern contributes its prefix and
+// suffix text together with its own digit counts.
+type numStrFmtPatternDto struct {
+	positivePrefix string
+	positiveSuffix string
+
+	hasNegativeSubPattern bool
+	negativePrefix        string
+	negativeSuffix        string
+	negativeStartIdx      int
+
+	hasZeroSubPattern bool
+	zeroPrefix        string
+	zeroSuffix        string
+
+	// Set to 'true' if the zero sub-pattern contains
+	// only literal text and no number part. Example:
+	// "#,##0.00;(#,##0.00);-". In this case the literal
+	// text is stored in 'zeroPrefix'.
+	zeroLiteralOnly bool
+
+	// Digit counts extracted from the number part of the
+	// zero sub-pattern.
+	zeroMinIntegerDigits    int
+	zeroMinFractionalDigits int
+	zeroMaxFractionalDigits int
+
+	minIntegerDigits    int
+	minFractionalDigits int
+	maxFractionalDigits int
+
+	// Integer grouping sizes; primary group first. An
+	// empty slice signals no integer separation.
+	integerGrouping []uint
+
+	// Number of '#' and '0' placeholders in the integer
+	// part of the positive sub-pattern.
+	integerPlaceholders int
+
+	hasExponent       bool
+	exponentUpperCase bool
+	exponentLeadPlus  bool
+	minExponentDigits int
+	exponentIdx       int
+
+	// NumStrFmtType.None(), NumStrFmtType.Percent() or
+	// NumStrFmtType.PerMille()
+	percentFmtType NumStrFormatTypeCode
+
+	padPosition numStrFmtPatternPadPosition
+
+	// The pad character following the pad escape ('*').
+	padChar rune
+
+	// The display width of the positive sub-pattern
+	// excluding the pad escape. Only meaningful when
+	// 'padPosition' is not numStrFmtPatPadNone.
+	fieldWidth int
+}
+
+// numStrFmtPatternQuark - Provides low level helper
+// methods used to parse CLDR and Excel style number format
+// patterns such as "#,##0.00;(#,##0.00)" or "0.000E+00".
+type numStrFmtPatternQuark struct {
+	lock *sync.Mutex
+}
+
+// patternError - Formats an error message which identifies
+// the position of the offending character within a number
+// format pattern.
+//
+// Position 'runeIdx' is the zero based index of the
+// character within the runes of 'pattern'.
+func (nStrFmtPatQuark *numStrFmtPatternQuark) patternError(
+	ePrefix *ePref.ErrPrefixDto,
+	pattern []rune,
+	runeIdx int,
+	message string) error {
+
+	var charStr string
+
+	if runeIdx >= 0 && runeIdx < len(pattern) {
+		charStr = fmt.Sprintf("'%v'", string(pattern[runeIdx]))
+	} else {
+		charStr = "end of pattern"
+	}
+
+	return fmt.Errorf("%v\n"+
+		"Error: Invalid number format pattern!\n"+
+		"%v\n"+
+		"Character: %v\n"+
+		"Position:  %v (zero based index)\n"+
+		"Pattern:   \"%v\"\n"+
+		"            %v^\n",
+		ePrefix.String(),
+		message,
+		charStr,
+		runeIdx,
+		string(pattern),
+		strings.Repeat(" ", runeIdx))
+}
+
+// isExponentDigits - Returns 'true' if the characters
+// beginning at index 'startIdx' in 'patRunes' consist of
+// an optional plus sign ('+') followed by a required
+// exponent digit ('0'). This identifies an exponent
+// symbol ('E') which has been placed ahead of the
+// mantissa as in "E0".
+func (nStrFmtPatQuark *numStrFmtPatternQuark) isExponentDigits(
+	patRunes []rune,
+	startIdx int) bool {
+
+	idx := startIdx
+
+	if idx < len(patRunes) &&
+		patRunes[idx] == '+' {
+		idx++
+	}
+
+	return idx < len(patRunes) &&
+		patRunes[idx] == '0'
+}
+
+// parsePattern - Parses a CLDR or Excel style number format
+// pattern string and returns the extracted components.
+//
+// A pattern consists of up to three sub-patterns separated
+// by semicolons (';'). The first sub-pattern formats
+// positive values, the second formats negative values and
+// the third formats zero values. Each sub-pattern consists
+// of an optional prefix, a number part and an optional
+// suffix. The zero sub-pattern may instead consist solely
+// of literal text ("#,##0.00;(#,##0.00);-").
+//
+// The number part may contain the following characters:
+//
+//	'0'		Required digit
+//	'#'		Optional digit
+//	','		Integer grouping separator
+//	'.'		Decimal separator
+//	'E'		Exponent ('E', 'E+', 'e' or 'e+' followed
+//			by one or more '0' characters)
+//
+// Prefix and suffix text may contain the following special
+// characters:
+//
+//	'¤'		Replaced by 'currencySymbol'. Runs of two,
+//			three or five currency signs are treated
+//			the same as a single sign.
+//	'%'		Percent; values are multiplied by 100
+//	'‰'		Per mille; values are multiplied by 1,000
+//	'*'		Pad escape; the following character is the
+//			pad character
+//	'...'	Quoted literal text ('' is a single quote)
+//	"..."	Quoted literal text (Excel style)
+//	'\'		The following character is a literal
+//	'_'		Excel spacing; the following character is
+//			replaced by a single space
+//
+// All other prefix and suffix characters are treated as
+// literal text, except that an unquoted '[' (Excel color,
+// condition and locale sections) and an unquoted
+// exponent placed ahead of the mantissa ("E0") are
+// rejected.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	pattern						string
+//
+//		The number format pattern to be parsed.
+//
+//	currencySymbol				string
+//
+//		The text substituted for each currency placeholder
+//		('¤') found in the pattern. If the pattern contains
+//		a currency placeholder and this string is empty, an
+//		error will be returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	patternDto					numStrFmtPatternDto
+//
+//		If this method completes successfully, this
+//		structure will be populated with the components
+//		extracted from 'pattern'.
+//
+//	err							error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message identifying
+//		the position of the offending character.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrFmtPatQuark *numStrFmtPatternQuark) parsePattern(
+	pattern string,
+	currencySymbol string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	patternDto numStrFmtPatternDto,
+	err error) {
+
+	if nStrFmtPatQuark.lock == nil {
+		nStrFmtPatQuark.lock = new(sync.Mutex)
+	}
+
+	nStrFmtPatQuark.lock.Lock()
+
+	defer nStrFmtPatQuark.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrFmtPatternQuark."+
+			"parsePattern()",
+		"")
+
+	if err != nil {
+		return patternDto, err
+	}
+
+	patRunes := []rune(pattern)
+
+	lenPatRunes := len(patRunes)
+
+	if lenPatRunes == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'pattern' is invalid!\n"+
+			"'pattern' is an empty string.\n",
+			ePrefix.String())
+
+		return patternDto, err
+	}
+
+	patternDto.percentFmtType = NumStrFmtType.None()
+
+	var prefix, suffix string
+
+	idx := 0
+
+	for subPatIdx := 0; subPatIdx < 3; subPatIdx++ {
+
+		subPatStart := idx
+
+		prefix,
+			suffix,
+			idx,
+			err = nStrFmtPatQuark.parseSubPattern(
+			patRunes,
+			idx,
+			subPatIdx,
+			currencySymbol,
+			&patternDto,
+			ePrefix)
+
+		if err != nil {
+			return patternDto, err
+		}
+
+		switch subPatIdx {
+		case 0:
+			patternDto.positivePrefix = prefix
+			patternDto.positiveSuffix = suffix
+
+		case 1:
+			patternDto.hasNegativeSubPattern = true
+			patternDto.negativePrefix = prefix
+			patternDto.negativeSuffix = suffix
+			patternDto.negativeStartIdx = subPatStart
+
+		case 2:
+			patternDto.hasZeroSubPattern = true
+			patternDto.zeroPrefix = prefix
+			patternDto.zeroSuffix = suffix
+		}
+
+		if idx >= lenPatRunes {
+			break
+		}
+
+		// patRunes[idx] is a sub-pattern separator (';')
+
+		if subPatIdx == 2 {
+
+			err = nStrFmtPatQuark.patternError(
+				ePrefix,
+				patRunes,
+				idx,
+				"A pattern may contain a maximum of three sub-patterns\n"+
+					"(positive;negative;zero).")
+
+			return patternDto, err
+		}
+
+		idx++
+
+		if idx >= lenPatRunes {
+
+			err = nStrFmtPatQuark.patternError(
+				ePrefix,
+				patRunes,
+				idx-1,
+				"The sub-pattern separator (';') must be followed\n"+
+					"by a sub-pattern.")
+
+			return patternDto, err
+		}
+	}
+
+	return patternDto, err
+}
+
+// parseSubPattern - Parses a single sub-pattern beginning
+// at index 'startIdx' in 'patRunes'. Parsing stops at the
+// end of the pattern or at the next sub-pattern separator
+// (';'), whose index is returned as 'nextIdx'.
+//
+// Number part, pad and percent components are only stored
+// in 'patternDto' when 'subPatIdx' is zero (the positive
+// sub-pattern). The digit counts of the zero sub-pattern
+// ('subPatIdx' = 2) are stored separately. The number
+// part of the negative sub-pattern is validated but
+// otherwise ignored.
+func (nStrFmtPatQuark *numStrFmtPatternQuark) parseSubPattern(
+	patRunes []rune,
+	startIdx int,
+	subPatIdx int,
+	currencySymbol string,
+	patternDto *numStrFmtPatternDto,
+	ePrefix *ePref.ErrPrefixDto) (
+	prefix string,
+	suffix string,
+	nextIdx int,
+	err error) {
+
+	const (
+		statePrefix = iota
+		stateSuffix
+	)
+
+	lenPatRunes := len(patRunes)
+
+	state := statePrefix
+
+	var prefixBuilder, suffixBuilder strings.Builder
+
+	var numberLen int
+
+	padPosition := numStrFmtPatPadNone
+
+	percentFmtType := NumStrFmtType.None()
+
+	idx := startIdx
+
+	var literal string
+
+subPatternLoop:
+	for idx < lenPatRunes {
+
+		r := patRunes[idx]
+
+		literal = ""
+
+		switch {
+
+		case r == ';':
+
+			if state == statePrefix {
+
+				err = nStrFmtPatQuark.patternError(
+					ePrefix,
+					patRunes,
+					idx,
+					"The sub-pattern ending at this position does not\n"+
+						"contain any digit placeholders ('0' or '#').")
+
+				return prefix, suffix, idx, err
+			}
+
+			break subPatternLoop
+
+		case r == '0' || r == '#' || r == ',' || r == '.':
+
+			if state == stateSuffix {
+
+				err = nStrFmtPatQuark.patternError(
+					ePrefix,
+					patRunes,
+					idx,
+					"Number pattern characters ('0', '#', ',' and '.')\n"+
+						"are not allowed in the suffix. Quote this character\n"+
+						"if it is intended as literal text.")
+
+				return prefix, suffix, idx, err
+			}
+
+			var numberStartIdx = idx
+
+			idx,
+				err = nStrFmtPatQuark.parseNumberPart(
+				patRunes,
+				idx,
+				subPatIdx,
+				patternDto,
+				ePrefix)
+
+			if err != nil {
+				return prefix, suffix, idx, err
+			}
+
+			numberLen = idx - numberStartIdx
+
+			state = stateSuffix
+
+			continue
+
+		case r >= '1' && r <= '9':
+
+			err = nStrFmtPatQuark.patternError(
+				ePrefix,
+				patRunes,
+				idx,
+				"Rounding increment digits ('1' through '9') are not\n"+
+					"supported. Quote this character if it is intended as\n"+
+					"literal text.")
+
+			return prefix, suffix, idx, err
+
+		case r == '*':
+
+			if subPatIdx != 0 {
+
+				err = nStrFmtPatQuark.patternError(
+					ePrefix,
+					patRunes,
+					idx,
+					"The pad escape ('*') is only allowed in the first\n"+
+						"(positive) sub-pattern.")
+
+				return prefix, suffix, idx, err
+			}
+
+			if padPosition != numStrFmtPatPadNone {
+
+				err = nStrFmtPatQuark.patternError(
+					ePrefix,
+					patRunes,
+					idx,
+					"A pattern may contain only one pad escape ('*').")
+
+				return prefix, suffix, idx, err
+			}
+
+			if idx+1 >= lenPatRunes {
+
+				err = nStrFmtPatQuark.patternError(
+					ePrefix,
+					patRunes,
+					idx,
+					"The pad escape ('*') must be followed by a pad\n"+
+						"character.")
+
+				return prefix, suffix, idx, err
+			}
+
+			if unicode.IsControl(patRunes[idx+1]) {
+
+				err = nStrFmtPatQuark.patternError(
+					ePrefix,
+					patRunes,
+					idx+1,
+					"The pad character cannot be a control character.")
+
+				return prefix, suffix, idx + 1, err
+			}
+
+			patternDto.padChar = patRunes[idx+1]
+
+			if state == statePrefix {
+
+				if prefixBuilder.Len() == 0 {
+					padPosition = numStrFmtPatPadBeforePrefix
+				} else {
+					padPosition = numStrFmtPatPadAfterPrefix
+				}
+
+			} else {
+
+				if suffixBuilder.Len() == 0 {
+					padPosition = numStrFmtPatPadBeforeSuffix
+				} else {
+					padPosition = numStrFmtPatPadAfterSuffix
+				}
+			}
+
+			idx += 2
+
+			continue
+
+		case r == '\'' || r == '"':
+
+			closeIdx := idx + 1
+
+			var quoted strings.Builder
+
+			for closeIdx < lenPatRunes {
+
+				if patRunes[closeIdx] == r {
+
+					if r == '\'' &&
+						closeIdx+1 < lenPatRunes &&
+						patRunes[closeIdx+1] == '\'' {
+						// '' inside quotes is a literal quote
+						quoted.WriteRune('\'')
+						closeIdx += 2
+						continue
+					}
+
+					break
+				}
+
+				quoted.WriteRune(patRunes[closeIdx])
+				closeIdx++
+			}
+
+			if closeIdx >= lenPatRunes {
+
+				err = nStrFmtPatQuark.patternError(
+					ePrefix,
+					patRunes,
+					idx,
+					"This quote character was never closed.")
+
+				return prefix, suffix, idx, err
+			}
+
+			if r == '\'' && closeIdx == idx+1 {
+				// '' outside quotes is a literal quote
+				literal = "'"
+			} else {
+				literal = quoted.String()
+			}
+
+			idx = closeIdx
+
+		case r == '\\' || r == '_':
+
+			if idx+1 >= lenPatRunes {
+
+				err = nStrFmtPatQuark.patternError(
+					ePrefix,
+					patRunes,
+					idx,
+					"This escape character must be followed by\n"+
+						"another character.")
+
+				return prefix, suffix, idx, err
+			}
+
+			idx++
+
+			if r == '_' {
+				literal = " "
+			} else {
+				literal = string(patRunes[idx])
+			}
+
+		case r == '¤':
+			// Currency Sign '¤'
+
+			if len(currencySymbol) == 0 {
+
+				err = nStrFmtPatQuark.patternError(
+					ePrefix,
+					patRunes,
+					idx,
+					"The pattern contains a currency placeholder ('¤'),\n"+
+						"but input parameter 'currencySymbol' is empty.")
+
+				return prefix, suffix, idx, err
+			}
+
+			// '¤¤' (ISO code), '¤¤¤' (display name) and
+			// '¤¤¤¤¤' (narrow symbol) are treated the same
+			// as '¤'.
+			currencySignIdx := idx
+
+			for idx+1 < lenPatRunes &&
+				patRunes[idx+1] == '¤' {
+				idx++
+			}
+
+			numOfCurrencySigns := idx - currencySignIdx + 1
+
+			if numOfCurrencySigns == 4 ||
+				numOfCurrencySigns > 5 {
+
+				err = nStrFmtPatQuark.patternError(
+					ePrefix,
+					patRunes,
+					currencySignIdx,
+					"A currency placeholder must consist of one, two,\n"+
+						"three or five currency signs ('¤').")
+
+				return prefix, suffix, currencySignIdx, err
+			}
+
+			literal = currencySymbol
+
+		case (r == 'E' || r == 'e') &&
+			state == statePrefix &&
+			nStrFmtPatQuark.isExponentDigits(patRunes, idx+1):
+
+			err = nStrFmtPatQuark.patternError(
+				ePrefix,
+				patRunes,
+				idx,
+				"The exponent ('E') must be preceded by mantissa\n"+
+					"digit placeholders ('0' or '#'). Quote the 'E' if\n"+
+					"it is intended as literal text.")
+
+			return prefix, suffix, idx, err
+
+		case r == '[':
+
+			err = nStrFmtPatQuark.patternError(
+				ePrefix,
+				patRunes,
+				idx,
+				"Excel color, condition and locale sections\n"+
+					"('[Red]', '[>=100]', '[$-409]') are not supported.\n"+
+					"Quote this character if it is intended as literal\n"+
+					"text.")
+
+			return prefix, suffix, idx, err
+
+		case r == '%' || r == '‰':
+			// Percent '%' or Per Mille '‰'
+
+			newFmtType := NumStrFmtType.Percent()
+
+			if r == '‰' {
+				newFmtType = NumStrFmtType.PerMille()
+			}
+
+			if percentFmtType != NumStrFmtType.None() {
+
+				err = nStrFmtPatQuark.patternError(
+					ePrefix,
+					patRunes,
+					idx,
+					"A sub-pattern may contain only one percent ('%')\n"+
+						"or per mille ('‰') symbol.")
+
+				return prefix, suffix, idx, err
+			}
+
+			percentFmtType = newFmtType
+
+			literal = string(r)
+
+		default:
+
+			literal = string(r)
+		}
+
+		if state == statePrefix {
+
+			if padPosition == numStrFmtPatPadAfterPrefix {
+
+				err = nStrFmtPatQuark.patternError(
+					ePrefix,
+					patRunes,
+					idx,
+					"A pad escape ('*') inside the prefix must\n"+
+						"immediately precede the number.")
+
+				return prefix, suffix, idx, err
+			}
+
+			prefixBuilder.WriteString(literal)
+
+		} else {
+
+			if padPosition == numStrFmtPatPadAfterSuffix {
+
+				err = nStrFmtPatQuark.patternError(
+					ePrefix,
+					patRunes,
+					idx,
+					"A pad escape ('*') inside the suffix must\n"+
+						"be placed at the end of the sub-pattern.")
+
+				return prefix, suffix, idx, err
+			}
+
+			suffixBuilder.WriteString(literal)
+		}
+
+		idx++
+	}
+
+	if state == statePrefix {
+
+		if subPatIdx != 2 {
+
+			err = nStrFmtPatQuark.patternError(
+				ePrefix,
+				patRunes,
+				idx,
+				"The sub-pattern ending at this position does not\n"+
+					"contain any digit placeholders ('0' or '#').")
+
+			return prefix, suffix, idx, err
+		}
+
+		// The zero sub-pattern consists solely of literal
+		// text. Examples: "0.00;-0.00;\"zero\"" or
+		// "#,##0.00;(#,##0.00);-"
+		patternDto.zeroLiteralOnly = true
+	}
+
+	prefix = prefixBuilder.String()
+
+	suffix = suffixBuilder.String()
+
+	if subPatIdx == 0 {
+
+		if percentFmtType != NumStrFmtType.None() &&
+			patternDto.hasExponent {
+
+			err = nStrFmtPatQuark.patternError(
+				ePrefix,
+				patRunes,
+				patternDto.exponentIdx,
+				"Percent and per mille symbols cannot be combined\n"+
+					"with an exponent.")
+
+			return prefix, suffix, idx, err
+		}
+
+		patternDto.percentFmtType = percentFmtType
+
+		patternDto.padPosition = padPosition
+
+		patternDto.fieldWidth =
+			len([]rune(prefix)) +
+				numberLen +
+				len([]rune(suffix))
+	}
+
+	return prefix, suffix, idx, err
+}
+
+// parseNumberPart - Parses the number part of a
+// sub-pattern beginning at index 'startIdx' in 'patRunes'.
+// The returned 'nextIdx' is the index of the first
+// character following the number part.
+//
+// If 'subPatIdx' is zero (the positive sub-pattern), the
+// digit counts, integer grouping and exponent components
+// are stored in 'patternDto'. If 'subPatIdx' is two (the
+// zero sub-pattern), only the digit counts are stored.
+func (nStrFmtPatQuark *numStrFmtPatternQuark) parseNumberPart(
+	patRunes []rune,
+	startIdx int,
+	subPatIdx int,
+	patternDto *numStrFmtPatternDto,
+	ePrefix *ePref.ErrPrefixDto) (
+	nextIdx int,
+	err error) {
+
+	lenPatRunes := len(patRunes)
+
+	idx := startIdx
+
+	var intPlaceholders, intZeros int
+
+	// Number of integer placeholders preceding each
+	// grouping separator.
+	var commaPositions []int
+
+	lastWasComma := false
+
+	// Integer Part
+	for idx < lenPatRunes {
+
+		r := patRunes[idx]
+
+		if r == '#' {
+
+			if intZeros > 0 {
+
+				err = nStrFmtPatQuark.patternError(
+					ePrefix,
+					patRunes,
+					idx,
+					"An optional digit ('#') cannot follow a required\n"+
+						"digit ('0') in the integer part.")
+
+				return idx, err
+			}
+
+			intPlaceholders++
+			lastWasComma = false
+
+		} else if r == '0' {
+
+			intZeros++
+			intPlaceholders++
+			lastWasComma = false
+
+		} else if r == ',' {
+
+			if intPlaceholders == 0 || lastWasComma {
+
+				err = nStrFmtPatQuark.patternError(
+					ePrefix,
+					patRunes,
+					idx,
+					"A grouping separator (',') must be preceded by\n"+
+						"a digit placeholder ('0' or '#').")
+
+				return idx, err
+			}
+
+			commaPositions = append(commaPositions, intPlaceholders)
+			lastWasComma = true
+
+		} else if r >= '1' && r <= '9' {
+
+			err = nStrFmtPatQuark.patternError(
+				ePrefix,
+				patRunes,
+				idx,
+				"Rounding increment digits ('1' through '9') are not\n"+
+					"supported.")
+
+			return idx, err
+
+		} else {
+			break
+		}
+
+		idx++
+	}
+
+	if lastWasComma {
+
+		err = nStrFmtPatQuark.patternError(
+			ePrefix,
+			patRunes,
+			idx-1,
+			"A grouping separator (',') must be followed by a\n"+
+				"digit placeholder ('0' or '#'). Scaling by trailing\n"+
+				"grouping separators is not supported.")
+
+		return idx - 1, err
+	}
+
+	var fracZeros, fracPlaceholders int
+
+	// Fractional Part
+	if idx < lenPatRunes &&
+		patRunes[idx] == '.' {
+
+		idx++
+
+		for idx < lenPatRunes {
+
+			r := patRunes[idx]
+
+			if r == '0' {
+
+				if fracPlaceholders > fracZeros {
+
+					err = nStrFmtPatQuark.patternError(
+						ePrefix,
+						patRunes,
+						idx,
+						"A required digit ('0') cannot follow an optional\n"+
+							"digit ('#') in the fractional part.")
+
+					return idx, err
+				}
+
+				fracZeros++
+				fracPlaceholders++
+
+			} else if r == '#' {
+
+				fracPlaceholders++
+
+			} else if r == ',' {
+
+				err = nStrFmtPatQuark.patternError(
+					ePrefix,
+					patRunes,
+					idx,
+					"A grouping separator (',') is not allowed in the\n"+
+						"fractional part.")
+
+				return idx, err
+
+			} else if r == '.' {
+
+				err = nStrFmtPatQuark.patternError(
+					ePrefix,
+					patRunes,
+					idx,
+					"A number pattern may contain only one decimal\n"+
+						"separator ('.').")
+
+				return idx, err
+
+			} else if r >= '1' && r <= '9' {
+
+				err = nStrFmtPatQuark.patternError(
+					ePrefix,
+					patRunes,
+					idx,
+					"Rounding increment digits ('1' through '9') are not\n"+
+						"supported.")
+
+				return idx, err
+
+			} else {
+				break
+			}
+
+			idx++
+		}
+	}
+
+	if intPlaceholders == 0 && fracPlaceholders == 0 {
+
+		err = nStrFmtPatQuark.patternError(
+			ePrefix,
+			patRunes,
+			startIdx,
+			"The number part must contain at least one digit\n"+
+				"placeholder ('0' or '#').")
+
+		return startIdx, err
+	}
+
+	// Exponent
+	var hasExponent, expUpperCase, expLeadPlus bool
+
+	var expZeros, expIdx int
+
+	if idx < lenPatRunes &&
+		(patRunes[idx] == 'E' || patRunes[idx] == 'e') {
+
+		hasExponent = true
+
+		expIdx = idx
+
+		expUpperCase = patRunes[idx] == 'E'
+
+		idx++
+
+		if idx < lenPatRunes && patRunes[idx] == '+' {
+			expLeadPlus = true
+			idx++
+		}
+
+		for idx < lenPatRunes && patRunes[idx] == '0' {
+			expZeros++
+			idx++
+		}
+
+		if expZeros == 0 {
+
+			err = nStrFmtPatQuark.patternError(
+				ePrefix,
+				patRunes,
+				idx,
+				"The exponent symbol ('E') must be followed by one\n"+
+					"or more required digits ('0'). Quote the 'E' if it\n"+
+					"is intended as literal text.")
+
+			return idx, err
+		}
+
+		if len(commaPositions) > 0 {
+
+			err = nStrFmtPatQuark.patternError(
+				ePrefix,
+				patRunes,
+				expIdx,
+				"Grouping separators (',') cannot be combined with\n"+
+					"an exponent.")
+
+			return idx, err
+		}
+
+		// CLDR: A maximum number of integer digits greater
+		// than the minimum and greater than one forces the
+		// exponent to a multiple of the maximum. Only the
+		// Standard (one integer digit) and Engineering
+		// (multiple of three) calculations are supported.
+		if !(intPlaceholders == 1 ||
+			(intPlaceholders == 3 && intPlaceholders > intZeros)) {
+
+			err = nStrFmtPatQuark.patternError(
+				ePrefix,
+				patRunes,
+				expIdx,
+				"Scientific notation patterns must contain a single\n"+
+					"integer digit placeholder (\"0.00E0\"), or three\n"+
+					"integer placeholders for engineering notation\n"+
+					"(\"##0.00E0\").")
+
+			return idx, err
+		}
+	}
+
+	if subPatIdx == 2 {
+
+		patternDto.zeroMinIntegerDigits = intZeros
+		patternDto.zeroMinFractionalDigits = fracZeros
+		patternDto.zeroMaxFractionalDigits = fracPlaceholders
+	}
+
+	if subPatIdx != 0 {
+		return idx, err
+	}
+
+	patternDto.minIntegerDigits = intZeros
+	patternDto.minFractionalDigits = fracZeros
+	patternDto.maxFractionalDigits = fracPlaceholders
+	patternDto.integerPlaceholders = intPlaceholders
+
+	patternDto.integerGrouping = nil
+
+	lenCommas := len(commaPositions)
+
+	if lenCommas > 0 {
+
+		primary :=
+			intPlaceholders - commaPositions[lenCommas-1]
+
+		patternDto.integerGrouping =
+			append(patternDto.integerGrouping, uint(primary))
+
+		if lenCommas > 1 {
+
+			secondary :=
+				commaPositions[lenCommas-1] -
+					commaPositions[lenCommas-2]
+
+			if secondary != primary {
+				patternDto.integerGrouping =
+					append(patternDto.integerGrouping,
+						uint(secondary))
+			}
+		}
+	}
+
+	patternDto.hasExponent = hasExponent
+	patternDto.exponentUpperCase = expUpperCase
+	patternDto.exponentLeadPlus = expLeadPlus
+	patternDto.minExponentDigits = expZeros
+	patternDto.exponentIdx = expIdx
+
+	return idx, err
+}
+
+// newPatternSymbolSpec - Creates a Number Symbol Specification
+// from the prefix and suffix text extracted from a number
+// format pattern.
+//
+// If both 'leadingSymbol' and 'trailingSymbol' are empty
+// strings, a NOP Number Symbol Specification is returned.
+func (nStrFmtPatQuark *numStrFmtPatternQuark) newPatternSymbolSpec(
+	leadingSymbol string,
+	leadingNumFieldSymPosition NumberFieldSymbolPosition,
+	trailingSymbol string,
+	trailingNumFieldSymPosition NumberFieldSymbolPosition,
+	errPrefDto *ePref.ErrPrefixDto) (
+	NumStrNumberSymbolSpec,
+	error) {
+
+	if nStrFmtPatQuark.lock == nil {
+		nStrFmtPatQuark.lock = new(sync.Mutex)
+	}
+
+	nStrFmtPatQuark.lock.Lock()
+
+	defer nStrFmtPatQuark.lock.Unlock()
+
+	lenLeading := len(leadingSymbol)
+
+	lenTrailing := len(trailingSymbol)
+
+	if lenLeading == 0 && lenTrailing == 0 {
+
+		return new(NumStrNumberSymbolSpec).NewNOP(), nil
+	}
+
+	if lenTrailing == 0 {
+
+		return new(NumStrNumberSymbolSpec).NewNumberSignLeadingSymbol(
+			leadingSymbol,
+			leadingNumFieldSymPosition,
+			errPrefDto)
+	}
+
+	if lenLeading == 0 {
+
+		return new(NumStrNumberSymbolSpec).NewNumberSignTrailingSymbol(
+			trailingSymbol,
+			trailingNumFieldSymPosition,
+			errPrefDto)
+	}
+
+	return new(NumStrNumberSymbolSpec).NewNumberSignLeadingTrailingSymbol(
+		leadingSymbol,
+		leadingNumFieldSymPosition,
+		trailingSymbol,
+		trailingNumFieldSymPosition,
+		errPrefDto)
+}
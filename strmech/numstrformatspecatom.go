@@ -55,11 +55,31 @@ func (numStrFmtSpecAtom *numStrFmtSpecAtom) empty(
 
 	signedNumFmtSpec.decSeparator.Empty()
 
+	signedNumFmtSpec.digitCountSpec.Empty()
+
+	signedNumFmtSpec.zeroDigitCountSpec.Empty()
+
+	signedNumFmtSpec.hasZeroLiteral = false
+
+	signedNumFmtSpec.zeroLiteral = ""
+
+	signedNumFmtSpec.digitSystem = NumDigitSys.None()
+
 	signedNumFmtSpec.intSeparatorSpec.Empty()
 
 	signedNumFmtSpec.numberSymbolsGroup.Empty()
 
 	signedNumFmtSpec.numberFieldSpec.Empty()
+
+	signedNumFmtSpec.ordinalFmtSpec.Empty()
+
+	signedNumFmtSpec.percentFmtSpec.Empty()
+
+	signedNumFmtSpec.radixFmtSpec.Empty()
+
+	signedNumFmtSpec.romanNumFmtSpec.Empty()
+
+	signedNumFmtSpec.sciNotFmtSpec.Empty()
 }
 
 //	equal
@@ -130,6 +150,32 @@ func (numStrFmtSpecAtom *numStrFmtSpecAtom) equal(
 		return false
 	}
 
+	if !signedNumFmtSpec1.digitCountSpec.Equal(
+		&signedNumFmtSpec2.digitCountSpec) {
+
+		return false
+	}
+
+	if !signedNumFmtSpec1.zeroDigitCountSpec.Equal(
+		&signedNumFmtSpec2.zeroDigitCountSpec) {
+
+		return false
+	}
+
+	if signedNumFmtSpec1.hasZeroLiteral !=
+		signedNumFmtSpec2.hasZeroLiteral ||
+		signedNumFmtSpec1.zeroLiteral !=
+			signedNumFmtSpec2.zeroLiteral {
+
+		return false
+	}
+
+	if signedNumFmtSpec1.digitSystem !=
+		signedNumFmtSpec2.digitSystem {
+
+		return false
+	}
+
 	areEqual,
 		_ := signedNumFmtSpec1.intSeparatorSpec.Equal(
 		&signedNumFmtSpec2.intSeparatorSpec,
@@ -152,6 +198,36 @@ func (numStrFmtSpecAtom *numStrFmtSpecAtom) equal(
 		return false
 	}
 
+	if !signedNumFmtSpec1.ordinalFmtSpec.Equal(
+		&signedNumFmtSpec2.ordinalFmtSpec) {
+
+		return false
+	}
+
+	if !signedNumFmtSpec1.percentFmtSpec.Equal(
+		&signedNumFmtSpec2.percentFmtSpec) {
+
+		return false
+	}
+
+	if !signedNumFmtSpec1.radixFmtSpec.Equal(
+		&signedNumFmtSpec2.radixFmtSpec) {
+
+		return false
+	}
+
+	if !signedNumFmtSpec1.romanNumFmtSpec.Equal(
+		&signedNumFmtSpec2.romanNumFmtSpec) {
+
+		return false
+	}
+
+	if !signedNumFmtSpec1.sciNotFmtSpec.Equal(
+		&signedNumFmtSpec2.sciNotFmtSpec) {
+
+		return false
+	}
+
 	return true
 }
 
@@ -377,6 +453,111 @@ func (numStrFmtSpecAtom *numStrFmtSpecAtom) setDecimalSeparatorSpec(
 	return err
 }
 
+// setDigitSystem - Deletes and replaces the Number Digit
+// System for the instance of NumStrFormatSpec passed as
+// input parameter 'numStrFmtSpec'.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrFmtSpec				*NumStrFormatSpec
+//
+//		A pointer to an instance of NumStrFormatSpec.
+//		The Number Digit System for this instance will
+//		be set to 'digitSystem'.
+//
+//	digitSystem					NumberDigitSystem
+//
+//		Specifies the digit system used to display the
+//		numeric digits zero through nine in formatted
+//		number strings. If this value is invalid, an
+//		error will be returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (numStrFmtSpecAtom *numStrFmtSpecAtom) setDigitSystem(
+	numStrFmtSpec *NumStrFormatSpec,
+	digitSystem NumberDigitSystem,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if numStrFmtSpecAtom.lock == nil {
+		numStrFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	numStrFmtSpecAtom.lock.Lock()
+
+	defer numStrFmtSpecAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrFmtSpecAtom."+
+			"setDigitSystem()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if numStrFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrFmtSpec' is invalid!\n"+
+			"'numStrFmtSpec' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if !digitSystem.XIsValid() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'digitSystem' is invalid!\n"+
+			"'digitSystem' integer value = '%v'\n",
+			ePrefix.String(),
+			digitSystem.XValueInt())
+
+		return err
+	}
+
+	numStrFmtSpec.digitSystem = digitSystem
+
+	return err
+}
+
 // setIntegerGroupingParams - Deletes and resets the member
 // variable data value for 'NumStrFormatSpec.intSeparatorSpec'
 // contained in the instance of NumStrFormatSpec passed as
@@ -940,6 +1121,26 @@ func (numStrFmtSpecAtom *numStrFmtSpecAtom) setNStrFmtComponents(
 		return err
 	}
 
+	numStrFmtSpec.digitCountSpec.Empty()
+
+	numStrFmtSpec.zeroDigitCountSpec.Empty()
+
+	numStrFmtSpec.hasZeroLiteral = false
+
+	numStrFmtSpec.zeroLiteral = ""
+
+	numStrFmtSpec.digitSystem = NumDigitSys.None()
+
+	numStrFmtSpec.ordinalFmtSpec.Empty()
+
+	numStrFmtSpec.percentFmtSpec.Empty()
+
+	numStrFmtSpec.radixFmtSpec.Empty()
+
+	numStrFmtSpec.romanNumFmtSpec.Empty()
+
+	numStrFmtSpec.sciNotFmtSpec.Empty()
+
 	err = numStrFmtSpec.decSeparator.CopyIn(
 		&decSeparatorSpec,
 		ePrefix.XCpy(
@@ -1394,6 +1595,26 @@ func (numStrFmtSpecAtom *numStrFmtSpecAtom) setNStrFmtElements(
 		return err
 	}
 
+	numStrFmtSpec.digitCountSpec.Empty()
+
+	numStrFmtSpec.zeroDigitCountSpec.Empty()
+
+	numStrFmtSpec.hasZeroLiteral = false
+
+	numStrFmtSpec.zeroLiteral = ""
+
+	numStrFmtSpec.digitSystem = NumDigitSys.None()
+
+	numStrFmtSpec.ordinalFmtSpec.Empty()
+
+	numStrFmtSpec.percentFmtSpec.Empty()
+
+	numStrFmtSpec.radixFmtSpec.Empty()
+
+	numStrFmtSpec.romanNumFmtSpec.Empty()
+
+	numStrFmtSpec.sciNotFmtSpec.Empty()
+
 	err = numStrFmtSpec.decSeparator.CopyIn(
 		&decSeparatorSpec,
 		ePrefix.XCpy(
@@ -145,6 +145,81 @@ func (nStrFmtSpecElectron *numStrFmtSpecElectron) testValidityNumStrFormatSpec(
 
 	}
 
+	err = numberStrFmtSpec.digitCountSpec.
+		IsValidInstanceError(
+			ePrefix.XCpy(
+				"numberStrFmtSpec.digitCountSpec"))
+
+	if err != nil {
+		return isValid, err
+	}
+
+	err = numberStrFmtSpec.zeroDigitCountSpec.
+		IsValidInstanceError(
+			ePrefix.XCpy(
+				"numberStrFmtSpec.zeroDigitCountSpec"))
+
+	if err != nil {
+		return isValid, err
+	}
+
+	if !numberStrFmtSpec.digitSystem.XIsValid() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: This Number String Format Specification is invalid!\n"+
+			"'numberStrFmtSpec.digitSystem' has an invalid value.\n"+
+			"'numberStrFmtSpec.digitSystem' integer value = '%v'\n",
+			ePrefix.String(),
+			numberStrFmtSpec.digitSystem.XValueInt())
+
+		return isValid, err
+	}
+
+	err = numberStrFmtSpec.ordinalFmtSpec.
+		IsValidInstanceError(
+			ePrefix.XCpy(
+				"numberStrFmtSpec.ordinalFmtSpec"))
+
+	if err != nil {
+		return isValid, err
+	}
+
+	err = numberStrFmtSpec.percentFmtSpec.
+		IsValidInstanceError(
+			ePrefix.XCpy(
+				"numberStrFmtSpec.percentFmtSpec"))
+
+	if err != nil {
+		return isValid, err
+	}
+
+	err = numberStrFmtSpec.radixFmtSpec.
+		IsValidInstanceError(
+			ePrefix.XCpy(
+				"numberStrFmtSpec.radixFmtSpec"))
+
+	if err != nil {
+		return isValid, err
+	}
+
+	err = numberStrFmtSpec.romanNumFmtSpec.
+		IsValidInstanceError(
+			ePrefix.XCpy(
+				"numberStrFmtSpec.romanNumFmtSpec"))
+
+	if err != nil {
+		return isValid, err
+	}
+
+	err = numberStrFmtSpec.sciNotFmtSpec.
+		IsValidInstanceError(
+			ePrefix.XCpy(
+				"numberStrFmtSpec.sciNotFmtSpec"))
+
+	if err != nil {
+		return isValid, err
+	}
+
 	isValid = true
 
 	return isValid, err
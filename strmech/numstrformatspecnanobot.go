@@ -145,6 +145,35 @@ func (nStrFmtSpecNanobot *numStrFmtSpecNanobot) copySignedNumberFormatSpec(
 		return err
 	}
 
+	err = destinationSignedNumFmtSpec.digitCountSpec.CopyIn(
+		&sourceSignedNumFmtSpec.digitCountSpec,
+		ePrefix.XCpy(
+			"destinationSignedNumFmtSpec.digitCountSpec"+
+				"<-sourceSignedNumFmtSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	err = destinationSignedNumFmtSpec.zeroDigitCountSpec.CopyIn(
+		&sourceSignedNumFmtSpec.zeroDigitCountSpec,
+		ePrefix.XCpy(
+			"destinationSignedNumFmtSpec.zeroDigitCountSpec"+
+				"<-sourceSignedNumFmtSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	destinationSignedNumFmtSpec.hasZeroLiteral =
+		sourceSignedNumFmtSpec.hasZeroLiteral
+
+	destinationSignedNumFmtSpec.zeroLiteral =
+		sourceSignedNumFmtSpec.zeroLiteral
+
+	destinationSignedNumFmtSpec.digitSystem =
+		sourceSignedNumFmtSpec.digitSystem
+
 	err = destinationSignedNumFmtSpec.intSeparatorSpec.CopyIn(
 		&sourceSignedNumFmtSpec.intSeparatorSpec,
 		ePrefix.XCpy(
@@ -171,6 +200,56 @@ func (nStrFmtSpecNanobot *numStrFmtSpecNanobot) copySignedNumberFormatSpec(
 			" destinationSignedNumFmtSpec.numberSymbols"+
 				"<-sourceSignedNumFmtSpec"))
 
+	if err != nil {
+		return err
+	}
+
+	err = destinationSignedNumFmtSpec.ordinalFmtSpec.CopyIn(
+		&sourceSignedNumFmtSpec.ordinalFmtSpec,
+		ePrefix.XCpy(
+			"destinationSignedNumFmtSpec.ordinalFmtSpec"+
+				"<-sourceSignedNumFmtSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	err = destinationSignedNumFmtSpec.percentFmtSpec.CopyIn(
+		&sourceSignedNumFmtSpec.percentFmtSpec,
+		ePrefix.XCpy(
+			"destinationSignedNumFmtSpec.percentFmtSpec"+
+				"<-sourceSignedNumFmtSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	err = destinationSignedNumFmtSpec.radixFmtSpec.CopyIn(
+		&sourceSignedNumFmtSpec.radixFmtSpec,
+		ePrefix.XCpy(
+			"destinationSignedNumFmtSpec.radixFmtSpec"+
+				"<-sourceSignedNumFmtSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	err = destinationSignedNumFmtSpec.romanNumFmtSpec.CopyIn(
+		&sourceSignedNumFmtSpec.romanNumFmtSpec,
+		ePrefix.XCpy(
+			"destinationSignedNumFmtSpec.romanNumFmtSpec"+
+				"<-sourceSignedNumFmtSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	err = destinationSignedNumFmtSpec.sciNotFmtSpec.CopyIn(
+		&sourceSignedNumFmtSpec.sciNotFmtSpec,
+		ePrefix.XCpy(
+			"destinationSignedNumFmtSpec.sciNotFmtSpec"+
+				"<-sourceSignedNumFmtSpec"))
+
 	return err
 }
 
@@ -2775,6 +2854,1609 @@ func (nStrFmtSpecNanobot *numStrFmtSpecNanobot) setCurrencyParams(
 	return err
 }
 
+// setNumFmtPattern
+//
+// Deletes and resets the member variable data values
+// for the NumStrFormatSpec instance passed as input
+// parameter 'numStrFmtSpec'. The instance is then
+// reconfigured using a CLDR or Excel style number format
+// pattern such as "#,##0.00;(#,##0.00)", "0.000E+00",
+// "¤ #,##0.00" or "#,##,##0.##".
+//
+// The digit placeholders, grouping separators, exponent
+// and pad escape are taken from the positive (first)
+// sub-pattern. The optional negative sub-pattern
+// contributes only its prefix and suffix text. The
+// optional zero sub-pattern contributes its prefix and
+// suffix text together with its own digit counts, which
+// are applied when the numeric value is zero. If the
+// negative sub-pattern is omitted, negative values are
+// formatted with a leading minus sign ('-') placed in
+// front of the positive prefix. If the zero sub-pattern is
+// omitted, zero values are formatted with the positive
+// prefix, suffix and digit counts.
+//
+// For a complete description of the pattern syntax, see
+// method NumStrFormatSpec.NewNumFmtPattern().
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrFmtSpec				*NumStrFormatSpec
+//
+//		A pointer to an instance of NumStrFormatSpec. All
+//		the member variable data values in this instance
+//		will be deleted and reset according to the number
+//		format pattern passed as input parameter 'pattern'.
+//
+//	pattern						string
+//
+//		The CLDR or Excel style number format pattern used
+//		to configure 'numStrFmtSpec'.
+//
+//	decSeparatorChars			string
+//
+//		The character or characters substituted for the
+//		decimal separator placeholder ('.') in 'pattern'.
+//
+//	intSeparatorChars			string
+//
+//		The character or characters substituted for the
+//		grouping separator placeholder (',') in 'pattern'.
+//		If 'pattern' does not contain a grouping separator,
+//		this parameter is ignored.
+//
+//	currencySymbol				string
+//
+//		The text substituted for the currency placeholder
+//		('¤') in 'pattern'. If 'pattern' does not contain a
+//		currency placeholder, this parameter is ignored.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message. Errors
+//		caused by an invalid pattern identify the position
+//		of the offending character.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrFmtSpecNanobot *numStrFmtSpecNanobot) setNumFmtPattern(
+	numStrFmtSpec *NumStrFormatSpec,
+	pattern string,
+	decSeparatorChars string,
+	intSeparatorChars string,
+	currencySymbol string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrFmtSpecNanobot.lock == nil {
+		nStrFmtSpecNanobot.lock = new(sync.Mutex)
+	}
+
+	nStrFmtSpecNanobot.lock.Lock()
+
+	defer nStrFmtSpecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrFmtSpecNanobot."+
+			"setNumFmtPattern()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if numStrFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrFmtSpec' is invalid!\n"+
+			"'numStrFmtSpec' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	nStrFmtPatQuark := numStrFmtPatternQuark{}
+
+	var patternDto numStrFmtPatternDto
+
+	patternDto,
+		err = nStrFmtPatQuark.parsePattern(
+		pattern,
+		currencySymbol,
+		ePrefix.XCpy(
+			"pattern"))
+
+	if err != nil {
+		return err
+	}
+
+	var decSeparator DecimalSeparatorSpec
+
+	decSeparator,
+		err = new(DecimalSeparatorSpec).NewStr(
+		decSeparatorChars,
+		ePrefix.XCpy(
+			"decSeparatorChars"))
+
+	if err != nil {
+		return err
+	}
+
+	var intSeparatorSpec IntegerSeparatorSpec
+
+	if len(patternDto.integerGrouping) == 0 {
+
+		intSeparatorSpec =
+			new(IntegerSeparatorSpec).NewNoIntegerSeparation()
+
+	} else {
+
+		intSeparatorSpec,
+			err = new(IntegerSeparatorSpec).NewComponents(
+			intSeparatorChars,
+			patternDto.integerGrouping,
+			false,
+			ePrefix.XCpy(
+				"intSeparatorChars"))
+
+		if err != nil {
+			return err
+		}
+	}
+
+	leadingSymPos := NumFieldSymPos.InsideNumField()
+
+	trailingSymPos := NumFieldSymPos.InsideNumField()
+
+	fieldLength := -1
+
+	fieldJustification := TxtJustify.Right()
+
+	switch patternDto.padPosition {
+
+	case numStrFmtPatPadBeforePrefix:
+
+		fieldLength = patternDto.fieldWidth
+
+	case numStrFmtPatPadAfterPrefix:
+
+		leadingSymPos = NumFieldSymPos.OutsideNumField()
+
+		fieldLength = patternDto.fieldWidth -
+			len([]rune(patternDto.positivePrefix))
+
+	case numStrFmtPatPadBeforeSuffix:
+
+		trailingSymPos = NumFieldSymPos.OutsideNumField()
+
+		fieldLength = patternDto.fieldWidth -
+			len([]rune(patternDto.positiveSuffix))
+
+		fieldJustification = TxtJustify.Left()
+
+	case numStrFmtPatPadAfterSuffix:
+
+		fieldLength = patternDto.fieldWidth
+
+		fieldJustification = TxtJustify.Left()
+	}
+
+	var numberFieldSpec NumStrNumberFieldSpec
+
+	numberFieldSpec,
+		err = new(NumStrNumberFieldSpec).NewFieldSpec(
+		fieldLength,
+		fieldJustification,
+		ePrefix.XCpy(
+			"numberFieldSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	if patternDto.padPosition != numStrFmtPatPadNone {
+
+		err = numberFieldSpec.SetFillChar(
+			patternDto.padChar,
+			ePrefix.XCpy(
+				"numberFieldSpec<-padChar"))
+
+		if err != nil {
+			return err
+		}
+	}
+
+	var positiveSign, negativeSign, zeroSign NumStrNumberSymbolSpec
+
+	positiveSign,
+		err = nStrFmtPatQuark.newPatternSymbolSpec(
+		patternDto.positivePrefix,
+		leadingSymPos,
+		patternDto.positiveSuffix,
+		trailingSymPos,
+		ePrefix.XCpy(
+			"positiveSign"))
+
+	if err != nil {
+		return err
+	}
+
+	negativePrefix := "-" + patternDto.positivePrefix
+
+	negativeSuffix := patternDto.positiveSuffix
+
+	if patternDto.hasNegativeSubPattern {
+
+		negativePrefix = patternDto.negativePrefix
+
+		negativeSuffix = patternDto.negativeSuffix
+
+		if len(negativePrefix) == 0 &&
+			len(negativeSuffix) == 0 {
+
+			err = nStrFmtPatQuark.patternError(
+				ePrefix,
+				[]rune(pattern),
+				patternDto.negativeStartIdx,
+				"The negative sub-pattern must contain a prefix or\n"+
+					"suffix which distinguishes negative values.")
+
+			return err
+		}
+	}
+
+	negativeSign,
+		err = nStrFmtPatQuark.newPatternSymbolSpec(
+		negativePrefix,
+		leadingSymPos,
+		negativeSuffix,
+		trailingSymPos,
+		ePrefix.XCpy(
+			"negativeSign"))
+
+	if err != nil {
+		return err
+	}
+
+	if patternDto.hasZeroSubPattern &&
+		!patternDto.zeroLiteralOnly {
+
+		zeroSign,
+			err = nStrFmtPatQuark.newPatternSymbolSpec(
+			patternDto.zeroPrefix,
+			leadingSymPos,
+			patternDto.zeroSuffix,
+			trailingSymPos,
+			ePrefix.XCpy(
+				"zeroSign"))
+
+		if err != nil {
+			return err
+		}
+
+	} else {
+
+		zeroSign = positiveSign
+	}
+
+	var numberSymbolsGroup NumStrNumberSymbolGroup
+
+	numberSymbolsGroup,
+		err = new(NumStrNumberSymbolGroup).NewSignedNumComponents(
+		positiveSign,
+		negativeSign,
+		zeroSign,
+		ePrefix.XCpy(
+			"numberSymbolsGroup"))
+
+	if err != nil {
+		return err
+	}
+
+	var zeroLiteral string
+
+	if patternDto.zeroLiteralOnly {
+		zeroLiteral = patternDto.zeroPrefix
+	}
+
+	if patternDto.hasExponent {
+
+		sciNotFmt := SciNotFmt.ENotUprCaseENoLeadPlus()
+
+		if patternDto.exponentUpperCase {
+
+			if patternDto.exponentLeadPlus {
+				sciNotFmt = SciNotFmt.ENotUprCaseELeadPlus()
+			}
+
+		} else {
+
+			if patternDto.exponentLeadPlus {
+				sciNotFmt = SciNotFmt.ENotLwrCaseELeadPlus()
+			} else {
+				sciNotFmt = SciNotFmt.ENotLwrCaseENoLeadPlus()
+			}
+		}
+
+		sciNotCalcType := ScientificNotationCalcType(0).Standard()
+
+		// As in CLDR, when the maximum number of integer
+		// digits (the '#' and '0' placeholders) exceeds
+		// both the minimum number of integer digits (the
+		// '0' placeholders) and one, the exponent is
+		// forced to a multiple of the maximum number of
+		// integer digits. The parser only accepts a
+		// maximum of three (Engineering Notation).
+		if patternDto.integerPlaceholders > 1 &&
+			patternDto.integerPlaceholders >
+				patternDto.minIntegerDigits {
+
+			sciNotCalcType = ScientificNotationCalcType(0).Engineering()
+		}
+
+		var sciNotFmtSpec SciNotationFormatSpec
+
+		sciNotFmtSpec,
+			err = new(SciNotationFormatSpec).NewSciNotationFormat(
+			sciNotFmt,
+			sciNotCalcType,
+			NumRoundType.HalfAwayFromZero(),
+			patternDto.maxFractionalDigits,
+			patternDto.minExponentDigits,
+			false,
+			ePrefix.XCpy(
+				"sciNotFmtSpec"))
+
+		if err != nil {
+			return err
+		}
+
+		err = sciNotFmtSpec.SetSignificandMinFracDigits(
+			patternDto.minFractionalDigits,
+			ePrefix.XCpy(
+				"sciNotFmtSpec"))
+
+		if err != nil {
+			return err
+		}
+
+		err = new(numStrFmtSpecNanobot).setSciNotationNumFormat(
+			numStrFmtSpec,
+			sciNotFmtSpec,
+			decSeparator,
+			numberSymbolsGroup,
+			numberFieldSpec,
+			ePrefix.XCpy(
+				"numStrFmtSpec<-sciNotFmtSpec"))
+
+		if err != nil {
+			return err
+		}
+
+		numStrFmtSpec.hasZeroLiteral = patternDto.zeroLiteralOnly
+
+		numStrFmtSpec.zeroLiteral = zeroLiteral
+
+		return err
+	}
+
+	var digitCountSpec NumStrDigitCountSpec
+
+	digitCountSpec,
+		err = new(NumStrDigitCountSpec).NewDigitCounts(
+		patternDto.minIntegerDigits,
+		patternDto.minFractionalDigits,
+		patternDto.maxFractionalDigits,
+		NumRoundType.HalfAwayFromZero(),
+		ePrefix.XCpy(
+			"digitCountSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	nStrFmtSpecAtom := numStrFmtSpecAtom{}
+
+	err = nStrFmtSpecAtom.setNStrFmtComponents(
+		numStrFmtSpec,
+		decSeparator,
+		intSeparatorSpec,
+		numberSymbolsGroup,
+		numberFieldSpec,
+		ePrefix.XCpy("numStrFmtSpec<-"))
+
+	if err != nil {
+		return err
+	}
+
+	if patternDto.percentFmtType != NumStrFmtType.None() {
+
+		var percentFmtSpec NumStrPercentFormatSpec
+
+		percentFmtSpec,
+			err = new(NumStrPercentFormatSpec).NewPercentFormat(
+			patternDto.percentFmtType,
+			ePrefix.XCpy(
+				"percentFmtSpec"))
+
+		if err != nil {
+			return err
+		}
+
+		err = numStrFmtSpec.percentFmtSpec.CopyIn(
+			&percentFmtSpec,
+			ePrefix.XCpy(
+				"numStrFmtSpec.percentFmtSpec<-percentFmtSpec"))
+
+		if err != nil {
+			return err
+		}
+	}
+
+	numStrFmtSpec.hasZeroLiteral = patternDto.zeroLiteralOnly
+
+	numStrFmtSpec.zeroLiteral = zeroLiteral
+
+	if patternDto.hasZeroSubPattern &&
+		!patternDto.zeroLiteralOnly {
+
+		var zeroDigitCountSpec NumStrDigitCountSpec
+
+		zeroDigitCountSpec,
+			err = new(NumStrDigitCountSpec).NewDigitCounts(
+			patternDto.zeroMinIntegerDigits,
+			patternDto.zeroMinFractionalDigits,
+			patternDto.zeroMaxFractionalDigits,
+			NumRoundType.HalfAwayFromZero(),
+			ePrefix.XCpy(
+				"zeroDigitCountSpec"))
+
+		if err != nil {
+			return err
+		}
+
+		err = numStrFmtSpec.zeroDigitCountSpec.CopyIn(
+			&zeroDigitCountSpec,
+			ePrefix.XCpy(
+				"numStrFmtSpec.zeroDigitCountSpec<-zeroDigitCountSpec"))
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return numStrFmtSpec.digitCountSpec.CopyIn(
+		&digitCountSpec,
+		ePrefix.XCpy(
+			"numStrFmtSpec.digitCountSpec<-digitCountSpec"))
+}
+
+// setOrdinalNumFormat
+//
+// Deletes and resets the member variable data values
+// for the NumStrFormatSpec instance passed as input
+// parameter 'numStrFmtSpec'. The instance is then
+// reconfigured to format non-negative integer values as
+// ordinal numbers.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrFmtSpec				*NumStrFormatSpec
+//
+//		A pointer to an instance of NumStrFormatSpec. All
+//		the member variable data values in this instance
+//		will be deleted and reset to format ordinal
+//		numbers.
+//
+//	languageCode				string
+//
+//		The two character ISO 639-1 language code which
+//		determines the ordinal suffix rules and the
+//		integer separator. This parameter is not case
+//		sensitive. A BCP 47 language tag such as "en-US"
+//		or "fr-FR" may also be submitted, in which case
+//		only the primary language subtag is used.
+//		Supported language codes are:
+//
+//			"EN"	English		"1st", "2nd", "1,000th"
+//			"FR"	French		"1er", "2e", "1 000e"
+//			"DE"	German		"1.", "2.", "1.000."
+//
+//		If 'languageCode' is not supported, an error will
+//		be returned.
+//
+//	useFeminine					bool
+//
+//		When set to 'true', the feminine form of the
+//		ordinal suffix is applied where the language
+//		distinguishes grammatical gender. In French, the
+//		feminine form of "1er" is "1re". This option has
+//		no effect for English and German.
+//
+//	useSuperscript				bool
+//
+//		When set to 'true', ordinal suffixes are rendered
+//		with Unicode superscript characters ("1ˢᵗ",
+//		"1ᵉʳ"). German ordinals, which consist of a
+//		trailing period, are not affected.
+//
+//	numberSymbolsGroup			NumStrNumberSymbolGroup
+//
+//		This instance of NumStrNumberSymbolGroup contains
+//		the Number Symbol Specifications for positive,
+//		negative and zero numeric values.
+//
+//		Since ordinal numbers can only represent
+//		non-negative values, only the Positive and Zero
+//		Number Sign Symbol Specifications are applied to
+//		the formatted ordinal numbers.
+//
+//		If these specifications are NOP, no symbols are
+//		added to the formatted ordinal numbers.
+//
+//	numberFieldSpec				NumStrNumberFieldSpec
+//
+//		This Number Field Specification contains the
+//		field length and text justification parameters
+//		used to display the formatted number string
+//		within a larger number field.
+//
+//		To set the field length equal to the length of
+//		the formatted number string, set the field
+//		length to minus one (-1).
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrFmtSpecNanobot *numStrFmtSpecNanobot) setOrdinalNumFormat(
+	numStrFmtSpec *NumStrFormatSpec,
+	languageCode string,
+	useFeminine bool,
+	useSuperscript bool,
+	numberSymbolsGroup NumStrNumberSymbolGroup,
+	numberFieldSpec NumStrNumberFieldSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrFmtSpecNanobot.lock == nil {
+		nStrFmtSpecNanobot.lock = new(sync.Mutex)
+	}
+
+	nStrFmtSpecNanobot.lock.Lock()
+
+	defer nStrFmtSpecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrFmtSpecNanobot."+
+			"setOrdinalNumFormat()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if numStrFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrFmtSpec' is invalid!\n"+
+			"'numStrFmtSpec' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	var ordinalFmtSpec NumStrOrdinalFormatSpec
+
+	ordinalFmtSpec,
+		err = new(NumStrOrdinalFormatSpec).NewOrdinalFormat(
+		languageCode,
+		useFeminine,
+		useSuperscript,
+		ePrefix.XCpy(
+			"ordinalFmtSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	var decSeparator DecimalSeparatorSpec
+
+	decSeparator,
+		err = new(DecimalSeparatorSpec).NewUS(
+		ePrefix.XCpy("decSeparator"))
+
+	if err != nil {
+		return err
+	}
+
+	err = new(numStrFmtSpecAtom).setNStrFmtComponents(
+		numStrFmtSpec,
+		decSeparator,
+		new(IntegerSeparatorSpec).NewNoIntegerSeparation(),
+		numberSymbolsGroup,
+		numberFieldSpec,
+		ePrefix.XCpy("numStrFmtSpec<-"))
+
+	if err != nil {
+		return err
+	}
+
+	return numStrFmtSpec.ordinalFmtSpec.CopyIn(
+		&ordinalFmtSpec,
+		ePrefix.XCpy(
+			"numStrFmtSpec.ordinalFmtSpec<-ordinalFmtSpec"))
+}
+
+// setPercentNumFormat
+//
+// Deletes and resets the member variable data values
+// for the NumStrFormatSpec instance passed as input
+// parameter 'numStrFmtSpec'. The instance is then
+// reconfigured to scale and format numeric values as
+// percentages, per mille values or basis points.
+//
+// The percent, per mille or basis point symbol is
+// supplied by the Currency Symbol Specification
+// contained in input parameter 'numberSymbolsGroup'.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrFmtSpec				*NumStrFormatSpec
+//
+//		A pointer to an instance of NumStrFormatSpec. All
+//		the member variable data values in this instance
+//		will be deleted and reset to format percentages,
+//		per mille values or basis points.
+//
+//	percentFmtType				NumStrFormatTypeCode
+//
+//		Specifies the scale factor applied to numeric
+//		values. Must be set to one of the following
+//		values or an error will be returned:
+//
+//			NumStrFmtType.Percent()		x 100
+//			NumStrFmtType.PerMille()	x 1,000
+//			NumStrFmtType.BasisPoint()	x 10,000
+//
+//	decSeparator				DecimalSeparatorSpec
+//
+//		The Decimal Separator Specification applied to
+//		the scaled numeric value.
+//
+//	intSeparatorSpec			IntegerSeparatorSpec
+//
+//		The Integer Separator Specification applied to
+//		the integer digits of the scaled numeric value.
+//
+//	numberSymbolsGroup			NumStrNumberSymbolGroup
+//
+//		This instance of NumStrNumberSymbolGroup contains
+//		the Number Symbol Specifications for positive,
+//		negative and zero numeric values. The Currency
+//		Symbol Specification is used to configure the
+//		percent, per mille or basis point symbol.
+//
+//	numberFieldSpec				NumStrNumberFieldSpec
+//
+//		This Number Field Specification contains the
+//		field length and text justification parameters
+//		used to display the formatted number string
+//		within a larger number field.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrFmtSpecNanobot *numStrFmtSpecNanobot) setPercentNumFormat(
+	numStrFmtSpec *NumStrFormatSpec,
+	percentFmtType NumStrFormatTypeCode,
+	decSeparator DecimalSeparatorSpec,
+	intSeparatorSpec IntegerSeparatorSpec,
+	numberSymbolsGroup NumStrNumberSymbolGroup,
+	numberFieldSpec NumStrNumberFieldSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrFmtSpecNanobot.lock == nil {
+		nStrFmtSpecNanobot.lock = new(sync.Mutex)
+	}
+
+	nStrFmtSpecNanobot.lock.Lock()
+
+	defer nStrFmtSpecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrFmtSpecNanobot."+
+			"setPercentNumFormat()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if numStrFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrFmtSpec' is invalid!\n"+
+			"'numStrFmtSpec' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	var percentFmtSpec NumStrPercentFormatSpec
+
+	percentFmtSpec,
+		err = new(NumStrPercentFormatSpec).NewPercentFormat(
+		percentFmtType,
+		ePrefix.XCpy(
+			"percentFmtSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	err = new(numStrFmtSpecAtom).setNStrFmtComponents(
+		numStrFmtSpec,
+		decSeparator,
+		intSeparatorSpec,
+		numberSymbolsGroup,
+		numberFieldSpec,
+		ePrefix.XCpy("numStrFmtSpec<-"))
+
+	if err != nil {
+		return err
+	}
+
+	return numStrFmtSpec.percentFmtSpec.CopyIn(
+		&percentFmtSpec,
+		ePrefix.XCpy(
+			"numStrFmtSpec.percentFmtSpec<-percentFmtSpec"))
+}
+
+// setPercentNumFormatCountry
+//
+// Deletes and resets the member variable data values
+// for the NumStrFormatSpec instance passed as input
+// parameter 'numStrFmtSpec'. The instance is then
+// reconfigured to scale and format numeric values as
+// percentages, per mille values or basis points using
+// the decimal separator, integer separator and number
+// sign conventions of a designated country or culture.
+//
+// The percent, per mille or basis point symbol is
+// configured as a trailing symbol. Countries which
+// separate the percent symbol from the number with a
+// space (France, Germany etc.) will receive a
+// non-breaking space (U+00A0) before the symbol. Basis
+// points are always separated from the number by a
+// space.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrFmtSpec				*NumStrFormatSpec
+//
+//		A pointer to an instance of NumStrFormatSpec. All
+//		the member variable data values in this instance
+//		will be deleted and reset.
+//
+//	percentFmtType				NumStrFormatTypeCode
+//
+//		Specifies the scale factor applied to numeric
+//		values. Must be set to one of the following
+//		values or an error will be returned:
+//
+//			NumStrFmtType.Percent()		x 100
+//			NumStrFmtType.PerMille()	x 1,000
+//			NumStrFmtType.BasisPoint()	x 10,000
+//
+//	countryCultureFormat		NumStrFmtCountryCultureSpec
+//
+//		The Signed Number Format contained in this
+//		Country Culture Specification supplies the
+//		decimal separator, integer separator and number
+//		sign symbols. The Country Code
+//		('CountryCodeTwoChar') determines the spacing of
+//		the percent symbol.
+//
+//	numberFieldSpec				NumStrNumberFieldSpec
+//
+//		This Number Field Specification contains the
+//		field length and text justification parameters
+//		used to display the formatted number string
+//		within a larger number field.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrFmtSpecNanobot *numStrFmtSpecNanobot) setPercentNumFormatCountry(
+	numStrFmtSpec *NumStrFormatSpec,
+	percentFmtType NumStrFormatTypeCode,
+	countryCultureFormat NumStrFmtCountryCultureSpec,
+	numberFieldSpec NumStrNumberFieldSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrFmtSpecNanobot.lock == nil {
+		nStrFmtSpecNanobot.lock = new(sync.Mutex)
+	}
+
+	nStrFmtSpecNanobot.lock.Lock()
+
+	defer nStrFmtSpecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrFmtSpecNanobot."+
+			"setPercentNumFormatCountry()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	percentSymbol,
+		ok := new(numStrPercentFormatSpecAtom).
+		getCountryPercentSymbol(
+			percentFmtType,
+			countryCultureFormat.CountryCodeTwoChar)
+
+	if !ok {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'percentFmtType' is invalid!\n"+
+			"'percentFmtType' must be Percent, PerMille or BasisPoint.\n"+
+			"percentFmtType = '%v'\n",
+			ePrefix.String(),
+			percentFmtType.String())
+
+		return err
+	}
+
+	var percentSymbolSpec NumStrNumberSymbolSpec
+
+	percentSymbolSpec,
+		err = new(NumStrNumberSymbolSpec).NewCurrencyTrailingSymbol(
+		percentSymbol,
+		false,
+		NumFieldSymPos.InsideNumField(),
+		ePrefix.XCpy(
+			"percentSymbolSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	signedNumSymbols :=
+		&countryCultureFormat.SignedNumStrFormat.numberSymbolsGroup
+
+	var numberSymbolsGroup NumStrNumberSymbolGroup
+
+	numberSymbolsGroup,
+		err = new(NumStrNumberSymbolGroup).NewCurrencyComponents(
+		signedNumSymbols.positiveNumberSign,
+		signedNumSymbols.negativeNumberSign,
+		signedNumSymbols.zeroNumberSign,
+		percentSymbolSpec,
+		ePrefix.XCpy(
+			"numberSymbolsGroup"))
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrFmtSpecNanobot).setPercentNumFormat(
+		numStrFmtSpec,
+		percentFmtType,
+		countryCultureFormat.SignedNumStrFormat.decSeparator,
+		countryCultureFormat.SignedNumStrFormat.intSeparatorSpec,
+		numberSymbolsGroup,
+		numberFieldSpec,
+		ePrefix.XCpy(
+			"numStrFmtSpec<-"))
+}
+
+// setRadixNumFormat
+//
+// Deletes and resets all member variable data values
+// contained in an instance of NumStrFormatSpec in order
+// to format integer values as binary, octal or
+// hexadecimal number strings.
+//
+// The decimal separator is set to the period ('.') and
+// the number symbols are set to the US signed number
+// defaults (leading minus sign for negative values).
+// These symbols are only applied to sign-magnitude
+// radix formats.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the member variable data values in the
+//	NumStrFormatSpec instance passed as input
+//	parameter 'numStrFmtSpec' will be deleted and
+//	replaced.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrFmtSpec				*NumStrFormatSpec
+//
+//		A pointer to an instance of NumStrFormatSpec.
+//		All the member variable data values in this
+//		instance will be deleted and reset.
+//
+//	radixFmtType				NumStrFormatTypeCode
+//
+//		Specifies the base of the formatted number
+//		string. Must be set to one of the following
+//		values or an error will be returned:
+//
+//			NumStrFmtType.Binary()		base 2
+//			NumStrFmtType.Octal()		base 8
+//			NumStrFmtType.Hexadecimal()	base 16
+//
+//	useRadixPrefix				bool
+//
+//		When set to 'true', the radix prefix will be
+//		added to the beginning of the formatted digits:
+//
+//			Binary      = "0b"
+//			Octal       = "0o"
+//			Hexadecimal = "0x"
+//
+//	useUpperCaseDigits			bool
+//
+//		When set to 'true', hexadecimal digits will be
+//		formatted in upper case ('A'-'F'). Otherwise,
+//		hexadecimal digits are formatted in lower case
+//		('a'-'f'). This parameter has no effect on
+//		binary or octal number strings.
+//
+//	twosComplementBitWidth		int
+//
+//		Controls the formatting of negative values.
+//
+//		If this parameter is set to zero, negative values
+//		are formatted in sign-magnitude form using a
+//		leading minus sign ('-'):
+//
+//			-255 Hexadecimal = "-0xff"
+//
+//		If this parameter is greater than zero, all values
+//		are formatted as two's complement bit patterns of
+//		the specified width. Digits are padded with
+//		leading zeros to the full width and no number
+//		sign is applied:
+//
+//			-1 Hexadecimal, Width 16 = "0xffff"
+//			 1 Hexadecimal, Width 16 = "0x0001"
+//
+//		If a numeric value cannot be represented within
+//		the specified number of bits, an error will be
+//		returned when the value is formatted.
+//
+//		If this parameter is less than zero or greater
+//		than 4,096, an error will be returned.
+//
+//	intSeparatorSpec			IntegerSeparatorSpec
+//
+//		Integer Separator Specification. This parameter
+//		specifies the grouping of the formatted digits
+//		and the separator characters inserted between
+//		each group. Grouping is applied to the digits
+//		only. The radix prefix is never grouped.
+//
+//			Example: Binary digits grouped in nibbles
+//				intSeparatorChars = "_"
+//				intSeparatorGrouping = []uint{4}
+//				Number String = "0b1010_1101"
+//
+//		To format digits without grouping, pass an
+//		instance created by:
+//
+//			IntegerSeparatorSpec.NewNoIntegerSeparation()
+//
+//	numberFieldSpec				NumStrNumberFieldSpec
+//
+//		This Number Field Specification contains the
+//		field length and text justification parameters
+//		used to display the formatted number string
+//		within a larger number field.
+//
+//		To set the field length equal to the length of
+//		the formatted number string, set the field
+//		length to minus one (-1).
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrFmtSpecNanobot *numStrFmtSpecNanobot) setRadixNumFormat(
+	numStrFmtSpec *NumStrFormatSpec,
+	radixFmtType NumStrFormatTypeCode,
+	useRadixPrefix bool,
+	useUpperCaseDigits bool,
+	twosComplementBitWidth int,
+	intSeparatorSpec IntegerSeparatorSpec,
+	numberFieldSpec NumStrNumberFieldSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrFmtSpecNanobot.lock == nil {
+		nStrFmtSpecNanobot.lock = new(sync.Mutex)
+	}
+
+	nStrFmtSpecNanobot.lock.Lock()
+
+	defer nStrFmtSpecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrFmtSpecNanobot."+
+			"setRadixNumFormat()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if numStrFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrFmtSpec' is invalid!\n"+
+			"'numStrFmtSpec' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	var radixFmtSpec NumStrRadixFormatSpec
+
+	radixFmtSpec,
+		err = new(NumStrRadixFormatSpec).NewRadixFormat(
+		radixFmtType,
+		useRadixPrefix,
+		useUpperCaseDigits,
+		twosComplementBitWidth,
+		ePrefix.XCpy(
+			"radixFmtSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	var decSeparator DecimalSeparatorSpec
+
+	decSeparator,
+		err = new(DecimalSeparatorSpec).NewUS(
+		ePrefix.XCpy("decSeparator"))
+
+	if err != nil {
+		return err
+	}
+
+	var numSymbolsGroup NumStrNumberSymbolGroup
+
+	numSymbolsGroup,
+		err = new(NumStrNumberSymbolGroup).
+		NewSignedNumDefaultsUSMinus(
+			ePrefix.XCpy(
+				"numSymbolsGroup<-"))
+
+	if err != nil {
+		return err
+	}
+
+	err = new(numStrFmtSpecAtom).setNStrFmtComponents(
+		numStrFmtSpec,
+		decSeparator,
+		intSeparatorSpec,
+		numSymbolsGroup,
+		numberFieldSpec,
+		ePrefix.XCpy("numStrFmtSpec<-"))
+
+	if err != nil {
+		return err
+	}
+
+	return numStrFmtSpec.radixFmtSpec.CopyIn(
+		&radixFmtSpec,
+		ePrefix.XCpy(
+			"numStrFmtSpec.radixFmtSpec<-radixFmtSpec"))
+}
+
+// setRomanNumeralNumFormat
+//
+// Deletes and resets the member variable data values
+// for the NumStrFormatSpec instance passed as input
+// parameter 'numStrFmtSpec'. The instance is then
+// reconfigured to format positive integer values as
+// Roman numerals.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrFmtSpec				*NumStrFormatSpec
+//
+//		A pointer to an instance of NumStrFormatSpec. All
+//		the member variable data values in this instance
+//		will be deleted and reset to format Roman
+//		numerals.
+//
+//	useLowerCase				bool
+//
+//		When set to 'true', Roman numerals will be
+//		formatted as lower case characters ("xiv").
+//		Otherwise, Roman numerals are formatted as upper
+//		case characters ("XIV").
+//
+//	useVinculum					bool
+//
+//		When set to 'true', values from 4,000 through
+//		3,999,999 will be formatted using the vinculum,
+//		or overline, form. The thousands portion of the
+//		value is rendered with a combining overline
+//		(U+0305) following each numeral.
+//
+//		When set to 'false', the maximum value which can
+//		be formatted is 3,999.
+//
+//	numberSymbolsGroup			NumStrNumberSymbolGroup
+//
+//		This instance of NumStrNumberSymbolGroup contains
+//		the Number Symbol Specifications for positive,
+//		negative and zero numeric values.
+//
+//		Since Roman numerals can only represent positive
+//		values, only the Positive Number Sign Symbol
+//		Specification is applied to the formatted Roman
+//		numerals. Leading and trailing positive number
+//		symbols may be used to produce outline numbers
+//		such as "IV." or "(iv)".
+//
+//		If the Positive Number Sign Symbol Specification
+//		is NOP, no symbols are added to the formatted
+//		Roman numerals.
+//
+//	numberFieldSpec				NumStrNumberFieldSpec
+//
+//		This Number Field Specification contains the
+//		field length and text justification parameters
+//		used to display the formatted number string
+//		within a larger number field.
+//
+//		To set the field length equal to the length of
+//		the formatted number string, set the field
+//		length to minus one (-1).
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrFmtSpecNanobot *numStrFmtSpecNanobot) setRomanNumeralNumFormat(
+	numStrFmtSpec *NumStrFormatSpec,
+	useLowerCase bool,
+	useVinculum bool,
+	numberSymbolsGroup NumStrNumberSymbolGroup,
+	numberFieldSpec NumStrNumberFieldSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrFmtSpecNanobot.lock == nil {
+		nStrFmtSpecNanobot.lock = new(sync.Mutex)
+	}
+
+	nStrFmtSpecNanobot.lock.Lock()
+
+	defer nStrFmtSpecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrFmtSpecNanobot."+
+			"setRomanNumeralNumFormat()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if numStrFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrFmtSpec' is invalid!\n"+
+			"'numStrFmtSpec' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	var romanNumFmtSpec NumStrRomanNumeralFormatSpec
+
+	romanNumFmtSpec,
+		err = new(NumStrRomanNumeralFormatSpec).NewRomanNumeralFormat(
+		useLowerCase,
+		useVinculum,
+		ePrefix.XCpy(
+			"romanNumFmtSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	var decSeparator DecimalSeparatorSpec
+
+	decSeparator,
+		err = new(DecimalSeparatorSpec).NewUS(
+		ePrefix.XCpy("decSeparator"))
+
+	if err != nil {
+		return err
+	}
+
+	err = new(numStrFmtSpecAtom).setNStrFmtComponents(
+		numStrFmtSpec,
+		decSeparator,
+		new(IntegerSeparatorSpec).NewNoIntegerSeparation(),
+		numberSymbolsGroup,
+		numberFieldSpec,
+		ePrefix.XCpy("numStrFmtSpec<-"))
+
+	if err != nil {
+		return err
+	}
+
+	return numStrFmtSpec.romanNumFmtSpec.CopyIn(
+		&romanNumFmtSpec,
+		ePrefix.XCpy(
+			"numStrFmtSpec.romanNumFmtSpec<-romanNumFmtSpec"))
+}
+
+// setSciNotationNumFormat
+//
+// Deletes and resets all member variable data values
+// contained in an instance of NumStrFormatSpec in order
+// to format numeric values as Scientific Notation
+// number strings.
+//
+// Integer separation is turned off because the
+// significand never contains more than three integer
+// digits.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the member variable data values in the
+//	NumStrFormatSpec instance passed as input
+//	parameter 'numStrFmtSpec' will be deleted and
+//	replaced.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrFmtSpec				*NumStrFormatSpec
+//
+//		A pointer to an instance of NumStrFormatSpec.
+//		All the member variable data values in this
+//		instance will be deleted and reset.
+//
+//	sciNotFmtSpec				SciNotationFormatSpec
+//
+//		The Scientific Notation Format Specification
+//		controls the calculation type, the rounding of
+//		the significand, the exponent display format,
+//		the minimum number of exponent digits and
+//		superscript exponent output.
+//
+//		If this specification is NOP or invalid, an
+//		error will be returned.
+//
+//	decSeparatorSpec			DecimalSeparatorSpec
+//
+//		This structure contains the radix point or
+//		decimal separator character(s) which will be
+//		used to separate the integer and fractional
+//		digits of the significand.
+//
+//	numberSymbolsGroup			NumStrNumberSymbolGroup
+//
+//		This instance of NumStrNumberSymbolGroup
+//		contains the Number Symbol Specifications for
+//		negative numeric values, positive numeric values
+//		and zero numeric values. Currency symbols are
+//		NOT applied to Scientific Notation number
+//		strings.
+//
+//	numberFieldSpec				NumStrNumberFieldSpec
+//
+//		This Number Field Specification contains the
+//		field length and text justification parameters
+//		used to display the formatted number string
+//		within a larger number field.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrFmtSpecNanobot *numStrFmtSpecNanobot) setSciNotationNumFormat(
+	numStrFmtSpec *NumStrFormatSpec,
+	sciNotFmtSpec SciNotationFormatSpec,
+	decSeparatorSpec DecimalSeparatorSpec,
+	numberSymbolsGroup NumStrNumberSymbolGroup,
+	numberFieldSpec NumStrNumberFieldSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrFmtSpecNanobot.lock == nil {
+		nStrFmtSpecNanobot.lock = new(sync.Mutex)
+	}
+
+	nStrFmtSpecNanobot.lock.Lock()
+
+	defer nStrFmtSpecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrFmtSpecNanobot."+
+			"setSciNotationNumFormat()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if numStrFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrFmtSpec' is invalid!\n"+
+			"'numStrFmtSpec' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if sciNotFmtSpec.IsNOP() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sciNotFmtSpec' is invalid!\n"+
+			"'sciNotFmtSpec' is NOP and has not been configured\n"+
+			"for Scientific Notation formatting.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	err = sciNotFmtSpec.IsValidInstanceError(
+		ePrefix.XCpy(
+			"sciNotFmtSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	err = new(numStrFmtSpecAtom).setNStrFmtComponents(
+		numStrFmtSpec,
+		decSeparatorSpec,
+		new(IntegerSeparatorSpec).NewNoIntegerSeparation(),
+		numberSymbolsGroup,
+		numberFieldSpec,
+		ePrefix.XCpy("numStrFmtSpec<-"))
+
+	if err != nil {
+		return err
+	}
+
+	return numStrFmtSpec.sciNotFmtSpec.CopyIn(
+		&sciNotFmtSpec,
+		ePrefix.XCpy(
+			"numStrFmtSpec.sciNotFmtSpec<-sciNotFmtSpec"))
+}
+
 //	setSignedNumDefaultsFrance
 //
 //	Deletes and resets the member variable data values
@@ -281,6 +281,26 @@ func (nStrHelperAtom *numStrHelperAtom) dirtyToNativeNumRunes(
 		return nativeNumStr, err
 	}
 
+	// Native digits (Arabic-Indic, Devanagari, Thai,
+	// full-width, etc.) are normalized to Latin (ASCII)
+	// digits. The caller's rune array is NOT modified.
+	normalizedNumRunes := RuneArrayDto{}
+
+	normalizedNumRunes.CharsArray,
+		_,
+		err = new(numStrDigitSystemQuark).normalizeNativeDigits(
+		dirtyNumberRunes.CharsArray,
+		dirtyNumberRunesLabel,
+		ePrefix.XCpy(
+			"normalizedNumRunes<-"+dirtyNumberRunesLabel))
+
+	if err != nil {
+
+		return nativeNumStr, err
+	}
+
+	dirtyNumberRunes = &normalizedNumRunes
+
 	var decSepChars *RuneArrayDto
 
 	decSepChars = &decimalSeparator.decimalSeparatorChars
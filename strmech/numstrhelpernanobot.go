@@ -318,6 +318,19 @@ type numStrHelperNanobot struct {
 //			Trailing Currency Symbols: " €"
 //			Number String:   "123.456 €"
 //
+//	minIntegerDigits			int
+//
+//		The minimum number of integer digits displayed
+//		in the formatted number string. If the integer
+//		value contains fewer digits, it will be padded
+//		with leading zeros.
+//
+//			Example: minIntegerDigits = 3
+//				integer = 7  Number String: "007"
+//
+//		Values less than two are ignored and excess
+//		leading zeros are removed.
+//
 //	numberFieldSpec				NumStrNumberFieldSpec
 //
 //		This Number Field Specification contains all
@@ -433,6 +446,7 @@ func (nStrHelperNanobot *numStrHelperNanobot) formatNumStrElements(
 	positiveNumberSign NumStrNumberSymbolSpec,
 	zeroNumberSign NumStrNumberSymbolSpec,
 	currencySymbol NumStrNumberSymbolSpec,
+	minIntegerDigits int,
 	numberFieldSpec NumStrNumberFieldSpec,
 	errPrefDto *ePref.ErrPrefixDto) (
 	numStr string,
@@ -582,6 +596,17 @@ func (nStrHelperNanobot *numStrHelperNanobot) formatNumStrElements(
 
 	}
 
+	numOfIntDigits = integerDigits.GetRuneArrayLength()
+
+	if minIntegerDigits > numOfIntDigits {
+
+		integerDigits.CharsArray = append(
+			[]rune(strings.Repeat(
+				"0",
+				minIntegerDigits-numOfIntDigits)),
+			integerDigits.CharsArray...)
+	}
+
 	if numOfFracDigits > 0 &&
 		decSeparator.GetNumberOfSeparatorChars() == 0 {
 
@@ -1219,6 +1244,11 @@ func (nStrHelperNanobot *numStrHelperNanobot) formatNumStrElements(
 		return numStr, err
 	}
 
+	numStr = new(numStrNumberFieldSpecAtom).replaceFillChars(
+		&numberFieldSpec,
+		numStr,
+		tempNumStr)
+
 	numStr =
 		outsideNumFieldLeadingSymbols +
 			numStr +
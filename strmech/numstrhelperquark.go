@@ -812,6 +812,24 @@ func (nStrHelperQuark *numStrHelperQuark) extractNumRunes(
 			err
 	}
 
+	// Native digits (Arabic-Indic, Devanagari, Thai,
+	// full-width, etc.) are normalized to Latin (ASCII)
+	// digits before the search begins.
+	targetSearchString.CharsArray,
+		_,
+		err = new(numStrDigitSystemQuark).normalizeNativeDigits(
+		targetSearchString.CharsArray,
+		targetSearchStringName,
+		ePrefix.XCpy(
+			"targetSearchString"))
+
+	if err != nil {
+
+		return searchResults,
+			numStrKernel,
+			err
+	}
+
 	targetInputParms := CharSearchTargetInputParametersDto{}.New()
 
 	targetInputParms.TargetString = &targetSearchString
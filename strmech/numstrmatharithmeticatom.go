@@ -0,0 +1,600 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
+
+// numStrMathArithmeticAtom - Provides low level helper
+// methods used to perform arithmetic operations on rune
+// arrays of numeric digit characters ('0' through '9').
+//
+// The numeric digit arrays processed by these methods
+// are treated as unsigned integer magnitudes. Number
+// signs and the placement of the radix point are
+// managed by the calling methods.
+type numStrMathArithmeticAtom struct {
+	lock *sync.Mutex
+}
+
+// addDigitMagnitudes
+//
+// Adds two rune arrays of numeric digits and returns the
+// total as a new rune array of numeric digits.
+//
+// Both input arrays are treated as unsigned integer
+// values. Leading zeros will be deleted from the
+// returned total.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	addend01					[]rune
+//
+//		An array of numeric digit characters ('0'-'9')
+//		which will be added to 'addend02'.
+//
+//	addend02					[]rune
+//
+//		An array of numeric digit characters ('0'-'9')
+//		which will be added to 'addend01'.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	[]rune
+//
+//		An array of numeric digit characters containing
+//		the sum of 'addend01' and 'addend02'.
+func (nStrMathArithAtom *numStrMathArithmeticAtom) addDigitMagnitudes(
+	addend01 []rune,
+	addend02 []rune) []rune {
+
+	if nStrMathArithAtom.lock == nil {
+		nStrMathArithAtom.lock = new(sync.Mutex)
+	}
+
+	nStrMathArithAtom.lock.Lock()
+
+	defer nStrMathArithAtom.lock.Unlock()
+
+	idx01 := len(addend01) - 1
+
+	idx02 := len(addend02) - 1
+
+	maxLen := idx01 + 1
+
+	if idx02+1 > maxLen {
+		maxLen = idx02 + 1
+	}
+
+	total := make([]rune, maxLen+1)
+
+	var carry, digitSum rune
+
+	for i := maxLen; i > 0; i-- {
+
+		digitSum = carry
+
+		if idx01 >= 0 {
+			digitSum += addend01[idx01] - '0'
+		}
+
+		if idx02 >= 0 {
+			digitSum += addend02[idx02] - '0'
+		}
+
+		idx01--
+		idx02--
+
+		carry = 0
+
+		if digitSum > 9 {
+			digitSum -= 10
+			carry = 1
+		}
+
+		total[i] = digitSum + '0'
+	}
+
+	total[0] = carry + '0'
+
+	return nStrMathArithAtom.trimLeadingZeros(total)
+}
+
+// compareDigitMagnitudes
+//
+// Compares the magnitudes of two rune arrays of numeric
+// digits. Both arrays are treated as unsigned integer
+// values. Leading zeros are ignored.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	digits01					[]rune
+//
+//		An array of numeric digit characters ('0'-'9')
+//		which will be compared to 'digits02'.
+//
+//	digits02					[]rune
+//
+//		An array of numeric digit characters ('0'-'9')
+//		which will be compared to 'digits01'.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	int
+//
+//		The comparison result will be set to one of three
+//		values:
+//
+//		-1	= 'digits01' is less than 'digits02'
+//		 0	= 'digits01' is equal to 'digits02'
+//		+1	= 'digits01' is greater than 'digits02'
+func (nStrMathArithAtom *numStrMathArithmeticAtom) compareDigitMagnitudes(
+	digits01 []rune,
+	digits02 []rune) int {
+
+	if nStrMathArithAtom.lock == nil {
+		nStrMathArithAtom.lock = new(sync.Mutex)
+	}
+
+	nStrMathArithAtom.lock.Lock()
+
+	defer nStrMathArithAtom.lock.Unlock()
+
+	start01 := 0
+
+	for start01 < len(digits01)-1 &&
+		digits01[start01] == '0' {
+		start01++
+	}
+
+	start02 := 0
+
+	for start02 < len(digits02)-1 &&
+		digits02[start02] == '0' {
+		start02++
+	}
+
+	len01 := len(digits01) - start01
+
+	len02 := len(digits02) - start02
+
+	if len01 > len02 {
+		return 1
+	}
+
+	if len01 < len02 {
+		return -1
+	}
+
+	for i := 0; i < len01; i++ {
+
+		if digits01[start01+i] > digits02[start02+i] {
+			return 1
+		}
+
+		if digits01[start01+i] < digits02[start02+i] {
+			return -1
+		}
+	}
+
+	return 0
+}
+
+// divideDigitMagnitudes
+//
+// Performs long division on two rune arrays of numeric
+// digits. Both arrays are treated as unsigned integer
+// values.
+//
+// The integer quotient is returned as a rune array of
+// numeric digits. Any remainder is discarded. However,
+// a boolean flag signals whether the remainder is equal
+// to zero.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	dividend					[]rune
+//
+//		An array of numeric digit characters ('0'-'9')
+//		which will be divided by 'divisor'.
+//
+//	divisor						[]rune
+//
+//		An array of numeric digit characters ('0'-'9').
+//		'dividend' will be divided by this value.
+//
+//		If 'divisor' has a value of zero, an error will
+//		be returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	quotient					[]rune
+//
+//		An array of numeric digit characters containing
+//		the integer quotient of 'dividend' divided by
+//		'divisor'.
+//
+//	remainderIsZero				bool
+//
+//		If this parameter is set to 'true', the division
+//		operation was exact and produced a remainder of
+//		zero.
+//
+//	err							error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrMathArithAtom *numStrMathArithmeticAtom) divideDigitMagnitudes(
+	dividend []rune,
+	divisor []rune,
+	errPrefDto *ePref.ErrPrefixDto) (
+	quotient []rune,
+	remainderIsZero bool,
+	err error) {
+
+	if nStrMathArithAtom.lock == nil {
+		nStrMathArithAtom.lock = new(sync.Mutex)
+	}
+
+	nStrMathArithAtom.lock.Lock()
+
+	defer nStrMathArithAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrMathArithmeticAtom."+
+			"divideDigitMagnitudes()",
+		"")
+
+	if err != nil {
+		return quotient, remainderIsZero, err
+	}
+
+	nStrMathArithAtom2 := numStrMathArithmeticAtom{}
+
+	divisor = nStrMathArithAtom2.trimLeadingZeros(divisor)
+
+	if len(divisor) == 1 &&
+		divisor[0] == '0' {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Division by zero!\n"+
+			"Input parameter 'divisor' has a value of zero.\n",
+			ePrefix.String())
+
+		return quotient, remainderIsZero, err
+	}
+
+	quotient = make([]rune, len(dividend))
+
+	remainder := []rune{'0'}
+
+	var quotientDigit rune
+
+	for i := 0; i < len(dividend); i++ {
+
+		remainder = nStrMathArithAtom2.trimLeadingZeros(
+			append(remainder, dividend[i]))
+
+		quotientDigit = '0'
+
+		for nStrMathArithAtom2.compareDigitMagnitudes(
+			remainder,
+			divisor) >= 0 {
+
+			remainder,
+				err = nStrMathArithAtom2.subtractDigitMagnitudes(
+				remainder,
+				divisor,
+				ePrefix.XCpy(
+					"remainder-divisor"))
+
+			if err != nil {
+				return quotient, remainderIsZero, err
+			}
+
+			quotientDigit++
+		}
+
+		quotient[i] = quotientDigit
+	}
+
+	if len(quotient) == 0 {
+		quotient = []rune{'0'}
+	}
+
+	quotient = nStrMathArithAtom2.trimLeadingZeros(quotient)
+
+	remainderIsZero = len(remainder) == 1 &&
+		remainder[0] == '0'
+
+	return quotient, remainderIsZero, err
+}
+
+// multiplyDigitMagnitudes
+//
+// Multiplies two rune arrays of numeric digits and
+// returns the product as a new rune array of numeric
+// digits.
+//
+// Both input arrays are treated as unsigned integer
+// values. Leading zeros will be deleted from the
+// returned product.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	multiplicand				[]rune
+//
+//		An array of numeric digit characters ('0'-'9')
+//		which will be multiplied by 'multiplier'.
+//
+//	multiplier					[]rune
+//
+//		An array of numeric digit characters ('0'-'9')
+//		which will be multiplied by 'multiplicand'.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	[]rune
+//
+//		An array of numeric digit characters containing
+//		the product of 'multiplicand' and 'multiplier'.
+func (nStrMathArithAtom *numStrMathArithmeticAtom) multiplyDigitMagnitudes(
+	multiplicand []rune,
+	multiplier []rune) []rune {
+
+	if nStrMathArithAtom.lock == nil {
+		nStrMathArithAtom.lock = new(sync.Mutex)
+	}
+
+	nStrMathArithAtom.lock.Lock()
+
+	defer nStrMathArithAtom.lock.Unlock()
+
+	len01 := len(multiplicand)
+
+	len02 := len(multiplier)
+
+	if len01 == 0 || len02 == 0 {
+		return []rune{'0'}
+	}
+
+	accumulator := make([]int, len01+len02)
+
+	for i := len01 - 1; i >= 0; i-- {
+
+		digit01 := int(multiplicand[i] - '0')
+
+		if digit01 == 0 {
+			continue
+		}
+
+		for j := len02 - 1; j >= 0; j-- {
+
+			accumulator[i+j+1] +=
+				digit01 * int(multiplier[j]-'0')
+		}
+	}
+
+	product := make([]rune, len01+len02)
+
+	carry := 0
+
+	for k := len(accumulator) - 1; k >= 0; k-- {
+
+		carry += accumulator[k]
+
+		product[k] = rune(carry%10) + '0'
+
+		carry /= 10
+	}
+
+	return nStrMathArithAtom.trimLeadingZeros(product)
+}
+
+// subtractDigitMagnitudes
+//
+// Subtracts one rune array of numeric digits from
+// another and returns the difference as a new rune
+// array of numeric digits.
+//
+// Both input arrays are treated as unsigned integer
+// values. The magnitude of 'minuend' must be greater
+// than or equal to that of 'subtrahend'. Otherwise, an
+// error will be returned.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	minuend						[]rune
+//
+//		An array of numeric digit characters ('0'-'9')
+//		from which 'subtrahend' will be subtracted.
+//
+//	subtrahend					[]rune
+//
+//		An array of numeric digit characters ('0'-'9')
+//		which will be subtracted from 'minuend'.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	difference					[]rune
+//
+//		An array of numeric digit characters containing
+//		the result of subtracting 'subtrahend' from
+//		'minuend'.
+//
+//	err							error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrMathArithAtom *numStrMathArithmeticAtom) subtractDigitMagnitudes(
+	minuend []rune,
+	subtrahend []rune,
+	errPrefDto *ePref.ErrPrefixDto) (
+	difference []rune,
+	err error) {
+
+	if nStrMathArithAtom.lock == nil {
+		nStrMathArithAtom.lock = new(sync.Mutex)
+	}
+
+	nStrMathArithAtom.lock.Lock()
+
+	defer nStrMathArithAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrMathArithmeticAtom."+
+			"subtractDigitMagnitudes()",
+		"")
+
+	if err != nil {
+		return difference, err
+	}
+
+	if new(numStrMathArithmeticAtom).compareDigitMagnitudes(
+		minuend,
+		subtrahend) < 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The magnitude of input parameter 'minuend'\n"+
+			"is less than that of input parameter 'subtrahend'.\n"+
+			"minuend    = '%v'\n"+
+			"subtrahend = '%v'\n",
+			ePrefix.String(),
+			string(minuend),
+			string(subtrahend))
+
+		return difference, err
+	}
+
+	idx01 := len(minuend) - 1
+
+	idx02 := len(subtrahend) - 1
+
+	difference = make([]rune, len(minuend))
+
+	var borrow, digitDiff rune
+
+	for i := idx01; i >= 0; i-- {
+
+		digitDiff = minuend[i] - '0' - borrow
+
+		if idx02 >= 0 {
+			digitDiff -= subtrahend[idx02] - '0'
+		}
+
+		idx02--
+
+		borrow = 0
+
+		if digitDiff < 0 {
+			digitDiff += 10
+			borrow = 1
+		}
+
+		difference[i] = digitDiff + '0'
+	}
+
+	difference = nStrMathArithAtom.trimLeadingZeros(difference)
+
+	return difference, err
+}
+
+// trimLeadingZeros
+//
+// Deletes leading zeros from a rune array of numeric
+// digits. If the array consists entirely of zeros, or
+// is empty, a single zero digit ('0') is returned.
+//
+// This method does NOT lock the current instance of
+// numStrMathArithmeticAtom. It is designed to be
+// called by other methods of this type.
+func (nStrMathArithAtom *numStrMathArithmeticAtom) trimLeadingZeros(
+	digits []rune) []rune {
+
+	start := 0
+
+	for start < len(digits)-1 &&
+		digits[start] == '0' {
+
+		start++
+	}
+
+	if start >= len(digits) {
+		return []rune{'0'}
+	}
+
+	trimmedDigits := make([]rune, len(digits)-start)
+
+	copy(trimmedDigits, digits[start:])
+
+	return trimmedDigits
+}
@@ -0,0 +1,346 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
+
+// numStrMathArithmeticElectron - Provides helper methods
+// used to convert the integer and fractional digits of a
+// NumberStrKernel to and from scaled integer digit arrays
+// for use in arithmetic operations.
+type numStrMathArithmeticElectron struct {
+	lock *sync.Mutex
+}
+
+// getScaledDigits
+//
+// Receives a pointer to an instance of NumberStrKernel
+// and returns the integer and fractional digits as a
+// single rune array representing an unsigned integer
+// value scaled by 10^scale.
+//
+// If the number of fractional digits contained in
+// 'numStrKernel' is less than 'scale', the returned
+// array will be padded with trailing zeros.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		integer and fractional digits contained in this
+//		instance will be used to construct the returned
+//		scaled digits array. This instance will NOT be
+//		modified.
+//
+//	scale						int
+//
+//		The number of fractional digits represented in
+//		the returned digit array. This value must be
+//		greater than or equal to the number of fractional
+//		digits contained in 'numStrKernel'.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	scaledDigits				[]rune
+//
+//		An array of numeric digit characters representing
+//		the absolute value of 'numStrKernel' multiplied by
+//		10^scale.
+//
+//	err							error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrMathArithElectron *numStrMathArithmeticElectron) getScaledDigits(
+	numStrKernel *NumberStrKernel,
+	scale int,
+	errPrefDto *ePref.ErrPrefixDto) (
+	scaledDigits []rune,
+	err error) {
+
+	if nStrMathArithElectron.lock == nil {
+		nStrMathArithElectron.lock = new(sync.Mutex)
+	}
+
+	nStrMathArithElectron.lock.Lock()
+
+	defer nStrMathArithElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrMathArithmeticElectron."+
+			"getScaledDigits()",
+		"")
+
+	if err != nil {
+		return scaledDigits, err
+	}
+
+	if numStrKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return scaledDigits, err
+	}
+
+	lenIntDigits := len(numStrKernel.integerDigits.CharsArray)
+
+	lenFracDigits := len(numStrKernel.fractionalDigits.CharsArray)
+
+	if scale < lenFracDigits {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'scale' is invalid!\n"+
+			"'scale' is less than the number of fractional digits.\n"+
+			"scale                        = '%v'\n"+
+			"Number of Fractional Digits  = '%v'\n",
+			ePrefix.String(),
+			scale,
+			lenFracDigits)
+
+		return scaledDigits, err
+	}
+
+	scaledDigits = make([]rune, lenIntDigits+scale)
+
+	copy(scaledDigits,
+		numStrKernel.integerDigits.CharsArray)
+
+	copy(scaledDigits[lenIntDigits:],
+		numStrKernel.fractionalDigits.CharsArray)
+
+	for i := lenIntDigits + lenFracDigits; i < len(scaledDigits); i++ {
+		scaledDigits[i] = '0'
+	}
+
+	for i := 0; i < len(scaledDigits); i++ {
+
+		if scaledDigits[i] < '0' ||
+			scaledDigits[i] > '9' {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'numStrKernel' is invalid!\n"+
+				"'numStrKernel' contains a non-numeric digit.\n"+
+				"Invalid Digit = '%v'\n",
+				ePrefix.String(),
+				string(scaledDigits[i]))
+
+			return scaledDigits, err
+		}
+	}
+
+	if len(scaledDigits) == 0 {
+		scaledDigits = []rune{'0'}
+	}
+
+	return scaledDigits, err
+}
+
+// setFromScaledDigits
+//
+// Deletes and resets the internal member variable data
+// values for an instance of NumberStrKernel using a rune
+// array of numeric digits representing an unsigned
+// integer value scaled by 10^scale.
+//
+// The last 'scale' digits of 'scaledDigits' will be
+// configured as fractional digits. The remaining leading
+// digits will be configured as integer digits.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the data values contained in input parameter
+//	'numStrKernel' will be deleted and reset to new
+//	values.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		data values for all internal member variables
+//		contained in this instance will be deleted and
+//		reset to new values.
+//
+//	scaledDigits				[]rune
+//
+//		An array of numeric digit characters ('0'-'9')
+//		representing an unsigned integer value scaled by
+//		10^scale.
+//
+//	scale						int
+//
+//		The number of trailing digits in 'scaledDigits'
+//		which will be configured as fractional digits.
+//
+//	numberSign					NumericSignValueType
+//
+//		The number sign applied to a non-zero numeric
+//		value. If the numeric value is zero, the number
+//		sign will be set to NumSignVal.Zero().
+//
+//	numStrFormatSpec			*NumStrFormatSpec
+//
+//		A pointer to an instance of NumStrFormatSpec. A
+//		deep copy of this format specification will be
+//		stored as the default Number String Format
+//		Specification for 'numStrKernel'.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrMathArithElectron *numStrMathArithmeticElectron) setFromScaledDigits(
+	numStrKernel *NumberStrKernel,
+	scaledDigits []rune,
+	scale int,
+	numberSign NumericSignValueType,
+	numStrFormatSpec *NumStrFormatSpec,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if nStrMathArithElectron.lock == nil {
+		nStrMathArithElectron.lock = new(sync.Mutex)
+	}
+
+	nStrMathArithElectron.lock.Lock()
+
+	defer nStrMathArithElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrMathArithmeticElectron."+
+			"setFromScaledDigits()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if numStrKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if scale < 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'scale' is invalid!\n"+
+			"'scale' has a value less than zero.\n"+
+			"scale = '%v'\n",
+			ePrefix.String(),
+			scale)
+
+		return err
+	}
+
+	if len(scaledDigits) < scale {
+
+		padding := make([]rune, scale-len(scaledDigits))
+
+		for i := 0; i < len(padding); i++ {
+			padding[i] = '0'
+		}
+
+		scaledDigits = append(padding, scaledDigits...)
+	}
+
+	lenIntDigits := len(scaledDigits) - scale
+
+	integerDigits := new(numStrMathArithmeticAtom).
+		trimLeadingZeros(scaledDigits[:lenIntDigits])
+
+	fractionalDigits := make([]rune, scale)
+
+	copy(fractionalDigits, scaledDigits[lenIntDigits:])
+
+	err = new(numberStrKernelNanobot).setWithRunes(
+		numStrKernel,
+		integerDigits,
+		fractionalDigits,
+		numberSign,
+		ePrefix.XCpy(
+			"numStrKernel<-scaledDigits"))
+
+	if err != nil {
+		return err
+	}
+
+	if numStrFormatSpec == nil {
+		return err
+	}
+
+	return numStrKernel.numStrFormatSpec.CopyIn(
+		numStrFormatSpec,
+		ePrefix.XCpy(
+			"numStrKernel.numStrFormatSpec"))
+}
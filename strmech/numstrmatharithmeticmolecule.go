@@ -0,0 +1,419 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
+
+// numStrMathArithmeticMolecule - Provides helper methods
+// used to extract and combine signed numeric digit
+// arrays from instances of NumberStrKernel.
+type numStrMathArithmeticMolecule struct {
+	lock *sync.Mutex
+}
+
+// addSignedNumStrKernels
+//
+// Adds or subtracts the numeric values of two instances
+// of NumberStrKernel taking into account the number
+// signs of both operands. The exact result is stored in
+// input parameter 'result'.
+//
+// If input parameter 'negateNumStrKernel02' is set to
+// 'true', the numeric value of 'numStrKernel02' will be
+// subtracted from that of 'numStrKernel01'. Otherwise,
+// the two numeric values will be added together.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the data values contained in input parameter
+//	'result' will be deleted and reset to new values.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	result						*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		result of the addition or subtraction operation
+//		will be stored in this instance. The default
+//		Number String Format Specification will be copied
+//		from 'numStrKernel01'.
+//
+//	numStrKernel01				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel
+//		containing the first operand.
+//
+//	numStrKernel02				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel
+//		containing the second operand.
+//
+//	negateNumStrKernel02		bool
+//
+//		If this parameter is set to 'true', the number
+//		sign of 'numStrKernel02' will be reversed before
+//		it is added to 'numStrKernel01'. This has the
+//		effect of subtracting 'numStrKernel02' from
+//		'numStrKernel01'.
+//
+//		'numStrKernel02' will NOT be modified.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrMathArithMolecule *numStrMathArithmeticMolecule) addSignedNumStrKernels(
+	result *NumberStrKernel,
+	numStrKernel01 *NumberStrKernel,
+	numStrKernel02 *NumberStrKernel,
+	negateNumStrKernel02 bool,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if nStrMathArithMolecule.lock == nil {
+		nStrMathArithMolecule.lock = new(sync.Mutex)
+	}
+
+	nStrMathArithMolecule.lock.Lock()
+
+	defer nStrMathArithMolecule.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrMathArithmeticMolecule."+
+			"addSignedNumStrKernels()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if result == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'result' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	nStrMathArithMolecule2 := numStrMathArithmeticMolecule{}
+
+	var digits01, digits02 []rune
+	var sign01, sign02 NumericSignValueType
+	var scale01, scale02 int
+
+	digits01,
+		scale01,
+		sign01,
+		err = nStrMathArithMolecule2.getValidatedDigits(
+		numStrKernel01,
+		ePrefix.XCpy(
+			"numStrKernel01"))
+
+	if err != nil {
+		return err
+	}
+
+	digits02,
+		scale02,
+		sign02,
+		err = nStrMathArithMolecule2.getValidatedDigits(
+		numStrKernel02,
+		ePrefix.XCpy(
+			"numStrKernel02"))
+
+	if err != nil {
+		return err
+	}
+
+	if negateNumStrKernel02 {
+
+		if sign02 == NumSignVal.Positive() {
+
+			sign02 = NumSignVal.Negative()
+
+		} else if sign02 == NumSignVal.Negative() {
+
+			sign02 = NumSignVal.Positive()
+		}
+	}
+
+	// Align the radix points
+	scale := scale01
+
+	if scale02 > scale {
+		scale = scale02
+	}
+
+	digits01 = nStrMathArithMolecule2.appendZeros(
+		digits01,
+		scale-scale01)
+
+	digits02 = nStrMathArithMolecule2.appendZeros(
+		digits02,
+		scale-scale02)
+
+	nStrMathArithAtom := numStrMathArithmeticAtom{}
+
+	var resultDigits []rune
+
+	resultSign := sign01
+
+	if sign01 == NumSignVal.Zero() {
+
+		resultDigits = digits02
+
+		resultSign = sign02
+
+	} else if sign02 == NumSignVal.Zero() ||
+		sign01 == sign02 {
+
+		resultDigits = nStrMathArithAtom.addDigitMagnitudes(
+			digits01,
+			digits02)
+
+	} else if nStrMathArithAtom.compareDigitMagnitudes(
+		digits01,
+		digits02) >= 0 {
+
+		resultDigits,
+			err = nStrMathArithAtom.subtractDigitMagnitudes(
+			digits01,
+			digits02,
+			ePrefix.XCpy(
+				"digits01-digits02"))
+
+	} else {
+
+		resultDigits,
+			err = nStrMathArithAtom.subtractDigitMagnitudes(
+			digits02,
+			digits01,
+			ePrefix.XCpy(
+				"digits02-digits01"))
+
+		resultSign = sign02
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrMathArithmeticElectron).
+		setFromScaledDigits(
+			result,
+			resultDigits,
+			scale,
+			resultSign,
+			&numStrKernel01.numStrFormatSpec,
+			ePrefix.XCpy(
+				"result<-resultDigits"))
+}
+
+// appendZeros
+//
+// Returns a new rune array consisting of the original
+// 'digits' array followed by 'numOfZeros' zero
+// characters ('0'). This is equivalent to multiplying
+// the integer value of 'digits' by 10^numOfZeros.
+//
+// This method does NOT lock the current instance of
+// numStrMathArithmeticMolecule.
+func (nStrMathArithMolecule *numStrMathArithmeticMolecule) appendZeros(
+	digits []rune,
+	numOfZeros int) []rune {
+
+	if numOfZeros < 0 {
+		numOfZeros = 0
+	}
+
+	newDigits := make([]rune, len(digits)+numOfZeros)
+
+	copy(newDigits, digits)
+
+	for i := len(digits); i < len(newDigits); i++ {
+		newDigits[i] = '0'
+	}
+
+	return newDigits
+}
+
+// getValidatedDigits
+//
+// Validates an instance of NumberStrKernel and returns
+// the integer and fractional digits as a single rune
+// array representing an unsigned scaled integer value.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. If
+//		this instance is invalid, an error will be
+//		returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	scaledDigits				[]rune
+//
+//		The integer digits followed by the fractional
+//		digits of 'numStrKernel'.
+//
+//	scale						int
+//
+//		The number of fractional digits contained in
+//		'scaledDigits'.
+//
+//	numberSign					NumericSignValueType
+//
+//		The number sign of 'numStrKernel'. If the numeric
+//		value of 'numStrKernel' is zero, this value is
+//		set to NumSignVal.Zero().
+//
+//	err							error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrMathArithMolecule *numStrMathArithmeticMolecule) getValidatedDigits(
+	numStrKernel *NumberStrKernel,
+	errPrefDto *ePref.ErrPrefixDto) (
+	scaledDigits []rune,
+	scale int,
+	numberSign NumericSignValueType,
+	err error) {
+
+	if nStrMathArithMolecule.lock == nil {
+		nStrMathArithMolecule.lock = new(sync.Mutex)
+	}
+
+	nStrMathArithMolecule.lock.Lock()
+
+	defer nStrMathArithMolecule.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrMathArithmeticMolecule."+
+			"getValidatedDigits()",
+		"")
+
+	if err != nil {
+		return scaledDigits, scale, numberSign, err
+	}
+
+	if numStrKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return scaledDigits, scale, numberSign, err
+	}
+
+	_,
+		err = new(numberStrKernelAtom).
+		testValidityOfNumStrKernel(
+			numStrKernel,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	if err != nil {
+		return scaledDigits, scale, numberSign, err
+	}
+
+	scale = len(numStrKernel.fractionalDigits.CharsArray)
+
+	scaledDigits,
+		err = new(numStrMathArithmeticElectron).
+		getScaledDigits(
+			numStrKernel,
+			scale,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	if err != nil {
+		return scaledDigits, scale, numberSign, err
+	}
+
+	numberSign = NumSignVal.Zero()
+
+	for i := 0; i < len(scaledDigits); i++ {
+
+		if scaledDigits[i] != '0' {
+
+			numberSign = NumSignVal.Positive()
+
+			break
+		}
+	}
+
+	if numberSign == NumSignVal.Positive() &&
+		numStrKernel.numberSign == NumSignVal.Negative() {
+
+		numberSign = NumSignVal.Negative()
+	}
+
+	return scaledDigits, scale, numberSign, err
+}
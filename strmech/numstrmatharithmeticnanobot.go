@@ -0,0 +1,722 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
+
+// numStrMathArithmeticNanobot - Provides helper methods
+// used to perform exact arithmetic operations on the
+// numeric values encapsulated by instances of
+// NumberStrKernel.
+//
+// All arithmetic is performed directly on the integer
+// and fractional rune digit arrays. No conversion to
+// float64, big.Float or big.Rat is performed.
+type numStrMathArithmeticNanobot struct {
+	lock *sync.Mutex
+}
+
+// addNumStrKernels
+//
+// Adds the numeric values of two NumberStrKernel
+// instances and stores the exact sum in input parameter
+// 'sum'.
+//
+// The number of fractional digits in the sum is equal
+// to the greater number of fractional digits contained
+// in the two addends.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the data values contained in input parameter
+//	'sum' will be deleted and reset to new values.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	sum							*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		sum of 'addend01' and 'addend02' will be stored in
+//		this instance. The default Number String Format
+//		Specification will be copied from 'addend01'.
+//
+//	addend01					*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. This
+//		numeric value will be added to 'addend02'.
+//
+//	addend02					*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. This
+//		numeric value will be added to 'addend01'.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrMathArithNanobot *numStrMathArithmeticNanobot) addNumStrKernels(
+	sum *NumberStrKernel,
+	addend01 *NumberStrKernel,
+	addend02 *NumberStrKernel,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if nStrMathArithNanobot.lock == nil {
+		nStrMathArithNanobot.lock = new(sync.Mutex)
+	}
+
+	nStrMathArithNanobot.lock.Lock()
+
+	defer nStrMathArithNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrMathArithmeticNanobot."+
+			"addNumStrKernels()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrMathArithmeticMolecule).
+		addSignedNumStrKernels(
+			sum,
+			addend01,
+			addend02,
+			false,
+			ePrefix.XCpy(
+				"sum<-addend01+addend02"))
+}
+
+// divideNumStrKernels
+//
+// Divides the numeric value of 'dividend' by that of
+// 'divisor' and stores the quotient in input parameter
+// 'quotient'.
+//
+// The quotient is calculated exactly to one digit beyond
+// the requested precision. Any non-zero remainder is then
+// taken into account before the rounding algorithm
+// specified by 'roundingType' is applied. As a result,
+// the quotient is correctly rounded to 'precision'
+// fractional digits.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the data values contained in input parameter
+//	'quotient' will be deleted and reset to new values.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	quotient					*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		result of dividing 'dividend' by 'divisor' will be
+//		stored in this instance. The default Number String
+//		Format Specification will be copied from
+//		'dividend'.
+//
+//	dividend					*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. This
+//		numeric value will be divided by 'divisor'.
+//
+//	divisor						*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel.
+//		'dividend' will be divided by this numeric value.
+//
+//		If 'divisor' has a value of zero, an error will be
+//		returned.
+//
+//	precision					int
+//
+//		The number of fractional digits to the right of
+//		the radix point which will be returned in the
+//		quotient.
+//
+//		If this value is less than zero, an error will be
+//		returned.
+//
+//	roundingType				NumberRoundingType
+//
+//		The rounding algorithm applied to the quotient.
+//
+//		If this parameter is set to NumRoundType.NoRounding(),
+//		the quotient will be truncated to 'precision'
+//		fractional digits.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrMathArithNanobot *numStrMathArithmeticNanobot) divideNumStrKernels(
+	quotient *NumberStrKernel,
+	dividend *NumberStrKernel,
+	divisor *NumberStrKernel,
+	precision int,
+	roundingType NumberRoundingType,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if nStrMathArithNanobot.lock == nil {
+		nStrMathArithNanobot.lock = new(sync.Mutex)
+	}
+
+	nStrMathArithNanobot.lock.Lock()
+
+	defer nStrMathArithNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrMathArithmeticNanobot."+
+			"divideNumStrKernels()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if quotient == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'quotient' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if precision < 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'precision' is invalid!\n"+
+			"'precision' has a value less than zero.\n"+
+			"precision = '%v'\n",
+			ePrefix.String(),
+			precision)
+
+		return err
+	}
+
+	if roundingType == NumRoundType.NoRounding() {
+		roundingType = NumRoundType.Truncate()
+	}
+
+	var roundingSpec NumStrRoundingSpec
+
+	roundingSpec,
+		err = new(NumStrRoundingSpec).NewRoundingSpec(
+		roundingType,
+		precision,
+		ePrefix.XCpy(
+			"roundingSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	nStrMathArithMolecule := numStrMathArithmeticMolecule{}
+
+	var dividendDigits, divisorDigits []rune
+	var dividendSign, divisorSign NumericSignValueType
+	var dividendScale, divisorScale int
+
+	dividendDigits,
+		dividendScale,
+		dividendSign,
+		err = nStrMathArithMolecule.getValidatedDigits(
+		dividend,
+		ePrefix.XCpy(
+			"dividend"))
+
+	if err != nil {
+		return err
+	}
+
+	divisorDigits,
+		divisorScale,
+		divisorSign,
+		err = nStrMathArithMolecule.getValidatedDigits(
+		divisor,
+		ePrefix.XCpy(
+			"divisor"))
+
+	if err != nil {
+		return err
+	}
+
+	if divisorSign == NumSignVal.Zero() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Division by zero!\n"+
+			"Input parameter 'divisor' has a value of zero.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	// dividend / divisor =
+	//  (D1 / 10^s1) / (D2 / 10^s2) =
+	//    (D1 x 10^s2) / (D2 x 10^s1)
+	//
+	// In order to produce 'quotientScale'
+	// fractional digits, the dividend is
+	// multiplied by 10^quotientScale.
+	quotientScale := precision + 1
+
+	dividendDigits = nStrMathArithMolecule.appendZeros(
+		dividendDigits,
+		divisorScale+quotientScale)
+
+	divisorDigits = nStrMathArithMolecule.appendZeros(
+		divisorDigits,
+		dividendScale)
+
+	var quotientDigits []rune
+	var remainderIsZero bool
+
+	quotientDigits,
+		remainderIsZero,
+		err = new(numStrMathArithmeticAtom).
+		divideDigitMagnitudes(
+			dividendDigits,
+			divisorDigits,
+			ePrefix.XCpy(
+				"dividend/divisor"))
+
+	if err != nil {
+		return err
+	}
+
+	quotientSign := NumSignVal.Positive()
+
+	if dividendSign != divisorSign {
+		quotientSign = NumSignVal.Negative()
+	}
+
+	lastIdx := len(quotientDigits) - 1
+
+	isDirectedRounding := false
+
+	if roundingType == NumRoundType.Ceiling() ||
+		roundingType == NumRoundType.Floor() ||
+		roundingType == NumRoundType.Truncate() {
+
+		isDirectedRounding = true
+
+		// Directed rounding is applied here because the
+		// 'Ceiling' and 'Floor' algorithms employed by
+		// the kernel rounder always round to an integer
+		// value. If the Round From Digit or the remainder
+		// is non-zero, the exact quotient lies beyond the
+		// retained digits.
+		isInexact := !remainderIsZero ||
+			quotientDigits[lastIdx] != '0'
+
+		quotientDigits = quotientDigits[:lastIdx]
+
+		quotientScale--
+
+		if len(quotientDigits) == 0 {
+			quotientDigits = []rune{'0'}
+		}
+
+		if isInexact &&
+			((roundingType == NumRoundType.Ceiling() &&
+				quotientSign == NumSignVal.Positive()) ||
+				(roundingType == NumRoundType.Floor() &&
+					quotientSign == NumSignVal.Negative())) {
+
+			quotientDigits = new(numStrMathArithmeticAtom).
+				addDigitMagnitudes(
+					quotientDigits,
+					[]rune{'1'})
+		}
+
+	} else if !remainderIsZero {
+		// The exact quotient is greater than the
+		// computed digits. If the Round From Digit
+		// is '5', the true value lies above the
+		// midpoint. Changing the digit to '6'
+		// guarantees correct results for the
+		// 'Half' rounding algorithms.
+		if quotientDigits[lastIdx] == '5' {
+			quotientDigits[lastIdx] = '6'
+		}
+
+	} else {
+
+		for quotientScale > 0 &&
+			len(quotientDigits) > 1 &&
+			quotientDigits[len(quotientDigits)-1] == '0' {
+
+			quotientDigits =
+				quotientDigits[:len(quotientDigits)-1]
+
+			quotientScale--
+		}
+	}
+
+	err = new(numStrMathArithmeticElectron).
+		setFromScaledDigits(
+			quotient,
+			quotientDigits,
+			quotientScale,
+			quotientSign,
+			&dividend.numStrFormatSpec,
+			ePrefix.XCpy(
+				"quotient<-quotientDigits"))
+
+	if err != nil {
+		return err
+	}
+
+	if !isDirectedRounding {
+
+		err = new(numStrMathRoundingNanobot).roundNumStrKernel(
+			quotient,
+			roundingSpec,
+			ePrefix.XCpy(
+				"quotient"))
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_,
+		err = new(numberStrKernelElectron).
+		getSetIsNonZeroValue(
+			quotient,
+			ePrefix.XCpy(
+				"quotient"))
+
+	return err
+}
+
+// multiplyNumStrKernels
+//
+// Multiplies the numeric values of two NumberStrKernel
+// instances and stores the exact product in input
+// parameter 'product'.
+//
+// The number of fractional digits in the product is
+// equal to the sum of the fractional digits contained
+// in the multiplicand and multiplier.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the data values contained in input parameter
+//	'product' will be deleted and reset to new values.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	product						*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		product of 'multiplicand' and 'multiplier' will be
+//		stored in this instance. The default Number String
+//		Format Specification will be copied from
+//		'multiplicand'.
+//
+//	multiplicand				*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. This
+//		numeric value will be multiplied by 'multiplier'.
+//
+//	multiplier					*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. This
+//		numeric value will be multiplied by 'multiplicand'.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrMathArithNanobot *numStrMathArithmeticNanobot) multiplyNumStrKernels(
+	product *NumberStrKernel,
+	multiplicand *NumberStrKernel,
+	multiplier *NumberStrKernel,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if nStrMathArithNanobot.lock == nil {
+		nStrMathArithNanobot.lock = new(sync.Mutex)
+	}
+
+	nStrMathArithNanobot.lock.Lock()
+
+	defer nStrMathArithNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrMathArithmeticNanobot."+
+			"multiplyNumStrKernels()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if product == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'product' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	nStrMathArithMolecule := numStrMathArithmeticMolecule{}
+
+	var digits01, digits02 []rune
+	var sign01, sign02 NumericSignValueType
+	var scale01, scale02 int
+
+	digits01,
+		scale01,
+		sign01,
+		err = nStrMathArithMolecule.getValidatedDigits(
+		multiplicand,
+		ePrefix.XCpy(
+			"multiplicand"))
+
+	if err != nil {
+		return err
+	}
+
+	digits02,
+		scale02,
+		sign02,
+		err = nStrMathArithMolecule.getValidatedDigits(
+		multiplier,
+		ePrefix.XCpy(
+			"multiplier"))
+
+	if err != nil {
+		return err
+	}
+
+	productSign := NumSignVal.Positive()
+
+	if sign01 != sign02 {
+		productSign = NumSignVal.Negative()
+	}
+
+	return new(numStrMathArithmeticElectron).
+		setFromScaledDigits(
+			product,
+			new(numStrMathArithmeticAtom).
+				multiplyDigitMagnitudes(
+					digits01,
+					digits02),
+			scale01+scale02,
+			productSign,
+			&multiplicand.numStrFormatSpec,
+			ePrefix.XCpy(
+				"product<-multiplicand x multiplier"))
+}
+
+// subtractNumStrKernels
+//
+// Subtracts the numeric value of 'subtrahend' from that
+// of 'minuend' and stores the exact difference in input
+// parameter 'difference'.
+//
+// The number of fractional digits in the difference is
+// equal to the greater number of fractional digits
+// contained in 'minuend' and 'subtrahend'.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the data values contained in input parameter
+//	'difference' will be deleted and reset to new values.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	difference					*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. The
+//		result of subtracting 'subtrahend' from 'minuend'
+//		will be stored in this instance. The default
+//		Number String Format Specification will be copied
+//		from 'minuend'.
+//
+//	minuend						*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel.
+//		'subtrahend' will be subtracted from this numeric
+//		value.
+//
+//	subtrahend					*NumberStrKernel
+//
+//		A pointer to an instance of NumberStrKernel. This
+//		numeric value will be subtracted from 'minuend'.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrMathArithNanobot *numStrMathArithmeticNanobot) subtractNumStrKernels(
+	difference *NumberStrKernel,
+	minuend *NumberStrKernel,
+	subtrahend *NumberStrKernel,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if nStrMathArithNanobot.lock == nil {
+		nStrMathArithNanobot.lock = new(sync.Mutex)
+	}
+
+	nStrMathArithNanobot.lock.Lock()
+
+	defer nStrMathArithNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrMathArithmeticNanobot."+
+			"subtractNumStrKernels()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrMathArithmeticMolecule).
+		addSignedNumStrKernels(
+			difference,
+			minuend,
+			subtrahend,
+			true,
+			ePrefix.XCpy(
+				"difference<-minuend-subtrahend"))
+}
@@ -1,7 +1,9 @@
 package strmech
 
 import (
+	"fmt"
 	ePref "github.com/MikeAustin71/errpref"
+	"math/big"
 	"sync"
 )
 
@@ -61,6 +63,284 @@ func (nStrMathRoundElectron *numStrMathRoundingElectron) roundDown(
 	return err
 }
 
+// roundToDecimalIncrement
+//
+// Rounds the numeric value contained in an instance of
+// NumberStrKernel to the nearest multiple of a decimal
+// rounding increment.
+//
+// The rounding increment is passed as two components,
+// an unsigned integer value ('incrementDigits') and the
+// number of fractional digits ('incrementScale'):
+//
+//	rounding increment = incrementDigits / 10^incrementScale
+//
+//	Examples:
+//		incrementDigits  incrementScale  Rounding Increment
+//		      5                2               0.05
+//		     25                2               0.25
+//		    100                0               100
+//		      1                3               0.001
+//
+// Upon completion, the rounded numeric value stored in
+// 'numStrKernel' will contain exactly 'incrementScale'
+// fractional digits.
+//
+// The rounding algorithm applied to the numeric value is
+// specified by input parameter 'roundingType'. All
+// rounding algorithms, including 'Floor' and 'Ceiling',
+// are applied with respect to the rounding increment.
+//
+//	Examples:
+//		Value   Increment  Rounding Type      Result
+//		 1.23     0.05     HalfAwayFromZero     1.25
+//		 1.225    0.05     HalfToEven           1.20
+//		-1.21     0.25     Floor               -1.25
+//		 1250     100      HalfToEven           1200
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	This method will modify the numeric value contained
+//	in input parameter 'numStrKernel'.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		This instance of NumberStrKernel contains the
+//		numeric value to be rounded. This instance will be
+//		modified to reflect the rounded numeric value.
+//
+//	incrementDigits				*big.Int
+//
+//		The unsigned integer digits of the rounding
+//		increment. This value must be greater than zero.
+//
+//	incrementScale				int
+//
+//		The number of fractional digits contained in the
+//		rounding increment. This value must be greater
+//		than or equal to zero.
+//
+//	roundingType				NumberRoundingType
+//
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied to
+//		the numeric value contained in 'numStrKernel'.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrMathRoundElectron *numStrMathRoundingElectron) roundToDecimalIncrement(
+	numStrKernel *NumberStrKernel,
+	incrementDigits *big.Int,
+	incrementScale int,
+	roundingType NumberRoundingType,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if nStrMathRoundElectron.lock == nil {
+		nStrMathRoundElectron.lock = new(sync.Mutex)
+	}
+
+	nStrMathRoundElectron.lock.Lock()
+
+	defer nStrMathRoundElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"nStrMathRoundElectron."+
+			"roundToDecimalIncrement()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if numStrKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if incrementDigits == nil ||
+		incrementDigits.Sign() <= 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'incrementDigits' is invalid!\n"+
+			"'incrementDigits' is nil or has a value less than\n"+
+			"or equal to zero.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if incrementScale < 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'incrementScale' is invalid!\n"+
+			"'incrementScale' has a value less than zero (0).\n"+
+			"incrementScale = '%v'\n",
+			ePrefix.String(),
+			incrementScale)
+
+		return err
+	}
+
+	numOfFracDigits :=
+		numStrKernel.fractionalDigits.GetRuneArrayLength()
+
+	allDigits := string(numStrKernel.integerDigits.CharsArray) +
+		string(numStrKernel.fractionalDigits.CharsArray)
+
+	// absValue = absDigits / 10^numOfFracDigits
+	absDigits := big.NewInt(0)
+
+	if len(allDigits) > 0 {
+
+		var ok bool
+
+		_,
+			ok = absDigits.SetString(allDigits, 10)
+
+		if !ok {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: The integer and fractional digits contained\n"+
+				"in 'numStrKernel' are invalid!\n"+
+				"Digits = '%v'\n",
+				ePrefix.String(),
+				allDigits)
+
+			return err
+		}
+	}
+
+	bigTen := big.NewInt(10)
+
+	// absValue / increment =
+	//  (absDigits / 10^numOfFracDigits) /
+	//    (incrementDigits / 10^incrementScale) =
+	//      (absDigits x 10^incrementScale) /
+	//        (incrementDigits x 10^numOfFracDigits)
+	dividend := new(big.Int).Mul(
+		absDigits,
+		new(big.Int).Exp(
+			bigTen,
+			big.NewInt(int64(incrementScale)),
+			nil))
+
+	divisor := new(big.Int).Mul(
+		incrementDigits,
+		new(big.Int).Exp(
+			bigTen,
+			big.NewInt(int64(numOfFracDigits)),
+			nil))
+
+	integerQuotient,
+		remainder := new(big.Int).QuoRem(
+		dividend,
+		divisor,
+		new(big.Int))
+
+	var roundedQuotient *big.Int
+
+	roundedQuotient,
+		err = new(numStrMathRoundingQuark).roundIntegerQuotient(
+		integerQuotient,
+		remainder,
+		divisor,
+		numStrKernel.numberSign == NumSignVal.Negative(),
+		roundingType,
+		ePrefix.XCpy(
+			"roundedQuotient"))
+
+	if err != nil {
+		return err
+	}
+
+	// The rounded value is a multiple of the
+	// rounding increment scaled by
+	// 10^incrementScale.
+	resultDigits := []rune(
+		new(big.Int).Mul(
+			roundedQuotient,
+			incrementDigits).Text(10))
+
+	for len(resultDigits) < incrementScale+1 {
+
+		resultDigits = append(
+			[]rune{'0'},
+			resultDigits...)
+	}
+
+	lenIntDigits := len(resultDigits) - incrementScale
+
+	numStrKernel.integerDigits.CharsArray =
+		make([]rune, lenIntDigits)
+
+	copy(numStrKernel.integerDigits.CharsArray,
+		resultDigits[:lenIntDigits])
+
+	if incrementScale > 0 {
+
+		numStrKernel.fractionalDigits.CharsArray =
+			make([]rune, incrementScale)
+
+		copy(numStrKernel.fractionalDigits.CharsArray,
+			resultDigits[lenIntDigits:])
+
+	} else {
+
+		numStrKernel.fractionalDigits.CharsArray = nil
+	}
+
+	_,
+		err = new(numberStrKernelElectron).
+		getSetIsNonZeroValue(
+			numStrKernel,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	return err
+}
+
 // roundUp
 //
 // Rounds a value up by one.
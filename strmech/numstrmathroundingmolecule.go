@@ -0,0 +1,403 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"math/big"
+	"sync"
+)
+
+// numStrMathRoundingMolecule - Provides helper methods
+// used to round the numeric value contained in an
+// instance of NumberStrKernel to a rounding increment or
+// to a specified number of significant digits.
+//
+// All rounding algorithms defined by enumeration
+// NumberRoundingType are supported.
+type numStrMathRoundingMolecule struct {
+	lock *sync.Mutex
+}
+
+// roundToIncrement
+//
+// Rounds the numeric value contained in an instance of
+// NumberStrKernel to the nearest multiple of the
+// rounding increment specified by input parameter
+// 'roundingIncrement'.
+//
+// The number of fractional digits in the rounded result
+// will be equal to the number of fractional digits
+// contained in 'roundingIncrement'.
+//
+//	Examples:
+//		Value   Increment  Rounding Type      Result
+//		 1.23     0.05     HalfAwayFromZero     1.25
+//		 1.225    0.05     HalfToEven           1.20
+//		 7.13     0.25     Ceiling              7.25
+//		-1.21     0.25     Floor               -1.25
+//		 1250     100      HalfToEven           1200
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	This method will modify the numeric value contained
+//	in input parameter 'numStrKernel'.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		This instance of NumberStrKernel contains the
+//		numeric value to be rounded. This instance will be
+//		modified to reflect the rounded numeric value.
+//
+//	roundingIncrement			string
+//
+//		A pure number string specifying the rounding
+//		increment such as "0.05", "0.25" or "100". If this
+//		string is invalid, an error will be returned.
+//
+//	roundingType				NumberRoundingType
+//
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied to
+//		the numeric value contained in 'numStrKernel'.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrMathRoundMolecule *numStrMathRoundingMolecule) roundToIncrement(
+	numStrKernel *NumberStrKernel,
+	roundingIncrement string,
+	roundingType NumberRoundingType,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if nStrMathRoundMolecule.lock == nil {
+		nStrMathRoundMolecule.lock = new(sync.Mutex)
+	}
+
+	nStrMathRoundMolecule.lock.Lock()
+
+	defer nStrMathRoundMolecule.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrMathRoundingMolecule."+
+			"roundToIncrement()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if numStrKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	var incrementDigits *big.Int
+	var incrementScale int
+
+	incrementDigits,
+		incrementScale,
+		err = new(numStrRoundingSpecElectron).
+		parseRoundingIncrement(
+			roundingIncrement,
+			ePrefix.XCpy(
+				"roundingIncrement"))
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrMathRoundingElectron).
+		roundToDecimalIncrement(
+			numStrKernel,
+			incrementDigits,
+			incrementScale,
+			roundingType,
+			ePrefix.XCpy(
+				fmt.Sprintf("numStrKernel<-"+
+					"RoundTo Increment %v",
+					roundingIncrement)))
+}
+
+// roundToSignificantDigits
+//
+// Rounds the numeric value contained in an instance of
+// NumberStrKernel to the number of significant digits
+// specified by input parameter 'significantDigits'.
+//
+// Significant digits are counted from the first non-zero
+// digit. Where the rounded value contains fewer digits
+// than 'significantDigits', trailing zeros are added to
+// the fractional digits. However, trailing zeros are
+// NEVER added to the integer digits.
+//
+// If rounding causes a carry into a new leading digit
+// (9.99 -> 10.0), the excess trailing fractional zero is
+// removed so that the result displays the requested
+// number of significant digits (10).
+//
+// Numeric values equal to zero are NOT modified.
+//
+//	Examples:
+//		Value       Significant  Rounding Type      Result
+//		             Digits
+//		 123456        2         HalfAwayFromZero   120000
+//		 0.0012345     3         HalfToEven         0.00123
+//		-2.5           4         HalfAwayFromZero  -2.500
+//		 9.99          2         HalfAwayFromZero   10
+//		 1.21          2         Ceiling            1.3
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	This method will modify the numeric value contained
+//	in input parameter 'numStrKernel'.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	numStrKernel				*NumberStrKernel
+//
+//		This instance of NumberStrKernel contains the
+//		numeric value to be rounded. This instance will be
+//		modified to reflect the rounded numeric value.
+//
+//	significantDigits			int
+//
+//		The number of significant digits which will remain
+//		after completion of the rounding operation. This
+//		value must be greater than zero.
+//
+//	roundingType				NumberRoundingType
+//
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied to
+//		the numeric value contained in 'numStrKernel'.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrMathRoundMolecule *numStrMathRoundingMolecule) roundToSignificantDigits(
+	numStrKernel *NumberStrKernel,
+	significantDigits int,
+	roundingType NumberRoundingType,
+	errPrefDto *ePref.ErrPrefixDto) error {
+
+	if nStrMathRoundMolecule.lock == nil {
+		nStrMathRoundMolecule.lock = new(sync.Mutex)
+	}
+
+	nStrMathRoundMolecule.lock.Lock()
+
+	defer nStrMathRoundMolecule.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrMathRoundingMolecule."+
+			"roundToSignificantDigits()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if numStrKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if significantDigits < 1 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'significantDigits' is invalid!\n"+
+			"'significantDigits' has a value less than one (1).\n"+
+			"significantDigits = '%v'\n",
+			ePrefix.String(),
+			significantDigits)
+
+		return err
+	}
+
+	lenIntDigits :=
+		numStrKernel.integerDigits.GetRuneArrayLength()
+
+	allDigits := append(
+		append([]rune{},
+			numStrKernel.integerDigits.CharsArray...),
+		numStrKernel.fractionalDigits.CharsArray...)
+
+	firstNonZeroIdx := -1
+
+	for i := 0; i < len(allDigits); i++ {
+
+		if allDigits[i] > '0' &&
+			allDigits[i] <= '9' {
+
+			firstNonZeroIdx = i
+
+			break
+		}
+	}
+
+	if firstNonZeroIdx == -1 {
+		// Zero Value. Nothing to do.
+		return err
+	}
+
+	// The first significant digit occupies
+	// position 10^leadingExponent. The last
+	// significant digit occupies position
+	// 10^roundingExponent.
+	leadingExponent := lenIntDigits - 1 - firstNonZeroIdx
+
+	roundingExponent := leadingExponent - significantDigits + 1
+
+	incrementDigits := big.NewInt(1)
+
+	incrementScale := 0
+
+	if roundingExponent < 0 {
+
+		incrementScale = -roundingExponent
+
+	} else {
+
+		incrementDigits.Exp(
+			big.NewInt(10),
+			big.NewInt(int64(roundingExponent)),
+			nil)
+	}
+
+	err = new(numStrMathRoundingElectron).
+		roundToDecimalIncrement(
+			numStrKernel,
+			incrementDigits,
+			incrementScale,
+			roundingType,
+			ePrefix.XCpy(
+				fmt.Sprintf("numStrKernel<-"+
+					"RoundTo %v-significant digits",
+					significantDigits)))
+
+	if err != nil || incrementScale == 0 {
+		return err
+	}
+
+	// Check for a carry into a new leading
+	// digit: 9.99 -> 10.0
+	numOfSignificantDigits := 0
+
+	foundNonZero := false
+
+	allDigits = append(
+		append([]rune{},
+			numStrKernel.integerDigits.CharsArray...),
+		numStrKernel.fractionalDigits.CharsArray...)
+
+	for i := 0; i < len(allDigits); i++ {
+
+		if allDigits[i] != '0' {
+			foundNonZero = true
+		}
+
+		if foundNonZero {
+			numOfSignificantDigits++
+		}
+	}
+
+	lenFracDigits :=
+		numStrKernel.fractionalDigits.GetRuneArrayLength()
+
+	if numOfSignificantDigits > significantDigits &&
+		lenFracDigits > 0 &&
+		numStrKernel.fractionalDigits.CharsArray[lenFracDigits-1] == '0' {
+
+		numStrKernel.fractionalDigits.CharsArray =
+			numStrKernel.fractionalDigits.CharsArray[:lenFracDigits-1]
+
+		_,
+			err = new(numberStrKernelElectron).
+			getSetIsNonZeroValue(
+				numStrKernel,
+				ePrefix.XCpy(
+					"numStrKernel"))
+	}
+
+	return err
+}
@@ -21,6 +21,11 @@ type numStrMathRoundingNanobot struct {
 // apply numeric value formatting algorithms such as
 // 'Truncate', 'Floor' and 'Ceiling'.
 //
+// The rounding target may be a number of fractional
+// digits, a number of significant digits or a rounding
+// increment as configured in the Number String Rounding
+// Specification.
+//
 // ----------------------------------------------------------------
 //
 // IMPORTANT
@@ -132,6 +137,28 @@ func (nStrMathRoundNanobot *numStrMathRoundingNanobot) roundNumStrKernel(
 		return err
 	}
 
+	if numStrRoundingSpec.roundToSignificantDigits > 0 {
+
+		return new(numStrMathRoundingMolecule).
+			roundToSignificantDigits(
+				numStrKernel,
+				numStrRoundingSpec.roundToSignificantDigits,
+				roundingType,
+				ePrefix.XCpy(
+					"numStrKernel"))
+	}
+
+	if len(numStrRoundingSpec.roundingIncrement) > 0 {
+
+		return new(numStrMathRoundingMolecule).
+			roundToIncrement(
+				numStrKernel,
+				numStrRoundingSpec.roundingIncrement,
+				roundingType,
+				ePrefix.XCpy(
+					"numStrKernel"))
+	}
+
 	var roundToFractionalDigits int
 
 	roundToFractionalDigits =
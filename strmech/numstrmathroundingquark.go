@@ -3,6 +3,7 @@ package strmech
 import (
 	"fmt"
 	ePref "github.com/MikeAustin71/errpref"
+	"math/big"
 	"sync"
 )
 
@@ -95,3 +96,260 @@ func (nStrMathRoundQuark *numStrMathRoundingQuark) preRoundingValidation(
 
 	return err
 }
+
+// roundIntegerQuotient
+//
+// Receives the components of an integer division
+// operation and applies the rounding algorithm
+// specified by input parameter 'roundingType' in order
+// to round the exact quotient to an integer value.
+//
+// The exact quotient is computed as:
+//
+//	integerQuotient + (remainder / divisor)
+//
+// All three big.Int input values are treated as unsigned
+// magnitudes. The number sign of the exact quotient is
+// specified by input parameter 'isNegative'. The number
+// sign is required in order to correctly apply rounding
+// algorithms such as 'Floor', 'Ceiling',
+// 'HalfUpWithNegNums' and 'HalfDownWithNegNums'.
+//
+// The returned rounded quotient is also an unsigned
+// magnitude. It will be equal to 'integerQuotient' or
+// 'integerQuotient' plus one (+1).
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	integerQuotient				*big.Int
+//
+//		The integer portion of the quotient. This value
+//		must be greater than or equal to zero.
+//
+//	remainder					*big.Int
+//
+//		The remainder of the division operation. This value
+//		must be greater than or equal to zero and less than
+//		'divisor'.
+//
+//	divisor						*big.Int
+//
+//		The divisor used in the division operation. This
+//		value must be greater than zero.
+//
+//	isNegative					bool
+//
+//		When set to 'true', this parameter signals that the
+//		exact quotient is a negative value.
+//
+//	roundingType				NumberRoundingType
+//
+//		This enumeration parameter is used to specify the
+//		type of rounding algorithm that will be applied to
+//		the exact quotient. 'NoRounding' is treated as
+//		'Truncate'.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string which
+//		is included in all returned error messages. Usually,
+//		it contains the name of the calling method or methods
+//		listed as a function chain.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref' software
+//		package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	roundedQuotient				*big.Int
+//
+//		If this method completes successfully, this
+//		parameter will return the unsigned magnitude of the
+//		quotient rounded to an integer value.
+//
+//	err							error
+//
+//		If this method completes successfully, this returned
+//		error Type is set equal to 'nil'. If errors are
+//		encountered during processing, the returned error
+//		Type will encapsulate an error message.
+//
+//		If an error message is returned, the text value for
+//		input parameter 'errPrefDto' (error prefix) will be
+//		prefixed or attached at the beginning of the error
+//		message.
+func (nStrMathRoundQuark *numStrMathRoundingQuark) roundIntegerQuotient(
+	integerQuotient *big.Int,
+	remainder *big.Int,
+	divisor *big.Int,
+	isNegative bool,
+	roundingType NumberRoundingType,
+	errPrefDto *ePref.ErrPrefixDto) (
+	roundedQuotient *big.Int,
+	err error) {
+
+	if nStrMathRoundQuark.lock == nil {
+		nStrMathRoundQuark.lock = new(sync.Mutex)
+	}
+
+	nStrMathRoundQuark.lock.Lock()
+
+	defer nStrMathRoundQuark.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"nStrMathRoundQuark."+
+			"roundIntegerQuotient()",
+		"")
+
+	if err != nil {
+		return roundedQuotient, err
+	}
+
+	if integerQuotient == nil ||
+		remainder == nil ||
+		divisor == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: One or more of the input parameters\n"+
+			"'integerQuotient', 'remainder' or 'divisor'\n"+
+			"is a nil pointer!\n",
+			ePrefix.String())
+
+		return roundedQuotient, err
+	}
+
+	if divisor.Sign() <= 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'divisor' is invalid!\n"+
+			"'divisor' has a value less than or equal to zero.\n"+
+			"divisor = '%v'\n",
+			ePrefix.String(),
+			divisor.Text(10))
+
+		return roundedQuotient, err
+	}
+
+	// Compare the remainder to one half of
+	// the divisor: 2 x remainder vs. divisor
+	//  -1 = Less than half
+	//   0 = Exactly half
+	//  +1 = Greater than half
+	halfComparison := new(big.Int).
+		Lsh(remainder, 1).
+		Cmp(divisor)
+
+	hasRemainder := remainder.Sign() != 0
+
+	quotientIsOdd := integerQuotient.Bit(0) == 1
+
+	// roundUp signals that the magnitude
+	// of the quotient will be increased
+	// by one.
+	var roundUp bool
+
+	switch roundingType {
+
+	case NumRoundType.NoRounding(),
+		NumRoundType.Truncate():
+
+		roundUp = false
+
+	case NumRoundType.HalfUpWithNegNums():
+
+		if isNegative {
+			roundUp = halfComparison > 0
+		} else {
+			roundUp = halfComparison >= 0
+		}
+
+	case NumRoundType.HalfDownWithNegNums():
+
+		if isNegative {
+			roundUp = halfComparison >= 0
+		} else {
+			roundUp = halfComparison > 0
+		}
+
+	case NumRoundType.HalfAwayFromZero():
+
+		roundUp = halfComparison >= 0
+
+	case NumRoundType.HalfTowardsZero():
+
+		roundUp = halfComparison > 0
+
+	case NumRoundType.HalfToEven():
+
+		roundUp = halfComparison > 0 ||
+			(halfComparison == 0 && quotientIsOdd)
+
+	case NumRoundType.HalfToOdd():
+
+		roundUp = halfComparison > 0 ||
+			(halfComparison == 0 && !quotientIsOdd)
+
+	case NumRoundType.Randomly():
+
+		if halfComparison != 0 {
+
+			roundUp = halfComparison > 0
+
+			break
+		}
+
+		var randomNum64 int64
+
+		randomNum64,
+			err = new(numStrMathQuark).randomInt64(
+			int64(70),
+			ePrefix.XCpy(""))
+
+		if err != nil {
+			return roundedQuotient, err
+		}
+
+		roundUp = randomNum64%2 == 0
+
+	case NumRoundType.Floor():
+
+		roundUp = isNegative && hasRemainder
+
+	case NumRoundType.Ceiling():
+
+		roundUp = !isNegative && hasRemainder
+
+	default:
+
+		err = fmt.Errorf("%v\n"+
+			"Error: This rounding algorithm selected is invalid!\n"+
+			"Rounding Type string value  = '%v'\n"+
+			"Rounding Type integer value = '%v'\n",
+			ePrefix.String(),
+			roundingType.String(),
+			roundingType.XValueInt())
+
+		return roundedQuotient, err
+	}
+
+	roundedQuotient = new(big.Int).Set(integerQuotient)
+
+	if roundUp {
+		roundedQuotient.Add(
+			roundedQuotient,
+			big.NewInt(1))
+	}
+
+	return roundedQuotient, err
+}
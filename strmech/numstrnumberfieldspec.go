@@ -3,7 +3,9 @@ package strmech
 import (
 	"fmt"
 	ePref "github.com/MikeAustin71/errpref"
+	"strings"
 	"sync"
+	"unicode"
 )
 
 // NumStrNumberFieldSpec
@@ -138,6 +140,20 @@ type NumStrNumberFieldSpec struct {
 	//			Text Field String =
 	//				"5672.1234567"
 
+	fillChar rune
+	//	The character used to pad the numeric value
+	//	string to the length of the number field. A zero
+	//	value signals that the default fill character, a
+	//	space (' '), will be used.
+	//
+	//		Example
+	//	        Number String = "12.50"
+	//			fieldLength = 8
+	//			fieldJustification = TxtJustify.Right()
+	//			fillChar = '*'
+	//			Text Field String =
+	//				"***12.50"
+
 	lock *sync.Mutex
 }
 
@@ -474,6 +490,27 @@ func (nStrNumberFieldSpec *NumStrNumberFieldSpec) GetNumFieldLength() int {
 	return nStrNumberFieldSpec.fieldLength
 }
 
+// GetFillChar - Returns the character used to pad the
+// numeric value string to the length of the number field.
+// If no fill character has been configured, the default
+// space character (' ') is returned.
+func (nStrNumberFieldSpec *NumStrNumberFieldSpec) GetFillChar() rune {
+
+	if nStrNumberFieldSpec.lock == nil {
+		nStrNumberFieldSpec.lock = new(sync.Mutex)
+	}
+
+	nStrNumberFieldSpec.lock.Lock()
+
+	defer nStrNumberFieldSpec.lock.Unlock()
+
+	if nStrNumberFieldSpec.fillChar == 0 {
+		return ' '
+	}
+
+	return nStrNumberFieldSpec.fillChar
+}
+
 // GetNumFieldJustification - Returns the text justification
 // specification for the current instance of
 // NumStrNumberFieldSpec.
@@ -1621,6 +1658,86 @@ func (nStrNumberFieldSpec *NumStrNumberFieldSpec) SetFieldJustification(
 					"<-fieldJustification"))
 }
 
+// SetFillChar - Sets the character used to pad the numeric
+// value string to the length of the number field. By
+// default, number fields are padded with spaces.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	fillChar                   rune
+//	   - The character used to pad the numeric value string
+//	     within the number field. If this character is a
+//	     control character, an error will be returned.
+//
+//	errorPrefix                interface{}
+//	   - This object encapsulates error prefix text which is
+//	     included in all returned error messages. Usually, it
+//	     contains the name of the calling method or methods
+//	     listed as a method or function chain of execution.
+//
+//	     If no error prefix information is needed, set this
+//	     parameter to 'nil'.
+//
+//	     This empty interface must be convertible to one of
+//	     the types supported by NewIEmpty() in the 'errpref'
+//	     software package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//	   - If this method completes successfully, the returned
+//	     error Type is set equal to 'nil'. If errors are
+//	     encountered during processing, the returned error Type
+//	     will encapsulate an error message. The 'errorPrefix'
+//	     text will be attached to the beginning of the error
+//	     message.
+func (nStrNumberFieldSpec *NumStrNumberFieldSpec) SetFillChar(
+	fillChar rune,
+	errorPrefix interface{}) error {
+
+	if nStrNumberFieldSpec.lock == nil {
+		nStrNumberFieldSpec.lock = new(sync.Mutex)
+	}
+
+	nStrNumberFieldSpec.lock.Lock()
+
+	defer nStrNumberFieldSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrNumberFieldSpec."+
+			"SetFillChar()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if unicode.IsControl(fillChar) {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'fillChar' is invalid!\n"+
+			"'fillChar' is a control character.\n"+
+			"fillChar integer value = '%v'\n",
+			ePrefix.String(),
+			fillChar)
+
+		return err
+	}
+
+	nStrNumberFieldSpec.fillChar = fillChar
+
+	return err
+}
+
 //	SetNOP
 //
 //	Reconfigures the current instance of
@@ -1800,6 +1917,9 @@ func (nStrNumberFieldSpecNanobot *numStrNumberFieldSpecNanobot) copyNStrNumberFi
 	destinationNumberFieldSpec.fieldJustification =
 		sourceNumberFieldSpec.fieldJustification
 
+	destinationNumberFieldSpec.fillChar =
+		sourceNumberFieldSpec.fillChar
+
 	return err
 }
 
@@ -1995,6 +2115,8 @@ func (nStrNumFieldSpecAtom *numStrNumberFieldSpecAtom) empty(
 	nStrNumFieldSpec.fieldLength = -2
 
 	nStrNumFieldSpec.fieldJustification = TxtJustify.None()
+
+	nStrNumFieldSpec.fillChar = 0
 }
 
 // equal - Receives a pointer to two instances of
@@ -2066,6 +2188,12 @@ func (nStrNumFieldSpecAtom *numStrNumberFieldSpecAtom) equal(
 		return false
 	}
 
+	if nStrNumFieldSpec1.fillChar !=
+		nStrNumFieldSpec2.fillChar {
+
+		return false
+	}
+
 	return true
 }
 
@@ -2137,9 +2265,57 @@ func (nStrNumFieldSpecAtom *numStrNumberFieldSpecAtom) setNOP(
 
 	nStrNumFieldSpec.fieldJustification = TxtJustify.None()
 
+	nStrNumFieldSpec.fillChar = 0
+
 	return
 }
 
+// replaceFillChars - Receives a number string
+// ('justifiedStr') produced by justifying 'textStr' within
+// a number field padded with spaces. The space characters
+// added as padding on either side of 'textStr' are
+// replaced with the fill character configured for
+// 'nStrNumFieldSpec'.
+//
+// If 'nStrNumFieldSpec' uses the default space fill
+// character, or if 'justifiedStr' does not contain
+// 'textStr', 'justifiedStr' is returned unchanged.
+func (nStrNumFieldSpecAtom *numStrNumberFieldSpecAtom) replaceFillChars(
+	nStrNumFieldSpec *NumStrNumberFieldSpec,
+	justifiedStr string,
+	textStr string) string {
+
+	if nStrNumFieldSpecAtom.lock == nil {
+		nStrNumFieldSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrNumFieldSpecAtom.lock.Lock()
+
+	defer nStrNumFieldSpecAtom.lock.Unlock()
+
+	if nStrNumFieldSpec == nil ||
+		nStrNumFieldSpec.fillChar == 0 ||
+		nStrNumFieldSpec.fillChar == ' ' ||
+		len(justifiedStr) <= len(textStr) {
+
+		return justifiedStr
+	}
+
+	textIdx := strings.Index(justifiedStr, textStr)
+
+	if textIdx < 0 {
+		return justifiedStr
+	}
+
+	fillStr := string(nStrNumFieldSpec.fillChar)
+
+	return strings.Repeat(fillStr, textIdx) +
+		textStr +
+		strings.Repeat(
+			fillStr,
+			len(justifiedStr)-textIdx-len(textStr))
+}
+
 // setNStrNumberFieldLength - Deletes and resets the member
 // variable data value for 'NumStrNumberFieldSpec.fieldLength'
 // contained in the instance of NumStrNumberFieldSpec passed
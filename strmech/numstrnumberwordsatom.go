@@ -0,0 +1,470 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"strings"
+	"sync"
+)
+
+// numStrNumberWordsAtom
+//
+// Provides helper methods used to spell out numeric
+// values as words in English, French and German.
+type numStrNumberWordsAtom struct {
+	lock *sync.Mutex
+}
+
+// fractionalDigitsToWords
+//
+// Spells out each digit in an array of fractional digits
+// as a separate word in the language specified by input
+// parameter 'languageCode'.
+//
+//	Example:
+//		fractionalDigits	= "056"
+//		languageCode		= "EN"
+//		words				= "zero five six"
+//
+// 'languageCode' must be set to one of the following
+// values:
+//
+//	"EN"	English
+//	"FR"	French
+//	"DE"	German
+//
+// If 'fractionalDigits' contains characters other than
+// the digits '0' through '9', an error is returned.
+func (nStrNumWordsAtom *numStrNumberWordsAtom) fractionalDigitsToWords(
+	fractionalDigits []rune,
+	languageCode string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	words string,
+	err error) {
+
+	if nStrNumWordsAtom.lock == nil {
+		nStrNumWordsAtom.lock = new(sync.Mutex)
+	}
+
+	nStrNumWordsAtom.lock.Lock()
+
+	defer nStrNumWordsAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrNumberWordsAtom."+
+			"fractionalDigitsToWords()",
+		"")
+
+	if err != nil {
+		return words, err
+	}
+
+	digitWords := new(numStrNumberWordsQuark).
+		getDigitWords(languageCode)
+
+	wordArray := make([]string, len(fractionalDigits))
+
+	for i := 0; i < len(fractionalDigits); i++ {
+
+		if fractionalDigits[i] < '0' ||
+			fractionalDigits[i] > '9' {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'fractionalDigits' is invalid!\n"+
+				"'fractionalDigits' contains a non-numeric character.\n"+
+				"fractionalDigits = '%v'\n",
+				ePrefix.String(),
+				string(fractionalDigits))
+
+			return words, err
+		}
+
+		wordArray[i] = digitWords[fractionalDigits[i]-'0']
+	}
+
+	words = strings.Join(wordArray, " ")
+
+	return words, err
+}
+
+// integerToWords
+//
+// Spells out an integer value as words in the language
+// specified by input parameter 'languageCode'.
+//
+// Large numbers are named using the short or long scale
+// as specified by input parameter 'numberScale'.
+//
+//	Examples:
+//	  1,234,567 "EN" Short Scale
+//		"one million two hundred thirty-four thousand
+//		five hundred sixty-seven"
+//
+//	  1,500,000,000 "EN" Long Scale
+//		"one thousand five hundred million"
+//
+//	  1,500,000,000 "FR" Long Scale
+//		"un milliard cinq cents millions"
+//
+//	  1,500,000,000 "DE" Long Scale
+//		"eine Milliarde fünfhundert Millionen"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	integerDigits				[]rune
+//
+//		An array of numeric digits ('0' through '9')
+//		representing a positive integer value. Leading
+//		zeros are ignored.
+//
+//		The maximum number of significant digits is 66.
+//		If this limit is exceeded, an error is returned.
+//
+//	languageCode				string
+//
+//		Specifies the language used to spell out the
+//		integer value. 'languageCode' must be set to one
+//		of the following values:
+//
+//			"EN"	English
+//			"FR"	French
+//			"DE"	German
+//
+//	isBritish					bool
+//
+//		If this parameter is set to 'true' and
+//		'languageCode' is "EN", the word "and" will be
+//		inserted before the tens and units in accordance
+//		with British English usage.
+//
+//			"one hundred and five"
+//			"one thousand and five"
+//
+//	numberScale					NumberWordsScale
+//
+//		Specifies the naming system for large numbers.
+//		This parameter must be set to one of the
+//		following values:
+//
+//			NumWordsScale.ShortScale()
+//			NumWordsScale.LongScale()
+//
+//	isFinalWord					bool
+//
+//		If set to 'true', the returned words will not be
+//		followed by a noun such as a currency unit.
+//
+//		In German, a final value of one is written as
+//		"eins" when 'isFinalWord' is 'true' and as "ein"
+//		when 'isFinalWord' is 'false' ("ein Euro").
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	words						string
+//
+//		If this method completes successfully, this
+//		parameter will return the integer value spelled
+//		out as words.
+//
+//	isWholeMillions				bool
+//
+//		This parameter is set to 'true' if the integer
+//		value is greater than or equal to one million and
+//		the last six digits are all zero. In French, a
+//		currency unit following such a value is preceded
+//		by "de" ("un million d'euros").
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+func (nStrNumWordsAtom *numStrNumberWordsAtom) integerToWords(
+	integerDigits []rune,
+	languageCode string,
+	isBritish bool,
+	numberScale NumberWordsScale,
+	isFinalWord bool,
+	errPrefDto *ePref.ErrPrefixDto) (
+	words string,
+	isWholeMillions bool,
+	err error) {
+
+	if nStrNumWordsAtom.lock == nil {
+		nStrNumWordsAtom.lock = new(sync.Mutex)
+	}
+
+	nStrNumWordsAtom.lock.Lock()
+
+	defer nStrNumWordsAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrNumberWordsAtom."+
+			"integerToWords()",
+		"")
+
+	if err != nil {
+		return words, isWholeMillions, err
+	}
+
+	nStrNumWordsQuark := numStrNumberWordsQuark{}
+
+	scaleNames := nStrNumWordsQuark.getScaleNames(
+		languageCode,
+		numberScale)
+
+	if len(scaleNames) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameters 'languageCode' and 'numberScale'\n"+
+			"do not specify a valid language and number scale.\n"+
+			"languageCode = '%v'\n"+
+			"numberScale = '%v'\n",
+			ePrefix.String(),
+			languageCode,
+			numberScale.String())
+
+		return words, isWholeMillions, err
+	}
+
+	groupSize := 3
+
+	if languageCode == "EN" &&
+		numberScale == NumWordsScale.LongScale() {
+
+		groupSize = 6
+	}
+
+	var groups []int
+
+	groups,
+		err = new(numStrNumberWordsElectron).getIntegerGroups(
+		integerDigits,
+		groupSize,
+		ePrefix.XCpy(
+			"groups<-integerDigits"))
+
+	if err != nil {
+		return words, isWholeMillions, err
+	}
+
+	lenGroups := len(groups)
+
+	if lenGroups == 0 {
+
+		words = nStrNumWordsQuark.getDigitWords(
+			languageCode)[0]
+
+		return words, isWholeMillions, err
+	}
+
+	if groupSize == 6 {
+
+		isWholeMillions = lenGroups > 1 &&
+			groups[lenGroups-1] == 0
+
+	} else {
+
+		isWholeMillions = lenGroups > 2 &&
+			groups[lenGroups-1] == 0 &&
+			groups[lenGroups-2] == 0
+	}
+
+	var wordArray []string
+
+	switch languageCode {
+
+	case "EN":
+
+		for i := 0; i < lenGroups; i++ {
+
+			scaleIdx := lenGroups - 1 - i
+
+			if groups[i] == 0 {
+				continue
+			}
+
+			if scaleIdx == 0 &&
+				isBritish &&
+				groups[i] < 100 &&
+				len(wordArray) > 0 {
+
+				wordArray = append(wordArray, "and")
+			}
+
+			if groupSize == 6 {
+
+				wordArray = append(wordArray,
+					nStrNumWordsQuark.englishBelowMillion(
+						groups[i],
+						isBritish))
+
+				if scaleIdx > 0 {
+					wordArray = append(wordArray,
+						scaleNames[scaleIdx-1])
+				}
+
+				continue
+			}
+
+			wordArray = append(wordArray,
+				nStrNumWordsQuark.englishBelowThousand(
+					groups[i],
+					isBritish))
+
+			if scaleIdx == 1 {
+
+				wordArray = append(wordArray, "thousand")
+
+			} else if scaleIdx > 1 {
+
+				wordArray = append(wordArray,
+					scaleNames[scaleIdx-2])
+			}
+		}
+
+	case "FR":
+
+		for i := 0; i < lenGroups; i++ {
+
+			scaleIdx := lenGroups - 1 - i
+
+			if groups[i] == 0 {
+				continue
+			}
+
+			switch {
+
+			case scaleIdx == 0:
+
+				wordArray = append(wordArray,
+					nStrNumWordsQuark.frenchBelowThousand(
+						groups[i],
+						true))
+
+			case scaleIdx == 1:
+
+				// "mille" is invariable and is never
+				// preceded by "un".
+				if groups[i] > 1 {
+
+					wordArray = append(wordArray,
+						nStrNumWordsQuark.frenchBelowThousand(
+							groups[i],
+							false))
+				}
+
+				wordArray = append(wordArray, "mille")
+
+			default:
+
+				scaleName := scaleNames[scaleIdx-2]
+
+				if groups[i] > 1 {
+					scaleName += "s"
+				}
+
+				wordArray = append(wordArray,
+					nStrNumWordsQuark.frenchBelowThousand(
+						groups[i],
+						true),
+					scaleName)
+			}
+		}
+
+	case "DE":
+
+		// Values less than one million are
+		// written as a single compound word.
+		lowValue := groups[lenGroups-1]
+
+		if lenGroups > 1 {
+			lowValue += groups[lenGroups-2] * 1000
+		}
+
+		for i := 0; i < lenGroups-2; i++ {
+
+			scaleIdx := lenGroups - 1 - i
+
+			if groups[i] == 0 {
+				continue
+			}
+
+			scaleName := scaleNames[scaleIdx-2]
+
+			if groups[i] == 1 {
+
+				// Million, Milliarde etc. are feminine
+				wordArray = append(wordArray,
+					"eine",
+					scaleName)
+
+				continue
+			}
+
+			numberWord := nStrNumWordsQuark.germanBelowThousand(
+				groups[i],
+				false)
+
+			if strings.HasSuffix(numberWord, "ein") {
+				numberWord += "e"
+			}
+
+			if strings.HasSuffix(scaleName, "e") {
+				scaleName += "n"
+			} else {
+				scaleName += "en"
+			}
+
+			wordArray = append(wordArray,
+				numberWord,
+				scaleName)
+		}
+
+		if lowValue > 0 {
+
+			wordArray = append(wordArray,
+				nStrNumWordsQuark.germanBelowMillion(
+					lowValue,
+					isFinalWord))
+		}
+
+	default:
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'languageCode' is invalid!\n"+
+			"languageCode = '%v'\n",
+			ePrefix.String(),
+			languageCode)
+
+		return words, isWholeMillions, err
+	}
+
+	words = strings.Join(wordArray, " ")
+
+	return words, isWholeMillions, err
+}
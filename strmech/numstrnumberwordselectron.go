@@ -0,0 +1,321 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"strings"
+	"sync"
+)
+
+// numStrNumberWordsElectron
+//
+// Provides helper methods used to spell out numeric
+// values as words in English, French and German.
+type numStrNumberWordsElectron struct {
+	lock *sync.Mutex
+}
+
+// getCurrencyUnitName
+//
+// Returns the singular or plural form of a currency
+// unit name for use in number words.
+//
+// Currency unit names are taken from the Country Culture
+// Specification (NumStrFmtCountryCultureSpec member
+// variables 'CurrencyName' and 'MinorCurrencyName').
+//
+// English and French unit names are converted to lower
+// case and form the plural by adding 's'. The English
+// minor unit "Pence" has the singular form "penny".
+//
+// German unit names are nouns and retain their
+// capitalization. German currency units used with
+// numbers do not change in the plural ("zwei Euro",
+// "fünfzig Cent").
+//
+//	Examples:
+//		"EN", "Dollar", plural	-> "dollars"
+//		"EN", "Pence", singular	-> "penny"
+//		"FR", "Euro", plural	-> "euros"
+//		"DE", "Euro", plural	-> "Euro"
+func (nStrNumWordsElectron *numStrNumberWordsElectron) getCurrencyUnitName(
+	languageCode string,
+	unitName string,
+	isPlural bool) string {
+
+	if nStrNumWordsElectron.lock == nil {
+		nStrNumWordsElectron.lock = new(sync.Mutex)
+	}
+
+	nStrNumWordsElectron.lock.Lock()
+
+	defer nStrNumWordsElectron.lock.Unlock()
+
+	unitName = strings.TrimSpace(unitName)
+
+	if languageCode == "DE" {
+
+		return unitName
+	}
+
+	unitName = strings.ToLower(unitName)
+
+	if unitName == "pence" {
+
+		if isPlural {
+			return "pence"
+		}
+
+		return "penny"
+	}
+
+	if isPlural &&
+		!strings.HasSuffix(unitName, "s") {
+
+		unitName += "s"
+	}
+
+	return unitName
+}
+
+// getIntegerGroups
+//
+// Receives an array of integer digits and converts them
+// to an array of integer values, each of which contains
+// 'groupSize' digits. The first element of the returned
+// array contains the most significant digits.
+//
+//	Example:
+//		integerDigits	= "1234567"
+//		groupSize		= 3
+//		groups			= [1, 234, 567]
+//
+// Leading zeros are ignored. If the integer value is
+// zero, an empty array is returned.
+//
+// The maximum number of significant integer digits
+// which can be spelled out as words is 66. This is the
+// range covered by the largest supported scale names
+// ("vigintillion" on the short scale, "décilliard" on the
+// long scale). If 'integerDigits' contains more than 66
+// significant digits, an error is returned.
+//
+// If 'integerDigits' contains characters other than the
+// digits '0' through '9', an error is returned.
+func (nStrNumWordsElectron *numStrNumberWordsElectron) getIntegerGroups(
+	integerDigits []rune,
+	groupSize int,
+	errPrefDto *ePref.ErrPrefixDto) (
+	groups []int,
+	err error) {
+
+	if nStrNumWordsElectron.lock == nil {
+		nStrNumWordsElectron.lock = new(sync.Mutex)
+	}
+
+	nStrNumWordsElectron.lock.Lock()
+
+	defer nStrNumWordsElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrNumberWordsElectron."+
+			"getIntegerGroups()",
+		"")
+
+	if err != nil {
+		return groups, err
+	}
+
+	if groupSize < 1 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'groupSize' is invalid!\n"+
+			"'groupSize' must be greater than zero.\n"+
+			"groupSize = '%v'\n",
+			ePrefix.String(),
+			groupSize)
+
+		return groups, err
+	}
+
+	maxIntegerDigits := 66
+
+	firstSignificantDigit := len(integerDigits)
+
+	for i := 0; i < len(integerDigits); i++ {
+
+		if integerDigits[i] < '0' ||
+			integerDigits[i] > '9' {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'integerDigits' is invalid!\n"+
+				"'integerDigits' contains a non-numeric character.\n"+
+				"integerDigits = '%v'\n",
+				ePrefix.String(),
+				string(integerDigits))
+
+			return groups, err
+		}
+
+		if integerDigits[i] != '0' &&
+			firstSignificantDigit == len(integerDigits) {
+
+			firstSignificantDigit = i
+		}
+	}
+
+	significantDigits := integerDigits[firstSignificantDigit:]
+
+	lenSigDigits := len(significantDigits)
+
+	if lenSigDigits > maxIntegerDigits {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The integer value is out of range!\n"+
+			"Numbers spelled out as words are limited to a\n"+
+			"maximum of %v integer digits.\n"+
+			"Number of integer digits = '%v'\n",
+			ePrefix.String(),
+			maxIntegerDigits,
+			lenSigDigits)
+
+		return groups, err
+	}
+
+	firstGroupLen := lenSigDigits % groupSize
+
+	if firstGroupLen == 0 {
+		firstGroupLen = groupSize
+	}
+
+	groupValue := 0
+
+	for i := 0; i < lenSigDigits; i++ {
+
+		groupValue = groupValue*10 +
+			int(significantDigits[i]-'0')
+
+		if i+1 == firstGroupLen ||
+			(i+1 > firstGroupLen &&
+				(i+1-firstGroupLen)%groupSize == 0) {
+
+			groups = append(groups, groupValue)
+
+			groupValue = 0
+		}
+	}
+
+	return groups, err
+}
+
+// getLanguageCode
+//
+// Returns the language code used to spell out numbers as
+// words for the country identified by the Country
+// Culture Specification passed as input parameter
+// 'countryCultureSpec'.
+//
+// The language is determined from the two character
+// country code ('CountryCodeTwoChar'). Supported
+// countries and their language codes are listed as
+// follows:
+//
+//	Country Code	Language Code	Default Scale
+//	 "US"			 "EN"			 Short Scale
+//	 "GB"			 "EN"			 Short Scale
+//	 "FR"			 "FR"			 Long Scale
+//	 "DE"			 "DE"			 Long Scale
+//
+// Return parameter 'isBritish' is set to 'true' for
+// "GB". British English inserts the word "and" before
+// the tens and units of a number.
+//
+// If the country is not supported, an error is
+// returned.
+func (nStrNumWordsElectron *numStrNumberWordsElectron) getLanguageCode(
+	countryCultureSpec *NumStrFmtCountryCultureSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	languageCode string,
+	isBritish bool,
+	defaultScale NumberWordsScale,
+	err error) {
+
+	if nStrNumWordsElectron.lock == nil {
+		nStrNumWordsElectron.lock = new(sync.Mutex)
+	}
+
+	nStrNumWordsElectron.lock.Lock()
+
+	defer nStrNumWordsElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrNumberWordsElectron."+
+			"getLanguageCode()",
+		"")
+
+	if err != nil {
+		return languageCode, isBritish, defaultScale, err
+	}
+
+	if countryCultureSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'countryCultureSpec' is a nil pointer!\n",
+			ePrefix.String())
+
+		return languageCode, isBritish, defaultScale, err
+	}
+
+	countryCode := strings.ToUpper(
+		strings.TrimSpace(
+			countryCultureSpec.CountryCodeTwoChar))
+
+	switch countryCode {
+
+	case "US":
+
+		languageCode = "EN"
+
+		defaultScale = NumWordsScale.ShortScale()
+
+	case "GB":
+
+		languageCode = "EN"
+
+		isBritish = true
+
+		defaultScale = NumWordsScale.ShortScale()
+
+	case "FR":
+
+		languageCode = "FR"
+
+		defaultScale = NumWordsScale.LongScale()
+
+	case "DE":
+
+		languageCode = "DE"
+
+		defaultScale = NumWordsScale.LongScale()
+
+	default:
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'countryCultureSpec' is invalid!\n"+
+			"Numbers can only be spelled out as words for the\n"+
+			"following countries: US, GB, FR and DE.\n"+
+			"countryCultureSpec.CountryCodeTwoChar = '%v'\n",
+			ePrefix.String(),
+			countryCultureSpec.CountryCodeTwoChar)
+	}
+
+	return languageCode, isBritish, defaultScale, err
+}
@@ -0,0 +1,569 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// numStrNumberWordsNanobot
+//
+// Provides helper methods used to spell out the numeric
+// value of a NumberStrKernel as words in English, French
+// and German.
+type numStrNumberWordsNanobot struct {
+	lock *sync.Mutex
+}
+
+// formatCurrencyWords
+//
+// Spells out the numeric value of a NumberStrKernel
+// instance as a currency amount in words. This format is
+// typically used when writing cheques and legal
+// documents.
+//
+// The numeric value is first rounded to the number of
+// minor currency unit digits specified by
+// 'countryCultureSpec.CurrencyDecimalDigits'. The
+// integer value is then spelled out in words followed by
+// the currency unit name. The minor currency units are
+// presented either as a fraction or in words. The first
+// letter of the returned string is always capitalized.
+//
+//	Examples: 1234.56
+//
+//	 US	minorUnitsInWords = false
+//		"One thousand two hundred thirty-four dollars and 56/100"
+//
+//	 US	minorUnitsInWords = true
+//		"One thousand two hundred thirty-four dollars and fifty-six cents"
+//
+//	 France minorUnitsInWords = false
+//		"Mille deux cent trente-quatre euros et 56/100"
+//
+//	 Germany minorUnitsInWords = true
+//		"Eintausendzweihundertvierunddreißig Euro und sechsundfünfzig Cent"
+//
+// For a description of the input parameters, see method
+// NumberStrKernel.FmtCurrencyWords().
+func (nStrNumWordsNanobot *numStrNumberWordsNanobot) formatCurrencyWords(
+	numStrKernel *NumberStrKernel,
+	roundingType NumberRoundingType,
+	countryCultureSpec *NumStrFmtCountryCultureSpec,
+	numberScale NumberWordsScale,
+	minorUnitsInWords bool,
+	errPrefDto *ePref.ErrPrefixDto) (
+	currencyWords string,
+	err error) {
+
+	if nStrNumWordsNanobot.lock == nil {
+		nStrNumWordsNanobot.lock = new(sync.Mutex)
+	}
+
+	nStrNumWordsNanobot.lock.Lock()
+
+	defer nStrNumWordsNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrNumberWordsNanobot."+
+			"formatCurrencyWords()",
+		"")
+
+	if err != nil {
+		return currencyWords, err
+	}
+
+	if numStrKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return currencyWords, err
+	}
+
+	var languageCode string
+	var isBritish bool
+
+	languageCode,
+		isBritish,
+		numberScale,
+		err = new(numStrNumberWordsNanobot).getLanguageScale(
+		countryCultureSpec,
+		numberScale,
+		ePrefix.XCpy(
+			"languageCode<-countryCultureSpec"))
+
+	if err != nil {
+		return currencyWords, err
+	}
+
+	if len(strings.TrimSpace(countryCultureSpec.CurrencyName)) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'countryCultureSpec' is invalid!\n"+
+			"'countryCultureSpec.CurrencyName' is empty.\n",
+			ePrefix.String())
+
+		return currencyWords, err
+	}
+
+	minorDigits := int(countryCultureSpec.CurrencyDecimalDigits)
+
+	if minorUnitsInWords &&
+		minorDigits > 0 &&
+		len(strings.TrimSpace(countryCultureSpec.MinorCurrencyName)) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'countryCultureSpec' is invalid!\n"+
+			"Minor currency units cannot be spelled out as words\n"+
+			"because 'countryCultureSpec.MinorCurrencyName' is empty.\n",
+			ePrefix.String())
+
+		return currencyWords, err
+	}
+
+	var newNumStrKernel NumberStrKernel
+
+	err = new(numberStrKernelNanobot).copy(
+		&newNumStrKernel,
+		numStrKernel,
+		ePrefix.XCpy(
+			"newNumStrKernel<-numStrKernel"))
+
+	if err != nil {
+		return currencyWords, err
+	}
+
+	err = new(numberStrKernelQuark).roundNumStrKernel(
+		&newNumStrKernel,
+		roundingType,
+		minorDigits,
+		ePrefix.XCpy(
+			"newNumStrKernel"))
+
+	if err != nil {
+		return currencyWords, err
+	}
+
+	// If no rounding was performed, the minor
+	// currency units are truncated or padded
+	// with zeros.
+	minorUnitDigits := make([]rune, minorDigits)
+
+	for i := 0; i < minorDigits; i++ {
+
+		minorUnitDigits[i] = '0'
+
+		if i < len(newNumStrKernel.fractionalDigits.CharsArray) {
+			minorUnitDigits[i] =
+				newNumStrKernel.fractionalDigits.CharsArray[i]
+		}
+	}
+
+	integerDigits := newNumStrKernel.integerDigits.CharsArray
+
+	integerValueStr := strings.TrimLeft(
+		string(integerDigits),
+		"0")
+
+	minorValueStr := strings.TrimLeft(
+		string(minorUnitDigits),
+		"0")
+
+	nStrNumWordsAtom := numStrNumberWordsAtom{}
+
+	nStrNumWordsElectron := numStrNumberWordsElectron{}
+
+	var majorWords string
+	var isWholeMillions bool
+
+	majorWords,
+		isWholeMillions,
+		err = nStrNumWordsAtom.integerToWords(
+		integerDigits,
+		languageCode,
+		isBritish,
+		numberScale,
+		false,
+		ePrefix.XCpy(
+			"majorWords<-integerDigits"))
+
+	if err != nil {
+		return currencyWords, err
+	}
+
+	isMajorPlural := integerValueStr != "1"
+
+	if languageCode == "FR" &&
+		len(integerValueStr) == 0 {
+
+		// French: "zéro euro"
+		isMajorPlural = false
+	}
+
+	majorUnit := nStrNumWordsElectron.getCurrencyUnitName(
+		languageCode,
+		countryCultureSpec.CurrencyName,
+		isMajorPlural)
+
+	if languageCode == "FR" &&
+		isWholeMillions {
+
+		// French: "un million d'euros"
+		if strings.ContainsRune(
+			"aeiouyéh",
+			[]rune(majorUnit)[0]) {
+
+			majorUnit = "d'" + majorUnit
+
+		} else {
+
+			majorUnit = "de " + majorUnit
+		}
+	}
+
+	var minusWord, andWord string
+
+	switch languageCode {
+	case "FR":
+		minusWord = "moins"
+		andWord = "et"
+	case "DE":
+		minusWord = "minus"
+		andWord = "und"
+	default:
+		minusWord = "minus"
+		andWord = "and"
+	}
+
+	var wordArray []string
+
+	if newNumStrKernel.numberSign == NumSignVal.Negative() &&
+		(len(integerValueStr) > 0 ||
+			len(minorValueStr) > 0) {
+
+		wordArray = append(wordArray, minusWord)
+	}
+
+	if !minorUnitsInWords ||
+		len(integerValueStr) > 0 ||
+		len(minorValueStr) == 0 {
+
+		wordArray = append(wordArray,
+			majorWords,
+			majorUnit)
+	}
+
+	if minorDigits > 0 {
+
+		if !minorUnitsInWords {
+
+			wordArray = append(wordArray,
+				andWord,
+				string(minorUnitDigits)+
+					"/1"+
+					strings.Repeat("0", minorDigits))
+
+		} else if len(minorValueStr) > 0 {
+
+			var minorWords string
+
+			minorWords,
+				_,
+				err = nStrNumWordsAtom.integerToWords(
+				minorUnitDigits,
+				languageCode,
+				isBritish,
+				numberScale,
+				false,
+				ePrefix.XCpy(
+					"minorWords<-minorUnitDigits"))
+
+			if err != nil {
+				return currencyWords, err
+			}
+
+			if len(wordArray) > 0 &&
+				len(integerValueStr) > 0 {
+
+				wordArray = append(wordArray, andWord)
+			}
+
+			wordArray = append(wordArray,
+				minorWords,
+				nStrNumWordsElectron.getCurrencyUnitName(
+					languageCode,
+					countryCultureSpec.MinorCurrencyName,
+					minorValueStr != "1"))
+		}
+	}
+
+	wordRunes := []rune(strings.Join(wordArray, " "))
+
+	if len(wordRunes) > 0 {
+		wordRunes[0] = unicode.ToUpper(wordRunes[0])
+	}
+
+	currencyWords = string(wordRunes)
+
+	return currencyWords, err
+}
+
+// formatNumberWords
+//
+// Spells out the numeric value of a NumberStrKernel
+// instance as words.
+//
+// The integer value is spelled out as a cardinal number.
+// Fractional digits are read individually following the
+// decimal separator word ("point", "virgule" or
+// "Komma").
+//
+//	Examples: -1234.56
+//
+//	 US		"minus one thousand two hundred thirty-four
+//			point five six"
+//
+//	 France	"moins mille deux cent trente-quatre virgule
+//			cinq six"
+//
+//	 Germany "minus eintausendzweihundertvierunddreißig
+//			Komma fünf sechs"
+//
+// For a description of the input parameters, see method
+// NumberStrKernel.FmtNumberWords().
+func (nStrNumWordsNanobot *numStrNumberWordsNanobot) formatNumberWords(
+	numStrKernel *NumberStrKernel,
+	roundingSpec NumStrRoundingSpec,
+	countryCultureSpec *NumStrFmtCountryCultureSpec,
+	numberScale NumberWordsScale,
+	errPrefDto *ePref.ErrPrefixDto) (
+	numberWords string,
+	err error) {
+
+	if nStrNumWordsNanobot.lock == nil {
+		nStrNumWordsNanobot.lock = new(sync.Mutex)
+	}
+
+	nStrNumWordsNanobot.lock.Lock()
+
+	defer nStrNumWordsNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrNumberWordsNanobot."+
+			"formatNumberWords()",
+		"")
+
+	if err != nil {
+		return numberWords, err
+	}
+
+	if numStrKernel == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numStrKernel' is a nil pointer!\n",
+			ePrefix.String())
+
+		return numberWords, err
+	}
+
+	var languageCode string
+	var isBritish bool
+
+	languageCode,
+		isBritish,
+		numberScale,
+		err = new(numStrNumberWordsNanobot).getLanguageScale(
+		countryCultureSpec,
+		numberScale,
+		ePrefix.XCpy(
+			"languageCode<-countryCultureSpec"))
+
+	if err != nil {
+		return numberWords, err
+	}
+
+	var newNumStrKernel NumberStrKernel
+
+	err = new(numberStrKernelNanobot).copy(
+		&newNumStrKernel,
+		numStrKernel,
+		ePrefix.XCpy(
+			"newNumStrKernel<-numStrKernel"))
+
+	if err != nil {
+		return numberWords, err
+	}
+
+	err = new(numStrMathRoundingNanobot).roundNumStrKernel(
+		&newNumStrKernel,
+		roundingSpec,
+		ePrefix.XCpy(
+			"newNumStrKernel Rounding"))
+
+	if err != nil {
+		return numberWords, err
+	}
+
+	nStrNumWordsAtom := numStrNumberWordsAtom{}
+
+	var integerWords string
+
+	integerWords,
+		_,
+		err = nStrNumWordsAtom.integerToWords(
+		newNumStrKernel.integerDigits.CharsArray,
+		languageCode,
+		isBritish,
+		numberScale,
+		true,
+		ePrefix.XCpy(
+			"integerWords<-newNumStrKernel"))
+
+	if err != nil {
+		return numberWords, err
+	}
+
+	var minusWord, decimalWord string
+
+	switch languageCode {
+	case "FR":
+		minusWord = "moins"
+		decimalWord = "virgule"
+	case "DE":
+		minusWord = "minus"
+		decimalWord = "Komma"
+	default:
+		minusWord = "minus"
+		decimalWord = "point"
+	}
+
+	var wordArray []string
+
+	isNonZero := strings.Trim(
+		string(newNumStrKernel.integerDigits.CharsArray)+
+			string(newNumStrKernel.fractionalDigits.CharsArray),
+		"0") != ""
+
+	if newNumStrKernel.numberSign == NumSignVal.Negative() &&
+		isNonZero {
+
+		wordArray = append(wordArray, minusWord)
+	}
+
+	wordArray = append(wordArray, integerWords)
+
+	if len(newNumStrKernel.fractionalDigits.CharsArray) > 0 {
+
+		var fractionalWords string
+
+		fractionalWords,
+			err = nStrNumWordsAtom.fractionalDigitsToWords(
+			newNumStrKernel.fractionalDigits.CharsArray,
+			languageCode,
+			ePrefix.XCpy(
+				"fractionalWords<-newNumStrKernel"))
+
+		if err != nil {
+			return numberWords, err
+		}
+
+		wordArray = append(wordArray,
+			decimalWord,
+			fractionalWords)
+	}
+
+	numberWords = strings.Join(wordArray, " ")
+
+	return numberWords, err
+}
+
+// getLanguageScale
+//
+// Returns the language code and number scale used to
+// spell out numbers as words for the country identified
+// by input parameter 'countryCultureSpec'.
+//
+// If input parameter 'numberScale' is set to
+// NumWordsScale.None(), the default number scale for the
+// country's language is returned. English defaults to
+// the short scale. French and German default to the long
+// scale.
+//
+// If 'numberScale' is invalid or the country is not
+// supported, an error is returned.
+func (nStrNumWordsNanobot *numStrNumberWordsNanobot) getLanguageScale(
+	countryCultureSpec *NumStrFmtCountryCultureSpec,
+	numberScale NumberWordsScale,
+	errPrefDto *ePref.ErrPrefixDto) (
+	languageCode string,
+	isBritish bool,
+	validNumberScale NumberWordsScale,
+	err error) {
+
+	if nStrNumWordsNanobot.lock == nil {
+		nStrNumWordsNanobot.lock = new(sync.Mutex)
+	}
+
+	nStrNumWordsNanobot.lock.Lock()
+
+	defer nStrNumWordsNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrNumberWordsNanobot."+
+			"getLanguageScale()",
+		"")
+
+	if err != nil {
+		return languageCode, isBritish, validNumberScale, err
+	}
+
+	languageCode,
+		isBritish,
+		validNumberScale,
+		err = new(numStrNumberWordsElectron).getLanguageCode(
+		countryCultureSpec,
+		ePrefix.XCpy(
+			"languageCode<-countryCultureSpec"))
+
+	if err != nil {
+		return languageCode, isBritish, validNumberScale, err
+	}
+
+	if numberScale == NumWordsScale.None() {
+
+		return languageCode, isBritish, validNumberScale, err
+	}
+
+	if !numberScale.XIsValid() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'numberScale' is invalid!\n"+
+			"numberScale string value = '%v'\n"+
+			"numberScale integer value = '%v'\n",
+			ePrefix.String(),
+			numberScale.String(),
+			numberScale.XValueInt())
+
+		return languageCode, isBritish, validNumberScale, err
+	}
+
+	validNumberScale = numberScale
+
+	return languageCode, isBritish, validNumberScale, err
+}
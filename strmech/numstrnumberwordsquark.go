@@ -0,0 +1,822 @@
+package strmech
+
+import (
+	"strings"
+	"sync"
+)
+
+// numStrNumberWordsQuark
+//
+// Provides helper methods used to spell out numeric
+// values as words in English, French and German.
+//
+// The methods of this type convert small integer values
+// (less than one thousand or less than one million) to
+// words and supply the names of the large number scale
+// terms (million, billion, milliard etc.).
+type numStrNumberWordsQuark struct {
+	lock *sync.Mutex
+}
+
+// englishBelowMillion
+//
+// Converts an integer value greater than zero and less
+// than one million (1,000,000) to English words.
+//
+// This method is used to spell out six digit blocks when
+// English numbers are named using the long scale.
+//
+// If 'britishAnd' is set to 'true', the word 'and' will
+// be inserted before the tens and units in accordance
+// with British English usage.
+//
+//	Examples:
+//		 12,345  "twelve thousand three hundred forty-five"
+//		  1,005  "one thousand and five" (britishAnd = true)
+//
+// If 'value' is less than one or greater than 999,999,
+// an empty string is returned.
+func (nStrNumWordsQuark *numStrNumberWordsQuark) englishBelowMillion(
+	value int,
+	britishAnd bool) string {
+
+	if nStrNumWordsQuark.lock == nil {
+		nStrNumWordsQuark.lock = new(sync.Mutex)
+	}
+
+	nStrNumWordsQuark.lock.Lock()
+
+	defer nStrNumWordsQuark.lock.Unlock()
+
+	if value < 1 ||
+		value > 999999 {
+
+		return ""
+	}
+
+	thousands := value / 1000
+
+	remainder := value % 1000
+
+	var words []string
+
+	if thousands > 0 {
+
+		words = append(words,
+			new(numStrNumberWordsQuark).
+				englishBelowThousand(thousands, britishAnd),
+			"thousand")
+
+		if britishAnd &&
+			remainder > 0 &&
+			remainder < 100 {
+
+			words = append(words, "and")
+		}
+	}
+
+	if remainder > 0 {
+
+		words = append(words,
+			new(numStrNumberWordsQuark).
+				englishBelowThousand(remainder, britishAnd))
+	}
+
+	return strings.Join(words, " ")
+}
+
+// englishBelowThousand
+//
+// Converts an integer value greater than zero and less
+// than one thousand (1,000) to English words.
+//
+// Tens and units are hyphenated. If 'britishAnd' is set
+// to 'true', the word 'and' will be inserted after the
+// word 'hundred' in accordance with British English
+// usage.
+//
+//	Examples:
+//		234  "two hundred thirty-four"
+//		234  "two hundred and thirty-four" (britishAnd = true)
+//
+// If 'value' is less than one or greater than 999, an
+// empty string is returned.
+func (nStrNumWordsQuark *numStrNumberWordsQuark) englishBelowThousand(
+	value int,
+	britishAnd bool) string {
+
+	if nStrNumWordsQuark.lock == nil {
+		nStrNumWordsQuark.lock = new(sync.Mutex)
+	}
+
+	nStrNumWordsQuark.lock.Lock()
+
+	defer nStrNumWordsQuark.lock.Unlock()
+
+	if value < 1 ||
+		value > 999 {
+
+		return ""
+	}
+
+	units := new(numStrNumberWordsQuark).
+		getDigitWords("EN")
+
+	tens := []string{
+		"",
+		"",
+		"twenty",
+		"thirty",
+		"forty",
+		"fifty",
+		"sixty",
+		"seventy",
+		"eighty",
+		"ninety",
+	}
+
+	teens := []string{
+		"ten",
+		"eleven",
+		"twelve",
+		"thirteen",
+		"fourteen",
+		"fifteen",
+		"sixteen",
+		"seventeen",
+		"eighteen",
+		"nineteen",
+	}
+
+	hundreds := value / 100
+
+	remainder := value % 100
+
+	var words []string
+
+	if hundreds > 0 {
+
+		words = append(words,
+			units[hundreds],
+			"hundred")
+
+		if britishAnd &&
+			remainder > 0 {
+
+			words = append(words, "and")
+		}
+	}
+
+	if remainder == 0 {
+
+		return strings.Join(words, " ")
+	}
+
+	if remainder < 10 {
+
+		words = append(words, units[remainder])
+
+	} else if remainder < 20 {
+
+		words = append(words, teens[remainder-10])
+
+	} else if remainder%10 == 0 {
+
+		words = append(words, tens[remainder/10])
+
+	} else {
+
+		words = append(words,
+			tens[remainder/10]+
+				"-"+
+				units[remainder%10])
+	}
+
+	return strings.Join(words, " ")
+}
+
+// frenchBelowThousand
+//
+// Converts an integer value greater than zero and less
+// than one thousand (1,000) to French words using the
+// traditional spelling rules.
+//
+// The numbers 21, 31, 41, 51, 61 and 71 are formed with
+// 'et' ("vingt et un", "soixante et onze"). All other
+// compound numbers below one hundred are hyphenated
+// ("quatre-vingt-dix-neuf").
+//
+// The words "cent" and "quatre-vingt" take a plural 's'
+// only when they end the number and are not followed by
+// "mille". Set 'pluralize' to 'true' when the number is
+// final or is followed by a noun such as "millions" or a
+// currency unit. Set 'pluralize' to 'false' when the
+// number is followed by "mille".
+//
+//	Examples:
+//		 80  "quatre-vingts"		(pluralize = true)
+//		 80  "quatre-vingt"			(pluralize = false)
+//		200  "deux cents"			(pluralize = true)
+//		201  "deux cent un"
+//
+// If 'value' is less than one or greater than 999, an
+// empty string is returned.
+func (nStrNumWordsQuark *numStrNumberWordsQuark) frenchBelowThousand(
+	value int,
+	pluralize bool) string {
+
+	if nStrNumWordsQuark.lock == nil {
+		nStrNumWordsQuark.lock = new(sync.Mutex)
+	}
+
+	nStrNumWordsQuark.lock.Lock()
+
+	defer nStrNumWordsQuark.lock.Unlock()
+
+	if value < 1 ||
+		value > 999 {
+
+		return ""
+	}
+
+	units := []string{
+		"zéro",
+		"un",
+		"deux",
+		"trois",
+		"quatre",
+		"cinq",
+		"six",
+		"sept",
+		"huit",
+		"neuf",
+		"dix",
+		"onze",
+		"douze",
+		"treize",
+		"quatorze",
+		"quinze",
+		"seize",
+		"dix-sept",
+		"dix-huit",
+		"dix-neuf",
+	}
+
+	tens := []string{
+		"",
+		"",
+		"vingt",
+		"trente",
+		"quarante",
+		"cinquante",
+		"soixante",
+	}
+
+	hundreds := value / 100
+
+	remainder := value % 100
+
+	var words []string
+
+	if hundreds > 0 {
+
+		if hundreds > 1 {
+			words = append(words, units[hundreds])
+		}
+
+		if remainder == 0 &&
+			hundreds > 1 &&
+			pluralize {
+
+			words = append(words, "cents")
+
+		} else {
+
+			words = append(words, "cent")
+		}
+	}
+
+	if remainder == 0 {
+
+		return strings.Join(words, " ")
+	}
+
+	tensDigit := remainder / 10
+
+	unitsDigit := remainder % 10
+
+	switch {
+
+	case remainder < 20:
+
+		words = append(words, units[remainder])
+
+	case tensDigit < 7:
+
+		if unitsDigit == 0 {
+
+			words = append(words, tens[tensDigit])
+
+		} else if unitsDigit == 1 {
+
+			words = append(words,
+				tens[tensDigit]+" et un")
+
+		} else {
+
+			words = append(words,
+				tens[tensDigit]+"-"+units[unitsDigit])
+		}
+
+	case tensDigit == 7:
+
+		if unitsDigit == 1 {
+
+			words = append(words, "soixante et onze")
+
+		} else {
+
+			words = append(words,
+				"soixante-"+units[10+unitsDigit])
+		}
+
+	case tensDigit == 8:
+
+		if unitsDigit == 0 {
+
+			if pluralize {
+				words = append(words, "quatre-vingts")
+			} else {
+				words = append(words, "quatre-vingt")
+			}
+
+		} else {
+
+			words = append(words,
+				"quatre-vingt-"+units[unitsDigit])
+		}
+
+	default:
+		// tensDigit == 9
+
+		words = append(words,
+			"quatre-vingt-"+units[10+unitsDigit])
+	}
+
+	return strings.Join(words, " ")
+}
+
+// germanBelowMillion
+//
+// Converts an integer value greater than zero and less
+// than one million (1,000,000) to a single German
+// compound word.
+//
+// In German, a value of one standing alone at the end
+// of a number is written as "eins". When the number is
+// followed by a noun, such as a currency unit, the form
+// "ein" is used. Set 'isFinalWord' to 'true' if the
+// returned number will NOT be followed by a noun.
+//
+//	Examples:
+//		     21  "einundzwanzig"
+//		  1,000  "eintausend"
+//		101,001  "einhunderteintausendeins"	(isFinalWord = true)
+//		101,001  "einhunderteintausendein"	(isFinalWord = false)
+//
+// If 'value' is less than one or greater than 999,999,
+// an empty string is returned.
+func (nStrNumWordsQuark *numStrNumberWordsQuark) germanBelowMillion(
+	value int,
+	isFinalWord bool) string {
+
+	if nStrNumWordsQuark.lock == nil {
+		nStrNumWordsQuark.lock = new(sync.Mutex)
+	}
+
+	nStrNumWordsQuark.lock.Lock()
+
+	defer nStrNumWordsQuark.lock.Unlock()
+
+	if value < 1 ||
+		value > 999999 {
+
+		return ""
+	}
+
+	thousands := value / 1000
+
+	remainder := value % 1000
+
+	var words string
+
+	if thousands > 0 {
+
+		words = new(numStrNumberWordsQuark).
+			germanBelowThousand(thousands, false) +
+			"tausend"
+	}
+
+	if remainder > 0 {
+
+		words += new(numStrNumberWordsQuark).
+			germanBelowThousand(remainder, isFinalWord)
+	}
+
+	return words
+}
+
+// germanBelowThousand
+//
+// Converts an integer value greater than zero and less
+// than one thousand (1,000) to a single German compound
+// word.
+//
+// Units precede tens and are joined by "und"
+// ("vierunddreißig"). Hundreds are always prefixed with
+// the number of hundreds ("einhundert").
+//
+// If 'isFinalWord' is set to 'true', a final value of
+// one is written as "eins". Otherwise, it is written as
+// "ein".
+//
+//	Examples:
+//		  1  "eins"					(isFinalWord = true)
+//		  1  "ein"					(isFinalWord = false)
+//		234  "zweihundertvierunddreißig"
+//
+// If 'value' is less than one or greater than 999, an
+// empty string is returned.
+func (nStrNumWordsQuark *numStrNumberWordsQuark) germanBelowThousand(
+	value int,
+	isFinalWord bool) string {
+
+	if nStrNumWordsQuark.lock == nil {
+		nStrNumWordsQuark.lock = new(sync.Mutex)
+	}
+
+	nStrNumWordsQuark.lock.Lock()
+
+	defer nStrNumWordsQuark.lock.Unlock()
+
+	if value < 1 ||
+		value > 999 {
+
+		return ""
+	}
+
+	units := []string{
+		"null",
+		"ein",
+		"zwei",
+		"drei",
+		"vier",
+		"fünf",
+		"sechs",
+		"sieben",
+		"acht",
+		"neun",
+		"zehn",
+		"elf",
+		"zwölf",
+		"dreizehn",
+		"vierzehn",
+		"fünfzehn",
+		"sechzehn",
+		"siebzehn",
+		"achtzehn",
+		"neunzehn",
+	}
+
+	tens := []string{
+		"",
+		"",
+		"zwanzig",
+		"dreißig",
+		"vierzig",
+		"fünfzig",
+		"sechzig",
+		"siebzig",
+		"achtzig",
+		"neunzig",
+	}
+
+	hundreds := value / 100
+
+	remainder := value % 100
+
+	var words string
+
+	if hundreds > 0 {
+
+		words = units[hundreds] + "hundert"
+	}
+
+	switch {
+
+	case remainder == 0:
+
+	case remainder == 1:
+
+		words += "ein"
+
+		if isFinalWord {
+			words += "s"
+		}
+
+	case remainder < 20:
+
+		words += units[remainder]
+
+	case remainder%10 == 0:
+
+		words += tens[remainder/10]
+
+	default:
+
+		words += units[remainder%10] +
+			"und" +
+			tens[remainder/10]
+	}
+
+	return words
+}
+
+// getDigitWords
+//
+// Returns an array of ten strings containing the words
+// for the digits zero (0) through nine (9) in the
+// language specified by input parameter 'languageCode'.
+//
+// 'languageCode' must be set to one of the following
+// values:
+//
+//	"EN"	English
+//	"FR"	French
+//	"DE"	German
+//
+// If 'languageCode' is invalid, the English digit words
+// are returned.
+func (nStrNumWordsQuark *numStrNumberWordsQuark) getDigitWords(
+	languageCode string) []string {
+
+	if nStrNumWordsQuark.lock == nil {
+		nStrNumWordsQuark.lock = new(sync.Mutex)
+	}
+
+	nStrNumWordsQuark.lock.Lock()
+
+	defer nStrNumWordsQuark.lock.Unlock()
+
+	switch languageCode {
+
+	case "FR":
+
+		return []string{
+			"zéro",
+			"un",
+			"deux",
+			"trois",
+			"quatre",
+			"cinq",
+			"six",
+			"sept",
+			"huit",
+			"neuf",
+		}
+
+	case "DE":
+
+		return []string{
+			"null",
+			"eins",
+			"zwei",
+			"drei",
+			"vier",
+			"fünf",
+			"sechs",
+			"sieben",
+			"acht",
+			"neun",
+		}
+	}
+
+	return []string{
+		"zero",
+		"one",
+		"two",
+		"three",
+		"four",
+		"five",
+		"six",
+		"seven",
+		"eight",
+		"nine",
+	}
+}
+
+// getScaleNames
+//
+// Returns the names of the large number scale terms for
+// the language and number scale specified by input
+// parameters 'languageCode' and 'numberScale'.
+//
+// The first element of the returned array is always the
+// name for one million (10^6).
+//
+// For the short scale and for the French and German long
+// scale, each successive element names a value one
+// thousand times larger than the previous element. Each
+// array contains 20 elements. The last element names the
+// value 10^63.
+//
+//	Short Scale:	million, billion, trillion ...
+//	Long Scale:		million, milliard, billion, billiard ...
+//
+// For the English long scale, each successive element
+// names a value one million times larger than the
+// previous element. The intermediate values are formed
+// by preceding these names with "thousand". This array
+// contains 10 elements. The last element names the value
+// 10^60.
+//
+//	English Long Scale: million, billion, trillion ...
+//
+// 'languageCode' must be set to one of the following
+// values:
+//
+//	"EN"	English
+//	"FR"	French
+//	"DE"	German
+//
+// 'numberScale' must be set to one of the following
+// values:
+//
+//	NumWordsScale.ShortScale()
+//	NumWordsScale.LongScale()
+//
+// If 'languageCode' or 'numberScale' is invalid, an
+// empty array is returned.
+func (nStrNumWordsQuark *numStrNumberWordsQuark) getScaleNames(
+	languageCode string,
+	numberScale NumberWordsScale) []string {
+
+	if nStrNumWordsQuark.lock == nil {
+		nStrNumWordsQuark.lock = new(sync.Mutex)
+	}
+
+	nStrNumWordsQuark.lock.Lock()
+
+	defer nStrNumWordsQuark.lock.Unlock()
+
+	switch {
+
+	case languageCode == "EN" &&
+		numberScale == NumWordsScale.ShortScale():
+
+		return []string{
+			"million",
+			"billion",
+			"trillion",
+			"quadrillion",
+			"quintillion",
+			"sextillion",
+			"septillion",
+			"octillion",
+			"nonillion",
+			"decillion",
+			"undecillion",
+			"duodecillion",
+			"tredecillion",
+			"quattuordecillion",
+			"quindecillion",
+			"sexdecillion",
+			"septendecillion",
+			"octodecillion",
+			"novemdecillion",
+			"vigintillion",
+		}
+
+	case languageCode == "EN" &&
+		numberScale == NumWordsScale.LongScale():
+
+		return []string{
+			"million",
+			"billion",
+			"trillion",
+			"quadrillion",
+			"quintillion",
+			"sextillion",
+			"septillion",
+			"octillion",
+			"nonillion",
+			"decillion",
+		}
+
+	case languageCode == "FR" &&
+		numberScale == NumWordsScale.ShortScale():
+
+		return []string{
+			"million",
+			"billion",
+			"trillion",
+			"quadrillion",
+			"quintillion",
+			"sextillion",
+			"septillion",
+			"octillion",
+			"nonillion",
+			"décillion",
+			"undécillion",
+			"duodécillion",
+			"trédécillion",
+			"quattuordécillion",
+			"quindécillion",
+			"sexdécillion",
+			"septendécillion",
+			"octodécillion",
+			"novemdécillion",
+			"vigintillion",
+		}
+
+	case languageCode == "FR" &&
+		numberScale == NumWordsScale.LongScale():
+
+		return []string{
+			"million",
+			"milliard",
+			"billion",
+			"billiard",
+			"trillion",
+			"trilliard",
+			"quadrillion",
+			"quadrilliard",
+			"quintillion",
+			"quintilliard",
+			"sextillion",
+			"sextilliard",
+			"septillion",
+			"septilliard",
+			"octillion",
+			"octilliard",
+			"nonillion",
+			"nonilliard",
+			"décillion",
+			"décilliard",
+		}
+
+	case languageCode == "DE" &&
+		numberScale == NumWordsScale.ShortScale():
+
+		return []string{
+			"Million",
+			"Billion",
+			"Trillion",
+			"Quadrillion",
+			"Quintillion",
+			"Sextillion",
+			"Septillion",
+			"Oktillion",
+			"Nonillion",
+			"Dezillion",
+			"Undezillion",
+			"Duodezillion",
+			"Tredezillion",
+			"Quattuordezillion",
+			"Quindezillion",
+			"Sedezillion",
+			"Septendezillion",
+			"Oktodezillion",
+			"Novemdezillion",
+			"Vigintillion",
+		}
+
+	case languageCode == "DE" &&
+		numberScale == NumWordsScale.LongScale():
+
+		return []string{
+			"Million",
+			"Milliarde",
+			"Billion",
+			"Billiarde",
+			"Trillion",
+			"Trilliarde",
+			"Quadrillion",
+			"Quadrilliarde",
+			"Quintillion",
+			"Quintilliarde",
+			"Sextillion",
+			"Sextilliarde",
+			"Septillion",
+			"Septilliarde",
+			"Oktillion",
+			"Oktilliarde",
+			"Nonillion",
+			"Nonilliarde",
+			"Dezillion",
+			"Dezilliarde",
+		}
+	}
+
+	return []string{}
+}
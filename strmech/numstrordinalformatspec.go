@@ -0,0 +1,991 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
+
+// NumStrOrdinalFormatSpec
+//
+// Number String Ordinal Format Specification. This type
+// contains the parameters required to format
+// non-negative integer values as ordinal numbers.
+//
+// When configured as a member of NumStrFormatSpec, this
+// specification directs NumberStrKernel.FmtNumStr() to
+// render the integer value of a NumberStrKernel followed
+// by the ordinal suffix of a designated language.
+//
+//	Examples:
+//		English			1 = "1st"	2 = "2nd"	23 = "23rd"
+//						11 = "11th"	111 = "111th"
+//						1000000 = "1,000,000th"
+//		English,
+//		Superscript		1 = "1ˢᵗ"	2 = "2ⁿᵈ"
+//		French			1 = "1er"	2 = "2e"
+//		French,
+//		Feminine		1 = "1re"	2 = "2e"
+//		French,
+//		Superscript		1 = "1ᵉʳ"	2 = "2ᵉ"
+//		German			1 = "1."	23 = "23."
+//						1000000 = "1.000.000."
+//
+// Integer digits are grouped by thousands using the
+// default integer separator of the designated language.
+//
+// Ordinal numbers have no representation for negative
+// or fractional values. Attempting to format such values
+// will generate an error.
+//
+// An empty or zero value instance of
+// NumStrOrdinalFormatSpec is treated as a NOP, or 'No
+// Operation', specification. In this case numeric values
+// are formatted in base 10.
+type NumStrOrdinalFormatSpec struct {
+	ordinalFmtType NumStrFormatTypeCode
+	//	When set to NumStrFmtType.Ordinal(), this
+	//	specification is operational and numeric values
+	//	will be formatted as ordinal numbers.
+	//
+	//	Any other value signals that this specification
+	//	is NOP, or Not Operational.
+
+	languageCode string
+	//	The two character ISO 639-1 language code which
+	//	determines the ordinal suffix rules and the
+	//	integer separator. Supported language codes are
+	//	"EN" (English), "FR" (French) and "DE" (German).
+
+	useFeminine bool
+	//	When set to 'true', the feminine form of the
+	//	ordinal suffix is applied where the language
+	//	distinguishes grammatical gender. In French, the
+	//	feminine form of "1er" is "1re". This option has
+	//	no effect for English and German.
+
+	useSuperscript bool
+	//	When set to 'true', ordinal suffixes are rendered
+	//	with Unicode superscript characters ("1ˢᵗ",
+	//	"1ᵉʳ"). German ordinals, which consist of a
+	//	trailing period, are not affected.
+
+	lock *sync.Mutex
+}
+
+// CopyIn
+//
+// Copies the data fields from an incoming instance of
+// NumStrOrdinalFormatSpec ('incomingOrdinalFmtSpec')
+// to the data fields of the current
+// NumStrOrdinalFormatSpec instance.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the member variable data values in the current
+//	NumStrOrdinalFormatSpec instance will be
+//	deleted and replaced.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	incomingOrdinalFmtSpec		*NumStrOrdinalFormatSpec
+//
+//		A pointer to an instance of
+//		NumStrOrdinalFormatSpec. This method will
+//		NOT change the values of internal member
+//		variables contained in this instance.
+//
+//		If this instance is invalid, an error will be
+//		returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrOrdinalFmtSpec *NumStrOrdinalFormatSpec) CopyIn(
+	incomingOrdinalFmtSpec *NumStrOrdinalFormatSpec,
+	errorPrefix interface{}) error {
+
+	if nStrOrdinalFmtSpec.lock == nil {
+		nStrOrdinalFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrOrdinalFmtSpec.lock.Lock()
+
+	defer nStrOrdinalFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrOrdinalFormatSpec."+
+			"CopyIn()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrOrdinalFormatSpecAtom).copy(
+		nStrOrdinalFmtSpec,
+		incomingOrdinalFmtSpec,
+		ePrefix.XCpy(
+			"nStrOrdinalFmtSpec<-incomingOrdinalFmtSpec"))
+}
+
+// CopyOut
+//
+// Returns a deep copy of the current
+// NumStrOrdinalFormatSpec instance.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	deepCopyOrdinalFmtSpec		NumStrOrdinalFormatSpec
+//
+//		If this method completes successfully, a deep
+//		copy of the current NumStrOrdinalFormatSpec
+//		instance will be returned.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrOrdinalFmtSpec *NumStrOrdinalFormatSpec) CopyOut(
+	errorPrefix interface{}) (
+	deepCopyOrdinalFmtSpec NumStrOrdinalFormatSpec,
+	err error) {
+
+	if nStrOrdinalFmtSpec.lock == nil {
+		nStrOrdinalFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrOrdinalFmtSpec.lock.Lock()
+
+	defer nStrOrdinalFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrOrdinalFormatSpec."+
+			"CopyOut()",
+		"")
+
+	if err != nil {
+		return deepCopyOrdinalFmtSpec, err
+	}
+
+	err = new(numStrOrdinalFormatSpecAtom).copy(
+		&deepCopyOrdinalFmtSpec,
+		nStrOrdinalFmtSpec,
+		ePrefix.XCpy(
+			"deepCopyOrdinalFmtSpec<-nStrOrdinalFmtSpec"))
+
+	return deepCopyOrdinalFmtSpec, err
+}
+
+// Empty
+//
+// Resets all internal member variables for the current
+// instance of NumStrOrdinalFormatSpec to their
+// initial or zero values. Afterwards, the current
+// instance is NOP, or Not Operational.
+func (nStrOrdinalFmtSpec *NumStrOrdinalFormatSpec) Empty() {
+
+	if nStrOrdinalFmtSpec.lock == nil {
+		nStrOrdinalFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrOrdinalFmtSpec.lock.Lock()
+
+	new(numStrOrdinalFormatSpecAtom).empty(
+		nStrOrdinalFmtSpec)
+
+	nStrOrdinalFmtSpec.lock.Unlock()
+
+	nStrOrdinalFmtSpec.lock = nil
+}
+
+// Equal
+//
+// Receives a pointer to another instance of
+// NumStrOrdinalFormatSpec and proceeds to compare
+// its internal member variables to those of the current
+// instance. If all member variables are equivalent,
+// this method returns 'true'.
+func (nStrOrdinalFmtSpec *NumStrOrdinalFormatSpec) Equal(
+	incomingOrdinalFmtSpec *NumStrOrdinalFormatSpec) bool {
+
+	if nStrOrdinalFmtSpec.lock == nil {
+		nStrOrdinalFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrOrdinalFmtSpec.lock.Lock()
+
+	defer nStrOrdinalFmtSpec.lock.Unlock()
+
+	return new(numStrOrdinalFormatSpecAtom).equal(
+		nStrOrdinalFmtSpec,
+		incomingOrdinalFmtSpec)
+}
+
+// GetLanguageCode
+//
+// Returns the two character ISO 639-1 language code
+// which determines the ordinal suffix rules applied by
+// the current instance of NumStrOrdinalFormatSpec:
+//
+//	"EN"	English		"1st", "2nd", "23rd", "111th"
+//	"FR"	French		"1er", "1re", "2e"
+//	"DE"	German		"1.", "2."
+//
+// If the current instance was created from a BCP 47
+// language tag such as "en-US", only the primary
+// language subtag ("EN") is returned.
+//
+// If the current instance is NOP, an empty string is
+// returned.
+func (nStrOrdinalFmtSpec *NumStrOrdinalFormatSpec) GetLanguageCode() string {
+
+	if nStrOrdinalFmtSpec.lock == nil {
+		nStrOrdinalFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrOrdinalFmtSpec.lock.Lock()
+
+	defer nStrOrdinalFmtSpec.lock.Unlock()
+
+	return nStrOrdinalFmtSpec.languageCode
+}
+
+// IsNOP
+//
+// Stands for 'Is No Operation'. If this method returns
+// 'true', the current instance of
+// NumStrOrdinalFormatSpec is not configured for
+// ordinal number formatting and numeric values will be
+// formatted in base 10.
+func (nStrOrdinalFmtSpec *NumStrOrdinalFormatSpec) IsNOP() bool {
+
+	if nStrOrdinalFmtSpec.lock == nil {
+		nStrOrdinalFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrOrdinalFmtSpec.lock.Lock()
+
+	defer nStrOrdinalFmtSpec.lock.Unlock()
+
+	return nStrOrdinalFmtSpec.ordinalFmtType !=
+		NumStrFmtType.Ordinal()
+}
+
+// IsValidInstanceError
+//
+// Performs a diagnostic review of the data values
+// encapsulated in the current
+// NumStrOrdinalFormatSpec instance to determine if
+// they are valid.
+//
+// A NOP instance is considered valid.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrOrdinalFmtSpec *NumStrOrdinalFormatSpec) IsValidInstanceError(
+	errorPrefix interface{}) error {
+
+	if nStrOrdinalFmtSpec.lock == nil {
+		nStrOrdinalFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrOrdinalFmtSpec.lock.Lock()
+
+	defer nStrOrdinalFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrOrdinalFormatSpec."+
+			"IsValidInstanceError()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrOrdinalFormatSpecAtom).testValidity(
+		nStrOrdinalFmtSpec,
+		ePrefix.XCpy(
+			"nStrOrdinalFmtSpec"))
+}
+
+// NewOrdinalFormat
+//
+// Creates and returns a new instance of
+// NumStrOrdinalFormatSpec configured to format
+// non-negative integer values as ordinal numbers.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	languageCode				string
+//
+//		The two character ISO 639-1 language code which
+//		determines the ordinal suffix rules and the
+//		integer separator. This parameter is not case
+//		sensitive. A BCP 47 language tag such as "en-US"
+//		or "fr-FR" may also be submitted, in which case
+//		only the primary language subtag is used.
+//		Supported language codes are:
+//
+//			"EN"	English		"1st", "2nd", "1,000th"
+//			"FR"	French		"1er", "2e", "1 000e"
+//			"DE"	German		"1.", "2.", "1.000."
+//
+//		If 'languageCode' is not supported, an error will
+//		be returned.
+//
+//	useFeminine					bool
+//
+//		When set to 'true', the feminine form of the
+//		ordinal suffix is applied where the language
+//		distinguishes grammatical gender. In French, the
+//		feminine form of "1er" is "1re". This option has
+//		no effect for English and German.
+//
+//	useSuperscript				bool
+//
+//		When set to 'true', ordinal suffixes are rendered
+//		with Unicode superscript characters:
+//
+//			English		"1ˢᵗ", "2ⁿᵈ", "3ʳᵈ", "4ᵗʰ"
+//			French		"1ᵉʳ", "1ʳᵉ", "2ᵉ"
+//
+//		German ordinals, which consist of a trailing
+//		period, are not affected.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	newOrdinalFmtSpec			NumStrOrdinalFormatSpec
+//
+//		If this method completes successfully, a new,
+//		fully populated instance of
+//		NumStrOrdinalFormatSpec will be returned.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrOrdinalFmtSpec *NumStrOrdinalFormatSpec) NewOrdinalFormat(
+	languageCode string,
+	useFeminine bool,
+	useSuperscript bool,
+	errorPrefix interface{}) (
+	newOrdinalFmtSpec NumStrOrdinalFormatSpec,
+	err error) {
+
+	if nStrOrdinalFmtSpec.lock == nil {
+		nStrOrdinalFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrOrdinalFmtSpec.lock.Lock()
+
+	defer nStrOrdinalFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrOrdinalFormatSpec."+
+			"NewOrdinalFormat()",
+		"")
+
+	if err != nil {
+		return newOrdinalFmtSpec, err
+	}
+
+	newOrdinalFmtSpec.ordinalFmtType =
+		NumStrFmtType.Ordinal()
+
+	newOrdinalFmtSpec.languageCode =
+		new(numStrOrdinalQuark).
+			getPrimaryLanguageCode(languageCode)
+
+	newOrdinalFmtSpec.useFeminine = useFeminine
+
+	newOrdinalFmtSpec.useSuperscript = useSuperscript
+
+	err = new(numStrOrdinalFormatSpecAtom).testValidity(
+		&newOrdinalFmtSpec,
+		ePrefix.XCpy(
+			"newOrdinalFmtSpec"))
+
+	if err != nil {
+		return NumStrOrdinalFormatSpec{}, err
+	}
+
+	return newOrdinalFmtSpec, err
+}
+
+// UsesFeminine
+//
+// Returns 'true' if the feminine form of the ordinal
+// suffix will be applied where the language
+// distinguishes grammatical gender.
+func (nStrOrdinalFmtSpec *NumStrOrdinalFormatSpec) UsesFeminine() bool {
+
+	if nStrOrdinalFmtSpec.lock == nil {
+		nStrOrdinalFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrOrdinalFmtSpec.lock.Lock()
+
+	defer nStrOrdinalFmtSpec.lock.Unlock()
+
+	return nStrOrdinalFmtSpec.useFeminine
+}
+
+// UsesSuperscript
+//
+// Returns 'true' if ordinal suffixes will be rendered
+// with Unicode superscript characters.
+func (nStrOrdinalFmtSpec *NumStrOrdinalFormatSpec) UsesSuperscript() bool {
+
+	if nStrOrdinalFmtSpec.lock == nil {
+		nStrOrdinalFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrOrdinalFmtSpec.lock.Lock()
+
+	defer nStrOrdinalFmtSpec.lock.Unlock()
+
+	return nStrOrdinalFmtSpec.useSuperscript
+}
+
+// numStrOrdinalFormatSpecAtom - Provides helper methods
+// for type NumStrOrdinalFormatSpec.
+type numStrOrdinalFormatSpecAtom struct {
+	lock *sync.Mutex
+}
+
+// copy
+//
+// Copies all data from input parameter
+// 'sourceOrdinalFmtSpec' to input parameter
+// 'destinationOrdinalFmtSpec'. The source instance is
+// validated before the copy operation is performed.
+func (nStrOrdinalFmtSpecAtom *numStrOrdinalFormatSpecAtom) copy(
+	destinationOrdinalFmtSpec *NumStrOrdinalFormatSpec,
+	sourceOrdinalFmtSpec *NumStrOrdinalFormatSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrOrdinalFmtSpecAtom.lock == nil {
+		nStrOrdinalFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrOrdinalFmtSpecAtom.lock.Lock()
+
+	defer nStrOrdinalFmtSpecAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrOrdinalFormatSpecAtom."+
+			"copy()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if destinationOrdinalFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'destinationOrdinalFmtSpec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if sourceOrdinalFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sourceOrdinalFmtSpec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	err = new(numStrOrdinalFormatSpecAtom).testValidity(
+		sourceOrdinalFmtSpec,
+		ePrefix.XCpy(
+			"sourceOrdinalFmtSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	destinationOrdinalFmtSpec.ordinalFmtType =
+		sourceOrdinalFmtSpec.ordinalFmtType
+
+	destinationOrdinalFmtSpec.languageCode =
+		sourceOrdinalFmtSpec.languageCode
+
+	destinationOrdinalFmtSpec.useFeminine =
+		sourceOrdinalFmtSpec.useFeminine
+
+	destinationOrdinalFmtSpec.useSuperscript =
+		sourceOrdinalFmtSpec.useSuperscript
+
+	return err
+}
+
+// empty
+//
+// Resets all member variables of input parameter
+// 'ordinalFmtSpec' to their zero values.
+func (nStrOrdinalFmtSpecAtom *numStrOrdinalFormatSpecAtom) empty(
+	ordinalFmtSpec *NumStrOrdinalFormatSpec) {
+
+	if nStrOrdinalFmtSpecAtom.lock == nil {
+		nStrOrdinalFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrOrdinalFmtSpecAtom.lock.Lock()
+
+	defer nStrOrdinalFmtSpecAtom.lock.Unlock()
+
+	if ordinalFmtSpec == nil {
+		return
+	}
+
+	ordinalFmtSpec.ordinalFmtType = NumStrFmtType.None()
+
+	ordinalFmtSpec.languageCode = ""
+
+	ordinalFmtSpec.useFeminine = false
+
+	ordinalFmtSpec.useSuperscript = false
+}
+
+// equal
+//
+// Compares the member variables of two instances of
+// NumStrOrdinalFormatSpec and returns 'true' if they are
+// equivalent in all respects.
+func (nStrOrdinalFmtSpecAtom *numStrOrdinalFormatSpecAtom) equal(
+	ordinalFmtSpec1 *NumStrOrdinalFormatSpec,
+	ordinalFmtSpec2 *NumStrOrdinalFormatSpec) bool {
+
+	if nStrOrdinalFmtSpecAtom.lock == nil {
+		nStrOrdinalFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrOrdinalFmtSpecAtom.lock.Lock()
+
+	defer nStrOrdinalFmtSpecAtom.lock.Unlock()
+
+	if ordinalFmtSpec1 == nil ||
+		ordinalFmtSpec2 == nil {
+
+		return false
+	}
+
+	if ordinalFmtSpec1.ordinalFmtType !=
+		ordinalFmtSpec2.ordinalFmtType {
+
+		return false
+	}
+
+	if ordinalFmtSpec1.languageCode !=
+		ordinalFmtSpec2.languageCode {
+
+		return false
+	}
+
+	if ordinalFmtSpec1.useFeminine !=
+		ordinalFmtSpec2.useFeminine {
+
+		return false
+	}
+
+	if ordinalFmtSpec1.useSuperscript !=
+		ordinalFmtSpec2.useSuperscript {
+
+		return false
+	}
+
+	return true
+}
+
+// testValidity
+//
+// Performs a diagnostic review of the member variables
+// contained in an instance of NumStrOrdinalFormatSpec.
+// If any member variable is invalid, an error is
+// returned.
+//
+// A NOP instance is considered valid.
+func (nStrOrdinalFmtSpecAtom *numStrOrdinalFormatSpecAtom) testValidity(
+	ordinalFmtSpec *NumStrOrdinalFormatSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrOrdinalFmtSpecAtom.lock == nil {
+		nStrOrdinalFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrOrdinalFmtSpecAtom.lock.Lock()
+
+	defer nStrOrdinalFmtSpecAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrOrdinalFormatSpecAtom."+
+			"testValidity()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if ordinalFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'ordinalFmtSpec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if ordinalFmtSpec.ordinalFmtType != NumStrFmtType.Ordinal() {
+		return err
+	}
+
+	switch ordinalFmtSpec.languageCode {
+
+	case "EN", "FR", "DE":
+
+	default:
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The ordinal language code is invalid!\n"+
+			"Ordinal numbers can only be formatted for the\n"+
+			"following languages: EN, FR and DE.\n"+
+			"languageCode = '%v'\n",
+			ePrefix.String(),
+			ordinalFmtSpec.languageCode)
+	}
+
+	return err
+}
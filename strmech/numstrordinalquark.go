@@ -0,0 +1,367 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"strings"
+	"sync"
+)
+
+// numStrOrdinalQuark
+//
+// Provides helper methods used to format non-negative
+// integer values as ordinal numbers.
+type numStrOrdinalQuark struct {
+	lock *sync.Mutex
+}
+
+// formatOrdinal
+//
+// Receives a string of integer digits and returns the
+// digits followed by the ordinal suffix of a designated
+// language.
+//
+// The integer digits are grouped by thousands using the
+// default integer separator of the designated language:
+// a comma (',') for English, a space (' ') for French
+// and a period ('.') for German.
+//
+//	Examples:
+//		"1"			EN					"1st"
+//		"12"		EN					"12th"
+//		"23"		EN					"23rd"
+//		"111"		EN					"111th"
+//		"1000000"	EN					"1,000,000th"
+//		"2"			EN, superscript		"2ⁿᵈ"
+//		"1"			FR					"1er"
+//		"1"			FR, feminine		"1re"
+//		"21"		FR					"21e"
+//		"1000000"	FR					"1 000 000e"
+//		"1"			FR, superscript		"1ᵉʳ"
+//		"1"			DE					"1."
+//		"1000000"	DE					"1.000.000."
+//
+// Because the integer digits are processed as a string,
+// there is no practical limit on the magnitude of the
+// value.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	intDigits					string
+//
+//		A string consisting entirely of the numeric digit
+//		characters zero through nine ('0'-'9'). This
+//		string represents a non-negative integer value.
+//
+//		If 'intDigits' is empty or contains any
+//		character which is not a numeric digit, an error
+//		will be returned.
+//
+//	languageCode				string
+//
+//		The two character ISO 639-1 language code which
+//		determines the ordinal suffix rules. Valid values
+//		are "EN", "FR" and "DE". This parameter is not
+//		case sensitive.
+//
+//		A BCP 47 language tag such as "en-US" or "fr-FR"
+//		is also accepted. In that case, only the primary
+//		language subtag ("en", "fr") is used.
+//
+//	useFeminine					bool
+//
+//		When set to 'true', the feminine form of the
+//		French ordinal suffix for the value one ("1re")
+//		is applied. This parameter has no effect for
+//		English and German.
+//
+//	useSuperscript				bool
+//
+//		When set to 'true', English and French ordinal
+//		suffixes are rendered with Unicode superscript
+//		characters. German ordinals are not affected.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	ordinalStr					string
+//
+//		If this method completes successfully, this
+//		parameter will return 'intDigits', grouped with
+//		the language's integer separator, followed by
+//		the appropriate ordinal suffix.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+func (nStrOrdinalQuark *numStrOrdinalQuark) formatOrdinal(
+	intDigits string,
+	languageCode string,
+	useFeminine bool,
+	useSuperscript bool,
+	errPrefDto *ePref.ErrPrefixDto) (
+	ordinalStr string,
+	err error) {
+
+	if nStrOrdinalQuark.lock == nil {
+		nStrOrdinalQuark.lock = new(sync.Mutex)
+	}
+
+	nStrOrdinalQuark.lock.Lock()
+
+	defer nStrOrdinalQuark.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrOrdinalQuark."+
+			"formatOrdinal()",
+		"")
+
+	if err != nil {
+		return ordinalStr, err
+	}
+
+	lenIntDigits := len(intDigits)
+
+	if lenIntDigits == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'intDigits' is invalid!\n"+
+			"'intDigits' is an empty string.\n",
+			ePrefix.String())
+
+		return ordinalStr, err
+	}
+
+	for i := 0; i < lenIntDigits; i++ {
+
+		if intDigits[i] < '0' || intDigits[i] > '9' {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'intDigits' is invalid!\n"+
+				"'intDigits' contains a non-numeric character.\n"+
+				"intDigits = '%v'\n",
+				ePrefix.String(),
+				intDigits)
+
+			return ordinalStr, err
+		}
+	}
+
+	// Only the last two digits determine the suffix
+	lastTwo := int(intDigits[lenIntDigits-1] - '0')
+
+	if lenIntDigits > 1 {
+		lastTwo += int(intDigits[lenIntDigits-2]-'0') * 10
+	}
+
+	var suffix string
+	var intSeparatorSpec IntegerSeparatorSpec
+	intSepMech := integerSeparatorSpecMechanics{}
+
+	switch new(numStrOrdinalQuark).
+		getPrimaryLanguageCode(languageCode) {
+
+	case "EN":
+
+		err = intSepMech.setToUSADefaults(
+			&intSeparatorSpec,
+			ePrefix.XCpy(
+				"intSeparatorSpec"))
+
+		switch {
+
+		case lastTwo%100 >= 11 && lastTwo%100 <= 13:
+
+			suffix = "th"
+
+		case lastTwo%10 == 1:
+
+			suffix = "st"
+
+		case lastTwo%10 == 2:
+
+			suffix = "nd"
+
+		case lastTwo%10 == 3:
+
+			suffix = "rd"
+
+		default:
+
+			suffix = "th"
+		}
+
+	case "FR":
+
+		err = intSepMech.setToFrenchDefaults(
+			&intSeparatorSpec,
+			ePrefix.XCpy(
+				"intSeparatorSpec"))
+
+		suffix = "e"
+
+		if strings.TrimLeft(intDigits, "0") == "1" {
+
+			if useFeminine {
+				suffix = "re"
+			} else {
+				suffix = "er"
+			}
+		}
+
+	case "DE":
+
+		err = intSepMech.setToGermanDefaults(
+			&intSeparatorSpec,
+			ePrefix.XCpy(
+				"intSeparatorSpec"))
+
+		suffix = "."
+
+	default:
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'languageCode' is invalid!\n"+
+			"Ordinal numbers can only be formatted for the\n"+
+			"following languages: EN, FR and DE.\n"+
+			"languageCode = '%v'\n",
+			ePrefix.String(),
+			languageCode)
+
+		return ordinalStr, err
+	}
+
+	if err != nil {
+		return ordinalStr, err
+	}
+
+	var groupedDigits []rune
+
+	groupedDigits,
+		err = new(integerSeparatorSpecMolecule).
+		applyIntSeparators(
+			&intSeparatorSpec,
+			[]rune(intDigits),
+			ePrefix.XCpy(
+				"groupedDigits<-intDigits"))
+
+	if err != nil {
+		return ordinalStr, err
+	}
+
+	// German ordinals consist of a trailing period
+	// which has no superscript form.
+	if useSuperscript &&
+		suffix != "." {
+
+		suffix = new(numStrOrdinalQuark).
+			getSuperscriptSuffix(suffix)
+	}
+
+	return string(groupedDigits) + suffix, err
+}
+
+// getPrimaryLanguageCode
+//
+// Receives an ISO 639-1 language code or a BCP 47
+// language tag and returns the primary language subtag
+// converted to upper case.
+//
+// Subtags may be delimited by hyphens ('-') or
+// underscores ('_').
+//
+//	Examples:
+//		"en"			"EN"
+//		"en-US"			"EN"
+//		"fr_CA"			"FR"
+//		" de-DE "		"DE"
+func (nStrOrdinalQuark *numStrOrdinalQuark) getPrimaryLanguageCode(
+	languageCode string) string {
+
+	if nStrOrdinalQuark.lock == nil {
+		nStrOrdinalQuark.lock = new(sync.Mutex)
+	}
+
+	nStrOrdinalQuark.lock.Lock()
+
+	defer nStrOrdinalQuark.lock.Unlock()
+
+	languageCode = strings.TrimSpace(languageCode)
+
+	if idx := strings.IndexAny(languageCode, "-_"); idx > -1 {
+		languageCode = languageCode[:idx]
+	}
+
+	return strings.ToUpper(languageCode)
+}
+
+// getSuperscriptSuffix
+//
+// Converts the lower case letters of an English or
+// French ordinal suffix to Unicode superscript (modifier
+// letter) characters.
+//
+//	Examples:
+//		"st"	"ˢᵗ"
+//		"nd"	"ⁿᵈ"
+//		"rd"	"ʳᵈ"
+//		"th"	"ᵗʰ"
+//		"er"	"ᵉʳ"
+//		"re"	"ʳᵉ"
+//		"e"		"ᵉ"
+//
+// Characters which have no superscript equivalent are
+// returned unchanged.
+func (nStrOrdinalQuark *numStrOrdinalQuark) getSuperscriptSuffix(
+	suffix string) string {
+
+	if nStrOrdinalQuark.lock == nil {
+		nStrOrdinalQuark.lock = new(sync.Mutex)
+	}
+
+	nStrOrdinalQuark.lock.Lock()
+
+	defer nStrOrdinalQuark.lock.Unlock()
+
+	superscripts := map[rune]rune{
+		'd': 'ᵈ',
+		'e': 'ᵉ',
+		'h': 'ʰ',
+		'n': 'ⁿ',
+		'r': 'ʳ',
+		's': 'ˢ',
+		't': 'ᵗ',
+	}
+
+	suffixRunes := []rune(suffix)
+
+	for i, char := range suffixRunes {
+
+		if superChar, ok := superscripts[char]; ok {
+			suffixRunes[i] = superChar
+		}
+	}
+
+	return string(suffixRunes)
+}
@@ -0,0 +1,1327 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"strings"
+	"sync"
+)
+
+// NumStrPercentFormatSpec
+//
+// Number String Percent Format Specification. This type
+// specifies that numeric values will be scaled and
+// formatted as percentages, per mille values or basis
+// points.
+//
+// When configured as a member of NumStrFormatSpec, this
+// specification directs NumberStrKernel.FmtNumStr() to
+// multiply the numeric value of a NumberStrKernel by
+// the designated scale factor before formatting:
+//
+//	Format Type						Scale Factor	Symbol
+//	NumStrFmtType.Percent()			100				"%"
+//	NumStrFmtType.PerMille()		1,000			"‰"
+//	NumStrFmtType.BasisPoint()		10,000			"bp"
+//
+// The percent, per mille or basis point symbol, together
+// with its placement and spacing, is configured through
+// the Currency Symbol Specification of the Number Symbol
+// Group in NumStrFormatSpec. This allows each country or
+// culture to position the symbol correctly relative to
+// the number sign.
+//
+//	Examples:
+//		Value:  0.1234
+//		Percent, US 		= "12.34%"
+//		Percent, France		= "12,34 %"
+//		Per Mille, US		= "123.4‰"
+//		Basis Points, US	= "1,234 bp"
+//
+// An empty or zero value instance of
+// NumStrPercentFormatSpec is treated as a NOP, or 'No
+// Operation', specification. In this case numeric values
+// are NOT scaled.
+type NumStrPercentFormatSpec struct {
+	percentFmtType NumStrFormatTypeCode
+	//	Specifies the scale factor applied to numeric
+	//	values. Valid values are:
+	//
+	//		NumStrFmtType.Percent()
+	//		NumStrFmtType.PerMille()
+	//		NumStrFmtType.BasisPoint()
+	//
+	//	Any other value signals that this specification
+	//	is NOP, or Not Operational.
+
+	lock *sync.Mutex
+}
+
+// CopyIn
+//
+// Copies the data fields from an incoming instance of
+// NumStrPercentFormatSpec ('incomingPercentFmtSpec')
+// to the data fields of the current
+// NumStrPercentFormatSpec instance.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the member variable data values in the current
+//	NumStrPercentFormatSpec instance will be
+//	deleted and replaced.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	incomingPercentFmtSpec		*NumStrPercentFormatSpec
+//
+//		A pointer to an instance of
+//		NumStrPercentFormatSpec. This method will
+//		NOT change the values of internal member
+//		variables contained in this instance.
+//
+//		If this instance is invalid, an error will be
+//		returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrPercentFmtSpec *NumStrPercentFormatSpec) CopyIn(
+	incomingPercentFmtSpec *NumStrPercentFormatSpec,
+	errorPrefix interface{}) error {
+
+	if nStrPercentFmtSpec.lock == nil {
+		nStrPercentFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrPercentFmtSpec.lock.Lock()
+
+	defer nStrPercentFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrPercentFormatSpec."+
+			"CopyIn()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrPercentFormatSpecAtom).copy(
+		nStrPercentFmtSpec,
+		incomingPercentFmtSpec,
+		ePrefix.XCpy(
+			"nStrPercentFmtSpec<-incomingPercentFmtSpec"))
+}
+
+// CopyOut
+//
+// Returns a deep copy of the current
+// NumStrPercentFormatSpec instance.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	deepCopyPercentFmtSpec		NumStrPercentFormatSpec
+//
+//		If this method completes successfully, a deep
+//		copy of the current NumStrPercentFormatSpec
+//		instance will be returned.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrPercentFmtSpec *NumStrPercentFormatSpec) CopyOut(
+	errorPrefix interface{}) (
+	deepCopyPercentFmtSpec NumStrPercentFormatSpec,
+	err error) {
+
+	if nStrPercentFmtSpec.lock == nil {
+		nStrPercentFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrPercentFmtSpec.lock.Lock()
+
+	defer nStrPercentFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrPercentFormatSpec."+
+			"CopyOut()",
+		"")
+
+	if err != nil {
+		return deepCopyPercentFmtSpec, err
+	}
+
+	err = new(numStrPercentFormatSpecAtom).copy(
+		&deepCopyPercentFmtSpec,
+		nStrPercentFmtSpec,
+		ePrefix.XCpy(
+			"deepCopyPercentFmtSpec<-nStrPercentFmtSpec"))
+
+	return deepCopyPercentFmtSpec, err
+}
+
+// Empty
+//
+// Resets all internal member variables for the current
+// instance of NumStrPercentFormatSpec to their
+// initial or zero values. Afterwards, the current
+// instance is NOP, or Not Operational.
+func (nStrPercentFmtSpec *NumStrPercentFormatSpec) Empty() {
+
+	if nStrPercentFmtSpec.lock == nil {
+		nStrPercentFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrPercentFmtSpec.lock.Lock()
+
+	new(numStrPercentFormatSpecAtom).empty(
+		nStrPercentFmtSpec)
+
+	nStrPercentFmtSpec.lock.Unlock()
+
+	nStrPercentFmtSpec.lock = nil
+}
+
+// Equal
+//
+// Receives a pointer to another instance of
+// NumStrPercentFormatSpec and proceeds to compare
+// its internal member variables to those of the current
+// instance. If all member variables are equivalent,
+// this method returns 'true'.
+func (nStrPercentFmtSpec *NumStrPercentFormatSpec) Equal(
+	incomingPercentFmtSpec *NumStrPercentFormatSpec) bool {
+
+	if nStrPercentFmtSpec.lock == nil {
+		nStrPercentFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrPercentFmtSpec.lock.Lock()
+
+	defer nStrPercentFmtSpec.lock.Unlock()
+
+	return new(numStrPercentFormatSpecAtom).equal(
+		nStrPercentFmtSpec,
+		incomingPercentFmtSpec)
+}
+
+// GetPercentFormatType
+//
+// Returns the Number String Format Type Code which
+// specifies the scale factor applied to numeric values.
+//
+// If the current instance is NOP, this method returns
+// NumStrFmtType.None().
+func (nStrPercentFmtSpec *NumStrPercentFormatSpec) GetPercentFormatType() NumStrFormatTypeCode {
+
+	if nStrPercentFmtSpec.lock == nil {
+		nStrPercentFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrPercentFmtSpec.lock.Lock()
+
+	defer nStrPercentFmtSpec.lock.Unlock()
+
+	_,
+		_,
+		ok := new(numStrPercentFormatSpecAtom).getPercentParams(
+		nStrPercentFmtSpec.percentFmtType)
+
+	if !ok {
+		return NumStrFmtType.None()
+	}
+
+	return nStrPercentFmtSpec.percentFmtType
+}
+
+// GetPercentSymbol
+//
+// Returns the standard symbol associated with the
+// current instance of NumStrPercentFormatSpec:
+//
+//	NumStrFmtType.Percent()			"%"
+//	NumStrFmtType.PerMille()		"‰"
+//	NumStrFmtType.BasisPoint()		"bp"
+//
+// If the current instance is NOP, this method returns
+// an empty string.
+func (nStrPercentFmtSpec *NumStrPercentFormatSpec) GetPercentSymbol() string {
+
+	if nStrPercentFmtSpec.lock == nil {
+		nStrPercentFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrPercentFmtSpec.lock.Lock()
+
+	defer nStrPercentFmtSpec.lock.Unlock()
+
+	_,
+		percentSymbol,
+		_ := new(numStrPercentFormatSpecAtom).getPercentParams(
+		nStrPercentFmtSpec.percentFmtType)
+
+	return percentSymbol
+}
+
+// GetScaleExponent
+//
+// Returns the power of ten by which numeric values are
+// multiplied before formatting:
+//
+//	NumStrFmtType.Percent()			2	(x 100)
+//	NumStrFmtType.PerMille()		3	(x 1,000)
+//	NumStrFmtType.BasisPoint()		4	(x 10,000)
+//
+// If the current instance is NOP, this method returns
+// zero.
+func (nStrPercentFmtSpec *NumStrPercentFormatSpec) GetScaleExponent() int {
+
+	if nStrPercentFmtSpec.lock == nil {
+		nStrPercentFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrPercentFmtSpec.lock.Lock()
+
+	defer nStrPercentFmtSpec.lock.Unlock()
+
+	scaleExponent,
+		_,
+		_ := new(numStrPercentFormatSpecAtom).getPercentParams(
+		nStrPercentFmtSpec.percentFmtType)
+
+	return scaleExponent
+}
+
+// IsNOP
+//
+// Stands for 'Is No Operation'. If this method returns
+// 'true', the current instance of NumStrPercentFormatSpec
+// is not configured for percent, per mille or basis
+// point formatting and numeric values will NOT be
+// scaled.
+func (nStrPercentFmtSpec *NumStrPercentFormatSpec) IsNOP() bool {
+
+	if nStrPercentFmtSpec.lock == nil {
+		nStrPercentFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrPercentFmtSpec.lock.Lock()
+
+	defer nStrPercentFmtSpec.lock.Unlock()
+
+	_,
+		_,
+		ok := new(numStrPercentFormatSpecAtom).getPercentParams(
+		nStrPercentFmtSpec.percentFmtType)
+
+	return !ok
+}
+
+// IsValidInstanceError
+//
+// Performs a diagnostic review of the data values
+// encapsulated in the current
+// NumStrPercentFormatSpec instance to determine if
+// they are valid.
+//
+// A NOP instance is considered valid.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrPercentFmtSpec *NumStrPercentFormatSpec) IsValidInstanceError(
+	errorPrefix interface{}) error {
+
+	if nStrPercentFmtSpec.lock == nil {
+		nStrPercentFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrPercentFmtSpec.lock.Lock()
+
+	defer nStrPercentFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrPercentFormatSpec."+
+			"IsValidInstanceError()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrPercentFormatSpecAtom).testValidity(
+		nStrPercentFmtSpec,
+		ePrefix.XCpy(
+			"nStrPercentFmtSpec"))
+}
+
+// NewPercentFormat
+//
+// Creates and returns a new instance of
+// NumStrPercentFormatSpec configured to scale numeric
+// values as percentages, per mille values or basis
+// points.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	percentFmtType				NumStrFormatTypeCode
+//
+//		Specifies the scale factor applied to numeric
+//		values. Must be set to one of the following
+//		values or an error will be returned:
+//
+//			NumStrFmtType.Percent()		x 100
+//			NumStrFmtType.PerMille()	x 1,000
+//			NumStrFmtType.BasisPoint()	x 10,000
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	newPercentFmtSpec			NumStrPercentFormatSpec
+//
+//		If this method completes successfully, a new,
+//		fully populated instance of
+//		NumStrPercentFormatSpec will be returned.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrPercentFmtSpec *NumStrPercentFormatSpec) NewPercentFormat(
+	percentFmtType NumStrFormatTypeCode,
+	errorPrefix interface{}) (
+	newPercentFmtSpec NumStrPercentFormatSpec,
+	err error) {
+
+	if nStrPercentFmtSpec.lock == nil {
+		nStrPercentFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrPercentFmtSpec.lock.Lock()
+
+	defer nStrPercentFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrPercentFormatSpec."+
+			"NewPercentFormat()",
+		"")
+
+	if err != nil {
+		return newPercentFmtSpec, err
+	}
+
+	if _, _, ok := new(numStrPercentFormatSpecAtom).
+		getPercentParams(percentFmtType); !ok {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'percentFmtType' is invalid!\n"+
+			"'percentFmtType' must be Percent, PerMille or BasisPoint.\n"+
+			"percentFmtType = '%v'\n",
+			ePrefix.String(),
+			percentFmtType.String())
+
+		return newPercentFmtSpec, err
+	}
+
+	newPercentFmtSpec.percentFmtType = percentFmtType
+
+	return newPercentFmtSpec, err
+}
+
+// numStrPercentFormatSpecAtom - Provides helper methods
+// for type NumStrPercentFormatSpec.
+type numStrPercentFormatSpecAtom struct {
+	lock *sync.Mutex
+}
+
+// copy
+//
+// Copies all data from input parameter
+// 'sourcePercentFmtSpec' to input parameter
+// 'destinationPercentFmtSpec'. The source instance is
+// validated before the copy operation is performed.
+func (nStrPercentFmtSpecAtom *numStrPercentFormatSpecAtom) copy(
+	destinationPercentFmtSpec *NumStrPercentFormatSpec,
+	sourcePercentFmtSpec *NumStrPercentFormatSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrPercentFmtSpecAtom.lock == nil {
+		nStrPercentFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrPercentFmtSpecAtom.lock.Lock()
+
+	defer nStrPercentFmtSpecAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrPercentFormatSpecAtom."+
+			"copy()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if destinationPercentFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'destinationPercentFmtSpec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if sourcePercentFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sourcePercentFmtSpec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	err = new(numStrPercentFormatSpecAtom).testValidity(
+		sourcePercentFmtSpec,
+		ePrefix.XCpy(
+			"sourcePercentFmtSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	destinationPercentFmtSpec.percentFmtType =
+		sourcePercentFmtSpec.percentFmtType
+
+	return err
+}
+
+// empty
+//
+// Resets all member variables of input parameter
+// 'percentFmtSpec' to their zero values.
+func (nStrPercentFmtSpecAtom *numStrPercentFormatSpecAtom) empty(
+	percentFmtSpec *NumStrPercentFormatSpec) {
+
+	if nStrPercentFmtSpecAtom.lock == nil {
+		nStrPercentFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrPercentFmtSpecAtom.lock.Lock()
+
+	defer nStrPercentFmtSpecAtom.lock.Unlock()
+
+	if percentFmtSpec == nil {
+		return
+	}
+
+	percentFmtSpec.percentFmtType = NumStrFmtType.None()
+}
+
+// equal
+//
+// Compares the member variables of two instances of
+// NumStrPercentFormatSpec and returns 'true' if they are
+// equivalent in all respects.
+func (nStrPercentFmtSpecAtom *numStrPercentFormatSpecAtom) equal(
+	percentFmtSpec1 *NumStrPercentFormatSpec,
+	percentFmtSpec2 *NumStrPercentFormatSpec) bool {
+
+	if nStrPercentFmtSpecAtom.lock == nil {
+		nStrPercentFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrPercentFmtSpecAtom.lock.Lock()
+
+	defer nStrPercentFmtSpecAtom.lock.Unlock()
+
+	if percentFmtSpec1 == nil ||
+		percentFmtSpec2 == nil {
+
+		return false
+	}
+
+	if percentFmtSpec1.percentFmtType !=
+		percentFmtSpec2.percentFmtType {
+
+		return false
+	}
+
+	return true
+}
+
+// extractPercentSymbol
+//
+// Validates a percent, per mille or basis point number
+// string and converts it to a Native Number String. The
+// percent symbol is removed and the Number String
+// Format Type Code associated with the symbol is
+// returned.
+//
+// The following symbols are recognized:
+//
+//	"%"				NumStrFmtType.Percent()
+//	"‰"				NumStrFmtType.PerMille()
+//	"‱"				NumStrFmtType.BasisPoint()
+//	"bp", "bps"		NumStrFmtType.BasisPoint()
+//
+// Basis point abbreviations are recognized without
+// regard to character case. Spaces, non-breaking spaces
+// (U+00A0) and narrow non-breaking spaces (U+202F) are
+// ignored.
+//
+// 'percentNumStr' must consist of the following
+// components and nothing else:
+//
+//  1. At most one number sign designation: a leading
+//     plus ('+') or minus ('-') sign, a trailing minus
+//     sign or surrounding parentheses ('()').
+//
+//  2. At most one percent symbol located immediately
+//     before or after the numeric digits.
+//
+//  3. Numeric digits ('0' - '9'). Integer separators
+//     (comma, period or apostrophe) which do not match
+//     'decimalSeparator' may separate integer digits.
+//
+//  4. At most one 'decimalSeparator'.
+//
+// If no percent symbol is found, 'symbolFmtType' is
+// returned as NumStrFmtType.None().
+//
+//	Examples:
+//		"-12.5 %"	=> "-12.5"		Percent
+//		"(1,250bp)"	=> "-1250"		BasisPoint
+//		"1.2.3%"	=> Error
+//		"12.5% 3"	=> Error
+//		"12.5%%"	=> Error
+//
+// This method does NOT lock the current instance of
+// numStrPercentFormatSpecAtom.
+func (nStrPercentFmtSpecAtom *numStrPercentFormatSpecAtom) extractPercentSymbol(
+	percentNumStr string,
+	decimalSeparator string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	nativeNumStr string,
+	symbolFmtType NumStrFormatTypeCode,
+	err error) {
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrPercentFormatSpecAtom."+
+			"extractPercentSymbol()",
+		"")
+
+	if err != nil {
+		return nativeNumStr, symbolFmtType, err
+	}
+
+	symbolFmtType = NumStrFmtType.None()
+
+	if len(decimalSeparator) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'decimalSeparator' is invalid!\n"+
+			"'decimalSeparator' is an empty string.\n",
+			ePrefix.String())
+
+		return nativeNumStr, symbolFmtType, err
+	}
+
+	numStr := strings.NewReplacer(
+		" ", "",
+		"\u00A0", "",
+		"\u202F", "").Replace(percentNumStr)
+
+	isNegative := false
+
+	switch {
+
+	case len(numStr) > 1 &&
+		numStr[0] == '(' &&
+		numStr[len(numStr)-1] == ')':
+
+		isNegative = true
+
+		numStr = numStr[1 : len(numStr)-1]
+
+	case len(numStr) > 0 &&
+		(numStr[0] == '-' || numStr[0] == '+'):
+
+		isNegative = numStr[0] == '-'
+
+		numStr = numStr[1:]
+
+	case len(numStr) > 0 &&
+		numStr[len(numStr)-1] == '-':
+
+		isNegative = true
+
+		numStr = numStr[:len(numStr)-1]
+	}
+
+	percentSymbols := []struct {
+		symbol  string
+		fmtType NumStrFormatTypeCode
+	}{
+		{"‱", NumStrFmtType.BasisPoint()},
+		{"‰", NumStrFmtType.PerMille()},
+		{"%", NumStrFmtType.Percent()},
+		{"bps", NumStrFmtType.BasisPoint()},
+		{"bp", NumStrFmtType.BasisPoint()},
+	}
+
+	lowerNumStr := strings.ToLower(numStr)
+
+	for _, percentSymbol := range percentSymbols {
+
+		if strings.HasSuffix(lowerNumStr, percentSymbol.symbol) {
+
+			numStr = numStr[:len(numStr)-len(percentSymbol.symbol)]
+
+			symbolFmtType = percentSymbol.fmtType
+
+			break
+		}
+
+		if strings.HasPrefix(lowerNumStr, percentSymbol.symbol) {
+
+			numStr = numStr[len(percentSymbol.symbol):]
+
+			symbolFmtType = percentSymbol.fmtType
+
+			break
+		}
+	}
+
+	intDigits := make([]rune, 0, len(numStr))
+	var fracDigits []rune
+	isFractional := false
+	lastWasDigit := false
+
+	// Integer digit groups are delimited by integer
+	// separators. The leading group contains one to
+	// three digits. Every following group contains
+	// exactly three digits.
+	hasGroupSeparator := false
+	groupDigitCount := 0
+
+	isGroupValid := func() bool {
+
+		if hasGroupSeparator {
+			return groupDigitCount == 3
+		}
+
+		return groupDigitCount <= 3
+	}
+
+	groupErr := func() error {
+
+		return fmt.Errorf("%v\n"+
+			"Error: Input parameter 'percentNumStr' is invalid!\n"+
+			"'percentNumStr' contains an invalid group of integer digits.\n"+
+			"Digit groups following an integer separator must contain\n"+
+			"exactly three digits.\n"+
+			"percentNumStr = '%v'\n",
+			ePrefix.String(),
+			percentNumStr)
+	}
+
+	for len(numStr) > 0 {
+
+		if strings.HasPrefix(numStr, decimalSeparator) {
+
+			if isFractional {
+
+				err = fmt.Errorf("%v\n"+
+					"Error: Input parameter 'percentNumStr' is invalid!\n"+
+					"'percentNumStr' contains more than one decimal separator.\n"+
+					"percentNumStr    = '%v'\n"+
+					"decimalSeparator = '%v'\n",
+					ePrefix.String(),
+					percentNumStr,
+					decimalSeparator)
+
+				return nativeNumStr, symbolFmtType, err
+			}
+
+			if hasGroupSeparator &&
+				!isGroupValid() {
+
+				err = groupErr()
+
+				return nativeNumStr, symbolFmtType, err
+			}
+
+			isFractional = true
+
+			lastWasDigit = false
+
+			numStr = numStr[len(decimalSeparator):]
+
+			continue
+		}
+
+		charRune := []rune(numStr)[0]
+
+		numStr = numStr[len(string(charRune)):]
+
+		switch {
+
+		case charRune >= '0' && charRune <= '9':
+
+			if isFractional {
+				fracDigits = append(fracDigits, charRune)
+			} else {
+				intDigits = append(intDigits, charRune)
+				groupDigitCount++
+			}
+
+			lastWasDigit = true
+
+			continue
+
+		case (charRune == ',' || charRune == '.' || charRune == '\'') &&
+			!isFractional &&
+			lastWasDigit &&
+			len(numStr) > 0 &&
+			numStr[0] >= '0' && numStr[0] <= '9':
+
+			// Integer Separator
+			if !isGroupValid() {
+
+				err = groupErr()
+
+				return nativeNumStr, symbolFmtType, err
+			}
+
+			hasGroupSeparator = true
+
+			groupDigitCount = 0
+
+			lastWasDigit = false
+
+			continue
+		}
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'percentNumStr' is invalid!\n"+
+			"'percentNumStr' contains an invalid character.\n"+
+			"percentNumStr     = '%v'\n"+
+			"Invalid Character = '%v'\n",
+			ePrefix.String(),
+			percentNumStr,
+			string(charRune))
+
+		return nativeNumStr, symbolFmtType, err
+	}
+
+	if !isFractional &&
+		hasGroupSeparator &&
+		!isGroupValid() {
+
+		err = groupErr()
+
+		return nativeNumStr, symbolFmtType, err
+	}
+
+	if len(intDigits)+len(fracDigits) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'percentNumStr' is invalid!\n"+
+			"'percentNumStr' contains no numeric digits.\n"+
+			"percentNumStr = '%v'\n",
+			ePrefix.String(),
+			percentNumStr)
+
+		return nativeNumStr, symbolFmtType, err
+	}
+
+	if len(intDigits) == 0 {
+		intDigits = append(intDigits, '0')
+	}
+
+	nativeNumStr = string(intDigits)
+
+	if len(fracDigits) > 0 {
+		nativeNumStr += "." + string(fracDigits)
+	}
+
+	if isNegative {
+		nativeNumStr = "-" + nativeNumStr
+	}
+
+	return nativeNumStr, symbolFmtType, err
+}
+
+// getCountryPercentSymbol
+//
+// Returns the percent, per mille or basis point symbol
+// customarily used by the country identified by the ISO
+// 3166 two character country code, 'countryCodeTwoChar'.
+//
+// Countries which separate the percent or per mille
+// symbol from the number with a space (for example,
+// France and Germany: "12,5 %") receive a non-breaking
+// space (U+00A0) prefix. Basis points are always
+// separated from the number by a non-breaking space.
+//
+// If 'percentFmtType' is not Percent, PerMille or
+// BasisPoint, 'ok' is returned as 'false'.
+//
+// This method does NOT lock the current instance of
+// numStrPercentFormatSpecAtom.
+func (nStrPercentFmtSpecAtom *numStrPercentFormatSpecAtom) getCountryPercentSymbol(
+	percentFmtType NumStrFormatTypeCode,
+	countryCodeTwoChar string) (
+	percentSymbol string,
+	ok bool) {
+
+	_,
+		percentSymbol,
+		ok = nStrPercentFmtSpecAtom.getPercentParams(
+		percentFmtType)
+
+	if !ok {
+		return percentSymbol, ok
+	}
+
+	if percentFmtType == NumStrFmtType.BasisPoint() {
+
+		return "\u00A0" + percentSymbol, ok
+	}
+
+	switch strings.ToUpper(countryCodeTwoChar) {
+
+	case "AT", "BE", "BG", "CZ", "DE", "DK", "ES", "FI",
+		"FR", "LU", "NO", "RU", "SE", "SK":
+
+		percentSymbol = "\u00A0" + percentSymbol
+	}
+
+	return percentSymbol, ok
+}
+
+// getPercentParams
+//
+// Returns the scale exponent (power of ten) and the
+// standard symbol associated with a Number String Format
+// Type Code. If the format type is not Percent, PerMille
+// or BasisPoint, 'ok' is returned as 'false', the scale
+// exponent is set to zero and the symbol is set to an
+// empty string.
+//
+// This method does NOT lock the current instance of
+// numStrPercentFormatSpecAtom.
+func (nStrPercentFmtSpecAtom *numStrPercentFormatSpecAtom) getPercentParams(
+	percentFmtType NumStrFormatTypeCode) (
+	scaleExponent int,
+	percentSymbol string,
+	ok bool) {
+
+	switch percentFmtType {
+
+	case NumStrFmtType.Percent():
+
+		return 2, "%", true
+
+	case NumStrFmtType.PerMille():
+
+		return 3, "‰", true
+
+	case NumStrFmtType.BasisPoint():
+
+		return 4, "bp", true
+
+	}
+
+	return 0, "", false
+}
+
+// testValidity
+//
+// Performs a diagnostic review of the member variables
+// contained in an instance of NumStrPercentFormatSpec.
+// If any member variable is invalid, an error is
+// returned.
+//
+// A NOP instance is considered valid.
+func (nStrPercentFmtSpecAtom *numStrPercentFormatSpecAtom) testValidity(
+	percentFmtSpec *NumStrPercentFormatSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrPercentFmtSpecAtom.lock == nil {
+		nStrPercentFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrPercentFmtSpecAtom.lock.Lock()
+
+	defer nStrPercentFmtSpecAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrPercentFormatSpecAtom."+
+			"testValidity()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if percentFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'percentFmtSpec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if percentFmtSpec.percentFmtType == NumStrFmtType.None() {
+		return err
+	}
+
+	if _, _, ok := nStrPercentFmtSpecAtom.getPercentParams(
+		percentFmtSpec.percentFmtType); !ok {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The percent format type is invalid!\n"+
+			"The percent format type must be None, Percent,\n"+
+			"PerMille or BasisPoint.\n"+
+			"percentFmtType = '%v'\n",
+			ePrefix.String(),
+			percentFmtSpec.percentFmtType.String())
+
+		return err
+	}
+
+	return err
+}
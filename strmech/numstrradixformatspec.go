@@ -0,0 +1,1103 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
+
+// NumStrRadixFormatSpec
+//
+// Number String Radix Format Specification. This type
+// contains the parameters required to format integer
+// numeric values as binary (base 2), octal (base 8) or
+// hexadecimal (base 16) number strings.
+//
+// When configured as a member of NumStrFormatSpec, this
+// specification directs NumberStrKernel.FmtNumStr() to
+// render the integer value of a NumberStrKernel in the
+// designated base. Grouping of the resulting digits is
+// controlled by the Integer Separator Specification
+// configured in NumStrFormatSpec.
+//
+//	Examples:
+//		Value:  -255
+//		Hexadecimal, prefix, upper case digits,
+//		sign-magnitude  = "-0xFF"
+//
+//		Value:  -1
+//		Hexadecimal, prefix, lower case digits,
+//		two's complement width 16 bits = "0xffff"
+//
+//		Value:  173
+//		Binary, prefix, integer grouping of 4
+//		with separator '_' = "0b1010_1101"
+//
+// An empty or zero value instance of
+// NumStrRadixFormatSpec is treated as a NOP, or 'No
+// Operation', specification. In this case numeric values
+// are formatted in base 10.
+type NumStrRadixFormatSpec struct {
+	radixFmtType NumStrFormatTypeCode
+	//	Specifies the base, or radix, of the formatted
+	//	number string. Valid values are:
+	//
+	//		NumStrFmtType.Binary()
+	//		NumStrFmtType.Octal()
+	//		NumStrFmtType.Hexadecimal()
+	//
+	//	Any other value signals that this specification
+	//	is NOP, or Not Operational.
+
+	useRadixPrefix bool
+	//	When set to 'true', the radix prefix will be
+	//	added to the beginning of the formatted integer
+	//	digits:
+	//
+	//		Binary      = "0b"
+	//		Octal       = "0o"
+	//		Hexadecimal = "0x"
+
+	useUpperCaseDigits bool
+	//	When set to 'true', hexadecimal digits 'a'
+	//	through 'f' will be formatted as upper case
+	//	characters 'A' through 'F'. This parameter
+	//	has no effect on binary or octal number
+	//	strings.
+
+	twosComplementBitWidth int
+	//	When set to zero, negative values are formatted
+	//	in sign-magnitude form. The absolute value is
+	//	converted to the designated base and the
+	//	negative number sign configured in
+	//	NumStrFormatSpec is applied.
+	//
+	//	When set to a value greater than zero, all
+	//	values are formatted as two's complement bit
+	//	patterns of this width. Negative number signs
+	//	are NOT applied, and the formatted digits are
+	//	padded with leading zeros to the full width. If
+	//	the numeric value cannot be represented in the
+	//	designated number of bits, an error will be
+	//	returned.
+
+	lock *sync.Mutex
+}
+
+// CopyIn
+//
+// Copies the data fields from an incoming instance of
+// NumStrRadixFormatSpec ('incomingRadixFmtSpec') to the
+// data fields of the current NumStrRadixFormatSpec
+// instance.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the member variable data values in the current
+//	NumStrRadixFormatSpec instance will be deleted and
+//	replaced.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	incomingRadixFmtSpec		*NumStrRadixFormatSpec
+//
+//		A pointer to an instance of NumStrRadixFormatSpec.
+//		This method will NOT change the values of internal
+//		member variables contained in this instance.
+//
+//		If this instance is invalid, an error will be
+//		returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrRadixFmtSpec *NumStrRadixFormatSpec) CopyIn(
+	incomingRadixFmtSpec *NumStrRadixFormatSpec,
+	errorPrefix interface{}) error {
+
+	if nStrRadixFmtSpec.lock == nil {
+		nStrRadixFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRadixFmtSpec.lock.Lock()
+
+	defer nStrRadixFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrRadixFormatSpec."+
+			"CopyIn()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrRadixFormatSpecAtom).copy(
+		nStrRadixFmtSpec,
+		incomingRadixFmtSpec,
+		ePrefix.XCpy(
+			"nStrRadixFmtSpec<-incomingRadixFmtSpec"))
+}
+
+// CopyOut
+//
+// Returns a deep copy of the current
+// NumStrRadixFormatSpec instance.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	deepCopyRadixFmtSpec		NumStrRadixFormatSpec
+//
+//		If this method completes successfully, a deep
+//		copy of the current NumStrRadixFormatSpec
+//		instance will be returned.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrRadixFmtSpec *NumStrRadixFormatSpec) CopyOut(
+	errorPrefix interface{}) (
+	deepCopyRadixFmtSpec NumStrRadixFormatSpec,
+	err error) {
+
+	if nStrRadixFmtSpec.lock == nil {
+		nStrRadixFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRadixFmtSpec.lock.Lock()
+
+	defer nStrRadixFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrRadixFormatSpec."+
+			"CopyOut()",
+		"")
+
+	if err != nil {
+		return deepCopyRadixFmtSpec, err
+	}
+
+	err = new(numStrRadixFormatSpecAtom).copy(
+		&deepCopyRadixFmtSpec,
+		nStrRadixFmtSpec,
+		ePrefix.XCpy(
+			"deepCopyRadixFmtSpec<-nStrRadixFmtSpec"))
+
+	return deepCopyRadixFmtSpec, err
+}
+
+// Empty
+//
+// Resets all internal member variables for the current
+// instance of NumStrRadixFormatSpec to their initial or
+// zero values. Afterwards, the current instance is NOP,
+// or Not Operational.
+func (nStrRadixFmtSpec *NumStrRadixFormatSpec) Empty() {
+
+	if nStrRadixFmtSpec.lock == nil {
+		nStrRadixFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRadixFmtSpec.lock.Lock()
+
+	new(numStrRadixFormatSpecAtom).empty(
+		nStrRadixFmtSpec)
+
+	nStrRadixFmtSpec.lock.Unlock()
+
+	nStrRadixFmtSpec.lock = nil
+}
+
+// Equal
+//
+// Receives a pointer to another instance of
+// NumStrRadixFormatSpec and proceeds to compare its
+// internal member variables to those of the current
+// instance. If all member variables are equivalent,
+// this method returns 'true'.
+func (nStrRadixFmtSpec *NumStrRadixFormatSpec) Equal(
+	incomingRadixFmtSpec *NumStrRadixFormatSpec) bool {
+
+	if nStrRadixFmtSpec.lock == nil {
+		nStrRadixFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRadixFmtSpec.lock.Lock()
+
+	defer nStrRadixFmtSpec.lock.Unlock()
+
+	return new(numStrRadixFormatSpecAtom).equal(
+		nStrRadixFmtSpec,
+		incomingRadixFmtSpec)
+}
+
+// GetRadix
+//
+// Returns the numeric base configured for the current
+// instance of NumStrRadixFormatSpec (2, 8 or 16).
+//
+// If the current instance is NOP, this method returns
+// a value of 10.
+func (nStrRadixFmtSpec *NumStrRadixFormatSpec) GetRadix() int {
+
+	if nStrRadixFmtSpec.lock == nil {
+		nStrRadixFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRadixFmtSpec.lock.Lock()
+
+	defer nStrRadixFmtSpec.lock.Unlock()
+
+	radix,
+		_ := new(numStrRadixFormatSpecAtom).getRadixParams(
+		nStrRadixFmtSpec.radixFmtType)
+
+	return radix
+}
+
+// GetRadixFormatType
+//
+// Returns the Number String Format Type Code which
+// specifies the base of the formatted number string.
+func (nStrRadixFmtSpec *NumStrRadixFormatSpec) GetRadixFormatType() NumStrFormatTypeCode {
+
+	if nStrRadixFmtSpec.lock == nil {
+		nStrRadixFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRadixFmtSpec.lock.Lock()
+
+	defer nStrRadixFmtSpec.lock.Unlock()
+
+	return nStrRadixFmtSpec.radixFmtType
+}
+
+// GetTwosComplementBitWidth
+//
+// Returns the two's complement bit width. A value of
+// zero signals that negative values are formatted in
+// sign-magnitude form.
+func (nStrRadixFmtSpec *NumStrRadixFormatSpec) GetTwosComplementBitWidth() int {
+
+	if nStrRadixFmtSpec.lock == nil {
+		nStrRadixFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRadixFmtSpec.lock.Lock()
+
+	defer nStrRadixFmtSpec.lock.Unlock()
+
+	return nStrRadixFmtSpec.twosComplementBitWidth
+}
+
+// IsNOP
+//
+// Stands for 'Is No Operation'. If this method returns
+// 'true', the current instance of NumStrRadixFormatSpec
+// is not configured for binary, octal or hexadecimal
+// formatting and numeric values will be formatted in
+// base 10.
+func (nStrRadixFmtSpec *NumStrRadixFormatSpec) IsNOP() bool {
+
+	if nStrRadixFmtSpec.lock == nil {
+		nStrRadixFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRadixFmtSpec.lock.Lock()
+
+	defer nStrRadixFmtSpec.lock.Unlock()
+
+	_,
+		ok := new(numStrRadixFormatSpecAtom).getRadixParams(
+		nStrRadixFmtSpec.radixFmtType)
+
+	return !ok
+}
+
+// IsValidInstanceError
+//
+// Performs a diagnostic review of the data values
+// encapsulated in the current NumStrRadixFormatSpec
+// instance to determine if they are valid.
+//
+// A NOP instance is considered valid.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrRadixFmtSpec *NumStrRadixFormatSpec) IsValidInstanceError(
+	errorPrefix interface{}) error {
+
+	if nStrRadixFmtSpec.lock == nil {
+		nStrRadixFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRadixFmtSpec.lock.Lock()
+
+	defer nStrRadixFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrRadixFormatSpec."+
+			"IsValidInstanceError()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrRadixFormatSpecAtom).testValidity(
+		nStrRadixFmtSpec,
+		ePrefix.XCpy(
+			"nStrRadixFmtSpec"))
+}
+
+// NewRadixFormat
+//
+// Creates and returns a new instance of
+// NumStrRadixFormatSpec configured to format integer
+// values as binary, octal or hexadecimal number
+// strings.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	radixFmtType				NumStrFormatTypeCode
+//
+//		Specifies the base of the formatted number
+//		string. Must be set to one of the following
+//		values or an error will be returned:
+//
+//			NumStrFmtType.Binary()
+//			NumStrFmtType.Octal()
+//			NumStrFmtType.Hexadecimal()
+//
+//	useRadixPrefix				bool
+//
+//		When set to 'true', the radix prefix ("0b", "0o"
+//		or "0x") will be added to the beginning of the
+//		formatted digits.
+//
+//	useUpperCaseDigits			bool
+//
+//		When set to 'true', hexadecimal digits will be
+//		formatted in upper case ('A'-'F'). Otherwise,
+//		hexadecimal digits are formatted in lower case
+//		('a'-'f').
+//
+//	twosComplementBitWidth		int
+//
+//		Set this value to zero to format negative values
+//		in sign-magnitude form.
+//
+//		A value greater than zero specifies the number
+//		of bits used to format values as two's
+//		complement bit patterns. Typical values are 8,
+//		16, 32 and 64.
+//
+//		If this value is less than zero or greater than
+//		4,096, an error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	newRadixFmtSpec				NumStrRadixFormatSpec
+//
+//		If this method completes successfully, a new,
+//		fully populated instance of NumStrRadixFormatSpec
+//		will be returned.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrRadixFmtSpec *NumStrRadixFormatSpec) NewRadixFormat(
+	radixFmtType NumStrFormatTypeCode,
+	useRadixPrefix bool,
+	useUpperCaseDigits bool,
+	twosComplementBitWidth int,
+	errorPrefix interface{}) (
+	newRadixFmtSpec NumStrRadixFormatSpec,
+	err error) {
+
+	if nStrRadixFmtSpec.lock == nil {
+		nStrRadixFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRadixFmtSpec.lock.Lock()
+
+	defer nStrRadixFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrRadixFormatSpec."+
+			"NewRadixFormat()",
+		"")
+
+	if err != nil {
+		return newRadixFmtSpec, err
+	}
+
+	newRadixFmtSpec.radixFmtType = radixFmtType
+
+	newRadixFmtSpec.useRadixPrefix = useRadixPrefix
+
+	newRadixFmtSpec.useUpperCaseDigits = useUpperCaseDigits
+
+	newRadixFmtSpec.twosComplementBitWidth =
+		twosComplementBitWidth
+
+	atom := numStrRadixFormatSpecAtom{}
+
+	if _, ok := atom.getRadixParams(radixFmtType); !ok {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'radixFmtType' is invalid!\n"+
+			"'radixFmtType' must be Binary, Octal or Hexadecimal.\n"+
+			"radixFmtType = '%v'\n",
+			ePrefix.String(),
+			radixFmtType.String())
+
+		return NumStrRadixFormatSpec{}, err
+	}
+
+	err = atom.testValidity(
+		&newRadixFmtSpec,
+		ePrefix.XCpy(
+			"newRadixFmtSpec"))
+
+	if err != nil {
+		return NumStrRadixFormatSpec{}, err
+	}
+
+	return newRadixFmtSpec, err
+}
+
+// UsesRadixPrefix
+//
+// Returns 'true' if the radix prefix ("0b", "0o" or
+// "0x") will be added to formatted number strings.
+func (nStrRadixFmtSpec *NumStrRadixFormatSpec) UsesRadixPrefix() bool {
+
+	if nStrRadixFmtSpec.lock == nil {
+		nStrRadixFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRadixFmtSpec.lock.Lock()
+
+	defer nStrRadixFmtSpec.lock.Unlock()
+
+	return nStrRadixFmtSpec.useRadixPrefix
+}
+
+// UsesUpperCaseDigits
+//
+// Returns 'true' if hexadecimal digits will be formatted
+// as upper case characters.
+func (nStrRadixFmtSpec *NumStrRadixFormatSpec) UsesUpperCaseDigits() bool {
+
+	if nStrRadixFmtSpec.lock == nil {
+		nStrRadixFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRadixFmtSpec.lock.Lock()
+
+	defer nStrRadixFmtSpec.lock.Unlock()
+
+	return nStrRadixFmtSpec.useUpperCaseDigits
+}
+
+// numStrRadixFormatSpecAtom - Provides helper methods for
+// type NumStrRadixFormatSpec.
+type numStrRadixFormatSpecAtom struct {
+	lock *sync.Mutex
+}
+
+// copy
+//
+// Copies all data from input parameter
+// 'sourceRadixFmtSpec' to input parameter
+// 'destinationRadixFmtSpec'. The source instance is
+// validated before the copy operation is performed.
+func (nStrRadixFmtSpecAtom *numStrRadixFormatSpecAtom) copy(
+	destinationRadixFmtSpec *NumStrRadixFormatSpec,
+	sourceRadixFmtSpec *NumStrRadixFormatSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrRadixFmtSpecAtom.lock == nil {
+		nStrRadixFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrRadixFmtSpecAtom.lock.Lock()
+
+	defer nStrRadixFmtSpecAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrRadixFormatSpecAtom."+
+			"copy()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if destinationRadixFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'destinationRadixFmtSpec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if sourceRadixFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sourceRadixFmtSpec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	err = new(numStrRadixFormatSpecAtom).testValidity(
+		sourceRadixFmtSpec,
+		ePrefix.XCpy(
+			"sourceRadixFmtSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	destinationRadixFmtSpec.radixFmtType =
+		sourceRadixFmtSpec.radixFmtType
+
+	destinationRadixFmtSpec.useRadixPrefix =
+		sourceRadixFmtSpec.useRadixPrefix
+
+	destinationRadixFmtSpec.useUpperCaseDigits =
+		sourceRadixFmtSpec.useUpperCaseDigits
+
+	destinationRadixFmtSpec.twosComplementBitWidth =
+		sourceRadixFmtSpec.twosComplementBitWidth
+
+	return err
+}
+
+// empty
+//
+// Resets all member variables of input parameter
+// 'radixFmtSpec' to their zero values.
+func (nStrRadixFmtSpecAtom *numStrRadixFormatSpecAtom) empty(
+	radixFmtSpec *NumStrRadixFormatSpec) {
+
+	if nStrRadixFmtSpecAtom.lock == nil {
+		nStrRadixFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrRadixFmtSpecAtom.lock.Lock()
+
+	defer nStrRadixFmtSpecAtom.lock.Unlock()
+
+	if radixFmtSpec == nil {
+		return
+	}
+
+	radixFmtSpec.radixFmtType = NumStrFmtType.None()
+
+	radixFmtSpec.useRadixPrefix = false
+
+	radixFmtSpec.useUpperCaseDigits = false
+
+	radixFmtSpec.twosComplementBitWidth = 0
+}
+
+// equal
+//
+// Compares the member variables of two instances of
+// NumStrRadixFormatSpec and returns 'true' if they are
+// equivalent in all respects.
+func (nStrRadixFmtSpecAtom *numStrRadixFormatSpecAtom) equal(
+	radixFmtSpec1 *NumStrRadixFormatSpec,
+	radixFmtSpec2 *NumStrRadixFormatSpec) bool {
+
+	if nStrRadixFmtSpecAtom.lock == nil {
+		nStrRadixFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrRadixFmtSpecAtom.lock.Lock()
+
+	defer nStrRadixFmtSpecAtom.lock.Unlock()
+
+	if radixFmtSpec1 == nil ||
+		radixFmtSpec2 == nil {
+
+		return false
+	}
+
+	if radixFmtSpec1.radixFmtType !=
+		radixFmtSpec2.radixFmtType {
+
+		return false
+	}
+
+	if radixFmtSpec1.useRadixPrefix !=
+		radixFmtSpec2.useRadixPrefix {
+
+		return false
+	}
+
+	if radixFmtSpec1.useUpperCaseDigits !=
+		radixFmtSpec2.useUpperCaseDigits {
+
+		return false
+	}
+
+	if radixFmtSpec1.twosComplementBitWidth !=
+		radixFmtSpec2.twosComplementBitWidth {
+
+		return false
+	}
+
+	return true
+}
+
+// getRadixParams
+//
+// Returns the numeric base associated with a Number
+// String Format Type Code. If the format type is not
+// Binary, Octal or Hexadecimal, 'ok' is returned as
+// 'false' and 'radix' is set to 10.
+//
+// This method does NOT lock the current instance of
+// numStrRadixFormatSpecAtom.
+func (nStrRadixFmtSpecAtom *numStrRadixFormatSpecAtom) getRadixParams(
+	radixFmtType NumStrFormatTypeCode) (
+	radix int,
+	ok bool) {
+
+	switch radixFmtType {
+
+	case NumStrFmtType.Binary():
+
+		return 2, true
+
+	case NumStrFmtType.Octal():
+
+		return 8, true
+
+	case NumStrFmtType.Hexadecimal():
+
+		return 16, true
+
+	}
+
+	return 10, false
+}
+
+// getRadixPrefix
+//
+// Returns the radix prefix associated with a numeric
+// base: "0b" for base 2, "0o" for base 8 and "0x" for
+// base 16. Any other base returns an empty string.
+//
+// This method does NOT lock the current instance of
+// numStrRadixFormatSpecAtom.
+func (nStrRadixFmtSpecAtom *numStrRadixFormatSpecAtom) getRadixPrefix(
+	radix int) string {
+
+	switch radix {
+
+	case 2:
+
+		return "0b"
+
+	case 8:
+
+		return "0o"
+
+	case 16:
+
+		return "0x"
+
+	}
+
+	return ""
+}
+
+// testValidity
+//
+// Performs a diagnostic review of the member variables
+// contained in an instance of NumStrRadixFormatSpec. If
+// any member variable is invalid, an error is returned.
+//
+// A NOP instance is considered valid.
+func (nStrRadixFmtSpecAtom *numStrRadixFormatSpecAtom) testValidity(
+	radixFmtSpec *NumStrRadixFormatSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrRadixFmtSpecAtom.lock == nil {
+		nStrRadixFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrRadixFmtSpecAtom.lock.Lock()
+
+	defer nStrRadixFmtSpecAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrRadixFormatSpecAtom."+
+			"testValidity()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if radixFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'radixFmtSpec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if radixFmtSpec.twosComplementBitWidth < 0 ||
+		radixFmtSpec.twosComplementBitWidth > 4096 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The two's complement bit width is invalid!\n"+
+			"The bit width must be greater than or equal to zero\n"+
+			"and less than or equal to 4,096.\n"+
+			"twosComplementBitWidth = '%v'\n",
+			ePrefix.String(),
+			radixFmtSpec.twosComplementBitWidth)
+
+		return err
+	}
+
+	return err
+}
@@ -0,0 +1,922 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
+
+// NumStrRomanNumeralFormatSpec
+//
+// Number String Roman Numeral Format Specification. This
+// type contains the parameters required to format
+// positive integer values as Roman numerals.
+//
+// When configured as a member of NumStrFormatSpec, this
+// specification directs NumberStrKernel.FmtNumStr() to
+// render the integer value of a NumberStrKernel as a
+// Roman numeral.
+//
+//	Examples:
+//		Value:  1994
+//		Upper case             = "MCMXCIV"
+//		Lower case             = "mcmxciv"
+//
+//		Value:  4000
+//		Upper case, vinculum   = "I̅V̅"
+//
+// Standard Roman numerals are limited to values between
+// 1 and 3,999. When the vinculum, or overline, form is
+// enabled, values between 4,000 and 3,999,999 are
+// formatted by placing a combining overline character
+// (U+0305) after each numeral in the thousands portion
+// of the value. An overlined numeral represents its
+// standard value multiplied by 1,000.
+//
+// Roman numerals have no representation for zero,
+// negative values or fractional values. Attempting to
+// format such values will generate an error.
+//
+// An empty or zero value instance of
+// NumStrRomanNumeralFormatSpec is treated as a NOP, or
+// 'No Operation', specification. In this case numeric
+// values are formatted in base 10.
+type NumStrRomanNumeralFormatSpec struct {
+	romanNumeralFmtType NumStrFormatTypeCode
+	//	When set to NumStrFmtType.RomanNumeral(), this
+	//	specification is operational and numeric values
+	//	will be formatted as Roman numerals.
+	//
+	//	Any other value signals that this specification
+	//	is NOP, or Not Operational.
+
+	useLowerCase bool
+	//	When set to 'true', Roman numerals will be
+	//	formatted as lower case characters ("mcmxciv").
+	//	Otherwise, Roman numerals are formatted as upper
+	//	case characters ("MCMXCIV").
+
+	useVinculum bool
+	//	When set to 'true', values greater than 3,999
+	//	will be formatted using the vinculum, or
+	//	overline, form. The thousands portion of the
+	//	value is rendered as Roman numerals with each
+	//	character followed by a combining overline
+	//	(U+0305). The maximum value which can be
+	//	formatted in this manner is 3,999,999.
+	//
+	//	When set to 'false', values greater than 3,999
+	//	will generate an error.
+
+	lock *sync.Mutex
+}
+
+// CopyIn
+//
+// Copies the data fields from an incoming instance of
+// NumStrRomanNumeralFormatSpec ('incomingRomanFmtSpec')
+// to the data fields of the current
+// NumStrRomanNumeralFormatSpec instance.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the member variable data values in the current
+//	NumStrRomanNumeralFormatSpec instance will be
+//	deleted and replaced.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	incomingRomanFmtSpec		*NumStrRomanNumeralFormatSpec
+//
+//		A pointer to an instance of
+//		NumStrRomanNumeralFormatSpec. This method will
+//		NOT change the values of internal member
+//		variables contained in this instance.
+//
+//		If this instance is invalid, an error will be
+//		returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrRomanFmtSpec *NumStrRomanNumeralFormatSpec) CopyIn(
+	incomingRomanFmtSpec *NumStrRomanNumeralFormatSpec,
+	errorPrefix interface{}) error {
+
+	if nStrRomanFmtSpec.lock == nil {
+		nStrRomanFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRomanFmtSpec.lock.Lock()
+
+	defer nStrRomanFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrRomanNumeralFormatSpec."+
+			"CopyIn()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrRomanNumeralFormatSpecAtom).copy(
+		nStrRomanFmtSpec,
+		incomingRomanFmtSpec,
+		ePrefix.XCpy(
+			"nStrRomanFmtSpec<-incomingRomanFmtSpec"))
+}
+
+// CopyOut
+//
+// Returns a deep copy of the current
+// NumStrRomanNumeralFormatSpec instance.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	deepCopyRomanFmtSpec		NumStrRomanNumeralFormatSpec
+//
+//		If this method completes successfully, a deep
+//		copy of the current NumStrRomanNumeralFormatSpec
+//		instance will be returned.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrRomanFmtSpec *NumStrRomanNumeralFormatSpec) CopyOut(
+	errorPrefix interface{}) (
+	deepCopyRomanFmtSpec NumStrRomanNumeralFormatSpec,
+	err error) {
+
+	if nStrRomanFmtSpec.lock == nil {
+		nStrRomanFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRomanFmtSpec.lock.Lock()
+
+	defer nStrRomanFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrRomanNumeralFormatSpec."+
+			"CopyOut()",
+		"")
+
+	if err != nil {
+		return deepCopyRomanFmtSpec, err
+	}
+
+	err = new(numStrRomanNumeralFormatSpecAtom).copy(
+		&deepCopyRomanFmtSpec,
+		nStrRomanFmtSpec,
+		ePrefix.XCpy(
+			"deepCopyRomanFmtSpec<-nStrRomanFmtSpec"))
+
+	return deepCopyRomanFmtSpec, err
+}
+
+// Empty
+//
+// Resets all internal member variables for the current
+// instance of NumStrRomanNumeralFormatSpec to their
+// initial or zero values. Afterwards, the current
+// instance is NOP, or Not Operational.
+func (nStrRomanFmtSpec *NumStrRomanNumeralFormatSpec) Empty() {
+
+	if nStrRomanFmtSpec.lock == nil {
+		nStrRomanFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRomanFmtSpec.lock.Lock()
+
+	new(numStrRomanNumeralFormatSpecAtom).empty(
+		nStrRomanFmtSpec)
+
+	nStrRomanFmtSpec.lock.Unlock()
+
+	nStrRomanFmtSpec.lock = nil
+}
+
+// Equal
+//
+// Receives a pointer to another instance of
+// NumStrRomanNumeralFormatSpec and proceeds to compare
+// its internal member variables to those of the current
+// instance. If all member variables are equivalent,
+// this method returns 'true'.
+func (nStrRomanFmtSpec *NumStrRomanNumeralFormatSpec) Equal(
+	incomingRomanFmtSpec *NumStrRomanNumeralFormatSpec) bool {
+
+	if nStrRomanFmtSpec.lock == nil {
+		nStrRomanFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRomanFmtSpec.lock.Lock()
+
+	defer nStrRomanFmtSpec.lock.Unlock()
+
+	return new(numStrRomanNumeralFormatSpecAtom).equal(
+		nStrRomanFmtSpec,
+		incomingRomanFmtSpec)
+}
+
+// IsNOP
+//
+// Stands for 'Is No Operation'. If this method returns
+// 'true', the current instance of
+// NumStrRomanNumeralFormatSpec is not configured for
+// Roman numeral formatting and numeric values will be
+// formatted in base 10.
+func (nStrRomanFmtSpec *NumStrRomanNumeralFormatSpec) IsNOP() bool {
+
+	if nStrRomanFmtSpec.lock == nil {
+		nStrRomanFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRomanFmtSpec.lock.Lock()
+
+	defer nStrRomanFmtSpec.lock.Unlock()
+
+	return nStrRomanFmtSpec.romanNumeralFmtType !=
+		NumStrFmtType.RomanNumeral()
+}
+
+// IsValidInstanceError
+//
+// Performs a diagnostic review of the data values
+// encapsulated in the current
+// NumStrRomanNumeralFormatSpec instance to determine if
+// they are valid.
+//
+// A NOP instance is considered valid.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrRomanFmtSpec *NumStrRomanNumeralFormatSpec) IsValidInstanceError(
+	errorPrefix interface{}) error {
+
+	if nStrRomanFmtSpec.lock == nil {
+		nStrRomanFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRomanFmtSpec.lock.Lock()
+
+	defer nStrRomanFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrRomanNumeralFormatSpec."+
+			"IsValidInstanceError()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrRomanNumeralFormatSpecAtom).testValidity(
+		nStrRomanFmtSpec,
+		ePrefix.XCpy(
+			"nStrRomanFmtSpec"))
+}
+
+// NewRomanNumeralFormat
+//
+// Creates and returns a new instance of
+// NumStrRomanNumeralFormatSpec configured to format
+// positive integer values as Roman numerals.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	useLowerCase				bool
+//
+//		When set to 'true', Roman numerals will be
+//		formatted as lower case characters ("xiv").
+//		Otherwise, Roman numerals are formatted as upper
+//		case characters ("XIV").
+//
+//	useVinculum					bool
+//
+//		When set to 'true', values from 4,000 through
+//		3,999,999 will be formatted using the vinculum,
+//		or overline, form in which the thousands portion
+//		of the value is rendered with a combining
+//		overline (U+0305) following each numeral.
+//
+//		When set to 'false', the maximum value which can
+//		be formatted is 3,999.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	newRomanFmtSpec				NumStrRomanNumeralFormatSpec
+//
+//		If this method completes successfully, a new,
+//		fully populated instance of
+//		NumStrRomanNumeralFormatSpec will be returned.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrRomanFmtSpec *NumStrRomanNumeralFormatSpec) NewRomanNumeralFormat(
+	useLowerCase bool,
+	useVinculum bool,
+	errorPrefix interface{}) (
+	newRomanFmtSpec NumStrRomanNumeralFormatSpec,
+	err error) {
+
+	if nStrRomanFmtSpec.lock == nil {
+		nStrRomanFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRomanFmtSpec.lock.Lock()
+
+	defer nStrRomanFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrRomanNumeralFormatSpec."+
+			"NewRomanNumeralFormat()",
+		"")
+
+	if err != nil {
+		return newRomanFmtSpec, err
+	}
+
+	newRomanFmtSpec.romanNumeralFmtType =
+		NumStrFmtType.RomanNumeral()
+
+	newRomanFmtSpec.useLowerCase = useLowerCase
+
+	newRomanFmtSpec.useVinculum = useVinculum
+
+	err = new(numStrRomanNumeralFormatSpecAtom).testValidity(
+		&newRomanFmtSpec,
+		ePrefix.XCpy(
+			"newRomanFmtSpec"))
+
+	if err != nil {
+		return NumStrRomanNumeralFormatSpec{}, err
+	}
+
+	return newRomanFmtSpec, err
+}
+
+// UsesLowerCase
+//
+// Returns 'true' if Roman numerals will be formatted as
+// lower case characters.
+func (nStrRomanFmtSpec *NumStrRomanNumeralFormatSpec) UsesLowerCase() bool {
+
+	if nStrRomanFmtSpec.lock == nil {
+		nStrRomanFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRomanFmtSpec.lock.Lock()
+
+	defer nStrRomanFmtSpec.lock.Unlock()
+
+	return nStrRomanFmtSpec.useLowerCase
+}
+
+// UsesVinculum
+//
+// Returns 'true' if values greater than 3,999 will be
+// formatted using the vinculum, or overline, form.
+func (nStrRomanFmtSpec *NumStrRomanNumeralFormatSpec) UsesVinculum() bool {
+
+	if nStrRomanFmtSpec.lock == nil {
+		nStrRomanFmtSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRomanFmtSpec.lock.Lock()
+
+	defer nStrRomanFmtSpec.lock.Unlock()
+
+	return nStrRomanFmtSpec.useVinculum
+}
+
+// numStrRomanNumeralFormatSpecAtom - Provides helper
+// methods for type NumStrRomanNumeralFormatSpec.
+type numStrRomanNumeralFormatSpecAtom struct {
+	lock *sync.Mutex
+}
+
+// copy
+//
+// Copies all data from input parameter
+// 'sourceRomanFmtSpec' to input parameter
+// 'destinationRomanFmtSpec'. The source instance is
+// validated before the copy operation is performed.
+func (nStrRomanFmtSpecAtom *numStrRomanNumeralFormatSpecAtom) copy(
+	destinationRomanFmtSpec *NumStrRomanNumeralFormatSpec,
+	sourceRomanFmtSpec *NumStrRomanNumeralFormatSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrRomanFmtSpecAtom.lock == nil {
+		nStrRomanFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrRomanFmtSpecAtom.lock.Lock()
+
+	defer nStrRomanFmtSpecAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrRomanNumeralFormatSpecAtom."+
+			"copy()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if destinationRomanFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'destinationRomanFmtSpec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if sourceRomanFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sourceRomanFmtSpec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	err = new(numStrRomanNumeralFormatSpecAtom).testValidity(
+		sourceRomanFmtSpec,
+		ePrefix.XCpy(
+			"sourceRomanFmtSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	destinationRomanFmtSpec.romanNumeralFmtType =
+		sourceRomanFmtSpec.romanNumeralFmtType
+
+	destinationRomanFmtSpec.useLowerCase =
+		sourceRomanFmtSpec.useLowerCase
+
+	destinationRomanFmtSpec.useVinculum =
+		sourceRomanFmtSpec.useVinculum
+
+	return err
+}
+
+// empty
+//
+// Resets all member variables of input parameter
+// 'romanFmtSpec' to their zero values.
+func (nStrRomanFmtSpecAtom *numStrRomanNumeralFormatSpecAtom) empty(
+	romanFmtSpec *NumStrRomanNumeralFormatSpec) {
+
+	if nStrRomanFmtSpecAtom.lock == nil {
+		nStrRomanFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrRomanFmtSpecAtom.lock.Lock()
+
+	defer nStrRomanFmtSpecAtom.lock.Unlock()
+
+	if romanFmtSpec == nil {
+		return
+	}
+
+	romanFmtSpec.romanNumeralFmtType = NumStrFmtType.None()
+
+	romanFmtSpec.useLowerCase = false
+
+	romanFmtSpec.useVinculum = false
+}
+
+// equal
+//
+// Compares the member variables of two instances of
+// NumStrRomanNumeralFormatSpec and returns 'true' if
+// they are equivalent in all respects.
+func (nStrRomanFmtSpecAtom *numStrRomanNumeralFormatSpecAtom) equal(
+	romanFmtSpec1 *NumStrRomanNumeralFormatSpec,
+	romanFmtSpec2 *NumStrRomanNumeralFormatSpec) bool {
+
+	if nStrRomanFmtSpecAtom.lock == nil {
+		nStrRomanFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrRomanFmtSpecAtom.lock.Lock()
+
+	defer nStrRomanFmtSpecAtom.lock.Unlock()
+
+	if romanFmtSpec1 == nil ||
+		romanFmtSpec2 == nil {
+
+		return false
+	}
+
+	if romanFmtSpec1.romanNumeralFmtType !=
+		romanFmtSpec2.romanNumeralFmtType {
+
+		return false
+	}
+
+	if romanFmtSpec1.useLowerCase !=
+		romanFmtSpec2.useLowerCase {
+
+		return false
+	}
+
+	if romanFmtSpec1.useVinculum !=
+		romanFmtSpec2.useVinculum {
+
+		return false
+	}
+
+	return true
+}
+
+// testValidity
+//
+// Performs a diagnostic review of the member variables
+// contained in an instance of
+// NumStrRomanNumeralFormatSpec. If any member variable
+// is invalid, an error is returned.
+//
+// A NOP instance is considered valid.
+func (nStrRomanFmtSpecAtom *numStrRomanNumeralFormatSpecAtom) testValidity(
+	romanFmtSpec *NumStrRomanNumeralFormatSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrRomanFmtSpecAtom.lock == nil {
+		nStrRomanFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrRomanFmtSpecAtom.lock.Lock()
+
+	defer nStrRomanFmtSpecAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrRomanNumeralFormatSpecAtom."+
+			"testValidity()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if romanFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'romanFmtSpec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if romanFmtSpec.romanNumeralFmtType !=
+		NumStrFmtType.RomanNumeral() &&
+		(romanFmtSpec.useLowerCase ||
+			romanFmtSpec.useVinculum) {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The Roman numeral format type is invalid!\n"+
+			"Roman numeral options are configured, but the format\n"+
+			"type is not NumStrFmtType.RomanNumeral().\n"+
+			"romanNumeralFmtType = '%v'\n",
+			ePrefix.String(),
+			romanFmtSpec.romanNumeralFmtType.String())
+
+		return err
+	}
+
+	return err
+}
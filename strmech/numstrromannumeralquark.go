@@ -0,0 +1,407 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"strings"
+	"sync"
+)
+
+// numStrRomanNumeralQuark
+//
+// Provides helper methods used to convert positive
+// integer values to and from Roman numerals.
+type numStrRomanNumeralQuark struct {
+	lock *sync.Mutex
+}
+
+// formatRomanNumeral
+//
+// Converts a positive integer value to a string of Roman
+// numerals.
+//
+//	Examples:
+//		1994	upper case				"MCMXCIV"
+//		1994	lower case				"mcmxciv"
+//		4000	upper case, vinculum	"I̅V̅"
+//		4001	upper case, vinculum	"I̅V̅I"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	value						int64
+//
+//		The integer value to be converted to Roman
+//		numerals. If 'value' is less than one, an error
+//		will be returned.
+//
+//		If 'useVinculum' is 'false', the maximum value is
+//		3,999. If 'useVinculum' is 'true', the maximum
+//		value is 3,999,999. Values exceeding these limits
+//		will generate an error.
+//
+//	useLowerCase				bool
+//
+//		When set to 'true', the returned Roman numerals
+//		will be formatted as lower case characters.
+//
+//	useVinculum					bool
+//
+//		When set to 'true', values greater than 3,999
+//		will be formatted in vinculum, or overline, form.
+//		The thousands portion of the value is rendered as
+//		Roman numerals with each character followed by a
+//		combining overline (U+0305). The remainder is
+//		rendered as standard Roman numerals.
+//
+//		Values less than 4,000 are always formatted as
+//		standard Roman numerals.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	romanNumeralStr				string
+//
+//		If this method completes successfully, this
+//		parameter will return 'value' formatted as Roman
+//		numerals.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+func (nStrRomanNumQuark *numStrRomanNumeralQuark) formatRomanNumeral(
+	value int64,
+	useLowerCase bool,
+	useVinculum bool,
+	errPrefDto *ePref.ErrPrefixDto) (
+	romanNumeralStr string,
+	err error) {
+
+	if nStrRomanNumQuark.lock == nil {
+		nStrRomanNumQuark.lock = new(sync.Mutex)
+	}
+
+	nStrRomanNumQuark.lock.Lock()
+
+	defer nStrRomanNumQuark.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrRomanNumeralQuark."+
+			"formatRomanNumeral()",
+		"")
+
+	if err != nil {
+		return romanNumeralStr, err
+	}
+
+	var maxValue int64 = 3999
+
+	if useVinculum {
+		maxValue = 3999999
+	}
+
+	if value < 1 ||
+		value > maxValue {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'value' is out of range!\n"+
+			"Roman numerals can only represent integer values\n"+
+			"from 1 through %v.\n"+
+			"useVinculum = '%v'\n"+
+			"value = '%v'\n",
+			ePrefix.String(),
+			maxValue,
+			useVinculum,
+			value)
+
+		return romanNumeralStr, err
+	}
+
+	var sb strings.Builder
+
+	if value > 3999 {
+
+		thousands := nStrRomanNumQuark.getStandardNumerals(
+			value / 1000)
+
+		for _, numeral := range thousands {
+
+			sb.WriteRune(numeral)
+
+			sb.WriteRune('\u0305')
+		}
+
+		value = value % 1000
+	}
+
+	sb.WriteString(
+		nStrRomanNumQuark.getStandardNumerals(value))
+
+	romanNumeralStr = sb.String()
+
+	if useLowerCase {
+		romanNumeralStr = strings.ToLower(romanNumeralStr)
+	}
+
+	return romanNumeralStr, err
+}
+
+// getStandardNumerals
+//
+// Returns the standard upper case Roman numerals for an
+// integer value greater than zero and less than 4,000.
+//
+// If 'value' is less than one or greater than 3,999, an
+// empty string is returned.
+//
+// This method does NOT lock the current instance of
+// numStrRomanNumeralQuark.
+func (nStrRomanNumQuark *numStrRomanNumeralQuark) getStandardNumerals(
+	value int64) string {
+
+	if value < 1 || value > 3999 {
+		return ""
+	}
+
+	numeralValues := []int64{
+		1000, 900, 500, 400,
+		100, 90, 50, 40,
+		10, 9, 5, 4, 1}
+
+	numeralSymbols := []string{
+		"M", "CM", "D", "CD",
+		"C", "XC", "L", "XL",
+		"X", "IX", "V", "IV", "I"}
+
+	var sb strings.Builder
+
+	for i := 0; i < len(numeralValues); i++ {
+
+		for value >= numeralValues[i] {
+
+			sb.WriteString(numeralSymbols[i])
+
+			value -= numeralValues[i]
+		}
+	}
+
+	return sb.String()
+}
+
+// parseRomanNumeral
+//
+// Parses a string of Roman numerals and returns the
+// equivalent integer value.
+//
+// Parsing is strict. Only canonical Roman numerals are
+// accepted. Non-canonical forms such as "IIII", "VX",
+// "IC" or "MMMM" will generate an error.
+//
+// The input string must consist entirely of upper case
+// or entirely of lower case numerals. Mixed case strings
+// such as "Xiv" will generate an error. Leading and
+// trailing white space is NOT permitted.
+//
+// Numerals in vinculum, or overline, form (a numeral
+// followed by a combining overline U+0305) are accepted
+// for values from 4,000 through 3,999,999, provided they
+// are canonical.
+//
+//	Examples:
+//		"MCMXCIV"	= 1994
+//		"mcmxciv"	= 1994
+//		"I̅V̅I"		= 4001
+//		"IIII"		= error
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	romanNumeralStr				string
+//
+//		The string of Roman numerals to be parsed.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	value						int64
+//
+//		If this method completes successfully, this
+//		parameter will return the integer value of the
+//		Roman numerals passed as 'romanNumeralStr'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+func (nStrRomanNumQuark *numStrRomanNumeralQuark) parseRomanNumeral(
+	romanNumeralStr string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	value int64,
+	err error) {
+
+	if nStrRomanNumQuark.lock == nil {
+		nStrRomanNumQuark.lock = new(sync.Mutex)
+	}
+
+	nStrRomanNumQuark.lock.Lock()
+
+	defer nStrRomanNumQuark.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrRomanNumeralQuark."+
+			"parseRomanNumeral()",
+		"")
+
+	if err != nil {
+		return value, err
+	}
+
+	if len(romanNumeralStr) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'romanNumeralStr' is invalid!\n"+
+			"'romanNumeralStr' is an empty string.\n",
+			ePrefix.String())
+
+		return value, err
+	}
+
+	numeralRunes := []rune(strings.ToUpper(romanNumeralStr))
+
+	lenNumeralRunes := len(numeralRunes)
+
+	var numeralValues []int64
+
+	useVinculum := false
+
+	for i := 0; i < lenNumeralRunes; i++ {
+
+		var numeralValue int64
+
+		switch numeralRunes[i] {
+		case 'I':
+			numeralValue = 1
+		case 'V':
+			numeralValue = 5
+		case 'X':
+			numeralValue = 10
+		case 'L':
+			numeralValue = 50
+		case 'C':
+			numeralValue = 100
+		case 'D':
+			numeralValue = 500
+		case 'M':
+			numeralValue = 1000
+		default:
+
+			err = fmt.Errorf("%v\n"+
+				"Error: Input parameter 'romanNumeralStr' is invalid!\n"+
+				"'romanNumeralStr' contains an invalid character.\n"+
+				"romanNumeralStr = '%v'\n"+
+				"Invalid character = '%v' at index %v\n",
+				ePrefix.String(),
+				romanNumeralStr,
+				string(numeralRunes[i]),
+				i)
+
+			return value, err
+		}
+
+		if i+1 < lenNumeralRunes &&
+			numeralRunes[i+1] == '\u0305' {
+
+			numeralValue *= 1000
+
+			useVinculum = true
+
+			i++
+		}
+
+		numeralValues = append(numeralValues, numeralValue)
+	}
+
+	for i := 0; i < len(numeralValues); i++ {
+
+		if i+1 < len(numeralValues) &&
+			numeralValues[i] < numeralValues[i+1] {
+
+			value -= numeralValues[i]
+
+		} else {
+
+			value += numeralValues[i]
+		}
+	}
+
+	// Strict parsing: the canonical form of the computed
+	// value must exactly match the input string.
+	useLowerCase := romanNumeralStr ==
+		strings.ToLower(romanNumeralStr)
+
+	var canonicalStr string
+	var err2 error
+
+	canonicalStr,
+		err2 = new(numStrRomanNumeralQuark).formatRomanNumeral(
+		value,
+		useLowerCase,
+		useVinculum,
+		nil)
+
+	if err2 != nil ||
+		canonicalStr != romanNumeralStr {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'romanNumeralStr' is invalid!\n"+
+			"'romanNumeralStr' is not a canonical Roman numeral.\n"+
+			"Roman numerals must use standard subtractive notation\n"+
+			"and consist entirely of upper or lower case characters.\n"+
+			"romanNumeralStr = '%v'\n",
+			ePrefix.String(),
+			romanNumeralStr)
+
+		return 0, err
+	}
+
+	return value, err
+}
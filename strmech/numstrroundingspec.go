@@ -3,6 +3,8 @@ package strmech
 import (
 	"fmt"
 	ePref "github.com/MikeAustin71/errpref"
+	"math/big"
+	"strings"
 	"sync"
 )
 
@@ -14,6 +16,36 @@ import (
 // contains all the parameters required to
 // configure a rounding algorithm for a
 // floating point number string.
+//
+// The rounding algorithm may be applied to one of
+// three rounding targets:
+//
+//  1. Fractional Digits
+//     The numeric value is rounded to a specified
+//     number of digits to the right of the decimal
+//     separator. This is the default rounding target.
+//
+//     Example: 1.2567 rounded to 2 fractional
+//     digits = 1.26
+//
+//  2. Significant Digits
+//     The numeric value is rounded to a specified
+//     number of significant digits.
+//
+//     Example: 0.0012567 rounded to 3 significant
+//     digits = 0.00126
+//
+//  3. Rounding Increment
+//     The numeric value is rounded to the nearest
+//     multiple of a specified rounding increment
+//     such as 0.05, 0.25 or 100.
+//
+//     Example: 1.23 rounded to an increment of
+//     0.05 = 1.25
+//
+// All rounding algorithms defined by enumeration
+// NumberRoundingType may be applied to all three
+// rounding targets.
 type NumStrRoundingSpec struct {
 	roundingType NumberRoundingType
 	// This enumeration parameter is used to specify the type
@@ -235,6 +267,42 @@ type NumStrRoundingSpec struct {
 	// the right of the decimal separator (a.k.a.
 	// decimal point) which will remain after
 	// completion of the number rounding operation.
+	//
+	// This parameter is ignored if either
+	// 'roundToSignificantDigits' or
+	// 'roundingIncrement' is configured.
+
+	roundToSignificantDigits int
+	// When set to a value greater than zero (0),
+	// this parameter controls the number of
+	// significant digits which will remain after
+	// completion of the number rounding operation.
+	//
+	//	Examples:
+	//		123456   rounded to 2 significant digits = 120000
+	//		0.012345 rounded to 3 significant digits = 0.0123
+	//		2.5      rounded to 4 significant digits = 2.500
+	//
+	// A value of zero (0) signals that rounding to
+	// significant digits is NOT configured.
+
+	roundingIncrement string
+	// When populated, this string contains a pure
+	// number string specifying the rounding
+	// increment. The numeric value will be rounded
+	// to the nearest multiple of this increment.
+	//
+	// The number of fractional digits in the
+	// rounded result is equal to the number of
+	// fractional digits in the rounding increment.
+	//
+	//	Examples:
+	//		"0.05" - Swiss Rappen (5-cent increments)
+	//		"0.25" - Quarter increments
+	//		"100"  - Round to the nearest hundred
+	//
+	// An empty string signals that rounding to an
+	// increment is NOT configured.
 
 	lock *sync.Mutex
 }
@@ -580,6 +648,37 @@ func (nStrRoundingSpec *NumStrRoundingSpec) Equal(
 		incomingNStrRoundingSpec)
 }
 
+// GetRoundingIncrement
+//
+// Returns the value of member variable
+// 'NumStrRoundingSpec.roundingIncrement' for the current
+// instance of NumStrRoundingSpec.
+//
+// When populated, this pure number string specifies the
+// rounding increment. Numeric values will be rounded to
+// the nearest multiple of this increment.
+//
+//	Examples:
+//		"0.05" - Swiss Rappen (5-cent increments)
+//		"0.25" - Quarter increments
+//		"100"  - Round to the nearest hundred
+//
+// An empty string signals that rounding to an increment
+// is NOT configured for the current instance of
+// NumStrRoundingSpec.
+func (nStrRoundingSpec *NumStrRoundingSpec) GetRoundingIncrement() string {
+
+	if nStrRoundingSpec.lock == nil {
+		nStrRoundingSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRoundingSpec.lock.Lock()
+
+	defer nStrRoundingSpec.lock.Unlock()
+
+	return nStrRoundingSpec.roundingIncrement
+}
+
 // GetRoundingType - Returns the value of member variable
 // 'NumStrRoundingSpec.roundingType' for the current instance
 // of NumStrRoundingSpec.
@@ -634,6 +733,33 @@ func (nStrRoundingSpec *NumStrRoundingSpec) GetRoundToFractionalDigits() int {
 	return nStrRoundingSpec.roundToFractionalDigits
 }
 
+// GetRoundToSignificantDigits
+//
+// Returns the value of member variable
+// 'NumStrRoundingSpec.roundToSignificantDigits' for the
+// current instance of NumStrRoundingSpec.
+//
+// When set to a value greater than zero (0), this
+// integer value controls the number of significant
+// digits which will remain after completion of the
+// number rounding operation.
+//
+// A value of zero (0) signals that rounding to
+// significant digits is NOT configured for the current
+// instance of NumStrRoundingSpec.
+func (nStrRoundingSpec *NumStrRoundingSpec) GetRoundToSignificantDigits() int {
+
+	if nStrRoundingSpec.lock == nil {
+		nStrRoundingSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRoundingSpec.lock.Lock()
+
+	defer nStrRoundingSpec.lock.Unlock()
+
+	return nStrRoundingSpec.roundToSignificantDigits
+}
+
 // IsValidInstance
 //
 // Performs a diagnostic review of the data values
@@ -948,115 +1074,162 @@ func (nStrRoundingSpec *NumStrRoundingSpec) NewRoundingSpec(
 	return newNumStrRoundingSpec, err
 }
 
-// SetRoundingSpec - Deletes and overwrites all member variable
-// data values in the current instance of NumStrRoundingSpec.
+// NewRoundingSpecIncrement
 //
-// ----------------------------------------------------------------
+// Creates and returns a new instance of NumStrRoundingSpec
+// configured to round numeric values to the nearest
+// multiple of a rounding increment.
 //
-// # IMPORTANT
+// Rounding to increments is typically used in cash
+// handling. For example, Swiss Franc cash amounts are
+// rounded to increments of 0.05 (5 Rappen).
 //
-// This method will delete and overwrite all pre-existing data
-// values in the current instance of NumStrRoundingSpec.
+//	Examples:
+//		Value   Increment  Rounding Type      Result
+//		 1.23     0.05     HalfAwayFromZero     1.25
+//		 1.225    0.05     HalfToEven           1.20
+//		 7.13     0.25     Ceiling              7.25
+//		-1.21     0.25     Floor               -1.25
+//		 1250     100      HalfToEven           1200
+//		 1250     100      HalfAwayFromZero     1300
 //
 // ----------------------------------------------------------------
 //
-// Input Parameters
+// # Input Parameters
 //
-//	 roundingType               NumberRoundingType
-//	    - This parameter will replace the current value of
-//	      the 'NumStrRoundingSpec.roundingType' member variable
-//	      data value contained in the current instance of
-//	      NumStrRoundingSpec.
+//	roundingType				NumberRoundingType
 //
-//	      NumberRoundingType is an enumeration specifying the
-//	      rounding algorithm to be applied in the fractional digit
-//	      rounding operation. Valid values are listed as follows:
+//		This enumeration parameter specifies the rounding
+//		algorithm which will be applied in the number
+//		rounding operation. Valid values are listed as
+//		follows:
+//
+//			NumRoundType.NoRounding(),
+//			NumRoundType.HalfUpWithNegNums(),
+//			NumRoundType.HalfDownWithNegNums(),
+//			NumRoundType.HalfAwayFromZero(),
+//			NumRoundType.HalfTowardsZero(),
+//			NumRoundType.HalfToEven(),
+//			NumRoundType.HalfToOdd(),
+//			NumRoundType.Randomly(),
+//			NumRoundType.Floor(),
+//			NumRoundType.Ceiling(),
+//			NumRoundType.Truncate(),
+//
+//		All rounding algorithms, including 'Floor' and
+//		'Ceiling', are applied with respect to the
+//		rounding target.
+//
+//	roundingIncrement			string
+//
+//		A pure number string specifying the rounding
+//		increment. The numeric value will be rounded to
+//		the nearest multiple of this increment.
+//
+//		This string may only contain numeric digits and
+//		a single period ('.') as a decimal separator.
+//		Leading and trailing white space is ignored. The
+//		numeric value of the rounding increment must be
+//		greater than zero (0).
+//
+//		The number of fractional digits in the rounded
+//		result will be equal to the number of fractional
+//		digits in 'roundingIncrement'.
+//
+//			Examples:
+//				"0.05" - Swiss Rappen (5-cent increments)
+//				"0.25" - Quarter increments
+//				"100"  - Round to the nearest hundred
+//
+//		If 'roundingIncrement' is invalid, an error will
+//		be returned.
 //
-//	       NumRoundType.None(),
-//	       NumRoundType.HalfUpWithNegNums(),
-//	       NumRoundType.HalfDownWithNegNums(),
-//	       NumRoundType.HalfAwayFromZero(),
-//	       NumRoundType.HalfTowardsZero(),
-//	       NumRoundType.HalfToEven(),
-//	       NumRoundType.HalfToOdd(),
-//	       NumRoundType.Randomly(),
-//	       NumRoundType.Floor(),
-//	       NumRoundType.Ceiling(),
-//	       NumRoundType.Truncate(),
+//	errorPrefix					interface{}
 //
-//	 roundToFractionalDigits    int
-//	    - This parameter will replace the current value of
-//	      the 'NumStrRoundingSpec.roundToFractionalDigits' member
-//	      variable data value contained in the current instance
-//	      of NumStrRoundingSpec.
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//	      When set to a positive integer value, this parameter
-//	      controls the number of digits to the right of the
-//	      decimal separator (a.k.a. decimal point) which will
-//	      remain after completion of the number rounding
-//	      operation.
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//	 errorPrefix                interface{}
-//		   - This object encapsulates error prefix text which is
-//		     included in all returned error messages. Usually, it
-//		     contains the name of the calling method or methods
-//		     listed as a method or function chain of execution.
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//		     If no error prefix information is needed, set this
-//	      parameter to 'nil'.
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//		     This empty interface must be convertible to one of the
-//		     following types:
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//		     1. nil - A nil value is valid and generates an empty
-//		        collection of error prefix and error context
-//		        information.
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//		     2. string - A string containing error prefix information.
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//		     3. []string A one-dimensional slice of strings containing
-//		        error prefix information
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//		     4. [][2]string A two-dimensional slice of strings
-//		        containing error prefix and error context information.
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//		     5. ErrPrefixDto - An instance of ErrPrefixDto. Information
-//		        from this object will be copied for use in error and
-//		        informational messages.
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//		     6. *ErrPrefixDto - A pointer to an instance of ErrPrefixDto.
-//		        Information from this object will be copied for use in
-//		        error and informational messages.
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
 //
-//		     7. IBasicErrorPrefix - An interface to a method generating
-//		        a two-dimensional slice of strings containing error
-//		        prefix and error context information.
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
-//		     If parameter 'errorPrefix' is NOT convertible to one of
-//		     the valid types listed above, it will be considered
-//		     invalid and trigger the return of an error.
+// ----------------------------------------------------------------
 //
-//		     Types ErrPrefixDto and IBasicErrorPrefix are included in
-//		     the 'errpref' software package,
-//		     "github.com/MikeAustin71/errpref".
+// # Return Values
 //
-// ----------------------------------------------------------------
+//	newNumStrRoundingSpec		NumStrRoundingSpec
 //
-// Return Values
+//		If this method completes successfully, this
+//		parameter will return a fully populated instance
+//		of NumStrRoundingSpec.
 //
-//	err                        error
-//	   - If this method completes successfully, this returned error
-//	     Type is set equal to 'nil'. If errors are encountered during
-//	     processing, the returned error Type will encapsulate an error
-//	     message.
+//	err							error
 //
-//	     If an error message is returned, the text value for input
-//	     parameter 'errPrefDto' (error prefix) will be prefixed or
-//	     attached at the beginning of the error message.
-func (nStrRoundingSpec *NumStrRoundingSpec) SetRoundingSpec(
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrRoundingSpec *NumStrRoundingSpec) NewRoundingSpecIncrement(
 	roundingType NumberRoundingType,
-	roundToFractionalDigits int,
+	roundingIncrement string,
 	errorPrefix interface{}) (
+	newNumStrRoundingSpec NumStrRoundingSpec,
 	err error) {
 
 	if nStrRoundingSpec.lock == nil {
@@ -1073,125 +1246,173 @@ func (nStrRoundingSpec *NumStrRoundingSpec) SetRoundingSpec(
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumStrRoundingSpec."+
-			"SetRoundingSpec()",
+			"NewRoundingSpecIncrement()",
 		"")
 
 	if err != nil {
-		return err
+		return newNumStrRoundingSpec, err
 	}
 
 	err = new(numStrRoundingSpecNanobot).
-		setNStrNStrRoundingSpec(
-			nStrRoundingSpec,
+		setNStrRoundingSpecIncrement(
+			&newNumStrRoundingSpec,
 			roundingType,
-			roundToFractionalDigits,
+			roundingIncrement,
 			ePrefix.XCpy(
-				"nStrRoundingSpec<-"))
+				"newNumStrRoundingSpec<-"))
 
-	return err
+	return newNumStrRoundingSpec, err
 }
 
-// SetRoundingType - Deletes and resets the
-// 'NumStrRoundingSpec.roundingType' member variable
-// data value contained in the current instance of
-// NumStrRoundingSpec.
+// NewRoundingSpecSignificantDigits
 //
-// ----------------------------------------------------------------
+// Creates and returns a new instance of NumStrRoundingSpec
+// configured to round numeric values to a specified
+// number of significant digits.
 //
-// # IMPORTANT
+// Rounding to significant digits is typically used when
+// reporting scientific and laboratory measurements.
 //
-// This method will delete and overwrite the pre-existing data
-// value for the 'NumStrRoundingSpec.roundingType' member
-// variable contained in the current instance of
-// NumStrRoundingSpec.
+//	Examples:
+//		Value       Significant  Rounding Type      Result
+//		             Digits
+//		 123456        2         HalfAwayFromZero   120000
+//		 0.0012345     3         HalfToEven         0.00123
+//		 0.0012355     3         HalfToEven         0.00124
+//		-2.5           4         HalfAwayFromZero  -2.500
+//		 9.99          2         HalfAwayFromZero   10
+//		 1.21          2         Ceiling            1.3
 //
 // ----------------------------------------------------------------
 //
-// Input Parameters
+// # Input Parameters
 //
-//	 roundingType               NumberRoundingType
-//	    - This parameter will replace the current value of
-//	      the 'NumStrRoundingSpec.roundingType' member variable
-//	      data value contained in the current instance of
-//	      NumStrRoundingSpec.
+//	roundingType				NumberRoundingType
 //
-//	      NumberRoundingType is an enumeration specifying the
-//	      rounding algorithm to be applied in the fractional digit
-//	      rounding operation. Valid values are listed as follows:
+//		This enumeration parameter specifies the rounding
+//		algorithm which will be applied in the number
+//		rounding operation. Valid values are listed as
+//		follows:
+//
+//			NumRoundType.NoRounding(),
+//			NumRoundType.HalfUpWithNegNums(),
+//			NumRoundType.HalfDownWithNegNums(),
+//			NumRoundType.HalfAwayFromZero(),
+//			NumRoundType.HalfTowardsZero(),
+//			NumRoundType.HalfToEven(),
+//			NumRoundType.HalfToOdd(),
+//			NumRoundType.Randomly(),
+//			NumRoundType.Floor(),
+//			NumRoundType.Ceiling(),
+//			NumRoundType.Truncate(),
+//
+//		All rounding algorithms, including 'Floor' and
+//		'Ceiling', are applied with respect to the
+//		rounding target.
+//
+//	roundToSignificantDigits	int
+//
+//		The number of significant digits which will
+//		remain after completion of the number rounding
+//		operation. Trailing zeros are added where
+//		necessary in order to display the specified
+//		number of significant digits.
 //
-//	       NumRoundType.None(),
-//	       NumRoundType.HalfUpWithNegNums(),
-//	       NumRoundType.HalfDownWithNegNums(),
-//	       NumRoundType.HalfAwayFromZero(),
-//	       NumRoundType.HalfTowardsZero(),
-//	       NumRoundType.HalfToEven(),
-//	       NumRoundType.HalfToOdd(),
-//	       NumRoundType.Randomly(),
-//	       NumRoundType.Floor(),
-//	       NumRoundType.Ceiling(),
-//	       NumRoundType.Truncate(),
+//			Examples:
+//				123456   rounded to 2 significant digits = 120000
+//				0.012345 rounded to 3 significant digits = 0.0123
+//				2.5      rounded to 4 significant digits = 2.500
+//				9.99     rounded to 2 significant digits = 10
 //
+//		If 'roundToSignificantDigits' is less than one
+//		(1), an error will be returned.
 //
-//	 errorPrefix                interface{}
-//		   - This object encapsulates error prefix text which is
-//		     included in all returned error messages. Usually, it
-//		     contains the name of the calling method or methods
-//		     listed as a method or function chain of execution.
+//	errorPrefix					interface{}
 //
-//		     If no error prefix information is needed, set this
-//	      parameter to 'nil'.
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
 //
-//		     This empty interface must be convertible to one of the
-//		     following types:
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
 //
-//		     1. nil - A nil value is valid and generates an empty
-//		        collection of error prefix and error context
-//		        information.
+//		This empty interface must be convertible to one of
+//		the following types:
 //
-//		     2. string - A string containing error prefix information.
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
 //
-//		     3. []string A one-dimensional slice of strings containing
-//		        error prefix information
+//		2.	string
+//				A string containing error prefix
+//				information.
 //
-//		     4. [][2]string A two-dimensional slice of strings
-//		        containing error prefix and error context information.
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
 //
-//		     5. ErrPrefixDto - An instance of ErrPrefixDto. Information
-//		        from this object will be copied for use in error and
-//		        informational messages.
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
 //
-//		     6. *ErrPrefixDto - A pointer to an instance of ErrPrefixDto.
-//		        Information from this object will be copied for use in
-//		        error and informational messages.
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
 //
-//		     7. IBasicErrorPrefix - An interface to a method generating
-//		        a two-dimensional slice of strings containing error
-//		        prefix and error context information.
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
 //
-//		     If parameter 'errorPrefix' is NOT convertible to one of
-//		     the valid types listed above, it will be considered
-//		     invalid and trigger the return of an error.
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
 //
-//		     Types ErrPrefixDto and IBasicErrorPrefix are included in
-//		     the 'errpref' software package,
-//		     "github.com/MikeAustin71/errpref".
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
 //
 // ----------------------------------------------------------------
 //
-// Return Values
+// # Return Values
 //
-//	err                        error
-//	   - If this method completes successfully, this returned error
-//	     Type is set equal to 'nil'. If errors are encountered during
-//	     processing, the returned error Type will encapsulate an error
-//	     message.
+//	newNumStrRoundingSpec		NumStrRoundingSpec
 //
-//	     If an error message is returned, the text value for input
-//	     parameter 'errPrefDto' (error prefix) will be prefixed or
-//	     attached at the beginning of the error message.
-func (nStrRoundingSpec *NumStrRoundingSpec) SetRoundingType(
+//		If this method completes successfully, this
+//		parameter will return a fully populated instance
+//		of NumStrRoundingSpec.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrRoundingSpec *NumStrRoundingSpec) NewRoundingSpecSignificantDigits(
 	roundingType NumberRoundingType,
+	roundToSignificantDigits int,
 	errorPrefix interface{}) (
+	newNumStrRoundingSpec NumStrRoundingSpec,
 	err error) {
 
 	if nStrRoundingSpec.lock == nil {
@@ -1208,49 +1429,222 @@ func (nStrRoundingSpec *NumStrRoundingSpec) SetRoundingType(
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumStrRoundingSpec."+
-			"SetRoundingType()",
+			"NewRoundingSpecSignificantDigits()",
+		"")
+
+	if err != nil {
+		return newNumStrRoundingSpec, err
+	}
+
+	err = new(numStrRoundingSpecNanobot).
+		setNStrRoundingSpecSignificantDigits(
+			&newNumStrRoundingSpec,
+			roundingType,
+			roundToSignificantDigits,
+			ePrefix.XCpy(
+				"newNumStrRoundingSpec<-"))
+
+	return newNumStrRoundingSpec, err
+}
+
+// SetRoundingIncrement
+//
+// Deletes and resets the rounding target for the current
+// instance of NumStrRoundingSpec. Numeric values will be
+// rounded to the nearest multiple of the rounding
+// increment specified by input parameter
+// 'roundingIncrement'.
+//
+// Any previously configured fractional digits or
+// significant digits rounding target will be cleared.
+//
+// The rounding algorithm ('roundingType') configured for
+// the current instance of NumStrRoundingSpec is NOT
+// changed.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	roundingIncrement			string
+//
+//		A pure number string specifying the rounding
+//		increment. The numeric value will be rounded to
+//		the nearest multiple of this increment.
+//
+//		This string may only contain numeric digits and
+//		a single period ('.') as a decimal separator.
+//		Leading and trailing white space is ignored. The
+//		numeric value of the rounding increment must be
+//		greater than zero (0).
+//
+//		The number of fractional digits in the rounded
+//		result will be equal to the number of fractional
+//		digits in 'roundingIncrement'.
+//
+//			Examples:
+//				"0.05" - Swiss Rappen (5-cent increments)
+//				"0.25" - Quarter increments
+//				"100"  - Round to the nearest hundred
+//
+//		If 'roundingIncrement' is invalid, an error will
+//		be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrRoundingSpec *NumStrRoundingSpec) SetRoundingIncrement(
+	roundingIncrement string,
+	errorPrefix interface{}) (
+	err error) {
+
+	if nStrRoundingSpec.lock == nil {
+		nStrRoundingSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRoundingSpec.lock.Lock()
+
+	defer nStrRoundingSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrRoundingSpec."+
+			"SetRoundingIncrement()",
 		"")
 
 	if err != nil {
 		return err
 	}
 
-	err = new(numStrRoundingSpecAtom).
-		setRoundingType(
+	return new(numStrRoundingSpecAtom).
+		setRoundingIncrement(
 			nStrRoundingSpec,
-			roundingType,
+			roundingIncrement,
 			ePrefix.XCpy(
 				"nStrRoundingSpec<-"))
-
-	return err
 }
 
-// SetRoundToFractionalDigits - Deletes and resets the
-// 'NumStrRoundingSpec.roundToFractionalDigits' member
-// variable data value contained in the current instance
-// of NumStrRoundingSpec.
+// SetRoundingSpec - Deletes and overwrites all member variable
+// data values in the current instance of NumStrRoundingSpec.
 //
 // ----------------------------------------------------------------
 //
 // # IMPORTANT
 //
-// This method will delete and overwrite the pre-existing data
-// value for the 'NumStrRoundingSpec.roundToFractionalDigits'
-// member variable contained in the current instance of
-// NumStrRoundingSpec.
+// This method will delete and overwrite all pre-existing data
+// values in the current instance of NumStrRoundingSpec.
 //
 // ----------------------------------------------------------------
 //
 // Input Parameters
 //
+//	 roundingType               NumberRoundingType
+//	    - This parameter will replace the current value of
+//	      the 'NumStrRoundingSpec.roundingType' member variable
+//	      data value contained in the current instance of
+//	      NumStrRoundingSpec.
+//
+//	      NumberRoundingType is an enumeration specifying the
+//	      rounding algorithm to be applied in the fractional digit
+//	      rounding operation. Valid values are listed as follows:
+//
+//	       NumRoundType.None(),
+//	       NumRoundType.HalfUpWithNegNums(),
+//	       NumRoundType.HalfDownWithNegNums(),
+//	       NumRoundType.HalfAwayFromZero(),
+//	       NumRoundType.HalfTowardsZero(),
+//	       NumRoundType.HalfToEven(),
+//	       NumRoundType.HalfToOdd(),
+//	       NumRoundType.Randomly(),
+//	       NumRoundType.Floor(),
+//	       NumRoundType.Ceiling(),
+//	       NumRoundType.Truncate(),
+//
 //	 roundToFractionalDigits    int
 //	    - This parameter will replace the current value of
 //	      the 'NumStrRoundingSpec.roundToFractionalDigits' member
-//	      variable data value contained in the input parameter,
-//	      'nStrRoundingSpec'. When set to a positive integer value,
-//	      this parameter controls the number of digits to the right
-//	      of the decimal separator (a.k.a. decimal point) which
-//	      will remain after completion of the number rounding
+//	      variable data value contained in the current instance
+//	      of NumStrRoundingSpec.
+//
+//	      When set to a positive integer value, this parameter
+//	      controls the number of digits to the right of the
+//	      decimal separator (a.k.a. decimal point) which will
+//	      remain after completion of the number rounding
 //	      operation.
 //
 //	 errorPrefix                interface{}
@@ -1310,7 +1704,8 @@ func (nStrRoundingSpec *NumStrRoundingSpec) SetRoundingType(
 //	     If an error message is returned, the text value for input
 //	     parameter 'errPrefDto' (error prefix) will be prefixed or
 //	     attached at the beginning of the error message.
-func (nStrRoundingSpec *NumStrRoundingSpec) SetRoundToFractionalDigits(
+func (nStrRoundingSpec *NumStrRoundingSpec) SetRoundingSpec(
+	roundingType NumberRoundingType,
 	roundToFractionalDigits int,
 	errorPrefix interface{}) (
 	err error) {
@@ -1329,16 +1724,17 @@ func (nStrRoundingSpec *NumStrRoundingSpec) SetRoundToFractionalDigits(
 		err = ePref.ErrPrefixDto{}.NewIEmpty(
 		errorPrefix,
 		"NumStrRoundingSpec."+
-			"SetRoundToFractionalDigits()",
+			"SetRoundingSpec()",
 		"")
 
 	if err != nil {
 		return err
 	}
 
-	err = new(numStrRoundingSpecAtom).
-		setRoundToFractionalDigits(
+	err = new(numStrRoundingSpecNanobot).
+		setNStrNStrRoundingSpec(
 			nStrRoundingSpec,
+			roundingType,
 			roundToFractionalDigits,
 			ePrefix.XCpy(
 				"nStrRoundingSpec<-"))
@@ -1346,8 +1742,407 @@ func (nStrRoundingSpec *NumStrRoundingSpec) SetRoundToFractionalDigits(
 	return err
 }
 
-// numStrRoundingSpecNanobot - This type provides
-// helper methods for NumStrRoundingSpec
+// SetRoundingType - Deletes and resets the
+// 'NumStrRoundingSpec.roundingType' member variable
+// data value contained in the current instance of
+// NumStrRoundingSpec.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// This method will delete and overwrite the pre-existing data
+// value for the 'NumStrRoundingSpec.roundingType' member
+// variable contained in the current instance of
+// NumStrRoundingSpec.
+//
+// ----------------------------------------------------------------
+//
+// Input Parameters
+//
+//	 roundingType               NumberRoundingType
+//	    - This parameter will replace the current value of
+//	      the 'NumStrRoundingSpec.roundingType' member variable
+//	      data value contained in the current instance of
+//	      NumStrRoundingSpec.
+//
+//	      NumberRoundingType is an enumeration specifying the
+//	      rounding algorithm to be applied in the fractional digit
+//	      rounding operation. Valid values are listed as follows:
+//
+//	       NumRoundType.None(),
+//	       NumRoundType.HalfUpWithNegNums(),
+//	       NumRoundType.HalfDownWithNegNums(),
+//	       NumRoundType.HalfAwayFromZero(),
+//	       NumRoundType.HalfTowardsZero(),
+//	       NumRoundType.HalfToEven(),
+//	       NumRoundType.HalfToOdd(),
+//	       NumRoundType.Randomly(),
+//	       NumRoundType.Floor(),
+//	       NumRoundType.Ceiling(),
+//	       NumRoundType.Truncate(),
+//
+//
+//	 errorPrefix                interface{}
+//		   - This object encapsulates error prefix text which is
+//		     included in all returned error messages. Usually, it
+//		     contains the name of the calling method or methods
+//		     listed as a method or function chain of execution.
+//
+//		     If no error prefix information is needed, set this
+//	      parameter to 'nil'.
+//
+//		     This empty interface must be convertible to one of the
+//		     following types:
+//
+//		     1. nil - A nil value is valid and generates an empty
+//		        collection of error prefix and error context
+//		        information.
+//
+//		     2. string - A string containing error prefix information.
+//
+//		     3. []string A one-dimensional slice of strings containing
+//		        error prefix information
+//
+//		     4. [][2]string A two-dimensional slice of strings
+//		        containing error prefix and error context information.
+//
+//		     5. ErrPrefixDto - An instance of ErrPrefixDto. Information
+//		        from this object will be copied for use in error and
+//		        informational messages.
+//
+//		     6. *ErrPrefixDto - A pointer to an instance of ErrPrefixDto.
+//		        Information from this object will be copied for use in
+//		        error and informational messages.
+//
+//		     7. IBasicErrorPrefix - An interface to a method generating
+//		        a two-dimensional slice of strings containing error
+//		        prefix and error context information.
+//
+//		     If parameter 'errorPrefix' is NOT convertible to one of
+//		     the valid types listed above, it will be considered
+//		     invalid and trigger the return of an error.
+//
+//		     Types ErrPrefixDto and IBasicErrorPrefix are included in
+//		     the 'errpref' software package,
+//		     "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// Return Values
+//
+//	err                        error
+//	   - If this method completes successfully, this returned error
+//	     Type is set equal to 'nil'. If errors are encountered during
+//	     processing, the returned error Type will encapsulate an error
+//	     message.
+//
+//	     If an error message is returned, the text value for input
+//	     parameter 'errPrefDto' (error prefix) will be prefixed or
+//	     attached at the beginning of the error message.
+func (nStrRoundingSpec *NumStrRoundingSpec) SetRoundingType(
+	roundingType NumberRoundingType,
+	errorPrefix interface{}) (
+	err error) {
+
+	if nStrRoundingSpec.lock == nil {
+		nStrRoundingSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRoundingSpec.lock.Lock()
+
+	defer nStrRoundingSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrRoundingSpec."+
+			"SetRoundingType()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	err = new(numStrRoundingSpecAtom).
+		setRoundingType(
+			nStrRoundingSpec,
+			roundingType,
+			ePrefix.XCpy(
+				"nStrRoundingSpec<-"))
+
+	return err
+}
+
+// SetRoundToFractionalDigits - Deletes and resets the
+// 'NumStrRoundingSpec.roundToFractionalDigits' member
+// variable data value contained in the current instance
+// of NumStrRoundingSpec.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// This method will delete and overwrite the pre-existing data
+// value for the 'NumStrRoundingSpec.roundToFractionalDigits'
+// member variable contained in the current instance of
+// NumStrRoundingSpec.
+//
+// ----------------------------------------------------------------
+//
+// Input Parameters
+//
+//	 roundToFractionalDigits    int
+//	    - This parameter will replace the current value of
+//	      the 'NumStrRoundingSpec.roundToFractionalDigits' member
+//	      variable data value contained in the input parameter,
+//	      'nStrRoundingSpec'. When set to a positive integer value,
+//	      this parameter controls the number of digits to the right
+//	      of the decimal separator (a.k.a. decimal point) which
+//	      will remain after completion of the number rounding
+//	      operation.
+//
+//	 errorPrefix                interface{}
+//		   - This object encapsulates error prefix text which is
+//		     included in all returned error messages. Usually, it
+//		     contains the name of the calling method or methods
+//		     listed as a method or function chain of execution.
+//
+//		     If no error prefix information is needed, set this
+//	      parameter to 'nil'.
+//
+//		     This empty interface must be convertible to one of the
+//		     following types:
+//
+//		     1. nil - A nil value is valid and generates an empty
+//		        collection of error prefix and error context
+//		        information.
+//
+//		     2. string - A string containing error prefix information.
+//
+//		     3. []string A one-dimensional slice of strings containing
+//		        error prefix information
+//
+//		     4. [][2]string A two-dimensional slice of strings
+//		        containing error prefix and error context information.
+//
+//		     5. ErrPrefixDto - An instance of ErrPrefixDto. Information
+//		        from this object will be copied for use in error and
+//		        informational messages.
+//
+//		     6. *ErrPrefixDto - A pointer to an instance of ErrPrefixDto.
+//		        Information from this object will be copied for use in
+//		        error and informational messages.
+//
+//		     7. IBasicErrorPrefix - An interface to a method generating
+//		        a two-dimensional slice of strings containing error
+//		        prefix and error context information.
+//
+//		     If parameter 'errorPrefix' is NOT convertible to one of
+//		     the valid types listed above, it will be considered
+//		     invalid and trigger the return of an error.
+//
+//		     Types ErrPrefixDto and IBasicErrorPrefix are included in
+//		     the 'errpref' software package,
+//		     "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// Return Values
+//
+//	err                        error
+//	   - If this method completes successfully, this returned error
+//	     Type is set equal to 'nil'. If errors are encountered during
+//	     processing, the returned error Type will encapsulate an error
+//	     message.
+//
+//	     If an error message is returned, the text value for input
+//	     parameter 'errPrefDto' (error prefix) will be prefixed or
+//	     attached at the beginning of the error message.
+func (nStrRoundingSpec *NumStrRoundingSpec) SetRoundToFractionalDigits(
+	roundToFractionalDigits int,
+	errorPrefix interface{}) (
+	err error) {
+
+	if nStrRoundingSpec.lock == nil {
+		nStrRoundingSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRoundingSpec.lock.Lock()
+
+	defer nStrRoundingSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrRoundingSpec."+
+			"SetRoundToFractionalDigits()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	err = new(numStrRoundingSpecAtom).
+		setRoundToFractionalDigits(
+			nStrRoundingSpec,
+			roundToFractionalDigits,
+			ePrefix.XCpy(
+				"nStrRoundingSpec<-"))
+
+	return err
+}
+
+// SetRoundToSignificantDigits
+//
+// Deletes and resets the rounding target for the current
+// instance of NumStrRoundingSpec. Numeric values will be
+// rounded to the number of significant digits specified
+// by input parameter 'roundToSignificantDigits'.
+//
+// Any previously configured fractional digits or
+// rounding increment target will be cleared.
+//
+// The rounding algorithm ('roundingType') configured for
+// the current instance of NumStrRoundingSpec is NOT
+// changed.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	roundToSignificantDigits	int
+//
+//		The number of significant digits which will
+//		remain after completion of the number rounding
+//		operation. Trailing zeros are added where
+//		necessary in order to display the specified
+//		number of significant digits.
+//
+//			Examples:
+//				123456   rounded to 2 significant digits = 120000
+//				0.012345 rounded to 3 significant digits = 0.0123
+//				2.5      rounded to 4 significant digits = 2.500
+//				9.99     rounded to 2 significant digits = 10
+//
+//		If 'roundToSignificantDigits' is less than one
+//		(1), an error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrRoundingSpec *NumStrRoundingSpec) SetRoundToSignificantDigits(
+	roundToSignificantDigits int,
+	errorPrefix interface{}) (
+	err error) {
+
+	if nStrRoundingSpec.lock == nil {
+		nStrRoundingSpec.lock = new(sync.Mutex)
+	}
+
+	nStrRoundingSpec.lock.Lock()
+
+	defer nStrRoundingSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"NumStrRoundingSpec."+
+			"SetRoundToSignificantDigits()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(numStrRoundingSpecAtom).
+		setRoundToSignificantDigits(
+			nStrRoundingSpec,
+			roundToSignificantDigits,
+			ePrefix.XCpy(
+				"nStrRoundingSpec<-"))
+}
+
+// numStrRoundingSpecNanobot - This type provides
+// helper methods for NumStrRoundingSpec
 type numStrRoundingSpecNanobot struct {
 	lock *sync.Mutex
 }
@@ -1398,28 +2193,300 @@ type numStrRoundingSpecNanobot struct {
 //	     contains the name of the calling method or methods listed
 //	     as a function chain.
 //
-//	     If no error prefix information is needed, set this
-//	     parameter to 'nil'.
+//	     If no error prefix information is needed, set this
+//	     parameter to 'nil'.
+//
+//	     Type ErrPrefixDto is included in the 'errpref' software
+//	     package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// Return Values
+//
+//	err                        error
+//	   - If this method completes successfully, this returned error
+//	     Type is set equal to 'nil'. If errors are encountered during
+//	     processing, the returned error Type will encapsulate an error
+//	     message.
+//
+//	     If an error message is returned, the text value for input
+//	     parameter 'errPrefDto' (error prefix) will be prefixed or
+//	     attached at the beginning of the error message.
+func (nStrRoundingSpecNanobot *numStrRoundingSpecNanobot) copyNStrRoundingSpec(
+	destinationNStrRoundingSpec *NumStrRoundingSpec,
+	sourceNStrRoundingSpec *NumStrRoundingSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrRoundingSpecNanobot.lock == nil {
+		nStrRoundingSpecNanobot.lock = new(sync.Mutex)
+	}
+
+	nStrRoundingSpecNanobot.lock.Lock()
+
+	defer nStrRoundingSpecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrRoundingSpecNanobot."+
+			"copyNStrRoundingSpec()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if destinationNStrRoundingSpec == nil {
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'destinationNStrRoundingSpec' is invalid!\n"+
+			"'destinationNStrRoundingSpec' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if sourceNStrRoundingSpec == nil {
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sourceNStrRoundingSpec' is invalid!\n"+
+			"'sourceNStrRoundingSpec' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	new(numStrRoundingSpecAtom).empty(
+		destinationNStrRoundingSpec)
+
+	destinationNStrRoundingSpec.roundingType =
+		sourceNStrRoundingSpec.roundingType
+
+	destinationNStrRoundingSpec.roundToFractionalDigits =
+		sourceNStrRoundingSpec.roundToFractionalDigits
+
+	destinationNStrRoundingSpec.roundToSignificantDigits =
+		sourceNStrRoundingSpec.roundToSignificantDigits
+
+	destinationNStrRoundingSpec.roundingIncrement =
+		sourceNStrRoundingSpec.roundingIncrement
+
+	return err
+}
+
+// setNStrNStrRoundingSpec - Deletes and resets all member
+// variable data values contained in the instance of
+// NumStrRoundingSpec passed as an input parameter.
+//
+// ----------------------------------------------------------------
+//
+// Input Parameters
+//
+//		nStrRoundingSpec           *NumStrRoundingSpec
+//		    - A pointer to an instance of NumStrRoundingSpec.
+//		      All the member variable data values in this instance
+//		      will be deleted and reset according to the data
+//		      extracted from the following input parameters.
+//
+//	 roundingType               NumberRoundingType
+//	    - This parameter will replace the current value of
+//	      the 'NumStrRoundingSpec.roundingType' member variable
+//	      data value contained in the input parameter,
+//	      'nStrRoundingSpec'.
+//
+//	      NumberRoundingType is an enumeration specifying the
+//	      rounding algorithm to be applied in the fractional digit
+//	      rounding operation. Valid values are listed as follows:
+//
+//	       NumRoundType.None(),
+//	       NumRoundType.HalfUpWithNegNums(),
+//	       NumRoundType.HalfDownWithNegNums(),
+//	       NumRoundType.HalfAwayFromZero(),
+//	       NumRoundType.HalfTowardsZero(),
+//	       NumRoundType.HalfToEven(),
+//	       NumRoundType.HalfToOdd(),
+//	       NumRoundType.Randomly(),
+//	       NumRoundType.Floor(),
+//	       NumRoundType.Ceiling(),
+//	       NumRoundType.Truncate(),
+//
+//	 roundToFractionalDigits    int
+//	    - This parameter will replace the current value of
+//	      the 'NumStrRoundingSpec.roundToFractionalDigits' member
+//	      variable data value contained in the input parameter,
+//	      'nStrRoundingSpec'. When set to a positive integer value,
+//	      this parameter controls the number of digits to the right
+//	      of the decimal separator (a.k.a. decimal point) which
+//	      will remain after completion of the number rounding
+//	      operation.
+//
+//		 errPrefDto                 *ePref.ErrPrefixDto
+//		    - This object encapsulates an error prefix string which is
+//		      included in all returned error messages. Usually, it
+//		      contains the name of the calling method or methods listed
+//		      as a function chain.
+//
+//		      If no error prefix information is needed, set this
+//		      parameter to 'nil'.
+//
+//		      Type ErrPrefixDto is included in the 'errpref' software
+//		      package, "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// Return Values
+//
+//	err                        error
+//	   - If this method completes successfully, this returned error
+//	     Type is set equal to 'nil'. If errors are encountered during
+//	     processing, the returned error Type will encapsulate an error
+//	     message.
+//
+//	     If an error message is returned, the text value for input
+//	     parameter 'errPrefDto' (error prefix) will be prefixed or
+//	     attached at the beginning of the error message.
+func (nStrRoundingSpecNanobot *numStrRoundingSpecNanobot) setNStrNStrRoundingSpec(
+	nStrRoundingSpec *NumStrRoundingSpec,
+	roundingType NumberRoundingType,
+	roundToFractionalDigits int,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrRoundingSpecNanobot.lock == nil {
+		nStrRoundingSpecNanobot.lock = new(sync.Mutex)
+	}
+
+	nStrRoundingSpecNanobot.lock.Lock()
+
+	defer nStrRoundingSpecNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrRoundingSpecNanobot."+
+			"setNStrNStrRoundingSpec()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if nStrRoundingSpec == nil {
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'nStrRoundingSpec' is invalid!\n"+
+			"'nStrRoundingSpec' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	nStrRoundingSpecAtom := numStrRoundingSpecAtom{}
+
+	nStrRoundingSpecAtom.empty(
+		nStrRoundingSpec)
+
+	err = nStrRoundingSpecAtom.setRoundingType(
+		nStrRoundingSpec,
+		roundingType,
+		ePrefix.XCpy(
+			"nStrRoundingSpec<-roundingType"))
+
+	if err != nil {
+		return err
+	}
+
+	err = nStrRoundingSpecAtom.setRoundToFractionalDigits(
+		nStrRoundingSpec,
+		roundToFractionalDigits,
+		ePrefix.XCpy(
+			"nStrRoundingSpec<-"+
+				"roundToFractionalDigits"))
+
+	if err != nil {
+		return err
+	}
+
+	return err
+}
+
+// setNStrRoundingSpecIncrement
+//
+// Deletes and resets all member variable data values for
+// the instance of NumStrRoundingSpec passed as input
+// parameter 'nStrRoundingSpec'. The new rounding
+// specification applies the rounding algorithm specified
+// by 'roundingType' to the rounding increment specified by
+// input parameter 'roundingIncrement'.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the member variable data values contained in
+//	input parameter 'nStrRoundingSpec' will be deleted
+//	and replaced.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	nStrRoundingSpec			*NumStrRoundingSpec
+//
+//		A pointer to an instance of NumStrRoundingSpec.
+//		All the member variable data values in this
+//		instance will be deleted and replaced.
+//
+//	roundingType				NumberRoundingType
+//
+//		This enumeration parameter specifies the rounding
+//		algorithm which will be applied in the number
+//		rounding operation.
+//
+//	roundingIncrement			string
+//
+//		A pure number string specifying the rounding
+//		increment such as "0.05", "0.25" or "100". The
+//		numeric value will be rounded to the nearest
+//		multiple of this increment.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
 //
-//	     Type ErrPrefixDto is included in the 'errpref' software
-//	     package, "github.com/MikeAustin71/errpref".
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
 //
 // ----------------------------------------------------------------
 //
-// Return Values
+// # Return Values
 //
-//	err                        error
-//	   - If this method completes successfully, this returned error
-//	     Type is set equal to 'nil'. If errors are encountered during
-//	     processing, the returned error Type will encapsulate an error
-//	     message.
+//	err							error
 //
-//	     If an error message is returned, the text value for input
-//	     parameter 'errPrefDto' (error prefix) will be prefixed or
-//	     attached at the beginning of the error message.
-func (nStrRoundingSpecNanobot *numStrRoundingSpecNanobot) copyNStrRoundingSpec(
-	destinationNStrRoundingSpec *NumStrRoundingSpec,
-	sourceNStrRoundingSpec *NumStrRoundingSpec,
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrRoundingSpecNanobot *numStrRoundingSpecNanobot) setNStrRoundingSpecIncrement(
+	nStrRoundingSpec *NumStrRoundingSpec,
+	roundingType NumberRoundingType,
+	roundingIncrement string,
 	errPrefDto *ePref.ErrPrefixDto) (
 	err error) {
 
@@ -1437,118 +2504,119 @@ func (nStrRoundingSpecNanobot *numStrRoundingSpecNanobot) copyNStrRoundingSpec(
 		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
 		errPrefDto,
 		"numStrRoundingSpecNanobot."+
-			"copyNStrRoundingSpec()",
+			"setNStrRoundingSpecIncrement()",
 		"")
 
 	if err != nil {
 		return err
 	}
 
-	if destinationNStrRoundingSpec == nil {
+	if nStrRoundingSpec == nil {
 		err = fmt.Errorf("%v\n"+
-			"Error: Input parameter 'destinationNStrRoundingSpec' is invalid!\n"+
-			"'destinationNStrRoundingSpec' is a 'nil' pointer.\n",
+			"Error: Input parameter 'nStrRoundingSpec' is invalid!\n"+
+			"'nStrRoundingSpec' is a 'nil' pointer.\n",
 			ePrefix.String())
 
 		return err
 	}
 
-	if sourceNStrRoundingSpec == nil {
-		err = fmt.Errorf("%v\n"+
-			"Error: Input parameter 'sourceNStrRoundingSpec' is invalid!\n"+
-			"'sourceNStrRoundingSpec' is a 'nil' pointer.\n",
-			ePrefix.String())
-
-		return err
-	}
+	nStrRoundingSpecAtom := numStrRoundingSpecAtom{}
 
-	new(numStrRoundingSpecAtom).empty(
-		destinationNStrRoundingSpec)
+	nStrRoundingSpecAtom.empty(
+		nStrRoundingSpec)
 
-	destinationNStrRoundingSpec.roundingType =
-		sourceNStrRoundingSpec.roundingType
+	err = nStrRoundingSpecAtom.setRoundingType(
+		nStrRoundingSpec,
+		roundingType,
+		ePrefix.XCpy(
+			"nStrRoundingSpec<-roundingType"))
 
-	destinationNStrRoundingSpec.roundToFractionalDigits =
-		sourceNStrRoundingSpec.roundToFractionalDigits
+	if err != nil {
+		return err
+	}
 
-	return err
+	return nStrRoundingSpecAtom.setRoundingIncrement(
+		nStrRoundingSpec,
+		roundingIncrement,
+		ePrefix.XCpy(
+			"nStrRoundingSpec<-roundingIncrement"))
 }
 
-// setNStrNStrRoundingSpec - Deletes and resets all member
-// variable data values contained in the instance of
-// NumStrRoundingSpec passed as an input parameter.
+// setNStrRoundingSpecSignificantDigits
+//
+// Deletes and resets all member variable data values for
+// the instance of NumStrRoundingSpec passed as input
+// parameter 'nStrRoundingSpec'. The new rounding
+// specification applies the rounding algorithm specified
+// by 'roundingType' to the number of significant digits
+// specified by input parameter 'roundToSignificantDigits'.
 //
 // ----------------------------------------------------------------
 //
-// Input Parameters
+// # IMPORTANT
 //
-//		nStrRoundingSpec           *NumStrRoundingSpec
-//		    - A pointer to an instance of NumStrRoundingSpec.
-//		      All the member variable data values in this instance
-//		      will be deleted and reset according to the data
-//		      extracted from the following input parameters.
+//	All the member variable data values contained in
+//	input parameter 'nStrRoundingSpec' will be deleted
+//	and replaced.
 //
-//	 roundingType               NumberRoundingType
-//	    - This parameter will replace the current value of
-//	      the 'NumStrRoundingSpec.roundingType' member variable
-//	      data value contained in the input parameter,
-//	      'nStrRoundingSpec'.
+// ----------------------------------------------------------------
 //
-//	      NumberRoundingType is an enumeration specifying the
-//	      rounding algorithm to be applied in the fractional digit
-//	      rounding operation. Valid values are listed as follows:
+// # Input Parameters
 //
-//	       NumRoundType.None(),
-//	       NumRoundType.HalfUpWithNegNums(),
-//	       NumRoundType.HalfDownWithNegNums(),
-//	       NumRoundType.HalfAwayFromZero(),
-//	       NumRoundType.HalfTowardsZero(),
-//	       NumRoundType.HalfToEven(),
-//	       NumRoundType.HalfToOdd(),
-//	       NumRoundType.Randomly(),
-//	       NumRoundType.Floor(),
-//	       NumRoundType.Ceiling(),
-//	       NumRoundType.Truncate(),
+//	nStrRoundingSpec			*NumStrRoundingSpec
 //
-//	 roundToFractionalDigits    int
-//	    - This parameter will replace the current value of
-//	      the 'NumStrRoundingSpec.roundToFractionalDigits' member
-//	      variable data value contained in the input parameter,
-//	      'nStrRoundingSpec'. When set to a positive integer value,
-//	      this parameter controls the number of digits to the right
-//	      of the decimal separator (a.k.a. decimal point) which
-//	      will remain after completion of the number rounding
-//	      operation.
+//		A pointer to an instance of NumStrRoundingSpec.
+//		All the member variable data values in this
+//		instance will be deleted and replaced.
 //
-//		 errPrefDto                 *ePref.ErrPrefixDto
-//		    - This object encapsulates an error prefix string which is
-//		      included in all returned error messages. Usually, it
-//		      contains the name of the calling method or methods listed
-//		      as a function chain.
+//	roundingType				NumberRoundingType
 //
-//		      If no error prefix information is needed, set this
-//		      parameter to 'nil'.
+//		This enumeration parameter specifies the rounding
+//		algorithm which will be applied in the number
+//		rounding operation.
 //
-//		      Type ErrPrefixDto is included in the 'errpref' software
-//		      package, "github.com/MikeAustin71/errpref".
+//	roundToSignificantDigits	int
+//
+//		The number of significant digits which will
+//		remain after completion of the number rounding
+//		operation. This value must be greater than zero
+//		(0).
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
 //
 // ----------------------------------------------------------------
 //
-// Return Values
+// # Return Values
 //
-//	err                        error
-//	   - If this method completes successfully, this returned error
-//	     Type is set equal to 'nil'. If errors are encountered during
-//	     processing, the returned error Type will encapsulate an error
-//	     message.
+//	err							error
 //
-//	     If an error message is returned, the text value for input
-//	     parameter 'errPrefDto' (error prefix) will be prefixed or
-//	     attached at the beginning of the error message.
-func (nStrRoundingSpecNanobot *numStrRoundingSpecNanobot) setNStrNStrRoundingSpec(
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrRoundingSpecNanobot *numStrRoundingSpecNanobot) setNStrRoundingSpecSignificantDigits(
 	nStrRoundingSpec *NumStrRoundingSpec,
 	roundingType NumberRoundingType,
-	roundToFractionalDigits int,
+	roundToSignificantDigits int,
 	errPrefDto *ePref.ErrPrefixDto) (
 	err error) {
 
@@ -1566,7 +2634,7 @@ func (nStrRoundingSpecNanobot *numStrRoundingSpecNanobot) setNStrNStrRoundingSpe
 		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
 		errPrefDto,
 		"numStrRoundingSpecNanobot."+
-			"setNStrNStrRoundingSpec()",
+			"setNStrRoundingSpecSignificantDigits()",
 		"")
 
 	if err != nil {
@@ -1597,18 +2665,11 @@ func (nStrRoundingSpecNanobot *numStrRoundingSpecNanobot) setNStrNStrRoundingSpe
 		return err
 	}
 
-	err = nStrRoundingSpecAtom.setRoundToFractionalDigits(
+	return nStrRoundingSpecAtom.setRoundToSignificantDigits(
 		nStrRoundingSpec,
-		roundToFractionalDigits,
+		roundToSignificantDigits,
 		ePrefix.XCpy(
-			"nStrRoundingSpec<-"+
-				"roundToFractionalDigits"))
-
-	if err != nil {
-		return err
-	}
-
-	return err
+			"nStrRoundingSpec<-roundToSignificantDigits"))
 }
 
 // numStrRoundingSpecAtom - This type provides
@@ -1661,6 +2722,10 @@ func (nStrRoundingSpecAtom *numStrRoundingSpecAtom) empty(
 	nStrRoundingSpec.roundingType = NumRoundType.None()
 
 	nStrRoundingSpec.roundToFractionalDigits = -1
+
+	nStrRoundingSpec.roundToSignificantDigits = 0
+
+	nStrRoundingSpec.roundingIncrement = ""
 }
 
 // equal - Receives a pointer to two instances of
@@ -1692,20 +2757,130 @@ func (nStrRoundingSpecAtom *numStrRoundingSpecAtom) empty(
 //
 // ----------------------------------------------------------------
 //
-// Return Values
+// Return Values
+//
+//	bool
+//	   - If the comparison of 'nStrRoundingSpec1' and
+//	     'nStrRoundingSpec2' shows that all internal member
+//	     variables are equivalent, this method will return a
+//	     boolean value of 'true'.
+//
+//	     If the two instances are NOT equal, this method will
+//	     return a boolean value of 'false' to the calling
+//	     function.
+func (nStrRoundingSpecAtom *numStrRoundingSpecAtom) equal(
+	nStrRoundingSpec1 *NumStrRoundingSpec,
+	nStrRoundingSpec2 *NumStrRoundingSpec) bool {
+
+	if nStrRoundingSpecAtom.lock == nil {
+		nStrRoundingSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrRoundingSpecAtom.lock.Lock()
+
+	defer nStrRoundingSpecAtom.lock.Unlock()
+
+	if nStrRoundingSpec1 == nil ||
+		nStrRoundingSpec2 == nil {
+		return false
+	}
+
+	if nStrRoundingSpec1.roundingType !=
+		nStrRoundingSpec2.roundingType {
+
+		return false
+	}
+
+	if nStrRoundingSpec1.roundToFractionalDigits !=
+		nStrRoundingSpec2.roundToFractionalDigits {
+
+		return false
+	}
+
+	if nStrRoundingSpec1.roundToSignificantDigits !=
+		nStrRoundingSpec2.roundToSignificantDigits {
+
+		return false
+	}
+
+	if nStrRoundingSpec1.roundingIncrement !=
+		nStrRoundingSpec2.roundingIncrement {
+
+		return false
+	}
+
+	return true
+}
+
+// setRoundingIncrement
+//
+// Deletes and resets the rounding target for the
+// instance of NumStrRoundingSpec passed as input
+// parameter 'nStrRoundingSpec'. The new rounding target
+// is a rounding increment specified by input parameter
+// 'roundingIncrement'.
+//
+// Upon completion, the significant digits rounding
+// target is cleared and member variable
+// 'roundToFractionalDigits' is set to zero (0).
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	nStrRoundingSpec			*NumStrRoundingSpec
+//
+//		A pointer to an instance of NumStrRoundingSpec.
+//		The rounding target configured in this instance
+//		will be deleted and reset to the rounding increment
+//		specified by 'roundingIncrement'.
+//
+//	roundingIncrement			string
+//
+//		A pure number string specifying the rounding
+//		increment such as "0.05", "0.25" or "100". This
+//		string may only contain numeric digits and a
+//		single period ('.') as a decimal separator. The
+//		numeric value must be greater than zero.
+//
+//		Leading and trailing white space will be deleted.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
 //
-//	bool
-//	   - If the comparison of 'nStrRoundingSpec1' and
-//	     'nStrRoundingSpec2' shows that all internal member
-//	     variables are equivalent, this method will return a
-//	     boolean value of 'true'.
+//	err							error
 //
-//	     If the two instances are NOT equal, this method will
-//	     return a boolean value of 'false' to the calling
-//	     function.
-func (nStrRoundingSpecAtom *numStrRoundingSpecAtom) equal(
-	nStrRoundingSpec1 *NumStrRoundingSpec,
-	nStrRoundingSpec2 *NumStrRoundingSpec) bool {
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrRoundingSpecAtom *numStrRoundingSpecAtom) setRoundingIncrement(
+	nStrRoundingSpec *NumStrRoundingSpec,
+	roundingIncrement string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
 
 	if nStrRoundingSpecAtom.lock == nil {
 		nStrRoundingSpecAtom.lock = new(sync.Mutex)
@@ -1715,24 +2890,48 @@ func (nStrRoundingSpecAtom *numStrRoundingSpecAtom) equal(
 
 	defer nStrRoundingSpecAtom.lock.Unlock()
 
-	if nStrRoundingSpec1 == nil ||
-		nStrRoundingSpec2 == nil {
-		return false
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrRoundingSpecAtom."+
+			"setRoundingIncrement()",
+		"")
+
+	if err != nil {
+		return err
 	}
 
-	if nStrRoundingSpec1.roundingType !=
-		nStrRoundingSpec2.roundingType {
+	if nStrRoundingSpec == nil {
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'nStrRoundingSpec' is invalid!\n"+
+			"'nStrRoundingSpec' is a 'nil' pointer.\n",
+			ePrefix.String())
 
-		return false
+		return err
 	}
 
-	if nStrRoundingSpec1.roundToFractionalDigits !=
-		nStrRoundingSpec2.roundToFractionalDigits {
+	_,
+		_,
+		err = new(numStrRoundingSpecElectron).
+		parseRoundingIncrement(
+			roundingIncrement,
+			ePrefix.XCpy(
+				"roundingIncrement"))
 
-		return false
+	if err != nil {
+		return err
 	}
 
-	return true
+	nStrRoundingSpec.roundingIncrement =
+		strings.TrimSpace(roundingIncrement)
+
+	nStrRoundingSpec.roundToSignificantDigits = 0
+
+	nStrRoundingSpec.roundToFractionalDigits = 0
+
+	return err
 }
 
 // setRoundingType - Deletes and resets the
@@ -1857,6 +3056,10 @@ func (nStrRoundingSpecAtom *numStrRoundingSpecAtom) setRoundingType(
 // variable data value contained in the instance of
 // NumStrRoundingSpec passed as an input parameter.
 //
+// The significant digits and rounding increment targets
+// are cleared. As a result, fractional digits become the
+// active rounding target.
+//
 // ----------------------------------------------------------------
 //
 // Input Parameters
@@ -1955,6 +3158,130 @@ func (nStrRoundingSpecAtom *numStrRoundingSpecAtom) setRoundToFractionalDigits(
 
 	nStrRoundingSpec.roundToFractionalDigits = roundToFractionalDigits
 
+	nStrRoundingSpec.roundToSignificantDigits = 0
+
+	nStrRoundingSpec.roundingIncrement = ""
+
+	return err
+}
+
+// setRoundToSignificantDigits
+//
+// Deletes and resets the rounding target for the
+// instance of NumStrRoundingSpec passed as input
+// parameter 'nStrRoundingSpec'. The new rounding target
+// is the number of significant digits specified by
+// input parameter 'roundToSignificantDigits'.
+//
+// Upon completion, the rounding increment target is
+// cleared and member variable 'roundToFractionalDigits'
+// is set to zero (0).
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	nStrRoundingSpec			*NumStrRoundingSpec
+//
+//		A pointer to an instance of NumStrRoundingSpec.
+//		The rounding target configured in this instance
+//		will be deleted and reset to the number of
+//		significant digits specified by
+//		'roundToSignificantDigits'.
+//
+//	roundToSignificantDigits	int
+//
+//		The number of significant digits which will
+//		remain after completion of the number rounding
+//		operation. This value must be greater than zero
+//		(0).
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrRoundingSpecAtom *numStrRoundingSpecAtom) setRoundToSignificantDigits(
+	nStrRoundingSpec *NumStrRoundingSpec,
+	roundToSignificantDigits int,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if nStrRoundingSpecAtom.lock == nil {
+		nStrRoundingSpecAtom.lock = new(sync.Mutex)
+	}
+
+	nStrRoundingSpecAtom.lock.Lock()
+
+	defer nStrRoundingSpecAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrRoundingSpecAtom."+
+			"setRoundToSignificantDigits()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if nStrRoundingSpec == nil {
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'nStrRoundingSpec' is invalid!\n"+
+			"'nStrRoundingSpec' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if roundToSignificantDigits < 1 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'roundToSignificantDigits' is invalid!\n"+
+			"'roundToSignificantDigits' has a value less than one (1).\n"+
+			"'roundToSignificantDigits' = '%v'.\n",
+			ePrefix.String(),
+			roundToSignificantDigits)
+
+		return err
+	}
+
+	nStrRoundingSpec.roundToSignificantDigits =
+		roundToSignificantDigits
+
+	nStrRoundingSpec.roundingIncrement = ""
+
+	nStrRoundingSpec.roundToFractionalDigits = 0
+
 	return err
 }
 
@@ -1964,6 +3291,179 @@ type numStrRoundingSpecElectron struct {
 	lock *sync.Mutex
 }
 
+//	parseRoundingIncrement
+//
+//	Receives a pure number string specifying a rounding
+//	increment and returns the increment as an unsigned
+//	integer value ('incrementDigits') and the number of
+//	fractional digits ('incrementScale'):
+//
+//		rounding increment =
+//			incrementDigits / 10^incrementScale
+//
+//	A valid rounding increment consists exclusively of
+//	numeric digits ('0' through '9') and an optional
+//	period ('.') used as a decimal separator. Leading
+//	and trailing white space is ignored. Number signs
+//	and exponents are NOT permitted. The numeric value
+//	of the rounding increment must be greater than zero.
+//
+//		Examples:
+//			"0.05"	-> incrementDigits=5,   incrementScale=2
+//			"0.25"	-> incrementDigits=25,  incrementScale=2
+//			"100"	-> incrementDigits=100, incrementScale=0
+//			"0.050"	-> incrementDigits=50,  incrementScale=3
+//			"-0.05"	-> Error
+//			"0.00"	-> Error
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	roundingIncrement			string
+//
+//		A pure number string specifying the rounding
+//		increment. If this string is invalid, an error
+//		will be returned.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error
+//		messages. Usually, it contains the name of the
+//		calling method or methods listed as a function
+//		chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	incrementDigits				*big.Int
+//
+//		The rounding increment expressed as an unsigned
+//		integer value scaled by 10^incrementScale.
+//
+//	incrementScale				int
+//
+//		The number of fractional digits contained in the
+//		rounding increment.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (nStrRoundingSpecElectron *numStrRoundingSpecElectron) parseRoundingIncrement(
+	roundingIncrement string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	incrementDigits *big.Int,
+	incrementScale int,
+	err error) {
+
+	if nStrRoundingSpecElectron.lock == nil {
+		nStrRoundingSpecElectron.lock = new(sync.Mutex)
+	}
+
+	nStrRoundingSpecElectron.lock.Lock()
+
+	defer nStrRoundingSpecElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"numStrRoundingSpecElectron."+
+			"parseRoundingIncrement()",
+		"")
+
+	if err != nil {
+		return incrementDigits, incrementScale, err
+	}
+
+	incrementRunes := []rune(strings.TrimSpace(roundingIncrement))
+
+	lenIncrementRunes := len(incrementRunes)
+
+	var digits []rune
+
+	foundDecimalSeparator := false
+
+	for i := 0; i < lenIncrementRunes; i++ {
+
+		if incrementRunes[i] >= '0' &&
+			incrementRunes[i] <= '9' {
+
+			digits = append(digits, incrementRunes[i])
+
+			if foundDecimalSeparator {
+				incrementScale++
+			}
+
+			continue
+		}
+
+		if incrementRunes[i] == '.' &&
+			!foundDecimalSeparator {
+
+			foundDecimalSeparator = true
+
+			continue
+		}
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'roundingIncrement' is invalid!\n"+
+			"'roundingIncrement' contains an invalid character.\n"+
+			"Only numeric digits and a single decimal separator\n"+
+			"('.') are permitted.\n"+
+			"roundingIncrement = '%v'\n"+
+			"Invalid Character Index = '%v'\n",
+			ePrefix.String(),
+			roundingIncrement,
+			i)
+
+		return incrementDigits, incrementScale, err
+	}
+
+	incrementDigits = big.NewInt(0)
+
+	if len(digits) > 0 {
+
+		incrementDigits.SetString(
+			string(digits),
+			10)
+	}
+
+	if incrementDigits.Sign() == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'roundingIncrement' is invalid!\n"+
+			"'roundingIncrement' is empty or has a value of zero.\n"+
+			"The rounding increment must be greater than zero.\n"+
+			"roundingIncrement = '%v'\n",
+			ePrefix.String(),
+			roundingIncrement)
+
+		return incrementDigits, incrementScale, err
+	}
+
+	return incrementDigits, incrementScale, err
+}
+
 //	testValidityOfNumStrRoundingSpec
 //
 //	Receives a pointer to an instance of
@@ -2093,6 +3593,49 @@ func (nStrRoundingSpecElectron *numStrRoundingSpecElectron) testValidityOfNumStr
 		return isValid, err
 	}
 
+	if nStrRoundingSpec.roundToSignificantDigits < 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Member variable 'NumStrRoundingSpec.roundToSignificantDigits' is invalid!\n"+
+			"'roundToSignificantDigits' has a value less than zero (0).\n"+
+			"'roundToSignificantDigits' = '%v'.\n",
+			ePrefix.String(),
+			nStrRoundingSpec.roundToSignificantDigits)
+
+		return isValid, err
+	}
+
+	if len(nStrRoundingSpec.roundingIncrement) > 0 {
+
+		if nStrRoundingSpec.roundToSignificantDigits > 0 {
+
+			err = fmt.Errorf("%v\n"+
+				"Error: NumStrRoundingSpec is invalid!\n"+
+				"Both 'roundToSignificantDigits' and 'roundingIncrement'\n"+
+				"are configured. Only one of these rounding targets\n"+
+				"may be specified.\n"+
+				"'roundToSignificantDigits' = '%v'.\n"+
+				"'roundingIncrement'        = '%v'.\n",
+				ePrefix.String(),
+				nStrRoundingSpec.roundToSignificantDigits,
+				nStrRoundingSpec.roundingIncrement)
+
+			return isValid, err
+		}
+
+		_,
+			_,
+			err = new(numStrRoundingSpecElectron).
+			parseRoundingIncrement(
+				nStrRoundingSpec.roundingIncrement,
+				ePrefix.XCpy(
+					"nStrRoundingSpec.roundingIncrement"))
+
+		if err != nil {
+			return isValid, err
+		}
+	}
+
 	if !nStrRoundingSpec.roundingType.XIsValid() {
 
 		err = fmt.Errorf("%v\n"+
@@ -2213,6 +2213,13 @@ func (charsArrayDto *RuneArrayDto) GetRuneArray() []rune {
 // If all rune array member elements do NOT consist
 // of numeric character digits in the range '0' through
 // '9' inclusive, this method returns 'false'.
+//
+// Native digits from the digit systems defined by
+// enumeration NumberDigitSystem, such as Arabic-Indic
+// ('٠' through '٩') or Devanagari ('०' through '९'),
+// are also recognized as numeric character digits,
+// provided that all digits in the rune array belong to
+// the same digit system.
 func (charsArrayDto *RuneArrayDto) IsAllNumericDigits() bool {
 
 	if charsArrayDto.lock == nil {
@@ -211,6 +211,14 @@ func (runeDtoQuark *runeArrayDtoQuark) countTotalTrailingZeros(
 //	array contains all numeric character digits in range
 //	of '0' through '9', inclusive.
 //
+//	Native digits belonging to one of the digit systems
+//	defined by enumeration NumberDigitSystem (Arabic-Indic,
+//	Extended Arabic-Indic, Devanagari, Thai or Full-Width)
+//	are also classified as numeric character digits.
+//	However, all digits in the array must belong to the
+//	same digit system. An array mixing digits from two or
+//	more digit systems will return 'false'.
+//
 //	The name of the member variable rune array is:
 //
 //			RuneArrayDto.CharsArray
@@ -225,7 +233,9 @@ func (runeDtoQuark *runeArrayDtoQuark) countTotalTrailingZeros(
 //		rune array. The contents of this rune array will
 //		be examined to determine if the member elements
 //		consist exclusively of numeric character digits
-//		in the range '0' through '9', inclusive.
+//		in the range '0' through '9', inclusive, or of
+//		native digits from a single digit system defined
+//		by enumeration NumberDigitSystem.
 //
 //		If the rune array is 'nil', or has a length of
 //		zero, this method will return 'false' and no
@@ -240,7 +250,9 @@ func (runeDtoQuark *runeArrayDtoQuark) countTotalTrailingZeros(
 //		If the rune array contained within input
 //		paramter, 'runeArrayDto' contains all numeric
 //		characters in the range '0' through '9',
-//		inclusive, this	method will return 'true'.
+//		inclusive, or all native digits from a single
+//		NumberDigitSystem, this method will return
+//		'true'.
 //
 //		Otherwise, this return parameter is set to
 //		'false'.
@@ -271,10 +283,40 @@ func (runeDtoQuark *runeArrayDtoQuark) isRuneArrayAllNumericDigits(
 		return isAllNumericDigits
 	}
 
+	digitSysQuark := numStrDigitSystemQuark{}
+
+	var asciiDigit, zeroDigit, firstZeroDigit rune
+	var isNativeDigit bool
+
 	for i := 0; i < lenTargetAry; i++ {
-		if runeArrayDto.CharsArray[i] < '0' ||
-			runeArrayDto.CharsArray[i] > '9' {
 
+		if runeArrayDto.CharsArray[i] >= '0' &&
+			runeArrayDto.CharsArray[i] <= '9' {
+
+			zeroDigit = '0'
+
+		} else {
+
+			asciiDigit,
+				isNativeDigit = digitSysQuark.nativeDigitToAscii(
+				runeArrayDto.CharsArray[i])
+
+			if !isNativeDigit {
+
+				return isAllNumericDigits
+			}
+
+			zeroDigit =
+				runeArrayDto.CharsArray[i] - (asciiDigit - '0')
+		}
+
+		if i == 0 {
+
+			firstZeroDigit = zeroDigit
+
+		} else if zeroDigit != firstZeroDigit {
+
+			// Mixed digit systems
 			return isAllNumericDigits
 		}
 	}
@@ -0,0 +1,1435 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
+
+// SciNotationFormatSpec
+//
+// Scientific Notation Format Specification. This type
+// contains the parameters required to format numeric
+// values as Scientific Notation number strings.
+//
+// When configured as a member of NumStrFormatSpec, this
+// specification directs NumberStrKernel.FmtNumStr() to
+// render the numeric value of a NumberStrKernel in
+// Scientific Notation.
+//
+// The Scientific Notation Format Specification controls:
+//
+//  1. The calculation type (Standard or Engineering)
+//     used to compute the significand and exponent.
+//
+//  2. The rounding type and the number of fractional
+//     digits applied to the significand, together with
+//     the minimum number of fractional digits retained
+//     when trailing zeros are deleted.
+//
+//  3. The exponent display format taken from the
+//     ScientificNotationFormat enumeration.
+//
+//  4. The minimum number of exponent digits. Exponents
+//     with fewer digits are padded with leading zeros.
+//
+//  5. Superscript exponent output for the Exponential
+//     display format.
+//
+// Examples:
+//
+//	Value: 265,200,000
+//
+//	Standard, 3 fractional digits,
+//	ENotUprCaseELeadPlus = "2.652E+8"
+//
+//	Standard, 3 fractional digits,
+//	ENotUprCaseELeadPlus,
+//	minimum exponent digits 2 = "2.652E+08"
+//
+//	Standard, 3 fractional digits,
+//	Exponential = "2.652 x 10^8"
+//
+//	Standard, 3 fractional digits,
+//	Exponential, superscript = "2.652×10⁸"
+//
+//	Engineering, 1 fractional digit,
+//	ENotLwrCaseENoLeadPlus = "265.2e6"
+//
+// An empty or zero value instance of
+// SciNotationFormatSpec is treated as a NOP, or 'No
+// Operation', specification. In this case numeric values
+// are NOT formatted in Scientific Notation.
+//
+// ----------------------------------------------------------------
+//
+// # Reference:
+//
+//	https://en.wikipedia.org/wiki/Scientific_notation
+//	https://en.wikipedia.org/wiki/Engineering_notation
+type SciNotationFormatSpec struct {
+	sciNotFmt ScientificNotationFormat
+	//	Specifies the display format of the Scientific
+	//	Notation number string. Valid values are:
+	//
+	//		SciNotFmt.Exponential()
+	//			"2.652 x 10^8"
+	//
+	//		SciNotFmt.ENotUprCaseELeadPlus()
+	//			"2.652E+8"
+	//
+	//		SciNotFmt.ENotUprCaseENoLeadPlus()
+	//			"2.652E8"
+	//
+	//		SciNotFmt.ENotLwrCaseELeadPlus()
+	//			"2.652e+8"
+	//
+	//		SciNotFmt.ENotLwrCaseENoLeadPlus()
+	//			"2.652e8"
+	//
+	//	A value of SciNotFmt.None() signals that this
+	//	specification is NOP, or Not Operational.
+
+	sciNotCalcType ScientificNotationCalcType
+	//	Specifies the calculation used to compute the
+	//	significand and exponent.
+	//
+	//		Standard
+	//			The significand has a single integer
+	//			digit: 2.652 x 10^8
+	//
+	//		Engineering
+	//			The exponent is a multiple of three and
+	//			the significand has one to three integer
+	//			digits: 265.2 x 10^6
+
+	significandRoundingType NumberRoundingType
+	//	The rounding algorithm applied to the fractional
+	//	digits of the significand. If this value is set
+	//	to NumRoundType.NoRounding(), all significant
+	//	digits are retained and
+	//	'significandFracDigits' is ignored.
+
+	significandFracDigits int
+	//	The number of fractional digits to the right of
+	//	the decimal separator in the formatted
+	//	significand.
+
+	significandMinFracDigits int
+	//	The minimum number of fractional digits in the
+	//	formatted significand. Trailing zeros in excess
+	//	of this minimum are deleted from the significand
+	//	after rounding to 'significandFracDigits'.
+	//
+	//		significandFracDigits = 3
+	//		significandMinFracDigits = 0
+	//			1.200E3 => 1.2E3
+	//
+	//	NewSciNotationFormat() sets this value equal to
+	//	'significandFracDigits'.
+
+	minExponentDigits int
+	//	The minimum number of digits in the formatted
+	//	exponent. Exponents with fewer digits are padded
+	//	with leading zeros. A value of zero or one
+	//	signals that no padding will be applied.
+	//
+	//		minExponentDigits = 2   "2.652E+08"
+
+	useSuperscriptExponent bool
+	//	When set to 'true', the Exponential display
+	//	format renders the exponent in superscript
+	//	characters:
+	//
+	//		"2.652×10⁸"
+	//		"2.652×10⁻⁴"
+	//
+	//	This option is only valid for the Exponential
+	//	display format.
+
+	lock *sync.Mutex
+}
+
+// CopyIn
+//
+// Copies the data fields from an incoming instance of
+// SciNotationFormatSpec ('incomingSciNotFmtSpec') to the
+// data fields of the current SciNotationFormatSpec
+// instance.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+//	All the member variable data values in the current
+//	SciNotationFormatSpec instance will be deleted and
+//	replaced.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	incomingSciNotFmtSpec		*SciNotationFormatSpec
+//
+//		A pointer to an instance of SciNotationFormatSpec.
+//		This method will NOT change the values of internal
+//		member variables contained in this instance.
+//
+//		If this instance is invalid, an error will be
+//		returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (sciNotFmtSpec *SciNotationFormatSpec) CopyIn(
+	incomingSciNotFmtSpec *SciNotationFormatSpec,
+	errorPrefix interface{}) error {
+
+	if sciNotFmtSpec.lock == nil {
+		sciNotFmtSpec.lock = new(sync.Mutex)
+	}
+
+	sciNotFmtSpec.lock.Lock()
+
+	defer sciNotFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"SciNotationFormatSpec."+
+			"CopyIn()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(sciNotationFormatSpecAtom).copy(
+		sciNotFmtSpec,
+		incomingSciNotFmtSpec,
+		ePrefix.XCpy(
+			"sciNotFmtSpec<-incomingSciNotFmtSpec"))
+}
+
+// CopyOut
+//
+// Returns a deep copy of the current
+// SciNotationFormatSpec instance.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	deepCopySciNotFmtSpec		SciNotationFormatSpec
+//
+//		If this method completes successfully, a deep
+//		copy of the current SciNotationFormatSpec
+//		instance will be returned.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (sciNotFmtSpec *SciNotationFormatSpec) CopyOut(
+	errorPrefix interface{}) (
+	deepCopySciNotFmtSpec SciNotationFormatSpec,
+	err error) {
+
+	if sciNotFmtSpec.lock == nil {
+		sciNotFmtSpec.lock = new(sync.Mutex)
+	}
+
+	sciNotFmtSpec.lock.Lock()
+
+	defer sciNotFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"SciNotationFormatSpec."+
+			"CopyOut()",
+		"")
+
+	if err != nil {
+		return deepCopySciNotFmtSpec, err
+	}
+
+	err = new(sciNotationFormatSpecAtom).copy(
+		&deepCopySciNotFmtSpec,
+		sciNotFmtSpec,
+		ePrefix.XCpy(
+			"deepCopySciNotFmtSpec<-sciNotFmtSpec"))
+
+	return deepCopySciNotFmtSpec, err
+}
+
+// Empty
+//
+// Resets all internal member variables for the current
+// instance of SciNotationFormatSpec to their initial or
+// zero values. Afterwards, the current instance is NOP,
+// or Not Operational.
+func (sciNotFmtSpec *SciNotationFormatSpec) Empty() {
+
+	if sciNotFmtSpec.lock == nil {
+		sciNotFmtSpec.lock = new(sync.Mutex)
+	}
+
+	sciNotFmtSpec.lock.Lock()
+
+	new(sciNotationFormatSpecAtom).empty(
+		sciNotFmtSpec)
+
+	sciNotFmtSpec.lock.Unlock()
+
+	sciNotFmtSpec.lock = nil
+}
+
+// Equal
+//
+// Receives a pointer to another instance of
+// SciNotationFormatSpec and proceeds to compare its
+// internal member variables to those of the current
+// instance. If all member variables are equivalent,
+// this method returns 'true'.
+func (sciNotFmtSpec *SciNotationFormatSpec) Equal(
+	incomingSciNotFmtSpec *SciNotationFormatSpec) bool {
+
+	if sciNotFmtSpec.lock == nil {
+		sciNotFmtSpec.lock = new(sync.Mutex)
+	}
+
+	sciNotFmtSpec.lock.Lock()
+
+	defer sciNotFmtSpec.lock.Unlock()
+
+	return new(sciNotationFormatSpecAtom).equal(
+		sciNotFmtSpec,
+		incomingSciNotFmtSpec)
+}
+
+// GetMinExponentDigits
+//
+// Returns the minimum number of digits in the formatted
+// exponent. Exponents with fewer digits are padded with
+// leading zeros.
+func (sciNotFmtSpec *SciNotationFormatSpec) GetMinExponentDigits() int {
+
+	if sciNotFmtSpec.lock == nil {
+		sciNotFmtSpec.lock = new(sync.Mutex)
+	}
+
+	sciNotFmtSpec.lock.Lock()
+
+	defer sciNotFmtSpec.lock.Unlock()
+
+	return sciNotFmtSpec.minExponentDigits
+}
+
+// GetSciNotationCalcType
+//
+// Returns the Scientific Notation Calculation Type
+// (Standard or Engineering) used to compute the
+// significand and exponent.
+func (sciNotFmtSpec *SciNotationFormatSpec) GetSciNotationCalcType() ScientificNotationCalcType {
+
+	if sciNotFmtSpec.lock == nil {
+		sciNotFmtSpec.lock = new(sync.Mutex)
+	}
+
+	sciNotFmtSpec.lock.Lock()
+
+	defer sciNotFmtSpec.lock.Unlock()
+
+	return sciNotFmtSpec.sciNotCalcType
+}
+
+// GetSciNotationFormat
+//
+// Returns the Scientific Notation display format
+// configured for the current instance of
+// SciNotationFormatSpec.
+func (sciNotFmtSpec *SciNotationFormatSpec) GetSciNotationFormat() ScientificNotationFormat {
+
+	if sciNotFmtSpec.lock == nil {
+		sciNotFmtSpec.lock = new(sync.Mutex)
+	}
+
+	sciNotFmtSpec.lock.Lock()
+
+	defer sciNotFmtSpec.lock.Unlock()
+
+	return sciNotFmtSpec.sciNotFmt
+}
+
+// GetSignificandFracDigits
+//
+// Returns the number of fractional digits to the right
+// of the decimal separator in the formatted significand.
+func (sciNotFmtSpec *SciNotationFormatSpec) GetSignificandFracDigits() int {
+
+	if sciNotFmtSpec.lock == nil {
+		sciNotFmtSpec.lock = new(sync.Mutex)
+	}
+
+	sciNotFmtSpec.lock.Lock()
+
+	defer sciNotFmtSpec.lock.Unlock()
+
+	return sciNotFmtSpec.significandFracDigits
+}
+
+// GetSignificandMinFracDigits
+//
+// Returns the minimum number of fractional digits in the
+// formatted significand. Trailing zeros in excess of this
+// minimum are deleted from the significand.
+func (sciNotFmtSpec *SciNotationFormatSpec) GetSignificandMinFracDigits() int {
+
+	if sciNotFmtSpec.lock == nil {
+		sciNotFmtSpec.lock = new(sync.Mutex)
+	}
+
+	sciNotFmtSpec.lock.Lock()
+
+	defer sciNotFmtSpec.lock.Unlock()
+
+	return sciNotFmtSpec.significandMinFracDigits
+}
+
+// GetSignificandRoundingType
+//
+// Returns the rounding algorithm applied to the
+// fractional digits of the significand.
+func (sciNotFmtSpec *SciNotationFormatSpec) GetSignificandRoundingType() NumberRoundingType {
+
+	if sciNotFmtSpec.lock == nil {
+		sciNotFmtSpec.lock = new(sync.Mutex)
+	}
+
+	sciNotFmtSpec.lock.Lock()
+
+	defer sciNotFmtSpec.lock.Unlock()
+
+	return sciNotFmtSpec.significandRoundingType
+}
+
+// IsNOP
+//
+// Stands for 'Is No Operation'. If this method returns
+// 'true', the current instance of SciNotationFormatSpec
+// is not configured for Scientific Notation formatting.
+func (sciNotFmtSpec *SciNotationFormatSpec) IsNOP() bool {
+
+	if sciNotFmtSpec.lock == nil {
+		sciNotFmtSpec.lock = new(sync.Mutex)
+	}
+
+	sciNotFmtSpec.lock.Lock()
+
+	defer sciNotFmtSpec.lock.Unlock()
+
+	return !sciNotFmtSpec.sciNotFmt.XIsValid()
+}
+
+// IsValidInstanceError
+//
+// Performs a diagnostic review of the data values
+// encapsulated in the current SciNotationFormatSpec
+// instance to determine if they are valid.
+//
+// A NOP instance is considered valid.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (sciNotFmtSpec *SciNotationFormatSpec) IsValidInstanceError(
+	errorPrefix interface{}) error {
+
+	if sciNotFmtSpec.lock == nil {
+		sciNotFmtSpec.lock = new(sync.Mutex)
+	}
+
+	sciNotFmtSpec.lock.Lock()
+
+	defer sciNotFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"SciNotationFormatSpec."+
+			"IsValidInstanceError()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(sciNotationFormatSpecAtom).testValidity(
+		sciNotFmtSpec,
+		ePrefix.XCpy(
+			"sciNotFmtSpec"))
+}
+
+// NewSciNotationFormat
+//
+// Creates and returns a new instance of
+// SciNotationFormatSpec configured to format numeric
+// values as Scientific Notation number strings.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	sciNotFmt					ScientificNotationFormat
+//
+//		Specifies the display format of the Scientific
+//		Notation number string. Must be set to one of
+//		the following values or an error will be
+//		returned:
+//
+//			SciNotFmt.Exponential()
+//				"2.652 x 10^8"
+//
+//			SciNotFmt.ENotUprCaseELeadPlus()
+//				"2.652E+8"
+//
+//			SciNotFmt.ENotUprCaseENoLeadPlus()
+//				"2.652E8"
+//
+//			SciNotFmt.ENotLwrCaseELeadPlus()
+//				"2.652e+8"
+//
+//			SciNotFmt.ENotLwrCaseENoLeadPlus()
+//				"2.652e8"
+//
+//	sciNotCalcType				ScientificNotationCalcType
+//
+//		Specifies the calculation used to compute the
+//		significand and exponent. Must be set to one of
+//		the following values or an error will be
+//		returned:
+//
+//			ScientificNotationCalcType(0).Standard()
+//				The significand has a single integer
+//				digit.
+//					265,200,000 = 2.652 x 10^8
+//
+//			ScientificNotationCalcType(0).Engineering()
+//				The exponent is a multiple of three.
+//					265,200,000 = 265.2 x 10^6
+//
+//	significandRoundingType		NumberRoundingType
+//
+//		The rounding algorithm applied to the fractional
+//		digits of the significand. If this parameter is
+//		set to NumRoundType.NoRounding(), all significant
+//		digits are retained and 'significandFracDigits'
+//		is ignored.
+//
+//		If this parameter is invalid, an error will be
+//		returned.
+//
+//	significandFracDigits		int
+//
+//		The number of fractional digits to the right of
+//		the decimal separator in the formatted
+//		significand. If this value is less than zero or
+//		greater than 1,000, an error will be returned.
+//
+//	minExponentDigits			int
+//
+//		The minimum number of digits in the formatted
+//		exponent. Exponents with fewer digits are padded
+//		with leading zeros.
+//
+//			minExponentDigits = 2   "2.652E+08"
+//
+//		If this value is less than zero or greater than
+//		20, an error will be returned.
+//
+//	useSuperscriptExponent		bool
+//
+//		When set to 'true', the exponent will be
+//		formatted with superscript characters:
+//
+//			"2.652×10⁸"
+//
+//		This option is only valid when 'sciNotFmt' is
+//		set to SciNotFmt.Exponential(). If set to 'true'
+//		for an E-Notation format, an error will be
+//		returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	newSciNotFmtSpec			SciNotationFormatSpec
+//
+//		If this method completes successfully, a new,
+//		fully populated instance of SciNotationFormatSpec
+//		will be returned.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (sciNotFmtSpec *SciNotationFormatSpec) NewSciNotationFormat(
+	sciNotFmt ScientificNotationFormat,
+	sciNotCalcType ScientificNotationCalcType,
+	significandRoundingType NumberRoundingType,
+	significandFracDigits int,
+	minExponentDigits int,
+	useSuperscriptExponent bool,
+	errorPrefix interface{}) (
+	newSciNotFmtSpec SciNotationFormatSpec,
+	err error) {
+
+	if sciNotFmtSpec.lock == nil {
+		sciNotFmtSpec.lock = new(sync.Mutex)
+	}
+
+	sciNotFmtSpec.lock.Lock()
+
+	defer sciNotFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"SciNotationFormatSpec."+
+			"NewSciNotationFormat()",
+		"")
+
+	if err != nil {
+		return newSciNotFmtSpec, err
+	}
+
+	if !sciNotFmt.XIsValid() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sciNotFmt' is invalid!\n"+
+			"'sciNotFmt' must be Exponential or one of the\n"+
+			"E-Notation formats.\n"+
+			"sciNotFmt = '%v'\n",
+			ePrefix.String(),
+			sciNotFmt.String())
+
+		return newSciNotFmtSpec, err
+	}
+
+	newSciNotFmtSpec.sciNotFmt = sciNotFmt
+
+	newSciNotFmtSpec.sciNotCalcType = sciNotCalcType
+
+	newSciNotFmtSpec.significandRoundingType =
+		significandRoundingType
+
+	newSciNotFmtSpec.significandFracDigits =
+		significandFracDigits
+
+	newSciNotFmtSpec.significandMinFracDigits =
+		significandFracDigits
+
+	newSciNotFmtSpec.minExponentDigits =
+		minExponentDigits
+
+	newSciNotFmtSpec.useSuperscriptExponent =
+		useSuperscriptExponent
+
+	err = new(sciNotationFormatSpecAtom).testValidity(
+		&newSciNotFmtSpec,
+		ePrefix.XCpy(
+			"newSciNotFmtSpec"))
+
+	if err != nil {
+		return SciNotationFormatSpec{}, err
+	}
+
+	return newSciNotFmtSpec, err
+}
+
+// SetSignificandMinFracDigits
+//
+// Sets the minimum number of fractional digits in the
+// formatted significand. After the significand is rounded
+// to the number of fractional digits returned by
+// GetSignificandFracDigits(), trailing zeros in excess of
+// this minimum are deleted.
+//
+//	Example:
+//		significandFracDigits = 3
+//		minFracDigits = 0
+//		Value: 1,200 => "1.2E3"
+//		Value: 1,000 => "1E3"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	minFracDigits				int
+//
+//		The minimum number of fractional digits in the
+//		formatted significand. If this value is less than
+//		zero or greater than the number of significand
+//		fractional digits, an error will be returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (sciNotFmtSpec *SciNotationFormatSpec) SetSignificandMinFracDigits(
+	minFracDigits int,
+	errorPrefix interface{}) error {
+
+	if sciNotFmtSpec.lock == nil {
+		sciNotFmtSpec.lock = new(sync.Mutex)
+	}
+
+	sciNotFmtSpec.lock.Lock()
+
+	defer sciNotFmtSpec.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"SciNotationFormatSpec."+
+			"SetSignificandMinFracDigits()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	oldMinFracDigits := sciNotFmtSpec.significandMinFracDigits
+
+	sciNotFmtSpec.significandMinFracDigits = minFracDigits
+
+	err = new(sciNotationFormatSpecAtom).testValidity(
+		sciNotFmtSpec,
+		ePrefix.XCpy(
+			"sciNotFmtSpec"))
+
+	if err != nil {
+		sciNotFmtSpec.significandMinFracDigits = oldMinFracDigits
+	}
+
+	return err
+}
+
+// UsesSuperscriptExponent
+//
+// Returns 'true' if the exponent will be formatted with
+// superscript characters ("2.652×10⁸").
+func (sciNotFmtSpec *SciNotationFormatSpec) UsesSuperscriptExponent() bool {
+
+	if sciNotFmtSpec.lock == nil {
+		sciNotFmtSpec.lock = new(sync.Mutex)
+	}
+
+	sciNotFmtSpec.lock.Lock()
+
+	defer sciNotFmtSpec.lock.Unlock()
+
+	return sciNotFmtSpec.useSuperscriptExponent
+}
+
+// sciNotationFormatSpecAtom - Provides helper methods for
+// type SciNotationFormatSpec.
+type sciNotationFormatSpecAtom struct {
+	lock *sync.Mutex
+}
+
+// copy
+//
+// Copies all data from input parameter
+// 'sourceSciNotFmtSpec' to input parameter
+// 'destinationSciNotFmtSpec'. The source instance is
+// validated before the copy operation is performed.
+func (sciNotFmtSpecAtom *sciNotationFormatSpecAtom) copy(
+	destinationSciNotFmtSpec *SciNotationFormatSpec,
+	sourceSciNotFmtSpec *SciNotationFormatSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if sciNotFmtSpecAtom.lock == nil {
+		sciNotFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	sciNotFmtSpecAtom.lock.Lock()
+
+	defer sciNotFmtSpecAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"sciNotationFormatSpecAtom."+
+			"copy()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if destinationSciNotFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'destinationSciNotFmtSpec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if sourceSciNotFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sourceSciNotFmtSpec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	err = new(sciNotationFormatSpecAtom).testValidity(
+		sourceSciNotFmtSpec,
+		ePrefix.XCpy(
+			"sourceSciNotFmtSpec"))
+
+	if err != nil {
+		return err
+	}
+
+	destinationSciNotFmtSpec.sciNotFmt =
+		sourceSciNotFmtSpec.sciNotFmt
+
+	destinationSciNotFmtSpec.sciNotCalcType =
+		sourceSciNotFmtSpec.sciNotCalcType
+
+	destinationSciNotFmtSpec.significandRoundingType =
+		sourceSciNotFmtSpec.significandRoundingType
+
+	destinationSciNotFmtSpec.significandFracDigits =
+		sourceSciNotFmtSpec.significandFracDigits
+
+	destinationSciNotFmtSpec.significandMinFracDigits =
+		sourceSciNotFmtSpec.significandMinFracDigits
+
+	destinationSciNotFmtSpec.minExponentDigits =
+		sourceSciNotFmtSpec.minExponentDigits
+
+	destinationSciNotFmtSpec.useSuperscriptExponent =
+		sourceSciNotFmtSpec.useSuperscriptExponent
+
+	return err
+}
+
+// empty
+//
+// Resets all member variables of input parameter
+// 'sciNotFmtSpec' to their zero values.
+func (sciNotFmtSpecAtom *sciNotationFormatSpecAtom) empty(
+	sciNotFmtSpec *SciNotationFormatSpec) {
+
+	if sciNotFmtSpecAtom.lock == nil {
+		sciNotFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	sciNotFmtSpecAtom.lock.Lock()
+
+	defer sciNotFmtSpecAtom.lock.Unlock()
+
+	if sciNotFmtSpec == nil {
+		return
+	}
+
+	sciNotFmtSpec.sciNotFmt = SciNotFmt.None()
+
+	sciNotFmtSpec.sciNotCalcType =
+		ScientificNotationCalcType(0).None()
+
+	sciNotFmtSpec.significandRoundingType =
+		NumRoundType.None()
+
+	sciNotFmtSpec.significandFracDigits = 0
+
+	sciNotFmtSpec.significandMinFracDigits = 0
+
+	sciNotFmtSpec.minExponentDigits = 0
+
+	sciNotFmtSpec.useSuperscriptExponent = false
+}
+
+// equal
+//
+// Compares the member variables of two instances of
+// SciNotationFormatSpec and returns 'true' if they are
+// equivalent in all respects.
+func (sciNotFmtSpecAtom *sciNotationFormatSpecAtom) equal(
+	sciNotFmtSpec1 *SciNotationFormatSpec,
+	sciNotFmtSpec2 *SciNotationFormatSpec) bool {
+
+	if sciNotFmtSpecAtom.lock == nil {
+		sciNotFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	sciNotFmtSpecAtom.lock.Lock()
+
+	defer sciNotFmtSpecAtom.lock.Unlock()
+
+	if sciNotFmtSpec1 == nil ||
+		sciNotFmtSpec2 == nil {
+
+		return false
+	}
+
+	if sciNotFmtSpec1.sciNotFmt !=
+		sciNotFmtSpec2.sciNotFmt {
+
+		return false
+	}
+
+	if sciNotFmtSpec1.sciNotCalcType !=
+		sciNotFmtSpec2.sciNotCalcType {
+
+		return false
+	}
+
+	if sciNotFmtSpec1.significandRoundingType !=
+		sciNotFmtSpec2.significandRoundingType {
+
+		return false
+	}
+
+	if sciNotFmtSpec1.significandFracDigits !=
+		sciNotFmtSpec2.significandFracDigits {
+
+		return false
+	}
+
+	if sciNotFmtSpec1.significandMinFracDigits !=
+		sciNotFmtSpec2.significandMinFracDigits {
+
+		return false
+	}
+
+	if sciNotFmtSpec1.minExponentDigits !=
+		sciNotFmtSpec2.minExponentDigits {
+
+		return false
+	}
+
+	if sciNotFmtSpec1.useSuperscriptExponent !=
+		sciNotFmtSpec2.useSuperscriptExponent {
+
+		return false
+	}
+
+	return true
+}
+
+// testValidity
+//
+// Performs a diagnostic review of the member variables
+// contained in an instance of SciNotationFormatSpec. If
+// any member variable is invalid, an error is returned.
+//
+// A NOP instance is considered valid.
+func (sciNotFmtSpecAtom *sciNotationFormatSpecAtom) testValidity(
+	sciNotFmtSpec *SciNotationFormatSpec,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if sciNotFmtSpecAtom.lock == nil {
+		sciNotFmtSpecAtom.lock = new(sync.Mutex)
+	}
+
+	sciNotFmtSpecAtom.lock.Lock()
+
+	defer sciNotFmtSpecAtom.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"sciNotationFormatSpecAtom."+
+			"testValidity()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if sciNotFmtSpec == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sciNotFmtSpec' is "+
+			"a nil pointer!\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if !sciNotFmtSpec.sciNotFmt.XIsValid() {
+		// NOP instance
+		return err
+	}
+
+	if sciNotFmtSpec.sciNotCalcType !=
+		ScientificNotationCalcType(0).Standard() &&
+		sciNotFmtSpec.sciNotCalcType !=
+			ScientificNotationCalcType(0).Engineering() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The Scientific Notation Calculation Type is invalid!\n"+
+			"'sciNotCalcType' must be Standard or Engineering.\n"+
+			"sciNotCalcType integer value = '%v'\n",
+			ePrefix.String(),
+			int(sciNotFmtSpec.sciNotCalcType))
+
+		return err
+	}
+
+	if !sciNotFmtSpec.significandRoundingType.XIsValid() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The significand rounding type is invalid!\n"+
+			"significandRoundingType = '%v'\n",
+			ePrefix.String(),
+			sciNotFmtSpec.significandRoundingType.String())
+
+		return err
+	}
+
+	if sciNotFmtSpec.significandFracDigits < 0 ||
+		sciNotFmtSpec.significandFracDigits > 1000 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The number of significand fractional digits is invalid!\n"+
+			"'significandFracDigits' must be greater than or equal to zero\n"+
+			"and less than or equal to 1,000.\n"+
+			"significandFracDigits = '%v'\n",
+			ePrefix.String(),
+			sciNotFmtSpec.significandFracDigits)
+
+		return err
+	}
+
+	if sciNotFmtSpec.significandMinFracDigits < 0 ||
+		sciNotFmtSpec.significandMinFracDigits >
+			sciNotFmtSpec.significandFracDigits {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The minimum number of significand fractional digits\n"+
+			"is invalid! 'significandMinFracDigits' must be greater than\n"+
+			"or equal to zero and less than or equal to 'significandFracDigits'.\n"+
+			"significandMinFracDigits = '%v'\n"+
+			"significandFracDigits = '%v'\n",
+			ePrefix.String(),
+			sciNotFmtSpec.significandMinFracDigits,
+			sciNotFmtSpec.significandFracDigits)
+
+		return err
+	}
+
+	if sciNotFmtSpec.minExponentDigits < 0 ||
+		sciNotFmtSpec.minExponentDigits > 20 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: The minimum number of exponent digits is invalid!\n"+
+			"'minExponentDigits' must be greater than or equal to zero\n"+
+			"and less than or equal to 20.\n"+
+			"minExponentDigits = '%v'\n",
+			ePrefix.String(),
+			sciNotFmtSpec.minExponentDigits)
+
+		return err
+	}
+
+	if sciNotFmtSpec.useSuperscriptExponent &&
+		sciNotFmtSpec.sciNotFmt != SciNotFmt.Exponential() {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Superscript exponents are only valid for the\n"+
+			"Exponential display format.\n"+
+			"sciNotFmt = '%v'\n",
+			ePrefix.String(),
+			sciNotFmtSpec.sciNotFmt.String())
+
+		return err
+	}
+
+	return err
+}
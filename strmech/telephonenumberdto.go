@@ -0,0 +1,1195 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
+
+// TelephoneNumberDto
+//
+// Contains the components of a parsed telephone number.
+// Telephone numbers are parsed according to the
+// numbering plan data encapsulated in an instance of
+// NumStrFmtCountryTelephoneNumSpec.
+//
+//	Example:
+//		Raw Telephone Number:	"+44 20 7946 0958"
+//		CountryCodeTwoChar:		"GB"
+//		CountryTelephoneCode:	"44"
+//		AreaCode:				"20"
+//		SubscriberNumber:		"79460958"
+//		Extension:				""
+//
+// Once parsed, the telephone number may be formatted for
+// E.164, national, international and dialing
+// presentation. Reference methods:
+//
+//	TelephoneNumberDto.FmtDial()
+//	TelephoneNumberDto.FmtE164()
+//	TelephoneNumberDto.FmtInternational()
+//	TelephoneNumberDto.FmtNational()
+//
+// To create an instance of TelephoneNumberDto, reference
+// methods:
+//
+//	TelephoneNumberDto.NewParseTelephoneNumber()
+//	NumStrFmtCountryTelephoneNumSpec.ParseTelephoneNumber()
+type TelephoneNumberDto struct {
+	CountryName string
+	//	The name of the country associated with this
+	//	telephone number.
+
+	CountryCodeTwoChar string
+	//	The ISO 3166-1 alpha-2 Two Character code
+	//	identifying the country associated with this
+	//	telephone number.
+
+	CountryTelephoneCode string
+	//	The ITU-T E.164 country calling code.
+	//	Example: "44"
+
+	AreaCode string
+	//	The area code digits, excluding any trunk prefix.
+	//	Countries with closed numbering plans do not use
+	//	an area code. In this case, 'AreaCode' is an
+	//	empty string.
+	//	Example: "20"
+
+	SubscriberNumber string
+	//	The subscriber number digits.
+	//	Example: "79460958"
+
+	Extension string
+	//	The extension number digits. If the telephone
+	//	number does not include an extension, this
+	//	string is empty.
+	//	Example: "42"
+
+	telephoneNumSpec NumStrFmtCountryTelephoneNumSpec
+	//	The Country Telephone Number Specification used
+	//	to parse and format this telephone number.
+
+	lock *sync.Mutex
+}
+
+// CopyIn
+//
+// Copies the data fields from an incoming instance of
+// TelephoneNumberDto ('incomingTelephoneNumDto') to the
+// data fields of the current TelephoneNumberDto
+// instance.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// All the data fields in the current TelephoneNumberDto
+// instance will be deleted and overwritten.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	incomingTelephoneNumDto		*TelephoneNumberDto
+//
+//		A pointer to an instance of TelephoneNumberDto.
+//		The data values in this object will be copied to
+//		the current TelephoneNumberDto instance.
+//
+//		'incomingTelephoneNumDto' will NOT be changed or
+//		modified.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (telNumDto *TelephoneNumberDto) CopyIn(
+	incomingTelephoneNumDto *TelephoneNumberDto,
+	errorPrefix interface{}) (
+	err error) {
+
+	if telNumDto.lock == nil {
+		telNumDto.lock = new(sync.Mutex)
+	}
+
+	telNumDto.lock.Lock()
+
+	defer telNumDto.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"TelephoneNumberDto."+
+			"CopyIn()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	return new(telephoneNumberDtoElectron).copy(
+		telNumDto,
+		incomingTelephoneNumDto,
+		ePrefix.XCpy(
+			"telNumDto<-incomingTelephoneNumDto"))
+}
+
+// CopyOut
+//
+// Returns a deep copy of the current TelephoneNumberDto
+// instance.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	deepCopyTelephoneNumDto		TelephoneNumberDto
+//
+//		If this method completes successfully, a deep
+//		copy of the current TelephoneNumberDto instance
+//		will be returned.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (telNumDto *TelephoneNumberDto) CopyOut(
+	errorPrefix interface{}) (
+	deepCopyTelephoneNumDto TelephoneNumberDto,
+	err error) {
+
+	if telNumDto.lock == nil {
+		telNumDto.lock = new(sync.Mutex)
+	}
+
+	telNumDto.lock.Lock()
+
+	defer telNumDto.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"TelephoneNumberDto."+
+			"CopyOut()",
+		"")
+
+	if err != nil {
+		return deepCopyTelephoneNumDto, err
+	}
+
+	err = new(telephoneNumberDtoElectron).copy(
+		&deepCopyTelephoneNumDto,
+		telNumDto,
+		ePrefix.XCpy(
+			"deepCopyTelephoneNumDto<-telNumDto"))
+
+	return deepCopyTelephoneNumDto, err
+}
+
+// Empty
+//
+// Resets all internal member variables for the current
+// instance of TelephoneNumberDto to their initial or
+// zero values.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// This method will delete all pre-existing internal
+// member variable data values in the current instance
+// of TelephoneNumberDto.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	NONE
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NONE
+func (telNumDto *TelephoneNumberDto) Empty() {
+
+	if telNumDto.lock == nil {
+		telNumDto.lock = new(sync.Mutex)
+	}
+
+	telNumDto.lock.Lock()
+
+	new(telephoneNumberDtoElectron).empty(
+		telNumDto)
+
+	telNumDto.lock.Unlock()
+
+	telNumDto.lock = nil
+}
+
+// FmtDial
+//
+// Returns the telephone number encapsulated by the
+// current instance of TelephoneNumberDto formatted as a
+// string of digits suitable for dialing from within the
+// host country.
+//
+// If the telephone number includes an extension, the
+// extension is appended following a comma (',') which
+// signals a dialing pause.
+//
+//	Example:
+//		Parsed Telephone Number: "(212) 555-0100 x42"
+//		Dial String:			 "12125550100,42"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	string
+//
+//		The telephone number formatted for dialing.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (telNumDto *TelephoneNumberDto) FmtDial(
+	errorPrefix interface{}) (
+	string,
+	error) {
+
+	if telNumDto.lock == nil {
+		telNumDto.lock = new(sync.Mutex)
+	}
+
+	telNumDto.lock.Lock()
+
+	defer telNumDto.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"TelephoneNumberDto."+
+			"FmtDial()",
+		"")
+
+	if err != nil {
+		return "", err
+	}
+
+	return new(telephoneNumberDtoNanobot).fmtDial(
+		telNumDto,
+		ePrefix.XCpy(
+			"telNumDto"))
+}
+
+// FmtE164
+//
+// Returns the telephone number encapsulated by the
+// current instance of TelephoneNumberDto formatted in
+// accordance with the ITU-T E.164 standard.
+//
+// The E.164 format consists of a plus sign ('+')
+// followed by the Country Telephone Code, the area code
+// and the subscriber number. Any extension is omitted.
+//
+//	Example:
+//		Parsed Telephone Number: "+44 20 7946 0958"
+//		E.164 String:			 "+442079460958"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	string
+//
+//		The telephone number formatted in accordance with
+//		the E.164 standard.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (telNumDto *TelephoneNumberDto) FmtE164(
+	errorPrefix interface{}) (
+	string,
+	error) {
+
+	if telNumDto.lock == nil {
+		telNumDto.lock = new(sync.Mutex)
+	}
+
+	telNumDto.lock.Lock()
+
+	defer telNumDto.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"TelephoneNumberDto."+
+			"FmtE164()",
+		"")
+
+	if err != nil {
+		return "", err
+	}
+
+	return new(telephoneNumberDtoNanobot).fmtE164(
+		telNumDto,
+		ePrefix.XCpy(
+			"telNumDto"))
+}
+
+// FmtInternational
+//
+// Returns the telephone number encapsulated by the
+// current instance of TelephoneNumberDto formatted for
+// display to callers outside the host country.
+//
+// If the telephone number includes an extension, the
+// extension is appended following " x".
+//
+//	Example:
+//		Parsed Telephone Number: "(212) 555-0100 x42"
+//		International String:	 "+1 (212) 555-0100 x42"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	string
+//
+//		The telephone number formatted for international
+//		display.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (telNumDto *TelephoneNumberDto) FmtInternational(
+	errorPrefix interface{}) (
+	string,
+	error) {
+
+	if telNumDto.lock == nil {
+		telNumDto.lock = new(sync.Mutex)
+	}
+
+	telNumDto.lock.Lock()
+
+	defer telNumDto.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"TelephoneNumberDto."+
+			"FmtInternational()",
+		"")
+
+	if err != nil {
+		return "", err
+	}
+
+	return new(telephoneNumberDtoNanobot).fmtInternational(
+		telNumDto,
+		ePrefix.XCpy(
+			"telNumDto"))
+}
+
+// FmtNational
+//
+// Returns the telephone number encapsulated by the
+// current instance of TelephoneNumberDto formatted for
+// display to callers inside the host country.
+//
+// If the telephone number includes an extension, the
+// extension is appended following " x".
+//
+//	Example:
+//		Parsed Telephone Number: "+44 20 7946 0958"
+//		National String:		 "020 7946 0958"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	string
+//
+//		The telephone number formatted for national
+//		display.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (telNumDto *TelephoneNumberDto) FmtNational(
+	errorPrefix interface{}) (
+	string,
+	error) {
+
+	if telNumDto.lock == nil {
+		telNumDto.lock = new(sync.Mutex)
+	}
+
+	telNumDto.lock.Lock()
+
+	defer telNumDto.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"TelephoneNumberDto."+
+			"FmtNational()",
+		"")
+
+	if err != nil {
+		return "", err
+	}
+
+	return new(telephoneNumberDtoNanobot).fmtNational(
+		telNumDto,
+		ePrefix.XCpy(
+			"telNumDto"))
+}
+
+// GetTelephoneNumSpec
+//
+// Returns a deep copy of the Country Telephone Number
+// Specification used to parse and format the telephone
+// number encapsulated by the current instance of
+// TelephoneNumberDto.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NumStrFmtCountryTelephoneNumSpec
+//
+//		A deep copy of the Country Telephone Number
+//		Specification contained in the current instance
+//		of TelephoneNumberDto.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (telNumDto *TelephoneNumberDto) GetTelephoneNumSpec(
+	errorPrefix interface{}) (
+	NumStrFmtCountryTelephoneNumSpec,
+	error) {
+
+	if telNumDto.lock == nil {
+		telNumDto.lock = new(sync.Mutex)
+	}
+
+	telNumDto.lock.Lock()
+
+	defer telNumDto.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"TelephoneNumberDto."+
+			"GetTelephoneNumSpec()",
+		"")
+
+	telephoneNumSpec := NumStrFmtCountryTelephoneNumSpec{}
+
+	if err != nil {
+		return telephoneNumSpec, err
+	}
+
+	err = new(numStrFmtCountryTelephoneNumSpecElectron).copy(
+		&telephoneNumSpec,
+		&telNumDto.telephoneNumSpec,
+		ePrefix.XCpy(
+			"telephoneNumSpec<-telNumDto.telephoneNumSpec"))
+
+	return telephoneNumSpec, err
+}
+
+// NewParseTelephoneNumber
+//
+// Parses a raw telephone number string and returns a
+// new instance of TelephoneNumberDto containing the
+// country, area code, subscriber number and extension
+// components of the telephone number.
+//
+// If the raw telephone number begins with a plus sign
+// ('+'), or with the International Prefix of the default
+// country, the country is identified from the Country
+// Telephone Code contained in the raw telephone number.
+// All other telephone numbers are parsed as national
+// numbers of the default country.
+//
+// The number of area code, subscriber and extension
+// digits is validated against the numbering plan of the
+// identified country.
+//
+//	Examples:
+//		"+44 20 7946 0958"
+//		"+44 (0)20 7946 0958"
+//		"(212) 555-0100 x42"
+//		"011 44 20 7946 0958"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	rawTelephoneNum				string
+//
+//		The raw telephone number string to be parsed.
+//
+//		If this string is empty or invalid, an error will
+//		be returned.
+//
+//	defaultCountryCode			string
+//
+//		The ISO 3166-1 alpha-2 ("US") or alpha-3 ("USA")
+//		country code of the default country. National
+//		telephone numbers are parsed using the numbering
+//		plan of the default country.
+//
+//		If 'rawTelephoneNum' is a national number and
+//		this parameter is empty, an error will be
+//		returned.
+//
+//	errorPrefix					interface{}
+//
+//		This object encapsulates error prefix text which
+//		is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a method or function
+//		chain of execution.
+//
+//		If no error prefix information is needed, set this
+//		parameter to 'nil'.
+//
+//		This empty interface must be convertible to one of
+//		the following types:
+//
+//		1.	nil
+//				A nil value is valid and generates an
+//				empty collection of error prefix and
+//				error context information.
+//
+//		2.	string
+//				A string containing error prefix
+//				information.
+//
+//		3.	[]string
+//				A one-dimensional slice of strings
+//				containing error prefix information.
+//
+//		4.	[][2]string
+//				A two-dimensional slice of strings
+//		   		containing error prefix and error
+//		   		context information.
+//
+//		5.	ErrPrefixDto
+//				An instance of ErrPrefixDto.
+//				Information from this object will
+//				be copied for use in error and
+//				informational messages.
+//
+//		6.	*ErrPrefixDto
+//				A pointer to an instance of
+//				ErrPrefixDto. Information from
+//				this object will be copied for use
+//				in error and informational messages.
+//
+//		7.	IBasicErrorPrefix
+//				An interface to a method
+//				generating a two-dimensional slice
+//				of strings containing error prefix
+//				and error context information.
+//
+//		If parameter 'errorPrefix' is NOT convertible
+//		to one of the valid types listed above, it will
+//		be considered invalid and trigger the return of
+//		an error.
+//
+//		Types ErrPrefixDto and IBasicErrorPrefix are
+//		included in the 'errpref' software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	TelephoneNumberDto
+//
+//		If this method completes successfully, a new
+//		instance of TelephoneNumberDto containing the
+//		parsed telephone number will be returned.
+//
+//	error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errorPrefix' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (telNumDto *TelephoneNumberDto) NewParseTelephoneNumber(
+	rawTelephoneNum string,
+	defaultCountryCode string,
+	errorPrefix interface{}) (
+	TelephoneNumberDto,
+	error) {
+
+	if telNumDto.lock == nil {
+		telNumDto.lock = new(sync.Mutex)
+	}
+
+	telNumDto.lock.Lock()
+
+	defer telNumDto.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"TelephoneNumberDto."+
+			"NewParseTelephoneNumber()",
+		"")
+
+	newTelephoneNumDto := TelephoneNumberDto{}
+
+	if err != nil {
+		return newTelephoneNumDto, err
+	}
+
+	err = new(numStrFmtCountryTelephoneNumSpecNanobot).
+		parseTelephoneNumber(
+			&newTelephoneNumDto,
+			rawTelephoneNum,
+			defaultCountryCode,
+			ePrefix.XCpy(
+				"newTelephoneNumDto<-rawTelephoneNum"))
+
+	return newTelephoneNumDto, err
+}
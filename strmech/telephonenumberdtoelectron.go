@@ -0,0 +1,331 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"sync"
+)
+
+// telephoneNumberDtoElectron
+//
+// Provides helper methods for type TelephoneNumberDto.
+type telephoneNumberDtoElectron struct {
+	lock *sync.Mutex
+}
+
+// copy
+//
+// Copies all data from input parameter
+// 'sourceTelephoneNumDto' to input parameter
+// 'destinationTelephoneNumDto'.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// All pre-existing data values in
+// 'destinationTelephoneNumDto' will be deleted and
+// overwritten.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	destinationTelephoneNumDto	*TelephoneNumberDto
+//
+//		A pointer to an instance of TelephoneNumberDto.
+//		All data values in 'sourceTelephoneNumDto' will
+//		be copied to this instance.
+//
+//	sourceTelephoneNumDto		*TelephoneNumberDto
+//
+//		A pointer to an instance of TelephoneNumberDto.
+//		All data values in this instance will be copied
+//		to 'destinationTelephoneNumDto'.
+//
+//		'sourceTelephoneNumDto' will NOT be changed or
+//		modified.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (telNumDtoElectron *telephoneNumberDtoElectron) copy(
+	destinationTelephoneNumDto *TelephoneNumberDto,
+	sourceTelephoneNumDto *TelephoneNumberDto,
+	errPrefDto *ePref.ErrPrefixDto) (
+	err error) {
+
+	if telNumDtoElectron.lock == nil {
+		telNumDtoElectron.lock = new(sync.Mutex)
+	}
+
+	telNumDtoElectron.lock.Lock()
+
+	defer telNumDtoElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"telephoneNumberDtoElectron."+
+			"copy()",
+		"")
+
+	if err != nil {
+		return err
+	}
+
+	if destinationTelephoneNumDto == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'destinationTelephoneNumDto' is invalid!\n"+
+			"'destinationTelephoneNumDto' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	if sourceTelephoneNumDto == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'sourceTelephoneNumDto' is invalid!\n"+
+			"'sourceTelephoneNumDto' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return err
+	}
+
+	destinationTelephoneNumDto.CountryName =
+		sourceTelephoneNumDto.CountryName
+
+	destinationTelephoneNumDto.CountryCodeTwoChar =
+		sourceTelephoneNumDto.CountryCodeTwoChar
+
+	destinationTelephoneNumDto.CountryTelephoneCode =
+		sourceTelephoneNumDto.CountryTelephoneCode
+
+	destinationTelephoneNumDto.AreaCode =
+		sourceTelephoneNumDto.AreaCode
+
+	destinationTelephoneNumDto.SubscriberNumber =
+		sourceTelephoneNumDto.SubscriberNumber
+
+	destinationTelephoneNumDto.Extension =
+		sourceTelephoneNumDto.Extension
+
+	return new(numStrFmtCountryTelephoneNumSpecElectron).copy(
+		&destinationTelephoneNumDto.telephoneNumSpec,
+		&sourceTelephoneNumDto.telephoneNumSpec,
+		ePrefix.XCpy(
+			"destinationTelephoneNumDto.telephoneNumSpec<-"+
+				"sourceTelephoneNumDto.telephoneNumSpec"))
+}
+
+// empty
+//
+// Resets all internal member variables for the instance
+// of TelephoneNumberDto passed as input parameter
+// 'telephoneNumDto' to their initial or zero states.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// All pre-existing data values in 'telephoneNumDto' will
+// be deleted and reset to their zero values.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	telephoneNumDto				*TelephoneNumberDto
+//
+//		A pointer to an instance of TelephoneNumberDto.
+//		All data values in this instance will be reset to
+//		their zero values.
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	NONE
+func (telNumDtoElectron *telephoneNumberDtoElectron) empty(
+	telephoneNumDto *TelephoneNumberDto) {
+
+	if telNumDtoElectron.lock == nil {
+		telNumDtoElectron.lock = new(sync.Mutex)
+	}
+
+	telNumDtoElectron.lock.Lock()
+
+	defer telNumDtoElectron.lock.Unlock()
+
+	if telephoneNumDto == nil {
+		return
+	}
+
+	telephoneNumDto.CountryName = ""
+
+	telephoneNumDto.CountryCodeTwoChar = ""
+
+	telephoneNumDto.CountryTelephoneCode = ""
+
+	telephoneNumDto.AreaCode = ""
+
+	telephoneNumDto.SubscriberNumber = ""
+
+	telephoneNumDto.Extension = ""
+
+	new(numStrFmtCountryTelephoneNumSpecElectron).empty(
+		&telephoneNumDto.telephoneNumSpec)
+}
+
+// fmtDigits
+//
+// Formats a string of numeric digits using the Number
+// Format string of a NumStrFmtCharReplacementSpec
+// instance.
+//
+// If the number of Number Replacement Characters in the
+// Number Format string is not equal to the number of
+// numeric digits, no formatting is performed and the
+// returned boolean value 'isFormatted' is set to
+// 'false'.
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	charReplacementSpec			*NumStrFmtCharReplacementSpec
+//
+//		A pointer to an instance of
+//		NumStrFmtCharReplacementSpec containing the
+//		Number Format used to format 'numericDigits'.
+//
+//	numericDigits				string
+//
+//		A string of numeric digits ('0' - '9') to be
+//		formatted.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	formattedStr				string
+//
+//		If 'isFormatted' is 'true', this string contains
+//		the formatted numeric digits.
+//
+//	isFormatted					bool
+//
+//		If the Number Format was successfully applied to
+//		'numericDigits', this value is set to 'true'.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (telNumDtoElectron *telephoneNumberDtoElectron) fmtDigits(
+	charReplacementSpec *NumStrFmtCharReplacementSpec,
+	numericDigits string,
+	errPrefDto *ePref.ErrPrefixDto) (
+	formattedStr string,
+	isFormatted bool,
+	err error) {
+
+	if telNumDtoElectron.lock == nil {
+		telNumDtoElectron.lock = new(sync.Mutex)
+	}
+
+	telNumDtoElectron.lock.Lock()
+
+	defer telNumDtoElectron.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"telephoneNumberDtoElectron."+
+			"fmtDigits()",
+		"")
+
+	if err != nil {
+		return formattedStr, isFormatted, err
+	}
+
+	nStrFmtCharReplaceElectron :=
+		numStrFmtCharReplacementSpecElectron{}
+
+	if len(numericDigits) == 0 ||
+		nStrFmtCharReplaceElectron.getNumOfReplacementChars(
+			charReplacementSpec) != len(numericDigits) {
+
+		return formattedStr, isFormatted, err
+	}
+
+	formattedStr,
+		err = nStrFmtCharReplaceElectron.replaceNumericDigits(
+		charReplacementSpec,
+		[]rune(numericDigits),
+		ePrefix.XCpy(
+			"formattedStr<-numericDigits"))
+
+	if err != nil {
+		return formattedStr, isFormatted, err
+	}
+
+	isFormatted = true
+
+	return formattedStr, isFormatted, err
+}
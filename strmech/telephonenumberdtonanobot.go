@@ -0,0 +1,700 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"strings"
+	"sync"
+)
+
+// telephoneNumberDtoNanobot
+//
+// Provides helper methods for type TelephoneNumberDto.
+type telephoneNumberDtoNanobot struct {
+	lock *sync.Mutex
+}
+
+// fmtDial
+//
+// Formats the telephone number encapsulated by an
+// instance of TelephoneNumberDto as a string of digits
+// suitable for dialing from within the host country.
+//
+// The dialing format is taken from the
+// 'SubscriberFmtFullInternal.PhoneNoDialFmt' member of
+// the Country Telephone Number Specification. If this
+// format cannot be applied, the dial string is composed
+// of the Trunk Prefix, the area code and the subscriber
+// number.
+//
+// If the telephone number includes an extension, the
+// extension is appended following a comma (',') which
+// signals a dialing pause.
+//
+//	Example:
+//		"12125550100,42"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	telephoneNumDto				*TelephoneNumberDto
+//
+//		A pointer to an instance of TelephoneNumberDto
+//		containing the telephone number to be formatted.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	dialStr						string
+//
+//		The telephone number formatted for dialing.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (telNumDtoNanobot *telephoneNumberDtoNanobot) fmtDial(
+	telephoneNumDto *TelephoneNumberDto,
+	errPrefDto *ePref.ErrPrefixDto) (
+	dialStr string,
+	err error) {
+
+	if telNumDtoNanobot.lock == nil {
+		telNumDtoNanobot.lock = new(sync.Mutex)
+	}
+
+	telNumDtoNanobot.lock.Lock()
+
+	defer telNumDtoNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"telephoneNumberDtoNanobot."+
+			"fmtDial()",
+		"")
+
+	if err != nil {
+		return dialStr, err
+	}
+
+	if telephoneNumDto == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'telephoneNumDto' is invalid!\n"+
+			"'telephoneNumDto' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return dialStr, err
+	}
+
+	if len(telephoneNumDto.SubscriberNumber) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'telephoneNumDto' is invalid!\n"+
+			"'telephoneNumDto.SubscriberNumber' is empty.\n",
+			ePrefix.String())
+
+		return dialStr, err
+	}
+
+	var isFormatted bool
+
+	dialStr,
+		isFormatted,
+		err = new(telephoneNumberDtoElectron).fmtDigits(
+		&telephoneNumDto.telephoneNumSpec.
+			SubscriberFmtFullInternal.PhoneNoDialFmt,
+		telephoneNumDto.AreaCode+
+			telephoneNumDto.SubscriberNumber,
+		ePrefix.XCpy(
+			"dialStr<-SubscriberFmtFullInternal"))
+
+	if err != nil {
+		return dialStr, err
+	}
+
+	if !isFormatted {
+
+		dialStr = ""
+
+		if len(telephoneNumDto.AreaCode) > 0 {
+
+			dialStr = strings.TrimSpace(
+				telephoneNumDto.telephoneNumSpec.TrunkPrefix)
+		}
+
+		dialStr += telephoneNumDto.AreaCode +
+			telephoneNumDto.SubscriberNumber
+	}
+
+	if len(telephoneNumDto.Extension) > 0 {
+
+		dialStr += "," + telephoneNumDto.Extension
+	}
+
+	return dialStr, err
+}
+
+// fmtE164
+//
+// Formats the telephone number encapsulated by an
+// instance of TelephoneNumberDto in the ITU-T E.164
+// international format.
+//
+// The E.164 format consists of a plus sign ('+')
+// followed by the Country Telephone Code, the area code
+// and the subscriber number. No separators are included.
+// Since E.164 does not provide for extensions, any
+// extension is omitted.
+//
+//	Example:
+//		"+442079460958"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	telephoneNumDto				*TelephoneNumberDto
+//
+//		A pointer to an instance of TelephoneNumberDto
+//		containing the telephone number to be formatted.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	e164Str						string
+//
+//		The telephone number formatted in accordance with
+//		the E.164 standard.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (telNumDtoNanobot *telephoneNumberDtoNanobot) fmtE164(
+	telephoneNumDto *TelephoneNumberDto,
+	errPrefDto *ePref.ErrPrefixDto) (
+	e164Str string,
+	err error) {
+
+	if telNumDtoNanobot.lock == nil {
+		telNumDtoNanobot.lock = new(sync.Mutex)
+	}
+
+	telNumDtoNanobot.lock.Lock()
+
+	defer telNumDtoNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"telephoneNumberDtoNanobot."+
+			"fmtE164()",
+		"")
+
+	if err != nil {
+		return e164Str, err
+	}
+
+	if telephoneNumDto == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'telephoneNumDto' is invalid!\n"+
+			"'telephoneNumDto' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return e164Str, err
+	}
+
+	if len(telephoneNumDto.SubscriberNumber) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'telephoneNumDto' is invalid!\n"+
+			"'telephoneNumDto.SubscriberNumber' is empty.\n",
+			ePrefix.String())
+
+		return e164Str, err
+	}
+
+	if len(telephoneNumDto.CountryTelephoneCode) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'telephoneNumDto' is invalid!\n"+
+			"'telephoneNumDto.CountryTelephoneCode' is empty.\n",
+			ePrefix.String())
+
+		return e164Str, err
+	}
+
+	e164Str = "+" +
+		telephoneNumDto.CountryTelephoneCode +
+		telephoneNumDto.AreaCode +
+		telephoneNumDto.SubscriberNumber
+
+	return e164Str, err
+}
+
+// fmtInternational
+//
+// Formats the telephone number encapsulated by an
+// instance of TelephoneNumberDto for display to callers
+// outside the host country.
+//
+// The display format is taken from the
+// 'SubscriberFmtFullExternal.PhoneNoDisplayFmt' member
+// of the Country Telephone Number Specification and is
+// preceded by a plus sign ('+'). If the number of area
+// code digits is fixed, the display format covers the
+// area code and subscriber number. Otherwise, it covers
+// the subscriber number only and the Country Telephone
+// Code and area code precede the subscriber number.
+//
+// Mobile numbers parsed without an area code which do
+// not match this display format are formatted with the
+// 'MobileFmtFullExternal.PhoneNoDisplayFmt' member.
+//
+// If the telephone number includes an extension, the
+// extension is appended following " x".
+//
+//	Example:
+//		"+1 (212) 555-0100 x42"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	telephoneNumDto				*TelephoneNumberDto
+//
+//		A pointer to an instance of TelephoneNumberDto
+//		containing the telephone number to be formatted.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	internationalStr			string
+//
+//		The telephone number formatted for international
+//		display.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (telNumDtoNanobot *telephoneNumberDtoNanobot) fmtInternational(
+	telephoneNumDto *TelephoneNumberDto,
+	errPrefDto *ePref.ErrPrefixDto) (
+	internationalStr string,
+	err error) {
+
+	if telNumDtoNanobot.lock == nil {
+		telNumDtoNanobot.lock = new(sync.Mutex)
+	}
+
+	telNumDtoNanobot.lock.Lock()
+
+	defer telNumDtoNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"telephoneNumberDtoNanobot."+
+			"fmtInternational()",
+		"")
+
+	if err != nil {
+		return internationalStr, err
+	}
+
+	if telephoneNumDto == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'telephoneNumDto' is invalid!\n"+
+			"'telephoneNumDto' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return internationalStr, err
+	}
+
+	if len(telephoneNumDto.SubscriberNumber) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'telephoneNumDto' is invalid!\n"+
+			"'telephoneNumDto.SubscriberNumber' is empty.\n",
+			ePrefix.String())
+
+		return internationalStr, err
+	}
+
+	if len(telephoneNumDto.CountryTelephoneCode) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'telephoneNumDto' is invalid!\n"+
+			"'telephoneNumDto.CountryTelephoneCode' is empty.\n",
+			ePrefix.String())
+
+		return internationalStr, err
+	}
+
+	telNumDtoElectron := telephoneNumberDtoElectron{}
+
+	displayFmt := &telephoneNumDto.telephoneNumSpec.
+		SubscriberFmtFullExternal.PhoneNoDisplayFmt
+
+	var formattedStr string
+	var isFormatted, isMobileFormatted bool
+
+	telNumSpec := &telephoneNumDto.telephoneNumSpec
+
+	// Fixed length area codes are included in the
+	// display format. Variable length area codes are
+	// not.
+	isAreaCodeFixed :=
+		strings.TrimSpace(telNumSpec.AreaCodeMinNumOfDigits) ==
+			strings.TrimSpace(telNumSpec.AreaCodeMaxNumOfDigits)
+
+	numericDigits := telephoneNumDto.SubscriberNumber
+
+	if isAreaCodeFixed {
+		numericDigits = telephoneNumDto.AreaCode + numericDigits
+	}
+
+	formattedStr,
+		isFormatted,
+		err = telNumDtoElectron.fmtDigits(
+		displayFmt,
+		numericDigits,
+		ePrefix.XCpy(
+			"formattedStr<-numericDigits"))
+
+	if err != nil {
+		return internationalStr, err
+	}
+
+	if !isFormatted &&
+		len(telephoneNumDto.AreaCode) == 0 {
+
+		// Mobile numbers dialed without an area code
+		formattedStr,
+			isMobileFormatted,
+			err = telNumDtoElectron.fmtDigits(
+			&telNumSpec.MobileFmtFullExternal.PhoneNoDisplayFmt,
+			telephoneNumDto.SubscriberNumber,
+			ePrefix.XCpy(
+				"formattedStr<-SubscriberNumber"))
+
+		if err != nil {
+			return internationalStr, err
+		}
+	}
+
+	if !isFormatted &&
+		!isMobileFormatted {
+
+		formattedStr = telephoneNumDto.SubscriberNumber
+	}
+
+	if isFormatted &&
+		isAreaCodeFixed {
+
+		internationalStr = "+" + formattedStr
+
+	} else {
+
+		internationalStr = "+" +
+			telephoneNumDto.CountryTelephoneCode +
+			" "
+
+		if len(telephoneNumDto.AreaCode) > 0 {
+
+			internationalStr +=
+				telephoneNumDto.AreaCode + " "
+		}
+
+		internationalStr += formattedStr
+	}
+
+	if len(telephoneNumDto.Extension) > 0 {
+
+		internationalStr += " x" + telephoneNumDto.Extension
+	}
+
+	return internationalStr, err
+}
+
+// fmtNational
+//
+// Formats the telephone number encapsulated by an
+// instance of TelephoneNumberDto for display to callers
+// inside the host country.
+//
+// The display format is taken from the
+// 'SubscriberFmtAbbrInternal.PhoneNoDisplayFmt' member
+// of the Country Telephone Number Specification. If the
+// number of area code digits is fixed, the display
+// format covers the area code and subscriber number.
+// Otherwise, it covers the subscriber number only and
+// the Trunk Prefix and area code precede the subscriber
+// number.
+//
+// Mobile numbers parsed without an area code which do
+// not match this display format are formatted with the
+// 'MobileFmtAbbrInternal.PhoneNoDisplayFmt' member.
+//
+// If the telephone number includes an extension, the
+// extension is appended following " x".
+//
+//	Example:
+//		"(212) 555-0100 x42"
+//		"020 7946 0958"
+//
+// ----------------------------------------------------------------
+//
+// # Input Parameters
+//
+//	telephoneNumDto				*TelephoneNumberDto
+//
+//		A pointer to an instance of TelephoneNumberDto
+//		containing the telephone number to be formatted.
+//
+//	errPrefDto					*ePref.ErrPrefixDto
+//
+//		This object encapsulates an error prefix string
+//		which is included in all returned error messages.
+//		Usually, it contains the name of the calling
+//		method or methods listed as a function chain.
+//
+//		If no error prefix information is needed, set
+//		this parameter to 'nil'.
+//
+//		Type ErrPrefixDto is included in the 'errpref'
+//		software package:
+//			"github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// # Return Values
+//
+//	nationalStr					string
+//
+//		The telephone number formatted for national
+//		display.
+//
+//	err							error
+//
+//		If this method completes successfully, the
+//		returned error Type is set equal to 'nil'. If
+//		errors are encountered during processing, the
+//		returned error Type will encapsulate an error
+//		message.
+//
+//		If an error message is returned, the text value
+//		for input parameter 'errPrefDto' (error prefix)
+//		will be prefixed or attached at the beginning of
+//		the error message.
+func (telNumDtoNanobot *telephoneNumberDtoNanobot) fmtNational(
+	telephoneNumDto *TelephoneNumberDto,
+	errPrefDto *ePref.ErrPrefixDto) (
+	nationalStr string,
+	err error) {
+
+	if telNumDtoNanobot.lock == nil {
+		telNumDtoNanobot.lock = new(sync.Mutex)
+	}
+
+	telNumDtoNanobot.lock.Lock()
+
+	defer telNumDtoNanobot.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewFromErrPrefDto(
+		errPrefDto,
+		"telephoneNumberDtoNanobot."+
+			"fmtNational()",
+		"")
+
+	if err != nil {
+		return nationalStr, err
+	}
+
+	if telephoneNumDto == nil {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'telephoneNumDto' is invalid!\n"+
+			"'telephoneNumDto' is a 'nil' pointer.\n",
+			ePrefix.String())
+
+		return nationalStr, err
+	}
+
+	if len(telephoneNumDto.SubscriberNumber) == 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'telephoneNumDto' is invalid!\n"+
+			"'telephoneNumDto.SubscriberNumber' is empty.\n",
+			ePrefix.String())
+
+		return nationalStr, err
+	}
+
+	telNumDtoElectron := telephoneNumberDtoElectron{}
+
+	displayFmt := &telephoneNumDto.telephoneNumSpec.
+		SubscriberFmtAbbrInternal.PhoneNoDisplayFmt
+
+	var formattedStr string
+	var isFormatted, isMobileFormatted bool
+
+	telNumSpec := &telephoneNumDto.telephoneNumSpec
+
+	// Fixed length area codes are included in the
+	// display format. Variable length area codes are
+	// not.
+	isAreaCodeFixed :=
+		strings.TrimSpace(telNumSpec.AreaCodeMinNumOfDigits) ==
+			strings.TrimSpace(telNumSpec.AreaCodeMaxNumOfDigits)
+
+	numericDigits := telephoneNumDto.SubscriberNumber
+
+	if isAreaCodeFixed {
+		numericDigits = telephoneNumDto.AreaCode + numericDigits
+	}
+
+	formattedStr,
+		isFormatted,
+		err = telNumDtoElectron.fmtDigits(
+		displayFmt,
+		numericDigits,
+		ePrefix.XCpy(
+			"formattedStr<-numericDigits"))
+
+	if err != nil {
+		return nationalStr, err
+	}
+
+	if !isFormatted &&
+		len(telephoneNumDto.AreaCode) == 0 {
+
+		// Mobile numbers dialed without an area code
+		formattedStr,
+			isMobileFormatted,
+			err = telNumDtoElectron.fmtDigits(
+			&telNumSpec.MobileFmtAbbrInternal.PhoneNoDisplayFmt,
+			telephoneNumDto.SubscriberNumber,
+			ePrefix.XCpy(
+				"formattedStr<-SubscriberNumber"))
+
+		if err != nil {
+			return nationalStr, err
+		}
+	}
+
+	if !isFormatted &&
+		!isMobileFormatted {
+
+		formattedStr = telephoneNumDto.SubscriberNumber
+	}
+
+	if isFormatted &&
+		isAreaCodeFixed {
+
+		nationalStr = formattedStr
+
+	} else {
+
+		if len(telephoneNumDto.AreaCode) > 0 {
+
+			nationalStr = strings.TrimSpace(
+				telNumSpec.TrunkPrefix) +
+				telephoneNumDto.AreaCode +
+				" "
+		}
+
+		nationalStr += formattedStr
+	}
+
+	if len(telephoneNumDto.Extension) > 0 {
+
+		nationalStr += " x" + telephoneNumDto.Extension
+	}
+
+	return nationalStr, err
+}
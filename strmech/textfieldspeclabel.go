@@ -4,6 +4,7 @@ import (
 	"fmt"
 	ePref "github.com/MikeAustin71/errpref"
 	"io"
+	"strconv"
 	"strings"
 	"sync"
 )
@@ -1166,6 +1167,263 @@ func (txtFieldLabel TextFieldSpecLabel) New() TextFieldSpecLabel {
 	return newTxtFieldLabel
 }
 
+// NewOrdinalLabel - Returns a new, populated concrete instance
+// of TextFieldSpecLabel containing an integer value formatted
+// as an ordinal number.
+//
+// This method is designed to generate ordinal columns or
+// headings such as "1st", "2e" or "3." which may be justified
+// within a text field like any other text label. The formatted
+// ordinal number is followed by the text specified by input
+// parameter 'labelSuffix'.
+//
+// The language suffix rules, the feminine form and the
+// superscript option are controlled by input parameter
+// 'ordinalFmtSpec'.
+//
+// ----------------------------------------------------------------
+//
+// Input Parameters
+//
+//	value                      int
+//	   - The integer value which will be formatted as an ordinal
+//	     number.
+//
+//	     If 'value' is less than zero (0), an error will be
+//	     returned.
+//
+//
+//	ordinalFmtSpec             NumStrOrdinalFormatSpec
+//	   - The Ordinal Format Specification which controls the
+//	     language suffix rules, the feminine form and the
+//	     superscript option applied to the formatted ordinal
+//	     number.
+//
+//	     If this specification is NOP, or Not Operational, the
+//	     ordinal number will be formatted using English language
+//	     suffixes without superscript characters.
+//
+//	     If this specification is invalid, an error will be
+//	     returned.
+//
+//
+//	labelSuffix                string
+//	   - A string which will be appended to the end of the
+//	     formatted ordinal number. If this parameter is an empty
+//	     string, no suffix will be added.
+//
+//
+//	fieldLen                   int
+//	   - The length of the text field in which the ordinal number
+//	     label will be displayed. If 'fieldLen' is less than the
+//	     length of the ordinal number label string, it will be
+//	     automatically set equal to the label string length.
+//
+//	     To automatically set the value of 'fieldLen' to the length
+//	     of the ordinal number label, set this parameter to a value
+//	     of minus one (-1).
+//
+//	     If this parameter is submitted with a value less than
+//	     minus one (-1) or greater than 1-million (1,000,000), an
+//	     error will be returned.
+//
+//
+//	textJustification          TextJustify
+//	   - An enumeration which specifies the justification of the
+//	     ordinal number label string within the text field
+//	     specified by 'fieldLen'.
+//
+//	     Text justification can only be evaluated in the context of
+//	     a text label, field length and a Text Justification object
+//	     of type TextJustify. This is because text labels with a
+//	     field length equal to or less than the length of the text
+//	     label will never use text justification. In these cases,
+//	     text justification is completely ignored.
+//
+//	     If the field length is greater than the length of the text
+//	     label, text justification must be equal to one of these
+//	     three valid values:
+//	         TextJustify(0).Left()
+//	         TextJustify(0).Right()
+//	         TextJustify(0).Center()
+//
+//	     You can also use the abbreviated text justification
+//	     enumeration syntax as follows:
+//
+//	         TxtJustify.Left()
+//	         TxtJustify.Right()
+//	         TxtJustify.Center()
+//
+//
+//	errorPrefix                interface{}
+//	   - This object encapsulates error prefix text which is
+//	     included in all returned error messages. Usually, it
+//	     contains the name of the calling method or methods
+//	     listed as a method or function chain of execution.
+//
+//	     If no error prefix information is needed, set this
+//	     parameter to 'nil'.
+//
+//	     This empty interface must be convertible to one of the
+//	     following types:
+//
+//	     1. nil - A nil value is valid and generates an empty
+//	        collection of error prefix and error context
+//	        information.
+//
+//	     2. string - A string containing error prefix information.
+//
+//	     3. []string A one-dimensional slice of strings containing
+//	        error prefix information
+//
+//	     4. [][2]string A two-dimensional slice of strings
+//	        containing error prefix and error context information.
+//
+//	     5. ErrPrefixDto - An instance of ErrPrefixDto. Information
+//	        from this object will be copied for use in error and
+//	        informational messages.
+//
+//	     6. *ErrPrefixDto - A pointer to an instance of ErrPrefixDto.
+//	        Information from this object will be copied for use in
+//	        error and informational messages.
+//
+//	     7. IBasicErrorPrefix - An interface to a method generating
+//	        a two-dimensional slice of strings containing error
+//	        prefix and error context information.
+//
+//	     If parameter 'errorPrefix' is NOT convertible to one of
+//	     the valid types listed above, it will be considered
+//	     invalid and trigger the return of an error.
+//
+//	     Types ErrPrefixDto and IBasicErrorPrefix are included in
+//	     the 'errpref' software package,
+//	     "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// Return Values
+//
+//	TextFieldSpecLabel
+//	   - This method will return a new, populated concrete instance
+//	     of TextFieldSpecLabel containing the ordinal number
+//	     generated from the input parameters.
+//
+//
+//	error
+//	   - If this method completes successfully and no errors are
+//	     encountered this return value is set to 'nil'. Otherwise,
+//	     if errors are encountered, this return value will contain
+//	     an appropriate error message.
+//
+//	     If an error message is returned, the text value of input
+//	     parameter 'errorPrefix' will be inserted or prefixed at
+//	     the beginning of the error message.
+//
+// ------------------------------------------------------------------------
+//
+// Example Usage
+//
+//	Example 1:
+//	             value = 23
+//	      languageCode = "EN"
+//	       labelSuffix = ""
+//	          fieldLen = 6
+//	 textJustification = TextJustify(0).Right()
+//	            result = "  23rd"
+//
+//	Example 2:
+//	             value = 1
+//	      languageCode = "FR"
+//	       useFeminine = true
+//	       labelSuffix = ""
+//	          fieldLen = -1
+//	 textJustification = TextJustify(0).Left()
+//	            result = "1re"
+func (txtFieldLabel TextFieldSpecLabel) NewOrdinalLabel(
+	value int,
+	ordinalFmtSpec NumStrOrdinalFormatSpec,
+	labelSuffix string,
+	fieldLen int,
+	textJustification TextJustify,
+	errorPrefix interface{}) (
+	TextFieldSpecLabel,
+	error) {
+
+	if txtFieldLabel.lock == nil {
+		txtFieldLabel.lock = new(sync.Mutex)
+	}
+
+	txtFieldLabel.lock.Lock()
+
+	defer txtFieldLabel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	newTextLabel := TextFieldSpecLabel{}
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"TextFieldSpecLabel.NewOrdinalLabel()",
+		"")
+
+	if err != nil {
+		return newTextLabel, err
+	}
+
+	err = ordinalFmtSpec.IsValidInstanceError(
+		ePrefix.XCpy(
+			"ordinalFmtSpec"))
+
+	if err != nil {
+		return newTextLabel, err
+	}
+
+	if value < 0 {
+
+		err = fmt.Errorf("%v\n"+
+			"Error: Input parameter 'value' is invalid!\n"+
+			"Ordinal numbers cannot be negative.\n"+
+			"value = '%v'\n",
+			ePrefix.String(),
+			value)
+
+		return newTextLabel, err
+	}
+
+	languageCode := "EN"
+
+	if !ordinalFmtSpec.IsNOP() {
+		languageCode = ordinalFmtSpec.GetLanguageCode()
+	}
+
+	var ordinalStr string
+
+	ordinalStr,
+		err = new(numStrOrdinalQuark).formatOrdinal(
+		strconv.Itoa(value),
+		languageCode,
+		ordinalFmtSpec.UsesFeminine(),
+		ordinalFmtSpec.UsesSuperscript(),
+		ePrefix.XCpy(
+			"ordinalStr<-value"))
+
+	if err != nil {
+		return newTextLabel, err
+	}
+
+	err = new(textFieldSpecLabelNanobot).
+		setTextFieldLabel(
+			&newTextLabel,
+			[]rune(ordinalStr+labelSuffix),
+			fieldLen,
+			textJustification,
+			ePrefix)
+
+	return newTextLabel, err
+}
+
 // NewPtr - Returns a pointer to a new unpopulated instance of
 // TextFieldSpecLabel. All the member variables contained in
 // this new instance are set to their uninitialized or zero values.
@@ -1615,6 +1873,244 @@ func (txtFieldLabel TextFieldSpecLabel) NewPtrTextLabelRunes(
 	return &newTextLabel, err
 }
 
+// NewRomanNumeralLabel - Returns a new, populated concrete
+// instance of TextFieldSpecLabel containing an integer value
+// formatted as Roman numerals.
+//
+// This method is designed to generate outline numbers for
+// lists and headings such as "IV." or "xiv)". The formatted
+// Roman numerals are followed by the text specified by input
+// parameter 'labelSuffix'.
+//
+// The case of the Roman numerals and the vinculum, or
+// overline, option are controlled by input parameter
+// 'romanNumeralFmtSpec'.
+//
+// ----------------------------------------------------------------
+//
+// Input Parameters
+//
+//	value                      int
+//	   - The integer value which will be formatted as Roman
+//	     numerals.
+//
+//	     If 'value' is less than one (1), an error will be
+//	     returned. If the vinculum option is disabled, the
+//	     maximum value is 3,999. If the vinculum option is
+//	     enabled, the maximum value is 3,999,999.
+//
+//
+//	romanNumeralFmtSpec        NumStrRomanNumeralFormatSpec
+//	   - The Roman Numeral Format Specification which controls
+//	     the character case and the vinculum option applied to
+//	     the formatted Roman numerals.
+//
+//	     If this specification is NOP, or Not Operational, the
+//	     Roman numerals will be formatted as upper case
+//	     characters without the vinculum option.
+//
+//	     If this specification is invalid, an error will be
+//	     returned.
+//
+//
+//	labelSuffix                string
+//	   - A string which will be appended to the end of the
+//	     formatted Roman numerals. Typical values are ".", ")"
+//	     or ":". If this parameter is an empty string, no suffix
+//	     will be added.
+//
+//
+//	fieldLen                   int
+//	   - The length of the text field in which the Roman numeral
+//	     label will be displayed. If 'fieldLen' is less than the
+//	     length of the Roman numeral label string, it will be
+//	     automatically set equal to the label string length.
+//
+//	     To automatically set the value of 'fieldLen' to the length
+//	     of the Roman numeral label, set this parameter to a value
+//	     of minus one (-1).
+//
+//	     If this parameter is submitted with a value less than
+//	     minus one (-1) or greater than 1-million (1,000,000), an
+//	     error will be returned.
+//
+//
+//	textJustification          TextJustify
+//	   - An enumeration which specifies the justification of the
+//	     Roman numeral label string within the text field
+//	     specified by 'fieldLen'.
+//
+//	     Text justification can only be evaluated in the context of
+//	     a text label, field length and a Text Justification object
+//	     of type TextJustify. This is because text labels with a
+//	     field length equal to or less than the length of the text
+//	     label will never use text justification. In these cases,
+//	     text justification is completely ignored.
+//
+//	     If the field length is greater than the length of the text
+//	     label, text justification must be equal to one of these
+//	     three valid values:
+//	         TextJustify(0).Left()
+//	         TextJustify(0).Right()
+//	         TextJustify(0).Center()
+//
+//	     You can also use the abbreviated text justification
+//	     enumeration syntax as follows:
+//
+//	         TxtJustify.Left()
+//	         TxtJustify.Right()
+//	         TxtJustify.Center()
+//
+//
+//	errorPrefix                interface{}
+//	   - This object encapsulates error prefix text which is
+//	     included in all returned error messages. Usually, it
+//	     contains the name of the calling method or methods
+//	     listed as a method or function chain of execution.
+//
+//	     If no error prefix information is needed, set this
+//	     parameter to 'nil'.
+//
+//	     This empty interface must be convertible to one of the
+//	     following types:
+//
+//	     1. nil - A nil value is valid and generates an empty
+//	        collection of error prefix and error context
+//	        information.
+//
+//	     2. string - A string containing error prefix information.
+//
+//	     3. []string A one-dimensional slice of strings containing
+//	        error prefix information
+//
+//	     4. [][2]string A two-dimensional slice of strings
+//	        containing error prefix and error context information.
+//
+//	     5. ErrPrefixDto - An instance of ErrPrefixDto. Information
+//	        from this object will be copied for use in error and
+//	        informational messages.
+//
+//	     6. *ErrPrefixDto - A pointer to an instance of ErrPrefixDto.
+//	        Information from this object will be copied for use in
+//	        error and informational messages.
+//
+//	     7. IBasicErrorPrefix - An interface to a method generating
+//	        a two-dimensional slice of strings containing error
+//	        prefix and error context information.
+//
+//	     If parameter 'errorPrefix' is NOT convertible to one of
+//	     the valid types listed above, it will be considered
+//	     invalid and trigger the return of an error.
+//
+//	     Types ErrPrefixDto and IBasicErrorPrefix are included in
+//	     the 'errpref' software package,
+//	     "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// Return Values
+//
+//	TextFieldSpecLabel
+//	   - This method will return a new, populated concrete instance
+//	     of TextFieldSpecLabel containing the Roman numerals
+//	     generated from the input parameters.
+//
+//
+//	error
+//	   - If this method completes successfully and no errors are
+//	     encountered this return value is set to 'nil'. Otherwise,
+//	     if errors are encountered, this return value will contain
+//	     an appropriate error message.
+//
+//	     If an error message is returned, the text value of input
+//	     parameter 'errorPrefix' will be inserted or prefixed at
+//	     the beginning of the error message.
+//
+// ------------------------------------------------------------------------
+//
+// Example Usage
+//
+//	Example 1:
+//	             value = 14
+//	      useLowerCase = false
+//	       labelSuffix = "."
+//	          fieldLen = 6
+//	 textJustification = TextJustify(0).Right()
+//	            result = "  XIV."
+//
+//	Example 2:
+//	             value = 3
+//	      useLowerCase = true
+//	       labelSuffix = ")"
+//	          fieldLen = -1
+//	 textJustification = TextJustify(0).Left()
+//	            result = "iii)"
+func (txtFieldLabel TextFieldSpecLabel) NewRomanNumeralLabel(
+	value int,
+	romanNumeralFmtSpec NumStrRomanNumeralFormatSpec,
+	labelSuffix string,
+	fieldLen int,
+	textJustification TextJustify,
+	errorPrefix interface{}) (
+	TextFieldSpecLabel,
+	error) {
+
+	if txtFieldLabel.lock == nil {
+		txtFieldLabel.lock = new(sync.Mutex)
+	}
+
+	txtFieldLabel.lock.Lock()
+
+	defer txtFieldLabel.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+	var err error
+
+	newTextLabel := TextFieldSpecLabel{}
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"TextFieldSpecLabel.NewRomanNumeralLabel()",
+		"")
+
+	if err != nil {
+		return newTextLabel, err
+	}
+
+	err = romanNumeralFmtSpec.IsValidInstanceError(
+		ePrefix.XCpy(
+			"romanNumeralFmtSpec"))
+
+	if err != nil {
+		return newTextLabel, err
+	}
+
+	var romanNumeralStr string
+
+	romanNumeralStr,
+		err = new(numStrRomanNumeralQuark).formatRomanNumeral(
+		int64(value),
+		romanNumeralFmtSpec.UsesLowerCase(),
+		romanNumeralFmtSpec.UsesVinculum(),
+		ePrefix.XCpy(
+			"romanNumeralStr<-value"))
+
+	if err != nil {
+		return newTextLabel, err
+	}
+
+	err = new(textFieldSpecLabelNanobot).
+		setTextFieldLabel(
+			&newTextLabel,
+			[]rune(romanNumeralStr+labelSuffix),
+			fieldLen,
+			textJustification,
+			ePrefix)
+
+	return newTextLabel, err
+}
+
 // NewTextLabel - Returns a new, populated concrete instance of
 // TextFieldSpecLabel. This type encapsulates a string which
 // is formatted as a text label.
@@ -2002,6 +2002,454 @@ func (stdLine *TextLineSpecStandardLine) AddTextFieldLabel(
 	return indexId, err
 }
 
+// AddTextFieldOrdinal - This method will append a Label text
+// field object containing an integer value formatted as an
+// ordinal number to the end of the current array of text field
+// objects maintained by the current instance of
+// TextLineSpecStandardLine.
+//
+// This method is designed to generate ordinal columns such as
+// "1st", "2e" or "3." which may be justified within the text
+// field like any other label. The formatted ordinal number is
+// followed by the text specified by input parameter
+// 'labelSuffix'.
+//
+// This operation will create a new TextFieldSpecLabel object
+// using method TextFieldSpecLabel.NewOrdinalLabel(). This
+// TextFieldSpecLabel object will then be added to the text field
+// objects collection for the current TextLineSpecStandardLine
+// instance.
+//
+// If the method completes successfully, the internal array index
+// of the new Text Field Label Object will be returned to the
+// calling function.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// Adding TextFields without setting the number of standard line
+// repetitions, means that no text will be generated. The number
+// of standard line repetitions must be set to a number greater
+// than zero. See methods:
+//
+//	TextLineSpecStandardLine.GetNumOfStdLines()
+//	TextLineSpecStandardLine.SetNumOfStdLines()
+//
+// Instances of TextLineSpecStandardLine created with one of the
+// 'New' methods are automatically defaulted with the Number of
+// Standard Lines set to a value of one (1).
+//
+// ----------------------------------------------------------------
+//
+// Input Parameters
+//
+//	value                      int
+//	   - The integer value which will be formatted as an ordinal
+//	     number.
+//
+//	     If 'value' is less than zero (0), an error will be
+//	     returned.
+//
+//
+//	ordinalFmtSpec             NumStrOrdinalFormatSpec
+//	   - The Ordinal Format Specification which controls the
+//	     language suffix rules, the feminine form and the
+//	     superscript option applied to the formatted ordinal
+//	     number.
+//
+//	     If this specification is NOP, or Not Operational, the
+//	     ordinal number will be formatted using English language
+//	     suffixes without superscript characters.
+//
+//
+//	labelSuffix                string
+//	   - A string which will be appended to the end of the
+//	     formatted ordinal number. If this parameter is an empty
+//	     string, no suffix will be added.
+//
+//
+//	fieldLen                   int
+//	   - The length of the text field in which the ordinal number
+//	     label will be displayed. If 'fieldLen' is less than the
+//	     length of the ordinal number label, it will be
+//	     automatically set equal to the label length.
+//
+//	     To automatically set the value of 'fieldLen' to the length
+//	     of the ordinal number label, set this parameter to a value
+//	     of minus one (-1).
+//
+//	     If this parameter is submitted with a value less than
+//	     minus one (-1) or greater than 1-million (1,000,000), an
+//	     error will be returned.
+//
+//
+//	textJustification          TextJustify
+//	   - An enumeration which specifies the justification of the
+//	     ordinal number label within the field specified by
+//	     'fieldLen'.
+//
+//	     If the field length is greater than the length of the
+//	     label, text justification must be equal to one of these
+//	     three valid values:
+//	         TextJustify(0).Left()
+//	         TextJustify(0).Right()
+//	         TextJustify(0).Center()
+//
+//	     You can also use the abbreviated text justification
+//	     enumeration syntax as follows:
+//
+//	         TxtJustify.Left()
+//	         TxtJustify.Right()
+//	         TxtJustify.Center()
+//
+//
+//	errorPrefix                interface{}
+//	   - This object encapsulates error prefix text which is
+//	     included in all returned error messages. Usually, it
+//	     contains the name of the calling method or methods
+//	     listed as a method or function chain of execution.
+//
+//	     If no error prefix information is needed, set this
+//	     parameter to 'nil'.
+//
+//	     This empty interface must be convertible to one of the
+//	     following types:
+//
+//	     1. nil - A nil value is valid and generates an empty
+//	        collection of error prefix and error context
+//	        information.
+//
+//	     2. string - A string containing error prefix information.
+//
+//	     3. []string A one-dimensional slice of strings containing
+//	        error prefix information
+//
+//	     4. [][2]string A two-dimensional slice of strings
+//	        containing error prefix and error context information.
+//
+//	     5. ErrPrefixDto - An instance of ErrPrefixDto. Information
+//	        from this object will be copied for use in error and
+//	        informational messages.
+//
+//	     6. *ErrPrefixDto - A pointer to an instance of ErrPrefixDto.
+//	        Information from this object will be copied for use in
+//	        error and informational messages.
+//
+//	     7. IBasicErrorPrefix - An interface to a method generating
+//	        a two-dimensional slice of strings containing error
+//	        prefix and error context information.
+//
+//	     If parameter 'errorPrefix' is NOT convertible to one of
+//	     the valid types listed above, it will be considered
+//	     invalid and trigger the return of an error.
+//
+//	     Types ErrPrefixDto and IBasicErrorPrefix are included in
+//	     the 'errpref' software package,
+//	     "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// Return Values
+//
+//	indexId                    int
+//	   - If this method completes successfully, the internal array
+//	     index of the new text label object will be returned as an
+//	     integer value.
+//
+//	     In the event of an error, 'indexId' will be set to a value
+//	     of minus one (-1).
+//
+//
+//	err                        error
+//	   - If this method completes successfully and no errors are
+//	     encountered, this return value is set to 'nil'. Otherwise,
+//	     if errors are encountered, this return value will contain
+//	     an appropriate error message.
+//
+//	     If an error message is returned, the text value of input
+//	     parameter 'errorPrefix' will be inserted or prefixed at
+//	     the beginning of the error message.
+func (stdLine *TextLineSpecStandardLine) AddTextFieldOrdinal(
+	value int,
+	ordinalFmtSpec NumStrOrdinalFormatSpec,
+	labelSuffix string,
+	fieldLen int,
+	textJustification TextJustify,
+	errorPrefix interface{}) (
+	indexId int,
+	err error) {
+
+	if stdLine.lock == nil {
+		stdLine.lock = new(sync.Mutex)
+	}
+
+	stdLine.lock.Lock()
+
+	defer stdLine.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	indexId = -1
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"TextLineSpecStandardLine.AddTextFieldOrdinal()",
+		"")
+
+	if err != nil {
+		return indexId, err
+	}
+
+	var newLabelField TextFieldSpecLabel
+
+	newLabelField,
+		err = TextFieldSpecLabel{}.NewOrdinalLabel(
+		value,
+		ordinalFmtSpec,
+		labelSuffix,
+		fieldLen,
+		textJustification,
+		ePrefix.XCpy(
+			"newLabelField"))
+
+	if err != nil {
+		return indexId, err
+	}
+
+	stdLine.textFields = append(stdLine.textFields,
+		&newLabelField)
+
+	indexId = len(stdLine.textFields) - 1
+
+	return indexId, err
+}
+
+// AddTextFieldRomanNumeral - This method will append a Label text
+// field object containing an integer value formatted as Roman
+// numerals to the end of the current array of text field objects
+// maintained by the current instance of TextLineSpecStandardLine.
+//
+// This method is designed to generate outline numbers for lists
+// and headings such as "IV." or "xiv)". The formatted Roman
+// numerals are followed by the text specified by input parameter
+// 'labelSuffix'.
+//
+// This operation will create a new TextFieldSpecLabel object
+// using method TextFieldSpecLabel.NewRomanNumeralLabel(). This
+// TextFieldSpecLabel object will then be added to the text field
+// objects collection for the current TextLineSpecStandardLine
+// instance.
+//
+// If the method completes successfully, the internal array index
+// of the new Text Field Label Object will be returned to the
+// calling function.
+//
+// ----------------------------------------------------------------
+//
+// # IMPORTANT
+//
+// Adding TextFields without setting the number of standard line
+// repetitions, means that no text will be generated. The number
+// of standard line repetitions must be set to a number greater
+// than zero. See methods:
+//
+//	TextLineSpecStandardLine.GetNumOfStdLines()
+//	TextLineSpecStandardLine.SetNumOfStdLines()
+//
+// Instances of TextLineSpecStandardLine created with one of the
+// 'New' methods are automatically defaulted with the Number of
+// Standard Lines set to a value of one (1).
+//
+// ----------------------------------------------------------------
+//
+// Input Parameters
+//
+//	value                      int
+//	   - The integer value which will be formatted as Roman
+//	     numerals.
+//
+//	     If 'value' is less than one (1), an error will be
+//	     returned. If the vinculum option is disabled, the
+//	     maximum value is 3,999. If the vinculum option is
+//	     enabled, the maximum value is 3,999,999.
+//
+//
+//	romanNumeralFmtSpec        NumStrRomanNumeralFormatSpec
+//	   - The Roman Numeral Format Specification which controls
+//	     the character case and the vinculum option applied to
+//	     the formatted Roman numerals.
+//
+//	     If this specification is NOP, or Not Operational, the
+//	     Roman numerals will be formatted as upper case
+//	     characters without the vinculum option.
+//
+//
+//	labelSuffix                string
+//	   - A string which will be appended to the end of the
+//	     formatted Roman numerals. Typical values are ".", ")"
+//	     or ":". If this parameter is an empty string, no suffix
+//	     will be added.
+//
+//
+//	fieldLen                   int
+//	   - The length of the text field in which the Roman numeral
+//	     label will be displayed. If 'fieldLen' is less than the
+//	     length of the Roman numeral label, it will be
+//	     automatically set equal to the label length.
+//
+//	     To automatically set the value of 'fieldLen' to the length
+//	     of the Roman numeral label, set this parameter to a value
+//	     of minus one (-1).
+//
+//	     If this parameter is submitted with a value less than
+//	     minus one (-1) or greater than 1-million (1,000,000), an
+//	     error will be returned.
+//
+//
+//	textJustification          TextJustify
+//	   - An enumeration which specifies the justification of the
+//	     Roman numeral label within the field specified by
+//	     'fieldLen'.
+//
+//	     If the field length is greater than the length of the
+//	     label, text justification must be equal to one of these
+//	     three valid values:
+//	         TextJustify(0).Left()
+//	         TextJustify(0).Right()
+//	         TextJustify(0).Center()
+//
+//	     You can also use the abbreviated text justification
+//	     enumeration syntax as follows:
+//
+//	         TxtJustify.Left()
+//	         TxtJustify.Right()
+//	         TxtJustify.Center()
+//
+//
+//	errorPrefix                interface{}
+//	   - This object encapsulates error prefix text which is
+//	     included in all returned error messages. Usually, it
+//	     contains the name of the calling method or methods
+//	     listed as a method or function chain of execution.
+//
+//	     If no error prefix information is needed, set this
+//	     parameter to 'nil'.
+//
+//	     This empty interface must be convertible to one of the
+//	     following types:
+//
+//	     1. nil - A nil value is valid and generates an empty
+//	        collection of error prefix and error context
+//	        information.
+//
+//	     2. string - A string containing error prefix information.
+//
+//	     3. []string A one-dimensional slice of strings containing
+//	        error prefix information
+//
+//	     4. [][2]string A two-dimensional slice of strings
+//	        containing error prefix and error context information.
+//
+//	     5. ErrPrefixDto - An instance of ErrPrefixDto. Information
+//	        from this object will be copied for use in error and
+//	        informational messages.
+//
+//	     6. *ErrPrefixDto - A pointer to an instance of ErrPrefixDto.
+//	        Information from this object will be copied for use in
+//	        error and informational messages.
+//
+//	     7. IBasicErrorPrefix - An interface to a method generating
+//	        a two-dimensional slice of strings containing error
+//	        prefix and error context information.
+//
+//	     If parameter 'errorPrefix' is NOT convertible to one of
+//	     the valid types listed above, it will be considered
+//	     invalid and trigger the return of an error.
+//
+//	     Types ErrPrefixDto and IBasicErrorPrefix are included in
+//	     the 'errpref' software package,
+//	     "github.com/MikeAustin71/errpref".
+//
+// ----------------------------------------------------------------
+//
+// Return Values
+//
+//	indexId                    int
+//	   - If this method completes successfully, the internal array
+//	     index of the new text label object will be returned as an
+//	     integer value.
+//
+//	     In the event of an error, 'indexId' will be set to a value
+//	     of minus one (-1).
+//
+//
+//	err                        error
+//	   - If this method completes successfully and no errors are
+//	     encountered, this return value is set to 'nil'. Otherwise,
+//	     if errors are encountered, this return value will contain
+//	     an appropriate error message.
+//
+//	     If an error message is returned, the text value of input
+//	     parameter 'errorPrefix' will be inserted or prefixed at
+//	     the beginning of the error message.
+func (stdLine *TextLineSpecStandardLine) AddTextFieldRomanNumeral(
+	value int,
+	romanNumeralFmtSpec NumStrRomanNumeralFormatSpec,
+	labelSuffix string,
+	fieldLen int,
+	textJustification TextJustify,
+	errorPrefix interface{}) (
+	indexId int,
+	err error) {
+
+	if stdLine.lock == nil {
+		stdLine.lock = new(sync.Mutex)
+	}
+
+	stdLine.lock.Lock()
+
+	defer stdLine.lock.Unlock()
+
+	var ePrefix *ePref.ErrPrefixDto
+
+	indexId = -1
+
+	ePrefix,
+		err = ePref.ErrPrefixDto{}.NewIEmpty(
+		errorPrefix,
+		"TextLineSpecStandardLine.AddTextFieldRomanNumeral()",
+		"")
+
+	if err != nil {
+		return indexId, err
+	}
+
+	var newLabelField TextFieldSpecLabel
+
+	newLabelField,
+		err = TextFieldSpecLabel{}.NewRomanNumeralLabel(
+		value,
+		romanNumeralFmtSpec,
+		labelSuffix,
+		fieldLen,
+		textJustification,
+		ePrefix.XCpy(
+			"newLabelField"))
+
+	if err != nil {
+		return indexId, err
+	}
+
+	stdLine.textFields = append(stdLine.textFields,
+		&newLabelField)
+
+	indexId = len(stdLine.textFields) - 1
+
+	return indexId, err
+}
+
 // AddTextFieldSpacer - This method will append a Spacer text field
 // object to the end of the current array of text field objects
 // maintained by the current instance of TextLineSpecStandardLine.
@@ -0,0 +1,539 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"math/big"
+	"testing"
+)
+
+func TestBigDecimal_Arithmetic_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestBigDecimal_Arithmetic_000100",
+		"")
+
+	// operand01, operand02, sum, difference, product
+	testData := [][5]string{
+		{"123.45", "-0.125", "123.325", "123.575", "-15.43125"},
+		{"-5", "-7.5", "-12.5", "2.5", "37.5"},
+		{"10.25", "10.25", "20.50", "0.00", "105.0625"},
+		{"0.001", "1000", "1000.001", "-999.999", "1.000"},
+		{"99999999999999999999", "0.00000000000000000001",
+			"99999999999999999999.00000000000000000001",
+			"99999999999999999998.99999999999999999999",
+			"0.99999999999999999999"},
+	}
+
+	var err error
+	var operand01, operand02, result BigDecimal
+
+	for i := 0; i < len(testData); i++ {
+
+		operand01,
+			err = new(BigDecimal).NewFromNumStr(
+			testData[i][0],
+			ePrefix.XCpy(
+				"operand01"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		operand02,
+			err = new(BigDecimal).NewFromNumStr(
+			testData[i][1],
+			ePrefix.XCpy(
+				"operand02"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		result,
+			err = operand01.Add(
+			&operand02,
+			ePrefix.XCpy(
+				"sum"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if result.String() != testData[i][2] {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Add() result is invalid!\n"+
+				"Expected Sum = '%v'\n"+
+				"  Actual Sum = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i][2],
+				result.String())
+
+			return
+		}
+
+		result,
+			err = operand01.Subtract(
+			&operand02,
+			ePrefix.XCpy(
+				"difference"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if result.String() != testData[i][3] {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Subtract() result is invalid!\n"+
+				"Expected Difference = '%v'\n"+
+				"  Actual Difference = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i][3],
+				result.String())
+
+			return
+		}
+
+		result,
+			err = operand01.Multiply(
+			&operand02,
+			ePrefix.XCpy(
+				"product"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if result.String() != testData[i][4] {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Multiply() result is invalid!\n"+
+				"Expected Product = '%v'\n"+
+				"  Actual Product = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i][4],
+				result.String())
+
+			return
+		}
+	}
+}
+
+func TestBigDecimal_Divide_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestBigDecimal_Divide_000100",
+		"")
+
+	bigDec := BigDecimal{}
+
+	dividend := bigDec.NewFromInt64(2, 0)
+
+	divisor := bigDec.NewFromInt64(3, 0)
+
+	quotient,
+		err := dividend.Divide(
+		&divisor,
+		10,
+		NumRoundType.HalfAwayFromZero(),
+		ePrefix.XCpy(
+			"2/3"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	expectedStr := "0.6666666667"
+
+	if quotient.String() != expectedStr {
+
+		t.Errorf("%v\n"+
+			"Error: Divide() result is invalid!\n"+
+			"Expected Quotient = '%v'\n"+
+			"  Actual Quotient = '%v'\n",
+			ePrefix.String(),
+			expectedStr,
+			quotient.String())
+
+		return
+	}
+
+	divisor = bigDec.NewFromInt64(0, -2)
+
+	_,
+		err = dividend.Divide(
+		&divisor,
+		2,
+		NumRoundType.HalfAwayFromZero(),
+		ePrefix.XCpy(
+			"2/0"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from Divide()\n"+
+			"because the divisor is zero.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	dividend = bigDec.NewFromInt64(-1, 0)
+
+	divisor = bigDec.NewFromInt64(8, 0)
+
+	quotient,
+		err = dividend.Divide(
+		&divisor,
+		2,
+		NumRoundType.HalfToEven(),
+		ePrefix.XCpy(
+			"-1/8"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	expectedStr = "-0.12"
+
+	if quotient.String() != expectedStr {
+
+		t.Errorf("%v\n"+
+			"Error: Divide() HalfToEven result is invalid!\n"+
+			"Expected Quotient = '%v'\n"+
+			"  Actual Quotient = '%v'\n",
+			ePrefix.String(),
+			expectedStr,
+			quotient.String())
+
+		return
+	}
+}
+
+func TestBigDecimal_Round_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestBigDecimal_Round_000100",
+		"")
+
+	type roundTest struct {
+		numStr       string
+		roundingType NumberRoundingType
+		expected     string
+	}
+
+	testData := []roundTest{
+		{"2.345", NumRoundType.HalfUpWithNegNums(), "2.35"},
+		{"-2.345", NumRoundType.HalfUpWithNegNums(), "-2.34"},
+		{"2.345", NumRoundType.HalfDownWithNegNums(), "2.34"},
+		{"-2.345", NumRoundType.HalfDownWithNegNums(), "-2.35"},
+		{"-2.345", NumRoundType.HalfAwayFromZero(), "-2.35"},
+		{"-2.345", NumRoundType.HalfTowardsZero(), "-2.34"},
+		{"2.3451", NumRoundType.HalfTowardsZero(), "2.35"},
+		{"2.345", NumRoundType.HalfToEven(), "2.34"},
+		{"2.355", NumRoundType.HalfToEven(), "2.36"},
+		{"2.345", NumRoundType.HalfToOdd(), "2.35"},
+		{"2.355", NumRoundType.HalfToOdd(), "2.35"},
+		{"2.341", NumRoundType.Ceiling(), "2.35"},
+		{"-2.349", NumRoundType.Ceiling(), "-2.34"},
+		{"2.349", NumRoundType.Floor(), "2.34"},
+		{"-2.341", NumRoundType.Floor(), "-2.35"},
+		{"-2.349", NumRoundType.Truncate(), "-2.34"},
+		{"2.349", NumRoundType.NoRounding(), "2.349"},
+		{"2.3", NumRoundType.HalfAwayFromZero(), "2.3"},
+	}
+
+	var err error
+	var bigDec BigDecimal
+
+	for i := 0; i < len(testData); i++ {
+
+		bigDec,
+			err = new(BigDecimal).NewFromNumStr(
+			testData[i].numStr,
+			ePrefix.XCpy(
+				"bigDec"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		err = bigDec.Round(
+			testData[i].roundingType,
+			2,
+			ePrefix.XCpy(
+				testData[i].roundingType.String()))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if bigDec.String() != testData[i].expected {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Round() result is invalid!\n"+
+				"Number String  = '%v'\n"+
+				"Rounding Type  = '%v'\n"+
+				"Expected Value = '%v'\n"+
+				"  Actual Value = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].numStr,
+				testData[i].roundingType.String(),
+				testData[i].expected,
+				bigDec.String())
+
+			return
+		}
+	}
+}
+
+func TestBigDecimal_Conversion_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestBigDecimal_Conversion_000100",
+		"")
+
+	bigDec,
+		err := new(BigDecimal).NewFromBigRat(
+		big.NewRat(-1, 3),
+		4,
+		NumRoundType.HalfAwayFromZero(),
+		ePrefix.XCpy(
+			"-1/3"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	expectedStr := "-0.3333"
+
+	if bigDec.String() != expectedStr {
+
+		t.Errorf("%v\n"+
+			"Error: NewFromBigRat() result is invalid!\n"+
+			"Expected Value = '%v'\n"+
+			"  Actual Value = '%v'\n",
+			ePrefix.String(),
+			expectedStr,
+			bigDec.String())
+
+		return
+	}
+
+	bigDec,
+		err = new(BigDecimal).NewFromBigInt(
+		big.NewInt(1250000),
+		-5,
+		ePrefix.XCpy(
+			"1250000 x 10^-5"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	expectedBigDec := new(BigDecimal).NewFromInt64(125, -1)
+
+	if !bigDec.Equal(&expectedBigDec) {
+
+		t.Errorf("%v\n"+
+			"Error: Expected bigDec == expectedBigDec.\n"+
+			"bigDec         = '%v'\n"+
+			"expectedBigDec = '%v'\n",
+			ePrefix.String(),
+			bigDec.String(),
+			expectedBigDec.String())
+
+		return
+	}
+
+	bigDec.Normalize()
+
+	exponent := bigDec.GetExponent()
+
+	if exponent.String() != "-1" {
+
+		t.Errorf("%v\n"+
+			"Error: Normalize() exponent is invalid!\n"+
+			"Expected Exponent = '-1'\n"+
+			"  Actual Exponent = '%v'\n",
+			ePrefix.String(),
+			exponent.String())
+
+		return
+	}
+
+	var numStrKernel NumberStrKernel
+
+	numStrKernel,
+		err = bigDec.GetNumberStrKernel(
+		ePrefix.XCpy(
+			"numStrKernel<-bigDec"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var nativeNumStr string
+
+	nativeNumStr,
+		_,
+		err = numStrKernel.FmtNumStrNative(
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	expectedStr = "12.5"
+
+	if nativeNumStr != expectedStr {
+
+		t.Errorf("%v\n"+
+			"Error: GetNumberStrKernel() result is invalid!\n"+
+			"Expected Value = '%v'\n"+
+			"  Actual Value = '%v'\n",
+			ePrefix.String(),
+			expectedStr,
+			nativeNumStr)
+
+		return
+	}
+
+	bigDec02,
+		err := new(BigDecimal).NewFromNumStrKernel(
+		&numStrKernel,
+		ePrefix.XCpy(
+			"bigDec02<-numStrKernel"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	if bigDec02.Compare(&bigDec) != 0 {
+
+		t.Errorf("%v\n"+
+			"Error: Expected bigDec02 == bigDec.\n"+
+			"bigDec02 = '%v'\n"+
+			"bigDec   = '%v'\n",
+			ePrefix.String(),
+			bigDec02.String(),
+			bigDec.String())
+
+		return
+	}
+
+	if bigDec.GetBigRat().Cmp(big.NewRat(25, 2)) != 0 {
+
+		t.Errorf("%v\n"+
+			"Error: GetBigRat() result is invalid!\n"+
+			"Expected Value = '25/2'\n"+
+			"  Actual Value = '%v'\n",
+			ePrefix.String(),
+			bigDec.GetBigRat().String())
+
+		return
+	}
+}
+
+func TestBigDecimal_NewFromNumStr_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestBigDecimal_NewFromNumStr_000100",
+		"")
+
+	// nativeNumStr, expected String(), expected exponent
+	testData := [][3]string{
+		{"123.4560", "123.4560", "-4"},
+		{"-0.0005", "-0.0005", "-4"},
+		{"1000000", "1000000", "0"},
+		{"5.000", "5.000", "-3"},
+		{"-12.50", "-12.50", "-2"},
+		{"0.00", "0.00", "-2"},
+	}
+
+	var err error
+	var bigDec BigDecimal
+	var exponent BigIntNum
+
+	for i := 0; i < len(testData); i++ {
+
+		bigDec,
+			err = new(BigDecimal).NewFromNumStr(
+			testData[i][0],
+			ePrefix.XCpy(
+				"bigDec"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if bigDec.String() != testData[i][1] {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: NewFromNumStr() did not preserve scale!\n"+
+				"Expected Value = '%v'\n"+
+				"  Actual Value = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i][1],
+				bigDec.String())
+
+			return
+		}
+
+		exponent = bigDec.GetExponent()
+
+		if exponent.String() != testData[i][2] {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: NewFromNumStr() exponent is invalid!\n"+
+				"Expected Exponent = '%v'\n"+
+				"  Actual Exponent = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i][2],
+				exponent.String())
+
+			return
+		}
+	}
+}
@@ -51,3 +51,636 @@ func TestMathBigRatHelper_RatToBigFloat_000100(t *testing.T) {
 
 	return
 }
+
+func TestMathBigRatHelper_MixedFraction_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestMathBigRatHelper_MixedFraction_000100()",
+		"")
+
+	type mixedFractionTest struct {
+		numerator          int64
+		denominator        int64
+		useVulgarFractions bool
+		expectedStr        string
+	}
+
+	testData := []mixedFractionTest{
+		{13, 4, false, "3 1/4"},
+		{13, 4, true, "3¼"},
+		{-1, 3, false, "-1/3"},
+		{-1, 3, true, "-⅓"},
+		{22, 7, false, "3 1/7"},
+		{22, 7, true, "3⅐"},
+		{5, 1, true, "5"},
+		{0, 1, false, "0"},
+		{7, 11, true, "7/11"},
+		{-40, 11, true, "-3 7/11"},
+	}
+
+	mathRatHelper := MathBigRatHelper{}
+
+	var err error
+	var actualStr string
+	var parsedRat *big.Rat
+
+	for i := 0; i < len(testData); i++ {
+
+		bigRatNum := big.NewRat(
+			testData[i].numerator,
+			testData[i].denominator)
+
+		actualStr,
+			err = mathRatHelper.BigRatToMixedFraction(
+			bigRatNum,
+			testData[i].useVulgarFractions,
+			ePrefix.XCpy(
+				"bigRatNum"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if actualStr != testData[i].expectedStr {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: actualStr != expectedStr\n"+
+				"bigRatNum   = '%v'\n"+
+				"actualStr   = '%v'\n"+
+				"expectedStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				bigRatNum.String(),
+				actualStr,
+				testData[i].expectedStr)
+
+			return
+		}
+
+		parsedRat,
+			err = mathRatHelper.MixedFractionToBigRat(
+			actualStr,
+			ePrefix.XCpy(
+				"actualStr"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if parsedRat.Cmp(bigRatNum) != 0 {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: parsedRat != bigRatNum\n"+
+				"mixedFractionStr = '%v'\n"+
+				"parsedRat        = '%v'\n"+
+				"bigRatNum        = '%v'\n",
+				ePrefix.String(),
+				i,
+				actualStr,
+				parsedRat.String(),
+				bigRatNum.String())
+
+			return
+		}
+	}
+
+	parsedRat,
+		err = mathRatHelper.MixedFractionToBigRat(
+		" +2 3⁄4 ",
+		ePrefix.XCpy(
+			"fraction slash"))
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			ePrefix.String(),
+			err.Error())
+		return
+	}
+
+	if parsedRat.Cmp(big.NewRat(11, 4)) != 0 {
+
+		t.Errorf("%v\n"+
+			"Error: Expected parsedRat = 11/4\n"+
+			"Instead, parsedRat = '%v'\n",
+			ePrefix.String(),
+			parsedRat.String())
+
+		return
+	}
+
+	invalidStrs := []string{
+		"",
+		"3 5/4",
+		"1/0",
+		"3 1/4 5",
+		"a/4",
+		"3 4",
+	}
+
+	for i := 0; i < len(invalidStrs); i++ {
+
+		_,
+			err = mathRatHelper.MixedFractionToBigRat(
+			invalidStrs[i],
+			ePrefix.XCpy(
+				"invalidStrs"))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from\n"+
+				"MixedFractionToBigRat() because the input\n"+
+				"string '%v' is invalid.\n"+
+				"However, NO ERROR WAS RETURNED!\n",
+				ePrefix.String(),
+				i,
+				invalidStrs[i])
+
+			return
+		}
+	}
+}
+
+func TestMathBigRatHelper_RepeatingDecimal_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestMathBigRatHelper_RepeatingDecimal_000100()",
+		"")
+
+	type repeatingDecimalTest struct {
+		numerator   int64
+		denominator int64
+		useOverline bool
+		expectedStr string
+	}
+
+	testData := []repeatingDecimalTest{
+		{1, 3, false, "0.(3)"},
+		{1, 3, true, "0.3\u0305"},
+		{1, 7, false, "0.(142857)"},
+		{1, 7, true, "0.1\u03054\u03052\u03058\u03055\u03057\u0305"},
+		{1, 6, false, "0.1(6)"},
+		{1, 6, true, "0.16\u0305"},
+		{1, 4, false, "0.25"},
+		{-22, 7, false, "-3.(142857)"},
+		{5, 1, false, "5"},
+		{1, 12, false, "0.08(3)"},
+		{-1, 81, false, "-0.(012345679)"},
+	}
+
+	mathRatHelper := MathBigRatHelper{}
+
+	var err error
+	var actualStr string
+	var parsedRat *big.Rat
+
+	for i := 0; i < len(testData); i++ {
+
+		bigRatNum := big.NewRat(
+			testData[i].numerator,
+			testData[i].denominator)
+
+		actualStr,
+			err = mathRatHelper.BigRatToRepeatingDecimal(
+			bigRatNum,
+			testData[i].useOverline,
+			100,
+			ePrefix.XCpy(
+				"bigRatNum"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if actualStr != testData[i].expectedStr {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: actualStr != expectedStr\n"+
+				"bigRatNum   = '%v'\n"+
+				"actualStr   = '%v'\n"+
+				"expectedStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				bigRatNum.String(),
+				actualStr,
+				testData[i].expectedStr)
+
+			return
+		}
+
+		parsedRat,
+			err = mathRatHelper.RepeatingDecimalToBigRat(
+			actualStr,
+			ePrefix.XCpy(
+				"actualStr"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if parsedRat.Cmp(bigRatNum) != 0 {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: parsedRat != bigRatNum\n"+
+				"repeatingDecimalStr = '%v'\n"+
+				"parsedRat           = '%v'\n"+
+				"bigRatNum           = '%v'\n",
+				ePrefix.String(),
+				i,
+				actualStr,
+				parsedRat.String(),
+				bigRatNum.String())
+
+			return
+		}
+	}
+
+	_,
+		err = mathRatHelper.BigRatToRepeatingDecimal(
+		big.NewRat(1, 97),
+		false,
+		20,
+		ePrefix.XCpy(
+			"1/97 maxFractionalDigits=20"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from\n"+
+			"BigRatToRepeatingDecimal() because the repetend\n"+
+			"of 1/97 exceeds 20 digits.\n"+
+			"However, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	invalidStrs := []string{
+		"",
+		"0.(3",
+		"0.()",
+		"0.(3)4",
+		"1.2\u03053",
+		"12a",
+		"1.2.3",
+	}
+
+	for i := 0; i < len(invalidStrs); i++ {
+
+		_,
+			err = mathRatHelper.RepeatingDecimalToBigRat(
+			invalidStrs[i],
+			ePrefix.XCpy(
+				"invalidStrs"))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from\n"+
+				"RepeatingDecimalToBigRat() because the input\n"+
+				"string '%v' is invalid.\n"+
+				"However, NO ERROR WAS RETURNED!\n",
+				ePrefix.String(),
+				i,
+				invalidStrs[i])
+
+			return
+		}
+	}
+}
+
+func TestMathBigRatHelper_ContinuedFraction_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestMathBigRatHelper_ContinuedFraction_000100()",
+		"")
+
+	piRat, _ := new(big.Rat).SetString("3.14159265358979")
+
+	type approxTest struct {
+		value          *big.Rat
+		maxDenominator *big.Int
+		tolerance      *big.Rat
+		expectedRatStr string
+	}
+
+	testData := []approxTest{
+		{piRat, big.NewInt(1000), nil, "355/113"},
+		{piRat, big.NewInt(100), nil, "311/99"},
+		{piRat, big.NewInt(7), nil, "22/7"},
+		{piRat, big.NewInt(1), nil, "3/1"},
+		{piRat, nil, big.NewRat(1, 100), "22/7"},
+		{piRat, nil, big.NewRat(1, 1000000), "355/113"},
+		{piRat, big.NewInt(200), big.NewRat(1, 1000000), "355/113"},
+		{new(big.Rat).Neg(piRat), big.NewInt(1000), nil, "-355/113"},
+		{big.NewRat(3, 4), big.NewInt(1000), nil, "3/4"},
+		{big.NewRat(1, 3), nil, big.NewRat(0, 1), "1/3"},
+		{big.NewRat(48000, 44100), big.NewInt(200), nil, "160/147"},
+	}
+
+	mathRatHelper := MathBigRatHelper{}
+
+	var err error
+	var approximation *big.Rat
+
+	for i := 0; i < len(testData); i++ {
+
+		approximation,
+			err = mathRatHelper.BestRationalApproximation(
+			testData[i].value,
+			testData[i].maxDenominator,
+			testData[i].tolerance,
+			ePrefix.XCpy(
+				"value"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if approximation.String() != testData[i].expectedRatStr {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: approximation != expectedRatStr\n"+
+				"value          = '%v'\n"+
+				"approximation  = '%v'\n"+
+				"expectedRatStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].value.String(),
+				approximation.String(),
+				testData[i].expectedRatStr)
+
+			return
+		}
+	}
+
+	_,
+		err = mathRatHelper.BestRationalApproximation(
+		piRat,
+		big.NewInt(100),
+		big.NewRat(1, 1000000),
+		ePrefix.XCpy(
+			"maxDenominator too small"))
+
+	if err == nil {
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from\n"+
+			"BestRationalApproximation() because no approximation\n"+
+			"within the tolerance has a denominator <= 100.\n"+
+			"However, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+		return
+	}
+
+	_,
+		err = mathRatHelper.BestRationalApproximation(
+		piRat,
+		nil,
+		nil,
+		ePrefix.XCpy(
+			"nil maxDenominator and tolerance"))
+
+	if err == nil {
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from\n"+
+			"BestRationalApproximation() because both\n"+
+			"'maxDenominator' and 'tolerance' are nil.\n"+
+			"However, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+		return
+	}
+
+	approximation,
+		err = mathRatHelper.BigFloatToBestRational(
+		big.NewFloat(1.4142135623730951),
+		big.NewInt(100),
+		nil,
+		ePrefix.XCpy(
+			"Square Root of 2"))
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			ePrefix.String(),
+			err.Error())
+		return
+	}
+
+	if approximation.String() != "140/99" {
+		t.Errorf("%v\n"+
+			"Error: Expected approximation = '140/99'\n"+
+			"Instead, approximation = '%v'\n",
+			ePrefix.String(),
+			approximation.String())
+		return
+	}
+
+	var convergents []ContinuedFractionConvergentDto
+
+	convergents,
+		err = mathRatHelper.ContinuedFractionConvergents(
+		piRat,
+		4,
+		ePrefix.XCpy(
+			"piRat"))
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			ePrefix.String(),
+			err.Error())
+		return
+	}
+
+	expectedConvergents := []string{"3/1", "22/7", "333/106", "355/113"}
+
+	expectedPartialQuotients := []string{"3", "7", "15", "1"}
+
+	if len(convergents) != len(expectedConvergents) {
+		t.Errorf("%v\n"+
+			"Error: Expected %v convergents.\n"+
+			"Instead, %v convergents were returned.\n",
+			ePrefix.String(),
+			len(expectedConvergents),
+			len(convergents))
+		return
+	}
+
+	for i := 0; i < len(convergents); i++ {
+
+		if convergents[i].Convergent.String() != expectedConvergents[i] ||
+			convergents[i].PartialQuotient.String() != expectedPartialQuotients[i] {
+
+			t.Errorf("%v Convergent #%v\n"+
+				"Error: Invalid convergent.\n"+
+				"Actual Convergent         = '%v'\n"+
+				"Expected Convergent       = '%v'\n"+
+				"Actual Partial Quotient   = '%v'\n"+
+				"Expected Partial Quotient = '%v'\n",
+				ePrefix.String(),
+				i,
+				convergents[i].String(),
+				expectedConvergents[i],
+				convergents[i].PartialQuotient.String(),
+				expectedPartialQuotients[i])
+			return
+		}
+
+		if i > 0 &&
+			convergents[i].AbsoluteError.Cmp(
+				convergents[i-1].AbsoluteError) >= 0 {
+
+			t.Errorf("%v Convergent #%v\n"+
+				"Error: Convergent errors are not decreasing.\n"+
+				"%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				convergents[i-1].String(),
+				convergents[i].String())
+			return
+		}
+	}
+
+	convergents,
+		err = mathRatHelper.ContinuedFractionConvergents(
+		big.NewRat(-7, 3),
+		0,
+		ePrefix.XCpy(
+			"-7/3"))
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			ePrefix.String(),
+			err.Error())
+		return
+	}
+
+	lastConvergent := convergents[len(convergents)-1]
+
+	if lastConvergent.Convergent.Cmp(big.NewRat(-7, 3)) != 0 ||
+		lastConvergent.AbsoluteError.Sign() != 0 ||
+		convergents[0].PartialQuotient.Int64() != -3 {
+
+		t.Errorf("%v\n"+
+			"Error: Invalid convergents for -7/3\n"+
+			"First Convergent: %v\n"+
+			"Last Convergent:  %v\n",
+			ePrefix.String(),
+			convergents[0].String(),
+			lastConvergent.String())
+		return
+	}
+}
+
+func TestMathBigRatHelper_ContinuedFraction_000200(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestMathBigRatHelper_ContinuedFraction_000200()",
+		"")
+
+	numStrKernel,
+		_,
+		err := new(NumberStrKernel).
+		NewParsePureNumberStr(
+			"1.059463094359",
+			".",
+			true,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			ePrefix.String(),
+			err.Error())
+		return
+	}
+
+	var approximation *big.Rat
+
+	approximation,
+		err = numStrKernel.GetBestRationalApproximation(
+		big.NewInt(100),
+		nil,
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			ePrefix.String(),
+			err.Error())
+		return
+	}
+
+	if approximation.String() != "89/84" {
+		t.Errorf("%v\n"+
+			"Error: Expected approximation = '89/84'\n"+
+			"Instead, approximation = '%v'\n",
+			ePrefix.String(),
+			approximation.String())
+		return
+	}
+
+	var convergents []ContinuedFractionConvergentDto
+
+	convergents,
+		err = numStrKernel.GetContinuedFractionConvergents(
+		0,
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			ePrefix.String(),
+			err.Error())
+		return
+	}
+
+	expectedValue := big.NewRat(1059463094359, 1000000000000)
+
+	lastConvergent := convergents[len(convergents)-1]
+
+	if lastConvergent.Convergent.Cmp(expectedValue) != 0 {
+		t.Errorf("%v\n"+
+			"Error: The last convergent is not equal to the\n"+
+			"NumberStrKernel value.\n"+
+			"Last Convergent = '%v'\n"+
+			"Expected Value  = '%v'\n",
+			ePrefix.String(),
+			lastConvergent.Convergent.String(),
+			expectedValue.String())
+		return
+	}
+}
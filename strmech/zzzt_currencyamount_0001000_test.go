@@ -0,0 +1,565 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"testing"
+)
+
+func TestCurrencyAmount_ConvertTo_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestCurrencyAmount_ConvertTo_000100",
+		"")
+
+	var rateTable CurrencyRateTable
+
+	rates := []struct {
+		baseCode  string
+		quoteCode string
+		rate      string
+	}{
+		{"USD", "EUR", "0.92"},
+		{"gbp", "usd", "1.2715"},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+
+	for i := 0; i < len(rates); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).NewParseNativeNumberStr(
+			rates[i].rate,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"rate"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		err = rateTable.AddRate(
+			rates[i].baseCode,
+			rates[i].quoteCode,
+			&numStrKernel,
+			ePrefix.XCpy(
+				"rateTable"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+	}
+
+	if rateTable.GetNumberOfRates() != 2 {
+
+		t.Errorf("%v\n"+
+			"Error: Expected rateTable.GetNumberOfRates() == 2\n"+
+			"Instead, rateTable.GetNumberOfRates() == %v\n",
+			ePrefix.String(),
+			rateTable.GetNumberOfRates())
+
+		return
+	}
+
+	if !rateTable.HasRate("EUR", "USD") ||
+		rateTable.HasRate("EUR", "GBP") {
+
+		t.Errorf("%v\n"+
+			"Error: rateTable.HasRate() returned invalid results!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	type conversionTest struct {
+		amount          string
+		sourceCode      string
+		sourceMinor     uint
+		targetCode      string
+		targetMinor     uint
+		roundingType    NumberRoundingType
+		expectedAmount  string
+		expectedCurCode string
+	}
+
+	testData := []conversionTest{
+		{"100", "USD", 2, "EUR", 2,
+			NumRoundType.HalfAwayFromZero(), "92.00", "EUR"},
+		{"1234.57", "USD", 2, "eur", 2,
+			NumRoundType.HalfAwayFromZero(), "1,135.80", "EUR"},
+		{"100", "EUR", 2, "USD", 2,
+			NumRoundType.HalfAwayFromZero(), "108.70", "USD"},
+		{"100", "EUR", 2, "USD", 2,
+			NumRoundType.Truncate(), "108.69", "USD"},
+		{"100", "GBP", 2, "USD", 2,
+			NumRoundType.HalfToEven(), "127.15", "USD"},
+		{"-50.25", "USD", 2, "GBP", 2,
+			NumRoundType.HalfAwayFromZero(), "-39.52", "GBP"},
+		{"10.125", "USD", 2, "USD", 2,
+			NumRoundType.HalfToEven(), "10.12", "USD"},
+	}
+
+	var sourceAmt, targetAmt CurrencyAmount
+	var actualNumStr string
+
+	for i := 0; i < len(testData); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).NewParseNativeNumberStr(
+			testData[i].amount,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		sourceAmt,
+			err = new(CurrencyAmount).NewCurrencyAmount(
+			&numStrKernel,
+			testData[i].sourceCode,
+			testData[i].sourceMinor,
+			ePrefix.XCpy(
+				"sourceAmt"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		targetAmt,
+			err = sourceAmt.ConvertTo(
+			testData[i].targetCode,
+			testData[i].targetMinor,
+			&rateTable,
+			testData[i].roundingType,
+			ePrefix.XCpy(
+				"targetAmt"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		numStrKernel,
+			err = targetAmt.GetAmount(
+			ePrefix.XCpy(
+				"targetAmt"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		actualNumStr = numStrKernel.String()
+
+		if actualNumStr != testData[i].expectedAmount ||
+			targetAmt.GetCurrencyCode() != testData[i].expectedCurCode ||
+			targetAmt.GetMinorUnitDigits() != testData[i].targetMinor {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: ConvertTo() result is invalid!\n"+
+				"Expected Amount   = '%v %v'\n"+
+				"  Actual Amount   = '%v %v'\n"+
+				"  Actual Minor Units = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].expectedAmount,
+				testData[i].expectedCurCode,
+				actualNumStr,
+				targetAmt.GetCurrencyCode(),
+				targetAmt.GetMinorUnitDigits())
+
+			return
+		}
+	}
+
+	_,
+		err = sourceAmt.ConvertTo(
+		"JPY",
+		0,
+		&rateTable,
+		NumRoundType.HalfAwayFromZero(),
+		ePrefix.XCpy(
+			"Missing JPY Rate"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from ConvertTo()\n"+
+			"because the rate table contains no JPY rate.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	_,
+		err = sourceAmt.ConvertTo(
+		"EUR",
+		2,
+		&rateTable,
+		NumRoundType.NoRounding(),
+		ePrefix.XCpy(
+			"NoRounding"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from ConvertTo()\n"+
+			"because 'roundingType' is NoRounding.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	invalidRates := []struct {
+		baseCode  string
+		quoteCode string
+	}{
+		{"USD", "US$"},
+		{"USD", "ZZZ"},
+		{"ZZZ", "USD"},
+		{"XYZ", "EUR"},
+		{"EUR", "eur"},
+	}
+
+	numOfRates := rateTable.GetNumberOfRates()
+
+	for i := 0; i < len(invalidRates); i++ {
+
+		err = rateTable.AddRate(
+			invalidRates[i].baseCode,
+			invalidRates[i].quoteCode,
+			&numStrKernel,
+			ePrefix.XCpy(
+				"Invalid Currency Code"))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from AddRate()\n"+
+				"because the currency code pair is invalid.\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n"+
+				"baseCurrencyCode  = '%v'\n"+
+				"quoteCurrencyCode = '%v'\n",
+				ePrefix.String(),
+				i,
+				invalidRates[i].baseCode,
+				invalidRates[i].quoteCode)
+
+			return
+		}
+	}
+
+	if rateTable.GetNumberOfRates() != numOfRates {
+
+		t.Errorf("%v\n"+
+			"Error: AddRate() returned errors but the rate\n"+
+			"table was modified!\n"+
+			"Expected Number Of Rates = '%v'\n"+
+			"  Actual Number Of Rates = '%v'\n",
+			ePrefix.String(),
+			numOfRates,
+			rateTable.GetNumberOfRates())
+	}
+}
+
+func TestCurrencyAmount_FmtCurrencyNumStr_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestCurrencyAmount_FmtCurrencyNumStr_000100",
+		"")
+
+	type fmtTest struct {
+		amount       string
+		currencyCode string
+		minorUnits   uint
+		roundingType NumberRoundingType
+		expected     string
+	}
+
+	testData := []fmtTest{
+		{"1234567.891", "USD", 2, NumRoundType.HalfAwayFromZero(), "$ 1,234,567.89"},
+		{"1234567.891", "EUR", 2, NumRoundType.HalfAwayFromZero(), "1 234 567,89 €"},
+		{"1234567.891", "GBP", 2, NumRoundType.HalfAwayFromZero(), "£ 1,234,567.89"},
+		{"1234567.891", "CHF", 2, NumRoundType.HalfAwayFromZero(), "CHF 1'234'567.89"},
+		{"-1234567.5", "JPY", 0, NumRoundType.HalfAwayFromZero(), "-¥1,234,568"},
+		{"1234.561", "USD", 2, NumRoundType.Ceiling(), "$ 1,234.57"},
+		{"1234.561", "USD", 2, NumRoundType.Floor(), "$ 1,234.56"},
+		{"-1234.561", "USD", 2, NumRoundType.Floor(), "$ (1,234.57)"},
+		{"-1234.561", "USD", 2, NumRoundType.Ceiling(), "$ (1,234.56)"},
+		{"1234.565", "USD", 2, NumRoundType.HalfToEven(), "$ 1,234.56"},
+		{"1234.575", "USD", 2, NumRoundType.HalfToEven(), "$ 1,234.58"},
+		{"1234.5", "USD", 2, NumRoundType.Floor(), "$ 1,234.50"},
+		{"1234.567", "JPY", 0, NumRoundType.HalfToEven(), "¥1,235"},
+		{"1234.5", "JPY", 0, NumRoundType.HalfToEven(), "¥1,234"},
+		{"1234.001", "JPY", 0, NumRoundType.Ceiling(), "¥1,235"},
+		{"1234.999", "JPY", 0, NumRoundType.Floor(), "¥1,234"},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var curAmt CurrencyAmount
+	var actualNumStr string
+
+	for i := 0; i < len(testData); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).NewParseNativeNumberStr(
+			testData[i].amount,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		err = curAmt.SetCurrencyAmount(
+			&numStrKernel,
+			testData[i].currencyCode,
+			testData[i].minorUnits,
+			ePrefix.XCpy(
+				"curAmt"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		actualNumStr,
+			err = curAmt.FmtCurrencyNumStr(
+			new(NumStrNumberFieldSpec).NewNOP(),
+			testData[i].roundingType,
+			ePrefix.XCpy(
+				"curAmt"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expected {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: FmtCurrencyNumStr() result is invalid!\n"+
+				"Amount          = '%v'\n"+
+				"Currency Code   = '%v'\n"+
+				"Rounding Type   = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].amount,
+				testData[i].currencyCode,
+				testData[i].roundingType.String(),
+				testData[i].expected,
+				actualNumStr)
+
+			return
+		}
+	}
+
+	curAmt2 := curAmt.CopyOut()
+
+	if !curAmt2.Equal(&curAmt) {
+
+		t.Errorf("%v\n"+
+			"Error: curAmt2 is NOT equal to curAmt!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	err = curAmt2.SetCurrencyAmount(
+		&numStrKernel,
+		"ZZZ",
+		2,
+		ePrefix.XCpy(
+			"curAmt2"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from SetCurrencyAmount()\n"+
+			"because ZZZ is not an ISO 4217 currency code.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	if !curAmt2.Equal(&curAmt) {
+
+		t.Errorf("%v\n"+
+			"Error: SetCurrencyAmount() returned an error\n"+
+			"but curAmt2 was modified!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	curAmt2.Empty()
+
+	if curAmt2.Equal(&curAmt) {
+
+		t.Errorf("%v\n"+
+			"Error: After Empty(), curAmt2 is equal to curAmt!\n",
+			ePrefix.String())
+
+		return
+	}
+}
+
+func TestCurrencyAmount_NewCurrencyAmount_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestCurrencyAmount_NewCurrencyAmount_000100()",
+		"")
+
+	numStrKernel,
+		_,
+		err := new(NumberStrKernel).NewParseNativeNumberStr(
+		"1234.56",
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	testData := []struct {
+		currencyCode    string
+		minorUnitDigits uint
+		isValid         bool
+	}{
+		{"USD", 2, true},
+		{"jpy", 0, true},
+		{"KWD", 3, true},
+		{"ZZZ", 2, false},
+		{"JPY", 2, false},
+		{"USD", 3, false},
+		{"KWD", 2, false},
+	}
+
+	var curAmt CurrencyAmount
+
+	for i := 0; i < len(testData); i++ {
+
+		curAmt,
+			err = new(CurrencyAmount).NewCurrencyAmount(
+			&numStrKernel,
+			testData[i].currencyCode,
+			testData[i].minorUnitDigits,
+			ePrefix.XCpy(
+				"curAmt"))
+
+		if testData[i].isValid {
+
+			if err != nil {
+				t.Errorf("%v Test #%v\n"+
+					"%v\n",
+					ePrefix.String(),
+					i,
+					err.Error())
+				return
+			}
+
+			if curAmt.GetMinorUnitDigits() !=
+				testData[i].minorUnitDigits {
+
+				t.Errorf("%v Test #%v\n"+
+					"Error: GetMinorUnitDigits() is invalid!\n"+
+					"Expected = '%v'\n"+
+					"  Actual = '%v'\n",
+					ePrefix.String(),
+					i,
+					testData[i].minorUnitDigits,
+					curAmt.GetMinorUnitDigits())
+				return
+			}
+
+			continue
+		}
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from NewCurrencyAmount()\n"+
+				"because the currency code or minor unit digits are\n"+
+				"invalid.\n"+
+				"currencyCode    = '%v'\n"+
+				"minorUnitDigits = '%v'\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n",
+				ePrefix.String(),
+				i,
+				testData[i].currencyCode,
+				testData[i].minorUnitDigits)
+
+			return
+		}
+	}
+
+	sourceAmt,
+		err := new(CurrencyAmount).NewCurrencyAmount(
+		&numStrKernel,
+		"USD",
+		2,
+		ePrefix.XCpy(
+			"sourceAmt"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	_,
+		err = sourceAmt.ConvertTo(
+		"USD",
+		3,
+		nil,
+		NumRoundType.HalfAwayFromZero(),
+		ePrefix.XCpy(
+			"sourceAmt USD 3"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from ConvertTo()\n"+
+			"because USD does not use 3 minor unit digits.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+}
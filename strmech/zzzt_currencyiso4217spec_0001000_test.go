@@ -0,0 +1,454 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"testing"
+)
+
+func TestCurrencyISO4217Spec_NewFromCode_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestCurrencyISO4217Spec_NewFromCode_000100",
+		"")
+
+	type lookupTest struct {
+		currencyCode   string
+		alphabeticCode string
+		numericCode    string
+		minorUnits     uint
+		currencySymbol string
+	}
+
+	testData := []lookupTest{
+		{"JPY", "JPY", "392", 0, "¥"},
+		{"chf", "CHF", "756", 2, "CHF"},
+		{"756", "CHF", "756", 2, "CHF"},
+		{" KWD ", "KWD", "414", 3, "KD"},
+		{"CLF", "CLF", "990", 4, "UF"},
+		{"978", "EUR", "978", 2, "€"},
+		{"INR", "INR", "356", 2, "₹"},
+	}
+
+	var err error
+	var curISO4217Spec CurrencyISO4217Spec
+
+	for i := 0; i < len(testData); i++ {
+
+		curISO4217Spec,
+			err = new(CurrencyISO4217Spec).NewFromCode(
+			testData[i].currencyCode,
+			ePrefix.XCpy(
+				"curISO4217Spec"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if curISO4217Spec.AlphabeticCode != testData[i].alphabeticCode ||
+			curISO4217Spec.NumericCode != testData[i].numericCode ||
+			curISO4217Spec.MinorUnits != testData[i].minorUnits ||
+			curISO4217Spec.CurrencySymbol != testData[i].currencySymbol {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: NewFromCode() returned invalid data!\n"+
+				"Currency Code = '%v'\n"+
+				"Expected: '%v' '%v' '%v' '%v'\n"+
+				"  Actual: '%v' '%v' '%v' '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].currencyCode,
+				testData[i].alphabeticCode,
+				testData[i].numericCode,
+				testData[i].minorUnits,
+				testData[i].currencySymbol,
+				curISO4217Spec.AlphabeticCode,
+				curISO4217Spec.NumericCode,
+				curISO4217Spec.MinorUnits,
+				curISO4217Spec.CurrencySymbol)
+
+			return
+		}
+	}
+
+	curISO4217Spec2 := curISO4217Spec.CopyOut()
+
+	if !curISO4217Spec2.Equal(&curISO4217Spec) {
+
+		t.Errorf("%v\n"+
+			"Error: curISO4217Spec2 is NOT equal to curISO4217Spec!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	curISO4217Spec2.Empty()
+
+	if curISO4217Spec2.Equal(&curISO4217Spec) {
+
+		t.Errorf("%v\n"+
+			"Error: After Empty(), curISO4217Spec2 is equal to curISO4217Spec!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	codes := curISO4217Spec.GetAllAlphabeticCodes()
+
+	for i := 1; i < len(codes); i++ {
+
+		if codes[i-1] >= codes[i] {
+
+			t.Errorf("%v\n"+
+				"Error: GetAllAlphabeticCodes() is NOT in alphabetical order!\n"+
+				"codes[%v] = '%v'\n"+
+				"codes[%v] = '%v'\n",
+				ePrefix.String(),
+				i-1,
+				codes[i-1],
+				i,
+				codes[i])
+
+			return
+		}
+	}
+
+	invalidCodes := []string{
+		"",
+		"ZZZ",
+		"US",
+		"999",
+		"XAU",
+	}
+
+	for i := 0; i < len(invalidCodes); i++ {
+
+		_,
+			err = new(CurrencyISO4217Spec).NewFromCode(
+			invalidCodes[i],
+			ePrefix.XCpy(
+				"invalidCodes"))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from NewFromCode()\n"+
+				"because the currency code is invalid.\n"+
+				"Currency Code = '%v'\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n",
+				ePrefix.String(),
+				i,
+				invalidCodes[i])
+
+			return
+		}
+	}
+}
+
+func TestNumStrFormatSpec_NewCurrencyISO4217_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrFormatSpec_NewCurrencyISO4217_000100",
+		"")
+
+	type fmtTest struct {
+		numStr       string
+		currencyCode string
+		expected     string
+	}
+
+	testData := []fmtTest{
+		{"1234.567", "JPY", "¥1,235"},
+		{"-1234.567", "JPY", "-¥1,235"},
+		{"1234.567", "CHF", "CHF 1'234.57"},
+		{"-1234.567", "CHF", "-CHF 1'234.57"},
+		{"1234.567", "EUR", "1.234,57 €"},
+		{"-1234.567", "EUR", "-1.234,57 €"},
+		{"1234.5678", "KWD", "KD 1,234.568"},
+		{"1234567.891", "INR", "₹12,34,567.89"},
+		{"1234567.891", "SEK", "1 234 567,89 kr"},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var curISO4217Spec CurrencyISO4217Spec
+	var numStrFmtSpec NumStrFormatSpec
+	var roundingSpec NumStrRoundingSpec
+	var actualNumStr string
+
+	for i := 0; i < len(testData); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).NewParseNativeNumberStr(
+			testData[i].numStr,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		curISO4217Spec,
+			err = new(CurrencyISO4217Spec).NewFromCode(
+			testData[i].currencyCode,
+			ePrefix.XCpy(
+				"curISO4217Spec"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		numStrFmtSpec,
+			err = new(NumStrFormatSpec).NewCurrencyISO4217(
+			testData[i].currencyCode,
+			new(NumStrNumberFieldSpec).NewNOP(),
+			ePrefix.XCpy(
+				"numStrFmtSpec"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		roundingSpec,
+			err = curISO4217Spec.GetNumStrRoundingSpec(
+			NumRoundType.HalfAwayFromZero(),
+			ePrefix.XCpy(
+				"roundingSpec"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		actualNumStr,
+			err = numStrKernel.FmtNumStr(
+			roundingSpec,
+			numStrFmtSpec,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expected {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: NewCurrencyISO4217() format is invalid!\n"+
+				"Currency Code   = '%v'\n"+
+				"Number String   = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].currencyCode,
+				testData[i].numStr,
+				testData[i].expected,
+				actualNumStr)
+
+			return
+		}
+	}
+}
+
+func TestNumStrFormatSpec_NewCurrencyISO4217_000200(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrFormatSpec_NewCurrencyISO4217_000200",
+		"")
+
+	type fmtTest struct {
+		currencyCode      string
+		expectedFracCount int
+		expected          string
+		expectedUnrounded string
+	}
+
+	testData := []fmtTest{
+		{"JPY", 0, "-¥1,234,568", "-¥1,234,567.891"},
+		{"USD", 2, "-$1,234,567.89", "-$1,234,567.891"},
+		{"KWD", 3, "-KD 1,234,567.891", "-KD 1,234,567.891"},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var curISO4217Spec CurrencyISO4217Spec
+	var numStrFmtSpec NumStrFormatSpec
+	var roundingSpec, noRoundingSpec NumStrRoundingSpec
+	var actualNumStr string
+
+	numStrKernel,
+		_,
+		err = new(NumberStrKernel).NewParseNativeNumberStr(
+		"-1234567.891",
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	noRoundingSpec,
+		err = new(NumStrRoundingSpec).NewRoundingSpec(
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"noRoundingSpec"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	for i := 0; i < len(testData); i++ {
+
+		curISO4217Spec,
+			err = new(CurrencyISO4217Spec).NewFromCode(
+			testData[i].currencyCode,
+			ePrefix.XCpy(
+				"curISO4217Spec"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		roundingSpec,
+			err = curISO4217Spec.GetNumStrRoundingSpec(
+			NumRoundType.HalfAwayFromZero(),
+			ePrefix.XCpy(
+				"roundingSpec"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if roundingSpec.GetRoundToFractionalDigits() !=
+			testData[i].expectedFracCount {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: GetNumStrRoundingSpec() fractional digits\n"+
+				"are invalid!\n"+
+				"Currency Code   = '%v'\n"+
+				"Expected Digits = '%v'\n"+
+				"  Actual Digits = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].currencyCode,
+				testData[i].expectedFracCount,
+				roundingSpec.GetRoundToFractionalDigits())
+
+			return
+		}
+
+		numStrFmtSpec,
+			err = new(NumStrFormatSpec).NewCurrencyISO4217(
+			testData[i].currencyCode,
+			new(NumStrNumberFieldSpec).NewNOP(),
+			ePrefix.XCpy(
+				"numStrFmtSpec"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		actualNumStr,
+			err = numStrKernel.FmtNumStr(
+			roundingSpec,
+			numStrFmtSpec,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expected {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Rounded currency number string is invalid!\n"+
+				"Currency Code   = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].currencyCode,
+				testData[i].expected,
+				actualNumStr)
+
+			return
+		}
+
+		// NewCurrencyISO4217() does NOT perform rounding.
+		actualNumStr,
+			err = numStrKernel.FmtNumStr(
+			noRoundingSpec,
+			numStrFmtSpec,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expectedUnrounded {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Unrounded currency number string is invalid!\n"+
+				"Currency Code   = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].currencyCode,
+				testData[i].expectedUnrounded,
+				actualNumStr)
+
+			return
+		}
+	}
+
+	_,
+		err = curISO4217Spec.GetNumStrRoundingSpec(
+		NumberRoundingType(-99),
+		ePrefix.XCpy(
+			"Invalid roundingType"))
+
+	if err == nil {
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from\n"+
+			"GetNumStrRoundingSpec() because 'roundingType'\n"+
+			"is invalid. However, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+	}
+}
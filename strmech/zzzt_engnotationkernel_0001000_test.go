@@ -0,0 +1,414 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"testing"
+)
+
+func TestEngNotationKernel_FmtEngNotation_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestEngNotationKernel_FmtEngNotation_000100",
+		"")
+
+	type engNotTest struct {
+		numStr       string
+		engNotFmt    EngineeringNotationFormat
+		roundingType NumberRoundingType
+		fracDigits   int
+		unitSymbol   string
+		expected     string
+	}
+
+	halfAway := NumRoundType.HalfAwayFromZero()
+	noRounding := NumRoundType.NoRounding()
+
+	testData := []engNotTest{
+		{"4700", EngNotFmt.SIPrefixSymbol(),
+			noRounding, 0, "Ω", "4.7 kΩ"},
+		{"4700", EngNotFmt.SIPrefixName(),
+			noRounding, 0, "", "4.7 kilo"},
+		{"4700", EngNotFmt.Exponential(),
+			noRounding, 0, "", "4.7 x 10^3"},
+		{"265200000", EngNotFmt.ENotUprCaseELeadPlus(),
+			noRounding, 0, "", "265.2E+6"},
+		{"265200000", EngNotFmt.ENotUprCaseENoLeadPlus(),
+			noRounding, 0, "", "265.2E6"},
+		{"265200000", EngNotFmt.ENotLwrCaseELeadPlus(),
+			halfAway, 2, "", "265.20e+6"},
+		{"-265200000", EngNotFmt.ENotLwrCaseENoLeadPlus(),
+			noRounding, 0, "", "-265.2e6"},
+		{"0.0000033", EngNotFmt.SIPrefixSymbol(),
+			noRounding, 0, "F", "3.3 μF"},
+		{"0.0000033", EngNotFmt.SIPrefixName(),
+			noRounding, 0, "", "3.3 micro"},
+		{"0.0123", EngNotFmt.Exponential(),
+			halfAway, 1, "", "12.3 x 10^-3"},
+		{"0.0123", EngNotFmt.ENotUprCaseELeadPlus(),
+			halfAway, 1, "m", "12.3E-3 m"},
+		{"999999", EngNotFmt.SIPrefixSymbol(),
+			halfAway, 1, "", "1.0 M"},
+		{"47", EngNotFmt.SIPrefixSymbol(),
+			noRounding, 0, "", "47"},
+		{"47", EngNotFmt.SIPrefixSymbol(),
+			noRounding, 0, "Ω", "47 Ω"},
+		{"0", EngNotFmt.SIPrefixName(),
+			halfAway, 1, "", "0.0"},
+		{"2200000", EngNotFmt.SIPrefixName(),
+			noRounding, 0, "", "2.2 mega"},
+		{"1234567891000000000000000", EngNotFmt.SIPrefixSymbol(),
+			halfAway, 3, "", "1.235 Y"},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var actualNumStr string
+
+	for i := 0; i < len(testData); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).NewParseNativeNumberStr(
+			testData[i].numStr,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		actualNumStr,
+			err = numStrKernel.FmtEngNotation(
+			testData[i].engNotFmt,
+			testData[i].roundingType,
+			testData[i].fracDigits,
+			testData[i].unitSymbol,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"Number String = '%v'\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				testData[i].numStr,
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expected {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: FmtEngNotation() result is invalid!\n"+
+				"Number String   = '%v'\n"+
+				"Eng Not Format  = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].numStr,
+				testData[i].engNotFmt.String(),
+				testData[i].expected,
+				actualNumStr)
+
+			return
+		}
+	}
+
+	numStrKernel,
+		_,
+		err = new(NumberStrKernel).NewParseNativeNumberStr(
+		"1000000000000000000000000000",
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"numStrKernel 10^27"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	_,
+		err = numStrKernel.FmtEngNotation(
+		EngNotFmt.SIPrefixSymbol(),
+		NumRoundType.NoRounding(),
+		0,
+		"",
+		ePrefix.XCpy(
+			"numStrKernel 10^27"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from FmtEngNotation()\n"+
+			"because no SI prefix exists for 10^27.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	_,
+		err = numStrKernel.FmtEngNotation(
+		EngNotFmt.None(),
+		NumRoundType.NoRounding(),
+		0,
+		"",
+		ePrefix.XCpy(
+			"EngNotFmt.None()"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from FmtEngNotation()\n"+
+			"because 'engNotFmt' is invalid.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+}
+
+func TestEngNotationKernel_GetNumberStrKernel_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestEngNotationKernel_GetNumberStrKernel_000100",
+		"")
+
+	numStrKernel,
+		_,
+		err := new(NumberStrKernel).NewParseNativeNumberStr(
+		"-0.00004725",
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var engNotKernel EngNotationKernel
+
+	engNotKernel,
+		err = numStrKernel.GetEngineeringNotation(
+		NumRoundType.HalfAwayFromZero(),
+		2,
+		ePrefix.XCpy(
+			"engNotKernel"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	if engNotKernel.GetCoefficient().Int64() != -4725 ||
+		engNotKernel.GetCoefficientFracDigits() != 2 ||
+		engNotKernel.GetExponent().Int64() != -6 {
+
+		t.Errorf("%v\n"+
+			"Error: Engineering Notation components are invalid!\n"+
+			"Expected coefficient='-4725' fracDigits='2' exponent='-6'\n"+
+			"Instead, coefficient='%v' fracDigits='%v' exponent='%v'\n",
+			ePrefix.String(),
+			engNotKernel.GetCoefficient().Text(10),
+			engNotKernel.GetCoefficientFracDigits(),
+			engNotKernel.GetExponent().Text(10))
+
+		return
+	}
+
+	engNotKernel2 := engNotKernel.CopyOut()
+
+	if !engNotKernel2.Equal(&engNotKernel) {
+
+		t.Errorf("%v\n"+
+			"Error: engNotKernel2 is NOT equal to engNotKernel!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	var roundTripKernel NumberStrKernel
+
+	roundTripKernel,
+		err = engNotKernel2.GetNumberStrKernel(
+		ePrefix.XCpy(
+			"roundTripKernel"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var actualNumStr string
+
+	actualNumStr,
+		_,
+		err = roundTripKernel.FmtNumStrNative(
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"roundTripKernel"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	expectedNumStr := "-0.00004725"
+
+	if actualNumStr != expectedNumStr {
+
+		t.Errorf("%v\n"+
+			"Error: GetNumberStrKernel() result is invalid!\n"+
+			"Expected Result = '%v'\n"+
+			"  Actual Result = '%v'\n",
+			ePrefix.String(),
+			expectedNumStr,
+			actualNumStr)
+
+		return
+	}
+
+	engNotKernel2.Empty()
+
+	if engNotKernel2.Equal(&engNotKernel) {
+
+		t.Errorf("%v\n"+
+			"Error: After Empty(), engNotKernel2 is equal to engNotKernel!\n",
+			ePrefix.String())
+
+		return
+	}
+}
+
+func TestNumberStrKernel_NewParseSIPrefixNumberStr_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumberStrKernel_NewParseSIPrefixNumberStr_000100",
+		"")
+
+	type siParseTest struct {
+		siPrefixNumStr string
+		unitSymbol     string
+		expected       string
+	}
+
+	testData := []siParseTest{
+		{"3.3µ", "", "0.0000033"},
+		{"3.3μ", "", "0.0000033"},
+		{"3.3u", "", "0.0000033"},
+		{"2.2 mega", "", "2200000"},
+		{"2.2 Mega", "", "2200000"},
+		{"-4.7k", "", "-4700"},
+		{"4.7 kΩ", "Ω", "4700"},
+		{"470 Ω", "Ω", "470"},
+		{"15", "", "15"},
+		{"+1.5 m", "", "0.0015"},
+		{"1.5 M", "", "1500000"},
+		{"12 yocto", "", "0.000000000000000000000012"},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var actualNumStr string
+
+	for i := 0; i < len(testData); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).NewParseSIPrefixNumberStr(
+			testData[i].siPrefixNumStr,
+			testData[i].unitSymbol,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"SI Prefix Number String = '%v'\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				testData[i].siPrefixNumStr,
+				err.Error())
+			return
+		}
+
+		actualNumStr,
+			_,
+			err = numStrKernel.FmtNumStrNative(
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expected {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: NewParseSIPrefixNumberStr() result is invalid!\n"+
+				"SI Prefix Number String = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].siPrefixNumStr,
+				testData[i].expected,
+				actualNumStr)
+
+			return
+		}
+	}
+
+	invalidNumStrs := []string{
+		"",
+		"kilo",
+		"4.7 K",
+		"4.7 kilogram",
+		"1.2.3 k",
+	}
+
+	for i := 0; i < len(invalidNumStrs); i++ {
+
+		_,
+			_,
+			err = new(NumberStrKernel).NewParseSIPrefixNumberStr(
+			invalidNumStrs[i],
+			"",
+			ePrefix.XCpy(
+				"invalidNumStrs"))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from\n"+
+				"NewParseSIPrefixNumberStr() because the\n"+
+				"number string is invalid.\n"+
+				"Number String = '%v'\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n",
+				ePrefix.String(),
+				i,
+				invalidNumStrs[i])
+
+			return
+		}
+	}
+}
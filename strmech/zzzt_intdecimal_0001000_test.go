@@ -0,0 +1,419 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"testing"
+)
+
+func TestIntDecimal_Arithmetic_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestIntDecimal_Arithmetic_000100",
+		"")
+
+	// operand01, operand02, sum, difference, product
+	testData := [][5]string{
+		{"1234.50", "-0.125", "1234.375", "1234.625", "-154.31250"},
+		{"19.99", "3", "22.99", "16.99", "59.97"},
+		{"-0.10", "-0.20", "-0.30", "0.10", "0.0200"},
+		{"100", "-100", "0", "200", "-10000"},
+	}
+
+	var err error
+	var operand01, operand02, result IntDecimal
+
+	for i := 0; i < len(testData); i++ {
+
+		operand01,
+			err = new(IntDecimal).NewFromNumStr(
+			testData[i][0],
+			ePrefix.XCpy(
+				"operand01"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		operand02,
+			err = new(IntDecimal).NewFromNumStr(
+			testData[i][1],
+			ePrefix.XCpy(
+				"operand02"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		result,
+			err = operand01.Add(
+			&operand02,
+			ePrefix.XCpy(
+				"sum"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if result.String() != testData[i][2] {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Add() result is invalid!\n"+
+				"Expected Sum = '%v'\n"+
+				"  Actual Sum = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i][2],
+				result.String())
+
+			return
+		}
+
+		result,
+			err = operand01.Subtract(
+			&operand02,
+			ePrefix.XCpy(
+				"difference"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if result.String() != testData[i][3] {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Subtract() result is invalid!\n"+
+				"Expected Difference = '%v'\n"+
+				"  Actual Difference = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i][3],
+				result.String())
+
+			return
+		}
+
+		result,
+			err = operand01.Multiply(
+			&operand02,
+			ePrefix.XCpy(
+				"product"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if result.String() != testData[i][4] {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Multiply() result is invalid!\n"+
+				"Expected Product = '%v'\n"+
+				"  Actual Product = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i][4],
+				result.String())
+
+			return
+		}
+	}
+}
+
+func TestIntDecimal_SetScale_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestIntDecimal_SetScale_000100",
+		"")
+
+	type scaleTest struct {
+		numStr       string
+		newScale     int
+		roundingType NumberRoundingType
+		expected     string
+	}
+
+	testData := []scaleTest{
+		{"123.4567", 2, NumRoundType.HalfAwayFromZero(), "123.46"},
+		{"-123.455", 2, NumRoundType.HalfAwayFromZero(), "-123.46"},
+		{"123.445", 2, NumRoundType.HalfToEven(), "123.44"},
+		{"0.1250001", 2, NumRoundType.HalfToEven(), "0.13"},
+		{"123.459", 2, NumRoundType.NoRounding(), "123.45"},
+		{"-123.451", 0, NumRoundType.Floor(), "-124"},
+		{"19.9", 3, NumRoundType.HalfAwayFromZero(), "19.900"},
+	}
+
+	var err error
+	var intDecimal IntDecimal
+
+	for i := 0; i < len(testData); i++ {
+
+		intDecimal,
+			err = new(IntDecimal).NewFromNumStr(
+			testData[i].numStr,
+			ePrefix.XCpy(
+				"intDecimal"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		err = intDecimal.SetScale(
+			testData[i].newScale,
+			testData[i].roundingType,
+			ePrefix.XCpy(
+				testData[i].roundingType.String()))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if intDecimal.String() != testData[i].expected {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: SetScale() result is invalid!\n"+
+				"Number String  = '%v'\n"+
+				"Rounding Type  = '%v'\n"+
+				"Expected Value = '%v'\n"+
+				"  Actual Value = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].numStr,
+				testData[i].roundingType.String(),
+				testData[i].expected,
+				intDecimal.String())
+
+			return
+		}
+
+		if intDecimal.GetScale() != testData[i].newScale {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: GetScale() result is invalid!\n"+
+				"Expected Scale = '%v'\n"+
+				"  Actual Scale = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].newScale,
+				intDecimal.GetScale())
+
+			return
+		}
+	}
+}
+
+func TestIntDecimal_Compare_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestIntDecimal_Compare_000100",
+		"")
+
+	intDecimal01,
+		err := new(IntDecimal).NewFromNumStr(
+		"1.2500",
+		ePrefix.XCpy(
+			"intDecimal01"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var intDecimal02 IntDecimal
+
+	intDecimal02,
+		err = new(IntDecimal).NewFromInt64(
+		125,
+		2,
+		ePrefix.XCpy(
+			"intDecimal02"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var areEqual bool
+
+	areEqual,
+		err = intDecimal01.Equal(
+		&intDecimal02,
+		ePrefix.XCpy(
+			"intDecimal01==intDecimal02"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	if !areEqual {
+
+		t.Errorf("%v\n"+
+			"Error: Expected intDecimal01 == intDecimal02.\n"+
+			"intDecimal01 = '%v'\n"+
+			"intDecimal02 = '%v'\n",
+			ePrefix.String(),
+			intDecimal01.String(),
+			intDecimal02.String())
+
+		return
+	}
+
+	intDecimal02,
+		err = new(IntDecimal).NewFromNumStr(
+		"-3.5",
+		ePrefix.XCpy(
+			"intDecimal02"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var comparison int
+
+	comparison,
+		err = intDecimal02.Compare(
+		&intDecimal01,
+		ePrefix.XCpy(
+			"intDecimal02 vs intDecimal01"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	if comparison != -1 {
+
+		t.Errorf("%v\n"+
+			"Error: Expected comparison == -1.\n"+
+			"Instead, comparison = '%v'\n",
+			ePrefix.String(),
+			comparison)
+
+		return
+	}
+
+	_,
+		err = intDecimal02.Compare(
+		nil,
+		ePrefix.XCpy(
+			"nil"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from Compare()\n"+
+			"because 'incomingIntDecimal' is a nil pointer.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+}
+
+func TestIntDecimal_Format_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestIntDecimal_Format_000100",
+		"")
+
+	intDecimal,
+		err := new(IntDecimal).NewFromNumStr(
+		"-1234567.5",
+		ePrefix.XCpy(
+			"intDecimal"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	err = intDecimal.SetScale(
+		2,
+		NumRoundType.HalfAwayFromZero(),
+		ePrefix.XCpy(
+			"intDecimal"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var numberFieldSpec NumStrNumberFieldSpec
+
+	numberFieldSpec,
+		err = new(NumStrNumberFieldSpec).NewFieldSpec(
+		-1,
+		TxtJustify.Right(),
+		ePrefix.XCpy(
+			"numberFieldSpec"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var numStrFmtSpec NumStrFormatSpec
+
+	numStrFmtSpec,
+		err = new(NumStrFormatSpec).NewSignedNumDefaultsUSMinus(
+		numberFieldSpec,
+		ePrefix.XCpy(
+			"numStrFmtSpec"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var actualNumStr string
+
+	actualNumStr,
+		err = intDecimal.Format(
+		numStrFmtSpec,
+		ePrefix.XCpy(
+			"intDecimal"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	expectedNumStr := "-1,234,567.50"
+
+	if actualNumStr != expectedNumStr {
+
+		t.Errorf("%v\n"+
+			"Error: Format() result is invalid!\n"+
+			"Expected Number String = '%v'\n"+
+			"  Actual Number String = '%v'\n",
+			ePrefix.String(),
+			expectedNumStr,
+			actualNumStr)
+
+		return
+	}
+}
@@ -0,0 +1,726 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"math/big"
+	"testing"
+)
+
+func TestMathFloatHelper_NthRoot_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestMathFloatHelper_NthRoot_000100",
+		"")
+
+	type nthRootTest struct {
+		radicandStr    string
+		nthRoot        int64
+		fracDigits     int
+		expectedResult string
+	}
+
+	testData := []nthRootTest{
+		{"2", 2, 60,
+			"1.414213562373095048801688724209698078569671875376948073176680"},
+		{"0.0000123", 2, 55,
+			"0.0035071355833500363833634934966131027694105155429767285"},
+		{"98765432109876543210", 2, 50,
+			"9938079900.55808231173954156543476014650557905800340969820291"},
+		{"10", 3, 50,
+			"2.15443469003188372175929356651935049525934494219211"},
+		{"27", 3, 40,
+			"3.0000000000000000000000000000000000000000"},
+		{"12345678901234567890.123", 7, 55,
+			"533.7762944996152729973487098638736548527300629799763624672"},
+		{"-7.5", 5, 50,
+			"-1.49627786973884473850810213932978255331700624709325"},
+		{"-32", 5, 2,
+			"-2.00"},
+		{"0", 4, 3,
+			"0.000"},
+	}
+
+	mathFloatHelper := MathFloatHelper{}
+
+	var err error
+	var ok bool
+	var radicand, root *big.Float
+	var rootNumStr NumberStrKernel
+	var actualNumStr string
+
+	for i := 0; i < len(testData); i++ {
+
+		radicand,
+			ok = new(big.Float).
+			SetPrec(512).
+			SetString(testData[i].radicandStr)
+
+		if !ok {
+			t.Errorf("%v Test #%v\n"+
+				"Error: radicand.SetString() FAILED!\n"+
+				"radicandStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].radicandStr)
+			return
+		}
+
+		if testData[i].nthRoot == 2 {
+
+			root,
+				rootNumStr,
+				err = mathFloatHelper.Sqrt(
+				radicand,
+				testData[i].fracDigits,
+				NumRoundType.HalfAwayFromZero(),
+				ePrefix.XCpy(
+					"root<-radicand"))
+
+		} else {
+
+			root,
+				rootNumStr,
+				err = mathFloatHelper.NthRoot(
+				radicand,
+				testData[i].nthRoot,
+				testData[i].fracDigits,
+				NumRoundType.HalfAwayFromZero(),
+				ePrefix.XCpy(
+					"root<-radicand"))
+		}
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualNumStr,
+			_,
+			err = rootNumStr.FmtNumStrPure(
+			".",
+			true,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"rootNumStr"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expectedResult {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: NumberStrKernel root is invalid!\n"+
+				"Radicand        = '%v'\n"+
+				"Nth Root        = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].radicandStr,
+				testData[i].nthRoot,
+				testData[i].expectedResult,
+				actualNumStr)
+
+			return
+		}
+
+		actualNumStr = root.Text('f', testData[i].fracDigits)
+
+		if actualNumStr != testData[i].expectedResult {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: big.Float root is invalid!\n"+
+				"Radicand        = '%v'\n"+
+				"Nth Root        = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].radicandStr,
+				testData[i].nthRoot,
+				testData[i].expectedResult,
+				actualNumStr)
+
+			return
+		}
+	}
+
+	_,
+		_,
+		err = mathFloatHelper.Sqrt(
+		big.NewFloat(-4),
+		5,
+		NumRoundType.HalfAwayFromZero(),
+		ePrefix.XCpy(
+			"Sqrt(-4)"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from Sqrt()\n"+
+			"because 'radicand' is negative.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	_,
+		_,
+		err = mathFloatHelper.NthRoot(
+		big.NewFloat(8),
+		0,
+		5,
+		NumRoundType.HalfAwayFromZero(),
+		ePrefix.XCpy(
+			"nthRoot=0"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from NthRoot()\n"+
+			"because 'nthRoot' is zero.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	_,
+		_,
+		err = mathFloatHelper.NthRoot(
+		big.NewFloat(8),
+		3,
+		5,
+		NumRoundType.NoRounding(),
+		ePrefix.XCpy(
+			"NoRounding"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from NthRoot()\n"+
+			"because 'roundingType' is NoRounding.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+}
+
+func TestMathFloatHelper_Pow_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestMathFloatHelper_Pow_000100",
+		"")
+
+	type powTest struct {
+		baseStr        string
+		exponentStr    string
+		fracDigits     int
+		expectedResult string
+	}
+
+	testData := []powTest{
+		{"2", "10", 0, "1024"},
+		{"2", "-2", 4, "0.2500"},
+		{"1.5", "-3", 10, "0.2962962963"},
+		{"-1.25", "7", 8, "-4.76837158"},
+		{"2", "0.5", 50,
+			"1.41421356237309504880168872420969807856967187537695"},
+		{"10", "-1.5", 55,
+			"0.0316227766016837933199889354443271853371955513932521683"},
+		{"3.5", "-7.25", 60,
+			"0.000113633577179036386686117042393513335128445344520714548811"},
+		{"123.456", "20.5", 20,
+			"7516231248372774067546583603508713535411347.68533137646432646917"},
+		{"0.5", "100.25", 60,
+			"0.000000000000000000000000000000663350307334149093926669145064"},
+		{"7.25", "0", 2, "1.00"},
+		{"0", "3.5", 2, "0.00"},
+	}
+
+	mathFloatHelper := MathFloatHelper{}
+
+	var err error
+	var ok bool
+	var base, exponent, raisedToExponent *big.Float
+	var raisedToExponentNumStr NumberStrKernel
+	var actualNumStr string
+
+	for i := 0; i < len(testData); i++ {
+
+		base,
+			ok = new(big.Float).
+			SetPrec(512).
+			SetString(testData[i].baseStr)
+
+		if !ok {
+			t.Errorf("%v Test #%v\n"+
+				"Error: base.SetString() FAILED!\n"+
+				"baseStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].baseStr)
+			return
+		}
+
+		exponent,
+			ok = new(big.Float).
+			SetPrec(512).
+			SetString(testData[i].exponentStr)
+
+		if !ok {
+			t.Errorf("%v Test #%v\n"+
+				"Error: exponent.SetString() FAILED!\n"+
+				"exponentStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].exponentStr)
+			return
+		}
+
+		raisedToExponent,
+			raisedToExponentNumStr,
+			err = mathFloatHelper.Pow(
+			base,
+			exponent,
+			testData[i].fracDigits,
+			NumRoundType.HalfAwayFromZero(),
+			ePrefix.XCpy(
+				"base^exponent"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualNumStr,
+			_,
+			err = raisedToExponentNumStr.FmtNumStrPure(
+			".",
+			true,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"raisedToExponentNumStr"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expectedResult {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: NumberStrKernel result is invalid!\n"+
+				"Base            = '%v'\n"+
+				"Exponent        = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].baseStr,
+				testData[i].exponentStr,
+				testData[i].expectedResult,
+				actualNumStr)
+
+			return
+		}
+
+		actualNumStr = raisedToExponent.Text(
+			'f',
+			testData[i].fracDigits)
+
+		if actualNumStr != testData[i].expectedResult {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: big.Float result is invalid!\n"+
+				"Base            = '%v'\n"+
+				"Exponent        = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].baseStr,
+				testData[i].exponentStr,
+				testData[i].expectedResult,
+				actualNumStr)
+
+			return
+		}
+	}
+
+	type powErrorTest struct {
+		baseStr     string
+		exponentStr string
+	}
+
+	errorData := []powErrorTest{
+		{"0", "-1"},
+		{"-2", "0.5"},
+		{"10", "2000000"},
+	}
+
+	for i := 0; i < len(errorData); i++ {
+
+		base,
+			_ = new(big.Float).SetString(errorData[i].baseStr)
+
+		exponent,
+			_ = new(big.Float).SetString(errorData[i].exponentStr)
+
+		_,
+			_,
+			err = mathFloatHelper.Pow(
+			base,
+			exponent,
+			5,
+			NumRoundType.HalfAwayFromZero(),
+			ePrefix.XCpy(
+				"errorData"))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from Pow()\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n"+
+				"Base     = '%v'\n"+
+				"Exponent = '%v'\n",
+				ePrefix.String(),
+				i,
+				errorData[i].baseStr,
+				errorData[i].exponentStr)
+
+			return
+		}
+	}
+}
+
+func TestMathFloatHelper_NthRoot_000200(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestMathFloatHelper_NthRoot_000200",
+		"")
+
+	type nthRootTest struct {
+		radicandStr    string
+		nthRoot        int64
+		fracDigits     int
+		roundingType   NumberRoundingType
+		expectedResult string
+	}
+
+	testData := []nthRootTest{
+		{"2", 2, 5, NumRoundType.Ceiling(), "1.41422"},
+		{"2", 2, 5, NumRoundType.Floor(), "1.41421"},
+		{"2", 2, 5, NumRoundType.Truncate(), "1.41421"},
+		{"4", 2, 5, NumRoundType.Ceiling(), "2.00000"},
+		{"4", 2, 5, NumRoundType.Floor(), "2.00000"},
+		{"2", 3, 5, NumRoundType.Ceiling(), "1.25993"},
+		{"2", 3, 5, NumRoundType.Floor(), "1.25992"},
+		{"-32", 3, 5, NumRoundType.Ceiling(), "-3.17480"},
+		{"-32", 3, 5, NumRoundType.Floor(), "-3.17481"},
+		{"-32", 3, 5, NumRoundType.Truncate(), "-3.17480"},
+		{"27", 3, 5, NumRoundType.Ceiling(), "3.00000"},
+		{"27", 3, 5, NumRoundType.Floor(), "3.00000"},
+		{"0.25", 2, 0, NumRoundType.Ceiling(), "1"},
+		{"0.25", 2, 0, NumRoundType.Floor(), "0"},
+		{"0.0625", 2, 5, NumRoundType.Floor(), "0.25000"},
+		{"0.0625", 2, 5, NumRoundType.Ceiling(), "0.25000"},
+		{"0.001", 3, 5, NumRoundType.Floor(), "0.10000"},
+		{"0.001", 3, 5, NumRoundType.Ceiling(), "0.10000"},
+		{"-0.008", 3, 5, NumRoundType.Floor(), "-0.20000"},
+		{"-0.008", 3, 5, NumRoundType.Truncate(), "-0.20000"},
+	}
+
+	mathFloatHelper := MathFloatHelper{}
+
+	var err error
+	var ok bool
+	var radicand, root *big.Float
+	var rootNumStr NumberStrKernel
+	var actualNumStr string
+
+	for i := 0; i < len(testData); i++ {
+
+		radicand,
+			ok = new(big.Float).
+			SetPrec(512).
+			SetString(testData[i].radicandStr)
+
+		if !ok {
+			t.Errorf("%v Test #%v\n"+
+				"Error: radicand.SetString() FAILED!\n"+
+				"radicandStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].radicandStr)
+			return
+		}
+
+		if testData[i].nthRoot == 2 {
+
+			root,
+				rootNumStr,
+				err = mathFloatHelper.Sqrt(
+				radicand,
+				testData[i].fracDigits,
+				testData[i].roundingType,
+				ePrefix.XCpy(
+					"root<-radicand"))
+
+		} else {
+
+			root,
+				rootNumStr,
+				err = mathFloatHelper.NthRoot(
+				radicand,
+				testData[i].nthRoot,
+				testData[i].fracDigits,
+				testData[i].roundingType,
+				ePrefix.XCpy(
+					"root<-radicand"))
+		}
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualNumStr,
+			_,
+			err = rootNumStr.FmtNumStrPure(
+			".",
+			true,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"rootNumStr"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expectedResult {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: NumberStrKernel root is invalid!\n"+
+				"Radicand        = '%v'\n"+
+				"Nth Root        = '%v'\n"+
+				"Rounding Type   = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].radicandStr,
+				testData[i].nthRoot,
+				testData[i].roundingType.String(),
+				testData[i].expectedResult,
+				actualNumStr)
+
+			return
+		}
+
+		actualNumStr = root.Text('f', testData[i].fracDigits)
+
+		if actualNumStr != testData[i].expectedResult {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: big.Float root is invalid!\n"+
+				"Radicand        = '%v'\n"+
+				"Nth Root        = '%v'\n"+
+				"Rounding Type   = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].radicandStr,
+				testData[i].nthRoot,
+				testData[i].roundingType.String(),
+				testData[i].expectedResult,
+				actualNumStr)
+
+			return
+		}
+	}
+}
+
+func TestMathFloatHelper_Pow_000200(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestMathFloatHelper_Pow_000200",
+		"")
+
+	type powTest struct {
+		baseStr        string
+		exponentStr    string
+		fracDigits     int
+		roundingType   NumberRoundingType
+		expectedResult string
+	}
+
+	testData := []powTest{
+		{"10", "-3", 5, NumRoundType.Floor(), "0.00100"},
+		{"10", "-3", 5, NumRoundType.Ceiling(), "0.00100"},
+		{"10", "-3", 5, NumRoundType.Truncate(), "0.00100"},
+		{"10", "-1", 4, NumRoundType.Ceiling(), "0.1000"},
+		{"5", "-2", 4, NumRoundType.Ceiling(), "0.0400"},
+		{"5", "-2", 4, NumRoundType.Floor(), "0.0400"},
+		{"-2", "-3", 4, NumRoundType.Floor(), "-0.1250"},
+		{"-2", "-3", 4, NumRoundType.Ceiling(), "-0.1250"},
+		{"3", "-1", 4, NumRoundType.Floor(), "0.3333"},
+		{"3", "-1", 4, NumRoundType.Ceiling(), "0.3334"},
+		{"1.1", "2", 2, NumRoundType.Floor(), "1.21"},
+		{"0.1", "-1", 3, NumRoundType.Floor(), "10.000"},
+		{"25", "0.5", 6, NumRoundType.Floor(), "5.000000"},
+		{"25", "0.5", 6, NumRoundType.Ceiling(), "5.000000"},
+		{"9", "1.5", 6, NumRoundType.Floor(), "27.000000"},
+		{"9", "1.5", 6, NumRoundType.Truncate(), "27.000000"},
+		{"100", "1.5", 6, NumRoundType.Ceiling(), "1000.000000"},
+		{"100", "-0.5", 6, NumRoundType.Ceiling(), "0.100000"},
+		{"100", "-0.5", 6, NumRoundType.Floor(), "0.100000"},
+		{"9", "-0.5", 4, NumRoundType.Floor(), "0.3333"},
+		{"9", "-0.5", 4, NumRoundType.Ceiling(), "0.3334"},
+		{"10000000000", "0.1", 3, NumRoundType.Floor(), "10.000"},
+		{"2", "0.5", 5, NumRoundType.Floor(), "1.41421"},
+		{"2", "0.5", 5, NumRoundType.Ceiling(), "1.41422"},
+	}
+
+	mathFloatHelper := MathFloatHelper{}
+
+	var err error
+	var ok bool
+	var base, exponent, raisedToExponent *big.Float
+	var raisedToExponentNumStr NumberStrKernel
+	var actualNumStr string
+
+	for i := 0; i < len(testData); i++ {
+
+		base,
+			ok = new(big.Float).
+			SetPrec(512).
+			SetString(testData[i].baseStr)
+
+		if !ok {
+			t.Errorf("%v Test #%v\n"+
+				"Error: base.SetString() FAILED!\n"+
+				"baseStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].baseStr)
+			return
+		}
+
+		exponent,
+			ok = new(big.Float).
+			SetPrec(512).
+			SetString(testData[i].exponentStr)
+
+		if !ok {
+			t.Errorf("%v Test #%v\n"+
+				"Error: exponent.SetString() FAILED!\n"+
+				"exponentStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].exponentStr)
+			return
+		}
+
+		raisedToExponent,
+			raisedToExponentNumStr,
+			err = mathFloatHelper.Pow(
+			base,
+			exponent,
+			testData[i].fracDigits,
+			testData[i].roundingType,
+			ePrefix.XCpy(
+				"base^exponent"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualNumStr,
+			_,
+			err = raisedToExponentNumStr.FmtNumStrPure(
+			".",
+			true,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"raisedToExponentNumStr"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expectedResult {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: NumberStrKernel result is invalid!\n"+
+				"Base            = '%v'\n"+
+				"Exponent        = '%v'\n"+
+				"Rounding Type   = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].baseStr,
+				testData[i].exponentStr,
+				testData[i].roundingType.String(),
+				testData[i].expectedResult,
+				actualNumStr)
+
+			return
+		}
+
+		actualNumStr = raisedToExponent.Text('f', testData[i].fracDigits)
+
+		if actualNumStr != testData[i].expectedResult {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: big.Float result is invalid!\n"+
+				"Base            = '%v'\n"+
+				"Exponent        = '%v'\n"+
+				"Rounding Type   = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].baseStr,
+				testData[i].exponentStr,
+				testData[i].roundingType.String(),
+				testData[i].expectedResult,
+				actualNumStr)
+
+			return
+		}
+	}
+}
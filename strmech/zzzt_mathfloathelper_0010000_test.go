@@ -0,0 +1,865 @@
+package strmech
+
+import (
+	"fmt"
+	ePref "github.com/MikeAustin71/errpref"
+	"math/big"
+	"testing"
+)
+
+func TestMathFloatHelper_Transcendental_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestMathFloatHelper_Transcendental_000100",
+		"")
+
+	type transcendentalTest struct {
+		funcName       string
+		inputNumStr    string
+		fracDigits     int
+		expectedResult string
+	}
+
+	testData := []transcendentalTest{
+		{"ConstantE", "", 60,
+			"2.718281828459045235360287471352662497757247093699959574966968"},
+		{"ConstantLn2", "", 60,
+			"0.693147180559945309417232121458176568075500134360255254120680"},
+		{"Exp", "2.5", 50,
+			"12.18249396070347343807017595116796618318276779006316"},
+		{"Exp", "-12.75", 50,
+			"0.00000290232040865040388563698513062825881581082054"},
+		{"Exp", "0", 5,
+			"1.00000"},
+		{"Ln", "2.5", 50,
+			"0.91629073187415506518352721176801107145010121990826"},
+		{"Ln", "0.001", 50,
+			"-6.90775527898213705205397436405309262280330446588632"},
+		{"Ln", "1", 4,
+			"0.0000"},
+		{"Log10", "0.001", 10,
+			"-3.0000000000"},
+		{"Log10", "2.5", 50,
+			"0.39794000867203760957252221055101394646362023707578"},
+		{"Sin", "2.5", 50,
+			"0.59847214410395649405185470218616227170359717157722"},
+		{"Sin", "-0.3", 50,
+			"-0.29552020666133957510532074568502737367783211174262"},
+		{"Sin", "1000000", 50,
+			"-0.34999350217129295211765248678077146906140660532872"},
+		{"Cos", "2.5", 50,
+			"-0.80114361554693371483350279046735166442856784876782"},
+		{"Tan", "2.5", 50,
+			"-0.74702229723866027935535268782527455790411695688301"},
+		{"Tan", "1.5707963267948966", 30,
+			"51998506188720270.660194741661226868475811544987"},
+		{"Atan", "2.5", 50,
+			"1.19028994968253173292773377482931833760117898602945"},
+		{"Atan", "-0.3", 50,
+			"-0.29145679447786709199560462143289119350316759901207"},
+	}
+
+	mathFloatHelper := MathFloatHelper{}
+
+	var err error
+	var ok bool
+	var inputNum, result *big.Float
+	var resultNumStr NumberStrKernel
+	var actualNumStr string
+
+	for i := 0; i < len(testData); i++ {
+
+		inputNum = new(big.Float).SetPrec(512)
+
+		if len(testData[i].inputNumStr) > 0 {
+
+			inputNum,
+				ok = inputNum.SetString(testData[i].inputNumStr)
+
+			if !ok {
+				t.Errorf("%v Test #%v\n"+
+					"Error: inputNum.SetString() FAILED!\n"+
+					"inputNumStr = '%v'\n",
+					ePrefix.String(),
+					i,
+					testData[i].inputNumStr)
+				return
+			}
+		}
+
+		switch testData[i].funcName {
+
+		case "ConstantE":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.ConstantE(
+				testData[i].fracDigits,
+				NumRoundType.HalfAwayFromZero(),
+				ePrefix.XCpy(
+					"ConstantE()"))
+
+		case "ConstantLn2":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.ConstantLn2(
+				testData[i].fracDigits,
+				NumRoundType.HalfAwayFromZero(),
+				ePrefix.XCpy(
+					"ConstantLn2()"))
+
+		case "Exp":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.Exp(
+				inputNum,
+				testData[i].fracDigits,
+				NumRoundType.HalfAwayFromZero(),
+				ePrefix.XCpy(
+					"Exp()"))
+
+		case "Ln":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.Ln(
+				inputNum,
+				testData[i].fracDigits,
+				NumRoundType.HalfAwayFromZero(),
+				ePrefix.XCpy(
+					"Ln()"))
+
+		case "Log10":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.Log10(
+				inputNum,
+				testData[i].fracDigits,
+				NumRoundType.HalfAwayFromZero(),
+				ePrefix.XCpy(
+					"Log10()"))
+
+		case "Sin":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.Sin(
+				inputNum,
+				testData[i].fracDigits,
+				NumRoundType.HalfAwayFromZero(),
+				ePrefix.XCpy(
+					"Sin()"))
+
+		case "Cos":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.Cos(
+				inputNum,
+				testData[i].fracDigits,
+				NumRoundType.HalfAwayFromZero(),
+				ePrefix.XCpy(
+					"Cos()"))
+
+		case "Tan":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.Tan(
+				inputNum,
+				testData[i].fracDigits,
+				NumRoundType.HalfAwayFromZero(),
+				ePrefix.XCpy(
+					"Tan()"))
+
+		case "Atan":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.Atan(
+				inputNum,
+				testData[i].fracDigits,
+				NumRoundType.HalfAwayFromZero(),
+				ePrefix.XCpy(
+					"Atan()"))
+
+		default:
+
+			err = fmt.Errorf("invalid funcName '%v'",
+				testData[i].funcName)
+		}
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualNumStr,
+			_,
+			err = resultNumStr.FmtNumStrPure(
+			".",
+			true,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"resultNumStr"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expectedResult {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: NumberStrKernel result is invalid!\n"+
+				"Function        = '%v'\n"+
+				"Input Number    = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].funcName,
+				testData[i].inputNumStr,
+				testData[i].expectedResult,
+				actualNumStr)
+
+			return
+		}
+
+		actualNumStr = result.Text('f', testData[i].fracDigits)
+
+		if actualNumStr != testData[i].expectedResult {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: big.Float result is invalid!\n"+
+				"Function        = '%v'\n"+
+				"Input Number    = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].funcName,
+				testData[i].inputNumStr,
+				testData[i].expectedResult,
+				actualNumStr)
+
+			return
+		}
+	}
+}
+
+func TestMathFloatHelper_Transcendental_000200(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestMathFloatHelper_Transcendental_000200",
+		"")
+
+	mathFloatHelper := MathFloatHelper{}
+
+	var err error
+
+	_,
+		_,
+		err = mathFloatHelper.Ln(
+		big.NewFloat(-1),
+		5,
+		NumRoundType.HalfAwayFromZero(),
+		ePrefix.XCpy(
+			"Ln(-1)"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from Ln()\n"+
+			"because 'num' is negative.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	_,
+		_,
+		err = mathFloatHelper.Log10(
+		big.NewFloat(0),
+		5,
+		NumRoundType.HalfAwayFromZero(),
+		ePrefix.XCpy(
+			"Log10(0)"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from Log10()\n"+
+			"because 'num' is zero.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	_,
+		_,
+		err = mathFloatHelper.Exp(
+		big.NewFloat(10000000),
+		5,
+		NumRoundType.HalfAwayFromZero(),
+		ePrefix.XCpy(
+			"Exp(10000000)"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from Exp()\n"+
+			"because the result exceeds the maximum value.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	_,
+		_,
+		err = mathFloatHelper.Sin(
+		big.NewFloat(2.5),
+		5,
+		NumRoundType.NoRounding(),
+		ePrefix.XCpy(
+			"NoRounding"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from Sin()\n"+
+			"because 'roundingType' is NoRounding.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	hugeRadians,
+		_ := new(big.Float).SetPrec(4096).SetString("1e1001")
+
+	_,
+		_,
+		err = mathFloatHelper.Cos(
+		hugeRadians,
+		5,
+		NumRoundType.HalfAwayFromZero(),
+		ePrefix.XCpy(
+			"Cos(1e1001)"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from Cos()\n"+
+			"because 'radians' is too large.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+}
+
+func TestMathFloatHelper_Transcendental_000300(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestMathFloatHelper_Transcendental_000300",
+		"")
+
+	type transcendentalTest struct {
+		funcName       string
+		inputNumStr    string
+		fracDigits     int
+		roundingType   NumberRoundingType
+		expectedResult string
+	}
+
+	testData := []transcendentalTest{
+		{"Exp", "0.5", 5, NumRoundType.Ceiling(), "1.64873"},
+		{"Exp", "0.5", 5, NumRoundType.Floor(), "1.64872"},
+		{"Exp", "-0.5", 5, NumRoundType.Ceiling(), "0.60654"},
+		{"Exp", "-0.5", 5, NumRoundType.Floor(), "0.60653"},
+		{"Exp", "0", 5, NumRoundType.Ceiling(), "1.00000"},
+		{"Exp", "-1e400", 5, NumRoundType.HalfAwayFromZero(), "0.00000"},
+		{"Exp", "-1e400", 5, NumRoundType.Floor(), "0.00000"},
+		{"Exp", "-1e400", 5, NumRoundType.Truncate(), "0.00000"},
+		{"Exp", "-1e400", 5, NumRoundType.Ceiling(), "0.00001"},
+		{"Exp", "-30", 5, NumRoundType.HalfToEven(), "0.00000"},
+		{"Exp", "-30", 12, NumRoundType.HalfAwayFromZero(), "0.000000000000"},
+		{"Exp", "-30", 14, NumRoundType.HalfAwayFromZero(), "0.00000000000009"},
+		{"Ln", "2.5", 5, NumRoundType.Ceiling(), "0.91630"},
+		{"Ln", "2.5", 5, NumRoundType.Floor(), "0.91629"},
+		{"Ln", "0.5", 5, NumRoundType.Ceiling(), "-0.69314"},
+		{"Ln", "0.5", 5, NumRoundType.Floor(), "-0.69315"},
+		{"Ln", "1", 5, NumRoundType.Floor(), "0.00000"},
+		{"Sin", "0.5", 5, NumRoundType.Ceiling(), "0.47943"},
+		{"Sin", "0.5", 5, NumRoundType.Floor(), "0.47942"},
+		{"Sin", "-0.5", 5, NumRoundType.Ceiling(), "-0.47942"},
+		{"Sin", "-0.5", 5, NumRoundType.Floor(), "-0.47943"},
+		{"Cos", "2.5", 5, NumRoundType.Ceiling(), "-0.80114"},
+		{"Cos", "2.5", 5, NumRoundType.Floor(), "-0.80115"},
+		{"Cos", "0", 5, NumRoundType.Floor(), "1.00000"},
+		{"Tan", "2.5", 5, NumRoundType.Ceiling(), "-0.74702"},
+		{"Tan", "2.5", 5, NumRoundType.Floor(), "-0.74703"},
+		{"Tan", "0.5", 5, NumRoundType.Ceiling(), "0.54631"},
+		{"Tan", "0.5", 5, NumRoundType.Truncate(), "0.54630"},
+		{"Atan", "1", 5, NumRoundType.Ceiling(), "0.78540"},
+		{"Atan", "1", 5, NumRoundType.Floor(), "0.78539"},
+		{"Atan", "-1", 5, NumRoundType.Ceiling(), "-0.78539"},
+		{"Atan", "-1", 5, NumRoundType.Floor(), "-0.78540"},
+	}
+
+	mathFloatHelper := MathFloatHelper{}
+
+	var err error
+	var ok bool
+	var inputNum, result *big.Float
+	var resultNumStr NumberStrKernel
+	var actualNumStr string
+
+	for i := 0; i < len(testData); i++ {
+
+		inputNum,
+			ok = new(big.Float).
+			SetPrec(512).
+			SetString(testData[i].inputNumStr)
+
+		if !ok {
+			t.Errorf("%v Test #%v\n"+
+				"Error: inputNum.SetString() FAILED!\n"+
+				"inputNumStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].inputNumStr)
+			return
+		}
+
+		switch testData[i].funcName {
+
+		case "Exp":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.Exp(
+				inputNum,
+				testData[i].fracDigits,
+				testData[i].roundingType,
+				ePrefix.XCpy(
+					"Exp()"))
+
+		case "Ln":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.Ln(
+				inputNum,
+				testData[i].fracDigits,
+				testData[i].roundingType,
+				ePrefix.XCpy(
+					"Ln()"))
+
+		case "Sin":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.Sin(
+				inputNum,
+				testData[i].fracDigits,
+				testData[i].roundingType,
+				ePrefix.XCpy(
+					"Sin()"))
+
+		case "Cos":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.Cos(
+				inputNum,
+				testData[i].fracDigits,
+				testData[i].roundingType,
+				ePrefix.XCpy(
+					"Cos()"))
+
+		case "Tan":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.Tan(
+				inputNum,
+				testData[i].fracDigits,
+				testData[i].roundingType,
+				ePrefix.XCpy(
+					"Tan()"))
+
+		case "Atan":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.Atan(
+				inputNum,
+				testData[i].fracDigits,
+				testData[i].roundingType,
+				ePrefix.XCpy(
+					"Atan()"))
+
+		default:
+
+			err = fmt.Errorf("invalid funcName '%v'",
+				testData[i].funcName)
+		}
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualNumStr,
+			_,
+			err = resultNumStr.FmtNumStrPure(
+			".",
+			true,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"resultNumStr"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expectedResult {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: NumberStrKernel result is invalid!\n"+
+				"Function        = '%v'\n"+
+				"Input Number    = '%v'\n"+
+				"Rounding Type   = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].funcName,
+				testData[i].inputNumStr,
+				testData[i].roundingType.String(),
+				testData[i].expectedResult,
+				actualNumStr)
+
+			return
+		}
+
+		actualNumStr = result.Text('f', testData[i].fracDigits)
+
+		if actualNumStr != testData[i].expectedResult {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: big.Float result is invalid!\n"+
+				"Function        = '%v'\n"+
+				"Input Number    = '%v'\n"+
+				"Rounding Type   = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].funcName,
+				testData[i].inputNumStr,
+				testData[i].roundingType.String(),
+				testData[i].expectedResult,
+				actualNumStr)
+
+			return
+		}
+	}
+}
+
+func TestMathFloatHelper_Transcendental_000400(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestMathFloatHelper_Transcendental_000400",
+		"")
+
+	type transcendentalTest struct {
+		funcName       string
+		inputNumStr    string
+		fracDigits     int
+		roundingType   NumberRoundingType
+		expectedResult string
+	}
+
+	testData := []transcendentalTest{
+		{"Exp", "0.1", 20, NumRoundType.Ceiling(), "1.10517091807564762482"},
+		{"Exp", "0.1", 20, NumRoundType.Floor(), "1.10517091807564762481"},
+		{"Exp", "0", 5, NumRoundType.Ceiling(), "1.00000"},
+		{"Ln", "0.1", 20, NumRoundType.Ceiling(), "-2.30258509299404568401"},
+		{"Ln", "0.1", 20, NumRoundType.Floor(), "-2.30258509299404568402"},
+		{"Ln", "1", 20, NumRoundType.Ceiling(), "0.00000000000000000000"},
+		{"Log10", "0.001", 20, NumRoundType.Ceiling(), "-3.00000000000000000000"},
+		{"Log10", "0.001", 20, NumRoundType.Floor(), "-3.00000000000000000000"},
+		{"Log10", "1000", 20, NumRoundType.Floor(), "3.00000000000000000000"},
+		{"Sin", "0.1", 20, NumRoundType.Ceiling(), "0.09983341664682815231"},
+		{"Sin", "0.1", 20, NumRoundType.Floor(), "0.09983341664682815230"},
+		{"Cos", "0.1", 20, NumRoundType.Ceiling(), "0.99500416527802576610"},
+		{"Cos", "0.1", 20, NumRoundType.Floor(), "0.99500416527802576609"},
+		{"Tan", "0.1", 20, NumRoundType.Ceiling(), "0.10033467208545054506"},
+		{"Tan", "0.1", 20, NumRoundType.Floor(), "0.10033467208545054505"},
+		{"Atan", "0.1", 20, NumRoundType.Ceiling(), "0.09966865249116202738"},
+		{"Atan", "0.1", 20, NumRoundType.Floor(), "0.09966865249116202737"},
+		{"Atan", "-0.1", 20, NumRoundType.Ceiling(), "-0.09966865249116202737"},
+	}
+
+	mathFloatHelper := MathFloatHelper{}
+
+	var err error
+	var inputNumStrKernel NumberStrKernel
+	var result *big.Float
+	var resultNumStr NumberStrKernel
+	var actualNumStr string
+
+	for i := 0; i < len(testData); i++ {
+
+		inputNumStrKernel,
+			_,
+			err = new(NumberStrKernel).
+			NewParsePureNumberStr(
+				testData[i].inputNumStr,
+				".",
+				true,
+				NumRoundType.NoRounding(),
+				0,
+				ePrefix.XCpy(
+					"inputNumStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		switch testData[i].funcName {
+
+		case "Exp":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.ExpNumStrKernel(
+				&inputNumStrKernel,
+				testData[i].fracDigits,
+				testData[i].roundingType,
+				ePrefix.XCpy(
+					"ExpNumStrKernel()"))
+
+		case "Ln":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.LnNumStrKernel(
+				&inputNumStrKernel,
+				testData[i].fracDigits,
+				testData[i].roundingType,
+				ePrefix.XCpy(
+					"LnNumStrKernel()"))
+
+		case "Log10":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.Log10NumStrKernel(
+				&inputNumStrKernel,
+				testData[i].fracDigits,
+				testData[i].roundingType,
+				ePrefix.XCpy(
+					"Log10NumStrKernel()"))
+
+		case "Sin":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.SinNumStrKernel(
+				&inputNumStrKernel,
+				testData[i].fracDigits,
+				testData[i].roundingType,
+				ePrefix.XCpy(
+					"SinNumStrKernel()"))
+
+		case "Cos":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.CosNumStrKernel(
+				&inputNumStrKernel,
+				testData[i].fracDigits,
+				testData[i].roundingType,
+				ePrefix.XCpy(
+					"CosNumStrKernel()"))
+
+		case "Tan":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.TanNumStrKernel(
+				&inputNumStrKernel,
+				testData[i].fracDigits,
+				testData[i].roundingType,
+				ePrefix.XCpy(
+					"TanNumStrKernel()"))
+
+		case "Atan":
+
+			result,
+				resultNumStr,
+				err = mathFloatHelper.AtanNumStrKernel(
+				&inputNumStrKernel,
+				testData[i].fracDigits,
+				testData[i].roundingType,
+				ePrefix.XCpy(
+					"AtanNumStrKernel()"))
+
+		default:
+
+			err = fmt.Errorf("invalid funcName '%v'",
+				testData[i].funcName)
+		}
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualNumStr,
+			_,
+			err = resultNumStr.FmtNumStrPure(
+			".",
+			true,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"resultNumStr"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expectedResult {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: NumberStrKernel result is invalid!\n"+
+				"Function        = '%v'\n"+
+				"Input Number    = '%v'\n"+
+				"Rounding Type   = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].funcName,
+				testData[i].inputNumStr,
+				testData[i].roundingType.String(),
+				testData[i].expectedResult,
+				actualNumStr)
+
+			return
+		}
+
+		actualNumStr = result.Text('f', testData[i].fracDigits)
+
+		if actualNumStr != testData[i].expectedResult {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: big.Float result is invalid!\n"+
+				"Function        = '%v'\n"+
+				"Input Number    = '%v'\n"+
+				"Rounding Type   = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].funcName,
+				testData[i].inputNumStr,
+				testData[i].roundingType.String(),
+				testData[i].expectedResult,
+				actualNumStr)
+
+			return
+		}
+	}
+
+	_,
+		_,
+		err = mathFloatHelper.LnNumStrKernel(
+		nil,
+		5,
+		NumRoundType.HalfAwayFromZero(),
+		ePrefix.XCpy(
+			"nil param"))
+
+	if err == nil {
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from\n"+
+			"LnNumStrKernel() because 'num' is a nil pointer.\n"+
+			"However, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	inputNumStrKernel,
+		_,
+		err = new(NumberStrKernel).
+		NewParsePureNumberStr(
+			"-0.1",
+			".",
+			true,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"inputNumStrKernel"))
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			ePrefix.String(),
+			err.Error())
+		return
+	}
+
+	_,
+		_,
+		err = mathFloatHelper.Log10NumStrKernel(
+		&inputNumStrKernel,
+		5,
+		NumRoundType.HalfAwayFromZero(),
+		ePrefix.XCpy(
+			"negative param"))
+
+	if err == nil {
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from\n"+
+			"Log10NumStrKernel() because 'num' is negative.\n"+
+			"However, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+	}
+}
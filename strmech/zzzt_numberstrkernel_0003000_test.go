@@ -0,0 +1,435 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"testing"
+)
+
+func TestNumberStrKernel_Add_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumberStrKernel_Add_000100",
+		"")
+
+	testData := [][3]string{
+		{"123.45", "-0.125", "123.325"},
+		{"999999999999999999999.99", "0.01", "1,000,000,000,000,000,000,000.00"},
+		{"-5", "-7.5", "-12.5"},
+		{"10.25", "-10.25", "0.00"},
+		{"-0.001", "1", "0.999"},
+	}
+
+	var err error
+	var addend01, addend02, sum NumberStrKernel
+	var actualNumStr string
+
+	for i := 0; i < len(testData); i++ {
+
+		addend01,
+			_,
+			err = new(NumberStrKernel).NewParseNativeNumberStr(
+			testData[i][0],
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"addend01"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		addend02,
+			_,
+			err = new(NumberStrKernel).NewParseNativeNumberStr(
+			testData[i][1],
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"addend02"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		sum,
+			err = addend01.Add(
+			&addend02,
+			ePrefix.XCpy(
+				"sum"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		actualNumStr = sum.String()
+
+		if actualNumStr != testData[i][2] {
+
+			t.Errorf("%v\n"+
+				"Test #%v - addend01.Add(addend02)\n"+
+				"Error: actualNumStr != expectedNumStr\n"+
+				"actualNumStr   = '%v'\n"+
+				"expectedNumStr = '%v'\n",
+				ePrefix.String(),
+				i+1,
+				actualNumStr,
+				testData[i][2])
+
+			return
+		}
+	}
+}
+
+func TestNumberStrKernel_Divide_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumberStrKernel_Divide_000100",
+		"")
+
+	type divideTestData struct {
+		dividend       string
+		divisor        string
+		precision      int
+		roundingType   NumberRoundingType
+		expectedNumStr string
+	}
+
+	testData := []divideTestData{
+		{"2", "3", 5, NumRoundType.HalfAwayFromZero(), "0.66667"},
+		{"-2", "3", 5, NumRoundType.Truncate(), "-0.66666"},
+		{"0.1250001", "1", 2, NumRoundType.HalfToEven(), "0.13"},
+		{"0.125", "1", 2, NumRoundType.HalfToEven(), "0.12"},
+		{"22", "7", 10, NumRoundType.HalfAwayFromZero(), "3.1428571429"},
+		{"-1", "-0.0004", 1, NumRoundType.NoRounding(), "2,500.0"},
+		{"1.5", "-0.3", 0, NumRoundType.HalfAwayFromZero(), "-5"},
+		{"-1", "3000", 2, NumRoundType.HalfAwayFromZero(), "0.00"},
+		{"1", "3000", 2, NumRoundType.Ceiling(), "0.01"},
+		{"-1", "3000", 2, NumRoundType.Ceiling(), "0.00"},
+		{"1", "3000", 2, NumRoundType.Floor(), "0.00"},
+		{"-1", "3000", 2, NumRoundType.Floor(), "-0.01"},
+		{"1", "3000", 2, NumRoundType.Truncate(), "0.00"},
+		{"-1", "3000", 2, NumRoundType.Truncate(), "0.00"},
+		{"2", "3", 3, NumRoundType.Ceiling(), "0.667"},
+		{"-2", "3", 3, NumRoundType.Ceiling(), "-0.666"},
+		{"2", "3", 3, NumRoundType.Floor(), "0.666"},
+		{"-2", "3", 3, NumRoundType.Floor(), "-0.667"},
+		{"1", "1600", 3, NumRoundType.Ceiling(), "0.001"},
+		{"-1", "1600", 3, NumRoundType.Floor(), "-0.001"},
+		{"1", "8", 2, NumRoundType.Ceiling(), "0.13"},
+		{"-1", "8", 2, NumRoundType.Floor(), "-0.13"},
+		{"1", "4", 2, NumRoundType.Ceiling(), "0.25"},
+		{"-10", "4", 0, NumRoundType.Floor(), "-3"},
+		{"-10", "4", 0, NumRoundType.Truncate(), "-2"},
+		{"99.99", "10", 2, NumRoundType.Ceiling(), "10.00"},
+	}
+
+	var err error
+	var dividend, divisor, quotient NumberStrKernel
+	var actualNumStr string
+
+	for i := 0; i < len(testData); i++ {
+
+		dividend,
+			_,
+			err = new(NumberStrKernel).NewParseNativeNumberStr(
+			testData[i].dividend,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"dividend"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		divisor,
+			_,
+			err = new(NumberStrKernel).NewParseNativeNumberStr(
+			testData[i].divisor,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"divisor"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		quotient,
+			err = dividend.Divide(
+			&divisor,
+			testData[i].precision,
+			testData[i].roundingType,
+			ePrefix.XCpy(
+				"quotient"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		actualNumStr = quotient.String()
+
+		if actualNumStr != testData[i].expectedNumStr {
+
+			t.Errorf("%v\n"+
+				"Test #%v - dividend.Divide(divisor)\n"+
+				"Error: actualNumStr != expectedNumStr\n"+
+				"actualNumStr   = '%v'\n"+
+				"expectedNumStr = '%v'\n",
+				ePrefix.String(),
+				i+1,
+				actualNumStr,
+				testData[i].expectedNumStr)
+
+			return
+		}
+	}
+}
+
+func TestNumberStrKernel_Divide_000200(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumberStrKernel_Divide_000200",
+		"")
+
+	dividend,
+		_,
+		err := new(NumberStrKernel).NewParseNativeNumberStr(
+		"5.25",
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"dividend"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var divisor NumberStrKernel
+
+	divisor,
+		err = new(NumberStrKernel).NewFromStringDigits(
+		"0",
+		"00",
+		NumSignVal.Zero(),
+		ePrefix.XCpy(
+			"divisor"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	_,
+		err = dividend.Divide(
+		&divisor,
+		2,
+		NumRoundType.HalfAwayFromZero(),
+		ePrefix.XCpy(
+			"Division By Zero"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: dividend.Divide(divisor)\n"+
+			"Expected an error return from division by zero.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	_,
+		err = dividend.Divide(
+		&dividend,
+		-1,
+		NumRoundType.HalfAwayFromZero(),
+		ePrefix.XCpy(
+			"precision=-1"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: dividend.Divide(dividend)\n"+
+			"Expected an error return from invalid precision.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+}
+
+func TestNumberStrKernel_Multiply_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumberStrKernel_Multiply_000100",
+		"")
+
+	testData := [][3]string{
+		{"-1.5", "2.25", "-3.375"},
+		{"123456789012345678901234567890", "987654321", "121,932,631,124,828,532,112,482,853,211,126,352,690"},
+		{"-0.02", "-0.5", "0.010"},
+		{"0", "-17.4", "0.0"},
+	}
+
+	var err error
+	var multiplicand, multiplier, product NumberStrKernel
+	var actualNumStr string
+
+	for i := 0; i < len(testData); i++ {
+
+		multiplicand,
+			_,
+			err = new(NumberStrKernel).NewParseNativeNumberStr(
+			testData[i][0],
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"multiplicand"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		multiplier,
+			_,
+			err = new(NumberStrKernel).NewParseNativeNumberStr(
+			testData[i][1],
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"multiplier"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		product,
+			err = multiplicand.Multiply(
+			&multiplier,
+			ePrefix.XCpy(
+				"product"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		actualNumStr = product.String()
+
+		if actualNumStr != testData[i][2] {
+
+			t.Errorf("%v\n"+
+				"Test #%v - multiplicand.Multiply(multiplier)\n"+
+				"Error: actualNumStr != expectedNumStr\n"+
+				"actualNumStr   = '%v'\n"+
+				"expectedNumStr = '%v'\n",
+				ePrefix.String(),
+				i+1,
+				actualNumStr,
+				testData[i][2])
+
+			return
+		}
+	}
+}
+
+func TestNumberStrKernel_Subtract_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumberStrKernel_Subtract_000100",
+		"")
+
+	testData := [][3]string{
+		{"10.5", "20.75", "-10.25"},
+		{"-3", "-3", "0"},
+		{"1000000000000000000000", "0.000000000000000000001", "999,999,999,999,999,999,999.999999999999999999999"},
+		{"-2.5", "1.25", "-3.75"},
+	}
+
+	var err error
+	var minuend, subtrahend, difference NumberStrKernel
+	var actualNumStr string
+
+	for i := 0; i < len(testData); i++ {
+
+		minuend,
+			_,
+			err = new(NumberStrKernel).NewParseNativeNumberStr(
+			testData[i][0],
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"minuend"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		subtrahend,
+			_,
+			err = new(NumberStrKernel).NewParseNativeNumberStr(
+			testData[i][1],
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"subtrahend"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		difference,
+			err = minuend.Subtract(
+			&subtrahend,
+			ePrefix.XCpy(
+				"difference"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		actualNumStr = difference.String()
+
+		if actualNumStr != testData[i][2] {
+
+			t.Errorf("%v\n"+
+				"Test #%v - minuend.Subtract(subtrahend)\n"+
+				"Error: actualNumStr != expectedNumStr\n"+
+				"actualNumStr   = '%v'\n"+
+				"expectedNumStr = '%v'\n",
+				ePrefix.String(),
+				i+1,
+				actualNumStr,
+				testData[i][2])
+
+			return
+		}
+	}
+}
@@ -0,0 +1,519 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"testing"
+)
+
+func TestNumberStrKernel_FmtNumStrRadix_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumberStrKernel_FmtNumStrRadix_000100",
+		"")
+
+	type radixTest struct {
+		numStr         string
+		radixFmtType   NumStrFormatTypeCode
+		useRadixPrefix bool
+		useUpperCase   bool
+		bitWidth       int
+		groupNibbles   bool
+		expected       string
+	}
+
+	testData := []radixTest{
+		{"255", NumStrFmtType.Hexadecimal(), true, true, 0, false, "0xFF"},
+		{"-255", NumStrFmtType.Hexadecimal(), true, false, 0, false, "-0xff"},
+		{"48879", NumStrFmtType.Hexadecimal(), false, true, 0, false, "BEEF"},
+		{"-1", NumStrFmtType.Hexadecimal(), true, false, 16, false, "0xffff"},
+		{"1", NumStrFmtType.Hexadecimal(), true, false, 16, false, "0x0001"},
+		{"-128", NumStrFmtType.Binary(), true, false, 8, false, "0b10000000"},
+		{"173", NumStrFmtType.Binary(), true, false, 0, true, "0b1010_1101"},
+		{"-5", NumStrFmtType.Binary(), false, false, 0, false, "-101"},
+		{"-6", NumStrFmtType.Binary(), false, false, 8, true, "1111_1010"},
+		{"511", NumStrFmtType.Octal(), true, false, 0, false, "0o777"},
+		{"-1", NumStrFmtType.Octal(), false, false, 9, false, "777"},
+		{"0", NumStrFmtType.Hexadecimal(), true, false, 0, false, "0x0"},
+		{"42.000", NumStrFmtType.Octal(), false, false, 0, false, "52"},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var roundingSpec NumStrRoundingSpec
+	var intSeparatorSpec IntegerSeparatorSpec
+	var numberFieldSpec NumStrNumberFieldSpec
+	var numStrFmtSpec NumStrFormatSpec
+	var actualNumStr string
+
+	roundingSpec,
+		err = new(NumStrRoundingSpec).NewRoundingSpec(
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"roundingSpec"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	numberFieldSpec,
+		err = new(NumStrNumberFieldSpec).NewFieldSpec(
+		-1,
+		TxtJustify.Right(),
+		ePrefix.XCpy(
+			"numberFieldSpec"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	for i := 0; i < len(testData); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).NewParseNativeNumberStr(
+			testData[i].numStr,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if testData[i].groupNibbles {
+
+			intSeparatorSpec,
+				err = new(IntegerSeparatorSpec).NewComponents(
+				"_",
+				[]uint{4},
+				false,
+				ePrefix.XCpy(
+					"intSeparatorSpec"))
+
+			if err != nil {
+				t.Errorf("\n%v\n",
+					err.Error())
+				return
+			}
+
+		} else {
+
+			intSeparatorSpec =
+				new(IntegerSeparatorSpec).NewNoIntegerSeparation()
+		}
+
+		numStrFmtSpec,
+			err = new(NumStrFormatSpec).NewRadixNumFormat(
+			testData[i].radixFmtType,
+			testData[i].useRadixPrefix,
+			testData[i].useUpperCase,
+			testData[i].bitWidth,
+			intSeparatorSpec,
+			numberFieldSpec,
+			ePrefix.XCpy(
+				"numStrFmtSpec"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		actualNumStr,
+			err = numStrKernel.FmtNumStr(
+			roundingSpec,
+			numStrFmtSpec,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"Number String = '%v'\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				testData[i].numStr,
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expected {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: FmtNumStr() radix result is invalid!\n"+
+				"Number String   = '%v'\n"+
+				"Radix Format    = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].numStr,
+				testData[i].radixFmtType.String(),
+				testData[i].expected,
+				actualNumStr)
+
+			return
+		}
+	}
+}
+
+func TestNumberStrKernel_FmtNumStrRadix_000200(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumberStrKernel_FmtNumStrRadix_000200",
+		"")
+
+	roundingSpec,
+		err := new(NumStrRoundingSpec).NewRoundingSpec(
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"roundingSpec"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var numberFieldSpec NumStrNumberFieldSpec
+
+	numberFieldSpec,
+		err = new(NumStrNumberFieldSpec).NewFieldSpec(
+		10,
+		TxtJustify.Right(),
+		ePrefix.XCpy(
+			"numberFieldSpec"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var numStrFmtSpec NumStrFormatSpec
+
+	numStrFmtSpec,
+		err = new(NumStrFormatSpec).NewRadixNumFormat(
+		NumStrFmtType.Hexadecimal(),
+		true,
+		true,
+		8,
+		new(IntegerSeparatorSpec).NewNoIntegerSeparation(),
+		numberFieldSpec,
+		ePrefix.XCpy(
+			"numStrFmtSpec"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var numStrKernel NumberStrKernel
+
+	numStrKernel,
+		_,
+		err = new(NumberStrKernel).NewParseNativeNumberStr(
+		"-128",
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var actualNumStr string
+
+	actualNumStr,
+		err = numStrKernel.FmtNumStr(
+		roundingSpec,
+		numStrFmtSpec,
+		ePrefix.XCpy(
+			"-128"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	expectedNumStr := "      0x80"
+
+	if actualNumStr != expectedNumStr {
+
+		t.Errorf("%v\n"+
+			"Error: FmtNumStr() radix result is invalid!\n"+
+			"Expected Result = '%v'\n"+
+			"  Actual Result = '%v'\n",
+			ePrefix.String(),
+			expectedNumStr,
+			actualNumStr)
+
+		return
+	}
+
+	// 128 cannot be represented as an 8-bit
+	// two's complement value.
+	numStrKernel,
+		_,
+		err = new(NumberStrKernel).NewParseNativeNumberStr(
+		"128",
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	_,
+		err = numStrKernel.FmtNumStr(
+		roundingSpec,
+		numStrFmtSpec,
+		ePrefix.XCpy(
+			"128"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from FmtNumStr()\n"+
+			"because 128 exceeds the 8-bit two's complement range.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	numStrKernel,
+		_,
+		err = new(NumberStrKernel).NewParseNativeNumberStr(
+		"12.5",
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	_,
+		err = numStrKernel.FmtNumStr(
+		roundingSpec,
+		numStrFmtSpec,
+		ePrefix.XCpy(
+			"12.5"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from FmtNumStr()\n"+
+			"because 12.5 is not an integer value.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	_,
+		err = new(NumStrFormatSpec).NewRadixNumFormat(
+		NumStrFmtType.Currency(),
+		true,
+		true,
+		0,
+		new(IntegerSeparatorSpec).NewNoIntegerSeparation(),
+		numberFieldSpec,
+		ePrefix.XCpy(
+			"Currency"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from NewRadixNumFormat()\n"+
+			"because 'radixFmtType' is Currency.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+}
+
+func TestNumberStrKernel_NewParseRadixNumberStr_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumberStrKernel_NewParseRadixNumberStr_000100",
+		"")
+
+	type parseTest struct {
+		radixNumStr  string
+		radixFmtType NumStrFormatTypeCode
+		expected     string
+	}
+
+	testData := []parseTest{
+		{"0x1F", NumStrFmtType.None(), "31"},
+		{"0o17", NumStrFmtType.None(), "15"},
+		{"0b1010", NumStrFmtType.None(), "10"},
+		{"  -0XdeAD_beef ", NumStrFmtType.None(), "-3735928559"},
+		{"0b1010_0101", NumStrFmtType.Binary(), "165"},
+		{"1111_0000", NumStrFmtType.Binary(), "240"},
+		{"0x_ff", NumStrFmtType.Hexadecimal(), "255"},
+		{"0b1", NumStrFmtType.Hexadecimal(), "177"},
+		{"+777", NumStrFmtType.Octal(), "511"},
+		{"-0", NumStrFmtType.Octal(), "0"},
+		{"0xFFFF_FFFF_FFFF_FFFF_FFFF", NumStrFmtType.None(),
+			"1208925819614629174706175"},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var actualNumStr string
+
+	for i := 0; i < len(testData); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).NewParseRadixNumberStr(
+			testData[i].radixNumStr,
+			testData[i].radixFmtType,
+			ePrefix.XCpy(
+				testData[i].radixNumStr))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualNumStr,
+			_,
+			err = numStrKernel.FmtNumStrNative(
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expected {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: NewParseRadixNumberStr() result is invalid!\n"+
+				"Radix Number String = '%v'\n"+
+				"Expected Value = '%v'\n"+
+				"  Actual Value = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].radixNumStr,
+				testData[i].expected,
+				actualNumStr)
+
+			return
+		}
+	}
+
+	numStrKernel,
+		_,
+		err = new(NumberStrKernel).NewParseHexadecimalNumberStr(
+		"-ff",
+		ePrefix.XCpy(
+			"-ff"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	actualNumStr,
+		_,
+		err = numStrKernel.FmtNumStrNative(
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	if actualNumStr != "-255" {
+
+		t.Errorf("%v\n"+
+			"Error: NewParseHexadecimalNumberStr() result is invalid!\n"+
+			"Expected Value = '-255'\n"+
+			"  Actual Value = '%v'\n",
+			ePrefix.String(),
+			actualNumStr)
+
+		return
+	}
+
+	invalidData := []parseTest{
+		{"1F", NumStrFmtType.None(), ""},
+		{"0b102", NumStrFmtType.None(), ""},
+		{"0o8", NumStrFmtType.Octal(), ""},
+		{"0x", NumStrFmtType.Hexadecimal(), ""},
+		{"_101", NumStrFmtType.Binary(), ""},
+		{"10__1", NumStrFmtType.Binary(), ""},
+		{"101_", NumStrFmtType.Binary(), ""},
+		{"0x1F", NumStrFmtType.Octal(), ""},
+		{"", NumStrFmtType.Hexadecimal(), ""},
+		{"12", NumStrFmtType.Currency(), ""},
+	}
+
+	for i := 0; i < len(invalidData); i++ {
+
+		_,
+			_,
+			err = new(NumberStrKernel).NewParseRadixNumberStr(
+			invalidData[i].radixNumStr,
+			invalidData[i].radixFmtType,
+			ePrefix.XCpy(
+				invalidData[i].radixNumStr))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from\n"+
+				"NewParseRadixNumberStr() because the input\n"+
+				"is invalid.\n"+
+				"Radix Number String = '%v'\n"+
+				"Radix Format Type   = '%v'\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n",
+				ePrefix.String(),
+				i,
+				invalidData[i].radixNumStr,
+				invalidData[i].radixFmtType.String())
+
+			return
+		}
+	}
+}
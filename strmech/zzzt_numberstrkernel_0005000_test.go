@@ -0,0 +1,667 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"testing"
+)
+
+func TestNumberStrKernel_FmtNumStrSciNotation_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumberStrKernel_FmtNumStrSciNotation_000100",
+		"")
+
+	type sciNotTest struct {
+		numStr            string
+		sciNotFmt         ScientificNotationFormat
+		sciNotCalcType    ScientificNotationCalcType
+		roundingType      NumberRoundingType
+		fracDigits        int
+		minExponentDigits int
+		useSuperscript    bool
+		expected          string
+	}
+
+	standard := ScientificNotationCalcType(0).Standard()
+	engineering := ScientificNotationCalcType(0).Engineering()
+	halfAway := NumRoundType.HalfAwayFromZero()
+
+	testData := []sciNotTest{
+		{"265200000", SciNotFmt.ENotUprCaseELeadPlus(),
+			standard, halfAway, 3, 0, false, "2.652E+8"},
+		{"265200000", SciNotFmt.ENotUprCaseELeadPlus(),
+			standard, halfAway, 3, 2, false, "2.652E+08"},
+		{"265200000", SciNotFmt.Exponential(),
+			standard, halfAway, 3, 0, false, "2.652 x 10^8"},
+		{"-265200000", SciNotFmt.ENotLwrCaseENoLeadPlus(),
+			standard, halfAway, 3, 0, false, "-2.652e8"},
+		{"0.0000521", SciNotFmt.ENotUprCaseELeadPlus(),
+			standard, halfAway, 2, 2, false, "5.21E-05"},
+		{"0.0000521", SciNotFmt.Exponential(),
+			standard, halfAway, 2, 0, true, "5.21×10⁻⁵"},
+		{"123456", SciNotFmt.Exponential(),
+			standard, halfAway, 2, 0, true, "1.23×10⁵"},
+		{"9.996", SciNotFmt.ENotUprCaseELeadPlus(),
+			standard, halfAway, 2, 0, false, "1.00E+1"},
+		{"7", SciNotFmt.ENotUprCaseELeadPlus(),
+			standard, halfAway, 0, 0, false, "7E+0"},
+		{"1234.5", SciNotFmt.ENotUprCaseENoLeadPlus(),
+			standard, NumRoundType.NoRounding(), 0, 0, false, "1.2345E3"},
+		{"0", SciNotFmt.ENotUprCaseELeadPlus(),
+			standard, halfAway, 2, 0, false, "0.00E+0"},
+		{"265200000", SciNotFmt.ENotLwrCaseENoLeadPlus(),
+			engineering, halfAway, 1, 0, false, "265.2e6"},
+		{"0.0123", SciNotFmt.ENotUprCaseELeadPlus(),
+			engineering, halfAway, 1, 0, false, "12.3E-3"},
+		{"999999", SciNotFmt.ENotLwrCaseELeadPlus(),
+			engineering, halfAway, 1, 0, false, "1.0e+6"},
+		{"-4500", SciNotFmt.Exponential(),
+			engineering, halfAway, 2, 0, true, "-4.50×10³"},
+		{"1.21", SciNotFmt.ENotUprCaseELeadPlus(),
+			standard, NumRoundType.Ceiling(), 1, 0, false, "1.3E+0"},
+		{"1.21", SciNotFmt.ENotUprCaseELeadPlus(),
+			standard, NumRoundType.Floor(), 1, 0, false, "1.2E+0"},
+		{"-1.21", SciNotFmt.ENotUprCaseELeadPlus(),
+			standard, NumRoundType.Floor(), 1, 0, false, "-1.3E+0"},
+		{"-1.21", SciNotFmt.ENotUprCaseELeadPlus(),
+			standard, NumRoundType.Ceiling(), 1, 0, false, "-1.2E+0"},
+		{"-1.29", SciNotFmt.ENotUprCaseELeadPlus(),
+			standard, NumRoundType.Truncate(), 1, 0, false, "-1.2E+0"},
+		{"9.91", SciNotFmt.ENotUprCaseELeadPlus(),
+			standard, NumRoundType.Ceiling(), 1, 0, false, "1.0E+1"},
+		{"-0.000991", SciNotFmt.ENotUprCaseELeadPlus(),
+			standard, NumRoundType.Floor(), 1, 0, false, "-1.0E-3"},
+		{"123456", SciNotFmt.ENotLwrCaseELeadPlus(),
+			engineering, NumRoundType.Ceiling(), 1, 0, false, "123.5e+3"},
+		{"-123456", SciNotFmt.ENotLwrCaseELeadPlus(),
+			engineering, NumRoundType.Floor(), 0, 0, false, "-124e+3"},
+		{"999001", SciNotFmt.ENotLwrCaseELeadPlus(),
+			engineering, NumRoundType.Ceiling(), 0, 0, false, "1e+6"},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var sciNotFmtSpec SciNotationFormatSpec
+	var numStrFmtSpec NumStrFormatSpec
+	var actualNumStr string
+
+	roundingSpec,
+		err := new(NumStrRoundingSpec).NewRoundingSpec(
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"roundingSpec"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var decSeparator DecimalSeparatorSpec
+
+	decSeparator,
+		err = new(DecimalSeparatorSpec).NewUS(
+		ePrefix.XCpy(
+			"decSeparator"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var numSymbolsGroup NumStrNumberSymbolGroup
+
+	numSymbolsGroup,
+		err = new(NumStrNumberSymbolGroup).NewSignedNumDefaultsUSMinus(
+		ePrefix.XCpy(
+			"numSymbolsGroup"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var numberFieldSpec NumStrNumberFieldSpec
+
+	numberFieldSpec,
+		err = new(NumStrNumberFieldSpec).NewFieldSpec(
+		-1,
+		TxtJustify.Right(),
+		ePrefix.XCpy(
+			"numberFieldSpec"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	for i := 0; i < len(testData); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).NewParseNativeNumberStr(
+			testData[i].numStr,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		sciNotFmtSpec,
+			err = new(SciNotationFormatSpec).NewSciNotationFormat(
+			testData[i].sciNotFmt,
+			testData[i].sciNotCalcType,
+			testData[i].roundingType,
+			testData[i].fracDigits,
+			testData[i].minExponentDigits,
+			testData[i].useSuperscript,
+			ePrefix.XCpy(
+				"sciNotFmtSpec"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		numStrFmtSpec,
+			err = new(NumStrFormatSpec).NewSciNotationNumFormat(
+			sciNotFmtSpec,
+			decSeparator,
+			numSymbolsGroup,
+			numberFieldSpec,
+			ePrefix.XCpy(
+				"numStrFmtSpec"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		actualNumStr,
+			err = numStrKernel.FmtNumStr(
+			roundingSpec,
+			numStrFmtSpec,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"Number String = '%v'\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				testData[i].numStr,
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expected {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: FmtNumStr() scientific notation result is invalid!\n"+
+				"Number String   = '%v'\n"+
+				"Sci Not Format  = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].numStr,
+				testData[i].sciNotFmt.String(),
+				testData[i].expected,
+				actualNumStr)
+
+			return
+		}
+	}
+}
+
+func TestNumberStrKernel_FmtNumStrSciNotation_000200(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumberStrKernel_FmtNumStrSciNotation_000200",
+		"")
+
+	sciNotFmtSpec,
+		err := new(SciNotationFormatSpec).NewSciNotationFormat(
+		SciNotFmt.ENotUprCaseELeadPlus(),
+		ScientificNotationCalcType(0).Standard(),
+		NumRoundType.HalfAwayFromZero(),
+		2,
+		0,
+		false,
+		ePrefix.XCpy(
+			"sciNotFmtSpec"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var decSeparator DecimalSeparatorSpec
+
+	decSeparator,
+		err = new(DecimalSeparatorSpec).NewGermany(
+		ePrefix.XCpy(
+			"decSeparator"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var numSymbolsGroup NumStrNumberSymbolGroup
+
+	numSymbolsGroup,
+		err = new(NumStrNumberSymbolGroup).NewSignedNumDefaultsUSParen(
+		ePrefix.XCpy(
+			"numSymbolsGroup"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var numberFieldSpec NumStrNumberFieldSpec
+
+	numberFieldSpec,
+		err = new(NumStrNumberFieldSpec).NewFieldSpec(
+		12,
+		TxtJustify.Right(),
+		ePrefix.XCpy(
+			"numberFieldSpec"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var numStrFmtSpec NumStrFormatSpec
+
+	numStrFmtSpec,
+		err = new(NumStrFormatSpec).NewSciNotationNumFormat(
+		sciNotFmtSpec,
+		decSeparator,
+		numSymbolsGroup,
+		numberFieldSpec,
+		ePrefix.XCpy(
+			"numStrFmtSpec"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var numStrKernel NumberStrKernel
+
+	numStrKernel,
+		_,
+		err = new(NumberStrKernel).NewParseNativeNumberStr(
+		"-0.0012345",
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var roundingSpec NumStrRoundingSpec
+
+	roundingSpec,
+		err = new(NumStrRoundingSpec).NewRoundingSpec(
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"roundingSpec"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var actualNumStr string
+
+	actualNumStr,
+		err = numStrKernel.FmtNumStr(
+		roundingSpec,
+		numStrFmtSpec,
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	expectedNumStr := "   (1,23E-3)"
+
+	if actualNumStr != expectedNumStr {
+
+		t.Errorf("%v\n"+
+			"Error: FmtNumStr() result is invalid!\n"+
+			"Expected Result = '%v'\n"+
+			"  Actual Result = '%v'\n",
+			ePrefix.String(),
+			expectedNumStr,
+			actualNumStr)
+
+		return
+	}
+
+	_,
+		err = new(SciNotationFormatSpec).NewSciNotationFormat(
+		SciNotFmt.ENotUprCaseELeadPlus(),
+		ScientificNotationCalcType(0).Standard(),
+		NumRoundType.HalfAwayFromZero(),
+		2,
+		0,
+		true,
+		ePrefix.XCpy(
+			"Superscript E-Notation"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from NewSciNotationFormat()\n"+
+			"because superscript exponents are only valid for the\n"+
+			"Exponential display format.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	err = sciNotFmtSpec.SetSignificandMinFracDigits(
+		3,
+		ePrefix.XCpy(
+			"sciNotFmtSpec minFracDigits=3"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from\n"+
+			"SetSignificandMinFracDigits() because the minimum\n"+
+			"exceeds the number of significand fractional digits.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	if sciNotFmtSpec.GetSignificandMinFracDigits() != 2 {
+
+		t.Errorf("%v\n"+
+			"Error: A failed call to SetSignificandMinFracDigits()\n"+
+			"changed the minimum number of fractional digits.\n"+
+			"Expected Result = '2'\n"+
+			"  Actual Result = '%v'\n",
+			ePrefix.String(),
+			sciNotFmtSpec.GetSignificandMinFracDigits())
+
+		return
+	}
+
+	_,
+		err = new(NumStrFormatSpec).NewSciNotationNumFormat(
+		SciNotationFormatSpec{},
+		decSeparator,
+		numSymbolsGroup,
+		numberFieldSpec,
+		ePrefix.XCpy(
+			"NOP sciNotFmtSpec"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from NewSciNotationNumFormat()\n"+
+			"because 'sciNotFmtSpec' is NOP.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+}
+
+func TestNumberStrKernel_NewParseSciNotationStr_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumberStrKernel_NewParseSciNotationStr_000100",
+		"")
+
+	usDecSeparator,
+		err := new(DecimalSeparatorSpec).NewUS(
+		ePrefix.XCpy(
+			"usDecSeparator"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var germanDecSeparator DecimalSeparatorSpec
+
+	germanDecSeparator,
+		err = new(DecimalSeparatorSpec).NewGermany(
+		ePrefix.XCpy(
+			"germanDecSeparator"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	type sciNotParseTest struct {
+		sciNotStr    string
+		decSeparator DecimalSeparatorSpec
+		expected     string
+	}
+
+	testData := []sciNotParseTest{
+		{"1.602E-19", usDecSeparator,
+			"0.0000000000000000001602"},
+		{"6.022e+23", usDecSeparator,
+			"602200000000000000000000"},
+		{"-3.1×10^8", usDecSeparator,
+			"-310000000"},
+		{"2.652 x 10^8", usDecSeparator,
+			"265200000"},
+		{"5.21×10⁻⁵", usDecSeparator,
+			"0.0000521"},
+		{"1.23 x 10⁵", usDecSeparator,
+			"123000"},
+		{"  +7E0  ", usDecSeparator,
+			"7"},
+		{"1.5E−3", usDecSeparator,
+			"0.0015"},
+		{"12.345E1", usDecSeparator,
+			"123.45"},
+		{"-2,5E-3", germanDecSeparator,
+			"-0.0025"},
+		{"0E+5", usDecSeparator,
+			"0"},
+	}
+
+	var numStrKernel NumberStrKernel
+	var actualNumStr string
+
+	for i := 0; i < len(testData); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).NewParseSciNotationStr(
+			testData[i].sciNotStr,
+			testData[i].decSeparator,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"Sci Notation String = '%v'\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				testData[i].sciNotStr,
+				err.Error())
+			return
+		}
+
+		actualNumStr,
+			_,
+			err = numStrKernel.FmtNumStrNative(
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("\n%v\n",
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expected {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: NewParseSciNotationStr() result is invalid!\n"+
+				"Sci Notation String = '%v'\n"+
+				"Expected Result = '%v'\n"+
+				"  Actual Result = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].sciNotStr,
+				testData[i].expected,
+				actualNumStr)
+
+			return
+		}
+	}
+
+	_,
+		err = numStrKernel.SetFromSciNotationStr(
+		"4.25E2",
+		usDecSeparator,
+		ePrefix.XCpy(
+			"numStrKernel<-4.25E2"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	actualNumStr,
+		_,
+		err = numStrKernel.FmtNumStrNative(
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	if actualNumStr != "425" {
+
+		t.Errorf("%v\n"+
+			"Error: SetFromSciNotationStr() result is invalid!\n"+
+			"Expected Result = '425'\n"+
+			"  Actual Result = '%v'\n",
+			ePrefix.String(),
+			actualNumStr)
+
+		return
+	}
+
+	invalidSciNotStrs := []string{
+		"",
+		"1.602",
+		"1.602E",
+		"1.602E-",
+		"1.602E+1.5",
+		"1.602E19x",
+		"1.602 x 9^19",
+		"E19",
+		"1.602Q19",
+	}
+
+	for i := 0; i < len(invalidSciNotStrs); i++ {
+
+		_,
+			err = numStrKernel.SetFromSciNotationStr(
+			invalidSciNotStrs[i],
+			usDecSeparator,
+			ePrefix.XCpy(
+				"invalidSciNotStrs"))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from\n"+
+				"SetFromSciNotationStr() because the\n"+
+				"number string is invalid.\n"+
+				"Sci Notation String = '%v'\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n",
+				ePrefix.String(),
+				i,
+				invalidSciNotStrs[i])
+
+			return
+		}
+	}
+
+	actualNumStr,
+		_,
+		err = numStrKernel.FmtNumStrNative(
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"numStrKernel after errors"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	if actualNumStr != "425" {
+
+		t.Errorf("%v\n"+
+			"Error: SetFromSciNotationStr() modified the\n"+
+			"NumberStrKernel after returning an error!\n"+
+			"Expected Result = '425'\n"+
+			"  Actual Result = '%v'\n",
+			ePrefix.String(),
+			actualNumStr)
+
+		return
+	}
+}
@@ -0,0 +1,1064 @@
+package strmech
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeDecimalDriver
+//
+// A minimal, in-memory 'database/sql' driver used to
+// test the 'sql.Scanner' and 'driver.Valuer'
+// implementations of NumberStrKernel. Values are stored
+// exactly as received from the driver.Valuer interface
+// and returned unchanged to the sql.Scanner interface.
+//
+// Supported statements:
+//
+//	"INSERT"	args: key, value
+//	"SELECT"	args: key
+type fakeDecimalDriver struct {
+	lock  sync.Mutex
+	store map[string]driver.Value
+}
+
+type fakeDecimalConn struct {
+	drv *fakeDecimalDriver
+}
+
+type fakeDecimalStmt struct {
+	conn  *fakeDecimalConn
+	query string
+}
+
+type fakeDecimalRows struct {
+	values []driver.Value
+	index  int
+}
+
+var fakeDecimalDriverOnce sync.Once
+
+var fakeDecimalDriverInstance = &fakeDecimalDriver{
+	store: make(map[string]driver.Value),
+}
+
+func (drv *fakeDecimalDriver) Open(
+	name string) (driver.Conn, error) {
+
+	return &fakeDecimalConn{drv: drv}, nil
+}
+
+func (conn *fakeDecimalConn) Prepare(
+	query string) (driver.Stmt, error) {
+
+	return &fakeDecimalStmt{conn: conn, query: query}, nil
+}
+
+func (conn *fakeDecimalConn) Close() error {
+	return nil
+}
+
+func (conn *fakeDecimalConn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("fakeDecimalConn: transactions are not supported")
+}
+
+func (stmt *fakeDecimalStmt) Close() error {
+	return nil
+}
+
+func (stmt *fakeDecimalStmt) NumInput() int {
+	return -1
+}
+
+func (stmt *fakeDecimalStmt) Exec(
+	args []driver.Value) (driver.Result, error) {
+
+	if stmt.query != "INSERT" ||
+		len(args) != 2 {
+
+		return nil, fmt.Errorf("fakeDecimalStmt: invalid Exec '%v'", stmt.query)
+	}
+
+	stmt.conn.drv.lock.Lock()
+
+	defer stmt.conn.drv.lock.Unlock()
+
+	stmt.conn.drv.store[fmt.Sprintf("%v", args[0])] = args[1]
+
+	return driver.RowsAffected(1), nil
+}
+
+func (stmt *fakeDecimalStmt) Query(
+	args []driver.Value) (driver.Rows, error) {
+
+	if stmt.query != "SELECT" ||
+		len(args) != 1 {
+
+		return nil, fmt.Errorf("fakeDecimalStmt: invalid Query '%v'", stmt.query)
+	}
+
+	stmt.conn.drv.lock.Lock()
+
+	defer stmt.conn.drv.lock.Unlock()
+
+	value, ok := stmt.conn.drv.store[fmt.Sprintf("%v", args[0])]
+
+	if !ok {
+		return &fakeDecimalRows{}, nil
+	}
+
+	return &fakeDecimalRows{values: []driver.Value{value}}, nil
+}
+
+func (rows *fakeDecimalRows) Columns() []string {
+	return []string{"amount"}
+}
+
+func (rows *fakeDecimalRows) Close() error {
+	return nil
+}
+
+func (rows *fakeDecimalRows) Next(dest []driver.Value) error {
+
+	if rows.index >= len(rows.values) {
+		return io.EOF
+	}
+
+	dest[0] = rows.values[rows.index]
+
+	rows.index++
+
+	return nil
+}
+
+func TestNumberStrKernel_MarshalText_000100(t *testing.T) {
+
+	funcName := "TestNumberStrKernel_MarshalText_000100()"
+
+	type textTest struct {
+		inputText    string
+		expectedText string
+	}
+
+	testData := []textTest{
+		{"-1234.5678", "-1234.5678"},
+		{"+42", "42"},
+		{"  0.5  ", "0.5"},
+		{"6.022E+23", "602200000000000000000000"},
+		{"1.602e-19", "0.0000000000000000001602"},
+		{"123456789012345678901234567890.123456789012345678901234567891",
+			"123456789012345678901234567890.123456789012345678901234567891"},
+		{"-0.1", "-0.1"},
+	}
+
+	var err error
+	var text []byte
+
+	for i := 0; i < len(testData); i++ {
+
+		var numStrKernel NumberStrKernel
+
+		err = numStrKernel.UnmarshalText(
+			[]byte(testData[i].inputText))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				funcName,
+				i,
+				err.Error())
+			return
+		}
+
+		text,
+			err = numStrKernel.MarshalText()
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				funcName,
+				i,
+				err.Error())
+			return
+		}
+
+		if string(text) != testData[i].expectedText {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: MarshalText() result is invalid!\n"+
+				"inputText    = '%v'\n"+
+				"Actual Text  = '%v'\n"+
+				"Expected Text= '%v'\n",
+				funcName,
+				i,
+				testData[i].inputText,
+				string(text),
+				testData[i].expectedText)
+
+			return
+		}
+	}
+
+	invalidData := []string{
+		"12abc",
+		"1,000",
+		"--5",
+		"1.2.3",
+		"1e",
+		"+",
+	}
+
+	for i := 0; i < len(invalidData); i++ {
+
+		numStrKernel,
+			_,
+			err := new(NumberStrKernel).NewParseNativeNumberStr(
+			"99.5",
+			NumRoundType.NoRounding(),
+			0,
+			funcName)
+
+		if err != nil {
+			t.Errorf("%v\n"+
+				"%v\n",
+				funcName,
+				err.Error())
+			return
+		}
+
+		err = numStrKernel.UnmarshalText(
+			[]byte(invalidData[i]))
+
+		if err == nil {
+
+			t.Errorf("%v Invalid Test #%v\n"+
+				"Error: Expected an error return from UnmarshalText()\n"+
+				"because the input text is invalid.\n"+
+				"input text = '%v'\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n",
+				funcName,
+				i,
+				invalidData[i])
+
+			return
+		}
+
+		text,
+			err = numStrKernel.MarshalText()
+
+		if err != nil {
+			t.Errorf("%v\n"+
+				"%v\n",
+				funcName,
+				err.Error())
+			return
+		}
+
+		if string(text) != "99.5" {
+
+			t.Errorf("%v Invalid Test #%v\n"+
+				"Error: A failed UnmarshalText() modified the\n"+
+				"NumberStrKernel numeric value.\n"+
+				"Actual Value   = '%v'\n"+
+				"Expected Value = '99.5'\n",
+				funcName,
+				i,
+				string(text))
+
+			return
+		}
+	}
+
+	var emptyKernel NumberStrKernel
+
+	text,
+		err = emptyKernel.MarshalText()
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	if len(text) != 0 {
+
+		t.Errorf("%v\n"+
+			"Error: Expected MarshalText() on an empty\n"+
+			"NumberStrKernel to return an empty byte array.\n"+
+			"Instead, text = '%v'\n",
+			funcName,
+			string(text))
+	}
+}
+
+func TestNumberStrKernel_UnmarshalText_000200(t *testing.T) {
+
+	funcName := "TestNumberStrKernel_UnmarshalText_000200()"
+
+	oversizedData := []string{
+		"1e1000000",
+		"1e-1000000",
+		"-1.5E+999999",
+		"1e10000",
+		"1e99999999999999999999",
+		"0." + strings.Repeat("1", 10001),
+	}
+
+	var numStrKernel NumberStrKernel
+	var err error
+
+	startTime := time.Now()
+
+	for i := 0; i < len(oversizedData); i++ {
+
+		err = numStrKernel.UnmarshalText(
+			[]byte(oversizedData[i]))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from UnmarshalText()\n"+
+				"because the decoded value exceeds the maximum\n"+
+				"number of digits.\n"+
+				"input text = '%v'\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n",
+				funcName,
+				i,
+				oversizedData[i])
+
+			return
+		}
+
+		err = numStrKernel.UnmarshalJSON(
+			[]byte(oversizedData[i]))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from UnmarshalJSON()\n"+
+				"because the decoded value exceeds the maximum\n"+
+				"number of digits.\n"+
+				"input data = '%v'\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n",
+				funcName,
+				i,
+				oversizedData[i])
+
+			return
+		}
+
+		err = numStrKernel.Scan(oversizedData[i])
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from Scan()\n"+
+				"because the decoded value exceeds the maximum\n"+
+				"number of digits.\n"+
+				"src = '%v'\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n",
+				funcName,
+				i,
+				oversizedData[i])
+
+			return
+		}
+	}
+
+	elapsedTime := time.Since(startTime)
+
+	if elapsedTime > time.Second {
+
+		t.Errorf("%v\n"+
+			"Error: Rejecting oversized encoded values took too long.\n"+
+			"Elapsed Time = '%v'\n",
+			funcName,
+			elapsedTime)
+
+		return
+	}
+
+	err = numStrKernel.UnmarshalText(
+		[]byte("1e9999"))
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	if len(numStrKernel.integerDigits.CharsArray) != 10000 {
+
+		t.Errorf("%v\n"+
+			"Error: UnmarshalText(\"1e9999\") returned the wrong\n"+
+			"number of integer digits.\n"+
+			"Actual Integer Digits   = '%v'\n"+
+			"Expected Integer Digits = '10000'\n",
+			funcName,
+			len(numStrKernel.integerDigits.CharsArray))
+	}
+}
+
+func TestNumberStrKernel_MarshalJSON_000100(t *testing.T) {
+
+	funcName := "TestNumberStrKernel_MarshalJSON_000100()"
+
+	type jsonRecord struct {
+		Name   string           `json:"name"`
+		Amount NumberStrKernel  `json:"amount"`
+		Rate   *NumberStrKernel `json:"rate"`
+	}
+
+	bigNumStr := "-98765432109876543210.0123456789012345678901"
+
+	amount,
+		_,
+		err := new(NumberStrKernel).NewParseNativeNumberStr(
+		bigNumStr,
+		NumRoundType.NoRounding(),
+		0,
+		funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	var rate NumberStrKernel
+
+	rate,
+		_,
+		err = new(NumberStrKernel).NewParseNativeNumberStr(
+		"0.0725",
+		NumRoundType.NoRounding(),
+		0,
+		funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	err = rate.SetJsonMarshalFormat(
+		NumStrJsonFmt.BareNumber(),
+		funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	record := jsonRecord{
+		Name:   "Invoice",
+		Amount: amount,
+		Rate:   &rate,
+	}
+
+	var jsonBytes []byte
+
+	jsonBytes,
+		err = json.Marshal(record)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	expectedJson := `{"name":"Invoice",` +
+		`"amount":"` + bigNumStr + `",` +
+		`"rate":0.0725}`
+
+	if string(jsonBytes) != expectedJson {
+
+		t.Errorf("%v\n"+
+			"Error: json.Marshal() result is invalid!\n"+
+			"Actual JSON   = '%v'\n"+
+			"Expected JSON = '%v'\n",
+			funcName,
+			string(jsonBytes),
+			expectedJson)
+
+		return
+	}
+
+	// Bare JSON numbers must be unmarshalled without
+	// passing through float64.
+	inputJson := `{"name":"Transfer",` +
+		`"amount":123456789012345678901234567890.123456789,` +
+		`"rate":"6.25E-2"}`
+
+	var decodedRecord jsonRecord
+
+	err = json.Unmarshal(
+		[]byte(inputJson),
+		&decodedRecord)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	var text []byte
+
+	text,
+		err = decodedRecord.Amount.MarshalText()
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	if string(text) != "123456789012345678901234567890.123456789" {
+
+		t.Errorf("%v\n"+
+			"Error: Unmarshalled 'amount' is invalid!\n"+
+			"Actual Value   = '%v'\n"+
+			"Expected Value = '123456789012345678901234567890.123456789'\n",
+			funcName,
+			string(text))
+
+		return
+	}
+
+	if decodedRecord.Rate == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Unmarshalled 'rate' is a nil pointer!\n",
+			funcName)
+
+		return
+	}
+
+	text,
+		err = decodedRecord.Rate.MarshalText()
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	if string(text) != "0.0625" {
+
+		t.Errorf("%v\n"+
+			"Error: Unmarshalled 'rate' is invalid!\n"+
+			"Actual Value   = '%v'\n"+
+			"Expected Value = '0.0625'\n",
+			funcName,
+			string(text))
+
+		return
+	}
+
+	// 'null' is a no-op
+	err = decodedRecord.Amount.UnmarshalJSON(
+		[]byte("null"))
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	text,
+		err = decodedRecord.Amount.MarshalText()
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	if string(text) != "123456789012345678901234567890.123456789" {
+
+		t.Errorf("%v\n"+
+			"Error: UnmarshalJSON(null) modified the numeric value!\n"+
+			"Actual Value   = '%v'\n",
+			funcName,
+			string(text))
+
+		return
+	}
+
+	var emptyKernel NumberStrKernel
+
+	jsonBytes,
+		err = json.Marshal(emptyKernel)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	if string(jsonBytes) != "null" {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an empty NumberStrKernel to be\n"+
+			"marshalled as 'null'.\n"+
+			"Instead, JSON = '%v'\n",
+			funcName,
+			string(jsonBytes))
+
+		return
+	}
+
+	invalidJson := []string{
+		`{"amount":true}`,
+		`{"amount":"12abc"}`,
+		`{"amount":[1]}`,
+	}
+
+	for i := 0; i < len(invalidJson); i++ {
+
+		var badRecord jsonRecord
+
+		err = json.Unmarshal(
+			[]byte(invalidJson[i]),
+			&badRecord)
+
+		if err == nil {
+
+			t.Errorf("%v Invalid Test #%v\n"+
+				"Error: Expected an error return from json.Unmarshal()\n"+
+				"because the input JSON is invalid.\n"+
+				"input JSON = '%v'\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n",
+				funcName,
+				i,
+				invalidJson[i])
+
+			return
+		}
+	}
+
+	err = rate.SetJsonMarshalFormat(
+		NumStrJsonFmt.None(),
+		funcName)
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from\n"+
+			"SetJsonMarshalFormat(NumStrJsonFmt.None())\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			funcName)
+
+		return
+	}
+
+	if rate.GetJsonMarshalFormat() != NumStrJsonFmt.BareNumber() {
+
+		t.Errorf("%v\n"+
+			"Error: Expected JSON Marshal Format = 'BareNumber'\n"+
+			"Instead, JSON Marshal Format = '%v'\n",
+			funcName,
+			rate.GetJsonMarshalFormat().String())
+	}
+}
+
+func TestNumberStrKernel_SqlScanValue_000100(t *testing.T) {
+
+	funcName := "TestNumberStrKernel_SqlScanValue_000100()"
+
+	fakeDecimalDriverOnce.Do(func() {
+		sql.Register(
+			"strmechFakeDecimal",
+			fakeDecimalDriverInstance)
+	})
+
+	db, err := sql.Open("strmechFakeDecimal", "")
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	defer func() {
+		_ = db.Close()
+	}()
+
+	testData := []string{
+		"-1234.5678",
+		"0",
+		"98765432109876543210987654321.00000000000000000001",
+	}
+
+	for i := 0; i < len(testData); i++ {
+
+		var numStrKernel NumberStrKernel
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).NewParseNativeNumberStr(
+			testData[i],
+			NumRoundType.NoRounding(),
+			0,
+			funcName)
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				funcName,
+				i,
+				err.Error())
+			return
+		}
+
+		key := fmt.Sprintf("key%v", i)
+
+		// Pass by value to verify the value receiver
+		_,
+			err = db.Exec(
+			"INSERT",
+			key,
+			numStrKernel)
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				funcName,
+				i,
+				err.Error())
+			return
+		}
+
+		var scannedKernel NumberStrKernel
+
+		err = db.QueryRow(
+			"SELECT",
+			key).Scan(&scannedKernel)
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				funcName,
+				i,
+				err.Error())
+			return
+		}
+
+		var text []byte
+
+		text,
+			err = scannedKernel.MarshalText()
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				funcName,
+				i,
+				err.Error())
+			return
+		}
+
+		if string(text) != testData[i] {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Scanned value does not match stored value!\n"+
+				"Actual Value   = '%v'\n"+
+				"Expected Value = '%v'\n",
+				funcName,
+				i,
+				string(text),
+				testData[i])
+
+			return
+		}
+	}
+
+	// An empty NumberStrKernel is stored as NULL
+	var emptyKernel NumberStrKernel
+
+	_,
+		err = db.Exec(
+		"INSERT",
+		"nullKey",
+		&emptyKernel)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	var nullString sql.NullString
+
+	err = db.QueryRow(
+		"SELECT",
+		"nullKey").Scan(&nullString)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	if nullString.Valid {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an empty NumberStrKernel to be\n"+
+			"stored as a database NULL value.\n"+
+			"Instead, stored value = '%v'\n",
+			funcName,
+			nullString.String)
+
+		return
+	}
+
+	var numStrKernel NumberStrKernel
+
+	err = numStrKernel.Scan(int64(-9223372036854775808))
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	var value driver.Value
+
+	value,
+		err = numStrKernel.Value()
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	if value != "-9223372036854775808" {
+
+		t.Errorf("%v\n"+
+			"Error: Scan(int64) produced an invalid value!\n"+
+			"Actual Value   = '%v'\n"+
+			"Expected Value = '-9223372036854775808'\n",
+			funcName,
+			value)
+
+		return
+	}
+
+	err = numStrKernel.Scan(float64(1.1))
+
+	if err == nil ||
+		!strings.Contains(err.Error(), "floating point") {
+
+		t.Errorf("%v\n"+
+			"Error: Expected Scan(float64) to return a\n"+
+			"floating point error.\n"+
+			"err = '%v'\n",
+			funcName,
+			err)
+
+		return
+	}
+
+	emptyData := []interface{}{
+		"",
+		"   ",
+		[]byte{},
+		[]byte(" \t"),
+	}
+
+	for i := 0; i < len(emptyData); i++ {
+
+		err = numStrKernel.Scan(emptyData[i])
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from Scan()\n"+
+				"because the text value is empty.\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n"+
+				"src = '%v'\n",
+				funcName,
+				i,
+				emptyData[i])
+
+			return
+		}
+
+		value,
+			err = numStrKernel.Value()
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				funcName,
+				i,
+				err.Error())
+			return
+		}
+
+		if value != "-9223372036854775808" {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: A failed Scan() modified the NumberStrKernel!\n"+
+				"Actual Value   = '%v'\n"+
+				"Expected Value = '-9223372036854775808'\n",
+				funcName,
+				i,
+				value)
+
+			return
+		}
+	}
+
+	emptyText := []string{
+		"",
+		"   ",
+		" \t\n",
+	}
+
+	for i := 0; i < len(emptyText); i++ {
+
+		err = numStrKernel.UnmarshalText(
+			[]byte(emptyText[i]))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from UnmarshalText()\n"+
+				"because the text value is empty.\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n"+
+				"text = '%v'\n",
+				funcName,
+				i,
+				emptyText[i])
+
+			return
+		}
+	}
+
+	emptyJSON := []string{
+		"",
+		"   ",
+		`""`,
+		`"   "`,
+		` " \t" `,
+	}
+
+	for i := 0; i < len(emptyJSON); i++ {
+
+		err = numStrKernel.UnmarshalJSON(
+			[]byte(emptyJSON[i]))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from UnmarshalJSON()\n"+
+				"because the JSON value is empty.\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n"+
+				"data = '%v'\n",
+				funcName,
+				i,
+				emptyJSON[i])
+
+			return
+		}
+	}
+
+	value,
+		err = numStrKernel.Value()
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	if value != "-9223372036854775808" {
+
+		t.Errorf("%v\n"+
+			"Error: A failed UnmarshalText() or UnmarshalJSON()\n"+
+			"modified the NumberStrKernel!\n"+
+			"Actual Value   = '%v'\n"+
+			"Expected Value = '-9223372036854775808'\n",
+			funcName,
+			value)
+
+		return
+	}
+
+	err = numStrKernel.Scan(nil)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	value,
+		err = numStrKernel.Value()
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	if value != nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected Scan(nil) to produce an empty\n"+
+			"NumberStrKernel with a 'nil' Value().\n"+
+			"Instead, Value() = '%v'\n",
+			funcName,
+			value)
+	}
+}
@@ -0,0 +1,477 @@
+package strmech
+
+import (
+	"testing"
+)
+
+func testNumStrKernelColFromNativeNumStrs(
+	t *testing.T,
+	funcName string,
+	nativeNumStrs []string) (
+	NumberStrKernelCollection,
+	bool) {
+
+	numStrKernelCol := NumberStrKernelCollection{}.New()
+
+	var err error
+
+	for i := 0; i < len(nativeNumStrs); i++ {
+
+		err = numStrKernelCol.AddNativeNumStr(
+			nativeNumStrs[i],
+			funcName)
+
+		if err != nil {
+			t.Errorf("%v\n"+
+				"%v\n",
+				funcName,
+				err.Error())
+
+			return numStrKernelCol, false
+		}
+	}
+
+	return numStrKernelCol, true
+}
+
+func testNumStrKernelColCheckResult(
+	t *testing.T,
+	funcName string,
+	label string,
+	numStrKernel NumberStrKernel,
+	err error,
+	expectedNumStr string) bool {
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"Error: %v\n"+
+			"%v\n",
+			funcName,
+			label,
+			err.Error())
+
+		return false
+	}
+
+	text,
+		err := numStrKernel.MarshalText()
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"Error: %v - MarshalText()\n"+
+			"%v\n",
+			funcName,
+			label,
+			err.Error())
+
+		return false
+	}
+
+	if string(text) != expectedNumStr {
+
+		t.Errorf("%v\n"+
+			"Error: %v result is invalid!\n"+
+			"Actual NumStr   = '%v'\n"+
+			"Expected NumStr = '%v'\n",
+			funcName,
+			label,
+			string(text),
+			expectedNumStr)
+
+		return false
+	}
+
+	return true
+}
+
+func TestNumberStrKernelCollection_Statistics_000100(t *testing.T) {
+
+	funcName := "TestNumberStrKernelCollection_Statistics_000100()"
+
+	numStrKernelCol,
+		ok := testNumStrKernelColFromNativeNumStrs(
+		t,
+		funcName,
+		[]string{"1.5", "-2.25", "10", "3", "0.125"})
+
+	if !ok {
+		return
+	}
+
+	if numStrKernelCol.GetNumberOfNumStrKernels() != 5 {
+		t.Errorf("%v\n"+
+			"Error: Expected 5 collection members.\n"+
+			"Instead, the collection contains %v members.\n",
+			funcName,
+			numStrKernelCol.GetNumberOfNumStrKernels())
+
+		return
+	}
+
+	result,
+		err := numStrKernelCol.GetSum(funcName)
+
+	if !testNumStrKernelColCheckResult(
+		t, funcName, "GetSum()", result, err, "12.375") {
+		return
+	}
+
+	roundingSpec,
+		err := new(NumStrRoundingSpec).NewRoundingSpec(
+		NumRoundType.HalfAwayFromZero(),
+		2,
+		funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	result,
+		err = numStrKernelCol.GetMean(
+		roundingSpec,
+		funcName)
+
+	if !testNumStrKernelColCheckResult(
+		t, funcName, "GetMean()", result, err, "2.48") {
+		return
+	}
+
+	result,
+		err = numStrKernelCol.GetMedian(funcName)
+
+	if !testNumStrKernelColCheckResult(
+		t, funcName, "GetMedian()", result, err, "1.5") {
+		return
+	}
+
+	result,
+		err = numStrKernelCol.GetMinimum(funcName)
+
+	if !testNumStrKernelColCheckResult(
+		t, funcName, "GetMinimum()", result, err, "-2.25") {
+		return
+	}
+
+	result,
+		err = numStrKernelCol.GetMaximum(funcName)
+
+	if !testNumStrKernelColCheckResult(
+		t, funcName, "GetMaximum()", result, err, "10") {
+		return
+	}
+
+	roundingSpec,
+		err = new(NumStrRoundingSpec).NewRoundingSpec(
+		NumRoundType.HalfToEven(),
+		4,
+		funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	result,
+		err = numStrKernelCol.GetVariance(
+		false,
+		roundingSpec,
+		funcName)
+
+	if !testNumStrKernelColCheckResult(
+		t, funcName, "GetVariance(population)", result, err, "17.14") {
+		return
+	}
+
+	result,
+		err = numStrKernelCol.GetVariance(
+		true,
+		roundingSpec,
+		funcName)
+
+	if !testNumStrKernelColCheckResult(
+		t, funcName, "GetVariance(sample)", result, err, "21.425") {
+		return
+	}
+
+	result,
+		err = numStrKernelCol.GetStandardDeviation(
+		false,
+		roundingSpec,
+		funcName)
+
+	if !testNumStrKernelColCheckResult(
+		t, funcName, "GetStandardDeviation(population)", result, err, "4.14") {
+		return
+	}
+
+	roundingSpec,
+		err = new(NumStrRoundingSpec).NewRoundingSpec(
+		NumRoundType.HalfAwayFromZero(),
+		20,
+		funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	result,
+		err = numStrKernelCol.GetStandardDeviation(
+		true,
+		roundingSpec,
+		funcName)
+
+	if !testNumStrKernelColCheckResult(
+		t,
+		funcName,
+		"GetStandardDeviation(sample)",
+		result,
+		err,
+		"4.62871472441324687866") {
+		return
+	}
+
+	result,
+		err = numStrKernelCol.GetNumStrKernelAtIndex(
+		1,
+		funcName)
+
+	if !testNumStrKernelColCheckResult(
+		t, funcName, "GetNumStrKernelAtIndex(1)", result, err, "-2.25") {
+		return
+	}
+
+	_,
+		err = numStrKernelCol.GetNumStrKernelAtIndex(
+		5,
+		funcName)
+
+	if err == nil {
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from GetNumStrKernelAtIndex(5)\n"+
+			"because the index is out of range.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			funcName)
+
+		return
+	}
+}
+
+func TestNumberStrKernelCollection_Percentile_000100(t *testing.T) {
+
+	funcName := "TestNumberStrKernelCollection_Percentile_000100()"
+
+	numStrKernelCol,
+		ok := testNumStrKernelColFromNativeNumStrs(
+		t,
+		funcName,
+		[]string{"40", "10", "30", "20"})
+
+	if !ok {
+		return
+	}
+
+	testData := []struct {
+		percentile     string
+		expectedNumStr string
+	}{
+		{"0", "10"},
+		{"25", "17.5"},
+		{"50", "25"},
+		{"90", "37"},
+		{"99.9", "39.97"},
+		{"100", "40"},
+	}
+
+	var result NumberStrKernel
+	var err error
+
+	for i := 0; i < len(testData); i++ {
+
+		result,
+			err = numStrKernelCol.GetPercentile(
+			testData[i].percentile,
+			funcName)
+
+		if !testNumStrKernelColCheckResult(
+			t,
+			funcName,
+			"GetPercentile("+testData[i].percentile+")",
+			result,
+			err,
+			testData[i].expectedNumStr) {
+			return
+		}
+	}
+
+	result,
+		err = numStrKernelCol.GetMedian(funcName)
+
+	if !testNumStrKernelColCheckResult(
+		t, funcName, "GetMedian()", result, err, "25") {
+		return
+	}
+
+	invalidPercentiles := []string{"100.1", "-1", "abc"}
+
+	for i := 0; i < len(invalidPercentiles); i++ {
+
+		_,
+			err = numStrKernelCol.GetPercentile(
+			invalidPercentiles[i],
+			funcName)
+
+		if err == nil {
+			t.Errorf("%v\n"+
+				"Error: Expected an error return from GetPercentile()\n"+
+				"because the percentile is invalid.\n"+
+				"percentile = '%v'\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n",
+				funcName,
+				invalidPercentiles[i])
+
+			return
+		}
+	}
+}
+
+func TestNumberStrKernelCollection_Sum_000100(t *testing.T) {
+
+	funcName := "TestNumberStrKernelCollection_Sum_000100()"
+
+	numStrKernelCol := NumberStrKernelCollection{}.New()
+
+	numStrKernel,
+		_,
+		err := new(NumberStrKernel).NewParseNativeNumberStr(
+		"0.1",
+		NumRoundType.NoRounding(),
+		0,
+		funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	for i := 0; i < 1000; i++ {
+
+		err = numStrKernelCol.AddNumStrKernel(
+			&numStrKernel,
+			funcName)
+
+		if err != nil {
+			t.Errorf("%v\n"+
+				"%v\n",
+				funcName,
+				err.Error())
+			return
+		}
+	}
+
+	err = numStrKernelCol.AddNativeNumStr(
+		"123456789012345678901234567890.00000000000000000001",
+		funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	result,
+		err := numStrKernelCol.GetSum(funcName)
+
+	if !testNumStrKernelColCheckResult(
+		t,
+		funcName,
+		"GetSum()",
+		result,
+		err,
+		"123456789012345678901234567990.00000000000000000001") {
+		return
+	}
+
+	numStrKernelCol.Empty()
+
+	_,
+		err = numStrKernelCol.GetSum(funcName)
+
+	if err == nil {
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from GetSum()\n"+
+			"because the collection is empty.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			funcName)
+
+		return
+	}
+
+	err = numStrKernelCol.AddNativeNumStr(
+		"5",
+		funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	roundingSpec,
+		err := new(NumStrRoundingSpec).NewRoundingSpec(
+		NumRoundType.HalfAwayFromZero(),
+		2,
+		funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	_,
+		err = numStrKernelCol.GetVariance(
+		true,
+		roundingSpec,
+		funcName)
+
+	if err == nil {
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from GetVariance()\n"+
+			"because a sample variance requires two values.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			funcName)
+
+		return
+	}
+
+	result,
+		err = numStrKernelCol.GetVariance(
+		false,
+		roundingSpec,
+		funcName)
+
+	if !testNumStrKernelColCheckResult(
+		t, funcName, "GetVariance(population)", result, err, "0") {
+		return
+	}
+}
@@ -0,0 +1,480 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"testing"
+)
+
+func TestNumStrDigitSystem_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrDigitSystem_000100()",
+		"")
+
+	type digitSystemTest struct {
+		numStr         string
+		digitSystem    NumberDigitSystem
+		expectedNumStr string
+	}
+
+	testData := []digitSystemTest{
+		{"1234567.89", NumDigitSys.None(), "1,234,567.89"},
+		{"1234567.89", NumDigitSys.Latin(), "1,234,567.89"},
+		{"1234567.89", NumDigitSys.ArabicIndic(), "١,٢٣٤,٥٦٧.٨٩"},
+		{"1234567.89", NumDigitSys.ExtendedArabicIndic(), "۱,۲۳۴,۵۶۷.۸۹"},
+		{"1234567.89", NumDigitSys.Devanagari(), "१,२३४,५६७.८९"},
+		{"1234567.89", NumDigitSys.Thai(), "๑,๒๓๔,๕๖๗.๘๙"},
+		{"-1234567.89", NumDigitSys.FullWidth(), "-１,２３４,５６７.８９"},
+		{"0", NumDigitSys.Devanagari(), "०"},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var roundingSpec NumStrRoundingSpec
+	var numStrFmtSpec NumStrFormatSpec
+	var actualNumStr string
+
+	roundingSpec,
+		err = new(NumStrRoundingSpec).NewRoundingSpec(
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"roundingSpec"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	numStrFmtSpec,
+		err = new(NumStrFormatSpec).NewSignedNumDefaultsUSMinus(
+		NumStrNumberFieldSpec{},
+		ePrefix.XCpy(
+			"numStrFmtSpec"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	for i := 0; i < len(testData); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).
+			NewParsePureNumberStr(
+				testData[i].numStr,
+				".",
+				true,
+				NumRoundType.NoRounding(),
+				0,
+				ePrefix.XCpy(
+					"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		err = numStrFmtSpec.SetDigitSystem(
+			testData[i].digitSystem,
+			ePrefix.XCpy(
+				"numStrFmtSpec"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualNumStr,
+			err = numStrKernel.FmtNumStr(
+			roundingSpec,
+			numStrFmtSpec,
+			ePrefix.XCpy(
+				"actualNumStr"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expectedNumStr {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: actualNumStr != expectedNumStr\n"+
+				"digitSystem    = '%v'\n"+
+				"actualNumStr   = '%v'\n"+
+				"expectedNumStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].digitSystem.String(),
+				actualNumStr,
+				testData[i].expectedNumStr)
+
+			return
+		}
+	}
+
+	if numStrFmtSpec.GetDigitSystem() != NumDigitSys.Devanagari() {
+
+		t.Errorf("%v\n"+
+			"Error: Expected GetDigitSystem() = 'Devanagari'\n"+
+			"Instead, GetDigitSystem() = '%v'\n",
+			ePrefix.String(),
+			numStrFmtSpec.GetDigitSystem().String())
+
+		return
+	}
+
+	var numStrFmtSpec2 NumStrFormatSpec
+
+	numStrFmtSpec2,
+		err = numStrFmtSpec.CopyOut(
+		ePrefix.XCpy(
+			"numStrFmtSpec2"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	if numStrFmtSpec2.GetDigitSystem() != NumDigitSys.Devanagari() {
+
+		t.Errorf("%v\n"+
+			"Error: Expected copied digit system = 'Devanagari'\n"+
+			"Instead, copied digit system = '%v'\n",
+			ePrefix.String(),
+			numStrFmtSpec2.GetDigitSystem().String())
+
+		return
+	}
+
+	err = numStrFmtSpec.SetDigitSystem(
+		NumberDigitSystem(99),
+		ePrefix.XCpy(
+			"invalid digitSystem"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from\n"+
+			"SetDigitSystem() with an invalid digit system.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	var digitSystem NumberDigitSystem
+
+	digitSystem,
+		err = NumDigitSys.XParseString(
+		"extendedarabicindic",
+		false)
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	if digitSystem != NumDigitSys.ExtendedArabicIndic() {
+
+		t.Errorf("%v\n"+
+			"Error: XParseString(\"extendedarabicindic\") failed!\n"+
+			"digitSystem = '%v'\n",
+			ePrefix.String(),
+			digitSystem.String())
+
+		return
+	}
+}
+
+func TestNumStrDigitSystem_000200(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrDigitSystem_000200()",
+		"")
+
+	type digitParseTest struct {
+		dirtyNumStr      string
+		decimalSeparator string
+		expectedIntStr   string
+		expectedFracStr  string
+		expectedSign     int
+	}
+
+	testData := []digitParseTest{
+		{"١٢٣٫٤٥", "٫", "123", "45", 1},
+		{"-۱۲۳۴.۵", ".", "1234", "5", -1},
+		{"१,२३४.५६", ".", "1234", "56", 1},
+		{"฿ ๑๒๓.๔๕", ".", "123", "45", 1},
+		{"(１２３)", ".", "123", "", -1},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var actualSign int
+
+	for i := 0; i < len(testData); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).NewParseDirtyNumberStr(
+			testData[i].dirtyNumStr,
+			testData[i].decimalSeparator,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualSign,
+			err = numStrKernel.GetNumberSignAsInt(
+			ePrefix.XCpy(
+				"actualSign"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if numStrKernel.GetIntegerString() != testData[i].expectedIntStr ||
+			numStrKernel.GetFractionalString() != testData[i].expectedFracStr ||
+			actualSign != testData[i].expectedSign {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: NewParseDirtyNumberStr() failed!\n"+
+				"dirtyNumStr     = '%v'\n"+
+				"Integer Digits  = '%v' Expected = '%v'\n"+
+				"Frac Digits     = '%v' Expected = '%v'\n"+
+				"Number Sign     = '%v' Expected = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].dirtyNumStr,
+				numStrKernel.GetIntegerString(),
+				testData[i].expectedIntStr,
+				numStrKernel.GetFractionalString(),
+				testData[i].expectedFracStr,
+				actualSign,
+				testData[i].expectedSign)
+
+			return
+		}
+	}
+
+	negativeNumSignSearchSpecs := NegNumSearchSpecCollection{}
+
+	err = negativeNumSignSearchSpecs.AddLeadingNegNumSearchStr(
+		"-",
+		ePrefix.XCpy(
+			"-"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	var decimalSeparator DecimalSeparatorSpec
+
+	decimalSeparator,
+		err = new(DecimalSeparatorSpec).NewStr(
+		"٫",
+		ePrefix.XCpy(
+			"decimalSeparator"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	var numberStrSearchResults CharSearchNumStrParseResultsDto
+
+	numberStrSearchResults,
+		numStrKernel,
+		err = new(NumberStrKernel).NewParseCustomNumberStr(
+		"Total: -١٢٣٬٤٥٦٫٧٨ SAR",
+		0,
+		-1,
+		negativeNumSignSearchSpecs,
+		decimalSeparator,
+		[]string{"SAR"},
+		false,
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	if !numberStrSearchResults.FoundNumericDigits {
+
+		t.Errorf("%v\n"+
+			"Error: NewParseCustomNumberStr() failed to\n"+
+			"find native numeric digits.\n",
+			ePrefix.String())
+
+		return
+	}
+
+	actualSign,
+		err = numStrKernel.GetNumberSignAsInt(
+		ePrefix.XCpy(
+			"actualSign"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	if numStrKernel.GetIntegerString() != "123456" ||
+		numStrKernel.GetFractionalString() != "78" ||
+		actualSign != -1 {
+
+		t.Errorf("%v\n"+
+			"Error: NewParseCustomNumberStr() failed!\n"+
+			"Integer Digits  = '%v' Expected = '123456'\n"+
+			"Frac Digits     = '%v' Expected = '78'\n"+
+			"Number Sign     = '%v' Expected = '-1'\n",
+			ePrefix.String(),
+			numStrKernel.GetIntegerString(),
+			numStrKernel.GetFractionalString(),
+			actualSign)
+
+		return
+	}
+}
+
+func TestNumStrDigitSystem_000300(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrDigitSystem_000300()",
+		"")
+
+	mixedDigitNumStrs := []string{
+		"1٢3",
+		"١٢٣.4",
+		"-۱۲3",
+		"१,२३४.5६",
+		"๑2๓",
+		"１2３",
+		"١۲٣",
+	}
+
+	var err error
+
+	negativeNumSignSearchSpecs := NegNumSearchSpecCollection{}
+
+	err = negativeNumSignSearchSpecs.AddLeadingNegNumSearchStr(
+		"-",
+		ePrefix.XCpy(
+			"-"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	var decimalSeparator DecimalSeparatorSpec
+
+	decimalSeparator,
+		err = new(DecimalSeparatorSpec).NewStr(
+		".",
+		ePrefix.XCpy(
+			"decimalSeparator"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	for i := 0; i < len(mixedDigitNumStrs); i++ {
+
+		_,
+			_,
+			err = new(NumberStrKernel).NewParseDirtyNumberStr(
+			mixedDigitNumStrs[i],
+			".",
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"Dirty"))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from\n"+
+				"NewParseDirtyNumberStr() because the number\n"+
+				"string mixes digits from two digit systems.\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n"+
+				"dirtyNumStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				mixedDigitNumStrs[i])
+
+			return
+		}
+
+		_,
+			_,
+			err = new(NumberStrKernel).NewParseCustomNumberStr(
+			mixedDigitNumStrs[i],
+			0,
+			-1,
+			negativeNumSignSearchSpecs,
+			decimalSeparator,
+			nil,
+			false,
+			ePrefix.XCpy(
+				"Custom"))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from\n"+
+				"NewParseCustomNumberStr() because the number\n"+
+				"string mixes digits from two digit systems.\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n"+
+				"rawNumStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				mixedDigitNumStrs[i])
+
+			return
+		}
+	}
+}
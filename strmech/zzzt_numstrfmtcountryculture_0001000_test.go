@@ -0,0 +1,574 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"testing"
+)
+
+func TestNumStrFmtCountryCultureSpec_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrFmtCountryCultureSpec_000100()",
+		"")
+
+	type countryCultureTest struct {
+		countryCode         string
+		expectedLocaleTag   string
+		expectedSignedStr   string
+		expectedCurrencyStr string
+	}
+
+	testData := []countryCultureTest{
+		{"IN", "en-IN", "-12,34,567.89", "-₹12,34,567.89"},
+		{"CH", "de-CH", "-1’234’567.89", "CHF\u00A0-1’234’567.89"},
+		{"CHE", "de-CH", "-1’234’567.89", "CHF\u00A0-1’234’567.89"},
+		{"jp", "ja-JP", "-1,234,567.89", "-￥1,234,567.89"},
+		{"BR", "pt-BR", "-1.234.567,89", "-R$\u00A01.234.567,89"},
+		{"643", "ru-RU", "-1\u202F234\u202F567,89", "-1\u202F234\u202F567,89\u00A0₽"},
+		{"SA", "ar-SA", "\u200E-1,234,567.89", "\u200E-1,234,567.89\u00A0ر.س.\u200F"},
+		{"EG", "ar-EG", "\u200E-1,234,567.89", "\u200E-1,234,567.89\u00A0ج.م.\u200F"},
+		{"US", "en-US", "-1,234,567.89", "-$1,234,567.89"},
+		{"GB", "en-GB", "-1,234,567.89", "-£1,234,567.89"},
+		{"DE", "de-DE", "-1.234.567,89", "-1.234.567,89\u00A0€"},
+		{"FR", "fr-FR", "-1\u202F234\u202F567,89", "-1\u202F234\u202F567,89\u00A0€"},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var roundingSpec NumStrRoundingSpec
+	var countryCultureSpec NumStrFmtCountryCultureSpec
+	var actualStr string
+
+	roundingSpec,
+		err = new(NumStrRoundingSpec).NewRoundingSpec(
+		NumRoundType.HalfAwayFromZero(),
+		2,
+		ePrefix.XCpy(
+			"roundingSpec"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	numStrKernel,
+		_,
+		err = new(NumberStrKernel).
+		NewParsePureNumberStr(
+			"-1234567.891",
+			".",
+			true,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	for i := 0; i < len(testData); i++ {
+
+		countryCultureSpec,
+			err = new(NumStrFmtCountryCultureSpec).NewCountryCode(
+			testData[i].countryCode,
+			ePrefix.XCpy(
+				testData[i].countryCode))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if countryCultureSpec.LocaleTag != testData[i].expectedLocaleTag {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: LocaleTag != expectedLocaleTag\n"+
+				"countryCode       = '%v'\n"+
+				"LocaleTag         = '%v'\n"+
+				"expectedLocaleTag = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].countryCode,
+				countryCultureSpec.LocaleTag,
+				testData[i].expectedLocaleTag)
+
+			return
+		}
+
+		actualStr,
+			err = numStrKernel.FmtNumStr(
+			roundingSpec,
+			countryCultureSpec.SignedNumStrFormat,
+			ePrefix.XCpy(
+				"Signed Number"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if actualStr != testData[i].expectedSignedStr {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: actualStr != expectedSignedStr\n"+
+				"countryCode       = '%v'\n"+
+				"actualStr         = '%v'\n"+
+				"expectedSignedStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].countryCode,
+				actualStr,
+				testData[i].expectedSignedStr)
+
+			return
+		}
+
+		actualStr,
+			err = numStrKernel.FmtNumStr(
+			roundingSpec,
+			countryCultureSpec.CurrencyNumStrFormat,
+			ePrefix.XCpy(
+				"Currency"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if actualStr != testData[i].expectedCurrencyStr {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: actualStr != expectedCurrencyStr\n"+
+				"countryCode         = '%v'\n"+
+				"actualStr           = '%v'\n"+
+				"expectedCurrencyStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].countryCode,
+				actualStr,
+				testData[i].expectedCurrencyStr)
+
+			return
+		}
+	}
+
+	invalidCountryCodes := []string{
+		"",
+		"XX",
+		"ZZZ",
+		"999",
+	}
+
+	for i := 0; i < len(invalidCountryCodes); i++ {
+
+		_,
+			err = new(NumStrFmtCountryCultureSpec).NewCountryCode(
+			invalidCountryCodes[i],
+			ePrefix.XCpy(
+				invalidCountryCodes[i]))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from\n"+
+				"NewCountryCode() because the country code\n"+
+				"is invalid. HOWEVER, NO ERROR WAS RETURNED!\n"+
+				"countryCode = '%v'\n",
+				ePrefix.String(),
+				i,
+				invalidCountryCodes[i])
+
+			return
+		}
+	}
+}
+
+func TestNumStrFmtCountryCultureSpec_000200(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrFmtCountryCultureSpec_000200()",
+		"")
+
+	type localeTagTest struct {
+		localeTag          string
+		expectedLocaleTag  string
+		expectedCountryTwo string
+	}
+
+	testData := []localeTagTest{
+		{"fr-CH", "fr-CH", "CH"},
+		{"it_CH", "it-CH", "CH"},
+		{"gsw-CH", "de-CH", "CH"},
+		{"FR-be", "fr-BE", "BE"},
+		{"fr-CA", "fr-CA", "CA"},
+		{"pt_BR", "pt-BR", "BR"},
+		{"pt", "pt-BR", "BR"},
+		{"ja", "ja-JP", "JP"},
+		{"zh-Hant-TW", "zh-TW", "TW"},
+		{"hi-IN", "hi-IN", "IN"},
+		{"de-DE", "de-DE", "DE"},
+		{"en-GB", "en-GB", "GB"},
+	}
+
+	var err error
+	var countryCultureSpec NumStrFmtCountryCultureSpec
+
+	for i := 0; i < len(testData); i++ {
+
+		countryCultureSpec,
+			err = new(NumStrFmtCountryCultureSpec).NewLocaleTag(
+			testData[i].localeTag,
+			ePrefix.XCpy(
+				testData[i].localeTag))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if countryCultureSpec.LocaleTag != testData[i].expectedLocaleTag ||
+			countryCultureSpec.CountryCodeTwoChar != testData[i].expectedCountryTwo {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Locale Tag search returned the wrong entry!\n"+
+				"localeTag          = '%v'\n"+
+				"LocaleTag          = '%v'\n"+
+				"expectedLocaleTag  = '%v'\n"+
+				"CountryCodeTwoChar = '%v'\n"+
+				"expectedCountryTwo = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].localeTag,
+				countryCultureSpec.LocaleTag,
+				testData[i].expectedLocaleTag,
+				countryCultureSpec.CountryCodeTwoChar,
+				testData[i].expectedCountryTwo)
+
+			return
+		}
+	}
+
+	invalidLocaleTags := []string{
+		"",
+		"x",
+		"xx-XX",
+		"tlh",
+	}
+
+	for i := 0; i < len(invalidLocaleTags); i++ {
+
+		_,
+			err = new(NumStrFmtCountryCultureSpec).NewLocaleTag(
+			invalidLocaleTags[i],
+			ePrefix.XCpy(
+				invalidLocaleTags[i]))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from\n"+
+				"NewLocaleTag() because the locale tag\n"+
+				"is invalid. HOWEVER, NO ERROR WAS RETURNED!\n"+
+				"localeTag = '%v'\n",
+				ePrefix.String(),
+				i,
+				invalidLocaleTags[i])
+
+			return
+		}
+	}
+
+	localeTags := new(NumStrFmtCountryCultureSpec).
+		GetCatalogLocaleTags()
+
+	if len(localeTags) < 50 {
+
+		t.Errorf("%v\n"+
+			"Error: Expected at least 50 catalog locale tags.\n"+
+			"Instead, len(localeTags) = '%v'\n",
+			ePrefix.String(),
+			len(localeTags))
+
+		return
+	}
+
+	for i := 0; i < len(localeTags); i++ {
+
+		_,
+			err = new(NumStrFmtCountryCultureSpec).NewLocaleTag(
+			localeTags[i],
+			ePrefix.XCpy(
+				localeTags[i]))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+	}
+}
+
+func TestNumStrFmtCountryCultureSpec_000300(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrFmtCountryCultureSpec_000300()",
+		"")
+
+	// The ISO 4217 currency data for every Country
+	// Culture Catalog entry must match the ISO 4217
+	// registry. The legacy country setups (NewUS(),
+	// NewUK(), NewGermany() and NewFrance()) use their
+	// own short currency names and are only checked for
+	// the currency code, code number and minor units.
+
+	var err error
+	var countryCultureSpec NumStrFmtCountryCultureSpec
+	var curISO4217Spec CurrencyISO4217Spec
+
+	for i := 0; i < len(numStrCountryCultureCatalog); i++ {
+
+		catalogEntry := numStrCountryCultureCatalog[i]
+
+		countryCultureSpec,
+			err = new(NumStrFmtCountryCultureSpec).NewLocaleTag(
+			catalogEntry.localeTag,
+			ePrefix.XCpy(
+				catalogEntry.localeTag))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		curISO4217Spec,
+			err = new(CurrencyISO4217Spec).NewFromCode(
+			countryCultureSpec.CurrencyCode,
+			ePrefix.XCpy(
+				countryCultureSpec.CurrencyCode))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"localeTag = '%v'\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				catalogEntry.localeTag,
+				err.Error())
+			return
+		}
+
+		if countryCultureSpec.CurrencyCodeNo != curISO4217Spec.NumericCode ||
+			countryCultureSpec.CurrencyDecimalDigits != curISO4217Spec.MinorUnits ||
+			countryCultureSpec.CurrencyName != curISO4217Spec.CurrencyName {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: The catalog currency data does not match\n"+
+				"the ISO 4217 registry!\n"+
+				"localeTag             = '%v'\n"+
+				"CurrencyCode          = '%v'\n"+
+				"CurrencyCodeNo        = '%v'\n"+
+				"Registry NumericCode  = '%v'\n"+
+				"CurrencyDecimalDigits = '%v'\n"+
+				"Registry MinorUnits   = '%v'\n"+
+				"CurrencyName          = '%v'\n"+
+				"Registry CurrencyName = '%v'\n",
+				ePrefix.String(),
+				i,
+				catalogEntry.localeTag,
+				countryCultureSpec.CurrencyCode,
+				countryCultureSpec.CurrencyCodeNo,
+				curISO4217Spec.NumericCode,
+				countryCultureSpec.CurrencyDecimalDigits,
+				curISO4217Spec.MinorUnits,
+				countryCultureSpec.CurrencyName,
+				curISO4217Spec.CurrencyName)
+
+			return
+		}
+	}
+}
+
+func TestNumStrFmtCountryCultureSpec_000400(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrFmtCountryCultureSpec_000400()",
+		"")
+
+	type legacyCountryTest struct {
+		countryCode           string
+		newLegacySpec         func(errorPrefix interface{}) (NumStrFmtCountryCultureSpec, error)
+		expectedLegacyCurStr  string
+		expectedCatalogCurStr string
+	}
+
+	testData := []legacyCountryTest{
+		{"US", new(NumStrFmtCountryCultureSpec).NewUS, "$ (1,234,567.89)", "-$1,234,567.89"},
+		{"GB", new(NumStrFmtCountryCultureSpec).NewUK, "-£ 1,234,567.89", "-£1,234,567.89"},
+		{"DE", new(NumStrFmtCountryCultureSpec).NewGermany, "1.234.567,89- €", "-1.234.567,89\u00A0€"},
+		{"FR", new(NumStrFmtCountryCultureSpec).NewFrance, "-1 234 567,89 €", "-1\u202F234\u202F567,89\u00A0€"},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var roundingSpec NumStrRoundingSpec
+	var countryCultureSpec NumStrFmtCountryCultureSpec
+	var actualStr string
+
+	roundingSpec,
+		err = new(NumStrRoundingSpec).NewRoundingSpec(
+		NumRoundType.HalfAwayFromZero(),
+		2,
+		ePrefix.XCpy(
+			"roundingSpec"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	numStrKernel,
+		_,
+		err = new(NumberStrKernel).
+		NewParsePureNumberStr(
+			"-1234567.891",
+			".",
+			true,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	for i := 0; i < len(testData); i++ {
+
+		// The dedicated country setup methods retain
+		// their original formats.
+		countryCultureSpec,
+			err = testData[i].newLegacySpec(
+			ePrefix.XCpy(
+				testData[i].countryCode))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualStr,
+			err = numStrKernel.FmtNumStr(
+			roundingSpec,
+			countryCultureSpec.CurrencyNumStrFormat,
+			ePrefix.XCpy(
+				"Legacy Currency"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if actualStr != testData[i].expectedLegacyCurStr {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: actualStr != expectedLegacyCurStr\n"+
+				"countryCode          = '%v'\n"+
+				"actualStr            = '%v'\n"+
+				"expectedLegacyCurStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].countryCode,
+				actualStr,
+				testData[i].expectedLegacyCurStr)
+
+			return
+		}
+
+		// The Country Culture Catalog returns the CLDR
+		// formats.
+		countryCultureSpec,
+			err = new(NumStrFmtCountryCultureSpec).NewCountryCode(
+			testData[i].countryCode,
+			ePrefix.XCpy(
+				testData[i].countryCode))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualStr,
+			err = numStrKernel.FmtNumStr(
+			roundingSpec,
+			countryCultureSpec.CurrencyNumStrFormat,
+			ePrefix.XCpy(
+				"Catalog Currency"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if actualStr != testData[i].expectedCatalogCurStr {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: actualStr != expectedCatalogCurStr\n"+
+				"countryCode           = '%v'\n"+
+				"actualStr             = '%v'\n"+
+				"expectedCatalogCurStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].countryCode,
+				actualStr,
+				testData[i].expectedCatalogCurStr)
+
+			return
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"strings"
+	"testing"
+)
+
+func TestNumStrFormatPattern_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrFormatPattern_000100()",
+		"")
+
+	type patternTest struct {
+		pattern        string
+		decSeparator   string
+		intSeparator   string
+		currencySymbol string
+		numStr         string
+		expectedNumStr string
+	}
+
+	testData := []patternTest{
+		{"#,##0.00;(#,##0.00)", ".", ",", "", "1234.567", "1,234.57"},
+		{"#,##0.00;(#,##0.00)", ".", ",", "", "-1234.567", "(1,234.57)"},
+		{"#,##0.00;(#,##0.00)", ".", ",", "", "0", "0.00"},
+		{"#,##0.00", ".", ",", "", "-1234.5", "-1,234.50"},
+		{"0.000E+00", ".", ",", "", "1234.5678", "1.235E+03"},
+		{"0.000E+00", ".", ",", "", "-0.0012345", "-1.235E-03"},
+		{"0.0e0", ".", ",", "", "1234.5678", "1.2e3"},
+		{"0.###E0", ".", ",", "", "0", "0E0"},
+		{"0.###E0", ".", ",", "", "1200", "1.2E3"},
+		{"0.0##E0", ".", ",", "", "1000", "1.0E3"},
+		{"0.0##E0", ".", ",", "", "1234.5678", "1.235E3"},
+		{"##0.###E0", ".", ",", "", "0.00012345", "123.45E-6"},
+		{"##0.###E0", ".", ",", "", "12345", "12.345E3"},
+		{"##0.00E0", ".", ",", "", "1200", "1.20E3"},
+		{"###E0", ".", ",", "", "1234567", "1E6"},
+		{"#E0", ".", ",", "", "1234567", "1E6"},
+		{"¤ #,##0.00", ".", ",", "$", "1234.567", "$ 1,234.57"},
+		{"¤ #,##0.00", ".", ",", "$", "-1234.567", "-$ 1,234.57"},
+		{"#,##0.00 ¤", ",", ".", "€", "1234.567", "1.234,57 €"},
+		{"#,##,##0.##", ".", ",", "", "1234567.891", "12,34,567.89"},
+		{"#,##,##0.##", ".", ",", "", "1234567.8", "12,34,567.8"},
+		{"#,##,##0.##", ".", ",", "", "1234567", "12,34,567"},
+		{"000", ".", ",", "", "7", "007"},
+		{"000.0##", ".", ",", "", "7.1", "007.1"},
+		{"000.0##", ".", ",", "", "7.12345", "007.123"},
+		{"#,##0%", ".", ",", "", "0.1234", "12%"},
+		{"#,##0.0‰", ".", ",", "", "0.1234", "123.4‰"},
+		{"0.00;-0.00;'zero' 0", ".", ",", "", "0", "zero 0"},
+		{"0.00;-0.00;0", ".", ",", "", "0", "0"},
+		{"0.00;-0.00;0", ".", ",", "", "1.5", "1.50"},
+		{"'#'0", ".", ",", "", "5", "#5"},
+		{"* #,##0.00", ".", ",", "", "12.5", "   12.50"},
+		{"#,##0.00* ", ".", ",", "", "12.5", "12.50   "},
+		{"¤* #,##0.00", ".", ",", "$", "12.5", "$   12.50"},
+		{"*x#,##0.00", ".", ",", "", "12.5", "xxx12.50"},
+		{"#,##0.00*_", ".", ",", "", "-12.5", "-12.50__"},
+		{"*x0000", ".", ",", "", "12", "0012"},
+		{"0.00;-0.00;\"zero\"", ".", ",", "", "0", "zero"},
+		{"0.00;-0.00;\"zero\"", ".", ",", "", "-1.5", "-1.50"},
+		{"#,##0.00;(#,##0.00);-", ".", ",", "", "0", "-"},
+		{"#,##0.00;(#,##0.00);-", ".", ",", "", "-1234.5", "(1,234.50)"},
+		{"* #,##0.00;(#,##0.00);-", ".", ",", "", "0", "       -"},
+		{"0.00E0;-0.00E0;'none'", ".", ",", "", "0", "none"},
+		{"¤¤ #,##0.00", ".", ",", "USD", "12.5", "USD 12.50"},
+		{"¤¤¤¤¤#,##0.00", ".", ",", "$", "12.5", "$12.50"},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var roundingSpec NumStrRoundingSpec
+	var numStrFmtSpec NumStrFormatSpec
+	var actualNumStr string
+
+	roundingSpec,
+		err = new(NumStrRoundingSpec).NewRoundingSpec(
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"roundingSpec"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	for i := 0; i < len(testData); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).
+			NewParsePureNumberStr(
+				testData[i].numStr,
+				".",
+				true,
+				NumRoundType.NoRounding(),
+				0,
+				ePrefix.XCpy(
+					"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		numStrFmtSpec,
+			err = new(NumStrFormatSpec).NewNumFmtPattern(
+			testData[i].pattern,
+			testData[i].decSeparator,
+			testData[i].intSeparator,
+			testData[i].currencySymbol,
+			ePrefix.XCpy(
+				"numStrFmtSpec"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualNumStr,
+			err = numStrKernel.FmtNumStr(
+			roundingSpec,
+			numStrFmtSpec,
+			ePrefix.XCpy(
+				"actualNumStr"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expectedNumStr {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: actualNumStr != expectedNumStr\n"+
+				"pattern        = '%v'\n"+
+				"numStr         = '%v'\n"+
+				"actualNumStr   = '%v'\n"+
+				"expectedNumStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].pattern,
+				testData[i].numStr,
+				actualNumStr,
+				testData[i].expectedNumStr)
+
+			return
+		}
+	}
+
+	err = numStrFmtSpec.SetNumFmtPattern(
+		"#,##0.00",
+		".",
+		",",
+		"",
+		ePrefix.XCpy(
+			"numStrFmtSpec"))
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			ePrefix.String(),
+			err.Error())
+		return
+	}
+
+	var digitCountSpec NumStrDigitCountSpec
+
+	digitCountSpec,
+		err = numStrFmtSpec.GetDigitCountSpec(
+		ePrefix.XCpy(
+			"digitCountSpec"))
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			ePrefix.String(),
+			err.Error())
+		return
+	}
+
+	if digitCountSpec.GetMinIntegerDigits() != 1 ||
+		digitCountSpec.GetMinFractionalDigits() != 2 ||
+		digitCountSpec.GetMaxFractionalDigits() != 2 {
+
+		t.Errorf("%v\n"+
+			"Error: Invalid digit counts for pattern \"#,##0.00\"\n"+
+			"minIntegerDigits    = '%v' Expected '1'\n"+
+			"minFractionalDigits = '%v' Expected '2'\n"+
+			"maxFractionalDigits = '%v' Expected '2'\n",
+			ePrefix.String(),
+			digitCountSpec.GetMinIntegerDigits(),
+			digitCountSpec.GetMinFractionalDigits(),
+			digitCountSpec.GetMaxFractionalDigits())
+
+		return
+	}
+}
+
+func TestNumStrFormatPattern_000200(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrFormatPattern_000200()",
+		"")
+
+	type patternErrorTest struct {
+		pattern          string
+		currencySymbol   string
+		expectedPosition string
+	}
+
+	testData := []patternErrorTest{
+		{"#,##0.0.0", "", "Position:  7 "},
+		{"0#.00", "", "Position:  1 "},
+		{"#,##0.#0", "", "Position:  7 "},
+		{"#,##0,", "", "Position:  5 "},
+		{"#,,##0", "", "Position:  2 "},
+		{"0.00E", "", "Position:  5 "},
+		{"#,##0.00E+00", "", "Position:  8 "},
+		{"#0.0E0", "", "Position:  4 "},
+		{"000E0", "", "Position:  3 "},
+		{"####0E0", "", "Position:  5 "},
+		{"¤#,##0.00", "", "Position:  0 "},
+		{"'abc 0.00", "", "Position:  0 "},
+		{"E0", "", "Position:  0 "},
+		{"0.00;-0.00;E+0", "", "Position:  11 "},
+		{"#,##0.00¤¤¤¤", "$", "Position:  8 "},
+		{"#,##0.00¤¤¤¤¤¤", "$", "Position:  8 "},
+		{"[Red]#,##0.00", "", "Position:  0 "},
+		{"#,##0.00;[Red]-#,##0.00", "", "Position:  9 "},
+		{"[>=100]0.00", "", "Position:  0 "},
+		{"0.00;-0.00;[Red]'zero'", "", "Position:  11 "},
+		{"0.00;'none'", "", "Position:  11 "},
+		{"0.05", "", "Position:  3 "},
+		{"0.00;;", "", "Position:  5 "},
+		{"0;0;0;0", "", "Position:  5 "},
+		{"0.00;0.00", "", "Position:  5 "},
+		{"abc", "", "Position:  3 "},
+		{"0%;0‰‰", "", "Position:  5 "},
+	}
+
+	var err error
+
+	for i := 0; i < len(testData); i++ {
+
+		_,
+			err = new(NumStrFormatSpec).NewNumFmtPattern(
+			testData[i].pattern,
+			".",
+			",",
+			testData[i].currencySymbol,
+			ePrefix.XCpy(
+				"numStrFmtSpec"))
+
+		if err == nil {
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from\n"+
+				"NewNumFmtPattern() because the pattern\n"+
+				"'%v' is invalid.\n"+
+				"However, NO ERROR WAS RETURNED!\n",
+				ePrefix.String(),
+				i,
+				testData[i].pattern)
+
+			return
+		}
+
+		if !strings.Contains(
+			err.Error(),
+			testData[i].expectedPosition) {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: The error message does not identify the\n"+
+				"expected pattern position.\n"+
+				"pattern          = '%v'\n"+
+				"expectedPosition = '%v'\n"+
+				"Error Message:\n%v\n",
+				ePrefix.String(),
+				i,
+				testData[i].pattern,
+				testData[i].expectedPosition,
+				err.Error())
+
+			return
+		}
+	}
+}
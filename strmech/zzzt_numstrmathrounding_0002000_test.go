@@ -0,0 +1,462 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"testing"
+)
+
+func TestNumStrMathRoundingSignificantDigits_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrMathRoundingSignificantDigits_000100()",
+		"")
+
+	type sigDigitsTest struct {
+		numStr            string
+		significantDigits int
+		roundingType      NumberRoundingType
+		expectedNumStr    string
+	}
+
+	testData := []sigDigitsTest{
+		{"123456", 2, NumRoundType.HalfAwayFromZero(), "120000"},
+		{"125000", 2, NumRoundType.HalfToEven(), "120000"},
+		{"135000", 2, NumRoundType.HalfToEven(), "140000"},
+		{"125000", 2, NumRoundType.HalfToOdd(), "130000"},
+		{"0.0012345", 3, NumRoundType.HalfToEven(), "0.00123"},
+		{"0.0012355", 3, NumRoundType.HalfToEven(), "0.00124"},
+		{"-2.5", 4, NumRoundType.HalfAwayFromZero(), "-2.500"},
+		{"9.99", 2, NumRoundType.HalfAwayFromZero(), "10"},
+		{"0.096", 1, NumRoundType.HalfAwayFromZero(), "0.1"},
+		{"1.21", 2, NumRoundType.Ceiling(), "1.3"},
+		{"-1.21", 2, NumRoundType.Ceiling(), "-1.2"},
+		{"1.29", 2, NumRoundType.Floor(), "1.2"},
+		{"-1.21", 2, NumRoundType.Floor(), "-1.3"},
+		{"-7.45", 2, NumRoundType.HalfUpWithNegNums(), "-7.4"},
+		{"-7.45", 2, NumRoundType.HalfDownWithNegNums(), "-7.5"},
+		{"7.45", 2, NumRoundType.HalfTowardsZero(), "7.4"},
+		{"-7.46", 2, NumRoundType.HalfTowardsZero(), "-7.5"},
+		{"98765.4321", 3, NumRoundType.Truncate(), "98700"},
+		{"0.000", 3, NumRoundType.HalfAwayFromZero(), "0.000"},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var actualNumStr string
+
+	for i := 0; i < len(testData); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).
+			NewParsePureNumberStr(
+				testData[i].numStr,
+				".",
+				true,
+				NumRoundType.NoRounding(),
+				0,
+				ePrefix.XCpy(
+					"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		err = numStrKernel.RoundToSignificantDigits(
+			testData[i].roundingType,
+			testData[i].significantDigits,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualNumStr,
+			_,
+			err = numStrKernel.FmtNumStrPure(
+			".",
+			true,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expectedNumStr {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Significant digits rounding failed!\n"+
+				"Number String      = '%v'\n"+
+				"Significant Digits = '%v'\n"+
+				"Rounding Type      = '%v'\n"+
+				"Expected Result    = '%v'\n"+
+				"  Actual Result    = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].numStr,
+				testData[i].significantDigits,
+				testData[i].roundingType.String(),
+				testData[i].expectedNumStr,
+				actualNumStr)
+
+			return
+		}
+	}
+
+	err = numStrKernel.RoundToSignificantDigits(
+		NumRoundType.HalfAwayFromZero(),
+		0,
+		ePrefix.XCpy(
+			"significantDigits=0"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from\n"+
+			"RoundToSignificantDigits() because\n"+
+			"'roundToSignificantDigits' is zero.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+}
+
+func TestNumStrMathRoundingIncrement_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrMathRoundingIncrement_000100()",
+		"")
+
+	type incrementTest struct {
+		numStr            string
+		roundingIncrement string
+		roundingType      NumberRoundingType
+		expectedNumStr    string
+	}
+
+	testData := []incrementTest{
+		{"1.23", "0.05", NumRoundType.HalfAwayFromZero(), "1.25"},
+		{"1.22", "0.05", NumRoundType.HalfAwayFromZero(), "1.20"},
+		{"1.225", "0.05", NumRoundType.HalfAwayFromZero(), "1.25"},
+		{"1.225", "0.05", NumRoundType.HalfToEven(), "1.20"},
+		{"1.275", "0.05", NumRoundType.HalfToEven(), "1.30"},
+		{"1.225", "0.05", NumRoundType.HalfToOdd(), "1.25"},
+		{"-1.225", "0.05", NumRoundType.HalfUpWithNegNums(), "-1.20"},
+		{"-1.225", "0.05", NumRoundType.HalfDownWithNegNums(), "-1.25"},
+		{"-1.225", "0.05", NumRoundType.HalfTowardsZero(), "-1.20"},
+		{"7.13", "0.25", NumRoundType.Ceiling(), "7.25"},
+		{"-7.13", "0.25", NumRoundType.Ceiling(), "-7.00"},
+		{"-1.21", "0.25", NumRoundType.Floor(), "-1.25"},
+		{"7.49", "0.25", NumRoundType.Truncate(), "7.25"},
+		{"1250", "100", NumRoundType.HalfToEven(), "1200"},
+		{"1250", "100", NumRoundType.HalfAwayFromZero(), "1300"},
+		{"1250.75", "100", NumRoundType.Floor(), "1200"},
+		{"49.99", "100", NumRoundType.HalfAwayFromZero(), "0"},
+		{"3.14159", "0.001", NumRoundType.HalfAwayFromZero(), "3.142"},
+		{"12", " 0.50 ", NumRoundType.HalfAwayFromZero(), "12.00"},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var actualNumStr string
+
+	for i := 0; i < len(testData); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).
+			NewParsePureNumberStr(
+				testData[i].numStr,
+				".",
+				true,
+				NumRoundType.NoRounding(),
+				0,
+				ePrefix.XCpy(
+					"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		err = numStrKernel.RoundToIncrement(
+			testData[i].roundingType,
+			testData[i].roundingIncrement,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualNumStr,
+			_,
+			err = numStrKernel.FmtNumStrPure(
+			".",
+			true,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if actualNumStr != testData[i].expectedNumStr {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Increment rounding failed!\n"+
+				"Number String      = '%v'\n"+
+				"Rounding Increment = '%v'\n"+
+				"Rounding Type      = '%v'\n"+
+				"Expected Result    = '%v'\n"+
+				"  Actual Result    = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].numStr,
+				testData[i].roundingIncrement,
+				testData[i].roundingType.String(),
+				testData[i].expectedNumStr,
+				actualNumStr)
+
+			return
+		}
+	}
+
+	invalidIncrements := []string{
+		"",
+		"0.00",
+		"-0.05",
+		"1e2",
+		"0.0.5",
+	}
+
+	for i := 0; i < len(invalidIncrements); i++ {
+
+		_,
+			err = new(NumStrRoundingSpec).NewRoundingSpecIncrement(
+			NumRoundType.HalfAwayFromZero(),
+			invalidIncrements[i],
+			ePrefix.XCpy(
+				"invalidIncrements"))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from\n"+
+				"NewRoundingSpecIncrement() because\n"+
+				"'roundingIncrement' is invalid.\n"+
+				"roundingIncrement = '%v'\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n",
+				ePrefix.String(),
+				i,
+				invalidIncrements[i])
+
+			return
+		}
+	}
+}
+
+func TestNumStrMathRoundingIncrement_000200(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrMathRoundingIncrement_000200()",
+		"")
+
+	roundingSpec,
+		err := new(NumStrRoundingSpec).NewRoundingSpecIncrement(
+		NumRoundType.HalfAwayFromZero(),
+		"0.05",
+		ePrefix.XCpy(
+			"roundingSpec"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var numStrKernel NumberStrKernel
+
+	numStrKernel,
+		_,
+		err = new(NumberStrKernel).
+		NewParsePureNumberStr(
+			"-1234.5678",
+			".",
+			true,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	var actualNumStr string
+
+	actualNumStr,
+		err = numStrKernel.FmtSignedNumStrSimple(
+		roundingSpec,
+		".",
+		",",
+		true,
+		-1,
+		TxtJustify.Right(),
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	expectedNumStr := "-1,234.55"
+
+	if actualNumStr != expectedNumStr {
+
+		t.Errorf("%v\n"+
+			"Error: FmtSignedNumStrSimple() with a rounding\n"+
+			"increment of 0.05 failed!\n"+
+			"Expected Result = '%v'\n"+
+			"  Actual Result = '%v'\n",
+			ePrefix.String(),
+			expectedNumStr,
+			actualNumStr)
+
+		return
+	}
+
+	var copiedSpec NumStrRoundingSpec
+
+	copiedSpec,
+		err = roundingSpec.CopyOut(
+		ePrefix.XCpy(
+			"copiedSpec<-roundingSpec"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	if !copiedSpec.Equal(&roundingSpec) {
+
+		t.Errorf("%v\n"+
+			"Error: copiedSpec is NOT equal to roundingSpec!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	if copiedSpec.GetRoundingIncrement() != "0.05" {
+
+		t.Errorf("%v\n"+
+			"Error: copiedSpec.GetRoundingIncrement() failed!\n"+
+			"Expected Increment = '0.05'\n"+
+			"  Actual Increment = '%v'\n",
+			ePrefix.String(),
+			copiedSpec.GetRoundingIncrement())
+
+		return
+	}
+
+	err = copiedSpec.SetRoundToSignificantDigits(
+		3,
+		ePrefix.XCpy(
+			"copiedSpec"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	if copiedSpec.GetRoundingIncrement() != "" ||
+		copiedSpec.GetRoundToSignificantDigits() != 3 {
+
+		t.Errorf("%v\n"+
+			"Error: copiedSpec.SetRoundToSignificantDigits() failed!\n"+
+			"Expected Increment          = ''\n"+
+			"  Actual Increment          = '%v'\n"+
+			"Expected Significant Digits = '3'\n"+
+			"  Actual Significant Digits = '%v'\n",
+			ePrefix.String(),
+			copiedSpec.GetRoundingIncrement(),
+			copiedSpec.GetRoundToSignificantDigits())
+
+		return
+	}
+
+	actualNumStr,
+		err = numStrKernel.FmtSignedNumStrSimple(
+		copiedSpec,
+		".",
+		",",
+		true,
+		-1,
+		TxtJustify.Right(),
+		ePrefix.XCpy(
+			"numStrKernel"))
+
+	if err != nil {
+		t.Errorf("\n%v\n",
+			err.Error())
+		return
+	}
+
+	expectedNumStr = "-1,230"
+
+	if actualNumStr != expectedNumStr {
+
+		t.Errorf("%v\n"+
+			"Error: FmtSignedNumStrSimple() with 3\n"+
+			"significant digits failed!\n"+
+			"Expected Result = '%v'\n"+
+			"  Actual Result = '%v'\n",
+			ePrefix.String(),
+			expectedNumStr,
+			actualNumStr)
+
+		return
+	}
+}
@@ -0,0 +1,437 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"strings"
+	"testing"
+)
+
+func TestNumStrNumberWords_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrNumberWords_000100()",
+		"")
+
+	type numWordsTest struct {
+		numStr        string
+		country       string
+		numberScale   NumberWordsScale
+		expectedWords string
+	}
+
+	testData := []numWordsTest{
+		{"0", "US", NumWordsScale.None(), "zero"},
+		{"7", "US", NumWordsScale.None(), "seven"},
+		{"-1234.56", "US", NumWordsScale.None(),
+			"minus one thousand two hundred thirty-four point five six"},
+		{"1005", "US", NumWordsScale.None(), "one thousand five"},
+		{"1005", "GB", NumWordsScale.None(), "one thousand and five"},
+		{"123", "GB", NumWordsScale.None(), "one hundred and twenty-three"},
+		{"1500000000", "US", NumWordsScale.None(),
+			"one billion five hundred million"},
+		{"1500000000", "US", NumWordsScale.LongScale(),
+			"one thousand five hundred million"},
+		{"2000000000000", "GB", NumWordsScale.LongScale(),
+			"two billion"},
+		{"71", "FR", NumWordsScale.None(), "soixante et onze"},
+		{"80", "FR", NumWordsScale.None(), "quatre-vingts"},
+		{"81", "FR", NumWordsScale.None(), "quatre-vingt-un"},
+		{"99", "FR", NumWordsScale.None(), "quatre-vingt-dix-neuf"},
+		{"200", "FR", NumWordsScale.None(), "deux cents"},
+		{"200000", "FR", NumWordsScale.None(), "deux cent mille"},
+		{"-1234.56", "FR", NumWordsScale.None(),
+			"moins mille deux cent trente-quatre virgule cinq six"},
+		{"1500000000", "FR", NumWordsScale.None(),
+			"un milliard cinq cents millions"},
+		{"1", "DE", NumWordsScale.None(), "eins"},
+		{"21", "DE", NumWordsScale.None(), "einundzwanzig"},
+		{"101001", "DE", NumWordsScale.None(),
+			"einhunderteintausendeins"},
+		{"-1234.56", "DE", NumWordsScale.None(),
+			"minus eintausendzweihundertvierunddreißig Komma fünf sechs"},
+		{"1500000000", "DE", NumWordsScale.None(),
+			"eine Milliarde fünfhundert Millionen"},
+		{"2000000000000", "DE", NumWordsScale.None(),
+			"zwei Billionen"},
+		{"1000000000", "DE", NumWordsScale.ShortScale(),
+			"eine Billion"},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var countrySpec NumStrFmtCountryCultureSpec
+	var roundingSpec NumStrRoundingSpec
+	var actualWords string
+
+	roundingSpec,
+		err = new(NumStrRoundingSpec).NewRoundingSpec(
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"roundingSpec"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	for i := 0; i < len(testData); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).
+			NewParsePureNumberStr(
+				testData[i].numStr,
+				".",
+				true,
+				NumRoundType.NoRounding(),
+				0,
+				ePrefix.XCpy(
+					"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		countrySpec,
+			err = testNumStrNumberWordsCountrySpec(
+			testData[i].country,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualWords,
+			err = numStrKernel.FmtNumberWords(
+			roundingSpec,
+			countrySpec,
+			testData[i].numberScale,
+			ePrefix.XCpy(
+				"actualWords"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if actualWords != testData[i].expectedWords {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: actualWords != expectedWords\n"+
+				"numStr        = '%v'\n"+
+				"country       = '%v'\n"+
+				"actualWords   = '%v'\n"+
+				"expectedWords = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].numStr,
+				testData[i].country,
+				actualWords,
+				testData[i].expectedWords)
+
+			return
+		}
+	}
+}
+
+func TestNumStrNumberWords_000200(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrNumberWords_000200()",
+		"")
+
+	type currencyWordsTest struct {
+		numStr            string
+		country           string
+		minorUnitsInWords bool
+		expectedWords     string
+	}
+
+	testData := []currencyWordsTest{
+		{"1234.56", "US", false,
+			"One thousand two hundred thirty-four dollars and 56/100"},
+		{"1234.56", "US", true,
+			"One thousand two hundred thirty-four dollars and fifty-six cents"},
+		{"1.005", "US", true,
+			"One dollar and one cent"},
+		{"5", "US", true,
+			"Five dollars"},
+		{"0.5", "US", true,
+			"Fifty cents"},
+		{"-12.30", "US", false,
+			"Minus twelve dollars and 30/100"},
+		{"101.01", "GB", true,
+			"One hundred and one pounds and one penny"},
+		{"2.02", "GB", true,
+			"Two pounds and two pence"},
+		{"1234.56", "FR", false,
+			"Mille deux cent trente-quatre euros et 56/100"},
+		{"1", "FR", true,
+			"Un euro"},
+		{"2000000", "FR", false,
+			"Deux millions d'euros et 00/100"},
+		{"280.80", "FR", true,
+			"Deux cent quatre-vingts euros et quatre-vingts cents"},
+		{"1234.56", "DE", false,
+			"Eintausendzweihundertvierunddreißig Euro und 56/100"},
+		{"1.01", "DE", true,
+			"Ein Euro und ein Cent"},
+		{"3000000.99", "DE", true,
+			"Drei Millionen Euro und neunundneunzig Cent"},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var countrySpec NumStrFmtCountryCultureSpec
+	var actualWords string
+
+	for i := 0; i < len(testData); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).
+			NewParsePureNumberStr(
+				testData[i].numStr,
+				".",
+				true,
+				NumRoundType.NoRounding(),
+				0,
+				ePrefix.XCpy(
+					"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		countrySpec,
+			err = testNumStrNumberWordsCountrySpec(
+			testData[i].country,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualWords,
+			err = numStrKernel.FmtCurrencyWords(
+			NumRoundType.HalfAwayFromZero(),
+			countrySpec,
+			NumWordsScale.None(),
+			testData[i].minorUnitsInWords,
+			ePrefix.XCpy(
+				"actualWords"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if actualWords != testData[i].expectedWords {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: actualWords != expectedWords\n"+
+				"numStr        = '%v'\n"+
+				"country       = '%v'\n"+
+				"actualWords   = '%v'\n"+
+				"expectedWords = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].numStr,
+				testData[i].country,
+				actualWords,
+				testData[i].expectedWords)
+
+			return
+		}
+	}
+}
+
+func TestNumStrNumberWords_000300(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrNumberWords_000300()",
+		"")
+
+	countrySpec,
+		err := new(NumStrFmtCountryCultureSpec).NewUS(
+		ePrefix.XCpy(
+			"countrySpec"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	var roundingSpec NumStrRoundingSpec
+
+	roundingSpec,
+		err = new(NumStrRoundingSpec).NewRoundingSpec(
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"roundingSpec"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	var numStrKernel NumberStrKernel
+
+	// 66 digits - the maximum supported
+	numStrKernel,
+		_,
+		err = new(NumberStrKernel).
+		NewParsePureNumberStr(
+			"1"+strings.Repeat("0", 65),
+			".",
+			true,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"numStrKernel 66 digits"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	var actualWords string
+
+	actualWords,
+		err = numStrKernel.FmtNumberWords(
+		roundingSpec,
+		countrySpec,
+		NumWordsScale.ShortScale(),
+		ePrefix.XCpy(
+			"actualWords"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	expectedWords := "one hundred vigintillion"
+
+	if actualWords != expectedWords {
+
+		t.Errorf("%v\n"+
+			"Error: actualWords != expectedWords\n"+
+			"actualWords   = '%v'\n"+
+			"expectedWords = '%v'\n",
+			ePrefix.String(),
+			actualWords,
+			expectedWords)
+
+		return
+	}
+
+	// 67 digits - out of range
+	numStrKernel,
+		_,
+		err = new(NumberStrKernel).
+		NewParsePureNumberStr(
+			"1"+strings.Repeat("0", 66),
+			".",
+			true,
+			NumRoundType.NoRounding(),
+			0,
+			ePrefix.XCpy(
+				"numStrKernel 67 digits"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	_,
+		err = numStrKernel.FmtNumberWords(
+		roundingSpec,
+		countrySpec,
+		NumWordsScale.LongScale(),
+		ePrefix.XCpy(
+			"67 digits"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from\n"+
+			"FmtNumberWords() because the integer value\n"+
+			"exceeds 66 digits. HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	_,
+		err = numStrKernel.FmtNumberWords(
+		roundingSpec,
+		countrySpec,
+		NumberWordsScale(99),
+		ePrefix.XCpy(
+			"Invalid numberScale"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from\n"+
+			"FmtNumberWords() because 'numberScale' is\n"+
+			"invalid. HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+}
+
+func testNumStrNumberWordsCountrySpec(
+	country string,
+	ePrefix ePref.ErrPrefixDto) (
+	NumStrFmtCountryCultureSpec,
+	error) {
+
+	switch country {
+	case "GB":
+		return new(NumStrFmtCountryCultureSpec).NewUK(ePrefix)
+	case "FR":
+		return new(NumStrFmtCountryCultureSpec).NewFrance(ePrefix)
+	case "DE":
+		return new(NumStrFmtCountryCultureSpec).NewGermany(ePrefix)
+	}
+
+	return new(NumStrFmtCountryCultureSpec).NewUS(ePrefix)
+}
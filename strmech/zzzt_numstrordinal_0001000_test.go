@@ -0,0 +1,489 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"testing"
+)
+
+func TestNumStrOrdinal_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrOrdinal_000100()",
+		"")
+
+	type ordinalTest struct {
+		numStr             string
+		languageCode       string
+		useFeminine        bool
+		useSuperscript     bool
+		expectedOrdinalStr string
+	}
+
+	testData := []ordinalTest{
+		{"1", "EN", false, false, "1st"},
+		{"2", "EN", false, false, "2nd"},
+		{"3", "EN", false, false, "3rd"},
+		{"4", "EN", false, false, "4th"},
+		{"11", "EN", false, false, "11th"},
+		{"12", "EN", false, false, "12th"},
+		{"13", "EN", false, false, "13th"},
+		{"21", "EN", false, false, "21st"},
+		{"23", "EN", false, false, "23rd"},
+		{"111", "EN", false, false, "111th"},
+		{"1002", "EN", false, false, "1,002nd"},
+		{"1000000", "EN", false, false, "1,000,000th"},
+		{"1000001", "EN", false, true, "1,000,001ˢᵗ"},
+		{"0", "EN", false, false, "0th"},
+		{"1", "EN", false, true, "1ˢᵗ"},
+		{"22", "EN", false, true, "22ⁿᵈ"},
+		{"1", "FR", false, false, "1er"},
+		{"1", "FR", true, false, "1re"},
+		{"2", "FR", false, false, "2e"},
+		{"21", "FR", false, false, "21e"},
+		{"2", "FR", false, true, "2ᵉ"},
+		{"1", "DE", false, false, "1."},
+		{"25", "DE", false, false, "25."},
+		{"7.0", "en", false, false, "7th"},
+		{"1000000", "FR", false, false, "1 000 000e"},
+		{"1000", "FR", true, true, "1 000ᵉ"},
+		{"1000000", "DE", false, false, "1.000.000."},
+		{"1000", "DE", false, true, "1.000."},
+		{"1000000", "en-US", false, false, "1,000,000th"},
+		{"22", "en-GB", false, false, "22nd"},
+		{"1", "fr-FR", true, false, "1re"},
+		{"12345", "fr_CA", false, false, "12 345e"},
+		{"3", "de-DE", false, false, "3."},
+		{"1234", "DE-AT", false, false, "1.234."},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var roundingSpec NumStrRoundingSpec
+	var numStrFmtSpec NumStrFormatSpec
+	var actualOrdinalStr string
+
+	roundingSpec,
+		err = new(NumStrRoundingSpec).NewRoundingSpec(
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"roundingSpec"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	for i := 0; i < len(testData); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).
+			NewParsePureNumberStr(
+				testData[i].numStr,
+				".",
+				true,
+				NumRoundType.NoRounding(),
+				0,
+				ePrefix.XCpy(
+					"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		numStrFmtSpec,
+			err = new(NumStrFormatSpec).NewOrdinalNumFormat(
+			testData[i].languageCode,
+			testData[i].useFeminine,
+			testData[i].useSuperscript,
+			NumStrNumberSymbolGroup{},
+			NumStrNumberFieldSpec{},
+			ePrefix.XCpy(
+				"numStrFmtSpec"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualOrdinalStr,
+			err = numStrKernel.FmtNumStr(
+			roundingSpec,
+			numStrFmtSpec,
+			ePrefix.XCpy(
+				"actualOrdinalStr"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if actualOrdinalStr != testData[i].expectedOrdinalStr {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: actualOrdinalStr != expectedOrdinalStr\n"+
+				"numStr             = '%v'\n"+
+				"actualOrdinalStr   = '%v'\n"+
+				"expectedOrdinalStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].numStr,
+				actualOrdinalStr,
+				testData[i].expectedOrdinalStr)
+
+			return
+		}
+
+		actualOrdinalStr,
+			err = numStrKernel.FmtOrdinalNumStr(
+			roundingSpec,
+			testData[i].languageCode,
+			testData[i].useFeminine,
+			testData[i].useSuperscript,
+			ePrefix.XCpy(
+				"FmtOrdinalNumStr"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if actualOrdinalStr != testData[i].expectedOrdinalStr {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: FmtOrdinalNumStr() actualOrdinalStr !=\n"+
+				"expectedOrdinalStr\n"+
+				"numStr             = '%v'\n"+
+				"actualOrdinalStr   = '%v'\n"+
+				"expectedOrdinalStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].numStr,
+				actualOrdinalStr,
+				testData[i].expectedOrdinalStr)
+
+			return
+		}
+	}
+
+	_,
+		err = new(NumStrFormatSpec).NewOrdinalNumFormat(
+		"ES",
+		false,
+		false,
+		NumStrNumberSymbolGroup{},
+		NumStrNumberFieldSpec{},
+		ePrefix.XCpy(
+			"Invalid languageCode"))
+
+	if err == nil {
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from\n"+
+			"NewOrdinalNumFormat() because 'languageCode'\n"+
+			"is invalid. However, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	invalidValues := []string{
+		"-3",
+		"12.5",
+	}
+
+	for i := 0; i < len(invalidValues); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).
+			NewParsePureNumberStr(
+				invalidValues[i],
+				".",
+				true,
+				NumRoundType.NoRounding(),
+				0,
+				ePrefix.XCpy(
+					"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		_,
+			err = numStrKernel.FmtOrdinalNumStr(
+			roundingSpec,
+			"EN",
+			false,
+			false,
+			ePrefix.XCpy(
+				invalidValues[i]))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from\n"+
+				"FmtOrdinalNumStr() because the value cannot be\n"+
+				"formatted as an ordinal number.\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n"+
+				"numStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				invalidValues[i])
+
+			return
+		}
+	}
+}
+
+func TestNumStrOrdinal_000200(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrOrdinal_000200()",
+		"")
+
+	var err error
+	var ordinalFmtSpec NumStrOrdinalFormatSpec
+
+	ordinalFmtSpec,
+		err = new(NumStrOrdinalFormatSpec).NewOrdinalFormat(
+		"EN",
+		false,
+		false,
+		ePrefix.XCpy(
+			"ordinalFmtSpec"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	var txtLabel TextFieldSpecLabel
+
+	txtLabel,
+		err = TextFieldSpecLabel{}.NewOrdinalLabel(
+		23,
+		ordinalFmtSpec,
+		"",
+		6,
+		TxtJustify.Right(),
+		ePrefix.XCpy(
+			"txtLabel<-23"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	expectedStr := "  23rd"
+
+	var actualStr string
+
+	actualStr,
+		err = txtLabel.GetFormattedText(
+		ePrefix.XCpy(
+			"txtLabel"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	if actualStr != expectedStr {
+
+		t.Errorf("%v\n"+
+			"Error: actualStr != expectedStr\n"+
+			"actualStr   = '%v'\n"+
+			"expectedStr = '%v'\n",
+			ePrefix.String(),
+			actualStr,
+			expectedStr)
+
+		return
+	}
+
+	stdLine := TextLineSpecStandardLine{}.New()
+
+	_,
+		err = stdLine.AddTextFieldOrdinal(
+		2,
+		NumStrOrdinalFormatSpec{},
+		":",
+		-1,
+		TxtJustify.Left(),
+		ePrefix.XCpy(
+			"stdLine<-2"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	expectedStr = "2nd:\n"
+
+	actualStr,
+		err = stdLine.GetFormattedText(
+		ePrefix.XCpy(
+			"stdLine"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	if actualStr != expectedStr {
+
+		t.Errorf("%v\n"+
+			"Error: stdLine actualStr != expectedStr\n"+
+			"actualStr   = '%v'\n"+
+			"expectedStr = '%v'\n",
+			ePrefix.String(),
+			actualStr,
+			expectedStr)
+
+		return
+	}
+
+	_,
+		err = TextFieldSpecLabel{}.NewOrdinalLabel(
+		-1,
+		ordinalFmtSpec,
+		"",
+		-1,
+		TxtJustify.Left(),
+		ePrefix.XCpy(
+			"txtLabel<--1"))
+
+	if err == nil {
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from\n"+
+			"NewOrdinalLabel() because 'value' is negative.\n"+
+			"However, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+}
+
+func TestNumStrOrdinal_000300(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrOrdinal_000300()",
+		"")
+
+	type languageCodeTest struct {
+		languageCode         string
+		expectedLanguageCode string
+	}
+
+	testData := []languageCodeTest{
+		{"en", "EN"},
+		{"en-US", "EN"},
+		{"fr-FR", "FR"},
+		{"fr_CA", "FR"},
+		{" de-DE ", "DE"},
+		{"de-Latn-CH", "DE"},
+	}
+
+	var err error
+	var ordinalFmtSpec NumStrOrdinalFormatSpec
+	var actualLanguageCode string
+
+	for i := 0; i < len(testData); i++ {
+
+		ordinalFmtSpec,
+			err = new(NumStrOrdinalFormatSpec).NewOrdinalFormat(
+			testData[i].languageCode,
+			false,
+			false,
+			ePrefix.XCpy(
+				"ordinalFmtSpec"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualLanguageCode = ordinalFmtSpec.GetLanguageCode()
+
+		if actualLanguageCode != testData[i].expectedLanguageCode {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: actualLanguageCode != expectedLanguageCode\n"+
+				"languageCode         = '%v'\n"+
+				"actualLanguageCode   = '%v'\n"+
+				"expectedLanguageCode = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].languageCode,
+				actualLanguageCode,
+				testData[i].expectedLanguageCode)
+
+			return
+		}
+	}
+
+	invalidLanguageCodes := []string{
+		"es-ES",
+		"-US",
+		"",
+		"eng",
+	}
+
+	for i := 0; i < len(invalidLanguageCodes); i++ {
+
+		_,
+			err = new(NumStrOrdinalFormatSpec).NewOrdinalFormat(
+			invalidLanguageCodes[i],
+			false,
+			false,
+			ePrefix.XCpy(
+				"Invalid languageCode"))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from\n"+
+				"NewOrdinalFormat() because 'languageCode'\n"+
+				"is invalid. However, NO ERROR WAS RETURNED!\n"+
+				"languageCode = '%v'\n",
+				ePrefix.String(),
+				i,
+				invalidLanguageCodes[i])
+
+			return
+		}
+	}
+}
@@ -0,0 +1,358 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"testing"
+)
+
+func TestNumStrPercent_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrPercent_000100()",
+		"")
+
+	type percentTest struct {
+		numStr             string
+		percentFmtType     NumStrFormatTypeCode
+		countryCode        string
+		expectedPercentStr string
+	}
+
+	testData := []percentTest{
+		{"0.1234", NumStrFmtType.Percent(), "US", "12.34%"},
+		{"0.1234", NumStrFmtType.Percent(), "FR", "12,34\u00A0%"},
+		{"0.1234", NumStrFmtType.Percent(), "DE", "12,34\u00A0%"},
+		{"0.1234", NumStrFmtType.PerMille(), "US", "123.4‰"},
+		{"0.1234", NumStrFmtType.BasisPoint(), "US", "1,234\u00A0bp"},
+		{"-0.5", NumStrFmtType.Percent(), "US", "-50%"},
+		{"12", NumStrFmtType.Percent(), "US", "1,200%"},
+		{"0.00005", NumStrFmtType.Percent(), "US", "0.005%"},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var roundingSpec NumStrRoundingSpec
+	var numStrFmtSpec NumStrFormatSpec
+	var numberFieldSpec NumStrNumberFieldSpec
+	var countryCultureSpec NumStrFmtCountryCultureSpec
+	var actualPercentStr string
+
+	roundingSpec,
+		err = new(NumStrRoundingSpec).NewRoundingSpec(
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"roundingSpec"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	numberFieldSpec,
+		err = new(NumStrNumberFieldSpec).NewFieldSpec(
+		-1,
+		TxtJustify.Right(),
+		ePrefix.XCpy(
+			"numberFieldSpec"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	for i := 0; i < len(testData); i++ {
+
+		switch testData[i].countryCode {
+		case "FR":
+			countryCultureSpec,
+				err = new(NumStrFmtCountryCultureSpec).NewFrance(
+				ePrefix.XCpy(
+					"countryCultureSpec"))
+		case "DE":
+			countryCultureSpec,
+				err = new(NumStrFmtCountryCultureSpec).NewGermany(
+				ePrefix.XCpy(
+					"countryCultureSpec"))
+		default:
+			countryCultureSpec,
+				err = new(NumStrFmtCountryCultureSpec).NewUS(
+				ePrefix.XCpy(
+					"countryCultureSpec"))
+		}
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).
+			NewParsePureNumberStr(
+				testData[i].numStr,
+				".",
+				true,
+				NumRoundType.NoRounding(),
+				0,
+				ePrefix.XCpy(
+					"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		numStrFmtSpec,
+			err = new(NumStrFormatSpec).NewPercentNumFormatCountry(
+			testData[i].percentFmtType,
+			countryCultureSpec,
+			numberFieldSpec,
+			ePrefix.XCpy(
+				"numStrFmtSpec"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualPercentStr,
+			err = numStrKernel.FmtNumStr(
+			roundingSpec,
+			numStrFmtSpec,
+			ePrefix.XCpy(
+				"actualPercentStr"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if actualPercentStr != testData[i].expectedPercentStr {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: actualPercentStr != expectedPercentStr\n"+
+				"numStr             = '%v'\n"+
+				"actualPercentStr   = '%v'\n"+
+				"expectedPercentStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].numStr,
+				actualPercentStr,
+				testData[i].expectedPercentStr)
+
+			return
+		}
+	}
+
+	numStrFmtSpec,
+		err = new(NumStrFormatSpec).NewPercentNumFormatCountry(
+		NumStrFmtType.RomanNumeral(),
+		countryCultureSpec,
+		numberFieldSpec,
+		ePrefix.XCpy(
+			"Invalid percentFmtType"))
+
+	if err == nil {
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from\n"+
+			"NewPercentNumFormatCountry() because 'percentFmtType'\n"+
+			"is invalid. However, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+}
+
+func TestNumStrPercent_000200(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrPercent_000200()",
+		"")
+
+	type percentParseTest struct {
+		percentNumStr    string
+		percentFmtType   NumStrFormatTypeCode
+		decimalSeparator string
+		expectedIntStr   string
+		expectedFracStr  string
+		expectedSign     int
+	}
+
+	testData := []percentParseTest{
+		{"12.5%", NumStrFmtType.None(), ".", "0", "125", 1},
+		{"12,5\u00A0%", NumStrFmtType.None(), ",", "0", "125", 1},
+		{"-12.5 %", NumStrFmtType.None(), ".", "0", "125", -1},
+		{"125‰", NumStrFmtType.None(), ".", "0", "125", 1},
+		{"1,250 bp", NumStrFmtType.None(), ".", "0", "125", 1},
+		{"1250 bps", NumStrFmtType.None(), ".", "0", "125", 1},
+		{"250", NumStrFmtType.Percent(), ".", "2", "5", 1},
+		{"(12.5%)", NumStrFmtType.None(), ".", "0", "125", -1},
+		{"12.5%-", NumStrFmtType.None(), ".", "0", "125", -1},
+		{"%12,5", NumStrFmtType.None(), ",", "0", "125", 1},
+		{"1.250,5 bp", NumStrFmtType.None(), ",", "0", "12505", 1},
+		{"+.5%", NumStrFmtType.None(), ".", "0", "005", 1},
+		{"1,234,567.5%", NumStrFmtType.None(), ".", "12345", "675", 1},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var numberSign int
+
+	for i := 0; i < len(testData); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).NewParsePercentNumberStr(
+			testData[i].percentNumStr,
+			testData[i].percentFmtType,
+			testData[i].decimalSeparator,
+			ePrefix.XCpy(
+				"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		numberSign,
+			err = numStrKernel.GetNumberSignAsInt(
+			ePrefix.XCpy(
+				"numberSign"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if numStrKernel.GetIntegerString() != testData[i].expectedIntStr ||
+			numStrKernel.GetFractionalString() != testData[i].expectedFracStr ||
+			numberSign != testData[i].expectedSign {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Parsed value does NOT match expected value.\n"+
+				"percentNumStr   = '%v'\n"+
+				"Actual Integer  = '%v'\n"+
+				"Actual Fraction = '%v'\n"+
+				"Actual Sign     = '%v'\n"+
+				"Expected Value  = '%v.%v' Sign= '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].percentNumStr,
+				numStrKernel.GetIntegerString(),
+				numStrKernel.GetFractionalString(),
+				numberSign,
+				testData[i].expectedIntStr,
+				testData[i].expectedFracStr,
+				testData[i].expectedSign)
+
+			return
+		}
+	}
+
+	_,
+		_,
+		err = new(NumberStrKernel).NewParsePercentNumberStr(
+		"12.5",
+		NumStrFmtType.None(),
+		".",
+		ePrefix.XCpy(
+			"No percent symbol"))
+
+	if err == nil {
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from\n"+
+			"NewParsePercentNumberStr() because no percent\n"+
+			"symbol was found. However, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+
+	invalidPercentNumStrs := []string{
+		"1.2.3%",
+		"12.5% 3",
+		"abc12%",
+		"12.5%%",
+		"%12.5%",
+		"12%5",
+		"--12.5%",
+		"12.5-%-",
+		"%",
+		"1,,250 bp",
+		"12.5,0%",
+		"12,5%",
+		"1,2345%",
+		"1234,567%",
+		"1,234,56%",
+	}
+
+	for i := 0; i < len(invalidPercentNumStrs); i++ {
+
+		_,
+			_,
+			err = new(NumberStrKernel).NewParsePercentNumberStr(
+			invalidPercentNumStrs[i],
+			NumStrFmtType.None(),
+			".",
+			ePrefix.XCpy(
+				"Invalid percentNumStr"))
+
+		if err == nil {
+			t.Errorf("%v Invalid Test #%v\n"+
+				"Error: Expected an error return from\n"+
+				"NewParsePercentNumberStr() because 'percentNumStr'\n"+
+				"is invalid. However, NO ERROR WAS RETURNED!\n"+
+				"percentNumStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				invalidPercentNumStrs[i])
+
+			return
+		}
+	}
+
+	_,
+		_,
+		err = new(NumberStrKernel).NewParsePercentNumberStr(
+		"12.5‰",
+		NumStrFmtType.Percent(),
+		".",
+		ePrefix.XCpy(
+			"Conflicting symbol"))
+
+	if err == nil {
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from\n"+
+			"NewParsePercentNumberStr() because the percent\n"+
+			"symbol conflicts with 'percentFmtType'.\n"+
+			"However, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+	}
+}
@@ -0,0 +1,474 @@
+package strmech
+
+import (
+	ePref "github.com/MikeAustin71/errpref"
+	"testing"
+)
+
+func TestNumStrRomanNumeral_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrRomanNumeral_000100()",
+		"")
+
+	type romanNumeralTest struct {
+		numStr               string
+		useLowerCase         bool
+		useVinculum          bool
+		expectedRomanNumeral string
+	}
+
+	testData := []romanNumeralTest{
+		{"1", false, false, "I"},
+		{"4", false, false, "IV"},
+		{"9", true, false, "ix"},
+		{"14", false, false, "XIV"},
+		{"40", false, false, "XL"},
+		{"90", false, false, "XC"},
+		{"400", false, false, "CD"},
+		{"1994", false, false, "MCMXCIV"},
+		{"1994", true, false, "mcmxciv"},
+		{"3999", false, false, "MMMCMXCIX"},
+		{"3999", false, true, "MMMCMXCIX"},
+		{"4000", false, true, "I̅V̅"},
+		{"4001", false, true, "I̅V̅I"},
+		{"3999999", false, true,
+			"M̅M̅M̅C̅M̅X̅C̅I̅X̅CMXCIX"},
+	}
+
+	var err error
+	var numStrKernel NumberStrKernel
+	var roundingSpec NumStrRoundingSpec
+	var numStrFmtSpec NumStrFormatSpec
+	var numberFieldSpec NumStrNumberFieldSpec
+	var actualRomanNumeral string
+
+	roundingSpec,
+		err = new(NumStrRoundingSpec).NewRoundingSpec(
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"roundingSpec"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	numberFieldSpec,
+		err = new(NumStrNumberFieldSpec).NewFieldSpec(
+		-1,
+		TxtJustify.Right(),
+		ePrefix.XCpy(
+			"numberFieldSpec"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	for i := 0; i < len(testData); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).
+			NewParsePureNumberStr(
+				testData[i].numStr,
+				".",
+				true,
+				NumRoundType.NoRounding(),
+				0,
+				ePrefix.XCpy(
+					"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		numStrFmtSpec,
+			err = new(NumStrFormatSpec).NewRomanNumeralNumFormat(
+			testData[i].useLowerCase,
+			testData[i].useVinculum,
+			NumStrNumberSymbolGroup{},
+			numberFieldSpec,
+			ePrefix.XCpy(
+				"numStrFmtSpec"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		actualRomanNumeral,
+			err = numStrKernel.FmtNumStr(
+			roundingSpec,
+			numStrFmtSpec,
+			ePrefix.XCpy(
+				"actualRomanNumeral"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if actualRomanNumeral != testData[i].expectedRomanNumeral {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: actualRomanNumeral != expectedRomanNumeral\n"+
+				"numStr               = '%v'\n"+
+				"actualRomanNumeral   = '%v'\n"+
+				"expectedRomanNumeral = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].numStr,
+				actualRomanNumeral,
+				testData[i].expectedRomanNumeral)
+
+			return
+		}
+
+		var parsedKernel NumberStrKernel
+
+		parsedKernel,
+			_,
+			err = new(NumberStrKernel).NewParseRomanNumeral(
+			actualRomanNumeral,
+			ePrefix.XCpy(
+				"parsedKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		if parsedKernel.GetIntegerString() != testData[i].numStr {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Round trip parse failed!\n"+
+				"romanNumeral   = '%v'\n"+
+				"parsedValue    = '%v'\n"+
+				"expectedValue  = '%v'\n",
+				ePrefix.String(),
+				i,
+				actualRomanNumeral,
+				parsedKernel.GetIntegerString(),
+				testData[i].numStr)
+
+			return
+		}
+	}
+}
+
+func TestNumStrRomanNumeral_000200(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrRomanNumeral_000200()",
+		"")
+
+	invalidRomanNumerals := []string{
+		"",
+		"IIII",
+		"VX",
+		"IC",
+		"MMMM",
+		"VV",
+		"XM",
+		"IIX",
+		"Xiv",
+		"mCm",
+		" XIV",
+		"XIV.",
+		"ABC",
+		"I̅I̅I̅",
+	}
+
+	var err error
+
+	for i := 0; i < len(invalidRomanNumerals); i++ {
+
+		_,
+			_,
+			err = new(NumberStrKernel).NewParseRomanNumeral(
+			invalidRomanNumerals[i],
+			ePrefix.XCpy(
+				invalidRomanNumerals[i]))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from\n"+
+				"NewParseRomanNumeral() because the Roman numeral\n"+
+				"is invalid. HOWEVER, NO ERROR WAS RETURNED!\n"+
+				"romanNumeral = '%v'\n",
+				ePrefix.String(),
+				i,
+				invalidRomanNumerals[i])
+
+			return
+		}
+	}
+
+	invalidValues := []struct {
+		numStr      string
+		useVinculum bool
+	}{
+		{"0", false},
+		{"-5", false},
+		{"12.5", false},
+		{"4000", false},
+		{"4000000", true},
+	}
+
+	var numStrKernel NumberStrKernel
+	var roundingSpec NumStrRoundingSpec
+	var numStrFmtSpec NumStrFormatSpec
+
+	roundingSpec,
+		err = new(NumStrRoundingSpec).NewRoundingSpec(
+		NumRoundType.NoRounding(),
+		0,
+		ePrefix.XCpy(
+			"roundingSpec"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	for i := 0; i < len(invalidValues); i++ {
+
+		numStrKernel,
+			_,
+			err = new(NumberStrKernel).
+			NewParsePureNumberStr(
+				invalidValues[i].numStr,
+				".",
+				true,
+				NumRoundType.NoRounding(),
+				0,
+				ePrefix.XCpy(
+					"numStrKernel"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		numStrFmtSpec,
+			err = new(NumStrFormatSpec).NewRomanNumeralNumFormat(
+			false,
+			invalidValues[i].useVinculum,
+			NumStrNumberSymbolGroup{},
+			NumStrNumberFieldSpec{},
+			ePrefix.XCpy(
+				"numStrFmtSpec"))
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"%v\n",
+				ePrefix.String(),
+				i,
+				err.Error())
+			return
+		}
+
+		_,
+			err = numStrKernel.FmtNumStr(
+			roundingSpec,
+			numStrFmtSpec,
+			ePrefix.XCpy(
+				invalidValues[i].numStr))
+
+		if err == nil {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Expected an error return from\n"+
+				"FmtNumStr() because the value cannot be\n"+
+				"formatted as a Roman numeral.\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n"+
+				"numStr = '%v'\n",
+				ePrefix.String(),
+				i,
+				invalidValues[i].numStr)
+
+			return
+		}
+	}
+}
+
+func TestNumStrRomanNumeral_000300(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestNumStrRomanNumeral_000300()",
+		"")
+
+	romanNumeralFmtSpec,
+		err := new(NumStrRomanNumeralFormatSpec).NewRomanNumeralFormat(
+		true,
+		false,
+		ePrefix.XCpy(
+			"romanNumeralFmtSpec"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	var label TextFieldSpecLabel
+
+	label,
+		err = TextFieldSpecLabel{}.NewRomanNumeralLabel(
+		14,
+		romanNumeralFmtSpec,
+		")",
+		7,
+		TxtJustify.Right(),
+		ePrefix.XCpy(
+			"label"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	expectedLabel := "xiv)"
+
+	actualLabel := label.GetTextLabel()
+
+	if actualLabel != expectedLabel {
+
+		t.Errorf("%v\n"+
+			"Error: actualLabel != expectedLabel\n"+
+			"actualLabel   = '%v'\n"+
+			"expectedLabel = '%v'\n",
+			ePrefix.String(),
+			actualLabel,
+			expectedLabel)
+
+		return
+	}
+
+	expectedFmtLabel := "   xiv)"
+
+	var actualFmtLabel string
+
+	actualFmtLabel,
+		err = label.GetFormattedText(
+		ePrefix.XCpy(
+			"actualFmtLabel"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	if actualFmtLabel != expectedFmtLabel {
+
+		t.Errorf("%v\n"+
+			"Error: actualFmtLabel != expectedFmtLabel\n"+
+			"actualFmtLabel   = '%v'\n"+
+			"expectedFmtLabel = '%v'\n",
+			ePrefix.String(),
+			actualFmtLabel,
+			expectedFmtLabel)
+
+		return
+	}
+
+	stdLine := TextLineSpecStandardLine{}.New()
+
+	for i := 1; i <= 3; i++ {
+
+		_,
+			err = stdLine.AddTextFieldRomanNumeral(
+			i,
+			NumStrRomanNumeralFormatSpec{},
+			".",
+			5,
+			TxtJustify.Left(),
+			ePrefix.XCpy(
+				"stdLine"))
+
+		if err != nil {
+			t.Errorf("%v\n",
+				err.Error())
+			return
+		}
+	}
+
+	var actualStdLine string
+
+	actualStdLine,
+		err = stdLine.GetFormattedText(
+		ePrefix.XCpy(
+			"actualStdLine"))
+
+	if err != nil {
+		t.Errorf("%v\n",
+			err.Error())
+		return
+	}
+
+	expectedStdLine := "I.   II.  III. \n"
+
+	if actualStdLine != expectedStdLine {
+
+		t.Errorf("%v\n"+
+			"Error: actualStdLine != expectedStdLine\n"+
+			"actualStdLine   = '%v'\n"+
+			"expectedStdLine = '%v'\n",
+			ePrefix.String(),
+			actualStdLine,
+			expectedStdLine)
+
+		return
+	}
+
+	_,
+		err = stdLine.AddTextFieldRomanNumeral(
+		0,
+		NumStrRomanNumeralFormatSpec{},
+		".",
+		5,
+		TxtJustify.Left(),
+		ePrefix.XCpy(
+			"stdLine value=0"))
+
+	if err == nil {
+
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from\n"+
+			"AddTextFieldRomanNumeral() because 'value' is zero.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			ePrefix.String())
+
+		return
+	}
+}
@@ -91,3 +91,90 @@ func TestRuneArrayDto_DeleteLeadingTrailingChars_000100(t *testing.T) {
 
 	return
 }
+
+func TestRuneArrayDto_IsAllNumericDigits_000100(t *testing.T) {
+
+	ePrefix := ePref.ErrPrefixDto{}.NewEPrefCtx(
+		"TestRuneArrayDto_IsAllNumericDigits_000100()",
+		"")
+
+	type numericDigitsTest struct {
+		charsStr       string
+		expectedResult bool
+	}
+
+	testData := []numericDigitsTest{
+		{"0123456789", true},
+		{"٠١٢٣٤٥٦٧٨٩", true},
+		{"١٢٣", true},
+		{"०१२३४५६७८९", true},
+		{"४२", true},
+		{"۰۱۲۳۴۵۶۷۸۹", true},
+		{"๐๑๒๓", true},
+		{"０１２３", true},
+		{"12٣", false},
+		{"١२", false},
+		{"١٢.٣", false},
+		{"१२a", false},
+		{"12 3", false},
+		{"", false},
+	}
+
+	var runeArrayDto RuneArrayDto
+	var actualResult bool
+
+	for i := 0; i < len(testData); i++ {
+
+		runeArrayDto = new(RuneArrayDto).NewStringDefault(
+			testData[i].charsStr)
+
+		actualResult = runeArrayDto.IsAllNumericDigits()
+
+		if actualResult != testData[i].expectedResult {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: IsAllNumericDigits() returned an invalid result!\n"+
+				"charsStr       = '%v'\n"+
+				"expectedResult = '%v'\n"+
+				"actualResult   = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].charsStr,
+				testData[i].expectedResult,
+				actualResult)
+
+			return
+		}
+
+		actualResult = new(runeArrayDtoQuark).
+			isRuneArrayAllNumericDigits(&runeArrayDto)
+
+		if actualResult != testData[i].expectedResult {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: isRuneArrayAllNumericDigits() returned an\n"+
+				"invalid result!\n"+
+				"charsStr       = '%v'\n"+
+				"expectedResult = '%v'\n"+
+				"actualResult   = '%v'\n",
+				ePrefix.String(),
+				i,
+				testData[i].charsStr,
+				testData[i].expectedResult,
+				actualResult)
+
+			return
+		}
+	}
+
+	actualResult = new(runeArrayDtoQuark).
+		isRuneArrayAllNumericDigits(nil)
+
+	if actualResult != false {
+
+		t.Errorf("%v\n"+
+			"Error: isRuneArrayAllNumericDigits(nil) returned 'true'.\n"+
+			"Expected a return value of 'false'.\n",
+			ePrefix.String())
+	}
+}
@@ -0,0 +1,671 @@
+package strmech
+
+import (
+	"testing"
+)
+
+func testTelephoneNumCheckFormats(
+	t *testing.T,
+	funcName string,
+	telNumDto *TelephoneNumberDto,
+	expectedE164 string,
+	expectedNational string,
+	expectedInternational string,
+	expectedDial string) bool {
+
+	testData := []struct {
+		label    string
+		fmtFunc  func(errorPrefix interface{}) (string, error)
+		expected string
+	}{
+		{"FmtE164()", telNumDto.FmtE164, expectedE164},
+		{"FmtNational()", telNumDto.FmtNational, expectedNational},
+		{"FmtInternational()", telNumDto.FmtInternational, expectedInternational},
+		{"FmtDial()", telNumDto.FmtDial, expectedDial},
+	}
+
+	for i := 0; i < len(testData); i++ {
+
+		if len(testData[i].expected) == 0 {
+			continue
+		}
+
+		actualStr,
+			err := testData[i].fmtFunc(funcName)
+
+		if err != nil {
+			t.Errorf("%v\n"+
+				"Error: %v\n"+
+				"%v\n",
+				funcName,
+				testData[i].label,
+				err.Error())
+
+			return false
+		}
+
+		if actualStr != testData[i].expected {
+			t.Errorf("%v\n"+
+				"Error: %v result is invalid!\n"+
+				"Actual   = '%v'\n"+
+				"Expected = '%v'\n",
+				funcName,
+				testData[i].label,
+				actualStr,
+				testData[i].expected)
+
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestTelephoneNumberDto_NewParseTelephoneNumber_000100(t *testing.T) {
+
+	funcName := "TestTelephoneNumberDto_NewParseTelephoneNumber_000100()"
+
+	telNumDto,
+		err := new(TelephoneNumberDto).NewParseTelephoneNumber(
+		"+44 20 7946 0958",
+		"",
+		funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	if telNumDto.CountryCodeTwoChar != "GB" ||
+		telNumDto.CountryTelephoneCode != "44" ||
+		telNumDto.AreaCode != "20" ||
+		telNumDto.SubscriberNumber != "79460958" ||
+		telNumDto.Extension != "" {
+
+		t.Errorf("%v\n"+
+			"Error: Parsed telephone number is invalid!\n"+
+			"CountryCodeTwoChar   = '%v'\n"+
+			"CountryTelephoneCode = '%v'\n"+
+			"AreaCode             = '%v'\n"+
+			"SubscriberNumber     = '%v'\n"+
+			"Extension            = '%v'\n",
+			funcName,
+			telNumDto.CountryCodeTwoChar,
+			telNumDto.CountryTelephoneCode,
+			telNumDto.AreaCode,
+			telNumDto.SubscriberNumber,
+			telNumDto.Extension)
+
+		return
+	}
+
+	if !testTelephoneNumCheckFormats(
+		t,
+		funcName,
+		&telNumDto,
+		"+442079460958",
+		"020 7946 0958",
+		"+44 20 7946 0958",
+		"02079460958") {
+		return
+	}
+
+	rawTelNums := []string{
+		"+44 (0)20 7946 0958",
+		"020 7946 0958",
+		"00 44 20 7946 0958",
+	}
+
+	for i := 0; i < len(rawTelNums); i++ {
+
+		telNumDto,
+			err = new(TelephoneNumberDto).NewParseTelephoneNumber(
+			rawTelNums[i],
+			"GB",
+			funcName)
+
+		if err != nil {
+			t.Errorf("%v\n"+
+				"rawTelNum = '%v'\n"+
+				"%v\n",
+				funcName,
+				rawTelNums[i],
+				err.Error())
+			return
+		}
+
+		if !testTelephoneNumCheckFormats(
+			t,
+			funcName,
+			&telNumDto,
+			"+442079460958",
+			"020 7946 0958",
+			"+44 20 7946 0958",
+			"") {
+			return
+		}
+	}
+
+	telNumDto,
+		err = new(TelephoneNumberDto).NewParseTelephoneNumber(
+		"011 44 20 7946 0958",
+		"US",
+		funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	if !testTelephoneNumCheckFormats(
+		t,
+		funcName,
+		&telNumDto,
+		"+442079460958",
+		"",
+		"+44 20 7946 0958",
+		"") {
+		return
+	}
+}
+
+func TestTelephoneNumberDto_NewParseTelephoneNumber_000200(t *testing.T) {
+
+	funcName := "TestTelephoneNumberDto_NewParseTelephoneNumber_000200()"
+
+	telNumDto,
+		err := new(TelephoneNumberDto).NewParseTelephoneNumber(
+		"(212) 555-0100 x42",
+		"US",
+		funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	if telNumDto.AreaCode != "212" ||
+		telNumDto.SubscriberNumber != "5550100" ||
+		telNumDto.Extension != "42" {
+
+		t.Errorf("%v\n"+
+			"Error: Parsed telephone number is invalid!\n"+
+			"AreaCode         = '%v'\n"+
+			"SubscriberNumber = '%v'\n"+
+			"Extension        = '%v'\n",
+			funcName,
+			telNumDto.AreaCode,
+			telNumDto.SubscriberNumber,
+			telNumDto.Extension)
+
+		return
+	}
+
+	if !testTelephoneNumCheckFormats(
+		t,
+		funcName,
+		&telNumDto,
+		"+12125550100",
+		"(212) 555-0100 x42",
+		"+1 (212) 555-0100 x42",
+		"12125550100,42") {
+		return
+	}
+
+	var telNumDto2 TelephoneNumberDto
+
+	telNumDto2,
+		err = telNumDto.CopyOut(funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	if !testTelephoneNumCheckFormats(
+		t,
+		funcName,
+		&telNumDto2,
+		"+12125550100",
+		"(212) 555-0100 x42",
+		"+1 (212) 555-0100 x42",
+		"12125550100,42") {
+		return
+	}
+
+	extensionData := []struct {
+		rawTelephoneNum   string
+		expectedExtension string
+	}{
+		{"+1-212-555-0100 ext. 7", "7"},
+		{"(212) 555-0100#12", "12"},
+		{"212-555-0100 x4200", "4200"},
+		{"212.555.0100 ext 123456", "123456"},
+	}
+
+	for i := 0; i < len(extensionData); i++ {
+
+		telNumDto,
+			err = new(TelephoneNumberDto).NewParseTelephoneNumber(
+			extensionData[i].rawTelephoneNum,
+			"US",
+			funcName)
+
+		if err != nil {
+			t.Errorf("%v Test #%v\n"+
+				"rawTelephoneNum = '%v'\n"+
+				"%v\n",
+				funcName,
+				i,
+				extensionData[i].rawTelephoneNum,
+				err.Error())
+			return
+		}
+
+		if telNumDto.AreaCode != "212" ||
+			telNumDto.SubscriberNumber != "5550100" ||
+			telNumDto.Extension != extensionData[i].expectedExtension {
+
+			t.Errorf("%v Test #%v\n"+
+				"Error: Parsed telephone number is invalid!\n"+
+				"rawTelephoneNum  = '%v'\n"+
+				"AreaCode         = '%v'\n"+
+				"SubscriberNumber = '%v'\n"+
+				"Extension        = '%v'\n"+
+				"Expected Ext     = '%v'\n",
+				funcName,
+				i,
+				extensionData[i].rawTelephoneNum,
+				telNumDto.AreaCode,
+				telNumDto.SubscriberNumber,
+				telNumDto.Extension,
+				extensionData[i].expectedExtension)
+
+			return
+		}
+	}
+
+	telNumDto2.Empty()
+
+	_,
+		err = telNumDto2.FmtE164(funcName)
+
+	if err == nil {
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from FmtE164()\n"+
+			"because 'telNumDto2' is empty.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			funcName)
+
+		return
+	}
+}
+
+func TestNumStrFmtCountryTelephoneNumSpec_ParseTelephoneNumber_000100(t *testing.T) {
+
+	funcName := "TestNumStrFmtCountryTelephoneNumSpec_ParseTelephoneNumber_000100()"
+
+	telNumSpec,
+		err := new(NumStrFmtCountryTelephoneNumSpec).NewCountryCode(
+		"fra",
+		funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	var telNumDto TelephoneNumberDto
+
+	telNumDto,
+		err = telNumSpec.ParseTelephoneNumber(
+		"01 23 45 67 89",
+		funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	if !testTelephoneNumCheckFormats(
+		t,
+		funcName,
+		&telNumDto,
+		"+33123456789",
+		"01 23 45 67 89",
+		"+33 1 23 45 67 89",
+		"0123456789") {
+		return
+	}
+
+	countryCultureSpec,
+		err := new(NumStrFmtCountryCultureSpec).NewFrance(
+		funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	if countryCultureSpec.TelephoneNumberFormat.CountryTelephoneCode != "33" {
+		t.Errorf("%v\n"+
+			"Error: Expected TelephoneNumberFormat.CountryTelephoneCode = '33'\n"+
+			"Instead, CountryTelephoneCode = '%v'\n",
+			funcName,
+			countryCultureSpec.TelephoneNumberFormat.CountryTelephoneCode)
+
+		return
+	}
+
+	var countryCultureSpec2 NumStrFmtCountryCultureSpec
+
+	countryCultureSpec2,
+		err = countryCultureSpec.CopyOut(funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	telNumDto,
+		err = countryCultureSpec2.TelephoneNumberFormat.ParseTelephoneNumber(
+		"+33 1 23 45 67 89",
+		funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	if !testTelephoneNumCheckFormats(
+		t,
+		funcName,
+		&telNumDto,
+		"+33123456789",
+		"01 23 45 67 89",
+		"+33 1 23 45 67 89",
+		"") {
+		return
+	}
+}
+
+func TestTelephoneNumberDto_NewParseTelephoneNumber_000300(t *testing.T) {
+
+	funcName := "TestTelephoneNumberDto_NewParseTelephoneNumber_000300()"
+
+	testData := []struct {
+		rawTelNum          string
+		defaultCountryCode string
+	}{
+		{"(212) 555-010", "US"},
+		{"(212) 555-01000", "US"},
+		{"(212) 555-0100 x1234567", "US"},
+		{"(212) 555*0100", "US"},
+		{"(212 555-0100", "US"},
+		{"212 + 555-0100", "US"},
+		{"212 555-0100 abc42", "US"},
+		{"212 555-0100", ""},
+		{"212 555-0100", "XX"},
+		{"+999 1234 5678", "US"},
+		{"", "US"},
+	}
+
+	var err error
+
+	for i := 0; i < len(testData); i++ {
+
+		_,
+			err = new(TelephoneNumberDto).NewParseTelephoneNumber(
+			testData[i].rawTelNum,
+			testData[i].defaultCountryCode,
+			funcName)
+
+		if err == nil {
+			t.Errorf("%v\n"+
+				"Error: Expected an error return from NewParseTelephoneNumber()\n"+
+				"because the input parameters are invalid.\n"+
+				"rawTelNum          = '%v'\n"+
+				"defaultCountryCode = '%v'\n"+
+				"HOWEVER, NO ERROR WAS RETURNED!\n",
+				funcName,
+				testData[i].rawTelNum,
+				testData[i].defaultCountryCode)
+
+			return
+		}
+	}
+
+	_,
+		err = new(NumStrFmtCountryTelephoneNumSpec).NewCountryCode(
+		"XX",
+		funcName)
+
+	if err == nil {
+		t.Errorf("%v\n"+
+			"Error: Expected an error return from NewCountryCode()\n"+
+			"because country code 'XX' is invalid.\n"+
+			"HOWEVER, NO ERROR WAS RETURNED!\n",
+			funcName)
+
+		return
+	}
+}
+
+func TestNumStrFmtCountryTelephoneNumSpec_NewCountryCode_000100(t *testing.T) {
+
+	funcName := "TestNumStrFmtCountryTelephoneNumSpec_NewCountryCode_000100()"
+
+	countryCultureSpec,
+		err := new(NumStrFmtCountryCultureSpec).NewUS(
+		funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	telNumSpec := countryCultureSpec.TelephoneNumberFormat
+
+	testData := []struct {
+		label    string
+		actual   string
+		expected string
+	}{
+		{"PhoneExtNumMinNumOfDigitsExternal",
+			telNumSpec.PhoneExtNumMinNumOfDigitsExternal, "4"},
+		{"PhoneExtNumMaxNumOfDigitsExternal",
+			telNumSpec.PhoneExtNumMaxNumOfDigitsExternal, "4"},
+		{"PhoneExtNumMinNumOfDigitsInternal",
+			telNumSpec.PhoneExtNumMinNumOfDigitsInternal, "4"},
+		{"PhoneExtNumMaxNumOfDigitsInternal",
+			telNumSpec.PhoneExtNumMaxNumOfDigitsInternal, "4"},
+		{"PhoneExtFmtFullExternal.PhoneNoDialFmt",
+			telNumSpec.PhoneExtFmtFullExternal.PhoneNoDialFmt.NumberFormat, "NNNN"},
+		{"PhoneExtFmtFullExternal.PhoneNoDisplayFmt",
+			telNumSpec.PhoneExtFmtFullExternal.PhoneNoDisplayFmt.NumberFormat, "NNNN"},
+		{"PhoneExtFmtAbbrExternal.PhoneNoDialFmt",
+			telNumSpec.PhoneExtFmtAbbrExternal.PhoneNoDialFmt.NumberFormat, "NNNN"},
+		{"PhoneExtFmtAbbrExternal.PhoneNoDisplayFmt",
+			telNumSpec.PhoneExtFmtAbbrExternal.PhoneNoDisplayFmt.NumberFormat, "NNNN"},
+		{"PhoneExtFmtFullInternal.PhoneNoDialFmt",
+			telNumSpec.PhoneExtFmtFullInternal.PhoneNoDialFmt.NumberFormat, "NNNN"},
+		{"PhoneExtFmtFullInternal.PhoneNoDisplayFmt",
+			telNumSpec.PhoneExtFmtFullInternal.PhoneNoDisplayFmt.NumberFormat, "NNNN"},
+		{"PhoneExtFmtAbbrInternal.PhoneNoDialFmt",
+			telNumSpec.PhoneExtFmtAbbrInternal.PhoneNoDialFmt.NumberFormat, "NNNN"},
+		{"PhoneExtFmtAbbrInternal.PhoneNoDisplayFmt",
+			telNumSpec.PhoneExtFmtAbbrInternal.PhoneNoDisplayFmt.NumberFormat, "NNNN"},
+		{"SubscriberFmtFullExternal.PhoneNoDialFmt",
+			telNumSpec.SubscriberFmtFullExternal.PhoneNoDialFmt.NumberFormat, "1NNNNNNNNNN"},
+		{"SubscriberFmtFullExternal.PhoneNoDisplayFmt",
+			telNumSpec.SubscriberFmtFullExternal.PhoneNoDisplayFmt.NumberFormat, "1 (NNN) NNN-NNNN"},
+		{"SubscriberFmtAbbrInternal.PhoneNoDisplayFmt",
+			telNumSpec.SubscriberFmtAbbrInternal.PhoneNoDisplayFmt.NumberFormat, "(NNN) NNN-NNNN"},
+	}
+
+	for i := 0; i < len(testData); i++ {
+
+		if testData[i].actual != testData[i].expected {
+			t.Errorf("%v\n"+
+				"Error: TelephoneNumberFormat.%v is invalid!\n"+
+				"Actual   = '%v'\n"+
+				"Expected = '%v'\n",
+				funcName,
+				testData[i].label,
+				testData[i].actual,
+				testData[i].expected)
+
+			return
+		}
+	}
+}
+
+func TestTelephoneNumberDto_NewParseTelephoneNumber_000400(t *testing.T) {
+
+	funcName := "TestTelephoneNumberDto_NewParseTelephoneNumber_000400()"
+
+	testData := []struct {
+		rawTelNum             string
+		expectedAreaCode      string
+		expectedSubscriberNum string
+		expectedE164          string
+		expectedNational      string
+		expectedInternational string
+		expectedDial          string
+	}{
+		{"+91 98765 43210",
+			"",
+			"9876543210",
+			"+919876543210",
+			"98765 43210",
+			"+91 98765 43210",
+			"09876543210"},
+		{"098765 43210",
+			"",
+			"9876543210",
+			"+919876543210",
+			"98765 43210",
+			"+91 98765 43210",
+			"09876543210"},
+		{"+91 70123 45678",
+			"",
+			"7012345678",
+			"+917012345678",
+			"70123 45678",
+			"+91 70123 45678",
+			"07012345678"},
+		{"+91 80 2345 6789",
+			"80",
+			"23456789",
+			"+918023456789",
+			"080 2345 6789",
+			"+91 80 2345 6789",
+			"08023456789"},
+	}
+
+	for i := 0; i < len(testData); i++ {
+
+		telNumDto,
+			err := new(TelephoneNumberDto).NewParseTelephoneNumber(
+			testData[i].rawTelNum,
+			"IN",
+			funcName)
+
+		if err != nil {
+			t.Errorf("%v\n"+
+				"Test #%v\n"+
+				"%v\n",
+				funcName,
+				i,
+				err.Error())
+			return
+		}
+
+		if telNumDto.AreaCode != testData[i].expectedAreaCode ||
+			telNumDto.SubscriberNumber != testData[i].expectedSubscriberNum {
+
+			t.Errorf("%v\n"+
+				"Test #%v\n"+
+				"Error: Parsed telephone number is invalid!\n"+
+				"rawTelNum        = '%v'\n"+
+				"AreaCode         = '%v'\n"+
+				"SubscriberNumber = '%v'\n",
+				funcName,
+				i,
+				testData[i].rawTelNum,
+				telNumDto.AreaCode,
+				telNumDto.SubscriberNumber)
+
+			return
+		}
+
+		if !testTelephoneNumCheckFormats(
+			t,
+			funcName,
+			&telNumDto,
+			testData[i].expectedE164,
+			testData[i].expectedNational,
+			testData[i].expectedInternational,
+			testData[i].expectedDial) {
+			return
+		}
+	}
+
+	// The Telephone Catalog used for parsing accepts
+	// one to six extension digits.
+	catalogTelNumSpec,
+		err := new(NumStrFmtCountryTelephoneNumSpec).NewCountryCode(
+		"US",
+		funcName)
+
+	if err != nil {
+		t.Errorf("%v\n"+
+			"%v\n",
+			funcName,
+			err.Error())
+		return
+	}
+
+	if catalogTelNumSpec.PhoneExtNumMinNumOfDigitsExternal != "1" ||
+		catalogTelNumSpec.PhoneExtNumMaxNumOfDigitsExternal != "6" {
+
+		t.Errorf("%v\n"+
+			"Error: Telephone Catalog US extension digit limits are invalid!\n"+
+			"PhoneExtNumMinNumOfDigitsExternal = '%v'\n"+
+			"PhoneExtNumMaxNumOfDigitsExternal = '%v'\n"+
+			"Expected '1' and '6'\n",
+			funcName,
+			catalogTelNumSpec.PhoneExtNumMinNumOfDigitsExternal,
+			catalogTelNumSpec.PhoneExtNumMaxNumOfDigitsExternal)
+	}
+}